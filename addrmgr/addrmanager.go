@@ -17,6 +17,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -47,6 +48,11 @@ type AddrManager struct {
 	lamtx          sync.Mutex
 	localAddresses map[string]*LocalAddress
 	version        int
+
+	// changes is the number of changes to the known addresses since they
+	// were last saved and lastSave is when that was.
+	changes  int
+	lastSave time.Time
 }
 
 type serializedKnownAddress struct {
@@ -101,10 +107,19 @@ const (
 	// address manager will claim to need more addresses.
 	needAddressThreshold = 1000
 
-	// dumpAddressInterval is the interval used to dump the address
-	// cache to disk for future use.
+	// dumpAddressInterval is the maximum interval between the changes to
+	// the address cache and their dump to disk for future use.
 	dumpAddressInterval = time.Minute * 10
 
+	// saveCheckInterval is the interval at which the address handler
+	// checks whether the address cache needs to be dumped to disk.
+	saveCheckInterval = time.Minute
+
+	// saveChangesThreshold is the number of changes to the address cache
+	// which causes it to be dumped to disk at the next check rather than
+	// after dumpAddressInterval.
+	saveChangesThreshold = 500
+
 	// triedBucketSize is the maximum number of addresses in each
 	// tried address bucket.
 	triedBucketSize = 256
@@ -159,7 +174,9 @@ const (
 	getAddrPercent = 23
 
 	// serialisationVersion is the current version of the on-disk format.
-	serialisationVersion = 2
+	// Versions 1 and 2 are a single JSON document and version 3 is a
+	// checksummed record per line.
+	serialisationVersion = 3
 )
 
 // updateAddress is a helper function to either update an address already known
@@ -188,6 +205,7 @@ func (a *AddrManager) updateAddress(netAddr, srcAddr *wire.NetAddress) {
 			naCopy.Timestamp = netAddr.Timestamp
 			naCopy.AddService(netAddr.Services)
 			ka.na = &naCopy
+			a.changes++
 		}
 
 		// If already in tried, we have nothing to do here.
@@ -233,6 +251,7 @@ func (a *AddrManager) updateAddress(netAddr, srcAddr *wire.NetAddress) {
 	// Add to new bucket.
 	ka.refs++
 	a.addrNew[bucket][addr] = ka
+	a.changes++
 
 	log.Tracef("Added new address %s for a total of %d addresses", addr,
 		a.nTried+a.nNew)
@@ -340,13 +359,15 @@ func (a *AddrManager) getTriedBucket(netAddr *wire.NetAddress) int {
 // addressHandler is the main handler for the address manager.  It must be run
 // as a goroutine.
 func (a *AddrManager) addressHandler() {
-	dumpAddressTicker := time.NewTicker(dumpAddressInterval)
-	defer dumpAddressTicker.Stop()
+	saveCheckTicker := time.NewTicker(saveCheckInterval)
+	defer saveCheckTicker.Stop()
 out:
 	for {
 		select {
-		case <-dumpAddressTicker.C:
-			a.savePeers()
+		case <-saveCheckTicker.C:
+			if a.needsSave() {
+				a.savePeers()
+			}
 
 		case <-a.quit:
 			break out
@@ -357,12 +378,51 @@ out:
 	log.Trace("Address handler done")
 }
 
+// needsSave returns whether the known addresses changed enough, or long enough
+// ago, since they were last saved to be saved again.
+func (a *AddrManager) needsSave() bool {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	return a.changes >= saveChangesThreshold ||
+		(a.changes > 0 && time.Since(a.lastSave) >= dumpAddressInterval)
+}
+
 // savePeers saves all the known addresses to a file so they can be read back
-// in at next run.
+// in at next run.  The file is replaced atomically so a crash while saving
+// leaves the previous file intact.
 func (a *AddrManager) savePeers() {
 	a.mtx.Lock()
-	defer a.mtx.Unlock()
+	var data []byte
+	var err error
+	if a.version < 3 {
+		data, err = a.serializeLegacyPeers()
+	} else {
+		data, err = a.serializePeers()
+	}
+	changes := a.changes
+	a.mtx.Unlock()
 
+	if err != nil {
+		log.Errorf("Failed to encode file %s: %v", a.peersFile, err)
+		return
+	}
+	if err := writeFileAtomic(a.peersFile, data); err != nil {
+		log.Errorf("Failed to write file %s: %v", a.peersFile, err)
+		return
+	}
+
+	a.mtx.Lock()
+	a.changes -= changes
+	a.lastSave = time.Now()
+	a.mtx.Unlock()
+}
+
+// serializeLegacyPeers returns all the known addresses in the single JSON
+// document format of versions 1 and 2.
+//
+// This function MUST be called with the address manager lock held (for reads).
+func (a *AddrManager) serializeLegacyPeers() ([]byte, error) {
 	// First we make a serialisable datastructure so we can encode it to
 	// json.
 	sam := new(serializedAddrManager)
@@ -406,21 +466,16 @@ func (a *AddrManager) savePeers() {
 		}
 	}
 
-	w, err := os.Create(a.peersFile)
+	data, err := json.Marshal(sam)
 	if err != nil {
-		log.Errorf("Error opening file %s: %v", a.peersFile, err)
-		return
-	}
-	enc := json.NewEncoder(w)
-	defer w.Close()
-	if err := enc.Encode(&sam); err != nil {
-		log.Errorf("Failed to encode file %s: %v", a.peersFile, err)
-		return
+		return nil, err
 	}
+	return append(data, '\n'), nil
 }
 
-// loadPeers loads the known address from the saved file.  If empty, missing, or
-// malformed file, just don't load anything and start fresh
+// loadPeers loads the known address from the saved file.  Damaged parts of the
+// file are skipped, and if the file is empty, missing, or unusable, just don't
+// load anything and start fresh.
 func (a *AddrManager) loadPeers() {
 	a.mtx.Lock()
 	defer a.mtx.Unlock()
@@ -441,20 +496,38 @@ func (a *AddrManager) loadPeers() {
 }
 
 func (a *AddrManager) deserializePeers(filePath string) error {
-
-	_, err := os.Stat(filePath)
+	data, err := os.ReadFile(filePath)
 	if os.IsNotExist(err) {
 		return nil
 	}
-	r, err := os.Open(filePath)
 	if err != nil {
 		return fmt.Errorf("%s error opening file: %v", filePath, err)
 	}
-	defer r.Close()
 
+	if isLegacyPeersFile(data) {
+		return a.deserializeLegacyPeers(filePath, data)
+	}
+
+	numSaved, numDamaged, err := a.deserializePeersV3(data)
+	if err != nil {
+		return err
+	}
+	if numDamaged > 0 || (numSaved >= 0 && numSaved != a.numAddresses()) {
+		log.Warnf("Recovered %d addresses from damaged file %s, "+
+			"skipped %d damaged entries", a.numAddresses(), filePath,
+			numDamaged)
+
+		// Save the recovered addresses at the next check.
+		a.changes = saveChangesThreshold
+	}
+	return nil
+}
+
+// deserializeLegacyPeers loads the addresses of a peers file in the single
+// JSON document format of versions 1 and 2.
+func (a *AddrManager) deserializeLegacyPeers(filePath string, data []byte) error {
 	var sam serializedAddrManager
-	dec := json.NewDecoder(r)
-	err = dec.Decode(&sam)
+	err := json.Unmarshal(data, &sam)
 	if err != nil {
 		return fmt.Errorf("error reading %s: %v", filePath, err)
 	}
@@ -772,6 +845,14 @@ func NetAddressKey(na *wire.NetAddress) string {
 // have not been used recently and should not pick 'close' addresses
 // consecutively.
 func (a *AddrManager) GetAddress() *KnownAddress {
+	return a.GetAddressFrom()
+}
+
+// GetAddressFrom returns a single address that should be routable and is
+// reachable through one of the passed networks, picked in the same way as
+// GetAddress.  Addresses from any network are considered when no networks are
+// passed.  It returns nil when there are no addresses on the passed networks.
+func (a *AddrManager) GetAddressFrom(nets ...Network) *KnownAddress {
 	// Protect concurrent access.
 	a.mtx.Lock()
 	defer a.mtx.Unlock()
@@ -780,6 +861,10 @@ func (a *AddrManager) GetAddress() *KnownAddress {
 		return nil
 	}
 
+	if len(nets) != 0 {
+		return a.getNetworkAddress(nets, false)
+	}
+
 	// Use a 50% chance for choosing between tried and new table entries.
 	if a.nTried > 0 && (a.nNew == 0 || a.rand.Intn(2) == 0) {
 		// Tried entry.
@@ -838,6 +923,70 @@ func (a *AddrManager) GetAddress() *KnownAddress {
 	}
 }
 
+// GetUntriedAddress returns a single address from the new table, which holds
+// the addresses that have never been connected to successfully, that is
+// reachable through one of the passed networks.  Addresses from any network
+// are considered when no networks are passed.  It is intended to pick the
+// addresses tested by feeler connections and returns nil when there are no
+// such addresses.
+func (a *AddrManager) GetUntriedAddress(nets ...Network) *KnownAddress {
+	// Protect concurrent access.
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	if a.nNew == 0 {
+		return nil
+	}
+	return a.getNetworkAddress(nets, true)
+}
+
+// getNetworkAddress returns a single address which is reachable through one of
+// the passed networks, or any network when none are passed.  Since the buckets
+// are not grouped by network, the candidates from the tried and new tables are
+// collected first and an address is then picked from them with the same
+// preferences as GetAddress.  Only the new table is considered when newOnly is
+// set.
+//
+// This function MUST be called with the address manager lock held (for writes).
+func (a *AddrManager) getNetworkAddress(nets []Network, newOnly bool) *KnownAddress {
+	var tried, unTried []*KnownAddress
+	for _, ka := range a.addrIndex {
+		if len(nets) != 0 && !slices.Contains(nets, AddressNetwork(ka.na)) {
+			continue
+		}
+		if ka.tried {
+			if newOnly {
+				continue
+			}
+			tried = append(tried, ka)
+		} else {
+			unTried = append(unTried, ka)
+		}
+	}
+
+	// Use a 50% chance for choosing between tried and new table entries.
+	candidates, table := unTried, "new"
+	if len(tried) > 0 && (len(unTried) == 0 || a.rand.Intn(2) == 0) {
+		candidates, table = tried, "tried"
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	large := 1 << 30
+	factor := 1.0
+	for {
+		ka := candidates[a.rand.Intn(len(candidates))]
+		randval := a.rand.Intn(large)
+		if float64(randval) < (factor * ka.chance() * float64(large)) {
+			log.Tracef("Selected %v from %s bucket",
+				NetAddressKey(ka.na), table)
+			return ka
+		}
+		factor *= 1.2
+	}
+}
+
 func (a *AddrManager) find(addr *wire.NetAddress) *KnownAddress {
 	return a.addrIndex[NetAddressKey(addr)]
 }
@@ -857,6 +1006,7 @@ func (a *AddrManager) Attempt(addr *wire.NetAddress) {
 	// set last tried time to now
 	ka.attempts++
 	ka.lastattempt = time.Now()
+	a.changes++
 }
 
 // Connected Marks the given address as currently connected and working at the
@@ -879,6 +1029,7 @@ func (a *AddrManager) Connected(addr *wire.NetAddress) {
 		naCopy := *ka.na
 		naCopy.Timestamp = time.Now()
 		ka.na = &naCopy
+		a.changes++
 	}
 }
 
@@ -900,6 +1051,7 @@ func (a *AddrManager) Good(addr *wire.NetAddress) {
 	ka.lastsuccess = now
 	ka.lastattempt = now
 	ka.attempts = 0
+	a.changes++
 
 	// move to tried set, optionally evicting other addresses if neeed.
 	if ka.tried {
@@ -987,6 +1139,7 @@ func (a *AddrManager) SetServices(addr *wire.NetAddress, services wire.ServiceFl
 		naCopy := *ka.na
 		naCopy.Services = services
 		ka.na = &naCopy
+		a.changes++
 	}
 }
 
@@ -1,11 +1,13 @@
 package addrmgr
 
 import (
+	"bytes"
 	"io/ioutil"
 	"math/rand"
 	"net"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/gcash/bchd/wire"
 )
@@ -190,3 +192,159 @@ func TestAddrManagerV1ToV2(t *testing.T) {
 	addrMgr.loadPeers()
 	assertAddrs(t, addrMgr, expectedAddrs)
 }
+
+// TestAddrManagerRecovery ensures the addresses of a damaged peers file which
+// are not damaged themselves are recovered, and that a good address stays in
+// the tried buckets.
+func TestAddrManagerRecovery(t *testing.T) {
+	tempDir := t.TempDir()
+	addrMgr := New(tempDir, nil)
+
+	const numAddrs = 10
+	expectedAddrs := make(map[string]*wire.NetAddress, numAddrs)
+	for i := 0; i < numAddrs; i++ {
+		addr := wire.NewNetAddressIPPort(net.IPv4(173, 194, byte(i), 1),
+			8333, wire.SFNodeNetwork)
+		expectedAddrs[NetAddressKey(addr)] = addr
+		addrMgr.AddAddress(addr, addr)
+	}
+	var goodAddr *wire.NetAddress
+	for _, addr := range expectedAddrs {
+		goodAddr = addr
+		break
+	}
+	addrMgr.Good(goodAddr)
+	addrMgr.savePeers()
+
+	if _, err := os.Stat(addrMgr.peersFile + ".tmp"); !os.IsNotExist(err) {
+		t.Fatalf("temporary peers file left behind: %v", err)
+	}
+	saved, err := os.ReadFile(addrMgr.peersFile)
+	if err != nil {
+		t.Fatalf("unable to read peers file: %v", err)
+	}
+	lines := bytes.SplitAfter(saved, []byte{'\n'})
+	lines = lines[:len(lines)-1]
+	if len(lines) != numAddrs+1 {
+		t.Fatalf("got %d lines in the peers file, want %d", len(lines),
+			numAddrs+1)
+	}
+
+	// The index of the tried address in the file.
+	var triedLine int
+	for i, line := range lines {
+		if bytes.Contains(line, []byte(`"Tried":true`)) {
+			triedLine = i
+		}
+	}
+	if triedLine == 0 {
+		t.Fatal("no tried address in the peers file")
+	}
+	damagedLine := 1
+	if triedLine == 1 {
+		damagedLine = 2
+	}
+
+	tests := []struct {
+		name     string
+		damage   func() []byte
+		numAddrs int
+	}{{
+		name:     "intact",
+		damage:   func() []byte { return saved },
+		numAddrs: numAddrs,
+	}, {
+		name: "truncated",
+		damage: func() []byte {
+			damaged := bytes.Join(append(lines[:damagedLine:damagedLine],
+				lines[damagedLine+1:]...), nil)
+			return append(damaged, lines[damagedLine][:20]...)
+		},
+		numAddrs: numAddrs - 1,
+	}, {
+		name: "damaged address",
+		damage: func() []byte {
+			damaged := bytes.Clone(saved)
+			i := bytes.Index(damaged, lines[damagedLine]) + 30
+			damaged[i] ^= 0x01
+			return damaged
+		},
+		numAddrs: numAddrs - 1,
+	}, {
+		name: "damaged header",
+		damage: func() []byte {
+			damaged := bytes.Clone(saved)
+			damaged[0] ^= 0x01
+			return damaged
+		},
+		numAddrs: numAddrs,
+	}}
+
+	for _, test := range tests {
+		err := os.WriteFile(addrMgr.peersFile, test.damage(), 0644)
+		if err != nil {
+			t.Fatalf("%s: unable to write peers file: %v", test.name, err)
+		}
+		addrMgr := New(tempDir, nil)
+		addrMgr.loadPeers()
+
+		addrs := addrMgr.getAddresses()
+		if len(addrs) != test.numAddrs {
+			t.Errorf("%s: recovered %d addresses, want %d", test.name,
+				len(addrs), test.numAddrs)
+			continue
+		}
+		for _, addr := range addrs {
+			expectedAddr, ok := expectedAddrs[NetAddressKey(addr)]
+			if !ok {
+				t.Errorf("%s: recovered unknown address %v",
+					test.name, addr)
+				continue
+			}
+			assertAddr(t, addr, expectedAddr)
+		}
+		if ka := addrMgr.find(goodAddr); ka == nil || !ka.tried {
+			t.Errorf("%s: good address was not recovered as tried",
+				test.name)
+		}
+		if addrMgr.nNew+addrMgr.nTried != test.numAddrs {
+			t.Errorf("%s: got %d new and %d tried addresses, want "+
+				"%d in total", test.name, addrMgr.nNew,
+				addrMgr.nTried, test.numAddrs)
+		}
+	}
+}
+
+// TestAddrManagerNeedsSave ensures the address cache is saved once it changed
+// enough or a change is old enough.
+func TestAddrManagerNeedsSave(t *testing.T) {
+	addrMgr := New(t.TempDir(), nil)
+	addrMgr.savePeers()
+	if addrMgr.needsSave() {
+		t.Fatal("needsSave is set without changes")
+	}
+
+	addr := wire.NewNetAddressIPPort(net.IPv4(173, 194, 0, 1), 8333,
+		wire.SFNodeNetwork)
+	addrMgr.AddAddress(addr, addr)
+	if addrMgr.needsSave() {
+		t.Fatal("needsSave is set right after a change")
+	}
+
+	addrMgr.lastSave = time.Now().Add(-dumpAddressInterval)
+	if !addrMgr.needsSave() {
+		t.Fatal("needsSave is not set for an old change")
+	}
+
+	addrMgr.savePeers()
+	for i := 0; i < saveChangesThreshold; i++ {
+		addrMgr.Attempt(addr)
+	}
+	if !addrMgr.needsSave() {
+		t.Fatal("needsSave is not set after many changes")
+	}
+	addrMgr.savePeers()
+	if addrMgr.needsSave() {
+		t.Fatal("needsSave is set after saving")
+	}
+}
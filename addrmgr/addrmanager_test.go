@@ -351,6 +351,91 @@ func TestGetAddress(t *testing.T) {
 	}
 }
 
+// TestGetAddressFrom ensures only addresses on the requested networks are
+// returned.
+func TestGetAddressFrom(t *testing.T) {
+	n := addrmgr.New("testgetaddressfrom", lookupFunc)
+
+	// Add an IPv4 and an IPv6 address.
+	ipv6IP := "2620:100::1"
+	for _, addr := range []string{someIP + ":8333", "[" + ipv6IP + "]:8333"} {
+		if err := n.AddAddressByIP(addr); err != nil {
+			t.Fatalf("Adding address %s failed: %v", addr, err)
+		}
+	}
+
+	// There are no onion addresses.
+	if ka := n.GetAddressFrom(addrmgr.OnionNetwork); ka != nil {
+		t.Errorf("GetAddressFrom onion: got %v, want nil", ka.NetAddress().IP)
+	}
+
+	tests := []struct {
+		net  addrmgr.Network
+		want string
+	}{
+		{addrmgr.IPv4Network, someIP},
+		{addrmgr.IPv6Network, ipv6IP},
+	}
+	for _, test := range tests {
+		// Pick several times to ensure the filter is always applied.
+		for i := 0; i < 10; i++ {
+			ka := n.GetAddressFrom(test.net)
+			if ka == nil {
+				t.Fatalf("GetAddressFrom %v: no address", test.net)
+			}
+			if got := ka.NetAddress().IP.String(); got != test.want {
+				t.Fatalf("GetAddressFrom %v: got %v, want %v",
+					test.net, got, test.want)
+			}
+		}
+
+		// Tried addresses must be filtered as well.
+		ka := n.GetAddressFrom(test.net)
+		n.Good(ka.NetAddress())
+	}
+	if ka := n.GetAddressFrom(addrmgr.IPv6Network); ka == nil ||
+		ka.NetAddress().IP.String() != ipv6IP {
+
+		t.Errorf("GetAddressFrom ipv6 after good: got %v, want %v", ka,
+			ipv6IP)
+	}
+}
+
+// TestGetUntriedAddress ensures only addresses from the new table are returned.
+func TestGetUntriedAddress(t *testing.T) {
+	n := addrmgr.New("testgetuntriedaddress", lookupFunc)
+
+	// Get an address from an empty set (should be nil)
+	if ka := n.GetUntriedAddress(); ka != nil {
+		t.Errorf("GetUntriedAddress: got %v, want nil", ka.NetAddress().IP)
+	}
+
+	// Add a new address and get it
+	err := n.AddAddressByIP(someIP + ":8333")
+	if err != nil {
+		t.Fatalf("Adding address failed: %v", err)
+	}
+	ka := n.GetUntriedAddress()
+	if ka == nil {
+		t.Fatal("Did not get an address where there is one in the new table")
+	}
+	if ka.NetAddress().IP.String() != someIP {
+		t.Errorf("Wrong IP: got %v, want %v", ka.NetAddress().IP, someIP)
+	}
+	if ka := n.GetUntriedAddress(addrmgr.IPv6Network); ka != nil {
+		t.Errorf("GetUntriedAddress ipv6: got %v, want nil",
+			ka.NetAddress().IP)
+	}
+
+	// Once the address is moved to the tried table it must no longer be
+	// returned.
+	n.Good(ka.NetAddress())
+	if ka := n.GetUntriedAddress(); ka != nil {
+		t.Errorf("GetUntriedAddress after good: got %v, want nil",
+			ka.NetAddress().IP)
+	}
+}
+
 func TestGetBestLocalAddress(t *testing.T) {
 	localAddrs := []wire.NetAddress{
 		{IP: net.ParseIP("192.168.0.100")},
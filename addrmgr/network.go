@@ -229,6 +229,60 @@ func IsRoutable(na *wire.NetAddress) bool {
 		IsLocal(na) || (IsRFC4193(na) && !IsOnionCatTor(na)))
 }
 
+// Network identifies the network an address is reachable through.
+type Network uint8
+
+// These constants define the networks an address may belong to.
+const (
+	// IPv4Network is the IPv4 internet.
+	IPv4Network Network = iota
+
+	// IPv6Network is the IPv6 internet.
+	IPv6Network
+
+	// OnionNetwork is the Tor network.
+	OnionNetwork
+)
+
+// networkStrings is a map of networks back to their constant names for pretty
+// printing.
+var networkStrings = map[Network]string{
+	IPv4Network:  "ipv4",
+	IPv6Network:  "ipv6",
+	OnionNetwork: "onion",
+}
+
+// String returns the Network in human-readable form.
+func (n Network) String() string {
+	if s, ok := networkStrings[n]; ok {
+		return s
+	}
+	return fmt.Sprintf("Unknown Network (%d)", uint8(n))
+}
+
+// ParseNetwork returns the network with the passed name.  Valid names are
+// ipv4, ipv6 and onion.
+func ParseNetwork(name string) (Network, error) {
+	for n, s := range networkStrings {
+		if s == name {
+			return n, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown network '%s'", name)
+}
+
+// AddressNetwork returns the network the passed address is reachable through.
+func AddressNetwork(na *wire.NetAddress) Network {
+	switch {
+	case IsOnionCatTor(na):
+		return OnionNetwork
+	case IsIPv4(na):
+		return IPv4Network
+	default:
+		return IPv6Network
+	}
+}
+
 // GroupKey returns a string representing the network group an address is part
 // of.  This is the /16 for IPv4, the /32 (/36 for he.net) for IPv6, the string
 // "local" for a local address, the string "tor:key" where key is the /4 of the
@@ -200,3 +200,35 @@ func TestGroupKey(t *testing.T) {
 		}
 	}
 }
+
+// TestAddressNetwork ensures addresses are mapped to the expected networks and
+// network names are parsed as intended.
+func TestAddressNetwork(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want addrmgr.Network
+	}{
+		{"12.1.2.3", addrmgr.IPv4Network},
+		{"::ffff:12.1.2.3", addrmgr.IPv4Network},
+		{"2620:100::1", addrmgr.IPv6Network},
+		{"fd87:d87e:eb43:25::1", addrmgr.OnionNetwork},
+	}
+	for _, test := range tests {
+		na := wire.NewNetAddressIPPort(net.ParseIP(test.ip), 8333,
+			wire.SFNodeNetwork)
+		if got := addrmgr.AddressNetwork(na); got != test.want {
+			t.Errorf("AddressNetwork %s: got %v, want %v", test.ip,
+				got, test.want)
+		}
+
+		got, err := addrmgr.ParseNetwork(test.want.String())
+		if err != nil || got != test.want {
+			t.Errorf("ParseNetwork %s: got %v (err %v), want %v",
+				test.want, got, err, test.want)
+		}
+	}
+
+	if _, err := addrmgr.ParseNetwork("i2p"); err == nil {
+		t.Error("ParseNetwork i2p: expected error, got none")
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package addrmgr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"strconv"
+	"time"
+)
+
+// The version 3 peers file is written one record per line so a damaged file
+// only loses the damaged records.  Each line is the CRC32 checksum of a JSON
+// record as 8 hex digits, a space and the record:
+//
+//	<checksum> {"Version":3,"Key":[...],"NumAddresses":2}
+//	<checksum> {"Addr":"1.2.3.4:8333",...,"Tried":false,"Buckets":[17,912]}
+//	<checksum> {"Addr":"5.6.7.8:8333",...,"Tried":true,"Buckets":[40]}
+//
+// The first line is the header and every following line is an address along
+// with the buckets it is in.
+
+// checksumLen is the length of the hex encoded checksum prefixing each line
+// of a version 3 peers file.
+const checksumLen = 8
+
+// serializedPeersHeader is the first record of a version 3 peers file.
+type serializedPeersHeader struct {
+	Version      int
+	Key          [32]byte
+	NumAddresses int
+}
+
+// serializedPeer is an address record of a version 3 peers file.  Buckets
+// holds the new buckets of a new address or the tried bucket of a tried one.
+type serializedPeer struct {
+	serializedKnownAddress
+	Tried   bool
+	Buckets []int
+}
+
+// isLegacyPeersFile returns whether the passed peers file contents are in the
+// single JSON document format used by versions 1 and 2.
+func isLegacyPeersFile(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	return len(data) > 0 && data[0] == '{'
+}
+
+// appendPeersRecord appends the passed record to a version 3 peers file as a
+// checksummed line.
+func appendPeersRecord(buf *bytes.Buffer, record interface{}) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(buf, "%08x ", crc32.ChecksumIEEE(data))
+	buf.Write(data)
+	buf.WriteByte('\n')
+	return nil
+}
+
+// parsePeersRecord decodes a line of a version 3 peers file into the passed
+// record after verifying its checksum.
+func parsePeersRecord(line []byte, record interface{}) error {
+	if len(line) < checksumLen+1 || line[checksumLen] != ' ' {
+		return fmt.Errorf("malformed line")
+	}
+	checksum, err := strconv.ParseUint(string(line[:checksumLen]), 16, 32)
+	if err != nil {
+		return fmt.Errorf("malformed checksum: %v", err)
+	}
+	data := line[checksumLen+1:]
+	if uint32(checksum) != crc32.ChecksumIEEE(data) {
+		return fmt.Errorf("checksum mismatch")
+	}
+	return json.Unmarshal(data, record)
+}
+
+// serializePeers returns all the known addresses in the version 3 peers file
+// format.
+//
+// This function MUST be called with the address manager lock held (for reads).
+func (a *AddrManager) serializePeers() ([]byte, error) {
+	buckets := make(map[*KnownAddress][]int, len(a.addrIndex))
+	for i := range a.addrNew {
+		for _, ka := range a.addrNew[i] {
+			buckets[ka] = append(buckets[ka], i)
+		}
+	}
+	for i := range a.addrTried {
+		for e := a.addrTried[i].Front(); e != nil; e = e.Next() {
+			ka := e.Value.(*KnownAddress)
+			buckets[ka] = append(buckets[ka], i)
+		}
+	}
+
+	var buf bytes.Buffer
+	header := serializedPeersHeader{
+		Version:      serialisationVersion,
+		Key:          a.key,
+		NumAddresses: len(a.addrIndex),
+	}
+	if err := appendPeersRecord(&buf, &header); err != nil {
+		return nil, err
+	}
+	for k, v := range a.addrIndex {
+		sp := serializedPeer{
+			serializedKnownAddress: serializedKnownAddress{
+				Addr:        k,
+				Src:         NetAddressKey(v.srcAddr),
+				Attempts:    v.attempts,
+				TimeStamp:   v.na.Timestamp.Unix(),
+				LastAttempt: v.lastattempt.Unix(),
+				LastSuccess: v.lastsuccess.Unix(),
+				Services:    v.na.Services,
+				SrcServices: v.srcAddr.Services,
+			},
+			Tried:   v.tried,
+			Buckets: buckets[v],
+		}
+		if err := appendPeersRecord(&buf, &sp); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// deserializePeersV3 loads the addresses of a version 3 peers file, skipping
+// the lines which are damaged.  Addresses are put back in the buckets they
+// were saved in when those are usable, and in the buckets they hash to
+// otherwise, for instance when the header holding the bucket key is damaged.
+// It returns the number of addresses the file was saved with, or -1 when the
+// header is damaged, and the number of damaged lines.
+//
+// This function MUST be called with the address manager lock held (for writes).
+func (a *AddrManager) deserializePeersV3(data []byte) (int, int, error) {
+	lines := bytes.Split(data, []byte{'\n'})
+	if len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return 0, 0, nil
+	}
+
+	numSaved := -1
+	numDamaged := 0
+	keyValid := false
+	var header serializedPeersHeader
+	if err := parsePeersRecord(lines[0], &header); err != nil {
+		log.Warnf("Damaged peers file header, rehashing addresses: %v",
+			err)
+		numDamaged++
+	} else {
+		if header.Version > serialisationVersion {
+			return 0, 0, fmt.Errorf("unknown version %v in serialized "+
+				"addrmanager", header.Version)
+		}
+		a.key = header.Key
+		keyValid = true
+		numSaved = header.NumAddresses
+	}
+
+	for _, line := range lines[1:] {
+		var sp serializedPeer
+		if err := parsePeersRecord(line, &sp); err != nil {
+			numDamaged++
+			continue
+		}
+		if !a.restorePeer(&sp, keyValid) {
+			numDamaged++
+		}
+	}
+	return numSaved, numDamaged, nil
+}
+
+// restorePeer adds the passed saved address back to the address manager.  The
+// saved buckets are only used when keyValid is set, since the bucket of an
+// address depends on the key.  It returns false when the record is unusable.
+//
+// This function MUST be called with the address manager lock held (for writes).
+func (a *AddrManager) restorePeer(sp *serializedPeer, keyValid bool) bool {
+	na, err := a.DeserializeNetAddress(sp.Addr, sp.Services)
+	if err != nil {
+		return false
+	}
+	addr := NetAddressKey(na)
+	if _, ok := a.addrIndex[addr]; ok {
+		return false
+	}
+	srcAddr, err := a.DeserializeNetAddress(sp.Src, sp.SrcServices)
+	if err != nil {
+		return false
+	}
+	na.Timestamp = time.Unix(sp.TimeStamp, 0)
+	ka := &KnownAddress{
+		na:          na,
+		srcAddr:     srcAddr,
+		attempts:    sp.Attempts,
+		lastattempt: time.Unix(sp.LastAttempt, 0),
+		lastsuccess: time.Unix(sp.LastSuccess, 0),
+	}
+
+	if sp.Tried {
+		bucket := a.getTriedBucket(na)
+		if keyValid && len(sp.Buckets) == 1 && sp.Buckets[0] >= 0 &&
+			sp.Buckets[0] < triedBucketCount {
+
+			bucket = sp.Buckets[0]
+		}
+		if a.addrTried[bucket].Len() < triedBucketSize {
+			ka.tried = true
+			a.addrTried[bucket].PushBack(ka)
+			a.addrIndex[addr] = ka
+			a.nTried++
+			return true
+		}
+		// The tried bucket is full, so keep the address as a new one.
+		sp.Buckets = nil
+	}
+
+	buckets := sp.Buckets
+	if !keyValid || len(buckets) == 0 {
+		buckets = []int{a.getNewBucket(na, srcAddr)}
+	}
+	for _, bucket := range buckets {
+		if bucket < 0 || bucket >= newBucketCount ||
+			ka.refs == newBucketsPerAddress ||
+			len(a.addrNew[bucket]) >= newBucketSize {
+
+			continue
+		}
+		if _, ok := a.addrNew[bucket][addr]; ok {
+			continue
+		}
+		ka.refs++
+		a.addrNew[bucket][addr] = ka
+	}
+	if ka.refs == 0 {
+		return false
+	}
+	a.addrIndex[addr] = ka
+	a.nNew++
+	return true
+}
+
+// writeFileAtomic writes the passed data to a temporary file next to the
+// passed path, syncs it to disk and then renames it over the path, so the file
+// at the path is never partially written.
+func writeFileAtomic(path string, data []byte) error {
+	tmpPath := path + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,361 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bchec
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	"math/bits"
+)
+
+// SchnorrBatchItem is a single Schnorr signature verification which is part
+// of a batch.
+type SchnorrBatchItem struct {
+	PubKey    *PublicKey
+	Hash      []byte
+	Signature *Signature
+}
+
+// VerifySchnorrBatch verifies all of the passed Schnorr signatures at once.
+// It returns true only if every signature is valid.  A false result does not
+// tell which of the signatures is invalid, so callers should fall back to
+// verifying the signatures individually to find out.
+//
+// For each signature the point R is recovered from r and the batch is checked
+// with the random linear combination
+//
+//	(a1*s1 + ... + an*sn) * G = a1*R1 + a1*e1*P1 + ... + an*Rn + an*en*Pn
+//
+// where a1 is 1 and the remaining coefficients are random 128 bit integers.
+// The right hand side is computed with a single multi-scalar multiplication,
+// which shares the point doublings between all terms and makes the batch
+// substantially cheaper than verifying the signatures one by one.
+func VerifySchnorrBatch(items []SchnorrBatchItem) bool {
+	switch len(items) {
+	case 0:
+		return true
+	case 1:
+		item := items[0]
+		return item.Signature.sigType == SignatureTypeSchnorr &&
+			item.Signature.Verify(item.Hash, item.PubKey)
+	}
+
+	curve := S256()
+	n := curve.Params().N
+
+	sSum := new(big.Int)
+	xs := make([]*fieldVal, 0, len(items)*2)
+	ys := make([]*fieldVal, 0, len(items)*2)
+	ks := make([][]byte, 0, len(items)*2)
+	var randBytes [16]byte
+	for i, item := range items {
+		sig := item.Signature
+		if sig.sigType != SignatureTypeSchnorr {
+			return false
+		}
+		if _, ok := item.PubKey.Curve.(*KoblitzCurve); !ok {
+			return false
+		}
+
+		// Signature is invalid if s >= order or r >= p.
+		if sig.S.Cmp(n) >= 0 || sig.R.Cmp(curve.P) >= 0 {
+			return false
+		}
+
+		rx, ry, ok := liftSchnorrR(sig.R)
+		if !ok {
+			return false
+		}
+
+		// Compute scalar e = Hash(r || compressed(P) || m) mod N
+		eBytes := sha256.Sum256(append(append(padIntBytes(sig.R),
+			item.PubKey.SerializeCompressed()...), item.Hash...))
+		e := new(big.Int).SetBytes(eBytes[:])
+		e.Mod(e, n)
+
+		a := big.NewInt(1)
+		for i > 0 && (a.Sign() == 0 || a.Cmp(one) == 0) {
+			if _, err := rand.Read(randBytes[:]); err != nil {
+				return false
+			}
+			a.SetBytes(randBytes[:])
+		}
+
+		sSum.Add(sSum, new(big.Int).Mul(a, sig.S))
+		e.Mul(e, a).Mod(e, n)
+
+		px, py := curve.bigAffineToField(item.PubKey.X, item.PubKey.Y)
+		xs = append(xs, rx, px)
+		ys = append(ys, ry, py)
+		ks = append(ks, a.Bytes(), e.Bytes())
+	}
+	sSum.Mod(sSum, n)
+
+	lx, ly, lz := curve.scalarBaseMultJacobian(sSum.Bytes())
+	rx, ry, rz := curve.multiScalarMultJacobian(xs, ys, ks)
+	return jacobianEqual(lx, ly, lz, rx, ry, rz)
+}
+
+// liftSchnorrR returns the point with the passed x coordinate whose y
+// coordinate is a quadratic residue, which is the only point a valid Schnorr
+// signature with that r value can commit to.  r must be less than P.
+func liftSchnorrR(r *big.Int) (*fieldVal, *fieldVal, bool) {
+	// y^2 = x^3 + 7
+	x := new(fieldVal).SetByteSlice(r.Bytes())
+	c := new(fieldVal).SquareVal(x).Mul(x).AddInt(7).Normalize()
+
+	// Since P = 3 mod 4 and (P+1)/4 is even, c^((P+1)/4) is both a square
+	// root of c when one exists and a quadratic residue itself.
+	y := fieldSqrt(c)
+	if !new(fieldVal).SquareVal(y).Normalize().Equals(c) {
+		return nil, nil, false
+	}
+	return x, y.Normalize(), true
+}
+
+// fieldSqrt returns a^((P+1)/4) using the addition chain of libsecp256k1,
+// which takes 253 squarings and 13 multiplications.  xN denotes a raised to
+// the power 2^N - 1.
+func fieldSqrt(a *fieldVal) *fieldVal {
+	sqrN := func(f *fieldVal, n int) *fieldVal {
+		for i := 0; i < n; i++ {
+			f.Square()
+		}
+		return f
+	}
+
+	x2 := new(fieldVal).SquareVal(a).Mul(a)
+	x3 := new(fieldVal).SquareVal(x2).Mul(a)
+	x6 := sqrN(new(fieldVal).Set(x3), 3).Mul(x3)
+	x9 := sqrN(new(fieldVal).Set(x6), 3).Mul(x3)
+	x11 := sqrN(new(fieldVal).Set(x9), 2).Mul(x2)
+	x22 := sqrN(new(fieldVal).Set(x11), 11).Mul(x11)
+	x44 := sqrN(new(fieldVal).Set(x22), 22).Mul(x22)
+	x88 := sqrN(new(fieldVal).Set(x44), 44).Mul(x44)
+	x176 := sqrN(new(fieldVal).Set(x88), 88).Mul(x88)
+	x220 := sqrN(new(fieldVal).Set(x176), 44).Mul(x44)
+	x223 := sqrN(new(fieldVal).Set(x220), 3).Mul(x3)
+
+	t := sqrN(x223, 23).Mul(x22)
+	t = sqrN(t, 6).Mul(x2)
+	return sqrN(t, 2)
+}
+
+// jacobianEqual returns whether the two passed Jacobian points are the same
+// point.
+func jacobianEqual(x1, y1, z1, x2, y2, z2 *fieldVal) bool {
+	inf1 := z1.Normalize().IsZero()
+	inf2 := z2.Normalize().IsZero()
+	if inf1 || inf2 {
+		return inf1 == inf2
+	}
+
+	// x1*z2^2 == x2*z1^2 and y1*z2^3 == y2*z1^3
+	z1z1 := new(fieldVal).SquareVal(z1)
+	z2z2 := new(fieldVal).SquareVal(z2)
+	u1 := new(fieldVal).Mul2(x1, z2z2).Normalize()
+	u2 := new(fieldVal).Mul2(x2, z1z1).Normalize()
+	if !u1.Equals(u2) {
+		return false
+	}
+	s1 := new(fieldVal).Mul2(y1, z2z2.Mul(z2)).Normalize()
+	s2 := new(fieldVal).Mul2(y2, z1z1.Mul(z1)).Normalize()
+	return s1.Equals(s2)
+}
+
+// msmWindow is the window width of the w-NAF representation used by
+// multiScalarMultJacobian.  Each term precomputes 2^(msmWindow-2) points.
+const msmWindow = 5
+
+// wNAF returns the width-w non-adjacent form of the big endian integer k, of
+// at most 32 bytes, with the least significant digit first.  Every non-zero
+// digit is odd and smaller than 2^(w-1) in magnitude, and any w consecutive
+// digits contain at most one non-zero digit.
+func wNAF(k []byte, w uint) []int8 {
+	// Load k into little endian limbs with room for a final carry.
+	var n [5]uint64
+	for i, b := range k {
+		pos := uint(len(k) - 1 - i)
+		n[pos/8] |= uint64(b) << (8 * (pos % 8))
+	}
+
+	mod := uint64(1) << w
+	digits := make([]int8, 0, len(k)*8+1)
+	for n != [5]uint64{} {
+		var d int64
+		if n[0]&1 == 1 {
+			d = int64(n[0] & (mod - 1))
+			if d >= int64(mod>>1) {
+				d -= int64(mod)
+			}
+
+			// Subtracting a positive digit only clears the low bits
+			// while adding the magnitude of a negative one may carry.
+			if d > 0 {
+				n[0] -= uint64(d)
+			} else {
+				var carry uint64
+				n[0], carry = bits.Add64(n[0], uint64(-d), 0)
+				for l := 1; l < len(n) && carry != 0; l++ {
+					n[l], carry = bits.Add64(n[l], 0, carry)
+				}
+			}
+		}
+		digits = append(digits, int8(d))
+		for l := 0; l < len(n)-1; l++ {
+			n[l] = n[l]>>1 | n[l+1]<<63
+		}
+		n[len(n)-1] >>= 1
+	}
+	return digits
+}
+
+// msmTerm is a single term of a multi-scalar multiplication.  table holds
+// the odd multiples P, 3P, 5P, ... of the term's point.
+type msmTerm struct {
+	table  [1 << (msmWindow - 2)][3]*fieldVal
+	digits []int8
+}
+
+// newMsmTerm returns a term for the affine point (x, y) with the w-NAF digits
+// of the passed integer, negating the point if sign is negative.  The table
+// is in Jacobian coordinates until normalized with toAffine.
+func (curve *KoblitzCurve) newMsmTerm(x, y *fieldVal, k []byte, sign int) *msmTerm {
+	t := &msmTerm{digits: wNAF(k, msmWindow)}
+	py := new(fieldVal).Set(y)
+	if sign < 0 {
+		py.Normalize().Negate(1)
+	}
+	t.table[0] = [3]*fieldVal{new(fieldVal).Set(x), py, new(fieldVal).SetInt(1)}
+
+	dx, dy, dz := new(fieldVal), new(fieldVal), new(fieldVal)
+	curve.doubleJacobian(t.table[0][0], t.table[0][1], t.table[0][2], dx, dy, dz)
+	for i := 1; i < len(t.table); i++ {
+		prev := t.table[i-1]
+		nx, ny, nz := new(fieldVal), new(fieldVal), new(fieldVal)
+		curve.addJacobian(prev[0], prev[1], prev[2], dx, dy, dz, nx, ny, nz)
+		t.table[i] = [3]*fieldVal{nx, ny, nz}
+	}
+	return t
+}
+
+// toAffine converts the tables of all passed terms to affine coordinates
+// with a single field inversion using Montgomery's trick, which makes every
+// addition in the main loop a cheaper mixed addition.
+func toAffine(terms []*msmTerm) {
+	var points [][3]*fieldVal
+	for _, t := range terms {
+		points = append(points, t.table[:]...)
+	}
+
+	// prefix[i] = z0 * z1 * ... * zi
+	prefix := make([]*fieldVal, len(points))
+	acc := new(fieldVal).SetInt(1)
+	for i, p := range points {
+		acc = new(fieldVal).Mul2(acc, p[2].Normalize())
+		prefix[i] = acc
+	}
+	inv := new(fieldVal).Set(acc).Normalize().Inverse()
+
+	for i := len(points) - 1; i >= 0; i-- {
+		p := points[i]
+		zInv := new(fieldVal).Set(inv)
+		if i > 0 {
+			zInv.Mul(prefix[i-1])
+			inv.Mul(p[2])
+		}
+		zInv2 := new(fieldVal).SquareVal(zInv)
+		p[0].Mul(zInv2).Normalize()
+		p[1].Mul(zInv2.Mul(zInv)).Normalize()
+		p[2].SetInt(1)
+	}
+}
+
+// endomorphism returns a term for ϕ(P) = (βx, y) with the w-NAF digits of the
+// passed integer, reusing the affine multiples of the term for P.  sign is
+// applied relative to the points of that term.
+func (curve *KoblitzCurve) endomorphism(t *msmTerm, k []byte, sign int) *msmTerm {
+	e := &msmTerm{digits: wNAF(k, msmWindow)}
+	for i, p := range t.table {
+		y := new(fieldVal).Set(p[1])
+		if sign < 0 {
+			y.Normalize().Negate(1)
+		}
+		e.table[i] = [3]*fieldVal{new(fieldVal).Mul2(p[0], curve.beta), y, p[2]}
+	}
+	return e
+}
+
+// multiScalarMultJacobian returns the Jacobian coordinates of the sum of
+// ks[i]*(xs[i], ys[i]) where the points are affine and the scalars are big
+// endian integers.  It uses Strauss' method with w-NAF digits so the point
+// doublings are shared between all of the terms.  Scalars longer than 128
+// bits are split with the endomorphism as in scalarMultJacobian.
+func (curve *KoblitzCurve) multiScalarMultJacobian(xs, ys []*fieldVal, ks [][]byte) (*fieldVal, *fieldVal, *fieldVal) {
+	type split struct {
+		term  *msmTerm
+		k2    []byte
+		sign2 int
+	}
+
+	terms := make([]*msmTerm, 0, len(ks)*2)
+	var splits []split
+	for i, k := range ks {
+		k = curve.moduloReduce(k)
+		if len(k) <= 16 {
+			terms = append(terms, curve.newMsmTerm(xs[i], ys[i], k, 1))
+			continue
+		}
+
+		// k * P = k1 * P + k2 * ϕ(P) where the signs of k1 and k2
+		// are applied to the points.  The sign of k2 is kept relative
+		// to the sign of k1 since ϕ(P) is derived from the k1 term.
+		k1, k2, signK1, signK2 := curve.splitK(k)
+		t := curve.newMsmTerm(xs[i], ys[i], k1, signK1)
+		terms = append(terms, t)
+		sign2 := signK2
+		if signK1 != 0 {
+			sign2 *= signK1
+		}
+		splits = append(splits, split{term: t, k2: k2, sign2: sign2})
+	}
+	toAffine(terms)
+	for _, s := range splits {
+		terms = append(terms, curve.endomorphism(s.term, s.k2, s.sign2))
+	}
+
+	m := 0
+	for _, t := range terms {
+		m = max(m, len(t.digits))
+	}
+
+	// Point Q = ∞ (point at infinity).
+	qx, qy, qz := new(fieldVal), new(fieldVal), new(fieldVal)
+	negY := new(fieldVal)
+	for i := m - 1; i >= 0; i-- {
+		// Q = 2 * Q
+		curve.doubleJacobian(qx, qy, qz, qx, qy, qz)
+
+		for _, t := range terms {
+			if i >= len(t.digits) || t.digits[i] == 0 {
+				continue
+			}
+			d := t.digits[i]
+			if d > 0 {
+				p := t.table[d/2]
+				curve.addJacobian(qx, qy, qz, p[0], p[1], p[2],
+					qx, qy, qz)
+			} else {
+				p := t.table[-d/2]
+				negY.NegateVal(p[1], 1)
+				curve.addJacobian(qx, qy, qz, p[0], negY, p[2],
+					qx, qy, qz)
+			}
+		}
+	}
+
+	return qx, qy, qz
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bchec
+
+import (
+	"crypto/sha256"
+	"testing"
+)
+
+// batchTestItems returns count valid Schnorr signatures, each made with a
+// fresh key over a distinct message.
+func batchTestItems(t testing.TB, count int) []SchnorrBatchItem {
+	items := make([]SchnorrBatchItem, 0, count)
+	for i := 0; i < count; i++ {
+		privKey, err := NewPrivateKey(S256())
+		if err != nil {
+			t.Fatalf("failed to generate key: %v", err)
+		}
+		hash := sha256.Sum256([]byte{byte(i), byte(i >> 8)})
+		sig, err := privKey.SignSchnorr(hash[:])
+		if err != nil {
+			t.Fatalf("failed to sign: %v", err)
+		}
+		items = append(items, SchnorrBatchItem{
+			PubKey:    privKey.PubKey(),
+			Hash:      hash[:],
+			Signature: sig,
+		})
+	}
+	return items
+}
+
+func TestVerifySchnorrBatch(t *testing.T) {
+	items := batchTestItems(t, 20)
+	for _, size := range []int{0, 1, 2, 20} {
+		if !VerifySchnorrBatch(items[:size]) {
+			t.Fatalf("valid batch of %d signatures failed to verify", size)
+		}
+	}
+
+	// Each kind of invalid signature must fail the whole batch.
+	tests := []struct {
+		name   string
+		modify func(item *SchnorrBatchItem)
+	}{
+		{"wrong hash", func(item *SchnorrBatchItem) {
+			hash := sha256.Sum256(item.Hash)
+			item.Hash = hash[:]
+		}},
+		{"wrong key", func(item *SchnorrBatchItem) {
+			item.PubKey = items[0].PubKey
+		}},
+		{"modified s", func(item *SchnorrBatchItem) {
+			sig := *item.Signature
+			sig.S = fromHex("01")
+			item.Signature = &sig
+		}},
+		{"r not on curve", func(item *SchnorrBatchItem) {
+			sig := *item.Signature
+			sig.R = fromHex("05")
+			item.Signature = &sig
+		}},
+		{"ecdsa signature", func(item *SchnorrBatchItem) {
+			sig := *item.Signature
+			sig.sigType = SignatureTypeECDSA
+			item.Signature = &sig
+		}},
+	}
+	for _, test := range tests {
+		batch := make([]SchnorrBatchItem, len(items))
+		copy(batch, items)
+		test.modify(&batch[7])
+		if VerifySchnorrBatch(batch) {
+			t.Errorf("%s: invalid batch verified", test.name)
+		}
+	}
+}
+
+// BenchmarkSchnorrBatchVerify benchmarks how long it takes to verify a batch
+// of 100 Schnorr signatures.  Compare with 100 times BenchmarkSchnorrSigVerify.
+func BenchmarkSchnorrBatchVerify(b *testing.B) {
+	b.StopTimer()
+	items := batchTestItems(b, 100)
+	b.StartTimer()
+
+	for i := 0; i < b.N; i++ {
+		VerifySchnorrBatch(items)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build libsecp256k1 && cgo
+// +build libsecp256k1,cgo
+
+package bchec
+
+/*
+#cgo LDFLAGS: -lsecp256k1
+#include <secp256k1.h>
+#include <secp256k1_schnorr.h>
+*/
+import "C"
+
+import (
+	"math/big"
+	"sync"
+	"unsafe"
+)
+
+// verifyScratch holds the values passed to libsecp256k1 for a single
+// verification.  Scratch space is pooled to avoid allocating it per call.
+type verifyScratch struct {
+	pubKey C.secp256k1_pubkey
+	sig    C.secp256k1_ecdsa_signature
+	sig64  [64]byte
+	hash   [32]byte
+}
+
+// libsecp256k1Verifier verifies signatures with libsecp256k1.  The library
+// must be built with the Schnorr module of Bitcoin Cash Node, which provides
+// secp256k1_schnorr_verify.
+//
+// A single verification context is created and shared by all goroutines,
+// which libsecp256k1 permits since verification does not modify it.
+type libsecp256k1Verifier struct {
+	ctx     *C.secp256k1_context
+	scratch sync.Pool
+}
+
+func init() {
+	ctx := C.secp256k1_context_create(C.SECP256K1_CONTEXT_VERIFY)
+	if ctx == nil {
+		return
+	}
+	accelVerifier = &libsecp256k1Verifier{
+		ctx: ctx,
+		scratch: sync.Pool{
+			New: func() interface{} { return new(verifyScratch) },
+		},
+	}
+	accelEnabled = 1
+}
+
+// prepare fills the scratch space with the passed values.  It returns false
+// when they can not be handled by libsecp256k1.
+func (v *libsecp256k1Verifier) prepare(sc *verifyScratch, pubKey *PublicKey,
+	hash []byte, r, s *big.Int) bool {
+
+	if _, ok := pubKey.Curve.(*KoblitzCurve); !ok || len(hash) != 32 {
+		return false
+	}
+	if r.Sign() < 0 || s.Sign() < 0 || r.BitLen() > 256 || s.BitLen() > 256 {
+		return false
+	}
+	r.FillBytes(sc.sig64[:32])
+	s.FillBytes(sc.sig64[32:])
+	copy(sc.hash[:], hash)
+
+	serialized := pubKey.SerializeCompressed()
+	return C.secp256k1_ec_pubkey_parse(v.ctx, &sc.pubKey,
+		(*C.uchar)(unsafe.Pointer(&serialized[0])),
+		C.size_t(len(serialized))) == 1
+}
+
+// verifyECDSA verifies an ECDSA signature.  Like crypto/ecdsa, and unlike
+// libsecp256k1, high S values are accepted so the signature is normalized
+// before it is verified.
+func (v *libsecp256k1Verifier) verifyECDSA(pubKey *PublicKey, hash []byte,
+	r, s *big.Int) (bool, bool) {
+
+	sc := v.scratch.Get().(*verifyScratch)
+	defer v.scratch.Put(sc)
+
+	if !v.prepare(sc, pubKey, hash, r, s) {
+		return false, false
+	}
+
+	// Parsing fails when r or s is not less than the group order, which
+	// makes the signature invalid.
+	if C.secp256k1_ecdsa_signature_parse_compact(v.ctx, &sc.sig,
+		(*C.uchar)(unsafe.Pointer(&sc.sig64[0]))) != 1 {
+
+		return false, true
+	}
+	C.secp256k1_ecdsa_signature_normalize(v.ctx, &sc.sig, &sc.sig)
+
+	valid := C.secp256k1_ecdsa_verify(v.ctx, &sc.sig,
+		(*C.uchar)(unsafe.Pointer(&sc.hash[0])), &sc.pubKey) == 1
+	return valid, true
+}
+
+// verifySchnorr verifies a Bitcoin Cash Schnorr signature.
+func (v *libsecp256k1Verifier) verifySchnorr(pubKey *PublicKey, hash []byte,
+	r, s *big.Int) (bool, bool) {
+
+	sc := v.scratch.Get().(*verifyScratch)
+	defer v.scratch.Put(sc)
+
+	if !v.prepare(sc, pubKey, hash, r, s) {
+		return false, false
+	}
+
+	valid := C.secp256k1_schnorr_verify(v.ctx,
+		(*C.uchar)(unsafe.Pointer(&sc.sig64[0])),
+		(*C.uchar)(unsafe.Pointer(&sc.hash[0])), &sc.pubKey) == 1
+	return valid, true
+}
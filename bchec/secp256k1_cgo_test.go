@@ -0,0 +1,58 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build libsecp256k1 && cgo
+// +build libsecp256k1,cgo
+
+package bchec
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+)
+
+// TestLibsecp256k1Verify ensures signatures verified with libsecp256k1 give
+// the same results as the pure Go implementation.
+func TestLibsecp256k1Verify(t *testing.T) {
+	if !AccelerationAvailable() {
+		t.Fatal("libsecp256k1 backend is not available")
+	}
+	defer SetAcceleration(true)
+
+	for i := 0; i < 50; i++ {
+		privKey, err := NewPrivateKey(S256())
+		if err != nil {
+			t.Fatalf("failed to generate key: %v", err)
+		}
+		hash := sha256.Sum256([]byte{byte(i)})
+		ecdsaSig, err := privKey.SignECDSA(hash[:])
+		if err != nil {
+			t.Fatalf("failed to sign: %v", err)
+		}
+		schnorrSig, err := privKey.SignSchnorr(hash[:])
+		if err != nil {
+			t.Fatalf("failed to sign: %v", err)
+		}
+		highS := &Signature{
+			R:       ecdsaSig.R,
+			S:       new(big.Int).Sub(S256().N, ecdsaSig.S),
+			sigType: SignatureTypeECDSA,
+		}
+		otherHash := sha256.Sum256(hash[:])
+
+		for _, sig := range []*Signature{ecdsaSig, highS, schnorrSig} {
+			for _, h := range [][]byte{hash[:], otherHash[:]} {
+				SetAcceleration(false)
+				want := sig.Verify(h, privKey.PubKey())
+				SetAcceleration(true)
+				got := sig.Verify(h, privKey.PubKey())
+				if got != want {
+					t.Fatalf("libsecp256k1 verified %v, pure Go "+
+						"verified %v", got, want)
+				}
+			}
+		}
+	}
+}
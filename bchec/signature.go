@@ -49,6 +49,11 @@ var (
 	oneInitializer = []byte{0x01}
 )
 
+// Type returns the type of the signature.
+func (sig *Signature) Type() SignatureType {
+	return sig.sigType
+}
+
 // Serialize returns the a serialized signature depending on the SignatureType.
 // Note that the serialized bytes returned do not include the appended hash type
 // used in Bitcoin signature scripts.
@@ -91,8 +96,22 @@ func (sig *Signature) Serialize() []byte {
 
 // Verify verifies either an ECDSA or Schnorr signature depending
 // on the SignatureType of the signature. It returns true if the
-// signature is valid, false otherwise.
+// signature is valid, false otherwise.  The accelerated backend is
+// used when enabled, see SetAcceleration.
 func (sig *Signature) Verify(hash []byte, pubKey *PublicKey) bool {
+	if v := activeVerifier(); v != nil {
+		var valid, ok bool
+		switch sig.sigType {
+		case SignatureTypeECDSA:
+			valid, ok = v.verifyECDSA(pubKey, hash, sig.R, sig.S)
+		case SignatureTypeSchnorr:
+			valid, ok = v.verifySchnorr(pubKey, hash, sig.R, sig.S)
+		}
+		if ok {
+			return valid
+		}
+	}
+
 	if sig.sigType == SignatureTypeECDSA {
 		return ecdsa.Verify(pubKey.ToECDSA(), hash, sig.R, sig.S)
 	} else if sig.sigType == SignatureTypeSchnorr {
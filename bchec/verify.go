@@ -0,0 +1,57 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bchec
+
+import (
+	"math/big"
+	"sync/atomic"
+)
+
+// sigVerifier is implemented by alternative signature verification backends.
+// The verify methods return false for ok when the backend can not handle the
+// passed values, in which case the signature is verified by this package.
+type sigVerifier interface {
+	verifyECDSA(pubKey *PublicKey, hash []byte, r, s *big.Int) (valid, ok bool)
+	verifySchnorr(pubKey *PublicKey, hash []byte, r, s *big.Int) (valid, ok bool)
+}
+
+var (
+	// accelVerifier is the accelerated verification backend compiled into
+	// the binary, if any.  It is set during package initialization.
+	accelVerifier sigVerifier
+
+	// accelEnabled is non-zero when signatures are verified by
+	// accelVerifier.
+	accelEnabled int32
+)
+
+// AccelerationAvailable returns whether the binary was built with an
+// accelerated signature verification backend, which is the case when built
+// with the libsecp256k1 build tag.
+func AccelerationAvailable() bool {
+	return accelVerifier != nil
+}
+
+// SetAcceleration enables or disables signature verification through the
+// accelerated backend.  It returns whether the backend is in use afterwards,
+// which is always false when no backend is available.
+//
+// This function is safe for concurrent access.
+func SetAcceleration(enabled bool) bool {
+	if accelVerifier == nil || !enabled {
+		atomic.StoreInt32(&accelEnabled, 0)
+		return false
+	}
+	atomic.StoreInt32(&accelEnabled, 1)
+	return true
+}
+
+// activeVerifier returns the accelerated backend if it is enabled.
+func activeVerifier() sigVerifier {
+	if atomic.LoadInt32(&accelEnabled) == 0 {
+		return nil
+	}
+	return accelVerifier
+}
@@ -0,0 +1,388 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bchrpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gcash/bchd/addrmgr"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+)
+
+const (
+	// maxBcmrDocumentSize is the maximum number of bytes read when fetching
+	// a registry document.  Larger documents are rejected.
+	maxBcmrDocumentSize = 1024 * 1024 * 2
+
+	// maxBcmrCacheEntries is the maximum number of registry documents,
+	// including failed fetches, held in the cache at any one time.
+	maxBcmrCacheEntries = 1000
+
+	// bcmrFailureTTL is how long a failed fetch is remembered so clients
+	// requesting the same token do not cause repeated fetches.
+	bcmrFailureTTL = time.Minute
+
+	// maxBcmrAuthchainLength is the maximum number of transactions of an
+	// authchain followed from the authbase to find the latest registry
+	// publication of an identity.
+	maxBcmrAuthchainLength = 100
+
+	// maxBcmrSpenderSearch is the maximum number of transactions of the
+	// address of a confirmed authchain output searched for the transaction
+	// spending it.
+	maxBcmrSpenderSearch = 1000
+)
+
+// bcmrTag is the OP_RETURN prefix identifying an on-chain publication of a
+// Bitcoin Cash Metadata Registry.
+var bcmrTag = []byte("BCMR")
+
+// BcmrConfig holds the settings for a BcmrResolver.
+type BcmrConfig struct {
+	// Timeout is the maximum time allowed for fetching a single document.
+	Timeout time.Duration
+
+	// CacheTTL is how long a successfully fetched document is cached.
+	CacheTTL time.Duration
+
+	// IPFSGateway is the URL prefix used to fetch ipfs:// URIs.
+	IPFSGateway string
+}
+
+// bcmrIdentitySnapshot is a single timestamped snapshot of an identity in a
+// registry document.
+type bcmrIdentitySnapshot struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Token       *struct {
+		Category string `json:"category"`
+		Symbol   string `json:"symbol"`
+		Decimals uint32 `json:"decimals"`
+	} `json:"token"`
+}
+
+// bcmrRegistry is the subset of a registry document used by the resolver.
+// Identities are keyed by authbase and then by snapshot timestamp.
+type bcmrRegistry struct {
+	Identities map[string]map[string]bcmrIdentitySnapshot `json:"identities"`
+}
+
+// bcmrTokenInfo is the metadata resolved for a single token.
+type bcmrTokenInfo struct {
+	Name        string
+	Symbol      string
+	Decimals    uint32
+	Description string
+}
+
+// lookup returns the metadata of the latest snapshot, which is not in the
+// future, of the identity with the given authbase or token category.  The
+// category is expected as a hex string in display byte order.
+func (r *bcmrRegistry) lookup(category string, now time.Time) (*bcmrTokenInfo, bool) {
+	category = strings.ToLower(category)
+
+	var (
+		best     *bcmrIdentitySnapshot
+		bestTime time.Time
+	)
+	for authbase, snapshots := range r.Identities {
+		for ts, snapshot := range snapshots {
+			snapshot := snapshot
+			if strings.ToLower(authbase) != category &&
+				(snapshot.Token == nil || strings.ToLower(snapshot.Token.Category) != category) {
+				continue
+			}
+			t, err := time.Parse(time.RFC3339, ts)
+			if err != nil || t.After(now) {
+				continue
+			}
+			if best == nil || t.After(bestTime) {
+				best, bestTime = &snapshot, t
+			}
+		}
+	}
+	if best == nil {
+		return nil, false
+	}
+
+	info := &bcmrTokenInfo{
+		Name:        best.Name,
+		Description: best.Description,
+	}
+	if best.Token != nil {
+		info.Symbol = best.Token.Symbol
+		info.Decimals = best.Token.Decimals
+	}
+	return info, true
+}
+
+// bcmrCacheEntry is a cached fetch result.  Exactly one of registry and err
+// is set.
+type bcmrCacheEntry struct {
+	registry *bcmrRegistry
+	err      error
+	expires  time.Time
+}
+
+// bcmrFetch is a fetch of a registry document in progress.  The entry is set
+// before done is closed.
+type bcmrFetch struct {
+	done  chan struct{}
+	entry *bcmrCacheEntry
+}
+
+// BcmrResolver fetches, hash-checks and caches Bitcoin Cash Metadata Registry
+// documents referenced on-chain.  Documents are cached by their committed
+// hash so every token published in the same registry shares one entry, and
+// concurrent requests for the same document share a single fetch.
+//
+// The URIs published on-chain are chosen by anyone, so documents are only
+// fetched from publicly routable addresses to keep them from reaching services
+// on the network of the node.  The configured IPFS gateway is trusted and may
+// be a local one.
+type BcmrResolver struct {
+	cfg           BcmrConfig
+	client        *http.Client
+	gatewayClient *http.Client
+
+	mtx      sync.Mutex
+	cache    map[[sha256.Size]byte]*bcmrCacheEntry
+	inflight map[[sha256.Size]byte]*bcmrFetch
+}
+
+// NewBcmrResolver returns a new BcmrResolver using the passed config.
+func NewBcmrResolver(cfg *BcmrConfig) *BcmrResolver {
+	// Proxies are not used for the untrusted URIs since the addresses
+	// would then be checked against the proxy instead of the destination.
+	dialer := &net.Dialer{
+		Timeout: cfg.Timeout,
+		Control: publicAddrControl,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &BcmrResolver{
+		cfg: *cfg,
+		client: &http.Client{
+			Timeout:   cfg.Timeout,
+			Transport: transport,
+		},
+		gatewayClient: &http.Client{Timeout: cfg.Timeout},
+		cache:         make(map[[sha256.Size]byte]*bcmrCacheEntry),
+		inflight:      make(map[[sha256.Size]byte]*bcmrFetch),
+	}
+}
+
+// publicAddrControl refuses connections to addresses which are not routable
+// over the public internet.  It is called by the dialer once the host has been
+// resolved, so it also applies to host names resolving to such addresses and to
+// redirects.
+func publicAddrControl(network, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsMulticast() ||
+		!addrmgr.IsRoutable(&wire.NetAddress{IP: ip}) {
+
+		return fmt.Errorf("refusing to fetch registry from non-public "+
+			"address %s", host)
+	}
+	return nil
+}
+
+// resolve returns the registry document at uri after checking that its
+// sha256 matches hash.  Results, including failures, are cached.
+func (r *BcmrResolver) resolve(ctx context.Context, uri string, hash []byte) (*bcmrRegistry, error) {
+	if len(hash) != sha256.Size {
+		return nil, fmt.Errorf("invalid registry hash length %d", len(hash))
+	}
+	var key [sha256.Size]byte
+	copy(key[:], hash)
+
+	r.mtx.Lock()
+	entry, ok := r.cache[key]
+	if ok && time.Now().Before(entry.expires) {
+		r.mtx.Unlock()
+		return entry.registry, entry.err
+	}
+
+	// Join the fetch of the document in progress, if any.  Fetches are
+	// not bound to the context of the request starting them, so a
+	// cancelled request neither aborts the fetch for the other requests
+	// nor keeps the result from being cached.
+	fetch, ok := r.inflight[key]
+	if !ok {
+		fetch = &bcmrFetch{done: make(chan struct{})}
+		r.inflight[key] = fetch
+		go r.fetchHandler(uri, key, fetch)
+	}
+	r.mtx.Unlock()
+
+	select {
+	case <-fetch.done:
+		return fetch.entry.registry, fetch.entry.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fetchHandler fetches the registry document at uri for the passed in progress
+// fetch and caches the result.  It must be run as a goroutine.
+func (r *BcmrResolver) fetchHandler(uri string, key [sha256.Size]byte, fetch *bcmrFetch) {
+	registry, err := r.fetch(context.Background(), uri, key)
+	now := time.Now()
+	entry := &bcmrCacheEntry{registry: registry, err: err}
+	if err != nil {
+		log.Debugf("Failed to resolve metadata registry %s: %v", uri, err)
+		entry.expires = now.Add(bcmrFailureTTL)
+	} else {
+		entry.expires = now.Add(r.cfg.CacheTTL)
+	}
+
+	r.mtx.Lock()
+	r.addCacheEntry(key, entry, now)
+	delete(r.inflight, key)
+	r.mtx.Unlock()
+
+	fetch.entry = entry
+	close(fetch.done)
+}
+
+// addCacheEntry adds an entry to the cache, making room first by removing
+// expired entries and then, if still full, the entry closest to expiring.
+//
+// This function MUST be called with the resolver lock held.
+func (r *BcmrResolver) addCacheEntry(key [sha256.Size]byte, entry *bcmrCacheEntry, now time.Time) {
+	if _, ok := r.cache[key]; !ok && len(r.cache) >= maxBcmrCacheEntries {
+		var (
+			oldestKey [sha256.Size]byte
+			oldest    *bcmrCacheEntry
+		)
+		for k, e := range r.cache {
+			if now.After(e.expires) {
+				delete(r.cache, k)
+				continue
+			}
+			if oldest == nil || e.expires.Before(oldest.expires) {
+				oldestKey, oldest = k, e
+			}
+		}
+		if len(r.cache) >= maxBcmrCacheEntries && oldest != nil {
+			delete(r.cache, oldestKey)
+		}
+	}
+	r.cache[key] = entry
+}
+
+// fetch downloads, hash-checks and decodes the registry document at uri.
+func (r *BcmrResolver) fetch(ctx context.Context, uri string, hash [sha256.Size]byte) (*bcmrRegistry, error) {
+	url, viaGateway, err := r.fetchURL(uri)
+	if err != nil {
+		return nil, err
+	}
+	client := r.client
+	if viaGateway {
+		client = r.gatewayClient
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	doc, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxBcmrDocumentSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(doc) > maxBcmrDocumentSize {
+		return nil, errors.New("registry document is too large")
+	}
+
+	return decodeBcmrDocument(doc, hash)
+}
+
+// fetchURL returns the URL to fetch for a registry URI and whether it is
+// fetched through the configured IPFS gateway, as ipfs:// URIs are.  URIs
+// without a scheme are fetched over https as required by the BCMR
+// specification.
+func (r *BcmrResolver) fetchURL(uri string) (string, bool, error) {
+	switch {
+	case uri == "":
+		return "", false, errors.New("empty registry uri")
+	case strings.HasPrefix(uri, "ipfs://"):
+		if r.cfg.IPFSGateway == "" {
+			return "", false, errors.New("no ipfs gateway configured")
+		}
+		return strings.TrimSuffix(r.cfg.IPFSGateway, "/") + "/" + strings.TrimPrefix(uri, "ipfs://"), true, nil
+	case strings.HasPrefix(uri, "https://"):
+		return uri, false, nil
+	case strings.Contains(uri, "://"):
+		return "", false, fmt.Errorf("unsupported registry uri %s", uri)
+	default:
+		return "https://" + uri, false, nil
+	}
+}
+
+// decodeBcmrDocument checks the sha256 of doc against hash and decodes it as
+// a registry document.
+func decodeBcmrDocument(doc []byte, hash [sha256.Size]byte) (*bcmrRegistry, error) {
+	if sha256.Sum256(doc) != hash {
+		return nil, errors.New("registry document does not match the committed hash")
+	}
+	var registry bcmrRegistry
+	if err := json.Unmarshal(doc, &registry); err != nil {
+		return nil, fmt.Errorf("invalid registry document: %v", err)
+	}
+	return &registry, nil
+}
+
+// extractBcmrPublication returns the registry hash and URI from the first
+// BCMR publication output of the passed transaction, if any.  A publication
+// is an OP_RETURN output pushing "BCMR", the 32 byte sha256 of the document
+// and optionally one or more URIs of which the first is used.
+func extractBcmrPublication(tx *wire.MsgTx) ([]byte, string, bool) {
+	for _, txOut := range tx.TxOut {
+		if len(txOut.PkScript) == 0 || txOut.PkScript[0] != txscript.OP_RETURN {
+			continue
+		}
+		pushes, err := txscript.PushedData(txOut.PkScript)
+		if err != nil || len(pushes) < 2 || !bytes.Equal(pushes[0], bcmrTag) ||
+			len(pushes[1]) != sha256.Size {
+
+			continue
+		}
+		var uri string
+		if len(pushes) > 2 {
+			uri = string(pushes[2])
+		}
+		return pushes[1], uri, true
+	}
+	return nil, "", false
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bchrpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestBcmrResolver ensures concurrent requests for a registry document share a
+// single fetch through the gateway and documents are not fetched from
+// addresses which are not publicly routable.
+func TestBcmrResolver(t *testing.T) {
+	doc := []byte(`{"identities":{}}`)
+	hash := sha256.Sum256(doc)
+
+	var fetches int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		<-release
+		w.Write(doc)
+	}))
+	defer server.Close()
+
+	r := NewBcmrResolver(&BcmrConfig{
+		Timeout:     time.Second * 10,
+		CacheTTL:    time.Hour,
+		IPFSGateway: server.URL,
+	})
+
+	const numRequests = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, numRequests)
+	for i := 0; i < numRequests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := r.resolve(context.Background(), "ipfs://doc", hash[:])
+			errs <- err
+		}()
+	}
+
+	// Wait for the fetch to start.  Requests arriving later either join
+	// the fetch in progress or find its result in the cache.
+	for {
+		r.mtx.Lock()
+		fetch := r.inflight[hash]
+		r.mtx.Unlock()
+		if fetch != nil && atomic.LoadInt32(&fetches) == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("resolve: %v", err)
+		}
+	}
+	if n := atomic.LoadInt32(&fetches); n != 1 {
+		t.Fatalf("got %d fetches, want 1", n)
+	}
+
+	// Registries published with URIs of local addresses are not fetched.
+	local := sha256.Sum256([]byte("local"))
+	_, err := r.resolve(context.Background(), server.URL+"/doc", local[:])
+	if err == nil || !strings.Contains(err.Error(), "unsupported") {
+		t.Fatalf("resolve: fetched a http registry uri: %v", err)
+	}
+	uri := strings.Replace(server.URL, "http://", "https://", 1) + "/doc"
+	local = sha256.Sum256([]byte("local https"))
+	_, err = r.resolve(context.Background(), uri, local[:])
+	if err == nil || !strings.Contains(err.Error(), "non-public") {
+		t.Fatalf("resolve: fetched a registry from a local address: %v",
+			err)
+	}
+}
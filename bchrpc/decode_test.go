@@ -0,0 +1,191 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bchrpc
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/bchrpc/pb"
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/database"
+	_ "github.com/gcash/bchd/database/ffldb"
+	"github.com/gcash/bchd/integration/rpctest"
+	"github.com/gcash/bchd/mempool"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// TestDecodeTransaction ensures the inputs of decoded transactions are resolved
+// from the mempool and the utxo set, including their token data, and the fee is
+// only reported when all of the inputs are resolved.
+func TestDecodeTransaction(t *testing.T) {
+	params := chaincfg.RegressionNetParams
+	params.Upgrade9ForkHeight = 0
+
+	db, err := database.Create("ffldb", t.TempDir(), params.Net)
+	if err != nil {
+		t.Fatalf("failed to create db: %v", err)
+	}
+	defer db.Close()
+	chain, err := blockchain.New(&blockchain.Config{
+		DB:                 db,
+		ChainParams:        &params,
+		TimeSource:         blockchain.NewMedianTime(),
+		ExcessiveBlockSize: 32000000,
+	})
+	if err != nil {
+		t.Fatalf("failed to create blockchain: %v", err)
+	}
+
+	addr, err := bchutil.NewAddressPubKeyHash(make([]byte, 20), &params)
+	if err != nil {
+		t.Fatalf("NewAddressPubKeyHash: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("PayToAddrScript: %v", err)
+	}
+
+	// The coinbase of the first block is in the utxo set.
+	block, err := rpctest.CreateBlock(nil, nil, 1, time.Time{}, addr, nil,
+		&params)
+	if err != nil {
+		t.Fatalf("CreateBlock: %v", err)
+	}
+	if _, _, err := chain.ProcessBlock(block, blockchain.BFNone); err != nil {
+		t.Fatalf("ProcessBlock: %v", err)
+	}
+	coinbase := block.Transactions()[0]
+	coinbaseValue := coinbase.MsgTx().TxOut[0].Value
+
+	// The mempool transaction transfers tokens from an output only known
+	// to the utxo view of the mempool.
+	var category chainhash.Hash
+	category[0] = 0x01
+	tokenData := wire.TokenData{
+		CategoryID: category,
+		Amount:     1000,
+		BitField:   0x10,
+	}
+	fundingTx := wire.NewMsgTx(2)
+	fundingTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{
+		Hash: chainhash.HashH([]byte("funding")),
+	}, nil))
+	fundingTx.AddTxOut(wire.NewTxOut(10000, []byte{txscript.OP_TRUE},
+		tokenData))
+	fundingView := blockchain.NewUtxoViewpoint()
+	fundingView.AddTxOuts(bchutil.NewTx(fundingTx), 1)
+
+	txMemPool := mempool.New(&mempool.Config{
+		Policy: mempool.Policy{
+			MaxTxVersion:         2,
+			AcceptNonStd:         true,
+			DisableRelayPriority: true,
+		},
+		ChainParams: &params,
+		FetchUtxoView: func(tx *bchutil.Tx) (*blockchain.UtxoViewpoint, error) {
+			view := blockchain.NewUtxoViewpoint()
+			for _, txIn := range tx.MsgTx().TxIn {
+				entry := fundingView.LookupEntry(txIn.PreviousOutPoint)
+				view.Entries()[txIn.PreviousOutPoint] = entry.Clone()
+			}
+			return view, nil
+		},
+		BestHeight:     func() int32 { return chain.BestSnapshot().Height },
+		BestHash:       func() *chainhash.Hash { return &chain.BestSnapshot().Hash },
+		MedianTimePast: func() time.Time { return chain.BestSnapshot().MedianTime },
+		CalcSequenceLock: func(tx *bchutil.Tx, view *blockchain.UtxoViewpoint) (*blockchain.SequenceLock, error) {
+			return &blockchain.SequenceLock{Seconds: -1, BlockHeight: -1}, nil
+		},
+		IsDeploymentActive: chain.IsDeploymentActive,
+	})
+	poolTx := wire.NewMsgTx(2)
+	poolTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: fundingTx.TxHash()},
+		nil))
+	poolTx.AddTxOut(wire.NewTxOut(9000, pkScript, tokenData))
+	_, err = txMemPool.ProcessTransaction(bchutil.NewTx(poolTx), false,
+		false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: %v", err)
+	}
+
+	s := &GrpcServer{
+		chain:       chain,
+		chainParams: &params,
+		txMemPool:   txMemPool,
+	}
+	decode := func(msgTx *wire.MsgTx) *pb.DecodeTransactionResponse {
+		t.Helper()
+		var buf bytes.Buffer
+		if err := msgTx.Serialize(&buf); err != nil {
+			t.Fatalf("Serialize: %v", err)
+		}
+		resp, err := s.DecodeTransaction(context.Background(),
+			&pb.DecodeTransactionRequest{Transaction: buf.Bytes()})
+		if err != nil {
+			t.Fatalf("DecodeTransaction: %v", err)
+		}
+		return resp
+	}
+
+	msgTx := wire.NewMsgTx(2)
+	msgTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: poolTx.TxHash()}, nil))
+	msgTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: *coinbase.Hash()}, nil))
+	msgTx.AddTxOut(wire.NewTxOut(5000, pkScript, tokenData))
+	resp := decode(msgTx)
+	if len(resp.UnresolvedInputs) != 0 {
+		t.Fatalf("unexpected unresolved inputs %v", resp.UnresolvedInputs)
+	}
+	if want := 9000 + coinbaseValue - 5000; resp.Fee != want {
+		t.Errorf("got fee %d, want %d", resp.Fee, want)
+	}
+
+	// The input spending the mempool transaction carries its tokens.
+	poolInput := resp.Transaction.Inputs[0]
+	if poolInput.Value != 9000 || poolInput.Address != addr.String() ||
+		!bytes.Equal(poolInput.PreviousScript, pkScript) {
+
+		t.Errorf("unexpected mempool input %v", poolInput)
+	}
+	wantToken := getPbCashTokenDataFromTokenData(tokenData)
+	if !reflect.DeepEqual(poolInput.CashToken, wantToken) {
+		t.Errorf("got token data %v, want %v", poolInput.CashToken,
+			wantToken)
+	}
+	utxoInput := resp.Transaction.Inputs[1]
+	if utxoInput.Value != coinbaseValue || utxoInput.Address != addr.String() ||
+		utxoInput.CashToken != nil {
+
+		t.Errorf("unexpected utxo input %v", utxoInput)
+	}
+
+	// Inputs which can't be found are reported and leave the fee unknown,
+	// as do outputs beyond the ones of the spent transaction.
+	msgTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{
+		Hash: chainhash.HashH([]byte("unknown")),
+	}, nil))
+	msgTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{
+		Hash:  poolTx.TxHash(),
+		Index: 1,
+	}, nil))
+	resp = decode(msgTx)
+	if want := []uint32{2, 3}; !reflect.DeepEqual(resp.UnresolvedInputs, want) {
+		t.Errorf("got unresolved inputs %v, want %v",
+			resp.UnresolvedInputs, want)
+	}
+	if resp.Fee != 0 {
+		t.Errorf("got fee %d with unresolved inputs", resp.Fee)
+	}
+	if resp.Transaction.Inputs[0].Value != 9000 {
+		t.Errorf("resolved input not decoded along with unresolved ones")
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bchrpc
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gcash/bchd/bchrpc/pb"
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// maxDepositConfirmations is the highest confirmation count a deposit
+// subscription can request to be notified of.
+const maxDepositConfirmations = 1000
+
+// deposit is a payment to the addresses watched by a deposit subscription.
+type deposit struct {
+	hash    chainhash.Hash
+	outputs []*pb.Transaction_Output
+
+	// inputs are the outpoints spent by the payment, which are used to
+	// tell when a block double spends it while it is unconfirmed.
+	inputs []wire.OutPoint
+
+	// blockHash and height identify the block confirming the payment.
+	// blockHash is nil while the payment is unconfirmed.
+	blockHash *chainhash.Hash
+	height    int32
+
+	// notified is the highest confirmation count notified since the
+	// payment was confirmed.
+	notified int32
+}
+
+// depositWatch tracks the confirmations of the payments to the addresses of a
+// deposit subscription.
+type depositWatch struct {
+	// scripts maps the public key scripts paying to the watched addresses
+	// to the addresses.
+	scripts map[string]string
+
+	// milestones holds the confirmation counts to notify in ascending
+	// order, starting with the first confirmation.
+	milestones []int32
+
+	deposits map[chainhash.Hash]*deposit
+}
+
+// newDepositWatch returns a watch of the passed addresses notifying the
+// passed confirmation counts along with the first confirmation.
+func newDepositWatch(addresses []string, confirmations []uint32, params *chaincfg.Params) (*depositWatch, error) {
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("no addresses to watch")
+	}
+	w := &depositWatch{
+		scripts:  make(map[string]string, len(addresses)),
+		deposits: make(map[chainhash.Hash]*deposit),
+	}
+	for _, addrStr := range addresses {
+		addr, err := bchutil.DecodeAddress(addrStr, params)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address: %s", addrStr)
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported address: %s", addrStr)
+		}
+		w.scripts[string(pkScript)] = addrStr
+	}
+
+	seen := map[int32]struct{}{1: {}}
+	w.milestones = []int32{1}
+	for _, confs := range confirmations {
+		if confs == 0 || confs > maxDepositConfirmations {
+			return nil, fmt.Errorf("confirmation count %d is not "+
+				"between 1 and %d", confs, maxDepositConfirmations)
+		}
+		if _, ok := seen[int32(confs)]; ok {
+			continue
+		}
+		seen[int32(confs)] = struct{}{}
+		w.milestones = append(w.milestones, int32(confs))
+	}
+	sort.Slice(w.milestones, func(i, j int) bool {
+		return w.milestones[i] < w.milestones[j]
+	})
+	return w, nil
+}
+
+// match returns the outputs of the passed transaction paying to the watched
+// addresses.
+func (w *depositWatch) match(tx *bchutil.Tx) []*pb.Transaction_Output {
+	var outputs []*pb.Transaction_Output
+	for i, txOut := range tx.MsgTx().TxOut {
+		addr, ok := w.scripts[string(txOut.PkScript)]
+		if !ok {
+			continue
+		}
+		output := &pb.Transaction_Output{
+			Index:        uint32(i),
+			Value:        txOut.Value,
+			PubkeyScript: txOut.PkScript,
+			Address:      addr,
+		}
+		if !txOut.TokenData.IsEmpty() {
+			output.CashToken = getPbCashTokenDataFromTokenData(txOut.TokenData)
+		}
+		outputs = append(outputs, output)
+	}
+	return outputs
+}
+
+// notification returns a notification of the passed type for the passed
+// deposit.
+func (d *deposit) notification(typ pb.DepositNotification_Type, confirmations int32) *pb.DepositNotification {
+	ntfn := &pb.DepositNotification{
+		Type:            typ,
+		TransactionHash: d.hash.CloneBytes(),
+		Outputs:         d.outputs,
+		Confirmations:   confirmations,
+	}
+	if d.blockHash != nil {
+		ntfn.BlockHash = d.blockHash.CloneBytes()
+		ntfn.BlockHeight = d.height
+	}
+	return ntfn
+}
+
+// txAccepted returns the notification of the payment made by the passed
+// transaction accepted to the mempool, or nil if it doesn't pay to the watched
+// addresses or was already seen.
+func (w *depositWatch) txAccepted(tx *bchutil.Tx) *pb.DepositNotification {
+	if _, ok := w.deposits[*tx.Hash()]; ok {
+		return nil
+	}
+	outputs := w.match(tx)
+	if len(outputs) == 0 {
+		return nil
+	}
+	d := &deposit{hash: *tx.Hash(), outputs: outputs}
+	for _, txIn := range tx.MsgTx().TxIn {
+		d.inputs = append(d.inputs, txIn.PreviousOutPoint)
+	}
+	w.deposits[d.hash] = d
+	return d.notification(pb.DepositNotification_UNCONFIRMED, 0)
+}
+
+// blockConnected returns the notifications of the confirmation counts reached
+// by the payments once the passed block is connected to the main chain.  The
+// payments which reached the highest requested count are no longer tracked.
+// The unconfirmed payments which the block double spends, or which are no
+// longer in the mempool according to the passed function, are removed.
+func (w *depositWatch) blockConnected(block *bchutil.Block, inMempool func(*chainhash.Hash) bool) []*pb.DepositNotification {
+	spent := make(map[wire.OutPoint]struct{})
+	for _, tx := range block.Transactions()[1:] {
+		for _, txIn := range tx.MsgTx().TxIn {
+			spent[txIn.PreviousOutPoint] = struct{}{}
+		}
+	}
+
+	var ntfns []*pb.DepositNotification
+	for _, tx := range block.Transactions() {
+		d, ok := w.deposits[*tx.Hash()]
+		if !ok {
+			outputs := w.match(tx)
+			if len(outputs) == 0 {
+				continue
+			}
+			d = &deposit{hash: *tx.Hash(), outputs: outputs}
+			w.deposits[d.hash] = d
+		}
+		d.blockHash = block.Hash()
+		d.height = block.Height()
+		d.notified = 0
+	}
+	for hash, d := range w.deposits {
+		if d.blockHash != nil {
+			continue
+		}
+		removed := !inMempool(&hash)
+		for _, prevOut := range d.inputs {
+			if _, ok := spent[prevOut]; ok {
+				removed = true
+				break
+			}
+		}
+		if removed {
+			ntfns = append(ntfns, d.notification(
+				pb.DepositNotification_REMOVED, 0))
+			delete(w.deposits, hash)
+		}
+	}
+
+	lastMilestone := w.milestones[len(w.milestones)-1]
+	for hash, d := range w.deposits {
+		if d.blockHash == nil {
+			continue
+		}
+		confs := block.Height() - d.height + 1
+		for _, milestone := range w.milestones {
+			if milestone > d.notified && milestone <= confs {
+				ntfns = append(ntfns, d.notification(
+					pb.DepositNotification_CONFIRMED, milestone))
+				d.notified = milestone
+			}
+		}
+		if d.notified >= lastMilestone {
+			delete(w.deposits, hash)
+		}
+	}
+
+	// Notify the removed payments first, followed by the confirmed ones in
+	// the order they were confirmed and then by confirmation count.
+	sort.SliceStable(ntfns, func(i, j int) bool {
+		if ntfns[i].BlockHeight != ntfns[j].BlockHeight {
+			return ntfns[i].BlockHeight < ntfns[j].BlockHeight
+		}
+		return ntfns[i].Confirmations < ntfns[j].Confirmations
+	})
+	return ntfns
+}
+
+// blockDisconnected returns the notifications of the payments confirmed by the
+// passed block once it is disconnected from the main chain.  The payments
+// returned to the mempool are unconfirmed again while the other ones are no
+// longer tracked.
+func (w *depositWatch) blockDisconnected(block *bchutil.Block, returned func(*chainhash.Hash) bool) []*pb.DepositNotification {
+	var ntfns []*pb.DepositNotification
+	for _, tx := range block.Transactions() {
+		d, ok := w.deposits[*tx.Hash()]
+		if !ok || d.blockHash == nil || *d.blockHash != *block.Hash() {
+			continue
+		}
+		if !returned(tx.Hash()) {
+			ntfns = append(ntfns, d.notification(
+				pb.DepositNotification_REMOVED, 0))
+			delete(w.deposits, d.hash)
+			continue
+		}
+		d.blockHash = nil
+		d.height = 0
+		d.notified = 0
+		ntfns = append(ntfns, d.notification(
+			pb.DepositNotification_UNCONFIRMED, 0))
+	}
+	return ntfns
+}
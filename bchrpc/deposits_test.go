@@ -0,0 +1,101 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bchrpc
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/bchrpc/pb"
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// TestDepositWatchRemoved ensures unconfirmed deposits which a connected block
+// double spends or which left the mempool are notified as removed.
+func TestDepositWatchRemoved(t *testing.T) {
+	params := &chaincfg.RegressionNetParams
+	addr, err := bchutil.NewAddressPubKeyHash(make([]byte, 20), params)
+	if err != nil {
+		t.Fatalf("NewAddressPubKeyHash: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("PayToAddrScript: %v", err)
+	}
+	watch, err := newDepositWatch([]string{addr.String()}, nil, params)
+	if err != nil {
+		t.Fatalf("newDepositWatch: %v", err)
+	}
+
+	newTx := func(seed string, pkScript []byte) *bchutil.Tx {
+		msgTx := wire.NewMsgTx(1)
+		msgTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{
+			Hash: chainhash.HashH([]byte(seed)),
+		}, nil))
+		msgTx.AddTxOut(wire.NewTxOut(1000, pkScript, wire.TokenData{}))
+		return bchutil.NewTx(msgTx)
+	}
+	doubleSpent := newTx("a", pkScript)
+	dropped := newTx("b", pkScript)
+	pending := newTx("c", pkScript)
+	for _, tx := range []*bchutil.Tx{doubleSpent, dropped, pending} {
+		if ntfn := watch.txAccepted(tx); ntfn == nil ||
+			ntfn.Type != pb.DepositNotification_UNCONFIRMED {
+
+			t.Fatalf("unexpected notification %v", ntfn)
+		}
+	}
+
+	// The block spends the input of the first deposit with another
+	// transaction, and the second deposit left the mempool.
+	msgBlock := wire.NewMsgBlock(&wire.BlockHeader{})
+	msgBlock.AddTransaction(wire.NewMsgTx(1))
+	msgBlock.AddTransaction(newTx("a", []byte{0x51}).MsgTx())
+	block := bchutil.NewBlock(msgBlock)
+	block.SetHeight(1)
+	inMempool := func(hash *chainhash.Hash) bool {
+		return *hash != *dropped.Hash()
+	}
+	ntfns := watch.blockConnected(block, inMempool)
+	removed := make(map[chainhash.Hash]struct{})
+	for _, ntfn := range ntfns {
+		if ntfn.Type != pb.DepositNotification_REMOVED {
+			t.Fatalf("unexpected notification %v", ntfn)
+		}
+		hash, _ := chainhash.NewHash(ntfn.TransactionHash)
+		removed[*hash] = struct{}{}
+	}
+	if len(removed) != 2 {
+		t.Fatalf("unexpected number of removed deposits %d", len(removed))
+	}
+	for _, tx := range []*bchutil.Tx{doubleSpent, dropped} {
+		if _, ok := removed[*tx.Hash()]; !ok {
+			t.Fatalf("deposit %v not removed", tx.Hash())
+		}
+		if _, ok := watch.deposits[*tx.Hash()]; ok {
+			t.Fatalf("deposit %v still tracked", tx.Hash())
+		}
+	}
+	if _, ok := watch.deposits[*pending.Hash()]; !ok {
+		t.Fatal("pending deposit no longer tracked")
+	}
+
+	// A deposit confirmed by a block is notified as confirmed even though
+	// it left the mempool.
+	msgBlock = wire.NewMsgBlock(&wire.BlockHeader{})
+	msgBlock.AddTransaction(wire.NewMsgTx(1))
+	msgBlock.AddTransaction(pending.MsgTx())
+	block = bchutil.NewBlock(msgBlock)
+	block.SetHeight(2)
+	ntfns = watch.blockConnected(block, func(*chainhash.Hash) bool {
+		return false
+	})
+	if len(ntfns) != 1 || ntfns[0].Type != pb.DepositNotification_CONFIRMED {
+		t.Fatalf("unexpected notifications %v", ntfns)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bchrpc
+
+import (
+	"sync"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// disconnectedBlock holds the details of a block disconnected from the main
+// chain which can only be gathered while the chain waits for the block to be
+// handled, before the blocks of a reorganization are connected.
+type disconnectedBlock struct {
+	hash chainhash.Hash
+
+	// spentTxos holds the outputs spent by the transactions of the block in
+	// the order of the spend journal, which was removed along with the
+	// block.  It is nil when they could not be gathered.
+	spentTxos []blockchain.SpentTxOut
+
+	// returnedTxs holds the hashes of the transactions of the block which
+	// were returned to the mempool.
+	returnedTxs []chainhash.Hash
+}
+
+// disconnectedBlocks is a queue of the details of the blocks disconnected from
+// the main chain which were recorded but not dispatched to the clients yet.
+type disconnectedBlocks struct {
+	mtx    sync.Mutex
+	blocks []*disconnectedBlock
+}
+
+// push adds the passed details of a disconnected block to the queue.
+func (q *disconnectedBlocks) push(details *disconnectedBlock) {
+	q.mtx.Lock()
+	q.blocks = append(q.blocks, details)
+	q.mtx.Unlock()
+}
+
+// pop removes the details of the passed block from the queue and returns them,
+// or nil if they were not recorded.  The details recorded before them are
+// dropped since the notifications of blocks are handled in order.
+func (q *disconnectedBlocks) pop(hash *chainhash.Hash) *disconnectedBlock {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	for i, details := range q.blocks {
+		if details.hash == *hash {
+			q.blocks = q.blocks[i+1:]
+			return details
+		}
+	}
+	return nil
+}
+
+// recordDisconnectedBlock records the outputs spent by the passed block, which
+// were just restored to the utxo set, and the transactions of the block which
+// were returned to the mempool.  It must be invoked synchronously by the chain
+// after the mempool handled the disconnected block.
+func (s *GrpcServer) recordDisconnectedBlock(block *bchutil.Block) {
+	details := &disconnectedBlock{hash: *block.Hash()}
+
+	txns := block.Transactions()[1:]
+	blockTxns := make(map[chainhash.Hash]*bchutil.Tx, len(txns))
+	for _, tx := range txns {
+		blockTxns[*tx.Hash()] = tx
+	}
+
+	spentTxos := make([]blockchain.SpentTxOut, 0, len(txns))
+	for _, tx := range txns {
+		for _, txIn := range tx.MsgTx().TxIn {
+			prevOut := txIn.PreviousOutPoint
+
+			// Outputs created by an earlier transaction of the block
+			// are gone along with it.
+			if prevTx, ok := blockTxns[prevOut.Hash]; ok {
+				txOut := prevTx.MsgTx().TxOut[prevOut.Index]
+				spentTxos = append(spentTxos, blockchain.SpentTxOut{
+					Amount:   txOut.Value,
+					PkScript: tokenPrefixedScript(txOut.PkScript, &txOut.TokenData),
+					Height:   block.Height(),
+				})
+				continue
+			}
+
+			entry, err := s.chain.FetchUtxoEntry(prevOut)
+			if err != nil || entry == nil || entry.IsSpent() {
+				log.Debugf("Unable to fetch output %v spent by "+
+					"disconnected block %v", prevOut, block.Hash())
+				spentTxos = nil
+				break
+			}
+			tokenData := entry.TokenData()
+			spentTxos = append(spentTxos, blockchain.SpentTxOut{
+				Amount:     entry.Amount(),
+				PkScript:   tokenPrefixedScript(entry.PkScript(), &tokenData),
+				Height:     entry.BlockHeight(),
+				IsCoinBase: entry.IsCoinBase(),
+			})
+		}
+		if spentTxos == nil {
+			break
+		}
+	}
+	details.spentTxos = spentTxos
+
+	for _, tx := range txns {
+		if s.txMemPool.HaveTransaction(tx.Hash()) {
+			details.returnedTxs = append(details.returnedTxs, *tx.Hash())
+		}
+	}
+
+	s.disconnectedBlocks.push(details)
+}
+
+// tokenPrefixedScript returns the passed public key script prefixed with the
+// passed token data like in the spend journal.
+func tokenPrefixedScript(pkScript []byte, tokenData *wire.TokenData) []byte {
+	if tokenData.IsEmpty() {
+		return pkScript
+	}
+	buf := tokenData.TokenDataBuffer()
+	buf.Write(pkScript)
+	return buf.Bytes()
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bchrpc
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gcash/bchd/bchrpc/pb"
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// rpcEventDoubleSpendProof indicates a new double-spend proof was stored by the
+// mempool.  It holds the mempool transaction spending the output of the proof
+// and the mempool transactions depending on it.
+type rpcEventDoubleSpendProof struct {
+	proof       *wire.MsgDSProofBeta
+	tx          *bchutil.Tx
+	descendants []*bchutil.Tx
+}
+
+// notification returns the notification of the double-spend proof sent by the
+// SubscribeDoubleSpendProofs stream.
+func (e *rpcEventDoubleSpendProof) notification() (*pb.DoubleSpendProofNotification, error) {
+	var buf bytes.Buffer
+	if err := e.proof.BchEncode(&buf, 0, wire.BaseEncoding); err != nil {
+		return nil, err
+	}
+	proofHash := e.proof.Hash()
+	ntfn := &pb.DoubleSpendProofNotification{
+		ProofHash: proofHash[:],
+		Outpoint: &pb.Transaction_Input_Outpoint{
+			Hash:  e.proof.PrevOut.Hash.CloneBytes(),
+			Index: e.proof.PrevOut.Index,
+		},
+		TransactionHash: e.tx.Hash().CloneBytes(),
+		SerializedProof: buf.Bytes(),
+	}
+	for _, tx := range e.descendants {
+		ntfn.DescendantHashes = append(ntfn.DescendantHashes,
+			tx.Hash().CloneBytes())
+	}
+	return ntfn, nil
+}
+
+// dsProofFilter selects the double-spend proofs sent by a
+// SubscribeDoubleSpendProofs stream by the transactions they put at risk.
+type dsProofFilter struct {
+	hashes  map[chainhash.Hash]struct{}
+	scripts map[string]struct{}
+}
+
+// newDSProofFilter returns a filter matching the double-spend proofs putting
+// the transactions with the passed hashes or paying to the passed addresses at
+// risk.  It matches all proofs when both are empty.
+func newDSProofFilter(hashes [][]byte, addresses []string, params *chaincfg.Params) (*dsProofFilter, error) {
+	f := &dsProofFilter{
+		hashes:  make(map[chainhash.Hash]struct{}, len(hashes)),
+		scripts: make(map[string]struct{}, len(addresses)),
+	}
+	for _, b := range hashes {
+		hash, err := chainhash.NewHash(b)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction hash: %v", err)
+		}
+		f.hashes[*hash] = struct{}{}
+	}
+	for _, addrStr := range addresses {
+		addr, err := bchutil.DecodeAddress(addrStr, params)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address: %s", addrStr)
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported address: %s", addrStr)
+		}
+		f.scripts[string(pkScript)] = struct{}{}
+	}
+	return f, nil
+}
+
+// matches returns whether the double-spend proof of the passed event puts a
+// transaction selected by the filter at risk.
+func (f *dsProofFilter) matches(e *rpcEventDoubleSpendProof) bool {
+	if len(f.hashes) == 0 && len(f.scripts) == 0 {
+		return true
+	}
+	txns := append([]*bchutil.Tx{e.tx}, e.descendants...)
+	for _, tx := range txns {
+		if _, ok := f.hashes[*tx.Hash()]; ok {
+			return true
+		}
+		for _, txOut := range tx.MsgTx().TxOut {
+			if _, ok := f.scripts[string(txOut.PkScript)]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
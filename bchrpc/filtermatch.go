@@ -0,0 +1,343 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bchrpc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/blockchain/indexers"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil/gcs"
+	"github.com/gcash/bchutil/gcs/builder"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// maxFilterWatches is the maximum number of filter watches which can
+	// be registered at once.
+	maxFilterWatches = 1000
+
+	// maxClientFilterWatches is the maximum number of filter watches which
+	// can be registered at once by clients from the same address.
+	maxClientFilterWatches = 10
+
+	// maxFilterWatchScripts is the maximum number of scripts of a single
+	// filter watch.
+	maxFilterWatchScripts = 10000
+
+	// maxFilterMatchBlocks is the maximum number of block filters matched
+	// for a filter watch by a single call.
+	maxFilterMatchBlocks = 2000
+
+	// maxFilterWatchMatches is the maximum number of matches kept for a
+	// filter watch.  The oldest matches are dropped beyond it.
+	maxFilterWatchMatches = 10000
+
+	// filterWatchIdleTimeout is the duration after which a filter watch
+	// which is not queried is removed.
+	filterWatchIdleTimeout = time.Hour
+
+	// filterWatchMaxAge is the duration after which a filter watch is
+	// removed even when it is queried.
+	filterWatchMaxAge = 24 * time.Hour
+
+	// filterWatchExpiryInterval is the interval at which the expired
+	// filter watches are removed in the background.
+	filterWatchExpiryInterval = time.Minute
+)
+
+var (
+	// errTooManyFilterWatches is returned when a filter watch is added
+	// while the maximum number of watches are registered.
+	errTooManyFilterWatches = errors.New("too many filter watches")
+
+	// errTooManyClientFilterWatches is returned when a filter watch is
+	// added while the maximum number of watches are registered by clients
+	// from the same address.
+	errTooManyClientFilterWatches = errors.New("too many filter watches " +
+		"for the client address")
+)
+
+// filterMatch is a block whose filter matched the scripts of a filter watch.
+type filterMatch struct {
+	hash   chainhash.Hash
+	height int32
+}
+
+// filterWatch is a set of scripts along with the blocks of the main chain
+// whose filters matched them.  The filters are matched lazily when the watch
+// is queried, from the last block which was matched up to the tip.
+type filterWatch struct {
+	mtx     sync.Mutex
+	scripts [][]byte
+	matches []filterMatch
+
+	// scannedHeight and scannedHash identify the last block whose filter
+	// was matched.  The height is one below the start height and the hash
+	// is zero when no filter was matched yet from the genesis block.
+	scannedHeight int32
+	scannedHash   chainhash.Hash
+
+	// prunedHeight is the height up to which matches were dropped to keep
+	// at most maxFilterWatchMatches of them, or -1 when none were.
+	prunedHeight int32
+
+	// client, created and lastUsed are protected by the mutex of the
+	// filterMatcher.
+	client   string
+	created  time.Time
+	lastUsed time.Time
+}
+
+// newFilterWatch returns a filter watch for the passed scripts which matches
+// the filters of the blocks from the passed start height of the chain.
+func newFilterWatch(chain *blockchain.BlockChain, scripts [][]byte, startHeight int32) (*filterWatch, error) {
+	w := &filterWatch{
+		scripts:       scripts,
+		scannedHeight: startHeight - 1,
+		prunedHeight:  -1,
+	}
+	if startHeight > 0 {
+		hash, err := chain.BlockHashByHeight(startHeight - 1)
+		if err != nil {
+			return nil, err
+		}
+		w.scannedHash = *hash
+	}
+	return w, nil
+}
+
+// rollback drops the matches of the blocks which were reorganized out of the
+// passed snapshot of the main chain since they were matched, so the filters of
+// the blocks which replaced them are matched again.
+//
+// This function MUST be called with the watch lock held.
+func (w *filterWatch) rollback(chain *blockchain.BlockChain, snapshot *blockchain.ChainSnapshot) error {
+	if w.scannedHeight < 0 {
+		return nil
+	}
+
+	// Walk back from the last matched block until the fork point with the
+	// main chain.
+	hash, height := w.scannedHash, w.scannedHeight
+	for {
+		if _, err := snapshot.BlockHeightByHash(&hash); err == nil {
+			break
+		}
+		header, err := chain.HeaderByHash(&hash)
+		if err != nil {
+			return err
+		}
+		hash, height = header.PrevBlock, height-1
+	}
+	if height == w.scannedHeight {
+		return nil
+	}
+
+	for len(w.matches) > 0 && w.matches[len(w.matches)-1].height > height {
+		w.matches = w.matches[:len(w.matches)-1]
+	}
+	w.scannedHash, w.scannedHeight = hash, height
+	return nil
+}
+
+// scan matches the filters of up to maxFilterMatchBlocks blocks of the passed
+// snapshot of the main chain following the last block which was matched.  It
+// returns whether all blocks up to the tip of the snapshot were matched.
+//
+// This function MUST be called with the watch lock held.
+func (w *filterWatch) scan(cfIndex *indexers.CfIndex, snapshot *blockchain.ChainSnapshot) (bool, error) {
+	for i := 0; i < maxFilterMatchBlocks; i++ {
+		height := w.scannedHeight + 1
+		if height > snapshot.Height() {
+			return true, nil
+		}
+		hash, err := snapshot.BlockHashByHeight(height)
+		if err != nil {
+			return false, err
+		}
+		filterBytes, err := cfIndex.FilterByBlockHash(hash, wire.GCSFilterRegular)
+		if err != nil {
+			return false, err
+		}
+		if len(filterBytes) == 0 {
+			// The index didn't catch up with the block yet.
+			return false, nil
+		}
+		filter, err := gcs.FromNBytes(builder.DefaultP, builder.DefaultM,
+			filterBytes)
+		if err != nil {
+			return false, err
+		}
+		if filter.N() > 0 {
+			matched, err := filter.MatchAny(builder.DeriveKey(hash), w.scripts)
+			if err != nil {
+				return false, err
+			}
+			if matched {
+				w.addMatch(filterMatch{hash: *hash, height: height})
+			}
+		}
+		w.scannedHash, w.scannedHeight = *hash, height
+	}
+	return w.scannedHeight >= snapshot.Height(), nil
+}
+
+// addMatch appends the passed match, dropping the oldest match when the watch
+// already has maxFilterWatchMatches of them.
+//
+// This function MUST be called with the watch lock held.
+func (w *filterWatch) addMatch(match filterMatch) {
+	if len(w.matches) >= maxFilterWatchMatches {
+		w.prunedHeight = w.matches[0].height
+		w.matches = w.matches[1:]
+	}
+	w.matches = append(w.matches, match)
+}
+
+// matchesSince returns the matches of the blocks at or above the passed
+// height.
+//
+// This function MUST be called with the watch lock held.
+func (w *filterWatch) matchesSince(height int32) []filterMatch {
+	i := len(w.matches)
+	for i > 0 && w.matches[i-1].height >= height {
+		i--
+	}
+	return w.matches[i:]
+}
+
+// clientHost returns the host of the address of the client of a request, so
+// the connections of a client are accounted for together.
+func clientHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// filterMatcher holds the filter watches registered by clients by their id.
+type filterMatcher struct {
+	mtx     sync.Mutex
+	watches map[string]*filterWatch
+
+	// clients holds the number of watches registered by clients from
+	// each address.
+	clients map[string]int
+}
+
+// newFilterMatcher returns a new filterMatcher without any watches.
+func newFilterMatcher() *filterMatcher {
+	return &filterMatcher{
+		watches: make(map[string]*filterWatch),
+		clients: make(map[string]int),
+	}
+}
+
+// expire removes the watches which were not used since the idle timeout or
+// which were created more than the maximum age ago.
+//
+// This function MUST be called with the matcher lock held.
+func (m *filterMatcher) expire(now time.Time) {
+	for id, w := range m.watches {
+		if now.Sub(w.lastUsed) <= filterWatchIdleTimeout &&
+			now.Sub(w.created) <= filterWatchMaxAge {
+
+			continue
+		}
+		delete(m.watches, id)
+		if m.clients[w.client]--; m.clients[w.client] == 0 {
+			delete(m.clients, w.client)
+		}
+	}
+}
+
+// expireAll removes the expired watches.
+//
+// This function is safe for concurrent access.
+func (m *filterMatcher) expireAll() {
+	m.mtx.Lock()
+	m.expire(time.Now())
+	m.mtx.Unlock()
+}
+
+// add registers the passed watch for a client with the passed address and
+// returns its id.
+//
+// This function is safe for concurrent access.
+func (m *filterMatcher) add(w *filterWatch, client string) (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	id := hex.EncodeToString(b[:])
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	now := time.Now()
+	m.expire(now)
+	if len(m.watches) >= maxFilterWatches {
+		return "", errTooManyFilterWatches
+	}
+	if m.clients[client] >= maxClientFilterWatches {
+		return "", errTooManyClientFilterWatches
+	}
+	w.client = client
+	w.created = now
+	w.lastUsed = now
+	m.watches[id] = w
+	m.clients[client]++
+	return id, nil
+}
+
+// lookup returns the watch with the passed id, or nil if there is no such
+// watch, and marks it as used.
+//
+// This function is safe for concurrent access.
+func (m *filterMatcher) lookup(id string) *filterWatch {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	now := time.Now()
+	m.expire(now)
+	w := m.watches[id]
+	if w != nil {
+		w.lastUsed = now
+	}
+	return w
+}
+
+// expireFilterWatches removes the expired filter watches at regular intervals,
+// so the scripts and matches of the watches which are no longer queried are
+// released, until the quit channel is closed.
+//
+// It should be run by a supervisor.
+func (s *GrpcServer) expireFilterWatches(quit <-chan struct{}) {
+	ticker := time.NewTicker(filterWatchExpiryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.filterMatcher.expireAll()
+		case <-quit:
+			return
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bchrpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+)
+
+// TestFilterMatcherLimits ensures the number of filter watches of a client
+// address is limited and that watches expire once idle or too old.
+func TestFilterMatcherLimits(t *testing.T) {
+	m := newFilterMatcher()
+	ids := make([]string, 0, maxClientFilterWatches)
+	for i := 0; i < maxClientFilterWatches; i++ {
+		id, err := m.add(&filterWatch{}, "10.0.0.1")
+		if err != nil {
+			t.Fatalf("add %d: unexpected error %v", i, err)
+		}
+		ids = append(ids, id)
+	}
+	if _, err := m.add(&filterWatch{}, "10.0.0.1"); err != errTooManyClientFilterWatches {
+		t.Fatalf("add over the client limit: unexpected error %v", err)
+	}
+	if _, err := m.add(&filterWatch{}, "10.0.0.2"); err != nil {
+		t.Fatalf("add for another client: unexpected error %v", err)
+	}
+
+	// Expiring a watch which is too old, even though it was just used,
+	// frees a slot for its client.
+	m.mtx.Lock()
+	m.watches[ids[0]].created = time.Now().Add(-filterWatchMaxAge - time.Minute)
+	m.watches[ids[1]].lastUsed = time.Now().Add(-filterWatchIdleTimeout - time.Minute)
+	m.mtx.Unlock()
+	if m.lookup(ids[0]) != nil || m.lookup(ids[1]) != nil {
+		t.Fatal("expired watch still registered")
+	}
+	if m.lookup(ids[2]) == nil {
+		t.Fatal("active watch removed")
+	}
+	if m.clients["10.0.0.1"] != maxClientFilterWatches-2 {
+		t.Fatalf("unexpected client watch count %d",
+			m.clients["10.0.0.1"])
+	}
+	if _, err := m.add(&filterWatch{}, "10.0.0.1"); err != nil {
+		t.Fatalf("add after expiry: unexpected error %v", err)
+	}
+}
+
+// TestFilterWatchPruning ensures the oldest matches of a filter watch are
+// dropped beyond the maximum number of matches.
+func TestFilterWatchPruning(t *testing.T) {
+	w := &filterWatch{prunedHeight: -1}
+	for height := int32(0); height < maxFilterWatchMatches+2; height++ {
+		w.addMatch(filterMatch{
+			hash:   chainhash.HashH([]byte{byte(height)}),
+			height: height,
+		})
+	}
+	if len(w.matches) != maxFilterWatchMatches {
+		t.Fatalf("unexpected number of matches %d", len(w.matches))
+	}
+	if w.prunedHeight != 1 || w.matches[0].height != 2 {
+		t.Fatalf("unexpected pruned height %d and first match %d",
+			w.prunedHeight, w.matches[0].height)
+	}
+	if matches := w.matchesSince(0); len(matches) != maxFilterWatchMatches {
+		t.Fatalf("unexpected number of matches since 0: %d", len(matches))
+	}
+}
@@ -161,6 +161,54 @@ func (SlpAction) EnumDescriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{1}
 }
 
+type GetMempoolRequest_Ordering int32
+
+const (
+	// Transactions paying the highest fee per kilobyte come first.
+	GetMempoolRequest_FEE_RATE GetMempoolRequest_Ordering = 0
+	// Transactions added to the mempool first come first.
+	GetMempoolRequest_TIME GetMempoolRequest_Ordering = 1
+)
+
+// Enum value maps for GetMempoolRequest_Ordering.
+var (
+	GetMempoolRequest_Ordering_name = map[int32]string{
+		0: "FEE_RATE",
+		1: "TIME",
+	}
+	GetMempoolRequest_Ordering_value = map[string]int32{
+		"FEE_RATE": 0,
+		"TIME":     1,
+	}
+)
+
+func (x GetMempoolRequest_Ordering) Enum() *GetMempoolRequest_Ordering {
+	p := new(GetMempoolRequest_Ordering)
+	*p = x
+	return p
+}
+
+func (x GetMempoolRequest_Ordering) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetMempoolRequest_Ordering) Descriptor() protoreflect.EnumDescriptor {
+	return file_bchrpc_proto_enumTypes[2].Descriptor()
+}
+
+func (GetMempoolRequest_Ordering) Type() protoreflect.EnumType {
+	return &file_bchrpc_proto_enumTypes[2]
+}
+
+func (x GetMempoolRequest_Ordering) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetMempoolRequest_Ordering.Descriptor instead.
+func (GetMempoolRequest_Ordering) EnumDescriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{2, 0}
+}
+
 // Bitcoin network types
 type GetBlockchainInfoResponse_BitcoinNet int32
 
@@ -208,11 +256,11 @@ func (x GetBlockchainInfoResponse_BitcoinNet) String() string {
 }
 
 func (GetBlockchainInfoResponse_BitcoinNet) Descriptor() protoreflect.EnumDescriptor {
-	return file_bchrpc_proto_enumTypes[2].Descriptor()
+	return file_bchrpc_proto_enumTypes[3].Descriptor()
 }
 
 func (GetBlockchainInfoResponse_BitcoinNet) Type() protoreflect.EnumType {
-	return &file_bchrpc_proto_enumTypes[2]
+	return &file_bchrpc_proto_enumTypes[3]
 }
 
 func (x GetBlockchainInfoResponse_BitcoinNet) Number() protoreflect.EnumNumber {
@@ -221,7 +269,149 @@ func (x GetBlockchainInfoResponse_BitcoinNet) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetBlockchainInfoResponse_BitcoinNet.Descriptor instead.
 func (GetBlockchainInfoResponse_BitcoinNet) EnumDescriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{5, 0}
+	return file_bchrpc_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type GetRawBlocksRequest_Compression int32
+
+const (
+	// Blocks are sent as serialized.
+	GetRawBlocksRequest_NONE GetRawBlocksRequest_Compression = 0
+	// Every block is compressed as an independent zstd frame.
+	GetRawBlocksRequest_ZSTD GetRawBlocksRequest_Compression = 1
+)
+
+// Enum value maps for GetRawBlocksRequest_Compression.
+var (
+	GetRawBlocksRequest_Compression_name = map[int32]string{
+		0: "NONE",
+		1: "ZSTD",
+	}
+	GetRawBlocksRequest_Compression_value = map[string]int32{
+		"NONE": 0,
+		"ZSTD": 1,
+	}
+)
+
+func (x GetRawBlocksRequest_Compression) Enum() *GetRawBlocksRequest_Compression {
+	p := new(GetRawBlocksRequest_Compression)
+	*p = x
+	return p
+}
+
+func (x GetRawBlocksRequest_Compression) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetRawBlocksRequest_Compression) Descriptor() protoreflect.EnumDescriptor {
+	return file_bchrpc_proto_enumTypes[4].Descriptor()
+}
+
+func (GetRawBlocksRequest_Compression) Type() protoreflect.EnumType {
+	return &file_bchrpc_proto_enumTypes[4]
+}
+
+func (x GetRawBlocksRequest_Compression) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetRawBlocksRequest_Compression.Descriptor instead.
+func (GetRawBlocksRequest_Compression) EnumDescriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{16, 0}
+}
+
+type TokenMetadata_Source int32
+
+const (
+	// The metadata was taken from the token's genesis transaction.
+	TokenMetadata_ON_CHAIN TokenMetadata_Source = 0
+	// The metadata was taken from a hash-checked BCMR document.
+	TokenMetadata_REGISTRY TokenMetadata_Source = 1
+)
+
+// Enum value maps for TokenMetadata_Source.
+var (
+	TokenMetadata_Source_name = map[int32]string{
+		0: "ON_CHAIN",
+		1: "REGISTRY",
+	}
+	TokenMetadata_Source_value = map[string]int32{
+		"ON_CHAIN": 0,
+		"REGISTRY": 1,
+	}
+)
+
+func (x TokenMetadata_Source) Enum() *TokenMetadata_Source {
+	p := new(TokenMetadata_Source)
+	*p = x
+	return p
+}
+
+func (x TokenMetadata_Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TokenMetadata_Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_bchrpc_proto_enumTypes[5].Descriptor()
+}
+
+func (TokenMetadata_Source) Type() protoreflect.EnumType {
+	return &file_bchrpc_proto_enumTypes[5]
+}
+
+func (x TokenMetadata_Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TokenMetadata_Source.Descriptor instead.
+func (TokenMetadata_Source) EnumDescriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{63, 0}
+}
+
+type TokenMetadata_TokenKind int32
+
+const (
+	TokenMetadata_SLP        TokenMetadata_TokenKind = 0
+	TokenMetadata_CASH_TOKEN TokenMetadata_TokenKind = 1
+)
+
+// Enum value maps for TokenMetadata_TokenKind.
+var (
+	TokenMetadata_TokenKind_name = map[int32]string{
+		0: "SLP",
+		1: "CASH_TOKEN",
+	}
+	TokenMetadata_TokenKind_value = map[string]int32{
+		"SLP":        0,
+		"CASH_TOKEN": 1,
+	}
+)
+
+func (x TokenMetadata_TokenKind) Enum() *TokenMetadata_TokenKind {
+	p := new(TokenMetadata_TokenKind)
+	*p = x
+	return p
+}
+
+func (x TokenMetadata_TokenKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TokenMetadata_TokenKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_bchrpc_proto_enumTypes[6].Descriptor()
+}
+
+func (TokenMetadata_TokenKind) Type() protoreflect.EnumType {
+	return &file_bchrpc_proto_enumTypes[6]
+}
+
+func (x TokenMetadata_TokenKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TokenMetadata_TokenKind.Descriptor instead.
+func (TokenMetadata_TokenKind) EnumDescriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{63, 1}
 }
 
 // State of the block in relation to the chain.
@@ -255,11 +445,11 @@ func (x BlockNotification_Type) String() string {
 }
 
 func (BlockNotification_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_bchrpc_proto_enumTypes[3].Descriptor()
+	return file_bchrpc_proto_enumTypes[7].Descriptor()
 }
 
 func (BlockNotification_Type) Type() protoreflect.EnumType {
-	return &file_bchrpc_proto_enumTypes[3]
+	return &file_bchrpc_proto_enumTypes[7]
 }
 
 func (x BlockNotification_Type) Number() protoreflect.EnumNumber {
@@ -268,7 +458,63 @@ func (x BlockNotification_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BlockNotification_Type.Descriptor instead.
 func (BlockNotification_Type) EnumDescriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{44, 0}
+	return file_bchrpc_proto_rawDescGZIP(), []int{64, 0}
+}
+
+// State of the payment.
+type DepositNotification_Type int32
+
+const (
+	// The payment was seen in the mempool or returned to it when the block
+	// confirming it was disconnected.
+	DepositNotification_UNCONFIRMED DepositNotification_Type = 0
+	// The payment reached a confirmation count.
+	DepositNotification_CONFIRMED DepositNotification_Type = 1
+	// The block confirming the payment was disconnected and the transaction
+	// was not returned to the mempool, or the unconfirmed payment was
+	// double spent by a block or dropped from the mempool.
+	DepositNotification_REMOVED DepositNotification_Type = 2
+)
+
+// Enum value maps for DepositNotification_Type.
+var (
+	DepositNotification_Type_name = map[int32]string{
+		0: "UNCONFIRMED",
+		1: "CONFIRMED",
+		2: "REMOVED",
+	}
+	DepositNotification_Type_value = map[string]int32{
+		"UNCONFIRMED": 0,
+		"CONFIRMED":   1,
+		"REMOVED":     2,
+	}
+)
+
+func (x DepositNotification_Type) Enum() *DepositNotification_Type {
+	p := new(DepositNotification_Type)
+	*p = x
+	return p
+}
+
+func (x DepositNotification_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DepositNotification_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_bchrpc_proto_enumTypes[8].Descriptor()
+}
+
+func (DepositNotification_Type) Type() protoreflect.EnumType {
+	return &file_bchrpc_proto_enumTypes[8]
+}
+
+func (x DepositNotification_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DepositNotification_Type.Descriptor instead.
+func (DepositNotification_Type) EnumDescriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{66, 0}
 }
 
 // State of the transaction acceptance.
@@ -304,11 +550,11 @@ func (x TransactionNotification_Type) String() string {
 }
 
 func (TransactionNotification_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_bchrpc_proto_enumTypes[4].Descriptor()
+	return file_bchrpc_proto_enumTypes[9].Descriptor()
 }
 
 func (TransactionNotification_Type) Type() protoreflect.EnumType {
-	return &file_bchrpc_proto_enumTypes[4]
+	return &file_bchrpc_proto_enumTypes[9]
 }
 
 func (x TransactionNotification_Type) Number() protoreflect.EnumNumber {
@@ -317,7 +563,7 @@ func (x TransactionNotification_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TransactionNotification_Type.Descriptor instead.
 func (TransactionNotification_Type) EnumDescriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{45, 0}
+	return file_bchrpc_proto_rawDescGZIP(), []int{68, 0}
 }
 
 type SlpTransactionInfo_ValidityJudgement int32
@@ -350,11 +596,11 @@ func (x SlpTransactionInfo_ValidityJudgement) String() string {
 }
 
 func (SlpTransactionInfo_ValidityJudgement) Descriptor() protoreflect.EnumDescriptor {
-	return file_bchrpc_proto_enumTypes[5].Descriptor()
+	return file_bchrpc_proto_enumTypes[10].Descriptor()
 }
 
 func (SlpTransactionInfo_ValidityJudgement) Type() protoreflect.EnumType {
-	return &file_bchrpc_proto_enumTypes[5]
+	return &file_bchrpc_proto_enumTypes[10]
 }
 
 func (x SlpTransactionInfo_ValidityJudgement) Number() protoreflect.EnumNumber {
@@ -363,7 +609,7 @@ func (x SlpTransactionInfo_ValidityJudgement) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SlpTransactionInfo_ValidityJudgement.Descriptor instead.
 func (SlpTransactionInfo_ValidityJudgement) EnumDescriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{54, 0}
+	return file_bchrpc_proto_rawDescGZIP(), []int{78, 0}
 }
 
 type SlpTransactionInfo_BurnFlags int32
@@ -405,11 +651,11 @@ func (x SlpTransactionInfo_BurnFlags) String() string {
 }
 
 func (SlpTransactionInfo_BurnFlags) Descriptor() protoreflect.EnumDescriptor {
-	return file_bchrpc_proto_enumTypes[6].Descriptor()
+	return file_bchrpc_proto_enumTypes[11].Descriptor()
 }
 
 func (SlpTransactionInfo_BurnFlags) Type() protoreflect.EnumType {
-	return &file_bchrpc_proto_enumTypes[6]
+	return &file_bchrpc_proto_enumTypes[11]
 }
 
 func (x SlpTransactionInfo_BurnFlags) Number() protoreflect.EnumNumber {
@@ -418,7 +664,7 @@ func (x SlpTransactionInfo_BurnFlags) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SlpTransactionInfo_BurnFlags.Descriptor instead.
 func (SlpTransactionInfo_BurnFlags) EnumDescriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{54, 1}
+	return file_bchrpc_proto_rawDescGZIP(), []int{78, 1}
 }
 
 type GetMempoolInfoRequest struct {
@@ -524,6 +770,18 @@ type GetMempoolRequest struct {
 	// When `full_transactions` is true, full transaction data is provided
 	// instead of just transaction hashes. Default is false.
 	FullTransactions bool `protobuf:"varint,1,opt,name=full_transactions,json=fullTransactions,proto3" json:"full_transactions,omitempty"`
+	// The maximum number of transactions to return. When zero and no
+	// continuation token is provided, all transactions are returned in no
+	// particular order. Otherwise up to 10000 transactions are returned in
+	// the requested order.
+	PageSize uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The order of the transactions of paginated responses.
+	OrderBy GetMempoolRequest_Ordering `protobuf:"varint,3,opt,name=order_by,json=orderBy,proto3,enum=pb.GetMempoolRequest_Ordering" json:"order_by,omitempty"`
+	// The continuation token of the previous page. The page starts after the
+	// last transaction of the previous page, so transactions removed from or
+	// added to the mempool in the meantime don't shift the pages. It must be
+	// used with the ordering of the previous page.
+	ContinuationToken []byte `protobuf:"bytes,4,opt,name=continuation_token,json=continuationToken,proto3" json:"continuation_token,omitempty"`
 }
 
 func (x *GetMempoolRequest) Reset() {
@@ -565,6 +823,27 @@ func (x *GetMempoolRequest) GetFullTransactions() bool {
 	return false
 }
 
+func (x *GetMempoolRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetMempoolRequest) GetOrderBy() GetMempoolRequest_Ordering {
+	if x != nil {
+		return x.OrderBy
+	}
+	return GetMempoolRequest_FEE_RATE
+}
+
+func (x *GetMempoolRequest) GetContinuationToken() []byte {
+	if x != nil {
+		return x.ContinuationToken
+	}
+	return nil
+}
+
 type GetMempoolResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -572,6 +851,9 @@ type GetMempoolResponse struct {
 
 	// List of unconfirmed transactions.
 	TransactionData []*GetMempoolResponse_TransactionData `protobuf:"bytes,1,rep,name=transaction_data,json=transactionData,proto3" json:"transaction_data,omitempty"`
+	// The token to pass to fetch the next page of a paginated response. It is
+	// empty on the last page.
+	NextContinuationToken []byte `protobuf:"bytes,2,opt,name=next_continuation_token,json=nextContinuationToken,proto3" json:"next_continuation_token,omitempty"`
 }
 
 func (x *GetMempoolResponse) Reset() {
@@ -613,14 +895,24 @@ func (x *GetMempoolResponse) GetTransactionData() []*GetMempoolResponse_Transact
 	return nil
 }
 
-type GetBlockchainInfoRequest struct {
+func (x *GetMempoolResponse) GetNextContinuationToken() []byte {
+	if x != nil {
+		return x.NextContinuationToken
+	}
+	return nil
+}
+
+type GetMempoolTransactionGraphRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The transaction hash, little-endian.
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (x *GetBlockchainInfoRequest) Reset() {
-	*x = GetBlockchainInfoRequest{}
+func (x *GetMempoolTransactionGraphRequest) Reset() {
+	*x = GetMempoolTransactionGraphRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -628,13 +920,13 @@ func (x *GetBlockchainInfoRequest) Reset() {
 	}
 }
 
-func (x *GetBlockchainInfoRequest) String() string {
+func (x *GetMempoolTransactionGraphRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockchainInfoRequest) ProtoMessage() {}
+func (*GetMempoolTransactionGraphRequest) ProtoMessage() {}
 
-func (x *GetBlockchainInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMempoolTransactionGraphRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -646,41 +938,46 @@ func (x *GetBlockchainInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockchainInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetBlockchainInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMempoolTransactionGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetMempoolTransactionGraphRequest) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{4}
 }
 
-type GetBlockchainInfoResponse struct {
+func (x *GetMempoolTransactionGraphRequest) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+type GetMempoolTransactionGraphResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Which network the node is operating on.
-	BitcoinNet GetBlockchainInfoResponse_BitcoinNet `protobuf:"varint,1,opt,name=bitcoin_net,json=bitcoinNet,proto3,enum=pb.GetBlockchainInfoResponse_BitcoinNet" json:"bitcoin_net,omitempty"`
-	// The current number of blocks on the longest chain.
-	BestHeight int32 `protobuf:"varint,2,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
-	// The hash of the best (tip) block in the most-work fully-validated chain, little-endian.
-	BestBlockHash []byte `protobuf:"bytes,3,opt,name=best_block_hash,json=bestBlockHash,proto3" json:"best_block_hash,omitempty"`
-	// Threshold for adding new blocks.
-	Difficulty float64 `protobuf:"fixed64,4,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	// Median time of the last 11 blocks.
-	MedianTime int64 `protobuf:"varint,5,opt,name=median_time,json=medianTime,proto3" json:"median_time,omitempty"`
-	// When `tx_index` is true, the node has full transaction index enabled.
-	TxIndex bool `protobuf:"varint,6,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
-	// When `addr_index` is true, the node has address index enabled and may
-	// be used with call related by address.
-	AddrIndex bool `protobuf:"varint,7,opt,name=addr_index,json=addrIndex,proto3" json:"addr_index,omitempty"`
-	// When `slp_index` is true, the node has the slp index enabled and may
-	// be used with slp related rpc methods and also causes slp metadata to be added
-	// in some of the existing rpc methods.
-	SlpIndex bool `protobuf:"varint,8,opt,name=slp_index,json=slpIndex,proto3" json:"slp_index,omitempty"`
-	// When `slp_graphsearch` is true, the node is able to handle calls to slp graph search
-	SlpGraphsearch bool `protobuf:"varint,9,opt,name=slp_graphsearch,json=slpGraphsearch,proto3" json:"slp_graphsearch,omitempty"`
-}
-
-func (x *GetBlockchainInfoResponse) Reset() {
-	*x = GetBlockchainInfoResponse{}
+	// The requested transaction.
+	Transaction *GetMempoolTransactionGraphResponse_Entry `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// The unconfirmed transactions the transaction depends on, directly or
+	// indirectly, ordered by increasing distance from the transaction.
+	Ancestors []*GetMempoolTransactionGraphResponse_Entry `protobuf:"bytes,2,rep,name=ancestors,proto3" json:"ancestors,omitempty"`
+	// The unconfirmed transactions which depend on the transaction, directly
+	// or indirectly, ordered by increasing distance from the transaction.
+	Descendants []*GetMempoolTransactionGraphResponse_Entry `protobuf:"bytes,3,rep,name=descendants,proto3" json:"descendants,omitempty"`
+	// The total size in bytes of the ancestors.
+	AncestorSize int64 `protobuf:"varint,4,opt,name=ancestor_size,json=ancestorSize,proto3" json:"ancestor_size,omitempty"`
+	// The total fee in satoshi of the ancestors.
+	AncestorFees int64 `protobuf:"varint,5,opt,name=ancestor_fees,json=ancestorFees,proto3" json:"ancestor_fees,omitempty"`
+	// The total size in bytes of the descendants.
+	DescendantSize int64 `protobuf:"varint,6,opt,name=descendant_size,json=descendantSize,proto3" json:"descendant_size,omitempty"`
+	// The total fee in satoshi of the descendants.
+	DescendantFees int64 `protobuf:"varint,7,opt,name=descendant_fees,json=descendantFees,proto3" json:"descendant_fees,omitempty"`
+	// When `truncated` is true, only the closest ancestors or descendants are
+	// returned because there are too many of them.
+	Truncated bool `protobuf:"varint,8,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *GetMempoolTransactionGraphResponse) Reset() {
+	*x = GetMempoolTransactionGraphResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -688,13 +985,13 @@ func (x *GetBlockchainInfoResponse) Reset() {
 	}
 }
 
-func (x *GetBlockchainInfoResponse) String() string {
+func (x *GetMempoolTransactionGraphResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockchainInfoResponse) ProtoMessage() {}
+func (*GetMempoolTransactionGraphResponse) ProtoMessage() {}
 
-func (x *GetBlockchainInfoResponse) ProtoReflect() protoreflect.Message {
+func (x *GetMempoolTransactionGraphResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -706,89 +1003,243 @@ func (x *GetBlockchainInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockchainInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetBlockchainInfoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMempoolTransactionGraphResponse.ProtoReflect.Descriptor instead.
+func (*GetMempoolTransactionGraphResponse) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetBlockchainInfoResponse) GetBitcoinNet() GetBlockchainInfoResponse_BitcoinNet {
+func (x *GetMempoolTransactionGraphResponse) GetTransaction() *GetMempoolTransactionGraphResponse_Entry {
 	if x != nil {
-		return x.BitcoinNet
+		return x.Transaction
 	}
-	return GetBlockchainInfoResponse_MAINNET
+	return nil
 }
 
-func (x *GetBlockchainInfoResponse) GetBestHeight() int32 {
+func (x *GetMempoolTransactionGraphResponse) GetAncestors() []*GetMempoolTransactionGraphResponse_Entry {
 	if x != nil {
-		return x.BestHeight
+		return x.Ancestors
 	}
-	return 0
+	return nil
 }
 
-func (x *GetBlockchainInfoResponse) GetBestBlockHash() []byte {
+func (x *GetMempoolTransactionGraphResponse) GetDescendants() []*GetMempoolTransactionGraphResponse_Entry {
 	if x != nil {
-		return x.BestBlockHash
+		return x.Descendants
 	}
 	return nil
 }
 
-func (x *GetBlockchainInfoResponse) GetDifficulty() float64 {
+func (x *GetMempoolTransactionGraphResponse) GetAncestorSize() int64 {
 	if x != nil {
-		return x.Difficulty
+		return x.AncestorSize
 	}
 	return 0
 }
 
-func (x *GetBlockchainInfoResponse) GetMedianTime() int64 {
+func (x *GetMempoolTransactionGraphResponse) GetAncestorFees() int64 {
 	if x != nil {
-		return x.MedianTime
+		return x.AncestorFees
 	}
 	return 0
 }
 
-func (x *GetBlockchainInfoResponse) GetTxIndex() bool {
-	if x != nil {
-		return x.TxIndex
-	}
-	return false
-}
-
-func (x *GetBlockchainInfoResponse) GetAddrIndex() bool {
+func (x *GetMempoolTransactionGraphResponse) GetDescendantSize() int64 {
 	if x != nil {
-		return x.AddrIndex
+		return x.DescendantSize
 	}
-	return false
+	return 0
 }
 
-func (x *GetBlockchainInfoResponse) GetSlpIndex() bool {
+func (x *GetMempoolTransactionGraphResponse) GetDescendantFees() int64 {
 	if x != nil {
-		return x.SlpIndex
+		return x.DescendantFees
 	}
-	return false
+	return 0
 }
 
-func (x *GetBlockchainInfoResponse) GetSlpGraphsearch() bool {
+func (x *GetMempoolTransactionGraphResponse) GetTruncated() bool {
 	if x != nil {
-		return x.SlpGraphsearch
+		return x.Truncated
 	}
 	return false
 }
 
-type GetBlockInfoRequest struct {
+type GetBlockchainInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to HashOrHeight:
-	//	*GetBlockInfoRequest_Hash
-	//	*GetBlockInfoRequest_Height
-	HashOrHeight isGetBlockInfoRequest_HashOrHeight `protobuf_oneof:"hash_or_height"`
+}
+
+func (x *GetBlockchainInfoRequest) Reset() {
+	*x = GetBlockchainInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockchainInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockchainInfoRequest) ProtoMessage() {}
+
+func (x *GetBlockchainInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockchainInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockchainInfoRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{6}
+}
+
+type GetBlockchainInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Which network the node is operating on.
+	BitcoinNet GetBlockchainInfoResponse_BitcoinNet `protobuf:"varint,1,opt,name=bitcoin_net,json=bitcoinNet,proto3,enum=pb.GetBlockchainInfoResponse_BitcoinNet" json:"bitcoin_net,omitempty"`
+	// The current number of blocks on the longest chain.
+	BestHeight int32 `protobuf:"varint,2,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
+	// The hash of the best (tip) block in the most-work fully-validated chain, little-endian.
+	BestBlockHash []byte `protobuf:"bytes,3,opt,name=best_block_hash,json=bestBlockHash,proto3" json:"best_block_hash,omitempty"`
+	// Threshold for adding new blocks.
+	Difficulty float64 `protobuf:"fixed64,4,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	// Median time of the last 11 blocks.
+	MedianTime int64 `protobuf:"varint,5,opt,name=median_time,json=medianTime,proto3" json:"median_time,omitempty"`
+	// When `tx_index` is true, the node has full transaction index enabled.
+	TxIndex bool `protobuf:"varint,6,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// When `addr_index` is true, the node has address index enabled and may
+	// be used with call related by address.
+	AddrIndex bool `protobuf:"varint,7,opt,name=addr_index,json=addrIndex,proto3" json:"addr_index,omitempty"`
+	// When `slp_index` is true, the node has the slp index enabled and may
+	// be used with slp related rpc methods and also causes slp metadata to be added
+	// in some of the existing rpc methods.
+	SlpIndex bool `protobuf:"varint,8,opt,name=slp_index,json=slpIndex,proto3" json:"slp_index,omitempty"`
+	// When `slp_graphsearch` is true, the node is able to handle calls to slp graph search
+	SlpGraphsearch bool `protobuf:"varint,9,opt,name=slp_graphsearch,json=slpGraphsearch,proto3" json:"slp_graphsearch,omitempty"`
+}
+
+func (x *GetBlockchainInfoResponse) Reset() {
+	*x = GetBlockchainInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockchainInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockchainInfoResponse) ProtoMessage() {}
+
+func (x *GetBlockchainInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockchainInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockchainInfoResponse) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetBlockchainInfoResponse) GetBitcoinNet() GetBlockchainInfoResponse_BitcoinNet {
+	if x != nil {
+		return x.BitcoinNet
+	}
+	return GetBlockchainInfoResponse_MAINNET
+}
+
+func (x *GetBlockchainInfoResponse) GetBestHeight() int32 {
+	if x != nil {
+		return x.BestHeight
+	}
+	return 0
+}
+
+func (x *GetBlockchainInfoResponse) GetBestBlockHash() []byte {
+	if x != nil {
+		return x.BestBlockHash
+	}
+	return nil
+}
+
+func (x *GetBlockchainInfoResponse) GetDifficulty() float64 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *GetBlockchainInfoResponse) GetMedianTime() int64 {
+	if x != nil {
+		return x.MedianTime
+	}
+	return 0
+}
+
+func (x *GetBlockchainInfoResponse) GetTxIndex() bool {
+	if x != nil {
+		return x.TxIndex
+	}
+	return false
+}
+
+func (x *GetBlockchainInfoResponse) GetAddrIndex() bool {
+	if x != nil {
+		return x.AddrIndex
+	}
+	return false
+}
+
+func (x *GetBlockchainInfoResponse) GetSlpIndex() bool {
+	if x != nil {
+		return x.SlpIndex
+	}
+	return false
+}
+
+func (x *GetBlockchainInfoResponse) GetSlpGraphsearch() bool {
+	if x != nil {
+		return x.SlpGraphsearch
+	}
+	return false
+}
+
+type GetBlockInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to HashOrHeight:
+	//	*GetBlockInfoRequest_Hash
+	//	*GetBlockInfoRequest_Height
+	HashOrHeight isGetBlockInfoRequest_HashOrHeight `protobuf_oneof:"hash_or_height"`
 }
 
 func (x *GetBlockInfoRequest) Reset() {
 	*x = GetBlockInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[6]
+		mi := &file_bchrpc_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -801,7 +1252,7 @@ func (x *GetBlockInfoRequest) String() string {
 func (*GetBlockInfoRequest) ProtoMessage() {}
 
 func (x *GetBlockInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[6]
+	mi := &file_bchrpc_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,7 +1265,7 @@ func (x *GetBlockInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockInfoRequest) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{6}
+	return file_bchrpc_proto_rawDescGZIP(), []int{8}
 }
 
 func (m *GetBlockInfoRequest) GetHashOrHeight() isGetBlockInfoRequest_HashOrHeight {
@@ -868,7 +1319,7 @@ type GetBlockInfoResponse struct {
 func (x *GetBlockInfoResponse) Reset() {
 	*x = GetBlockInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[7]
+		mi := &file_bchrpc_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -881,7 +1332,7 @@ func (x *GetBlockInfoResponse) String() string {
 func (*GetBlockInfoResponse) ProtoMessage() {}
 
 func (x *GetBlockInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[7]
+	mi := &file_bchrpc_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -894,7 +1345,7 @@ func (x *GetBlockInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockInfoResponse) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{7}
+	return file_bchrpc_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetBlockInfoResponse) GetInfo() *BlockInfo {
@@ -904,37 +1355,37 @@ func (x *GetBlockInfoResponse) GetInfo() *BlockInfo {
 	return nil
 }
 
-type GetBlockRequest struct {
+type GetBlockRangeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to HashOrHeight:
-	//	*GetBlockRequest_Hash
-	//	*GetBlockRequest_Height
-	HashOrHeight isGetBlockRequest_HashOrHeight `protobuf_oneof:"hash_or_height"`
-	// When `full_transactions` is true, full transactions are returned
-	// instead of just hashes. Default is false.
-	FullTransactions bool `protobuf:"varint,3,opt,name=full_transactions,json=fullTransactions,proto3" json:"full_transactions,omitempty"`
+	// Types that are assignable to Start:
+	//	*GetBlockRangeRequest_StartHash
+	//	*GetBlockRangeRequest_StartHeight
+	Start isGetBlockRangeRequest_Start `protobuf_oneof:"start"`
+	// The maximum number of blocks to return. If zero or more than 1000,
+	// up to 1000 blocks are returned.
+	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *GetBlockRequest) Reset() {
-	*x = GetBlockRequest{}
+func (x *GetBlockRangeRequest) Reset() {
+	*x = GetBlockRangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[8]
+		mi := &file_bchrpc_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBlockRequest) String() string {
+func (x *GetBlockRangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockRequest) ProtoMessage() {}
+func (*GetBlockRangeRequest) ProtoMessage() {}
 
-func (x *GetBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[8]
+func (x *GetBlockRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -945,83 +1396,89 @@ func (x *GetBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockRequest.ProtoReflect.Descriptor instead.
-func (*GetBlockRequest) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetBlockRangeRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockRangeRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{10}
 }
 
-func (m *GetBlockRequest) GetHashOrHeight() isGetBlockRequest_HashOrHeight {
+func (m *GetBlockRangeRequest) GetStart() isGetBlockRangeRequest_Start {
 	if m != nil {
-		return m.HashOrHeight
+		return m.Start
 	}
 	return nil
 }
 
-func (x *GetBlockRequest) GetHash() []byte {
-	if x, ok := x.GetHashOrHeight().(*GetBlockRequest_Hash); ok {
-		return x.Hash
+func (x *GetBlockRangeRequest) GetStartHash() []byte {
+	if x, ok := x.GetStart().(*GetBlockRangeRequest_StartHash); ok {
+		return x.StartHash
 	}
 	return nil
 }
 
-func (x *GetBlockRequest) GetHeight() int32 {
-	if x, ok := x.GetHashOrHeight().(*GetBlockRequest_Height); ok {
-		return x.Height
+func (x *GetBlockRangeRequest) GetStartHeight() int32 {
+	if x, ok := x.GetStart().(*GetBlockRangeRequest_StartHeight); ok {
+		return x.StartHeight
 	}
 	return 0
 }
 
-func (x *GetBlockRequest) GetFullTransactions() bool {
+func (x *GetBlockRangeRequest) GetCount() uint32 {
 	if x != nil {
-		return x.FullTransactions
+		return x.Count
 	}
-	return false
+	return 0
 }
 
-type isGetBlockRequest_HashOrHeight interface {
-	isGetBlockRequest_HashOrHeight()
+type isGetBlockRangeRequest_Start interface {
+	isGetBlockRangeRequest_Start()
 }
 
-type GetBlockRequest_Hash struct {
-	// The block hash as a byte array or base64 encoded string, little-endian.
-	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3,oneof"`
+type GetBlockRangeRequest_StartHash struct {
+	// The hash of the first block as a byte array or base64 encoded string, little-endian.
+	StartHash []byte `protobuf:"bytes,1,opt,name=start_hash,json=startHash,proto3,oneof"`
 }
 
-type GetBlockRequest_Height struct {
-	// The block number.
-	Height int32 `protobuf:"varint,2,opt,name=height,proto3,oneof"`
+type GetBlockRangeRequest_StartHeight struct {
+	// The height of the first block.
+	StartHeight int32 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3,oneof"`
 }
 
-func (*GetBlockRequest_Hash) isGetBlockRequest_HashOrHeight() {}
+func (*GetBlockRangeRequest_StartHash) isGetBlockRangeRequest_Start() {}
 
-func (*GetBlockRequest_Height) isGetBlockRequest_HashOrHeight() {}
+func (*GetBlockRangeRequest_StartHeight) isGetBlockRangeRequest_Start() {}
 
-type GetBlockResponse struct {
+type GetBlockRangeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A marshaled block.
-	Block *Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// Marshaled block header data, as well as metadata, ordered by height.
+	// It is empty when the start height is beyond the best block.
+	Infos []*BlockInfo `protobuf:"bytes,1,rep,name=infos,proto3" json:"infos,omitempty"`
+	// The height of the block following the last returned block, from which
+	// the next range starts.
+	NextHeight int32 `protobuf:"varint,2,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// The height of the best block when the range was read.
+	BestHeight int32 `protobuf:"varint,3,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
 }
 
-func (x *GetBlockResponse) Reset() {
-	*x = GetBlockResponse{}
+func (x *GetBlockRangeResponse) Reset() {
+	*x = GetBlockRangeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[9]
+		mi := &file_bchrpc_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBlockResponse) String() string {
+func (x *GetBlockRangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockResponse) ProtoMessage() {}
+func (*GetBlockRangeResponse) ProtoMessage() {}
 
-func (x *GetBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[9]
+func (x *GetBlockRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,46 +1489,63 @@ func (x *GetBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockResponse.ProtoReflect.Descriptor instead.
-func (*GetBlockResponse) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetBlockRangeResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockRangeResponse) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetBlockResponse) GetBlock() *Block {
+func (x *GetBlockRangeResponse) GetInfos() []*BlockInfo {
 	if x != nil {
-		return x.Block
+		return x.Infos
 	}
 	return nil
 }
 
-type GetRawBlockRequest struct {
+func (x *GetBlockRangeResponse) GetNextHeight() int32 {
+	if x != nil {
+		return x.NextHeight
+	}
+	return 0
+}
+
+func (x *GetBlockRangeResponse) GetBestHeight() int32 {
+	if x != nil {
+		return x.BestHeight
+	}
+	return 0
+}
+
+type GetBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to HashOrHeight:
-	//	*GetRawBlockRequest_Hash
-	//	*GetRawBlockRequest_Height
-	HashOrHeight isGetRawBlockRequest_HashOrHeight `protobuf_oneof:"hash_or_height"`
-}
+	//	*GetBlockRequest_Hash
+	//	*GetBlockRequest_Height
+	HashOrHeight isGetBlockRequest_HashOrHeight `protobuf_oneof:"hash_or_height"`
+	// When `full_transactions` is true, full transactions are returned
+	// instead of just hashes. Default is false.
+	FullTransactions bool `protobuf:"varint,3,opt,name=full_transactions,json=fullTransactions,proto3" json:"full_transactions,omitempty"`
+}
 
-func (x *GetRawBlockRequest) Reset() {
-	*x = GetRawBlockRequest{}
+func (x *GetBlockRequest) Reset() {
+	*x = GetBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[10]
+		mi := &file_bchrpc_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetRawBlockRequest) String() string {
+func (x *GetBlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRawBlockRequest) ProtoMessage() {}
+func (*GetBlockRequest) ProtoMessage() {}
 
-func (x *GetRawBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[10]
+func (x *GetBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1082,76 +1556,83 @@ func (x *GetRawBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRawBlockRequest.ProtoReflect.Descriptor instead.
-func (*GetRawBlockRequest) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetBlockRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{12}
 }
 
-func (m *GetRawBlockRequest) GetHashOrHeight() isGetRawBlockRequest_HashOrHeight {
+func (m *GetBlockRequest) GetHashOrHeight() isGetBlockRequest_HashOrHeight {
 	if m != nil {
 		return m.HashOrHeight
 	}
 	return nil
 }
 
-func (x *GetRawBlockRequest) GetHash() []byte {
-	if x, ok := x.GetHashOrHeight().(*GetRawBlockRequest_Hash); ok {
+func (x *GetBlockRequest) GetHash() []byte {
+	if x, ok := x.GetHashOrHeight().(*GetBlockRequest_Hash); ok {
 		return x.Hash
 	}
 	return nil
 }
 
-func (x *GetRawBlockRequest) GetHeight() int32 {
-	if x, ok := x.GetHashOrHeight().(*GetRawBlockRequest_Height); ok {
+func (x *GetBlockRequest) GetHeight() int32 {
+	if x, ok := x.GetHashOrHeight().(*GetBlockRequest_Height); ok {
 		return x.Height
 	}
 	return 0
 }
 
-type isGetRawBlockRequest_HashOrHeight interface {
-	isGetRawBlockRequest_HashOrHeight()
+func (x *GetBlockRequest) GetFullTransactions() bool {
+	if x != nil {
+		return x.FullTransactions
+	}
+	return false
 }
 
-type GetRawBlockRequest_Hash struct {
+type isGetBlockRequest_HashOrHeight interface {
+	isGetBlockRequest_HashOrHeight()
+}
+
+type GetBlockRequest_Hash struct {
 	// The block hash as a byte array or base64 encoded string, little-endian.
 	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3,oneof"`
 }
 
-type GetRawBlockRequest_Height struct {
+type GetBlockRequest_Height struct {
 	// The block number.
 	Height int32 `protobuf:"varint,2,opt,name=height,proto3,oneof"`
 }
 
-func (*GetRawBlockRequest_Hash) isGetRawBlockRequest_HashOrHeight() {}
+func (*GetBlockRequest_Hash) isGetBlockRequest_HashOrHeight() {}
 
-func (*GetRawBlockRequest_Height) isGetRawBlockRequest_HashOrHeight() {}
+func (*GetBlockRequest_Height) isGetBlockRequest_HashOrHeight() {}
 
-type GetRawBlockResponse struct {
+type GetBlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Raw block data (with header) serialized according the the bitcoin block protocol.
-	Block []byte `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// A marshaled block.
+	Block *Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
 }
 
-func (x *GetRawBlockResponse) Reset() {
-	*x = GetRawBlockResponse{}
+func (x *GetBlockResponse) Reset() {
+	*x = GetBlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[11]
+		mi := &file_bchrpc_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetRawBlockResponse) String() string {
+func (x *GetBlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRawBlockResponse) ProtoMessage() {}
+func (*GetBlockResponse) ProtoMessage() {}
 
-func (x *GetRawBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[11]
+func (x *GetBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,46 +1643,46 @@ func (x *GetRawBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRawBlockResponse.ProtoReflect.Descriptor instead.
-func (*GetRawBlockResponse) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetBlockResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockResponse) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetRawBlockResponse) GetBlock() []byte {
+func (x *GetBlockResponse) GetBlock() *Block {
 	if x != nil {
 		return x.Block
 	}
 	return nil
 }
 
-type GetBlockFilterRequest struct {
+type GetRawBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to HashOrHeight:
-	//	*GetBlockFilterRequest_Hash
-	//	*GetBlockFilterRequest_Height
-	HashOrHeight isGetBlockFilterRequest_HashOrHeight `protobuf_oneof:"hash_or_height"`
+	//	*GetRawBlockRequest_Hash
+	//	*GetRawBlockRequest_Height
+	HashOrHeight isGetRawBlockRequest_HashOrHeight `protobuf_oneof:"hash_or_height"`
 }
 
-func (x *GetBlockFilterRequest) Reset() {
-	*x = GetBlockFilterRequest{}
+func (x *GetRawBlockRequest) Reset() {
+	*x = GetRawBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[12]
+		mi := &file_bchrpc_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBlockFilterRequest) String() string {
+func (x *GetRawBlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockFilterRequest) ProtoMessage() {}
+func (*GetRawBlockRequest) ProtoMessage() {}
 
-func (x *GetBlockFilterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[12]
+func (x *GetRawBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1212,77 +1693,76 @@ func (x *GetBlockFilterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockFilterRequest.ProtoReflect.Descriptor instead.
-func (*GetBlockFilterRequest) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetRawBlockRequest.ProtoReflect.Descriptor instead.
+func (*GetRawBlockRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{14}
 }
 
-func (m *GetBlockFilterRequest) GetHashOrHeight() isGetBlockFilterRequest_HashOrHeight {
+func (m *GetRawBlockRequest) GetHashOrHeight() isGetRawBlockRequest_HashOrHeight {
 	if m != nil {
 		return m.HashOrHeight
 	}
 	return nil
 }
 
-func (x *GetBlockFilterRequest) GetHash() []byte {
-	if x, ok := x.GetHashOrHeight().(*GetBlockFilterRequest_Hash); ok {
+func (x *GetRawBlockRequest) GetHash() []byte {
+	if x, ok := x.GetHashOrHeight().(*GetRawBlockRequest_Hash); ok {
 		return x.Hash
 	}
 	return nil
 }
 
-func (x *GetBlockFilterRequest) GetHeight() int32 {
-	if x, ok := x.GetHashOrHeight().(*GetBlockFilterRequest_Height); ok {
+func (x *GetRawBlockRequest) GetHeight() int32 {
+	if x, ok := x.GetHashOrHeight().(*GetRawBlockRequest_Height); ok {
 		return x.Height
 	}
 	return 0
 }
 
-type isGetBlockFilterRequest_HashOrHeight interface {
-	isGetBlockFilterRequest_HashOrHeight()
+type isGetRawBlockRequest_HashOrHeight interface {
+	isGetRawBlockRequest_HashOrHeight()
 }
 
-type GetBlockFilterRequest_Hash struct {
+type GetRawBlockRequest_Hash struct {
 	// The block hash as a byte array or base64 encoded string, little-endian.
 	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3,oneof"`
 }
 
-type GetBlockFilterRequest_Height struct {
+type GetRawBlockRequest_Height struct {
 	// The block number.
 	Height int32 `protobuf:"varint,2,opt,name=height,proto3,oneof"`
 }
 
-func (*GetBlockFilterRequest_Hash) isGetBlockFilterRequest_HashOrHeight() {}
+func (*GetRawBlockRequest_Hash) isGetRawBlockRequest_HashOrHeight() {}
 
-func (*GetBlockFilterRequest_Height) isGetBlockFilterRequest_HashOrHeight() {}
+func (*GetRawBlockRequest_Height) isGetRawBlockRequest_HashOrHeight() {}
 
-type GetBlockFilterResponse struct {
+type GetRawBlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A compact filter matching input outpoints and public key scripts contained
-	// in a block (encoded according to BIP158).
-	Filter []byte `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Raw block data (with header) serialized according the the bitcoin block protocol.
+	Block []byte `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
 }
 
-func (x *GetBlockFilterResponse) Reset() {
-	*x = GetBlockFilterResponse{}
+func (x *GetRawBlockResponse) Reset() {
+	*x = GetRawBlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[13]
+		mi := &file_bchrpc_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBlockFilterResponse) String() string {
+func (x *GetRawBlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockFilterResponse) ProtoMessage() {}
+func (*GetRawBlockResponse) ProtoMessage() {}
 
-func (x *GetBlockFilterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[13]
+func (x *GetRawBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1293,51 +1773,51 @@ func (x *GetBlockFilterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockFilterResponse.ProtoReflect.Descriptor instead.
-func (*GetBlockFilterResponse) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetRawBlockResponse.ProtoReflect.Descriptor instead.
+func (*GetRawBlockResponse) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetBlockFilterResponse) GetFilter() []byte {
+func (x *GetRawBlockResponse) GetBlock() []byte {
 	if x != nil {
-		return x.Filter
+		return x.Block
 	}
 	return nil
 }
 
-// Request headers using a list of known block hashes.
-type GetHeadersRequest struct {
+type GetRawBlocksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A list of block hashes known to the client (most recent first) which
-	// is exponentially sparser toward the genesis block (0), little-endian.
-	// Common practice is to include all of the last 10 blocks, and then
-	// 9 blocks for each order of ten thereafter.
-	BlockLocatorHashes [][]byte `protobuf:"bytes,1,rep,name=block_locator_hashes,json=blockLocatorHashes,proto3" json:"block_locator_hashes,omitempty"`
-	// hash of the latest desired block header, little-endian; only blocks
-	// occurring before the stop will be returned.
-	StopHash []byte `protobuf:"bytes,2,opt,name=stop_hash,json=stopHash,proto3" json:"stop_hash,omitempty"`
+	// Types that are assignable to Start:
+	//	*GetRawBlocksRequest_StartHash
+	//	*GetRawBlocksRequest_StartHeight
+	Start isGetRawBlocksRequest_Start `protobuf_oneof:"start"`
+	// The maximum number of blocks to send. If zero all blocks up to the
+	// current tip are sent.
+	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// The compression applied to the blocks.
+	Compression GetRawBlocksRequest_Compression `protobuf:"varint,4,opt,name=compression,proto3,enum=pb.GetRawBlocksRequest_Compression" json:"compression,omitempty"`
 }
 
-func (x *GetHeadersRequest) Reset() {
-	*x = GetHeadersRequest{}
+func (x *GetRawBlocksRequest) Reset() {
+	*x = GetRawBlocksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[14]
+		mi := &file_bchrpc_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetHeadersRequest) String() string {
+func (x *GetRawBlocksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHeadersRequest) ProtoMessage() {}
+func (*GetRawBlocksRequest) ProtoMessage() {}
 
-func (x *GetHeadersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[14]
+func (x *GetRawBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1348,101 +1828,97 @@ func (x *GetHeadersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHeadersRequest.ProtoReflect.Descriptor instead.
-func (*GetHeadersRequest) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetRawBlocksRequest.ProtoReflect.Descriptor instead.
+func (*GetRawBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetHeadersRequest) GetBlockLocatorHashes() [][]byte {
-	if x != nil {
-		return x.BlockLocatorHashes
+func (m *GetRawBlocksRequest) GetStart() isGetRawBlocksRequest_Start {
+	if m != nil {
+		return m.Start
 	}
 	return nil
 }
 
-func (x *GetHeadersRequest) GetStopHash() []byte {
-	if x != nil {
-		return x.StopHash
+func (x *GetRawBlocksRequest) GetStartHash() []byte {
+	if x, ok := x.GetStart().(*GetRawBlocksRequest_StartHash); ok {
+		return x.StartHash
 	}
 	return nil
 }
 
-type GetHeadersResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// List of block headers.
-	Headers []*BlockInfo `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+func (x *GetRawBlocksRequest) GetStartHeight() int32 {
+	if x, ok := x.GetStart().(*GetRawBlocksRequest_StartHeight); ok {
+		return x.StartHeight
+	}
+	return 0
 }
 
-func (x *GetHeadersResponse) Reset() {
-	*x = GetHeadersResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetRawBlocksRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
 	}
+	return 0
 }
 
-func (x *GetHeadersResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetRawBlocksRequest) GetCompression() GetRawBlocksRequest_Compression {
+	if x != nil {
+		return x.Compression
+	}
+	return GetRawBlocksRequest_NONE
 }
 
-func (*GetHeadersResponse) ProtoMessage() {}
-
-func (x *GetHeadersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type isGetRawBlocksRequest_Start interface {
+	isGetRawBlocksRequest_Start()
 }
 
-// Deprecated: Use GetHeadersResponse.ProtoReflect.Descriptor instead.
-func (*GetHeadersResponse) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{15}
+type GetRawBlocksRequest_StartHash struct {
+	// The hash of the first block as a byte array or base64 encoded string, little-endian.
+	StartHash []byte `protobuf:"bytes,1,opt,name=start_hash,json=startHash,proto3,oneof"`
 }
 
-func (x *GetHeadersResponse) GetHeaders() []*BlockInfo {
-	if x != nil {
-		return x.Headers
-	}
-	return nil
+type GetRawBlocksRequest_StartHeight struct {
+	// The height of the first block.
+	StartHeight int32 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3,oneof"`
 }
 
-// Get a transaction from a transaction hash.
-type GetTransactionRequest struct {
+func (*GetRawBlocksRequest_StartHash) isGetRawBlocksRequest_Start() {}
+
+func (*GetRawBlocksRequest_StartHeight) isGetRawBlocksRequest_Start() {}
+
+type GetRawBlocksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A transaction hash, little-endian.
-	Hash                 []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	IncludeTokenMetadata bool   `protobuf:"varint,2,opt,name=include_token_metadata,json=includeTokenMetadata,proto3" json:"include_token_metadata,omitempty"`
-}
-
-func (x *GetTransactionRequest) Reset() {
-	*x = GetTransactionRequest{}
+	// The block number.
+	Height int32 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// The block hash, little-endian.
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Raw block data (with header) serialized according the the bitcoin block
+	// protocol and compressed as requested.
+	Block []byte `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	// The size of the serialized block before compression.
+	Size uint32 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *GetRawBlocksResponse) Reset() {
+	*x = GetRawBlocksResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[16]
+		mi := &file_bchrpc_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTransactionRequest) String() string {
+func (x *GetRawBlocksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionRequest) ProtoMessage() {}
+func (*GetRawBlocksResponse) ProtoMessage() {}
 
-func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[16]
+func (x *GetRawBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1453,52 +1929,67 @@ func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetRawBlocksResponse.ProtoReflect.Descriptor instead.
+func (*GetRawBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetTransactionRequest) GetHash() []byte {
+func (x *GetRawBlocksResponse) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetRawBlocksResponse) GetHash() []byte {
 	if x != nil {
 		return x.Hash
 	}
 	return nil
 }
 
-func (x *GetTransactionRequest) GetIncludeTokenMetadata() bool {
+func (x *GetRawBlocksResponse) GetBlock() []byte {
 	if x != nil {
-		return x.IncludeTokenMetadata
+		return x.Block
 	}
-	return false
+	return nil
 }
 
-type GetTransactionResponse struct {
+func (x *GetRawBlocksResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type GetBlockFilterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A marshaled transaction.
-	Transaction   *Transaction      `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	TokenMetadata *SlpTokenMetadata `protobuf:"bytes,2,opt,name=token_metadata,json=tokenMetadata,proto3" json:"token_metadata,omitempty"`
+	// Types that are assignable to HashOrHeight:
+	//	*GetBlockFilterRequest_Hash
+	//	*GetBlockFilterRequest_Height
+	HashOrHeight isGetBlockFilterRequest_HashOrHeight `protobuf_oneof:"hash_or_height"`
 }
 
-func (x *GetTransactionResponse) Reset() {
-	*x = GetTransactionResponse{}
+func (x *GetBlockFilterRequest) Reset() {
+	*x = GetBlockFilterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[17]
+		mi := &file_bchrpc_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTransactionResponse) String() string {
+func (x *GetBlockFilterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionResponse) ProtoMessage() {}
+func (*GetBlockFilterRequest) ProtoMessage() {}
 
-func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[17]
+func (x *GetBlockFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1509,85 +2000,62 @@ func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
-func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetBlockFilterRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockFilterRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetTransactionResponse) GetTransaction() *Transaction {
-	if x != nil {
-		return x.Transaction
+func (m *GetBlockFilterRequest) GetHashOrHeight() isGetBlockFilterRequest_HashOrHeight {
+	if m != nil {
+		return m.HashOrHeight
 	}
 	return nil
 }
 
-func (x *GetTransactionResponse) GetTokenMetadata() *SlpTokenMetadata {
-	if x != nil {
-		return x.TokenMetadata
+func (x *GetBlockFilterRequest) GetHash() []byte {
+	if x, ok := x.GetHashOrHeight().(*GetBlockFilterRequest_Hash); ok {
+		return x.Hash
 	}
 	return nil
 }
 
-// Get an encoded transaction from a transaction hash.
-type GetRawTransactionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// A transaction hash, little-endian.
-	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-}
-
-func (x *GetRawTransactionRequest) Reset() {
-	*x = GetRawTransactionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetBlockFilterRequest) GetHeight() int32 {
+	if x, ok := x.GetHashOrHeight().(*GetBlockFilterRequest_Height); ok {
+		return x.Height
 	}
+	return 0
 }
 
-func (x *GetRawTransactionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type isGetBlockFilterRequest_HashOrHeight interface {
+	isGetBlockFilterRequest_HashOrHeight()
 }
 
-func (*GetRawTransactionRequest) ProtoMessage() {}
-
-func (x *GetRawTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type GetBlockFilterRequest_Hash struct {
+	// The block hash as a byte array or base64 encoded string, little-endian.
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3,oneof"`
 }
 
-// Deprecated: Use GetRawTransactionRequest.ProtoReflect.Descriptor instead.
-func (*GetRawTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{18}
+type GetBlockFilterRequest_Height struct {
+	// The block number.
+	Height int32 `protobuf:"varint,2,opt,name=height,proto3,oneof"`
 }
 
-func (x *GetRawTransactionRequest) GetHash() []byte {
-	if x != nil {
-		return x.Hash
-	}
-	return nil
-}
+func (*GetBlockFilterRequest_Hash) isGetBlockFilterRequest_HashOrHeight() {}
 
-type GetRawTransactionResponse struct {
+func (*GetBlockFilterRequest_Height) isGetBlockFilterRequest_HashOrHeight() {}
+
+type GetBlockFilterResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Raw transaction in bytes.
-	Transaction []byte `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// A compact filter matching input outpoints and public key scripts contained
+	// in a block (encoded according to BIP158).
+	Filter []byte `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *GetRawTransactionResponse) Reset() {
-	*x = GetRawTransactionResponse{}
+func (x *GetBlockFilterResponse) Reset() {
+	*x = GetBlockFilterResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1595,13 +2063,13 @@ func (x *GetRawTransactionResponse) Reset() {
 	}
 }
 
-func (x *GetRawTransactionResponse) String() string {
+func (x *GetBlockFilterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRawTransactionResponse) ProtoMessage() {}
+func (*GetBlockFilterResponse) ProtoMessage() {}
 
-func (x *GetRawTransactionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetBlockFilterResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1613,54 +2081,33 @@ func (x *GetRawTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRawTransactionResponse.ProtoReflect.Descriptor instead.
-func (*GetRawTransactionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBlockFilterResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockFilterResponse) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetRawTransactionResponse) GetTransaction() []byte {
+func (x *GetBlockFilterResponse) GetFilter() []byte {
 	if x != nil {
-		return x.Transaction
+		return x.Filter
 	}
 	return nil
 }
 
-// Get marshaled transactions related to a specific address.
-//
-// RECOMMENDED:
-// Parameters have been provided to query without creating
-//   performance issues on the node or client.
-//
-// - The number of transactions to skip and fetch allow for iterating
-//       over a large set of transactions, if necessary.
-//
-// - A starting block parameter (either `hash` or `height`)
-//       may then be used to filter results to those occurring
-//       after a certain time.
-//
-// This approach will reduce network traffic and response processing
-//   for the client, as well as reduce workload on the node.
-type GetAddressTransactionsRequest struct {
+type RegisterFilterWatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The address to query transactions, in lowercase cashaddr format.
-	// The network prefix is optional (i.e. "cashaddress:").
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// The number of confirmed transactions to skip, starting with the oldest first.
-	// Does not affect results of unconfirmed transactions.
-	NbSkip uint32 `protobuf:"varint,2,opt,name=nb_skip,json=nbSkip,proto3" json:"nb_skip,omitempty"`
-	// Specify the number of transactions to fetch.
-	NbFetch uint32 `protobuf:"varint,3,opt,name=nb_fetch,json=nbFetch,proto3" json:"nb_fetch,omitempty"`
-	// Types that are assignable to StartBlock:
-	//	*GetAddressTransactionsRequest_Hash
-	//	*GetAddressTransactionsRequest_Height
-	StartBlock isGetAddressTransactionsRequest_StartBlock `protobuf_oneof:"start_block"`
+	// Public key scripts to match against the filters.
+	Scripts [][]byte `protobuf:"bytes,1,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	// Addresses whose public key scripts are matched against the filters.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// The height of the first block to match.
+	StartHeight int32 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
 }
 
-func (x *GetAddressTransactionsRequest) Reset() {
-	*x = GetAddressTransactionsRequest{}
+func (x *RegisterFilterWatchRequest) Reset() {
+	*x = RegisterFilterWatchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1668,13 +2115,13 @@ func (x *GetAddressTransactionsRequest) Reset() {
 	}
 }
 
-func (x *GetAddressTransactionsRequest) String() string {
+func (x *RegisterFilterWatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAddressTransactionsRequest) ProtoMessage() {}
+func (*RegisterFilterWatchRequest) ProtoMessage() {}
 
-func (x *GetAddressTransactionsRequest) ProtoReflect() protoreflect.Message {
+func (x *RegisterFilterWatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1686,101 +2133,108 @@ func (x *GetAddressTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAddressTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*GetAddressTransactionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegisterFilterWatchRequest.ProtoReflect.Descriptor instead.
+func (*RegisterFilterWatchRequest) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetAddressTransactionsRequest) GetAddress() string {
+func (x *RegisterFilterWatchRequest) GetScripts() [][]byte {
 	if x != nil {
-		return x.Address
+		return x.Scripts
 	}
-	return ""
+	return nil
 }
 
-func (x *GetAddressTransactionsRequest) GetNbSkip() uint32 {
+func (x *RegisterFilterWatchRequest) GetAddresses() []string {
 	if x != nil {
-		return x.NbSkip
+		return x.Addresses
 	}
-	return 0
+	return nil
 }
 
-func (x *GetAddressTransactionsRequest) GetNbFetch() uint32 {
+func (x *RegisterFilterWatchRequest) GetStartHeight() int32 {
 	if x != nil {
-		return x.NbFetch
+		return x.StartHeight
 	}
 	return 0
 }
 
-func (m *GetAddressTransactionsRequest) GetStartBlock() isGetAddressTransactionsRequest_StartBlock {
-	if m != nil {
-		return m.StartBlock
-	}
-	return nil
-}
+type RegisterFilterWatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *GetAddressTransactionsRequest) GetHash() []byte {
-	if x, ok := x.GetStartBlock().(*GetAddressTransactionsRequest_Hash); ok {
-		return x.Hash
-	}
-	return nil
+	// The id of the watch used to query its matches.
+	WatchId string `protobuf:"bytes,1,opt,name=watch_id,json=watchId,proto3" json:"watch_id,omitempty"`
 }
 
-func (x *GetAddressTransactionsRequest) GetHeight() int32 {
-	if x, ok := x.GetStartBlock().(*GetAddressTransactionsRequest_Height); ok {
-		return x.Height
+func (x *RegisterFilterWatchResponse) Reset() {
+	*x = RegisterFilterWatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-type isGetAddressTransactionsRequest_StartBlock interface {
-	isGetAddressTransactionsRequest_StartBlock()
+func (x *RegisterFilterWatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type GetAddressTransactionsRequest_Hash struct {
-	// Recommended. Only get transactions after (or within) a
-	// starting block identified by hash, little-endian.
-	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3,oneof"`
-}
+func (*RegisterFilterWatchResponse) ProtoMessage() {}
 
-type GetAddressTransactionsRequest_Height struct {
-	// Recommended. Only get transactions after (or within) a
-	// starting block identified by block number.
-	Height int32 `protobuf:"varint,5,opt,name=height,proto3,oneof"`
+func (x *RegisterFilterWatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*GetAddressTransactionsRequest_Hash) isGetAddressTransactionsRequest_StartBlock() {}
+// Deprecated: Use RegisterFilterWatchResponse.ProtoReflect.Descriptor instead.
+func (*RegisterFilterWatchResponse) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{21}
+}
 
-func (*GetAddressTransactionsRequest_Height) isGetAddressTransactionsRequest_StartBlock() {}
+func (x *RegisterFilterWatchResponse) GetWatchId() string {
+	if x != nil {
+		return x.WatchId
+	}
+	return ""
+}
 
-type GetAddressTransactionsResponse struct {
+type GetFilterMatchesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Transactions that have been included in a block.
-	ConfirmedTransactions []*Transaction `protobuf:"bytes,1,rep,name=confirmed_transactions,json=confirmedTransactions,proto3" json:"confirmed_transactions,omitempty"`
-	// Transactions in mempool which have not been included in a block.
-	UnconfirmedTransactions []*MempoolTransaction `protobuf:"bytes,2,rep,name=unconfirmed_transactions,json=unconfirmedTransactions,proto3" json:"unconfirmed_transactions,omitempty"`
+	// The id of the watch returned by RegisterFilterWatch.
+	WatchId string `protobuf:"bytes,1,opt,name=watch_id,json=watchId,proto3" json:"watch_id,omitempty"`
+	// Only matches of blocks at or above this height are returned.
+	SinceHeight int32 `protobuf:"varint,2,opt,name=since_height,json=sinceHeight,proto3" json:"since_height,omitempty"`
 }
 
-func (x *GetAddressTransactionsResponse) Reset() {
-	*x = GetAddressTransactionsResponse{}
+func (x *GetFilterMatchesRequest) Reset() {
+	*x = GetFilterMatchesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[21]
+		mi := &file_bchrpc_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAddressTransactionsResponse) String() string {
+func (x *GetFilterMatchesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAddressTransactionsResponse) ProtoMessage() {}
+func (*GetFilterMatchesRequest) ProtoMessage() {}
 
-func (x *GetAddressTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[21]
+func (x *GetFilterMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1791,76 +2245,61 @@ func (x *GetAddressTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAddressTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*GetAddressTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetFilterMatchesRequest.ProtoReflect.Descriptor instead.
+func (*GetFilterMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetAddressTransactionsResponse) GetConfirmedTransactions() []*Transaction {
+func (x *GetFilterMatchesRequest) GetWatchId() string {
 	if x != nil {
-		return x.ConfirmedTransactions
+		return x.WatchId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAddressTransactionsResponse) GetUnconfirmedTransactions() []*MempoolTransaction {
+func (x *GetFilterMatchesRequest) GetSinceHeight() int32 {
 	if x != nil {
-		return x.UnconfirmedTransactions
+		return x.SinceHeight
 	}
-	return nil
+	return 0
 }
 
-// Get encoded transactions related to a specific address.
-//
-// RECOMMENDED:
-// Parameters have been provided to query without creating
-//   performance issues on the node or client.
-//
-// - The number of transactions to skip and fetch allow for iterating
-//       over a large set of transactions, if necessary.
-//
-// - A starting block parameter (either `hash` or `height`)
-//       may then be used to filter results to those occurring
-//       after a certain time.
-//
-// This approach will reduce network traffic and response processing
-//   for the client, as well as reduce workload on the node.
-type GetRawAddressTransactionsRequest struct {
+type GetFilterMatchesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The address to query transactions, in lowercase cashaddr format.
-	// The network prefix is optional (i.e. "cashaddress:").
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// The number of confirmed transactions to skip, starting with the oldest first.
-	// Does not affect results of unconfirmed transactions.
-	NbSkip uint32 `protobuf:"varint,2,opt,name=nb_skip,json=nbSkip,proto3" json:"nb_skip,omitempty"`
-	// Specify the number of transactions to fetch.
-	NbFetch uint32 `protobuf:"varint,3,opt,name=nb_fetch,json=nbFetch,proto3" json:"nb_fetch,omitempty"`
-	// Types that are assignable to StartBlock:
-	//	*GetRawAddressTransactionsRequest_Hash
-	//	*GetRawAddressTransactionsRequest_Height
-	StartBlock isGetRawAddressTransactionsRequest_StartBlock `protobuf_oneof:"start_block"`
-}
-
-func (x *GetRawAddressTransactionsRequest) Reset() {
-	*x = GetRawAddressTransactionsRequest{}
+	// The matching blocks in ascending height order. Filters match a block
+	// which doesn't include any of the scripts with a small probability.
+	Matches []*GetFilterMatchesResponse_Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// The height of the last block whose filter was matched.
+	ScannedHeight int32 `protobuf:"varint,2,opt,name=scanned_height,json=scannedHeight,proto3" json:"scanned_height,omitempty"`
+	// The hash of the last block whose filter was matched, little-endian.
+	ScannedHash []byte `protobuf:"bytes,3,opt,name=scanned_hash,json=scannedHash,proto3" json:"scanned_hash,omitempty"`
+	// Whether the filters of all blocks up to the tip were matched.
+	AtTip bool `protobuf:"varint,4,opt,name=at_tip,json=atTip,proto3" json:"at_tip,omitempty"`
+	// The height up to which matches were dropped because the watch matched
+	// more than 10000 blocks, or -1 when none were dropped.
+	PrunedHeight int32 `protobuf:"varint,5,opt,name=pruned_height,json=prunedHeight,proto3" json:"pruned_height,omitempty"`
+}
+
+func (x *GetFilterMatchesResponse) Reset() {
+	*x = GetFilterMatchesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[22]
+		mi := &file_bchrpc_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetRawAddressTransactionsRequest) String() string {
+func (x *GetFilterMatchesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRawAddressTransactionsRequest) ProtoMessage() {}
+func (*GetFilterMatchesResponse) ProtoMessage() {}
 
-func (x *GetRawAddressTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[22]
+func (x *GetFilterMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1871,101 +2310,79 @@ func (x *GetRawAddressTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRawAddressTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*GetRawAddressTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetFilterMatchesResponse.ProtoReflect.Descriptor instead.
+func (*GetFilterMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetRawAddressTransactionsRequest) GetAddress() string {
+func (x *GetFilterMatchesResponse) GetMatches() []*GetFilterMatchesResponse_Match {
 	if x != nil {
-		return x.Address
+		return x.Matches
 	}
-	return ""
+	return nil
 }
 
-func (x *GetRawAddressTransactionsRequest) GetNbSkip() uint32 {
+func (x *GetFilterMatchesResponse) GetScannedHeight() int32 {
 	if x != nil {
-		return x.NbSkip
+		return x.ScannedHeight
 	}
 	return 0
 }
 
-func (x *GetRawAddressTransactionsRequest) GetNbFetch() uint32 {
+func (x *GetFilterMatchesResponse) GetScannedHash() []byte {
 	if x != nil {
-		return x.NbFetch
-	}
-	return 0
-}
-
-func (m *GetRawAddressTransactionsRequest) GetStartBlock() isGetRawAddressTransactionsRequest_StartBlock {
-	if m != nil {
-		return m.StartBlock
+		return x.ScannedHash
 	}
 	return nil
 }
 
-func (x *GetRawAddressTransactionsRequest) GetHash() []byte {
-	if x, ok := x.GetStartBlock().(*GetRawAddressTransactionsRequest_Hash); ok {
-		return x.Hash
+func (x *GetFilterMatchesResponse) GetAtTip() bool {
+	if x != nil {
+		return x.AtTip
 	}
-	return nil
+	return false
 }
 
-func (x *GetRawAddressTransactionsRequest) GetHeight() int32 {
-	if x, ok := x.GetStartBlock().(*GetRawAddressTransactionsRequest_Height); ok {
-		return x.Height
+func (x *GetFilterMatchesResponse) GetPrunedHeight() int32 {
+	if x != nil {
+		return x.PrunedHeight
 	}
 	return 0
 }
 
-type isGetRawAddressTransactionsRequest_StartBlock interface {
-	isGetRawAddressTransactionsRequest_StartBlock()
-}
-
-type GetRawAddressTransactionsRequest_Hash struct {
-	// Recommended. Only return transactions after some starting block
-	// identified by hash, little-endian.
-	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3,oneof"`
-}
-
-type GetRawAddressTransactionsRequest_Height struct {
-	// Recommended. Only return transactions after some starting block
-	// identified by block number.
-	Height int32 `protobuf:"varint,5,opt,name=height,proto3,oneof"`
-}
-
-func (*GetRawAddressTransactionsRequest_Hash) isGetRawAddressTransactionsRequest_StartBlock() {}
-
-func (*GetRawAddressTransactionsRequest_Height) isGetRawAddressTransactionsRequest_StartBlock() {}
-
-type GetRawAddressTransactionsResponse struct {
+// Request headers using a list of known block hashes.
+type GetHeadersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Transactions that have been included in a block.
-	ConfirmedTransactions [][]byte `protobuf:"bytes,1,rep,name=confirmed_transactions,json=confirmedTransactions,proto3" json:"confirmed_transactions,omitempty"`
-	// Transactions in mempool which have not been included in a block.
-	UnconfirmedTransactions [][]byte `protobuf:"bytes,2,rep,name=unconfirmed_transactions,json=unconfirmedTransactions,proto3" json:"unconfirmed_transactions,omitempty"`
+	// A list of block hashes known to the client (most recent first) which
+	// is exponentially sparser toward the genesis block (0), little-endian.
+	// Common practice is to include all of the last 10 blocks, and then
+	// 9 blocks for each order of ten thereafter.
+	BlockLocatorHashes [][]byte `protobuf:"bytes,1,rep,name=block_locator_hashes,json=blockLocatorHashes,proto3" json:"block_locator_hashes,omitempty"`
+	// hash of the latest desired block header, little-endian; only blocks
+	// occurring before the stop will be returned.
+	StopHash []byte `protobuf:"bytes,2,opt,name=stop_hash,json=stopHash,proto3" json:"stop_hash,omitempty"`
 }
 
-func (x *GetRawAddressTransactionsResponse) Reset() {
-	*x = GetRawAddressTransactionsResponse{}
+func (x *GetHeadersRequest) Reset() {
+	*x = GetHeadersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[23]
+		mi := &file_bchrpc_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetRawAddressTransactionsResponse) String() string {
+func (x *GetHeadersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRawAddressTransactionsResponse) ProtoMessage() {}
+func (*GetHeadersRequest) ProtoMessage() {}
 
-func (x *GetRawAddressTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[23]
+func (x *GetHeadersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1976,56 +2393,51 @@ func (x *GetRawAddressTransactionsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRawAddressTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*GetRawAddressTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetHeadersRequest.ProtoReflect.Descriptor instead.
+func (*GetHeadersRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetRawAddressTransactionsResponse) GetConfirmedTransactions() [][]byte {
+func (x *GetHeadersRequest) GetBlockLocatorHashes() [][]byte {
 	if x != nil {
-		return x.ConfirmedTransactions
+		return x.BlockLocatorHashes
 	}
 	return nil
 }
 
-func (x *GetRawAddressTransactionsResponse) GetUnconfirmedTransactions() [][]byte {
+func (x *GetHeadersRequest) GetStopHash() []byte {
 	if x != nil {
-		return x.UnconfirmedTransactions
+		return x.StopHash
 	}
 	return nil
 }
 
-type GetAddressUnspentOutputsRequest struct {
+type GetHeadersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The address to query transactions, in lowercase cashaddr format.
-	// The network identifier is optional (i.e. "cashaddress:").
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// When `include_mempool` is true, unconfirmed transactions from mempool
-	// are returned. Default is false.
-	IncludeMempool       bool `protobuf:"varint,2,opt,name=include_mempool,json=includeMempool,proto3" json:"include_mempool,omitempty"`
-	IncludeTokenMetadata bool `protobuf:"varint,3,opt,name=include_token_metadata,json=includeTokenMetadata,proto3" json:"include_token_metadata,omitempty"`
+	// List of block headers.
+	Headers []*BlockInfo `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
 }
 
-func (x *GetAddressUnspentOutputsRequest) Reset() {
-	*x = GetAddressUnspentOutputsRequest{}
+func (x *GetHeadersResponse) Reset() {
+	*x = GetHeadersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[24]
+		mi := &file_bchrpc_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAddressUnspentOutputsRequest) String() string {
+func (x *GetHeadersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAddressUnspentOutputsRequest) ProtoMessage() {}
+func (*GetHeadersResponse) ProtoMessage() {}
 
-func (x *GetAddressUnspentOutputsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[24]
+func (x *GetHeadersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2036,59 +2448,46 @@ func (x *GetAddressUnspentOutputsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAddressUnspentOutputsRequest.ProtoReflect.Descriptor instead.
-func (*GetAddressUnspentOutputsRequest) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *GetAddressUnspentOutputsRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *GetAddressUnspentOutputsRequest) GetIncludeMempool() bool {
-	if x != nil {
-		return x.IncludeMempool
-	}
-	return false
+// Deprecated: Use GetHeadersResponse.ProtoReflect.Descriptor instead.
+func (*GetHeadersResponse) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetAddressUnspentOutputsRequest) GetIncludeTokenMetadata() bool {
+func (x *GetHeadersResponse) GetHeaders() []*BlockInfo {
 	if x != nil {
-		return x.IncludeTokenMetadata
+		return x.Headers
 	}
-	return false
+	return nil
 }
 
-type GetAddressUnspentOutputsResponse struct {
+// Get a transaction from a transaction hash.
+type GetTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of unspent outputs.
-	Outputs       []*UnspentOutput    `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs,omitempty"`
-	TokenMetadata []*SlpTokenMetadata `protobuf:"bytes,2,rep,name=token_metadata,json=tokenMetadata,proto3" json:"token_metadata,omitempty"`
+	// A transaction hash, little-endian.
+	Hash                 []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	IncludeTokenMetadata bool   `protobuf:"varint,2,opt,name=include_token_metadata,json=includeTokenMetadata,proto3" json:"include_token_metadata,omitempty"`
 }
 
-func (x *GetAddressUnspentOutputsResponse) Reset() {
-	*x = GetAddressUnspentOutputsResponse{}
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[25]
+		mi := &file_bchrpc_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAddressUnspentOutputsResponse) String() string {
+func (x *GetTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAddressUnspentOutputsResponse) ProtoMessage() {}
+func (*GetTransactionRequest) ProtoMessage() {}
 
-func (x *GetAddressUnspentOutputsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[25]
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2099,57 +2498,52 @@ func (x *GetAddressUnspentOutputsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAddressUnspentOutputsResponse.ProtoReflect.Descriptor instead.
-func (*GetAddressUnspentOutputsResponse) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetAddressUnspentOutputsResponse) GetOutputs() []*UnspentOutput {
+func (x *GetTransactionRequest) GetHash() []byte {
 	if x != nil {
-		return x.Outputs
+		return x.Hash
 	}
 	return nil
 }
 
-func (x *GetAddressUnspentOutputsResponse) GetTokenMetadata() []*SlpTokenMetadata {
+func (x *GetTransactionRequest) GetIncludeTokenMetadata() bool {
 	if x != nil {
-		return x.TokenMetadata
+		return x.IncludeTokenMetadata
 	}
-	return nil
+	return false
 }
 
-type GetUnspentOutputRequest struct {
+type GetTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The hash of the transaction, little-endian.
-	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	// The number of the output, starting from zero.
-	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	// When include_mempool is true, unconfirmed transactions from mempool
-	// are returned. Default is false.
-	IncludeMempool       bool `protobuf:"varint,3,opt,name=include_mempool,json=includeMempool,proto3" json:"include_mempool,omitempty"`
-	IncludeTokenMetadata bool `protobuf:"varint,4,opt,name=include_token_metadata,json=includeTokenMetadata,proto3" json:"include_token_metadata,omitempty"`
+	// A marshaled transaction.
+	Transaction   *Transaction      `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	TokenMetadata *SlpTokenMetadata `protobuf:"bytes,2,opt,name=token_metadata,json=tokenMetadata,proto3" json:"token_metadata,omitempty"`
 }
 
-func (x *GetUnspentOutputRequest) Reset() {
-	*x = GetUnspentOutputRequest{}
+func (x *GetTransactionResponse) Reset() {
+	*x = GetTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[26]
+		mi := &file_bchrpc_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUnspentOutputRequest) String() string {
+func (x *GetTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUnspentOutputRequest) ProtoMessage() {}
+func (*GetTransactionResponse) ProtoMessage() {}
 
-func (x *GetUnspentOutputRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[26]
+func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2160,77 +2554,52 @@ func (x *GetUnspentOutputRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUnspentOutputRequest.ProtoReflect.Descriptor instead.
-func (*GetUnspentOutputRequest) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetUnspentOutputRequest) GetHash() []byte {
+func (x *GetTransactionResponse) GetTransaction() *Transaction {
 	if x != nil {
-		return x.Hash
+		return x.Transaction
 	}
 	return nil
 }
 
-func (x *GetUnspentOutputRequest) GetIndex() uint32 {
-	if x != nil {
-		return x.Index
-	}
-	return 0
-}
-
-func (x *GetUnspentOutputRequest) GetIncludeMempool() bool {
-	if x != nil {
-		return x.IncludeMempool
-	}
-	return false
-}
-
-func (x *GetUnspentOutputRequest) GetIncludeTokenMetadata() bool {
+func (x *GetTransactionResponse) GetTokenMetadata() *SlpTokenMetadata {
 	if x != nil {
-		return x.IncludeTokenMetadata
+		return x.TokenMetadata
 	}
-	return false
+	return nil
 }
 
-type GetUnspentOutputResponse struct {
+// Get an encoded transaction from a transaction hash.
+type GetRawTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A reference to the related input.
-	Outpoint *Transaction_Input_Outpoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
-	// Locking script dictating how funds can be spent in the future
-	PubkeyScript []byte `protobuf:"bytes,2,opt,name=pubkey_script,json=pubkeyScript,proto3" json:"pubkey_script,omitempty"`
-	// Amount in satoshi.
-	Value int64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
-	// When is_coinbase is true, the transaction was the first in a block,
-	// created by a miner, and used to pay the block reward
-	IsCoinbase bool `protobuf:"varint,4,opt,name=is_coinbase,json=isCoinbase,proto3" json:"is_coinbase,omitempty"`
-	// The index number of the block containing the transaction creating the output.
-	BlockHeight   int32             `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	SlpToken      *SlpToken         `protobuf:"bytes,6,opt,name=slp_token,json=slpToken,proto3" json:"slp_token,omitempty"`
-	TokenMetadata *SlpTokenMetadata `protobuf:"bytes,7,opt,name=token_metadata,json=tokenMetadata,proto3" json:"token_metadata,omitempty"`
-	CashToken     *CashToken        `protobuf:"bytes,8,opt,name=cash_token,json=cashToken,proto3" json:"cash_token,omitempty"`
+	// A transaction hash, little-endian.
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (x *GetUnspentOutputResponse) Reset() {
-	*x = GetUnspentOutputResponse{}
+func (x *GetRawTransactionRequest) Reset() {
+	*x = GetRawTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[27]
+		mi := &file_bchrpc_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUnspentOutputResponse) String() string {
+func (x *GetRawTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUnspentOutputResponse) ProtoMessage() {}
+func (*GetRawTransactionRequest) ProtoMessage() {}
 
-func (x *GetUnspentOutputResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[27]
+func (x *GetRawTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2241,93 +2610,44 @@ func (x *GetUnspentOutputResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUnspentOutputResponse.ProtoReflect.Descriptor instead.
-func (*GetUnspentOutputResponse) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *GetUnspentOutputResponse) GetOutpoint() *Transaction_Input_Outpoint {
-	if x != nil {
-		return x.Outpoint
-	}
-	return nil
-}
-
-func (x *GetUnspentOutputResponse) GetPubkeyScript() []byte {
-	if x != nil {
-		return x.PubkeyScript
-	}
-	return nil
-}
-
-func (x *GetUnspentOutputResponse) GetValue() int64 {
-	if x != nil {
-		return x.Value
-	}
-	return 0
-}
-
-func (x *GetUnspentOutputResponse) GetIsCoinbase() bool {
-	if x != nil {
-		return x.IsCoinbase
-	}
-	return false
-}
-
-func (x *GetUnspentOutputResponse) GetBlockHeight() int32 {
-	if x != nil {
-		return x.BlockHeight
-	}
-	return 0
-}
-
-func (x *GetUnspentOutputResponse) GetSlpToken() *SlpToken {
-	if x != nil {
-		return x.SlpToken
-	}
-	return nil
-}
-
-func (x *GetUnspentOutputResponse) GetTokenMetadata() *SlpTokenMetadata {
-	if x != nil {
-		return x.TokenMetadata
-	}
-	return nil
+// Deprecated: Use GetRawTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetRawTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetUnspentOutputResponse) GetCashToken() *CashToken {
+func (x *GetRawTransactionRequest) GetHash() []byte {
 	if x != nil {
-		return x.CashToken
+		return x.Hash
 	}
 	return nil
 }
 
-type GetMerkleProofRequest struct {
+type GetRawTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A transaction hash, little-endian.
-	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	// Raw transaction in bytes.
+	Transaction []byte `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
 }
 
-func (x *GetMerkleProofRequest) Reset() {
-	*x = GetMerkleProofRequest{}
+func (x *GetRawTransactionResponse) Reset() {
+	*x = GetRawTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[28]
+		mi := &file_bchrpc_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMerkleProofRequest) String() string {
+func (x *GetRawTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMerkleProofRequest) ProtoMessage() {}
+func (*GetRawTransactionResponse) ProtoMessage() {}
 
-func (x *GetMerkleProofRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[28]
+func (x *GetRawTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2338,52 +2658,48 @@ func (x *GetMerkleProofRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMerkleProofRequest.ProtoReflect.Descriptor instead.
-func (*GetMerkleProofRequest) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetRawTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetRawTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetMerkleProofRequest) GetTransactionHash() []byte {
+func (x *GetRawTransactionResponse) GetTransaction() []byte {
 	if x != nil {
-		return x.TransactionHash
+		return x.Transaction
 	}
 	return nil
 }
 
-type GetMerkleProofResponse struct {
+type GetTransactionConfirmationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Block header information for the corresponding transaction
-	Block *BlockInfo `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
-	// A list containing the transaction hash, the adjacent leaf transaction hash
-	// and the hashes of the highest nodes in the merkle tree not built with the transaction.
-	// Proof hashes are ordered following transaction order, or left to right on the merkle tree
-	Hashes [][]byte `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
-	// Binary representing the location of the matching transaction in the full merkle tree,
-	// starting with the root (`1`) at position/level 0, where `1` corresponds
-	// to a left branch and `01` is a right branch.
-	Flags []byte `protobuf:"bytes,3,opt,name=flags,proto3" json:"flags,omitempty"`
+	// A transaction hash, little-endian.
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// The number of confirmations from which a transaction is considered
+	// final. If zero, the default of 10 blocks is used, which is how deep
+	// node implementations with rolling checkpoints refuse to reorganize.
+	FinalizationDepth uint32 `protobuf:"varint,2,opt,name=finalization_depth,json=finalizationDepth,proto3" json:"finalization_depth,omitempty"`
 }
 
-func (x *GetMerkleProofResponse) Reset() {
-	*x = GetMerkleProofResponse{}
+func (x *GetTransactionConfirmationsRequest) Reset() {
+	*x = GetTransactionConfirmationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[29]
+		mi := &file_bchrpc_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMerkleProofResponse) String() string {
+func (x *GetTransactionConfirmationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMerkleProofResponse) ProtoMessage() {}
+func (*GetTransactionConfirmationsRequest) ProtoMessage() {}
 
-func (x *GetMerkleProofResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[29]
+func (x *GetTransactionConfirmationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2394,60 +2710,69 @@ func (x *GetMerkleProofResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMerkleProofResponse.ProtoReflect.Descriptor instead.
-func (*GetMerkleProofResponse) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *GetMerkleProofResponse) GetBlock() *BlockInfo {
-	if x != nil {
-		return x.Block
-	}
-	return nil
+// Deprecated: Use GetTransactionConfirmationsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionConfirmationsRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetMerkleProofResponse) GetHashes() [][]byte {
+func (x *GetTransactionConfirmationsRequest) GetHash() []byte {
 	if x != nil {
-		return x.Hashes
+		return x.Hash
 	}
 	return nil
 }
 
-func (x *GetMerkleProofResponse) GetFlags() []byte {
+func (x *GetTransactionConfirmationsRequest) GetFinalizationDepth() uint32 {
 	if x != nil {
-		return x.Flags
+		return x.FinalizationDepth
 	}
-	return nil
+	return 0
 }
 
-type SubmitTransactionRequest struct {
+type GetTransactionConfirmationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The encoded transaction.
-	Transaction          []byte             `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	SkipSlpValidityCheck bool               `protobuf:"varint,2,opt,name=skip_slp_validity_check,json=skipSlpValidityCheck,proto3" json:"skip_slp_validity_check,omitempty"`
-	RequiredSlpBurns     []*SlpRequiredBurn `protobuf:"bytes,3,rep,name=required_slp_burns,json=requiredSlpBurns,proto3" json:"required_slp_burns,omitempty"`
-}
-
-func (x *SubmitTransactionRequest) Reset() {
-	*x = SubmitTransactionRequest{}
+	// Whether the transaction is unconfirmed and in the mempool.
+	InMempool bool `protobuf:"varint,1,opt,name=in_mempool,json=inMempool,proto3" json:"in_mempool,omitempty"`
+	// The number of confirmations of the transaction, zero when unconfirmed.
+	Confirmations int32 `protobuf:"varint,2,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	// The hash of the block including the transaction, little-endian.
+	BlockHash []byte `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// The height of the block including the transaction.
+	BlockHeight int32 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Whether the transaction has at least finalization_depth confirmations
+	// or is included in a block at or before the last checkpoint.
+	Finalized bool `protobuf:"varint,5,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	// The finalization depth used.
+	FinalizationDepth uint32 `protobuf:"varint,6,opt,name=finalization_depth,json=finalizationDepth,proto3" json:"finalization_depth,omitempty"`
+	// The hashes of the transactions seen spending the same outputs as the
+	// transaction, little-endian. A recently seen conflicting transaction
+	// is a warning sign of a double spend attempt.
+	ConflictingHashes [][]byte `protobuf:"bytes,7,rep,name=conflicting_hashes,json=conflictingHashes,proto3" json:"conflicting_hashes,omitempty"`
+	// The number of logged chain reorganizations which disconnected a block
+	// including the transaction without the new chain including it too.
+	ReorgCount uint32 `protobuf:"varint,8,opt,name=reorg_count,json=reorgCount,proto3" json:"reorg_count,omitempty"`
+}
+
+func (x *GetTransactionConfirmationsResponse) Reset() {
+	*x = GetTransactionConfirmationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[30]
+		mi := &file_bchrpc_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmitTransactionRequest) String() string {
+func (x *GetTransactionConfirmationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitTransactionRequest) ProtoMessage() {}
+func (*GetTransactionConfirmationsResponse) ProtoMessage() {}
 
-func (x *SubmitTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[30]
+func (x *GetTransactionConfirmationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2458,107 +2783,103 @@ func (x *SubmitTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitTransactionRequest.ProtoReflect.Descriptor instead.
-func (*SubmitTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetTransactionConfirmationsResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionConfirmationsResponse) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SubmitTransactionRequest) GetTransaction() []byte {
+func (x *GetTransactionConfirmationsResponse) GetInMempool() bool {
 	if x != nil {
-		return x.Transaction
+		return x.InMempool
 	}
-	return nil
+	return false
 }
 
-func (x *SubmitTransactionRequest) GetSkipSlpValidityCheck() bool {
+func (x *GetTransactionConfirmationsResponse) GetConfirmations() int32 {
 	if x != nil {
-		return x.SkipSlpValidityCheck
+		return x.Confirmations
 	}
-	return false
+	return 0
 }
 
-func (x *SubmitTransactionRequest) GetRequiredSlpBurns() []*SlpRequiredBurn {
+func (x *GetTransactionConfirmationsResponse) GetBlockHash() []byte {
 	if x != nil {
-		return x.RequiredSlpBurns
+		return x.BlockHash
 	}
 	return nil
 }
 
-type SubmitTransactionResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetTransactionConfirmationsResponse) GetBlockHeight() int32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
 
-	// Transaction hash, little-endian.
-	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+func (x *GetTransactionConfirmationsResponse) GetFinalized() bool {
+	if x != nil {
+		return x.Finalized
+	}
+	return false
 }
 
-func (x *SubmitTransactionResponse) Reset() {
-	*x = SubmitTransactionResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[31]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetTransactionConfirmationsResponse) GetFinalizationDepth() uint32 {
+	if x != nil {
+		return x.FinalizationDepth
 	}
+	return 0
 }
 
-func (x *SubmitTransactionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetTransactionConfirmationsResponse) GetConflictingHashes() [][]byte {
+	if x != nil {
+		return x.ConflictingHashes
+	}
+	return nil
 }
 
-func (*SubmitTransactionResponse) ProtoMessage() {}
+func (x *GetTransactionConfirmationsResponse) GetReorgCount() uint32 {
+	if x != nil {
+		return x.ReorgCount
+	}
+	return 0
+}
 
-func (x *SubmitTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[31]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SubmitTransactionResponse.ProtoReflect.Descriptor instead.
-func (*SubmitTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *SubmitTransactionResponse) GetHash() []byte {
-	if x != nil {
-		return x.Hash
-	}
-	return nil
-}
-
-type CheckSlpTransactionRequest struct {
+// Get marshaled transactions related to a specific address.
+//
+// RECOMMENDED:
+// Parameters have been provided to query without creating
+//   performance issues on the node or client.
+//
+// - The number of transactions to skip and fetch allow for iterating
+//       over a large set of transactions, if necessary.
+//
+// - A starting block parameter (either `hash` or `height`)
+//       may then be used to filter results to those occurring
+//       after a certain time.
+//
+// This approach will reduce network traffic and response processing
+//   for the client, as well as reduce workload on the node.
+type GetAddressTransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transaction      []byte             `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	RequiredSlpBurns []*SlpRequiredBurn `protobuf:"bytes,2,rep,name=required_slp_burns,json=requiredSlpBurns,proto3" json:"required_slp_burns,omitempty"`
-	// Using the slp specification as a basis for validity judgement can lead to confusion for new users and
-	// result in accidental token burns.  use_spec_validity_judgement will cause the response's is_valid property
-	// to be returned according to the slp specification.  Therefore, use_spec_validity_judgement is false by
-	// default in order to avoid accidental token burns.  When use_spec_validity_judgement is false we return
-	// invalid in any case which would result in a burned token, unless the burn is explicitly included as an
-	// item in required_slp_burns property.
-	//
-	// When use_spec_validity_judgement is true, there are three cases where the is_valid response property
-	// will be returned as valid, instead of invalid, as per the slp specification.
-	//   1) inputs > outputs
-	//   2) missing transaction outputs
-	//   3) burned inputs from other tokens
-	//
-	// required_slp_burns is not used when use_spec_validity_judgement is set to true.
-	//
-	UseSpecValidityJudgement bool `protobuf:"varint,3,opt,name=use_spec_validity_judgement,json=useSpecValidityJudgement,proto3" json:"use_spec_validity_judgement,omitempty"`
+	// The address to query transactions, in lowercase cashaddr format.
+	// The network prefix is optional (i.e. "cashaddress:").
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The number of confirmed transactions to skip, starting with the oldest first.
+	// Does not affect results of unconfirmed transactions.
+	NbSkip uint32 `protobuf:"varint,2,opt,name=nb_skip,json=nbSkip,proto3" json:"nb_skip,omitempty"`
+	// Specify the number of transactions to fetch.
+	NbFetch uint32 `protobuf:"varint,3,opt,name=nb_fetch,json=nbFetch,proto3" json:"nb_fetch,omitempty"`
+	// Types that are assignable to StartBlock:
+	//	*GetAddressTransactionsRequest_Hash
+	//	*GetAddressTransactionsRequest_Height
+	StartBlock isGetAddressTransactionsRequest_StartBlock `protobuf_oneof:"start_block"`
 }
 
-func (x *CheckSlpTransactionRequest) Reset() {
-	*x = CheckSlpTransactionRequest{}
+func (x *GetAddressTransactionsRequest) Reset() {
+	*x = GetAddressTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2566,13 +2887,13 @@ func (x *CheckSlpTransactionRequest) Reset() {
 	}
 }
 
-func (x *CheckSlpTransactionRequest) String() string {
+func (x *GetAddressTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckSlpTransactionRequest) ProtoMessage() {}
+func (*GetAddressTransactionsRequest) ProtoMessage() {}
 
-func (x *CheckSlpTransactionRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAddressTransactionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2584,44 +2905,86 @@ func (x *CheckSlpTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckSlpTransactionRequest.ProtoReflect.Descriptor instead.
-func (*CheckSlpTransactionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAddressTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAddressTransactionsRequest) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *CheckSlpTransactionRequest) GetTransaction() []byte {
+func (x *GetAddressTransactionsRequest) GetAddress() string {
 	if x != nil {
-		return x.Transaction
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *CheckSlpTransactionRequest) GetRequiredSlpBurns() []*SlpRequiredBurn {
+func (x *GetAddressTransactionsRequest) GetNbSkip() uint32 {
 	if x != nil {
-		return x.RequiredSlpBurns
+		return x.NbSkip
 	}
-	return nil
+	return 0
 }
 
-func (x *CheckSlpTransactionRequest) GetUseSpecValidityJudgement() bool {
+func (x *GetAddressTransactionsRequest) GetNbFetch() uint32 {
 	if x != nil {
-		return x.UseSpecValidityJudgement
+		return x.NbFetch
 	}
-	return false
+	return 0
 }
 
-type CheckSlpTransactionResponse struct {
+func (m *GetAddressTransactionsRequest) GetStartBlock() isGetAddressTransactionsRequest_StartBlock {
+	if m != nil {
+		return m.StartBlock
+	}
+	return nil
+}
+
+func (x *GetAddressTransactionsRequest) GetHash() []byte {
+	if x, ok := x.GetStartBlock().(*GetAddressTransactionsRequest_Hash); ok {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *GetAddressTransactionsRequest) GetHeight() int32 {
+	if x, ok := x.GetStartBlock().(*GetAddressTransactionsRequest_Height); ok {
+		return x.Height
+	}
+	return 0
+}
+
+type isGetAddressTransactionsRequest_StartBlock interface {
+	isGetAddressTransactionsRequest_StartBlock()
+}
+
+type GetAddressTransactionsRequest_Hash struct {
+	// Recommended. Only get transactions after (or within) a
+	// starting block identified by hash, little-endian.
+	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3,oneof"`
+}
+
+type GetAddressTransactionsRequest_Height struct {
+	// Recommended. Only get transactions after (or within) a
+	// starting block identified by block number.
+	Height int32 `protobuf:"varint,5,opt,name=height,proto3,oneof"`
+}
+
+func (*GetAddressTransactionsRequest_Hash) isGetAddressTransactionsRequest_StartBlock() {}
+
+func (*GetAddressTransactionsRequest_Height) isGetAddressTransactionsRequest_StartBlock() {}
+
+type GetAddressTransactionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsValid       bool   `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
-	InvalidReason string `protobuf:"bytes,2,opt,name=invalid_reason,json=invalidReason,proto3" json:"invalid_reason,omitempty"`
-	BestHeight    int32  `protobuf:"varint,3,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
+	// Transactions that have been included in a block.
+	ConfirmedTransactions []*Transaction `protobuf:"bytes,1,rep,name=confirmed_transactions,json=confirmedTransactions,proto3" json:"confirmed_transactions,omitempty"`
+	// Transactions in mempool which have not been included in a block.
+	UnconfirmedTransactions []*MempoolTransaction `protobuf:"bytes,2,rep,name=unconfirmed_transactions,json=unconfirmedTransactions,proto3" json:"unconfirmed_transactions,omitempty"`
 }
 
-func (x *CheckSlpTransactionResponse) Reset() {
-	*x = CheckSlpTransactionResponse{}
+func (x *GetAddressTransactionsResponse) Reset() {
+	*x = GetAddressTransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2629,13 +2992,13 @@ func (x *CheckSlpTransactionResponse) Reset() {
 	}
 }
 
-func (x *CheckSlpTransactionResponse) String() string {
+func (x *GetAddressTransactionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckSlpTransactionResponse) ProtoMessage() {}
+func (*GetAddressTransactionsResponse) ProtoMessage() {}
 
-func (x *CheckSlpTransactionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAddressTransactionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2647,56 +3010,61 @@ func (x *CheckSlpTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckSlpTransactionResponse.ProtoReflect.Descriptor instead.
-func (*CheckSlpTransactionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAddressTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetAddressTransactionsResponse) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *CheckSlpTransactionResponse) GetIsValid() bool {
-	if x != nil {
-		return x.IsValid
-	}
-	return false
-}
-
-func (x *CheckSlpTransactionResponse) GetInvalidReason() string {
+func (x *GetAddressTransactionsResponse) GetConfirmedTransactions() []*Transaction {
 	if x != nil {
-		return x.InvalidReason
+		return x.ConfirmedTransactions
 	}
-	return ""
+	return nil
 }
 
-func (x *CheckSlpTransactionResponse) GetBestHeight() int32 {
+func (x *GetAddressTransactionsResponse) GetUnconfirmedTransactions() []*MempoolTransaction {
 	if x != nil {
-		return x.BestHeight
+		return x.UnconfirmedTransactions
 	}
-	return 0
+	return nil
 }
 
-// Request to subscribe or unsubscribe from a stream of transactions.
-type SubscribeTransactionsRequest struct {
+// Get encoded transactions related to a specific address.
+//
+// RECOMMENDED:
+// Parameters have been provided to query without creating
+//   performance issues on the node or client.
+//
+// - The number of transactions to skip and fetch allow for iterating
+//       over a large set of transactions, if necessary.
+//
+// - A starting block parameter (either `hash` or `height`)
+//       may then be used to filter results to those occurring
+//       after a certain time.
+//
+// This approach will reduce network traffic and response processing
+//   for the client, as well as reduce workload on the node.
+type GetRawAddressTransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Subscribe to a filter. add items to a filter
-	Subscribe *TransactionFilter `protobuf:"bytes,1,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
-	// Unsubscribe to a filter, remove items from a filter
-	Unsubscribe *TransactionFilter `protobuf:"bytes,2,opt,name=unsubscribe,proto3" json:"unsubscribe,omitempty"`
-	// When include_mempool is true, new unconfirmed transactions from mempool are
-	// included apart from the ones confirmed in a block.
-	IncludeMempool bool `protobuf:"varint,3,opt,name=include_mempool,json=includeMempool,proto3" json:"include_mempool,omitempty"`
-	// When include_in_block is true, transactions are included when they are confirmed.
-	// This notification is sent in addition to any requested mempool notifications.
-	IncludeInBlock bool `protobuf:"varint,4,opt,name=include_in_block,json=includeInBlock,proto3" json:"include_in_block,omitempty"`
-	// When serialize_tx is true, transactions are serialized using
-	// bitcoin protocol encoding. Default is false, transaction will be Marshaled
-	// (see `Transaction`, `MempoolTransaction` and `TransactionNotification`)
-	SerializeTx bool `protobuf:"varint,5,opt,name=serialize_tx,json=serializeTx,proto3" json:"serialize_tx,omitempty"`
+	// The address to query transactions, in lowercase cashaddr format.
+	// The network prefix is optional (i.e. "cashaddress:").
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The number of confirmed transactions to skip, starting with the oldest first.
+	// Does not affect results of unconfirmed transactions.
+	NbSkip uint32 `protobuf:"varint,2,opt,name=nb_skip,json=nbSkip,proto3" json:"nb_skip,omitempty"`
+	// Specify the number of transactions to fetch.
+	NbFetch uint32 `protobuf:"varint,3,opt,name=nb_fetch,json=nbFetch,proto3" json:"nb_fetch,omitempty"`
+	// Types that are assignable to StartBlock:
+	//	*GetRawAddressTransactionsRequest_Hash
+	//	*GetRawAddressTransactionsRequest_Height
+	StartBlock isGetRawAddressTransactionsRequest_StartBlock `protobuf_oneof:"start_block"`
 }
 
-func (x *SubscribeTransactionsRequest) Reset() {
-	*x = SubscribeTransactionsRequest{}
+func (x *GetRawAddressTransactionsRequest) Reset() {
+	*x = GetRawAddressTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2704,13 +3072,13 @@ func (x *SubscribeTransactionsRequest) Reset() {
 	}
 }
 
-func (x *SubscribeTransactionsRequest) String() string {
+func (x *GetRawAddressTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeTransactionsRequest) ProtoMessage() {}
+func (*GetRawAddressTransactionsRequest) ProtoMessage() {}
 
-func (x *SubscribeTransactionsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRawAddressTransactionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2722,84 +3090,86 @@ func (x *SubscribeTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeTransactionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRawAddressTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetRawAddressTransactionsRequest) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *SubscribeTransactionsRequest) GetSubscribe() *TransactionFilter {
+func (x *GetRawAddressTransactionsRequest) GetAddress() string {
 	if x != nil {
-		return x.Subscribe
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *SubscribeTransactionsRequest) GetUnsubscribe() *TransactionFilter {
+func (x *GetRawAddressTransactionsRequest) GetNbSkip() uint32 {
 	if x != nil {
-		return x.Unsubscribe
+		return x.NbSkip
 	}
-	return nil
+	return 0
 }
 
-func (x *SubscribeTransactionsRequest) GetIncludeMempool() bool {
+func (x *GetRawAddressTransactionsRequest) GetNbFetch() uint32 {
 	if x != nil {
-		return x.IncludeMempool
+		return x.NbFetch
 	}
-	return false
+	return 0
 }
 
-func (x *SubscribeTransactionsRequest) GetIncludeInBlock() bool {
-	if x != nil {
-		return x.IncludeInBlock
+func (m *GetRawAddressTransactionsRequest) GetStartBlock() isGetRawAddressTransactionsRequest_StartBlock {
+	if m != nil {
+		return m.StartBlock
 	}
-	return false
+	return nil
 }
 
-func (x *SubscribeTransactionsRequest) GetSerializeTx() bool {
-	if x != nil {
-		return x.SerializeTx
+func (x *GetRawAddressTransactionsRequest) GetHash() []byte {
+	if x, ok := x.GetStartBlock().(*GetRawAddressTransactionsRequest_Hash); ok {
+		return x.Hash
 	}
-	return false
+	return nil
 }
 
-// Options to define data structure to be sent by SubscribeBlock stream:
-//
-//  - BlockInfo (block metadata): `BlockInfo`
-//      - SubscribeBlocksRequest {}
-//
-//  - Marshaled Block (with transaction hashes): `Block`
-//      - SubscribeBlocksRequest {
-//            full_block = true
-//        }
-//  - Marshaled Block (with full transaction data): `Block`
-//      - SubscribeBlocksRequest {
-//            full_block = true
-//            full_transactions = true
-//        }
-//  - Serialized Block acccording to bitcoin protocol encoding: `bytes`
-//      - SubscribeBlocksRequest {
-//            serialize_block = true
-//        }
-type SubscribeBlocksRequest struct {
+func (x *GetRawAddressTransactionsRequest) GetHeight() int32 {
+	if x, ok := x.GetStartBlock().(*GetRawAddressTransactionsRequest_Height); ok {
+		return x.Height
+	}
+	return 0
+}
+
+type isGetRawAddressTransactionsRequest_StartBlock interface {
+	isGetRawAddressTransactionsRequest_StartBlock()
+}
+
+type GetRawAddressTransactionsRequest_Hash struct {
+	// Recommended. Only return transactions after some starting block
+	// identified by hash, little-endian.
+	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3,oneof"`
+}
+
+type GetRawAddressTransactionsRequest_Height struct {
+	// Recommended. Only return transactions after some starting block
+	// identified by block number.
+	Height int32 `protobuf:"varint,5,opt,name=height,proto3,oneof"`
+}
+
+func (*GetRawAddressTransactionsRequest_Hash) isGetRawAddressTransactionsRequest_StartBlock() {}
+
+func (*GetRawAddressTransactionsRequest_Height) isGetRawAddressTransactionsRequest_StartBlock() {}
+
+type GetRawAddressTransactionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// When full_block is true, a complete marshaled block is sent. See `Block`.
-	// Default is false, block metadata is sent. See `BlockInfo`.
-	FullBlock bool `protobuf:"varint,1,opt,name=full_block,json=fullBlock,proto3" json:"full_block,omitempty"`
-	// When full_transactions is true, provide full transaction info
-	// for a marshaled block.
-	// Default is false, only the transaction hashes are included for
-	// a marshaled block. See `TransactionData`.
-	FullTransactions bool `protobuf:"varint,2,opt,name=full_transactions,json=fullTransactions,proto3" json:"full_transactions,omitempty"`
-	// When serialize_block is true, blocks are serialized using bitcoin protocol encoding.
-	// Default is false, block will be Marshaled (see `BlockInfo` and `BlockNotification`)
-	SerializeBlock bool `protobuf:"varint,3,opt,name=serialize_block,json=serializeBlock,proto3" json:"serialize_block,omitempty"`
+	// Transactions that have been included in a block.
+	ConfirmedTransactions [][]byte `protobuf:"bytes,1,rep,name=confirmed_transactions,json=confirmedTransactions,proto3" json:"confirmed_transactions,omitempty"`
+	// Transactions in mempool which have not been included in a block.
+	UnconfirmedTransactions [][]byte `protobuf:"bytes,2,rep,name=unconfirmed_transactions,json=unconfirmedTransactions,proto3" json:"unconfirmed_transactions,omitempty"`
 }
 
-func (x *SubscribeBlocksRequest) Reset() {
-	*x = SubscribeBlocksRequest{}
+func (x *GetRawAddressTransactionsResponse) Reset() {
+	*x = GetRawAddressTransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2807,13 +3177,13 @@ func (x *SubscribeBlocksRequest) Reset() {
 	}
 }
 
-func (x *SubscribeBlocksRequest) String() string {
+func (x *GetRawAddressTransactionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeBlocksRequest) ProtoMessage() {}
+func (*GetRawAddressTransactionsResponse) ProtoMessage() {}
 
-func (x *SubscribeBlocksRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRawAddressTransactionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2825,42 +3195,41 @@ func (x *SubscribeBlocksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeBlocksRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeBlocksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRawAddressTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetRawAddressTransactionsResponse) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *SubscribeBlocksRequest) GetFullBlock() bool {
-	if x != nil {
-		return x.FullBlock
-	}
-	return false
-}
-
-func (x *SubscribeBlocksRequest) GetFullTransactions() bool {
+func (x *GetRawAddressTransactionsResponse) GetConfirmedTransactions() [][]byte {
 	if x != nil {
-		return x.FullTransactions
+		return x.ConfirmedTransactions
 	}
-	return false
+	return nil
 }
 
-func (x *SubscribeBlocksRequest) GetSerializeBlock() bool {
+func (x *GetRawAddressTransactionsResponse) GetUnconfirmedTransactions() [][]byte {
 	if x != nil {
-		return x.SerializeBlock
+		return x.UnconfirmedTransactions
 	}
-	return false
+	return nil
 }
 
-type GetSlpTokenMetadataRequest struct {
+type GetAddressUnspentOutputsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TokenIds [][]byte `protobuf:"bytes,1,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`
+	// The address to query transactions, in lowercase cashaddr format.
+	// The network identifier is optional (i.e. "cashaddress:").
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// When `include_mempool` is true, unconfirmed transactions from mempool
+	// are returned. Default is false.
+	IncludeMempool       bool `protobuf:"varint,2,opt,name=include_mempool,json=includeMempool,proto3" json:"include_mempool,omitempty"`
+	IncludeTokenMetadata bool `protobuf:"varint,3,opt,name=include_token_metadata,json=includeTokenMetadata,proto3" json:"include_token_metadata,omitempty"`
 }
 
-func (x *GetSlpTokenMetadataRequest) Reset() {
-	*x = GetSlpTokenMetadataRequest{}
+func (x *GetAddressUnspentOutputsRequest) Reset() {
+	*x = GetAddressUnspentOutputsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2868,13 +3237,13 @@ func (x *GetSlpTokenMetadataRequest) Reset() {
 	}
 }
 
-func (x *GetSlpTokenMetadataRequest) String() string {
+func (x *GetAddressUnspentOutputsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSlpTokenMetadataRequest) ProtoMessage() {}
+func (*GetAddressUnspentOutputsRequest) ProtoMessage() {}
 
-func (x *GetSlpTokenMetadataRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAddressUnspentOutputsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2886,28 +3255,44 @@ func (x *GetSlpTokenMetadataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSlpTokenMetadataRequest.ProtoReflect.Descriptor instead.
-func (*GetSlpTokenMetadataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAddressUnspentOutputsRequest.ProtoReflect.Descriptor instead.
+func (*GetAddressUnspentOutputsRequest) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetSlpTokenMetadataRequest) GetTokenIds() [][]byte {
+func (x *GetAddressUnspentOutputsRequest) GetAddress() string {
 	if x != nil {
-		return x.TokenIds
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-type GetSlpTokenMetadataResponse struct {
+func (x *GetAddressUnspentOutputsRequest) GetIncludeMempool() bool {
+	if x != nil {
+		return x.IncludeMempool
+	}
+	return false
+}
+
+func (x *GetAddressUnspentOutputsRequest) GetIncludeTokenMetadata() bool {
+	if x != nil {
+		return x.IncludeTokenMetadata
+	}
+	return false
+}
+
+type GetAddressUnspentOutputsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TokenMetadata []*SlpTokenMetadata `protobuf:"bytes,1,rep,name=token_metadata,json=tokenMetadata,proto3" json:"token_metadata,omitempty"`
+	// List of unspent outputs.
+	Outputs       []*UnspentOutput    `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	TokenMetadata []*SlpTokenMetadata `protobuf:"bytes,2,rep,name=token_metadata,json=tokenMetadata,proto3" json:"token_metadata,omitempty"`
 }
 
-func (x *GetSlpTokenMetadataResponse) Reset() {
-	*x = GetSlpTokenMetadataResponse{}
+func (x *GetAddressUnspentOutputsResponse) Reset() {
+	*x = GetAddressUnspentOutputsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2915,13 +3300,13 @@ func (x *GetSlpTokenMetadataResponse) Reset() {
 	}
 }
 
-func (x *GetSlpTokenMetadataResponse) String() string {
+func (x *GetAddressUnspentOutputsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSlpTokenMetadataResponse) ProtoMessage() {}
+func (*GetAddressUnspentOutputsResponse) ProtoMessage() {}
 
-func (x *GetSlpTokenMetadataResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAddressUnspentOutputsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2933,28 +3318,42 @@ func (x *GetSlpTokenMetadataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSlpTokenMetadataResponse.ProtoReflect.Descriptor instead.
-func (*GetSlpTokenMetadataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAddressUnspentOutputsResponse.ProtoReflect.Descriptor instead.
+func (*GetAddressUnspentOutputsResponse) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetSlpTokenMetadataResponse) GetTokenMetadata() []*SlpTokenMetadata {
+func (x *GetAddressUnspentOutputsResponse) GetOutputs() []*UnspentOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *GetAddressUnspentOutputsResponse) GetTokenMetadata() []*SlpTokenMetadata {
 	if x != nil {
 		return x.TokenMetadata
 	}
 	return nil
 }
 
-type GetSlpParsedScriptRequest struct {
+type GetUnspentOutputRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SlpOpreturnScript []byte `protobuf:"bytes,1,opt,name=slp_opreturn_script,json=slpOpreturnScript,proto3" json:"slp_opreturn_script,omitempty"`
+	// The hash of the transaction, little-endian.
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// The number of the output, starting from zero.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// When include_mempool is true, unconfirmed transactions from mempool
+	// are returned. Default is false.
+	IncludeMempool       bool `protobuf:"varint,3,opt,name=include_mempool,json=includeMempool,proto3" json:"include_mempool,omitempty"`
+	IncludeTokenMetadata bool `protobuf:"varint,4,opt,name=include_token_metadata,json=includeTokenMetadata,proto3" json:"include_token_metadata,omitempty"`
 }
 
-func (x *GetSlpParsedScriptRequest) Reset() {
-	*x = GetSlpParsedScriptRequest{}
+func (x *GetUnspentOutputRequest) Reset() {
+	*x = GetUnspentOutputRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2962,13 +3361,13 @@ func (x *GetSlpParsedScriptRequest) Reset() {
 	}
 }
 
-func (x *GetSlpParsedScriptRequest) String() string {
+func (x *GetUnspentOutputRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSlpParsedScriptRequest) ProtoMessage() {}
+func (*GetUnspentOutputRequest) ProtoMessage() {}
 
-func (x *GetSlpParsedScriptRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUnspentOutputRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2980,52 +3379,76 @@ func (x *GetSlpParsedScriptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSlpParsedScriptRequest.ProtoReflect.Descriptor instead.
-func (*GetSlpParsedScriptRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUnspentOutputRequest.ProtoReflect.Descriptor instead.
+func (*GetUnspentOutputRequest) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetSlpParsedScriptRequest) GetSlpOpreturnScript() []byte {
+func (x *GetUnspentOutputRequest) GetHash() []byte {
 	if x != nil {
-		return x.SlpOpreturnScript
+		return x.Hash
 	}
 	return nil
 }
 
-type GetSlpParsedScriptResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetUnspentOutputRequest) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
 
-	ParsingError string       `protobuf:"bytes,1,opt,name=parsing_error,json=parsingError,proto3" json:"parsing_error,omitempty"`
-	TokenId      []byte       `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
-	SlpAction    SlpAction    `protobuf:"varint,3,opt,name=slp_action,json=slpAction,proto3,enum=pb.SlpAction" json:"slp_action,omitempty"`
-	TokenType    SlpTokenType `protobuf:"varint,4,opt,name=token_type,json=tokenType,proto3,enum=pb.SlpTokenType" json:"token_type,omitempty"`
-	// Types that are assignable to SlpMetadata:
-	//	*GetSlpParsedScriptResponse_V1Genesis
-	//	*GetSlpParsedScriptResponse_V1Mint
-	//	*GetSlpParsedScriptResponse_V1Send
-	//	*GetSlpParsedScriptResponse_V1Nft1ChildGenesis
-	//	*GetSlpParsedScriptResponse_V1Nft1ChildSend
-	SlpMetadata isGetSlpParsedScriptResponse_SlpMetadata `protobuf_oneof:"slp_metadata"`
+func (x *GetUnspentOutputRequest) GetIncludeMempool() bool {
+	if x != nil {
+		return x.IncludeMempool
+	}
+	return false
 }
 
-func (x *GetSlpParsedScriptResponse) Reset() {
-	*x = GetSlpParsedScriptResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[39]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetUnspentOutputRequest) GetIncludeTokenMetadata() bool {
+	if x != nil {
+		return x.IncludeTokenMetadata
 	}
+	return false
 }
 
-func (x *GetSlpParsedScriptResponse) String() string {
+type GetUnspentOutputResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A reference to the related input.
+	Outpoint *Transaction_Input_Outpoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// Locking script dictating how funds can be spent in the future
+	PubkeyScript []byte `protobuf:"bytes,2,opt,name=pubkey_script,json=pubkeyScript,proto3" json:"pubkey_script,omitempty"`
+	// Amount in satoshi.
+	Value int64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	// When is_coinbase is true, the transaction was the first in a block,
+	// created by a miner, and used to pay the block reward
+	IsCoinbase bool `protobuf:"varint,4,opt,name=is_coinbase,json=isCoinbase,proto3" json:"is_coinbase,omitempty"`
+	// The index number of the block containing the transaction creating the output.
+	BlockHeight   int32             `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	SlpToken      *SlpToken         `protobuf:"bytes,6,opt,name=slp_token,json=slpToken,proto3" json:"slp_token,omitempty"`
+	TokenMetadata *SlpTokenMetadata `protobuf:"bytes,7,opt,name=token_metadata,json=tokenMetadata,proto3" json:"token_metadata,omitempty"`
+	CashToken     *CashToken        `protobuf:"bytes,8,opt,name=cash_token,json=cashToken,proto3" json:"cash_token,omitempty"`
+}
+
+func (x *GetUnspentOutputResponse) Reset() {
+	*x = GetUnspentOutputResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUnspentOutputResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSlpParsedScriptResponse) ProtoMessage() {}
+func (*GetUnspentOutputResponse) ProtoMessage() {}
 
-func (x *GetSlpParsedScriptResponse) ProtoReflect() protoreflect.Message {
+func (x *GetUnspentOutputResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3037,126 +3460,78 @@ func (x *GetSlpParsedScriptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSlpParsedScriptResponse.ProtoReflect.Descriptor instead.
-func (*GetSlpParsedScriptResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUnspentOutputResponse.ProtoReflect.Descriptor instead.
+func (*GetUnspentOutputResponse) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetSlpParsedScriptResponse) GetParsingError() string {
+func (x *GetUnspentOutputResponse) GetOutpoint() *Transaction_Input_Outpoint {
 	if x != nil {
-		return x.ParsingError
+		return x.Outpoint
 	}
-	return ""
+	return nil
 }
 
-func (x *GetSlpParsedScriptResponse) GetTokenId() []byte {
+func (x *GetUnspentOutputResponse) GetPubkeyScript() []byte {
 	if x != nil {
-		return x.TokenId
+		return x.PubkeyScript
 	}
 	return nil
 }
 
-func (x *GetSlpParsedScriptResponse) GetSlpAction() SlpAction {
+func (x *GetUnspentOutputResponse) GetValue() int64 {
 	if x != nil {
-		return x.SlpAction
+		return x.Value
 	}
-	return SlpAction_NON_SLP
+	return 0
 }
 
-func (x *GetSlpParsedScriptResponse) GetTokenType() SlpTokenType {
+func (x *GetUnspentOutputResponse) GetIsCoinbase() bool {
 	if x != nil {
-		return x.TokenType
-	}
-	return SlpTokenType_VERSION_NOT_SET
-}
-
-func (m *GetSlpParsedScriptResponse) GetSlpMetadata() isGetSlpParsedScriptResponse_SlpMetadata {
-	if m != nil {
-		return m.SlpMetadata
-	}
-	return nil
-}
-
-func (x *GetSlpParsedScriptResponse) GetV1Genesis() *SlpV1GenesisMetadata {
-	if x, ok := x.GetSlpMetadata().(*GetSlpParsedScriptResponse_V1Genesis); ok {
-		return x.V1Genesis
+		return x.IsCoinbase
 	}
-	return nil
+	return false
 }
 
-func (x *GetSlpParsedScriptResponse) GetV1Mint() *SlpV1MintMetadata {
-	if x, ok := x.GetSlpMetadata().(*GetSlpParsedScriptResponse_V1Mint); ok {
-		return x.V1Mint
+func (x *GetUnspentOutputResponse) GetBlockHeight() int32 {
+	if x != nil {
+		return x.BlockHeight
 	}
-	return nil
+	return 0
 }
 
-func (x *GetSlpParsedScriptResponse) GetV1Send() *SlpV1SendMetadata {
-	if x, ok := x.GetSlpMetadata().(*GetSlpParsedScriptResponse_V1Send); ok {
-		return x.V1Send
+func (x *GetUnspentOutputResponse) GetSlpToken() *SlpToken {
+	if x != nil {
+		return x.SlpToken
 	}
 	return nil
 }
 
-func (x *GetSlpParsedScriptResponse) GetV1Nft1ChildGenesis() *SlpV1Nft1ChildGenesisMetadata {
-	if x, ok := x.GetSlpMetadata().(*GetSlpParsedScriptResponse_V1Nft1ChildGenesis); ok {
-		return x.V1Nft1ChildGenesis
+func (x *GetUnspentOutputResponse) GetTokenMetadata() *SlpTokenMetadata {
+	if x != nil {
+		return x.TokenMetadata
 	}
 	return nil
 }
 
-func (x *GetSlpParsedScriptResponse) GetV1Nft1ChildSend() *SlpV1Nft1ChildSendMetadata {
-	if x, ok := x.GetSlpMetadata().(*GetSlpParsedScriptResponse_V1Nft1ChildSend); ok {
-		return x.V1Nft1ChildSend
+func (x *GetUnspentOutputResponse) GetCashToken() *CashToken {
+	if x != nil {
+		return x.CashToken
 	}
 	return nil
 }
 
-type isGetSlpParsedScriptResponse_SlpMetadata interface {
-	isGetSlpParsedScriptResponse_SlpMetadata()
-}
-
-type GetSlpParsedScriptResponse_V1Genesis struct {
-	V1Genesis *SlpV1GenesisMetadata `protobuf:"bytes,5,opt,name=v1_genesis,json=v1Genesis,proto3,oneof"` // NFT1 Group also uses this
-}
-
-type GetSlpParsedScriptResponse_V1Mint struct {
-	V1Mint *SlpV1MintMetadata `protobuf:"bytes,6,opt,name=v1_mint,json=v1Mint,proto3,oneof"` // NFT1 Group also uses this
-}
-
-type GetSlpParsedScriptResponse_V1Send struct {
-	V1Send *SlpV1SendMetadata `protobuf:"bytes,7,opt,name=v1_send,json=v1Send,proto3,oneof"` // NFT1 Group also uses this
-}
-
-type GetSlpParsedScriptResponse_V1Nft1ChildGenesis struct {
-	V1Nft1ChildGenesis *SlpV1Nft1ChildGenesisMetadata `protobuf:"bytes,8,opt,name=v1_nft1_child_genesis,json=v1Nft1ChildGenesis,proto3,oneof"`
-}
-
-type GetSlpParsedScriptResponse_V1Nft1ChildSend struct {
-	V1Nft1ChildSend *SlpV1Nft1ChildSendMetadata `protobuf:"bytes,9,opt,name=v1_nft1_child_send,json=v1Nft1ChildSend,proto3,oneof"`
-}
-
-func (*GetSlpParsedScriptResponse_V1Genesis) isGetSlpParsedScriptResponse_SlpMetadata() {}
-
-func (*GetSlpParsedScriptResponse_V1Mint) isGetSlpParsedScriptResponse_SlpMetadata() {}
-
-func (*GetSlpParsedScriptResponse_V1Send) isGetSlpParsedScriptResponse_SlpMetadata() {}
-
-func (*GetSlpParsedScriptResponse_V1Nft1ChildGenesis) isGetSlpParsedScriptResponse_SlpMetadata() {}
-
-func (*GetSlpParsedScriptResponse_V1Nft1ChildSend) isGetSlpParsedScriptResponse_SlpMetadata() {}
-
-type GetSlpTrustedValidationRequest struct {
+type GetMerkleProofRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Queries                 []*GetSlpTrustedValidationRequest_Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
-	IncludeGraphsearchCount bool                                    `protobuf:"varint,2,opt,name=include_graphsearch_count,json=includeGraphsearchCount,proto3" json:"include_graphsearch_count,omitempty"`
+	// A transaction hash, little-endian.
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
 }
 
-func (x *GetSlpTrustedValidationRequest) Reset() {
-	*x = GetSlpTrustedValidationRequest{}
+func (x *GetMerkleProofRequest) Reset() {
+	*x = GetMerkleProofRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3164,13 +3539,13 @@ func (x *GetSlpTrustedValidationRequest) Reset() {
 	}
 }
 
-func (x *GetSlpTrustedValidationRequest) String() string {
+func (x *GetMerkleProofRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSlpTrustedValidationRequest) ProtoMessage() {}
+func (*GetMerkleProofRequest) ProtoMessage() {}
 
-func (x *GetSlpTrustedValidationRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMerkleProofRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3182,35 +3557,37 @@ func (x *GetSlpTrustedValidationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSlpTrustedValidationRequest.ProtoReflect.Descriptor instead.
-func (*GetSlpTrustedValidationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMerkleProofRequest.ProtoReflect.Descriptor instead.
+func (*GetMerkleProofRequest) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetSlpTrustedValidationRequest) GetQueries() []*GetSlpTrustedValidationRequest_Query {
+func (x *GetMerkleProofRequest) GetTransactionHash() []byte {
 	if x != nil {
-		return x.Queries
+		return x.TransactionHash
 	}
 	return nil
 }
 
-func (x *GetSlpTrustedValidationRequest) GetIncludeGraphsearchCount() bool {
-	if x != nil {
-		return x.IncludeGraphsearchCount
-	}
-	return false
-}
-
-type GetSlpTrustedValidationResponse struct {
+type GetMerkleProofResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Results []*GetSlpTrustedValidationResponse_ValidityResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Block header information for the corresponding transaction
+	Block *BlockInfo `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// A list containing the transaction hash, the adjacent leaf transaction hash
+	// and the hashes of the highest nodes in the merkle tree not built with the transaction.
+	// Proof hashes are ordered following transaction order, or left to right on the merkle tree
+	Hashes [][]byte `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	// Binary representing the location of the matching transaction in the full merkle tree,
+	// starting with the root (`1`) at position/level 0, where `1` corresponds
+	// to a left branch and `01` is a right branch.
+	Flags []byte `protobuf:"bytes,3,opt,name=flags,proto3" json:"flags,omitempty"`
 }
 
-func (x *GetSlpTrustedValidationResponse) Reset() {
-	*x = GetSlpTrustedValidationResponse{}
+func (x *GetMerkleProofResponse) Reset() {
+	*x = GetMerkleProofResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3218,13 +3595,13 @@ func (x *GetSlpTrustedValidationResponse) Reset() {
 	}
 }
 
-func (x *GetSlpTrustedValidationResponse) String() string {
+func (x *GetMerkleProofResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSlpTrustedValidationResponse) ProtoMessage() {}
+func (*GetMerkleProofResponse) ProtoMessage() {}
 
-func (x *GetSlpTrustedValidationResponse) ProtoReflect() protoreflect.Message {
+func (x *GetMerkleProofResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3236,29 +3613,45 @@ func (x *GetSlpTrustedValidationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSlpTrustedValidationResponse.ProtoReflect.Descriptor instead.
-func (*GetSlpTrustedValidationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMerkleProofResponse.ProtoReflect.Descriptor instead.
+func (*GetMerkleProofResponse) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetSlpTrustedValidationResponse) GetResults() []*GetSlpTrustedValidationResponse_ValidityResult {
+func (x *GetMerkleProofResponse) GetBlock() *BlockInfo {
 	if x != nil {
-		return x.Results
+		return x.Block
 	}
 	return nil
 }
 
-type GetSlpGraphSearchRequest struct {
+func (x *GetMerkleProofResponse) GetHashes() [][]byte {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+func (x *GetMerkleProofResponse) GetFlags() []byte {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type SubmitTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash        []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	ValidHashes [][]byte `protobuf:"bytes,2,rep,name=valid_hashes,json=validHashes,proto3" json:"valid_hashes,omitempty"`
+	// The encoded transaction.
+	Transaction          []byte             `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	SkipSlpValidityCheck bool               `protobuf:"varint,2,opt,name=skip_slp_validity_check,json=skipSlpValidityCheck,proto3" json:"skip_slp_validity_check,omitempty"`
+	RequiredSlpBurns     []*SlpRequiredBurn `protobuf:"bytes,3,rep,name=required_slp_burns,json=requiredSlpBurns,proto3" json:"required_slp_burns,omitempty"`
 }
 
-func (x *GetSlpGraphSearchRequest) Reset() {
-	*x = GetSlpGraphSearchRequest{}
+func (x *SubmitTransactionRequest) Reset() {
+	*x = SubmitTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3266,13 +3659,13 @@ func (x *GetSlpGraphSearchRequest) Reset() {
 	}
 }
 
-func (x *GetSlpGraphSearchRequest) String() string {
+func (x *SubmitTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSlpGraphSearchRequest) ProtoMessage() {}
+func (*SubmitTransactionRequest) ProtoMessage() {}
 
-func (x *GetSlpGraphSearchRequest) ProtoReflect() protoreflect.Message {
+func (x *SubmitTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3284,35 +3677,43 @@ func (x *GetSlpGraphSearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSlpGraphSearchRequest.ProtoReflect.Descriptor instead.
-func (*GetSlpGraphSearchRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmitTransactionRequest.ProtoReflect.Descriptor instead.
+func (*SubmitTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetSlpGraphSearchRequest) GetHash() []byte {
+func (x *SubmitTransactionRequest) GetTransaction() []byte {
 	if x != nil {
-		return x.Hash
+		return x.Transaction
 	}
 	return nil
 }
 
-func (x *GetSlpGraphSearchRequest) GetValidHashes() [][]byte {
+func (x *SubmitTransactionRequest) GetSkipSlpValidityCheck() bool {
 	if x != nil {
-		return x.ValidHashes
+		return x.SkipSlpValidityCheck
+	}
+	return false
+}
+
+func (x *SubmitTransactionRequest) GetRequiredSlpBurns() []*SlpRequiredBurn {
+	if x != nil {
+		return x.RequiredSlpBurns
 	}
 	return nil
 }
 
-type GetSlpGraphSearchResponse struct {
+type SubmitTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Txdata [][]byte `protobuf:"bytes,1,rep,name=txdata,proto3" json:"txdata,omitempty"`
+	// Transaction hash, little-endian.
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (x *GetSlpGraphSearchResponse) Reset() {
-	*x = GetSlpGraphSearchResponse{}
+func (x *SubmitTransactionResponse) Reset() {
+	*x = SubmitTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3320,13 +3721,13 @@ func (x *GetSlpGraphSearchResponse) Reset() {
 	}
 }
 
-func (x *GetSlpGraphSearchResponse) String() string {
+func (x *SubmitTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSlpGraphSearchResponse) ProtoMessage() {}
+func (*SubmitTransactionResponse) ProtoMessage() {}
 
-func (x *GetSlpGraphSearchResponse) ProtoReflect() protoreflect.Message {
+func (x *SubmitTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3338,34 +3739,45 @@ func (x *GetSlpGraphSearchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSlpGraphSearchResponse.ProtoReflect.Descriptor instead.
-func (*GetSlpGraphSearchResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmitTransactionResponse.ProtoReflect.Descriptor instead.
+func (*SubmitTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetSlpGraphSearchResponse) GetTxdata() [][]byte {
+func (x *SubmitTransactionResponse) GetHash() []byte {
 	if x != nil {
-		return x.Txdata
+		return x.Hash
 	}
 	return nil
 }
 
-type BlockNotification struct {
+type CheckSlpTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Whether the block is connected to the chain.
-	Type BlockNotification_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pb.BlockNotification_Type" json:"type,omitempty"`
-	// Types that are assignable to Block:
-	//	*BlockNotification_BlockInfo
-	//	*BlockNotification_MarshaledBlock
-	//	*BlockNotification_SerializedBlock
-	Block isBlockNotification_Block `protobuf_oneof:"block"`
+	Transaction      []byte             `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	RequiredSlpBurns []*SlpRequiredBurn `protobuf:"bytes,2,rep,name=required_slp_burns,json=requiredSlpBurns,proto3" json:"required_slp_burns,omitempty"`
+	// Using the slp specification as a basis for validity judgement can lead to confusion for new users and
+	// result in accidental token burns.  use_spec_validity_judgement will cause the response's is_valid property
+	// to be returned according to the slp specification.  Therefore, use_spec_validity_judgement is false by
+	// default in order to avoid accidental token burns.  When use_spec_validity_judgement is false we return
+	// invalid in any case which would result in a burned token, unless the burn is explicitly included as an
+	// item in required_slp_burns property.
+	//
+	// When use_spec_validity_judgement is true, there are three cases where the is_valid response property
+	// will be returned as valid, instead of invalid, as per the slp specification.
+	//   1) inputs > outputs
+	//   2) missing transaction outputs
+	//   3) burned inputs from other tokens
+	//
+	// required_slp_burns is not used when use_spec_validity_judgement is set to true.
+	//
+	UseSpecValidityJudgement bool `protobuf:"varint,3,opt,name=use_spec_validity_judgement,json=useSpecValidityJudgement,proto3" json:"use_spec_validity_judgement,omitempty"`
 }
 
-func (x *BlockNotification) Reset() {
-	*x = BlockNotification{}
+func (x *CheckSlpTransactionRequest) Reset() {
+	*x = CheckSlpTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3373,13 +3785,13 @@ func (x *BlockNotification) Reset() {
 	}
 }
 
-func (x *BlockNotification) String() string {
+func (x *CheckSlpTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockNotification) ProtoMessage() {}
+func (*CheckSlpTransactionRequest) ProtoMessage() {}
 
-func (x *BlockNotification) ProtoReflect() protoreflect.Message {
+func (x *CheckSlpTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3391,87 +3803,44 @@ func (x *BlockNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockNotification.ProtoReflect.Descriptor instead.
-func (*BlockNotification) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckSlpTransactionRequest.ProtoReflect.Descriptor instead.
+func (*CheckSlpTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *BlockNotification) GetType() BlockNotification_Type {
+func (x *CheckSlpTransactionRequest) GetTransaction() []byte {
 	if x != nil {
-		return x.Type
-	}
-	return BlockNotification_CONNECTED
-}
-
-func (m *BlockNotification) GetBlock() isBlockNotification_Block {
-	if m != nil {
-		return m.Block
-	}
-	return nil
-}
-
-func (x *BlockNotification) GetBlockInfo() *BlockInfo {
-	if x, ok := x.GetBlock().(*BlockNotification_BlockInfo); ok {
-		return x.BlockInfo
+		return x.Transaction
 	}
 	return nil
 }
 
-func (x *BlockNotification) GetMarshaledBlock() *Block {
-	if x, ok := x.GetBlock().(*BlockNotification_MarshaledBlock); ok {
-		return x.MarshaledBlock
+func (x *CheckSlpTransactionRequest) GetRequiredSlpBurns() []*SlpRequiredBurn {
+	if x != nil {
+		return x.RequiredSlpBurns
 	}
 	return nil
 }
 
-func (x *BlockNotification) GetSerializedBlock() []byte {
-	if x, ok := x.GetBlock().(*BlockNotification_SerializedBlock); ok {
-		return x.SerializedBlock
+func (x *CheckSlpTransactionRequest) GetUseSpecValidityJudgement() bool {
+	if x != nil {
+		return x.UseSpecValidityJudgement
 	}
-	return nil
-}
-
-type isBlockNotification_Block interface {
-	isBlockNotification_Block()
-}
-
-type BlockNotification_BlockInfo struct {
-	// Marshaled block header data, as well as metadata stored by the node.
-	BlockInfo *BlockInfo `protobuf:"bytes,2,opt,name=block_info,json=blockInfo,proto3,oneof"`
-}
-
-type BlockNotification_MarshaledBlock struct {
-	// A Block.
-	MarshaledBlock *Block `protobuf:"bytes,3,opt,name=marshaled_block,json=marshaledBlock,proto3,oneof"`
-}
-
-type BlockNotification_SerializedBlock struct {
-	// Binary block, serialized using bitcoin protocol encoding.
-	SerializedBlock []byte `protobuf:"bytes,4,opt,name=serialized_block,json=serializedBlock,proto3,oneof"`
+	return false
 }
 
-func (*BlockNotification_BlockInfo) isBlockNotification_Block() {}
-
-func (*BlockNotification_MarshaledBlock) isBlockNotification_Block() {}
-
-func (*BlockNotification_SerializedBlock) isBlockNotification_Block() {}
-
-type TransactionNotification struct {
+type CheckSlpTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Whether or not the transaction has been included in a block.
-	Type TransactionNotification_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pb.TransactionNotification_Type" json:"type,omitempty"`
-	// Types that are assignable to Transaction:
-	//	*TransactionNotification_ConfirmedTransaction
-	//	*TransactionNotification_UnconfirmedTransaction
-	//	*TransactionNotification_SerializedTransaction
-	Transaction isTransactionNotification_Transaction `protobuf_oneof:"transaction"`
+	IsValid       bool   `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	InvalidReason string `protobuf:"bytes,2,opt,name=invalid_reason,json=invalidReason,proto3" json:"invalid_reason,omitempty"`
+	BestHeight    int32  `protobuf:"varint,3,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
 }
 
-func (x *TransactionNotification) Reset() {
-	*x = TransactionNotification{}
+func (x *CheckSlpTransactionResponse) Reset() {
+	*x = CheckSlpTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3479,13 +3848,13 @@ func (x *TransactionNotification) Reset() {
 	}
 }
 
-func (x *TransactionNotification) String() string {
+func (x *CheckSlpTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionNotification) ProtoMessage() {}
+func (*CheckSlpTransactionResponse) ProtoMessage() {}
 
-func (x *TransactionNotification) ProtoReflect() protoreflect.Message {
+func (x *CheckSlpTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3497,110 +3866,43 @@ func (x *TransactionNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionNotification.ProtoReflect.Descriptor instead.
-func (*TransactionNotification) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckSlpTransactionResponse.ProtoReflect.Descriptor instead.
+func (*CheckSlpTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *TransactionNotification) GetType() TransactionNotification_Type {
+func (x *CheckSlpTransactionResponse) GetIsValid() bool {
 	if x != nil {
-		return x.Type
-	}
-	return TransactionNotification_UNCONFIRMED
-}
-
-func (m *TransactionNotification) GetTransaction() isTransactionNotification_Transaction {
-	if m != nil {
-		return m.Transaction
-	}
-	return nil
-}
-
-func (x *TransactionNotification) GetConfirmedTransaction() *Transaction {
-	if x, ok := x.GetTransaction().(*TransactionNotification_ConfirmedTransaction); ok {
-		return x.ConfirmedTransaction
+		return x.IsValid
 	}
-	return nil
+	return false
 }
 
-func (x *TransactionNotification) GetUnconfirmedTransaction() *MempoolTransaction {
-	if x, ok := x.GetTransaction().(*TransactionNotification_UnconfirmedTransaction); ok {
-		return x.UnconfirmedTransaction
+func (x *CheckSlpTransactionResponse) GetInvalidReason() string {
+	if x != nil {
+		return x.InvalidReason
 	}
-	return nil
+	return ""
 }
 
-func (x *TransactionNotification) GetSerializedTransaction() []byte {
-	if x, ok := x.GetTransaction().(*TransactionNotification_SerializedTransaction); ok {
-		return x.SerializedTransaction
+func (x *CheckSlpTransactionResponse) GetBestHeight() int32 {
+	if x != nil {
+		return x.BestHeight
 	}
-	return nil
-}
-
-type isTransactionNotification_Transaction interface {
-	isTransactionNotification_Transaction()
-}
-
-type TransactionNotification_ConfirmedTransaction struct {
-	// A transaction included in a block.
-	ConfirmedTransaction *Transaction `protobuf:"bytes,2,opt,name=confirmed_transaction,json=confirmedTransaction,proto3,oneof"`
-}
-
-type TransactionNotification_UnconfirmedTransaction struct {
-	// A transaction in mempool.
-	UnconfirmedTransaction *MempoolTransaction `protobuf:"bytes,3,opt,name=unconfirmed_transaction,json=unconfirmedTransaction,proto3,oneof"`
-}
-
-type TransactionNotification_SerializedTransaction struct {
-	// Binary transaction, serialized using bitcoin protocol encoding.
-	SerializedTransaction []byte `protobuf:"bytes,4,opt,name=serialized_transaction,json=serializedTransaction,proto3,oneof"`
+	return 0
 }
 
-func (*TransactionNotification_ConfirmedTransaction) isTransactionNotification_Transaction() {}
-
-func (*TransactionNotification_UnconfirmedTransaction) isTransactionNotification_Transaction() {}
-
-func (*TransactionNotification_SerializedTransaction) isTransactionNotification_Transaction() {}
-
-// Metadata for identifying and validating a block
-type BlockInfo struct {
+type DecodeTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The double sha256 hash of the six header fields in the first 80 bytes
-	// of the block, when encoded according the bitcoin protocol, little-endian.
-	// sha256(sha256(encoded_header))
-	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	// The block number, an incremental index for each block mined.
-	Height int32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	// A version number to track software/protocol upgrades.
-	Version int32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	// Hash of the previous block, little-endian.
-	PreviousBlock []byte `protobuf:"bytes,4,opt,name=previous_block,json=previousBlock,proto3" json:"previous_block,omitempty"`
-	// The root of the Merkle Tree built from all transactions in the block, little-endian.
-	MerkleRoot []byte `protobuf:"bytes,5,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
-	// When mining of the block started, expressed in seconds since 1970-01-01.
-	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// Difficulty in Compressed Target Format.
-	Bits uint32 `protobuf:"varint,7,opt,name=bits,proto3" json:"bits,omitempty"`
-	// A random value that was generated during block mining which happened to
-	// result in a computed block hash below the difficulty target at the time.
-	Nonce uint32 `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	// Number of blocks in a chain, including the block itself upon creation.
-	Confirmations int32 `protobuf:"varint,9,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
-	// Difficulty target at time of creation.
-	Difficulty float64 `protobuf:"fixed64,10,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	// Hash of the next block in this chain, little-endian.
-	NextBlockHash []byte `protobuf:"bytes,11,opt,name=next_block_hash,json=nextBlockHash,proto3" json:"next_block_hash,omitempty"`
-	// Size of the block in bytes.
-	Size int32 `protobuf:"varint,12,opt,name=size,proto3" json:"size,omitempty"`
-	// The median block time of the latest 11 block timestamps.
-	MedianTime int64 `protobuf:"varint,13,opt,name=median_time,json=medianTime,proto3" json:"median_time,omitempty"`
+	// Binary transaction, serialized using bitcoin protocol encoding.
+	Transaction []byte `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
 }
 
-func (x *BlockInfo) Reset() {
-	*x = BlockInfo{}
+func (x *DecodeTransactionRequest) Reset() {
+	*x = DecodeTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bchrpc_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3608,13 +3910,13 @@ func (x *BlockInfo) Reset() {
 	}
 }
 
-func (x *BlockInfo) String() string {
+func (x *DecodeTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockInfo) ProtoMessage() {}
+func (*DecodeTransactionRequest) ProtoMessage() {}
 
-func (x *BlockInfo) ProtoReflect() protoreflect.Message {
+func (x *DecodeTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bchrpc_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3626,130 +3928,235 @@ func (x *BlockInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockInfo.ProtoReflect.Descriptor instead.
-func (*BlockInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecodeTransactionRequest.ProtoReflect.Descriptor instead.
+func (*DecodeTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_bchrpc_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *BlockInfo) GetHash() []byte {
+func (x *DecodeTransactionRequest) GetTransaction() []byte {
 	if x != nil {
-		return x.Hash
+		return x.Transaction
 	}
 	return nil
 }
 
-func (x *BlockInfo) GetHeight() int32 {
-	if x != nil {
-		return x.Height
+type DecodeTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The decoded transaction. The inputs whose previous output was found
+	// include its value, script, address and tokens.
+	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// The indexes of the inputs whose previous output was not found.
+	UnresolvedInputs []uint32 `protobuf:"varint,2,rep,packed,name=unresolved_inputs,json=unresolvedInputs,proto3" json:"unresolved_inputs,omitempty"`
+	// The fee paid by the transaction, zero when some inputs are unresolved.
+	Fee int64 `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	// Whether the transaction would be valid according to the slp rules,
+	// including whether it burns slp tokens of its inputs. Only set when the
+	// SlpIndex is enabled.
+	SlpValidity *CheckSlpTransactionResponse `protobuf:"bytes,4,opt,name=slp_validity,json=slpValidity,proto3" json:"slp_validity,omitempty"`
+}
+
+func (x *DecodeTransactionResponse) Reset() {
+	*x = DecodeTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *BlockInfo) GetVersion() int32 {
-	if x != nil {
-		return x.Version
+func (x *DecodeTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeTransactionResponse) ProtoMessage() {}
+
+func (x *DecodeTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *BlockInfo) GetPreviousBlock() []byte {
+// Deprecated: Use DecodeTransactionResponse.ProtoReflect.Descriptor instead.
+func (*DecodeTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DecodeTransactionResponse) GetTransaction() *Transaction {
 	if x != nil {
-		return x.PreviousBlock
+		return x.Transaction
 	}
 	return nil
 }
 
-func (x *BlockInfo) GetMerkleRoot() []byte {
+func (x *DecodeTransactionResponse) GetUnresolvedInputs() []uint32 {
 	if x != nil {
-		return x.MerkleRoot
+		return x.UnresolvedInputs
 	}
 	return nil
 }
 
-func (x *BlockInfo) GetTimestamp() int64 {
+func (x *DecodeTransactionResponse) GetFee() int64 {
 	if x != nil {
-		return x.Timestamp
+		return x.Fee
 	}
 	return 0
 }
 
-func (x *BlockInfo) GetBits() uint32 {
+func (x *DecodeTransactionResponse) GetSlpValidity() *CheckSlpTransactionResponse {
 	if x != nil {
-		return x.Bits
+		return x.SlpValidity
 	}
-	return 0
+	return nil
 }
 
-func (x *BlockInfo) GetNonce() uint32 {
+// Request to subscribe or unsubscribe from a stream of transactions.
+type SubscribeTransactionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Subscribe to a filter. add items to a filter
+	Subscribe *TransactionFilter `protobuf:"bytes,1,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	// Unsubscribe to a filter, remove items from a filter
+	Unsubscribe *TransactionFilter `protobuf:"bytes,2,opt,name=unsubscribe,proto3" json:"unsubscribe,omitempty"`
+	// When include_mempool is true, new unconfirmed transactions from mempool are
+	// included apart from the ones confirmed in a block.
+	IncludeMempool bool `protobuf:"varint,3,opt,name=include_mempool,json=includeMempool,proto3" json:"include_mempool,omitempty"`
+	// When include_in_block is true, transactions are included when they are confirmed.
+	// This notification is sent in addition to any requested mempool notifications.
+	IncludeInBlock bool `protobuf:"varint,4,opt,name=include_in_block,json=includeInBlock,proto3" json:"include_in_block,omitempty"`
+	// When serialize_tx is true, transactions are serialized using
+	// bitcoin protocol encoding. Default is false, transaction will be Marshaled
+	// (see `Transaction`, `MempoolTransaction` and `TransactionNotification`)
+	SerializeTx bool `protobuf:"varint,5,opt,name=serialize_tx,json=serializeTx,proto3" json:"serialize_tx,omitempty"`
+	// When include_token_burns is true, the slp tokens and CashTokens destroyed
+	// by each transaction are included in the notification as token_burns.
+	// Slp token burns require SlpIndex to be detected.
+	IncludeTokenBurns bool `protobuf:"varint,6,opt,name=include_token_burns,json=includeTokenBurns,proto3" json:"include_token_burns,omitempty"`
+	// When token_burns_only is true, only transactions which burn tokens are
+	// sent. Requires include_token_burns.
+	TokenBurnsOnly bool `protobuf:"varint,7,opt,name=token_burns_only,json=tokenBurnsOnly,proto3" json:"token_burns_only,omitempty"`
+}
+
+func (x *SubscribeTransactionsRequest) Reset() {
+	*x = SubscribeTransactionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeTransactionsRequest) ProtoMessage() {}
+
+func (x *SubscribeTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SubscribeTransactionsRequest) GetSubscribe() *TransactionFilter {
 	if x != nil {
-		return x.Nonce
+		return x.Subscribe
 	}
-	return 0
+	return nil
 }
 
-func (x *BlockInfo) GetConfirmations() int32 {
+func (x *SubscribeTransactionsRequest) GetUnsubscribe() *TransactionFilter {
 	if x != nil {
-		return x.Confirmations
+		return x.Unsubscribe
 	}
-	return 0
+	return nil
 }
 
-func (x *BlockInfo) GetDifficulty() float64 {
+func (x *SubscribeTransactionsRequest) GetIncludeMempool() bool {
 	if x != nil {
-		return x.Difficulty
+		return x.IncludeMempool
 	}
-	return 0
+	return false
 }
 
-func (x *BlockInfo) GetNextBlockHash() []byte {
+func (x *SubscribeTransactionsRequest) GetIncludeInBlock() bool {
 	if x != nil {
-		return x.NextBlockHash
+		return x.IncludeInBlock
 	}
-	return nil
+	return false
 }
 
-func (x *BlockInfo) GetSize() int32 {
+func (x *SubscribeTransactionsRequest) GetSerializeTx() bool {
 	if x != nil {
-		return x.Size
+		return x.SerializeTx
 	}
-	return 0
+	return false
 }
 
-func (x *BlockInfo) GetMedianTime() int64 {
+func (x *SubscribeTransactionsRequest) GetIncludeTokenBurns() bool {
 	if x != nil {
-		return x.MedianTime
+		return x.IncludeTokenBurns
 	}
-	return 0
+	return false
 }
 
-type Block struct {
+func (x *SubscribeTransactionsRequest) GetTokenBurnsOnly() bool {
+	if x != nil {
+		return x.TokenBurnsOnly
+	}
+	return false
+}
+
+type SubscribeDepositsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Block header data, as well as metadata stored by the node.
-	Info *BlockInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
-	// List of transactions or transaction hashes.
-	TransactionData []*Block_TransactionData `protobuf:"bytes,2,rep,name=transaction_data,json=transactionData,proto3" json:"transaction_data,omitempty"`
+	// The addresses to watch for payments.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// The confirmation counts at which a CONFIRMED notification is sent, in
+	// addition to the first confirmation. Counts above 1000 are not allowed.
+	Confirmations []uint32 `protobuf:"varint,2,rep,packed,name=confirmations,proto3" json:"confirmations,omitempty"`
 }
 
-func (x *Block) Reset() {
-	*x = Block{}
+func (x *SubscribeDepositsRequest) Reset() {
+	*x = SubscribeDepositsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[47]
+		mi := &file_bchrpc_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Block) String() string {
+func (x *SubscribeDepositsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Block) ProtoMessage() {}
+func (*SubscribeDepositsRequest) ProtoMessage() {}
 
-func (x *Block) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[47]
+func (x *SubscribeDepositsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3760,74 +4167,56 @@ func (x *Block) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Block.ProtoReflect.Descriptor instead.
-func (*Block) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use SubscribeDepositsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeDepositsRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *Block) GetInfo() *BlockInfo {
+func (x *SubscribeDepositsRequest) GetAddresses() []string {
 	if x != nil {
-		return x.Info
+		return x.Addresses
 	}
 	return nil
 }
 
-func (x *Block) GetTransactionData() []*Block_TransactionData {
+func (x *SubscribeDepositsRequest) GetConfirmations() []uint32 {
 	if x != nil {
-		return x.TransactionData
+		return x.Confirmations
 	}
 	return nil
 }
 
-type Transaction struct {
+type SubscribeDoubleSpendProofsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The double sha256 hash of the encoded transaction, little-endian.
-	// sha256(sha256(encoded_transaction))
-	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	// The version of the transaction format.
-	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	// List of inputs.
-	Inputs []*Transaction_Input `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	// List of outputs.
-	Outputs []*Transaction_Output `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs,omitempty"`
-	// The block height or timestamp after which this transaction is allowed.
-	// If value is greater than 500 million, it is assumed to be an epoch timestamp,
-	// otherwise it is treated as a block-height. Default is zero, or lock.
-	LockTime uint32 `protobuf:"varint,5,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
-	// The size of the transaction in bytes.
-	Size int32 `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
-	// When the transaction was included in a block, in epoch time.
-	Timestamp int64 `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// Number of blocks including proof of the transaction, including
-	// the block it appeared.
-	Confirmations int32 `protobuf:"varint,10,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
-	// Number of the block containing the transaction.
-	BlockHeight int32 `protobuf:"varint,11,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	// Hash of the block the transaction was recorded in, little-endian.
-	BlockHash          []byte              `protobuf:"bytes,12,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	SlpTransactionInfo *SlpTransactionInfo `protobuf:"bytes,13,opt,name=slp_transaction_info,json=slpTransactionInfo,proto3" json:"slp_transaction_info,omitempty"`
+	// The hashes of the transactions to be notified of double spends of,
+	// little-endian.
+	TransactionHashes [][]byte `protobuf:"bytes,1,rep,name=transaction_hashes,json=transactionHashes,proto3" json:"transaction_hashes,omitempty"`
+	// The addresses paid by the transactions to be notified of double spends
+	// of. When there are neither transaction hashes nor addresses, all the
+	// double-spend proofs are sent.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func (x *Transaction) Reset() {
-	*x = Transaction{}
+func (x *SubscribeDoubleSpendProofsRequest) Reset() {
+	*x = SubscribeDoubleSpendProofsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[48]
+		mi := &file_bchrpc_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Transaction) String() string {
+func (x *SubscribeDoubleSpendProofsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transaction) ProtoMessage() {}
+func (*SubscribeDoubleSpendProofsRequest) ProtoMessage() {}
 
-func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[48]
+func (x *SubscribeDoubleSpendProofsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3838,123 +4227,150 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
-func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use SubscribeDoubleSpendProofsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeDoubleSpendProofsRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *Transaction) GetHash() []byte {
+func (x *SubscribeDoubleSpendProofsRequest) GetTransactionHashes() [][]byte {
 	if x != nil {
-		return x.Hash
+		return x.TransactionHashes
 	}
 	return nil
 }
 
-func (x *Transaction) GetVersion() int32 {
-	if x != nil {
-		return x.Version
-	}
-	return 0
-}
-
-func (x *Transaction) GetInputs() []*Transaction_Input {
+func (x *SubscribeDoubleSpendProofsRequest) GetAddresses() []string {
 	if x != nil {
-		return x.Inputs
+		return x.Addresses
 	}
 	return nil
 }
 
-func (x *Transaction) GetOutputs() []*Transaction_Output {
-	if x != nil {
-		return x.Outputs
-	}
-	return nil
+// Options to define data structure to be sent by SubscribeBlock stream:
+//
+//  - BlockInfo (block metadata): `BlockInfo`
+//      - SubscribeBlocksRequest {}
+//
+//  - Marshaled Block (with transaction hashes): `Block`
+//      - SubscribeBlocksRequest {
+//            full_block = true
+//        }
+//  - Marshaled Block (with full transaction data): `Block`
+//      - SubscribeBlocksRequest {
+//            full_block = true
+//            full_transactions = true
+//        }
+//  - Serialized Block acccording to bitcoin protocol encoding: `bytes`
+//      - SubscribeBlocksRequest {
+//            serialize_block = true
+//        }
+type SubscribeBlocksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// When full_block is true, a complete marshaled block is sent. See `Block`.
+	// Default is false, block metadata is sent. See `BlockInfo`.
+	FullBlock bool `protobuf:"varint,1,opt,name=full_block,json=fullBlock,proto3" json:"full_block,omitempty"`
+	// When full_transactions is true, provide full transaction info
+	// for a marshaled block.
+	// Default is false, only the transaction hashes are included for
+	// a marshaled block. See `TransactionData`.
+	FullTransactions bool `protobuf:"varint,2,opt,name=full_transactions,json=fullTransactions,proto3" json:"full_transactions,omitempty"`
+	// When serialize_block is true, blocks are serialized using bitcoin protocol encoding.
+	// Default is false, block will be Marshaled (see `BlockInfo` and `BlockNotification`)
+	SerializeBlock bool `protobuf:"varint,3,opt,name=serialize_block,json=serializeBlock,proto3" json:"serialize_block,omitempty"`
+	// When include_fees is true, CONNECTED notifications include the breakdown
+	// of the coinbase of the block, compared to the block template generated
+	// by the node when the block was built from it. See `BlockFees`.
+	IncludeFees bool `protobuf:"varint,4,opt,name=include_fees,json=includeFees,proto3" json:"include_fees,omitempty"`
 }
 
-func (x *Transaction) GetLockTime() uint32 {
-	if x != nil {
-		return x.LockTime
+func (x *SubscribeBlocksRequest) Reset() {
+	*x = SubscribeBlocksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Transaction) GetSize() int32 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
+func (x *SubscribeBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Transaction) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
+func (*SubscribeBlocksRequest) ProtoMessage() {}
+
+func (x *SubscribeBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Transaction) GetConfirmations() int32 {
+// Deprecated: Use SubscribeBlocksRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SubscribeBlocksRequest) GetFullBlock() bool {
 	if x != nil {
-		return x.Confirmations
+		return x.FullBlock
 	}
-	return 0
+	return false
 }
 
-func (x *Transaction) GetBlockHeight() int32 {
+func (x *SubscribeBlocksRequest) GetFullTransactions() bool {
 	if x != nil {
-		return x.BlockHeight
+		return x.FullTransactions
 	}
-	return 0
+	return false
 }
 
-func (x *Transaction) GetBlockHash() []byte {
+func (x *SubscribeBlocksRequest) GetSerializeBlock() bool {
 	if x != nil {
-		return x.BlockHash
+		return x.SerializeBlock
 	}
-	return nil
+	return false
 }
 
-func (x *Transaction) GetSlpTransactionInfo() *SlpTransactionInfo {
+func (x *SubscribeBlocksRequest) GetIncludeFees() bool {
 	if x != nil {
-		return x.SlpTransactionInfo
+		return x.IncludeFees
 	}
-	return nil
+	return false
 }
 
-type MempoolTransaction struct {
+type GetSlpTokenMetadataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	// The time when the transaction was added too the pool.
-	AddedTime int64 `protobuf:"varint,2,opt,name=added_time,json=addedTime,proto3" json:"added_time,omitempty"`
-	// The block height when the transaction was added to the pool.
-	AddedHeight int32 `protobuf:"varint,3,opt,name=added_height,json=addedHeight,proto3" json:"added_height,omitempty"`
-	// The total fee in satoshi the transaction pays.
-	Fee int64 `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
-	// The fee in satoshi per kilobyte the transaction pays.
-	FeePerKb int64 `protobuf:"varint,5,opt,name=fee_per_kb,json=feePerKb,proto3" json:"fee_per_kb,omitempty"`
-	// The priority of the transaction when it was added to the pool.
-	StartingPriority float64 `protobuf:"fixed64,6,opt,name=starting_priority,json=startingPriority,proto3" json:"starting_priority,omitempty"`
+	TokenIds [][]byte `protobuf:"bytes,1,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`
 }
 
-func (x *MempoolTransaction) Reset() {
-	*x = MempoolTransaction{}
+func (x *GetSlpTokenMetadataRequest) Reset() {
+	*x = GetSlpTokenMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[49]
+		mi := &file_bchrpc_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MempoolTransaction) String() string {
+func (x *GetSlpTokenMetadataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MempoolTransaction) ProtoMessage() {}
+func (*GetSlpTokenMetadataRequest) ProtoMessage() {}
 
-func (x *MempoolTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[49]
+func (x *GetSlpTokenMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3965,90 +4381,43 @@ func (x *MempoolTransaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MempoolTransaction.ProtoReflect.Descriptor instead.
-func (*MempoolTransaction) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use GetSlpTokenMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetSlpTokenMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *MempoolTransaction) GetTransaction() *Transaction {
+func (x *GetSlpTokenMetadataRequest) GetTokenIds() [][]byte {
 	if x != nil {
-		return x.Transaction
+		return x.TokenIds
 	}
 	return nil
 }
 
-func (x *MempoolTransaction) GetAddedTime() int64 {
-	if x != nil {
-		return x.AddedTime
-	}
-	return 0
-}
-
-func (x *MempoolTransaction) GetAddedHeight() int32 {
-	if x != nil {
-		return x.AddedHeight
-	}
-	return 0
-}
-
-func (x *MempoolTransaction) GetFee() int64 {
-	if x != nil {
-		return x.Fee
-	}
-	return 0
-}
-
-func (x *MempoolTransaction) GetFeePerKb() int64 {
-	if x != nil {
-		return x.FeePerKb
-	}
-	return 0
-}
-
-func (x *MempoolTransaction) GetStartingPriority() float64 {
-	if x != nil {
-		return x.StartingPriority
-	}
-	return 0
-}
-
-type UnspentOutput struct {
+type GetSlpTokenMetadataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A reference to the output given by transaction hash and index.
-	Outpoint *Transaction_Input_Outpoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
-	// The public key script used to pay coins.
-	PubkeyScript []byte `protobuf:"bytes,2,opt,name=pubkey_script,json=pubkeyScript,proto3" json:"pubkey_script,omitempty"`
-	// The amount in satoshis
-	Value int64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
-	// When is_coinbase is true, the output is the first in the block,
-	// a generation transaction, the result of mining.
-	IsCoinbase bool `protobuf:"varint,4,opt,name=is_coinbase,json=isCoinbase,proto3" json:"is_coinbase,omitempty"`
-	// The block number containing the UXTO.
-	BlockHeight int32      `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	SlpToken    *SlpToken  `protobuf:"bytes,6,opt,name=slp_token,json=slpToken,proto3" json:"slp_token,omitempty"`
-	CashToken   *CashToken `protobuf:"bytes,7,opt,name=cash_token,json=cashToken,proto3" json:"cash_token,omitempty"`
+	TokenMetadata []*SlpTokenMetadata `protobuf:"bytes,1,rep,name=token_metadata,json=tokenMetadata,proto3" json:"token_metadata,omitempty"`
 }
 
-func (x *UnspentOutput) Reset() {
-	*x = UnspentOutput{}
+func (x *GetSlpTokenMetadataResponse) Reset() {
+	*x = GetSlpTokenMetadataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[50]
+		mi := &file_bchrpc_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnspentOutput) String() string {
+func (x *GetSlpTokenMetadataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnspentOutput) ProtoMessage() {}
+func (*GetSlpTokenMetadataResponse) ProtoMessage() {}
 
-func (x *UnspentOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[50]
+func (x *GetSlpTokenMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4059,98 +4428,100 @@ func (x *UnspentOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnspentOutput.ProtoReflect.Descriptor instead.
-func (*UnspentOutput) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use GetSlpTokenMetadataResponse.ProtoReflect.Descriptor instead.
+func (*GetSlpTokenMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *UnspentOutput) GetOutpoint() *Transaction_Input_Outpoint {
+func (x *GetSlpTokenMetadataResponse) GetTokenMetadata() []*SlpTokenMetadata {
 	if x != nil {
-		return x.Outpoint
+		return x.TokenMetadata
 	}
 	return nil
 }
 
-func (x *UnspentOutput) GetPubkeyScript() []byte {
-	if x != nil {
-		return x.PubkeyScript
-	}
-	return nil
+type GetSlpParsedScriptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SlpOpreturnScript []byte `protobuf:"bytes,1,opt,name=slp_opreturn_script,json=slpOpreturnScript,proto3" json:"slp_opreturn_script,omitempty"`
 }
 
-func (x *UnspentOutput) GetValue() int64 {
-	if x != nil {
-		return x.Value
+func (x *GetSlpParsedScriptRequest) Reset() {
+	*x = GetSlpParsedScriptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *UnspentOutput) GetIsCoinbase() bool {
-	if x != nil {
-		return x.IsCoinbase
-	}
-	return false
+func (x *GetSlpParsedScriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UnspentOutput) GetBlockHeight() int32 {
-	if x != nil {
-		return x.BlockHeight
+func (*GetSlpParsedScriptRequest) ProtoMessage() {}
+
+func (x *GetSlpParsedScriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UnspentOutput) GetSlpToken() *SlpToken {
-	if x != nil {
-		return x.SlpToken
-	}
-	return nil
+// Deprecated: Use GetSlpParsedScriptRequest.ProtoReflect.Descriptor instead.
+func (*GetSlpParsedScriptRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *UnspentOutput) GetCashToken() *CashToken {
+func (x *GetSlpParsedScriptRequest) GetSlpOpreturnScript() []byte {
 	if x != nil {
-		return x.CashToken
+		return x.SlpOpreturnScript
 	}
 	return nil
 }
 
-type TransactionFilter struct {
+type GetSlpParsedScriptResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Filter by address(es)
-	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	// Filter by output hash and index.
-	Outpoints []*Transaction_Input_Outpoint `protobuf:"bytes,2,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
-	// Filter by data elements contained in pubkey scripts.
-	DataElements [][]byte `protobuf:"bytes,3,rep,name=data_elements,json=dataElements,proto3" json:"data_elements,omitempty"`
-	// Subscribed/Unsubscribe to everything. Other filters
-	// will be ignored.
-	AllTransactions bool `protobuf:"varint,4,opt,name=all_transactions,json=allTransactions,proto3" json:"all_transactions,omitempty"`
-	// Subscribed/Unsubscribe to everything slp. Other filters
-	// will be ignored, except this filter will be overriden by all_transactions=true
-	AllSlpTransactions bool `protobuf:"varint,5,opt,name=all_slp_transactions,json=allSlpTransactions,proto3" json:"all_slp_transactions,omitempty"`
-	// only transactions associated with the included tokenIds
-	SlpTokenIds [][]byte `protobuf:"bytes,6,rep,name=slp_token_ids,json=slpTokenIds,proto3" json:"slp_token_ids,omitempty"`
+	ParsingError string       `protobuf:"bytes,1,opt,name=parsing_error,json=parsingError,proto3" json:"parsing_error,omitempty"`
+	TokenId      []byte       `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	SlpAction    SlpAction    `protobuf:"varint,3,opt,name=slp_action,json=slpAction,proto3,enum=pb.SlpAction" json:"slp_action,omitempty"`
+	TokenType    SlpTokenType `protobuf:"varint,4,opt,name=token_type,json=tokenType,proto3,enum=pb.SlpTokenType" json:"token_type,omitempty"`
+	// Types that are assignable to SlpMetadata:
+	//	*GetSlpParsedScriptResponse_V1Genesis
+	//	*GetSlpParsedScriptResponse_V1Mint
+	//	*GetSlpParsedScriptResponse_V1Send
+	//	*GetSlpParsedScriptResponse_V1Nft1ChildGenesis
+	//	*GetSlpParsedScriptResponse_V1Nft1ChildSend
+	SlpMetadata isGetSlpParsedScriptResponse_SlpMetadata `protobuf_oneof:"slp_metadata"`
 }
 
-func (x *TransactionFilter) Reset() {
-	*x = TransactionFilter{}
+func (x *GetSlpParsedScriptResponse) Reset() {
+	*x = GetSlpParsedScriptResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[51]
+		mi := &file_bchrpc_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransactionFilter) String() string {
+func (x *GetSlpParsedScriptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionFilter) ProtoMessage() {}
+func (*GetSlpParsedScriptResponse) ProtoMessage() {}
 
-func (x *TransactionFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[51]
+func (x *GetSlpParsedScriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4161,88 +4532,141 @@ func (x *TransactionFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionFilter.ProtoReflect.Descriptor instead.
-func (*TransactionFilter) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use GetSlpParsedScriptResponse.ProtoReflect.Descriptor instead.
+func (*GetSlpParsedScriptResponse) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *TransactionFilter) GetAddresses() []string {
+func (x *GetSlpParsedScriptResponse) GetParsingError() string {
 	if x != nil {
-		return x.Addresses
+		return x.ParsingError
 	}
-	return nil
+	return ""
 }
 
-func (x *TransactionFilter) GetOutpoints() []*Transaction_Input_Outpoint {
+func (x *GetSlpParsedScriptResponse) GetTokenId() []byte {
 	if x != nil {
-		return x.Outpoints
+		return x.TokenId
 	}
 	return nil
 }
 
-func (x *TransactionFilter) GetDataElements() [][]byte {
+func (x *GetSlpParsedScriptResponse) GetSlpAction() SlpAction {
 	if x != nil {
-		return x.DataElements
+		return x.SlpAction
 	}
-	return nil
+	return SlpAction_NON_SLP
 }
 
-func (x *TransactionFilter) GetAllTransactions() bool {
+func (x *GetSlpParsedScriptResponse) GetTokenType() SlpTokenType {
 	if x != nil {
-		return x.AllTransactions
+		return x.TokenType
 	}
-	return false
+	return SlpTokenType_VERSION_NOT_SET
 }
 
-func (x *TransactionFilter) GetAllSlpTransactions() bool {
-	if x != nil {
-		return x.AllSlpTransactions
+func (m *GetSlpParsedScriptResponse) GetSlpMetadata() isGetSlpParsedScriptResponse_SlpMetadata {
+	if m != nil {
+		return m.SlpMetadata
 	}
-	return false
+	return nil
 }
 
-func (x *TransactionFilter) GetSlpTokenIds() [][]byte {
-	if x != nil {
-		return x.SlpTokenIds
+func (x *GetSlpParsedScriptResponse) GetV1Genesis() *SlpV1GenesisMetadata {
+	if x, ok := x.GetSlpMetadata().(*GetSlpParsedScriptResponse_V1Genesis); ok {
+		return x.V1Genesis
 	}
 	return nil
 }
 
-// CashToken info used in transaction inputs / outputs
-//
-// WARNING: Some languages (e.g., JavaScript) may not properly handle the 'uint64'
-// for large amounts. For this reason, an annotation has been added for JS to
-// return a string for the amount field instead of casting uint64 to the JS 'number'
-// type. Other languages may require similar treatment.
-//
-type CashToken struct {
+func (x *GetSlpParsedScriptResponse) GetV1Mint() *SlpV1MintMetadata {
+	if x, ok := x.GetSlpMetadata().(*GetSlpParsedScriptResponse_V1Mint); ok {
+		return x.V1Mint
+	}
+	return nil
+}
+
+func (x *GetSlpParsedScriptResponse) GetV1Send() *SlpV1SendMetadata {
+	if x, ok := x.GetSlpMetadata().(*GetSlpParsedScriptResponse_V1Send); ok {
+		return x.V1Send
+	}
+	return nil
+}
+
+func (x *GetSlpParsedScriptResponse) GetV1Nft1ChildGenesis() *SlpV1Nft1ChildGenesisMetadata {
+	if x, ok := x.GetSlpMetadata().(*GetSlpParsedScriptResponse_V1Nft1ChildGenesis); ok {
+		return x.V1Nft1ChildGenesis
+	}
+	return nil
+}
+
+func (x *GetSlpParsedScriptResponse) GetV1Nft1ChildSend() *SlpV1Nft1ChildSendMetadata {
+	if x, ok := x.GetSlpMetadata().(*GetSlpParsedScriptResponse_V1Nft1ChildSend); ok {
+		return x.V1Nft1ChildSend
+	}
+	return nil
+}
+
+type isGetSlpParsedScriptResponse_SlpMetadata interface {
+	isGetSlpParsedScriptResponse_SlpMetadata()
+}
+
+type GetSlpParsedScriptResponse_V1Genesis struct {
+	V1Genesis *SlpV1GenesisMetadata `protobuf:"bytes,5,opt,name=v1_genesis,json=v1Genesis,proto3,oneof"` // NFT1 Group also uses this
+}
+
+type GetSlpParsedScriptResponse_V1Mint struct {
+	V1Mint *SlpV1MintMetadata `protobuf:"bytes,6,opt,name=v1_mint,json=v1Mint,proto3,oneof"` // NFT1 Group also uses this
+}
+
+type GetSlpParsedScriptResponse_V1Send struct {
+	V1Send *SlpV1SendMetadata `protobuf:"bytes,7,opt,name=v1_send,json=v1Send,proto3,oneof"` // NFT1 Group also uses this
+}
+
+type GetSlpParsedScriptResponse_V1Nft1ChildGenesis struct {
+	V1Nft1ChildGenesis *SlpV1Nft1ChildGenesisMetadata `protobuf:"bytes,8,opt,name=v1_nft1_child_genesis,json=v1Nft1ChildGenesis,proto3,oneof"`
+}
+
+type GetSlpParsedScriptResponse_V1Nft1ChildSend struct {
+	V1Nft1ChildSend *SlpV1Nft1ChildSendMetadata `protobuf:"bytes,9,opt,name=v1_nft1_child_send,json=v1Nft1ChildSend,proto3,oneof"`
+}
+
+func (*GetSlpParsedScriptResponse_V1Genesis) isGetSlpParsedScriptResponse_SlpMetadata() {}
+
+func (*GetSlpParsedScriptResponse_V1Mint) isGetSlpParsedScriptResponse_SlpMetadata() {}
+
+func (*GetSlpParsedScriptResponse_V1Send) isGetSlpParsedScriptResponse_SlpMetadata() {}
+
+func (*GetSlpParsedScriptResponse_V1Nft1ChildGenesis) isGetSlpParsedScriptResponse_SlpMetadata() {}
+
+func (*GetSlpParsedScriptResponse_V1Nft1ChildSend) isGetSlpParsedScriptResponse_SlpMetadata() {}
+
+type GetSlpTrustedValidationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CategoryId []byte `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Amount     uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
-	Bitfield   []byte `protobuf:"bytes,4,opt,name=bitfield,proto3" json:"bitfield,omitempty"`
+	Queries                 []*GetSlpTrustedValidationRequest_Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	IncludeGraphsearchCount bool                                    `protobuf:"varint,2,opt,name=include_graphsearch_count,json=includeGraphsearchCount,proto3" json:"include_graphsearch_count,omitempty"`
 }
 
-func (x *CashToken) Reset() {
-	*x = CashToken{}
+func (x *GetSlpTrustedValidationRequest) Reset() {
+	*x = GetSlpTrustedValidationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[52]
+		mi := &file_bchrpc_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CashToken) String() string {
+func (x *GetSlpTrustedValidationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CashToken) ProtoMessage() {}
+func (*GetSlpTrustedValidationRequest) ProtoMessage() {}
 
-func (x *CashToken) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[52]
+func (x *GetSlpTrustedValidationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4253,77 +4677,114 @@ func (x *CashToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CashToken.ProtoReflect.Descriptor instead.
-func (*CashToken) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use GetSlpTrustedValidationRequest.ProtoReflect.Descriptor instead.
+func (*GetSlpTrustedValidationRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CashToken) GetCategoryId() []byte {
+func (x *GetSlpTrustedValidationRequest) GetQueries() []*GetSlpTrustedValidationRequest_Query {
 	if x != nil {
-		return x.CategoryId
+		return x.Queries
 	}
 	return nil
 }
 
-func (x *CashToken) GetAmount() uint64 {
+func (x *GetSlpTrustedValidationRequest) GetIncludeGraphsearchCount() bool {
 	if x != nil {
-		return x.Amount
+		return x.IncludeGraphsearchCount
 	}
-	return 0
+	return false
 }
 
-func (x *CashToken) GetCommitment() []byte {
-	if x != nil {
-		return x.Commitment
+type GetSlpTrustedValidationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*GetSlpTrustedValidationResponse_ValidityResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *GetSlpTrustedValidationResponse) Reset() {
+	*x = GetSlpTrustedValidationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *CashToken) GetBitfield() []byte {
+func (x *GetSlpTrustedValidationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlpTrustedValidationResponse) ProtoMessage() {}
+
+func (x *GetSlpTrustedValidationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlpTrustedValidationResponse.ProtoReflect.Descriptor instead.
+func (*GetSlpTrustedValidationResponse) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetSlpTrustedValidationResponse) GetResults() []*GetSlpTrustedValidationResponse_ValidityResult {
 	if x != nil {
-		return x.Bitfield
+		return x.Results
 	}
 	return nil
 }
 
-// SlpToken info used in transaction inputs / outputs
-//
-// WARNING: Some languages (e.g., JavaScript) may not properly handle the 'uint64'
-// for large amounts. For this reason, an annotation has been added for JS to
-// return a string for the amount field instead of casting uint64 to the JS 'number'
-// type. Other languages may require similar treatment.
-//
-type SlpToken struct {
+type GetSlpGraphSearchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TokenId     []byte       `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
-	Amount      uint64       `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	IsMintBaton bool         `protobuf:"varint,3,opt,name=is_mint_baton,json=isMintBaton,proto3" json:"is_mint_baton,omitempty"`
-	Address     string       `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
-	Decimals    uint32       `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
-	SlpAction   SlpAction    `protobuf:"varint,6,opt,name=slp_action,json=slpAction,proto3,enum=pb.SlpAction" json:"slp_action,omitempty"`
-	TokenType   SlpTokenType `protobuf:"varint,7,opt,name=token_type,json=tokenType,proto3,enum=pb.SlpTokenType" json:"token_type,omitempty"`
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// valid_hashes are transactions the client has already validated. They are
+	// returned but the search does not continue through their inputs.
+	ValidHashes [][]byte `protobuf:"bytes,2,rep,name=valid_hashes,json=validHashes,proto3" json:"valid_hashes,omitempty"`
+	// known_hashes are transactions the client already has. They are left out
+	// of the response but the search continues through their inputs.
+	KnownHashes [][]byte `protobuf:"bytes,3,rep,name=known_hashes,json=knownHashes,proto3" json:"known_hashes,omitempty"`
+	// known_filter is a bloom filter of transaction hashes which is treated the
+	// same as known_hashes. A false positive leaves a needed transaction out of
+	// the response so clients must check the returned graph is complete.
+	KnownFilter *BloomFilter `protobuf:"bytes,4,opt,name=known_filter,json=knownFilter,proto3" json:"known_filter,omitempty"`
+	// offset is the number of transactions to skip. Use next_offset from the
+	// previous response to fetch the next page.
+	Offset uint32 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// limit is the maximum number of transactions to return. Zero, or a value
+	// above the server's limit, uses the server's limit. The server also caps
+	// the total size of the transactions in a response.
+	Limit uint32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *SlpToken) Reset() {
-	*x = SlpToken{}
+func (x *GetSlpGraphSearchRequest) Reset() {
+	*x = GetSlpGraphSearchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[53]
+		mi := &file_bchrpc_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SlpToken) String() string {
+func (x *GetSlpGraphSearchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SlpToken) ProtoMessage() {}
+func (*GetSlpGraphSearchRequest) ProtoMessage() {}
 
-func (x *SlpToken) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[53]
+func (x *GetSlpGraphSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4334,97 +4795,85 @@ func (x *SlpToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SlpToken.ProtoReflect.Descriptor instead.
-func (*SlpToken) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use GetSlpGraphSearchRequest.ProtoReflect.Descriptor instead.
+func (*GetSlpGraphSearchRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *SlpToken) GetTokenId() []byte {
+func (x *GetSlpGraphSearchRequest) GetHash() []byte {
 	if x != nil {
-		return x.TokenId
+		return x.Hash
 	}
 	return nil
 }
 
-func (x *SlpToken) GetAmount() uint64 {
+func (x *GetSlpGraphSearchRequest) GetValidHashes() [][]byte {
 	if x != nil {
-		return x.Amount
+		return x.ValidHashes
 	}
-	return 0
+	return nil
 }
 
-func (x *SlpToken) GetIsMintBaton() bool {
+func (x *GetSlpGraphSearchRequest) GetKnownHashes() [][]byte {
 	if x != nil {
-		return x.IsMintBaton
+		return x.KnownHashes
 	}
-	return false
+	return nil
 }
 
-func (x *SlpToken) GetAddress() string {
+func (x *GetSlpGraphSearchRequest) GetKnownFilter() *BloomFilter {
 	if x != nil {
-		return x.Address
+		return x.KnownFilter
 	}
-	return ""
+	return nil
 }
 
-func (x *SlpToken) GetDecimals() uint32 {
+func (x *GetSlpGraphSearchRequest) GetOffset() uint32 {
 	if x != nil {
-		return x.Decimals
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *SlpToken) GetSlpAction() SlpAction {
+func (x *GetSlpGraphSearchRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.SlpAction
+		return x.Limit
 	}
-	return SlpAction_NON_SLP
+	return 0
 }
 
-func (x *SlpToken) GetTokenType() SlpTokenType {
-	if x != nil {
-		return x.TokenType
-	}
-	return SlpTokenType_VERSION_NOT_SET
-}
-
-// SlpTransactionInfo is used inside the Transaction message type.
-type SlpTransactionInfo struct {
+type GetSlpGraphSearchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SlpAction         SlpAction                            `protobuf:"varint,1,opt,name=slp_action,json=slpAction,proto3,enum=pb.SlpAction" json:"slp_action,omitempty"`
-	ValidityJudgement SlpTransactionInfo_ValidityJudgement `protobuf:"varint,2,opt,name=validity_judgement,json=validityJudgement,proto3,enum=pb.SlpTransactionInfo_ValidityJudgement" json:"validity_judgement,omitempty"`
-	ParseError        string                               `protobuf:"bytes,3,opt,name=parse_error,json=parseError,proto3" json:"parse_error,omitempty"`
-	TokenId           []byte                               `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
-	BurnFlags         []SlpTransactionInfo_BurnFlags       `protobuf:"varint,5,rep,packed,name=burn_flags,json=burnFlags,proto3,enum=pb.SlpTransactionInfo_BurnFlags" json:"burn_flags,omitempty"`
-	// Types that are assignable to TxMetadata:
-	//	*SlpTransactionInfo_V1Genesis
-	//	*SlpTransactionInfo_V1Mint
-	//	*SlpTransactionInfo_V1Send
-	//	*SlpTransactionInfo_V1Nft1ChildGenesis
-	//	*SlpTransactionInfo_V1Nft1ChildSend
-	TxMetadata isSlpTransactionInfo_TxMetadata `protobuf_oneof:"tx_metadata"`
+	Txdata [][]byte `protobuf:"bytes,1,rep,name=txdata,proto3" json:"txdata,omitempty"`
+	// has_more is set when the results did not fit in this response.
+	HasMore bool `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	// next_offset is the offset to request the next page with.
+	NextOffset uint32 `protobuf:"varint,3,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
+	// total_count is the number of transactions in the search results, across
+	// all pages.
+	TotalCount uint32 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 }
 
-func (x *SlpTransactionInfo) Reset() {
-	*x = SlpTransactionInfo{}
+func (x *GetSlpGraphSearchResponse) Reset() {
+	*x = GetSlpGraphSearchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[54]
+		mi := &file_bchrpc_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SlpTransactionInfo) String() string {
+func (x *GetSlpGraphSearchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SlpTransactionInfo) ProtoMessage() {}
+func (*GetSlpGraphSearchResponse) ProtoMessage() {}
 
-func (x *SlpTransactionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[54]
+func (x *GetSlpGraphSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4435,154 +4884,68 @@ func (x *SlpTransactionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SlpTransactionInfo.ProtoReflect.Descriptor instead.
-func (*SlpTransactionInfo) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{54}
-}
-
-func (x *SlpTransactionInfo) GetSlpAction() SlpAction {
-	if x != nil {
-		return x.SlpAction
-	}
-	return SlpAction_NON_SLP
+// Deprecated: Use GetSlpGraphSearchResponse.ProtoReflect.Descriptor instead.
+func (*GetSlpGraphSearchResponse) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *SlpTransactionInfo) GetValidityJudgement() SlpTransactionInfo_ValidityJudgement {
+func (x *GetSlpGraphSearchResponse) GetTxdata() [][]byte {
 	if x != nil {
-		return x.ValidityJudgement
+		return x.Txdata
 	}
-	return SlpTransactionInfo_UNKNOWN_OR_INVALID
+	return nil
 }
 
-func (x *SlpTransactionInfo) GetParseError() string {
+func (x *GetSlpGraphSearchResponse) GetHasMore() bool {
 	if x != nil {
-		return x.ParseError
+		return x.HasMore
 	}
-	return ""
+	return false
 }
 
-func (x *SlpTransactionInfo) GetTokenId() []byte {
+func (x *GetSlpGraphSearchResponse) GetNextOffset() uint32 {
 	if x != nil {
-		return x.TokenId
+		return x.NextOffset
 	}
-	return nil
+	return 0
 }
 
-func (x *SlpTransactionInfo) GetBurnFlags() []SlpTransactionInfo_BurnFlags {
+func (x *GetSlpGraphSearchResponse) GetTotalCount() uint32 {
 	if x != nil {
-		return x.BurnFlags
-	}
-	return nil
-}
-
-func (m *SlpTransactionInfo) GetTxMetadata() isSlpTransactionInfo_TxMetadata {
-	if m != nil {
-		return m.TxMetadata
-	}
-	return nil
-}
-
-func (x *SlpTransactionInfo) GetV1Genesis() *SlpV1GenesisMetadata {
-	if x, ok := x.GetTxMetadata().(*SlpTransactionInfo_V1Genesis); ok {
-		return x.V1Genesis
-	}
-	return nil
-}
-
-func (x *SlpTransactionInfo) GetV1Mint() *SlpV1MintMetadata {
-	if x, ok := x.GetTxMetadata().(*SlpTransactionInfo_V1Mint); ok {
-		return x.V1Mint
-	}
-	return nil
-}
-
-func (x *SlpTransactionInfo) GetV1Send() *SlpV1SendMetadata {
-	if x, ok := x.GetTxMetadata().(*SlpTransactionInfo_V1Send); ok {
-		return x.V1Send
-	}
-	return nil
-}
-
-func (x *SlpTransactionInfo) GetV1Nft1ChildGenesis() *SlpV1Nft1ChildGenesisMetadata {
-	if x, ok := x.GetTxMetadata().(*SlpTransactionInfo_V1Nft1ChildGenesis); ok {
-		return x.V1Nft1ChildGenesis
-	}
-	return nil
-}
-
-func (x *SlpTransactionInfo) GetV1Nft1ChildSend() *SlpV1Nft1ChildSendMetadata {
-	if x, ok := x.GetTxMetadata().(*SlpTransactionInfo_V1Nft1ChildSend); ok {
-		return x.V1Nft1ChildSend
+		return x.TotalCount
 	}
-	return nil
-}
-
-type isSlpTransactionInfo_TxMetadata interface {
-	isSlpTransactionInfo_TxMetadata()
-}
-
-type SlpTransactionInfo_V1Genesis struct {
-	V1Genesis *SlpV1GenesisMetadata `protobuf:"bytes,6,opt,name=v1_genesis,json=v1Genesis,proto3,oneof"` // NFT1 Group also uses this
-}
-
-type SlpTransactionInfo_V1Mint struct {
-	V1Mint *SlpV1MintMetadata `protobuf:"bytes,7,opt,name=v1_mint,json=v1Mint,proto3,oneof"` // NFT1 Group also uses this
-}
-
-type SlpTransactionInfo_V1Send struct {
-	V1Send *SlpV1SendMetadata `protobuf:"bytes,8,opt,name=v1_send,json=v1Send,proto3,oneof"` // NFT1 Group also uses this
-}
-
-type SlpTransactionInfo_V1Nft1ChildGenesis struct {
-	V1Nft1ChildGenesis *SlpV1Nft1ChildGenesisMetadata `protobuf:"bytes,9,opt,name=v1_nft1_child_genesis,json=v1Nft1ChildGenesis,proto3,oneof"`
-}
-
-type SlpTransactionInfo_V1Nft1ChildSend struct {
-	V1Nft1ChildSend *SlpV1Nft1ChildSendMetadata `protobuf:"bytes,10,opt,name=v1_nft1_child_send,json=v1Nft1ChildSend,proto3,oneof"`
+	return 0
 }
 
-func (*SlpTransactionInfo_V1Genesis) isSlpTransactionInfo_TxMetadata() {}
-
-func (*SlpTransactionInfo_V1Mint) isSlpTransactionInfo_TxMetadata() {}
-
-func (*SlpTransactionInfo_V1Send) isSlpTransactionInfo_TxMetadata() {}
-
-func (*SlpTransactionInfo_V1Nft1ChildGenesis) isSlpTransactionInfo_TxMetadata() {}
-
-func (*SlpTransactionInfo_V1Nft1ChildSend) isSlpTransactionInfo_TxMetadata() {}
-
-// SlpV1GenesisMetadata is used to marshal type 1 and NFT1 Group GENESIS OP_RETURN scriptPubKey
-type SlpV1GenesisMetadata struct {
+// BloomFilter is a bloom filter using the same hashing as the p2p filterload
+// message.
+type BloomFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name          []byte `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Ticker        []byte `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
-	DocumentUrl   []byte `protobuf:"bytes,3,opt,name=document_url,json=documentUrl,proto3" json:"document_url,omitempty"`
-	DocumentHash  []byte `protobuf:"bytes,4,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty"`
-	Decimals      uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
-	MintBatonVout uint32 `protobuf:"varint,6,opt,name=mint_baton_vout,json=mintBatonVout,proto3" json:"mint_baton_vout,omitempty"`
-	MintAmount    uint64 `protobuf:"varint,7,opt,name=mint_amount,json=mintAmount,proto3" json:"mint_amount,omitempty"`
+	Filter    []byte `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	HashFuncs uint32 `protobuf:"varint,2,opt,name=hash_funcs,json=hashFuncs,proto3" json:"hash_funcs,omitempty"`
+	Tweak     uint32 `protobuf:"varint,3,opt,name=tweak,proto3" json:"tweak,omitempty"`
 }
 
-func (x *SlpV1GenesisMetadata) Reset() {
-	*x = SlpV1GenesisMetadata{}
+func (x *BloomFilter) Reset() {
+	*x = BloomFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[55]
+		mi := &file_bchrpc_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SlpV1GenesisMetadata) String() string {
+func (x *BloomFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SlpV1GenesisMetadata) ProtoMessage() {}
+func (*BloomFilter) ProtoMessage() {}
 
-func (x *SlpV1GenesisMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[55]
+func (x *BloomFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4593,87 +4956,60 @@ func (x *SlpV1GenesisMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SlpV1GenesisMetadata.ProtoReflect.Descriptor instead.
-func (*SlpV1GenesisMetadata) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{55}
-}
-
-func (x *SlpV1GenesisMetadata) GetName() []byte {
-	if x != nil {
-		return x.Name
-	}
-	return nil
-}
-
-func (x *SlpV1GenesisMetadata) GetTicker() []byte {
-	if x != nil {
-		return x.Ticker
-	}
-	return nil
-}
-
-func (x *SlpV1GenesisMetadata) GetDocumentUrl() []byte {
-	if x != nil {
-		return x.DocumentUrl
-	}
-	return nil
+// Deprecated: Use BloomFilter.ProtoReflect.Descriptor instead.
+func (*BloomFilter) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SlpV1GenesisMetadata) GetDocumentHash() []byte {
+func (x *BloomFilter) GetFilter() []byte {
 	if x != nil {
-		return x.DocumentHash
+		return x.Filter
 	}
 	return nil
 }
 
-func (x *SlpV1GenesisMetadata) GetDecimals() uint32 {
-	if x != nil {
-		return x.Decimals
-	}
-	return 0
-}
-
-func (x *SlpV1GenesisMetadata) GetMintBatonVout() uint32 {
+func (x *BloomFilter) GetHashFuncs() uint32 {
 	if x != nil {
-		return x.MintBatonVout
+		return x.HashFuncs
 	}
 	return 0
 }
 
-func (x *SlpV1GenesisMetadata) GetMintAmount() uint64 {
+func (x *BloomFilter) GetTweak() uint32 {
 	if x != nil {
-		return x.MintAmount
+		return x.Tweak
 	}
 	return 0
 }
 
-// SlpV1MintMetadata is used to marshal type 1 MINT OP_RETURN scriptPubKey
-type SlpV1MintMetadata struct {
+type GetTokenMetadataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MintBatonVout uint32 `protobuf:"varint,1,opt,name=mint_baton_vout,json=mintBatonVout,proto3" json:"mint_baton_vout,omitempty"`
-	MintAmount    uint64 `protobuf:"varint,2,opt,name=mint_amount,json=mintAmount,proto3" json:"mint_amount,omitempty"`
+	// slp_token_ids uses the same byte order as GetSlpTokenMetadata.
+	SlpTokenIds [][]byte `protobuf:"bytes,1,rep,name=slp_token_ids,json=slpTokenIds,proto3" json:"slp_token_ids,omitempty"`
+	// cash_token_categories uses the same byte order as CashToken.category_id.
+	CashTokenCategories [][]byte `protobuf:"bytes,2,rep,name=cash_token_categories,json=cashTokenCategories,proto3" json:"cash_token_categories,omitempty"`
 }
 
-func (x *SlpV1MintMetadata) Reset() {
-	*x = SlpV1MintMetadata{}
+func (x *GetTokenMetadataRequest) Reset() {
+	*x = GetTokenMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[56]
+		mi := &file_bchrpc_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SlpV1MintMetadata) String() string {
+func (x *GetTokenMetadataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SlpV1MintMetadata) ProtoMessage() {}
+func (*GetTokenMetadataRequest) ProtoMessage() {}
 
-func (x *SlpV1MintMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[56]
+func (x *GetTokenMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4684,51 +5020,50 @@ func (x *SlpV1MintMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SlpV1MintMetadata.ProtoReflect.Descriptor instead.
-func (*SlpV1MintMetadata) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use GetTokenMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *SlpV1MintMetadata) GetMintBatonVout() uint32 {
+func (x *GetTokenMetadataRequest) GetSlpTokenIds() [][]byte {
 	if x != nil {
-		return x.MintBatonVout
+		return x.SlpTokenIds
 	}
-	return 0
+	return nil
 }
 
-func (x *SlpV1MintMetadata) GetMintAmount() uint64 {
+func (x *GetTokenMetadataRequest) GetCashTokenCategories() [][]byte {
 	if x != nil {
-		return x.MintAmount
+		return x.CashTokenCategories
 	}
-	return 0
+	return nil
 }
 
-// SlpV1SendMetadata is used to marshal type 1 and NFT1 Group SEND OP_RETURN scriptPubKey
-type SlpV1SendMetadata struct {
+type GetTokenMetadataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amounts []uint64 `protobuf:"varint,1,rep,packed,name=amounts,proto3" json:"amounts,omitempty"`
+	TokenMetadata []*TokenMetadata `protobuf:"bytes,1,rep,name=token_metadata,json=tokenMetadata,proto3" json:"token_metadata,omitempty"`
 }
 
-func (x *SlpV1SendMetadata) Reset() {
-	*x = SlpV1SendMetadata{}
+func (x *GetTokenMetadataResponse) Reset() {
+	*x = GetTokenMetadataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[57]
+		mi := &file_bchrpc_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SlpV1SendMetadata) String() string {
+func (x *GetTokenMetadataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SlpV1SendMetadata) ProtoMessage() {}
+func (*GetTokenMetadataResponse) ProtoMessage() {}
 
-func (x *SlpV1SendMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[57]
+func (x *GetTokenMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4739,49 +5074,52 @@ func (x *SlpV1SendMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SlpV1SendMetadata.ProtoReflect.Descriptor instead.
-func (*SlpV1SendMetadata) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use GetTokenMetadataResponse.ProtoReflect.Descriptor instead.
+func (*GetTokenMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *SlpV1SendMetadata) GetAmounts() []uint64 {
+func (x *GetTokenMetadataResponse) GetTokenMetadata() []*TokenMetadata {
 	if x != nil {
-		return x.Amounts
+		return x.TokenMetadata
 	}
 	return nil
 }
 
-// SlpV1Nft1ChildGenesisMetadata is used to marshal NFT1 Child GENESIS OP_RETURN scriptPubKey
-type SlpV1Nft1ChildGenesisMetadata struct {
+// TokenMetadata holds the display metadata for an slp token or a CashToken category.
+type TokenMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         []byte `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Ticker       []byte `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
-	DocumentUrl  []byte `protobuf:"bytes,3,opt,name=document_url,json=documentUrl,proto3" json:"document_url,omitempty"`
-	DocumentHash []byte `protobuf:"bytes,4,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty"`
-	Decimals     uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
-	GroupTokenId []byte `protobuf:"bytes,6,opt,name=group_token_id,json=groupTokenId,proto3" json:"group_token_id,omitempty"`
+	TokenId      []byte                  `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Kind         TokenMetadata_TokenKind `protobuf:"varint,2,opt,name=kind,proto3,enum=pb.TokenMetadata_TokenKind" json:"kind,omitempty"`
+	Source       TokenMetadata_Source    `protobuf:"varint,3,opt,name=source,proto3,enum=pb.TokenMetadata_Source" json:"source,omitempty"`
+	Name         string                  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol       string                  `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals     uint32                  `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Description  string                  `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	RegistryUri  string                  `protobuf:"bytes,8,opt,name=registry_uri,json=registryUri,proto3" json:"registry_uri,omitempty"`
+	RegistryHash []byte                  `protobuf:"bytes,9,opt,name=registry_hash,json=registryHash,proto3" json:"registry_hash,omitempty"`
 }
 
-func (x *SlpV1Nft1ChildGenesisMetadata) Reset() {
-	*x = SlpV1Nft1ChildGenesisMetadata{}
+func (x *TokenMetadata) Reset() {
+	*x = TokenMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[58]
+		mi := &file_bchrpc_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SlpV1Nft1ChildGenesisMetadata) String() string {
+func (x *TokenMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SlpV1Nft1ChildGenesisMetadata) ProtoMessage() {}
+func (*TokenMetadata) ProtoMessage() {}
 
-func (x *SlpV1Nft1ChildGenesisMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[58]
+func (x *TokenMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4792,133 +5130,113 @@ func (x *SlpV1Nft1ChildGenesisMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SlpV1Nft1ChildGenesisMetadata.ProtoReflect.Descriptor instead.
-func (*SlpV1Nft1ChildGenesisMetadata) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use TokenMetadata.ProtoReflect.Descriptor instead.
+func (*TokenMetadata) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *SlpV1Nft1ChildGenesisMetadata) GetName() []byte {
+func (x *TokenMetadata) GetTokenId() []byte {
 	if x != nil {
-		return x.Name
+		return x.TokenId
 	}
 	return nil
 }
 
-func (x *SlpV1Nft1ChildGenesisMetadata) GetTicker() []byte {
+func (x *TokenMetadata) GetKind() TokenMetadata_TokenKind {
 	if x != nil {
-		return x.Ticker
+		return x.Kind
 	}
-	return nil
+	return TokenMetadata_SLP
 }
 
-func (x *SlpV1Nft1ChildGenesisMetadata) GetDocumentUrl() []byte {
+func (x *TokenMetadata) GetSource() TokenMetadata_Source {
 	if x != nil {
-		return x.DocumentUrl
+		return x.Source
 	}
-	return nil
+	return TokenMetadata_ON_CHAIN
 }
 
-func (x *SlpV1Nft1ChildGenesisMetadata) GetDocumentHash() []byte {
+func (x *TokenMetadata) GetName() string {
 	if x != nil {
-		return x.DocumentHash
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *SlpV1Nft1ChildGenesisMetadata) GetDecimals() uint32 {
+func (x *TokenMetadata) GetSymbol() string {
 	if x != nil {
-		return x.Decimals
+		return x.Symbol
 	}
-	return 0
+	return ""
 }
 
-func (x *SlpV1Nft1ChildGenesisMetadata) GetGroupTokenId() []byte {
+func (x *TokenMetadata) GetDecimals() uint32 {
 	if x != nil {
-		return x.GroupTokenId
+		return x.Decimals
 	}
-	return nil
-}
-
-// SlpV1Nft1ChildSendMetadata is used to marshal NFT1 Child SEND OP_RETURN scriptPubKey
-type SlpV1Nft1ChildSendMetadata struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	GroupTokenId []byte `protobuf:"bytes,1,opt,name=group_token_id,json=groupTokenId,proto3" json:"group_token_id,omitempty"`
+	return 0
 }
 
-func (x *SlpV1Nft1ChildSendMetadata) Reset() {
-	*x = SlpV1Nft1ChildSendMetadata{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[59]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TokenMetadata) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
+	return ""
 }
 
-func (x *SlpV1Nft1ChildSendMetadata) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SlpV1Nft1ChildSendMetadata) ProtoMessage() {}
-
-func (x *SlpV1Nft1ChildSendMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[59]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TokenMetadata) GetRegistryUri() string {
+	if x != nil {
+		return x.RegistryUri
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SlpV1Nft1ChildSendMetadata.ProtoReflect.Descriptor instead.
-func (*SlpV1Nft1ChildSendMetadata) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{59}
+	return ""
 }
 
-func (x *SlpV1Nft1ChildSendMetadata) GetGroupTokenId() []byte {
+func (x *TokenMetadata) GetRegistryHash() []byte {
 	if x != nil {
-		return x.GroupTokenId
+		return x.RegistryHash
 	}
 	return nil
 }
 
-// SlpTokenMetadata is used to marshal metadata about a specific TokenID
-type SlpTokenMetadata struct {
+type BlockNotification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TokenId   []byte       `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
-	TokenType SlpTokenType `protobuf:"varint,2,opt,name=token_type,json=tokenType,proto3,enum=pb.SlpTokenType" json:"token_type,omitempty"`
-	// Types that are assignable to TypeMetadata:
-	//	*SlpTokenMetadata_V1Fungible_
-	//	*SlpTokenMetadata_V1Nft1Group
-	//	*SlpTokenMetadata_V1Nft1Child
-	TypeMetadata isSlpTokenMetadata_TypeMetadata `protobuf_oneof:"type_metadata"`
+	// Whether the block is connected to the chain.
+	Type BlockNotification_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pb.BlockNotification_Type" json:"type,omitempty"`
+	// Types that are assignable to Block:
+	//	*BlockNotification_BlockInfo
+	//	*BlockNotification_MarshaledBlock
+	//	*BlockNotification_SerializedBlock
+	Block isBlockNotification_Block `protobuf_oneof:"block"`
+	// For DISCONNECTED notifications, the hashes of the transactions of the
+	// block which were returned to the mempool.  The other transactions of the
+	// block were rejected by the mempool and dropped, along with the mempool
+	// transactions spending them.
+	ReturnedTransactionHashes [][]byte `protobuf:"bytes,5,rep,name=returned_transaction_hashes,json=returnedTransactionHashes,proto3" json:"returned_transaction_hashes,omitempty"`
+	// For CONNECTED notifications requested with include_fees, the breakdown
+	// of the coinbase of the block.
+	Fees *BlockFees `protobuf:"bytes,6,opt,name=fees,proto3" json:"fees,omitempty"`
 }
 
-func (x *SlpTokenMetadata) Reset() {
-	*x = SlpTokenMetadata{}
+func (x *BlockNotification) Reset() {
+	*x = BlockNotification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[60]
+		mi := &file_bchrpc_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SlpTokenMetadata) String() string {
+func (x *BlockNotification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SlpTokenMetadata) ProtoMessage() {}
+func (*BlockNotification) ProtoMessage() {}
 
-func (x *SlpTokenMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[60]
+func (x *BlockNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4929,107 +5247,127 @@ func (x *SlpTokenMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SlpTokenMetadata.ProtoReflect.Descriptor instead.
-func (*SlpTokenMetadata) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use BlockNotification.ProtoReflect.Descriptor instead.
+func (*BlockNotification) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *SlpTokenMetadata) GetTokenId() []byte {
+func (x *BlockNotification) GetType() BlockNotification_Type {
 	if x != nil {
-		return x.TokenId
+		return x.Type
+	}
+	return BlockNotification_CONNECTED
+}
+
+func (m *BlockNotification) GetBlock() isBlockNotification_Block {
+	if m != nil {
+		return m.Block
 	}
 	return nil
 }
 
-func (x *SlpTokenMetadata) GetTokenType() SlpTokenType {
-	if x != nil {
-		return x.TokenType
+func (x *BlockNotification) GetBlockInfo() *BlockInfo {
+	if x, ok := x.GetBlock().(*BlockNotification_BlockInfo); ok {
+		return x.BlockInfo
 	}
-	return SlpTokenType_VERSION_NOT_SET
+	return nil
 }
 
-func (m *SlpTokenMetadata) GetTypeMetadata() isSlpTokenMetadata_TypeMetadata {
-	if m != nil {
-		return m.TypeMetadata
+func (x *BlockNotification) GetMarshaledBlock() *Block {
+	if x, ok := x.GetBlock().(*BlockNotification_MarshaledBlock); ok {
+		return x.MarshaledBlock
 	}
 	return nil
 }
 
-func (x *SlpTokenMetadata) GetV1Fungible() *SlpTokenMetadata_V1Fungible {
-	if x, ok := x.GetTypeMetadata().(*SlpTokenMetadata_V1Fungible_); ok {
-		return x.V1Fungible
+func (x *BlockNotification) GetSerializedBlock() []byte {
+	if x, ok := x.GetBlock().(*BlockNotification_SerializedBlock); ok {
+		return x.SerializedBlock
 	}
 	return nil
 }
 
-func (x *SlpTokenMetadata) GetV1Nft1Group() *SlpTokenMetadata_V1NFT1Group {
-	if x, ok := x.GetTypeMetadata().(*SlpTokenMetadata_V1Nft1Group); ok {
-		return x.V1Nft1Group
+func (x *BlockNotification) GetReturnedTransactionHashes() [][]byte {
+	if x != nil {
+		return x.ReturnedTransactionHashes
 	}
 	return nil
 }
 
-func (x *SlpTokenMetadata) GetV1Nft1Child() *SlpTokenMetadata_V1NFT1Child {
-	if x, ok := x.GetTypeMetadata().(*SlpTokenMetadata_V1Nft1Child); ok {
-		return x.V1Nft1Child
+func (x *BlockNotification) GetFees() *BlockFees {
+	if x != nil {
+		return x.Fees
 	}
 	return nil
 }
 
-type isSlpTokenMetadata_TypeMetadata interface {
-	isSlpTokenMetadata_TypeMetadata()
+type isBlockNotification_Block interface {
+	isBlockNotification_Block()
 }
 
-type SlpTokenMetadata_V1Fungible_ struct {
-	V1Fungible *SlpTokenMetadata_V1Fungible `protobuf:"bytes,3,opt,name=v1_fungible,json=v1Fungible,proto3,oneof"`
+type BlockNotification_BlockInfo struct {
+	// Marshaled block header data, as well as metadata stored by the node.
+	BlockInfo *BlockInfo `protobuf:"bytes,2,opt,name=block_info,json=blockInfo,proto3,oneof"`
 }
 
-type SlpTokenMetadata_V1Nft1Group struct {
-	V1Nft1Group *SlpTokenMetadata_V1NFT1Group `protobuf:"bytes,4,opt,name=v1_nft1_group,json=v1Nft1Group,proto3,oneof"`
+type BlockNotification_MarshaledBlock struct {
+	// A Block.
+	MarshaledBlock *Block `protobuf:"bytes,3,opt,name=marshaled_block,json=marshaledBlock,proto3,oneof"`
 }
 
-type SlpTokenMetadata_V1Nft1Child struct {
-	V1Nft1Child *SlpTokenMetadata_V1NFT1Child `protobuf:"bytes,5,opt,name=v1_nft1_child,json=v1Nft1Child,proto3,oneof"`
+type BlockNotification_SerializedBlock struct {
+	// Binary block, serialized using bitcoin protocol encoding.
+	SerializedBlock []byte `protobuf:"bytes,4,opt,name=serialized_block,json=serializedBlock,proto3,oneof"`
 }
 
-func (*SlpTokenMetadata_V1Fungible_) isSlpTokenMetadata_TypeMetadata() {}
+func (*BlockNotification_BlockInfo) isBlockNotification_Block() {}
 
-func (*SlpTokenMetadata_V1Nft1Group) isSlpTokenMetadata_TypeMetadata() {}
+func (*BlockNotification_MarshaledBlock) isBlockNotification_Block() {}
 
-func (*SlpTokenMetadata_V1Nft1Child) isSlpTokenMetadata_TypeMetadata() {}
+func (*BlockNotification_SerializedBlock) isBlockNotification_Block() {}
 
-// SlpRequiredBurn is used by clients to allow token burning
-type SlpRequiredBurn struct {
+// BlockFees breaks the coinbase of a connected block down into the subsidy and
+// the fees it claims.
+type BlockFees struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Outpoint  *Transaction_Input_Outpoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
-	TokenId   []byte                      `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
-	TokenType SlpTokenType                `protobuf:"varint,3,opt,name=token_type,json=tokenType,proto3,enum=pb.SlpTokenType" json:"token_type,omitempty"`
-	// Types that are assignable to BurnIntention:
-	//	*SlpRequiredBurn_Amount
-	//	*SlpRequiredBurn_MintBatonVout
-	BurnIntention isSlpRequiredBurn_BurnIntention `protobuf_oneof:"burn_intention"`
-}
-
-func (x *SlpRequiredBurn) Reset() {
-	*x = SlpRequiredBurn{}
+	// The block subsidy in satoshis.
+	Subsidy int64 `protobuf:"varint,1,opt,name=subsidy,proto3" json:"subsidy,omitempty"`
+	// The fees claimed by the coinbase in satoshis, which is its value minus
+	// the subsidy.
+	Fees int64 `protobuf:"varint,2,opt,name=fees,proto3" json:"fees,omitempty"`
+	// The total value of the coinbase outputs in satoshis.
+	CoinbaseValue int64 `protobuf:"varint,3,opt,name=coinbase_value,json=coinbaseValue,proto3" json:"coinbase_value,omitempty"`
+	// Whether the block was built from a block template generated by the node,
+	// that is it includes exactly the transactions of the template.
+	FromTemplate bool `protobuf:"varint,4,opt,name=from_template,json=fromTemplate,proto3" json:"from_template,omitempty"`
+	// The total fees of the template in satoshis, or 0 when the block was not
+	// built from a template.
+	TemplateFees int64 `protobuf:"varint,5,opt,name=template_fees,json=templateFees,proto3" json:"template_fees,omitempty"`
+	// The fees claimed by the block minus the fees of the template in satoshis,
+	// or 0 when the block was not built from a template.
+	FeeDelta int64 `protobuf:"varint,6,opt,name=fee_delta,json=feeDelta,proto3" json:"fee_delta,omitempty"`
+}
+
+func (x *BlockFees) Reset() {
+	*x = BlockFees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[61]
+		mi := &file_bchrpc_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SlpRequiredBurn) String() string {
+func (x *BlockFees) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SlpRequiredBurn) ProtoMessage() {}
+func (*BlockFees) ProtoMessage() {}
 
-func (x *SlpRequiredBurn) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[61]
+func (x *BlockFees) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5040,99 +5378,89 @@ func (x *SlpRequiredBurn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SlpRequiredBurn.ProtoReflect.Descriptor instead.
-func (*SlpRequiredBurn) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use BlockFees.ProtoReflect.Descriptor instead.
+func (*BlockFees) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *SlpRequiredBurn) GetOutpoint() *Transaction_Input_Outpoint {
+func (x *BlockFees) GetSubsidy() int64 {
 	if x != nil {
-		return x.Outpoint
+		return x.Subsidy
 	}
-	return nil
+	return 0
 }
 
-func (x *SlpRequiredBurn) GetTokenId() []byte {
+func (x *BlockFees) GetFees() int64 {
 	if x != nil {
-		return x.TokenId
+		return x.Fees
 	}
-	return nil
+	return 0
 }
 
-func (x *SlpRequiredBurn) GetTokenType() SlpTokenType {
+func (x *BlockFees) GetCoinbaseValue() int64 {
 	if x != nil {
-		return x.TokenType
+		return x.CoinbaseValue
 	}
-	return SlpTokenType_VERSION_NOT_SET
+	return 0
 }
 
-func (m *SlpRequiredBurn) GetBurnIntention() isSlpRequiredBurn_BurnIntention {
-	if m != nil {
-		return m.BurnIntention
+func (x *BlockFees) GetFromTemplate() bool {
+	if x != nil {
+		return x.FromTemplate
 	}
-	return nil
+	return false
 }
 
-func (x *SlpRequiredBurn) GetAmount() uint64 {
-	if x, ok := x.GetBurnIntention().(*SlpRequiredBurn_Amount); ok {
-		return x.Amount
+func (x *BlockFees) GetTemplateFees() int64 {
+	if x != nil {
+		return x.TemplateFees
 	}
 	return 0
 }
 
-func (x *SlpRequiredBurn) GetMintBatonVout() uint32 {
-	if x, ok := x.GetBurnIntention().(*SlpRequiredBurn_MintBatonVout); ok {
-		return x.MintBatonVout
+func (x *BlockFees) GetFeeDelta() int64 {
+	if x != nil {
+		return x.FeeDelta
 	}
 	return 0
 }
 
-type isSlpRequiredBurn_BurnIntention interface {
-	isSlpRequiredBurn_BurnIntention()
-}
-
-type SlpRequiredBurn_Amount struct {
-	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3,oneof"`
-}
-
-type SlpRequiredBurn_MintBatonVout struct {
-	MintBatonVout uint32 `protobuf:"varint,5,opt,name=mint_baton_vout,json=mintBatonVout,proto3,oneof"`
-}
-
-func (*SlpRequiredBurn_Amount) isSlpRequiredBurn_BurnIntention() {}
-
-func (*SlpRequiredBurn_MintBatonVout) isSlpRequiredBurn_BurnIntention() {}
-
-type GetMempoolResponse_TransactionData struct {
+type DepositNotification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Either one of the two following is provided, depending on the request.
-	//
-	// Types that are assignable to TxidsOrTxs:
-	//	*GetMempoolResponse_TransactionData_TransactionHash
-	//	*GetMempoolResponse_TransactionData_Transaction
-	TxidsOrTxs isGetMempoolResponse_TransactionData_TxidsOrTxs `protobuf_oneof:"txids_or_txs"`
-}
-
-func (x *GetMempoolResponse_TransactionData) Reset() {
-	*x = GetMempoolResponse_TransactionData{}
+	Type DepositNotification_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pb.DepositNotification_Type" json:"type,omitempty"`
+	// The hash of the transaction making the payment.
+	TransactionHash []byte `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	// The outputs of the transaction paying to the watched addresses.
+	Outputs []*Transaction_Output `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// The confirmation count reached for CONFIRMED notifications, zero
+	// otherwise.
+	Confirmations int32 `protobuf:"varint,4,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	// The hash and height of the block confirming the payment for CONFIRMED
+	// notifications, and of the disconnected block for REMOVED ones.
+	BlockHash   []byte `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight int32  `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *DepositNotification) Reset() {
+	*x = DepositNotification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[62]
+		mi := &file_bchrpc_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMempoolResponse_TransactionData) String() string {
+func (x *DepositNotification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMempoolResponse_TransactionData) ProtoMessage() {}
+func (*DepositNotification) ProtoMessage() {}
 
-func (x *GetMempoolResponse_TransactionData) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[62]
+func (x *DepositNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5143,79 +5471,89 @@ func (x *GetMempoolResponse_TransactionData) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMempoolResponse_TransactionData.ProtoReflect.Descriptor instead.
-func (*GetMempoolResponse_TransactionData) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use DepositNotification.ProtoReflect.Descriptor instead.
+func (*DepositNotification) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{66}
 }
 
-func (m *GetMempoolResponse_TransactionData) GetTxidsOrTxs() isGetMempoolResponse_TransactionData_TxidsOrTxs {
-	if m != nil {
-		return m.TxidsOrTxs
+func (x *DepositNotification) GetType() DepositNotification_Type {
+	if x != nil {
+		return x.Type
 	}
-	return nil
+	return DepositNotification_UNCONFIRMED
 }
 
-func (x *GetMempoolResponse_TransactionData) GetTransactionHash() []byte {
-	if x, ok := x.GetTxidsOrTxs().(*GetMempoolResponse_TransactionData_TransactionHash); ok {
+func (x *DepositNotification) GetTransactionHash() []byte {
+	if x != nil {
 		return x.TransactionHash
 	}
 	return nil
 }
 
-func (x *GetMempoolResponse_TransactionData) GetTransaction() *Transaction {
-	if x, ok := x.GetTxidsOrTxs().(*GetMempoolResponse_TransactionData_Transaction); ok {
-		return x.Transaction
+func (x *DepositNotification) GetOutputs() []*Transaction_Output {
+	if x != nil {
+		return x.Outputs
 	}
 	return nil
 }
 
-type isGetMempoolResponse_TransactionData_TxidsOrTxs interface {
-	isGetMempoolResponse_TransactionData_TxidsOrTxs()
-}
-
-type GetMempoolResponse_TransactionData_TransactionHash struct {
-	// The transaction hash, little-endian.
-	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3,oneof"`
-}
-
-type GetMempoolResponse_TransactionData_Transaction struct {
-	// The transaction data.
-	Transaction *Transaction `protobuf:"bytes,2,opt,name=transaction,proto3,oneof"`
+func (x *DepositNotification) GetConfirmations() int32 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
 }
 
-func (*GetMempoolResponse_TransactionData_TransactionHash) isGetMempoolResponse_TransactionData_TxidsOrTxs() {
+func (x *DepositNotification) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
 }
 
-func (*GetMempoolResponse_TransactionData_Transaction) isGetMempoolResponse_TransactionData_TxidsOrTxs() {
+func (x *DepositNotification) GetBlockHeight() int32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
 }
 
-type GetSlpTrustedValidationRequest_Query struct {
+type DoubleSpendProofNotification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PrevOutHash            []byte   `protobuf:"bytes,1,opt,name=prev_out_hash,json=prevOutHash,proto3" json:"prev_out_hash,omitempty"`
-	PrevOutVout            uint32   `protobuf:"varint,2,opt,name=prev_out_vout,json=prevOutVout,proto3" json:"prev_out_vout,omitempty"`
-	GraphsearchValidHashes [][]byte `protobuf:"bytes,3,rep,name=graphsearch_valid_hashes,json=graphsearchValidHashes,proto3" json:"graphsearch_valid_hashes,omitempty"`
-}
-
-func (x *GetSlpTrustedValidationRequest_Query) Reset() {
-	*x = GetSlpTrustedValidationRequest_Query{}
+	// The hash of the double-spend proof, little-endian.
+	ProofHash []byte `protobuf:"bytes,1,opt,name=proof_hash,json=proofHash,proto3" json:"proof_hash,omitempty"`
+	// The output spent by two different transactions.
+	Outpoint *Transaction_Input_Outpoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// The hash of the mempool transaction spending the output, little-endian.
+	TransactionHash []byte `protobuf:"bytes,3,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	// The hashes of the mempool transactions depending on the transaction
+	// spending the output, little-endian.
+	DescendantHashes [][]byte `protobuf:"bytes,4,rep,name=descendant_hashes,json=descendantHashes,proto3" json:"descendant_hashes,omitempty"`
+	// The proof, serialized as a dsproof-beta message using bitcoin protocol
+	// encoding.
+	SerializedProof []byte `protobuf:"bytes,5,opt,name=serialized_proof,json=serializedProof,proto3" json:"serialized_proof,omitempty"`
+}
+
+func (x *DoubleSpendProofNotification) Reset() {
+	*x = DoubleSpendProofNotification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[63]
+		mi := &file_bchrpc_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSlpTrustedValidationRequest_Query) String() string {
+func (x *DoubleSpendProofNotification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSlpTrustedValidationRequest_Query) ProtoMessage() {}
+func (*DoubleSpendProofNotification) ProtoMessage() {}
 
-func (x *GetSlpTrustedValidationRequest_Query) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[63]
+func (x *DoubleSpendProofNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5226,67 +5564,79 @@ func (x *GetSlpTrustedValidationRequest_Query) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSlpTrustedValidationRequest_Query.ProtoReflect.Descriptor instead.
-func (*GetSlpTrustedValidationRequest_Query) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{40, 0}
+// Deprecated: Use DoubleSpendProofNotification.ProtoReflect.Descriptor instead.
+func (*DoubleSpendProofNotification) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GetSlpTrustedValidationRequest_Query) GetPrevOutHash() []byte {
+func (x *DoubleSpendProofNotification) GetProofHash() []byte {
 	if x != nil {
-		return x.PrevOutHash
+		return x.ProofHash
 	}
 	return nil
 }
 
-func (x *GetSlpTrustedValidationRequest_Query) GetPrevOutVout() uint32 {
+func (x *DoubleSpendProofNotification) GetOutpoint() *Transaction_Input_Outpoint {
 	if x != nil {
-		return x.PrevOutVout
+		return x.Outpoint
 	}
-	return 0
+	return nil
 }
 
-func (x *GetSlpTrustedValidationRequest_Query) GetGraphsearchValidHashes() [][]byte {
+func (x *DoubleSpendProofNotification) GetTransactionHash() []byte {
 	if x != nil {
-		return x.GraphsearchValidHashes
+		return x.TransactionHash
 	}
 	return nil
 }
 
-type GetSlpTrustedValidationResponse_ValidityResult struct {
+func (x *DoubleSpendProofNotification) GetDescendantHashes() [][]byte {
+	if x != nil {
+		return x.DescendantHashes
+	}
+	return nil
+}
+
+func (x *DoubleSpendProofNotification) GetSerializedProof() []byte {
+	if x != nil {
+		return x.SerializedProof
+	}
+	return nil
+}
+
+type TransactionNotification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PrevOutHash []byte       `protobuf:"bytes,1,opt,name=prev_out_hash,json=prevOutHash,proto3" json:"prev_out_hash,omitempty"`
-	PrevOutVout uint32       `protobuf:"varint,2,opt,name=prev_out_vout,json=prevOutVout,proto3" json:"prev_out_vout,omitempty"`
-	TokenId     []byte       `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
-	SlpAction   SlpAction    `protobuf:"varint,4,opt,name=slp_action,json=slpAction,proto3,enum=pb.SlpAction" json:"slp_action,omitempty"`
-	TokenType   SlpTokenType `protobuf:"varint,5,opt,name=token_type,json=tokenType,proto3,enum=pb.SlpTokenType" json:"token_type,omitempty"`
-	// Types that are assignable to ValidityResultType:
-	//	*GetSlpTrustedValidationResponse_ValidityResult_V1TokenAmount
-	//	*GetSlpTrustedValidationResponse_ValidityResult_V1MintBaton
-	ValidityResultType  isGetSlpTrustedValidationResponse_ValidityResult_ValidityResultType `protobuf_oneof:"validity_result_type"`
-	SlpTxnOpreturn      []byte                                                              `protobuf:"bytes,8,opt,name=slp_txn_opreturn,json=slpTxnOpreturn,proto3" json:"slp_txn_opreturn,omitempty"`
-	GraphsearchTxnCount uint32                                                              `protobuf:"varint,9,opt,name=graphsearch_txn_count,json=graphsearchTxnCount,proto3" json:"graphsearch_txn_count,omitempty"`
+	// Whether or not the transaction has been included in a block.
+	Type TransactionNotification_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pb.TransactionNotification_Type" json:"type,omitempty"`
+	// Types that are assignable to Transaction:
+	//	*TransactionNotification_ConfirmedTransaction
+	//	*TransactionNotification_UnconfirmedTransaction
+	//	*TransactionNotification_SerializedTransaction
+	Transaction isTransactionNotification_Transaction `protobuf_oneof:"transaction"`
+	// Tokens destroyed by the transaction, when include_token_burns is set.
+	TokenBurns []*TokenBurn `protobuf:"bytes,5,rep,name=token_burns,json=tokenBurns,proto3" json:"token_burns,omitempty"`
 }
 
-func (x *GetSlpTrustedValidationResponse_ValidityResult) Reset() {
-	*x = GetSlpTrustedValidationResponse_ValidityResult{}
+func (x *TransactionNotification) Reset() {
+	*x = TransactionNotification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[64]
+		mi := &file_bchrpc_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSlpTrustedValidationResponse_ValidityResult) String() string {
+func (x *TransactionNotification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSlpTrustedValidationResponse_ValidityResult) ProtoMessage() {}
+func (*TransactionNotification) ProtoMessage() {}
 
-func (x *GetSlpTrustedValidationResponse_ValidityResult) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[64]
+func (x *TransactionNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5297,127 +5647,123 @@ func (x *GetSlpTrustedValidationResponse_ValidityResult) ProtoReflect() protoref
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSlpTrustedValidationResponse_ValidityResult.ProtoReflect.Descriptor instead.
-func (*GetSlpTrustedValidationResponse_ValidityResult) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{41, 0}
-}
-
-func (x *GetSlpTrustedValidationResponse_ValidityResult) GetPrevOutHash() []byte {
-	if x != nil {
-		return x.PrevOutHash
-	}
-	return nil
+// Deprecated: Use TransactionNotification.ProtoReflect.Descriptor instead.
+func (*TransactionNotification) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetSlpTrustedValidationResponse_ValidityResult) GetPrevOutVout() uint32 {
+func (x *TransactionNotification) GetType() TransactionNotification_Type {
 	if x != nil {
-		return x.PrevOutVout
+		return x.Type
 	}
-	return 0
+	return TransactionNotification_UNCONFIRMED
 }
 
-func (x *GetSlpTrustedValidationResponse_ValidityResult) GetTokenId() []byte {
-	if x != nil {
-		return x.TokenId
+func (m *TransactionNotification) GetTransaction() isTransactionNotification_Transaction {
+	if m != nil {
+		return m.Transaction
 	}
 	return nil
 }
 
-func (x *GetSlpTrustedValidationResponse_ValidityResult) GetSlpAction() SlpAction {
-	if x != nil {
-		return x.SlpAction
-	}
-	return SlpAction_NON_SLP
-}
-
-func (x *GetSlpTrustedValidationResponse_ValidityResult) GetTokenType() SlpTokenType {
-	if x != nil {
-		return x.TokenType
-	}
-	return SlpTokenType_VERSION_NOT_SET
-}
-
-func (m *GetSlpTrustedValidationResponse_ValidityResult) GetValidityResultType() isGetSlpTrustedValidationResponse_ValidityResult_ValidityResultType {
-	if m != nil {
-		return m.ValidityResultType
+func (x *TransactionNotification) GetConfirmedTransaction() *Transaction {
+	if x, ok := x.GetTransaction().(*TransactionNotification_ConfirmedTransaction); ok {
+		return x.ConfirmedTransaction
 	}
 	return nil
 }
 
-func (x *GetSlpTrustedValidationResponse_ValidityResult) GetV1TokenAmount() uint64 {
-	if x, ok := x.GetValidityResultType().(*GetSlpTrustedValidationResponse_ValidityResult_V1TokenAmount); ok {
-		return x.V1TokenAmount
+func (x *TransactionNotification) GetUnconfirmedTransaction() *MempoolTransaction {
+	if x, ok := x.GetTransaction().(*TransactionNotification_UnconfirmedTransaction); ok {
+		return x.UnconfirmedTransaction
 	}
-	return 0
+	return nil
 }
 
-func (x *GetSlpTrustedValidationResponse_ValidityResult) GetV1MintBaton() bool {
-	if x, ok := x.GetValidityResultType().(*GetSlpTrustedValidationResponse_ValidityResult_V1MintBaton); ok {
-		return x.V1MintBaton
+func (x *TransactionNotification) GetSerializedTransaction() []byte {
+	if x, ok := x.GetTransaction().(*TransactionNotification_SerializedTransaction); ok {
+		return x.SerializedTransaction
 	}
-	return false
+	return nil
 }
 
-func (x *GetSlpTrustedValidationResponse_ValidityResult) GetSlpTxnOpreturn() []byte {
+func (x *TransactionNotification) GetTokenBurns() []*TokenBurn {
 	if x != nil {
-		return x.SlpTxnOpreturn
+		return x.TokenBurns
 	}
 	return nil
 }
 
-func (x *GetSlpTrustedValidationResponse_ValidityResult) GetGraphsearchTxnCount() uint32 {
-	if x != nil {
-		return x.GraphsearchTxnCount
-	}
-	return 0
+type isTransactionNotification_Transaction interface {
+	isTransactionNotification_Transaction()
 }
 
-type isGetSlpTrustedValidationResponse_ValidityResult_ValidityResultType interface {
-	isGetSlpTrustedValidationResponse_ValidityResult_ValidityResultType()
+type TransactionNotification_ConfirmedTransaction struct {
+	// A transaction included in a block.
+	ConfirmedTransaction *Transaction `protobuf:"bytes,2,opt,name=confirmed_transaction,json=confirmedTransaction,proto3,oneof"`
 }
 
-type GetSlpTrustedValidationResponse_ValidityResult_V1TokenAmount struct {
-	V1TokenAmount uint64 `protobuf:"varint,6,opt,name=v1_token_amount,json=v1TokenAmount,proto3,oneof"`
+type TransactionNotification_UnconfirmedTransaction struct {
+	// A transaction in mempool.
+	UnconfirmedTransaction *MempoolTransaction `protobuf:"bytes,3,opt,name=unconfirmed_transaction,json=unconfirmedTransaction,proto3,oneof"`
 }
 
-type GetSlpTrustedValidationResponse_ValidityResult_V1MintBaton struct {
-	V1MintBaton bool `protobuf:"varint,7,opt,name=v1_mint_baton,json=v1MintBaton,proto3,oneof"`
+type TransactionNotification_SerializedTransaction struct {
+	// Binary transaction, serialized using bitcoin protocol encoding.
+	SerializedTransaction []byte `protobuf:"bytes,4,opt,name=serialized_transaction,json=serializedTransaction,proto3,oneof"`
 }
 
-func (*GetSlpTrustedValidationResponse_ValidityResult_V1TokenAmount) isGetSlpTrustedValidationResponse_ValidityResult_ValidityResultType() {
-}
+func (*TransactionNotification_ConfirmedTransaction) isTransactionNotification_Transaction() {}
 
-func (*GetSlpTrustedValidationResponse_ValidityResult_V1MintBaton) isGetSlpTrustedValidationResponse_ValidityResult_ValidityResultType() {
-}
+func (*TransactionNotification_UnconfirmedTransaction) isTransactionNotification_Transaction() {}
 
-type Block_TransactionData struct {
+func (*TransactionNotification_SerializedTransaction) isTransactionNotification_Transaction() {}
+
+// TokenBurn describes the tokens of a single slp token or CashToken category
+// destroyed by a transaction, being the tokens in the inputs which are not
+// carried over to the outputs.
+//
+// WARNING: Some languages (e.g., JavaScript) may not properly handle the 'uint64'
+// for large amounts. For this reason, an annotation has been added for JS to
+// return a string for the amount fields instead of casting uint64 to the JS 'number'
+// type. Other languages may require similar treatment.
+type TokenBurn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to TxidsOrTxs:
-	//	*Block_TransactionData_TransactionHash
-	//	*Block_TransactionData_Transaction
-	TxidsOrTxs isBlock_TransactionData_TxidsOrTxs `protobuf_oneof:"txids_or_txs"`
-}
-
-func (x *Block_TransactionData) Reset() {
-	*x = Block_TransactionData{}
+	Kind TokenMetadata_TokenKind `protobuf:"varint,1,opt,name=kind,proto3,enum=pb.TokenMetadata_TokenKind" json:"kind,omitempty"`
+	// token_id is the slp token id or the CashToken category, in the same byte
+	// order as SlpToken.token_id and CashToken.category_id respectively.
+	TokenId      []byte `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	InputAmount  uint64 `protobuf:"varint,3,opt,name=input_amount,json=inputAmount,proto3" json:"input_amount,omitempty"`
+	OutputAmount uint64 `protobuf:"varint,4,opt,name=output_amount,json=outputAmount,proto3" json:"output_amount,omitempty"`
+	BurnedAmount uint64 `protobuf:"varint,5,opt,name=burned_amount,json=burnedAmount,proto3" json:"burned_amount,omitempty"`
+	// burned_nfts is the number of CashToken NFTs in the inputs in excess of
+	// the NFTs in the outputs.
+	BurnedNfts uint32 `protobuf:"varint,6,opt,name=burned_nfts,json=burnedNfts,proto3" json:"burned_nfts,omitempty"`
+	// mint_baton_burned is set when an slp mint baton or a CashToken minting
+	// NFT in the inputs has no counterpart in the outputs.
+	MintBatonBurned bool `protobuf:"varint,7,opt,name=mint_baton_burned,json=mintBatonBurned,proto3" json:"mint_baton_burned,omitempty"`
+}
+
+func (x *TokenBurn) Reset() {
+	*x = TokenBurn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[65]
+		mi := &file_bchrpc_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Block_TransactionData) String() string {
+func (x *TokenBurn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Block_TransactionData) ProtoMessage() {}
+func (*TokenBurn) ProtoMessage() {}
 
-func (x *Block_TransactionData) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[65]
+func (x *TokenBurn) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5428,92 +5774,114 @@ func (x *Block_TransactionData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Block_TransactionData.ProtoReflect.Descriptor instead.
-func (*Block_TransactionData) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{47, 0}
+// Deprecated: Use TokenBurn.ProtoReflect.Descriptor instead.
+func (*TokenBurn) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{69}
 }
 
-func (m *Block_TransactionData) GetTxidsOrTxs() isBlock_TransactionData_TxidsOrTxs {
-	if m != nil {
-		return m.TxidsOrTxs
+func (x *TokenBurn) GetKind() TokenMetadata_TokenKind {
+	if x != nil {
+		return x.Kind
 	}
-	return nil
+	return TokenMetadata_SLP
 }
 
-func (x *Block_TransactionData) GetTransactionHash() []byte {
-	if x, ok := x.GetTxidsOrTxs().(*Block_TransactionData_TransactionHash); ok {
-		return x.TransactionHash
+func (x *TokenBurn) GetTokenId() []byte {
+	if x != nil {
+		return x.TokenId
 	}
 	return nil
 }
 
-func (x *Block_TransactionData) GetTransaction() *Transaction {
-	if x, ok := x.GetTxidsOrTxs().(*Block_TransactionData_Transaction); ok {
-		return x.Transaction
+func (x *TokenBurn) GetInputAmount() uint64 {
+	if x != nil {
+		return x.InputAmount
 	}
-	return nil
+	return 0
 }
 
-type isBlock_TransactionData_TxidsOrTxs interface {
-	isBlock_TransactionData_TxidsOrTxs()
+func (x *TokenBurn) GetOutputAmount() uint64 {
+	if x != nil {
+		return x.OutputAmount
+	}
+	return 0
 }
 
-type Block_TransactionData_TransactionHash struct {
-	// Just the transaction hash, little-endian.
-	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3,oneof"`
+func (x *TokenBurn) GetBurnedAmount() uint64 {
+	if x != nil {
+		return x.BurnedAmount
+	}
+	return 0
 }
 
-type Block_TransactionData_Transaction struct {
-	// A marshaled transaction.
-	Transaction *Transaction `protobuf:"bytes,2,opt,name=transaction,proto3,oneof"`
+func (x *TokenBurn) GetBurnedNfts() uint32 {
+	if x != nil {
+		return x.BurnedNfts
+	}
+	return 0
 }
 
-func (*Block_TransactionData_TransactionHash) isBlock_TransactionData_TxidsOrTxs() {}
-
-func (*Block_TransactionData_Transaction) isBlock_TransactionData_TxidsOrTxs() {}
+func (x *TokenBurn) GetMintBatonBurned() bool {
+	if x != nil {
+		return x.MintBatonBurned
+	}
+	return false
+}
 
-type Transaction_Input struct {
+// Metadata for identifying and validating a block
+type BlockInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The number of the input, starting from zero.
-	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	// The related outpoint.
-	Outpoint *Transaction_Input_Outpoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
-	// An unlocking script asserting a transaction is permitted to spend
-	// the Outpoint (UTXO)
-	SignatureScript []byte `protobuf:"bytes,3,opt,name=signature_script,json=signatureScript,proto3" json:"signature_script,omitempty"`
-	// As of BIP-68, the sequence number is interpreted as a relative
-	// lock-time for the input.
-	Sequence uint32 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	// Amount in satoshi.
-	Value int64 `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
-	// The pubkey_script of the previous output that is being spent.
-	PreviousScript []byte `protobuf:"bytes,6,opt,name=previous_script,json=previousScript,proto3" json:"previous_script,omitempty"`
-	// The bitcoin addresses associated with this input.
-	Address   string     `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
-	SlpToken  *SlpToken  `protobuf:"bytes,8,opt,name=slp_token,json=slpToken,proto3" json:"slp_token,omitempty"`
-	CashToken *CashToken `protobuf:"bytes,9,opt,name=cash_token,json=cashToken,proto3" json:"cash_token,omitempty"`
+	// The double sha256 hash of the six header fields in the first 80 bytes
+	// of the block, when encoded according the bitcoin protocol, little-endian.
+	// sha256(sha256(encoded_header))
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// The block number, an incremental index for each block mined.
+	Height int32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// A version number to track software/protocol upgrades.
+	Version int32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// Hash of the previous block, little-endian.
+	PreviousBlock []byte `protobuf:"bytes,4,opt,name=previous_block,json=previousBlock,proto3" json:"previous_block,omitempty"`
+	// The root of the Merkle Tree built from all transactions in the block, little-endian.
+	MerkleRoot []byte `protobuf:"bytes,5,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// When mining of the block started, expressed in seconds since 1970-01-01.
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Difficulty in Compressed Target Format.
+	Bits uint32 `protobuf:"varint,7,opt,name=bits,proto3" json:"bits,omitempty"`
+	// A random value that was generated during block mining which happened to
+	// result in a computed block hash below the difficulty target at the time.
+	Nonce uint32 `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Number of blocks in a chain, including the block itself upon creation.
+	Confirmations int32 `protobuf:"varint,9,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	// Difficulty target at time of creation.
+	Difficulty float64 `protobuf:"fixed64,10,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	// Hash of the next block in this chain, little-endian.
+	NextBlockHash []byte `protobuf:"bytes,11,opt,name=next_block_hash,json=nextBlockHash,proto3" json:"next_block_hash,omitempty"`
+	// Size of the block in bytes.
+	Size int32 `protobuf:"varint,12,opt,name=size,proto3" json:"size,omitempty"`
+	// The median block time of the latest 11 block timestamps.
+	MedianTime int64 `protobuf:"varint,13,opt,name=median_time,json=medianTime,proto3" json:"median_time,omitempty"`
 }
 
-func (x *Transaction_Input) Reset() {
-	*x = Transaction_Input{}
+func (x *BlockInfo) Reset() {
+	*x = BlockInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[66]
+		mi := &file_bchrpc_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Transaction_Input) String() string {
+func (x *BlockInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transaction_Input) ProtoMessage() {}
+func (*BlockInfo) ProtoMessage() {}
 
-func (x *Transaction_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[66]
+func (x *BlockInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5524,211 +5892,130 @@ func (x *Transaction_Input) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transaction_Input.ProtoReflect.Descriptor instead.
-func (*Transaction_Input) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{48, 0}
-}
-
-func (x *Transaction_Input) GetIndex() uint32 {
-	if x != nil {
-		return x.Index
-	}
-	return 0
-}
-
-func (x *Transaction_Input) GetOutpoint() *Transaction_Input_Outpoint {
-	if x != nil {
-		return x.Outpoint
-	}
-	return nil
+// Deprecated: Use BlockInfo.ProtoReflect.Descriptor instead.
+func (*BlockInfo) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *Transaction_Input) GetSignatureScript() []byte {
+func (x *BlockInfo) GetHash() []byte {
 	if x != nil {
-		return x.SignatureScript
+		return x.Hash
 	}
 	return nil
 }
 
-func (x *Transaction_Input) GetSequence() uint32 {
+func (x *BlockInfo) GetHeight() int32 {
 	if x != nil {
-		return x.Sequence
+		return x.Height
 	}
 	return 0
 }
 
-func (x *Transaction_Input) GetValue() int64 {
+func (x *BlockInfo) GetVersion() int32 {
 	if x != nil {
-		return x.Value
+		return x.Version
 	}
 	return 0
 }
 
-func (x *Transaction_Input) GetPreviousScript() []byte {
-	if x != nil {
-		return x.PreviousScript
-	}
-	return nil
-}
-
-func (x *Transaction_Input) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *Transaction_Input) GetSlpToken() *SlpToken {
+func (x *BlockInfo) GetPreviousBlock() []byte {
 	if x != nil {
-		return x.SlpToken
+		return x.PreviousBlock
 	}
 	return nil
 }
 
-func (x *Transaction_Input) GetCashToken() *CashToken {
+func (x *BlockInfo) GetMerkleRoot() []byte {
 	if x != nil {
-		return x.CashToken
+		return x.MerkleRoot
 	}
 	return nil
 }
 
-type Transaction_Output struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The number of the output, starting from zero.
-	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	// The number of satoshis to be transferred.
-	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
-	// The public key script used to pay coins.
-	PubkeyScript []byte `protobuf:"bytes,3,opt,name=pubkey_script,json=pubkeyScript,proto3" json:"pubkey_script,omitempty"`
-	// The bitcoin addresses associated with this output.
-	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
-	// The type of script.
-	ScriptClass string `protobuf:"bytes,5,opt,name=script_class,json=scriptClass,proto3" json:"script_class,omitempty"`
-	// The script expressed in Bitcoin Cash Script.
-	DisassembledScript string     `protobuf:"bytes,6,opt,name=disassembled_script,json=disassembledScript,proto3" json:"disassembled_script,omitempty"`
-	SlpToken           *SlpToken  `protobuf:"bytes,7,opt,name=slp_token,json=slpToken,proto3" json:"slp_token,omitempty"`
-	CashToken          *CashToken `protobuf:"bytes,8,opt,name=cash_token,json=cashToken,proto3" json:"cash_token,omitempty"`
-}
-
-func (x *Transaction_Output) Reset() {
-	*x = Transaction_Output{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[67]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Transaction_Output) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Transaction_Output) ProtoMessage() {}
-
-func (x *Transaction_Output) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[67]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Transaction_Output.ProtoReflect.Descriptor instead.
-func (*Transaction_Output) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{48, 1}
-}
-
-func (x *Transaction_Output) GetIndex() uint32 {
+func (x *BlockInfo) GetTimestamp() int64 {
 	if x != nil {
-		return x.Index
+		return x.Timestamp
 	}
 	return 0
 }
 
-func (x *Transaction_Output) GetValue() int64 {
+func (x *BlockInfo) GetBits() uint32 {
 	if x != nil {
-		return x.Value
+		return x.Bits
 	}
 	return 0
 }
 
-func (x *Transaction_Output) GetPubkeyScript() []byte {
+func (x *BlockInfo) GetNonce() uint32 {
 	if x != nil {
-		return x.PubkeyScript
+		return x.Nonce
 	}
-	return nil
+	return 0
 }
 
-func (x *Transaction_Output) GetAddress() string {
+func (x *BlockInfo) GetConfirmations() int32 {
 	if x != nil {
-		return x.Address
+		return x.Confirmations
 	}
-	return ""
+	return 0
 }
 
-func (x *Transaction_Output) GetScriptClass() string {
+func (x *BlockInfo) GetDifficulty() float64 {
 	if x != nil {
-		return x.ScriptClass
+		return x.Difficulty
 	}
-	return ""
+	return 0
 }
 
-func (x *Transaction_Output) GetDisassembledScript() string {
+func (x *BlockInfo) GetNextBlockHash() []byte {
 	if x != nil {
-		return x.DisassembledScript
+		return x.NextBlockHash
 	}
-	return ""
+	return nil
 }
 
-func (x *Transaction_Output) GetSlpToken() *SlpToken {
+func (x *BlockInfo) GetSize() int32 {
 	if x != nil {
-		return x.SlpToken
+		return x.Size
 	}
-	return nil
+	return 0
 }
 
-func (x *Transaction_Output) GetCashToken() *CashToken {
+func (x *BlockInfo) GetMedianTime() int64 {
 	if x != nil {
-		return x.CashToken
+		return x.MedianTime
 	}
-	return nil
+	return 0
 }
 
-type Transaction_Input_Outpoint struct {
+type Block struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The hash of the transaction containing the output to be spent, little-endian
-	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	// The index of specific output on the transaction.
-	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Block header data, as well as metadata stored by the node.
+	Info *BlockInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	// List of transactions or transaction hashes.
+	TransactionData []*Block_TransactionData `protobuf:"bytes,2,rep,name=transaction_data,json=transactionData,proto3" json:"transaction_data,omitempty"`
 }
 
-func (x *Transaction_Input_Outpoint) Reset() {
-	*x = Transaction_Input_Outpoint{}
+func (x *Block) Reset() {
+	*x = Block{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[68]
+		mi := &file_bchrpc_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Transaction_Input_Outpoint) String() string {
+func (x *Block) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transaction_Input_Outpoint) ProtoMessage() {}
+func (*Block) ProtoMessage() {}
 
-func (x *Transaction_Input_Outpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[68]
+func (x *Block) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5739,57 +6026,74 @@ func (x *Transaction_Input_Outpoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transaction_Input_Outpoint.ProtoReflect.Descriptor instead.
-func (*Transaction_Input_Outpoint) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{48, 0, 0}
+// Deprecated: Use Block.ProtoReflect.Descriptor instead.
+func (*Block) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *Transaction_Input_Outpoint) GetHash() []byte {
+func (x *Block) GetInfo() *BlockInfo {
 	if x != nil {
-		return x.Hash
+		return x.Info
 	}
 	return nil
 }
 
-func (x *Transaction_Input_Outpoint) GetIndex() uint32 {
+func (x *Block) GetTransactionData() []*Block_TransactionData {
 	if x != nil {
-		return x.Index
+		return x.TransactionData
 	}
-	return 0
+	return nil
 }
 
-// V1Fungible is used to marshal metadata specific to Type 1 token IDs
-type SlpTokenMetadata_V1Fungible struct {
+type Transaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TokenTicker       string `protobuf:"bytes,1,opt,name=token_ticker,json=tokenTicker,proto3" json:"token_ticker,omitempty"`
-	TokenName         string `protobuf:"bytes,2,opt,name=token_name,json=tokenName,proto3" json:"token_name,omitempty"`
-	TokenDocumentUrl  string `protobuf:"bytes,3,opt,name=token_document_url,json=tokenDocumentUrl,proto3" json:"token_document_url,omitempty"`
-	TokenDocumentHash []byte `protobuf:"bytes,4,opt,name=token_document_hash,json=tokenDocumentHash,proto3" json:"token_document_hash,omitempty"`
-	Decimals          uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
-	MintBatonHash     []byte `protobuf:"bytes,6,opt,name=mint_baton_hash,json=mintBatonHash,proto3" json:"mint_baton_hash,omitempty"`
-	MintBatonVout     uint32 `protobuf:"varint,7,opt,name=mint_baton_vout,json=mintBatonVout,proto3" json:"mint_baton_vout,omitempty"`
+	// The double sha256 hash of the encoded transaction, little-endian.
+	// sha256(sha256(encoded_transaction))
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// The version of the transaction format.
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// List of inputs.
+	Inputs []*Transaction_Input `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// List of outputs.
+	Outputs []*Transaction_Output `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// The block height or timestamp after which this transaction is allowed.
+	// If value is greater than 500 million, it is assumed to be an epoch timestamp,
+	// otherwise it is treated as a block-height. Default is zero, or lock.
+	LockTime uint32 `protobuf:"varint,5,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+	// The size of the transaction in bytes.
+	Size int32 `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+	// When the transaction was included in a block, in epoch time.
+	Timestamp int64 `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Number of blocks including proof of the transaction, including
+	// the block it appeared.
+	Confirmations int32 `protobuf:"varint,10,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	// Number of the block containing the transaction.
+	BlockHeight int32 `protobuf:"varint,11,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Hash of the block the transaction was recorded in, little-endian.
+	BlockHash          []byte              `protobuf:"bytes,12,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	SlpTransactionInfo *SlpTransactionInfo `protobuf:"bytes,13,opt,name=slp_transaction_info,json=slpTransactionInfo,proto3" json:"slp_transaction_info,omitempty"`
 }
 
-func (x *SlpTokenMetadata_V1Fungible) Reset() {
-	*x = SlpTokenMetadata_V1Fungible{}
+func (x *Transaction) Reset() {
+	*x = Transaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[69]
+		mi := &file_bchrpc_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SlpTokenMetadata_V1Fungible) String() string {
+func (x *Transaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SlpTokenMetadata_V1Fungible) ProtoMessage() {}
+func (*Transaction) ProtoMessage() {}
 
-func (x *SlpTokenMetadata_V1Fungible) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[69]
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5800,92 +6104,123 @@ func (x *SlpTokenMetadata_V1Fungible) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SlpTokenMetadata_V1Fungible.ProtoReflect.Descriptor instead.
-func (*SlpTokenMetadata_V1Fungible) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{60, 0}
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *SlpTokenMetadata_V1Fungible) GetTokenTicker() string {
+func (x *Transaction) GetHash() []byte {
 	if x != nil {
-		return x.TokenTicker
+		return x.Hash
 	}
-	return ""
+	return nil
 }
 
-func (x *SlpTokenMetadata_V1Fungible) GetTokenName() string {
+func (x *Transaction) GetVersion() int32 {
 	if x != nil {
-		return x.TokenName
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *SlpTokenMetadata_V1Fungible) GetTokenDocumentUrl() string {
+func (x *Transaction) GetInputs() []*Transaction_Input {
 	if x != nil {
-		return x.TokenDocumentUrl
+		return x.Inputs
 	}
-	return ""
+	return nil
 }
 
-func (x *SlpTokenMetadata_V1Fungible) GetTokenDocumentHash() []byte {
+func (x *Transaction) GetOutputs() []*Transaction_Output {
 	if x != nil {
-		return x.TokenDocumentHash
+		return x.Outputs
 	}
 	return nil
 }
 
-func (x *SlpTokenMetadata_V1Fungible) GetDecimals() uint32 {
+func (x *Transaction) GetLockTime() uint32 {
 	if x != nil {
-		return x.Decimals
+		return x.LockTime
 	}
 	return 0
 }
 
-func (x *SlpTokenMetadata_V1Fungible) GetMintBatonHash() []byte {
+func (x *Transaction) GetSize() int32 {
 	if x != nil {
-		return x.MintBatonHash
+		return x.Size
 	}
-	return nil
+	return 0
 }
 
-func (x *SlpTokenMetadata_V1Fungible) GetMintBatonVout() uint32 {
+func (x *Transaction) GetTimestamp() int64 {
 	if x != nil {
-		return x.MintBatonVout
+		return x.Timestamp
 	}
 	return 0
 }
 
-// V1NFT1Group is used to marshal metadata specific to NFT1 Group token IDs
-type SlpTokenMetadata_V1NFT1Group struct {
+func (x *Transaction) GetConfirmations() int32 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *Transaction) GetBlockHeight() int32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *Transaction) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *Transaction) GetSlpTransactionInfo() *SlpTransactionInfo {
+	if x != nil {
+		return x.SlpTransactionInfo
+	}
+	return nil
+}
+
+type MempoolTransaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TokenTicker       string `protobuf:"bytes,1,opt,name=token_ticker,json=tokenTicker,proto3" json:"token_ticker,omitempty"`
-	TokenName         string `protobuf:"bytes,2,opt,name=token_name,json=tokenName,proto3" json:"token_name,omitempty"`
-	TokenDocumentUrl  string `protobuf:"bytes,3,opt,name=token_document_url,json=tokenDocumentUrl,proto3" json:"token_document_url,omitempty"`
-	TokenDocumentHash []byte `protobuf:"bytes,4,opt,name=token_document_hash,json=tokenDocumentHash,proto3" json:"token_document_hash,omitempty"`
-	Decimals          uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
-	MintBatonHash     []byte `protobuf:"bytes,6,opt,name=mint_baton_hash,json=mintBatonHash,proto3" json:"mint_baton_hash,omitempty"`
-	MintBatonVout     uint32 `protobuf:"varint,7,opt,name=mint_baton_vout,json=mintBatonVout,proto3" json:"mint_baton_vout,omitempty"`
+	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// The time when the transaction was added too the pool.
+	AddedTime int64 `protobuf:"varint,2,opt,name=added_time,json=addedTime,proto3" json:"added_time,omitempty"`
+	// The block height when the transaction was added to the pool.
+	AddedHeight int32 `protobuf:"varint,3,opt,name=added_height,json=addedHeight,proto3" json:"added_height,omitempty"`
+	// The total fee in satoshi the transaction pays.
+	Fee int64 `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	// The fee in satoshi per kilobyte the transaction pays.
+	FeePerKb int64 `protobuf:"varint,5,opt,name=fee_per_kb,json=feePerKb,proto3" json:"fee_per_kb,omitempty"`
+	// The priority of the transaction when it was added to the pool.
+	StartingPriority float64 `protobuf:"fixed64,6,opt,name=starting_priority,json=startingPriority,proto3" json:"starting_priority,omitempty"`
 }
 
-func (x *SlpTokenMetadata_V1NFT1Group) Reset() {
-	*x = SlpTokenMetadata_V1NFT1Group{}
+func (x *MempoolTransaction) Reset() {
+	*x = MempoolTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[70]
+		mi := &file_bchrpc_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SlpTokenMetadata_V1NFT1Group) String() string {
+func (x *MempoolTransaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SlpTokenMetadata_V1NFT1Group) ProtoMessage() {}
+func (*MempoolTransaction) ProtoMessage() {}
 
-func (x *SlpTokenMetadata_V1NFT1Group) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[70]
+func (x *MempoolTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5896,90 +6231,90 @@ func (x *SlpTokenMetadata_V1NFT1Group) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SlpTokenMetadata_V1NFT1Group.ProtoReflect.Descriptor instead.
-func (*SlpTokenMetadata_V1NFT1Group) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{60, 1}
-}
-
-func (x *SlpTokenMetadata_V1NFT1Group) GetTokenTicker() string {
-	if x != nil {
-		return x.TokenTicker
-	}
-	return ""
+// Deprecated: Use MempoolTransaction.ProtoReflect.Descriptor instead.
+func (*MempoolTransaction) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *SlpTokenMetadata_V1NFT1Group) GetTokenName() string {
+func (x *MempoolTransaction) GetTransaction() *Transaction {
 	if x != nil {
-		return x.TokenName
+		return x.Transaction
 	}
-	return ""
+	return nil
 }
 
-func (x *SlpTokenMetadata_V1NFT1Group) GetTokenDocumentUrl() string {
+func (x *MempoolTransaction) GetAddedTime() int64 {
 	if x != nil {
-		return x.TokenDocumentUrl
+		return x.AddedTime
 	}
-	return ""
+	return 0
 }
 
-func (x *SlpTokenMetadata_V1NFT1Group) GetTokenDocumentHash() []byte {
+func (x *MempoolTransaction) GetAddedHeight() int32 {
 	if x != nil {
-		return x.TokenDocumentHash
+		return x.AddedHeight
 	}
-	return nil
+	return 0
 }
 
-func (x *SlpTokenMetadata_V1NFT1Group) GetDecimals() uint32 {
+func (x *MempoolTransaction) GetFee() int64 {
 	if x != nil {
-		return x.Decimals
+		return x.Fee
 	}
 	return 0
 }
 
-func (x *SlpTokenMetadata_V1NFT1Group) GetMintBatonHash() []byte {
+func (x *MempoolTransaction) GetFeePerKb() int64 {
 	if x != nil {
-		return x.MintBatonHash
+		return x.FeePerKb
 	}
-	return nil
+	return 0
 }
 
-func (x *SlpTokenMetadata_V1NFT1Group) GetMintBatonVout() uint32 {
+func (x *MempoolTransaction) GetStartingPriority() float64 {
 	if x != nil {
-		return x.MintBatonVout
+		return x.StartingPriority
 	}
 	return 0
 }
 
-// V1NFT1Child is used to marshal metadata specific to NFT1 Child token IDs
-type SlpTokenMetadata_V1NFT1Child struct {
+type UnspentOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TokenTicker       string `protobuf:"bytes,1,opt,name=token_ticker,json=tokenTicker,proto3" json:"token_ticker,omitempty"`
-	TokenName         string `protobuf:"bytes,2,opt,name=token_name,json=tokenName,proto3" json:"token_name,omitempty"`
-	TokenDocumentUrl  string `protobuf:"bytes,3,opt,name=token_document_url,json=tokenDocumentUrl,proto3" json:"token_document_url,omitempty"`
-	TokenDocumentHash []byte `protobuf:"bytes,4,opt,name=token_document_hash,json=tokenDocumentHash,proto3" json:"token_document_hash,omitempty"`
-	GroupId           []byte `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// A reference to the output given by transaction hash and index.
+	Outpoint *Transaction_Input_Outpoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// The public key script used to pay coins.
+	PubkeyScript []byte `protobuf:"bytes,2,opt,name=pubkey_script,json=pubkeyScript,proto3" json:"pubkey_script,omitempty"`
+	// The amount in satoshis
+	Value int64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	// When is_coinbase is true, the output is the first in the block,
+	// a generation transaction, the result of mining.
+	IsCoinbase bool `protobuf:"varint,4,opt,name=is_coinbase,json=isCoinbase,proto3" json:"is_coinbase,omitempty"`
+	// The block number containing the UXTO.
+	BlockHeight int32      `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	SlpToken    *SlpToken  `protobuf:"bytes,6,opt,name=slp_token,json=slpToken,proto3" json:"slp_token,omitempty"`
+	CashToken   *CashToken `protobuf:"bytes,7,opt,name=cash_token,json=cashToken,proto3" json:"cash_token,omitempty"`
 }
 
-func (x *SlpTokenMetadata_V1NFT1Child) Reset() {
-	*x = SlpTokenMetadata_V1NFT1Child{}
+func (x *UnspentOutput) Reset() {
+	*x = UnspentOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bchrpc_proto_msgTypes[71]
+		mi := &file_bchrpc_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SlpTokenMetadata_V1NFT1Child) String() string {
+func (x *UnspentOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SlpTokenMetadata_V1NFT1Child) ProtoMessage() {}
+func (*UnspentOutput) ProtoMessage() {}
 
-func (x *SlpTokenMetadata_V1NFT1Child) ProtoReflect() protoreflect.Message {
-	mi := &file_bchrpc_proto_msgTypes[71]
+func (x *UnspentOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5990,42 +6325,2188 @@ func (x *SlpTokenMetadata_V1NFT1Child) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SlpTokenMetadata_V1NFT1Child.ProtoReflect.Descriptor instead.
-func (*SlpTokenMetadata_V1NFT1Child) Descriptor() ([]byte, []int) {
-	return file_bchrpc_proto_rawDescGZIP(), []int{60, 2}
+// Deprecated: Use UnspentOutput.ProtoReflect.Descriptor instead.
+func (*UnspentOutput) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *SlpTokenMetadata_V1NFT1Child) GetTokenTicker() string {
+func (x *UnspentOutput) GetOutpoint() *Transaction_Input_Outpoint {
 	if x != nil {
-		return x.TokenTicker
+		return x.Outpoint
 	}
-	return ""
+	return nil
 }
 
-func (x *SlpTokenMetadata_V1NFT1Child) GetTokenName() string {
+func (x *UnspentOutput) GetPubkeyScript() []byte {
 	if x != nil {
-		return x.TokenName
+		return x.PubkeyScript
 	}
-	return ""
+	return nil
 }
 
-func (x *SlpTokenMetadata_V1NFT1Child) GetTokenDocumentUrl() string {
+func (x *UnspentOutput) GetValue() int64 {
 	if x != nil {
-		return x.TokenDocumentUrl
+		return x.Value
 	}
-	return ""
+	return 0
 }
 
-func (x *SlpTokenMetadata_V1NFT1Child) GetTokenDocumentHash() []byte {
+func (x *UnspentOutput) GetIsCoinbase() bool {
 	if x != nil {
-		return x.TokenDocumentHash
+		return x.IsCoinbase
 	}
-	return nil
+	return false
 }
 
-func (x *SlpTokenMetadata_V1NFT1Child) GetGroupId() []byte {
+func (x *UnspentOutput) GetBlockHeight() int32 {
 	if x != nil {
-		return x.GroupId
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *UnspentOutput) GetSlpToken() *SlpToken {
+	if x != nil {
+		return x.SlpToken
+	}
+	return nil
+}
+
+func (x *UnspentOutput) GetCashToken() *CashToken {
+	if x != nil {
+		return x.CashToken
+	}
+	return nil
+}
+
+type TransactionFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Filter by address(es)
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// Filter by output hash and index.
+	Outpoints []*Transaction_Input_Outpoint `protobuf:"bytes,2,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	// Filter by data elements contained in pubkey scripts.
+	DataElements [][]byte `protobuf:"bytes,3,rep,name=data_elements,json=dataElements,proto3" json:"data_elements,omitempty"`
+	// Subscribed/Unsubscribe to everything. Other filters
+	// will be ignored.
+	AllTransactions bool `protobuf:"varint,4,opt,name=all_transactions,json=allTransactions,proto3" json:"all_transactions,omitempty"`
+	// Subscribed/Unsubscribe to everything slp. Other filters
+	// will be ignored, except this filter will be overriden by all_transactions=true
+	AllSlpTransactions bool `protobuf:"varint,5,opt,name=all_slp_transactions,json=allSlpTransactions,proto3" json:"all_slp_transactions,omitempty"`
+	// only transactions associated with the included tokenIds
+	SlpTokenIds [][]byte `protobuf:"bytes,6,rep,name=slp_token_ids,json=slpTokenIds,proto3" json:"slp_token_ids,omitempty"`
+}
+
+func (x *TransactionFilter) Reset() {
+	*x = TransactionFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionFilter) ProtoMessage() {}
+
+func (x *TransactionFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionFilter.ProtoReflect.Descriptor instead.
+func (*TransactionFilter) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *TransactionFilter) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetOutpoints() []*Transaction_Input_Outpoint {
+	if x != nil {
+		return x.Outpoints
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetDataElements() [][]byte {
+	if x != nil {
+		return x.DataElements
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetAllTransactions() bool {
+	if x != nil {
+		return x.AllTransactions
+	}
+	return false
+}
+
+func (x *TransactionFilter) GetAllSlpTransactions() bool {
+	if x != nil {
+		return x.AllSlpTransactions
+	}
+	return false
+}
+
+func (x *TransactionFilter) GetSlpTokenIds() [][]byte {
+	if x != nil {
+		return x.SlpTokenIds
+	}
+	return nil
+}
+
+// CashToken info used in transaction inputs / outputs
+//
+// WARNING: Some languages (e.g., JavaScript) may not properly handle the 'uint64'
+// for large amounts. For this reason, an annotation has been added for JS to
+// return a string for the amount field instead of casting uint64 to the JS 'number'
+// type. Other languages may require similar treatment.
+//
+type CashToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CategoryId []byte `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Amount     uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Bitfield   []byte `protobuf:"bytes,4,opt,name=bitfield,proto3" json:"bitfield,omitempty"`
+}
+
+func (x *CashToken) Reset() {
+	*x = CashToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CashToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashToken) ProtoMessage() {}
+
+func (x *CashToken) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashToken.ProtoReflect.Descriptor instead.
+func (*CashToken) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CashToken) GetCategoryId() []byte {
+	if x != nil {
+		return x.CategoryId
+	}
+	return nil
+}
+
+func (x *CashToken) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CashToken) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *CashToken) GetBitfield() []byte {
+	if x != nil {
+		return x.Bitfield
+	}
+	return nil
+}
+
+// SlpToken info used in transaction inputs / outputs
+//
+// WARNING: Some languages (e.g., JavaScript) may not properly handle the 'uint64'
+// for large amounts. For this reason, an annotation has been added for JS to
+// return a string for the amount field instead of casting uint64 to the JS 'number'
+// type. Other languages may require similar treatment.
+//
+type SlpToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenId     []byte       `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Amount      uint64       `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	IsMintBaton bool         `protobuf:"varint,3,opt,name=is_mint_baton,json=isMintBaton,proto3" json:"is_mint_baton,omitempty"`
+	Address     string       `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Decimals    uint32       `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	SlpAction   SlpAction    `protobuf:"varint,6,opt,name=slp_action,json=slpAction,proto3,enum=pb.SlpAction" json:"slp_action,omitempty"`
+	TokenType   SlpTokenType `protobuf:"varint,7,opt,name=token_type,json=tokenType,proto3,enum=pb.SlpTokenType" json:"token_type,omitempty"`
+}
+
+func (x *SlpToken) Reset() {
+	*x = SlpToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlpToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlpToken) ProtoMessage() {}
+
+func (x *SlpToken) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlpToken.ProtoReflect.Descriptor instead.
+func (*SlpToken) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SlpToken) GetTokenId() []byte {
+	if x != nil {
+		return x.TokenId
+	}
+	return nil
+}
+
+func (x *SlpToken) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SlpToken) GetIsMintBaton() bool {
+	if x != nil {
+		return x.IsMintBaton
+	}
+	return false
+}
+
+func (x *SlpToken) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SlpToken) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *SlpToken) GetSlpAction() SlpAction {
+	if x != nil {
+		return x.SlpAction
+	}
+	return SlpAction_NON_SLP
+}
+
+func (x *SlpToken) GetTokenType() SlpTokenType {
+	if x != nil {
+		return x.TokenType
+	}
+	return SlpTokenType_VERSION_NOT_SET
+}
+
+// SlpTransactionInfo is used inside the Transaction message type.
+type SlpTransactionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SlpAction         SlpAction                            `protobuf:"varint,1,opt,name=slp_action,json=slpAction,proto3,enum=pb.SlpAction" json:"slp_action,omitempty"`
+	ValidityJudgement SlpTransactionInfo_ValidityJudgement `protobuf:"varint,2,opt,name=validity_judgement,json=validityJudgement,proto3,enum=pb.SlpTransactionInfo_ValidityJudgement" json:"validity_judgement,omitempty"`
+	ParseError        string                               `protobuf:"bytes,3,opt,name=parse_error,json=parseError,proto3" json:"parse_error,omitempty"`
+	TokenId           []byte                               `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	BurnFlags         []SlpTransactionInfo_BurnFlags       `protobuf:"varint,5,rep,packed,name=burn_flags,json=burnFlags,proto3,enum=pb.SlpTransactionInfo_BurnFlags" json:"burn_flags,omitempty"`
+	// Types that are assignable to TxMetadata:
+	//	*SlpTransactionInfo_V1Genesis
+	//	*SlpTransactionInfo_V1Mint
+	//	*SlpTransactionInfo_V1Send
+	//	*SlpTransactionInfo_V1Nft1ChildGenesis
+	//	*SlpTransactionInfo_V1Nft1ChildSend
+	TxMetadata isSlpTransactionInfo_TxMetadata `protobuf_oneof:"tx_metadata"`
+}
+
+func (x *SlpTransactionInfo) Reset() {
+	*x = SlpTransactionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlpTransactionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlpTransactionInfo) ProtoMessage() {}
+
+func (x *SlpTransactionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlpTransactionInfo.ProtoReflect.Descriptor instead.
+func (*SlpTransactionInfo) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SlpTransactionInfo) GetSlpAction() SlpAction {
+	if x != nil {
+		return x.SlpAction
+	}
+	return SlpAction_NON_SLP
+}
+
+func (x *SlpTransactionInfo) GetValidityJudgement() SlpTransactionInfo_ValidityJudgement {
+	if x != nil {
+		return x.ValidityJudgement
+	}
+	return SlpTransactionInfo_UNKNOWN_OR_INVALID
+}
+
+func (x *SlpTransactionInfo) GetParseError() string {
+	if x != nil {
+		return x.ParseError
+	}
+	return ""
+}
+
+func (x *SlpTransactionInfo) GetTokenId() []byte {
+	if x != nil {
+		return x.TokenId
+	}
+	return nil
+}
+
+func (x *SlpTransactionInfo) GetBurnFlags() []SlpTransactionInfo_BurnFlags {
+	if x != nil {
+		return x.BurnFlags
+	}
+	return nil
+}
+
+func (m *SlpTransactionInfo) GetTxMetadata() isSlpTransactionInfo_TxMetadata {
+	if m != nil {
+		return m.TxMetadata
+	}
+	return nil
+}
+
+func (x *SlpTransactionInfo) GetV1Genesis() *SlpV1GenesisMetadata {
+	if x, ok := x.GetTxMetadata().(*SlpTransactionInfo_V1Genesis); ok {
+		return x.V1Genesis
+	}
+	return nil
+}
+
+func (x *SlpTransactionInfo) GetV1Mint() *SlpV1MintMetadata {
+	if x, ok := x.GetTxMetadata().(*SlpTransactionInfo_V1Mint); ok {
+		return x.V1Mint
+	}
+	return nil
+}
+
+func (x *SlpTransactionInfo) GetV1Send() *SlpV1SendMetadata {
+	if x, ok := x.GetTxMetadata().(*SlpTransactionInfo_V1Send); ok {
+		return x.V1Send
+	}
+	return nil
+}
+
+func (x *SlpTransactionInfo) GetV1Nft1ChildGenesis() *SlpV1Nft1ChildGenesisMetadata {
+	if x, ok := x.GetTxMetadata().(*SlpTransactionInfo_V1Nft1ChildGenesis); ok {
+		return x.V1Nft1ChildGenesis
+	}
+	return nil
+}
+
+func (x *SlpTransactionInfo) GetV1Nft1ChildSend() *SlpV1Nft1ChildSendMetadata {
+	if x, ok := x.GetTxMetadata().(*SlpTransactionInfo_V1Nft1ChildSend); ok {
+		return x.V1Nft1ChildSend
+	}
+	return nil
+}
+
+type isSlpTransactionInfo_TxMetadata interface {
+	isSlpTransactionInfo_TxMetadata()
+}
+
+type SlpTransactionInfo_V1Genesis struct {
+	V1Genesis *SlpV1GenesisMetadata `protobuf:"bytes,6,opt,name=v1_genesis,json=v1Genesis,proto3,oneof"` // NFT1 Group also uses this
+}
+
+type SlpTransactionInfo_V1Mint struct {
+	V1Mint *SlpV1MintMetadata `protobuf:"bytes,7,opt,name=v1_mint,json=v1Mint,proto3,oneof"` // NFT1 Group also uses this
+}
+
+type SlpTransactionInfo_V1Send struct {
+	V1Send *SlpV1SendMetadata `protobuf:"bytes,8,opt,name=v1_send,json=v1Send,proto3,oneof"` // NFT1 Group also uses this
+}
+
+type SlpTransactionInfo_V1Nft1ChildGenesis struct {
+	V1Nft1ChildGenesis *SlpV1Nft1ChildGenesisMetadata `protobuf:"bytes,9,opt,name=v1_nft1_child_genesis,json=v1Nft1ChildGenesis,proto3,oneof"`
+}
+
+type SlpTransactionInfo_V1Nft1ChildSend struct {
+	V1Nft1ChildSend *SlpV1Nft1ChildSendMetadata `protobuf:"bytes,10,opt,name=v1_nft1_child_send,json=v1Nft1ChildSend,proto3,oneof"`
+}
+
+func (*SlpTransactionInfo_V1Genesis) isSlpTransactionInfo_TxMetadata() {}
+
+func (*SlpTransactionInfo_V1Mint) isSlpTransactionInfo_TxMetadata() {}
+
+func (*SlpTransactionInfo_V1Send) isSlpTransactionInfo_TxMetadata() {}
+
+func (*SlpTransactionInfo_V1Nft1ChildGenesis) isSlpTransactionInfo_TxMetadata() {}
+
+func (*SlpTransactionInfo_V1Nft1ChildSend) isSlpTransactionInfo_TxMetadata() {}
+
+// SlpV1GenesisMetadata is used to marshal type 1 and NFT1 Group GENESIS OP_RETURN scriptPubKey
+type SlpV1GenesisMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          []byte `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ticker        []byte `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	DocumentUrl   []byte `protobuf:"bytes,3,opt,name=document_url,json=documentUrl,proto3" json:"document_url,omitempty"`
+	DocumentHash  []byte `protobuf:"bytes,4,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty"`
+	Decimals      uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	MintBatonVout uint32 `protobuf:"varint,6,opt,name=mint_baton_vout,json=mintBatonVout,proto3" json:"mint_baton_vout,omitempty"`
+	MintAmount    uint64 `protobuf:"varint,7,opt,name=mint_amount,json=mintAmount,proto3" json:"mint_amount,omitempty"`
+}
+
+func (x *SlpV1GenesisMetadata) Reset() {
+	*x = SlpV1GenesisMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlpV1GenesisMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlpV1GenesisMetadata) ProtoMessage() {}
+
+func (x *SlpV1GenesisMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlpV1GenesisMetadata.ProtoReflect.Descriptor instead.
+func (*SlpV1GenesisMetadata) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SlpV1GenesisMetadata) GetName() []byte {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *SlpV1GenesisMetadata) GetTicker() []byte {
+	if x != nil {
+		return x.Ticker
+	}
+	return nil
+}
+
+func (x *SlpV1GenesisMetadata) GetDocumentUrl() []byte {
+	if x != nil {
+		return x.DocumentUrl
+	}
+	return nil
+}
+
+func (x *SlpV1GenesisMetadata) GetDocumentHash() []byte {
+	if x != nil {
+		return x.DocumentHash
+	}
+	return nil
+}
+
+func (x *SlpV1GenesisMetadata) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *SlpV1GenesisMetadata) GetMintBatonVout() uint32 {
+	if x != nil {
+		return x.MintBatonVout
+	}
+	return 0
+}
+
+func (x *SlpV1GenesisMetadata) GetMintAmount() uint64 {
+	if x != nil {
+		return x.MintAmount
+	}
+	return 0
+}
+
+// SlpV1MintMetadata is used to marshal type 1 MINT OP_RETURN scriptPubKey
+type SlpV1MintMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MintBatonVout uint32 `protobuf:"varint,1,opt,name=mint_baton_vout,json=mintBatonVout,proto3" json:"mint_baton_vout,omitempty"`
+	MintAmount    uint64 `protobuf:"varint,2,opt,name=mint_amount,json=mintAmount,proto3" json:"mint_amount,omitempty"`
+}
+
+func (x *SlpV1MintMetadata) Reset() {
+	*x = SlpV1MintMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlpV1MintMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlpV1MintMetadata) ProtoMessage() {}
+
+func (x *SlpV1MintMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlpV1MintMetadata.ProtoReflect.Descriptor instead.
+func (*SlpV1MintMetadata) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SlpV1MintMetadata) GetMintBatonVout() uint32 {
+	if x != nil {
+		return x.MintBatonVout
+	}
+	return 0
+}
+
+func (x *SlpV1MintMetadata) GetMintAmount() uint64 {
+	if x != nil {
+		return x.MintAmount
+	}
+	return 0
+}
+
+// SlpV1SendMetadata is used to marshal type 1 and NFT1 Group SEND OP_RETURN scriptPubKey
+type SlpV1SendMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amounts []uint64 `protobuf:"varint,1,rep,packed,name=amounts,proto3" json:"amounts,omitempty"`
+}
+
+func (x *SlpV1SendMetadata) Reset() {
+	*x = SlpV1SendMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlpV1SendMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlpV1SendMetadata) ProtoMessage() {}
+
+func (x *SlpV1SendMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlpV1SendMetadata.ProtoReflect.Descriptor instead.
+func (*SlpV1SendMetadata) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SlpV1SendMetadata) GetAmounts() []uint64 {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+// SlpV1Nft1ChildGenesisMetadata is used to marshal NFT1 Child GENESIS OP_RETURN scriptPubKey
+type SlpV1Nft1ChildGenesisMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         []byte `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ticker       []byte `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	DocumentUrl  []byte `protobuf:"bytes,3,opt,name=document_url,json=documentUrl,proto3" json:"document_url,omitempty"`
+	DocumentHash []byte `protobuf:"bytes,4,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty"`
+	Decimals     uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	GroupTokenId []byte `protobuf:"bytes,6,opt,name=group_token_id,json=groupTokenId,proto3" json:"group_token_id,omitempty"`
+}
+
+func (x *SlpV1Nft1ChildGenesisMetadata) Reset() {
+	*x = SlpV1Nft1ChildGenesisMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlpV1Nft1ChildGenesisMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlpV1Nft1ChildGenesisMetadata) ProtoMessage() {}
+
+func (x *SlpV1Nft1ChildGenesisMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlpV1Nft1ChildGenesisMetadata.ProtoReflect.Descriptor instead.
+func (*SlpV1Nft1ChildGenesisMetadata) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SlpV1Nft1ChildGenesisMetadata) GetName() []byte {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *SlpV1Nft1ChildGenesisMetadata) GetTicker() []byte {
+	if x != nil {
+		return x.Ticker
+	}
+	return nil
+}
+
+func (x *SlpV1Nft1ChildGenesisMetadata) GetDocumentUrl() []byte {
+	if x != nil {
+		return x.DocumentUrl
+	}
+	return nil
+}
+
+func (x *SlpV1Nft1ChildGenesisMetadata) GetDocumentHash() []byte {
+	if x != nil {
+		return x.DocumentHash
+	}
+	return nil
+}
+
+func (x *SlpV1Nft1ChildGenesisMetadata) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *SlpV1Nft1ChildGenesisMetadata) GetGroupTokenId() []byte {
+	if x != nil {
+		return x.GroupTokenId
+	}
+	return nil
+}
+
+// SlpV1Nft1ChildSendMetadata is used to marshal NFT1 Child SEND OP_RETURN scriptPubKey
+type SlpV1Nft1ChildSendMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupTokenId []byte `protobuf:"bytes,1,opt,name=group_token_id,json=groupTokenId,proto3" json:"group_token_id,omitempty"`
+}
+
+func (x *SlpV1Nft1ChildSendMetadata) Reset() {
+	*x = SlpV1Nft1ChildSendMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlpV1Nft1ChildSendMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlpV1Nft1ChildSendMetadata) ProtoMessage() {}
+
+func (x *SlpV1Nft1ChildSendMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlpV1Nft1ChildSendMetadata.ProtoReflect.Descriptor instead.
+func (*SlpV1Nft1ChildSendMetadata) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SlpV1Nft1ChildSendMetadata) GetGroupTokenId() []byte {
+	if x != nil {
+		return x.GroupTokenId
+	}
+	return nil
+}
+
+// SlpTokenMetadata is used to marshal metadata about a specific TokenID
+type SlpTokenMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenId   []byte       `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	TokenType SlpTokenType `protobuf:"varint,2,opt,name=token_type,json=tokenType,proto3,enum=pb.SlpTokenType" json:"token_type,omitempty"`
+	// Types that are assignable to TypeMetadata:
+	//	*SlpTokenMetadata_V1Fungible_
+	//	*SlpTokenMetadata_V1Nft1Group
+	//	*SlpTokenMetadata_V1Nft1Child
+	TypeMetadata isSlpTokenMetadata_TypeMetadata `protobuf_oneof:"type_metadata"`
+}
+
+func (x *SlpTokenMetadata) Reset() {
+	*x = SlpTokenMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlpTokenMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlpTokenMetadata) ProtoMessage() {}
+
+func (x *SlpTokenMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlpTokenMetadata.ProtoReflect.Descriptor instead.
+func (*SlpTokenMetadata) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SlpTokenMetadata) GetTokenId() []byte {
+	if x != nil {
+		return x.TokenId
+	}
+	return nil
+}
+
+func (x *SlpTokenMetadata) GetTokenType() SlpTokenType {
+	if x != nil {
+		return x.TokenType
+	}
+	return SlpTokenType_VERSION_NOT_SET
+}
+
+func (m *SlpTokenMetadata) GetTypeMetadata() isSlpTokenMetadata_TypeMetadata {
+	if m != nil {
+		return m.TypeMetadata
+	}
+	return nil
+}
+
+func (x *SlpTokenMetadata) GetV1Fungible() *SlpTokenMetadata_V1Fungible {
+	if x, ok := x.GetTypeMetadata().(*SlpTokenMetadata_V1Fungible_); ok {
+		return x.V1Fungible
+	}
+	return nil
+}
+
+func (x *SlpTokenMetadata) GetV1Nft1Group() *SlpTokenMetadata_V1NFT1Group {
+	if x, ok := x.GetTypeMetadata().(*SlpTokenMetadata_V1Nft1Group); ok {
+		return x.V1Nft1Group
+	}
+	return nil
+}
+
+func (x *SlpTokenMetadata) GetV1Nft1Child() *SlpTokenMetadata_V1NFT1Child {
+	if x, ok := x.GetTypeMetadata().(*SlpTokenMetadata_V1Nft1Child); ok {
+		return x.V1Nft1Child
+	}
+	return nil
+}
+
+type isSlpTokenMetadata_TypeMetadata interface {
+	isSlpTokenMetadata_TypeMetadata()
+}
+
+type SlpTokenMetadata_V1Fungible_ struct {
+	V1Fungible *SlpTokenMetadata_V1Fungible `protobuf:"bytes,3,opt,name=v1_fungible,json=v1Fungible,proto3,oneof"`
+}
+
+type SlpTokenMetadata_V1Nft1Group struct {
+	V1Nft1Group *SlpTokenMetadata_V1NFT1Group `protobuf:"bytes,4,opt,name=v1_nft1_group,json=v1Nft1Group,proto3,oneof"`
+}
+
+type SlpTokenMetadata_V1Nft1Child struct {
+	V1Nft1Child *SlpTokenMetadata_V1NFT1Child `protobuf:"bytes,5,opt,name=v1_nft1_child,json=v1Nft1Child,proto3,oneof"`
+}
+
+func (*SlpTokenMetadata_V1Fungible_) isSlpTokenMetadata_TypeMetadata() {}
+
+func (*SlpTokenMetadata_V1Nft1Group) isSlpTokenMetadata_TypeMetadata() {}
+
+func (*SlpTokenMetadata_V1Nft1Child) isSlpTokenMetadata_TypeMetadata() {}
+
+// SlpRequiredBurn is used by clients to allow token burning
+type SlpRequiredBurn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outpoint  *Transaction_Input_Outpoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	TokenId   []byte                      `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	TokenType SlpTokenType                `protobuf:"varint,3,opt,name=token_type,json=tokenType,proto3,enum=pb.SlpTokenType" json:"token_type,omitempty"`
+	// Types that are assignable to BurnIntention:
+	//	*SlpRequiredBurn_Amount
+	//	*SlpRequiredBurn_MintBatonVout
+	BurnIntention isSlpRequiredBurn_BurnIntention `protobuf_oneof:"burn_intention"`
+}
+
+func (x *SlpRequiredBurn) Reset() {
+	*x = SlpRequiredBurn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlpRequiredBurn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlpRequiredBurn) ProtoMessage() {}
+
+func (x *SlpRequiredBurn) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlpRequiredBurn.ProtoReflect.Descriptor instead.
+func (*SlpRequiredBurn) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SlpRequiredBurn) GetOutpoint() *Transaction_Input_Outpoint {
+	if x != nil {
+		return x.Outpoint
+	}
+	return nil
+}
+
+func (x *SlpRequiredBurn) GetTokenId() []byte {
+	if x != nil {
+		return x.TokenId
+	}
+	return nil
+}
+
+func (x *SlpRequiredBurn) GetTokenType() SlpTokenType {
+	if x != nil {
+		return x.TokenType
+	}
+	return SlpTokenType_VERSION_NOT_SET
+}
+
+func (m *SlpRequiredBurn) GetBurnIntention() isSlpRequiredBurn_BurnIntention {
+	if m != nil {
+		return m.BurnIntention
+	}
+	return nil
+}
+
+func (x *SlpRequiredBurn) GetAmount() uint64 {
+	if x, ok := x.GetBurnIntention().(*SlpRequiredBurn_Amount); ok {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SlpRequiredBurn) GetMintBatonVout() uint32 {
+	if x, ok := x.GetBurnIntention().(*SlpRequiredBurn_MintBatonVout); ok {
+		return x.MintBatonVout
+	}
+	return 0
+}
+
+type isSlpRequiredBurn_BurnIntention interface {
+	isSlpRequiredBurn_BurnIntention()
+}
+
+type SlpRequiredBurn_Amount struct {
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3,oneof"`
+}
+
+type SlpRequiredBurn_MintBatonVout struct {
+	MintBatonVout uint32 `protobuf:"varint,5,opt,name=mint_baton_vout,json=mintBatonVout,proto3,oneof"`
+}
+
+func (*SlpRequiredBurn_Amount) isSlpRequiredBurn_BurnIntention() {}
+
+func (*SlpRequiredBurn_MintBatonVout) isSlpRequiredBurn_BurnIntention() {}
+
+type GetMempoolResponse_TransactionData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Either one of the two following is provided, depending on the request.
+	//
+	// Types that are assignable to TxidsOrTxs:
+	//	*GetMempoolResponse_TransactionData_TransactionHash
+	//	*GetMempoolResponse_TransactionData_Transaction
+	TxidsOrTxs isGetMempoolResponse_TransactionData_TxidsOrTxs `protobuf_oneof:"txids_or_txs"`
+	// The fee paid by the transaction in satoshis.
+	Fee int64 `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	// The fee paid by the transaction in satoshis per kilobyte.
+	FeePerKb int64 `protobuf:"varint,4,opt,name=fee_per_kb,json=feePerKb,proto3" json:"fee_per_kb,omitempty"`
+	// The size of the transaction in bytes.
+	Size int32 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// The time the transaction was added to the mempool, in unix seconds.
+	AddedTime int64 `protobuf:"varint,6,opt,name=added_time,json=addedTime,proto3" json:"added_time,omitempty"`
+	// The height of the best block when the transaction was added to the
+	// mempool.
+	AddedHeight int32 `protobuf:"varint,7,opt,name=added_height,json=addedHeight,proto3" json:"added_height,omitempty"`
+}
+
+func (x *GetMempoolResponse_TransactionData) Reset() {
+	*x = GetMempoolResponse_TransactionData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMempoolResponse_TransactionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMempoolResponse_TransactionData) ProtoMessage() {}
+
+func (x *GetMempoolResponse_TransactionData) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMempoolResponse_TransactionData.ProtoReflect.Descriptor instead.
+func (*GetMempoolResponse_TransactionData) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (m *GetMempoolResponse_TransactionData) GetTxidsOrTxs() isGetMempoolResponse_TransactionData_TxidsOrTxs {
+	if m != nil {
+		return m.TxidsOrTxs
+	}
+	return nil
+}
+
+func (x *GetMempoolResponse_TransactionData) GetTransactionHash() []byte {
+	if x, ok := x.GetTxidsOrTxs().(*GetMempoolResponse_TransactionData_TransactionHash); ok {
+		return x.TransactionHash
+	}
+	return nil
+}
+
+func (x *GetMempoolResponse_TransactionData) GetTransaction() *Transaction {
+	if x, ok := x.GetTxidsOrTxs().(*GetMempoolResponse_TransactionData_Transaction); ok {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *GetMempoolResponse_TransactionData) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *GetMempoolResponse_TransactionData) GetFeePerKb() int64 {
+	if x != nil {
+		return x.FeePerKb
+	}
+	return 0
+}
+
+func (x *GetMempoolResponse_TransactionData) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetMempoolResponse_TransactionData) GetAddedTime() int64 {
+	if x != nil {
+		return x.AddedTime
+	}
+	return 0
+}
+
+func (x *GetMempoolResponse_TransactionData) GetAddedHeight() int32 {
+	if x != nil {
+		return x.AddedHeight
+	}
+	return 0
+}
+
+type isGetMempoolResponse_TransactionData_TxidsOrTxs interface {
+	isGetMempoolResponse_TransactionData_TxidsOrTxs()
+}
+
+type GetMempoolResponse_TransactionData_TransactionHash struct {
+	// The transaction hash, little-endian.
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3,oneof"`
+}
+
+type GetMempoolResponse_TransactionData_Transaction struct {
+	// The transaction data.
+	Transaction *Transaction `protobuf:"bytes,2,opt,name=transaction,proto3,oneof"`
+}
+
+func (*GetMempoolResponse_TransactionData_TransactionHash) isGetMempoolResponse_TransactionData_TxidsOrTxs() {
+}
+
+func (*GetMempoolResponse_TransactionData_Transaction) isGetMempoolResponse_TransactionData_TxidsOrTxs() {
+}
+
+type GetMempoolTransactionGraphResponse_Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The transaction hash, little-endian.
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	// The size of the transaction in bytes.
+	Size int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// The total fee in satoshi the transaction pays.
+	Fee int64 `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	// The fee in satoshi per kilobyte the transaction pays.
+	FeePerKb int64 `protobuf:"varint,4,opt,name=fee_per_kb,json=feePerKb,proto3" json:"fee_per_kb,omitempty"`
+	// The time when the transaction was added to the pool.
+	AddedTime int64 `protobuf:"varint,5,opt,name=added_time,json=addedTime,proto3" json:"added_time,omitempty"`
+	// The block height when the transaction was added to the pool.
+	AddedHeight int32 `protobuf:"varint,6,opt,name=added_height,json=addedHeight,proto3" json:"added_height,omitempty"`
+	// The hashes of the unconfirmed transactions spent by the transaction.
+	Depends [][]byte `protobuf:"bytes,7,rep,name=depends,proto3" json:"depends,omitempty"`
+	// The hashes of the unconfirmed transactions spending outputs of the
+	// transaction.
+	SpentBy [][]byte `protobuf:"bytes,8,rep,name=spent_by,json=spentBy,proto3" json:"spent_by,omitempty"`
+}
+
+func (x *GetMempoolTransactionGraphResponse_Entry) Reset() {
+	*x = GetMempoolTransactionGraphResponse_Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMempoolTransactionGraphResponse_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMempoolTransactionGraphResponse_Entry) ProtoMessage() {}
+
+func (x *GetMempoolTransactionGraphResponse_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMempoolTransactionGraphResponse_Entry.ProtoReflect.Descriptor instead.
+func (*GetMempoolTransactionGraphResponse_Entry) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *GetMempoolTransactionGraphResponse_Entry) GetTransactionHash() []byte {
+	if x != nil {
+		return x.TransactionHash
+	}
+	return nil
+}
+
+func (x *GetMempoolTransactionGraphResponse_Entry) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetMempoolTransactionGraphResponse_Entry) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *GetMempoolTransactionGraphResponse_Entry) GetFeePerKb() int64 {
+	if x != nil {
+		return x.FeePerKb
+	}
+	return 0
+}
+
+func (x *GetMempoolTransactionGraphResponse_Entry) GetAddedTime() int64 {
+	if x != nil {
+		return x.AddedTime
+	}
+	return 0
+}
+
+func (x *GetMempoolTransactionGraphResponse_Entry) GetAddedHeight() int32 {
+	if x != nil {
+		return x.AddedHeight
+	}
+	return 0
+}
+
+func (x *GetMempoolTransactionGraphResponse_Entry) GetDepends() [][]byte {
+	if x != nil {
+		return x.Depends
+	}
+	return nil
+}
+
+func (x *GetMempoolTransactionGraphResponse_Entry) GetSpentBy() [][]byte {
+	if x != nil {
+		return x.SpentBy
+	}
+	return nil
+}
+
+type GetFilterMatchesResponse_Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The block hash, little-endian.
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// The block height.
+	Height int32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *GetFilterMatchesResponse_Match) Reset() {
+	*x = GetFilterMatchesResponse_Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFilterMatchesResponse_Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilterMatchesResponse_Match) ProtoMessage() {}
+
+func (x *GetFilterMatchesResponse_Match) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilterMatchesResponse_Match.ProtoReflect.Descriptor instead.
+func (*GetFilterMatchesResponse_Match) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *GetFilterMatchesResponse_Match) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *GetFilterMatchesResponse_Match) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type GetSlpTrustedValidationRequest_Query struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrevOutHash            []byte   `protobuf:"bytes,1,opt,name=prev_out_hash,json=prevOutHash,proto3" json:"prev_out_hash,omitempty"`
+	PrevOutVout            uint32   `protobuf:"varint,2,opt,name=prev_out_vout,json=prevOutVout,proto3" json:"prev_out_vout,omitempty"`
+	GraphsearchValidHashes [][]byte `protobuf:"bytes,3,rep,name=graphsearch_valid_hashes,json=graphsearchValidHashes,proto3" json:"graphsearch_valid_hashes,omitempty"`
+}
+
+func (x *GetSlpTrustedValidationRequest_Query) Reset() {
+	*x = GetSlpTrustedValidationRequest_Query{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSlpTrustedValidationRequest_Query) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlpTrustedValidationRequest_Query) ProtoMessage() {}
+
+func (x *GetSlpTrustedValidationRequest_Query) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlpTrustedValidationRequest_Query.ProtoReflect.Descriptor instead.
+func (*GetSlpTrustedValidationRequest_Query) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{56, 0}
+}
+
+func (x *GetSlpTrustedValidationRequest_Query) GetPrevOutHash() []byte {
+	if x != nil {
+		return x.PrevOutHash
+	}
+	return nil
+}
+
+func (x *GetSlpTrustedValidationRequest_Query) GetPrevOutVout() uint32 {
+	if x != nil {
+		return x.PrevOutVout
+	}
+	return 0
+}
+
+func (x *GetSlpTrustedValidationRequest_Query) GetGraphsearchValidHashes() [][]byte {
+	if x != nil {
+		return x.GraphsearchValidHashes
+	}
+	return nil
+}
+
+type GetSlpTrustedValidationResponse_ValidityResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrevOutHash []byte       `protobuf:"bytes,1,opt,name=prev_out_hash,json=prevOutHash,proto3" json:"prev_out_hash,omitempty"`
+	PrevOutVout uint32       `protobuf:"varint,2,opt,name=prev_out_vout,json=prevOutVout,proto3" json:"prev_out_vout,omitempty"`
+	TokenId     []byte       `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	SlpAction   SlpAction    `protobuf:"varint,4,opt,name=slp_action,json=slpAction,proto3,enum=pb.SlpAction" json:"slp_action,omitempty"`
+	TokenType   SlpTokenType `protobuf:"varint,5,opt,name=token_type,json=tokenType,proto3,enum=pb.SlpTokenType" json:"token_type,omitempty"`
+	// Types that are assignable to ValidityResultType:
+	//	*GetSlpTrustedValidationResponse_ValidityResult_V1TokenAmount
+	//	*GetSlpTrustedValidationResponse_ValidityResult_V1MintBaton
+	ValidityResultType  isGetSlpTrustedValidationResponse_ValidityResult_ValidityResultType `protobuf_oneof:"validity_result_type"`
+	SlpTxnOpreturn      []byte                                                              `protobuf:"bytes,8,opt,name=slp_txn_opreturn,json=slpTxnOpreturn,proto3" json:"slp_txn_opreturn,omitempty"`
+	GraphsearchTxnCount uint32                                                              `protobuf:"varint,9,opt,name=graphsearch_txn_count,json=graphsearchTxnCount,proto3" json:"graphsearch_txn_count,omitempty"`
+}
+
+func (x *GetSlpTrustedValidationResponse_ValidityResult) Reset() {
+	*x = GetSlpTrustedValidationResponse_ValidityResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSlpTrustedValidationResponse_ValidityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlpTrustedValidationResponse_ValidityResult) ProtoMessage() {}
+
+func (x *GetSlpTrustedValidationResponse_ValidityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlpTrustedValidationResponse_ValidityResult.ProtoReflect.Descriptor instead.
+func (*GetSlpTrustedValidationResponse_ValidityResult) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{57, 0}
+}
+
+func (x *GetSlpTrustedValidationResponse_ValidityResult) GetPrevOutHash() []byte {
+	if x != nil {
+		return x.PrevOutHash
+	}
+	return nil
+}
+
+func (x *GetSlpTrustedValidationResponse_ValidityResult) GetPrevOutVout() uint32 {
+	if x != nil {
+		return x.PrevOutVout
+	}
+	return 0
+}
+
+func (x *GetSlpTrustedValidationResponse_ValidityResult) GetTokenId() []byte {
+	if x != nil {
+		return x.TokenId
+	}
+	return nil
+}
+
+func (x *GetSlpTrustedValidationResponse_ValidityResult) GetSlpAction() SlpAction {
+	if x != nil {
+		return x.SlpAction
+	}
+	return SlpAction_NON_SLP
+}
+
+func (x *GetSlpTrustedValidationResponse_ValidityResult) GetTokenType() SlpTokenType {
+	if x != nil {
+		return x.TokenType
+	}
+	return SlpTokenType_VERSION_NOT_SET
+}
+
+func (m *GetSlpTrustedValidationResponse_ValidityResult) GetValidityResultType() isGetSlpTrustedValidationResponse_ValidityResult_ValidityResultType {
+	if m != nil {
+		return m.ValidityResultType
+	}
+	return nil
+}
+
+func (x *GetSlpTrustedValidationResponse_ValidityResult) GetV1TokenAmount() uint64 {
+	if x, ok := x.GetValidityResultType().(*GetSlpTrustedValidationResponse_ValidityResult_V1TokenAmount); ok {
+		return x.V1TokenAmount
+	}
+	return 0
+}
+
+func (x *GetSlpTrustedValidationResponse_ValidityResult) GetV1MintBaton() bool {
+	if x, ok := x.GetValidityResultType().(*GetSlpTrustedValidationResponse_ValidityResult_V1MintBaton); ok {
+		return x.V1MintBaton
+	}
+	return false
+}
+
+func (x *GetSlpTrustedValidationResponse_ValidityResult) GetSlpTxnOpreturn() []byte {
+	if x != nil {
+		return x.SlpTxnOpreturn
+	}
+	return nil
+}
+
+func (x *GetSlpTrustedValidationResponse_ValidityResult) GetGraphsearchTxnCount() uint32 {
+	if x != nil {
+		return x.GraphsearchTxnCount
+	}
+	return 0
+}
+
+type isGetSlpTrustedValidationResponse_ValidityResult_ValidityResultType interface {
+	isGetSlpTrustedValidationResponse_ValidityResult_ValidityResultType()
+}
+
+type GetSlpTrustedValidationResponse_ValidityResult_V1TokenAmount struct {
+	V1TokenAmount uint64 `protobuf:"varint,6,opt,name=v1_token_amount,json=v1TokenAmount,proto3,oneof"`
+}
+
+type GetSlpTrustedValidationResponse_ValidityResult_V1MintBaton struct {
+	V1MintBaton bool `protobuf:"varint,7,opt,name=v1_mint_baton,json=v1MintBaton,proto3,oneof"`
+}
+
+func (*GetSlpTrustedValidationResponse_ValidityResult_V1TokenAmount) isGetSlpTrustedValidationResponse_ValidityResult_ValidityResultType() {
+}
+
+func (*GetSlpTrustedValidationResponse_ValidityResult_V1MintBaton) isGetSlpTrustedValidationResponse_ValidityResult_ValidityResultType() {
+}
+
+type Block_TransactionData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to TxidsOrTxs:
+	//	*Block_TransactionData_TransactionHash
+	//	*Block_TransactionData_Transaction
+	TxidsOrTxs isBlock_TransactionData_TxidsOrTxs `protobuf_oneof:"txids_or_txs"`
+}
+
+func (x *Block_TransactionData) Reset() {
+	*x = Block_TransactionData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Block_TransactionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Block_TransactionData) ProtoMessage() {}
+
+func (x *Block_TransactionData) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Block_TransactionData.ProtoReflect.Descriptor instead.
+func (*Block_TransactionData) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{71, 0}
+}
+
+func (m *Block_TransactionData) GetTxidsOrTxs() isBlock_TransactionData_TxidsOrTxs {
+	if m != nil {
+		return m.TxidsOrTxs
+	}
+	return nil
+}
+
+func (x *Block_TransactionData) GetTransactionHash() []byte {
+	if x, ok := x.GetTxidsOrTxs().(*Block_TransactionData_TransactionHash); ok {
+		return x.TransactionHash
+	}
+	return nil
+}
+
+func (x *Block_TransactionData) GetTransaction() *Transaction {
+	if x, ok := x.GetTxidsOrTxs().(*Block_TransactionData_Transaction); ok {
+		return x.Transaction
+	}
+	return nil
+}
+
+type isBlock_TransactionData_TxidsOrTxs interface {
+	isBlock_TransactionData_TxidsOrTxs()
+}
+
+type Block_TransactionData_TransactionHash struct {
+	// Just the transaction hash, little-endian.
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3,oneof"`
+}
+
+type Block_TransactionData_Transaction struct {
+	// A marshaled transaction.
+	Transaction *Transaction `protobuf:"bytes,2,opt,name=transaction,proto3,oneof"`
+}
+
+func (*Block_TransactionData_TransactionHash) isBlock_TransactionData_TxidsOrTxs() {}
+
+func (*Block_TransactionData_Transaction) isBlock_TransactionData_TxidsOrTxs() {}
+
+type Transaction_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of the input, starting from zero.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// The related outpoint.
+	Outpoint *Transaction_Input_Outpoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// An unlocking script asserting a transaction is permitted to spend
+	// the Outpoint (UTXO)
+	SignatureScript []byte `protobuf:"bytes,3,opt,name=signature_script,json=signatureScript,proto3" json:"signature_script,omitempty"`
+	// As of BIP-68, the sequence number is interpreted as a relative
+	// lock-time for the input.
+	Sequence uint32 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Amount in satoshi.
+	Value int64 `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	// The pubkey_script of the previous output that is being spent.
+	PreviousScript []byte `protobuf:"bytes,6,opt,name=previous_script,json=previousScript,proto3" json:"previous_script,omitempty"`
+	// The bitcoin addresses associated with this input.
+	Address   string     `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	SlpToken  *SlpToken  `protobuf:"bytes,8,opt,name=slp_token,json=slpToken,proto3" json:"slp_token,omitempty"`
+	CashToken *CashToken `protobuf:"bytes,9,opt,name=cash_token,json=cashToken,proto3" json:"cash_token,omitempty"`
+}
+
+func (x *Transaction_Input) Reset() {
+	*x = Transaction_Input{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transaction_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction_Input) ProtoMessage() {}
+
+func (x *Transaction_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction_Input.ProtoReflect.Descriptor instead.
+func (*Transaction_Input) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{72, 0}
+}
+
+func (x *Transaction_Input) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Transaction_Input) GetOutpoint() *Transaction_Input_Outpoint {
+	if x != nil {
+		return x.Outpoint
+	}
+	return nil
+}
+
+func (x *Transaction_Input) GetSignatureScript() []byte {
+	if x != nil {
+		return x.SignatureScript
+	}
+	return nil
+}
+
+func (x *Transaction_Input) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Transaction_Input) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Transaction_Input) GetPreviousScript() []byte {
+	if x != nil {
+		return x.PreviousScript
+	}
+	return nil
+}
+
+func (x *Transaction_Input) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Transaction_Input) GetSlpToken() *SlpToken {
+	if x != nil {
+		return x.SlpToken
+	}
+	return nil
+}
+
+func (x *Transaction_Input) GetCashToken() *CashToken {
+	if x != nil {
+		return x.CashToken
+	}
+	return nil
+}
+
+type Transaction_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of the output, starting from zero.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// The number of satoshis to be transferred.
+	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	// The public key script used to pay coins.
+	PubkeyScript []byte `protobuf:"bytes,3,opt,name=pubkey_script,json=pubkeyScript,proto3" json:"pubkey_script,omitempty"`
+	// The bitcoin addresses associated with this output.
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	// The type of script.
+	ScriptClass string `protobuf:"bytes,5,opt,name=script_class,json=scriptClass,proto3" json:"script_class,omitempty"`
+	// The script expressed in Bitcoin Cash Script.
+	DisassembledScript string     `protobuf:"bytes,6,opt,name=disassembled_script,json=disassembledScript,proto3" json:"disassembled_script,omitempty"`
+	SlpToken           *SlpToken  `protobuf:"bytes,7,opt,name=slp_token,json=slpToken,proto3" json:"slp_token,omitempty"`
+	CashToken          *CashToken `protobuf:"bytes,8,opt,name=cash_token,json=cashToken,proto3" json:"cash_token,omitempty"`
+}
+
+func (x *Transaction_Output) Reset() {
+	*x = Transaction_Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transaction_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction_Output) ProtoMessage() {}
+
+func (x *Transaction_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction_Output.ProtoReflect.Descriptor instead.
+func (*Transaction_Output) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{72, 1}
+}
+
+func (x *Transaction_Output) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Transaction_Output) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Transaction_Output) GetPubkeyScript() []byte {
+	if x != nil {
+		return x.PubkeyScript
+	}
+	return nil
+}
+
+func (x *Transaction_Output) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Transaction_Output) GetScriptClass() string {
+	if x != nil {
+		return x.ScriptClass
+	}
+	return ""
+}
+
+func (x *Transaction_Output) GetDisassembledScript() string {
+	if x != nil {
+		return x.DisassembledScript
+	}
+	return ""
+}
+
+func (x *Transaction_Output) GetSlpToken() *SlpToken {
+	if x != nil {
+		return x.SlpToken
+	}
+	return nil
+}
+
+func (x *Transaction_Output) GetCashToken() *CashToken {
+	if x != nil {
+		return x.CashToken
+	}
+	return nil
+}
+
+type Transaction_Input_Outpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hash of the transaction containing the output to be spent, little-endian
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// The index of specific output on the transaction.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *Transaction_Input_Outpoint) Reset() {
+	*x = Transaction_Input_Outpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transaction_Input_Outpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction_Input_Outpoint) ProtoMessage() {}
+
+func (x *Transaction_Input_Outpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction_Input_Outpoint.ProtoReflect.Descriptor instead.
+func (*Transaction_Input_Outpoint) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{72, 0, 0}
+}
+
+func (x *Transaction_Input_Outpoint) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *Transaction_Input_Outpoint) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+// V1Fungible is used to marshal metadata specific to Type 1 token IDs
+type SlpTokenMetadata_V1Fungible struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenTicker       string `protobuf:"bytes,1,opt,name=token_ticker,json=tokenTicker,proto3" json:"token_ticker,omitempty"`
+	TokenName         string `protobuf:"bytes,2,opt,name=token_name,json=tokenName,proto3" json:"token_name,omitempty"`
+	TokenDocumentUrl  string `protobuf:"bytes,3,opt,name=token_document_url,json=tokenDocumentUrl,proto3" json:"token_document_url,omitempty"`
+	TokenDocumentHash []byte `protobuf:"bytes,4,opt,name=token_document_hash,json=tokenDocumentHash,proto3" json:"token_document_hash,omitempty"`
+	Decimals          uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	MintBatonHash     []byte `protobuf:"bytes,6,opt,name=mint_baton_hash,json=mintBatonHash,proto3" json:"mint_baton_hash,omitempty"`
+	MintBatonVout     uint32 `protobuf:"varint,7,opt,name=mint_baton_vout,json=mintBatonVout,proto3" json:"mint_baton_vout,omitempty"`
+}
+
+func (x *SlpTokenMetadata_V1Fungible) Reset() {
+	*x = SlpTokenMetadata_V1Fungible{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlpTokenMetadata_V1Fungible) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlpTokenMetadata_V1Fungible) ProtoMessage() {}
+
+func (x *SlpTokenMetadata_V1Fungible) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlpTokenMetadata_V1Fungible.ProtoReflect.Descriptor instead.
+func (*SlpTokenMetadata_V1Fungible) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{84, 0}
+}
+
+func (x *SlpTokenMetadata_V1Fungible) GetTokenTicker() string {
+	if x != nil {
+		return x.TokenTicker
+	}
+	return ""
+}
+
+func (x *SlpTokenMetadata_V1Fungible) GetTokenName() string {
+	if x != nil {
+		return x.TokenName
+	}
+	return ""
+}
+
+func (x *SlpTokenMetadata_V1Fungible) GetTokenDocumentUrl() string {
+	if x != nil {
+		return x.TokenDocumentUrl
+	}
+	return ""
+}
+
+func (x *SlpTokenMetadata_V1Fungible) GetTokenDocumentHash() []byte {
+	if x != nil {
+		return x.TokenDocumentHash
+	}
+	return nil
+}
+
+func (x *SlpTokenMetadata_V1Fungible) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *SlpTokenMetadata_V1Fungible) GetMintBatonHash() []byte {
+	if x != nil {
+		return x.MintBatonHash
+	}
+	return nil
+}
+
+func (x *SlpTokenMetadata_V1Fungible) GetMintBatonVout() uint32 {
+	if x != nil {
+		return x.MintBatonVout
+	}
+	return 0
+}
+
+// V1NFT1Group is used to marshal metadata specific to NFT1 Group token IDs
+type SlpTokenMetadata_V1NFT1Group struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenTicker       string `protobuf:"bytes,1,opt,name=token_ticker,json=tokenTicker,proto3" json:"token_ticker,omitempty"`
+	TokenName         string `protobuf:"bytes,2,opt,name=token_name,json=tokenName,proto3" json:"token_name,omitempty"`
+	TokenDocumentUrl  string `protobuf:"bytes,3,opt,name=token_document_url,json=tokenDocumentUrl,proto3" json:"token_document_url,omitempty"`
+	TokenDocumentHash []byte `protobuf:"bytes,4,opt,name=token_document_hash,json=tokenDocumentHash,proto3" json:"token_document_hash,omitempty"`
+	Decimals          uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	MintBatonHash     []byte `protobuf:"bytes,6,opt,name=mint_baton_hash,json=mintBatonHash,proto3" json:"mint_baton_hash,omitempty"`
+	MintBatonVout     uint32 `protobuf:"varint,7,opt,name=mint_baton_vout,json=mintBatonVout,proto3" json:"mint_baton_vout,omitempty"`
+}
+
+func (x *SlpTokenMetadata_V1NFT1Group) Reset() {
+	*x = SlpTokenMetadata_V1NFT1Group{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlpTokenMetadata_V1NFT1Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlpTokenMetadata_V1NFT1Group) ProtoMessage() {}
+
+func (x *SlpTokenMetadata_V1NFT1Group) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlpTokenMetadata_V1NFT1Group.ProtoReflect.Descriptor instead.
+func (*SlpTokenMetadata_V1NFT1Group) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{84, 1}
+}
+
+func (x *SlpTokenMetadata_V1NFT1Group) GetTokenTicker() string {
+	if x != nil {
+		return x.TokenTicker
+	}
+	return ""
+}
+
+func (x *SlpTokenMetadata_V1NFT1Group) GetTokenName() string {
+	if x != nil {
+		return x.TokenName
+	}
+	return ""
+}
+
+func (x *SlpTokenMetadata_V1NFT1Group) GetTokenDocumentUrl() string {
+	if x != nil {
+		return x.TokenDocumentUrl
+	}
+	return ""
+}
+
+func (x *SlpTokenMetadata_V1NFT1Group) GetTokenDocumentHash() []byte {
+	if x != nil {
+		return x.TokenDocumentHash
+	}
+	return nil
+}
+
+func (x *SlpTokenMetadata_V1NFT1Group) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *SlpTokenMetadata_V1NFT1Group) GetMintBatonHash() []byte {
+	if x != nil {
+		return x.MintBatonHash
+	}
+	return nil
+}
+
+func (x *SlpTokenMetadata_V1NFT1Group) GetMintBatonVout() uint32 {
+	if x != nil {
+		return x.MintBatonVout
+	}
+	return 0
+}
+
+// V1NFT1Child is used to marshal metadata specific to NFT1 Child token IDs
+type SlpTokenMetadata_V1NFT1Child struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenTicker       string `protobuf:"bytes,1,opt,name=token_ticker,json=tokenTicker,proto3" json:"token_ticker,omitempty"`
+	TokenName         string `protobuf:"bytes,2,opt,name=token_name,json=tokenName,proto3" json:"token_name,omitempty"`
+	TokenDocumentUrl  string `protobuf:"bytes,3,opt,name=token_document_url,json=tokenDocumentUrl,proto3" json:"token_document_url,omitempty"`
+	TokenDocumentHash []byte `protobuf:"bytes,4,opt,name=token_document_hash,json=tokenDocumentHash,proto3" json:"token_document_hash,omitempty"`
+	GroupId           []byte `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (x *SlpTokenMetadata_V1NFT1Child) Reset() {
+	*x = SlpTokenMetadata_V1NFT1Child{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bchrpc_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlpTokenMetadata_V1NFT1Child) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlpTokenMetadata_V1NFT1Child) ProtoMessage() {}
+
+func (x *SlpTokenMetadata_V1NFT1Child) ProtoReflect() protoreflect.Message {
+	mi := &file_bchrpc_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlpTokenMetadata_V1NFT1Child.ProtoReflect.Descriptor instead.
+func (*SlpTokenMetadata_V1NFT1Child) Descriptor() ([]byte, []int) {
+	return file_bchrpc_proto_rawDescGZIP(), []int{84, 2}
+}
+
+func (x *SlpTokenMetadata_V1NFT1Child) GetTokenTicker() string {
+	if x != nil {
+		return x.TokenTicker
+	}
+	return ""
+}
+
+func (x *SlpTokenMetadata_V1NFT1Child) GetTokenName() string {
+	if x != nil {
+		return x.TokenName
+	}
+	return ""
+}
+
+func (x *SlpTokenMetadata_V1NFT1Child) GetTokenDocumentUrl() string {
+	if x != nil {
+		return x.TokenDocumentUrl
+	}
+	return ""
+}
+
+func (x *SlpTokenMetadata_V1NFT1Child) GetTokenDocumentHash() []byte {
+	if x != nil {
+		return x.TokenDocumentHash
+	}
+	return nil
+}
+
+func (x *SlpTokenMetadata_V1NFT1Child) GetGroupId() []byte {
+	if x != nil {
+		return x.GroupId
 	}
 	return nil
 }
@@ -6040,26 +8521,96 @@ var file_bchrpc_proto_rawDesc = []byte{
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74,
 	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22,
-	0x40, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x10, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0xed, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f,
-	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x83, 0x01, 0x0a, 0x0f,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x2b, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x33, 0x0a, 0x0b,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x74, 0x78, 0x69, 0x64, 0x73, 0x5f, 0x6f, 0x72, 0x5f, 0x74, 0x78,
-	0x73, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
+	0xeb, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x39, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f,
+	0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x22, 0x0a, 0x08, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x45, 0x45, 0x5f, 0x52, 0x41, 0x54,
+	0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x22, 0xab, 0x03,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x17, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a,
+	0x89, 0x02, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x33, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x6b, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x65, 0x65,
+	0x50, 0x65, 0x72, 0x4b, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61,
+	0x64, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x74,
+	0x78, 0x69, 0x64, 0x73, 0x5f, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x73, 0x22, 0x37, 0x0a, 0x21, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x22, 0xba, 0x05, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x09, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x65,
+	0x6e, 0x64, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x46, 0x65, 0x65,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x63,
+	0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65,
+	0x73, 0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x46,
+	0x65, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x1a, 0xed, 0x01, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x0a,
+	0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x62, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x64,
+	0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x61, 0x64, 0x64, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x64,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x5f,
+	0x62, 0x79, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x42,
+	0x79, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
 	0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc0, 0x03,
 	0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
 	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0b, 0x62,
@@ -6098,619 +8649,915 @@ var file_bchrpc_proto_rawDesc = []byte{
 	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x21, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
-	0x69, 0x6e, 0x66, 0x6f, 0x22, 0x80, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18,
-	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
-	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x75, 0x6c, 0x6c,
-	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6f, 0x72,
-	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x33, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x56, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x48, 0x00, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6f, 0x72, 0x5f, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x22, 0x2b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x22, 0x59, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x68, 0x61,
-	0x73, 0x68, 0x5f, 0x6f, 0x72, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x30, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x62,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x12, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x48,
-	0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x70, 0x48, 0x61,
-	0x73, 0x68, 0x22, 0x3d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x22, 0x61, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6e, 0x66, 0x6f, 0x22, 0x7b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a,
+	0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x22, 0x7e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x69, 0x6e,
+	0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0x80, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6f, 0x72, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x33, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x56, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42,
+	0x10, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6f, 0x72, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0x2b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0xe4,
+	0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52,
+	0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x45, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x21, 0x0a, 0x0b, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x5a, 0x53, 0x54, 0x44, 0x10, 0x01, 0x42, 0x07, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x22, 0x6c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0x59, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x10, 0x0a, 0x0e,
+	0x68, 0x61, 0x73, 0x68, 0x5f, 0x6f, 0x72, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x30,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x22, 0x77, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x07, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x38, 0x0a, 0x1b, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x77, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x9e, 0x02, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x61, 0x6e, 0x6e,
+	0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x74, 0x5f, 0x74, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x61, 0x74, 0x54, 0x69, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x75, 0x6e,
+	0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x3e, 0x0a,
+	0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x62, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x12, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x70, 0x48, 0x61, 0x73,
+	0x68, 0x22, 0x3d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x22, 0x61, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x34, 0x0a,
+	0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x88, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3b, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2e,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
 	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x34,
-	0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x88, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x31, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0x2e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22,
-	0x3d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xac,
-	0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x62,
-	0x5f, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x62, 0x53,
-	0x6b, 0x69, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x62, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x62, 0x46, 0x65, 0x74, 0x63, 0x68, 0x12, 0x14,
-	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x0d,
-	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0xbb, 0x01,
-	0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x46, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x51, 0x0a, 0x18, 0x75, 0x6e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e,
-	0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x17, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x20,
-	0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x62,
-	0x5f, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x62, 0x53,
-	0x6b, 0x69, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x62, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x62, 0x46, 0x65, 0x74, 0x63, 0x68, 0x12, 0x14,
-	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x0d,
-	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x95, 0x01,
-	0x0a, 0x21, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
-	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0c, 0x52, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x18, 0x75, 0x6e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x17, 0x75, 0x6e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d,
-	0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x34, 0x0a, 0x16,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x8c, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e,
-	0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0xa2, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73,
-	0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
-	0x64, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x3d,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x67, 0x0a,
+	0x22, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x2d, 0x0a, 0x12, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x11, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x70, 0x74, 0x68, 0x22, 0xc9, 0x02, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x24, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x11, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70,
+	0x74, 0x68, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x11,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xac, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x62, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x6e, 0x62, 0x53, 0x6b, 0x69, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x62, 0x5f, 0x66, 0x65,
+	0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x62, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x12, 0x14, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x48, 0x00, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0xbb, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65,
+	0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x51, 0x0a, 0x18,
+	0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x17, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0xaf, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x62, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x6e, 0x62, 0x53, 0x6b, 0x69, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x62, 0x5f, 0x66, 0x65,
+	0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x62, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x12, 0x14, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x48, 0x00, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0x95, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39,
+	0x0a, 0x18, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x17, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x1f, 0x47, 0x65,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
 	0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
 	0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
 	0x52, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xeb, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x6e,
-	0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4f, 0x75, 0x74,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
-	0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73,
-	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x69, 0x73, 0x43, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29,
-	0x0a, 0x09, 0x73, 0x6c, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
-	0x08, 0x73, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x0e, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x0a, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e,
-	0x43, 0x61, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09, 0x63, 0x61, 0x73, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x42, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6b, 0x6c,
-	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a,
-	0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x22, 0x6b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x8c, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
+	0x62, 0x2e, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
+	0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa2, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x73,
+	0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x65, 0x6d,
+	0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xeb, 0x02, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x2e, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x43, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x6c, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x08, 0x73, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3b, 0x0a,
+	0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x0a, 0x63, 0x61,
+	0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09, 0x63,
+	0x61, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x42, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x22, 0x6b, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73,
+	0x68, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x18, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x17, 0x73, 0x6b, 0x69, 0x70,
+	0x5f, 0x73, 0x6c, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x73, 0x6b, 0x69, 0x70, 0x53,
+	0x6c, 0x70, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
+	0x41, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x6c, 0x70, 0x5f,
+	0x62, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x6c, 0x70, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x42, 0x75, 0x72, 0x6e,
+	0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x6c, 0x70, 0x42, 0x75, 0x72,
+	0x6e, 0x73, 0x22, 0x2f, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x22, 0xc0, 0x01, 0x0a, 0x1a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x6c, 0x70,
 	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
 	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x17, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x73, 0x6c, 0x70,
-	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x73, 0x6b, 0x69, 0x70, 0x53, 0x6c, 0x70, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x41, 0x0a, 0x12, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x6c, 0x70, 0x5f, 0x62, 0x75, 0x72, 0x6e,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x10, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x6c, 0x70, 0x42, 0x75, 0x72, 0x6e, 0x73, 0x22, 0x2f,
-	0x0a, 0x19, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22,
-	0xc0, 0x01, 0x0a, 0x1a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x6c, 0x70, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x41, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x6c, 0x70,
-	0x5f, 0x62, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x6c, 0x70, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x42, 0x75, 0x72,
-	0x6e, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x6c, 0x70, 0x42, 0x75,
-	0x72, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x75, 0x73, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x5f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x75, 0x73, 0x65, 0x53, 0x70, 0x65,
-	0x63, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x1b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x6c, 0x70, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x25, 0x0a,
-	0x0e, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x82, 0x02, 0x0a, 0x1c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x75,
-	0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
-	0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69,
-	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x28, 0x0a,
-	0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x54, 0x78, 0x22, 0x8d, 0x01, 0x0a, 0x16, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x10, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x39, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x5f, 0x73, 0x6c, 0x70, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53,
+	0x6c, 0x70, 0x42, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x75, 0x73, 0x65, 0x5f, 0x73,
+	0x70, 0x65, 0x63, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x6a, 0x75, 0x64,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x75, 0x73,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x4a, 0x75, 0x64,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x1b, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x53, 0x6c, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x65, 0x73, 0x74,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62,
+	0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x3c, 0x0a, 0x18, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd1, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x6e, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x10, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x73, 0x6c, 0x70, 0x5f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x6c, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0b,
+	0x73, 0x6c, 0x70, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x22, 0xdc, 0x02, 0x0a, 0x1c,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x09,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x12, 0x37, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x75,
+	0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x69,
+	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x54, 0x78,
+	0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x75, 0x72, 0x6e, 0x73,
+	0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x73, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x42, 0x75, 0x72, 0x6e, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x5e, 0x0a, 0x18, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x70, 0x0a, 0x21, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2d, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x11, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xb0, 0x01, 0x0a,
+	0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x75, 0x6c,
+	0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x65, 0x65, 0x73, 0x22,
+	0x39, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x5a, 0x0a, 0x1b, 0x47, 0x65,
 	0x74, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x5a, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x4b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x50, 0x61, 0x72, 0x73, 0x65,
-	0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
-	0x0a, 0x13, 0x73, 0x6c, 0x70, 0x5f, 0x6f, 0x70, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x73, 0x6c, 0x70,
-	0x4f, 0x70, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x91,
-	0x04, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x53,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x70, 0x61, 0x72, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a,
-	0x0a, 0x73, 0x6c, 0x70, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x09, 0x73, 0x6c, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0a, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0a,
-	0x76, 0x31, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x09, 0x76, 0x31,
-	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x76, 0x31, 0x5f, 0x6d, 0x69,
-	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c,
-	0x70, 0x56, 0x31, 0x4d, 0x69, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48,
-	0x00, 0x52, 0x06, 0x76, 0x31, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x76, 0x31, 0x5f,
-	0x73, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x6c, 0x70, 0x56, 0x31, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x48, 0x00, 0x52, 0x06, 0x76, 0x31, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x56, 0x0a, 0x15, 0x76,
-	0x31, 0x5f, 0x6e, 0x66, 0x74, 0x31, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x67, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x6c, 0x70, 0x56, 0x31, 0x4e, 0x66, 0x74, 0x31, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
-	0x12, 0x76, 0x31, 0x4e, 0x66, 0x74, 0x31, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x47, 0x65, 0x6e, 0x65,
-	0x73, 0x69, 0x73, 0x12, 0x4d, 0x0a, 0x12, 0x76, 0x31, 0x5f, 0x6e, 0x66, 0x74, 0x31, 0x5f, 0x63,
-	0x68, 0x69, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x4e, 0x66, 0x74, 0x31, 0x43, 0x68,
-	0x69, 0x6c, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48,
-	0x00, 0x52, 0x0f, 0x76, 0x31, 0x4e, 0x66, 0x74, 0x31, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65,
-	0x6e, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x73, 0x6c, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0xac, 0x02, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x54, 0x72, 0x75,
-	0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x6c, 0x70, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x89, 0x01, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
-	0x22, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x4f, 0x75, 0x74, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x6f, 0x75, 0x74, 0x5f,
-	0x76, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x76,
-	0x4f, 0x75, 0x74, 0x56, 0x6f, 0x75, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x67, 0x72, 0x61, 0x70, 0x68,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x68, 0x61, 0x73,
-	0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x16, 0x67, 0x72, 0x61, 0x70, 0x68,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x48, 0x61, 0x73, 0x68, 0x65,
-	0x73, 0x22, 0x8e, 0x04, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x54, 0x72, 0x75, 0x73,
-	0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6c, 0x70, 0x5f, 0x6f, 0x70, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x11, 0x73, 0x6c, 0x70, 0x4f, 0x70, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x22, 0x91, 0x04, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x50, 0x61,
+	0x72, 0x73, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x73, 0x69,
+	0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x64, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x6c, 0x70, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x73, 0x6c, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2f, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x31, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x56, 0x31,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48,
+	0x00, 0x52, 0x09, 0x76, 0x31, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x30, 0x0a, 0x07,
+	0x76, 0x31, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x4d, 0x69, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x06, 0x76, 0x31, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x30,
+	0x0a, 0x07, 0x76, 0x31, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x06, 0x76, 0x31, 0x53, 0x65, 0x6e, 0x64,
+	0x12, 0x56, 0x0a, 0x15, 0x76, 0x31, 0x5f, 0x6e, 0x66, 0x74, 0x31, 0x5f, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x4e, 0x66, 0x74, 0x31, 0x43, 0x68,
+	0x69, 0x6c, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x48, 0x00, 0x52, 0x12, 0x76, 0x31, 0x4e, 0x66, 0x74, 0x31, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x4d, 0x0a, 0x12, 0x76, 0x31, 0x5f, 0x6e,
+	0x66, 0x74, 0x31, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x4e,
+	0x66, 0x74, 0x31, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x31, 0x4e, 0x66, 0x74, 0x31, 0x43, 0x68,
+	0x69, 0x6c, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x73, 0x6c, 0x70, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xac, 0x02, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53,
 	0x6c, 0x70, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x73, 0x1a, 0x9c, 0x03, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x6f,
-	0x75, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70,
-	0x72, 0x65, 0x76, 0x4f, 0x75, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x72,
-	0x65, 0x76, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x4f, 0x75, 0x74, 0x56, 0x6f, 0x75, 0x74, 0x12, 0x19,
-	0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x6c, 0x70,
-	0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
-	0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x73, 0x6c,
-	0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62,
-	0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x0f, 0x76, 0x31, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x02, 0x30, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x76, 0x31, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x76, 0x31, 0x5f, 0x6d, 0x69, 0x6e,
-	0x74, 0x5f, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
-	0x0b, 0x76, 0x31, 0x4d, 0x69, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10,
-	0x73, 0x6c, 0x70, 0x5f, 0x74, 0x78, 0x6e, 0x5f, 0x6f, 0x70, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x73, 0x6c, 0x70, 0x54, 0x78, 0x6e, 0x4f, 0x70,
-	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x74, 0x78, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x54, 0x78, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x22, 0x51, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x47, 0x72, 0x61, 0x70,
-	0x68, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61,
-	0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x48,
-	0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x33, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x47,
-	0x72, 0x61, 0x70, 0x68, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x64, 0x61, 0x74, 0x61, 0x22, 0x88, 0x02, 0x0a, 0x11, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
-	0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x2e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
-	0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x34, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65,
-	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x48, 0x00, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x22, 0x27, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x43,
-	0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49,
-	0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x42, 0x07, 0x0a, 0x05,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0xda, 0x02, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x20, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x51, 0x0a, 0x17, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x16, 0x75, 0x6e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x16, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0c, 0x48, 0x00, 0x52, 0x15, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x04, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45,
-	0x44, 0x10, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x84, 0x03, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
-	0x75, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
-	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04,
-	0x62, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x69, 0x74, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
-	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
-	0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0f,
-	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x64, 0x69,
-	0x61, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d,
-	0x65, 0x64, 0x69, 0x61, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x05, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x44, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x83, 0x01, 0x0a,
-	0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x2b, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x33, 0x0a,
-	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x74, 0x78, 0x69, 0x64, 0x73, 0x5f, 0x6f, 0x72, 0x5f, 0x74,
-	0x78, 0x73, 0x22, 0xcb, 0x08, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x2d, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12,
-	0x30, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69,
-	0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x48, 0x0a, 0x14, 0x73, 0x6c, 0x70, 0x5f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x12,
-	0x73, 0x6c, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x1a, 0x88, 0x03, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x3a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x07, 0x71, 0x75,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3a,
+	0x0a, 0x19, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x17, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x89, 0x01, 0x0a, 0x05, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x6f, 0x75, 0x74,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x65,
+	0x76, 0x4f, 0x75, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76,
+	0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x70, 0x72, 0x65, 0x76, 0x4f, 0x75, 0x74, 0x56, 0x6f, 0x75, 0x74, 0x12, 0x38, 0x0a, 0x18,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x16,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x8e, 0x04, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x53, 0x6c,
+	0x70, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x9c, 0x03, 0x0a, 0x0e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x70,
+	0x72, 0x65, 0x76, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x4f, 0x75, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x22, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x6f, 0x75, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x4f, 0x75, 0x74, 0x56,
+	0x6f, 0x75, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x2c,
+	0x0a, 0x0a, 0x73, 0x6c, 0x70, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x73, 0x6c, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0a,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a,
+	0x0f, 0x76, 0x31, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x76, 0x31,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x76,
+	0x31, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x0b, 0x76, 0x31, 0x4d, 0x69, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x6f,
+	0x6e, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x6c, 0x70, 0x5f, 0x74, 0x78, 0x6e, 0x5f, 0x6f, 0x70, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x73, 0x6c, 0x70,
+	0x54, 0x78, 0x6e, 0x4f, 0x70, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x74, 0x78, 0x6e, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x78, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x16, 0x0a, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53,
+	0x6c, 0x70, 0x47, 0x72, 0x61, 0x70, 0x68, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x32,
+	0x0a, 0x0c, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x90, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x78, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06,
+	0x74, 0x78, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x5a, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61,
+	0x73, 0x68, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x68, 0x61, 0x73, 0x68, 0x46, 0x75, 0x6e, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x77, 0x65,
+	0x61, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x22,
+	0x71, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x6c,
+	0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x0b, 0x73, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x32,
+	0x0a, 0x15, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x13, 0x63,
+	0x61, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x22, 0x54, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
+	0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x8b, 0x03, 0x0a, 0x0d, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x75,
+	0x72, 0x69, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x55, 0x72, 0x69, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x48, 0x61, 0x73, 0x68, 0x22, 0x24, 0x0a, 0x06, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x59, 0x10, 0x01,
+	0x22, 0x24, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x07, 0x0a,
+	0x03, 0x53, 0x4c, 0x50, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x41, 0x53, 0x48, 0x5f, 0x54,
+	0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x01, 0x22, 0xeb, 0x02, 0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x48,
+	0x00, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x34, 0x0a, 0x0f,
+	0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x00, 0x52, 0x0e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0f,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x3e, 0x0a, 0x1b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x19, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12,
+	0x21, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x65, 0x65, 0x73, 0x52, 0x04, 0x66, 0x65,
+	0x65, 0x73, 0x22, 0x27, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f,
+	0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49, 0x53,
+	0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0xc7, 0x01, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x65,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x73, 0x69, 0x64, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x75, 0x62, 0x73, 0x69, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x65, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61,
+	0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x66, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x65, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0xc1,
+	0x02, 0x0a, 0x13, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x30, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x33, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x43, 0x4f, 0x4e, 0x46, 0x49,
+	0x52, 0x4d, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52,
+	0x4d, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44,
+	0x10, 0x02, 0x22, 0xfc, 0x01, 0x0a, 0x1c, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x3a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
 	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70,
 	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x29,
-	0x0a, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71,
-	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x71,
-	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70,
-	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29,
-	0x0a, 0x09, 0x73, 0x6c, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
-	0x08, 0x73, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x0a, 0x63, 0x61, 0x73,
-	0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x70, 0x62, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09, 0x63, 0x61,
-	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x34, 0x0a, 0x08, 0x4f, 0x75, 0x74, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0xa0, 0x02,
-	0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x5f, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x75, 0x62,
-	0x6b, 0x65, 0x79, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x63, 0x6c,
-	0x61, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x61, 0x73, 0x73,
-	0x65, 0x6d, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x69, 0x73, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65,
-	0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x6c, 0x70, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x08, 0x73, 0x6c, 0x70, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x0a, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x73, 0x68,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09, 0x63, 0x61, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0xe6, 0x01, 0x0a, 0x12, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
-	0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x64,
-	0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x61, 0x64, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x64,
-	0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0b, 0x61, 0x64, 0x64, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x1c,
-	0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x62, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x62, 0x12, 0x2b, 0x0a, 0x11,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e,
-	0x67, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xa3, 0x02, 0x0a, 0x0d, 0x55, 0x6e,
-	0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6f,
-	0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f,
-	0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
-	0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x43, 0x6f, 0x69, 0x6e, 0x62,
-	0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x6c, 0x70, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x08, 0x73, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x2c, 0x0a, 0x0a, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09, 0x63, 0x61, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x95, 0x02, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4f, 0x75,
-	0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x6c, 0x70, 0x5f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x12, 0x61, 0x6c, 0x6c, 0x53, 0x6c, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x6c, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x6c, 0x70, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x09, 0x43, 0x61, 0x73, 0x68,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x74, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x69, 0x74, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xfa,
-	0x01, 0x0a, 0x08, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x61,
-	0x74, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x4d, 0x69, 0x6e,
-	0x74, 0x42, 0x61, 0x74, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x0a,
-	0x73, 0x6c, 0x70, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x09, 0x73, 0x6c, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0a, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0xe3, 0x06, 0x0a, 0x12,
-	0x53, 0x6c, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x6c, 0x70, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x73, 0x6c, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x57, 0x0a, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x6a, 0x75, 0x64,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x4a, 0x75, 0x64,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79,
-	0x4a, 0x75, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72,
-	0x73, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x70, 0x61, 0x72, 0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0a, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x66, 0x6c,
-	0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x6c, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
-	0x6f, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x09, 0x62, 0x75, 0x72,
-	0x6e, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x31, 0x5f, 0x67, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x6c, 0x70, 0x56, 0x31, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x09, 0x76, 0x31, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x12, 0x30, 0x0a, 0x07, 0x76, 0x31, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x4d, 0x69, 0x6e,
-	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x06, 0x76, 0x31, 0x4d,
-	0x69, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x76, 0x31, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x53,
-	0x65, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x06, 0x76,
-	0x31, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x56, 0x0a, 0x15, 0x76, 0x31, 0x5f, 0x6e, 0x66, 0x74, 0x31,
-	0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x4e,
-	0x66, 0x74, 0x31, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x12, 0x76, 0x31, 0x4e, 0x66, 0x74,
-	0x31, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x4d, 0x0a,
-	0x12, 0x76, 0x31, 0x5f, 0x6e, 0x66, 0x74, 0x31, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x73,
-	0x65, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x6c, 0x70, 0x56, 0x31, 0x4e, 0x66, 0x74, 0x31, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x6e,
-	0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x31, 0x4e,
-	0x66, 0x74, 0x31, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x22, 0x36, 0x0a, 0x11,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4f, 0x52, 0x5f,
-	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x41, 0x4c,
-	0x49, 0x44, 0x10, 0x01, 0x22, 0xbb, 0x01, 0x0a, 0x09, 0x42, 0x75, 0x72, 0x6e, 0x46, 0x6c, 0x61,
-	0x67, 0x73, 0x12, 0x22, 0x0a, 0x1e, 0x42, 0x55, 0x52, 0x4e, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x50,
-	0x55, 0x54, 0x53, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x53, 0x5f, 0x54, 0x4f, 0x4f, 0x5f,
-	0x48, 0x49, 0x47, 0x48, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x55, 0x52, 0x4e, 0x45, 0x44,
-	0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x53, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x4f, 0x50, 0x52, 0x45,
-	0x54, 0x55, 0x52, 0x4e, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x42, 0x55, 0x52, 0x4e, 0x45, 0x44,
-	0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x53, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x54, 0x4f,
-	0x4b, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x42, 0x55, 0x52, 0x4e, 0x45, 0x44, 0x5f,
-	0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x53, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f,
-	0x42, 0x43, 0x48, 0x5f, 0x56, 0x4f, 0x55, 0x54, 0x10, 0x03, 0x12, 0x26, 0x0a, 0x22, 0x42, 0x55,
-	0x52, 0x4e, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x53, 0x5f, 0x47, 0x52, 0x45, 0x41,
-	0x54, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x53,
-	0x10, 0x04, 0x42, 0x0d, 0x0a, 0x0b, 0x74, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0xf3, 0x01, 0x0a, 0x14, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x73,
+	0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x10, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74,
+	0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x22, 0x8a, 0x03, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x62,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x17, 0x75,
+	0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x62, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x16, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37,
+	0x0a, 0x16, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
+	0x52, 0x15, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x62, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70,
+	0x62, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x0a, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x42, 0x75, 0x72, 0x6e, 0x73, 0x22, 0x26, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x01, 0x42,
+	0x0d, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9d,
+	0x02, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x2f, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0c, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x27, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0d, 0x62, 0x75, 0x72, 0x6e,
+	0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x02, 0x30, 0x01, 0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x6e, 0x66, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x4e, 0x66,
+	0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x6f, 0x6e,
+	0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6d,
+	0x69, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x6f, 0x6e, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x22, 0x84,
+	0x03, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x69, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66,
+	0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x69,
+	0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x21, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x12, 0x44, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70,
+	0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x83, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x10,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x33, 0x0a, 0x0b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e,
+	0x0a, 0x0c, 0x74, 0x78, 0x69, 0x64, 0x73, 0x5f, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x73, 0x22, 0xcb,
+	0x08, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x06,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
+	0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x48, 0x0a, 0x14, 0x73, 0x6c, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x12, 0x73, 0x6c, 0x70, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x88,
+	0x03, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3a,
+	0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x6c,
+	0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x08, 0x73, 0x6c, 0x70,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x0a, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x61, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09, 0x63, 0x61, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x1a, 0x34, 0x0a, 0x08, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0xa0, 0x02, 0x0a, 0x06, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c,
+	0x65, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x64, 0x69, 0x73, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x6c, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x08, 0x73, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c,
+	0x0a, 0x0a, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x09, 0x63, 0x61, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe6, 0x01, 0x0a,
+	0x12, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x0a, 0x66, 0x65,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x62, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xa3, 0x02, 0x0a, 0x0d, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e,
+	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x2e, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x43, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x73, 0x6c, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x08, 0x73, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a,
+	0x0a, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x09, 0x63, 0x61, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x95, 0x02, 0x0a, 0x11,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x3c, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c,
+	0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x6c, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x61, 0x6c, 0x6c,
+	0x53, 0x6c, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x22, 0x0a, 0x0d, 0x73, 0x6c, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x64, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x09, 0x43, 0x61, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x62, 0x69, 0x74, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x62, 0x69, 0x74, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xfa, 0x01, 0x0a, 0x08, 0x53,
+	0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22,
+	0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x4d, 0x69, 0x6e, 0x74, 0x42, 0x61, 0x74,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x6c, 0x70, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x6c, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x73, 0x6c, 0x70,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0xe3, 0x06, 0x0a, 0x12, 0x53, 0x6c, 0x70, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c,
+	0x0a, 0x0a, 0x73, 0x6c, 0x70, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x73, 0x6c, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x12,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c,
+	0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x4a, 0x75, 0x64, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x73, 0x65, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x73,
+	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x64, 0x12, 0x3f, 0x0a, 0x0a, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x42, 0x75,
+	0x72, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x09, 0x62, 0x75, 0x72, 0x6e, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x31, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x56,
+	0x31, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x48, 0x00, 0x52, 0x09, 0x76, 0x31, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x30, 0x0a,
+	0x07, 0x76, 0x31, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x4d, 0x69, 0x6e, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x06, 0x76, 0x31, 0x4d, 0x69, 0x6e, 0x74, 0x12,
+	0x30, 0x0a, 0x07, 0x76, 0x31, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x53, 0x65, 0x6e, 0x64, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x06, 0x76, 0x31, 0x53, 0x65, 0x6e,
+	0x64, 0x12, 0x56, 0x0a, 0x15, 0x76, 0x31, 0x5f, 0x6e, 0x66, 0x74, 0x31, 0x5f, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x4e, 0x66, 0x74, 0x31, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x12, 0x76, 0x31, 0x4e, 0x66, 0x74, 0x31, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x4d, 0x0a, 0x12, 0x76, 0x31, 0x5f,
+	0x6e, 0x66, 0x74, 0x31, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x56, 0x31,
+	0x4e, 0x66, 0x74, 0x31, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x31, 0x4e, 0x66, 0x74, 0x31, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x22, 0x36, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x12, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x01,
+	0x22, 0xbb, 0x01, 0x0a, 0x09, 0x42, 0x75, 0x72, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x22,
+	0x0a, 0x1e, 0x42, 0x55, 0x52, 0x4e, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x53, 0x5f,
+	0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x53, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x48, 0x49, 0x47, 0x48,
+	0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x55, 0x52, 0x4e, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x50,
+	0x55, 0x54, 0x53, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x4f, 0x50, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e,
+	0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x42, 0x55, 0x52, 0x4e, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x50,
+	0x55, 0x54, 0x53, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10,
+	0x02, 0x12, 0x23, 0x0a, 0x1f, 0x42, 0x55, 0x52, 0x4e, 0x45, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x50,
+	0x55, 0x54, 0x53, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x43, 0x48, 0x5f,
+	0x56, 0x4f, 0x55, 0x54, 0x10, 0x03, 0x12, 0x26, 0x0a, 0x22, 0x42, 0x55, 0x52, 0x4e, 0x45, 0x44,
+	0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x53, 0x5f, 0x47, 0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x5f,
+	0x54, 0x48, 0x41, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x53, 0x10, 0x04, 0x42, 0x0d,
+	0x0a, 0x0b, 0x74, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xf3, 0x01,
+	0x0a, 0x14, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x62,
+	0x61, 0x74, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x74, 0x12, 0x23,
+	0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x11, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x4d, 0x69, 0x6e, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x74,
+	0x5f, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x74,
+	0x12, 0x23, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x31, 0x0a, 0x11, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x53, 0x65,
+	0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x07, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x1d, 0x53, 0x6c, 0x70,
+	0x56, 0x31, 0x4e, 0x66, 0x74, 0x31, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x73,
 	0x69, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
 	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
 	0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
@@ -6720,77 +9567,34 @@ var file_bchrpc_proto_rawDesc = []byte{
 	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
 	0x52, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a,
 	0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69,
-	0x6e, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x6f, 0x6e, 0x56, 0x6f,
-	0x75, 0x74, 0x12, 0x23, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0a, 0x6d, 0x69, 0x6e,
-	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x11, 0x53, 0x6c, 0x70, 0x56, 0x31,
-	0x4d, 0x69, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0f,
-	0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x75, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x6f, 0x6e,
-	0x56, 0x6f, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0a, 0x6d,
-	0x69, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x31, 0x0a, 0x11, 0x53, 0x6c, 0x70,
-	0x56, 0x31, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c,
-	0x0a, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42,
-	0x02, 0x30, 0x01, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xd5, 0x01, 0x0a,
-	0x1d, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x4e, 0x66, 0x74, 0x31, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x47,
-	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f,
-	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a,
-	0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x24,
+	0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64,
+	0x22, 0x42, 0x0a, 0x1a, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x4e, 0x66, 0x74, 0x31, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24,
 	0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x1a, 0x53, 0x6c, 0x70, 0x56, 0x31, 0x4e, 0x66, 0x74,
-	0x31, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x24, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x22, 0xc5, 0x08, 0x0a, 0x10, 0x53, 0x6c, 0x70,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a,
-	0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x76, 0x31, 0x5f,
-	0x66, 0x75, 0x6e, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x31, 0x46, 0x75, 0x6e, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x48,
-	0x00, 0x52, 0x0a, 0x76, 0x31, 0x46, 0x75, 0x6e, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x46, 0x0a,
-	0x0d, 0x76, 0x31, 0x5f, 0x6e, 0x66, 0x74, 0x31, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x31, 0x4e, 0x46, 0x54,
-	0x31, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00, 0x52, 0x0b, 0x76, 0x31, 0x4e, 0x66, 0x74, 0x31,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x46, 0x0a, 0x0d, 0x76, 0x31, 0x5f, 0x6e, 0x66, 0x74, 0x31,
-	0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x2e, 0x56, 0x31, 0x4e, 0x46, 0x54, 0x31, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x48, 0x00,
-	0x52, 0x0b, 0x76, 0x31, 0x4e, 0x66, 0x74, 0x31, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x1a, 0x98, 0x02,
-	0x0a, 0x0a, 0x56, 0x31, 0x46, 0x75, 0x6e, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12,
-	0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c,
-	0x0a, 0x12, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x2e, 0x0a, 0x13,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08,
-	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
-	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x74,
-	0x5f, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68,
-	0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x5f, 0x76,
-	0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x42,
-	0x61, 0x74, 0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x74, 0x1a, 0x99, 0x02, 0x0a, 0x0b, 0x56, 0x31, 0x4e,
-	0x46, 0x54, 0x31, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x64, 0x22, 0xc5, 0x08, 0x0a, 0x10, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c,
+	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x76, 0x31, 0x5f, 0x66, 0x75, 0x6e, 0x67,
+	0x69, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x56, 0x31, 0x46, 0x75, 0x6e, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x76,
+	0x31, 0x46, 0x75, 0x6e, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x76, 0x31, 0x5f,
+	0x6e, 0x66, 0x74, 0x31, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x31, 0x4e, 0x46, 0x54, 0x31, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x48, 0x00, 0x52, 0x0b, 0x76, 0x31, 0x4e, 0x66, 0x74, 0x31, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x46, 0x0a, 0x0d, 0x76, 0x31, 0x5f, 0x6e, 0x66, 0x74, 0x31, 0x5f, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c,
+	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x56,
+	0x31, 0x4e, 0x46, 0x54, 0x31, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x76, 0x31,
+	0x4e, 0x66, 0x74, 0x31, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x1a, 0x98, 0x02, 0x0a, 0x0a, 0x56, 0x31,
+	0x46, 0x75, 0x6e, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65,
 	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
 	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
 	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -6807,8 +9611,8 @@ var file_bchrpc_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0f,
 	0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x75, 0x74, 0x18,
 	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x6f, 0x6e,
-	0x56, 0x6f, 0x75, 0x74, 0x1a, 0xc8, 0x01, 0x0a, 0x0b, 0x56, 0x31, 0x4e, 0x46, 0x54, 0x31, 0x43,
-	0x68, 0x69, 0x6c, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x69,
+	0x56, 0x6f, 0x75, 0x74, 0x1a, 0x99, 0x02, 0x0a, 0x0b, 0x56, 0x31, 0x4e, 0x46, 0x54, 0x31, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x69,
 	0x63, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65,
 	0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
 	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b,
@@ -6818,178 +9622,252 @@ var file_bchrpc_proto_rawDesc = []byte{
 	0x74, 0x55, 0x72, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x6f,
 	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
 	0x0c, 0x52, 0x11, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x42,
-	0x0f, 0x0a, 0x0d, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0xf3, 0x01, 0x0a, 0x0f, 0x53, 0x6c, 0x70, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
-	0x42, 0x75, 0x72, 0x6e, 0x12, 0x3a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4f, 0x75,
-	0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0a, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
-	0x48, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x69,
-	0x6e, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x6f, 0x6e,
-	0x56, 0x6f, 0x75, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x5b, 0x0a, 0x0c, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f,
-	0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x56,
-	0x31, 0x5f, 0x46, 0x55, 0x4e, 0x47, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
-	0x56, 0x31, 0x5f, 0x4e, 0x46, 0x54, 0x31, 0x5f, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x10, 0x41, 0x12,
-	0x12, 0x0a, 0x0d, 0x56, 0x31, 0x5f, 0x4e, 0x46, 0x54, 0x31, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50,
-	0x10, 0x81, 0x01, 0x2a, 0xb2, 0x02, 0x0a, 0x09, 0x53, 0x6c, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x50, 0x10, 0x00, 0x12, 0x10,
-	0x0a, 0x0c, 0x4e, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x50, 0x5f, 0x42, 0x55, 0x52, 0x4e, 0x10, 0x01,
-	0x12, 0x13, 0x0a, 0x0f, 0x53, 0x4c, 0x50, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x45, 0x5f, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4c, 0x50, 0x5f, 0x55, 0x4e, 0x53,
-	0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e,
-	0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x4c, 0x50, 0x5f, 0x56, 0x31, 0x5f, 0x47, 0x45, 0x4e,
-	0x45, 0x53, 0x49, 0x53, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x4c, 0x50, 0x5f, 0x56, 0x31,
-	0x5f, 0x4d, 0x49, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x4c, 0x50, 0x5f, 0x56,
-	0x31, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0x06, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4c, 0x50, 0x5f,
-	0x56, 0x31, 0x5f, 0x4e, 0x46, 0x54, 0x31, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x47, 0x45,
-	0x4e, 0x45, 0x53, 0x49, 0x53, 0x10, 0x07, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4c, 0x50, 0x5f, 0x56,
-	0x31, 0x5f, 0x4e, 0x46, 0x54, 0x31, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4d, 0x49, 0x4e,
-	0x54, 0x10, 0x08, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4c, 0x50, 0x5f, 0x56, 0x31, 0x5f, 0x4e, 0x46,
-	0x54, 0x31, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0x09, 0x12,
-	0x24, 0x0a, 0x20, 0x53, 0x4c, 0x50, 0x5f, 0x56, 0x31, 0x5f, 0x4e, 0x46, 0x54, 0x31, 0x5f, 0x55,
-	0x4e, 0x49, 0x51, 0x55, 0x45, 0x5f, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x45, 0x4e, 0x45,
-	0x53, 0x49, 0x53, 0x10, 0x0a, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x4c, 0x50, 0x5f, 0x56, 0x31, 0x5f,
-	0x4e, 0x46, 0x54, 0x31, 0x5f, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x5f, 0x43, 0x48, 0x49, 0x4c,
-	0x44, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0x0b, 0x32, 0xc5, 0x0f, 0x0a, 0x06, 0x62, 0x63, 0x68,
-	0x72, 0x70, 0x63, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f,
-	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
-	0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x15, 0x2e, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70,
-	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x43, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a,
-	0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x47,
-	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x42,
+	0x61, 0x74, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x74,
+	0x5f, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x74,
+	0x1a, 0xc8, 0x01, 0x0a, 0x0b, 0x56, 0x31, 0x4e, 0x46, 0x54, 0x31, 0x43, 0x68, 0x69, 0x6c, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c,
+	0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xf3, 0x01, 0x0a,
+	0x0f, 0x53, 0x6c, 0x70, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x42, 0x75, 0x72, 0x6e,
+	0x12, 0x3a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x48, 0x00, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x62,
+	0x61, 0x74, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x00, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x74,
+	0x42, 0x10, 0x0a, 0x0e, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x2a, 0x5b, 0x0a, 0x0c, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x56, 0x31, 0x5f, 0x46, 0x55,
+	0x4e, 0x47, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x56, 0x31, 0x5f, 0x4e,
+	0x46, 0x54, 0x31, 0x5f, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x10, 0x41, 0x12, 0x12, 0x0a, 0x0d, 0x56,
+	0x31, 0x5f, 0x4e, 0x46, 0x54, 0x31, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x81, 0x01, 0x2a,
+	0xb2, 0x02, 0x0a, 0x09, 0x53, 0x6c, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x0a,
+	0x07, 0x4e, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x50, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f,
+	0x4e, 0x5f, 0x53, 0x4c, 0x50, 0x5f, 0x42, 0x55, 0x52, 0x4e, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
+	0x53, 0x4c, 0x50, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x02, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4c, 0x50, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x50, 0x50, 0x4f,
+	0x52, 0x54, 0x45, 0x44, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x12,
+	0x0a, 0x0e, 0x53, 0x4c, 0x50, 0x5f, 0x56, 0x31, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x53, 0x49, 0x53,
+	0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x4c, 0x50, 0x5f, 0x56, 0x31, 0x5f, 0x4d, 0x49, 0x4e,
+	0x54, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x4c, 0x50, 0x5f, 0x56, 0x31, 0x5f, 0x53, 0x45,
+	0x4e, 0x44, 0x10, 0x06, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4c, 0x50, 0x5f, 0x56, 0x31, 0x5f, 0x4e,
+	0x46, 0x54, 0x31, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x53, 0x49,
+	0x53, 0x10, 0x07, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4c, 0x50, 0x5f, 0x56, 0x31, 0x5f, 0x4e, 0x46,
+	0x54, 0x31, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4d, 0x49, 0x4e, 0x54, 0x10, 0x08, 0x12,
+	0x1a, 0x0a, 0x16, 0x53, 0x4c, 0x50, 0x5f, 0x56, 0x31, 0x5f, 0x4e, 0x46, 0x54, 0x31, 0x5f, 0x47,
+	0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0x09, 0x12, 0x24, 0x0a, 0x20, 0x53,
+	0x4c, 0x50, 0x5f, 0x56, 0x31, 0x5f, 0x4e, 0x46, 0x54, 0x31, 0x5f, 0x55, 0x4e, 0x49, 0x51, 0x55,
+	0x45, 0x5f, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x53, 0x49, 0x53, 0x10,
+	0x0a, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x4c, 0x50, 0x5f, 0x56, 0x31, 0x5f, 0x4e, 0x46, 0x54, 0x31,
+	0x5f, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x5f, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x5f, 0x53, 0x45,
+	0x4e, 0x44, 0x10, 0x0b, 0x32, 0xc0, 0x16, 0x0a, 0x06, 0x62, 0x63, 0x68, 0x72, 0x70, 0x63, 0x12,
+	0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
 	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x47,
 	0x65, 0x74, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x16, 0x47, 0x65,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64,
+	0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x70, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x61, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x41, 0x64, 0x64,
 	0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a,
-	0x19, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x18, 0x47, 0x65, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e,
-	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55,
-	0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x73, 0x70,
-	0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
-	0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50,
-	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c,
-	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c,
-	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53,
-	0x6c, 0x70, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1d,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64,
-	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x53,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x64, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65,
-	0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x47,
-	0x72, 0x61, 0x70, 0x68, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x67, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x55, 0x6e,
+	0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x55, 0x6e, 0x73, 0x70,
+	0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x19, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x55, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c,
+	0x70, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70,
+	0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c,
+	0x70, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11,
 	0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x47, 0x72, 0x61, 0x70, 0x68, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x6c, 0x70, 0x47, 0x72, 0x61, 0x70, 0x68, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x43, 0x68, 0x65,
+	0x68, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x70, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x58, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x6c, 0x70, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x53, 0x6c, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65,
 	0x63, 0x6b, 0x53, 0x6c, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x6c, 0x70, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x6c, 0x70, 0x54, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x44, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x70, 0x62, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x52, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72,
 	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x61, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01,
-	0x42, 0x30, 0x0a, 0x0d, 0x63, 0x61, 0x73, 0x68, 0x2e, 0x62, 0x63, 0x68, 0x64, 0x2e, 0x72, 0x70,
-	0x63, 0x5a, 0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x63,
-	0x61, 0x73, 0x68, 0x2f, 0x62, 0x63, 0x68, 0x64, 0x2f, 0x62, 0x63, 0x68, 0x72, 0x70, 0x63, 0x2f,
-	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x61, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x20, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x28, 0x01,
+	0x30, 0x01, 0x12, 0x48, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4e, 0x0a, 0x11,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x12, 0x69, 0x0a, 0x1a,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x25, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x42, 0x30, 0x0a, 0x0d, 0x63, 0x61, 0x73, 0x68, 0x2e,
+	0x62, 0x63, 0x68, 0x64, 0x2e, 0x72, 0x70, 0x63, 0x5a, 0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x63, 0x61, 0x73, 0x68, 0x2f, 0x62, 0x63, 0x68, 0x64, 0x2f,
+	0x62, 0x63, 0x68, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -7004,214 +9882,284 @@ func file_bchrpc_proto_rawDescGZIP() []byte {
 	return file_bchrpc_proto_rawDescData
 }
 
-var file_bchrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_bchrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
+var file_bchrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_bchrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
 var file_bchrpc_proto_goTypes = []interface{}{
-	(SlpTokenType)(0), // 0: pb.SlpTokenType
-	(SlpAction)(0),    // 1: pb.SlpAction
-	(GetBlockchainInfoResponse_BitcoinNet)(0),              // 2: pb.GetBlockchainInfoResponse.BitcoinNet
-	(BlockNotification_Type)(0),                            // 3: pb.BlockNotification.Type
-	(TransactionNotification_Type)(0),                      // 4: pb.TransactionNotification.Type
-	(SlpTransactionInfo_ValidityJudgement)(0),              // 5: pb.SlpTransactionInfo.ValidityJudgement
-	(SlpTransactionInfo_BurnFlags)(0),                      // 6: pb.SlpTransactionInfo.BurnFlags
-	(*GetMempoolInfoRequest)(nil),                          // 7: pb.GetMempoolInfoRequest
-	(*GetMempoolInfoResponse)(nil),                         // 8: pb.GetMempoolInfoResponse
-	(*GetMempoolRequest)(nil),                              // 9: pb.GetMempoolRequest
-	(*GetMempoolResponse)(nil),                             // 10: pb.GetMempoolResponse
-	(*GetBlockchainInfoRequest)(nil),                       // 11: pb.GetBlockchainInfoRequest
-	(*GetBlockchainInfoResponse)(nil),                      // 12: pb.GetBlockchainInfoResponse
-	(*GetBlockInfoRequest)(nil),                            // 13: pb.GetBlockInfoRequest
-	(*GetBlockInfoResponse)(nil),                           // 14: pb.GetBlockInfoResponse
-	(*GetBlockRequest)(nil),                                // 15: pb.GetBlockRequest
-	(*GetBlockResponse)(nil),                               // 16: pb.GetBlockResponse
-	(*GetRawBlockRequest)(nil),                             // 17: pb.GetRawBlockRequest
-	(*GetRawBlockResponse)(nil),                            // 18: pb.GetRawBlockResponse
-	(*GetBlockFilterRequest)(nil),                          // 19: pb.GetBlockFilterRequest
-	(*GetBlockFilterResponse)(nil),                         // 20: pb.GetBlockFilterResponse
-	(*GetHeadersRequest)(nil),                              // 21: pb.GetHeadersRequest
-	(*GetHeadersResponse)(nil),                             // 22: pb.GetHeadersResponse
-	(*GetTransactionRequest)(nil),                          // 23: pb.GetTransactionRequest
-	(*GetTransactionResponse)(nil),                         // 24: pb.GetTransactionResponse
-	(*GetRawTransactionRequest)(nil),                       // 25: pb.GetRawTransactionRequest
-	(*GetRawTransactionResponse)(nil),                      // 26: pb.GetRawTransactionResponse
-	(*GetAddressTransactionsRequest)(nil),                  // 27: pb.GetAddressTransactionsRequest
-	(*GetAddressTransactionsResponse)(nil),                 // 28: pb.GetAddressTransactionsResponse
-	(*GetRawAddressTransactionsRequest)(nil),               // 29: pb.GetRawAddressTransactionsRequest
-	(*GetRawAddressTransactionsResponse)(nil),              // 30: pb.GetRawAddressTransactionsResponse
-	(*GetAddressUnspentOutputsRequest)(nil),                // 31: pb.GetAddressUnspentOutputsRequest
-	(*GetAddressUnspentOutputsResponse)(nil),               // 32: pb.GetAddressUnspentOutputsResponse
-	(*GetUnspentOutputRequest)(nil),                        // 33: pb.GetUnspentOutputRequest
-	(*GetUnspentOutputResponse)(nil),                       // 34: pb.GetUnspentOutputResponse
-	(*GetMerkleProofRequest)(nil),                          // 35: pb.GetMerkleProofRequest
-	(*GetMerkleProofResponse)(nil),                         // 36: pb.GetMerkleProofResponse
-	(*SubmitTransactionRequest)(nil),                       // 37: pb.SubmitTransactionRequest
-	(*SubmitTransactionResponse)(nil),                      // 38: pb.SubmitTransactionResponse
-	(*CheckSlpTransactionRequest)(nil),                     // 39: pb.CheckSlpTransactionRequest
-	(*CheckSlpTransactionResponse)(nil),                    // 40: pb.CheckSlpTransactionResponse
-	(*SubscribeTransactionsRequest)(nil),                   // 41: pb.SubscribeTransactionsRequest
-	(*SubscribeBlocksRequest)(nil),                         // 42: pb.SubscribeBlocksRequest
-	(*GetSlpTokenMetadataRequest)(nil),                     // 43: pb.GetSlpTokenMetadataRequest
-	(*GetSlpTokenMetadataResponse)(nil),                    // 44: pb.GetSlpTokenMetadataResponse
-	(*GetSlpParsedScriptRequest)(nil),                      // 45: pb.GetSlpParsedScriptRequest
-	(*GetSlpParsedScriptResponse)(nil),                     // 46: pb.GetSlpParsedScriptResponse
-	(*GetSlpTrustedValidationRequest)(nil),                 // 47: pb.GetSlpTrustedValidationRequest
-	(*GetSlpTrustedValidationResponse)(nil),                // 48: pb.GetSlpTrustedValidationResponse
-	(*GetSlpGraphSearchRequest)(nil),                       // 49: pb.GetSlpGraphSearchRequest
-	(*GetSlpGraphSearchResponse)(nil),                      // 50: pb.GetSlpGraphSearchResponse
-	(*BlockNotification)(nil),                              // 51: pb.BlockNotification
-	(*TransactionNotification)(nil),                        // 52: pb.TransactionNotification
-	(*BlockInfo)(nil),                                      // 53: pb.BlockInfo
-	(*Block)(nil),                                          // 54: pb.Block
-	(*Transaction)(nil),                                    // 55: pb.Transaction
-	(*MempoolTransaction)(nil),                             // 56: pb.MempoolTransaction
-	(*UnspentOutput)(nil),                                  // 57: pb.UnspentOutput
-	(*TransactionFilter)(nil),                              // 58: pb.TransactionFilter
-	(*CashToken)(nil),                                      // 59: pb.CashToken
-	(*SlpToken)(nil),                                       // 60: pb.SlpToken
-	(*SlpTransactionInfo)(nil),                             // 61: pb.SlpTransactionInfo
-	(*SlpV1GenesisMetadata)(nil),                           // 62: pb.SlpV1GenesisMetadata
-	(*SlpV1MintMetadata)(nil),                              // 63: pb.SlpV1MintMetadata
-	(*SlpV1SendMetadata)(nil),                              // 64: pb.SlpV1SendMetadata
-	(*SlpV1Nft1ChildGenesisMetadata)(nil),                  // 65: pb.SlpV1Nft1ChildGenesisMetadata
-	(*SlpV1Nft1ChildSendMetadata)(nil),                     // 66: pb.SlpV1Nft1ChildSendMetadata
-	(*SlpTokenMetadata)(nil),                               // 67: pb.SlpTokenMetadata
-	(*SlpRequiredBurn)(nil),                                // 68: pb.SlpRequiredBurn
-	(*GetMempoolResponse_TransactionData)(nil),             // 69: pb.GetMempoolResponse.TransactionData
-	(*GetSlpTrustedValidationRequest_Query)(nil),           // 70: pb.GetSlpTrustedValidationRequest.Query
-	(*GetSlpTrustedValidationResponse_ValidityResult)(nil), // 71: pb.GetSlpTrustedValidationResponse.ValidityResult
-	(*Block_TransactionData)(nil),                          // 72: pb.Block.TransactionData
-	(*Transaction_Input)(nil),                              // 73: pb.Transaction.Input
-	(*Transaction_Output)(nil),                             // 74: pb.Transaction.Output
-	(*Transaction_Input_Outpoint)(nil),                     // 75: pb.Transaction.Input.Outpoint
-	(*SlpTokenMetadata_V1Fungible)(nil),                    // 76: pb.SlpTokenMetadata.V1Fungible
-	(*SlpTokenMetadata_V1NFT1Group)(nil),                   // 77: pb.SlpTokenMetadata.V1NFT1Group
-	(*SlpTokenMetadata_V1NFT1Child)(nil),                   // 78: pb.SlpTokenMetadata.V1NFT1Child
+	(SlpTokenType)(0),                                      // 0: pb.SlpTokenType
+	(SlpAction)(0),                                         // 1: pb.SlpAction
+	(GetMempoolRequest_Ordering)(0),                        // 2: pb.GetMempoolRequest.Ordering
+	(GetBlockchainInfoResponse_BitcoinNet)(0),              // 3: pb.GetBlockchainInfoResponse.BitcoinNet
+	(GetRawBlocksRequest_Compression)(0),                   // 4: pb.GetRawBlocksRequest.Compression
+	(TokenMetadata_Source)(0),                              // 5: pb.TokenMetadata.Source
+	(TokenMetadata_TokenKind)(0),                           // 6: pb.TokenMetadata.TokenKind
+	(BlockNotification_Type)(0),                            // 7: pb.BlockNotification.Type
+	(DepositNotification_Type)(0),                          // 8: pb.DepositNotification.Type
+	(TransactionNotification_Type)(0),                      // 9: pb.TransactionNotification.Type
+	(SlpTransactionInfo_ValidityJudgement)(0),              // 10: pb.SlpTransactionInfo.ValidityJudgement
+	(SlpTransactionInfo_BurnFlags)(0),                      // 11: pb.SlpTransactionInfo.BurnFlags
+	(*GetMempoolInfoRequest)(nil),                          // 12: pb.GetMempoolInfoRequest
+	(*GetMempoolInfoResponse)(nil),                         // 13: pb.GetMempoolInfoResponse
+	(*GetMempoolRequest)(nil),                              // 14: pb.GetMempoolRequest
+	(*GetMempoolResponse)(nil),                             // 15: pb.GetMempoolResponse
+	(*GetMempoolTransactionGraphRequest)(nil),              // 16: pb.GetMempoolTransactionGraphRequest
+	(*GetMempoolTransactionGraphResponse)(nil),             // 17: pb.GetMempoolTransactionGraphResponse
+	(*GetBlockchainInfoRequest)(nil),                       // 18: pb.GetBlockchainInfoRequest
+	(*GetBlockchainInfoResponse)(nil),                      // 19: pb.GetBlockchainInfoResponse
+	(*GetBlockInfoRequest)(nil),                            // 20: pb.GetBlockInfoRequest
+	(*GetBlockInfoResponse)(nil),                           // 21: pb.GetBlockInfoResponse
+	(*GetBlockRangeRequest)(nil),                           // 22: pb.GetBlockRangeRequest
+	(*GetBlockRangeResponse)(nil),                          // 23: pb.GetBlockRangeResponse
+	(*GetBlockRequest)(nil),                                // 24: pb.GetBlockRequest
+	(*GetBlockResponse)(nil),                               // 25: pb.GetBlockResponse
+	(*GetRawBlockRequest)(nil),                             // 26: pb.GetRawBlockRequest
+	(*GetRawBlockResponse)(nil),                            // 27: pb.GetRawBlockResponse
+	(*GetRawBlocksRequest)(nil),                            // 28: pb.GetRawBlocksRequest
+	(*GetRawBlocksResponse)(nil),                           // 29: pb.GetRawBlocksResponse
+	(*GetBlockFilterRequest)(nil),                          // 30: pb.GetBlockFilterRequest
+	(*GetBlockFilterResponse)(nil),                         // 31: pb.GetBlockFilterResponse
+	(*RegisterFilterWatchRequest)(nil),                     // 32: pb.RegisterFilterWatchRequest
+	(*RegisterFilterWatchResponse)(nil),                    // 33: pb.RegisterFilterWatchResponse
+	(*GetFilterMatchesRequest)(nil),                        // 34: pb.GetFilterMatchesRequest
+	(*GetFilterMatchesResponse)(nil),                       // 35: pb.GetFilterMatchesResponse
+	(*GetHeadersRequest)(nil),                              // 36: pb.GetHeadersRequest
+	(*GetHeadersResponse)(nil),                             // 37: pb.GetHeadersResponse
+	(*GetTransactionRequest)(nil),                          // 38: pb.GetTransactionRequest
+	(*GetTransactionResponse)(nil),                         // 39: pb.GetTransactionResponse
+	(*GetRawTransactionRequest)(nil),                       // 40: pb.GetRawTransactionRequest
+	(*GetRawTransactionResponse)(nil),                      // 41: pb.GetRawTransactionResponse
+	(*GetTransactionConfirmationsRequest)(nil),             // 42: pb.GetTransactionConfirmationsRequest
+	(*GetTransactionConfirmationsResponse)(nil),            // 43: pb.GetTransactionConfirmationsResponse
+	(*GetAddressTransactionsRequest)(nil),                  // 44: pb.GetAddressTransactionsRequest
+	(*GetAddressTransactionsResponse)(nil),                 // 45: pb.GetAddressTransactionsResponse
+	(*GetRawAddressTransactionsRequest)(nil),               // 46: pb.GetRawAddressTransactionsRequest
+	(*GetRawAddressTransactionsResponse)(nil),              // 47: pb.GetRawAddressTransactionsResponse
+	(*GetAddressUnspentOutputsRequest)(nil),                // 48: pb.GetAddressUnspentOutputsRequest
+	(*GetAddressUnspentOutputsResponse)(nil),               // 49: pb.GetAddressUnspentOutputsResponse
+	(*GetUnspentOutputRequest)(nil),                        // 50: pb.GetUnspentOutputRequest
+	(*GetUnspentOutputResponse)(nil),                       // 51: pb.GetUnspentOutputResponse
+	(*GetMerkleProofRequest)(nil),                          // 52: pb.GetMerkleProofRequest
+	(*GetMerkleProofResponse)(nil),                         // 53: pb.GetMerkleProofResponse
+	(*SubmitTransactionRequest)(nil),                       // 54: pb.SubmitTransactionRequest
+	(*SubmitTransactionResponse)(nil),                      // 55: pb.SubmitTransactionResponse
+	(*CheckSlpTransactionRequest)(nil),                     // 56: pb.CheckSlpTransactionRequest
+	(*CheckSlpTransactionResponse)(nil),                    // 57: pb.CheckSlpTransactionResponse
+	(*DecodeTransactionRequest)(nil),                       // 58: pb.DecodeTransactionRequest
+	(*DecodeTransactionResponse)(nil),                      // 59: pb.DecodeTransactionResponse
+	(*SubscribeTransactionsRequest)(nil),                   // 60: pb.SubscribeTransactionsRequest
+	(*SubscribeDepositsRequest)(nil),                       // 61: pb.SubscribeDepositsRequest
+	(*SubscribeDoubleSpendProofsRequest)(nil),              // 62: pb.SubscribeDoubleSpendProofsRequest
+	(*SubscribeBlocksRequest)(nil),                         // 63: pb.SubscribeBlocksRequest
+	(*GetSlpTokenMetadataRequest)(nil),                     // 64: pb.GetSlpTokenMetadataRequest
+	(*GetSlpTokenMetadataResponse)(nil),                    // 65: pb.GetSlpTokenMetadataResponse
+	(*GetSlpParsedScriptRequest)(nil),                      // 66: pb.GetSlpParsedScriptRequest
+	(*GetSlpParsedScriptResponse)(nil),                     // 67: pb.GetSlpParsedScriptResponse
+	(*GetSlpTrustedValidationRequest)(nil),                 // 68: pb.GetSlpTrustedValidationRequest
+	(*GetSlpTrustedValidationResponse)(nil),                // 69: pb.GetSlpTrustedValidationResponse
+	(*GetSlpGraphSearchRequest)(nil),                       // 70: pb.GetSlpGraphSearchRequest
+	(*GetSlpGraphSearchResponse)(nil),                      // 71: pb.GetSlpGraphSearchResponse
+	(*BloomFilter)(nil),                                    // 72: pb.BloomFilter
+	(*GetTokenMetadataRequest)(nil),                        // 73: pb.GetTokenMetadataRequest
+	(*GetTokenMetadataResponse)(nil),                       // 74: pb.GetTokenMetadataResponse
+	(*TokenMetadata)(nil),                                  // 75: pb.TokenMetadata
+	(*BlockNotification)(nil),                              // 76: pb.BlockNotification
+	(*BlockFees)(nil),                                      // 77: pb.BlockFees
+	(*DepositNotification)(nil),                            // 78: pb.DepositNotification
+	(*DoubleSpendProofNotification)(nil),                   // 79: pb.DoubleSpendProofNotification
+	(*TransactionNotification)(nil),                        // 80: pb.TransactionNotification
+	(*TokenBurn)(nil),                                      // 81: pb.TokenBurn
+	(*BlockInfo)(nil),                                      // 82: pb.BlockInfo
+	(*Block)(nil),                                          // 83: pb.Block
+	(*Transaction)(nil),                                    // 84: pb.Transaction
+	(*MempoolTransaction)(nil),                             // 85: pb.MempoolTransaction
+	(*UnspentOutput)(nil),                                  // 86: pb.UnspentOutput
+	(*TransactionFilter)(nil),                              // 87: pb.TransactionFilter
+	(*CashToken)(nil),                                      // 88: pb.CashToken
+	(*SlpToken)(nil),                                       // 89: pb.SlpToken
+	(*SlpTransactionInfo)(nil),                             // 90: pb.SlpTransactionInfo
+	(*SlpV1GenesisMetadata)(nil),                           // 91: pb.SlpV1GenesisMetadata
+	(*SlpV1MintMetadata)(nil),                              // 92: pb.SlpV1MintMetadata
+	(*SlpV1SendMetadata)(nil),                              // 93: pb.SlpV1SendMetadata
+	(*SlpV1Nft1ChildGenesisMetadata)(nil),                  // 94: pb.SlpV1Nft1ChildGenesisMetadata
+	(*SlpV1Nft1ChildSendMetadata)(nil),                     // 95: pb.SlpV1Nft1ChildSendMetadata
+	(*SlpTokenMetadata)(nil),                               // 96: pb.SlpTokenMetadata
+	(*SlpRequiredBurn)(nil),                                // 97: pb.SlpRequiredBurn
+	(*GetMempoolResponse_TransactionData)(nil),             // 98: pb.GetMempoolResponse.TransactionData
+	(*GetMempoolTransactionGraphResponse_Entry)(nil),       // 99: pb.GetMempoolTransactionGraphResponse.Entry
+	(*GetFilterMatchesResponse_Match)(nil),                 // 100: pb.GetFilterMatchesResponse.Match
+	(*GetSlpTrustedValidationRequest_Query)(nil),           // 101: pb.GetSlpTrustedValidationRequest.Query
+	(*GetSlpTrustedValidationResponse_ValidityResult)(nil), // 102: pb.GetSlpTrustedValidationResponse.ValidityResult
+	(*Block_TransactionData)(nil),                          // 103: pb.Block.TransactionData
+	(*Transaction_Input)(nil),                              // 104: pb.Transaction.Input
+	(*Transaction_Output)(nil),                             // 105: pb.Transaction.Output
+	(*Transaction_Input_Outpoint)(nil),                     // 106: pb.Transaction.Input.Outpoint
+	(*SlpTokenMetadata_V1Fungible)(nil),                    // 107: pb.SlpTokenMetadata.V1Fungible
+	(*SlpTokenMetadata_V1NFT1Group)(nil),                   // 108: pb.SlpTokenMetadata.V1NFT1Group
+	(*SlpTokenMetadata_V1NFT1Child)(nil),                   // 109: pb.SlpTokenMetadata.V1NFT1Child
 }
 var file_bchrpc_proto_depIdxs = []int32{
-	69, // 0: pb.GetMempoolResponse.transaction_data:type_name -> pb.GetMempoolResponse.TransactionData
-	2,  // 1: pb.GetBlockchainInfoResponse.bitcoin_net:type_name -> pb.GetBlockchainInfoResponse.BitcoinNet
-	53, // 2: pb.GetBlockInfoResponse.info:type_name -> pb.BlockInfo
-	54, // 3: pb.GetBlockResponse.block:type_name -> pb.Block
-	53, // 4: pb.GetHeadersResponse.headers:type_name -> pb.BlockInfo
-	55, // 5: pb.GetTransactionResponse.transaction:type_name -> pb.Transaction
-	67, // 6: pb.GetTransactionResponse.token_metadata:type_name -> pb.SlpTokenMetadata
-	55, // 7: pb.GetAddressTransactionsResponse.confirmed_transactions:type_name -> pb.Transaction
-	56, // 8: pb.GetAddressTransactionsResponse.unconfirmed_transactions:type_name -> pb.MempoolTransaction
-	57, // 9: pb.GetAddressUnspentOutputsResponse.outputs:type_name -> pb.UnspentOutput
-	67, // 10: pb.GetAddressUnspentOutputsResponse.token_metadata:type_name -> pb.SlpTokenMetadata
-	75, // 11: pb.GetUnspentOutputResponse.outpoint:type_name -> pb.Transaction.Input.Outpoint
-	60, // 12: pb.GetUnspentOutputResponse.slp_token:type_name -> pb.SlpToken
-	67, // 13: pb.GetUnspentOutputResponse.token_metadata:type_name -> pb.SlpTokenMetadata
-	59, // 14: pb.GetUnspentOutputResponse.cash_token:type_name -> pb.CashToken
-	53, // 15: pb.GetMerkleProofResponse.block:type_name -> pb.BlockInfo
-	68, // 16: pb.SubmitTransactionRequest.required_slp_burns:type_name -> pb.SlpRequiredBurn
-	68, // 17: pb.CheckSlpTransactionRequest.required_slp_burns:type_name -> pb.SlpRequiredBurn
-	58, // 18: pb.SubscribeTransactionsRequest.subscribe:type_name -> pb.TransactionFilter
-	58, // 19: pb.SubscribeTransactionsRequest.unsubscribe:type_name -> pb.TransactionFilter
-	67, // 20: pb.GetSlpTokenMetadataResponse.token_metadata:type_name -> pb.SlpTokenMetadata
-	1,  // 21: pb.GetSlpParsedScriptResponse.slp_action:type_name -> pb.SlpAction
-	0,  // 22: pb.GetSlpParsedScriptResponse.token_type:type_name -> pb.SlpTokenType
-	62, // 23: pb.GetSlpParsedScriptResponse.v1_genesis:type_name -> pb.SlpV1GenesisMetadata
-	63, // 24: pb.GetSlpParsedScriptResponse.v1_mint:type_name -> pb.SlpV1MintMetadata
-	64, // 25: pb.GetSlpParsedScriptResponse.v1_send:type_name -> pb.SlpV1SendMetadata
-	65, // 26: pb.GetSlpParsedScriptResponse.v1_nft1_child_genesis:type_name -> pb.SlpV1Nft1ChildGenesisMetadata
-	66, // 27: pb.GetSlpParsedScriptResponse.v1_nft1_child_send:type_name -> pb.SlpV1Nft1ChildSendMetadata
-	70, // 28: pb.GetSlpTrustedValidationRequest.queries:type_name -> pb.GetSlpTrustedValidationRequest.Query
-	71, // 29: pb.GetSlpTrustedValidationResponse.results:type_name -> pb.GetSlpTrustedValidationResponse.ValidityResult
-	3,  // 30: pb.BlockNotification.type:type_name -> pb.BlockNotification.Type
-	53, // 31: pb.BlockNotification.block_info:type_name -> pb.BlockInfo
-	54, // 32: pb.BlockNotification.marshaled_block:type_name -> pb.Block
-	4,  // 33: pb.TransactionNotification.type:type_name -> pb.TransactionNotification.Type
-	55, // 34: pb.TransactionNotification.confirmed_transaction:type_name -> pb.Transaction
-	56, // 35: pb.TransactionNotification.unconfirmed_transaction:type_name -> pb.MempoolTransaction
-	53, // 36: pb.Block.info:type_name -> pb.BlockInfo
-	72, // 37: pb.Block.transaction_data:type_name -> pb.Block.TransactionData
-	73, // 38: pb.Transaction.inputs:type_name -> pb.Transaction.Input
-	74, // 39: pb.Transaction.outputs:type_name -> pb.Transaction.Output
-	61, // 40: pb.Transaction.slp_transaction_info:type_name -> pb.SlpTransactionInfo
-	55, // 41: pb.MempoolTransaction.transaction:type_name -> pb.Transaction
-	75, // 42: pb.UnspentOutput.outpoint:type_name -> pb.Transaction.Input.Outpoint
-	60, // 43: pb.UnspentOutput.slp_token:type_name -> pb.SlpToken
-	59, // 44: pb.UnspentOutput.cash_token:type_name -> pb.CashToken
-	75, // 45: pb.TransactionFilter.outpoints:type_name -> pb.Transaction.Input.Outpoint
-	1,  // 46: pb.SlpToken.slp_action:type_name -> pb.SlpAction
-	0,  // 47: pb.SlpToken.token_type:type_name -> pb.SlpTokenType
-	1,  // 48: pb.SlpTransactionInfo.slp_action:type_name -> pb.SlpAction
-	5,  // 49: pb.SlpTransactionInfo.validity_judgement:type_name -> pb.SlpTransactionInfo.ValidityJudgement
-	6,  // 50: pb.SlpTransactionInfo.burn_flags:type_name -> pb.SlpTransactionInfo.BurnFlags
-	62, // 51: pb.SlpTransactionInfo.v1_genesis:type_name -> pb.SlpV1GenesisMetadata
-	63, // 52: pb.SlpTransactionInfo.v1_mint:type_name -> pb.SlpV1MintMetadata
-	64, // 53: pb.SlpTransactionInfo.v1_send:type_name -> pb.SlpV1SendMetadata
-	65, // 54: pb.SlpTransactionInfo.v1_nft1_child_genesis:type_name -> pb.SlpV1Nft1ChildGenesisMetadata
-	66, // 55: pb.SlpTransactionInfo.v1_nft1_child_send:type_name -> pb.SlpV1Nft1ChildSendMetadata
-	0,  // 56: pb.SlpTokenMetadata.token_type:type_name -> pb.SlpTokenType
-	76, // 57: pb.SlpTokenMetadata.v1_fungible:type_name -> pb.SlpTokenMetadata.V1Fungible
-	77, // 58: pb.SlpTokenMetadata.v1_nft1_group:type_name -> pb.SlpTokenMetadata.V1NFT1Group
-	78, // 59: pb.SlpTokenMetadata.v1_nft1_child:type_name -> pb.SlpTokenMetadata.V1NFT1Child
-	75, // 60: pb.SlpRequiredBurn.outpoint:type_name -> pb.Transaction.Input.Outpoint
-	0,  // 61: pb.SlpRequiredBurn.token_type:type_name -> pb.SlpTokenType
-	55, // 62: pb.GetMempoolResponse.TransactionData.transaction:type_name -> pb.Transaction
-	1,  // 63: pb.GetSlpTrustedValidationResponse.ValidityResult.slp_action:type_name -> pb.SlpAction
-	0,  // 64: pb.GetSlpTrustedValidationResponse.ValidityResult.token_type:type_name -> pb.SlpTokenType
-	55, // 65: pb.Block.TransactionData.transaction:type_name -> pb.Transaction
-	75, // 66: pb.Transaction.Input.outpoint:type_name -> pb.Transaction.Input.Outpoint
-	60, // 67: pb.Transaction.Input.slp_token:type_name -> pb.SlpToken
-	59, // 68: pb.Transaction.Input.cash_token:type_name -> pb.CashToken
-	60, // 69: pb.Transaction.Output.slp_token:type_name -> pb.SlpToken
-	59, // 70: pb.Transaction.Output.cash_token:type_name -> pb.CashToken
-	7,  // 71: pb.bchrpc.GetMempoolInfo:input_type -> pb.GetMempoolInfoRequest
-	9,  // 72: pb.bchrpc.GetMempool:input_type -> pb.GetMempoolRequest
-	11, // 73: pb.bchrpc.GetBlockchainInfo:input_type -> pb.GetBlockchainInfoRequest
-	13, // 74: pb.bchrpc.GetBlockInfo:input_type -> pb.GetBlockInfoRequest
-	15, // 75: pb.bchrpc.GetBlock:input_type -> pb.GetBlockRequest
-	17, // 76: pb.bchrpc.GetRawBlock:input_type -> pb.GetRawBlockRequest
-	19, // 77: pb.bchrpc.GetBlockFilter:input_type -> pb.GetBlockFilterRequest
-	21, // 78: pb.bchrpc.GetHeaders:input_type -> pb.GetHeadersRequest
-	23, // 79: pb.bchrpc.GetTransaction:input_type -> pb.GetTransactionRequest
-	25, // 80: pb.bchrpc.GetRawTransaction:input_type -> pb.GetRawTransactionRequest
-	27, // 81: pb.bchrpc.GetAddressTransactions:input_type -> pb.GetAddressTransactionsRequest
-	29, // 82: pb.bchrpc.GetRawAddressTransactions:input_type -> pb.GetRawAddressTransactionsRequest
-	31, // 83: pb.bchrpc.GetAddressUnspentOutputs:input_type -> pb.GetAddressUnspentOutputsRequest
-	33, // 84: pb.bchrpc.GetUnspentOutput:input_type -> pb.GetUnspentOutputRequest
-	35, // 85: pb.bchrpc.GetMerkleProof:input_type -> pb.GetMerkleProofRequest
-	43, // 86: pb.bchrpc.GetSlpTokenMetadata:input_type -> pb.GetSlpTokenMetadataRequest
-	45, // 87: pb.bchrpc.GetSlpParsedScript:input_type -> pb.GetSlpParsedScriptRequest
-	47, // 88: pb.bchrpc.GetSlpTrustedValidation:input_type -> pb.GetSlpTrustedValidationRequest
-	49, // 89: pb.bchrpc.GetSlpGraphSearch:input_type -> pb.GetSlpGraphSearchRequest
-	39, // 90: pb.bchrpc.CheckSlpTransaction:input_type -> pb.CheckSlpTransactionRequest
-	37, // 91: pb.bchrpc.SubmitTransaction:input_type -> pb.SubmitTransactionRequest
-	41, // 92: pb.bchrpc.SubscribeTransactions:input_type -> pb.SubscribeTransactionsRequest
-	41, // 93: pb.bchrpc.SubscribeTransactionStream:input_type -> pb.SubscribeTransactionsRequest
-	42, // 94: pb.bchrpc.SubscribeBlocks:input_type -> pb.SubscribeBlocksRequest
-	8,  // 95: pb.bchrpc.GetMempoolInfo:output_type -> pb.GetMempoolInfoResponse
-	10, // 96: pb.bchrpc.GetMempool:output_type -> pb.GetMempoolResponse
-	12, // 97: pb.bchrpc.GetBlockchainInfo:output_type -> pb.GetBlockchainInfoResponse
-	14, // 98: pb.bchrpc.GetBlockInfo:output_type -> pb.GetBlockInfoResponse
-	16, // 99: pb.bchrpc.GetBlock:output_type -> pb.GetBlockResponse
-	18, // 100: pb.bchrpc.GetRawBlock:output_type -> pb.GetRawBlockResponse
-	20, // 101: pb.bchrpc.GetBlockFilter:output_type -> pb.GetBlockFilterResponse
-	22, // 102: pb.bchrpc.GetHeaders:output_type -> pb.GetHeadersResponse
-	24, // 103: pb.bchrpc.GetTransaction:output_type -> pb.GetTransactionResponse
-	26, // 104: pb.bchrpc.GetRawTransaction:output_type -> pb.GetRawTransactionResponse
-	28, // 105: pb.bchrpc.GetAddressTransactions:output_type -> pb.GetAddressTransactionsResponse
-	30, // 106: pb.bchrpc.GetRawAddressTransactions:output_type -> pb.GetRawAddressTransactionsResponse
-	32, // 107: pb.bchrpc.GetAddressUnspentOutputs:output_type -> pb.GetAddressUnspentOutputsResponse
-	34, // 108: pb.bchrpc.GetUnspentOutput:output_type -> pb.GetUnspentOutputResponse
-	36, // 109: pb.bchrpc.GetMerkleProof:output_type -> pb.GetMerkleProofResponse
-	44, // 110: pb.bchrpc.GetSlpTokenMetadata:output_type -> pb.GetSlpTokenMetadataResponse
-	46, // 111: pb.bchrpc.GetSlpParsedScript:output_type -> pb.GetSlpParsedScriptResponse
-	48, // 112: pb.bchrpc.GetSlpTrustedValidation:output_type -> pb.GetSlpTrustedValidationResponse
-	50, // 113: pb.bchrpc.GetSlpGraphSearch:output_type -> pb.GetSlpGraphSearchResponse
-	40, // 114: pb.bchrpc.CheckSlpTransaction:output_type -> pb.CheckSlpTransactionResponse
-	38, // 115: pb.bchrpc.SubmitTransaction:output_type -> pb.SubmitTransactionResponse
-	52, // 116: pb.bchrpc.SubscribeTransactions:output_type -> pb.TransactionNotification
-	52, // 117: pb.bchrpc.SubscribeTransactionStream:output_type -> pb.TransactionNotification
-	51, // 118: pb.bchrpc.SubscribeBlocks:output_type -> pb.BlockNotification
-	95, // [95:119] is the sub-list for method output_type
-	71, // [71:95] is the sub-list for method input_type
-	71, // [71:71] is the sub-list for extension type_name
-	71, // [71:71] is the sub-list for extension extendee
-	0,  // [0:71] is the sub-list for field type_name
+	2,   // 0: pb.GetMempoolRequest.order_by:type_name -> pb.GetMempoolRequest.Ordering
+	98,  // 1: pb.GetMempoolResponse.transaction_data:type_name -> pb.GetMempoolResponse.TransactionData
+	99,  // 2: pb.GetMempoolTransactionGraphResponse.transaction:type_name -> pb.GetMempoolTransactionGraphResponse.Entry
+	99,  // 3: pb.GetMempoolTransactionGraphResponse.ancestors:type_name -> pb.GetMempoolTransactionGraphResponse.Entry
+	99,  // 4: pb.GetMempoolTransactionGraphResponse.descendants:type_name -> pb.GetMempoolTransactionGraphResponse.Entry
+	3,   // 5: pb.GetBlockchainInfoResponse.bitcoin_net:type_name -> pb.GetBlockchainInfoResponse.BitcoinNet
+	82,  // 6: pb.GetBlockInfoResponse.info:type_name -> pb.BlockInfo
+	82,  // 7: pb.GetBlockRangeResponse.infos:type_name -> pb.BlockInfo
+	83,  // 8: pb.GetBlockResponse.block:type_name -> pb.Block
+	4,   // 9: pb.GetRawBlocksRequest.compression:type_name -> pb.GetRawBlocksRequest.Compression
+	100, // 10: pb.GetFilterMatchesResponse.matches:type_name -> pb.GetFilterMatchesResponse.Match
+	82,  // 11: pb.GetHeadersResponse.headers:type_name -> pb.BlockInfo
+	84,  // 12: pb.GetTransactionResponse.transaction:type_name -> pb.Transaction
+	96,  // 13: pb.GetTransactionResponse.token_metadata:type_name -> pb.SlpTokenMetadata
+	84,  // 14: pb.GetAddressTransactionsResponse.confirmed_transactions:type_name -> pb.Transaction
+	85,  // 15: pb.GetAddressTransactionsResponse.unconfirmed_transactions:type_name -> pb.MempoolTransaction
+	86,  // 16: pb.GetAddressUnspentOutputsResponse.outputs:type_name -> pb.UnspentOutput
+	96,  // 17: pb.GetAddressUnspentOutputsResponse.token_metadata:type_name -> pb.SlpTokenMetadata
+	106, // 18: pb.GetUnspentOutputResponse.outpoint:type_name -> pb.Transaction.Input.Outpoint
+	89,  // 19: pb.GetUnspentOutputResponse.slp_token:type_name -> pb.SlpToken
+	96,  // 20: pb.GetUnspentOutputResponse.token_metadata:type_name -> pb.SlpTokenMetadata
+	88,  // 21: pb.GetUnspentOutputResponse.cash_token:type_name -> pb.CashToken
+	82,  // 22: pb.GetMerkleProofResponse.block:type_name -> pb.BlockInfo
+	97,  // 23: pb.SubmitTransactionRequest.required_slp_burns:type_name -> pb.SlpRequiredBurn
+	97,  // 24: pb.CheckSlpTransactionRequest.required_slp_burns:type_name -> pb.SlpRequiredBurn
+	84,  // 25: pb.DecodeTransactionResponse.transaction:type_name -> pb.Transaction
+	57,  // 26: pb.DecodeTransactionResponse.slp_validity:type_name -> pb.CheckSlpTransactionResponse
+	87,  // 27: pb.SubscribeTransactionsRequest.subscribe:type_name -> pb.TransactionFilter
+	87,  // 28: pb.SubscribeTransactionsRequest.unsubscribe:type_name -> pb.TransactionFilter
+	96,  // 29: pb.GetSlpTokenMetadataResponse.token_metadata:type_name -> pb.SlpTokenMetadata
+	1,   // 30: pb.GetSlpParsedScriptResponse.slp_action:type_name -> pb.SlpAction
+	0,   // 31: pb.GetSlpParsedScriptResponse.token_type:type_name -> pb.SlpTokenType
+	91,  // 32: pb.GetSlpParsedScriptResponse.v1_genesis:type_name -> pb.SlpV1GenesisMetadata
+	92,  // 33: pb.GetSlpParsedScriptResponse.v1_mint:type_name -> pb.SlpV1MintMetadata
+	93,  // 34: pb.GetSlpParsedScriptResponse.v1_send:type_name -> pb.SlpV1SendMetadata
+	94,  // 35: pb.GetSlpParsedScriptResponse.v1_nft1_child_genesis:type_name -> pb.SlpV1Nft1ChildGenesisMetadata
+	95,  // 36: pb.GetSlpParsedScriptResponse.v1_nft1_child_send:type_name -> pb.SlpV1Nft1ChildSendMetadata
+	101, // 37: pb.GetSlpTrustedValidationRequest.queries:type_name -> pb.GetSlpTrustedValidationRequest.Query
+	102, // 38: pb.GetSlpTrustedValidationResponse.results:type_name -> pb.GetSlpTrustedValidationResponse.ValidityResult
+	72,  // 39: pb.GetSlpGraphSearchRequest.known_filter:type_name -> pb.BloomFilter
+	75,  // 40: pb.GetTokenMetadataResponse.token_metadata:type_name -> pb.TokenMetadata
+	6,   // 41: pb.TokenMetadata.kind:type_name -> pb.TokenMetadata.TokenKind
+	5,   // 42: pb.TokenMetadata.source:type_name -> pb.TokenMetadata.Source
+	7,   // 43: pb.BlockNotification.type:type_name -> pb.BlockNotification.Type
+	82,  // 44: pb.BlockNotification.block_info:type_name -> pb.BlockInfo
+	83,  // 45: pb.BlockNotification.marshaled_block:type_name -> pb.Block
+	77,  // 46: pb.BlockNotification.fees:type_name -> pb.BlockFees
+	8,   // 47: pb.DepositNotification.type:type_name -> pb.DepositNotification.Type
+	105, // 48: pb.DepositNotification.outputs:type_name -> pb.Transaction.Output
+	106, // 49: pb.DoubleSpendProofNotification.outpoint:type_name -> pb.Transaction.Input.Outpoint
+	9,   // 50: pb.TransactionNotification.type:type_name -> pb.TransactionNotification.Type
+	84,  // 51: pb.TransactionNotification.confirmed_transaction:type_name -> pb.Transaction
+	85,  // 52: pb.TransactionNotification.unconfirmed_transaction:type_name -> pb.MempoolTransaction
+	81,  // 53: pb.TransactionNotification.token_burns:type_name -> pb.TokenBurn
+	6,   // 54: pb.TokenBurn.kind:type_name -> pb.TokenMetadata.TokenKind
+	82,  // 55: pb.Block.info:type_name -> pb.BlockInfo
+	103, // 56: pb.Block.transaction_data:type_name -> pb.Block.TransactionData
+	104, // 57: pb.Transaction.inputs:type_name -> pb.Transaction.Input
+	105, // 58: pb.Transaction.outputs:type_name -> pb.Transaction.Output
+	90,  // 59: pb.Transaction.slp_transaction_info:type_name -> pb.SlpTransactionInfo
+	84,  // 60: pb.MempoolTransaction.transaction:type_name -> pb.Transaction
+	106, // 61: pb.UnspentOutput.outpoint:type_name -> pb.Transaction.Input.Outpoint
+	89,  // 62: pb.UnspentOutput.slp_token:type_name -> pb.SlpToken
+	88,  // 63: pb.UnspentOutput.cash_token:type_name -> pb.CashToken
+	106, // 64: pb.TransactionFilter.outpoints:type_name -> pb.Transaction.Input.Outpoint
+	1,   // 65: pb.SlpToken.slp_action:type_name -> pb.SlpAction
+	0,   // 66: pb.SlpToken.token_type:type_name -> pb.SlpTokenType
+	1,   // 67: pb.SlpTransactionInfo.slp_action:type_name -> pb.SlpAction
+	10,  // 68: pb.SlpTransactionInfo.validity_judgement:type_name -> pb.SlpTransactionInfo.ValidityJudgement
+	11,  // 69: pb.SlpTransactionInfo.burn_flags:type_name -> pb.SlpTransactionInfo.BurnFlags
+	91,  // 70: pb.SlpTransactionInfo.v1_genesis:type_name -> pb.SlpV1GenesisMetadata
+	92,  // 71: pb.SlpTransactionInfo.v1_mint:type_name -> pb.SlpV1MintMetadata
+	93,  // 72: pb.SlpTransactionInfo.v1_send:type_name -> pb.SlpV1SendMetadata
+	94,  // 73: pb.SlpTransactionInfo.v1_nft1_child_genesis:type_name -> pb.SlpV1Nft1ChildGenesisMetadata
+	95,  // 74: pb.SlpTransactionInfo.v1_nft1_child_send:type_name -> pb.SlpV1Nft1ChildSendMetadata
+	0,   // 75: pb.SlpTokenMetadata.token_type:type_name -> pb.SlpTokenType
+	107, // 76: pb.SlpTokenMetadata.v1_fungible:type_name -> pb.SlpTokenMetadata.V1Fungible
+	108, // 77: pb.SlpTokenMetadata.v1_nft1_group:type_name -> pb.SlpTokenMetadata.V1NFT1Group
+	109, // 78: pb.SlpTokenMetadata.v1_nft1_child:type_name -> pb.SlpTokenMetadata.V1NFT1Child
+	106, // 79: pb.SlpRequiredBurn.outpoint:type_name -> pb.Transaction.Input.Outpoint
+	0,   // 80: pb.SlpRequiredBurn.token_type:type_name -> pb.SlpTokenType
+	84,  // 81: pb.GetMempoolResponse.TransactionData.transaction:type_name -> pb.Transaction
+	1,   // 82: pb.GetSlpTrustedValidationResponse.ValidityResult.slp_action:type_name -> pb.SlpAction
+	0,   // 83: pb.GetSlpTrustedValidationResponse.ValidityResult.token_type:type_name -> pb.SlpTokenType
+	84,  // 84: pb.Block.TransactionData.transaction:type_name -> pb.Transaction
+	106, // 85: pb.Transaction.Input.outpoint:type_name -> pb.Transaction.Input.Outpoint
+	89,  // 86: pb.Transaction.Input.slp_token:type_name -> pb.SlpToken
+	88,  // 87: pb.Transaction.Input.cash_token:type_name -> pb.CashToken
+	89,  // 88: pb.Transaction.Output.slp_token:type_name -> pb.SlpToken
+	88,  // 89: pb.Transaction.Output.cash_token:type_name -> pb.CashToken
+	12,  // 90: pb.bchrpc.GetMempoolInfo:input_type -> pb.GetMempoolInfoRequest
+	14,  // 91: pb.bchrpc.GetMempool:input_type -> pb.GetMempoolRequest
+	16,  // 92: pb.bchrpc.GetMempoolTransactionGraph:input_type -> pb.GetMempoolTransactionGraphRequest
+	18,  // 93: pb.bchrpc.GetBlockchainInfo:input_type -> pb.GetBlockchainInfoRequest
+	20,  // 94: pb.bchrpc.GetBlockInfo:input_type -> pb.GetBlockInfoRequest
+	22,  // 95: pb.bchrpc.GetBlockRange:input_type -> pb.GetBlockRangeRequest
+	24,  // 96: pb.bchrpc.GetBlock:input_type -> pb.GetBlockRequest
+	26,  // 97: pb.bchrpc.GetRawBlock:input_type -> pb.GetRawBlockRequest
+	28,  // 98: pb.bchrpc.GetRawBlocks:input_type -> pb.GetRawBlocksRequest
+	30,  // 99: pb.bchrpc.GetBlockFilter:input_type -> pb.GetBlockFilterRequest
+	32,  // 100: pb.bchrpc.RegisterFilterWatch:input_type -> pb.RegisterFilterWatchRequest
+	34,  // 101: pb.bchrpc.GetFilterMatches:input_type -> pb.GetFilterMatchesRequest
+	36,  // 102: pb.bchrpc.GetHeaders:input_type -> pb.GetHeadersRequest
+	38,  // 103: pb.bchrpc.GetTransaction:input_type -> pb.GetTransactionRequest
+	40,  // 104: pb.bchrpc.GetRawTransaction:input_type -> pb.GetRawTransactionRequest
+	42,  // 105: pb.bchrpc.GetTransactionConfirmations:input_type -> pb.GetTransactionConfirmationsRequest
+	44,  // 106: pb.bchrpc.GetAddressTransactions:input_type -> pb.GetAddressTransactionsRequest
+	46,  // 107: pb.bchrpc.GetRawAddressTransactions:input_type -> pb.GetRawAddressTransactionsRequest
+	48,  // 108: pb.bchrpc.GetAddressUnspentOutputs:input_type -> pb.GetAddressUnspentOutputsRequest
+	50,  // 109: pb.bchrpc.GetUnspentOutput:input_type -> pb.GetUnspentOutputRequest
+	52,  // 110: pb.bchrpc.GetMerkleProof:input_type -> pb.GetMerkleProofRequest
+	64,  // 111: pb.bchrpc.GetSlpTokenMetadata:input_type -> pb.GetSlpTokenMetadataRequest
+	66,  // 112: pb.bchrpc.GetSlpParsedScript:input_type -> pb.GetSlpParsedScriptRequest
+	68,  // 113: pb.bchrpc.GetSlpTrustedValidation:input_type -> pb.GetSlpTrustedValidationRequest
+	70,  // 114: pb.bchrpc.GetSlpGraphSearch:input_type -> pb.GetSlpGraphSearchRequest
+	56,  // 115: pb.bchrpc.CheckSlpTransaction:input_type -> pb.CheckSlpTransactionRequest
+	58,  // 116: pb.bchrpc.DecodeTransaction:input_type -> pb.DecodeTransactionRequest
+	73,  // 117: pb.bchrpc.GetTokenMetadata:input_type -> pb.GetTokenMetadataRequest
+	54,  // 118: pb.bchrpc.SubmitTransaction:input_type -> pb.SubmitTransactionRequest
+	60,  // 119: pb.bchrpc.SubscribeTransactions:input_type -> pb.SubscribeTransactionsRequest
+	60,  // 120: pb.bchrpc.SubscribeTransactionStream:input_type -> pb.SubscribeTransactionsRequest
+	63,  // 121: pb.bchrpc.SubscribeBlocks:input_type -> pb.SubscribeBlocksRequest
+	61,  // 122: pb.bchrpc.SubscribeDeposits:input_type -> pb.SubscribeDepositsRequest
+	62,  // 123: pb.bchrpc.SubscribeDoubleSpendProofs:input_type -> pb.SubscribeDoubleSpendProofsRequest
+	13,  // 124: pb.bchrpc.GetMempoolInfo:output_type -> pb.GetMempoolInfoResponse
+	15,  // 125: pb.bchrpc.GetMempool:output_type -> pb.GetMempoolResponse
+	17,  // 126: pb.bchrpc.GetMempoolTransactionGraph:output_type -> pb.GetMempoolTransactionGraphResponse
+	19,  // 127: pb.bchrpc.GetBlockchainInfo:output_type -> pb.GetBlockchainInfoResponse
+	21,  // 128: pb.bchrpc.GetBlockInfo:output_type -> pb.GetBlockInfoResponse
+	23,  // 129: pb.bchrpc.GetBlockRange:output_type -> pb.GetBlockRangeResponse
+	25,  // 130: pb.bchrpc.GetBlock:output_type -> pb.GetBlockResponse
+	27,  // 131: pb.bchrpc.GetRawBlock:output_type -> pb.GetRawBlockResponse
+	29,  // 132: pb.bchrpc.GetRawBlocks:output_type -> pb.GetRawBlocksResponse
+	31,  // 133: pb.bchrpc.GetBlockFilter:output_type -> pb.GetBlockFilterResponse
+	33,  // 134: pb.bchrpc.RegisterFilterWatch:output_type -> pb.RegisterFilterWatchResponse
+	35,  // 135: pb.bchrpc.GetFilterMatches:output_type -> pb.GetFilterMatchesResponse
+	37,  // 136: pb.bchrpc.GetHeaders:output_type -> pb.GetHeadersResponse
+	39,  // 137: pb.bchrpc.GetTransaction:output_type -> pb.GetTransactionResponse
+	41,  // 138: pb.bchrpc.GetRawTransaction:output_type -> pb.GetRawTransactionResponse
+	43,  // 139: pb.bchrpc.GetTransactionConfirmations:output_type -> pb.GetTransactionConfirmationsResponse
+	45,  // 140: pb.bchrpc.GetAddressTransactions:output_type -> pb.GetAddressTransactionsResponse
+	47,  // 141: pb.bchrpc.GetRawAddressTransactions:output_type -> pb.GetRawAddressTransactionsResponse
+	49,  // 142: pb.bchrpc.GetAddressUnspentOutputs:output_type -> pb.GetAddressUnspentOutputsResponse
+	51,  // 143: pb.bchrpc.GetUnspentOutput:output_type -> pb.GetUnspentOutputResponse
+	53,  // 144: pb.bchrpc.GetMerkleProof:output_type -> pb.GetMerkleProofResponse
+	65,  // 145: pb.bchrpc.GetSlpTokenMetadata:output_type -> pb.GetSlpTokenMetadataResponse
+	67,  // 146: pb.bchrpc.GetSlpParsedScript:output_type -> pb.GetSlpParsedScriptResponse
+	69,  // 147: pb.bchrpc.GetSlpTrustedValidation:output_type -> pb.GetSlpTrustedValidationResponse
+	71,  // 148: pb.bchrpc.GetSlpGraphSearch:output_type -> pb.GetSlpGraphSearchResponse
+	57,  // 149: pb.bchrpc.CheckSlpTransaction:output_type -> pb.CheckSlpTransactionResponse
+	59,  // 150: pb.bchrpc.DecodeTransaction:output_type -> pb.DecodeTransactionResponse
+	74,  // 151: pb.bchrpc.GetTokenMetadata:output_type -> pb.GetTokenMetadataResponse
+	55,  // 152: pb.bchrpc.SubmitTransaction:output_type -> pb.SubmitTransactionResponse
+	80,  // 153: pb.bchrpc.SubscribeTransactions:output_type -> pb.TransactionNotification
+	80,  // 154: pb.bchrpc.SubscribeTransactionStream:output_type -> pb.TransactionNotification
+	76,  // 155: pb.bchrpc.SubscribeBlocks:output_type -> pb.BlockNotification
+	78,  // 156: pb.bchrpc.SubscribeDeposits:output_type -> pb.DepositNotification
+	79,  // 157: pb.bchrpc.SubscribeDoubleSpendProofs:output_type -> pb.DoubleSpendProofNotification
+	124, // [124:158] is the sub-list for method output_type
+	90,  // [90:124] is the sub-list for method input_type
+	90,  // [90:90] is the sub-list for extension type_name
+	90,  // [90:90] is the sub-list for extension extendee
+	0,   // [0:90] is the sub-list for field type_name
 }
 
 func init() { file_bchrpc_proto_init() }
@@ -7232,8 +10180,68 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMempoolInfoResponse); i {
+		file_bchrpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMempoolInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMempoolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMempoolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMempoolTransactionGraphRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMempoolTransactionGraphResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockchainInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7244,8 +10252,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMempoolRequest); i {
+		file_bchrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockchainInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7256,8 +10264,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMempoolResponse); i {
+		file_bchrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7268,8 +10276,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockchainInfoRequest); i {
+		file_bchrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7280,8 +10288,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockchainInfoResponse); i {
+		file_bchrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockRangeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7292,8 +10300,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockInfoRequest); i {
+		file_bchrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockRangeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7304,8 +10312,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockInfoResponse); i {
+		file_bchrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7316,8 +10324,236 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockRequest); i {
+		file_bchrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRawBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRawBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRawBlocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRawBlocksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockFilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockFilterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterFilterWatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterFilterWatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFilterMatchesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFilterMatchesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHeadersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHeadersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRawTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRawTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionConfirmationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionConfirmationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7328,8 +10564,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockResponse); i {
+		file_bchrpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressTransactionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7340,8 +10576,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRawBlockRequest); i {
+		file_bchrpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRawAddressTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7352,8 +10588,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRawBlockResponse); i {
+		file_bchrpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRawAddressTransactionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7364,8 +10600,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockFilterRequest); i {
+		file_bchrpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressUnspentOutputsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7376,8 +10612,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockFilterResponse); i {
+		file_bchrpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressUnspentOutputsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7388,8 +10624,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHeadersRequest); i {
+		file_bchrpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUnspentOutputRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7400,8 +10636,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHeadersResponse); i {
+		file_bchrpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUnspentOutputResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7412,8 +10648,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionRequest); i {
+		file_bchrpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMerkleProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7424,8 +10660,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionResponse); i {
+		file_bchrpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMerkleProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7436,8 +10672,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRawTransactionRequest); i {
+		file_bchrpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7448,8 +10684,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRawTransactionResponse); i {
+		file_bchrpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7460,8 +10696,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAddressTransactionsRequest); i {
+		file_bchrpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSlpTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7472,8 +10708,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAddressTransactionsResponse); i {
+		file_bchrpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSlpTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7484,8 +10720,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRawAddressTransactionsRequest); i {
+		file_bchrpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7496,8 +10732,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRawAddressTransactionsResponse); i {
+		file_bchrpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7508,8 +10744,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAddressUnspentOutputsRequest); i {
+		file_bchrpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7520,8 +10756,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAddressUnspentOutputsResponse); i {
+		file_bchrpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeDepositsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7532,8 +10768,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUnspentOutputRequest); i {
+		file_bchrpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeDoubleSpendProofsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7544,8 +10780,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUnspentOutputResponse); i {
+		file_bchrpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeBlocksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7556,8 +10792,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMerkleProofRequest); i {
+		file_bchrpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlpTokenMetadataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7568,8 +10804,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMerkleProofResponse); i {
+		file_bchrpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlpTokenMetadataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7580,8 +10816,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitTransactionRequest); i {
+		file_bchrpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlpParsedScriptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7592,8 +10828,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitTransactionResponse); i {
+		file_bchrpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlpParsedScriptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7604,8 +10840,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckSlpTransactionRequest); i {
+		file_bchrpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlpTrustedValidationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7616,8 +10852,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckSlpTransactionResponse); i {
+		file_bchrpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlpTrustedValidationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7628,8 +10864,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeTransactionsRequest); i {
+		file_bchrpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlpGraphSearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7640,8 +10876,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeBlocksRequest); i {
+		file_bchrpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlpGraphSearchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7652,8 +10888,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSlpTokenMetadataRequest); i {
+		file_bchrpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BloomFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7664,8 +10900,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSlpTokenMetadataResponse); i {
+		file_bchrpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenMetadataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7676,8 +10912,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSlpParsedScriptRequest); i {
+		file_bchrpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenMetadataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7688,8 +10924,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSlpParsedScriptResponse); i {
+		file_bchrpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7700,8 +10936,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSlpTrustedValidationRequest); i {
+		file_bchrpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7712,8 +10948,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSlpTrustedValidationResponse); i {
+		file_bchrpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockFees); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7724,8 +10960,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSlpGraphSearchRequest); i {
+		file_bchrpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7736,8 +10972,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSlpGraphSearchResponse); i {
+		file_bchrpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoubleSpendProofNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7748,8 +10984,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockNotification); i {
+		file_bchrpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7760,8 +10996,8 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionNotification); i {
+		file_bchrpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenBurn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7772,7 +11008,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlockInfo); i {
 			case 0:
 				return &v.state
@@ -7784,7 +11020,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Block); i {
 			case 0:
 				return &v.state
@@ -7796,7 +11032,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Transaction); i {
 			case 0:
 				return &v.state
@@ -7808,7 +11044,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MempoolTransaction); i {
 			case 0:
 				return &v.state
@@ -7820,7 +11056,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnspentOutput); i {
 			case 0:
 				return &v.state
@@ -7832,7 +11068,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransactionFilter); i {
 			case 0:
 				return &v.state
@@ -7844,7 +11080,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CashToken); i {
 			case 0:
 				return &v.state
@@ -7856,7 +11092,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SlpToken); i {
 			case 0:
 				return &v.state
@@ -7868,7 +11104,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SlpTransactionInfo); i {
 			case 0:
 				return &v.state
@@ -7880,7 +11116,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SlpV1GenesisMetadata); i {
 			case 0:
 				return &v.state
@@ -7892,7 +11128,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SlpV1MintMetadata); i {
 			case 0:
 				return &v.state
@@ -7904,7 +11140,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SlpV1SendMetadata); i {
 			case 0:
 				return &v.state
@@ -7916,7 +11152,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SlpV1Nft1ChildGenesisMetadata); i {
 			case 0:
 				return &v.state
@@ -7928,7 +11164,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SlpV1Nft1ChildSendMetadata); i {
 			case 0:
 				return &v.state
@@ -7940,7 +11176,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SlpTokenMetadata); i {
 			case 0:
 				return &v.state
@@ -7952,7 +11188,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SlpRequiredBurn); i {
 			case 0:
 				return &v.state
@@ -7964,7 +11200,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetMempoolResponse_TransactionData); i {
 			case 0:
 				return &v.state
@@ -7976,7 +11212,31 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMempoolTransactionGraphResponse_Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFilterMatchesResponse_Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bchrpc_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSlpTrustedValidationRequest_Query); i {
 			case 0:
 				return &v.state
@@ -7988,7 +11248,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSlpTrustedValidationResponse_ValidityResult); i {
 			case 0:
 				return &v.state
@@ -8000,7 +11260,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Block_TransactionData); i {
 			case 0:
 				return &v.state
@@ -8012,7 +11272,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Transaction_Input); i {
 			case 0:
 				return &v.state
@@ -8024,7 +11284,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Transaction_Output); i {
 			case 0:
 				return &v.state
@@ -8036,7 +11296,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Transaction_Input_Outpoint); i {
 			case 0:
 				return &v.state
@@ -8048,7 +11308,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SlpTokenMetadata_V1Fungible); i {
 			case 0:
 				return &v.state
@@ -8060,7 +11320,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SlpTokenMetadata_V1NFT1Group); i {
 			case 0:
 				return &v.state
@@ -8072,7 +11332,7 @@ func file_bchrpc_proto_init() {
 				return nil
 			}
 		}
-		file_bchrpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_bchrpc_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SlpTokenMetadata_V1NFT1Child); i {
 			case 0:
 				return &v.state
@@ -8085,72 +11345,80 @@ func file_bchrpc_proto_init() {
 			}
 		}
 	}
-	file_bchrpc_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_bchrpc_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*GetBlockInfoRequest_Hash)(nil),
 		(*GetBlockInfoRequest_Height)(nil),
 	}
-	file_bchrpc_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_bchrpc_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*GetBlockRangeRequest_StartHash)(nil),
+		(*GetBlockRangeRequest_StartHeight)(nil),
+	}
+	file_bchrpc_proto_msgTypes[12].OneofWrappers = []interface{}{
 		(*GetBlockRequest_Hash)(nil),
 		(*GetBlockRequest_Height)(nil),
 	}
-	file_bchrpc_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_bchrpc_proto_msgTypes[14].OneofWrappers = []interface{}{
 		(*GetRawBlockRequest_Hash)(nil),
 		(*GetRawBlockRequest_Height)(nil),
 	}
-	file_bchrpc_proto_msgTypes[12].OneofWrappers = []interface{}{
+	file_bchrpc_proto_msgTypes[16].OneofWrappers = []interface{}{
+		(*GetRawBlocksRequest_StartHash)(nil),
+		(*GetRawBlocksRequest_StartHeight)(nil),
+	}
+	file_bchrpc_proto_msgTypes[18].OneofWrappers = []interface{}{
 		(*GetBlockFilterRequest_Hash)(nil),
 		(*GetBlockFilterRequest_Height)(nil),
 	}
-	file_bchrpc_proto_msgTypes[20].OneofWrappers = []interface{}{
+	file_bchrpc_proto_msgTypes[32].OneofWrappers = []interface{}{
 		(*GetAddressTransactionsRequest_Hash)(nil),
 		(*GetAddressTransactionsRequest_Height)(nil),
 	}
-	file_bchrpc_proto_msgTypes[22].OneofWrappers = []interface{}{
+	file_bchrpc_proto_msgTypes[34].OneofWrappers = []interface{}{
 		(*GetRawAddressTransactionsRequest_Hash)(nil),
 		(*GetRawAddressTransactionsRequest_Height)(nil),
 	}
-	file_bchrpc_proto_msgTypes[39].OneofWrappers = []interface{}{
+	file_bchrpc_proto_msgTypes[55].OneofWrappers = []interface{}{
 		(*GetSlpParsedScriptResponse_V1Genesis)(nil),
 		(*GetSlpParsedScriptResponse_V1Mint)(nil),
 		(*GetSlpParsedScriptResponse_V1Send)(nil),
 		(*GetSlpParsedScriptResponse_V1Nft1ChildGenesis)(nil),
 		(*GetSlpParsedScriptResponse_V1Nft1ChildSend)(nil),
 	}
-	file_bchrpc_proto_msgTypes[44].OneofWrappers = []interface{}{
+	file_bchrpc_proto_msgTypes[64].OneofWrappers = []interface{}{
 		(*BlockNotification_BlockInfo)(nil),
 		(*BlockNotification_MarshaledBlock)(nil),
 		(*BlockNotification_SerializedBlock)(nil),
 	}
-	file_bchrpc_proto_msgTypes[45].OneofWrappers = []interface{}{
+	file_bchrpc_proto_msgTypes[68].OneofWrappers = []interface{}{
 		(*TransactionNotification_ConfirmedTransaction)(nil),
 		(*TransactionNotification_UnconfirmedTransaction)(nil),
 		(*TransactionNotification_SerializedTransaction)(nil),
 	}
-	file_bchrpc_proto_msgTypes[54].OneofWrappers = []interface{}{
+	file_bchrpc_proto_msgTypes[78].OneofWrappers = []interface{}{
 		(*SlpTransactionInfo_V1Genesis)(nil),
 		(*SlpTransactionInfo_V1Mint)(nil),
 		(*SlpTransactionInfo_V1Send)(nil),
 		(*SlpTransactionInfo_V1Nft1ChildGenesis)(nil),
 		(*SlpTransactionInfo_V1Nft1ChildSend)(nil),
 	}
-	file_bchrpc_proto_msgTypes[60].OneofWrappers = []interface{}{
+	file_bchrpc_proto_msgTypes[84].OneofWrappers = []interface{}{
 		(*SlpTokenMetadata_V1Fungible_)(nil),
 		(*SlpTokenMetadata_V1Nft1Group)(nil),
 		(*SlpTokenMetadata_V1Nft1Child)(nil),
 	}
-	file_bchrpc_proto_msgTypes[61].OneofWrappers = []interface{}{
+	file_bchrpc_proto_msgTypes[85].OneofWrappers = []interface{}{
 		(*SlpRequiredBurn_Amount)(nil),
 		(*SlpRequiredBurn_MintBatonVout)(nil),
 	}
-	file_bchrpc_proto_msgTypes[62].OneofWrappers = []interface{}{
+	file_bchrpc_proto_msgTypes[86].OneofWrappers = []interface{}{
 		(*GetMempoolResponse_TransactionData_TransactionHash)(nil),
 		(*GetMempoolResponse_TransactionData_Transaction)(nil),
 	}
-	file_bchrpc_proto_msgTypes[64].OneofWrappers = []interface{}{
+	file_bchrpc_proto_msgTypes[90].OneofWrappers = []interface{}{
 		(*GetSlpTrustedValidationResponse_ValidityResult_V1TokenAmount)(nil),
 		(*GetSlpTrustedValidationResponse_ValidityResult_V1MintBaton)(nil),
 	}
-	file_bchrpc_proto_msgTypes[65].OneofWrappers = []interface{}{
+	file_bchrpc_proto_msgTypes[91].OneofWrappers = []interface{}{
 		(*Block_TransactionData_TransactionHash)(nil),
 		(*Block_TransactionData_Transaction)(nil),
 	}
@@ -8159,8 +11427,8 @@ func file_bchrpc_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_bchrpc_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   72,
+			NumEnums:      12,
+			NumMessages:   98,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -8191,20 +11459,60 @@ type BchrpcClient interface {
 	GetMempoolInfo(ctx context.Context, in *GetMempoolInfoRequest, opts ...grpc.CallOption) (*GetMempoolInfoResponse, error)
 	// GetMempool returns information about all transactions currently in the memory pool.
 	// Offers an option to return full transactions or just transactions hashes.
+	//
+	// Large mempools can be paged through ordered by fee rate or by time by
+	// setting a page size and passing the continuation token of each page to
+	// fetch the next one.
 	GetMempool(ctx context.Context, in *GetMempoolRequest, opts ...grpc.CallOption) (*GetMempoolResponse, error)
+	// GetMempoolTransactionGraph returns the unconfirmed transactions in the
+	// mempool a transaction depends on and those which depend on it, along
+	// with their fees and sizes. Wallets can use it to tell whether a payment
+	// depends on unconfirmed transactions.
+	GetMempoolTransactionGraph(ctx context.Context, in *GetMempoolTransactionGraphRequest, opts ...grpc.CallOption) (*GetMempoolTransactionGraphResponse, error)
 	// GetBlockchainInfo returns data about the blockchain including the most recent
 	// block hash and height.
 	GetBlockchainInfo(ctx context.Context, in *GetBlockchainInfoRequest, opts ...grpc.CallOption) (*GetBlockchainInfoResponse, error)
 	// GetBlockInfo returns metadata and info for a specified block.
 	GetBlockInfo(ctx context.Context, in *GetBlockInfoRequest, opts ...grpc.CallOption) (*GetBlockInfoResponse, error)
+	// GetBlockRange returns metadata and info for a range of consecutive main
+	// chain blocks. Explorer backends can page through the chain by requesting
+	// the next range from the returned next height.
+	GetBlockRange(ctx context.Context, in *GetBlockRangeRequest, opts ...grpc.CallOption) (*GetBlockRangeResponse, error)
 	// GetBlock returns detailed data for a block.
 	GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error)
 	// GetRawBlock returns a block in a serialized format.
 	GetRawBlock(ctx context.Context, in *GetRawBlockRequest, opts ...grpc.CallOption) (*GetRawBlockResponse, error)
+	// GetRawBlocks streams a range of main chain blocks in a serialized format,
+	// optionally compressed with zstd. This is intended for bootstrapping
+	// indexers without making one request per block.
+	//
+	// Blocks are read ahead of the client by a small bounded amount and the
+	// stream otherwise follows the flow control of the transport, so a slow
+	// client only slows down the stream. The stream is aborted if the range
+	// is reorganized out of the main chain while it is being sent.
+	GetRawBlocks(ctx context.Context, in *GetRawBlocksRequest, opts ...grpc.CallOption) (Bchrpc_GetRawBlocksClient, error)
 	// GetBlockFilter returns the compact filter (cf) of a block as a Golomb-Rice encoded set.
 	//
 	// **Requires CfIndex**
 	GetBlockFilter(ctx context.Context, in *GetBlockFilterRequest, opts ...grpc.CallOption) (*GetBlockFilterResponse, error)
+	// RegisterFilterWatch registers a set of scripts and addresses to be matched
+	// against the compact filters of the blocks from a start height, and returns
+	// the id of the watch. The filters are matched by the node so light clients
+	// don't need to download all of them. Watches which are not queried for an
+	// hour, or which were registered more than a day ago, are removed. Clients
+	// from the same address can register at most 10 watches at once.
+	//
+	// **Requires CfIndex**
+	RegisterFilterWatch(ctx context.Context, in *RegisterFilterWatchRequest, opts ...grpc.CallOption) (*RegisterFilterWatchResponse, error)
+	// GetFilterMatches returns the blocks since a height whose compact filters
+	// match any of the scripts of a watch, which may contain transactions paying
+	// to or spending from them. The filters are matched up to a bounded number of
+	// blocks per call, so the call is repeated until the scanned height reaches
+	// the tip. Matches of blocks which are reorganized out of the main chain are
+	// dropped and the new blocks are matched.
+	//
+	// **Requires CfIndex**
+	GetFilterMatches(ctx context.Context, in *GetFilterMatchesRequest, opts ...grpc.CallOption) (*GetFilterMatchesResponse, error)
 	// GetHeaders takes a block locator object and returns a batch of no more than 2000
 	// headers. Upon parsing the block locator, if the server concludes there has been a
 	// fork, it will send headers starting at the fork point, or genesis if no blocks in
@@ -8221,6 +11529,13 @@ type BchrpcClient interface {
 	//
 	// **Requires TxIndex**
 	GetRawTransaction(ctx context.Context, in *GetRawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
+	// GetTransactionConfirmations returns the number of confirmations of a transaction
+	// along with whether it is buried deep enough to be considered final and the
+	// transactions seen double spending it, so payment processors can decide whether
+	// to settle a payment with a single call.
+	//
+	// **Requires TxIndex for confirmed transactions**
+	GetTransactionConfirmations(ctx context.Context, in *GetTransactionConfirmationsRequest, opts ...grpc.CallOption) (*GetTransactionConfirmationsResponse, error)
 	// GetAddressTransactions returns the transactions for the given address. Offers offset,
 	// limit, and from block options.
 	//
@@ -8261,10 +11576,28 @@ type BchrpcClient interface {
 	GetSlpTrustedValidation(ctx context.Context, in *GetSlpTrustedValidationRequest, opts ...grpc.CallOption) (*GetSlpTrustedValidationResponse, error)
 	// GraphSearch returns all the transactions needed for a client to validate an SLP graph
 	//
+	// Transactions the client already has can be left out of the response with
+	// known_hashes or known_filter. Large results are split into pages.
+	//
 	// **Requires SlpIndex and SlpGraphSearch**
 	GetSlpGraphSearch(ctx context.Context, in *GetSlpGraphSearchRequest, opts ...grpc.CallOption) (*GetSlpGraphSearchResponse, error)
 	// CheckSlpTransaction checks the validity of a supposed slp transaction before it is broadcasted.
 	CheckSlpTransaction(ctx context.Context, in *CheckSlpTransactionRequest, opts ...grpc.CallOption) (*CheckSlpTransactionResponse, error)
+	// DecodeTransaction fully decodes a raw transaction: the scripts of the
+	// outputs are disassembled and classified, CashToken data is decoded and the
+	// slp message is parsed. The previous outputs spent by the inputs are looked
+	// up in the mempool, the utxo set and, when available, the TxIndex to report
+	// the values, scripts, addresses and tokens of the inputs along with the fee.
+	//
+	// **Requires SlpIndex for slp validity hints**
+	DecodeTransaction(ctx context.Context, in *DecodeTransactionRequest, opts ...grpc.CallOption) (*DecodeTransactionResponse, error)
+	// GetTokenMetadata returns the name, symbol and decimals for one or more slp
+	// tokens or CashToken categories. When a Bitcoin Cash Metadata Registry (BCMR)
+	// document is referenced on-chain it is fetched, checked against the committed
+	// hash and used in preference to the on-chain values.
+	//
+	// **Requires TxIndex and Bcmr, and SlpIndex for slp tokens**
+	GetTokenMetadata(ctx context.Context, in *GetTokenMetadataRequest, opts ...grpc.CallOption) (*GetTokenMetadataResponse, error)
 	// Submit a transaction to all connected peers.
 	SubmitTransaction(ctx context.Context, in *SubmitTransactionRequest, opts ...grpc.CallOption) (*SubmitTransactionResponse, error)
 	// SubscribeTransactions creates subscription to all relevant transactions based on
@@ -8289,7 +11622,33 @@ type BchrpcClient interface {
 	SubscribeTransactionStream(ctx context.Context, opts ...grpc.CallOption) (Bchrpc_SubscribeTransactionStreamClient, error)
 	// SubscribeBlocks creates a subscription for notifications of new blocks being
 	// connected to the blockchain or blocks being disconnected.
+	//
+	// When the chain is reorganized, DISCONNECTED notifications are sent for the
+	// blocks rolled back, from the old tip down to the fork point, before the
+	// CONNECTED notifications of the blocks of the new chain.  Each DISCONNECTED
+	// notification lists the transactions of the block returned to the mempool.
 	SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (Bchrpc_SubscribeBlocksClient, error)
+	// SubscribeDeposits creates a subscription for the payments to a set of
+	// addresses which tracks their confirmations. A notification is sent when a
+	// payment is seen in the mempool, when it is confirmed and when it reaches
+	// each of the requested confirmation counts.
+	//
+	// Reorganizations are accounted for: when the block confirming a payment is
+	// disconnected, an UNCONFIRMED notification is sent if the transaction was
+	// returned to the mempool, in which case the confirmation counts are
+	// notified again as it is confirmed anew, and a REMOVED notification
+	// otherwise. A payment is tracked until it reaches the highest requested
+	// confirmation count.
+	SubscribeDeposits(ctx context.Context, in *SubscribeDepositsRequest, opts ...grpc.CallOption) (Bchrpc_SubscribeDepositsClient, error)
+	// SubscribeDoubleSpendProofs creates a subscription for the double-spend
+	// proofs of mempool transactions, whether they were created by the node or
+	// received from its peers, so point-of-sale software can be alerted when an
+	// accepted zero-conf payment is double-spent.
+	//
+	// Proofs can only be created for pay-to-pubkey-hash outputs. A double spend
+	// of a transaction also puts the mempool transactions depending on it at
+	// risk, so they are included in the notification and matched by the filter.
+	SubscribeDoubleSpendProofs(ctx context.Context, in *SubscribeDoubleSpendProofsRequest, opts ...grpc.CallOption) (Bchrpc_SubscribeDoubleSpendProofsClient, error)
 }
 
 type bchrpcClient struct {
@@ -8318,6 +11677,15 @@ func (c *bchrpcClient) GetMempool(ctx context.Context, in *GetMempoolRequest, op
 	return out, nil
 }
 
+func (c *bchrpcClient) GetMempoolTransactionGraph(ctx context.Context, in *GetMempoolTransactionGraphRequest, opts ...grpc.CallOption) (*GetMempoolTransactionGraphResponse, error) {
+	out := new(GetMempoolTransactionGraphResponse)
+	err := c.cc.Invoke(ctx, "/pb.bchrpc/GetMempoolTransactionGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bchrpcClient) GetBlockchainInfo(ctx context.Context, in *GetBlockchainInfoRequest, opts ...grpc.CallOption) (*GetBlockchainInfoResponse, error) {
 	out := new(GetBlockchainInfoResponse)
 	err := c.cc.Invoke(ctx, "/pb.bchrpc/GetBlockchainInfo", in, out, opts...)
@@ -8336,6 +11704,15 @@ func (c *bchrpcClient) GetBlockInfo(ctx context.Context, in *GetBlockInfoRequest
 	return out, nil
 }
 
+func (c *bchrpcClient) GetBlockRange(ctx context.Context, in *GetBlockRangeRequest, opts ...grpc.CallOption) (*GetBlockRangeResponse, error) {
+	out := new(GetBlockRangeResponse)
+	err := c.cc.Invoke(ctx, "/pb.bchrpc/GetBlockRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bchrpcClient) GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error) {
 	out := new(GetBlockResponse)
 	err := c.cc.Invoke(ctx, "/pb.bchrpc/GetBlock", in, out, opts...)
@@ -8345,18 +11722,68 @@ func (c *bchrpcClient) GetBlock(ctx context.Context, in *GetBlockRequest, opts .
 	return out, nil
 }
 
-func (c *bchrpcClient) GetRawBlock(ctx context.Context, in *GetRawBlockRequest, opts ...grpc.CallOption) (*GetRawBlockResponse, error) {
-	out := new(GetRawBlockResponse)
-	err := c.cc.Invoke(ctx, "/pb.bchrpc/GetRawBlock", in, out, opts...)
+func (c *bchrpcClient) GetRawBlock(ctx context.Context, in *GetRawBlockRequest, opts ...grpc.CallOption) (*GetRawBlockResponse, error) {
+	out := new(GetRawBlockResponse)
+	err := c.cc.Invoke(ctx, "/pb.bchrpc/GetRawBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bchrpcClient) GetRawBlocks(ctx context.Context, in *GetRawBlocksRequest, opts ...grpc.CallOption) (Bchrpc_GetRawBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Bchrpc_serviceDesc.Streams[0], "/pb.bchrpc/GetRawBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bchrpcGetRawBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bchrpc_GetRawBlocksClient interface {
+	Recv() (*GetRawBlocksResponse, error)
+	grpc.ClientStream
+}
+
+type bchrpcGetRawBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *bchrpcGetRawBlocksClient) Recv() (*GetRawBlocksResponse, error) {
+	m := new(GetRawBlocksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bchrpcClient) GetBlockFilter(ctx context.Context, in *GetBlockFilterRequest, opts ...grpc.CallOption) (*GetBlockFilterResponse, error) {
+	out := new(GetBlockFilterResponse)
+	err := c.cc.Invoke(ctx, "/pb.bchrpc/GetBlockFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bchrpcClient) RegisterFilterWatch(ctx context.Context, in *RegisterFilterWatchRequest, opts ...grpc.CallOption) (*RegisterFilterWatchResponse, error) {
+	out := new(RegisterFilterWatchResponse)
+	err := c.cc.Invoke(ctx, "/pb.bchrpc/RegisterFilterWatch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bchrpcClient) GetBlockFilter(ctx context.Context, in *GetBlockFilterRequest, opts ...grpc.CallOption) (*GetBlockFilterResponse, error) {
-	out := new(GetBlockFilterResponse)
-	err := c.cc.Invoke(ctx, "/pb.bchrpc/GetBlockFilter", in, out, opts...)
+func (c *bchrpcClient) GetFilterMatches(ctx context.Context, in *GetFilterMatchesRequest, opts ...grpc.CallOption) (*GetFilterMatchesResponse, error) {
+	out := new(GetFilterMatchesResponse)
+	err := c.cc.Invoke(ctx, "/pb.bchrpc/GetFilterMatches", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8390,6 +11817,15 @@ func (c *bchrpcClient) GetRawTransaction(ctx context.Context, in *GetRawTransact
 	return out, nil
 }
 
+func (c *bchrpcClient) GetTransactionConfirmations(ctx context.Context, in *GetTransactionConfirmationsRequest, opts ...grpc.CallOption) (*GetTransactionConfirmationsResponse, error) {
+	out := new(GetTransactionConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/pb.bchrpc/GetTransactionConfirmations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bchrpcClient) GetAddressTransactions(ctx context.Context, in *GetAddressTransactionsRequest, opts ...grpc.CallOption) (*GetAddressTransactionsResponse, error) {
 	out := new(GetAddressTransactionsResponse)
 	err := c.cc.Invoke(ctx, "/pb.bchrpc/GetAddressTransactions", in, out, opts...)
@@ -8480,6 +11916,24 @@ func (c *bchrpcClient) CheckSlpTransaction(ctx context.Context, in *CheckSlpTran
 	return out, nil
 }
 
+func (c *bchrpcClient) DecodeTransaction(ctx context.Context, in *DecodeTransactionRequest, opts ...grpc.CallOption) (*DecodeTransactionResponse, error) {
+	out := new(DecodeTransactionResponse)
+	err := c.cc.Invoke(ctx, "/pb.bchrpc/DecodeTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bchrpcClient) GetTokenMetadata(ctx context.Context, in *GetTokenMetadataRequest, opts ...grpc.CallOption) (*GetTokenMetadataResponse, error) {
+	out := new(GetTokenMetadataResponse)
+	err := c.cc.Invoke(ctx, "/pb.bchrpc/GetTokenMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bchrpcClient) SubmitTransaction(ctx context.Context, in *SubmitTransactionRequest, opts ...grpc.CallOption) (*SubmitTransactionResponse, error) {
 	out := new(SubmitTransactionResponse)
 	err := c.cc.Invoke(ctx, "/pb.bchrpc/SubmitTransaction", in, out, opts...)
@@ -8490,7 +11944,7 @@ func (c *bchrpcClient) SubmitTransaction(ctx context.Context, in *SubmitTransact
 }
 
 func (c *bchrpcClient) SubscribeTransactions(ctx context.Context, in *SubscribeTransactionsRequest, opts ...grpc.CallOption) (Bchrpc_SubscribeTransactionsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Bchrpc_serviceDesc.Streams[0], "/pb.bchrpc/SubscribeTransactions", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Bchrpc_serviceDesc.Streams[1], "/pb.bchrpc/SubscribeTransactions", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8522,7 +11976,7 @@ func (x *bchrpcSubscribeTransactionsClient) Recv() (*TransactionNotification, er
 }
 
 func (c *bchrpcClient) SubscribeTransactionStream(ctx context.Context, opts ...grpc.CallOption) (Bchrpc_SubscribeTransactionStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Bchrpc_serviceDesc.Streams[1], "/pb.bchrpc/SubscribeTransactionStream", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Bchrpc_serviceDesc.Streams[2], "/pb.bchrpc/SubscribeTransactionStream", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8553,7 +12007,7 @@ func (x *bchrpcSubscribeTransactionStreamClient) Recv() (*TransactionNotificatio
 }
 
 func (c *bchrpcClient) SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (Bchrpc_SubscribeBlocksClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Bchrpc_serviceDesc.Streams[2], "/pb.bchrpc/SubscribeBlocks", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Bchrpc_serviceDesc.Streams[3], "/pb.bchrpc/SubscribeBlocks", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8584,26 +12038,130 @@ func (x *bchrpcSubscribeBlocksClient) Recv() (*BlockNotification, error) {
 	return m, nil
 }
 
+func (c *bchrpcClient) SubscribeDeposits(ctx context.Context, in *SubscribeDepositsRequest, opts ...grpc.CallOption) (Bchrpc_SubscribeDepositsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Bchrpc_serviceDesc.Streams[4], "/pb.bchrpc/SubscribeDeposits", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bchrpcSubscribeDepositsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bchrpc_SubscribeDepositsClient interface {
+	Recv() (*DepositNotification, error)
+	grpc.ClientStream
+}
+
+type bchrpcSubscribeDepositsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bchrpcSubscribeDepositsClient) Recv() (*DepositNotification, error) {
+	m := new(DepositNotification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bchrpcClient) SubscribeDoubleSpendProofs(ctx context.Context, in *SubscribeDoubleSpendProofsRequest, opts ...grpc.CallOption) (Bchrpc_SubscribeDoubleSpendProofsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Bchrpc_serviceDesc.Streams[5], "/pb.bchrpc/SubscribeDoubleSpendProofs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bchrpcSubscribeDoubleSpendProofsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bchrpc_SubscribeDoubleSpendProofsClient interface {
+	Recv() (*DoubleSpendProofNotification, error)
+	grpc.ClientStream
+}
+
+type bchrpcSubscribeDoubleSpendProofsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bchrpcSubscribeDoubleSpendProofsClient) Recv() (*DoubleSpendProofNotification, error) {
+	m := new(DoubleSpendProofNotification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // BchrpcServer is the server API for Bchrpc service.
 type BchrpcServer interface {
 	// GetMempoolInfo returns the state of the current mempool.
 	GetMempoolInfo(context.Context, *GetMempoolInfoRequest) (*GetMempoolInfoResponse, error)
 	// GetMempool returns information about all transactions currently in the memory pool.
 	// Offers an option to return full transactions or just transactions hashes.
+	//
+	// Large mempools can be paged through ordered by fee rate or by time by
+	// setting a page size and passing the continuation token of each page to
+	// fetch the next one.
 	GetMempool(context.Context, *GetMempoolRequest) (*GetMempoolResponse, error)
+	// GetMempoolTransactionGraph returns the unconfirmed transactions in the
+	// mempool a transaction depends on and those which depend on it, along
+	// with their fees and sizes. Wallets can use it to tell whether a payment
+	// depends on unconfirmed transactions.
+	GetMempoolTransactionGraph(context.Context, *GetMempoolTransactionGraphRequest) (*GetMempoolTransactionGraphResponse, error)
 	// GetBlockchainInfo returns data about the blockchain including the most recent
 	// block hash and height.
 	GetBlockchainInfo(context.Context, *GetBlockchainInfoRequest) (*GetBlockchainInfoResponse, error)
 	// GetBlockInfo returns metadata and info for a specified block.
 	GetBlockInfo(context.Context, *GetBlockInfoRequest) (*GetBlockInfoResponse, error)
+	// GetBlockRange returns metadata and info for a range of consecutive main
+	// chain blocks. Explorer backends can page through the chain by requesting
+	// the next range from the returned next height.
+	GetBlockRange(context.Context, *GetBlockRangeRequest) (*GetBlockRangeResponse, error)
 	// GetBlock returns detailed data for a block.
 	GetBlock(context.Context, *GetBlockRequest) (*GetBlockResponse, error)
 	// GetRawBlock returns a block in a serialized format.
 	GetRawBlock(context.Context, *GetRawBlockRequest) (*GetRawBlockResponse, error)
+	// GetRawBlocks streams a range of main chain blocks in a serialized format,
+	// optionally compressed with zstd. This is intended for bootstrapping
+	// indexers without making one request per block.
+	//
+	// Blocks are read ahead of the client by a small bounded amount and the
+	// stream otherwise follows the flow control of the transport, so a slow
+	// client only slows down the stream. The stream is aborted if the range
+	// is reorganized out of the main chain while it is being sent.
+	GetRawBlocks(*GetRawBlocksRequest, Bchrpc_GetRawBlocksServer) error
 	// GetBlockFilter returns the compact filter (cf) of a block as a Golomb-Rice encoded set.
 	//
 	// **Requires CfIndex**
 	GetBlockFilter(context.Context, *GetBlockFilterRequest) (*GetBlockFilterResponse, error)
+	// RegisterFilterWatch registers a set of scripts and addresses to be matched
+	// against the compact filters of the blocks from a start height, and returns
+	// the id of the watch. The filters are matched by the node so light clients
+	// don't need to download all of them. Watches which are not queried for an
+	// hour, or which were registered more than a day ago, are removed. Clients
+	// from the same address can register at most 10 watches at once.
+	//
+	// **Requires CfIndex**
+	RegisterFilterWatch(context.Context, *RegisterFilterWatchRequest) (*RegisterFilterWatchResponse, error)
+	// GetFilterMatches returns the blocks since a height whose compact filters
+	// match any of the scripts of a watch, which may contain transactions paying
+	// to or spending from them. The filters are matched up to a bounded number of
+	// blocks per call, so the call is repeated until the scanned height reaches
+	// the tip. Matches of blocks which are reorganized out of the main chain are
+	// dropped and the new blocks are matched.
+	//
+	// **Requires CfIndex**
+	GetFilterMatches(context.Context, *GetFilterMatchesRequest) (*GetFilterMatchesResponse, error)
 	// GetHeaders takes a block locator object and returns a batch of no more than 2000
 	// headers. Upon parsing the block locator, if the server concludes there has been a
 	// fork, it will send headers starting at the fork point, or genesis if no blocks in
@@ -8620,6 +12178,13 @@ type BchrpcServer interface {
 	//
 	// **Requires TxIndex**
 	GetRawTransaction(context.Context, *GetRawTransactionRequest) (*GetRawTransactionResponse, error)
+	// GetTransactionConfirmations returns the number of confirmations of a transaction
+	// along with whether it is buried deep enough to be considered final and the
+	// transactions seen double spending it, so payment processors can decide whether
+	// to settle a payment with a single call.
+	//
+	// **Requires TxIndex for confirmed transactions**
+	GetTransactionConfirmations(context.Context, *GetTransactionConfirmationsRequest) (*GetTransactionConfirmationsResponse, error)
 	// GetAddressTransactions returns the transactions for the given address. Offers offset,
 	// limit, and from block options.
 	//
@@ -8660,10 +12225,28 @@ type BchrpcServer interface {
 	GetSlpTrustedValidation(context.Context, *GetSlpTrustedValidationRequest) (*GetSlpTrustedValidationResponse, error)
 	// GraphSearch returns all the transactions needed for a client to validate an SLP graph
 	//
+	// Transactions the client already has can be left out of the response with
+	// known_hashes or known_filter. Large results are split into pages.
+	//
 	// **Requires SlpIndex and SlpGraphSearch**
 	GetSlpGraphSearch(context.Context, *GetSlpGraphSearchRequest) (*GetSlpGraphSearchResponse, error)
 	// CheckSlpTransaction checks the validity of a supposed slp transaction before it is broadcasted.
 	CheckSlpTransaction(context.Context, *CheckSlpTransactionRequest) (*CheckSlpTransactionResponse, error)
+	// DecodeTransaction fully decodes a raw transaction: the scripts of the
+	// outputs are disassembled and classified, CashToken data is decoded and the
+	// slp message is parsed. The previous outputs spent by the inputs are looked
+	// up in the mempool, the utxo set and, when available, the TxIndex to report
+	// the values, scripts, addresses and tokens of the inputs along with the fee.
+	//
+	// **Requires SlpIndex for slp validity hints**
+	DecodeTransaction(context.Context, *DecodeTransactionRequest) (*DecodeTransactionResponse, error)
+	// GetTokenMetadata returns the name, symbol and decimals for one or more slp
+	// tokens or CashToken categories. When a Bitcoin Cash Metadata Registry (BCMR)
+	// document is referenced on-chain it is fetched, checked against the committed
+	// hash and used in preference to the on-chain values.
+	//
+	// **Requires TxIndex and Bcmr, and SlpIndex for slp tokens**
+	GetTokenMetadata(context.Context, *GetTokenMetadataRequest) (*GetTokenMetadataResponse, error)
 	// Submit a transaction to all connected peers.
 	SubmitTransaction(context.Context, *SubmitTransactionRequest) (*SubmitTransactionResponse, error)
 	// SubscribeTransactions creates subscription to all relevant transactions based on
@@ -8688,7 +12271,33 @@ type BchrpcServer interface {
 	SubscribeTransactionStream(Bchrpc_SubscribeTransactionStreamServer) error
 	// SubscribeBlocks creates a subscription for notifications of new blocks being
 	// connected to the blockchain or blocks being disconnected.
+	//
+	// When the chain is reorganized, DISCONNECTED notifications are sent for the
+	// blocks rolled back, from the old tip down to the fork point, before the
+	// CONNECTED notifications of the blocks of the new chain.  Each DISCONNECTED
+	// notification lists the transactions of the block returned to the mempool.
 	SubscribeBlocks(*SubscribeBlocksRequest, Bchrpc_SubscribeBlocksServer) error
+	// SubscribeDeposits creates a subscription for the payments to a set of
+	// addresses which tracks their confirmations. A notification is sent when a
+	// payment is seen in the mempool, when it is confirmed and when it reaches
+	// each of the requested confirmation counts.
+	//
+	// Reorganizations are accounted for: when the block confirming a payment is
+	// disconnected, an UNCONFIRMED notification is sent if the transaction was
+	// returned to the mempool, in which case the confirmation counts are
+	// notified again as it is confirmed anew, and a REMOVED notification
+	// otherwise. A payment is tracked until it reaches the highest requested
+	// confirmation count.
+	SubscribeDeposits(*SubscribeDepositsRequest, Bchrpc_SubscribeDepositsServer) error
+	// SubscribeDoubleSpendProofs creates a subscription for the double-spend
+	// proofs of mempool transactions, whether they were created by the node or
+	// received from its peers, so point-of-sale software can be alerted when an
+	// accepted zero-conf payment is double-spent.
+	//
+	// Proofs can only be created for pay-to-pubkey-hash outputs. A double spend
+	// of a transaction also puts the mempool transactions depending on it at
+	// risk, so they are included in the notification and matched by the filter.
+	SubscribeDoubleSpendProofs(*SubscribeDoubleSpendProofsRequest, Bchrpc_SubscribeDoubleSpendProofsServer) error
 }
 
 // UnimplementedBchrpcServer can be embedded to have forward compatible implementations.
@@ -8701,21 +12310,36 @@ func (*UnimplementedBchrpcServer) GetMempoolInfo(context.Context, *GetMempoolInf
 func (*UnimplementedBchrpcServer) GetMempool(context.Context, *GetMempoolRequest) (*GetMempoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMempool not implemented")
 }
+func (*UnimplementedBchrpcServer) GetMempoolTransactionGraph(context.Context, *GetMempoolTransactionGraphRequest) (*GetMempoolTransactionGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMempoolTransactionGraph not implemented")
+}
 func (*UnimplementedBchrpcServer) GetBlockchainInfo(context.Context, *GetBlockchainInfoRequest) (*GetBlockchainInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockchainInfo not implemented")
 }
 func (*UnimplementedBchrpcServer) GetBlockInfo(context.Context, *GetBlockInfoRequest) (*GetBlockInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockInfo not implemented")
 }
+func (*UnimplementedBchrpcServer) GetBlockRange(context.Context, *GetBlockRangeRequest) (*GetBlockRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockRange not implemented")
+}
 func (*UnimplementedBchrpcServer) GetBlock(context.Context, *GetBlockRequest) (*GetBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
 }
 func (*UnimplementedBchrpcServer) GetRawBlock(context.Context, *GetRawBlockRequest) (*GetRawBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRawBlock not implemented")
 }
+func (*UnimplementedBchrpcServer) GetRawBlocks(*GetRawBlocksRequest, Bchrpc_GetRawBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetRawBlocks not implemented")
+}
 func (*UnimplementedBchrpcServer) GetBlockFilter(context.Context, *GetBlockFilterRequest) (*GetBlockFilterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockFilter not implemented")
 }
+func (*UnimplementedBchrpcServer) RegisterFilterWatch(context.Context, *RegisterFilterWatchRequest) (*RegisterFilterWatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterFilterWatch not implemented")
+}
+func (*UnimplementedBchrpcServer) GetFilterMatches(context.Context, *GetFilterMatchesRequest) (*GetFilterMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFilterMatches not implemented")
+}
 func (*UnimplementedBchrpcServer) GetHeaders(context.Context, *GetHeadersRequest) (*GetHeadersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHeaders not implemented")
 }
@@ -8725,6 +12349,9 @@ func (*UnimplementedBchrpcServer) GetTransaction(context.Context, *GetTransactio
 func (*UnimplementedBchrpcServer) GetRawTransaction(context.Context, *GetRawTransactionRequest) (*GetRawTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRawTransaction not implemented")
 }
+func (*UnimplementedBchrpcServer) GetTransactionConfirmations(context.Context, *GetTransactionConfirmationsRequest) (*GetTransactionConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionConfirmations not implemented")
+}
 func (*UnimplementedBchrpcServer) GetAddressTransactions(context.Context, *GetAddressTransactionsRequest) (*GetAddressTransactionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAddressTransactions not implemented")
 }
@@ -8755,6 +12382,12 @@ func (*UnimplementedBchrpcServer) GetSlpGraphSearch(context.Context, *GetSlpGrap
 func (*UnimplementedBchrpcServer) CheckSlpTransaction(context.Context, *CheckSlpTransactionRequest) (*CheckSlpTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckSlpTransaction not implemented")
 }
+func (*UnimplementedBchrpcServer) DecodeTransaction(context.Context, *DecodeTransactionRequest) (*DecodeTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeTransaction not implemented")
+}
+func (*UnimplementedBchrpcServer) GetTokenMetadata(context.Context, *GetTokenMetadataRequest) (*GetTokenMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenMetadata not implemented")
+}
 func (*UnimplementedBchrpcServer) SubmitTransaction(context.Context, *SubmitTransactionRequest) (*SubmitTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitTransaction not implemented")
 }
@@ -8767,6 +12400,12 @@ func (*UnimplementedBchrpcServer) SubscribeTransactionStream(Bchrpc_SubscribeTra
 func (*UnimplementedBchrpcServer) SubscribeBlocks(*SubscribeBlocksRequest, Bchrpc_SubscribeBlocksServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeBlocks not implemented")
 }
+func (*UnimplementedBchrpcServer) SubscribeDeposits(*SubscribeDepositsRequest, Bchrpc_SubscribeDepositsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeDeposits not implemented")
+}
+func (*UnimplementedBchrpcServer) SubscribeDoubleSpendProofs(*SubscribeDoubleSpendProofsRequest, Bchrpc_SubscribeDoubleSpendProofsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeDoubleSpendProofs not implemented")
+}
 
 func RegisterBchrpcServer(s *grpc.Server, srv BchrpcServer) {
 	s.RegisterService(&_Bchrpc_serviceDesc, srv)
@@ -8808,6 +12447,24 @@ func _Bchrpc_GetMempool_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bchrpc_GetMempoolTransactionGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMempoolTransactionGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BchrpcServer).GetMempoolTransactionGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.bchrpc/GetMempoolTransactionGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BchrpcServer).GetMempoolTransactionGraph(ctx, req.(*GetMempoolTransactionGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Bchrpc_GetBlockchainInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockchainInfoRequest)
 	if err := dec(in); err != nil {
@@ -8844,6 +12501,24 @@ func _Bchrpc_GetBlockInfo_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bchrpc_GetBlockRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BchrpcServer).GetBlockRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.bchrpc/GetBlockRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BchrpcServer).GetBlockRange(ctx, req.(*GetBlockRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Bchrpc_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockRequest)
 	if err := dec(in); err != nil {
@@ -8880,6 +12555,27 @@ func _Bchrpc_GetRawBlock_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bchrpc_GetRawBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetRawBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BchrpcServer).GetRawBlocks(m, &bchrpcGetRawBlocksServer{stream})
+}
+
+type Bchrpc_GetRawBlocksServer interface {
+	Send(*GetRawBlocksResponse) error
+	grpc.ServerStream
+}
+
+type bchrpcGetRawBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *bchrpcGetRawBlocksServer) Send(m *GetRawBlocksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Bchrpc_GetBlockFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockFilterRequest)
 	if err := dec(in); err != nil {
@@ -8898,6 +12594,42 @@ func _Bchrpc_GetBlockFilter_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bchrpc_RegisterFilterWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterFilterWatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BchrpcServer).RegisterFilterWatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.bchrpc/RegisterFilterWatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BchrpcServer).RegisterFilterWatch(ctx, req.(*RegisterFilterWatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bchrpc_GetFilterMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFilterMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BchrpcServer).GetFilterMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.bchrpc/GetFilterMatches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BchrpcServer).GetFilterMatches(ctx, req.(*GetFilterMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Bchrpc_GetHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetHeadersRequest)
 	if err := dec(in); err != nil {
@@ -8952,6 +12684,24 @@ func _Bchrpc_GetRawTransaction_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bchrpc_GetTransactionConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionConfirmationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BchrpcServer).GetTransactionConfirmations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.bchrpc/GetTransactionConfirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BchrpcServer).GetTransactionConfirmations(ctx, req.(*GetTransactionConfirmationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Bchrpc_GetAddressTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAddressTransactionsRequest)
 	if err := dec(in); err != nil {
@@ -9132,6 +12882,42 @@ func _Bchrpc_CheckSlpTransaction_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bchrpc_DecodeTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BchrpcServer).DecodeTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.bchrpc/DecodeTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BchrpcServer).DecodeTransaction(ctx, req.(*DecodeTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bchrpc_GetTokenMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BchrpcServer).GetTokenMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.bchrpc/GetTokenMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BchrpcServer).GetTokenMetadata(ctx, req.(*GetTokenMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Bchrpc_SubmitTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubmitTransactionRequest)
 	if err := dec(in); err != nil {
@@ -9218,6 +13004,48 @@ func (x *bchrpcSubscribeBlocksServer) Send(m *BlockNotification) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Bchrpc_SubscribeDeposits_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeDepositsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BchrpcServer).SubscribeDeposits(m, &bchrpcSubscribeDepositsServer{stream})
+}
+
+type Bchrpc_SubscribeDepositsServer interface {
+	Send(*DepositNotification) error
+	grpc.ServerStream
+}
+
+type bchrpcSubscribeDepositsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bchrpcSubscribeDepositsServer) Send(m *DepositNotification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bchrpc_SubscribeDoubleSpendProofs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeDoubleSpendProofsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BchrpcServer).SubscribeDoubleSpendProofs(m, &bchrpcSubscribeDoubleSpendProofsServer{stream})
+}
+
+type Bchrpc_SubscribeDoubleSpendProofsServer interface {
+	Send(*DoubleSpendProofNotification) error
+	grpc.ServerStream
+}
+
+type bchrpcSubscribeDoubleSpendProofsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bchrpcSubscribeDoubleSpendProofsServer) Send(m *DoubleSpendProofNotification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Bchrpc_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.bchrpc",
 	HandlerType: (*BchrpcServer)(nil),
@@ -9230,6 +13058,10 @@ var _Bchrpc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMempool",
 			Handler:    _Bchrpc_GetMempool_Handler,
 		},
+		{
+			MethodName: "GetMempoolTransactionGraph",
+			Handler:    _Bchrpc_GetMempoolTransactionGraph_Handler,
+		},
 		{
 			MethodName: "GetBlockchainInfo",
 			Handler:    _Bchrpc_GetBlockchainInfo_Handler,
@@ -9238,6 +13070,10 @@ var _Bchrpc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockInfo",
 			Handler:    _Bchrpc_GetBlockInfo_Handler,
 		},
+		{
+			MethodName: "GetBlockRange",
+			Handler:    _Bchrpc_GetBlockRange_Handler,
+		},
 		{
 			MethodName: "GetBlock",
 			Handler:    _Bchrpc_GetBlock_Handler,
@@ -9250,6 +13086,14 @@ var _Bchrpc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockFilter",
 			Handler:    _Bchrpc_GetBlockFilter_Handler,
 		},
+		{
+			MethodName: "RegisterFilterWatch",
+			Handler:    _Bchrpc_RegisterFilterWatch_Handler,
+		},
+		{
+			MethodName: "GetFilterMatches",
+			Handler:    _Bchrpc_GetFilterMatches_Handler,
+		},
 		{
 			MethodName: "GetHeaders",
 			Handler:    _Bchrpc_GetHeaders_Handler,
@@ -9262,6 +13106,10 @@ var _Bchrpc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetRawTransaction",
 			Handler:    _Bchrpc_GetRawTransaction_Handler,
 		},
+		{
+			MethodName: "GetTransactionConfirmations",
+			Handler:    _Bchrpc_GetTransactionConfirmations_Handler,
+		},
 		{
 			MethodName: "GetAddressTransactions",
 			Handler:    _Bchrpc_GetAddressTransactions_Handler,
@@ -9302,12 +13150,25 @@ var _Bchrpc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CheckSlpTransaction",
 			Handler:    _Bchrpc_CheckSlpTransaction_Handler,
 		},
+		{
+			MethodName: "DecodeTransaction",
+			Handler:    _Bchrpc_DecodeTransaction_Handler,
+		},
+		{
+			MethodName: "GetTokenMetadata",
+			Handler:    _Bchrpc_GetTokenMetadata_Handler,
+		},
 		{
 			MethodName: "SubmitTransaction",
 			Handler:    _Bchrpc_SubmitTransaction_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetRawBlocks",
+			Handler:       _Bchrpc_GetRawBlocks_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeTransactions",
 			Handler:       _Bchrpc_SubscribeTransactions_Handler,
@@ -9324,6 +13185,16 @@ var _Bchrpc_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Bchrpc_SubscribeBlocks_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeDeposits",
+			Handler:       _Bchrpc_SubscribeDeposits_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeDoubleSpendProofs",
+			Handler:       _Bchrpc_SubscribeDoubleSpendProofs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "bchrpc.proto",
 }
@@ -3,13 +3,15 @@ package bchrpc
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"math/big"
 	"net/http"
-	"strconv"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -22,10 +24,13 @@ import (
 	"github.com/gcash/bchd/database"
 	"github.com/gcash/bchd/mempool"
 	"github.com/gcash/bchd/mining"
+	"github.com/gcash/bchd/supervisor"
 	"github.com/gcash/bchd/txscript"
 	"github.com/gcash/bchd/wire"
 	"github.com/gcash/bchutil"
+	"github.com/gcash/bchutil/bloom"
 	"github.com/gcash/bchutil/merkleblock"
+	"github.com/klauspost/compress/zstd"
 	"github.com/simpleledgerinc/goslp"
 	"github.com/simpleledgerinc/goslp/v1parser"
 	"google.golang.org/grpc"
@@ -38,6 +43,41 @@ import (
 // to return per query.
 const maxAddressQuerySize = 10000
 
+// maxTokenMetadataQuerySize is the max number of tokens
+// which may be requested in a single GetTokenMetadata call.
+const maxTokenMetadataQuerySize = 100
+
+// maxSlpGraphSearchPageSize is the max number of transactions
+// to return per graph search page.
+const maxSlpGraphSearchPageSize = 10000
+
+// defaultFinalizationDepth is the number of confirmations from which
+// GetTransactionConfirmations reports transactions as final by default.  Node
+// implementations with rolling checkpoints refuse to reorganize blocks buried
+// this deep.
+const defaultFinalizationDepth = 10
+
+// maxBlockRangeCount is the maximum number of blocks returned by
+// GetBlockRange.
+const maxBlockRangeCount = 1000
+
+// maxMempoolPageSize is the maximum number of transactions returned per page
+// by GetMempool.
+const maxMempoolPageSize = 10000
+
+// rawBlockReadAhead is the number of blocks GetRawBlocks loads and
+// compresses ahead of the block being sent to the client.
+const rawBlockReadAhead = 16
+
+// maxSlpGraphSearchPageBytes is the max total size of the transactions
+// returned per graph search page.  It is kept below the default 4MB
+// message size limit of gRPC clients.
+const maxSlpGraphSearchPageBytes = 3 * 1024 * 1024
+
+// chainNotificationQueueSize is the number of block chain notifications
+// buffered for the server before the chain waits for it to catch up.
+const chainNotificationQueueSize = 100
+
 var serviceMap = map[string]interface{}{
 	"pb.bchrpc": &GrpcServer{},
 
@@ -83,6 +123,13 @@ type NetManager interface {
 	// transactions.  This function should be called whenever new transactions
 	// are added to the mempool.
 	AnnounceNewTransactions(txns []*mempool.TxDesc)
+
+	// AnnounceLocalTransactions is like AnnounceNewTransactions for the
+	// transactions accepted to the mempool as a result of a transaction
+	// submitted to this node, which must be the first one.  The submitted
+	// transaction is announced to a random subset of the outbound peers so
+	// its propagation can be tracked.
+	AnnounceLocalTransactions(txns []*mempool.TxDesc)
 }
 
 // GrpcServerConfig hols the various objects needed by the GrpcServer to
@@ -102,6 +149,14 @@ type GrpcServerConfig struct {
 	AddrIndex *indexers.AddrIndex
 	CfIndex   *indexers.CfIndex
 	SlpIndex  *indexers.SlpIndex
+
+	BcmrResolver *BcmrResolver
+
+	// TemplateFees returns the total fees of the block template generated
+	// by the node the passed block was built from, along with whether there
+	// is such a template.  It may be nil when the node doesn't generate
+	// block templates.
+	TemplateFees func(block *bchutil.Block) (int64, bool)
 }
 
 // GrpcServer is the gRPC server implementation. It holds all the objects
@@ -119,11 +174,41 @@ type GrpcServer struct {
 	cfIndex   *indexers.CfIndex
 	slpIndex  *indexers.SlpIndex
 
+	bcmrResolver  *BcmrResolver
+	filterMatcher *filterMatcher
+	templateFees  func(block *bchutil.Block) (int64, bool)
+
 	httpServer *http.Server
 	subscribe  chan *rpcEventSubscription
 	events     chan interface{}
 	quit       chan struct{}
 
+	// subscriptions holds the subscriptions events are dispatched to.  It
+	// is only accessed by the event dispatcher and kept here so they are
+	// not lost when the dispatcher is restarted after a panic.
+	subscriptions map[*rpcEventSubscription]struct{}
+
+	// supervisors run the background subsystems of the server, which are
+	// restarted after a panic instead of crashing the node.
+	supervisors []*supervisor.Supervisor
+
+	// slpGraphSearchStart is closed once the first mempool transaction is
+	// seen to start loading the slp graph search db, and the valid slp
+	// transactions of the mempool are then added to it through
+	// slpGraphSearchTxns once it is created.
+	slpGraphSearchStart     chan struct{}
+	slpGraphSearchStartOnce sync.Once
+	slpGraphSearchInit      sync.WaitGroup
+	slpGraphSearchTxns      chan *wire.MsgTx
+
+	// chainSubscription delivers the block chain notifications which are
+	// dispatched to the subscribed clients.  The details of disconnected
+	// blocks are recorded by disconnectSubscription as the chain is
+	// updated and dispatched along with their notification.
+	chainSubscription      *blockchain.Subscription
+	disconnectSubscription *blockchain.Subscription
+	disconnectedBlocks     disconnectedBlocks
+
 	wg       sync.WaitGroup
 	ready    uint32 // atomic
 	shutdown int32  // atomic
@@ -133,28 +218,49 @@ type GrpcServer struct {
 // be started.
 func NewGrpcServer(cfg *GrpcServerConfig) *GrpcServer {
 	s := &GrpcServer{
-		timeSource:  cfg.TimeSource,
-		chain:       cfg.Chain,
-		chainParams: cfg.ChainParams,
-		db:          cfg.DB,
-		txMemPool:   cfg.TxMemPool,
-		netMgr:      cfg.NetMgr,
-		txIndex:     cfg.TxIndex,
-		addrIndex:   cfg.AddrIndex,
-		cfIndex:     cfg.CfIndex,
-		slpIndex:    cfg.SlpIndex,
-		httpServer:  cfg.HTTPServer,
-		subscribe:   make(chan *rpcEventSubscription),
-		events:      make(chan interface{}),
-		quit:        make(chan struct{}),
-		wg:          sync.WaitGroup{},
+		timeSource:    cfg.TimeSource,
+		chain:         cfg.Chain,
+		chainParams:   cfg.ChainParams,
+		db:            cfg.DB,
+		txMemPool:     cfg.TxMemPool,
+		netMgr:        cfg.NetMgr,
+		txIndex:       cfg.TxIndex,
+		addrIndex:     cfg.AddrIndex,
+		cfIndex:       cfg.CfIndex,
+		slpIndex:      cfg.SlpIndex,
+		bcmrResolver:  cfg.BcmrResolver,
+		filterMatcher: newFilterMatcher(),
+		templateFees:  cfg.TemplateFees,
+		httpServer:    cfg.HTTPServer,
+		subscribe:     make(chan *rpcEventSubscription),
+		events:        make(chan interface{}),
+		quit:          make(chan struct{}),
+		wg:            sync.WaitGroup{},
+		subscriptions: make(map[*rpcEventSubscription]struct{}),
 	}
 	reflection.Register(cfg.Server)
 	pb.RegisterBchrpcServer(cfg.Server, s)
 	serviceMap["pb.bchrpc"] = s
 
+	s.supervisors = append(s.supervisors,
+		supervisor.New("gRPC event dispatcher", log, s.runEventDispatcher),
+		supervisor.New("filter watch expiry", log, s.expireFilterWatches))
+
 	// listen to changes in the mempool for adding/removing from slp entry cache
-	go s.slpEventHandler()
+	if s.slpIndex != nil {
+		s.supervisors = append(s.supervisors, supervisor.New(
+			"slp event handler", log, s.slpEventHandler))
+		if s.slpIndex.GraphSearchEnabled() {
+			s.slpGraphSearchStart = make(chan struct{})
+			s.slpGraphSearchTxns = make(chan *wire.MsgTx)
+			s.slpGraphSearchInit.Add(1)
+			s.supervisors = append(s.supervisors,
+				supervisor.New("slp graph search loader", log,
+					s.loadSlpGraphSearch),
+				supervisor.New("slp graph search", log,
+					s.addSlpGraphSearchTxns))
+		}
+	}
 
 	return s
 }
@@ -171,9 +277,11 @@ type rpcEventBlockConnected struct {
 }
 
 // rpcEventBlockDisconnected indicates a block that was disconnected from the
-// current best chain.
+// current best chain along with the details recorded when it was disconnected,
+// which are nil when they weren't recorded.
 type rpcEventBlockDisconnected struct {
 	*bchutil.Block
+	details *disconnectedBlock
 }
 
 // rpcEventSubscription represents a subscription to events from the RPC server.
@@ -218,13 +326,11 @@ func (s *GrpcServer) subscribeEvents() *rpcEventSubscription {
 }
 
 // runEventDispatcher runs a process that will forward new incoming events to
-// all the currently active client processes.
+// all the currently active client processes until the quit channel is closed.
 //
-// It should be run in a goroutine and calls Done on the wait group on finish.
-func (s *GrpcServer) runEventDispatcher() {
-	defer s.wg.Done()
-
-	subscriptions := make(map[*rpcEventSubscription]struct{})
+// It should be run by a supervisor.
+func (s *GrpcServer) runEventDispatcher(quit <-chan struct{}) {
+	subscriptions := s.subscriptions
 	for {
 		select {
 		case newSub := <-s.subscribe:
@@ -242,7 +348,7 @@ func (s *GrpcServer) runEventDispatcher() {
 				}
 			}
 
-		case <-s.quit:
+		case <-quit:
 			for sub := range subscriptions {
 				close(sub.in)
 			}
@@ -268,39 +374,54 @@ func (s *GrpcServer) NotifyNewTransactions(txs []*mempool.TxDesc) {
 	}
 }
 
-// handleBlockchainNotification handles the callback from the blockchain package
-// that notifies the RPC server about changes in the chain.
-func (s *GrpcServer) handleBlockchainNotification(notification *blockchain.Notification) {
-	switch notification.Type {
+// NotifyDoubleSpendProof is called by the server when a new double-spend proof
+// is stored by the mempool, along with the mempool transaction spending the
+// output of the proof.
+func (s *GrpcServer) NotifyDoubleSpendProof(proof *wire.MsgDSProofBeta, tx *bchutil.Tx) {
+	s.dispatchEvent(s.newDSProofEvent(proof, tx))
+}
 
-	case blockchain.NTBlockConnected:
-		block, ok := notification.Data.(*bchutil.Block)
-		if !ok {
-			log.Warnf("Chain connected notification is not a block.")
-			break
+// newDSProofEvent returns the event of the passed double-spend proof of an
+// output spent by the passed mempool transaction, which looks up the mempool
+// transactions depending on it.
+func (s *GrpcServer) newDSProofEvent(proof *wire.MsgDSProofBeta, tx *bchutil.Tx) *rpcEventDoubleSpendProof {
+	event := &rpcEventDoubleSpendProof{proof: proof, tx: tx}
+	if graph, err := s.txMemPool.TxGraph(tx.Hash()); err == nil {
+		for _, entry := range graph.Descendants {
+			event.descendants = append(event.descendants, entry.Tx)
 		}
-		s.dispatchEvent(&rpcEventBlockConnected{block})
-
-	case blockchain.NTBlockDisconnected:
-		block, ok := notification.Data.(*bchutil.Block)
-		if !ok {
-			log.Warnf("Chain disconnected notification is not a block.")
-			break
-		}
-		s.dispatchEvent(&rpcEventBlockDisconnected{block})
 	}
+	return event
 }
 
 // Start will start the GrpcServer, subscribe to blockchain notifications
-// and start the EventDispatcher in a new goroutine.
+// and start the EventDispatcher along with the other background subsystems.
 func (s *GrpcServer) Start() {
 	if atomic.SwapUint32(&s.ready, 1) != 0 {
 		panic("service already started")
 	}
 
-	s.wg.Add(1)
-	s.chain.Subscribe(s.handleBlockchainNotification)
-	go s.runEventDispatcher()
+	// The details of disconnected blocks are recorded synchronously, after
+	// the mempool handled the block and before the chain moves on.  This
+	// subscription is made first so the details are always recorded before
+	// the notification is dispatched.
+	s.disconnectSubscription = s.chain.SubscribeHandlers(
+		&blockchain.NotificationHandlers{
+			OnBlockDisconnected: s.recordDisconnectedBlock,
+		}, 0)
+	s.chainSubscription = s.chain.SubscribeHandlers(
+		&blockchain.NotificationHandlers{
+			OnBlockConnected: func(block *bchutil.Block) {
+				s.dispatchEvent(&rpcEventBlockConnected{block})
+			},
+			OnBlockDisconnected: func(block *bchutil.Block) {
+				details := s.disconnectedBlocks.pop(block.Hash())
+				s.dispatchEvent(&rpcEventBlockDisconnected{block, details})
+			},
+		}, chainNotificationQueueSize)
+	for _, sv := range s.supervisors {
+		sv.Start()
+	}
 }
 
 // Stop is used by server.go to stop the gRPC listener.
@@ -315,7 +436,16 @@ func (s *GrpcServer) Stop() error {
 		log.Errorf("Problem shutting down grpc: %v", err)
 		return err
 	}
+	if s.disconnectSubscription != nil {
+		s.disconnectSubscription.Unsubscribe()
+	}
+	if s.chainSubscription != nil {
+		s.chainSubscription.Unsubscribe()
+	}
 	close(s.quit)
+	for _, sv := range s.supervisors {
+		sv.Stop()
+	}
 	s.wg.Wait()
 	log.Infof("gRPC server shutdown complete")
 	return nil
@@ -341,10 +471,48 @@ func (s *GrpcServer) GetMempoolInfo(ctx context.Context, req *pb.GetMempoolInfoR
 
 // GetMempool returns information about all of the transactions currently in the memory pool.
 // Offers an option to return full transactions or just transactions hashes.
+// When a page size or continuation token is provided, a page of the
+// transactions ordered by fee rate or time is returned instead.
 func (s *GrpcServer) GetMempool(ctx context.Context, req *pb.GetMempoolRequest) (*pb.GetMempoolResponse, error) {
 	rawMempool := s.txMemPool.MiningDescs()
 	resp := &pb.GetMempoolResponse{}
+
+	if req.PageSize > 0 || len(req.ContinuationToken) > 0 {
+		var (
+			after *mempoolPageKey
+			err   error
+		)
+		if len(req.ContinuationToken) > 0 {
+			after, err = decodeMempoolContinuationToken(
+				req.ContinuationToken, req.OrderBy)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+		pageSize := req.PageSize
+		if pageSize == 0 || pageSize > maxMempoolPageSize {
+			pageSize = maxMempoolPageSize
+		}
+
+		var more bool
+		rawMempool, more = mempoolPage(rawMempool, req.OrderBy, after,
+			int(pageSize))
+		if more {
+			last := mempoolPageKeyOf(rawMempool[len(rawMempool)-1],
+				req.OrderBy)
+			resp.NextContinuationToken = encodeMempoolContinuationToken(
+				&last, req.OrderBy)
+		}
+	}
+
 	for _, txDesc := range rawMempool {
+		txData := &pb.GetMempoolResponse_TransactionData{
+			Fee:         txDesc.Fee,
+			FeePerKb:    txDesc.FeePerKB,
+			Size:        int32(txDesc.Tx.MsgTx().SerializeSize()),
+			AddedTime:   txDesc.Added.Unix(),
+			AddedHeight: txDesc.Height,
+		}
 		if req.FullTransactions {
 			respTx := marshalTransaction(txDesc.Tx, 0, nil, 0, s)
 			stxos, err := s.txMemPool.FetchInputUtxos(txDesc.Tx)
@@ -369,18 +537,143 @@ func (s *GrpcServer) GetMempool(ctx context.Context, req *pb.GetMempoolRequest)
 				}
 			}
 
-			resp.TransactionData = append(resp.TransactionData, &pb.GetMempoolResponse_TransactionData{
-				TxidsOrTxs: &pb.GetMempoolResponse_TransactionData_Transaction{
-					Transaction: respTx,
-				},
-			})
+			txData.TxidsOrTxs = &pb.GetMempoolResponse_TransactionData_Transaction{
+				Transaction: respTx,
+			}
 		} else {
-			resp.TransactionData = append(resp.TransactionData, &pb.GetMempoolResponse_TransactionData{
-				TxidsOrTxs: &pb.GetMempoolResponse_TransactionData_TransactionHash{
-					TransactionHash: txDesc.Tx.Hash().CloneBytes(),
-				},
-			})
+			txData.TxidsOrTxs = &pb.GetMempoolResponse_TransactionData_TransactionHash{
+				TransactionHash: txDesc.Tx.Hash().CloneBytes(),
+			}
+		}
+		resp.TransactionData = append(resp.TransactionData, txData)
+	}
+	return resp, nil
+}
+
+// mempoolPageKey is the position of a transaction in the order of a paginated
+// GetMempool response.  The value is the fee per kilobyte or the time the
+// transaction was added in unix nanoseconds, depending on the ordering, and
+// transactions with the same value are ordered by hash.
+type mempoolPageKey struct {
+	value int64
+	hash  chainhash.Hash
+}
+
+// mempoolPageKeyOf returns the position of the passed pool transaction in the
+// passed ordering.
+func mempoolPageKeyOf(txDesc *mining.TxDesc, order pb.GetMempoolRequest_Ordering) mempoolPageKey {
+	key := mempoolPageKey{hash: *txDesc.Tx.Hash()}
+	if order == pb.GetMempoolRequest_TIME {
+		key.value = txDesc.Added.UnixNano()
+	} else {
+		key.value = txDesc.FeePerKB
+	}
+	return key
+}
+
+// compareMempoolPageKeys returns a negative number when a comes before b in the
+// passed ordering, a positive number when it comes after b and zero when they
+// are the same.  Fee rates are ordered from the highest and times from the
+// earliest.
+func compareMempoolPageKeys(a, b *mempoolPageKey, order pb.GetMempoolRequest_Ordering) int {
+	if a.value != b.value {
+		if (a.value < b.value) == (order == pb.GetMempoolRequest_TIME) {
+			return -1
+		}
+		return 1
+	}
+	return bytes.Compare(a.hash[:], b.hash[:])
+}
+
+// mempoolPage returns up to pageSize of the passed pool transactions in the
+// passed ordering, starting after the passed position when it is not nil, and
+// whether more transactions follow the page.
+func mempoolPage(txDescs []*mining.TxDesc, order pb.GetMempoolRequest_Ordering,
+	after *mempoolPageKey, pageSize int) ([]*mining.TxDesc, bool) {
+
+	type entry struct {
+		txDesc *mining.TxDesc
+		key    mempoolPageKey
+	}
+	entries := make([]entry, 0, len(txDescs))
+	for _, txDesc := range txDescs {
+		key := mempoolPageKeyOf(txDesc, order)
+		if after != nil && compareMempoolPageKeys(&key, after, order) <= 0 {
+			continue
 		}
+		entries = append(entries, entry{txDesc: txDesc, key: key})
+	}
+	slices.SortFunc(entries, func(a, b entry) int {
+		return compareMempoolPageKeys(&a.key, &b.key, order)
+	})
+
+	more := len(entries) > pageSize
+	if more {
+		entries = entries[:pageSize]
+	}
+	page := make([]*mining.TxDesc, len(entries))
+	for i := range entries {
+		page[i] = entries[i].txDesc
+	}
+	return page, more
+}
+
+// mempoolContinuationTokenSize is the size of a GetMempool continuation token:
+// the ordering, the value of the position and the hash.
+const mempoolContinuationTokenSize = 1 + 8 + chainhash.HashSize
+
+// encodeMempoolContinuationToken returns the continuation token resuming a
+// paginated GetMempool response after the passed position.
+func encodeMempoolContinuationToken(key *mempoolPageKey, order pb.GetMempoolRequest_Ordering) []byte {
+	token := make([]byte, mempoolContinuationTokenSize)
+	token[0] = byte(order)
+	binary.BigEndian.PutUint64(token[1:9], uint64(key.value))
+	copy(token[9:], key.hash[:])
+	return token
+}
+
+// decodeMempoolContinuationToken returns the position encoded in the passed
+// GetMempool continuation token after checking it was made for the passed
+// ordering.
+func decodeMempoolContinuationToken(token []byte, order pb.GetMempoolRequest_Ordering) (*mempoolPageKey, error) {
+	if len(token) != mempoolContinuationTokenSize {
+		return nil, errors.New("malformed continuation token")
+	}
+	if pb.GetMempoolRequest_Ordering(token[0]) != order {
+		return nil, errors.New("continuation token was made for another ordering")
+	}
+	key := &mempoolPageKey{value: int64(binary.BigEndian.Uint64(token[1:9]))}
+	copy(key.hash[:], token[9:])
+	return key, nil
+}
+
+// GetMempoolTransactionGraph returns the unconfirmed transactions in the mempool
+// a transaction depends on and those which depend on it, along with their fees
+// and sizes.
+func (s *GrpcServer) GetMempoolTransactionGraph(ctx context.Context, req *pb.GetMempoolTransactionGraphRequest) (*pb.GetMempoolTransactionGraphResponse, error) {
+	txHash, err := chainhash.NewHash(req.Hash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction hash: %v", err)
+	}
+
+	graph, err := s.txMemPool.TxGraph(txHash)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "transaction not in mempool")
+	}
+
+	resp := &pb.GetMempoolTransactionGraphResponse{
+		Transaction:    marshalMempoolTxGraphEntry(graph.Tx),
+		AncestorSize:   graph.AncestorSize,
+		AncestorFees:   graph.AncestorFees,
+		DescendantSize: graph.DescendantSize,
+		DescendantFees: graph.DescendantFees,
+		Truncated:      graph.Truncated,
+	}
+	for _, entry := range graph.Ancestors {
+		resp.Ancestors = append(resp.Ancestors, marshalMempoolTxGraphEntry(entry))
+	}
+	for _, entry := range graph.Descendants {
+		resp.Descendants = append(resp.Descendants, marshalMempoolTxGraphEntry(entry))
 	}
 	return resp, nil
 }
@@ -391,16 +684,16 @@ func (s *GrpcServer) GetBlockchainInfo(ctx context.Context, req *pb.GetBlockchai
 	bestSnapShot := s.chain.BestSnapshot()
 
 	var net pb.GetBlockchainInfoResponse_BitcoinNet
-	switch s.chainParams {
-	case &chaincfg.MainNetParams:
+	switch s.chainParams.Net {
+	case wire.MainNet:
 		net = pb.GetBlockchainInfoResponse_MAINNET
-	case &chaincfg.TestNet3Params:
+	case wire.TestNet3:
 		net = pb.GetBlockchainInfoResponse_TESTNET3
-	case &chaincfg.TestNet4Params:
+	case wire.TestNet4:
 		net = pb.GetBlockchainInfoResponse_TESTNET4
-	case &chaincfg.RegressionNetParams:
+	case wire.TestNet:
 		net = pb.GetBlockchainInfoResponse_REGTEST
-	case &chaincfg.SimNetParams:
+	case wire.SimNet:
 		net = pb.GetBlockchainInfoResponse_SIMNET
 	default:
 		return nil, status.Error(codes.Internal, "unknown network parameters")
@@ -419,7 +712,7 @@ func (s *GrpcServer) GetBlockchainInfo(ctx context.Context, req *pb.GetBlockchai
 		BestHeight:     bestSnapShot.Height,
 		BestBlockHash:  bestSnapShot.Hash[:],
 		BitcoinNet:     net,
-		Difficulty:     getDifficultyRatio(bestSnapShot.Bits, s.chainParams),
+		Difficulty:     bestSnapShot.Difficulty,
 		MedianTime:     bestSnapShot.MedianTime.Unix(),
 	}
 	return resp, nil
@@ -462,6 +755,85 @@ func (s *GrpcServer) GetBlockInfo(ctx context.Context, req *pb.GetBlockInfoReque
 	return resp, nil
 }
 
+// GetBlockRange returns metadata and info for a range of consecutive main chain
+// blocks.
+func (s *GrpcServer) GetBlockRange(ctx context.Context, req *pb.GetBlockRangeRequest) (*pb.GetBlockRangeResponse, error) {
+	// Read the range from a snapshot of the chain so the blocks returned
+	// form a chain even when it is reorganized meanwhile.
+	snapshot := s.chain.ChainSnapshot()
+
+	startHeight := req.GetStartHeight()
+	if len(req.GetStartHash()) > 0 {
+		startHash, err := chainhash.NewHash(req.GetStartHash())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid hash: %v", err)
+		}
+		startHeight, err = snapshot.BlockHeightByHash(startHash)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, "block not found")
+		}
+	}
+	if startHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "start height must not be negative")
+	}
+
+	count := req.GetCount()
+	if count == 0 || count > maxBlockRangeCount {
+		count = maxBlockRangeCount
+	}
+	endHeight := snapshot.Height() + 1
+	if int64(startHeight)+int64(count) < int64(endHeight) {
+		endHeight = startHeight + int32(count)
+	}
+	hashes, err := snapshot.HeightRange(startHeight, endHeight)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid range: %v", err)
+	}
+
+	resp := &pb.GetBlockRangeResponse{
+		NextHeight: startHeight + int32(len(hashes)),
+		BestHeight: snapshot.Height(),
+	}
+	err = s.db.View(func(dbTx database.Tx) error {
+		for i := range hashes {
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+
+			// The header and size are read from the block index
+			// so the blocks are never loaded.
+			header, err := s.chain.HeaderByHash(&hashes[i])
+			if err != nil {
+				return status.Error(codes.NotFound, "block not found")
+			}
+			size, err := dbTx.FetchBlockSize(&hashes[i])
+			if err != nil {
+				return status.Error(codes.NotFound, "block not found")
+			}
+			medianTime, err := s.chain.MedianTimeByHash(&hashes[i])
+			if err != nil {
+				return status.Error(codes.Internal, "error calculating median time for block")
+			}
+
+			height := startHeight + int32(i)
+			info := marshalBlockHeaderInfo(&header, height, int32(size),
+				snapshot.Height()-height+1, medianTime, s.chainParams)
+			if i+1 < len(hashes) {
+				info.NextBlockHash = hashes[i+1].CloneBytes()
+			} else if nextHash, err := snapshot.BlockHashByHeight(height + 1); err == nil {
+				info.NextBlockHash = nextHash.CloneBytes()
+			}
+			resp.Infos = append(resp.Infos, info)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 // GetBlock returns detailed data for a block.
 func (s *GrpcServer) GetBlock(ctx context.Context, req *pb.GetBlockRequest) (*pb.GetBlockResponse, error) {
 	var (
@@ -582,6 +954,120 @@ func (s *GrpcServer) GetRawBlock(ctx context.Context, req *pb.GetRawBlockRequest
 	return resp, nil
 }
 
+// GetRawBlocks streams a range of main chain blocks in a serialized format,
+// optionally compressed with zstd.
+func (s *GrpcServer) GetRawBlocks(req *pb.GetRawBlocksRequest, stream pb.Bchrpc_GetRawBlocksServer) error {
+	switch req.GetCompression() {
+	case pb.GetRawBlocksRequest_NONE, pb.GetRawBlocksRequest_ZSTD:
+	default:
+		return status.Error(codes.InvalidArgument, "unknown compression")
+	}
+
+	var (
+		startHash   *chainhash.Hash
+		startHeight = req.GetStartHeight()
+	)
+	if len(req.GetStartHash()) > 0 {
+		var err error
+		startHash, err = chainhash.NewHash(req.GetStartHash())
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid hash: %v", err)
+		}
+		startHeight, err = s.chain.BlockHeightByHash(startHash)
+		if err != nil {
+			return status.Error(codes.NotFound, "block not found")
+		}
+	}
+
+	endHeight := s.chain.BestSnapshot().Height
+	if startHeight < 0 || startHeight > endHeight {
+		return status.Errorf(codes.NotFound, "block not found at height %d", startHeight)
+	}
+	if count := req.GetCount(); count > 0 && int64(startHeight)+int64(count)-1 < int64(endHeight) {
+		endHeight = startHeight + int32(count) - 1
+	}
+
+	// The encoder is only used and closed by the goroutine loading the
+	// blocks since it may still be running when sending a block fails.
+	var encoder *zstd.Encoder
+	if req.GetCompression() == pb.GetRawBlocksRequest_ZSTD {
+		var err error
+		encoder, err = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return status.Error(codes.Internal, "failed to create encoder")
+		}
+	}
+
+	// Blocks are loaded in a separate goroutine so that reading and
+	// compressing the next blocks overlaps with sending the current one.
+	// The channel bounds how far ahead of the client it gets.
+	ctx := stream.Context()
+	blocks := make(chan *pb.GetRawBlocksResponse, rawBlockReadAhead)
+	errChan := make(chan error, 1)
+	go func() {
+		defer close(blocks)
+		if encoder != nil {
+			defer encoder.Close()
+		}
+
+		prevHash := startHash
+		for height := startHeight; height <= endHeight; height++ {
+			block, err := s.chain.BlockByHeight(height)
+			if err != nil {
+				errChan <- status.Errorf(codes.NotFound, "block not found at height %d", height)
+				return
+			}
+
+			// Make sure the blocks sent form a chain.  The starting
+			// block is checked against the requested hash, if any.
+			if prevHash != nil && ((height == startHeight && !block.Hash().IsEqual(prevHash)) ||
+				(height != startHeight && block.MsgBlock().Header.PrevBlock != *prevHash)) {
+
+				errChan <- status.Error(codes.Aborted, "chain reorganized while streaming blocks")
+				return
+			}
+			prevHash = block.Hash()
+
+			raw, err := block.Bytes()
+			if err != nil {
+				errChan <- status.Error(codes.Internal, "block serialization error")
+				return
+			}
+			resp := &pb.GetRawBlocksResponse{
+				Height: height,
+				Hash:   prevHash.CloneBytes(),
+				Block:  raw,
+				Size:   uint32(len(raw)),
+			}
+			if encoder != nil {
+				resp.Block = encoder.EncodeAll(raw, make([]byte, 0, len(raw)/2))
+			}
+
+			select {
+			case blocks <- resp:
+			case <-ctx.Done():
+				errChan <- ctx.Err()
+				return
+			case <-s.quit:
+				errChan <- status.Error(codes.Unavailable, "server is shutting down")
+				return
+			}
+		}
+	}()
+
+	for resp := range blocks {
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	select {
+	case err := <-errChan:
+		return err
+	default:
+		return nil
+	}
+}
+
 // GetBlockFilter returns the compact filter (cf) of a block as a Golomb-Rice encoded set.
 //
 // **Requires CfIndex**
@@ -616,6 +1102,103 @@ func (s *GrpcServer) GetBlockFilter(ctx context.Context, req *pb.GetBlockFilterR
 	return resp, nil
 }
 
+// RegisterFilterWatch registers a set of scripts and addresses to be matched
+// against the compact filters of the blocks from a start height.
+//
+// **Requires CfIndex**
+func (s *GrpcServer) RegisterFilterWatch(ctx context.Context, req *pb.RegisterFilterWatchRequest) (*pb.RegisterFilterWatchResponse, error) {
+	if s.cfIndex == nil {
+		return nil, status.Error(codes.Unavailable, "cfindex required")
+	}
+
+	scripts := make([][]byte, 0, len(req.Scripts)+len(req.Addresses))
+	for _, script := range req.Scripts {
+		if len(script) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "empty script")
+		}
+		scripts = append(scripts, script)
+	}
+	for _, addrStr := range req.Addresses {
+		addr, err := bchutil.DecodeAddress(addrStr, s.chainParams)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", addrStr)
+		}
+		script, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", addrStr)
+		}
+		scripts = append(scripts, script)
+	}
+	if len(scripts) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no scripts or addresses to watch")
+	}
+	if len(scripts) > maxFilterWatchScripts {
+		return nil, status.Errorf(codes.InvalidArgument, "more than %d scripts and addresses", maxFilterWatchScripts)
+	}
+
+	bestHeight := s.chain.BestSnapshot().Height
+	if req.StartHeight < 0 || req.StartHeight > bestHeight+1 {
+		return nil, status.Errorf(codes.InvalidArgument, "start height %d is outside of the main chain", req.StartHeight)
+	}
+	w, err := newFilterWatch(s.chain, scripts, req.StartHeight)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create watch: %v", err)
+	}
+	id, err := s.filterMatcher.add(w, clientHost(ctx))
+	if err == errTooManyFilterWatches || err == errTooManyClientFilterWatches {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to register watch: %v", err)
+	}
+
+	return &pb.RegisterFilterWatchResponse{WatchId: id}, nil
+}
+
+// GetFilterMatches returns the blocks since a height whose compact filters
+// match any of the scripts of a watch.
+//
+// **Requires CfIndex**
+func (s *GrpcServer) GetFilterMatches(ctx context.Context, req *pb.GetFilterMatchesRequest) (*pb.GetFilterMatchesResponse, error) {
+	if s.cfIndex == nil {
+		return nil, status.Error(codes.Unavailable, "cfindex required")
+	}
+
+	w := s.filterMatcher.lookup(req.WatchId)
+	if w == nil {
+		return nil, status.Error(codes.NotFound, "watch not found")
+	}
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	snapshot := s.chain.ChainSnapshot()
+	if err := w.rollback(s.chain, snapshot); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rollback watch: %v", err)
+	}
+	atTip, err := w.scan(s.cfIndex, snapshot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to match filters: %v", err)
+	}
+
+	matches := w.matchesSince(req.SinceHeight)
+	resp := &pb.GetFilterMatchesResponse{
+		Matches:       make([]*pb.GetFilterMatchesResponse_Match, 0, len(matches)),
+		ScannedHeight: w.scannedHeight,
+		AtTip:         atTip,
+		PrunedHeight:  w.prunedHeight,
+	}
+	if w.scannedHeight >= 0 {
+		resp.ScannedHash = w.scannedHash.CloneBytes()
+	}
+	for _, match := range matches {
+		resp.Matches = append(resp.Matches, &pb.GetFilterMatchesResponse_Match{
+			BlockHash: match.hash.CloneBytes(),
+			Height:    match.height,
+		})
+	}
+	return resp, nil
+}
+
 // GetHeaders takes a block locator object and returns a batch of no more than 2000
 // headers. Upon parsing the block locator, if the server concludes there has been a
 // fork, it will send headers starting at the fork point, or genesis if no blocks in
@@ -658,7 +1241,7 @@ func (s *GrpcServer) GetHeaders(ctx context.Context, req *pb.GetHeadersRequest)
 	for i, header := range headers {
 		hash := header.BlockHash()
 		resp.Headers = append(resp.Headers, &pb.BlockInfo{
-			Difficulty:    getDifficultyRatio(header.Bits, s.chainParams),
+			Difficulty:    blockchain.CalcDifficultyRatio(header.Bits, s.chainParams),
 			Hash:          hash.CloneBytes(),
 			Height:        startHeight + int32(i),
 			Version:       header.Version,
@@ -821,6 +1404,76 @@ func (s *GrpcServer) GetRawTransaction(ctx context.Context, req *pb.GetRawTransa
 	return resp, nil
 }
 
+// GetTransactionConfirmations returns the number of confirmations of a
+// transaction along with whether it is final and the transactions seen double
+// spending it.
+//
+// **Requires TxIndex for confirmed transactions**
+func (s *GrpcServer) GetTransactionConfirmations(ctx context.Context, req *pb.GetTransactionConfirmationsRequest) (*pb.GetTransactionConfirmationsResponse, error) {
+	txHash, err := chainhash.NewHash(req.Hash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction hash: %v", err)
+	}
+
+	resp := &pb.GetTransactionConfirmationsResponse{
+		FinalizationDepth: req.FinalizationDepth,
+	}
+	if resp.FinalizationDepth == 0 {
+		resp.FinalizationDepth = defaultFinalizationDepth
+	}
+	for _, conflict := range s.txMemPool.Conflicts(txHash) {
+		resp.ConflictingHashes = append(resp.ConflictingHashes, conflict.CloneBytes())
+	}
+	reorgs, err := s.chain.ReorgLog(-1, txHash)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load the reorg log")
+	}
+	resp.ReorgCount = uint32(len(reorgs))
+
+	if s.txMemPool.IsTransactionInPool(txHash) {
+		resp.InMempool = true
+		return resp, nil
+	}
+
+	// Transactions which are neither in the mempool nor confirmed are
+	// only reported when they were seen conflicting with another one,
+	// which is most likely why they are gone.
+	var blockHash *chainhash.Hash
+	if s.txIndex != nil {
+		blockRegion, err := s.txIndex.TxBlockRegion(txHash)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to retrieve transaction location")
+		}
+		if blockRegion != nil {
+			blockHash = blockRegion.Hash
+		}
+	}
+	if blockHash == nil {
+		if len(resp.ConflictingHashes) > 0 {
+			return resp, nil
+		}
+		if s.txIndex == nil {
+			return nil, status.Error(codes.Unavailable, "txindex required")
+		}
+		return nil, status.Error(codes.NotFound, "transaction not found")
+	}
+
+	snapshot := s.chain.ChainSnapshot()
+	height, err := snapshot.BlockHeightByHash(blockHash)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "transaction not found in the main chain")
+	}
+	resp.BlockHash = blockHash.CloneBytes()
+	resp.BlockHeight = height
+	resp.Confirmations = snapshot.Height() - height + 1
+	resp.Finalized = int64(resp.Confirmations) >= int64(resp.FinalizationDepth)
+	if checkpoint := s.chain.LatestCheckpoint(); checkpoint != nil && height <= checkpoint.Height {
+		resp.Finalized = true
+	}
+
+	return resp, nil
+}
+
 // GetAddressTransactions returns the transactions for the given address. Offers offset,
 // limit, and from block options.
 //
@@ -1591,19 +2244,122 @@ func (s *GrpcServer) GetSlpGraphSearch(ctx context.Context, req *pb.GetSlpGraphS
 		validityCache[*hash] = struct{}{}
 	}
 
+	// setup the set of transactions the client already has
+	knownSet := make(map[chainhash.Hash]struct{})
+	for _, txHash := range req.GetKnownHashes() {
+		hash, err := chainhash.NewHash(txHash)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "graph search known hash %v is invalid", hex.EncodeToString(txHash))
+		}
+		knownSet[*hash] = struct{}{}
+	}
+	var knownFilter *bloom.Filter
+	if f := req.GetKnownFilter(); f != nil {
+		if len(f.GetFilter()) > wire.MaxFilterLoadFilterSize || f.GetHashFuncs() > wire.MaxFilterLoadHashFuncs {
+			return nil, status.Error(codes.InvalidArgument, "graph search known filter is too large")
+		}
+		knownFilter = bloom.LoadFilter(wire.NewMsgFilterLoad(f.GetFilter(), f.GetHashFuncs(), f.GetTweak(), wire.BloomUpdateNone))
+	}
+	var isKnown func(*chainhash.Hash) bool
+	if len(knownSet) > 0 || knownFilter != nil {
+		isKnown = func(hash *chainhash.Hash) bool {
+			if _, ok := knownSet[*hash]; ok {
+				return true
+			}
+			return knownFilter != nil && knownFilter.Matches(hash[:])
+		}
+	}
+
 	// perform the graph search
-	txData, err := gsDb.Find(hash, &entry.TokenIDHash, &validityCache)
+	txData, err := gsDb.FindMissing(hash, &entry.TokenIDHash, &validityCache, isKnown)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
 
-	res := &pb.GetSlpGraphSearchResponse{}
-	res.Txdata = txData
-	log.Infof("SLP graph search returned %s transactions for txid %v", fmt.Sprint(len(txData)), hash)
+	// The search order only depends on the ancestry of the searched
+	// transaction, which never changes, so pages are stable across calls.
+	limit := req.GetLimit()
+	if limit == 0 || limit > maxSlpGraphSearchPageSize {
+		limit = maxSlpGraphSearchPageSize
+	}
+	res := &pb.GetSlpGraphSearchResponse{
+		TotalCount: uint32(len(txData)),
+	}
+	offset := int(req.GetOffset())
+	if offset > len(txData) {
+		offset = len(txData)
+	}
+	pageBytes := 0
+	for _, txBuf := range txData[offset:] {
+		// Always return at least one transaction so every page makes
+		// progress.
+		if len(res.Txdata) == int(limit) ||
+			(len(res.Txdata) > 0 && pageBytes+len(txBuf) > maxSlpGraphSearchPageBytes) {
+
+			res.HasMore = true
+			break
+		}
+		res.Txdata = append(res.Txdata, txBuf)
+		pageBytes += len(txBuf)
+	}
+	res.NextOffset = uint32(offset + len(res.Txdata))
+	log.Infof("SLP graph search returned %d of %d transactions for txid %v", len(res.Txdata), len(txData), hash)
 
 	return res, nil
 }
 
+// GetTokenMetadata returns the name, symbol and decimals for slp tokens and
+// CashToken categories, preferring a hash-checked BCMR document referenced
+// on-chain over the on-chain values.
+//
+// **Requires TxIndex and Bcmr, and SlpIndex for slp tokens**
+func (s *GrpcServer) GetTokenMetadata(ctx context.Context, req *pb.GetTokenMetadataRequest) (*pb.GetTokenMetadataResponse, error) {
+	if s.bcmrResolver == nil {
+		return nil, status.Error(codes.Unavailable, "bcmr required")
+	}
+	if s.txIndex == nil {
+		return nil, status.Error(codes.Unavailable, "txindex required")
+	}
+	if len(req.GetSlpTokenIds()) > 0 && s.slpIndex == nil {
+		return nil, status.Error(codes.Unavailable, "slpindex required")
+	}
+	if len(req.GetSlpTokenIds())+len(req.GetCashTokenCategories()) > maxTokenMetadataQuerySize {
+		return nil, status.Errorf(codes.InvalidArgument, "number of tokens exceeds max of %d", maxTokenMetadataQuerySize)
+	}
+
+	tokenMetadata := make([]*pb.TokenMetadata, 0, len(req.GetSlpTokenIds())+len(req.GetCashTokenCategories()))
+	for _, hash := range req.GetSlpTokenIds() {
+		tokenID, err := chainhash.NewHash(hash)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "token ID hash %s is invalid: %s", hex.EncodeToString(hash), err)
+		}
+
+		tm, err := s.slpRegistryMetadata(ctx, *tokenID)
+		if err != nil {
+			return nil, status.Errorf(codes.Aborted, "token ID %v does not exist", hex.EncodeToString(hash))
+		}
+		tokenMetadata = append(tokenMetadata, tm)
+	}
+	for _, hash := range req.GetCashTokenCategories() {
+		category, err := chainhash.NewHash(hash)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "category %s is invalid: %s", hex.EncodeToString(hash), err)
+		}
+
+		tm, err := s.cashTokenRegistryMetadata(ctx, category)
+		if err != nil {
+			return nil, err
+		}
+		tokenMetadata = append(tokenMetadata, tm)
+	}
+
+	resp := &pb.GetTokenMetadataResponse{
+		TokenMetadata: tokenMetadata,
+	}
+
+	return resp, nil
+}
+
 func isMaybeSlpTransaction(txn *wire.MsgTx) bool {
 	if len(txn.TxOut) > 0 {
 		bchTagIDHex, _ := hex.DecodeString("534c5000")
@@ -1647,6 +2403,92 @@ func (s *GrpcServer) CheckSlpTransaction(ctx context.Context, req *pb.CheckSlpTr
 	return s.checkTransactionSlpValidity(msgTx, req.RequiredSlpBurns, true, !req.UseSpecValidityJudgement)
 }
 
+// DecodeTransaction fully decodes a raw transaction, resolving the previous
+// outputs spent by its inputs when they can be found.
+func (s *GrpcServer) DecodeTransaction(ctx context.Context, req *pb.DecodeTransactionRequest) (*pb.DecodeTransactionResponse, error) {
+	msgTx := &wire.MsgTx{}
+	if err := msgTx.BchDecode(bytes.NewReader(req.Transaction), wire.ProtocolVersion, wire.BaseEncoding); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "unable to deserialize transaction")
+	}
+	tx := bchutil.NewTx(msgTx)
+
+	resp := &pb.DecodeTransactionResponse{
+		Transaction: marshalTransaction(tx, 0, nil, 0, s),
+	}
+
+	if !blockchain.IsCoinBase(tx) {
+		var totalIn int64
+		for i, txIn := range msgTx.TxIn {
+			prevOut := s.fetchPrevOut(txIn.PreviousOutPoint)
+			if prevOut == nil {
+				resp.UnresolvedInputs = append(resp.UnresolvedInputs, uint32(i))
+				continue
+			}
+			totalIn += prevOut.Value
+
+			input := resp.Transaction.Inputs[i]
+			if !prevOut.TokenData.IsEmpty() {
+				input.CashToken = getPbCashTokenDataFromTokenData(prevOut.TokenData)
+			}
+			input.Value = prevOut.Value
+			input.PreviousScript = prevOut.PkScript
+
+			_, addrs, _, err := txscript.ExtractPkScriptAddrs(prevOut.PkScript, s.chainParams)
+			if err == nil && len(addrs) > 0 {
+				input.Address = addrs[0].String()
+				s.setInputSlpTokenAddress(input, addrs[0])
+			}
+		}
+		if len(resp.UnresolvedInputs) == 0 {
+			var totalOut int64
+			for _, txOut := range msgTx.TxOut {
+				totalOut += txOut.Value
+			}
+			resp.Fee = totalIn - totalOut
+		}
+	}
+
+	if s.slpIndex != nil && len(msgTx.TxIn) > 0 && len(msgTx.TxOut) > 0 {
+		slpValidity, err := s.checkTransactionSlpValidity(msgTx, nil, true, true)
+		if err != nil {
+			return nil, err
+		}
+		resp.SlpValidity = slpValidity
+	}
+	return resp, nil
+}
+
+// fetchPrevOut returns the output referenced by the passed outpoint from the
+// mempool, the utxo set or, when enabled, the transaction index, or nil if it
+// can't be found.
+func (s *GrpcServer) fetchPrevOut(outpoint wire.OutPoint) *wire.TxOut {
+	if tx, err := s.txMemPool.FetchTransaction(&outpoint.Hash); err == nil {
+		if outpoint.Index < uint32(len(tx.MsgTx().TxOut)) {
+			return tx.MsgTx().TxOut[outpoint.Index]
+		}
+		return nil
+	}
+
+	entry, err := s.chain.FetchUtxoEntry(outpoint)
+	if err == nil && entry != nil && !entry.IsSpent() {
+		return wire.NewTxOut(entry.Amount(), entry.PkScript(), entry.TokenData())
+	}
+
+	if s.txIndex == nil {
+		return nil
+	}
+	txBytes, _, _, err := s.fetchTransactionFromBlock(&outpoint.Hash)
+	if err != nil {
+		return nil
+	}
+	var prevTx wire.MsgTx
+	err = prevTx.BchDecode(bytes.NewReader(txBytes), wire.ProtocolVersion, wire.BaseEncoding)
+	if err != nil || outpoint.Index >= uint32(len(prevTx.TxOut)) {
+		return nil
+	}
+	return prevTx.TxOut[outpoint.Index]
+}
+
 func (s *GrpcServer) checkTransactionSlpValidity(msgTx *wire.MsgTx, requiredBurns []*pb.SlpRequiredBurn, disableErrorResponse bool, useSafeValidityJudgement bool) (*pb.CheckSlpTransactionResponse, error) {
 
 	// slpValid() and slpInvalid() are helpers to keep the return statements clean
@@ -2136,7 +2978,7 @@ func (s *GrpcServer) SubmitTransaction(ctx context.Context, req *pb.SubmitTransa
 	// Generate and relay inventory vectors for all newly accepted
 	// transactions into the memory pool due to the original being
 	// accepted.
-	s.netMgr.AnnounceNewTransactions(acceptedTxs)
+	s.netMgr.AnnounceLocalTransactions(acceptedTxs)
 
 	// Keep track of all the sendrawtransaction request txns so that they
 	// can be rebroadcast if they don't make their way into a block.
@@ -2170,6 +3012,8 @@ func (s *GrpcServer) SubscribeTransactions(req *pb.SubscribeTransactionsRequest,
 	includeMempool := req.IncludeMempool
 	includeBlocks := req.IncludeInBlock
 	serializeTx := req.SerializeTx
+	includeTokenBurns := req.IncludeTokenBurns
+	tokenBurnsOnly := req.TokenBurnsOnly
 	for {
 		select {
 		case event := <-subscription.Events():
@@ -2193,6 +3037,17 @@ func (s *GrpcServer) SubscribeTransactions(req *pb.SubscribeTransactionsRequest,
 				toSend := &pb.TransactionNotification{}
 				toSend.Type = pb.TransactionNotification_UNCONFIRMED
 
+				if includeTokenBurns {
+					burns, err := s.mempoolTokenBurns(txDesc.Tx)
+					if err != nil {
+						return err
+					}
+					if tokenBurnsOnly && len(burns) == 0 {
+						continue
+					}
+					toSend.TokenBurns = burns
+				}
+
 				if serializeTx {
 					var buf bytes.Buffer
 					if err := txDesc.Tx.MsgTx().BchEncode(&buf, wire.ProtocolVersion, wire.BaseEncoding); err != nil {
@@ -2233,7 +3088,10 @@ func (s *GrpcServer) SubscribeTransactions(req *pb.SubscribeTransactionsRequest,
 				// Search for all transactions.
 				block := event
 
-				for _, tx := range block.Transactions() {
+				// The CashTokens burned by the transactions of the
+				// block are only fetched once a transaction matches.
+				var cashTokenBurns [][]*blockchain.TokenBurn
+				for i, tx := range block.Transactions() {
 					if !filter.MatchAndUpdate(tx, s.chainParams) {
 						continue
 					}
@@ -2245,6 +3103,20 @@ func (s *GrpcServer) SubscribeTransactions(req *pb.SubscribeTransactionsRequest,
 					toSend := &pb.TransactionNotification{}
 					toSend.Type = pb.TransactionNotification_CONFIRMED
 
+					if includeTokenBurns {
+						if cashTokenBurns == nil {
+							cashTokenBurns = s.fetchBlockTokenBurns(block.Block)
+						}
+						burns, err := s.marshalTokenBurns(tx.MsgTx(), cashTokenBurns[i])
+						if err != nil {
+							return err
+						}
+						if tokenBurnsOnly && len(burns) == 0 {
+							continue
+						}
+						toSend.TokenBurns = burns
+					}
+
 					if serializeTx {
 						var buf bytes.Buffer
 						if err := tx.MsgTx().BchEncode(&buf, wire.ProtocolVersion, wire.BaseEncoding); err != nil {
@@ -2310,6 +3182,7 @@ func (s *GrpcServer) SubscribeTransactionStream(stream pb.Bchrpc_SubscribeTransa
 
 	filter := newTxFilter()
 	var includeMempool, includeBlocks, serializeTx bool
+	var includeTokenBurns, tokenBurnsOnly bool
 	for {
 		select {
 		case req := <-requests:
@@ -2319,6 +3192,8 @@ func (s *GrpcServer) SubscribeTransactionStream(stream pb.Bchrpc_SubscribeTransa
 			includeMempool = req.IncludeMempool
 			includeBlocks = req.IncludeInBlock
 			serializeTx = req.SerializeTx
+			includeTokenBurns = req.IncludeTokenBurns
+			tokenBurnsOnly = req.TokenBurnsOnly
 
 			// Update filter.
 			if err := filter.AddRPCFilter(req.GetSubscribe(), s.chainParams); err != nil {
@@ -2346,9 +3221,20 @@ func (s *GrpcServer) SubscribeTransactionStream(stream pb.Bchrpc_SubscribeTransa
 					continue
 				}
 
-				toSend := &pb.TransactionNotification{}
-				toSend.Type = pb.TransactionNotification_UNCONFIRMED
-
+				toSend := &pb.TransactionNotification{}
+				toSend.Type = pb.TransactionNotification_UNCONFIRMED
+
+				if includeTokenBurns {
+					burns, err := s.mempoolTokenBurns(txDesc.Tx)
+					if err != nil {
+						return err
+					}
+					if tokenBurnsOnly && len(burns) == 0 {
+						continue
+					}
+					toSend.TokenBurns = burns
+				}
+
 				if serializeTx {
 					var buf bytes.Buffer
 					if err := txDesc.Tx.MsgTx().BchEncode(&buf, wire.ProtocolVersion, wire.BaseEncoding); err != nil {
@@ -2389,7 +3275,10 @@ func (s *GrpcServer) SubscribeTransactionStream(stream pb.Bchrpc_SubscribeTransa
 				// Search for all transactions.
 				block := event
 
-				for _, tx := range block.Transactions() {
+				// The CashTokens burned by the transactions of the
+				// block are only fetched once a transaction matches.
+				var cashTokenBurns [][]*blockchain.TokenBurn
+				for i, tx := range block.Transactions() {
 					if !filter.MatchAndUpdate(tx, s.chainParams) {
 						continue
 					}
@@ -2401,6 +3290,20 @@ func (s *GrpcServer) SubscribeTransactionStream(stream pb.Bchrpc_SubscribeTransa
 					toSend := &pb.TransactionNotification{}
 					toSend.Type = pb.TransactionNotification_CONFIRMED
 
+					if includeTokenBurns {
+						if cashTokenBurns == nil {
+							cashTokenBurns = s.fetchBlockTokenBurns(block.Block)
+						}
+						burns, err := s.marshalTokenBurns(tx.MsgTx(), cashTokenBurns[i])
+						if err != nil {
+							return err
+						}
+						if tokenBurnsOnly && len(burns) == 0 {
+							continue
+						}
+						toSend.TokenBurns = burns
+					}
+
 					if serializeTx {
 						var buf bytes.Buffer
 						if err := tx.MsgTx().BchEncode(&buf, wire.ProtocolVersion, wire.BaseEncoding); err != nil {
@@ -2539,6 +3442,10 @@ func (s *GrpcServer) SubscribeBlocks(req *pb.SubscribeBlocksRequest, stream pb.B
 					}
 				}
 
+				if req.IncludeFees {
+					toSend.Fees = s.marshalBlockFees(block)
+				}
+
 				if err := stream.Send(toSend); err != nil {
 					return err
 				}
@@ -2548,6 +3455,12 @@ func (s *GrpcServer) SubscribeBlocks(req *pb.SubscribeBlocksRequest, stream pb.B
 				block := event.Block
 				toSend := &pb.BlockNotification{}
 				toSend.Type = pb.BlockNotification_DISCONNECTED
+				if event.details != nil {
+					for i := range event.details.returnedTxs {
+						toSend.ReturnedTransactionHashes = append(toSend.ReturnedTransactionHashes,
+							event.details.returnedTxs[i].CloneBytes())
+					}
+				}
 
 				medianTime, err := s.chain.MedianTimeByHash(block.Hash())
 				if err != nil {
@@ -2562,12 +3475,19 @@ func (s *GrpcServer) SubscribeBlocks(req *pb.SubscribeBlocksRequest, stream pb.B
 						},
 					}
 
+					// The spend journal of the block was removed when
+					// it was disconnected, so the spent outputs recorded
+					// at that time are used instead.
 					var spentTxos []blockchain.SpentTxOut
 					var err error
 					if req.FullTransactions {
-						spentTxos, err = s.chain.FetchSpendJournal(block)
-						if err != nil {
-							return status.Error(codes.Internal, "error loading spend journal")
+						if event.details != nil && event.details.spentTxos != nil {
+							spentTxos = event.details.spentTxos
+						} else {
+							spentTxos, err = s.chain.FetchSpendJournal(block)
+							if err != nil {
+								return status.Error(codes.Internal, "error loading spend journal")
+							}
 						}
 					}
 
@@ -2645,6 +3565,148 @@ func (s *GrpcServer) SubscribeBlocks(req *pb.SubscribeBlocksRequest, stream pb.B
 	}
 }
 
+// SubscribeDeposits creates a subscription for the payments to a set of
+// addresses, notifying them when they are seen in the mempool and as they reach
+// the requested confirmation counts.
+func (s *GrpcServer) SubscribeDeposits(req *pb.SubscribeDepositsRequest, stream pb.Bchrpc_SubscribeDepositsServer) error {
+	watch, err := newDepositWatch(req.Addresses, req.Confirmations, s.chainParams)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Subscribe before looking at the mempool so no payment is missed.
+	// The payments already seen are not notified again.
+	subscription := s.subscribeEvents()
+	defer subscription.Unsubscribe()
+
+	for _, txDesc := range s.txMemPool.TxDescs() {
+		if ntfn := watch.txAccepted(txDesc.Tx); ntfn != nil {
+			if err := stream.Send(ntfn); err != nil {
+				return err
+			}
+		}
+	}
+
+	// The watch is supervised so a panic while handling an event doesn't
+	// end the subscription along with the payments already seen.
+	var watchErr error
+	sv := supervisor.New("deposit watch", log, func(quit <-chan struct{}) {
+		watchErr = s.watchDeposits(watch, subscription, stream, quit)
+	})
+	sv.Start()
+	sv.Wait()
+	sv.Stop()
+	return watchErr
+}
+
+// watchDeposits sends the notifications of the passed deposit watch for the
+// events of the passed subscription until the client disconnects or the quit
+// channel is closed.
+func (s *GrpcServer) watchDeposits(watch *depositWatch, subscription *rpcEventSubscription,
+	stream pb.Bchrpc_SubscribeDepositsServer, quit <-chan struct{}) error {
+
+	for {
+		select {
+		case event := <-subscription.Events():
+			var ntfns []*pb.DepositNotification
+			switch event := event.(type) {
+			case *rpcEventTxAccepted:
+				if ntfn := watch.txAccepted(event.Tx); ntfn != nil {
+					ntfns = append(ntfns, ntfn)
+				}
+
+			case *rpcEventBlockConnected:
+				ntfns = watch.blockConnected(event.Block,
+					s.txMemPool.HaveTransaction)
+
+			case *rpcEventBlockDisconnected:
+				// Fall back to the current content of the mempool
+				// when the transactions returned to it were not
+				// recorded.
+				returned := s.txMemPool.HaveTransaction
+				if event.details != nil {
+					returnedTxs := make(map[chainhash.Hash]struct{},
+						len(event.details.returnedTxs))
+					for _, hash := range event.details.returnedTxs {
+						returnedTxs[hash] = struct{}{}
+					}
+					returned = func(hash *chainhash.Hash) bool {
+						_, ok := returnedTxs[*hash]
+						return ok
+					}
+				}
+				ntfns = watch.blockDisconnected(event.Block, returned)
+			}
+
+			for _, ntfn := range ntfns {
+				if err := stream.Send(ntfn); err != nil {
+					return err
+				}
+			}
+
+		case <-stream.Context().Done():
+			return nil // client disconnected
+
+		case <-quit:
+			return nil
+		}
+	}
+}
+
+// SubscribeDoubleSpendProofs creates a subscription for the double-spend proofs
+// of mempool transactions, optionally filtered by the transactions they put at
+// risk.  The proofs already stored by the mempool are sent first.
+func (s *GrpcServer) SubscribeDoubleSpendProofs(req *pb.SubscribeDoubleSpendProofsRequest, stream pb.Bchrpc_SubscribeDoubleSpendProofsServer) error {
+	filter, err := newDSProofFilter(req.TransactionHashes, req.Addresses, s.chainParams)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// The proofs stored while the stored proofs are sent are only sent
+	// once.
+	sent := make(map[chainhash.Hash]struct{})
+	send := func(event *rpcEventDoubleSpendProof) error {
+		hash := event.proof.Hash()
+		if _, ok := sent[hash]; ok || !filter.matches(event) {
+			return nil
+		}
+		sent[hash] = struct{}{}
+		ntfn, err := event.notification()
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return stream.Send(ntfn)
+	}
+
+	// Subscribe before looking at the stored proofs so no proof is missed.
+	subscription := s.subscribeEvents()
+	defer subscription.Unsubscribe()
+
+	for _, proof := range s.txMemPool.DoubleSpendProofs() {
+		tx := s.txMemPool.CheckSpend(proof.PrevOut)
+		if tx == nil {
+			continue
+		}
+		if err := send(s.newDSProofEvent(proof, tx)); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case event := <-subscription.Events():
+			if event, ok := event.(*rpcEventDoubleSpendProof); ok {
+				if err := send(event); err != nil {
+					return err
+				}
+			}
+
+		case <-stream.Context().Done():
+			return nil // client disconnected
+		}
+	}
+}
+
 func (s *GrpcServer) fetchTransactionFromBlock(txHash *chainhash.Hash) ([]byte, int32, *chainhash.Hash, error) {
 	// Look up the location of the transaction.
 	blockRegion, err := s.txIndex.TxBlockRegion(txHash)
@@ -2998,54 +4060,91 @@ func (s *GrpcServer) getSlpToken(hash *chainhash.Hash, vout uint32, scriptPubKey
 }
 
 // slpEventHandler handles valid slp transaction events from mempool and block
+// until the quit channel is closed.
 //
-// NOTE: this is launched as a goroutine and does not return errors!
-func (s *GrpcServer) slpEventHandler() {
-	if s.slpIndex == nil {
-		return
-	}
-
-	// track the first mempool event for starting certain services (e.g., graph search)
-	firstMempoolTxnSeen := false
-
-	// use this wait group to ensure sure the graph search db is created before we try to add
-	// any txns to the graph search db
-	initWg := sync.WaitGroup{}
-
+// It should be run by a supervisor.
+func (s *GrpcServer) slpEventHandler(quit <-chan struct{}) {
 	subscription := s.subscribeEvents()
 	defer subscription.Unsubscribe()
 
 	for {
-		event := <-subscription.Events()
-		switch event := event.(type) {
-		case *rpcEventTxAccepted:
-			txDesc := event
-			log.Debugf("new mempool txn %v", txDesc.Tx.Hash())
+		var event interface{}
+		select {
+		case event = <-subscription.Events():
+		case <-quit:
+			return
+		}
 
-			// kickoff slp graph search loading here
-			if !firstMempoolTxnSeen {
-				firstMempoolTxnSeen = true
-				if s.slpIndex.GraphSearchEnabled() {
-					log.Debug("starting slp graph search")
-					fetchTxn := func(txnHash *chainhash.Hash) ([]byte, error) {
-						txn, _, _, err := s.fetchTransactionFromBlock(txnHash)
-						return txn, err
-					}
-					initWg.Add(1)
-					go s.slpIndex.LoadSlpGraphSearchDb(fetchTxn, &initWg, &s.shutdown)
-				}
-			}
+		txDesc, ok := event.(*rpcEventTxAccepted)
+		if !ok {
+			continue
+		}
+		log.Debugf("new mempool txn %v", txDesc.Tx.Hash())
+
+		// kickoff slp graph search loading on the first mempool txn
+		graphSearch := s.slpIndex.GraphSearchEnabled()
+		if graphSearch {
+			s.slpGraphSearchStartOnce.Do(func() {
+				close(s.slpGraphSearchStart)
+			})
+		}
 
-			// validate new slp txns
-			isSlpValid := s.checkSlpTxOnEvent(txDesc.Tx.MsgTx(), "mempool")
-			if isSlpValid && s.slpIndex.GraphSearchEnabled() {
-				initWg.Wait()
-				go s.slpIndex.AddGraphSearchTxn(txDesc.Tx.MsgTx())
+		// validate new slp txns
+		isSlpValid := s.checkSlpTxOnEvent(txDesc.Tx.MsgTx(), "mempool")
+		if isSlpValid && graphSearch {
+			select {
+			case s.slpGraphSearchTxns <- txDesc.Tx.MsgTx():
+			case <-quit:
+				return
 			}
+		}
+	}
+}
 
-			continue
-		case *rpcEventBlockConnected:
-			continue
+// loadSlpGraphSearch loads the slp graph search db once the first mempool
+// transaction is seen.
+//
+// It should be run by a supervisor.
+func (s *GrpcServer) loadSlpGraphSearch(quit <-chan struct{}) {
+	select {
+	case <-s.slpGraphSearchStart:
+	case <-quit:
+		return
+	}
+
+	log.Debug("starting slp graph search")
+	fetchTxn := func(txnHash *chainhash.Hash) ([]byte, error) {
+		txn, _, _, err := s.fetchTransactionFromBlock(txnHash)
+		return txn, err
+	}
+	s.slpIndex.LoadSlpGraphSearchDb(fetchTxn, &s.slpGraphSearchInit,
+		&s.shutdown)
+}
+
+// addSlpGraphSearchTxns adds the valid slp transactions of the mempool to the
+// slp graph search db once it is created, until the quit channel is closed.
+//
+// It should be run by a supervisor.
+func (s *GrpcServer) addSlpGraphSearchTxns(quit <-chan struct{}) {
+	// Make sure the graph search db is created before adding any txns to
+	// it.
+	initialized := make(chan struct{})
+	go func() {
+		s.slpGraphSearchInit.Wait()
+		close(initialized)
+	}()
+	select {
+	case <-initialized:
+	case <-quit:
+		return
+	}
+
+	for {
+		select {
+		case tx := <-s.slpGraphSearchTxns:
+			s.slpIndex.AddGraphSearchTxn(tx)
+		case <-quit:
+			return
 		}
 	}
 }
@@ -3075,11 +4174,11 @@ func (s *GrpcServer) checkSlpTxOnEvent(tx *wire.MsgTx, eventStr string) bool {
 	return true
 }
 
-// marshalTokenMetadata returns marshalled token metadata for the provided tokenID hash
-func (s *GrpcServer) marshalTokenMetadata(tokenID chainhash.Hash) (*pb.SlpTokenMetadata, error) {
-
+// getSlpGenesis returns the parsed genesis message and slp index entry of the
+// genesis transaction for the provided tokenID hash
+func (s *GrpcServer) getSlpGenesis(tokenID chainhash.Hash) (*v1parser.SlpGenesis, *indexers.SlpTxEntry, error) {
 	if s.slpIndex == nil {
-		return nil, errors.New("slpindex required")
+		return nil, nil, errors.New("slpindex required")
 	}
 
 	var tokenIDHash []byte
@@ -3089,23 +4188,38 @@ func (s *GrpcServer) marshalTokenMetadata(tokenID chainhash.Hash) (*pb.SlpTokenM
 	tokenIDRev, err := chainhash.NewHash(tokenIDHash)
 	if err != nil {
 		log.Criticalf("Failed to parse token ID: %s, with error: %v", hex.EncodeToString(tokenIDHash), err)
-		return nil, err
+		return nil, nil, err
 	}
 	entry, err := s.getSlpIndexEntry(tokenIDRev)
 	if err != nil {
 		log.Criticalf("Failed to parse token ID: %s, with error: %v", hex.EncodeToString(tokenIDHash), err)
-		return nil, err
+		return nil, nil, err
 	}
 
 	slpMsg, err := v1parser.ParseSLP(entry.SlpOpReturn)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	genMsg, isGenesis := slpMsg.(*v1parser.SlpGenesis)
 	if !isGenesis {
-		return nil, errors.New("cannot build token metadata from a non-genesis entry")
+		return nil, nil, errors.New("cannot build token metadata from a non-genesis entry")
+	}
+	return genMsg, entry, nil
+}
+
+// marshalTokenMetadata returns marshalled token metadata for the provided tokenID hash
+func (s *GrpcServer) marshalTokenMetadata(tokenID chainhash.Hash) (*pb.SlpTokenMetadata, error) {
+
+	if s.slpIndex == nil {
+		return nil, errors.New("slpindex required")
+	}
+
+	genMsg, entry, err := s.getSlpGenesis(tokenID)
+	if err != nil {
+		return nil, err
 	}
+	slpMsg := v1parser.ParseResult(genMsg)
 
 	tm := &pb.SlpTokenMetadata{
 		TokenId:   tokenID[:],
@@ -3173,43 +4287,232 @@ func (s *GrpcServer) marshalTokenMetadata(tokenID chainhash.Hash) (*pb.SlpTokenM
 	return tm, nil
 }
 
-// getDifficultyRatio returns the proof-of-work difficulty as a multiple of the
-// minimum difficulty using the passed bits field from the header of a block.
-func getDifficultyRatio(bits uint32, params *chaincfg.Params) float64 {
-	// The minimum difficulty is the max possible proof-of-work limit bits
-	// converted back to a number.  Note this is not the same as the proof of
-	// work limit directly because the block difficulty is encoded in a block
-	// with the compact form which loses precision.
-	max := blockchain.CompactToBig(params.PowLimitBits)
-	target := blockchain.CompactToBig(bits)
+// slpRegistryMetadata returns the metadata for an slp token from its genesis
+// transaction, replaced by the metadata in the token document if the document
+// hash is set and the document is a registry listing the token.
+func (s *GrpcServer) slpRegistryMetadata(ctx context.Context, tokenID chainhash.Hash) (*pb.TokenMetadata, error) {
+	genMsg, _, err := s.getSlpGenesis(tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	tm := &pb.TokenMetadata{
+		TokenId:  tokenID[:],
+		Kind:     pb.TokenMetadata_SLP,
+		Source:   pb.TokenMetadata_ON_CHAIN,
+		Name:     string(genMsg.Name),
+		Symbol:   string(genMsg.Ticker),
+		Decimals: uint32(genMsg.Decimals),
+	}
+
+	// The document hash is optional in slp genesis transactions and
+	// without it there is nothing to check the document against.
+	if len(genMsg.DocumentHash) == sha256.Size {
+		s.applyRegistryMetadata(ctx, tm, string(genMsg.DocumentURI), genMsg.DocumentHash, hex.EncodeToString(tokenID[:]))
+	}
+	return tm, nil
+}
+
+// cashTokenRegistryMetadata returns the metadata for a CashToken category.
+// CashTokens carry no metadata on-chain so the metadata is only set when the
+// authchain of the category publishes a registry listing the category.  The
+// authchain starts with the authbase transaction, whose hash is the category,
+// and is followed by spending the zeroth output of each transaction, so the
+// latest publication along it up to the authhead is used.
+func (s *GrpcServer) cashTokenRegistryMetadata(ctx context.Context, category *chainhash.Hash) (*pb.TokenMetadata, error) {
+	var msgTx *wire.MsgTx
+	if tx, err := s.txMemPool.FetchTransaction(category); err == nil {
+		msgTx = tx.MsgTx()
+	} else {
+		txBytes, _, _, err := s.fetchTransactionFromBlock(category)
+		if err != nil {
+			return nil, err
+		}
+		msgTx = &wire.MsgTx{}
+		if err := msgTx.BchDecode(bytes.NewReader(txBytes), wire.ProtocolVersion, wire.BaseEncoding); err != nil {
+			return nil, status.Error(codes.Internal, "failed to unmarshal transaction")
+		}
+	}
+
+	var (
+		hash      []byte
+		uri       string
+		published bool
+	)
+	for i := 0; ; i++ {
+		if h, u, ok := extractBcmrPublication(msgTx); ok {
+			hash, uri, published = h, u, true
+		}
+		if i == maxBcmrAuthchainLength || len(msgTx.TxOut) == 0 {
+			break
+		}
+		outpoint := wire.OutPoint{Hash: msgTx.TxHash()}
+		next := s.fetchAuthchainSpender(outpoint, msgTx.TxOut[0].PkScript)
+		if next == nil {
+			break
+		}
+		msgTx = next
+	}
+
+	tm := &pb.TokenMetadata{
+		TokenId: category[:],
+		Kind:    pb.TokenMetadata_CASH_TOKEN,
+		Source:  pb.TokenMetadata_ON_CHAIN,
+	}
+	if published {
+		s.applyRegistryMetadata(ctx, tm, uri, hash, category.String())
+	}
+	return tm, nil
+}
+
+// fetchAuthchainSpender returns the transaction spending the passed authchain
+// output with the passed script, or nil if it is unspent or the spending
+// transaction can't be found.  Confirmed spends are looked up in the address
+// index, so they are only found when it is enabled.
+func (s *GrpcServer) fetchAuthchainSpender(outpoint wire.OutPoint, pkScript []byte) *wire.MsgTx {
+	if tx := s.txMemPool.CheckSpend(outpoint); tx != nil {
+		return tx.MsgTx()
+	}
+	if s.txMemPool.HaveTransaction(&outpoint.Hash) {
+		return nil
+	}
+	entry, err := s.chain.FetchUtxoEntry(outpoint)
+	if err != nil || (entry != nil && !entry.IsSpent()) {
+		return nil
+	}
+
+	if s.addrIndex == nil {
+		return nil
+	}
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(pkScript, s.chainParams)
+	if err != nil || len(addrs) != 1 {
+		return nil
+	}
+	var spender *wire.MsgTx
+	err = s.db.View(func(dbTx database.Tx) error {
+		regions, _, err := s.addrIndex.TxRegionsForAddress(dbTx,
+			addrs[0], 0, maxBcmrSpenderSearch, false)
+		if err != nil {
+			return err
+		}
+		serializedTxns, err := dbTx.FetchBlockRegions(regions)
+		if err != nil {
+			return err
+		}
+		for _, serializedTx := range serializedTxns {
+			var tx wire.MsgTx
+			err := tx.BchDecode(bytes.NewReader(serializedTx),
+				wire.ProtocolVersion, wire.BaseEncoding)
+			if err != nil {
+				return err
+			}
+			for _, txIn := range tx.TxIn {
+				if txIn.PreviousOutPoint == outpoint {
+					spender = &tx
+					return nil
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Debugf("Failed to find the spender of authchain output %v: %v",
+			outpoint, err)
+		return nil
+	}
+	return spender
+}
+
+// applyRegistryMetadata records the registry reference in tm and, if the
+// registry can be resolved and lists the category, replaces the metadata in
+// tm with the metadata from the registry.  Failing to resolve the registry is
+// not an error as the on-chain metadata is still valid.
+func (s *GrpcServer) applyRegistryMetadata(ctx context.Context, tm *pb.TokenMetadata, uri string, hash []byte, category string) {
+	tm.RegistryUri = uri
+	tm.RegistryHash = hash
 
-	difficulty := new(big.Rat).SetFrac(max, target)
-	outString := difficulty.FloatString(8)
-	diff, err := strconv.ParseFloat(outString, 64)
+	registry, err := s.bcmrResolver.resolve(ctx, uri, hash)
 	if err != nil {
-		log.Errorf("Cannot get difficulty: %v", err)
-		return 0
+		return
 	}
-	return diff
+	info, ok := registry.lookup(category, time.Now())
+	if !ok {
+		return
+	}
+
+	tm.Source = pb.TokenMetadata_REGISTRY
+	tm.Name = info.Name
+	tm.Symbol = info.Symbol
+	tm.Decimals = info.Decimals
+	tm.Description = info.Description
+}
+
+// marshalBlockFees returns the breakdown of the coinbase of the passed block,
+// compared to the block template generated by the node when the block was built
+// from it.
+func (s *GrpcServer) marshalBlockFees(block *bchutil.Block) *pb.BlockFees {
+	var coinbaseValue int64
+	for _, txOut := range block.MsgBlock().Transactions[0].TxOut {
+		coinbaseValue += txOut.Value
+	}
+	subsidy := blockchain.CalcBlockSubsidy(block.Height(), s.chainParams)
+	fees := &pb.BlockFees{
+		Subsidy:       subsidy,
+		Fees:          coinbaseValue - subsidy,
+		CoinbaseValue: coinbaseValue,
+	}
+	if s.templateFees == nil {
+		return fees
+	}
+	if templateFees, ok := s.templateFees(block); ok {
+		fees.FromTemplate = true
+		fees.TemplateFees = templateFees
+		fees.FeeDelta = fees.Fees - templateFees
+	}
+	return fees
 }
 
 func marshalBlockInfo(block *bchutil.Block, confirmations int32, medianTime time.Time, params *chaincfg.Params) *pb.BlockInfo {
+	return marshalBlockHeaderInfo(&block.MsgBlock().Header, block.Height(),
+		int32(block.MsgBlock().SerializeSize()), confirmations, medianTime, params)
+}
+
+func marshalBlockHeaderInfo(header *wire.BlockHeader, height, size, confirmations int32, medianTime time.Time, params *chaincfg.Params) *pb.BlockInfo {
+	hash := header.BlockHash()
 	return &pb.BlockInfo{
-		Difficulty:    getDifficultyRatio(block.MsgBlock().Header.Bits, params),
-		Hash:          block.Hash().CloneBytes(),
-		Height:        block.Height(),
-		Version:       block.MsgBlock().Header.Version,
-		Timestamp:     block.MsgBlock().Header.Timestamp.Unix(),
-		MerkleRoot:    block.MsgBlock().Header.MerkleRoot.CloneBytes(),
-		Nonce:         block.MsgBlock().Header.Nonce,
-		Bits:          block.MsgBlock().Header.Bits,
-		PreviousBlock: block.MsgBlock().Header.PrevBlock.CloneBytes(),
+		Difficulty:    blockchain.CalcDifficultyRatio(header.Bits, params),
+		Hash:          hash.CloneBytes(),
+		Height:        height,
+		Version:       header.Version,
+		Timestamp:     header.Timestamp.Unix(),
+		MerkleRoot:    header.MerkleRoot.CloneBytes(),
+		Nonce:         header.Nonce,
+		Bits:          header.Bits,
+		PreviousBlock: header.PrevBlock.CloneBytes(),
 		Confirmations: confirmations,
-		Size:          int32(block.MsgBlock().SerializeSize()),
+		Size:          size,
 		MedianTime:    medianTime.Unix(),
 	}
 }
 
+func marshalMempoolTxGraphEntry(entry *mempool.TxGraphEntry) *pb.GetMempoolTransactionGraphResponse_Entry {
+	pbEntry := &pb.GetMempoolTransactionGraphResponse_Entry{
+		TransactionHash: entry.Tx.Hash().CloneBytes(),
+		Size:            int32(entry.Size),
+		Fee:             entry.Fee,
+		FeePerKb:        entry.FeePerKB,
+		AddedTime:       entry.Added.Unix(),
+		AddedHeight:     entry.Height,
+	}
+	for _, hash := range entry.Depends {
+		pbEntry.Depends = append(pbEntry.Depends, hash.CloneBytes())
+	}
+	for _, hash := range entry.SpentBy {
+		pbEntry.SpentBy = append(pbEntry.SpentBy, hash.CloneBytes())
+	}
+	return pbEntry
+}
+
 func marshalTransaction(tx *bchutil.Tx, confirmations int32, blockHeader *wire.BlockHeader, blockHeight int32, s *GrpcServer) *pb.Transaction {
 	var (
 		txid        = tx.Hash()
@@ -3519,6 +4822,66 @@ func marshalTransaction(tx *bchutil.Tx, confirmations int32, blockHeader *wire.B
 	return respTx
 }
 
+// mempoolTokenBurns returns the slp tokens and CashTokens destroyed by the
+// passed mempool transaction.
+func (s *GrpcServer) mempoolTokenBurns(tx *bchutil.Tx) ([]*pb.TokenBurn, error) {
+	cashTokenBurns, err := s.txMemPool.CheckTokenBurns(tx)
+	if err != nil {
+		return nil, err
+	}
+	return s.marshalTokenBurns(tx.MsgTx(), cashTokenBurns)
+}
+
+// fetchBlockTokenBurns returns the CashTokens destroyed by each transaction of
+// the passed connected block.  No burns are returned when they can't be found,
+// such as when the block was disconnected since.
+func (s *GrpcServer) fetchBlockTokenBurns(block *bchutil.Block) [][]*blockchain.TokenBurn {
+	burns, err := s.chain.FetchTokenBurns(block)
+	if err != nil {
+		log.Warnf("Unable to fetch the token burns of block %v: %v",
+			block.Hash(), err)
+		return make([][]*blockchain.TokenBurn, len(block.Transactions()))
+	}
+	return burns
+}
+
+// marshalTokenBurns returns the passed CashTokens burned by a transaction
+// along with the slp tokens it destroys, when the slp index is enabled.
+func (s *GrpcServer) marshalTokenBurns(msgTx *wire.MsgTx, cashTokenBurns []*blockchain.TokenBurn) ([]*pb.TokenBurn, error) {
+	var slpBurns []*blockchain.TokenBurn
+	if s.slpIndex != nil {
+		err := s.db.View(func(dbTx database.Tx) error {
+			var err error
+			slpBurns, err = s.slpIndex.CheckSlpBurns(dbTx, msgTx)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var burns []*pb.TokenBurn
+	marshal := func(kind pb.TokenMetadata_TokenKind, burn *blockchain.TokenBurn) {
+		tokenID := burn.TokenID
+		burns = append(burns, &pb.TokenBurn{
+			Kind:            kind,
+			TokenId:         tokenID[:],
+			InputAmount:     burn.InputAmount,
+			OutputAmount:    burn.OutputAmount,
+			BurnedAmount:    burn.BurnedAmount(),
+			BurnedNfts:      burn.BurnedNFTs,
+			MintBatonBurned: burn.MintingBurned,
+		})
+	}
+	for _, burn := range slpBurns {
+		marshal(pb.TokenMetadata_SLP, burn)
+	}
+	for _, burn := range cashTokenBurns {
+		marshal(pb.TokenMetadata_CASH_TOKEN, burn)
+	}
+	return burns, nil
+}
+
 // setInputMetadata will set the value, previous script, and address for each input in the mempool transaction
 // from blockchain data adjusted upon the contents of the transaction pool.
 // Used when no s.txIndex is available
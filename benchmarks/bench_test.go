@@ -0,0 +1,247 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package benchmarks
+
+import (
+	"flag"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/mempool"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchutil"
+)
+
+const (
+	// corpusBlocks and corpusTxnsPerBlock are the number of blocks with
+	// transactions in the generated corpus and the number of transactions
+	// in each of them.
+	corpusBlocks       = 10
+	corpusTxnsPerBlock = 200
+
+	// mainnetSample is the block of the main network, with the outputs it
+	// spends, used to benchmark script validation.
+	mainnetSample = "277647"
+)
+
+var (
+	benchProfiles = flag.String("benchprofiles", "", "Directory to "+
+		"write a CPU and a heap profile of each benchmark to")
+
+	corpusOnce sync.Once
+	corpus     *Corpus
+	corpusErr  error
+)
+
+// loadCorpus returns the corpus used by the benchmarks, which is generated the
+// first time it is needed.
+func loadCorpus(b *testing.B) *Corpus {
+	corpusOnce.Do(func() {
+		corpus, corpusErr = GenerateCorpus(corpusBlocks,
+			corpusTxnsPerBlock)
+	})
+	if corpusErr != nil {
+		b.Fatalf("unable to generate corpus: %v", corpusErr)
+	}
+	return corpus
+}
+
+// profile captures profiles of the calling benchmark when the -benchprofiles
+// flag is set.
+func profile(b *testing.B) {
+	if *benchProfiles == "" {
+		return
+	}
+	stop, err := StartProfile(*benchProfiles, b.Name())
+	if err != nil {
+		b.Fatalf("unable to start profile: %v", err)
+	}
+	b.Cleanup(func() {
+		if err := stop(); err != nil {
+			b.Errorf("unable to write profile: %v", err)
+		}
+	})
+}
+
+// processBlocks processes the passed blocks with the passed chain.
+func processBlocks(b *testing.B, chain *blockchain.BlockChain, blocks []*bchutil.Block) {
+	for _, block := range blocks {
+		_, isOrphan, err := chain.ProcessBlock(block, blockchain.BFNone)
+		if err != nil {
+			b.Fatalf("unable to process block %v: %v", block.Hash(), err)
+		}
+		if isOrphan {
+			b.Fatalf("block %v is an orphan", block.Hash())
+		}
+	}
+}
+
+// newCorpusChain returns a chain with the passed number of blocks of the corpus
+// along with a function which removes it.
+func newCorpusChain(b *testing.B, corpus *Corpus, numBlocks int) (*blockchain.BlockChain, func()) {
+	chain, teardown, err := NewChain(corpus.Params, b.TempDir())
+	if err != nil {
+		b.Fatalf("unable to create chain: %v", err)
+	}
+	processBlocks(b, chain, corpus.Blocks[:numBlocks])
+	return chain, teardown
+}
+
+// BenchmarkProcessBlock benchmarks connecting the blocks of the corpus which
+// have transactions to the best chain.
+func BenchmarkProcessBlock(b *testing.B) {
+	corpus := loadCorpus(b)
+	matureBlocks := int(corpus.MatureHeight) - 1
+	profile(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		chain, teardown := newCorpusChain(b, corpus, matureBlocks)
+		b.StartTimer()
+
+		processBlocks(b, chain, corpus.Blocks[matureBlocks:])
+
+		b.StopTimer()
+		teardown()
+		b.StartTimer()
+	}
+	b.ReportMetric(float64(corpusBlocks*corpusTxnsPerBlock), "txns/op")
+}
+
+// BenchmarkFlushUtxoCache benchmarks writing the utxo cache of a chain with all
+// the blocks of the corpus to the database.
+func BenchmarkFlushUtxoCache(b *testing.B) {
+	corpus := loadCorpus(b)
+	profile(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		chain, teardown := newCorpusChain(b, corpus, len(corpus.Blocks))
+		b.StartTimer()
+
+		if err := chain.FlushCachedState(blockchain.FlushRequired); err != nil {
+			b.Fatalf("unable to flush utxo cache: %v", err)
+		}
+
+		b.StopTimer()
+		teardown()
+		b.StartTimer()
+	}
+}
+
+// BenchmarkValidateTransactionScripts benchmarks validating the scripts of the
+// transactions of a block of the main network without a signature cache.
+func BenchmarkValidateTransactionScripts(b *testing.B) {
+	testdata := filepath.Join("..", "blockchain", "testdata")
+	blocks, err := LoadBlocks(filepath.Join(testdata, mainnetSample+".dat.bz2"))
+	if err != nil {
+		b.Fatalf("unable to load block: %v", err)
+	}
+	view, err := LoadUtxoView(filepath.Join(testdata,
+		mainnetSample+".utxostore.bz2"))
+	if err != nil {
+		b.Fatalf("unable to load utxo view: %v", err)
+	}
+	txns := blocks[0].Transactions()[1:]
+	profile(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, tx := range txns {
+			_, err := blockchain.ValidateTransactionScripts(tx, view,
+				txscript.ScriptBip16, nil, nil, 0)
+			if err != nil {
+				b.Fatalf("unable to validate tx %v: %v", tx.Hash(),
+					err)
+			}
+		}
+	}
+	b.ReportMetric(float64(len(txns)), "txns/op")
+}
+
+// BenchmarkValidateTransactionScriptsForkID benchmarks validating the scripts of
+// the transactions of the corpus, which are signed with the fork id, with the
+// standard flags and without a signature cache.
+func BenchmarkValidateTransactionScriptsForkID(b *testing.B) {
+	corpus := loadCorpus(b)
+	view := blockchain.NewUtxoViewpoint()
+	var txns []*bchutil.Tx
+	for i, block := range corpus.Blocks {
+		height := int32(i + 1)
+		view.AddTxOuts(block.Transactions()[0], height)
+		if height >= corpus.MatureHeight {
+			txns = append(txns, block.Transactions()[1:]...)
+		}
+	}
+	profile(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, tx := range txns {
+			_, err := blockchain.ValidateTransactionScripts(tx, view,
+				txscript.StandardVerifyFlags, nil, nil, 0)
+			if err != nil {
+				b.Fatalf("unable to validate tx %v: %v", tx.Hash(),
+					err)
+			}
+		}
+	}
+	b.ReportMetric(float64(len(txns)), "txns/op")
+}
+
+// BenchmarkProcessTransaction benchmarks accepting the transactions of the
+// corpus which are not mined to an empty mempool.
+func BenchmarkProcessTransaction(b *testing.B) {
+	corpus := loadCorpus(b)
+	chain, teardown := newCorpusChain(b, corpus, len(corpus.Blocks))
+	defer teardown()
+	profile(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		txPool := mempool.New(&mempool.Config{
+			Policy: mempool.Policy{
+				DisableRelayPriority: true,
+				FreeTxRelayLimit:     15.0,
+				MaxOrphanTxs:         100,
+				MaxOrphanTxSize:      100000,
+				LimitSigChecks:       true,
+				MinRelayTxFee:        mempool.DefaultMinRelayTxFee,
+				MaxTxVersion:         2,
+			},
+			ChainParams:   corpus.Params,
+			FetchUtxoView: chain.FetchUtxoView,
+			BestHeight: func() int32 {
+				return chain.BestSnapshot().Height
+			},
+			BestHash: func() *chainhash.Hash {
+				return &chain.BestSnapshot().Hash
+			},
+			MedianTimePast: func() time.Time {
+				return chain.BestSnapshot().MedianTime
+			},
+			CalcSequenceLock: func(tx *bchutil.Tx, view *blockchain.UtxoViewpoint) (*blockchain.SequenceLock, error) {
+				return chain.CalcSequenceLock(tx, view, true)
+			},
+			IsDeploymentActive: chain.IsDeploymentActive,
+		})
+		b.StartTimer()
+
+		for _, tx := range corpus.Txns {
+			_, err := txPool.ProcessTransaction(tx, false, false, 0)
+			if err != nil {
+				b.Fatalf("unable to accept tx %v: %v", tx.Hash(), err)
+			}
+		}
+	}
+	b.ReportMetric(float64(len(corpus.Txns)), "txns/op")
+}
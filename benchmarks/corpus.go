@@ -0,0 +1,371 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package benchmarks
+
+import (
+	"compress/bzip2"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gcash/bchd/bchec"
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/database"
+	_ "github.com/gcash/bchd/database/ffldb" // Register the ffldb driver.
+	"github.com/gcash/bchd/mining"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+const (
+	// corpusTxFee is the fee paid by each of the generated transactions,
+	// which is above the default minimum relay fee.
+	corpusTxFee = 1000
+
+	// blockInterval is the time between the generated blocks.
+	blockInterval = 10 * time.Minute
+)
+
+// corpusKeySeed is the private key which the outputs of the generated corpus
+// pay to.  A fixed key makes the generated blocks the same on every run.
+var corpusKeySeed = []byte{
+	0x2b, 0x8c, 0x52, 0xb7, 0x7b, 0x32, 0x7c, 0x75,
+	0x5b, 0x9b, 0x37, 0x55, 0x15, 0xd1, 0x0b, 0x45,
+	0x45, 0x27, 0x5c, 0x3d, 0x8f, 0x3b, 0xa1, 0x1a,
+	0x91, 0x48, 0x42, 0x8a, 0x34, 0x1f, 0x6c, 0x6e,
+}
+
+// LoadBlocks returns the blocks of a file in the format bitcoind writes them,
+// which is compressed with bzip2 when the file name ends with .bz2.  The block
+// samples of the main network used by the tests of the blockchain package can
+// be loaded this way.
+func LoadBlocks(filename string) ([]*bchutil.Block, error) {
+	fi, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer fi.Close()
+
+	var r io.Reader = fi
+	if strings.HasSuffix(filename, ".bz2") {
+		r = bzip2.NewReader(fi)
+	}
+
+	var blocks []*bchutil.Block
+	for {
+		// Each block is preceded by the network magic and its length.
+		// The magic is not checked since the samples of the main network
+		// predate the split and use the magic of bitcoind.
+		var header [8]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			if err == io.EOF {
+				return blocks, nil
+			}
+			return nil, err
+		}
+
+		serialized := make([]byte, binary.LittleEndian.Uint32(header[4:]))
+		if _, err := io.ReadFull(r, serialized); err != nil {
+			return nil, err
+		}
+		block, err := bchutil.NewBlockFromBytes(serialized)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+}
+
+// LoadUtxoView returns the utxo view stored in a file in the utxostore format
+// used by the tests of the blockchain package, which is compressed with bzip2
+// when the file name ends with .bz2.
+func LoadUtxoView(filename string) (*blockchain.UtxoViewpoint, error) {
+	// The utxostore file format is:
+	// <tx hash><output index><serialized utxo len><serialized utxo>
+	//
+	// The output index and serialized utxo len are little endian uint32s.
+	fi, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer fi.Close()
+
+	var r io.Reader = fi
+	if strings.HasSuffix(filename, ".bz2") {
+		r = bzip2.NewReader(fi)
+	}
+
+	view := blockchain.NewUtxoViewpoint()
+	for {
+		var hash chainhash.Hash
+		if _, err := io.ReadFull(r, hash[:]); err != nil {
+			if err == io.EOF {
+				return view, nil
+			}
+			return nil, err
+		}
+		var header [8]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return nil, err
+		}
+		index := binary.LittleEndian.Uint32(header[:4])
+
+		serialized := make([]byte, binary.LittleEndian.Uint32(header[4:]))
+		if _, err := io.ReadFull(r, serialized); err != nil {
+			return nil, err
+		}
+		entry, err := blockchain.DeserializeUtxoEntry(serialized)
+		if err != nil {
+			return nil, err
+		}
+		view.Entries()[wire.OutPoint{Hash: hash, Index: index}] = entry
+	}
+}
+
+// NewChain returns a chain for the passed network which stores its blocks in a
+// new database in the passed directory, along with a function which closes the
+// database and removes it.
+func NewChain(params *chaincfg.Params, dataDir string) (*blockchain.BlockChain, func(), error) {
+	dbPath := filepath.Join(dataDir, "blocks_ffldb")
+	db, err := database.Create("ffldb", dbPath, params.Net)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot create database: %v", err)
+	}
+	teardown := func() {
+		db.Close()
+		os.RemoveAll(dbPath)
+	}
+
+	// Copy the chain params so the chain can't modify the global instance.
+	paramsCopy := *params
+	chain, err := blockchain.New(&blockchain.Config{
+		DB:                 db,
+		ChainParams:        &paramsCopy,
+		TimeSource:         blockchain.NewMedianTime(),
+		UtxoCacheMaxSize:   250 * 1024 * 1024,
+		ExcessiveBlockSize: 32000000,
+	})
+	if err != nil {
+		teardown()
+		return nil, nil, fmt.Errorf("cannot create chain: %v", err)
+	}
+	return chain, teardown, nil
+}
+
+// Corpus is a chain of generated blocks of the regression test network along
+// with transactions spending its outputs which are not mined.  All the outputs
+// pay to the same key.
+type Corpus struct {
+	// Params are the parameters of the network of the blocks.
+	Params *chaincfg.Params
+
+	// Blocks are the generated blocks following the genesis block.  The
+	// first MatureHeight-1 of them only have a coinbase, the outputs of
+	// which are spent by the transactions of the following blocks.
+	Blocks []*bchutil.Block
+
+	// MatureHeight is the height of the first block with transactions
+	// other than the coinbase.
+	MatureHeight int32
+
+	// Txns are transactions which can be added to the mempool of a chain
+	// with all the blocks of the corpus.
+	Txns []*bchutil.Tx
+}
+
+// corpusGenerator holds the state used to generate a corpus.
+type corpusGenerator struct {
+	params   *chaincfg.Params
+	key      *bchec.PrivateKey
+	pkScript []byte
+
+	// coinbases holds the coinbase of each generated block by height.
+	coinbases map[int32]*wire.MsgTx
+}
+
+// coinbase returns a coinbase transaction for a block at the passed height
+// which splits the subsidy in the passed number of outputs.
+func (g *corpusGenerator) coinbase(height int32, numOutputs int) (*wire.MsgTx, error) {
+	// The height is pushed first as required once BIP0034 is active and
+	// the script is padded so the coinbase is never too small.
+	script, err := txscript.NewScriptBuilder().AddInt64(int64(height)).
+		AddData([]byte(mining.CoinbaseFlags)).
+		AddData(make([]byte, 64)).Script()
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{},
+			wire.MaxPrevOutIndex),
+		SignatureScript: script,
+		Sequence:        wire.MaxTxInSequenceNum,
+	})
+	subsidy := blockchain.CalcBlockSubsidy(height, g.params)
+	for i := 0; i < numOutputs; i++ {
+		tx.AddTxOut(wire.NewTxOut(subsidy/int64(numOutputs), g.pkScript,
+			wire.TokenData{}))
+	}
+	return tx, nil
+}
+
+// spend returns a signed transaction spending the passed output, which pays
+// the passed amount, back to the corpus key in two outputs.
+func (g *corpusGenerator) spend(outPoint wire.OutPoint, amount int64) (*wire.MsgTx, error) {
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(&outPoint, nil))
+	change := amount - corpusTxFee
+	tx.AddTxOut(wire.NewTxOut(change/2, g.pkScript,
+		wire.TokenData{}))
+	tx.AddTxOut(wire.NewTxOut(change-change/2, g.pkScript,
+		wire.TokenData{}))
+
+	hashType := txscript.SigHashAll | txscript.SigHashForkID
+	sig, err := txscript.RawTxInECDSASignature(tx, 0, g.pkScript, hashType,
+		g.key, amount)
+	if err != nil {
+		return nil, err
+	}
+	tx.TxIn[0].SignatureScript, err = txscript.NewScriptBuilder().
+		AddData(sig).AddData(g.key.PubKey().SerializeCompressed()).
+		Script()
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// spendCoinbase returns transactions spending each output of the coinbase of
+// the block at the passed height.
+func (g *corpusGenerator) spendCoinbase(height int32) ([]*wire.MsgTx, error) {
+	coinbase := g.coinbases[height]
+	coinbaseHash := coinbase.TxHash()
+	txns := make([]*wire.MsgTx, 0, len(coinbase.TxOut))
+	for i, txOut := range coinbase.TxOut {
+		tx, err := g.spend(wire.OutPoint{Hash: coinbaseHash, Index: uint32(i)},
+			txOut.Value)
+		if err != nil {
+			return nil, err
+		}
+		txns = append(txns, tx)
+	}
+	return txns, nil
+}
+
+// GenerateCorpus returns a corpus of the passed number of blocks which each
+// have the passed number of transactions other than the coinbase.  The blocks
+// which mature the coinbase outputs spent by their transactions are generated
+// first, and the same number of transactions is generated for the mempool.  The
+// corpus is the same on every call with the same arguments.
+func GenerateCorpus(numBlocks, txnsPerBlock int) (*Corpus, error) {
+	params := &chaincfg.RegressionNetParams
+	key, _ := bchec.PrivKeyFromBytes(bchec.S256(), corpusKeySeed)
+	addr, err := bchutil.NewAddressPubKeyHash(
+		bchutil.Hash160(key.PubKey().SerializeCompressed()), params)
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+	g := &corpusGenerator{
+		params:    params,
+		key:       key,
+		pkScript:  pkScript,
+		coinbases: make(map[int32]*wire.MsgTx),
+	}
+
+	// The transactions of the block at a given height spend the outputs of
+	// the coinbase which matured in it.
+	maturity := int32(params.CoinbaseMaturity)
+	corpus := &Corpus{
+		Params:       params,
+		MatureHeight: maturity + 1,
+	}
+	prevBlock := params.GenesisBlock
+	lastHeight := maturity + int32(numBlocks)
+	for height := int32(1); height <= lastHeight; height++ {
+		coinbase, err := g.coinbase(height, txnsPerBlock)
+		if err != nil {
+			return nil, err
+		}
+		g.coinbases[height] = coinbase
+
+		txns := []*bchutil.Tx{bchutil.NewTx(coinbase)}
+		if height >= corpus.MatureHeight {
+			spends, err := g.spendCoinbase(height - maturity)
+			if err != nil {
+				return nil, err
+			}
+			for _, tx := range spends {
+				txns = append(txns, bchutil.NewTx(tx))
+			}
+		}
+
+		// Blocks must be ordered by transaction hash once the
+		// MagneticAnomaly upgrade is active.
+		if height > params.MagneticAnonomalyForkHeight {
+			sort.Sort(mining.TxSorter(txns[1:]))
+		}
+
+		block, err := solveBlock(prevBlock, txns, params.PowLimitBits)
+		if err != nil {
+			return nil, err
+		}
+		corpus.Blocks = append(corpus.Blocks, bchutil.NewBlock(block))
+		prevBlock = block
+	}
+
+	spends, err := g.spendCoinbase(lastHeight + 1 - maturity)
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range spends {
+		corpus.Txns = append(corpus.Txns, bchutil.NewTx(tx))
+	}
+	return corpus, nil
+}
+
+// solveBlock returns a block with the passed transactions extending the passed
+// block, with a nonce solving the passed proof of work target.
+func solveBlock(prevBlock *wire.MsgBlock, txns []*bchutil.Tx, bits uint32) (*wire.MsgBlock, error) {
+	merkles := blockchain.BuildMerkleTreeStore(txns)
+	block := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:    4,
+			PrevBlock:  prevBlock.BlockHash(),
+			MerkleRoot: *merkles[len(merkles)-1],
+			Timestamp:  prevBlock.Header.Timestamp.Add(blockInterval),
+			Bits:       bits,
+		},
+	}
+	for _, tx := range txns {
+		if err := block.AddTransaction(tx.MsgTx()); err != nil {
+			return nil, err
+		}
+	}
+
+	target := blockchain.CompactToBig(bits)
+	for nonce := uint32(0); ; nonce++ {
+		block.Header.Nonce = nonce
+		hash := block.Header.BlockHash()
+		if blockchain.HashToBig(&hash).Cmp(target) <= 0 {
+			return block, nil
+		}
+		if nonce == ^uint32(0) {
+			return nil, fmt.Errorf("no nonce solves block")
+		}
+	}
+}
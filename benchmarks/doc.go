@@ -0,0 +1,31 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package benchmarks provides benchmarks of block validation and the mempool
+along with the corpus they use and helpers to profile them.
+
+The benchmarks cover ProcessBlock, the flush of the utxo cache,
+ValidateTransactionScripts and ProcessTransaction.  They use a corpus of
+generated regression test network blocks which spend the outputs of each other,
+which is the same on every run, along with the samples of main network blocks
+used by the tests of the blockchain package.
+
+Run the benchmarks with:
+
+	go test -run=^$ -bench=. ./benchmarks
+
+A CPU and a heap profile of each benchmark are written to a directory with the
+-benchprofiles flag:
+
+	go test -run=^$ -bench=. ./benchmarks -args -benchprofiles=profiles
+
+CI can catch performance regressions with the -thresholds flag, which runs each
+benchmark once and fails when it takes longer per operation than the limit set
+in testdata/thresholds.json.  The limits are scaled by the -thresholdscale flag
+to account for slower machines:
+
+	go test -run=TestThresholds ./benchmarks -args -thresholds -thresholdscale=2
+*/
+package benchmarks
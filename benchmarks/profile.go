@@ -0,0 +1,50 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package benchmarks
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+)
+
+// StartProfile starts capturing a CPU profile to the file <name>.cpu.pprof in
+// the passed directory, which is created when it doesn't exist.  It returns a
+// function which stops the CPU profile and writes a heap profile to the file
+// <name>.heap.pprof in the same directory.  Both can be analyzed with go tool
+// pprof.  Only one CPU profile can be captured at a time.
+func StartProfile(dir, name string) (func() error, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	cpuFile, err := os.Create(filepath.Join(dir, name+".cpu.pprof"))
+	if err != nil {
+		return nil, err
+	}
+	if err := pprof.StartCPUProfile(cpuFile); err != nil {
+		cpuFile.Close()
+		return nil, fmt.Errorf("cannot start CPU profile: %v", err)
+	}
+
+	stop := func() error {
+		pprof.StopCPUProfile()
+		if err := cpuFile.Close(); err != nil {
+			return err
+		}
+
+		heapFile, err := os.Create(filepath.Join(dir, name+".heap.pprof"))
+		if err != nil {
+			return err
+		}
+		defer heapFile.Close()
+
+		// Collect the garbage so the profile only shows live memory.
+		runtime.GC()
+		return pprof.WriteHeapProfile(heapFile)
+	}
+	return stop, nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package benchmarks
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+)
+
+var (
+	checkThresholds = flag.Bool("thresholds", false, "Fail when a "+
+		"benchmark exceeds its threshold in testdata/thresholds.json")
+	thresholdScale = flag.Float64("thresholdscale", 1, "Factor the "+
+		"thresholds are multiplied by")
+)
+
+// thresholdBenchmarks are the benchmarks which can have a threshold, by name.
+var thresholdBenchmarks = map[string]func(*testing.B){
+	"BenchmarkProcessBlock":                     BenchmarkProcessBlock,
+	"BenchmarkFlushUtxoCache":                   BenchmarkFlushUtxoCache,
+	"BenchmarkValidateTransactionScripts":       BenchmarkValidateTransactionScripts,
+	"BenchmarkValidateTransactionScriptsForkID": BenchmarkValidateTransactionScriptsForkID,
+	"BenchmarkProcessTransaction":               BenchmarkProcessTransaction,
+}
+
+// TestThresholds ensures the benchmarks don't take longer per operation than
+// their thresholds when the -thresholds flag is set.
+func TestThresholds(t *testing.T) {
+	if !*checkThresholds {
+		t.Skip("thresholds are only checked with the -thresholds flag")
+	}
+
+	data, err := os.ReadFile(filepath.Join("testdata", "thresholds.json"))
+	if err != nil {
+		t.Fatalf("unable to read thresholds: %v", err)
+	}
+	var thresholds map[string]string
+	if err := json.Unmarshal(data, &thresholds); err != nil {
+		t.Fatalf("unable to parse thresholds: %v", err)
+	}
+
+	names := make([]string, 0, len(thresholds))
+	for name := range thresholds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		benchmark, ok := thresholdBenchmarks[name]
+		if !ok {
+			t.Errorf("%s: unknown benchmark", name)
+			continue
+		}
+		threshold, err := time.ParseDuration(thresholds[name])
+		if err != nil {
+			t.Errorf("%s: invalid threshold: %v", name, err)
+			continue
+		}
+		threshold = time.Duration(float64(threshold) * *thresholdScale)
+
+		result := testing.Benchmark(benchmark)
+		if result.N == 0 {
+			t.Errorf("%s: benchmark failed", name)
+			continue
+		}
+		perOp := time.Duration(result.NsPerOp())
+		if perOp > threshold {
+			t.Errorf("%s: took %v per operation, which exceeds the "+
+				"threshold of %v", name, perOp, threshold)
+			continue
+		}
+		t.Logf("%s: took %v per operation (threshold %v)", name, perOp,
+			threshold)
+	}
+}
@@ -6,6 +6,7 @@ package blockchain
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/gcash/bchd/chaincfg/chainhash"
 	"github.com/gcash/bchd/database"
@@ -38,10 +39,23 @@ func (b *BlockChain) maybeAcceptBlock(block *bchutil.Block, prevHash *chainhash.
 
 	// The block must pass all of the validation rules which depend on the
 	// position of the block within the block chain.
+	start := time.Now()
 	err := b.checkBlockContext(block, prevNode, flags)
 	if err != nil {
+		// The block passed the sanity checks, so its transactions
+		// match the merkle root and can't have been altered on the
+		// way, and it violates the rules regardless of who offers it.
+		// It isn't added to the block index, so it is recorded in the
+		// invalid block cache to reject it right away when it is
+		// offered again.  Blocks with unchecked proof of work aren't
+		// recorded since they are cheap to create.
+		if _, ok := err.(RuleError); ok && !flags.HasFlag(BFNoPoWCheck) {
+			b.recordInvalidBlock(block.Hash(), prevHash, blockHeight,
+				err.Error())
+		}
 		return false, err
 	}
+	b.validationStats.record(block.Hash(), phaseContext, start)
 
 	// Insert the block into the database if it's not already there.  Even
 	// though it is possible the block will ultimately fail to connect, it
@@ -52,12 +66,14 @@ func (b *BlockChain) maybeAcceptBlock(block *bchutil.Block, prevHash *chainhash.
 	// expensive connection logic.  It also has some other nice properties
 	// such as making blocks that never become part of the main chain or
 	// blocks that fail to connect available for further analysis.
+	start = time.Now()
 	err = b.db.Update(func(dbTx database.Tx) error {
 		return dbStoreBlock(dbTx, block)
 	})
 	if err != nil {
 		return false, err
 	}
+	b.validationStats.record(block.Hash(), phaseStore, start)
 
 	// Create a new block node for the block and add it to the node index. Even
 	// if the block ultimately gets connected to the main chain, it starts out
@@ -85,7 +101,7 @@ func (b *BlockChain) maybeAcceptBlock(block *bchutil.Block, prevHash *chainhash.
 	// inventory to other peers.
 	b.notificationLock.Lock()
 	b.chainLock.Unlock()
-	b.sendNotification(NTBlockAccepted, block)
+	b.sendNotification(&notification{typ: ntBlockAccepted, block: block})
 	b.chainLock.Lock()
 	b.notificationLock.Unlock()
 
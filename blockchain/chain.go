@@ -10,7 +10,9 @@ import (
 	"container/list"
 	"fmt"
 	"math"
+	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gcash/bchd/chaincfg"
@@ -69,9 +71,23 @@ type BestState struct {
 	NumTxns    uint64         // The number of txns in the block.
 	TotalTxns  uint64         // The total number of txns in the chain.
 	MedianTime time.Time      // Median time as per CalcPastMedianTime.
+
+	// Difficulty is the difficulty of the block as a multiple of the
+	// minimum difficulty.
+	Difficulty float64
+
+	// BlockSizeLimit is the maximum size of the next block, which is the
+	// limit set by the adaptive block size limit algorithm once it is
+	// active.
+	BlockSizeLimit uint64
+
+	// DeploymentStates holds the threshold state of each deployment of the
+	// network for the next block, indexed by deployment ID.
+	DeploymentStates []ThresholdState
 }
 
 // newBestState returns a new best stats instance for the given parameters.
+// The fields depending on the chain state are filled in by setBestState.
 func newBestState(node *blockNode, blockSize, numTxns,
 	totalTxns uint64, medianTime time.Time) *BestState {
 
@@ -120,6 +136,7 @@ type BlockChain struct {
 	sigCache            *txscript.SigCache
 	indexManager        IndexManager
 	hashCache           *txscript.HashCache
+	scriptFlagOverrides txscript.ScriptFlagOverrides
 	excessiveBlockSize  uint32
 
 	// The following fields are calculated based upon the provided chain
@@ -131,9 +148,25 @@ type BlockChain struct {
 	blocksPerRetarget   int32 // target timespan / target time per block
 
 	// chainLock protects concurrent access to the vast majority of the
-	// fields in this struct below this point.
+	// fields in this struct below this point.  It is held for writes for
+	// the entire processing of a block, including the validation of the
+	// scripts and the updates of the UTXO state.
 	chainLock sync.RWMutex
 
+	// indexLock protects the consistency of the block index and the best
+	// chain view across multiple lookups so the header and index queries
+	// don't contend with the processing of blocks.  Changing the tip of the
+	// best chain requires holding both the chain lock and the index lock
+	// for writes, so the queries may hold either of them for reads.  The
+	// index lock is only held for writes for the brief moment the tip is
+	// changed.
+	//
+	// The locks must be acquired in the following order to prevent
+	// deadlocks: chainLock, indexLock, then the locks of the block index,
+	// the best chain view and the state snapshot.  The chain lock must
+	// never be acquired while the index lock is held.
+	indexLock sync.RWMutex
+
 	// This is abla state.
 	ablaConfig ABLAConfig
 	ablaState  ABLAState
@@ -156,6 +189,19 @@ type BlockChain struct {
 	// to help prevent logic races when blocks are being processed.
 	utxoCache *utxoCache
 
+	// pipeline tracks the blocks being prevalidated ahead of processing.
+	// It has its own lock and is never protected by the chain lock so
+	// prevalidation can run while a block is being connected.
+	pipeline *blockPipeline
+
+	// validationStats times the phases of processing blocks.  It has its
+	// own lock and is never protected by the chain lock.
+	validationStats *validationStats
+
+	// invalidBlocks houses the blocks rejected for violating the consensus
+	// rules.  It has its own lock.
+	invalidBlocks *invalidBlockCache
+
 	// orphanLock protects the fields related to handling of orphan blocks.
 	// They are protected by a combination of the chain lock and the orphan lock.
 	orphanLock   sync.RWMutex
@@ -171,16 +217,21 @@ type BlockChain struct {
 	// The state is used as a fairly efficient way to cache information
 	// about the current best chain state that is returned to callers when
 	// requested.  It operates on the principle of MVCC such that any time a
-	// new block becomes the best block, the state pointer is replaced with
-	// a new struct and the old state is left untouched.  In this way,
-	// multiple callers can be pointing to different best chain states.
-	// This is acceptable for most callers because the state is only being
-	// queried at a specific point in time.
+	// new block becomes the best block, the state pointer is atomically
+	// replaced with a new struct and the old state is left untouched.  In
+	// this way, multiple callers can be pointing to different best chain
+	// states, and callers never wait for a lock to query it, even while a
+	// block is being connected or the utxo cache is being flushed.  This is
+	// acceptable for most callers because the state is only being queried
+	// at a specific point in time.
 	//
 	// In addition, some of the fields are stored in the database so the
 	// chain state can be quickly reconstructed on load.
-	stateLock     sync.RWMutex
-	stateSnapshot *BestState
+	stateSnapshot atomic.Pointer[BestState]
+
+	// stateLock serializes the commits of the block changes to the utxo
+	// cache with the flushes of the cache to the database.
+	stateLock sync.RWMutex
 
 	// notificationLock is used to make sure notifications are sent
 	// serially and protect against double mutex unlock panics during reorg.
@@ -214,10 +265,10 @@ type BlockChain struct {
 	unknownRulesWarned    bool
 	unknownVersionsWarned bool
 
-	// The notifications field stores a slice of callbacks to be executed on
-	// certain blockchain events.
+	// The subscriptions field stores the subscriptions to notifications
+	// about certain blockchain events.
 	notificationsLock sync.RWMutex
-	notifications     []NotificationCallback
+	subscriptions     []*Subscription
 
 	// The following fields are set if the blockchain is configured to prune
 	// historical blocks.
@@ -310,15 +361,18 @@ func (b *BlockChain) AddHeader(header *wire.BlockHeader) error {
 
 	tip := b.bestChain.tip()
 	node := newBlockNode(header, tip)
+	b.indexLock.Lock()
 	b.index.AddNode(node)
 	b.index.SetStatusFlags(node, statusValid)
 	b.bestChain.SetTip(node)
-	b.stateSnapshot = newBestState(node, 0, 0, 0, node.CalcPastMedianTime())
+	b.indexLock.Unlock()
+	state := newBestState(node, 0, 0, 0, node.CalcPastMedianTime())
+	b.setBestState(state)
 
 	// Atomically insert info into the database.
 	err := b.db.Update(func(dbTx database.Tx) error {
 		// Update best block state.
-		if err := dbPutBestState(dbTx, b.stateSnapshot, node.workSum); err != nil {
+		if err := dbPutBestState(dbTx, state, node.workSum); err != nil {
 			return err
 		}
 
@@ -678,9 +732,12 @@ func (b *BlockChain) getReorganizeNodes(node *blockNode) (*list.List, *list.List
 // must happen prior to calling this function requires the same details, so
 // it would be inefficient to repeat it.
 //
+// The passed script flags the block was validated with are recorded unless
+// they are nil, such as when the block was not validated again.
+//
 // This function MUST be called with the chain state lock held (for writes).
 func (b *BlockChain) connectBlock(node *blockNode, block *bchutil.Block,
-	view *UtxoViewpoint, stxos []SpentTxOut) error {
+	view *UtxoViewpoint, stxos []SpentTxOut, validatedFlags *BlockScriptFlags) error {
 
 	// Make sure it's extending the end of the best chain.
 	prevHash := &block.MsgBlock().Header.PrevBlock
@@ -719,9 +776,7 @@ func (b *BlockChain) connectBlock(node *blockNode, block *bchutil.Block,
 
 	// Generate a new best state snapshot that will be used to update the
 	// database and later memory if all database updates are successful.
-	b.stateLock.RLock()
-	curTotalTxns := b.stateSnapshot.TotalTxns
-	b.stateLock.RUnlock()
+	curTotalTxns := b.BestSnapshot().TotalTxns
 	numTxns := uint64(len(block.MsgBlock().Transactions))
 	blockSize := uint64(block.MsgBlock().SerializeSize())
 	state := newBestState(node, blockSize, numTxns,
@@ -730,6 +785,7 @@ func (b *BlockChain) connectBlock(node *blockNode, block *bchutil.Block,
 	b.ablaState = b.ablaState.nextABLAState(&b.ablaConfig, blockSize)
 
 	// Atomically insert info into the database.
+	start := time.Now()
 	err = b.db.Update(func(dbTx database.Tx) error {
 		// Update best block state.
 		err := dbPutBestState(dbTx, state, node.workSum)
@@ -757,6 +813,15 @@ func (b *BlockChain) connectBlock(node *blockNode, block *bchutil.Block,
 			return err
 		}
 
+		// Record the script flags the block was validated with.
+		if validatedFlags != nil {
+			err = dbPutBlockScriptFlags(dbTx, block.Hash(),
+				validatedFlags)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Allow the index manager to call each of the currently active
 		// optional indexes with the block being connected so they can
 		// update themselves accordingly.
@@ -780,31 +845,37 @@ func (b *BlockChain) connectBlock(node *blockNode, block *bchutil.Block,
 		log.Errorf("error committing block %s(%d) to utxo cache: %s", block.Hash(), block.Height(), err.Error())
 	}
 	b.stateLock.Unlock()
+	b.validationStats.record(block.Hash(), phaseIndexes, start)
 
 	// This node is now the end of the best chain.
+	b.indexLock.Lock()
 	b.bestChain.SetTip(node)
+	b.indexLock.Unlock()
 
 	// Update the state for the best block.  Notice how this replaces the
 	// entire struct instead of updating the existing one.  This effectively
 	// allows the old version to act as a snapshot which callers can use
 	// freely without needing to hold a lock for the duration.  See the
 	// comments on the state variable for more details.
-	b.stateLock.Lock()
-	b.stateSnapshot = state
-	b.stateLock.Unlock()
+	b.setBestState(state)
 
 	// Notify the caller that the block was connected to the main chain.
 	// The caller would typically want to react with actions such as
 	// updating wallets.
+	start = time.Now()
 	b.notificationLock.Lock()
 	b.chainLock.Unlock()
-	b.sendNotification(NTBlockConnected, block)
+	b.sendNotification(&notification{typ: ntBlockConnected, block: block})
 	b.chainLock.Lock()
 	b.notificationLock.Unlock()
+	b.validationStats.record(block.Hash(), phaseNotify, start)
 
 	b.stateLock.Lock()
 	defer b.stateLock.Unlock()
 
+	start = time.Now()
+	defer b.validationStats.record(block.Hash(), phaseFlush, start)
+
 	// Prune the blockchain if we're in prune mode.
 	if b.pruneMode {
 		if err := b.prune(); err != nil {
@@ -857,9 +928,7 @@ func (b *BlockChain) disconnectBlock(node *blockNode, block *bchutil.Block, view
 
 	// Generate a new best state snapshot that will be used to update the
 	// database and later memory if all database updates are successful.
-	b.stateLock.RLock()
-	curTotalTxns := b.stateSnapshot.TotalTxns
-	b.stateLock.RUnlock()
+	curTotalTxns := b.BestSnapshot().TotalTxns
 	numTxns := uint64(len(prevBlock.MsgBlock().Transactions))
 	blockSize := uint64(prevBlock.MsgBlock().SerializeSize())
 	newTotalTxns := curTotalTxns - uint64(len(block.MsgBlock().Transactions))
@@ -935,23 +1004,23 @@ func (b *BlockChain) disconnectBlock(node *blockNode, block *bchutil.Block, view
 	b.stateLock.Unlock()
 
 	// This node's parent is now the end of the best chain.
+	b.indexLock.Lock()
 	b.bestChain.SetTip(node.parent)
+	b.indexLock.Unlock()
 
 	// Update the state for the best block.  Notice how this replaces the
 	// entire struct instead of updating the existing one.  This effectively
 	// allows the old version to act as a snapshot which callers can use
 	// freely without needing to hold a lock for the duration.  See the
 	// comments on the state variable for more details.
-	b.stateLock.Lock()
-	b.stateSnapshot = state
-	b.stateLock.Unlock()
+	b.setBestState(state)
 
 	// Notify the caller that the block was disconnected from the main
 	// chain.  The caller would typically want to react with actions such as
 	// updating wallets.
 	b.notificationLock.Lock()
 	b.chainLock.Unlock()
-	b.sendNotification(NTBlockDisconnected, block)
+	b.sendNotification(&notification{typ: ntBlockDisconnected, block: block})
 	b.chainLock.Lock()
 	b.notificationLock.Unlock()
 
@@ -1029,6 +1098,11 @@ func (b *BlockChain) reorganizeChain(detachNodes, attachNodes *list.List) error
 	detachSpentTxOuts := make([][]SpentTxOut, 0, detachNodes.Len())
 	attachBlocks := make([]*bchutil.Block, 0, attachNodes.Len())
 
+	// The script flags the blocks to attach are validated with are recorded
+	// when they are connected.  They are nil for the blocks which were
+	// already validated.
+	attachFlags := make([]*BlockScriptFlags, 0, attachNodes.Len())
+
 	// Disconnect all of the blocks back to the point of the fork.  This
 	// entails loading the blocks and their associated spent txos from the
 	// database and using that information to unspend all of the spent txos
@@ -1115,6 +1189,7 @@ func (b *BlockChain) reorganizeChain(detachNodes, attachNodes *list.List) error
 
 		// Store the loaded block for later.
 		attachBlocks = append(attachBlocks, block)
+		attachFlags = append(attachFlags, nil)
 
 		// Skip checks if node has already been fully validated. Although
 		// checkConnectBlock gets skipped, we still need to update the UTXO
@@ -1141,10 +1216,13 @@ func (b *BlockChain) reorganizeChain(detachNodes, attachNodes *list.List) error
 		// In the case the block is determined to be invalid due to a
 		// rule violation, mark it as invalid and mark all of its
 		// descendants as having an invalid ancestor.
-		err = b.checkConnectBlock(n, block, view, nil)
+		validatedFlags := new(BlockScriptFlags)
+		err = b.checkConnectBlock(n, block, view, nil, validatedFlags)
 		if err != nil {
 			if _, ok := err.(RuleError); ok {
 				b.index.SetStatusFlags(n, statusValidateFailed)
+				b.recordInvalidBlock(&n.hash, &n.parent.hash,
+					n.height, err.Error())
 				for de := e.Next(); de != nil; de = de.Next() {
 					dn := de.Value.(*blockNode)
 					b.index.SetStatusFlags(dn, statusInvalidAncestor)
@@ -1153,6 +1231,7 @@ func (b *BlockChain) reorganizeChain(detachNodes, attachNodes *list.List) error
 			return err
 		}
 		b.index.SetStatusFlags(n, statusValid)
+		attachFlags[len(attachFlags)-1] = validatedFlags
 
 		newBest = n
 	}
@@ -1213,7 +1292,7 @@ func (b *BlockChain) reorganizeChain(detachNodes, attachNodes *list.List) error
 		}
 
 		// Update the database and chain state.
-		err = b.connectBlock(n, block, view, stxos)
+		err = b.connectBlock(n, block, view, stxos, attachFlags[i])
 		if err != nil {
 			return err
 		}
@@ -1223,7 +1302,7 @@ func (b *BlockChain) reorganizeChain(detachNodes, attachNodes *list.List) error
 		// to downstream peers.
 		b.notificationLock.Lock()
 		b.chainLock.Unlock()
-		b.sendNotification(NTBlockAccepted, block)
+		b.sendNotification(&notification{typ: ntBlockAccepted, block: block})
 		b.chainLock.Lock()
 		b.notificationLock.Unlock()
 	}
@@ -1239,6 +1318,28 @@ func (b *BlockChain) reorganizeChain(detachNodes, attachNodes *list.List) error
 	log.Infof("REORGANIZE: New best chain head is %v (height %v)",
 		newBest.hash, newBest.height)
 
+	// Record the reorganization in the reorg log so it can be audited
+	// later.  The chain is already reorganized at this point, so failing
+	// to log it is not fatal.
+	if detachNodes.Len() > 0 {
+		fork := detachNodes.Back().Value.(*blockNode).parent
+		entry := newReorgLogEntry(oldBest, fork, newBest, detachBlocks,
+			attachBlocks)
+		err := b.db.Update(func(dbTx database.Tx) error {
+			return dbPutReorgLogEntry(dbTx, entry)
+		})
+		if err != nil {
+			log.Warnf("Unable to log the reorganization: %v", err)
+		}
+
+		// Notify the caller that the reorganization is complete.
+		b.notificationLock.Lock()
+		b.chainLock.Unlock()
+		b.sendNotification(&notification{typ: ntReorg, reorg: entry})
+		b.chainLock.Lock()
+		b.notificationLock.Unlock()
+	}
+
 	return nil
 }
 
@@ -1282,12 +1383,17 @@ func (b *BlockChain) connectBestChain(node *blockNode, block *bchutil.Block, fla
 		// actually connecting the block.
 		view := NewUtxoViewpoint()
 		stxos := make([]SpentTxOut, 0, countSpentOutputs(block))
+		var validatedFlags *BlockScriptFlags
 		if !fastAdd {
-			err := b.checkConnectBlock(node, block, view, &stxos)
+			validatedFlags = new(BlockScriptFlags)
+			err := b.checkConnectBlock(node, block, view, &stxos,
+				validatedFlags)
 			if err == nil {
 				b.index.SetStatusFlags(node, statusValid)
 			} else if _, ok := err.(RuleError); ok {
 				b.index.SetStatusFlags(node, statusValidateFailed)
+				b.recordInvalidBlock(&node.hash,
+					&node.parent.hash, node.height, err.Error())
 			} else {
 				return false, err
 			}
@@ -1304,10 +1410,12 @@ func (b *BlockChain) connectBestChain(node *blockNode, block *bchutil.Block, fla
 		// utxos, spend them, and add the new utxos being created by
 		// this block.
 		if fastAdd {
+			start := time.Now()
 			err := view.addInputUtxos(b.utxoCache, block, true)
 			if err != nil {
 				return false, err
 			}
+			b.validationStats.record(block.Hash(), phaseFetchInputs, start)
 			err = connectTransactions(view, block, &stxos, false)
 			if err != nil {
 				return false, err
@@ -1315,7 +1423,7 @@ func (b *BlockChain) connectBestChain(node *blockNode, block *bchutil.Block, fla
 		}
 
 		// Connect the block to the main chain.
-		err := b.connectBlock(node, block, view, stxos)
+		err := b.connectBlock(node, block, view, stxos, validatedFlags)
 		if err != nil {
 			// If we got hit with a rule error, then we'll mark
 			// that status of the block as invalid and flush the
@@ -1324,6 +1432,8 @@ func (b *BlockChain) connectBestChain(node *blockNode, block *bchutil.Block, fla
 				b.index.SetStatusFlags(
 					node, statusValidateFailed,
 				)
+				b.recordInvalidBlock(&node.hash,
+					&node.parent.hash, node.height, err.Error())
 			}
 
 			flushIndexState()
@@ -1394,7 +1504,8 @@ func (b *BlockChain) connectBestChain(node *blockNode, block *bchutil.Block, fla
 //   - Latest block height is after the latest checkpoint (if enabled)
 //   - Latest block has a timestamp newer than 24 hours ago
 //
-// This function MUST be called with the chain state lock held (for reads).
+// This function MUST be called with either the chain state lock or the index
+// lock held (for reads).
 func (b *BlockChain) isCurrent() bool {
 	// Not current if the latest main (best) chain height is before the
 	// latest known good checkpoint (when checkpoints are enabled).
@@ -1420,8 +1531,8 @@ func (b *BlockChain) isCurrent() bool {
 //
 // This function is safe for concurrent access.
 func (b *BlockChain) IsCurrent() bool {
-	b.chainLock.RLock()
-	defer b.chainLock.RUnlock()
+	b.indexLock.RLock()
+	defer b.indexLock.RUnlock()
 
 	return b.isCurrent()
 }
@@ -1432,10 +1543,33 @@ func (b *BlockChain) IsCurrent() bool {
 //
 // This function is safe for concurrent access.
 func (b *BlockChain) BestSnapshot() *BestState {
-	b.stateLock.RLock()
-	snapshot := b.stateSnapshot
-	b.stateLock.RUnlock()
-	return snapshot
+	return b.stateSnapshot.Load()
+}
+
+// setBestState fills in the fields of the passed best state which depend on
+// the chain state and atomically replaces the best state snapshot with it.
+//
+// This function MUST be called with the chain state lock held (for writes).
+func (b *BlockChain) setBestState(state *BestState) {
+	state.Difficulty = CalcDifficultyRatio(state.Bits, b.chainParams)
+	nextHeight := state.Height + 1
+	state.BlockSizeLimit = b.MaxBlockSize(
+		nextHeight > b.chainParams.UahfForkHeight,
+		nextHeight > b.chainParams.ABLAForkHeight)
+	if node := b.index.LookupNode(&state.Hash); node != nil {
+		deployments := len(b.chainParams.Deployments)
+		state.DeploymentStates = make([]ThresholdState, deployments)
+		for id := 0; id < deployments; id++ {
+			deploymentState, err := b.deploymentState(node, uint32(id))
+			if err != nil {
+				log.Errorf("Unable to determine the state of "+
+					"deployment %d: %v", id, err)
+				continue
+			}
+			state.DeploymentStates[id] = deploymentState
+		}
+	}
+	b.stateSnapshot.Store(state)
 }
 
 // HeaderByHash returns the block header identified by the given hash or an
@@ -1451,6 +1585,21 @@ func (b *BlockChain) HeaderByHash(hash *chainhash.Hash) (wire.BlockHeader, error
 	return node.Header(), nil
 }
 
+// WorkSumByHash returns the total amount of work in the chain up to and
+// including the block identified by the given hash.  The block is not required
+// to be on the main chain.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) WorkSumByHash(hash *chainhash.Hash) (*big.Int, error) {
+	node := b.index.LookupNode(hash)
+	if node == nil {
+		err := fmt.Errorf("block %s is not known", hash)
+		return nil, err
+	}
+
+	return new(big.Int).Set(node.workSum), nil
+}
+
 // HeaderByHeight returns the block header identified by the given height or an
 // error if it doesn't exist. Note that this will return headers from the main
 // chain.
@@ -1473,6 +1622,20 @@ func (b *BlockChain) MainChainHasBlock(hash *chainhash.Hash) bool {
 	return node != nil && b.bestChain.Contains(node)
 }
 
+// IsAncestor returns whether the block with the passed ancestor hash is the
+// block with the passed hash or one of its ancestors.  The blocks are not
+// required to be on the main chain, but both must be known.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) IsAncestor(ancestorHash, hash *chainhash.Hash) bool {
+	ancestor := b.index.LookupNode(ancestorHash)
+	node := b.index.LookupNode(hash)
+	if ancestor == nil || node == nil {
+		return false
+	}
+	return node.Ancestor(ancestor.height) == ancestor
+}
+
 // BlockLocatorFromHash returns a block locator for the passed block hash.
 // See BlockLocator for details on the algorithm used to create a block locator.
 //
@@ -1482,10 +1645,10 @@ func (b *BlockChain) MainChainHasBlock(hash *chainhash.Hash) bool {
 //
 // This function is safe for concurrent access.
 func (b *BlockChain) BlockLocatorFromHash(hash *chainhash.Hash) BlockLocator {
-	b.chainLock.RLock()
+	b.indexLock.RLock()
 	node := b.index.LookupNode(hash)
 	locator := b.bestChain.blockLocator(node)
-	b.chainLock.RUnlock()
+	b.indexLock.RUnlock()
 	return locator
 }
 
@@ -1494,9 +1657,9 @@ func (b *BlockChain) BlockLocatorFromHash(hash *chainhash.Hash) BlockLocator {
 //
 // This function is safe for concurrent access.
 func (b *BlockChain) LatestBlockLocator() (BlockLocator, error) {
-	b.chainLock.RLock()
+	b.indexLock.RLock()
 	locator := b.bestChain.BlockLocator(nil)
-	b.chainLock.RUnlock()
+	b.indexLock.RUnlock()
 	return locator, nil
 }
 
@@ -1684,7 +1847,8 @@ func (b *BlockChain) IntervalBlockHashes(endHash *chainhash.Hash, interval int,
 // This is primarily a helper function for the locateBlocks and locateHeaders
 // functions.
 //
-// This function MUST be called with the chain state lock held (for reads).
+// This function MUST be called with either the chain state lock or the index
+// lock held (for reads).
 func (b *BlockChain) locateInventory(locator BlockLocator, hashStop *chainhash.Hash, maxEntries uint32, headersOnly bool) (*blockNode, uint32) {
 	// There are no block locators so a specific block is being requested
 	// as identified by the stop hash.
@@ -1748,7 +1912,8 @@ func (b *BlockChain) locateInventory(locator BlockLocator, hashStop *chainhash.H
 //
 // See the comment on the exported function for more details on special cases.
 //
-// This function MUST be called with the chain state lock held (for reads).
+// This function MUST be called with either the chain state lock or the index
+// lock held (for reads).
 func (b *BlockChain) locateBlocks(locator BlockLocator, hashStop *chainhash.Hash, maxHashes uint32) []chainhash.Hash {
 	// Find the node after the first known block in the locator and the
 	// total number of nodes after it needed while respecting the stop hash
@@ -1781,9 +1946,9 @@ func (b *BlockChain) locateBlocks(locator BlockLocator, hashStop *chainhash.Hash
 //
 // This function is safe for concurrent access.
 func (b *BlockChain) LocateBlocks(locator BlockLocator, hashStop *chainhash.Hash, maxHashes uint32) []chainhash.Hash {
-	b.chainLock.RLock()
+	b.indexLock.RLock()
 	hashes := b.locateBlocks(locator, hashStop, maxHashes)
-	b.chainLock.RUnlock()
+	b.indexLock.RUnlock()
 	return hashes
 }
 
@@ -1793,7 +1958,8 @@ func (b *BlockChain) LocateBlocks(locator BlockLocator, hashStop *chainhash.Hash
 //
 // See the comment on the exported function for more details on special cases.
 //
-// This function MUST be called with the chain state lock held (for reads).
+// This function MUST be called with either the chain state lock or the index
+// lock held (for reads).
 func (b *BlockChain) locateHeaders(locator BlockLocator, hashStop *chainhash.Hash, maxHeaders uint32) []wire.BlockHeader {
 	// Find the node after the first known block in the locator and the
 	// total number of nodes after it needed while respecting the stop hash
@@ -1840,6 +2006,8 @@ func (b *BlockChain) invalidateBlock(hash *chainhash.Hash) error {
 
 	b.index.SetStatusFlags(node, statusValidateFailed)
 	b.index.UnsetStatusFlags(node, statusValid)
+	b.recordInvalidBlock(hash, &node.parent.hash, node.height,
+		"invalidated manually")
 
 	b.chainLock.Lock()
 	defer b.chainLock.Unlock()
@@ -1875,6 +2043,12 @@ func (b *BlockChain) ReconsiderBlock(hash *chainhash.Hash) error {
 func (b *BlockChain) reconsiderBlock(hash *chainhash.Hash) error {
 	node := b.index.LookupNode(hash)
 	if node == nil {
+		// Blocks rejected before they were added to the block index
+		// are only known to the invalid block cache.  They are
+		// validated again once they are offered again.
+		if b.forgetInvalidBlock(hash) {
+			return nil
+		}
 		err := fmt.Errorf("block %s is not known", hash)
 		return err
 	}
@@ -1893,6 +2067,7 @@ func (b *BlockChain) reconsiderBlock(hash *chainhash.Hash) error {
 	for n := node; n.status.KnownInvalid(); n = n.parent {
 		b.index.UnsetStatusFlags(n, statusInvalidAncestor)
 		b.index.UnsetStatusFlags(n, statusValidateFailed)
+		b.forgetInvalidBlock(&n.hash)
 
 		firstNode = n
 	}
@@ -2119,9 +2294,9 @@ func (b *BlockChain) ReIndexChainState() error {
 //
 // This function is safe for concurrent access.
 func (b *BlockChain) LocateHeaders(locator BlockLocator, hashStop *chainhash.Hash) []wire.BlockHeader {
-	b.chainLock.RLock()
+	b.indexLock.RLock()
 	headers := b.locateHeaders(locator, hashStop, wire.MaxBlockHeadersPerMsg)
-	b.chainLock.RUnlock()
+	b.indexLock.RUnlock()
 	return headers
 }
 
@@ -2217,6 +2392,14 @@ type Config struct {
 	// signature cache.
 	HashCache *txscript.HashCache
 
+	// ScriptFlagOverrides forcibly enables or disables script flags on top
+	// of the flags selected by the consensus rules when validating blocks.
+	// It must only be used on test networks to test the behavior of
+	// upgrades.
+	//
+	// This field can be left empty to use the consensus flags.
+	ScriptFlagOverrides txscript.ScriptFlagOverrides
+
 	// ExcessiveBlockSize is the user-configurable max block size
 	ExcessiveBlockSize uint32
 
@@ -2330,7 +2513,11 @@ func New(config *Config) (*BlockChain, error) {
 		blocksPerRetarget:   int32(targetTimespan / targetTimePerBlock),
 		index:               newBlockIndex(config.DB, params),
 		utxoCache:           newUtxoCache(config.DB, config.UtxoCacheMaxSize),
+		pipeline:            newBlockPipeline(),
+		validationStats:     newValidationStats(maxValidationStats),
+		invalidBlocks:       newInvalidBlockCache(),
 		hashCache:           config.HashCache,
+		scriptFlagOverrides: config.ScriptFlagOverrides,
 		bestChain:           newChainView(nil),
 		orphans:             make(map[chainhash.Hash]*orphanBlock),
 		prevOrphans:         make(map[chainhash.Hash][]*orphanBlock),
@@ -2349,6 +2536,11 @@ func New(config *Config) (*BlockChain, error) {
 		return nil, err
 	}
 
+	// Load the blocks rejected for violating the consensus rules.
+	if err := b.invalidBlocks.load(b.db); err != nil {
+		return nil, err
+	}
+
 	bestNode := b.bestChain.Tip()
 	lastCheckpoint := b.LatestCheckpoint()
 	config.FastSync = config.FastSync && lastCheckpoint != nil && bestNode.height <= lastCheckpoint.Height
@@ -2428,7 +2620,7 @@ func New(config *Config) (*BlockChain, error) {
 	}
 
 	log.Infof("Chain state (height %d, hash %v, totaltx %d, work %v)",
-		bestNode.height, bestNode.hash, b.stateSnapshot.TotalTxns,
+		bestNode.height, bestNode.hash, b.BestSnapshot().TotalTxns,
 		bestNode.workSum)
 
 	return &b, nil
@@ -2440,6 +2632,15 @@ func (b *BlockChain) CachedStateSize() uint64 {
 	return b.utxoCache.TotalMemoryUsage()
 }
 
+// SetUtxoCacheMaxSize changes the maximum size in bytes of the utxo cache.  A
+// cache exceeding the new maximum is flushed the next time the chain state
+// is flushed when needed.
+//
+// This method is safe for concurrent access.
+func (b *BlockChain) SetUtxoCacheMaxSize(maxSize uint64) {
+	b.utxoCache.setMaxTotalMemoryUsage(maxSize)
+}
+
 // FlushCachedState flushes all the cached state of the blockchain to the
 // database.
 //
@@ -2447,5 +2648,5 @@ func (b *BlockChain) CachedStateSize() uint64 {
 func (b *BlockChain) FlushCachedState(mode FlushMode) error {
 	b.chainLock.Lock()
 	defer b.chainLock.Unlock()
-	return b.utxoCache.Flush(mode, b.stateSnapshot)
+	return b.utxoCache.Flush(mode, b.BestSnapshot())
 }
@@ -970,3 +970,155 @@ func TestIntervalBlockHashes(t *testing.T) {
 		}
 	}
 }
+
+// TestIndexQueriesWithoutChainLock ensures the header and index queries only
+// depend on the index lock so they don't wait for blocks being processed while
+// the chain lock is held.
+func TestIndexQueriesWithoutChainLock(t *testing.T) {
+	chain := newFakeChain(&chaincfg.MainNetParams)
+	nodes := chainedNodes(chain.bestChain.Genesis(), 10)
+	for _, node := range nodes {
+		chain.index.AddNode(node)
+	}
+	tip := nodes[len(nodes)-1]
+	chain.bestChain.SetTip(tip)
+
+	chain.chainLock.Lock()
+	defer chain.chainLock.Unlock()
+
+	done := make(chan []wire.BlockHeader)
+	go func() {
+		chain.IsCurrent()
+		chain.LatestBlockLocator()
+		chain.BlockLocatorFromHash(&tip.hash)
+		chain.LocateBlocks(nil, &tip.hash, 1)
+		chain.CalcNextRequiredDifficulty(time.Now())
+		done <- chain.LocateHeaders(nil, &tip.hash)
+	}()
+	select {
+	case headers := <-done:
+		if len(headers) != 1 || headers[0].BlockHash() != tip.hash {
+			t.Fatalf("unexpected headers -- got %v, want the tip",
+				headers)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("index queries blocked on the chain lock")
+	}
+
+	// Changing the tip requires the index lock, so the queries must wait
+	// while it's held for writes.
+	chain.indexLock.Lock()
+	locatorDone := make(chan struct{})
+	go func() {
+		chain.LatestBlockLocator()
+		close(locatorDone)
+	}()
+	select {
+	case <-locatorDone:
+		t.Fatal("index query did not wait for the index lock")
+	case <-time.After(50 * time.Millisecond):
+	}
+	chain.indexLock.Unlock()
+	<-locatorDone
+}
+
+// TestBestSnapshotWithoutLocks ensures the best state snapshot describes the
+// tip of the chain and can be queried while the chain state is locked, such as
+// while a block is being connected or the utxo cache is being flushed.
+func TestBestSnapshotWithoutLocks(t *testing.T) {
+	params := chaincfg.RegressionNetParams
+	chain, teardownFunc, err := chainSetup("bestsnapshot", &params)
+	if err != nil {
+		t.Fatalf("Failed to setup chain instance: %v", err)
+	}
+	defer teardownFunc()
+
+	chain.chainLock.Lock()
+	chain.stateLock.Lock()
+	defer chain.chainLock.Unlock()
+	defer chain.stateLock.Unlock()
+
+	done := make(chan *BestState)
+	go func() {
+		done <- chain.BestSnapshot()
+	}()
+	var snapshot *BestState
+	select {
+	case snapshot = <-done:
+	case <-time.After(time.Second):
+		t.Fatal("BestSnapshot blocked on the chain state locks")
+	}
+
+	if snapshot.Hash != *params.GenesisHash || snapshot.Height != 0 {
+		t.Fatalf("unexpected best block -- got %v (%d), want genesis",
+			snapshot.Hash, snapshot.Height)
+	}
+	wantDifficulty := CalcDifficultyRatio(params.GenesisBlock.Header.Bits,
+		&params)
+	if snapshot.Difficulty != wantDifficulty {
+		t.Fatalf("unexpected difficulty -- got %v, want %v",
+			snapshot.Difficulty, wantDifficulty)
+	}
+	wantLimit := chain.MaxBlockSize(1 > params.UahfForkHeight,
+		1 > params.ABLAForkHeight)
+	if snapshot.BlockSizeLimit != wantLimit {
+		t.Fatalf("unexpected block size limit -- got %d, want %d",
+			snapshot.BlockSizeLimit, wantLimit)
+	}
+	if len(snapshot.DeploymentStates) != len(params.Deployments) {
+		t.Fatalf("unexpected number of deployment states -- got %d, "+
+			"want %d", len(snapshot.DeploymentStates),
+			len(params.Deployments))
+	}
+	for id, state := range snapshot.DeploymentStates {
+		want, err := chain.deploymentState(chain.bestChain.Tip(),
+			uint32(id))
+		if err != nil {
+			t.Fatalf("deploymentState: unexpected error: %v", err)
+		}
+		if state != want {
+			t.Fatalf("unexpected state of deployment %d -- got %v, "+
+				"want %v", id, state, want)
+		}
+	}
+}
+
+// TestIsAncestor ensures blocks are only reported as ancestors of the blocks of
+// their own branch.
+func TestIsAncestor(t *testing.T) {
+	// Construct a synthetic block chain with a block index consisting of
+	// the following structure.
+	// 	genesis -> 1 -> 2 -> 3 -> 4
+	// 	                    \-> 3a
+	chain := newFakeChain(&chaincfg.MainNetParams)
+	branch0Nodes := chainedNodes(chain.bestChain.Genesis(), 4)
+	branch1Nodes := chainedNodes(branch0Nodes[1], 1)
+	for _, node := range branch0Nodes {
+		chain.index.AddNode(node)
+	}
+	for _, node := range branch1Nodes {
+		chain.index.AddNode(node)
+	}
+	chain.bestChain.SetTip(tstTip(branch0Nodes))
+	unknown := chainedNodes(nil, 1)[0]
+
+	tests := []struct {
+		name     string
+		ancestor *blockNode
+		node     *blockNode
+		want     bool
+	}{
+		{"genesis of tip", chain.bestChain.Genesis(), branch0Nodes[3], true},
+		{"parent of side chain", branch0Nodes[1], branch1Nodes[0], true},
+		{"same block", branch0Nodes[2], branch0Nodes[2], true},
+		{"descendant", branch0Nodes[3], branch0Nodes[1], false},
+		{"other branch", branch0Nodes[2], branch1Nodes[0], false},
+		{"unknown block", unknown, branch0Nodes[3], false},
+	}
+	for _, test := range tests {
+		got := chain.IsAncestor(&test.ancestor.hash, &test.node.hash)
+		if got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
@@ -1294,8 +1294,9 @@ func (b *BlockChain) createChainState() error {
 	// genesis block, use its timestamp for the median time.
 	numTxns := uint64(len(genesisBlock.MsgBlock().Transactions))
 	blockSize := uint64(genesisBlock.MsgBlock().SerializeSize())
-	b.stateSnapshot = newBestState(node, blockSize, numTxns,
-		numTxns, time.Unix(node.timestamp, 0))
+	state := newBestState(node, blockSize, numTxns, numTxns,
+		time.Unix(node.timestamp, 0))
+	b.setBestState(state)
 
 	// Create the initial the database chain state including creating the
 	// necessary index buckets and inserting the genesis block.
@@ -1369,7 +1370,7 @@ func (b *BlockChain) createChainState() error {
 		}
 
 		// Store the current best chain state into the database.
-		err = dbPutBestState(dbTx, b.stateSnapshot, node.workSum)
+		err = dbPutBestState(dbTx, state, node.workSum)
 		if err != nil {
 			return err
 		}
@@ -1532,8 +1533,8 @@ func (b *BlockChain) initChainState(fastSync bool) error {
 		// Initialize the state related to the best block.
 		blockSize := uint64(len(blockBytes))
 		numTxns := uint64(len(block.Transactions))
-		b.stateSnapshot = newBestState(tip, blockSize, numTxns,
-			state.totalTxns, tip.CalcPastMedianTime())
+		b.setBestState(newBestState(tip, blockSize, numTxns,
+			state.totalTxns, tip.CalcPastMedianTime()))
 
 		return nil
 	})
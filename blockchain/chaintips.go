@@ -0,0 +1,176 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"github.com/gcash/bchd/chaincfg/chainhash"
+)
+
+// ChainTipStatus describes the validation state of the branch ending at a
+// chain tip.
+type ChainTipStatus int
+
+// These constants define the validation states of a chain tip.
+const (
+	// ChainTipActive indicates the tip is the tip of the main chain.
+	ChainTipActive ChainTipStatus = iota
+
+	// ChainTipValidFork indicates the branch was fully validated but is
+	// not part of the main chain.
+	ChainTipValidFork
+
+	// ChainTipValidHeaders indicates the blocks of the branch are stored
+	// but were not all fully validated.
+	ChainTipValidHeaders
+
+	// ChainTipHeadersOnly indicates not all blocks of the branch are
+	// stored.
+	ChainTipHeadersOnly
+
+	// ChainTipInvalid indicates the branch contains a block which failed
+	// validation.
+	ChainTipInvalid
+)
+
+// chainTipStatusStrings maps the chain tip statuses to the names used by the
+// getchaintips RPC.
+var chainTipStatusStrings = map[ChainTipStatus]string{
+	ChainTipActive:       "active",
+	ChainTipValidFork:    "valid-fork",
+	ChainTipValidHeaders: "valid-headers",
+	ChainTipHeadersOnly:  "headers-only",
+	ChainTipInvalid:      "invalid",
+}
+
+// String returns the ChainTipStatus as a human-readable name.
+func (s ChainTipStatus) String() string {
+	if str, ok := chainTipStatusStrings[s]; ok {
+		return str
+	}
+	return "unknown"
+}
+
+// ChainTip describes the tip of a branch of the block tree.
+type ChainTip struct {
+	Hash   chainhash.Hash
+	Height int32
+
+	// BranchLen is the number of blocks of the branch which are not part
+	// of the main chain.  It is zero for the tip of the main chain.
+	BranchLen int32
+
+	Status ChainTipStatus
+
+	// InvalidBlock is the first block of an invalid branch which failed
+	// validation along with the reason when it is known.  It is nil for
+	// other branches.
+	InvalidBlock *InvalidBlock
+}
+
+// ChainTips returns the tips of all branches of the block tree, which are the
+// blocks without children and the tip of the main chain, along with the blocks
+// which were rejected before they were added to the block tree.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) ChainTips() []*ChainTip {
+	b.chainLock.RLock()
+	defer b.chainLock.RUnlock()
+
+	tipNodes := b.index.Tips()
+	best := b.bestChain.Tip()
+	bestIsTip := false
+	for _, node := range tipNodes {
+		if node == best {
+			bestIsTip = true
+			break
+		}
+	}
+	if !bestIsTip {
+		tipNodes = append(tipNodes, best)
+	}
+
+	tips := make([]*ChainTip, 0, len(tipNodes))
+	for _, node := range tipNodes {
+		fork := b.bestChain.FindFork(node)
+		tip := &ChainTip{
+			Hash:   node.hash,
+			Height: node.height,
+		}
+		if fork != nil {
+			tip.BranchLen = node.height - fork.height
+		}
+		status := b.index.NodeStatus(node)
+		switch {
+		case node == best:
+			tip.Status = ChainTipActive
+		case status.KnownInvalid():
+			tip.Status = ChainTipInvalid
+			tip.InvalidBlock = b.firstInvalidBlock(node)
+		case !b.branchHasData(node, fork):
+			tip.Status = ChainTipHeadersOnly
+		case status.KnownValid():
+			tip.Status = ChainTipValidFork
+		default:
+			tip.Status = ChainTipValidHeaders
+		}
+		tips = append(tips, tip)
+	}
+
+	// Blocks which failed the contextual checks are only known to the
+	// invalid block cache.
+	for _, invalid := range b.invalidBlocks.all() {
+		if b.index.HaveBlock(&invalid.Hash) {
+			continue
+		}
+		tip := &ChainTip{
+			Hash:         invalid.Hash,
+			Height:       invalid.Height,
+			Status:       ChainTipInvalid,
+			InvalidBlock: invalid,
+		}
+		if parent := b.index.LookupNode(&invalid.PrevHash); parent != nil {
+			if fork := b.bestChain.FindFork(parent); fork != nil {
+				tip.BranchLen = invalid.Height - fork.height
+			}
+		}
+		tips = append(tips, tip)
+	}
+	return tips
+}
+
+// firstInvalidBlock returns the details of the earliest block which failed
+// validation among the passed node and its ancestors, or nil when they are not
+// known.
+//
+// This function MUST be called with the chain state lock held (for reads).
+func (b *BlockChain) firstInvalidBlock(node *blockNode) *InvalidBlock {
+	var failed *blockNode
+	for n := node; n != nil; n = n.parent {
+		status := b.index.NodeStatus(n)
+		if !status.KnownInvalid() {
+			break
+		}
+		if status&statusValidateFailed != 0 {
+			failed = n
+		}
+	}
+	if failed == nil {
+		return nil
+	}
+	return b.invalidBlocks.lookup(&failed.hash)
+}
+
+// branchHasData returns whether the blocks from the passed node back to the
+// passed fork point are all stored.
+//
+// This function MUST be called with the chain state lock held (for reads).
+func (b *BlockChain) branchHasData(node, fork *blockNode) bool {
+	for n := node; n != nil && n != fork; n = n.parent {
+		if !b.index.NodeStatus(n).HaveData() {
+			return false
+		}
+	}
+	return true
+}
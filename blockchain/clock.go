@@ -0,0 +1,171 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock provides the current time and tickers to the time-dependent subsystems
+// such as the median time source, the mempool and the peers, so tests and test
+// networks can control the passage of time.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// NewTicker returns a new ticker which delivers the time on its channel
+	// at the passed interval.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker delivers the time at regular intervals on its channel.
+type Ticker interface {
+	// Chan returns the channel the ticks are delivered on.
+	Chan() <-chan time.Time
+
+	// Stop turns off the ticker.  No more ticks are delivered once it
+	// returns.
+	Stop()
+}
+
+// SystemClock is the Clock backed by the system clock.
+var SystemClock Clock = systemClock{}
+
+// systemClock implements the Clock interface with the system clock.
+type systemClock struct{}
+
+// Now returns the current system time.
+//
+// This is part of the Clock interface implementation.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// NewTicker returns a ticker backed by a time.Ticker.
+//
+// This is part of the Clock interface implementation.
+func (systemClock) NewTicker(d time.Duration) Ticker {
+	return systemTicker{time.NewTicker(d)}
+}
+
+// systemTicker implements the Ticker interface with a time.Ticker.
+type systemTicker struct {
+	*time.Ticker
+}
+
+// Chan returns the channel of the time.Ticker.
+//
+// This is part of the Ticker interface implementation.
+func (t systemTicker) Chan() <-chan time.Time {
+	return t.C
+}
+
+// MockClock is a Clock whose time only changes when it is set or advanced,
+// which fires the tickers whose interval elapsed.  It allows the behavior
+// depending on the passage of time, such as expiration and rate limiting, to be
+// tested deterministically.
+type MockClock struct {
+	mtx     sync.Mutex
+	now     time.Time
+	tickers map[*mockTicker]struct{}
+}
+
+// Ensure the MockClock type implements the Clock interface.
+var _ Clock = (*MockClock)(nil)
+
+// NewMockClock returns a new mock clock set to the passed time.
+func NewMockClock(now time.Time) *MockClock {
+	return &MockClock{
+		now:     now,
+		tickers: make(map[*mockTicker]struct{}),
+	}
+}
+
+// Now returns the time the clock is set to.
+//
+// This function is safe for concurrent access and is part of the Clock
+// interface implementation.
+func (c *MockClock) Now() time.Time {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.now
+}
+
+// NewTicker returns a ticker which fires as the clock is advanced past each
+// multiple of the passed interval.
+//
+// This function is safe for concurrent access and is part of the Clock
+// interface implementation.
+func (c *MockClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for MockClock.NewTicker")
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	t := &mockTicker{
+		clock:  c,
+		c:      make(chan time.Time, 1),
+		period: d,
+		next:   c.now.Add(d),
+	}
+	c.tickers[t] = struct{}{}
+	return t
+}
+
+// Set sets the clock to the passed time and fires the tickers which are due.
+// Like a time.Ticker, a ticker whose channel isn't drained drops the ticks.
+//
+// This function is safe for concurrent access.
+func (c *MockClock) Set(now time.Time) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.now = now
+	for t := range c.tickers {
+		for !t.next.After(now) {
+			select {
+			case t.c <- t.next:
+			default:
+			}
+			t.next = t.next.Add(t.period)
+		}
+	}
+}
+
+// Add advances the clock by the passed duration and fires the tickers which
+// are due.
+//
+// This function is safe for concurrent access.
+func (c *MockClock) Add(d time.Duration) {
+	c.Set(c.Now().Add(d))
+}
+
+// mockTicker implements the Ticker interface for a MockClock.
+type mockTicker struct {
+	clock  *MockClock
+	c      chan time.Time
+	period time.Duration
+	next   time.Time
+}
+
+// Chan returns the channel the ticks are delivered on.
+//
+// This is part of the Ticker interface implementation.
+func (t *mockTicker) Chan() <-chan time.Time {
+	return t.c
+}
+
+// Stop removes the ticker from its clock.
+//
+// This is part of the Ticker interface implementation.
+func (t *mockTicker) Stop() {
+	t.clock.mtx.Lock()
+	delete(t.clock.tickers, t)
+	t.clock.mtx.Unlock()
+}
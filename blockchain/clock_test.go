@@ -0,0 +1,95 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+// TestMockClock ensures the mock clock only advances when it is set or
+// advanced and fires the tickers as their intervals elapse.
+func TestMockClock(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	clock := NewMockClock(start)
+	if got := clock.Now(); !got.Equal(start) {
+		t.Fatalf("Now: unexpected time - got %v, want %v", got, start)
+	}
+
+	ticker := clock.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	// The ticker doesn't fire before its interval elapses.
+	clock.Add(time.Second * 59)
+	select {
+	case tick := <-ticker.Chan():
+		t.Fatalf("unexpected tick at %v", tick)
+	default:
+	}
+
+	// The ticker fires once its interval elapses.
+	clock.Add(time.Second)
+	want := start.Add(time.Minute)
+	select {
+	case tick := <-ticker.Chan():
+		if !tick.Equal(want) {
+			t.Fatalf("unexpected tick - got %v, want %v", tick, want)
+		}
+	default:
+		t.Fatal("ticker did not fire")
+	}
+
+	// Ticks are dropped while the channel isn't drained, so advancing the
+	// clock by several intervals only delivers the first tick.
+	clock.Add(time.Minute * 3)
+	want = start.Add(time.Minute * 2)
+	select {
+	case tick := <-ticker.Chan():
+		if !tick.Equal(want) {
+			t.Fatalf("unexpected tick - got %v, want %v", tick, want)
+		}
+	default:
+		t.Fatal("ticker did not fire")
+	}
+	select {
+	case tick := <-ticker.Chan():
+		t.Fatalf("unexpected tick at %v", tick)
+	default:
+	}
+
+	// A stopped ticker doesn't fire anymore.
+	ticker.Stop()
+	clock.Add(time.Hour)
+	select {
+	case tick := <-ticker.Chan():
+		t.Fatalf("unexpected tick after stop at %v", tick)
+	default:
+	}
+}
+
+// TestMedianTimeWithClock ensures the median time source adjusts the time of
+// the clock it was created with.
+func TestMedianTimeWithClock(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	clock := NewMockClock(now)
+	source := NewMedianTimeWithClock(clock)
+	if got := source.AdjustedTime(); !got.Equal(now) {
+		t.Fatalf("AdjustedTime: unexpected time - got %v, want %v", got,
+			now)
+	}
+
+	// Add enough samples two minutes ahead of the clock for them to be
+	// used as the offset.
+	for i := 0; i < 5; i++ {
+		source.AddTimeSample(strconv.Itoa(i), now.Add(time.Minute*2))
+	}
+	clock.Add(time.Hour)
+	want := now.Add(time.Hour + time.Minute*2)
+	if got := source.AdjustedTime(); !got.Equal(want) {
+		t.Fatalf("AdjustedTime: unexpected time - got %v, want %v", got,
+			want)
+	}
+}
@@ -7,8 +7,10 @@ package blockchain
 import (
 	"errors"
 	"math/big"
+	"strconv"
 	"time"
 
+	"github.com/gcash/bchd/chaincfg"
 	"github.com/gcash/bchd/chaincfg/chainhash"
 )
 
@@ -189,6 +191,25 @@ func BigToCompact(n *big.Int) uint32 {
 	return compact
 }
 
+// CalcDifficultyRatio returns the proof-of-work difficulty of the passed
+// compact target as a multiple of the minimum difficulty of the passed network,
+// rounded to 8 decimal places.
+func CalcDifficultyRatio(bits uint32, params *chaincfg.Params) float64 {
+	// The minimum difficulty is the max possible proof-of-work limit bits
+	// converted back to a number.  Note this is not the same as the proof of
+	// work limit directly because the block difficulty is encoded in a block
+	// with the compact form which loses precision.
+	max := CompactToBig(params.PowLimitBits)
+	target := CompactToBig(bits)
+
+	difficulty := new(big.Rat).SetFrac(max, target)
+	diff, err := strconv.ParseFloat(difficulty.FloatString(8), 64)
+	if err != nil {
+		return 0
+	}
+	return diff
+}
+
 // CalcWork calculates a work value from difficulty bits.  Bitcoin increases
 // the difficulty for generating a block by decreasing the value which the
 // generated hash must be less than.  This difficulty target is stored in each
@@ -531,10 +552,10 @@ func (b *BlockChain) calcLegacyRequiredDifficulty(lastNode *blockNode, newBlockT
 //
 // This function is safe for concurrent access.
 func (b *BlockChain) CalcNextRequiredDifficulty(timestamp time.Time) (uint32, error) {
-	b.chainLock.Lock()
+	b.indexLock.RLock()
 	tip := b.bestChain.Tip()
 	difficulty, err := b.calcNextRequiredDifficulty(tip, timestamp,
 		b.SelectDifficultyAdjustmentAlgorithm(tip))
-	b.chainLock.Unlock()
+	b.indexLock.RUnlock()
 	return difficulty, err
 }
@@ -226,6 +226,10 @@ const (
 
 	// ErrCashTokensValidation indicates the token data is invalid in some way
 	ErrCashTokensValidation
+
+	// ErrKnownInvalidBlock indicates the block was already rejected for
+	// violating the consensus rules.
+	ErrKnownInvalidBlock
 )
 
 // Map of ErrorCode values back to their constant names for pretty printing.
@@ -274,6 +278,7 @@ var errorCodeStrings = map[ErrorCode]string{
 	ErrTooManySigChecks:      "ErrTooManySigChecks",
 	ErrTxTooManySigChecks:    "ErrTxTooManySigChecks",
 	ErrCashTokensValidation:  "ErrCashTokensValidation",
+	ErrKnownInvalidBlock:     "ErrKnownInvalidBlock",
 }
 
 // String returns the ErrorCode as a human-readable name.
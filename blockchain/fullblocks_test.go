@@ -256,6 +256,7 @@ func TestFullBlocks(t *testing.T) {
 					item.Name, block.Hash(), blockHeight,
 					err)
 			}
+			return
 		}
 
 		if !isOrphan {
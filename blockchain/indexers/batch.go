@@ -0,0 +1,85 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"time"
+
+	"github.com/gcash/bchd/database"
+)
+
+const (
+	// maxBatchDuration is the maximum duration the updates made to the
+	// indexes are batched into a single database transaction before it is
+	// committed.
+	maxBatchDuration = time.Second
+
+	// maxBatchBlocks is the maximum number of blocks whose updates to the
+	// indexes are batched into a single database transaction, which bounds
+	// the memory held by the pending writes.
+	maxBatchBlocks = 500
+)
+
+// indexBatch batches the updates made to all of the indexes for consecutive
+// blocks into a single database transaction.  The transaction is committed
+// once its deadline passes or enough blocks were added to it, which avoids the
+// write amplification of committing a transaction per block and index while
+// the indexes catch up with the chain.
+type indexBatch struct {
+	db       database.DB
+	dbTx     database.Tx
+	deadline time.Time
+	blocks   int
+}
+
+// newIndexBatch returns a new batch of index updates for the passed database.
+func newIndexBatch(db database.DB) *indexBatch {
+	return &indexBatch{db: db}
+}
+
+// tx returns the database transaction of the batch, beginning a new one when
+// none is pending.
+func (b *indexBatch) tx() (database.Tx, error) {
+	if b.dbTx != nil {
+		return b.dbTx, nil
+	}
+	dbTx, err := b.db.Begin(true)
+	if err != nil {
+		return nil, err
+	}
+	b.dbTx = dbTx
+	b.deadline = time.Now().Add(maxBatchDuration)
+	b.blocks = 0
+	return dbTx, nil
+}
+
+// blockDone marks the updates made for a block as complete and commits the
+// batch when it is due.
+func (b *indexBatch) blockDone() error {
+	b.blocks++
+	if b.blocks < maxBatchBlocks && time.Now().Before(b.deadline) {
+		return nil
+	}
+	return b.commit()
+}
+
+// commit commits the pending database transaction of the batch, if any.
+func (b *indexBatch) commit() error {
+	if b.dbTx == nil {
+		return nil
+	}
+	dbTx := b.dbTx
+	b.dbTx = nil
+	return dbTx.Commit()
+}
+
+// rollback discards the pending database transaction of the batch, if any.
+func (b *indexBatch) rollback() {
+	if b.dbTx == nil {
+		return
+	}
+	_ = b.dbTx.Rollback()
+	b.dbTx = nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/database"
+	_ "github.com/gcash/bchd/database/ffldb"
+	"github.com/gcash/bchd/wire"
+)
+
+// TestIndexBatch ensures the updates made for the blocks in a batch are only
+// committed together once the batch is due or committed explicitly.
+func TestIndexBatch(t *testing.T) {
+	db, err := database.Create("ffldb", t.TempDir(), wire.MainNet)
+	if err != nil {
+		t.Fatalf("unable to create database: %v", err)
+	}
+	defer db.Close()
+
+	// put adds the passed key to the metadata through the batch and
+	// completes a block.
+	batch := newIndexBatch(db)
+	defer batch.rollback()
+	put := func(key byte) {
+		t.Helper()
+		dbTx, err := batch.tx()
+		if err != nil {
+			t.Fatalf("tx: %v", err)
+		}
+		if err := dbTx.Metadata().Put([]byte{key}, []byte{key}); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+		if err := batch.blockDone(); err != nil {
+			t.Fatalf("blockDone: %v", err)
+		}
+	}
+	committed := func(key byte) bool {
+		var exists bool
+		db.View(func(dbTx database.Tx) error {
+			exists = dbTx.Metadata().Get([]byte{key}) != nil
+			return nil
+		})
+		return exists
+	}
+
+	put(1)
+	put(2)
+	if committed(1) || committed(2) {
+		t.Fatal("batch committed before it was due")
+	}
+	if err := batch.commit(); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+	if !committed(1) || !committed(2) {
+		t.Fatal("batch was not committed")
+	}
+
+	// The batch is committed once enough blocks were added to it.
+	for i := 0; i < maxBatchBlocks; i++ {
+		put(3)
+	}
+	if !committed(3) {
+		t.Fatal("full batch was not committed")
+	}
+
+	// Pending updates are discarded on rollback.
+	put(4)
+	batch.rollback()
+	if committed(4) {
+		t.Fatal("rolled back batch was committed")
+	}
+}
@@ -5,6 +5,7 @@
 package indexers
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -27,9 +28,10 @@ const (
 	cfIndexVersion = 2
 )
 
-// Committed filters come in one flavor currently: basic. They are generated
-// and dropped in pairs, and both are indexed by a block's hash.  Besides
-// holding different content, they also live in different buckets.
+// Committed filters come in two flavors: basic and extended.  The filters of
+// every enabled type are generated and dropped together, and all are indexed
+// by a block's hash.  Besides holding different content, they also live in
+// different buckets.
 var (
 	// cfIndexParentBucketKey is the name of the parent bucket used to
 	// house the index. The rest of the buckets live below this bucket.
@@ -39,18 +41,21 @@ var (
 	// block hashes to cfilters.
 	cfIndexKeys = [][]byte{
 		[]byte("cf0byhashidx"),
+		[]byte("cf1byhashidx"),
 	}
 
 	// cfHeaderKeys is an array of db bucket names used to house indexes of
 	// block hashes to cf headers.
 	cfHeaderKeys = [][]byte{
 		[]byte("cf0headerbyhashidx"),
+		[]byte("cf1headerbyhashidx"),
 	}
 
 	// cfHashKeys is an array of db bucket names used to house indexes of
 	// block hashes to cf hashes.
 	cfHashKeys = [][]byte{
 		[]byte("cf0hashbyhashidx"),
+		[]byte("cf1hashbyhashidx"),
 	}
 
 	maxFilterType = uint8(len(cfHeaderKeys) - 1)
@@ -59,6 +64,10 @@ var (
 	// version this index is migrated to.
 	cfIndexMigrationVersionKey = []byte("cfindexmigrationversion")
 
+	// cfIndexFilterTypesKey is the db key used to store the set of filter
+	// types the index maintains.
+	cfIndexFilterTypesKey = []byte("cfindexfiltertypes")
+
 	// zeroHash is the chainhash.Hash value of all zero bytes, defined here
 	// for convenience.
 	zeroHash chainhash.Hash
@@ -129,10 +138,98 @@ func dbStoreMigrationVersion(dbTx database.Tx, version uint32) error {
 	return bucket.Put(cfIndexMigrationVersionKey, versionBytes)
 }
 
+// dbFetchFilterTypes retrieves the set of filter types the index maintains
+// from the bucket.  Indexes created before the set was stored only maintain
+// the regular filters.
+func dbFetchFilterTypes(dbTx database.Tx) (filterTypeSet, error) {
+	bucket := dbTx.Metadata().Bucket(cfIndexParentBucketKey)
+	if bucket == nil {
+		return 0, fmt.Errorf("bucket nil for key: %s", cfIndexParentBucketKey)
+	}
+	typesBytes := bucket.Get(cfIndexFilterTypesKey)
+	if len(typesBytes) != 1 {
+		return newFilterTypeSet([]wire.FilterType{wire.GCSFilterRegular}), nil
+	}
+	return filterTypeSet(typesBytes[0]), nil
+}
+
+// dbStoreFilterTypes stores the set of filter types the index maintains in the
+// bucket.
+func dbStoreFilterTypes(dbTx database.Tx, types filterTypeSet) error {
+	bucket := dbTx.Metadata().Bucket(cfIndexParentBucketKey)
+	if bucket == nil {
+		return fmt.Errorf("bucket nil for key: %s", cfIndexParentBucketKey)
+	}
+	return bucket.Put(cfIndexFilterTypesKey, []byte{byte(types)})
+}
+
+// filterTypeSet is a set of filter types with a bit for each type.
+type filterTypeSet uint8
+
+// newFilterTypeSet returns the set of the passed filter types.
+func newFilterTypeSet(filterTypes []wire.FilterType) filterTypeSet {
+	var set filterTypeSet
+	for _, filterType := range filterTypes {
+		set |= 1 << filterType
+	}
+	return set
+}
+
+// has returns whether the passed filter type is in the set.
+func (set filterTypeSet) has(filterType wire.FilterType) bool {
+	return uint8(filterType) <= maxFilterType && set&(1<<filterType) != 0
+}
+
+// buildExtendedFilter builds the extended filter of a block, which holds the
+// entries of the basic filter along with the token category of every output
+// carrying tokens.
+func buildExtendedFilter(block *wire.MsgBlock) (*gcs.Filter, error) {
+	blockHash := block.BlockHash()
+	b := builder.WithKeyHash(&blockHash)
+	if _, err := b.Key(); err != nil {
+		return nil, err
+	}
+
+	for i, tx := range block.Transactions {
+		if i > 0 {
+			for _, txIn := range tx.TxIn {
+				var buf bytes.Buffer
+				err := txIn.PreviousOutPoint.Serialize(&buf)
+				if err != nil {
+					return nil, err
+				}
+				b.AddEntry(buf.Bytes())
+			}
+		}
+		for _, txOut := range tx.TxOut {
+			if len(txOut.PkScript) > 0 {
+				b.AddEntry(txOut.PkScript)
+			}
+			if !txOut.TokenData.IsEmpty() {
+				b.AddEntry(txOut.TokenData.CategoryID[:])
+			}
+		}
+	}
+
+	return b.Build()
+}
+
+// buildFilter builds the filter of the passed type for a block.
+func buildFilter(block *wire.MsgBlock, filterType wire.FilterType) (*gcs.Filter, error) {
+	switch filterType {
+	case wire.GCSFilterRegular:
+		return builder.BuildBasicFilter(block)
+	case wire.GCSFilterExtended:
+		return buildExtendedFilter(block)
+	}
+	return nil, errors.New("unsupported filter type")
+}
+
 // CfIndex implements a committed filter (cf) by hash index.
 type CfIndex struct {
 	db          database.DB
 	chainParams *chaincfg.Params
+	filterTypes []wire.FilterType
 }
 
 // Ensure the CfIndex type implements the Indexer interface.
@@ -165,39 +262,93 @@ func (idx *CfIndex) StartBlock() (*chainhash.Hash, int32) {
 // Migrate migrates the index up to the current version. For the CfIndex we will
 // just drop the index here and write the new version number. The IndexManager
 // will then rebuild the index from the blockchain, using the new scheme,
-// as it continues its Init function.
+// as it continues its Init function.  The index is rebuilt the same way when
+// a filter type it doesn't maintain yet is enabled, since the filter headers
+// of the type must chain back to the genesis block.
 //
 // This is part of the Indexer interface.
 func (idx *CfIndex) Migrate(db database.DB, interrupt <-chan struct{}) error {
-	// Load the version number from the metadata bucket.
+	// Load the version number and the maintained filter types from the
+	// metadata bucket.
 	var cfIndexMigrationVersion uint32
+	var storedTypes filterTypeSet
 	err := db.View(func(dbTx database.Tx) error {
 		var err error
 		cfIndexMigrationVersion, err = dbFetchMigrationVersion(dbTx)
+		if err != nil {
+			return err
+		}
+		storedTypes, err = dbFetchFilterTypes(dbTx)
 		return err
 	})
 	if err != nil {
 		return err
 	}
 
-	// If the version is less than 1 then drop the index and write the new version.
-	if cfIndexMigrationVersion < cfIndexVersion {
-		log.Infof("Migrating CfIndex to version %d", cfIndexVersion)
-		if err := dropIndex(db, cfIndexParentBucketKey, cfIndexName, interrupt); err != nil {
-			return err
+	// If the version is less than the current one, or a filter type is
+	// enabled, then drop the index and write the new version.  The filters
+	// of the types which are no longer enabled are otherwise left in place
+	// rather than deleted in a single transaction.
+	enabledTypes := newFilterTypeSet(idx.filterTypes)
+	if cfIndexMigrationVersion >= cfIndexVersion &&
+		enabledTypes&^storedTypes == 0 {
+
+		if enabledTypes == storedTypes {
+			return nil
 		}
+		log.Infof("Some committed filter types are no longer " +
+			"maintained -- drop the index with --dropcfindex to " +
+			"reclaim their space")
 		return db.Update(func(dbTx database.Tx) error {
-			// The tip for the index does not exist, so create it.
-			if err := idx.Create(dbTx); err != nil {
-				return err
-			}
-
-			// Set the tip for the index to values which represent an
-			// uninitialized index.
-			return dbPutIndexerTip(dbTx, cfIndexParentBucketKey, &chainhash.Hash{}, -1)
+			return dbStoreFilterTypes(dbTx, enabledTypes)
 		})
 	}
-	return nil
+
+	log.Infof("Migrating CfIndex to version %d", cfIndexVersion)
+	if err := dropIndex(db, cfIndexParentBucketKey, cfIndexName, interrupt); err != nil {
+		return err
+	}
+	return db.Update(func(dbTx database.Tx) error {
+		// The tip for the index does not exist, so create it.
+		if err := idx.Create(dbTx); err != nil {
+			return err
+		}
+
+		// Set the tip for the index to values which represent an
+		// uninitialized index.
+		return dbPutIndexerTip(dbTx, cfIndexParentBucketKey, &chainhash.Hash{}, -1)
+	})
+}
+
+// PendingMigrations returns the migration Migrate will perform, which is a
+// rebuild of the index when its version is older than the current one or a
+// filter type it doesn't maintain yet is enabled.  The rebuild for a newly
+// enabled filter type is reported as a migration to the same version.
+//
+// This is part of the PendingMigrationser interface.
+func (idx *CfIndex) PendingMigrations(dbTx database.Tx) ([]blockchain.PendingDbMigration, error) {
+	// An index which doesn't exist yet is created rather than migrated.
+	if dbTx.Metadata().Bucket(cfIndexParentBucketKey) == nil {
+		return nil, nil
+	}
+
+	version, err := dbFetchMigrationVersion(dbTx)
+	if err != nil {
+		return nil, err
+	}
+	storedTypes, err := dbFetchFilterTypes(dbTx)
+	if err != nil {
+		return nil, err
+	}
+	enabledTypes := newFilterTypeSet(idx.filterTypes)
+	if version >= cfIndexVersion && enabledTypes&^storedTypes == 0 {
+		return nil, nil
+	}
+	return []blockchain.PendingDbMigration{{
+		Name:        cfIndexName,
+		FromVersion: version,
+		ToVersion:   cfIndexVersion,
+	}}, nil
 }
 
 // Key returns the database key to use for the index as a byte slice. This is
@@ -213,8 +364,8 @@ func (idx *CfIndex) Name() string {
 }
 
 // Create is invoked when the indexer manager determines the index needs to
-// be created for the first time. It creates buckets for the two hash-based cf
-// indexes (regular only currently).
+// be created for the first time. It creates buckets for the hash-based cf
+// indexes of every filter type.
 func (idx *CfIndex) Create(dbTx database.Tx) error {
 	meta := dbTx.Metadata()
 
@@ -244,7 +395,10 @@ func (idx *CfIndex) Create(dbTx database.Tx) error {
 		}
 	}
 
-	return dbStoreMigrationVersion(dbTx, cfIndexVersion)
+	if err := dbStoreMigrationVersion(dbTx, cfIndexVersion); err != nil {
+		return err
+	}
+	return dbStoreFilterTypes(dbTx, newFilterTypeSet(idx.filterTypes))
 }
 
 // storeFilter stores a given filter, and performs the steps needed to
@@ -281,7 +435,20 @@ func storeFilter(dbTx database.Tx, block *bchutil.Block, f *gcs.Filter,
 		return err
 	}
 
-	// Then fetch the previous block's filter header.
+	// Construct the new block's filter header, and store it.
+	fh, err := makeFilterHeader(dbTx, block, f, hkey)
+	if err != nil {
+		return err
+	}
+	return dbStoreFilterIdxEntry(dbTx, hkey, h, fh[:])
+}
+
+// makeFilterHeader returns the header of the passed filter of a block, which
+// commits to the stored filter header of the previous block.
+func makeFilterHeader(dbTx database.Tx, block *bchutil.Block, f *gcs.Filter,
+	hkey []byte) (chainhash.Hash, error) {
+
+	// Fetch the previous block's filter header.
 	var prevHeader *chainhash.Hash
 	ph := &block.MsgBlock().Header.PrevBlock
 	if ph.IsEqual(&zeroHash) {
@@ -289,35 +456,34 @@ func storeFilter(dbTx database.Tx, block *bchutil.Block, f *gcs.Filter,
 	} else {
 		pfh, err := dbFetchFilterIdxEntry(dbTx, hkey, ph)
 		if err != nil {
-			return err
+			return chainhash.Hash{}, err
 		}
-
-		// Construct the new block's filter header, and store it.
 		prevHeader, err = chainhash.NewHash(pfh)
 		if err != nil {
-			return err
+			return chainhash.Hash{}, err
 		}
 	}
 
-	fh, err := builder.MakeHeaderForFilter(f, *prevHeader)
-	if err != nil {
-		return err
-	}
-	return dbStoreFilterIdxEntry(dbTx, hkey, h, fh[:])
+	return builder.MakeHeaderForFilter(f, *prevHeader)
 }
 
 // ConnectBlock is invoked by the index manager when a new block has been
 // connected to the main chain. This indexer adds a hash-to-cf mapping for
-// every passed block. This is part of the Indexer interface.
+// every passed block and enabled filter type. This is part of the Indexer
+// interface.
 func (idx *CfIndex) ConnectBlock(dbTx database.Tx, block *bchutil.Block,
 	stxos []blockchain.SpentTxOut) error {
 
-	f, err := builder.BuildBasicFilter(block.MsgBlock())
-	if err != nil {
-		return err
+	for _, filterType := range idx.filterTypes {
+		f, err := buildFilter(block.MsgBlock(), filterType)
+		if err != nil {
+			return err
+		}
+		if err := storeFilter(dbTx, block, f, filterType); err != nil {
+			return err
+		}
 	}
-
-	return storeFilter(dbTx, block, f, wire.GCSFilterRegular)
+	return nil
 }
 
 // DisconnectBlock is invoked by the index manager when a block has been
@@ -326,28 +492,166 @@ func (idx *CfIndex) ConnectBlock(dbTx database.Tx, block *bchutil.Block,
 func (idx *CfIndex) DisconnectBlock(dbTx database.Tx, block *bchutil.Block,
 	_ []blockchain.SpentTxOut) error {
 
-	for _, key := range cfIndexKeys {
-		err := dbDeleteFilterIdxEntry(dbTx, key, block.Hash())
-		if err != nil {
-			return err
+	for _, filterType := range idx.filterTypes {
+		keys := [][]byte{
+			cfIndexKeys[filterType],
+			cfHeaderKeys[filterType],
+			cfHashKeys[filterType],
 		}
+		for _, key := range keys {
+			err := dbDeleteFilterIdxEntry(dbTx, key, block.Hash())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// FilterTypeEnabled returns whether the index maintains the filters of the
+// passed type.
+func (idx *CfIndex) FilterTypeEnabled(filterType wire.FilterType) bool {
+	return newFilterTypeSet(idx.filterTypes).has(filterType)
+}
+
+// RegenerateFilters rebuilds the filters of the passed type for the main chain
+// blocks from the start height through the end height, which repairs a range
+// of corrupted filters.  The blocks are fetched with the passed function,
+// which returns a nil block past the tip of the main chain.  Since the header
+// of each filter commits to the header of the previous one, the filters past
+// the end height are rebuilt as well until the stored header of a block
+// matches its rebuilt header.  The rebuilt filters are all stored in a single
+// transaction so readers never see a mix of old and rebuilt filters.  It
+// returns the height of the last block whose filter was rebuilt.
+//
+// This function is safe for concurrent access.
+func (idx *CfIndex) RegenerateFilters(filterType wire.FilterType, startHeight,
+	endHeight int32, fetchBlock func(height int32) (*bchutil.Block, error)) (int32, error) {
+
+	if !idx.FilterTypeEnabled(filterType) {
+		return 0, errors.New("unsupported filter type")
 	}
+	fkey := cfIndexKeys[filterType]
+	hkey := cfHeaderKeys[filterType]
+	hashkey := cfHashKeys[filterType]
 
-	for _, key := range cfHeaderKeys {
-		err := dbDeleteFilterIdxEntry(dbTx, key, block.Hash())
+	// regenerated houses a rebuilt filter along with its hash and header.
+	type regenerated struct {
+		blockHash  *chainhash.Hash
+		filter     []byte
+		filterHash chainhash.Hash
+		header     chainhash.Hash
+	}
+
+	// Rebuild the filters and chain their headers in memory without
+	// holding the database write lock.
+	var rebuilt []regenerated
+	var startPrevHash chainhash.Hash
+	var startPrevHeader []byte
+	var prevHeader chainhash.Hash
+	for height := startHeight; ; height++ {
+		block, err := fetchBlock(height)
 		if err != nil {
-			return err
+			return 0, err
+		}
+		if block == nil {
+			break
+		}
+		f, err := buildFilter(block.MsgBlock(), filterType)
+		if err != nil {
+			return 0, err
 		}
-	}
 
-	for _, key := range cfHashKeys {
-		err := dbDeleteFilterIdxEntry(dbTx, key, block.Hash())
+		var stored []byte
+		err = idx.db.View(func(dbTx database.Tx) error {
+			// The headers chain from the stored header of the block
+			// before the start height.
+			if height == startHeight {
+				startPrevHash = block.MsgBlock().Header.PrevBlock
+				if !startPrevHash.IsEqual(&zeroHash) {
+					pfh, err := dbFetchFilterIdxEntry(dbTx,
+						hkey, &startPrevHash)
+					if err != nil {
+						return err
+					}
+					startPrevHeader = pfh
+					copy(prevHeader[:], pfh)
+				}
+			}
+			if height > endHeight {
+				var err error
+				stored, err = dbFetchFilterIdxEntry(dbTx, hkey,
+					block.Hash())
+				return err
+			}
+			return nil
+		})
 		if err != nil {
-			return err
+			return 0, err
+		}
+
+		entry := regenerated{blockHash: block.Hash()}
+		entry.filter, err = f.NBytes()
+		if err != nil {
+			return 0, err
+		}
+		entry.filterHash, err = builder.GetFilterHash(f)
+		if err != nil {
+			return 0, err
+		}
+		entry.header, err = builder.MakeHeaderForFilter(f, prevHeader)
+		if err != nil {
+			return 0, err
 		}
+		if height > endHeight && bytes.Equal(stored, entry.header[:]) {
+			break
+		}
+		rebuilt = append(rebuilt, entry)
+		prevHeader = entry.header
 	}
 
-	return nil
+	err := idx.db.Update(func(dbTx database.Tx) error {
+		// The rebuilt headers are only valid when the header they chain
+		// from didn't change meanwhile.
+		if len(rebuilt) > 0 && !startPrevHash.IsEqual(&zeroHash) {
+			pfh, err := dbFetchFilterIdxEntry(dbTx, hkey,
+				&startPrevHash)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(pfh, startPrevHeader) {
+				return errors.New("the filter header before the " +
+					"regenerated range changed")
+			}
+		}
+		for _, entry := range rebuilt {
+			err := dbStoreFilterIdxEntry(dbTx, fkey, entry.blockHash,
+				entry.filter)
+			if err != nil {
+				return err
+			}
+			err = dbStoreFilterIdxEntry(dbTx, hashkey, entry.blockHash,
+				entry.filterHash[:])
+			if err != nil {
+				return err
+			}
+			err = dbStoreFilterIdxEntry(dbTx, hkey, entry.blockHash,
+				entry.header[:])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	lastHeight := startHeight + int32(len(rebuilt)) - 1
+	log.Infof("Regenerated the committed filters of type %d from height "+
+		"%d to %d", filterType, startHeight, lastHeight)
+	return lastHeight, nil
 }
 
 // entryByBlockHash fetches a filter index entry of a particular type
@@ -355,7 +659,7 @@ func (idx *CfIndex) DisconnectBlock(dbTx database.Tx, block *bchutil.Block,
 func (idx *CfIndex) entryByBlockHash(filterTypeKeys [][]byte,
 	filterType wire.FilterType, h *chainhash.Hash) ([]byte, error) {
 
-	if uint8(filterType) > maxFilterType {
+	if !idx.FilterTypeEnabled(filterType) {
 		return nil, errors.New("unsupported filter type")
 	}
 	key := filterTypeKeys[filterType]
@@ -374,7 +678,7 @@ func (idx *CfIndex) entryByBlockHash(filterTypeKeys [][]byte,
 func (idx *CfIndex) entriesByBlockHashes(filterTypeKeys [][]byte,
 	filterType wire.FilterType, blockHashes []*chainhash.Hash) ([][]byte, error) {
 
-	if uint8(filterType) > maxFilterType {
+	if !idx.FilterTypeEnabled(filterType) {
 		return nil, errors.New("unsupported filter type")
 	}
 	key := filterTypeKeys[filterType]
@@ -437,13 +741,23 @@ func (idx *CfIndex) FilterHashesByBlockHashes(blockHashes []*chainhash.Hash,
 
 // NewCfIndex returns a new instance of an indexer that is used to create a
 // mapping of the hashes of all blocks in the blockchain to their respective
-// committed filters.
+// committed filters of the passed types.  The regular filters are maintained
+// when no type is passed.
 //
 // It implements the Indexer interface which plugs into the IndexManager that
 // in turn is used by the blockchain package. This allows the index to be
 // seamlessly maintained along with the chain.
-func NewCfIndex(db database.DB, chainParams *chaincfg.Params) *CfIndex {
-	return &CfIndex{db: db, chainParams: chainParams}
+func NewCfIndex(db database.DB, chainParams *chaincfg.Params,
+	filterTypes ...wire.FilterType) *CfIndex {
+
+	if len(filterTypes) == 0 {
+		filterTypes = []wire.FilterType{wire.GCSFilterRegular}
+	}
+	return &CfIndex{
+		db:          db,
+		chainParams: chainParams,
+		filterTypes: filterTypes,
+	}
 }
 
 // DropCfIndex drops the CF index from the provided database if exists.
@@ -0,0 +1,207 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/database"
+	_ "github.com/gcash/bchd/database/ffldb"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchutil/gcs"
+	"github.com/gcash/bchutil/gcs/builder"
+)
+
+// TestCfIndexFilterTypes ensures the extended filters include token categories
+// and corrupted filters are regenerated along with the headers committing to
+// them.
+func TestCfIndexFilterTypes(t *testing.T) {
+	db, err := database.Create("ffldb", t.TempDir(), wire.MainNet)
+	if err != nil {
+		t.Fatalf("unable to create database: %v", err)
+	}
+	defer db.Close()
+
+	idx := NewCfIndex(db, &chaincfg.MainNetParams, wire.GCSFilterRegular,
+		wire.GCSFilterExtended)
+	if err := db.Update(idx.Create); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	// Build a chain of blocks whose coinbases pay to a token category.
+	category := chainhash.HashH([]byte("category"))
+	var blocks []*bchutil.Block
+	var prevHash chainhash.Hash
+	for i := 0; i < 4; i++ {
+		coinbase := wire.NewMsgTx(1)
+		coinbase.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: ^uint32(0)},
+			[]byte{byte(i), 0x51}))
+		coinbase.AddTxOut(wire.NewTxOut(0, []byte{0x51}, wire.TokenData{
+			CategoryID: category,
+			Amount:     1,
+			BitField:   0x10,
+		}))
+		msgBlock := wire.NewMsgBlock(wire.NewBlockHeader(1, &prevHash,
+			&chainhash.Hash{}, 0, 0))
+		msgBlock.AddTransaction(coinbase)
+		block := bchutil.NewBlock(msgBlock)
+		err := db.Update(func(dbTx database.Tx) error {
+			return idx.ConnectBlock(dbTx, block, nil)
+		})
+		if err != nil {
+			t.Fatalf("ConnectBlock: %v", err)
+		}
+		blocks = append(blocks, block)
+		prevHash = *block.Hash()
+	}
+
+	// matchCategory returns whether the filter of the passed type of the
+	// passed block matches the token category.
+	matchCategory := func(block *bchutil.Block, filterType wire.FilterType) bool {
+		t.Helper()
+		filterBytes, err := idx.FilterByBlockHash(block.Hash(), filterType)
+		if err != nil {
+			t.Fatalf("FilterByBlockHash: %v", err)
+		}
+		filter, err := gcs.FromNBytes(builder.DefaultP, builder.DefaultM,
+			filterBytes)
+		if err != nil {
+			t.Fatalf("FromNBytes: %v", err)
+		}
+		key := builder.DeriveKey(block.Hash())
+		match, err := filter.Match(key, category[:])
+		if err != nil {
+			t.Fatalf("Match: %v", err)
+		}
+		return match
+	}
+	if !matchCategory(blocks[1], wire.GCSFilterExtended) {
+		t.Fatal("extended filter does not match the token category")
+	}
+	if matchCategory(blocks[1], wire.GCSFilterRegular) {
+		t.Fatal("regular filter matches the token category")
+	}
+
+	headers := make([][]byte, len(blocks))
+	for i, block := range blocks {
+		headers[i], err = idx.FilterHeaderByBlockHash(block.Hash(),
+			wire.GCSFilterExtended)
+		if err != nil {
+			t.Fatalf("FilterHeaderByBlockHash: %v", err)
+		}
+	}
+
+	// Corrupt the filter and header of the second block along with the
+	// header of the third one, which the regeneration must repair.
+	err = db.Update(func(dbTx database.Tx) error {
+		filterType := wire.GCSFilterExtended
+		corrupt := []struct {
+			key  []byte
+			hash *chainhash.Hash
+		}{
+			{cfIndexKeys[filterType], blocks[1].Hash()},
+			{cfHeaderKeys[filterType], blocks[1].Hash()},
+			{cfHeaderKeys[filterType], blocks[2].Hash()},
+		}
+		for _, c := range corrupt {
+			err := dbStoreFilterIdxEntry(dbTx, c.key, c.hash,
+				make([]byte, 32))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to corrupt filters: %v", err)
+	}
+
+	fetchBlock := func(height int32) (*bchutil.Block, error) {
+		if int(height) >= len(blocks) {
+			return nil, nil
+		}
+		return blocks[height], nil
+	}
+	last, err := idx.RegenerateFilters(wire.GCSFilterExtended, 1, 1,
+		fetchBlock)
+	if err != nil {
+		t.Fatalf("RegenerateFilters: %v", err)
+	}
+	if last != 2 {
+		t.Fatalf("regenerated filters up to height %d, want 2", last)
+	}
+	for i, block := range blocks {
+		header, err := idx.FilterHeaderByBlockHash(block.Hash(),
+			wire.GCSFilterExtended)
+		if err != nil {
+			t.Fatalf("FilterHeaderByBlockHash: %v", err)
+		}
+		if !bytes.Equal(header, headers[i]) {
+			t.Fatalf("header of block %d was not regenerated", i)
+		}
+	}
+	if !matchCategory(blocks[1], wire.GCSFilterExtended) {
+		t.Fatal("regenerated filter does not match the token category")
+	}
+
+	// The filters of types which aren't maintained are not available.
+	regular := NewCfIndex(db, &chaincfg.MainNetParams)
+	_, err = regular.FilterByBlockHash(blocks[1].Hash(),
+		wire.GCSFilterExtended)
+	if err == nil {
+		t.Fatal("fetched a filter of a type which isn't maintained")
+	}
+}
+
+// TestCfIndexPendingMigrations ensures the pending migrations of the index
+// cover older versions and newly enabled filter types.
+func TestCfIndexPendingMigrations(t *testing.T) {
+	db, err := database.Create("ffldb", t.TempDir(), wire.MainNet)
+	if err != nil {
+		t.Fatalf("unable to create database: %v", err)
+	}
+	defer db.Close()
+
+	regular := NewCfIndex(db, &chaincfg.MainNetParams, wire.GCSFilterRegular)
+	extended := NewCfIndex(db, &chaincfg.MainNetParams, wire.GCSFilterRegular,
+		wire.GCSFilterExtended)
+	checkPending := func(name string, idx *CfIndex, want []blockchain.PendingDbMigration) {
+		t.Helper()
+		pending, err := PendingDbMigrations(db, []Indexer{idx})
+		if err != nil {
+			t.Fatalf("%s: PendingDbMigrations: %v", name, err)
+		}
+		if !reflect.DeepEqual(pending, want) {
+			t.Fatalf("%s: got pending migrations %+v, want %+v",
+				name, pending, want)
+		}
+	}
+
+	checkPending("no index", extended, nil)
+	if err := db.Update(regular.Create); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	checkPending("current", regular, nil)
+	checkPending("new filter type", extended, []blockchain.PendingDbMigration{
+		{Name: cfIndexName, FromVersion: cfIndexVersion,
+			ToVersion: cfIndexVersion},
+	})
+
+	err = db.Update(func(dbTx database.Tx) error {
+		return dbStoreMigrationVersion(dbTx, 1)
+	})
+	if err != nil {
+		t.Fatalf("unable to store the version: %v", err)
+	}
+	checkPending("old version", regular, []blockchain.PendingDbMigration{
+		{Name: cfIndexName, FromVersion: 1, ToVersion: cfIndexVersion},
+	})
+}
@@ -33,6 +33,13 @@ type NeedsInputser interface {
 	NeedsInputs() bool
 }
 
+// PendingMigrationser provides a generic interface for an indexer to report
+// the migrations its Migrate method will perform without modifying the
+// database.  Indexers without migrations don't need to implement it.
+type PendingMigrationser interface {
+	PendingMigrations(dbTx database.Tx) ([]blockchain.PendingDbMigration, error)
+}
+
 // Indexer provides a generic interface for an indexer that is managed by an
 // index manager such as the Manager type provided by this package.
 type Indexer interface {
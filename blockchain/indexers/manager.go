@@ -230,6 +230,28 @@ func (m *Manager) maybeCreateIndexes(dbTx database.Tx) error {
 	return nil
 }
 
+// PendingDbMigrations returns the migrations the passed indexes will perform on
+// the next start without modifying the database, in the order they are
+// performed.
+func PendingDbMigrations(db database.DB, indexes []Indexer) ([]blockchain.PendingDbMigration, error) {
+	var pending []blockchain.PendingDbMigration
+	err := db.View(func(dbTx database.Tx) error {
+		for _, indexer := range indexes {
+			migrationser, ok := indexer.(PendingMigrationser)
+			if !ok {
+				continue
+			}
+			migrations, err := migrationser.PendingMigrations(dbTx)
+			if err != nil {
+				return err
+			}
+			pending = append(pending, migrations...)
+		}
+		return nil
+	})
+	return pending, err
+}
+
 // Init initializes the enabled indexes.  This is called during chain
 // initialization and primarily consists of catching up all indexes to the
 // current best chain tip.  This is necessary since each index can be disabled
@@ -421,6 +443,12 @@ func (m *Manager) Init(chain *blockchain.BlockChain, interrupt <-chan struct{})
 	// each block that needs to be indexed.
 	log.Infof("Catching up indexes from height %d to %d", lowestHeight,
 		bestHeight)
+
+	// The updates made to all of the indexes are batched across blocks and
+	// committed together.  The blocks in a batch are always complete, so
+	// it's committed before returning when an interrupt is requested.
+	batch := newIndexBatch(m.db)
+	defer batch.rollback()
 	for height := lowestHeight + 1; height <= bestHeight; height++ {
 		// Load the block for the height since it is required to index
 		// it.
@@ -430,6 +458,9 @@ func (m *Manager) Init(chain *blockchain.BlockChain, interrupt <-chan struct{})
 		}
 
 		if interruptRequested(interrupt) {
+			if err := batch.commit(); err != nil {
+				return err
+			}
 			return errInterruptRequested
 		}
 
@@ -452,24 +483,33 @@ func (m *Manager) Init(chain *blockchain.BlockChain, interrupt <-chan struct{})
 				}
 			}
 
-			err := m.db.Update(func(dbTx database.Tx) error {
-				return dbIndexConnectBlock(
-					dbTx, indexer, block, spentTxos,
-				)
-			})
+			dbTx, err := batch.tx()
+			if err != nil {
+				return err
+			}
+			err = dbIndexConnectBlock(dbTx, indexer, block, spentTxos)
 			if err != nil {
 				return err
 			}
 			indexerHeights[i] = height
 		}
+		if err := batch.blockDone(); err != nil {
+			return err
+		}
 
 		// Log indexing progress.
 		progressLogger.LogBlockHeight(block, uint64(bestHeight))
 
 		if interruptRequested(interrupt) {
+			if err := batch.commit(); err != nil {
+				return err
+			}
 			return errInterruptRequested
 		}
 	}
+	if err := batch.commit(); err != nil {
+		return err
+	}
 
 	log.Infof("Indexes caught up to height %d", bestHeight)
 	return nil
@@ -517,6 +557,13 @@ func dbFetchTx(dbTx database.Tx, hash *chainhash.Hash) (*wire.MsgTx, error) {
 // keeps track of the state of each index it is managing, performs some sanity
 // checks, and invokes each indexer.
 //
+// The passed database transaction is the one the chain connects the block
+// with, which also stores the spend journal entry and the best chain state of
+// the block.  All of the enabled indexes are therefore updated in the same
+// write as the rest of the block and committed with it, so no batching is
+// needed on this path.  Only the catch-up of the indexes done by Init, which
+// runs outside of the chain, batches several blocks per transaction.
+//
 // This is part of the blockchain.IndexManager interface.
 func (m *Manager) ConnectBlock(dbTx database.Tx, block *bchutil.Block,
 	stxos []blockchain.SpentTxOut) error {
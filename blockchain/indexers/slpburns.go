@@ -0,0 +1,84 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"math"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/database"
+	"github.com/gcash/bchd/wire"
+	"github.com/simpleledgerinc/goslp/v1parser"
+)
+
+// fetchSlpIndexEntryIfExists returns the slp index entry of the passed
+// transaction, or nil when the transaction is not a valid slp transaction.
+func (idx *SlpIndex) fetchSlpIndexEntryIfExists(dbTx database.Tx, hash *chainhash.Hash) (*SlpTxEntry, error) {
+	if entry, ok := idx.cache.GetSlpTxEntry(hash); ok {
+		return &entry, nil
+	}
+	bucket := dbTx.Metadata().Bucket(slpIndexKey)
+	if bucket == nil || bucket.Get(hash[:]) == nil {
+		return nil, nil
+	}
+	return idx.GetSlpIndexEntry(dbTx, hash)
+}
+
+// CheckSlpBurns returns the slp tokens destroyed by the passed transaction,
+// being the tokens of the slp outputs it spends which are not carried over to
+// its outputs.  The outputs of the transaction only carry tokens when it is a
+// valid slp transaction in the index, so transactions from the mempool must be
+// added with AddPotentialSlpEntries first.  The token ids are in the byte order
+// of the TokenIDHash of the index entries.
+//
+// This function is safe for concurrent access.
+func (idx *SlpIndex) CheckSlpBurns(dbTx database.Tx, msgTx *wire.MsgTx) ([]*blockchain.TokenBurn, error) {
+	tally := blockchain.NewTokenBurnTally()
+	for _, txIn := range msgTx.TxIn {
+		prevOut := txIn.PreviousOutPoint
+		entry, err := idx.fetchSlpIndexEntryIfExists(dbTx, &prevOut.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if entry == nil {
+			continue
+		}
+		slpMsg, err := v1parser.ParseSLP(entry.SlpOpReturn)
+		if err != nil {
+			return nil, err
+		}
+		value, baton := slpMsg.GetVoutValue(int(prevOut.Index))
+		amount := uint64(0)
+		if value != nil {
+			amount = math.MaxUint64
+			if value.IsUint64() {
+				amount = value.Uint64()
+			}
+		}
+		if amount == 0 && !baton {
+			continue
+		}
+		tally.AddInput(entry.TokenIDHash, amount, false, baton)
+	}
+
+	txHash := msgTx.TxHash()
+	entry, err := idx.fetchSlpIndexEntryIfExists(dbTx, &txHash)
+	if err != nil {
+		return nil, err
+	}
+	if entry != nil {
+		slpMsg, err := v1parser.ParseSLP(entry.SlpOpReturn)
+		if err != nil {
+			return nil, err
+		}
+		slpTokenOutputs(slpMsg, func(vout int, amount uint64, baton bool) {
+			if vout < len(msgTx.TxOut) {
+				tally.AddOutput(entry.TokenIDHash, amount, false, baton)
+			}
+		})
+	}
+	return tally.Burns(), nil
+}
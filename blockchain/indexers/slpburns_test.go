@@ -0,0 +1,125 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/database"
+	_ "github.com/gcash/bchd/database/ffldb"
+	"github.com/gcash/bchd/wire"
+	"github.com/simpleledgerinc/goslp/metadatamaker"
+	"github.com/simpleledgerinc/goslp/v1parser"
+)
+
+// TestCheckSlpBurns ensures the slp tokens spent by a transaction which are not
+// carried over to its outputs are reported as burned.
+func TestCheckSlpBurns(t *testing.T) {
+	db, err := database.Create("ffldb", t.TempDir(), wire.MainNet)
+	if err != nil {
+		t.Fatalf("unable to create database: %v", err)
+	}
+	defer db.Close()
+
+	idx := NewSlpIndex(db, &SlpConfig{MaxCacheSize: 100})
+	if err := db.Update(idx.Create); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	// addEntry adds an slp index entry for the passed transaction with the
+	// passed slp message as its first output.
+	addEntry := func(tx *wire.MsgTx, tokenIDHash *chainhash.Hash) {
+		t.Helper()
+		script := tx.TxOut[0].PkScript
+		slpMsg, err := v1parser.ParseSLP(script)
+		if err != nil {
+			t.Fatalf("ParseSLP: %v", err)
+		}
+		hash := tx.TxHash()
+		if tokenIDHash == nil {
+			tokenIDHash = &hash
+		}
+		err = db.Update(func(dbTx database.Tx) error {
+			return dbPutSlpIndexEntry(idx, dbTx, &dbSlpIndexEntry{
+				tx:             tx,
+				slpMsg:         slpMsg,
+				tokenIDHash:    tokenIDHash,
+				slpMsgPkScript: script,
+			})
+		})
+		if err != nil {
+			t.Fatalf("dbPutSlpIndexEntry: %v", err)
+		}
+	}
+	newTx := func(prevOut wire.OutPoint, script []byte, numOutputs int) *wire.MsgTx {
+		tx := wire.NewMsgTx(1)
+		tx.AddTxIn(wire.NewTxIn(&prevOut, nil))
+		tx.AddTxOut(wire.NewTxOut(0, script, wire.TokenData{}))
+		for i := 0; i < numOutputs; i++ {
+			tx.AddTxOut(wire.NewTxOut(546, []byte{0x51}, wire.TokenData{}))
+		}
+		return tx
+	}
+	checkBurns := func(tx *wire.MsgTx) []*blockchain.TokenBurn {
+		t.Helper()
+		var burns []*blockchain.TokenBurn
+		err := db.View(func(dbTx database.Tx) error {
+			var err error
+			burns, err = idx.CheckSlpBurns(dbTx, tx)
+			return err
+		})
+		if err != nil {
+			t.Fatalf("CheckSlpBurns: %v", err)
+		}
+		return burns
+	}
+
+	script, err := metadatamaker.TokenType1Genesis(nil, nil, nil, nil, 0,
+		nil, 100)
+	if err != nil {
+		t.Fatalf("TokenType1Genesis: %v", err)
+	}
+	genesis := newTx(wire.OutPoint{Index: 1}, script, 1)
+	addEntry(genesis, nil)
+	tokenID := genesis.TxHash()
+	if burns := checkBurns(genesis); len(burns) != 0 {
+		t.Fatalf("genesis burns %d tokens", len(burns))
+	}
+
+	// A send of part of the tokens burns the rest.
+	script, err = metadatamaker.TokenType1Send(tokenID[:], []uint64{60})
+	if err != nil {
+		t.Fatalf("TokenType1Send: %v", err)
+	}
+	send := newTx(wire.OutPoint{Hash: tokenID, Index: 1}, script, 1)
+	addEntry(send, &tokenID)
+	burns := checkBurns(send)
+	if len(burns) != 1 {
+		t.Fatalf("got %d burns, want 1", len(burns))
+	}
+	if burns[0].TokenID != tokenID || burns[0].InputAmount != 100 ||
+		burns[0].OutputAmount != 60 || burns[0].BurnedAmount() != 40 {
+
+		t.Fatalf("unexpected burn %+v", burns[0])
+	}
+
+	// Spending the tokens with a transaction which is not a valid slp
+	// transaction burns all of them.
+	spend := newTx(wire.OutPoint{Hash: send.TxHash(), Index: 1}, []byte{0x6a}, 1)
+	burns = checkBurns(spend)
+	if len(burns) != 1 || burns[0].BurnedAmount() != 60 ||
+		burns[0].OutputAmount != 0 {
+
+		t.Fatalf("unexpected burns %+v", burns)
+	}
+
+	// Outputs without tokens aren't burned when spent.
+	spend = newTx(wire.OutPoint{Hash: send.TxHash(), Index: 2}, []byte{0x6a}, 1)
+	if burns := checkBurns(spend); len(burns) != 0 {
+		t.Fatalf("unexpected burns %+v", burns)
+	}
+}
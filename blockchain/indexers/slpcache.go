@@ -70,6 +70,11 @@ func (s *SlpCache) GetSlpTxEntry(hash *chainhash.Hash) (SlpTxEntry, bool) {
 	return SlpTxEntry{}, false
 }
 
+// RemoveSlpTxEntry removes a tx entry item from the cache
+func (s *SlpCache) RemoveSlpTxEntry(hash *chainhash.Hash) {
+	s.slpTxEntries.Remove(*hash)
+}
+
 // AddTempTokenMetadata puts token metadata into cache with a limited size
 func (s *SlpCache) AddTempTokenMetadata(item TokenMetadata) error {
 	return s.tokenMetadata.Set(*item.TokenID, &item)
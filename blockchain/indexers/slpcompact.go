@@ -0,0 +1,368 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"errors"
+	"math"
+	"sync/atomic"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/database"
+	"github.com/gcash/bchd/wire"
+	"github.com/simpleledgerinc/goslp/v1parser"
+)
+
+// slpCompactBatchSize is the number of slp index entries read or deleted in a
+// single database transaction while compacting the index.
+const slpCompactBatchSize = 10000
+
+// ErrSlpCompactInProgress is returned when the slp index is compacted while a
+// previous compaction is still running.
+var ErrSlpCompactInProgress = errors.New("slp index compaction already in progress")
+
+// SlpCompactPolicy determines which tokens are dropped when compacting the slp
+// index.
+type SlpCompactPolicy struct {
+	// MaxUnspentAmount is the largest amount of a token which may remain in
+	// unspent outputs for the token to be dropped.  The default of zero
+	// only drops the tokens whose entire supply is provably burned.
+	// Tokens with an unspent mint baton are never dropped since their
+	// supply can still grow.
+	MaxUnspentAmount uint64
+}
+
+// SlpCompactResult describes the entries dropped by a compaction of the slp
+// index.
+type SlpCompactResult struct {
+	// Tokens is the number of tokens whose entries were dropped.
+	Tokens int
+
+	// Entries is the number of transaction entries dropped.
+	Entries int
+
+	// ReclaimedBytes is the serialized size of the keys and values of the
+	// dropped entries.  The space is released on disk as the database
+	// compacts its files.
+	ReclaimedBytes int64
+
+	// dropped holds the tokens whose entries were dropped across the
+	// attempts of the compaction.
+	dropped map[uint32]struct{}
+}
+
+// SlpUnspentFunc returns whether the passed outpoint is in the set of unspent
+// transaction outputs of the current best chain.
+type SlpUnspentFunc func(outpoint wire.OutPoint) (bool, error)
+
+// slpTokenState tracks the outputs of a token which remain unspent.  The
+// token is kept when its supply can still grow or is unknown.
+type slpTokenState struct {
+	unspentAmount uint64
+	keep          bool
+}
+
+// slpTokenOutputs calls the passed function with the index, amount and whether
+// it is the mint baton of every output of a parsed slp message carrying tokens.
+func slpTokenOutputs(slpMsg v1parser.ParseResult, fn func(vout int, amount uint64, baton bool)) {
+	maxVout := 1
+	switch msg := slpMsg.(type) {
+	case *v1parser.SlpGenesis:
+		maxVout = max(maxVout, msg.MintBatonVout)
+	case *v1parser.SlpMint:
+		maxVout = max(maxVout, msg.MintBatonVout)
+	case *v1parser.SlpSend:
+		maxVout = len(msg.Amounts)
+	}
+	for vout := 1; vout <= maxVout; vout++ {
+		value, baton := slpMsg.GetVoutValue(vout)
+		switch {
+		case baton:
+			fn(vout, 0, true)
+		case value != nil && value.Sign() > 0:
+			amount := uint64(math.MaxUint64)
+			if value.IsUint64() {
+				amount = value.Uint64()
+			}
+			fn(vout, amount, false)
+		}
+	}
+}
+
+// slpCompactMaxAttempts is the number of times a compaction is restarted when
+// blocks are connected or disconnected while it runs before giving up.
+const slpCompactMaxAttempts = 5
+
+// ErrSlpCompactChainChanged is returned when the slp index could not be
+// compacted because the best chain kept changing while it was compacted.
+var ErrSlpCompactChainChanged = errors.New("the best chain kept changing " +
+	"while compacting the slp index")
+
+// errSlpCompactTipChanged is returned internally when the tip of the slp index
+// changed since the tokens to drop were determined.
+var errSlpCompactTipChanged = errors.New("slp index tip changed")
+
+// dbFetchSlpIndexTip returns the hash of the block the slp index is synced to,
+// which is the zero hash when the tip is not recorded.
+func dbFetchSlpIndexTip(dbTx database.Tx) chainhash.Hash {
+	if dbTx.Metadata().Bucket(indexTipsBucketName) == nil {
+		return chainhash.Hash{}
+	}
+	hash, _, err := dbFetchIndexerTip(dbTx, slpIndexKey)
+	if err != nil {
+		return chainhash.Hash{}
+	}
+	return *hash
+}
+
+// Compact drops the entries of the tokens which can no longer be spent
+// according to the passed policy, keeping their token metadata.  The outputs
+// carrying tokens are looked up with the passed function, so the tokens whose
+// outputs are all spent without a valid slp transaction, which burns them, are
+// dropped.  The transactions of a dropped token can no longer be queried and
+// are no longer searched by the slp graph search once it is reloaded.
+//
+// Since the outputs are checked against the current best chain, reorganizing
+// the blocks which spent them makes them unspent again with their entries
+// already dropped, so the outputs are then considered burned.  The index is
+// not rebuilt in that case.
+//
+// The entries are read and deleted in batches so the index keeps being
+// updated while it is compacted.  The tokens to drop are only valid for the
+// block the index was synced to when they were determined, so the compaction
+// starts over when a block is connected or disconnected before all of their
+// entries are deleted, and ErrSlpCompactChainChanged is returned when that
+// keeps happening.  The interrupt channel aborts the compaction between
+// batches.
+//
+// This function is safe for concurrent access.
+func (idx *SlpIndex) Compact(policy *SlpCompactPolicy, isUnspent SlpUnspentFunc,
+	interrupt <-chan struct{}) (*SlpCompactResult, error) {
+
+	if !atomic.CompareAndSwapInt32(&idx.compacting, 0, 1) {
+		return nil, ErrSlpCompactInProgress
+	}
+	defer atomic.StoreInt32(&idx.compacting, 0)
+
+	result := &SlpCompactResult{dropped: make(map[uint32]struct{})}
+	for attempt := 0; attempt < slpCompactMaxAttempts; attempt++ {
+		var tip chainhash.Hash
+		err := idx.db.View(func(dbTx database.Tx) error {
+			tip = dbFetchSlpIndexTip(dbTx)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		drop, err := idx.compactTokens(policy, isUnspent, interrupt)
+		if err != nil {
+			return nil, err
+		}
+		if len(drop) == 0 {
+			return result, nil
+		}
+
+		err = idx.dropTokenEntries(drop, tip, result, interrupt)
+		if err == errSlpCompactTipChanged {
+			log.Debugf("The slp index tip changed while compacting " +
+				"the index, starting over")
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("Compacted the slp index: dropped %d entries of %d "+
+			"tokens, reclaiming %d bytes", result.Entries,
+			result.Tokens, result.ReclaimedBytes)
+		return result, nil
+	}
+	return nil, ErrSlpCompactChainChanged
+}
+
+// compactTokens returns the tokens whose entries are dropped according to the
+// passed policy, looking their outputs up with the passed function.
+func (idx *SlpIndex) compactTokens(policy *SlpCompactPolicy, isUnspent SlpUnspentFunc,
+	interrupt <-chan struct{}) (map[uint32]struct{}, error) {
+
+	// Find the unspent outputs of every token.
+	tokens := make(map[uint32]*slpTokenState)
+	type slpOutputs struct {
+		tokenID  uint32
+		outpoint wire.OutPoint
+		amount   uint64
+		baton    bool
+	}
+	var outputs []slpOutputs
+	err := idx.forEachEntryBatch(interrupt, func(k, v []byte) error {
+		tokenID := byteOrder.Uint32(v[0:4])
+		if _, ok := tokens[tokenID]; !ok {
+			tokens[tokenID] = &slpTokenState{}
+		}
+		slpMsg, err := v1parser.ParseSLP(v[6:])
+		if err != nil {
+			// Keep the tokens with entries which can't be parsed
+			// since their outputs are unknown.
+			tokens[tokenID].keep = true
+			return nil
+		}
+		var hash chainhash.Hash
+		copy(hash[:], k)
+		slpTokenOutputs(slpMsg, func(vout int, amount uint64, baton bool) {
+			outputs = append(outputs, slpOutputs{
+				tokenID:  tokenID,
+				outpoint: wire.OutPoint{Hash: hash, Index: uint32(vout)},
+				amount:   amount,
+				baton:    baton,
+			})
+		})
+		return nil
+	}, func() error {
+		// Look the outputs up outside of the database transaction
+		// since the lookups may use the database as well.
+		for _, output := range outputs {
+			unspent, err := isUnspent(output.outpoint)
+			if err != nil {
+				return err
+			}
+			if !unspent {
+				continue
+			}
+			state := tokens[output.tokenID]
+			if output.baton {
+				state.keep = true
+				continue
+			}
+			if state.unspentAmount > math.MaxUint64-output.amount {
+				state.unspentAmount = math.MaxUint64
+			} else {
+				state.unspentAmount += output.amount
+			}
+		}
+		outputs = outputs[:0]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	drop := make(map[uint32]struct{})
+	for tokenID, state := range tokens {
+		if !state.keep && state.unspentAmount <= policy.MaxUnspentAmount {
+			drop[tokenID] = struct{}{}
+		}
+	}
+	return drop, nil
+}
+
+// dropTokenEntries deletes the entries of the passed tokens, which were
+// determined with the slp index synced to the passed tip, and accounts for
+// them in the passed result.  Each batch is deleted in a database transaction
+// ensuring the tip did not change, which blocks the index from being updated
+// while the batch is deleted.  errSlpCompactTipChanged is returned when it
+// changed.
+func (idx *SlpIndex) dropTokenEntries(drop map[uint32]struct{}, tip chainhash.Hash,
+	result *SlpCompactResult, interrupt <-chan struct{}) error {
+
+	dropped, batchTokens := result.dropped, make(map[uint32]struct{})
+	var deleted []chainhash.Hash
+	var reclaimed int64
+	err := idx.forEachEntryBatch(interrupt, func(k, v []byte) error {
+		tokenID := byteOrder.Uint32(v[0:4])
+		if _, ok := drop[tokenID]; !ok {
+			return nil
+		}
+		var hash chainhash.Hash
+		copy(hash[:], k)
+		deleted = append(deleted, hash)
+		batchTokens[tokenID] = struct{}{}
+		reclaimed += int64(len(k) + len(v))
+		return nil
+	}, func() error {
+		err := idx.db.Update(func(dbTx database.Tx) error {
+			if dbFetchSlpIndexTip(dbTx) != tip {
+				return errSlpCompactTipChanged
+			}
+			slpIndex := dbTx.Metadata().Bucket(slpIndexKey)
+			for i := range deleted {
+				if err := slpIndex.Delete(deleted[i][:]); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for i := range deleted {
+			idx.cache.RemoveSlpTxEntry(&deleted[i])
+		}
+		result.Entries += len(deleted)
+		result.ReclaimedBytes += reclaimed
+		for tokenID := range batchTokens {
+			dropped[tokenID] = struct{}{}
+		}
+		deleted, reclaimed = deleted[:0], 0
+		batchTokens = make(map[uint32]struct{})
+		return nil
+	})
+	result.Tokens = len(dropped)
+	return err
+}
+
+// forEachEntryBatch calls the passed entry function with the key and value of
+// every entry of the slp index, reading them in batches in separate database
+// transactions, and calls the passed batch function after each batch once the
+// database transaction is closed.  The entry function must not retain the key
+// or value.
+func (idx *SlpIndex) forEachEntryBatch(interrupt <-chan struct{},
+	entryFn func(k, v []byte) error, batchFn func() error) error {
+
+	var resumeKey []byte
+	for {
+		if interruptRequested(interrupt) {
+			return errInterruptRequested
+		}
+
+		var lastKey []byte
+		count := 0
+		err := idx.db.View(func(dbTx database.Tx) error {
+			slpIndex := dbTx.Metadata().Bucket(slpIndexKey)
+			if slpIndex == nil {
+				return errors.New("slp index bucket does not exist")
+			}
+			cursor := slpIndex.Cursor()
+			ok := cursor.First()
+			if resumeKey != nil {
+				ok = cursor.Seek(resumeKey)
+			}
+			for ; ok && count < slpCompactBatchSize; ok = cursor.Next() {
+				k, v := cursor.Key(), cursor.Value()
+				if len(v) < 6 {
+					continue
+				}
+				if err := entryFn(k, v); err != nil {
+					return err
+				}
+				lastKey = append(lastKey[:0], k...)
+				count++
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if err := batchFn(); err != nil {
+			return err
+		}
+		if count < slpCompactBatchSize {
+			return nil
+		}
+
+		// Resume after the last key read.
+		resumeKey = append(lastKey, 0)
+	}
+}
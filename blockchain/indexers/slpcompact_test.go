@@ -0,0 +1,245 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/database"
+	_ "github.com/gcash/bchd/database/ffldb"
+	"github.com/gcash/bchd/wire"
+	"github.com/simpleledgerinc/goslp/metadatamaker"
+	"github.com/simpleledgerinc/goslp/v1parser"
+)
+
+// TestSlpCompact ensures compacting the slp index drops the entries of the
+// tokens allowed by the policy and keeps the others along with the token
+// metadata.
+func TestSlpCompact(t *testing.T) {
+	db, err := database.Create("ffldb", t.TempDir(), wire.MainNet)
+	if err != nil {
+		t.Fatalf("unable to create database: %v", err)
+	}
+	defer db.Close()
+
+	idx := NewSlpIndex(db, &SlpConfig{MaxCacheSize: 100})
+	if err := db.Update(idx.Create); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	// addTx adds an slp index entry for a transaction with the passed slp
+	// message and returns its hash.
+	var nonce uint32
+	addTx := func(script []byte, tokenIDHash *chainhash.Hash) chainhash.Hash {
+		t.Helper()
+		slpMsg, err := v1parser.ParseSLP(script)
+		if err != nil {
+			t.Fatalf("ParseSLP: %v", err)
+		}
+		nonce++
+		tx := wire.NewMsgTx(1)
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: nonce}, nil))
+		tx.AddTxOut(wire.NewTxOut(0, script, wire.TokenData{}))
+		hash := tx.TxHash()
+		if tokenIDHash == nil {
+			tokenIDHash = &hash
+		}
+		err = db.Update(func(dbTx database.Tx) error {
+			return dbPutSlpIndexEntry(idx, dbTx, &dbSlpIndexEntry{
+				tx:             tx,
+				slpMsg:         slpMsg,
+				tokenIDHash:    tokenIDHash,
+				slpMsgPkScript: script,
+			})
+		})
+		if err != nil {
+			t.Fatalf("dbPutSlpIndexEntry: %v", err)
+		}
+		return hash
+	}
+
+	// genesis returns the script of a genesis message for the passed
+	// quantity, with a mint baton at the second output when requested.
+	// The baton is patched into the script since it can't be set through
+	// the metadata maker.
+	genesis := func(qty uint64, baton bool) []byte {
+		t.Helper()
+		script, err := metadatamaker.TokenType1Genesis(nil, nil, nil,
+			nil, 0, nil, qty)
+		if err != nil {
+			t.Fatalf("TokenType1Genesis: %v", err)
+		}
+		if baton {
+			script = bytes.Replace(script,
+				[]byte{0x01, 0x00, 0x4c, 0x00, 0x08},
+				[]byte{0x01, 0x00, 0x01, 0x02, 0x08}, 1)
+		}
+		return script
+	}
+	send := func(tokenIDHash chainhash.Hash, amounts ...uint64) []byte {
+		t.Helper()
+		script, err := metadatamaker.TokenType1Send(tokenIDHash[:],
+			amounts)
+		if err != nil {
+			t.Fatalf("TokenType1Send: %v", err)
+		}
+		return script
+	}
+
+	unspent := make(map[wire.OutPoint]struct{})
+	isUnspent := func(outpoint wire.OutPoint) (bool, error) {
+		_, ok := unspent[outpoint]
+		return ok, nil
+	}
+
+	// The supply of the burned token is spent by a send whose outputs are
+	// spent as well.
+	burned := addTx(genesis(100, false), nil)
+	burnedSend := addTx(send(burned, 60, 40), &burned)
+
+	// The dust token has 5 tokens left unspent.
+	dust := addTx(genesis(100, false), nil)
+	dustSend := addTx(send(dust, 95, 5), &dust)
+	unspent[wire.OutPoint{Hash: dustSend, Index: 2}] = struct{}{}
+
+	// The mintable token has no tokens left but its mint baton.
+	mintable := addTx(genesis(100, true), nil)
+	unspent[wire.OutPoint{Hash: mintable, Index: 2}] = struct{}{}
+
+	exists := func(hash chainhash.Hash) bool {
+		var entry *SlpTxEntry
+		db.View(func(dbTx database.Tx) error {
+			entry, _ = dbFetchSlpIndexEntry(dbTx, &hash)
+			return nil
+		})
+		return entry != nil
+	}
+
+	// Only the burned token is dropped by default.
+	result, err := idx.Compact(&SlpCompactPolicy{}, isUnspent, nil)
+	if err != nil {
+		t.Fatalf("Compact: %v", err)
+	}
+	if result.Tokens != 1 || result.Entries != 2 ||
+		result.ReclaimedBytes <= 0 {
+
+		t.Fatalf("unexpected result %+v", result)
+	}
+	for _, hash := range []chainhash.Hash{burned, burnedSend} {
+		if exists(hash) {
+			t.Fatalf("entry %v of the burned token was kept", hash)
+		}
+	}
+	for _, hash := range []chainhash.Hash{dust, dustSend, mintable} {
+		if !exists(hash) {
+			t.Fatalf("entry %v was dropped", hash)
+		}
+	}
+	err = db.View(func(dbTx database.Tx) error {
+		_, err := dbFetchTokenIDByHash(dbTx, &burned)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("token metadata of the burned token was dropped: %v", err)
+	}
+
+	// Raising the threshold drops the dust token but never a token with a
+	// mint baton.
+	policy := &SlpCompactPolicy{MaxUnspentAmount: 5}
+	result, err = idx.Compact(policy, isUnspent, nil)
+	if err != nil {
+		t.Fatalf("Compact: %v", err)
+	}
+	if result.Tokens != 1 || result.Entries != 2 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	if exists(dust) || exists(dustSend) || !exists(mintable) {
+		t.Fatal("unexpected entries dropped with the threshold")
+	}
+}
+
+// TestSlpCompactTipChanged ensures compacting the slp index starts over when a
+// block is connected while the tokens to drop are determined, so a token which
+// is transferred by the block is not dropped.
+func TestSlpCompactTipChanged(t *testing.T) {
+	db, err := database.Create("ffldb", t.TempDir(), wire.MainNet)
+	if err != nil {
+		t.Fatalf("unable to create database: %v", err)
+	}
+	defer db.Close()
+
+	idx := NewSlpIndex(db, &SlpConfig{MaxCacheSize: 100})
+	err = db.Update(func(dbTx database.Tx) error {
+		_, err := dbTx.Metadata().CreateBucket(indexTipsBucketName)
+		if err != nil {
+			return err
+		}
+		if err := idx.Create(dbTx); err != nil {
+			return err
+		}
+		return dbPutIndexerTip(dbTx, slpIndexKey, &chainhash.Hash{1}, 1)
+	})
+	if err != nil {
+		t.Fatalf("unable to create the slp index: %v", err)
+	}
+
+	script, err := metadatamaker.TokenType1Genesis(nil, nil, nil, nil, 0,
+		nil, 100)
+	if err != nil {
+		t.Fatalf("TokenType1Genesis: %v", err)
+	}
+	slpMsg, err := v1parser.ParseSLP(script)
+	if err != nil {
+		t.Fatalf("ParseSLP: %v", err)
+	}
+	tx := wire.NewMsgTx(1)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil))
+	tx.AddTxOut(wire.NewTxOut(0, script, wire.TokenData{}))
+	hash := tx.TxHash()
+	err = db.Update(func(dbTx database.Tx) error {
+		return dbPutSlpIndexEntry(idx, dbTx, &dbSlpIndexEntry{
+			tx:             tx,
+			slpMsg:         slpMsg,
+			tokenIDHash:    &hash,
+			slpMsgPkScript: script,
+		})
+	})
+	if err != nil {
+		t.Fatalf("dbPutSlpIndexEntry: %v", err)
+	}
+
+	// The genesis output looks spent until a block transferring the token
+	// to a new output is connected while the outputs are looked up.
+	var connected bool
+	isUnspent := func(outpoint wire.OutPoint) (bool, error) {
+		if connected {
+			return true, nil
+		}
+		connected = true
+		err := db.Update(func(dbTx database.Tx) error {
+			return dbPutIndexerTip(dbTx, slpIndexKey,
+				&chainhash.Hash{2}, 2)
+		})
+		return false, err
+	}
+
+	result, err := idx.Compact(&SlpCompactPolicy{}, isUnspent, nil)
+	if err != nil {
+		t.Fatalf("Compact: %v", err)
+	}
+	if result.Tokens != 0 || result.Entries != 0 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	var entry *SlpTxEntry
+	db.View(func(dbTx database.Tx) error {
+		entry, _ = dbFetchSlpIndexEntry(dbTx, &hash)
+		return nil
+	})
+	if entry == nil {
+		t.Fatal("entry of the transferred token was dropped")
+	}
+}
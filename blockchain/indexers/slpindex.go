@@ -421,6 +421,7 @@ type SlpIndex struct {
 	config        *SlpConfig
 	cache         *SlpCache
 	graphSearchDb *slpgraphsearch.Db
+	compacting    int32 // atomic
 }
 
 // Ensure the SlpIndex type implements the Indexer interface.
@@ -0,0 +1,236 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/database"
+)
+
+const (
+	// maxInvalidBlocks is the maximum number of invalid blocks kept in the
+	// invalid block cache.  The blocks recorded first are removed first.
+	maxInvalidBlocks = 1000
+
+	// invalidBlockHeaderSize is the size of the fixed part of a serialized
+	// invalid block: the time, the height and the hash of the previous
+	// block.  The reason makes up the rest.
+	invalidBlockHeaderSize = 8 + 4 + chainhash.HashSize
+)
+
+// invalidBlocksBucketName is the name of the db bucket used to house the
+// blocks rejected for violating the consensus rules.  It is keyed by the hash
+// of the blocks.
+var invalidBlocksBucketName = []byte("invalidblocks")
+
+// InvalidBlock describes a block which was rejected for violating the
+// consensus rules.
+type InvalidBlock struct {
+	Hash     chainhash.Hash
+	PrevHash chainhash.Hash
+	Height   int32
+
+	// Time is when the block was rejected.
+	Time time.Time
+
+	// Reason describes the rule the block violated.
+	Reason string
+}
+
+// serializeInvalidBlock returns the passed invalid block serialized for
+// storage in the invalid blocks bucket.  The hash is the key so it is not
+// part of the serialized value.
+func serializeInvalidBlock(e *InvalidBlock) []byte {
+	serialized := make([]byte, invalidBlockHeaderSize+len(e.Reason))
+	byteOrder.PutUint64(serialized[0:8], uint64(e.Time.Unix()))
+	byteOrder.PutUint32(serialized[8:12], uint32(e.Height))
+	copy(serialized[12:invalidBlockHeaderSize], e.PrevHash[:])
+	copy(serialized[invalidBlockHeaderSize:], e.Reason)
+	return serialized
+}
+
+// deserializeInvalidBlock decodes an invalid block serialized with
+// serializeInvalidBlock and stored under the passed key.
+func deserializeInvalidBlock(key, serialized []byte) (*InvalidBlock, error) {
+	if len(key) != chainhash.HashSize ||
+		len(serialized) < invalidBlockHeaderSize {
+
+		return nil, database.Error{
+			ErrorCode:   database.ErrCorruption,
+			Description: "corrupt invalid block entry",
+		}
+	}
+
+	e := &InvalidBlock{
+		Time:   time.Unix(int64(byteOrder.Uint64(serialized[0:8])), 0),
+		Height: int32(byteOrder.Uint32(serialized[8:12])),
+		Reason: string(serialized[invalidBlockHeaderSize:]),
+	}
+	copy(e.Hash[:], key)
+	copy(e.PrevHash[:], serialized[12:invalidBlockHeaderSize])
+	return e, nil
+}
+
+// invalidBlockCache houses the blocks rejected for violating the consensus
+// rules so they are rejected again right away when they are offered again,
+// including across restarts.  It mirrors the invalid blocks bucket in memory.
+type invalidBlockCache struct {
+	mtx    sync.RWMutex
+	blocks map[chainhash.Hash]*InvalidBlock
+}
+
+// newInvalidBlockCache returns a new empty invalid block cache.
+func newInvalidBlockCache() *invalidBlockCache {
+	return &invalidBlockCache{
+		blocks: make(map[chainhash.Hash]*InvalidBlock),
+	}
+}
+
+// load populates the cache with the invalid blocks stored in the passed
+// database.
+func (c *invalidBlockCache) load(db database.DB) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return db.View(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(invalidBlocksBucketName)
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(k, v []byte) error {
+			e, err := deserializeInvalidBlock(k, v)
+			if err != nil {
+				return err
+			}
+			c.blocks[e.Hash] = e
+			return nil
+		})
+	})
+}
+
+// lookup returns the invalid block with the passed hash or nil when the block
+// is not known to be invalid.
+func (c *invalidBlockCache) lookup(hash *chainhash.Hash) *InvalidBlock {
+	c.mtx.RLock()
+	e := c.blocks[*hash]
+	c.mtx.RUnlock()
+	return e
+}
+
+// all returns the invalid blocks in the cache.
+func (c *invalidBlockCache) all() []*InvalidBlock {
+	c.mtx.RLock()
+	blocks := make([]*InvalidBlock, 0, len(c.blocks))
+	for _, e := range c.blocks {
+		blocks = append(blocks, e)
+	}
+	c.mtx.RUnlock()
+	return blocks
+}
+
+// add records the passed invalid block in the cache and the passed database.
+// The oldest block is removed when the cache holds more than maxInvalidBlocks
+// blocks.
+func (c *invalidBlockCache) add(db database.DB, e *InvalidBlock) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	var evict *InvalidBlock
+	if _, ok := c.blocks[e.Hash]; !ok && len(c.blocks) >= maxInvalidBlocks {
+		for _, b := range c.blocks {
+			if evict == nil || b.Time.Before(evict.Time) {
+				evict = b
+			}
+		}
+	}
+
+	err := db.Update(func(dbTx database.Tx) error {
+		bucket, err := dbTx.Metadata().CreateBucketIfNotExists(
+			invalidBlocksBucketName)
+		if err != nil {
+			return err
+		}
+		if evict != nil {
+			if err := bucket.Delete(evict.Hash[:]); err != nil {
+				return err
+			}
+		}
+		return bucket.Put(e.Hash[:], serializeInvalidBlock(e))
+	})
+	if err != nil {
+		return err
+	}
+
+	if evict != nil {
+		delete(c.blocks, evict.Hash)
+	}
+	c.blocks[e.Hash] = e
+	return nil
+}
+
+// remove removes the block with the passed hash from the cache and the passed
+// database.  It returns whether the block was cached.
+func (c *invalidBlockCache) remove(db database.DB, hash *chainhash.Hash) (bool, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if _, ok := c.blocks[*hash]; !ok {
+		return false, nil
+	}
+	err := db.Update(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(invalidBlocksBucketName)
+		if bucket == nil {
+			return nil
+		}
+		return bucket.Delete(hash[:])
+	})
+	if err != nil {
+		return false, err
+	}
+	delete(c.blocks, *hash)
+	return true, nil
+}
+
+// recordInvalidBlock records the block with the passed hash, previous block
+// hash and height as rejected for the passed reason.  Failures to record the
+// block are only logged since the block was rejected either way.
+func (b *BlockChain) recordInvalidBlock(hash, prevHash *chainhash.Hash, height int32, reason string) {
+	e := &InvalidBlock{
+		Hash:     *hash,
+		PrevHash: *prevHash,
+		Height:   height,
+		Time:     time.Unix(time.Now().Unix(), 0),
+		Reason:   reason,
+	}
+	if err := b.invalidBlocks.add(b.db, e); err != nil {
+		log.Warnf("Unable to record invalid block %v: %v", hash, err)
+		return
+	}
+	log.Infof("Marked block %v (height %d) invalid: %s", hash, height,
+		reason)
+}
+
+// forgetInvalidBlock removes the block with the passed hash from the invalid
+// block cache so it is validated again when it is offered again.  It returns
+// whether the block was cached.
+func (b *BlockChain) forgetInvalidBlock(hash *chainhash.Hash) bool {
+	cached, err := b.invalidBlocks.remove(b.db, hash)
+	if err != nil {
+		log.Warnf("Unable to remove invalid block %v: %v", hash, err)
+	}
+	return cached
+}
+
+// InvalidBlock returns the details of the block with the passed hash when it
+// was rejected for violating the consensus rules, or nil otherwise.  Only the
+// last maxInvalidBlocks rejected blocks are kept.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) InvalidBlock(hash *chainhash.Hash) *InvalidBlock {
+	return b.invalidBlocks.lookup(hash)
+}
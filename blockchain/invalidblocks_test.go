@@ -0,0 +1,124 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gcash/bchutil"
+)
+
+// TestInvalidBlockCache ensures blocks rejected for violating the consensus
+// rules are recorded along with the reason, rejected right away when they are
+// offered again, also after the cache was reloaded from the database, and
+// reported by the chain tips.
+func TestInvalidBlockCache(t *testing.T) {
+	chain, params, tearDown := utxoCacheTestChain("TestInvalidBlockCache")
+	defer tearDown()
+
+	genesis := bchutil.NewBlock(params.GenesisBlock)
+	a1, _ := addBlock(chain, genesis, nil)
+
+	// A block with an unexpected difficulty fails the contextual checks
+	// and is never added to the block index.
+	badBits, _ := makeTestBlock(chain, a1, nil)
+	badBits.MsgBlock().Header.Bits = params.PowLimitBits - 1
+	if !solveBlock(&badBits.MsgBlock().Header) {
+		t.Fatal("unable to solve block")
+	}
+	badBits = bchutil.NewBlock(badBits.MsgBlock())
+	assertRuleError := func(block *bchutil.Block, code ErrorCode) {
+		t.Helper()
+		_, _, err := chain.ProcessBlock(block, BFNone)
+		if rerr, ok := err.(RuleError); !ok || rerr.ErrorCode != code {
+			t.Fatalf("ProcessBlock %v: got error %v, want %v",
+				block.Hash(), err, code)
+		}
+	}
+	assertRuleError(badBits, ErrUnexpectedDifficulty)
+	invalid := chain.InvalidBlock(badBits.Hash())
+	if invalid == nil {
+		t.Fatalf("block %v with unexpected difficulty is not recorded",
+			badBits.Hash())
+	}
+	if invalid.Height != 2 || invalid.Reason == "" || invalid.Time.IsZero() {
+		t.Errorf("got invalid block at height %d with reason %q, "+
+			"want height 2 with a reason", invalid.Height,
+			invalid.Reason)
+	}
+	assertRuleError(badBits, ErrKnownInvalidBlock)
+
+	// Blocks building on the invalid block are rejected instead of being
+	// kept as orphans.
+	child, _ := makeTestBlock(chain, badBits, nil)
+	assertRuleError(child, ErrInvalidAncestorBlock)
+	if err := chain.ProcessBlockHeader(&badBits.MsgBlock().Header, BFNone); err == nil {
+		t.Errorf("ProcessBlockHeader accepted the header of an invalid block")
+	}
+
+	// A block paying too much in its coinbase fails to connect.  It is
+	// added to the block index so it shows up as an invalid chain tip.
+	badValue, _ := makeTestBlock(chain, a1, nil)
+	msgBlock := badValue.MsgBlock()
+	msgBlock.Transactions[0].TxOut[0].Value++
+	merkles := BuildMerkleTreeStore(bchutil.NewBlock(msgBlock).Transactions())
+	msgBlock.Header.MerkleRoot = *merkles[len(merkles)-1]
+	if !solveBlock(&msgBlock.Header) {
+		t.Fatal("unable to solve block")
+	}
+	badValue = bchutil.NewBlock(msgBlock)
+	assertRuleError(badValue, ErrBadCoinbaseValue)
+	assertRuleError(badValue, ErrKnownInvalidBlock)
+
+	tips := chain.ChainTips()
+	if len(tips) != 3 {
+		t.Fatalf("got %d chain tips, want 3", len(tips))
+	}
+	for _, tip := range tips {
+		switch tip.Hash {
+		case *a1.Hash():
+			if tip.Status != ChainTipActive || tip.BranchLen != 0 {
+				t.Errorf("got main chain tip status %v with "+
+					"branch length %d", tip.Status, tip.BranchLen)
+			}
+		case *badValue.Hash(), *badBits.Hash():
+			if tip.Status != ChainTipInvalid || tip.BranchLen != 1 {
+				t.Errorf("got invalid tip status %v with branch "+
+					"length %d", tip.Status, tip.BranchLen)
+			}
+			if tip.InvalidBlock == nil ||
+				tip.InvalidBlock.Hash != tip.Hash ||
+				tip.InvalidBlock.Reason == "" {
+
+				t.Errorf("got invalid block %v for the invalid "+
+					"tip", tip.InvalidBlock)
+			}
+		default:
+			t.Errorf("unexpected chain tip %v", tip.Hash)
+		}
+	}
+
+	// The recorded blocks are loaded from the database again.
+	reloaded := newInvalidBlockCache()
+	if err := reloaded.load(chain.db); err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if !reflect.DeepEqual(reloaded.blocks, chain.invalidBlocks.blocks) {
+		t.Errorf("got reloaded invalid blocks %v, want %v",
+			reloaded.blocks, chain.invalidBlocks.blocks)
+	}
+
+	// Reconsidering a block which was never added to the block index
+	// forgets it so it is validated again.
+	if err := chain.ReconsiderBlock(badBits.Hash()); err != nil {
+		t.Fatalf("ReconsiderBlock: %v", err)
+	}
+	if chain.InvalidBlock(badBits.Hash()) != nil {
+		t.Errorf("reconsidered block %v is still recorded as invalid",
+			badBits.Hash())
+	}
+	assertRuleError(badBits, ErrUnexpectedDifficulty)
+}
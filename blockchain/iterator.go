@@ -0,0 +1,154 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"fmt"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/database"
+	"github.com/gcash/bchutil"
+)
+
+// Cursor identifies the last block returned by an Iterator.  Indexers can
+// persist it to resume iterating where they left off with
+// NewIteratorFromCursor.
+type Cursor struct {
+	Hash   chainhash.Hash
+	Height int32
+}
+
+// IteratorBlock is a main chain block returned by an Iterator.
+type IteratorBlock struct {
+	Block *bchutil.Block
+
+	// SpentOutputs holds an entry for every output the transactions of the
+	// block spend in the order they are spent.  See SpentTxOut for details.
+	SpentOutputs []SpentTxOut
+}
+
+// InvalidatedRange is the range of blocks previously returned by an Iterator
+// which were disconnected from the main chain by a reorganization.
+type InvalidatedRange struct {
+	// Fork is the last block the iterator returned which is still part of
+	// the main chain.  The iterator continues with the block after it.
+	Fork Cursor
+
+	// Hashes holds the hashes of the invalidated blocks from the highest to
+	// the lowest, which is the order consumers should undo them in.
+	Hashes []chainhash.Hash
+}
+
+// Iterator walks the blocks of the main chain in order along with the outputs
+// they spend.  It keeps track of the last block it returned, so it stays
+// consistent across reorganizations: when the blocks it returned are no
+// longer part of the main chain, it reports them before returning the blocks
+// of the new main chain.
+//
+// An Iterator is not safe for concurrent access, but multiple iterators can
+// walk the same chain concurrently.
+type Iterator struct {
+	chain *BlockChain
+
+	// last is the last block the iterator returned.  It is nil before the
+	// genesis block is returned.
+	last *blockNode
+}
+
+// NewIterator returns an iterator whose first block is the main chain block at
+// the passed height.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) NewIterator(startHeight int32) (*Iterator, error) {
+	if startHeight == 0 {
+		return &Iterator{chain: b}, nil
+	}
+
+	node := b.bestChain.NodeByHeight(startHeight)
+	if node == nil {
+		str := fmt.Sprintf("no block at height %d exists", startHeight)
+		return nil, errNotInMainChain(str)
+	}
+	return &Iterator{chain: b, last: node.parent}, nil
+}
+
+// NewIteratorFromCursor returns an iterator whose first block is the one
+// following the block identified by the passed cursor.  The block must be
+// known, but it does not have to be part of the main chain anymore, in which
+// case the iterator starts by reporting the blocks which were invalidated.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) NewIteratorFromCursor(cursor Cursor) (*Iterator, error) {
+	node := b.index.LookupNode(&cursor.Hash)
+	if node == nil || node.height != cursor.Height {
+		return nil, fmt.Errorf("no block with hash %s at height %d "+
+			"exists", cursor.Hash, cursor.Height)
+	}
+	return &Iterator{chain: b, last: node}, nil
+}
+
+// Cursor returns the cursor of the last block returned by the iterator.  It
+// returns false when the iterator has not returned any block yet.
+func (it *Iterator) Cursor() (Cursor, bool) {
+	if it.last == nil {
+		return Cursor{}, false
+	}
+	return Cursor{Hash: it.last.hash, Height: it.last.height}, true
+}
+
+// Next advances the iterator.  It returns the next main chain block, or the
+// range of previously returned blocks which are no longer part of the main
+// chain when the chain was reorganized since the last call.  In the latter
+// case the iterator rewinds to the fork point, so the following call returns
+// the first block of the new main chain after it.  Both are nil when the
+// iterator reached the tip of the main chain.  Consumers wanting to be woken
+// up when more blocks are available can subscribe to the OnBlockConnected
+// and OnReorg notifications.
+//
+// This function is safe for concurrent access with the processing of blocks.
+func (it *Iterator) Next() (*IteratorBlock, *InvalidatedRange, error) {
+	b := it.chain
+	b.chainLock.RLock()
+	defer b.chainLock.RUnlock()
+
+	if it.last != nil && !b.bestChain.Contains(it.last) {
+		fork := b.bestChain.FindFork(it.last)
+		invalidated := &InvalidatedRange{
+			Fork: Cursor{Hash: fork.hash, Height: fork.height},
+		}
+		for node := it.last; node != fork; node = node.parent {
+			invalidated.Hashes = append(invalidated.Hashes, node.hash)
+		}
+		it.last = fork
+		return nil, invalidated, nil
+	}
+
+	var node *blockNode
+	if it.last == nil {
+		node = b.bestChain.Genesis()
+	} else {
+		node = b.bestChain.Next(it.last)
+	}
+	if node == nil {
+		return nil, nil, nil
+	}
+
+	var result IteratorBlock
+	err := b.db.View(func(dbTx database.Tx) error {
+		block, err := dbFetchBlockByNode(dbTx, node)
+		if err != nil {
+			return err
+		}
+		result.Block = block
+		result.SpentOutputs, err = dbFetchSpendJournalEntry(dbTx, block)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	it.last = node
+	return &result, nil, nil
+}
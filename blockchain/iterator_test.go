@@ -0,0 +1,119 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchutil"
+)
+
+// TestIterator ensures iterators walk the main chain along with the spent
+// outputs of its blocks and report the blocks invalidated by reorganizations.
+func TestIterator(t *testing.T) {
+	chain, params, tearDown := utxoCacheTestChain("TestIterator")
+	defer tearDown()
+
+	genesis := bchutil.NewBlock(params.GenesisBlock)
+	a1, outs1 := addBlock(chain, genesis, nil)
+	a2, _ := addBlock(chain, a1, outs1)
+
+	// expectBlocks advances the iterator and ensures it returns the passed
+	// blocks followed by the tip of the main chain.
+	expectBlocks := func(it *Iterator, blocks ...*bchutil.Block) {
+		t.Helper()
+		for _, want := range blocks {
+			got, invalidated, err := it.Next()
+			if err != nil {
+				t.Fatalf("Next: %v", err)
+			}
+			if got == nil || invalidated != nil {
+				t.Fatalf("got block %v and invalidated range %v, "+
+					"want block %v", got, invalidated, want.Hash())
+			}
+			if *got.Block.Hash() != *want.Hash() {
+				t.Fatalf("got block %v, want %v", got.Block.Hash(),
+					want.Hash())
+			}
+			spends := 0
+			for _, tx := range want.MsgBlock().Transactions[1:] {
+				spends += len(tx.TxIn)
+			}
+			if len(got.SpentOutputs) != spends {
+				t.Fatalf("got %d spent outputs for block %v, "+
+					"want %d", len(got.SpentOutputs),
+					want.Hash(), spends)
+			}
+			cursor, ok := it.Cursor()
+			if !ok || cursor.Hash != *want.Hash() {
+				t.Fatalf("got cursor %v, want %v", cursor.Hash,
+					want.Hash())
+			}
+		}
+		got, invalidated, err := it.Next()
+		if err != nil || got != nil || invalidated != nil {
+			t.Fatalf("got block %v, invalidated range %v and error "+
+				"%v at the tip", got, invalidated, err)
+		}
+	}
+
+	it, err := chain.NewIterator(0)
+	if err != nil {
+		t.Fatalf("NewIterator: %v", err)
+	}
+	if _, ok := it.Cursor(); ok {
+		t.Fatal("new iterator has a cursor")
+	}
+	expectBlocks(it, genesis, a1, a2)
+	cursor, _ := it.Cursor()
+
+	// Reorganize to a longer chain forking after the first block.
+	b2, _ := addBlock(chain, a1, nil)
+	b3, _ := addBlock(chain, b2, nil)
+
+	// expectInvalidated advances the iterator and ensures it reports the
+	// second block as invalidated.
+	expectInvalidated := func(it *Iterator) {
+		t.Helper()
+		got, invalidated, err := it.Next()
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		want := &InvalidatedRange{
+			Fork:   Cursor{Hash: *a1.Hash(), Height: 1},
+			Hashes: []chainhash.Hash{*a2.Hash()},
+		}
+		if got != nil || !reflect.DeepEqual(invalidated, want) {
+			t.Fatalf("got block %v and invalidated range %v, "+
+				"want invalidated range %v", got, invalidated, want)
+		}
+	}
+	expectInvalidated(it)
+	expectBlocks(it, b2, b3)
+
+	// Resuming from the saved cursor reports the same invalidated range.
+	it, err = chain.NewIteratorFromCursor(cursor)
+	if err != nil {
+		t.Fatalf("NewIteratorFromCursor: %v", err)
+	}
+	expectInvalidated(it)
+	expectBlocks(it, b2, b3)
+
+	it, err = chain.NewIterator(2)
+	if err != nil {
+		t.Fatalf("NewIterator: %v", err)
+	}
+	expectBlocks(it, b2, b3)
+
+	if _, err := chain.NewIterator(4); err == nil {
+		t.Error("NewIterator succeeded above the tip")
+	}
+	cursor.Height++
+	if _, err := chain.NewIteratorFromCursor(cursor); err == nil {
+		t.Error("NewIteratorFromCursor succeeded with a wrong height")
+	}
+}
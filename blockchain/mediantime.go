@@ -76,6 +76,7 @@ func (s int64Sorter) Less(i, j int) bool {
 // used in the consensus code.
 type medianTime struct {
 	mtx                sync.Mutex
+	clock              Clock
 	knownIDs           map[string]struct{}
 	offsets            []int64
 	offsetSecs         int64
@@ -95,7 +96,7 @@ func (m *medianTime) AdjustedTime() time.Time {
 	defer m.mtx.Unlock()
 
 	// Limit the adjusted time to 1 second precision.
-	now := time.Unix(time.Now().Unix(), 0)
+	now := time.Unix(m.clock.Now().Unix(), 0)
 	return now.Add(time.Duration(m.offsetSecs) * time.Second)
 }
 
@@ -118,7 +119,7 @@ func (m *medianTime) AddTimeSample(sourceID string, timeVal time.Time) {
 	// of offsets while respecting the maximum number of allowed entries by
 	// replacing the oldest entry with the new entry once the maximum number
 	// of entries is reached.
-	now := time.Unix(time.Now().Unix(), 0)
+	now := time.Unix(m.clock.Now().Unix(), 0)
 	offsetSecs := int64(timeVal.Sub(now).Seconds())
 	numOffsets := len(m.offsets)
 	if numOffsets == maxMedianTimeEntries && maxMedianTimeEntries > 0 {
@@ -211,7 +212,15 @@ func (m *medianTime) Offset() time.Duration {
 // expects the time samples to be added from the timestamp field of the version
 // message received from remote peers that successfully connect and negotiate.
 func NewMedianTime() MedianTimeSource {
+	return NewMedianTimeWithClock(SystemClock)
+}
+
+// NewMedianTimeWithClock returns a new instance of concurrency-safe
+// implementation of the MedianTimeSource interface which adjusts the time of
+// the passed clock instead of the system clock.
+func NewMedianTimeWithClock(clock Clock) MedianTimeSource {
 	return &medianTime{
+		clock:    clock,
 		knownIDs: make(map[string]struct{}),
 		offsets:  make([]int64, 0, maxMedianTimeEntries),
 	}
@@ -0,0 +1,190 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"fmt"
+
+	"github.com/gcash/bchd/database"
+)
+
+// dbMigration is a forward migration of a versioned part of the database from
+// the previous version to the next.
+type dbMigration struct {
+	// version is the version of the data after the migration.
+	version uint32
+
+	// migrate performs the migration.  It must leave the data usable with
+	// the previous version when it fails or is interrupted so the
+	// migration is attempted again on the next start.  The new version is
+	// stored once it returns without error.
+	migrate func(db database.DB, interrupt <-chan struct{}) error
+}
+
+// dbSchema describes a part of the database, such as the utxo set, whose
+// format is tracked by a version stored in the metadata bucket along with the
+// migrations which bring older versions to the latest one.
+type dbSchema struct {
+	// name is the human-readable name of the data.
+	name string
+
+	// versionKey is the key of the version in the metadata bucket.
+	versionKey []byte
+
+	// initialVersion is the version of databases created before the
+	// version key was introduced.
+	initialVersion uint32
+
+	// latestVersion is the version written when the database is created.
+	latestVersion uint32
+
+	// migrations are the migrations from initialVersion to latestVersion
+	// ordered by version.
+	migrations []dbMigration
+}
+
+// dbSchemas are the versioned parts of the database used by this package.  New
+// format changes are made by bumping the latest version of the relevant schema
+// and appending a migration to it.
+var dbSchemas = []dbSchema{
+	{
+		name:           "utxo set",
+		versionKey:     utxoSetVersionKeyName,
+		initialVersion: 1,
+		latestVersion:  latestUtxoSetBucketVersion,
+		migrations: []dbMigration{
+			{version: 2, migrate: upgradeUtxoSetToV2},
+		},
+	},
+	{
+		name:           "spend journal",
+		versionKey:     spendJournalVersionKeyName,
+		initialVersion: 1,
+		latestVersion:  latestSpendJournalBucketVersion,
+	},
+}
+
+// PendingDbMigration describes a migration of a part of the database which
+// will be applied on the next start.
+type PendingDbMigration struct {
+	// Name is the human-readable name of the migrated data.
+	Name string
+
+	// FromVersion is the version of the data in the database.
+	FromVersion uint32
+
+	// ToVersion is the version of the data after the migration.
+	ToVersion uint32
+}
+
+// dbSchemaVersion returns the version of the passed schema stored in the
+// database.  An error is returned when the database was written by a newer
+// version of the software which uses a format this version doesn't know.
+func dbSchemaVersion(dbTx database.Tx, schema *dbSchema) (uint32, error) {
+	version := dbFetchVersion(dbTx, schema.versionKey)
+	if version == 0 {
+		version = schema.initialVersion
+	}
+	if version > schema.latestVersion {
+		return 0, fmt.Errorf("the %s in the database has version %d "+
+			"which is newer than the latest version %d supported by "+
+			"this software", schema.name, version,
+			schema.latestVersion)
+	}
+	return version, nil
+}
+
+// pendingDbMigrations returns the migrations of the passed schemas which have
+// not been applied to the database yet, in the order they are applied.  A
+// database without chain state has none since it is created with the latest
+// versions.
+func pendingDbMigrations(dbTx database.Tx, schemas []dbSchema) ([]PendingDbMigration, error) {
+	if dbTx.Metadata().Get(chainStateKeyName) == nil {
+		return nil, nil
+	}
+
+	var pending []PendingDbMigration
+	for i := range schemas {
+		schema := &schemas[i]
+		version, err := dbSchemaVersion(dbTx, schema)
+		if err != nil {
+			return nil, err
+		}
+		for _, migration := range schema.migrations {
+			if migration.version <= version {
+				continue
+			}
+			pending = append(pending, PendingDbMigration{
+				Name:        schema.name,
+				FromVersion: version,
+				ToVersion:   migration.version,
+			})
+			version = migration.version
+		}
+	}
+	return pending, nil
+}
+
+// PendingDbMigrations returns the migrations which will be applied to the
+// passed database on the next start without modifying it.  An error is
+// returned when the database was written by a newer version of the software.
+func PendingDbMigrations(db database.DB) ([]PendingDbMigration, error) {
+	var pending []PendingDbMigration
+	err := db.View(func(dbTx database.Tx) error {
+		var err error
+		pending, err = pendingDbMigrations(dbTx, dbSchemas)
+		return err
+	})
+	return pending, err
+}
+
+// applyDbMigrations brings every part of the database described by the passed
+// schemas to its latest version by applying the pending migrations in order.
+// The version of each part is stored after every migration so an interrupted
+// upgrade resumes with the first migration which was not completed.
+func applyDbMigrations(db database.DB, schemas []dbSchema, interrupt <-chan struct{}) error {
+	for i := range schemas {
+		schema := &schemas[i]
+
+		// Load the version from the database or store the initial
+		// version for databases created before the version key.
+		var version uint32
+		err := db.Update(func(dbTx database.Tx) error {
+			var err error
+			version, err = dbSchemaVersion(dbTx, schema)
+			if err != nil {
+				return err
+			}
+			return dbPutVersion(dbTx, schema.versionKey, version)
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range schema.migrations {
+			if migration.version <= version {
+				continue
+			}
+			if interruptRequested(interrupt) {
+				return errInterruptRequested
+			}
+
+			log.Infof("Migrating the %s from version %d to %d",
+				schema.name, version, migration.version)
+			if err := migration.migrate(db, interrupt); err != nil {
+				return err
+			}
+			err := db.Update(func(dbTx database.Tx) error {
+				return dbPutVersion(dbTx, schema.versionKey,
+					migration.version)
+			})
+			if err != nil {
+				return err
+			}
+			version = migration.version
+		}
+	}
+	return nil
+}
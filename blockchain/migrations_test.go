@@ -0,0 +1,117 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gcash/bchd/database"
+)
+
+// TestDbMigrations ensures pending migrations are reported without modifying
+// the database and are applied in order, resuming after a failed migration.
+func TestDbMigrations(t *testing.T) {
+	chain, _, tearDown := utxoCacheTestChain("TestDbMigrations")
+	defer tearDown()
+	db := chain.db
+
+	// A newly created database is at the latest version of every schema.
+	pending, err := PendingDbMigrations(db)
+	if err != nil {
+		t.Fatalf("PendingDbMigrations: %v", err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("got pending migrations %v for a new database",
+			pending)
+	}
+
+	var applied []uint32
+	errMigration := errors.New("migration failed")
+	failVersion := uint32(3)
+	migrateTo := func(version uint32) func(database.DB, <-chan struct{}) error {
+		return func(database.DB, <-chan struct{}) error {
+			if version == failVersion {
+				return errMigration
+			}
+			applied = append(applied, version)
+			return nil
+		}
+	}
+	schemas := []dbSchema{{
+		name:           "test data",
+		versionKey:     []byte("testdataversion"),
+		initialVersion: 1,
+		latestVersion:  3,
+		migrations: []dbMigration{
+			{version: 2, migrate: migrateTo(2)},
+			{version: 3, migrate: migrateTo(3)},
+		},
+	}}
+	storedVersion := func() uint32 {
+		var version uint32
+		db.View(func(dbTx database.Tx) error {
+			version = dbFetchVersion(dbTx, schemas[0].versionKey)
+			return nil
+		})
+		return version
+	}
+
+	// Databases without the version key are at the initial version.
+	err = db.View(func(dbTx database.Tx) error {
+		pending, err = pendingDbMigrations(dbTx, schemas)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("pendingDbMigrations: %v", err)
+	}
+	want := []PendingDbMigration{
+		{Name: "test data", FromVersion: 1, ToVersion: 2},
+		{Name: "test data", FromVersion: 2, ToVersion: 3},
+	}
+	if !reflect.DeepEqual(pending, want) {
+		t.Fatalf("got pending migrations %v, want %v", pending, want)
+	}
+	if version := storedVersion(); version != 0 {
+		t.Fatalf("checking for migrations stored version %d", version)
+	}
+
+	// The version of the last successful migration is kept when a later
+	// migration fails.
+	err = applyDbMigrations(db, schemas, nil)
+	if err != errMigration {
+		t.Fatalf("applyDbMigrations: got error %v, want %v", err,
+			errMigration)
+	}
+	if version := storedVersion(); version != 2 {
+		t.Fatalf("got version %d after a failed migration, want 2",
+			version)
+	}
+
+	// Only the remaining migration is applied once it succeeds.
+	failVersion = 0
+	if err := applyDbMigrations(db, schemas, nil); err != nil {
+		t.Fatalf("applyDbMigrations: %v", err)
+	}
+	if !reflect.DeepEqual(applied, []uint32{2, 3}) {
+		t.Fatalf("got applied migrations %v, want [2 3]", applied)
+	}
+	if version := storedVersion(); version != 3 {
+		t.Fatalf("got version %d after migrating, want 3", version)
+	}
+
+	// Databases written by a newer version are rejected.
+	err = db.Update(func(dbTx database.Tx) error {
+		return dbPutVersion(dbTx, schemas[0].versionKey, 4)
+	})
+	if err != nil {
+		t.Fatalf("dbPutVersion: %v", err)
+	}
+	if err := applyDbMigrations(db, schemas, nil); err == nil {
+		t.Fatal("applyDbMigrations: expected an error for a newer " +
+			"database version")
+	}
+}
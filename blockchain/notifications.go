@@ -5,77 +5,178 @@
 package blockchain
 
 import (
-	"fmt"
-)
+	"sync"
 
-// NotificationType represents the type of a notification message.
-type NotificationType int
+	"github.com/gcash/bchutil"
+)
 
-// NotificationCallback is used for a caller to provide a callback for
-// notifications about various chain events.
-type NotificationCallback func(*Notification)
+// NotificationHandlers defines the callbacks a subscriber of block chain
+// notifications provides.  Any of them may be nil, in which case the
+// associated notification is not delivered to the subscriber.
+type NotificationHandlers struct {
+	// OnBlockAccepted is invoked when a block is accepted into the block
+	// chain.  Note that this does not necessarily mean it was added to the
+	// main chain.  For that, use OnBlockConnected.
+	OnBlockAccepted func(block *bchutil.Block)
 
-// Constants for the type of a notification message.
-const (
-	// NTBlockAccepted indicates the associated block was accepted into
-	// the block chain.  Note that this does not necessarily mean it was
-	// added to the main chain.  For that, use NTBlockConnected.
-	NTBlockAccepted NotificationType = iota
+	// OnBlockConnected is invoked when a block is connected to the main
+	// chain.
+	OnBlockConnected func(block *bchutil.Block)
 
-	// NTBlockConnected indicates the associated block was connected to the
+	// OnBlockDisconnected is invoked when a block is disconnected from the
 	// main chain.
-	NTBlockConnected
+	OnBlockDisconnected func(block *bchutil.Block)
+
+	// OnTxAcceptedToChain is invoked for each transaction of a block, in
+	// block order, after the block is connected to the main chain.
+	OnTxAcceptedToChain func(tx *bchutil.Tx, block *bchutil.Block)
+
+	// OnReorg is invoked once a reorganization of the main chain which
+	// disconnected at least one block is complete.  It is invoked after the
+	// OnBlockDisconnected and OnBlockConnected notifications of the blocks
+	// involved.  The Depth method of the entry returns the number of
+	// disconnected blocks.
+	OnReorg func(entry *ReorgLogEntry)
+}
 
-	// NTBlockDisconnected indicates the associated block was disconnected
-	// from the main chain.
-	NTBlockDisconnected
+// notificationType identifies the kind of a queued notification.
+type notificationType int
+
+const (
+	ntBlockAccepted notificationType = iota
+	ntBlockConnected
+	ntBlockDisconnected
+	ntReorg
 )
 
-// notificationTypeStrings is a map of notification types back to their constant
-// names for pretty printing.
-var notificationTypeStrings = map[NotificationType]string{
-	NTBlockAccepted:     "NTBlockAccepted",
-	NTBlockConnected:    "NTBlockConnected",
-	NTBlockDisconnected: "NTBlockDisconnected",
+// notification is a notification queued for delivery to a subscriber.  Only
+// one of block and reorg is set depending on the type.
+type notification struct {
+	typ   notificationType
+	block *bchutil.Block
+	reorg *ReorgLogEntry
+}
+
+// Subscription is a subscription to block chain notifications as returned by
+// SubscribeHandlers.
+type Subscription struct {
+	chain    *BlockChain
+	handlers NotificationHandlers
+
+	// queue is nil for synchronous subscriptions.  Otherwise it buffers the
+	// notifications delivered to the handlers by the goroutine of the
+	// subscription, which closes done when it exits.
+	queue chan *notification
+	done  chan struct{}
+
+	unsubscribeOnce sync.Once
 }
 
-// String returns the NotificationType in human-readable form.
-func (n NotificationType) String() string {
-	if s, ok := notificationTypeStrings[n]; ok {
-		return s
+// deliver invokes the handlers of the subscription for the passed
+// notification.
+func (s *Subscription) deliver(n *notification) {
+	h := &s.handlers
+	switch n.typ {
+	case ntBlockAccepted:
+		if h.OnBlockAccepted != nil {
+			h.OnBlockAccepted(n.block)
+		}
+
+	case ntBlockConnected:
+		if h.OnBlockConnected != nil {
+			h.OnBlockConnected(n.block)
+		}
+		if h.OnTxAcceptedToChain != nil {
+			for _, tx := range n.block.Transactions() {
+				h.OnTxAcceptedToChain(tx, n.block)
+			}
+		}
+
+	case ntBlockDisconnected:
+		if h.OnBlockDisconnected != nil {
+			h.OnBlockDisconnected(n.block)
+		}
+
+	case ntReorg:
+		if h.OnReorg != nil {
+			h.OnReorg(n.reorg)
+		}
 	}
-	return fmt.Sprintf("Unknown Notification Type (%d)", int(n))
 }
 
-// Notification defines notification that is sent to the caller via the callback
-// function provided during the call to New and consists of a notification type
-// as well as associated data that depends on the type as follows:
-//   - NTBlockAccepted:     *bchutil.Block
-//   - NTBlockConnected:    *bchutil.Block
-//   - NTBlockDisconnected: *bchutil.Block
-type Notification struct {
-	Type NotificationType
-	Data interface{}
+// queueHandler delivers the queued notifications of the subscription until
+// the queue is closed.  It must be run as a goroutine.
+func (s *Subscription) queueHandler() {
+	for n := range s.queue {
+		s.deliver(n)
+	}
+	close(s.done)
 }
 
-// Subscribe to block chain notifications. Registers a callback to be executed
-// when various events take place. See the documentation on Notification and
-// NotificationType for details on the types and contents of notifications.
-func (b *BlockChain) Subscribe(callback NotificationCallback) {
+// Unsubscribe stops the delivery of notifications to the subscription.  For
+// buffered subscriptions it waits until the notifications which were already
+// queued are delivered, so it must not be called from one of the handlers of
+// the subscription.  It is safe to call more than once.
+func (s *Subscription) Unsubscribe() {
+	s.unsubscribeOnce.Do(func() {
+		b := s.chain
+		b.notificationsLock.Lock()
+		for i, sub := range b.subscriptions {
+			if sub == s {
+				b.subscriptions = append(b.subscriptions[:i],
+					b.subscriptions[i+1:]...)
+				break
+			}
+		}
+		b.notificationsLock.Unlock()
+
+		if s.queue != nil {
+			close(s.queue)
+			<-s.done
+		}
+	})
+}
+
+// SubscribeHandlers subscribes to block chain notifications with the passed
+// handlers.  Each subscriber receives every notification in the order the
+// events took place.
+//
+// A queue size of zero makes the subscription synchronous: the handlers are
+// invoked by the goroutine processing the block before it continues, which
+// subscribers that must observe the chain in lockstep, such as the mempool,
+// rely on.  Otherwise the notifications are buffered in a queue of the passed
+// size and delivered to the handlers by a goroutine dedicated to the
+// subscription, so a slow subscriber doesn't delay the chain or the other
+// subscribers until its queue is full.
+func (b *BlockChain) SubscribeHandlers(handlers *NotificationHandlers, queueSize int) *Subscription {
+	s := &Subscription{
+		chain:    b,
+		handlers: *handlers,
+	}
+	if queueSize > 0 {
+		s.queue = make(chan *notification, queueSize)
+		s.done = make(chan struct{})
+		go s.queueHandler()
+	}
+
 	b.notificationsLock.Lock()
-	b.notifications = append(b.notifications, callback)
+	b.subscriptions = append(b.subscriptions, s)
 	b.notificationsLock.Unlock()
+	return s
 }
 
-// sendNotification sends a notification with the passed type and data if the
-// caller requested notifications by providing a callback function in the call
-// to New.
-func (b *BlockChain) sendNotification(typ NotificationType, data interface{}) {
-	// Generate and send the notification.
-	n := Notification{Type: typ, Data: data}
+// sendNotification sends the passed notification to all subscribers.
+// Synchronous subscribers are invoked directly while the notification is
+// queued for buffered subscribers, waiting for room in their queue when it
+// is full.
+func (b *BlockChain) sendNotification(n *notification) {
 	b.notificationsLock.RLock()
-	for _, callback := range b.notifications {
-		callback(&n)
+	for _, s := range b.subscriptions {
+		if s.queue == nil {
+			s.deliver(n)
+			continue
+		}
+		s.queue <- n
 	}
 	b.notificationsLock.RUnlock()
 }
@@ -5,9 +5,11 @@
 package blockchain
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchutil"
 )
 
 // TestNotifications ensures that notification callbacks are fired on events.
@@ -26,19 +28,23 @@ func TestNotifications(t *testing.T) {
 	defer teardownFunc()
 
 	notificationCount := 0
-	callback := func(notification *Notification) {
-		if notification.Type == NTBlockAccepted {
+	handlers := &NotificationHandlers{
+		OnBlockAccepted: func(*bchutil.Block) {
 			notificationCount++
-		}
+		},
 	}
 
-	// Register callback multiple times then assert it is called that many
-	// times.
+	// Register the handlers multiple times then assert they are called
+	// that many times.
 	const numSubscribers = 3
 	for i := 0; i < numSubscribers; i++ {
-		chain.Subscribe(callback)
+		chain.SubscribeHandlers(handlers, 0)
 	}
 
+	// Register the handlers once more and unsubscribe right away to
+	// ensure they are no longer called.
+	chain.SubscribeHandlers(handlers, 0).Unsubscribe()
+
 	_, _, err = chain.ProcessBlock(blocks[1], BFNone)
 	if err != nil {
 		t.Fatalf("ProcessBlock fail on block 1: %v\n", err)
@@ -49,3 +55,75 @@ func TestNotifications(t *testing.T) {
 			"times, found %d", numSubscribers, notificationCount)
 	}
 }
+
+// TestBufferedNotifications ensures buffered subscribers receive the
+// notifications of a reorganization in order.
+func TestBufferedNotifications(t *testing.T) {
+	chain, params, tearDown := utxoCacheTestChain("TestBufferedNotifications")
+	defer tearDown()
+
+	// The handlers are only invoked by the goroutine of the subscription,
+	// so the events don't need to be protected.
+	var events []string
+	var txs int
+	var reorg *ReorgLogEntry
+	sub := chain.SubscribeHandlers(&NotificationHandlers{
+		OnBlockConnected: func(block *bchutil.Block) {
+			events = append(events, "connected "+block.Hash().String())
+		},
+		OnBlockDisconnected: func(block *bchutil.Block) {
+			events = append(events, "disconnected "+block.Hash().String())
+		},
+		OnTxAcceptedToChain: func(*bchutil.Tx, *bchutil.Block) {
+			txs++
+		},
+		OnReorg: func(entry *ReorgLogEntry) {
+			events = append(events, "reorg")
+			reorg = entry
+		},
+	}, 1)
+
+	genesis := bchutil.NewBlock(params.GenesisBlock)
+	a1, outs1 := addBlock(chain, genesis, nil)
+	a2, _ := addBlock(chain, a1, outs1)
+	b2, _ := addBlock(chain, a1, nil)
+	b3, _ := addBlock(chain, b2, nil)
+
+	// Unsubscribing waits for the queued notifications to be delivered.
+	sub.Unsubscribe()
+
+	hash := func(block *bchutil.Block) string {
+		return block.Hash().String()
+	}
+	want := []string{
+		"connected " + hash(a1),
+		"connected " + hash(a2),
+		"disconnected " + hash(a2),
+		"connected " + hash(b2),
+		"connected " + hash(b3),
+		"reorg",
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Fatalf("got events %v, want %v", events, want)
+	}
+	wantTxs := 4 + len(a2.Transactions()) - 1
+	if txs != wantTxs {
+		t.Errorf("got %d transactions accepted to the chain, want %d",
+			txs, wantTxs)
+	}
+	if reorg.Depth() != 1 || reorg.OldTip != *a2.Hash() ||
+		reorg.NewTip != *b3.Hash() || reorg.ForkHeight != 1 {
+
+		t.Errorf("got reorg of depth %d from %v to %v forking at %d, "+
+			"want depth 1 from %v to %v forking at 1", reorg.Depth(),
+			reorg.OldTip, reorg.NewTip, reorg.ForkHeight, a2.Hash(),
+			b3.Hash())
+	}
+
+	// No notifications are delivered after unsubscribing.
+	addBlock(chain, b3, nil)
+	if len(events) != len(want) {
+		t.Errorf("got %d events after unsubscribing, want %d",
+			len(events), len(want))
+	}
+}
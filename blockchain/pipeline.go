@@ -0,0 +1,343 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"math"
+	"sync"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// maxPrevalidatedBlocks is the maximum number of blocks tracked by the block
+// pipeline at any one time.  This bounds the memory held for blocks that are
+// prevalidated but never processed.
+const maxPrevalidatedBlocks = 8
+
+// prevalidatedBlock houses a block whose context free checks and signature
+// validation were started ahead of the block being processed.
+type prevalidatedBlock struct {
+	block  *bchutil.Block
+	height int32
+
+	// flags are the behavior flags the sanity checks were run with.
+	flags BehaviorFlags
+
+	// done is closed once prevalidation has finished.  The fields below
+	// must not be accessed before then.
+	done chan struct{}
+
+	// sanityErr is the result of the context free sanity checks.
+	sanityErr error
+
+	// missingInputs is the number of non-coinbase transactions which
+	// were skipped during signature validation because an input could
+	// not be resolved.
+	missingInputs int
+}
+
+// blockPipeline tracks the blocks being prevalidated so a block can be checked
+// while the block before it is still being connected.  Blocks are keyed by
+// hash, which also allows a block to resolve the outputs created by in-flight
+// ancestors that are not yet committed to the utxo set.
+type blockPipeline struct {
+	mtx    sync.Mutex
+	blocks map[chainhash.Hash]*prevalidatedBlock
+	order  []chainhash.Hash
+}
+
+// newBlockPipeline returns a new empty block pipeline.
+func newBlockPipeline() *blockPipeline {
+	return &blockPipeline{
+		blocks: make(map[chainhash.Hash]*prevalidatedBlock),
+	}
+}
+
+// makeRoom ensures there is room for another block by evicting the oldest
+// block which has finished prevalidation.  It returns false if the pipeline
+// is full of blocks which are still being prevalidated.
+//
+// This function MUST be called with the pipeline lock held.
+func (p *blockPipeline) makeRoom() bool {
+	if len(p.blocks) < maxPrevalidatedBlocks {
+		return true
+	}
+	for i, hash := range p.order {
+		pv := p.blocks[hash]
+		select {
+		case <-pv.done:
+			delete(p.blocks, hash)
+			p.order = append(p.order[:i], p.order[i+1:]...)
+			return true
+		default:
+		}
+	}
+	return false
+}
+
+// lookup returns the tracked entry for the passed block, if any.  The entry
+// is only returned for the same block instance that was prevalidated so a
+// mutated block sharing the hash of a valid one is never given its results.
+func (p *blockPipeline) lookup(block *bchutil.Block) *prevalidatedBlock {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	pv, ok := p.blocks[*block.Hash()]
+	if !ok || pv.block != block {
+		return nil
+	}
+	return pv
+}
+
+// remove stops tracking the passed block.
+func (p *blockPipeline) remove(hash *chainhash.Hash) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.blocks[*hash]; !ok {
+		return
+	}
+	delete(p.blocks, *hash)
+	for i := range p.order {
+		if p.order[i] == *hash {
+			p.order = append(p.order[:i], p.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// PrevalidateBlock starts the context free sanity checks and signature
+// validation of the passed block in the background, so they can overlap with
+// the processing of the blocks before it.  When the block is later passed to
+// ProcessBlock, the sanity check results are reused and the signatures are
+// found in the signature cache.
+//
+// Outputs created by ancestors which are themselves being prevalidated are
+// resolved from those blocks, so a chain of blocks can be prevalidated before
+// any of them is connected.  Inputs which cannot be resolved are left to be
+// validated when the block is connected.
+//
+// The block is ignored if its height can not be determined or the pipeline
+// is full.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) PrevalidateBlock(block *bchutil.Block) {
+	hash := block.Hash()
+	prevHash := &block.MsgBlock().Header.PrevBlock
+
+	p := b.pipeline
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.blocks[*hash]; ok {
+		return
+	}
+
+	// The parent is checked in the pipeline first since it is only
+	// removed from there after it has been added to the block index.
+	var ancestors []*prevalidatedBlock
+	height := int32(-1)
+	if parent, ok := p.blocks[*prevHash]; ok {
+		height = parent.height + 1
+		for ok && len(ancestors) < maxPrevalidatedBlocks {
+			ancestors = append(ancestors, parent)
+			parent, ok = p.blocks[parent.block.MsgBlock().Header.PrevBlock]
+		}
+	} else if prevNode := b.index.LookupNode(prevHash); prevNode != nil {
+		height = prevNode.height + 1
+	}
+	if height < 0 || !p.makeRoom() {
+		return
+	}
+
+	flags := BFNone
+	if height > b.chainParams.MagneticAnonomalyForkHeight {
+		flags |= BFMagneticAnomaly
+	}
+	if height > b.chainParams.Upgrade9Activation() {
+		flags |= BFUpgrade9
+	}
+
+	// Populate the lazily cached transaction hashes up front since the
+	// block is read concurrently by the prevalidation of its descendants.
+	for _, tx := range block.Transactions() {
+		tx.Hash()
+	}
+
+	pv := &prevalidatedBlock{
+		block:  block,
+		height: height,
+		flags:  flags,
+		done:   make(chan struct{}),
+	}
+	p.blocks[*hash] = pv
+	p.order = append(p.order, *hash)
+
+	go b.prevalidate(pv, ancestors)
+}
+
+// prevalidate runs the context free sanity checks of the passed block and,
+// when they pass, validates the signatures of every transaction whose inputs
+// can be resolved in order to populate the signature cache.
+//
+// This function MUST be run as a goroutine and does not take the chain lock.
+func (b *BlockChain) prevalidate(pv *prevalidatedBlock, ancestors []*prevalidatedBlock) {
+	defer close(pv.done)
+
+	block := pv.block
+	pv.sanityErr = checkBlockSanity(block, b.chainParams.PowLimit,
+		b.timeSource, pv.flags)
+	if pv.sanityErr != nil {
+		return
+	}
+
+	// There is nothing to gain from validating signatures early when
+	// there is no signature cache or the scripts will not be run at all
+	// because the block is covered by a checkpoint.
+	if b.sigCache == nil {
+		return
+	}
+	if checkpoint := b.LatestCheckpoint(); checkpoint != nil &&
+		pv.height <= checkpoint.Height {
+
+		return
+	}
+
+	// Build a view of the outputs created by the in-flight ancestors and
+	// the block itself, falling back to the utxo set for everything else.
+	view := NewUtxoViewpoint()
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		for _, tx := range ancestors[i].block.Transactions() {
+			view.AddTxOuts(tx, ancestors[i].height)
+		}
+	}
+	for _, tx := range block.Transactions() {
+		view.AddTxOuts(tx, pv.height)
+	}
+
+	scriptFlags := b.prevalidateScriptFlags(&block.MsgBlock().Header, pv.height)
+	var items []*txValidateItem
+	for _, tx := range block.Transactions()[1:] {
+		resolved := true
+		for _, txIn := range tx.MsgTx().TxIn {
+			if view.LookupEntry(txIn.PreviousOutPoint) != nil {
+				continue
+			}
+			entry, err := b.utxoCache.FetchEntry(txIn.PreviousOutPoint)
+			if err != nil || entry == nil || entry.IsSpent() {
+				resolved = false
+				break
+			}
+			view.entries[txIn.PreviousOutPoint] = entry
+		}
+		if !resolved {
+			pv.missingInputs++
+			continue
+		}
+
+		sigHashes := txSigHashes(tx, view, scriptFlags, nil)
+		sigChecks := uint32(0)
+		for txInIdx, txIn := range tx.MsgTx().TxIn {
+			if txIn.PreviousOutPoint.Index == math.MaxUint32 {
+				continue
+			}
+			items = append(items, &txValidateItem{
+				txInIndex:   txInIdx,
+				txIn:        txIn,
+				tx:          tx,
+				sigHashes:   sigHashes,
+				txSigChecks: &sigChecks,
+			})
+		}
+	}
+
+	// A failure here is not acted upon.  The block is fully validated when
+	// it is connected, which produces the authoritative error.
+	validator := newTxValidator(view, scriptFlags, b.sigCache, nil, 0,
+		b.chainParams.Upgrade9Activation())
+	if err := validator.Validate(items); err != nil {
+		log.Debugf("Prevalidation of block %v failed: %v", block.Hash(), err)
+	}
+}
+
+// prevalidateScriptFlags returns the script flags used to prevalidate a block
+// with the passed header and height.  It mirrors the flags computed by
+// checkConnectBlock, except that the rules activated by median time past and
+// deployments are approximated from the block itself since the parent may not
+// be in the block index yet.  Those rules only enable opcodes and never change
+// how a signature is interpreted, so the signature cache entries produced are
+// valid either way.
+func (b *BlockChain) prevalidateScriptFlags(header *wire.BlockHeader, height int32) txscript.ScriptFlags {
+	var scriptFlags txscript.ScriptFlags
+	if header.Timestamp.Unix() >= txscript.Bip16Activation.Unix() {
+		scriptFlags |= txscript.ScriptBip16
+	}
+	if header.Version >= 3 && height >= b.chainParams.BIP0066Height {
+		scriptFlags |= txscript.ScriptVerifyDERSignatures
+	}
+	if header.Version >= 4 && height >= b.chainParams.BIP0065Height {
+		scriptFlags |= txscript.ScriptVerifyCheckLockTimeVerify
+	}
+	if height >= b.chainParams.CSVHeight {
+		scriptFlags |= txscript.ScriptVerifyCheckSequenceVerify
+	}
+	if height > b.chainParams.UahfForkHeight {
+		scriptFlags |= txscript.ScriptVerifyStrictEncoding | txscript.ScriptVerifyBip143SigHash
+	}
+	if height > b.chainParams.DaaForkHeight {
+		scriptFlags |= txscript.ScriptVerifyLowS | txscript.ScriptVerifyNullFail
+	}
+	if height > b.chainParams.MagneticAnonomalyForkHeight {
+		scriptFlags |= txscript.ScriptVerifySigPushOnly |
+			txscript.ScriptVerifyCleanStack |
+			txscript.ScriptVerifyCheckDataSig
+	}
+	if height > b.chainParams.GreatWallForkHeight {
+		scriptFlags |= txscript.ScriptVerifySchnorr | txscript.ScriptVerifyAllowSegwitRecovery
+	}
+	if height > b.chainParams.GravitonForkHeight {
+		scriptFlags |= txscript.ScriptVerifyMinimalData | txscript.ScriptVerifySchnorrMultisig
+	}
+	if height > b.chainParams.PhononForkHeight {
+		scriptFlags |= txscript.ScriptReportSigChecks | txscript.ScriptVerifyReverseBytes
+	}
+	if header.Timestamp.Unix() >= int64(b.chainParams.CosmicInflationActivationTime) {
+		scriptFlags |= txscript.ScriptVerify64BitIntegers | txscript.ScriptVerifyNativeIntrospection
+	}
+	if height > b.chainParams.Upgrade9Activation() {
+		scriptFlags |= txscript.ScriptAllowCashTokens
+	}
+	if header.Timestamp.Unix() >= int64(b.chainParams.Upgrade11Activation()) {
+		scriptFlags |= txscript.ScriptAllowMay2025
+	}
+	return b.scriptFlagOverrides.Apply(scriptFlags)
+}
+
+// NextBlockScriptFlags returns the script flags which apply to the block
+// extending the current best chain, including the configured overrides.  The
+// rules activated by median time past are evaluated against the median time
+// past of the current tip, and the block is assumed to signal the rules
+// activated by block versions.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) NextBlockScriptFlags() txscript.ScriptFlags {
+	snapshot := b.BestSnapshot()
+	header := wire.BlockHeader{
+		Version:   4,
+		Timestamp: snapshot.MedianTime,
+	}
+	return b.prevalidateScriptFlags(&header, snapshot.Height+1)
+}
+
+// ScriptFlagOverrides returns the script flag overrides applied when
+// validating blocks.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) ScriptFlagOverrides() txscript.ScriptFlagOverrides {
+	return b.scriptFlagOverrides
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchutil"
+)
+
+func TestPrevalidateBlock(t *testing.T) {
+	chain, params, tearDown := utxoCacheTestChain("TestPrevalidateBlock")
+	defer tearDown()
+	chain.sigCache = txscript.NewSigCache(100)
+	tip := bchutil.NewBlock(params.GenesisBlock)
+	tip, spends := addBlock(chain, tip, nil)
+
+	// Build two blocks without processing them where the second spends
+	// the outputs created by the first.
+	block1, outs := makeTestBlock(chain, tip, spends)
+	block2, _ := makeTestBlock(chain, block1, outs)
+
+	chain.PrevalidateBlock(block1)
+	chain.PrevalidateBlock(block2)
+	for _, block := range []*bchutil.Block{block1, block2} {
+		pv := chain.pipeline.lookup(block)
+		if pv == nil {
+			t.Fatalf("Block %v is not being prevalidated", block.Hash())
+		}
+		<-pv.done
+		if pv.sanityErr != nil {
+			t.Fatalf("Unexpected sanity error for block %v: %v",
+				block.Hash(), pv.sanityErr)
+		}
+		if pv.missingInputs != 0 {
+			t.Fatalf("Expected all inputs of block %v to be resolved, "+
+				"%d transactions were skipped", block.Hash(),
+				pv.missingInputs)
+		}
+	}
+
+	// A different instance of the same block is not given the results.
+	if chain.pipeline.lookup(bchutil.NewBlock(block1.MsgBlock())) != nil {
+		t.Fatal("Prevalidation results returned for a different instance")
+	}
+
+	for _, block := range []*bchutil.Block{block1, block2} {
+		if _, _, err := chain.ProcessBlock(block, BFNone); err != nil {
+			t.Fatalf("Failed to process block %v: %v", block.Hash(), err)
+		}
+	}
+	if len(chain.pipeline.blocks) != 0 {
+		t.Fatalf("Expected an empty pipeline, has %d blocks",
+			len(chain.pipeline.blocks))
+	}
+
+	// A block failing the sanity checks during prevalidation must be
+	// rejected with the same error when processed.
+	block3, _ := makeTestBlock(chain, block2, nil)
+	msgBlock := block3.MsgBlock()
+	msgBlock.Header.MerkleRoot = chainhash.Hash{}
+	if !solveBlock(&msgBlock.Header) {
+		t.Fatal("Unable to solve block")
+	}
+	block3 = bchutil.NewBlock(msgBlock)
+
+	chain.PrevalidateBlock(block3)
+	_, _, err := chain.ProcessBlock(block3, BFNone)
+	if rerr, ok := err.(RuleError); !ok || rerr.ErrorCode != ErrBadMerkleRoot {
+		t.Fatalf("Expected ErrBadMerkleRoot, got %v", err)
+	}
+	if len(chain.pipeline.blocks) != 0 {
+		t.Fatalf("Expected an empty pipeline, has %d blocks",
+			len(chain.pipeline.blocks))
+	}
+}
@@ -6,9 +6,11 @@ package blockchain
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/gcash/bchd/chaincfg/chainhash"
 	"github.com/gcash/bchd/database"
+	"github.com/gcash/bchd/wire"
 	"github.com/gcash/bchutil"
 )
 
@@ -167,6 +169,14 @@ func (b *BlockChain) ProcessBlock(block *bchutil.Block, flags BehaviorFlags) (bo
 	blockHash := block.Hash()
 	log.Tracef("Processing block %v", blockHash)
 
+	// Blocks which were already rejected for violating the consensus rules
+	// are rejected right away, even across restarts.
+	if invalid := b.invalidBlocks.lookup(blockHash); invalid != nil {
+		str := fmt.Sprintf("block %v is known to be invalid: %s",
+			blockHash, invalid.Reason)
+		return false, false, ruleError(ErrKnownInvalidBlock, str)
+	}
+
 	if !flags.HasFlag(BFNoDupBlockCheck) {
 		// The block must not already exist in the main chain or side chains.
 		exists, err := b.blockExists(blockHash)
@@ -185,6 +195,17 @@ func (b *BlockChain) ProcessBlock(block *bchutil.Block, flags BehaviorFlags) (bo
 		}
 	}
 
+	// Time the phases of processing the block.  The transactions are
+	// decoded and hashed up front so the time is attributed to
+	// deserialization rather than to the sanity checks.
+	b.validationStats.start(blockHash)
+	defer b.validationStats.finish(block, false, false)
+	start := time.Now()
+	for _, tx := range block.Transactions() {
+		tx.Hash()
+	}
+	b.validationStats.record(blockHash, phaseDeserialize, start)
+
 	prevHash := &block.MsgBlock().Header.PrevBlock
 	prevNode := b.index.LookupNode(prevHash)
 
@@ -196,15 +217,35 @@ func (b *BlockChain) ProcessBlock(block *bchutil.Block, flags BehaviorFlags) (bo
 		flags |= BFMagneticAnomaly
 	}
 
-	if block.Height() > b.chainParams.Upgrade9ForkHeight {
+	if block.Height() > b.chainParams.Upgrade9Activation() {
 		flags |= BFUpgrade9
 	}
 
 	// Perform preliminary sanity checks on the block and its transactions.
-	err := checkBlockSanity(block, b.chainParams.PowLimit, b.timeSource, flags)
+	// When the block was prevalidated with the same rules, the result of
+	// those checks is reused.  A prevalidation run includes the proof of
+	// work check so it also holds when that check is skipped.
+	//
+	// The block stays in the pipeline until it has been processed so
+	// blocks building on it can still resolve its outputs.
+	var err error
+	start = time.Now()
+	sanityFlags := BFMagneticAnomaly | BFUpgrade9
+	if pv := b.pipeline.lookup(block); pv != nil {
+		defer b.pipeline.remove(blockHash)
+		<-pv.done
+		if pv.flags&sanityFlags == flags&sanityFlags {
+			err = pv.sanityErr
+		} else {
+			err = checkBlockSanity(block, b.chainParams.PowLimit, b.timeSource, flags)
+		}
+	} else {
+		err = checkBlockSanity(block, b.chainParams.PowLimit, b.timeSource, flags)
+	}
 	if err != nil {
 		return false, false, err
 	}
+	b.validationStats.record(blockHash, phaseSanity, start)
 
 	// Handle orphan blocks.
 	prevHashExists, err := b.blockExists(prevHash)
@@ -212,6 +253,12 @@ func (b *BlockChain) ProcessBlock(block *bchutil.Block, flags BehaviorFlags) (bo
 		return false, false, err
 	}
 	if !prevHashExists {
+		if b.invalidBlocks.lookup(prevHash) != nil {
+			str := fmt.Sprintf("previous block %s is known to be "+
+				"invalid", prevHash)
+			return false, false, ruleError(ErrInvalidAncestorBlock, str)
+		}
+
 		log.Infof("Adding orphan block %v with parent %v", blockHash, prevHash)
 		b.addOrphanBlock(block)
 
@@ -234,6 +281,59 @@ func (b *BlockChain) ProcessBlock(block *bchutil.Block, flags BehaviorFlags) (bo
 	}
 
 	log.Debugf("Accepted block %v", blockHash)
+	b.validationStats.finish(block, true, isMainChain)
 
 	return isMainChain, false, nil
 }
+
+// ProcessBlockHeader validates the passed header of a block which is not
+// available yet.  It ensures the header follows all of the rules which don't
+// depend on the transactions of the block and that it connects to a known
+// block which is not known to be invalid, so the header can be relayed before
+// the block itself is downloaded.  The header is not added to the chain.
+//
+// A RuleError with ErrDuplicateBlock is returned when the block is already
+// known and one with ErrPreviousBlockUnknown when its parent isn't.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) ProcessBlockHeader(header *wire.BlockHeader, flags BehaviorFlags) error {
+	b.chainLock.Lock()
+	defer b.chainLock.Unlock()
+
+	blockHash := header.BlockHash()
+	if invalid := b.invalidBlocks.lookup(&blockHash); invalid != nil {
+		str := fmt.Sprintf("block %v is known to be invalid: %s",
+			blockHash, invalid.Reason)
+		return ruleError(ErrKnownInvalidBlock, str)
+	}
+	exists, err := b.blockExists(&blockHash)
+	if err != nil {
+		return err
+	}
+	if exists {
+		str := fmt.Sprintf("already have block %v", blockHash)
+		return ruleError(ErrDuplicateBlock, str)
+	}
+
+	err = checkBlockHeaderSanity(header, b.chainParams.PowLimit,
+		b.timeSource, flags)
+	if err != nil {
+		return err
+	}
+
+	prevHash := &header.PrevBlock
+	prevNode := b.index.LookupNode(prevHash)
+	if prevNode == nil {
+		if b.invalidBlocks.lookup(prevHash) != nil {
+			str := fmt.Sprintf("previous block %s is known to be "+
+				"invalid", prevHash)
+			return ruleError(ErrInvalidAncestorBlock, str)
+		}
+		str := fmt.Sprintf("previous block %s is unknown", prevHash)
+		return ruleError(ErrPreviousBlockUnknown, str)
+	} else if b.index.NodeStatus(prevNode).KnownInvalid() {
+		str := fmt.Sprintf("previous block %s is known to be invalid", prevHash)
+		return ruleError(ErrInvalidAncestorBlock, str)
+	}
+	return b.checkBlockHeaderContext(header, prevNode, flags)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchutil"
+)
+
+func TestProcessBlockHeader(t *testing.T) {
+	chain, params, tearDown := utxoCacheTestChain("TestProcessBlockHeader")
+	defer tearDown()
+	tip := bchutil.NewBlock(params.GenesisBlock)
+	tip, _ = addBlock(chain, tip, nil)
+
+	// The header of a block building on the tip is accepted without adding
+	// the block to the chain.
+	block, _ := makeTestBlock(chain, tip, nil)
+	header := block.MsgBlock().Header
+	if err := chain.ProcessBlockHeader(&header, BFNone); err != nil {
+		t.Fatalf("Unexpected error for valid header: %v", err)
+	}
+	if have, _ := chain.HaveBlock(block.Hash()); have {
+		t.Fatal("Block added to the chain by its header")
+	}
+
+	// A header with an unknown parent is rejected.
+	orphan := header
+	orphan.PrevBlock = chainhash.Hash{0x01}
+	if !solveBlock(&orphan) {
+		t.Fatal("Unable to solve block")
+	}
+	err := chain.ProcessBlockHeader(&orphan, BFNone)
+	if rerr, ok := err.(RuleError); !ok || rerr.ErrorCode != ErrPreviousBlockUnknown {
+		t.Fatalf("Expected ErrPreviousBlockUnknown, got %v", err)
+	}
+
+	// A header with the wrong difficulty is rejected.
+	badBits := header
+	badBits.Bits--
+	if !solveBlock(&badBits) {
+		t.Fatal("Unable to solve block")
+	}
+	err = chain.ProcessBlockHeader(&badBits, BFNone)
+	if rerr, ok := err.(RuleError); !ok || rerr.ErrorCode != ErrUnexpectedDifficulty {
+		t.Fatalf("Expected ErrUnexpectedDifficulty, got %v", err)
+	}
+
+	// The header of a known block is reported as a duplicate.
+	if _, _, err := chain.ProcessBlock(block, BFNone); err != nil {
+		t.Fatalf("Failed to process block %v: %v", block.Hash(), err)
+	}
+	err = chain.ProcessBlockHeader(&header, BFNone)
+	if rerr, ok := err.(RuleError); !ok || rerr.ErrorCode != ErrDuplicateBlock {
+		t.Fatalf("Expected ErrDuplicateBlock, got %v", err)
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/database"
+	"github.com/gcash/bchutil"
+)
+
+const (
+	// maxReorgLogEntries is the maximum number of reorganizations kept in
+	// the reorg log.  The oldest entries are removed first.
+	maxReorgLogEntries = 1000
+
+	// reorgLogEntryHeaderSize is the size of the fixed part of a serialized
+	// reorg log entry: the time, the old and new tip hashes and heights,
+	// the fork height and the number of transactions.
+	reorgLogEntryHeaderSize = 8 + 2*(chainhash.HashSize+4) + 4 + 4
+)
+
+// reorgLogBucketName is the name of the db bucket used to house the log of
+// chain reorganizations.  It is keyed by a big endian sequence number so the
+// entries are iterated in the order they were added.
+var reorgLogBucketName = []byte("reorglog")
+
+// ReorgLogEntry describes a reorganization of the main chain.
+type ReorgLogEntry struct {
+	// Time is when the reorganization happened.
+	Time time.Time
+
+	// OldTip and OldHeight are the hash and height of the main chain tip
+	// before the reorganization and NewTip and NewHeight those after it.
+	OldTip    chainhash.Hash
+	OldHeight int32
+	NewTip    chainhash.Hash
+	NewHeight int32
+
+	// ForkHeight is the height of the last block the old and new main
+	// chains have in common.
+	ForkHeight int32
+
+	// Txids holds the hashes of the transactions of the disconnected
+	// blocks which are not part of the new main chain.  These were
+	// returned to the mempool when they are still valid.
+	Txids []chainhash.Hash
+}
+
+// Depth returns the number of blocks disconnected by the reorganization.
+func (e *ReorgLogEntry) Depth() int32 {
+	return e.OldHeight - e.ForkHeight
+}
+
+// serializeReorgLogEntry returns the passed entry serialized for storage in the
+// reorg log bucket.
+func serializeReorgLogEntry(e *ReorgLogEntry) []byte {
+	serialized := make([]byte, reorgLogEntryHeaderSize+
+		len(e.Txids)*chainhash.HashSize)
+	byteOrder.PutUint64(serialized[0:8], uint64(e.Time.Unix()))
+	offset := 8
+	copy(serialized[offset:], e.OldTip[:])
+	offset += chainhash.HashSize
+	byteOrder.PutUint32(serialized[offset:], uint32(e.OldHeight))
+	offset += 4
+	copy(serialized[offset:], e.NewTip[:])
+	offset += chainhash.HashSize
+	byteOrder.PutUint32(serialized[offset:], uint32(e.NewHeight))
+	offset += 4
+	byteOrder.PutUint32(serialized[offset:], uint32(e.ForkHeight))
+	offset += 4
+	byteOrder.PutUint32(serialized[offset:], uint32(len(e.Txids)))
+	offset += 4
+	for i := range e.Txids {
+		copy(serialized[offset:], e.Txids[i][:])
+		offset += chainhash.HashSize
+	}
+	return serialized
+}
+
+// deserializeReorgLogEntry decodes a reorg log entry serialized with
+// serializeReorgLogEntry.
+func deserializeReorgLogEntry(serialized []byte) (*ReorgLogEntry, error) {
+	if len(serialized) < reorgLogEntryHeaderSize {
+		return nil, database.Error{
+			ErrorCode:   database.ErrCorruption,
+			Description: "corrupt reorg log entry",
+		}
+	}
+
+	e := &ReorgLogEntry{
+		Time: time.Unix(int64(byteOrder.Uint64(serialized[0:8])), 0),
+	}
+	offset := 8
+	copy(e.OldTip[:], serialized[offset:])
+	offset += chainhash.HashSize
+	e.OldHeight = int32(byteOrder.Uint32(serialized[offset:]))
+	offset += 4
+	copy(e.NewTip[:], serialized[offset:])
+	offset += chainhash.HashSize
+	e.NewHeight = int32(byteOrder.Uint32(serialized[offset:]))
+	offset += 4
+	e.ForkHeight = int32(byteOrder.Uint32(serialized[offset:]))
+	offset += 4
+	numTxids := int(byteOrder.Uint32(serialized[offset:]))
+	offset += 4
+	if len(serialized)-offset != numTxids*chainhash.HashSize {
+		return nil, database.Error{
+			ErrorCode:   database.ErrCorruption,
+			Description: "corrupt reorg log entry",
+		}
+	}
+	e.Txids = make([]chainhash.Hash, numTxids)
+	for i := range e.Txids {
+		copy(e.Txids[i][:], serialized[offset:])
+		offset += chainhash.HashSize
+	}
+	return e, nil
+}
+
+// dbPutReorgLogEntry appends the passed entry to the reorg log and removes the
+// oldest entries when the log has more than maxReorgLogEntries entries.
+func dbPutReorgLogEntry(dbTx database.Tx, e *ReorgLogEntry) error {
+	bucket, err := dbTx.Metadata().CreateBucketIfNotExists(reorgLogBucketName)
+	if err != nil {
+		return err
+	}
+
+	var seq uint64
+	numEntries := 1
+	c := bucket.Cursor()
+	if c.Last() {
+		seq = binary.BigEndian.Uint64(c.Key()) + 1
+		for ok := true; ok; ok = c.Prev() {
+			numEntries++
+		}
+	}
+	var key [8]byte
+	binary.BigEndian.PutUint64(key[:], seq)
+	if err := bucket.Put(key[:], serializeReorgLogEntry(e)); err != nil {
+		return err
+	}
+
+	c = bucket.Cursor()
+	for ok := c.First(); ok && numEntries > maxReorgLogEntries; ok = c.Next() {
+		if err := c.Delete(); err != nil {
+			return err
+		}
+		numEntries--
+	}
+	return nil
+}
+
+// newReorgLogEntry returns the reorg log entry of a reorganization which
+// disconnected the passed blocks from the main chain and connected the passed
+// blocks in their place.
+func newReorgLogEntry(oldBest, forkNode, newBest *blockNode, detachBlocks,
+	attachBlocks []*bchutil.Block) *ReorgLogEntry {
+
+	confirmed := make(map[chainhash.Hash]struct{})
+	for _, block := range attachBlocks {
+		for _, tx := range block.Transactions() {
+			confirmed[*tx.Hash()] = struct{}{}
+		}
+	}
+
+	e := &ReorgLogEntry{
+		Time:       time.Unix(time.Now().Unix(), 0),
+		OldTip:     oldBest.hash,
+		OldHeight:  oldBest.height,
+		NewTip:     newBest.hash,
+		NewHeight:  newBest.height,
+		ForkHeight: forkNode.height,
+	}
+	for _, block := range detachBlocks {
+		// Coinbase transactions of disconnected blocks can never be
+		// returned to the mempool.
+		for _, tx := range block.Transactions()[1:] {
+			if _, ok := confirmed[*tx.Hash()]; !ok {
+				e.Txids = append(e.Txids, *tx.Hash())
+			}
+		}
+	}
+	return e
+}
+
+// reorgLogEntryHasTx returns whether the passed serialized reorg log entry
+// lists the passed transaction without deserializing it.
+func reorgLogEntryHasTx(serialized []byte, txHash *chainhash.Hash) bool {
+	if len(serialized) < reorgLogEntryHeaderSize {
+		return false
+	}
+	for offset := reorgLogEntryHeaderSize; offset+chainhash.HashSize <=
+		len(serialized); offset += chainhash.HashSize {
+
+		if bytes.Equal(serialized[offset:offset+chainhash.HashSize], txHash[:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReorgLog returns up to count logged reorganizations of the main chain, most
+// recent first.  When a transaction hash is passed, only the reorganizations
+// which disconnected the transaction are returned.  A negative count returns
+// all of the matching entries.  Only the last maxReorgLogEntries
+// reorganizations are kept.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) ReorgLog(count int, txHash *chainhash.Hash) ([]*ReorgLogEntry, error) {
+	var entries []*ReorgLogEntry
+	err := b.db.View(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(reorgLogBucketName)
+		if bucket == nil {
+			return nil
+		}
+		c := bucket.Cursor()
+		for ok := c.Last(); ok && (count < 0 || len(entries) < count); ok = c.Prev() {
+			v := c.Value()
+			if txHash != nil && !reorgLogEntryHasTx(v, txHash) {
+				continue
+			}
+			e, err := deserializeReorgLogEntry(v)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, e)
+		}
+		return nil
+	})
+	return entries, err
+}
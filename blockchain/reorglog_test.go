@@ -0,0 +1,141 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/database"
+	"github.com/gcash/bchutil"
+)
+
+// TestReorgLog ensures reorganizations are logged along with the transactions
+// of the disconnected blocks which the new main chain doesn't include.
+func TestReorgLog(t *testing.T) {
+	chain, params, tearDown := utxoCacheTestChain("TestReorgLog")
+	defer tearDown()
+
+	genesis := bchutil.NewBlock(params.GenesisBlock)
+	a1, outs1 := addBlock(chain, genesis, nil)
+	a2, outs2 := addBlock(chain, a1, outs1)
+	a3, _ := addBlock(chain, a2, outs2)
+
+	entries, err := chain.ReorgLog(-1, nil)
+	if err != nil {
+		t.Fatalf("ReorgLog: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("got %d reorg log entries without a reorganize",
+			len(entries))
+	}
+
+	// Reorganize to a longer chain forking after the first block which
+	// includes the transaction of the second block, but not those of the
+	// third block.
+	b2, _ := makeTestBlock(chain, a1, nil)
+	b3, _ := makeTestBlock(chain, b2, nil)
+	msgBlock := b3.MsgBlock()
+	msgBlock.Transactions = append(msgBlock.Transactions,
+		a2.MsgBlock().Transactions[1:]...)
+	merkles := BuildMerkleTreeStore(bchutil.NewBlock(msgBlock).Transactions())
+	msgBlock.Header.MerkleRoot = *merkles[len(merkles)-1]
+	if !solveBlock(&msgBlock.Header) {
+		t.Fatal("unable to solve block")
+	}
+	b3 = bchutil.NewBlock(msgBlock)
+	b4, _ := makeTestBlock(chain, b3, nil)
+	for _, block := range []*bchutil.Block{b2, b3, b4} {
+		if _, _, err := chain.ProcessBlock(block, BFNone); err != nil {
+			t.Fatalf("ProcessBlock %v: %v", block.Hash(), err)
+		}
+	}
+	if best := chain.BestSnapshot(); best.Hash != *b4.Hash() {
+		t.Fatalf("best block is %v, want %v", best.Hash, b4.Hash())
+	}
+
+	entries, err = chain.ReorgLog(-1, nil)
+	if err != nil {
+		t.Fatalf("ReorgLog: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("got %d reorg log entries, want 1", len(entries))
+	}
+	entry := entries[0]
+	if entry.OldTip != *a3.Hash() || entry.OldHeight != 3 ||
+		entry.NewTip != *b4.Hash() || entry.NewHeight != 4 ||
+		entry.ForkHeight != 1 || entry.Depth() != 2 {
+
+		t.Errorf("got reorg from %v (height %d) to %v (height %d) "+
+			"forking at %d, want from %v (height 3) to %v (height 4) "+
+			"forking at 1", entry.OldTip, entry.OldHeight, entry.NewTip,
+			entry.NewHeight, entry.ForkHeight, a3.Hash(), b4.Hash())
+	}
+	var want []chainhash.Hash
+	for _, tx := range a3.Transactions()[1:] {
+		want = append(want, *tx.Hash())
+	}
+	if !reflect.DeepEqual(entry.Txids, want) {
+		t.Errorf("got reorg txids %v, want %v", entry.Txids, want)
+	}
+	if entry.Time.IsZero() {
+		t.Error("reorg log entry has no time")
+	}
+
+	// The entries are limited to the passed count and filtered by the
+	// passed transaction.
+	entries, err = chain.ReorgLog(0, nil)
+	if err != nil || len(entries) != 0 {
+		t.Errorf("ReorgLog(0): got %d entries, err %v, want none",
+			len(entries), err)
+	}
+	entries, err = chain.ReorgLog(-1, &want[0])
+	if err != nil || len(entries) != 1 {
+		t.Errorf("ReorgLog(%v): got %d entries, err %v, want 1",
+			want[0], len(entries), err)
+	}
+	entries, err = chain.ReorgLog(-1, a1.Hash())
+	if err != nil || len(entries) != 0 {
+		t.Errorf("ReorgLog(%v): got %d entries, err %v, want none",
+			a1.Hash(), len(entries), err)
+	}
+}
+
+// TestReorgLogSerialization ensures reorg log entries survive a round trip
+// through their serialization and that truncated entries are rejected.
+func TestReorgLogSerialization(t *testing.T) {
+	entry := &ReorgLogEntry{
+		OldTip:     chainhash.DoubleHashH([]byte("old")),
+		OldHeight:  100,
+		NewTip:     chainhash.DoubleHashH([]byte("new")),
+		NewHeight:  101,
+		ForkHeight: 98,
+		Txids: []chainhash.Hash{
+			chainhash.DoubleHashH([]byte("tx1")),
+			chainhash.DoubleHashH([]byte("tx2")),
+		},
+	}
+	serialized := serializeReorgLogEntry(entry)
+	got, err := deserializeReorgLogEntry(serialized)
+	if err != nil {
+		t.Fatalf("deserializeReorgLogEntry: %v", err)
+	}
+	if !got.Time.Equal(entry.Time) {
+		t.Errorf("got time %v, want %v", got.Time, entry.Time)
+	}
+	got.Time = entry.Time
+	if !reflect.DeepEqual(got, entry) {
+		t.Errorf("got entry %+v, want %+v", got, entry)
+	}
+
+	for _, size := range []int{0, reorgLogEntryHeaderSize - 1, len(serialized) - 1} {
+		_, err := deserializeReorgLogEntry(serialized[:size])
+		if derr, ok := err.(database.Error); !ok || derr.ErrorCode != database.ErrCorruption {
+			t.Errorf("deserializing %d bytes: got error %v, want a "+
+				"corruption error", size, err)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/database"
+	"github.com/gcash/bchd/txscript"
+)
+
+// blockScriptFlagsBucketName is the name of the db bucket used to house the
+// script flags the blocks of the main chain were validated with.  It is keyed
+// by the hash of the blocks.
+var blockScriptFlagsBucketName = []byte("blockscriptflags")
+
+// BlockScriptFlags describes the script flags a block was validated with.
+type BlockScriptFlags struct {
+	// Flags holds the script flags active for the block, including the
+	// configured overrides.
+	Flags txscript.ScriptFlags
+
+	// ScriptsRun is whether the scripts of the block were executed.  They
+	// are skipped for the blocks before the latest checkpoint.
+	ScriptsRun bool
+}
+
+// serializeBlockScriptFlags returns the passed script flags serialized for
+// storage in the block script flags bucket.
+func serializeBlockScriptFlags(f *BlockScriptFlags) []byte {
+	var serialized [5]byte
+	byteOrder.PutUint32(serialized[0:4], uint32(f.Flags))
+	if f.ScriptsRun {
+		serialized[4] = 1
+	}
+	return serialized[:]
+}
+
+// dbPutBlockScriptFlags uses an existing database transaction to record the
+// script flags the block with the passed hash was validated with.
+func dbPutBlockScriptFlags(dbTx database.Tx, hash *chainhash.Hash, f *BlockScriptFlags) error {
+	bucket, err := dbTx.Metadata().CreateBucketIfNotExists(
+		blockScriptFlagsBucketName)
+	if err != nil {
+		return err
+	}
+	return bucket.Put(hash[:], serializeBlockScriptFlags(f))
+}
+
+// dbFetchBlockScriptFlags uses an existing database transaction to fetch the
+// script flags the block with the passed hash was validated with.  It returns
+// nil when they were not recorded.
+func dbFetchBlockScriptFlags(dbTx database.Tx, hash *chainhash.Hash) (*BlockScriptFlags, error) {
+	bucket := dbTx.Metadata().Bucket(blockScriptFlagsBucketName)
+	if bucket == nil {
+		return nil, nil
+	}
+	serialized := bucket.Get(hash[:])
+	if serialized == nil {
+		return nil, nil
+	}
+	if len(serialized) != 5 {
+		return nil, database.Error{
+			ErrorCode:   database.ErrCorruption,
+			Description: "corrupt block script flags entry",
+		}
+	}
+	return &BlockScriptFlags{
+		Flags:      txscript.ScriptFlags(byteOrder.Uint32(serialized[0:4])),
+		ScriptsRun: serialized[4] != 0,
+	}, nil
+}
+
+// BlockScriptFlags returns the script flags the block with the passed hash was
+// validated with when it was connected to the main chain.  It returns nil when
+// they were not recorded, which is the case for blocks connected without being
+// validated, such as during a fast sync, or before the flags were recorded.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) BlockScriptFlags(hash *chainhash.Hash) (*BlockScriptFlags, error) {
+	var flags *BlockScriptFlags
+	err := b.db.View(func(dbTx database.Tx) error {
+		var err error
+		flags, err = dbFetchBlockScriptFlags(dbTx, hash)
+		return err
+	})
+	return flags, err
+}
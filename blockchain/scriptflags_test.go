@@ -0,0 +1,67 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchutil"
+)
+
+// TestBlockScriptFlags ensures the script flags blocks are validated with are
+// recorded when they are connected to the main chain, both when they extend
+// it and when they are connected by a reorganization.
+func TestBlockScriptFlags(t *testing.T) {
+	chain, params, tearDown := utxoCacheTestChain("TestBlockScriptFlags")
+	defer tearDown()
+
+	genesis := bchutil.NewBlock(params.GenesisBlock)
+	a1, outs := addBlock(chain, genesis, nil)
+	a2, _ := addBlock(chain, a1, outs)
+
+	// The blocks of a side chain are only validated once the chain
+	// becomes the main chain.
+	b2, _ := makeTestBlock(chain, a1, nil)
+	if _, _, err := chain.ProcessBlock(b2, BFNone); err != nil {
+		t.Fatalf("ProcessBlock %v: %v", b2.Hash(), err)
+	}
+	flags, err := chain.BlockScriptFlags(b2.Hash())
+	if err != nil {
+		t.Fatalf("BlockScriptFlags: %v", err)
+	}
+	if flags != nil {
+		t.Errorf("got script flags %v for side chain block %v",
+			flags.Flags, b2.Hash())
+	}
+	b3, _ := addBlock(chain, b2, nil)
+
+	// Regtest activates the UAHF and DAA rules from the start, but not
+	// the magnetic anomaly rules.
+	want := txscript.ScriptVerifyStrictEncoding |
+		txscript.ScriptVerifyBip143SigHash | txscript.ScriptVerifyLowS |
+		txscript.ScriptVerifyNullFail
+	for _, block := range []*bchutil.Block{a1, a2, b2, b3} {
+		flags, err := chain.BlockScriptFlags(block.Hash())
+		if err != nil {
+			t.Fatalf("BlockScriptFlags: %v", err)
+		}
+		if flags == nil {
+			t.Errorf("script flags of block %v were not recorded",
+				block.Hash())
+			continue
+		}
+		if !flags.ScriptsRun {
+			t.Errorf("scripts of block %v were not run", block.Hash())
+		}
+		if flags.Flags&want != want ||
+			flags.Flags.HasFlag(txscript.ScriptVerifyCleanStack) {
+
+			t.Errorf("got script flags %v for block %v, want %v "+
+				"without %v", flags.Flags, block.Hash(), want,
+				txscript.ScriptVerifyCleanStack)
+		}
+	}
+}
@@ -36,6 +36,7 @@ type txValidator struct {
 	flags              txscript.ScriptFlags
 	sigCache           *txscript.SigCache
 	hashCache          *txscript.HashCache
+	batchVerifier      *txscript.BatchVerifier
 	sigChecks          uint32
 	maxSigChecks       uint32
 	upgrade9ForkHeight int32
@@ -139,6 +140,9 @@ out:
 				v.sendResult(err)
 				break out
 			}
+			if v.batchVerifier != nil {
+				vm.SetBatchVerifier(v.batchVerifier)
+			}
 
 			// Execute the script pair.
 			if err := vm.Execute(); err != nil {
@@ -257,32 +261,30 @@ func newTxValidator(utxoView *UtxoViewpoint, flags txscript.ScriptFlags,
 	}
 }
 
-// ValidateTransactionScripts validates the scripts for the passed transaction
-// using multiple goroutines. It returns the number of sigchecks in the transaction.
-func ValidateTransactionScripts(tx *bchutil.Tx, utxoView *UtxoViewpoint,
-	flags txscript.ScriptFlags, sigCache *txscript.SigCache,
-	hashCache *txscript.HashCache, upgrade9ForkHeight int32) (uint32, error) {
-
-	// If the HashCache is present, and it doesn't yet contain the
-	// partial sighashes for this transaction, then we add the
-	// sighashes for the transaction. This allows us to take
-	// advantage of the potential speed savings due to the new
-	// digest algorithm (BIP0143).
-	hash := tx.Hash()
-	if flags.HasFlag(txscript.ScriptVerifyBip143SigHash) && hashCache != nil &&
-		!hashCache.ContainsHashes(hash) {
-
-		hashCache.AddSigHashes(tx.MsgTx())
+// txSigHashes returns the partial sighashes shared by all inputs of the passed
+// transaction.  When the HashCache is present the sighashes are taken from it,
+// computing and adding them first if needed, so a transaction validated by the
+// mempool does not have them computed again when validated as part of a
+// block.  This allows us to take advantage of the potential speed savings due
+// to the new digest algorithm (BIP0143).
+//
+// Nil is returned before BIP0143 is active since legacy signature hashes do not
+// use them.
+func txSigHashes(tx *bchutil.Tx, utxoView *UtxoViewpoint, flags txscript.ScriptFlags,
+	hashCache *txscript.HashCache) *txscript.TxSigHashes {
+
+	if !flags.HasFlag(txscript.ScriptVerifyBip143SigHash) {
+		return nil
 	}
 
-	var cachedHashes *txscript.TxSigHashes
+	var sigHashes *txscript.TxSigHashes
 	if hashCache != nil {
-		cachedHashes, _ = hashCache.GetSigHashes(hash)
+		sigHashes = hashCache.GetOrAddSigHashes(tx.MsgTx())
 	} else {
-		cachedHashes = txscript.NewTxSigHashes(tx.MsgTx())
+		sigHashes = txscript.NewTxSigHashes(tx.MsgTx())
 	}
 
-	if cachedHashes != nil {
+	if flags.HasFlag(txscript.ScriptAllowCashTokens) {
 		utxoCache := txscript.NewUtxoCache()
 		for i, in := range tx.MsgTx().TxIn {
 			u := utxoView.LookupEntry(in.PreviousOutPoint)
@@ -291,10 +293,18 @@ func ValidateTransactionScripts(tx *bchutil.Tx, utxoView *UtxoViewpoint,
 			}
 			utxoCache.AddEntry(i, *wire.NewTxOut(u.amount, u.pkScript, u.tokenData))
 		}
-		if flags.HasFlag(txscript.ScriptAllowCashTokens) {
-			cachedHashes.AddTxSigHashUtxoFromUtxoCache(tx.MsgTx(), utxoCache)
-		}
+		sigHashes.AddTxSigHashUtxoFromUtxoCache(tx.MsgTx(), utxoCache)
 	}
+	return sigHashes
+}
+
+// ValidateTransactionScripts validates the scripts for the passed transaction
+// using multiple goroutines. It returns the number of sigchecks in the transaction.
+func ValidateTransactionScripts(tx *bchutil.Tx, utxoView *UtxoViewpoint,
+	flags txscript.ScriptFlags, sigCache *txscript.SigCache,
+	hashCache *txscript.HashCache, upgrade9ForkHeight int32) (uint32, error) {
+
+	cachedHashes := txSigHashes(tx, utxoView, flags, hashCache)
 
 	// Collect all of the transaction inputs and required information for
 	// validation.
@@ -355,38 +365,7 @@ func checkBlockScripts(block *bchutil.Block, utxoView *UtxoViewpoint,
 	for _, tx := range block.Transactions() {
 		sigChecks := uint32(0)
 
-		// If the HashCache is present, and it doesn't yet contain the
-		// partial sighashes for this transaction, then we add the
-		// sighashes for the transaction. This allows us to take
-		// advantage of the potential speed savings due to the new
-		// digest algorithm (BIP0143).
-		hash := tx.Hash()
-		if scriptFlags.HasFlag(txscript.ScriptVerifyBip143SigHash) && hashCache != nil &&
-			!hashCache.ContainsHashes(hash) {
-
-			hashCache.AddSigHashes(tx.MsgTx())
-		}
-
-		var cachedHashes *txscript.TxSigHashes
-		if hashCache != nil {
-			cachedHashes, _ = hashCache.GetSigHashes(hash)
-		} else {
-			cachedHashes = txscript.NewTxSigHashes(tx.MsgTx())
-		}
-
-		if cachedHashes != nil {
-			utxoCache := txscript.NewUtxoCache()
-			for i, in := range tx.MsgTx().TxIn {
-				u := utxoView.LookupEntry(in.PreviousOutPoint)
-				if u == nil {
-					break // Raise error?
-				}
-				utxoCache.AddEntry(i, *wire.NewTxOut(u.amount, u.pkScript, u.tokenData))
-			}
-			if scriptFlags.HasFlag(txscript.ScriptAllowCashTokens) {
-				cachedHashes.AddTxSigHashUtxoFromUtxoCache(tx.MsgTx(), utxoCache)
-			}
-		}
+		cachedHashes := txSigHashes(tx, utxoView, scriptFlags, hashCache)
 
 		for txInIdx, txIn := range tx.MsgTx().TxIn {
 			// Skip coinbases.
@@ -405,12 +384,24 @@ func checkBlockScripts(block *bchutil.Block, utxoView *UtxoViewpoint,
 		}
 	}
 
-	// Validate all of the inputs.
+	// Validate all of the inputs.  Once the NULLFAIL rule is active an
+	// invalid Schnorr signature always fails its script, so those
+	// signatures are collected and verified in batches afterwards.
 	validator := newTxValidator(utxoView, scriptFlags, sigCache, hashCache, maxSigChecks, upgrade9ForkHeight)
+	if scriptFlags.HasFlag(txscript.ScriptVerifyNullFail) &&
+		scriptFlags.HasFlag(txscript.ScriptVerifySchnorr) {
+
+		validator.batchVerifier = txscript.NewBatchVerifier(sigCache)
+	}
 	start := time.Now()
 	if err := validator.Validate(txValItems); err != nil {
 		return err
 	}
+	if validator.batchVerifier != nil {
+		if err := validator.batchVerifier.Verify(); err != nil {
+			return ruleError(ErrScriptValidation, err.Error())
+		}
+	}
 
 	elapsed := time.Since(start)
 
@@ -105,6 +105,15 @@ func (gs *Db) AddTxn(msgTx *wire.MsgTx) error {
 
 // Find performs a graph search for a given transaction hash
 func (gs *Db) Find(hash *chainhash.Hash, tokenID *chainhash.Hash, validityCache *map[chainhash.Hash]struct{}) ([][]byte, error) {
+	return gs.FindMissing(hash, tokenID, validityCache, nil)
+}
+
+// FindMissing performs a graph search for a given transaction hash, leaving
+// out the transactions for which isKnown returns true.  Unlike transactions in
+// the validity cache, the inputs of known transactions are still searched
+// since a client knowing a transaction may be missing its ancestors.  A nil
+// isKnown returns the same results as Find.
+func (gs *Db) FindMissing(hash *chainhash.Hash, tokenID *chainhash.Hash, validityCache *map[chainhash.Hash]struct{}, isKnown func(*chainhash.Hash) bool) ([][]byte, error) {
 
 	// get token graph
 	tokenGraph := gs.getTokenGraph(tokenID)
@@ -129,7 +138,7 @@ func (gs *Db) Find(hash *chainhash.Hash, tokenID *chainhash.Hash, validityCache
 	}
 
 	// perform the recursive graph search
-	err := gs.findInternal(txMsg, tokenGraph, &seen, validityCache, &txdata, &i)
+	err := gs.findInternal(txMsg, tokenGraph, &seen, validityCache, isKnown, &txdata, &i)
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +148,7 @@ func (gs *Db) Find(hash *chainhash.Hash, tokenID *chainhash.Hash, validityCache
 	return txdata[0:i], nil
 }
 
-func (gs *Db) findInternal(txMsg *wire.MsgTx, graph *tokenGraph, seen *map[chainhash.Hash]struct{}, validityCache *map[chainhash.Hash]struct{}, txdata *[][]byte, counter *int) error {
+func (gs *Db) findInternal(txMsg *wire.MsgTx, graph *tokenGraph, seen *map[chainhash.Hash]struct{}, validityCache *map[chainhash.Hash]struct{}, isKnown func(*chainhash.Hash) bool, txdata *[][]byte, counter *int) error {
 
 	hash := txMsg.TxHash()
 
@@ -149,13 +158,15 @@ func (gs *Db) findInternal(txMsg *wire.MsgTx, graph *tokenGraph, seen *map[chain
 	}
 	(*seen)[hash] = struct{}{}
 
-	// add txn buffer to results
-	txBuf := bytes.NewBuffer(make([]byte, 0, txMsg.SerializeSize()))
-	if err := txMsg.Serialize(txBuf); err != nil {
-		return err
+	// add txn buffer to results unless the client already has it
+	if isKnown == nil || !isKnown(&hash) {
+		txBuf := bytes.NewBuffer(make([]byte, 0, txMsg.SerializeSize()))
+		if err := txMsg.Serialize(txBuf); err != nil {
+			return err
+		}
+		(*txdata)[*counter] = txBuf.Bytes()
+		(*counter)++
 	}
-	(*txdata)[*counter] = txBuf.Bytes()
-	(*counter)++
 
 	// check exclude txids here, don't return with error
 	if _, ok := (*validityCache)[hash]; ok {
@@ -203,7 +214,7 @@ func (gs *Db) findInternal(txMsg *wire.MsgTx, graph *tokenGraph, seen *map[chain
 			return fmt.Errorf("txn %v was parsed as an unknown kind of slp transaction", prevTxHash)
 		}
 
-		err = gs.findInternal(inpTxMsg, graph, seen, validityCache, txdata, counter)
+		err = gs.findInternal(inpTxMsg, graph, seen, validityCache, isKnown, txdata, counter)
 		if err != nil {
 			continue
 		}
@@ -138,5 +138,24 @@ func TestSlpGraphSearch(t *testing.T) {
 				t.Fatalf("missing txid in graph search result: %v", msgTx.TxHash())
 			}
 		}
+
+		// a client knowing every result except the searched txn should
+		// only receive the searched txn
+		isKnown := func(h *chainhash.Hash) bool {
+			_, ok := expectedResults[*h]
+			return ok && !h.IsEqual(hash)
+		}
+		missingRes, err := gsDb.FindMissing(hash, tokenID, &validityCacheSet, isKnown)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(missingRes) != 1 {
+			t.Fatalf("expected 1 missing txn, got %d", len(missingRes))
+		}
+		msgTx := wire.MsgTx{}
+		msgTx.Deserialize(bytes.NewReader(missingRes[0]))
+		if msgTx.TxHash() != *hash {
+			t.Fatalf("unexpected missing txn %v", msgTx.TxHash())
+		}
 	}
 }
@@ -0,0 +1,206 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"fmt"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/database"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// ChainSnapshot is a read-only view of the main chain as it was when the
+// snapshot was taken.  Operations which look up several blocks, such as
+// calculating statistics over a range of heights or rescanning the chain, can
+// use a snapshot to see the blocks of a single chain even when a reorganize
+// happens while they run.
+//
+// Blocks which are disconnected from the main chain by a later reorganize
+// remain part of the snapshot.  They are still available since blocks are not
+// removed from the database when they are disconnected.
+type ChainSnapshot struct {
+	chain *BlockChain
+	tip   *blockNode
+}
+
+// ChainSnapshot returns a snapshot of the current main chain.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) ChainSnapshot() *ChainSnapshot {
+	return &ChainSnapshot{chain: b, tip: b.bestChain.Tip()}
+}
+
+// Hash returns the hash of the tip of the snapshot.
+func (s *ChainSnapshot) Hash() *chainhash.Hash {
+	return &s.tip.hash
+}
+
+// Height returns the height of the tip of the snapshot.
+func (s *ChainSnapshot) Height() int32 {
+	return s.tip.height
+}
+
+// IsMainChain returns whether the tip of the snapshot, and therefore every
+// block of the snapshot, is still part of the main chain.
+//
+// This function is safe for concurrent access.
+func (s *ChainSnapshot) IsMainChain() bool {
+	return s.chain.bestChain.Contains(s.tip)
+}
+
+// nodeByHeight returns the block node at the passed height in the snapshot or
+// nil if the height is beyond the tip of the snapshot.
+//
+// This function is safe for concurrent access.
+func (s *ChainSnapshot) nodeByHeight(height int32) *blockNode {
+	if height < 0 || height > s.tip.height {
+		return nil
+	}
+
+	// The main chain has the same blocks as the snapshot up to its tip as
+	// long as it contains the tip, in which case the node is looked up in
+	// constant time.  Otherwise the ancestors of the tip are walked.
+	bestChain := s.chain.bestChain
+	bestChain.mtx.Lock()
+	if bestChain.contains(s.tip) {
+		node := bestChain.nodeByHeight(height)
+		bestChain.mtx.Unlock()
+		return node
+	}
+	bestChain.mtx.Unlock()
+
+	return s.tip.Ancestor(height)
+}
+
+// nodeByHash returns the block node with the passed hash when it is part of
+// the snapshot, or nil otherwise.
+//
+// This function is safe for concurrent access.
+func (s *ChainSnapshot) nodeByHash(hash *chainhash.Hash) *blockNode {
+	node := s.chain.index.LookupNode(hash)
+	if node == nil || s.nodeByHeight(node.height) != node {
+		return nil
+	}
+	return node
+}
+
+// BlockHashByHeight returns the hash of the block at the passed height in the
+// snapshot.
+//
+// This function is safe for concurrent access.
+func (s *ChainSnapshot) BlockHashByHeight(height int32) (*chainhash.Hash, error) {
+	node := s.nodeByHeight(height)
+	if node == nil {
+		str := fmt.Sprintf("no block at height %d exists", height)
+		return nil, errNotInMainChain(str)
+	}
+
+	return &node.hash, nil
+}
+
+// BlockHeightByHash returns the height of the block with the passed hash in
+// the snapshot.
+//
+// This function is safe for concurrent access.
+func (s *ChainSnapshot) BlockHeightByHash(hash *chainhash.Hash) (int32, error) {
+	node := s.nodeByHash(hash)
+	if node == nil {
+		str := fmt.Sprintf("block %s is not in the main chain", hash)
+		return 0, errNotInMainChain(str)
+	}
+
+	return node.height, nil
+}
+
+// HeaderByHeight returns the header of the block at the passed height in the
+// snapshot.
+//
+// This function is safe for concurrent access.
+func (s *ChainSnapshot) HeaderByHeight(height int32) (wire.BlockHeader, error) {
+	node := s.nodeByHeight(height)
+	if node == nil {
+		err := fmt.Errorf("header at height %d is not known", height)
+		return wire.BlockHeader{}, err
+	}
+
+	return node.Header(), nil
+}
+
+// fetchBlock loads the block of the passed node from the database.
+func (s *ChainSnapshot) fetchBlock(node *blockNode) (*bchutil.Block, error) {
+	var block *bchutil.Block
+	err := s.chain.db.View(func(dbTx database.Tx) error {
+		var err error
+		block, err = dbFetchBlockByNode(dbTx, node)
+		return err
+	})
+	return block, err
+}
+
+// BlockByHeight returns the block at the passed height in the snapshot.
+//
+// This function is safe for concurrent access.
+func (s *ChainSnapshot) BlockByHeight(height int32) (*bchutil.Block, error) {
+	node := s.nodeByHeight(height)
+	if node == nil {
+		str := fmt.Sprintf("no block at height %d exists", height)
+		return nil, errNotInMainChain(str)
+	}
+
+	return s.fetchBlock(node)
+}
+
+// BlockByHash returns the block of the snapshot with the passed hash with the
+// appropriate chain height set.
+//
+// This function is safe for concurrent access.
+func (s *ChainSnapshot) BlockByHash(hash *chainhash.Hash) (*bchutil.Block, error) {
+	node := s.nodeByHash(hash)
+	if node == nil {
+		str := fmt.Sprintf("block %s is not in the main chain", hash)
+		return nil, errNotInMainChain(str)
+	}
+
+	return s.fetchBlock(node)
+}
+
+// HeightRange returns a range of block hashes of the snapshot for the given
+// start and end heights.  It is inclusive of the start height and exclusive of
+// the end height.  The end height will be limited to the height of the tip of
+// the snapshot.
+//
+// This function is safe for concurrent access.
+func (s *ChainSnapshot) HeightRange(startHeight, endHeight int32) ([]chainhash.Hash, error) {
+	// Ensure requested heights are sane.
+	if startHeight < 0 {
+		return nil, fmt.Errorf("start height of fetch range must not "+
+			"be less than zero - got %d", startHeight)
+	}
+	if endHeight < startHeight {
+		return nil, fmt.Errorf("end height of fetch range must not "+
+			"be less than the start height - got start %d, end %d",
+			startHeight, endHeight)
+	}
+
+	// Limit the ending height to the height of the snapshot.
+	if endHeight > s.tip.height+1 {
+		endHeight = s.tip.height + 1
+	}
+	if startHeight >= endHeight {
+		return nil, nil
+	}
+
+	// Walk back from the last block of the range so each node is only
+	// looked up once.
+	hashes := make([]chainhash.Hash, endHeight-startHeight)
+	node := s.nodeByHeight(endHeight - 1)
+	for i := len(hashes) - 1; i >= 0; i-- {
+		hashes[i] = node.hash
+		node = node.parent
+	}
+	return hashes, nil
+}
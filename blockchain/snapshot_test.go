@@ -0,0 +1,103 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/gcash/bchutil"
+)
+
+// TestChainSnapshot ensures a snapshot keeps returning the blocks of the main
+// chain as it was when the snapshot was taken after a reorganize.
+func TestChainSnapshot(t *testing.T) {
+	chain, params, tearDown := utxoCacheTestChain("TestChainSnapshot")
+	defer tearDown()
+
+	genesis := bchutil.NewBlock(params.GenesisBlock)
+	a1, outs := addBlock(chain, genesis, nil)
+	a2, _ := addBlock(chain, a1, nil)
+	a3, _ := addBlock(chain, a2, nil)
+	snapshot := chain.ChainSnapshot()
+
+	// Reorganize to a longer chain forking after the first block.  The
+	// fork spends the outputs of the first block so its blocks differ
+	// from the blocks of the original chain.
+	b2, _ := makeTestBlock(chain, a1, outs)
+	b3, _ := makeTestBlock(chain, b2, nil)
+	b4, _ := makeTestBlock(chain, b3, nil)
+	for _, block := range []*bchutil.Block{b2, b3, b4} {
+		if _, _, err := chain.ProcessBlock(block, BFNone); err != nil {
+			t.Fatalf("ProcessBlock %v: %v", block.Hash(), err)
+		}
+	}
+	if best := chain.BestSnapshot(); best.Hash != *b4.Hash() {
+		t.Fatalf("best block is %v, want %v", best.Hash, b4.Hash())
+	}
+
+	if snapshot.IsMainChain() {
+		t.Error("snapshot is reported as part of the main chain after " +
+			"a reorganize")
+	}
+	if *snapshot.Hash() != *a3.Hash() || snapshot.Height() != 3 {
+		t.Errorf("snapshot tip is %v (height %d), want %v (height 3)",
+			snapshot.Hash(), snapshot.Height(), a3.Hash())
+	}
+
+	want := []*bchutil.Block{genesis, a1, a2, a3}
+	hashes, err := snapshot.HeightRange(0, 10)
+	if err != nil {
+		t.Fatalf("HeightRange: %v", err)
+	}
+	if len(hashes) != len(want) {
+		t.Fatalf("HeightRange returned %d hashes, want %d", len(hashes),
+			len(want))
+	}
+	for i, block := range want {
+		height := int32(i)
+		if hashes[i] != *block.Hash() {
+			t.Errorf("HeightRange hash %d is %v, want %v", i,
+				hashes[i], block.Hash())
+		}
+		hash, err := snapshot.BlockHashByHeight(height)
+		if err != nil || *hash != *block.Hash() {
+			t.Errorf("BlockHashByHeight(%d) = %v, %v, want %v", height,
+				hash, err, block.Hash())
+		}
+		gotHeight, err := snapshot.BlockHeightByHash(block.Hash())
+		if err != nil || gotHeight != height {
+			t.Errorf("BlockHeightByHash(%v) = %d, %v, want %d",
+				block.Hash(), gotHeight, err, height)
+		}
+		fetched, err := snapshot.BlockByHeight(height)
+		if err != nil {
+			t.Errorf("BlockByHeight(%d): %v", height, err)
+		} else if *fetched.Hash() != *block.Hash() {
+			t.Errorf("BlockByHeight(%d) returned %v, want %v",
+				height, fetched.Hash(), block.Hash())
+		}
+	}
+
+	// Blocks of the new main chain are not part of the snapshot.
+	if _, err := snapshot.BlockHeightByHash(b2.Hash()); !isNotInMainChainErr(err) {
+		t.Errorf("BlockHeightByHash for a block after the snapshot: "+
+			"unexpected error %v", err)
+	}
+	if _, err := snapshot.BlockHashByHeight(4); !isNotInMainChainErr(err) {
+		t.Errorf("BlockHashByHeight beyond the snapshot tip: unexpected "+
+			"error %v", err)
+	}
+
+	// A new snapshot follows the new main chain.
+	snapshot = chain.ChainSnapshot()
+	if !snapshot.IsMainChain() || *snapshot.Hash() != *b4.Hash() {
+		t.Errorf("new snapshot tip is %v, want %v", snapshot.Hash(),
+			b4.Hash())
+	}
+	block, err := snapshot.BlockByHash(b2.Hash())
+	if err != nil || block.Height() != 2 {
+		t.Errorf("BlockByHash(%v) = %v, want height 2", b2.Hash(), err)
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"math"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// TokenBurn describes the tokens of a single token destroyed by a transaction,
+// being the tokens of its inputs which are not carried over to its outputs.
+type TokenBurn struct {
+	// TokenID identifies the token, which is the category of CashTokens.
+	TokenID chainhash.Hash
+
+	// InputAmount and OutputAmount are the fungible amounts of the token
+	// in the inputs and outputs of the transaction.  They saturate at the
+	// max uint64.
+	InputAmount  uint64
+	OutputAmount uint64
+
+	// BurnedNFTs is the number of NFTs in the inputs in excess of the NFTs
+	// in the outputs.
+	BurnedNFTs uint32
+
+	// MintingBurned is set when the inputs hold a minting NFT, or an slp
+	// mint baton, and the outputs don't.
+	MintingBurned bool
+}
+
+// BurnedAmount returns the fungible amount of the token destroyed by the
+// transaction.
+func (b *TokenBurn) BurnedAmount() uint64 {
+	if b.InputAmount <= b.OutputAmount {
+		return 0
+	}
+	return b.InputAmount - b.OutputAmount
+}
+
+// tokenTotals is the tally of the tokens of a single token in the inputs or
+// the outputs of a transaction.
+type tokenTotals struct {
+	amount  uint64
+	nfts    uint32
+	minting uint32
+}
+
+// add adds the passed tokens to the tally.
+func (t *tokenTotals) add(amount uint64, nft, minting bool) {
+	if t.amount > math.MaxUint64-amount {
+		t.amount = math.MaxUint64
+	} else {
+		t.amount += amount
+	}
+	if nft {
+		t.nfts++
+	}
+	if minting {
+		t.minting++
+	}
+}
+
+// TokenBurnTally tallies the tokens of the inputs and outputs of a transaction
+// per token to find the ones the transaction destroys.
+type TokenBurnTally struct {
+	ids     []chainhash.Hash
+	inputs  map[chainhash.Hash]*tokenTotals
+	outputs map[chainhash.Hash]*tokenTotals
+}
+
+// NewTokenBurnTally returns an empty tally of the tokens of a transaction.
+func NewTokenBurnTally() *TokenBurnTally {
+	return &TokenBurnTally{
+		inputs:  make(map[chainhash.Hash]*tokenTotals),
+		outputs: make(map[chainhash.Hash]*tokenTotals),
+	}
+}
+
+// totals returns the tally of the passed token in the passed set of totals,
+// adding it when the token is not tallied yet.
+func (t *TokenBurnTally) totals(set map[chainhash.Hash]*tokenTotals, id chainhash.Hash) *tokenTotals {
+	totals, ok := set[id]
+	if !ok {
+		if _, ok := t.inputs[id]; !ok {
+			if _, ok := t.outputs[id]; !ok {
+				t.ids = append(t.ids, id)
+			}
+		}
+		totals = &tokenTotals{}
+		set[id] = totals
+	}
+	return totals
+}
+
+// AddInput adds the tokens of an input of the transaction to the tally.
+func (t *TokenBurnTally) AddInput(id chainhash.Hash, amount uint64, nft, minting bool) {
+	t.totals(t.inputs, id).add(amount, nft, minting)
+}
+
+// AddOutput adds the tokens of an output of the transaction to the tally.
+func (t *TokenBurnTally) AddOutput(id chainhash.Hash, amount uint64, nft, minting bool) {
+	t.totals(t.outputs, id).add(amount, nft, minting)
+}
+
+// Burns returns the tokens destroyed by the transaction in the order the
+// tokens were first tallied.
+func (t *TokenBurnTally) Burns() []*TokenBurn {
+	var burns []*TokenBurn
+	for _, id := range t.ids {
+		in, ok := t.inputs[id]
+		if !ok {
+			continue
+		}
+		out, ok := t.outputs[id]
+		if !ok {
+			out = &tokenTotals{}
+		}
+		burn := &TokenBurn{
+			TokenID:       id,
+			InputAmount:   in.amount,
+			OutputAmount:  out.amount,
+			MintingBurned: in.minting > 0 && out.minting == 0,
+		}
+		if in.nfts > out.nfts {
+			burn.BurnedNFTs = in.nfts - out.nfts
+		}
+		if burn.BurnedAmount() == 0 && burn.BurnedNFTs == 0 &&
+			!burn.MintingBurned {
+
+			continue
+		}
+		burns = append(burns, burn)
+	}
+	return burns
+}
+
+// addTokenData adds the passed CashTokens to the tally with the passed
+// function.
+func addTokenData(tokenData *wire.TokenData, add func(chainhash.Hash, uint64, bool, bool)) {
+	if tokenData.IsEmpty() {
+		return
+	}
+	add(tokenData.CategoryID, tokenData.Amount, tokenData.HasNFT(),
+		tokenData.IsMintingNFT())
+}
+
+// CheckTokenBurns returns the CashTokens destroyed by the passed transaction,
+// with the outputs it spends taken from the passed utxo view.  The inputs
+// missing from the view are ignored, so their tokens are not accounted for.
+func CheckTokenBurns(tx *bchutil.Tx, utxoView *UtxoViewpoint) []*TokenBurn {
+	tally := NewTokenBurnTally()
+	for _, txIn := range tx.MsgTx().TxIn {
+		entry := utxoView.LookupEntry(txIn.PreviousOutPoint)
+		if entry == nil {
+			continue
+		}
+		addTokenData(&entry.tokenData, tally.AddInput)
+	}
+	for _, txOut := range tx.MsgTx().TxOut {
+		addTokenData(&txOut.TokenData, tally.AddOutput)
+	}
+	return tally.Burns()
+}
+
+// FetchTokenBurns returns the CashTokens destroyed by each transaction of the
+// passed block, which must be in the main chain, with the outputs they spend
+// taken from the spend journal of the block.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) FetchTokenBurns(block *bchutil.Block) ([][]*TokenBurn, error) {
+	stxos, err := b.FetchSpendJournal(block)
+	if err != nil {
+		return nil, err
+	}
+
+	view := NewUtxoViewpoint()
+	burns := make([][]*TokenBurn, len(block.Transactions()))
+	stxoIdx := 0
+	for i, tx := range block.Transactions() {
+		if i == 0 {
+			continue
+		}
+		for _, txIn := range tx.MsgTx().TxIn {
+			if stxoIdx >= len(stxos) {
+				return nil, AssertError("spend journal is missing " +
+					"spent outputs of the block")
+			}
+			stxo := &stxos[stxoIdx]
+			stxoIdx++
+
+			var tokenData wire.TokenData
+			pkScript, err := tokenData.SeparateTokenDataFromPKScriptIfExists(
+				stxo.PkScript, 0)
+			if err != nil {
+				return nil, err
+			}
+			view.entries[txIn.PreviousOutPoint] = &UtxoEntry{
+				amount:      stxo.Amount,
+				pkScript:    pkScript,
+				tokenData:   tokenData,
+				blockHeight: stxo.Height,
+			}
+		}
+		burns[i] = CheckTokenBurns(tx, view)
+	}
+	return burns, nil
+}
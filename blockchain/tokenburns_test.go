@@ -0,0 +1,84 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// TestCheckTokenBurns ensures the CashTokens of the inputs of a transaction
+// which are not carried over to its outputs are reported as burned.
+func TestCheckTokenBurns(t *testing.T) {
+	fungible := chainhash.Hash{0x01}
+	nft := chainhash.Hash{0x02}
+	minting := chainhash.Hash{0x03}
+	genesis := chainhash.Hash{0x04}
+
+	view := NewUtxoViewpoint()
+	var msgTx wire.MsgTx
+	addInput := func(tokenData wire.TokenData) {
+		prevOut := wire.OutPoint{Index: uint32(len(msgTx.TxIn))}
+		view.entries[prevOut] = NewUtxoEntry(&wire.TxOut{
+			Value:     1000,
+			PkScript:  []byte{0x51},
+			TokenData: tokenData,
+		}, 1, false)
+		msgTx.AddTxIn(wire.NewTxIn(&prevOut, nil))
+	}
+	addOutput := func(tokenData wire.TokenData) {
+		msgTx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}, tokenData))
+	}
+
+	// Part of the fungible tokens are burned.
+	addInput(wire.TokenData{CategoryID: fungible, Amount: 70, BitField: 0x10})
+	addInput(wire.TokenData{CategoryID: fungible, Amount: 30, BitField: 0x10})
+	addOutput(wire.TokenData{CategoryID: fungible, Amount: 60, BitField: 0x10})
+
+	// One of the two immutable NFTs is burned.
+	addInput(wire.TokenData{CategoryID: nft, BitField: 0x20})
+	addInput(wire.TokenData{CategoryID: nft, BitField: 0x20})
+	addOutput(wire.TokenData{CategoryID: nft, BitField: 0x20})
+
+	// The minting NFT is turned into an immutable NFT.
+	addInput(wire.TokenData{CategoryID: minting, BitField: 0x22})
+	addOutput(wire.TokenData{CategoryID: minting, BitField: 0x20})
+
+	// Tokens of new categories and inputs missing from the view aren't
+	// burns.
+	addOutput(wire.TokenData{CategoryID: genesis, Amount: 10, BitField: 0x10})
+	msgTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: math.MaxUint32}, nil))
+
+	burns := CheckTokenBurns(bchutil.NewTx(&msgTx), view)
+	want := []TokenBurn{
+		{TokenID: fungible, InputAmount: 100, OutputAmount: 60},
+		{TokenID: nft, BurnedNFTs: 1},
+		{TokenID: minting, MintingBurned: true},
+	}
+	if len(burns) != len(want) {
+		t.Fatalf("got %d burns, want %d", len(burns), len(want))
+	}
+	for i, burn := range burns {
+		if *burn != want[i] {
+			t.Errorf("burn %d: got %+v, want %+v", i, *burn, want[i])
+		}
+	}
+	if burns[0].BurnedAmount() != 40 {
+		t.Errorf("got burned amount %d, want 40", burns[0].BurnedAmount())
+	}
+
+	// Transactions carrying all of their tokens over burn nothing.
+	tally := NewTokenBurnTally()
+	tally.AddInput(fungible, math.MaxUint64, false, false)
+	tally.AddInput(fungible, 1, false, false)
+	tally.AddOutput(fungible, math.MaxUint64, false, false)
+	if burns := tally.Burns(); len(burns) != 0 {
+		t.Errorf("got burns %+v from saturated amounts", burns)
+	}
+}
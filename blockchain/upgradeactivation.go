@@ -0,0 +1,160 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"container/list"
+
+	"github.com/gcash/bchd/database"
+	"github.com/gcash/bchutil"
+)
+
+// SetUpgrade9Activation sets the block height after which upgrade9 is active.
+// The blocks of the main chain whose rules change are validated again under the
+// new rules and the first block which violates them is marked invalid and
+// disconnected along with its descendants.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) SetUpgrade9Activation(height int32) error {
+	b.chainLock.Lock()
+	defer b.chainLock.Unlock()
+
+	// The rules of the blocks after the lower of the old and the new
+	// activation height change.
+	var affected *blockNode
+	first := min(height, b.chainParams.Upgrade9Activation())
+	if first < b.bestChain.Tip().height {
+		affected = b.bestChain.NodeByHeight(first + 1)
+	}
+	return b.revalidateMainChain(affected, func() {
+		b.chainParams.SetUpgrade9Activation(height)
+	})
+}
+
+// SetUpgrade11Activation sets the median time past from which upgrade11 is
+// active.  The blocks of the main chain whose rules change are validated again
+// under the new rules and the first block which violates them is marked invalid
+// and disconnected along with its descendants.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) SetUpgrade11Activation(activationTime uint64) error {
+	b.chainLock.Lock()
+	defer b.chainLock.Unlock()
+
+	// The rules of the blocks whose parent has a median time past at or
+	// after the lower of the old and the new activation time change.  The
+	// median time past never decreases along the chain, so they are found
+	// by walking back from the tip.
+	var affected *blockNode
+	first := min(activationTime, b.chainParams.Upgrade11Activation())
+	for n := b.bestChain.Tip(); n.parent != nil; n = n.parent {
+		mtp := n.parent.CalcPastMedianTime().Unix()
+		if mtp < 0 || uint64(mtp) < first {
+			break
+		}
+		affected = n
+	}
+	return b.revalidateMainChain(affected, func() {
+		b.chainParams.SetUpgrade11Activation(activationTime)
+	})
+}
+
+// revalidateMainChain disconnects the passed main chain block along with all of
+// the blocks after it, calls the passed function to change the rules and then
+// connects the blocks again, which validates them under the changed rules.
+// When a block violates the changed rules, it is marked invalid and the main
+// chain ends at its parent.  A nil block only changes the rules.
+//
+// This function MUST be called with the chain state lock held (for writes).
+func (b *BlockChain) revalidateMainChain(node *blockNode, changeRules func()) error {
+	if node == nil {
+		changeRules()
+		return nil
+	}
+
+	// Disconnect the affected blocks before the rules change since they
+	// were connected under the old rules.
+	oldTip := b.bestChain.Tip()
+	detachNodes := list.New()
+	for n := oldTip; n != node.parent; n = n.parent {
+		detachNodes.PushBack(n)
+	}
+	err := b.reorganizeChain(detachNodes, list.New())
+	if err != nil {
+		return err
+	}
+
+	changeRules()
+
+	// Forget the blocks were validated so they are fully checked again
+	// when they are connected.  The checks which don't depend on the
+	// chain are run again here since they depend on the rules as well.
+	var nodes []*blockNode
+	for n := oldTip; n != node.parent; n = n.parent {
+		b.index.UnsetStatusFlags(n, statusValid)
+		nodes = append(nodes, n)
+	}
+	attachNodes := list.New()
+	for i := len(nodes) - 1; i >= 0; i-- {
+		n := nodes[i]
+
+		var block *bchutil.Block
+		err := b.db.View(func(dbTx database.Tx) error {
+			var err error
+			block, err = dbFetchBlockByNode(dbTx, n)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		flags := BFNoPoWCheck
+		if n.height > b.chainParams.MagneticAnonomalyForkHeight {
+			flags |= BFMagneticAnomaly
+		}
+		if n.height > b.chainParams.Upgrade9Activation() {
+			flags |= BFUpgrade9
+		}
+		err = checkBlockSanity(block, b.chainParams.PowLimit,
+			b.timeSource, flags)
+		if _, ok := err.(RuleError); ok {
+			log.Warnf("Block %v is invalid under the changed rules: %v",
+				&n.hash, err)
+			b.index.SetStatusFlags(n, statusValidateFailed)
+			b.recordInvalidBlock(&n.hash, &n.parent.hash, n.height,
+				err.Error())
+			for _, dn := range nodes[:i] {
+				b.index.SetStatusFlags(dn, statusInvalidAncestor)
+			}
+			break
+		}
+		if err != nil {
+			return err
+		}
+		attachNodes.PushBack(n)
+	}
+
+	// The checks of all the blocks run before any of them is connected, so
+	// no block is connected when one of them violates the changed rules.
+	// In that case the blocks before it have been marked valid by the
+	// checks and are connected on their own.
+	err = b.reorganizeChain(list.New(), attachNodes)
+	if _, ok := err.(RuleError); ok {
+		log.Warnf("Block is invalid under the changed rules: %v", err)
+
+		attachNodes.Init()
+		for _, n := range nodes {
+			if b.index.NodeStatus(n).KnownValid() {
+				attachNodes.PushFront(n)
+			}
+		}
+		err = b.reorganizeChain(list.New(), attachNodes)
+	}
+
+	if writeErr := b.index.flushToDB(); writeErr != nil {
+		log.Warnf("Error flushing block index changes to disk: %v", writeErr)
+	}
+	return err
+}
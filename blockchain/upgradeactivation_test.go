@@ -0,0 +1,78 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/gcash/bchutil"
+)
+
+// TestSetUpgradeActivation ensures the blocks of the main chain affected by a
+// changed upgrade activation are validated again under the new rules, the
+// blocks which remain valid stay connected and the first invalid block is
+// disconnected along with its descendants.
+func TestSetUpgradeActivation(t *testing.T) {
+	chain, params, tearDown := utxoCacheTestChain("TestSetUpgradeActivation")
+	defer tearDown()
+	chainParams := chain.chainParams
+
+	genesis := bchutil.NewBlock(params.GenesisBlock)
+	a1, outs := addBlock(chain, genesis, nil)
+	a2, outs := addBlock(chain, a1, outs)
+	a3, _ := addBlock(chain, a2, outs)
+
+	assertTip := func(block *bchutil.Block) {
+		t.Helper()
+		if tip := chain.BestSnapshot(); tip.Hash != *block.Hash() {
+			t.Fatalf("got tip %v at height %d, want %v", tip.Hash,
+				tip.Height, block.Hash())
+		}
+	}
+
+	// Moving upgrade11 past the tip validates all of the blocks again,
+	// which remain valid since they don't use its rules.
+	if err := chain.SetUpgrade11Activation(1 << 40); err != nil {
+		t.Fatalf("SetUpgrade11Activation: %v", err)
+	}
+	if got := chainParams.Upgrade11Activation(); got != 1<<40 {
+		t.Errorf("got upgrade11 activation %d, want %d", got, uint64(1<<40))
+	}
+	assertTip(a3)
+	for _, block := range []*bchutil.Block{a1, a2, a3} {
+		node := chain.index.LookupNode(block.Hash())
+		if !chain.index.NodeStatus(node).KnownValid() {
+			t.Errorf("block %v is not known valid", block.Hash())
+		}
+	}
+
+	// Activating upgrade9 after the first block applies its minimum
+	// transaction size to the coinbase of the second block, which is too
+	// small, so the chain ends at the first block.
+	if err := chain.SetUpgrade9Activation(1); err != nil {
+		t.Fatalf("SetUpgrade9Activation: %v", err)
+	}
+	if got := chainParams.Upgrade9Activation(); got != 1 {
+		t.Errorf("got upgrade9 activation %d, want 1", got)
+	}
+	assertTip(a1)
+	if chain.InvalidBlock(a2.Hash()) == nil {
+		t.Errorf("block %v is not recorded as invalid", a2.Hash())
+	}
+	node := chain.index.LookupNode(a3.Hash())
+	if !chain.index.NodeStatus(node).KnownInvalid() {
+		t.Errorf("descendant %v of the invalid block is not known "+
+			"invalid", a3.Hash())
+	}
+
+	// Restoring the activation beyond the tip only changes the rules.
+	if err := chain.SetUpgrade9Activation(1000); err != nil {
+		t.Fatalf("SetUpgrade9Activation: %v", err)
+	}
+	if got := chainParams.Upgrade9Activation(); got != 1000 {
+		t.Errorf("got upgrade9 activation %d, want 1000", got)
+	}
+	assertTip(a1)
+}
@@ -279,6 +279,16 @@ func (s *utxoCache) TotalMemoryUsage() uint64 {
 	return tmu
 }
 
+// setMaxTotalMemoryUsage changes the maximum memory usage in bytes that the
+// state should contain in normal circumstances.
+//
+// This method is safe for concurrent access.
+func (s *utxoCache) setMaxTotalMemoryUsage(maxTotalMemoryUsage uint64) {
+	s.mtx.Lock()
+	s.maxTotalMemoryUsage = maxTotalMemoryUsage
+	s.mtx.Unlock()
+}
+
 // fetchAndCacheEntry tries to fetch an entry from the database.  In none is
 // found, nil is returned.  If an entry is found, it is cached.
 //
@@ -646,6 +656,99 @@ func (s *utxoCache) Flush(mode FlushMode, bestState *BestState) error {
 	return nil
 }
 
+// warmEntries fetches the passed outpoints from the database and caches the
+// ones which are unspent and not cached yet.  Unlike fetchAndCacheEntry, misses
+// are not cached since most of the outpoints are expected to be spent.  It
+// returns false, without caching the remaining outpoints, once the cache
+// reaches the periodic flush threshold so warming never causes a flush.
+//
+// This method should be called with the state lock held.
+func (s *utxoCache) warmEntries(outpoints []wire.OutPoint) (int, bool, error) {
+	threshold := (utxoFlushPeriodicThreshold * s.maxTotalMemoryUsage) / 100
+	var added int
+	err := s.db.View(func(dbTx database.Tx) error {
+		for _, outpoint := range outpoints {
+			if s.totalMemoryUsage() >= threshold {
+				return nil
+			}
+			if _, found := s.cachedEntries[outpoint]; found {
+				continue
+			}
+			entry, err := dbFetchUtxoEntry(dbTx, outpoint)
+			if err != nil {
+				return err
+			}
+			if entry == nil {
+				continue
+			}
+			s.cachedEntries[outpoint] = entry
+			s.totalEntryMemory += entry.memoryUsage()
+			added++
+		}
+		return nil
+	})
+	return added, s.totalMemoryUsage() < threshold, err
+}
+
+// WarmUtxoCache preloads the UTXO cache with the unspent outputs created in
+// the most recent numBlocks blocks of the main chain, which are the outputs
+// most likely to be spent soon, so the validation of the first blocks and
+// transactions after startup isn't slowed down by a cold cache.  It stops
+// early once the cache is nearly full or interrupt is closed, and returns the
+// number of outputs added to the cache.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) WarmUtxoCache(numBlocks int32, interrupt <-chan struct{}) (int, error) {
+	var total int
+	node := b.bestChain.Tip()
+	for i := int32(0); i < numBlocks && node != nil; i++ {
+		select {
+		case <-interrupt:
+			return total, nil
+		default:
+		}
+
+		var block *bchutil.Block
+		err := b.db.View(func(dbTx database.Tx) error {
+			var err error
+			block, err = dbFetchBlockByNode(dbTx, node)
+			return err
+		})
+		if err != nil {
+			return total, err
+		}
+
+		var outpoints []wire.OutPoint
+		for _, tx := range block.Transactions() {
+			prevOut := wire.OutPoint{Hash: *tx.Hash()}
+			for txOutIdx, txOut := range tx.MsgTx().TxOut {
+				// Provably unspendable outputs are never added
+				// to the utxo set.
+				if txscript.IsUnspendable(txOut.PkScript) {
+					continue
+				}
+				prevOut.Index = uint32(txOutIdx)
+				outpoints = append(outpoints, prevOut)
+			}
+		}
+
+		// The chain lock keeps blocks from being connected while the
+		// cache is updated from the database.
+		b.chainLock.RLock()
+		b.utxoCache.mtx.Lock()
+		added, more, err := b.utxoCache.warmEntries(outpoints)
+		b.utxoCache.mtx.Unlock()
+		b.chainLock.RUnlock()
+		total += added
+		if err != nil || !more {
+			return total, err
+		}
+
+		node = node.parent
+	}
+	return total, nil
+}
+
 // rollBackBlock rolls back the effects of the block when the state was left in
 // an inconsistent state.  This means that no errors will be raised when the
 // state is invalid.
@@ -132,6 +132,22 @@ func makeSpendableOutForTx(tx *wire.MsgTx, txOutIndex uint32) spendableOut {
 //
 // Panics on errors.
 func addBlock(chain *BlockChain, prev *bchutil.Block, spends []*spendableOut) (*bchutil.Block, []*spendableOut) {
+	block, outs := makeTestBlock(chain, prev, spends)
+	_, _, err := chain.ProcessBlock(block, BFNone)
+	if err != nil {
+		panic(err)
+	}
+
+	return block, outs
+}
+
+// makeTestBlock creates a solved block that succeeds prev and spends all the
+// provided spendable outputs without adding it to the blockchain.  The new
+// block is returned, together with the new spendable outputs created in the
+// block.
+//
+// Panics on errors.
+func makeTestBlock(chain *BlockChain, prev *bchutil.Block, spends []*spendableOut) (*bchutil.Block, []*spendableOut) {
 	blockHeight := prev.Height() + 1
 	txns := make([]*wire.MsgTx, 0, 1+len(spends))
 
@@ -215,11 +231,6 @@ func addBlock(chain *BlockChain, prev *bchutil.Block, spends []*spendableOut) (*
 		panic(fmt.Sprintf("Unable to solve block at height %d", blockHeight))
 	}
 
-	_, _, err = chain.ProcessBlock(block, BFNone)
-	if err != nil {
-		panic(err)
-	}
-
 	return block, outs
 }
 
@@ -611,3 +622,67 @@ func TestUtxoCache_InitConsistentState(t *testing.T) {
 		assertNbEntriesOnDisk(t, chain, len(spendableOuts4))
 	})
 }
+
+func TestUtxoCache_Warm(t *testing.T) {
+	chain, params, tearDown := utxoCacheTestChain("TestUtxoCache_Warm")
+	defer tearDown()
+	cache := chain.utxoCache
+	tip := bchutil.NewBlock(params.GenesisBlock)
+
+	// Add 10 blocks and flush them so the cache starts out empty.
+	var spends []*spendableOut
+	for i := 0; i < 10; i++ {
+		tip, spends = addBlock(chain, tip, nil)
+	}
+	if err := chain.FlushCachedState(FlushRequired); err != nil {
+		t.Fatalf("unexpected error while flushing cache: %v", err)
+	}
+	if len(cache.cachedEntries) != 0 {
+		t.Fatalf("Expected 0 entries, has %d instead", len(cache.cachedEntries))
+	}
+
+	// Spend the coinbase of the last block.  The new block has two unspent
+	// outputs and a provably unspendable one, while the spent coinbase
+	// leaves the utxo set.
+	tip, _ = addBlock(chain, tip, spends)
+	if err := chain.FlushCachedState(FlushRequired); err != nil {
+		t.Fatalf("unexpected error while flushing cache: %v", err)
+	}
+
+	// Warming with the last 3 blocks must only add the unspent outputs.
+	added, err := chain.WarmUtxoCache(3, nil)
+	if err != nil {
+		t.Fatalf("unexpected error while warming cache: %v", err)
+	}
+	if added != 3 || len(cache.cachedEntries) != 3 {
+		t.Fatalf("Expected 3 warmed entries, added %d and has %d",
+			added, len(cache.cachedEntries))
+	}
+	for outpoint, entry := range cache.cachedEntries {
+		if entry == nil || entry.IsSpent() {
+			t.Fatalf("Unexpected spent entry warmed for %v", outpoint)
+		}
+		if entry.packedFlags&tfModified != 0 {
+			t.Fatalf("Warmed entry for %v should not be modified", outpoint)
+		}
+	}
+
+	// Warming again must not add the cached entries twice.
+	added, err = chain.WarmUtxoCache(3, nil)
+	if err != nil {
+		t.Fatalf("unexpected error while warming cache: %v", err)
+	}
+	if added != 0 {
+		t.Fatalf("Expected no entries added, added %d", added)
+	}
+
+	// Warming stops once the cache is nearly full.
+	cache.maxTotalMemoryUsage = cache.totalMemoryUsage()
+	added, err = chain.WarmUtxoCache(10, nil)
+	if err != nil {
+		t.Fatalf("unexpected error while warming cache: %v", err)
+	}
+	if added != 0 {
+		t.Fatalf("Expected no entries added to a full cache, added %d", added)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"fmt"
+
+	"github.com/gcash/bchd/database"
+	"github.com/gcash/bchd/wire"
+)
+
+// MaxUtxoHistoryDepth is the maximum number of blocks below the best chain tip
+// at which the state of an output can be reconstructed by
+// FetchUtxoEntryAtHeight, which bounds the number of blocks it loads.  It is
+// about two days of blocks.
+const MaxUtxoHistoryDepth = 288
+
+// FetchUtxoEntryAtHeight returns the passed output as it was once the block at
+// the passed height of the main chain was connected, or nil when it was not
+// unspent at that point, either because it was not created yet or because it
+// was already spent.
+//
+// The output is looked up in the current UTXO set first.  Otherwise the blocks
+// connected after the passed height are searched for the transaction which
+// spent it, and the output is reconstructed from the spend journal of that
+// block.  The passed height must therefore be within MaxUtxoHistoryDepth
+// blocks of the tip, and the blocks above it must not have been pruned.
+//
+// The blocks are searched without holding the chain lock, so the output is
+// reconstructed against the main chain as it was when the function was called.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) FetchUtxoEntryAtHeight(outpoint wire.OutPoint, height int32) (*UtxoEntry, error) {
+	b.chainLock.RLock()
+	tip := b.bestChain.Tip()
+	if height < 0 || height > tip.height {
+		b.chainLock.RUnlock()
+		return nil, fmt.Errorf("height %d is outside of the main chain "+
+			"of height %d", height, tip.height)
+	}
+	if tip.height-height > MaxUtxoHistoryDepth {
+		b.chainLock.RUnlock()
+		return nil, fmt.Errorf("height %d is more than %d blocks below "+
+			"the tip", height, MaxUtxoHistoryDepth)
+	}
+
+	// An output which is still unspent was unspent at the passed height as
+	// long as it was created by then.
+	entry, err := b.utxoCache.FetchEntry(outpoint)
+	if err != nil {
+		b.chainLock.RUnlock()
+		return nil, err
+	}
+	if entry != nil && !entry.IsSpent() {
+		b.chainLock.RUnlock()
+		if entry.BlockHeight() > height {
+			return nil, nil
+		}
+		return entry, nil
+	}
+
+	// Otherwise the blocks connected after the passed height are searched
+	// once the lock is released.
+	nodes := make([]*blockNode, 0, tip.height-height)
+	for h := height + 1; h <= tip.height; h++ {
+		nodes = append(nodes, b.bestChain.NodeByHeight(h))
+	}
+	b.chainLock.RUnlock()
+
+	// Search the blocks, from the oldest, for the transaction which spent
+	// the output.  It was unspent at the passed height when it was created
+	// by then.
+	var spent *UtxoEntry
+	err = b.db.View(func(dbTx database.Tx) error {
+		for _, node := range nodes {
+			block, err := dbFetchBlockByNode(dbTx, node)
+			if err != nil {
+				return err
+			}
+
+			// The spend journal holds the spent outputs in the order
+			// of the inputs of the transactions after the coinbase.
+			stxoIdx := 0
+			found := -1
+			for _, tx := range block.MsgBlock().Transactions[1:] {
+				for _, txIn := range tx.TxIn {
+					if txIn.PreviousOutPoint == outpoint {
+						found = stxoIdx
+					}
+					stxoIdx++
+				}
+			}
+			if found < 0 {
+				continue
+			}
+
+			stxos, err := dbFetchSpendJournalEntry(dbTx, block)
+			if err != nil {
+				return err
+			}
+			stxo := &stxos[found]
+			if stxo.Height <= height {
+				// The token data of the output is stored as a
+				// prefix of its script.
+				var tokenData wire.TokenData
+				pkScript, _ := tokenData.SeparateTokenDataFromPKScriptIfExists(
+					stxo.PkScript, 0)
+				txOut := wire.NewTxOut(stxo.Amount, pkScript,
+					tokenData)
+				spent = NewUtxoEntry(txOut, stxo.Height,
+					stxo.IsCoinBase)
+			}
+			return nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return spent, nil
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/gcash/bchutil"
+)
+
+// TestFetchUtxoEntryAtHeight ensures outputs are reported as unspent exactly
+// at the heights between the blocks which created and spent them.
+func TestFetchUtxoEntryAtHeight(t *testing.T) {
+	chain, params, tearDown := utxoCacheTestChain("TestFetchUtxoEntryAtHeight")
+	defer tearDown()
+
+	// Create the coinbase output at height 1 and spend it at height 3 with
+	// a transaction whose output remains unspent.
+	tip := bchutil.NewBlock(params.GenesisBlock)
+	tip, outs := addBlock(chain, tip, nil)
+	coinbaseOut := outs[0]
+	tip, _ = addBlock(chain, tip, nil)
+	tip, outs = addBlock(chain, tip, []*spendableOut{coinbaseOut})
+	spendOut := outs[1]
+	addBlock(chain, tip, nil)
+
+	// Flush the cache so the spent output is only found in the spend
+	// journal.
+	if err := chain.FlushCachedState(FlushRequired); err != nil {
+		t.Fatalf("unexpected error while flushing cache: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		out     *spendableOut
+		height  int32
+		unspent bool
+	}{
+		{"coinbase before creation", coinbaseOut, 0, false},
+		{"coinbase at creation", coinbaseOut, 1, true},
+		{"coinbase before spend", coinbaseOut, 2, true},
+		{"coinbase at spend", coinbaseOut, 3, false},
+		{"coinbase at tip", coinbaseOut, 4, false},
+		{"spend before creation", spendOut, 2, false},
+		{"spend at creation", spendOut, 3, true},
+		{"spend at tip", spendOut, 4, true},
+	}
+	for _, test := range tests {
+		entry, err := chain.FetchUtxoEntryAtHeight(test.out.prevOut,
+			test.height)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		if (entry != nil) != test.unspent {
+			t.Fatalf("%s: unexpected entry %v", test.name, entry)
+		}
+		if entry != nil && bchutil.Amount(entry.Amount()) != test.out.amount {
+			t.Fatalf("%s: unexpected amount %d, want %d", test.name,
+				entry.Amount(), test.out.amount)
+		}
+	}
+	entry, err := chain.FetchUtxoEntryAtHeight(coinbaseOut.prevOut, 2)
+	if err != nil || entry.BlockHeight() != 1 || !entry.IsCoinBase() {
+		t.Fatalf("unexpected reconstructed entry %v (err %v)", entry, err)
+	}
+
+	// Heights above the tip can't be queried.
+	if _, err := chain.FetchUtxoEntryAtHeight(coinbaseOut.prevOut, 5); err == nil {
+		t.Fatal("height above the tip accepted")
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"encoding/binary"
+	"errors"
+	"sort"
+
+	"github.com/gcash/bchd/database"
+	"github.com/gcash/bchd/wire"
+)
+
+var (
+	// UtxoAgeBuckets are the exclusive upper bounds, in blocks, of the age
+	// buckets of UtxoStats: about an hour, a day, a week, a month, a year
+	// and five years.  The last bucket holds the older outputs.
+	UtxoAgeBuckets = []int32{6, 144, 1008, 4320, 52560, 262800}
+
+	// UtxoValueBuckets are the exclusive upper bounds, in satoshi, of the
+	// value buckets of UtxoStats.  The last bucket holds the outputs worth
+	// 100 BCH or more.
+	UtxoValueBuckets = []int64{1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10}
+)
+
+// ErrInvalidUtxoStatsCursor is returned when a cursor which was not returned
+// by UtxoStats is passed to it.
+var ErrInvalidUtxoStatsCursor = errors.New("invalid utxo stats cursor")
+
+// UtxoBucketStats are the statistics of the unspent outputs in an age or
+// value bucket.
+type UtxoBucketStats struct {
+	Count     int64
+	Amount    int64
+	DustCount int64
+}
+
+// add adds an unspent output to the bucket.
+func (s *UtxoBucketStats) add(amount int64, dust bool) {
+	s.Count++
+	s.Amount += amount
+	if dust {
+		s.DustCount++
+	}
+}
+
+// UtxoStats are the statistics of part of the utxo set returned by UtxoStats.
+type UtxoStats struct {
+	// Height is the height the ages of the outputs are relative to.  It is
+	// the height the utxo set was at when the scan started.
+	Height int32
+
+	// Count and Amount are the number and total value of the scanned
+	// outputs and DustCount and DustAmount those of the dust outputs.
+	Count      int64
+	Amount     int64
+	DustCount  int64
+	DustAmount int64
+
+	// Ages holds the statistics of the outputs by age using the buckets
+	// of UtxoAgeBuckets and Values those by value using the buckets of
+	// UtxoValueBuckets.
+	Ages   []UtxoBucketStats
+	Values []UtxoBucketStats
+
+	// Cursor resumes the scan with the next output when passed to
+	// UtxoStats.  It is nil once the whole utxo set was scanned.
+	Cursor []byte
+}
+
+// UtxoStats scans up to maxUtxos outputs of the utxo set, starting with the
+// output the passed cursor points to or with the first output when it is nil,
+// and returns the distribution of their ages and values.  The passed function
+// tells which outputs are dust and may be nil.
+//
+// The statistics of a whole scan are obtained by adding the statistics of
+// each call.  The utxo set is read from the database, so it doesn't include
+// the changes of the blocks connected since the utxo cache was last flushed,
+// and it may change between calls.  The ages are relative to the height of
+// the flushed utxo set when the scan started.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) UtxoStats(cursor []byte, maxUtxos int, isDust func(*wire.TxOut) bool) (*UtxoStats, error) {
+	stats := &UtxoStats{
+		Ages:   make([]UtxoBucketStats, len(UtxoAgeBuckets)+1),
+		Values: make([]UtxoBucketStats, len(UtxoValueBuckets)+1),
+	}
+	var seekKey []byte
+	if cursor != nil {
+		if len(cursor) < 4 {
+			return nil, ErrInvalidUtxoStatsCursor
+		}
+		stats.Height = int32(binary.LittleEndian.Uint32(cursor))
+		seekKey = cursor[4:]
+	}
+
+	err := b.db.View(func(dbTx database.Tx) error {
+		// Use the height of the block the flushed utxo set is at for a
+		// new scan, or the best height if it isn't known.
+		if cursor == nil {
+			stats.Height = b.BestSnapshot().Height
+			_, hash, err := dbFetchUtxoStateConsistency(dbTx)
+			if err != nil {
+				return err
+			}
+			if hash != nil {
+				if node := b.index.LookupNode(hash); node != nil {
+					stats.Height = node.height
+				}
+			}
+		}
+
+		c := dbTx.Metadata().Bucket(utxoSetBucketName).Cursor()
+		var ok bool
+		if seekKey == nil {
+			ok = c.First()
+		} else {
+			ok = c.Seek(seekKey)
+		}
+		for ; ok; ok = c.Next() {
+			if stats.Count == int64(maxUtxos) {
+				stats.Cursor = make([]byte, 4+len(c.Key()))
+				binary.LittleEndian.PutUint32(stats.Cursor,
+					uint32(stats.Height))
+				copy(stats.Cursor[4:], c.Key())
+				return nil
+			}
+
+			entry, err := DeserializeUtxoEntry(c.Value())
+			if err != nil {
+				return err
+			}
+			amount := entry.Amount()
+			dust := isDust != nil && isDust(&wire.TxOut{
+				Value:     amount,
+				PkScript:  entry.PkScript(),
+				TokenData: entry.TokenData(),
+			})
+
+			stats.Count++
+			stats.Amount += amount
+			if dust {
+				stats.DustCount++
+				stats.DustAmount += amount
+			}
+
+			// Outputs of blocks after the reference height, which
+			// were flushed after the scan started, are counted as
+			// the most recent ones.
+			age := stats.Height - entry.BlockHeight()
+			i := sort.Search(len(UtxoAgeBuckets), func(i int) bool {
+				return age < UtxoAgeBuckets[i]
+			})
+			stats.Ages[i].add(amount, dust)
+			i = sort.Search(len(UtxoValueBuckets), func(i int) bool {
+				return amount < UtxoValueBuckets[i]
+			})
+			stats.Values[i].add(amount, dust)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// TestUtxoStats ensures the flushed utxo set is scanned in chunks resumed with
+// the returned cursor and the outputs are counted in the expected buckets.
+func TestUtxoStats(t *testing.T) {
+	chain, params, tearDown := utxoCacheTestChain("TestUtxoStats")
+	defer tearDown()
+
+	// Create a coinbase output at each of the heights 1 to 8.
+	tip := bchutil.NewBlock(params.GenesisBlock)
+	for i := 0; i < 8; i++ {
+		tip, _ = addBlock(chain, tip, nil)
+	}
+	if err := chain.FlushCachedState(FlushRequired); err != nil {
+		t.Fatalf("FlushCachedState: %v", err)
+	}
+
+	isDust := func(txOut *wire.TxOut) bool { return true }
+	stats, err := chain.UtxoStats(nil, 100, isDust)
+	if err != nil {
+		t.Fatalf("UtxoStats: %v", err)
+	}
+	if stats.Cursor != nil {
+		t.Fatal("got a cursor for a complete scan")
+	}
+	if stats.Height != 8 || stats.Count != 8 || stats.DustCount != 8 {
+		t.Fatalf("got height %d, %d outputs and %d dust outputs, want "+
+			"height 8 and 8 dust outputs", stats.Height, stats.Count,
+			stats.DustCount)
+	}
+	subsidy := CalcBlockSubsidy(1, params)
+	if stats.Amount != 8*subsidy || stats.DustAmount != stats.Amount {
+		t.Fatalf("got amount %d and dust amount %d, want %d",
+			stats.Amount, stats.DustAmount, 8*subsidy)
+	}
+
+	// The outputs of the heights 3 to 8 are less than 6 blocks old.
+	if stats.Ages[0].Count != 6 || stats.Ages[1].Count != 2 {
+		t.Errorf("got age buckets %v, want 6 and 2 outputs in the "+
+			"first two buckets", stats.Ages)
+	}
+	for i, bucket := range stats.Values {
+		want := int64(0)
+		if i == len(UtxoValueBuckets)-1 {
+			want = 8
+		}
+		if bucket.Count != want {
+			t.Errorf("got %d outputs in value bucket %d, want %d",
+				bucket.Count, i, want)
+		}
+	}
+
+	// Scanning in chunks yields the same statistics in total.
+	total := &UtxoStats{
+		Ages:   make([]UtxoBucketStats, len(stats.Ages)),
+		Values: make([]UtxoBucketStats, len(stats.Values)),
+	}
+	addBuckets := func(total, chunk []UtxoBucketStats) {
+		for i, bucket := range chunk {
+			total[i].Count += bucket.Count
+			total[i].Amount += bucket.Amount
+			total[i].DustCount += bucket.DustCount
+		}
+	}
+	var cursor []byte
+	for chunks := 1; ; chunks++ {
+		chunk, err := chain.UtxoStats(cursor, 3, isDust)
+		if err != nil {
+			t.Fatalf("UtxoStats: %v", err)
+		}
+		total.Height = chunk.Height
+		total.Count += chunk.Count
+		total.Amount += chunk.Amount
+		total.DustCount += chunk.DustCount
+		total.DustAmount += chunk.DustAmount
+		addBuckets(total.Ages, chunk.Ages)
+		addBuckets(total.Values, chunk.Values)
+		cursor = chunk.Cursor
+		if cursor == nil {
+			if chunks != 3 {
+				t.Errorf("scanned the utxo set in %d chunks, want 3",
+					chunks)
+			}
+			break
+		}
+	}
+	if !reflect.DeepEqual(total, stats) {
+		t.Errorf("got total stats %+v, want %+v", total, stats)
+	}
+
+	if _, err := chain.UtxoStats([]byte{1}, 3, nil); err != ErrInvalidUtxoStatsCursor {
+		t.Errorf("UtxoStats: got error %v for an invalid cursor", err)
+	}
+}
@@ -484,16 +484,16 @@ func checkBlockSanity(block *bchutil.Block, powLimit *big.Int, timeSource Median
 			txscript.ScriptVerifyCheckDataSig
 	}
 
+	// If MagneticAnomaly is active validate the CTOR consensus rule.
+	if magneticAnomaly {
+		if err := CheckCanonicalTxOrder(block); err != nil {
+			return err
+		}
+	}
+
 	// Do some preliminary checks on each transaction to ensure they are
 	// sane before continuing.
-	var lastTxid *chainhash.Hash
-	for i, tx := range transactions {
-		// If MagneticAnomaly is active validate the CTOR consensus rule, skipping
-		// the coinbase transaction.
-		if magneticAnomaly && i > 1 && lastTxid.Compare(tx.Hash()) >= 0 {
-			return ruleError(ErrInvalidTxOrder, "transactions are not in lexicographical order")
-		}
-		lastTxid = tx.Hash()
+	for _, tx := range transactions {
 		err := CheckTransactionSanity(tx, magneticAnomaly, upgrade9, scriptFlags)
 		if err != nil {
 			return err
@@ -532,6 +532,27 @@ func checkBlockSanity(block *bchutil.Block, powLimit *big.Int, timeSource Median
 	return nil
 }
 
+// CheckCanonicalTxOrder ensures the transactions of the passed block, other
+// than the coinbase, are sorted by ascending transaction hash as required by
+// the canonical transaction ordering (CTOR) consensus rule of the
+// MagneticAnomaly upgrade.  Only the order is checked, so it can be used to
+// verify the order of blocks which are not otherwise valid.
+func CheckCanonicalTxOrder(block *bchutil.Block) error {
+	transactions := block.Transactions()
+	for i := 2; i < len(transactions); i++ {
+		prevHash := transactions[i-1].Hash()
+		hash := transactions[i].Hash()
+		if prevHash.Compare(hash) >= 0 {
+			str := fmt.Sprintf("transactions are not in "+
+				"lexicographical order - transaction %v at "+
+				"index %d does not sort after transaction %v",
+				hash, i, prevHash)
+			return ruleError(ErrInvalidTxOrder, str)
+		}
+	}
+	return nil
+}
+
 // CheckBlockSanity performs some preliminary checks on a block to ensure it is
 // sane before continuing with block processing.  These checks are context free.
 func CheckBlockSanity(block *bchutil.Block, powLimit *big.Int, timeSource MedianTimeSource, magneticAnomalyActive bool, upgrade9Active bool) error {
@@ -981,8 +1002,11 @@ func CheckTransactionInputs(tx *bchutil.Tx, txHeight int32, utxoView *UtxoViewpo
 // connects to the end of the current main chain and then calls this function
 // with that node.
 //
+// When validatedFlags is not nil, it is set to the script flags the block was
+// validated with.
+//
 // This function MUST be called with the chain state lock held (for writes).
-func (b *BlockChain) checkConnectBlock(node *blockNode, block *bchutil.Block, view *UtxoViewpoint, stxos *[]SpentTxOut) error {
+func (b *BlockChain) checkConnectBlock(node *blockNode, block *bchutil.Block, view *UtxoViewpoint, stxos *[]SpentTxOut, validatedFlags *BlockScriptFlags) error {
 	// If the side chain blocks end up in the database, a call to
 	// CheckBlockSanity should be done here in case a previous version
 	// allowed a block that is no longer valid.  However, since the
@@ -995,37 +1019,11 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block *bchutil.Block, vi
 		str := "the coinbase for the genesis block is not spendable"
 		return ruleError(ErrMissingTxOut, str)
 	}
+	start := time.Now()
 
-	// If Uahf is active then we need to calculate the max block size
-	// using the excessiveBlockSize rather than the LegacyBlockSize
-	uahfActive := node.height > b.chainParams.UahfForkHeight
-
-	// If Daa hardfork is active then we need to use the new difficulty
-	// adjustment algorithm and also enforce Low S and Nullfail.
-	daaActive := node.height > b.chainParams.DaaForkHeight
-
-	// If MagneticAnomaly hardfork is active we must enforce PushOnly and CleanStack
-	// and enable OP_CHECKDATASIG and OP_CHECKDATASIGVERIFY and CTOR.
+	// If MagneticAnomaly hardfork is active we must enforce CTOR.
 	magneticAnomalyActive := node.height > b.chainParams.MagneticAnonomalyForkHeight
 
-	// If GreatWall hardfork is active then we must enforce the Schnorr and AllowSegitRecovery
-	// script flags.
-	greatWallActive := node.height > b.chainParams.GreatWallForkHeight
-
-	// If Graviton hardfork is active we must enforce MinimalData
-	gravitonActive := node.height > b.chainParams.GravitonForkHeight
-
-	// If Phonon hardfork is active we must enforce the new sig check rules and
-	// OP_REVERSEBYTES.
-	phononActive := node.height > b.chainParams.PhononForkHeight
-
-	// If CosmicInflation is active we enforce 64BitIntegers and NativeIntrospection
-	cosmicInflationActive := node.parent.CalcPastMedianTime().Unix() >= int64(b.chainParams.CosmicInflationActivationTime)
-
-	upgrade9Active := node.height > b.chainParams.Upgrade9ForkHeight
-
-	upgrade11Active := node.parent.CalcPastMedianTime().Unix() >= int64(b.chainParams.Upgrade11ActivationTime)
-
 	// BIP0030 added a rule to prevent blocks which contain duplicate
 	// transactions that 'overwrite' older transactions which are not fully
 	// spent.  See the documentation for checkBIP0030 for more details.
@@ -1054,83 +1052,18 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block *bchutil.Block, vi
 	//
 	// These utxo entries are needed for verification of things such as
 	// transaction inputs, counting pay-to-script-hashes, and scripts.
+	b.validationStats.record(block.Hash(), phaseCheckInputs, start)
+	start = time.Now()
 	err := view.addInputUtxos(b.utxoCache, block, magneticAnomalyActive)
 	if err != nil {
 		return err
 	}
+	b.validationStats.record(block.Hash(), phaseFetchInputs, start)
+	start = time.Now()
 
-	// BIP0016 describes a pay-to-script-hash type that is considered a
-	// "standard" type.  The rules for this BIP only apply to transactions
-	// after the timestamp defined by txscript.Bip16Activation.  See
-	// https://en.bitcoin.it/wiki/BIP_0016 for more details.
-	enforceBIP0016 := node.timestamp >= txscript.Bip16Activation.Unix()
-
-	// Blocks created after the BIP0016 activation time need to have the
-	// pay-to-script-hash checks enabled.
-	var scriptFlags txscript.ScriptFlags
-	if enforceBIP0016 {
-		scriptFlags |= txscript.ScriptBip16
-	}
-
-	// Enforce DER signatures for block versions 3+ once the historical
-	// activation threshold has been reached.  This is part of BIP0066.
-	blockHeader := &block.MsgBlock().Header
-	if blockHeader.Version >= 3 && node.height >= b.chainParams.BIP0066Height {
-		scriptFlags |= txscript.ScriptVerifyDERSignatures
-	}
-
-	// Enforce CHECKLOCKTIMEVERIFY for block versions 4+ once the historical
-	// activation threshold has been reached.  This is part of BIP0065.
-	if blockHeader.Version >= 4 && node.height >= b.chainParams.BIP0065Height {
-		scriptFlags |= txscript.ScriptVerifyCheckLockTimeVerify
-	}
-
-	// If Uahf is active we must enforce strict encoding on all signatures and enforce
-	// the replay protected sighash.
-	if uahfActive {
-		scriptFlags |= txscript.ScriptVerifyStrictEncoding | txscript.ScriptVerifyBip143SigHash
-	}
-
-	// If Daa is active enforce Low S and Nullfail script validation rules.
-	if daaActive {
-		scriptFlags |= txscript.ScriptVerifyLowS | txscript.ScriptVerifyNullFail
-	}
-
-	// If MagneticAnomaly hardfork is active we must enforce PushOnly and CleanStack
-	// and enable OP_CHECKDATASIG and OP_CHECKDATASIGVERIFY.
-	if magneticAnomalyActive {
-		scriptFlags |= txscript.ScriptVerifySigPushOnly |
-			txscript.ScriptVerifyCleanStack |
-			txscript.ScriptVerifyCheckDataSig
-	}
-
-	// If GreatWall hardfork is active enforce Schnorr and AllowSegwitRecovery script flags.
-	if greatWallActive {
-		scriptFlags |= txscript.ScriptVerifySchnorr | txscript.ScriptVerifyAllowSegwitRecovery
-	}
-
-	// If Graviton hardfork is active enforce MinimalData and SchnorrMultisig script flag.
-	if gravitonActive {
-		scriptFlags |= txscript.ScriptVerifyMinimalData | txscript.ScriptVerifySchnorrMultisig
-	}
-
-	// If Phonon hardfork is active we need to check the sig checks for both blocks and
-	// transactions as well as activate OP_REVERSEBYTES.
-	if phononActive {
-		scriptFlags |= txscript.ScriptReportSigChecks | txscript.ScriptVerifyReverseBytes
-	}
-
-	// If CosmicInflation hardfork is active enforce 64BitIntegers and NativeIntrospection
-	if cosmicInflationActive {
-		scriptFlags |= txscript.ScriptVerify64BitIntegers | txscript.ScriptVerifyNativeIntrospection
-	}
-
-	if upgrade9Active {
-		scriptFlags |= txscript.ScriptAllowCashTokens
-	}
-
-	if upgrade11Active {
-		scriptFlags |= txscript.ScriptAllowMay2025
+	scriptFlags, err := b.blockScriptFlags(node, &block.MsgBlock().Header)
+	if err != nil {
+		return err
 	}
 
 	// Perform several checks on the inputs for each transaction.  Also
@@ -1220,11 +1153,6 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block *bchutil.Block, vi
 		return err
 	}
 	if csvState == ThresholdActive {
-		// If the CSV soft-fork is now active, then modify the
-		// scriptFlags to ensure that the CSV op code is properly
-		// validated during the script checks bleow.
-		scriptFlags |= txscript.ScriptVerifyCheckSequenceVerify
-
 		// We obtain the MTP of the *previous* block in order to
 		// determine if transactions in the current block are final.
 		medianTime := node.parent.CalcPastMedianTime()
@@ -1256,18 +1184,148 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block *bchutil.Block, vi
 	// transactions are actually allowed to spend the coins by running the
 	// expensive ECDSA signature check scripts.  Doing this last helps
 	// prevent CPU exhaustion attacks.
+	b.validationStats.record(block.Hash(), phaseCheckInputs, start)
 	if runScripts {
+		start = time.Now()
 		maxSigChecks := uint32(b.ablaState.getBlockSizeLimit()) / BlockMaxBytesMaxSigChecksRatio // TODO change this to uint64
 		err := checkBlockScripts(block, view, scriptFlags, b.sigCache,
-			b.hashCache, maxSigChecks, b.chainParams.Upgrade9ForkHeight)
+			b.hashCache, maxSigChecks, b.chainParams.Upgrade9Activation())
 		if err != nil {
 			return err
 		}
+		b.validationStats.record(block.Hash(), phaseScripts, start)
+	}
+
+	if validatedFlags != nil {
+		validatedFlags.Flags = scriptFlags
+		validatedFlags.ScriptsRun = runScripts
 	}
 
 	return nil
 }
 
+// blockScriptFlags returns the script flags the transactions of the block with
+// the passed node and header are validated with, including the script flag
+// overrides configured for testing upgrades.
+//
+// This function MUST be called with the chain state lock held (for writes).
+func (b *BlockChain) blockScriptFlags(node *blockNode, blockHeader *wire.BlockHeader) (txscript.ScriptFlags, error) {
+	// If Uahf is active we must enforce strict encoding and the replay
+	// protected sighash.
+	uahfActive := node.height > b.chainParams.UahfForkHeight
+
+	// If Daa hardfork is active we must enforce Low S and Nullfail.
+	daaActive := node.height > b.chainParams.DaaForkHeight
+
+	// If MagneticAnomaly hardfork is active we must enforce PushOnly and CleanStack
+	// and enable OP_CHECKDATASIG and OP_CHECKDATASIGVERIFY.
+	magneticAnomalyActive := node.height > b.chainParams.MagneticAnonomalyForkHeight
+
+	// If GreatWall hardfork is active then we must enforce the Schnorr and AllowSegitRecovery
+	// script flags.
+	greatWallActive := node.height > b.chainParams.GreatWallForkHeight
+
+	// If Graviton hardfork is active we must enforce MinimalData
+	gravitonActive := node.height > b.chainParams.GravitonForkHeight
+
+	// If Phonon hardfork is active we must enforce the new sig check rules and
+	// OP_REVERSEBYTES.
+	phononActive := node.height > b.chainParams.PhononForkHeight
+
+	// If CosmicInflation is active we enforce 64BitIntegers and NativeIntrospection
+	cosmicInflationActive := node.parent.CalcPastMedianTime().Unix() >= int64(b.chainParams.CosmicInflationActivationTime)
+
+	upgrade9Active := node.height > b.chainParams.Upgrade9Activation()
+
+	upgrade11Active := node.parent.CalcPastMedianTime().Unix() >= int64(b.chainParams.Upgrade11Activation())
+
+	// BIP0016 describes a pay-to-script-hash type that is considered a
+	// "standard" type.  The rules for this BIP only apply to transactions
+	// after the timestamp defined by txscript.Bip16Activation.  See
+	// https://en.bitcoin.it/wiki/BIP_0016 for more details.
+	enforceBIP0016 := node.timestamp >= txscript.Bip16Activation.Unix()
+
+	// Blocks created after the BIP0016 activation time need to have the
+	// pay-to-script-hash checks enabled.
+	var scriptFlags txscript.ScriptFlags
+	if enforceBIP0016 {
+		scriptFlags |= txscript.ScriptBip16
+	}
+
+	// Enforce DER signatures for block versions 3+ once the historical
+	// activation threshold has been reached.  This is part of BIP0066.
+	if blockHeader.Version >= 3 && node.height >= b.chainParams.BIP0066Height {
+		scriptFlags |= txscript.ScriptVerifyDERSignatures
+	}
+
+	// Enforce CHECKLOCKTIMEVERIFY for block versions 4+ once the historical
+	// activation threshold has been reached.  This is part of BIP0065.
+	if blockHeader.Version >= 4 && node.height >= b.chainParams.BIP0065Height {
+		scriptFlags |= txscript.ScriptVerifyCheckLockTimeVerify
+	}
+
+	// If Uahf is active we must enforce strict encoding on all signatures and enforce
+	// the replay protected sighash.
+	if uahfActive {
+		scriptFlags |= txscript.ScriptVerifyStrictEncoding | txscript.ScriptVerifyBip143SigHash
+	}
+
+	// If Daa is active enforce Low S and Nullfail script validation rules.
+	if daaActive {
+		scriptFlags |= txscript.ScriptVerifyLowS | txscript.ScriptVerifyNullFail
+	}
+
+	// If MagneticAnomaly hardfork is active we must enforce PushOnly and CleanStack
+	// and enable OP_CHECKDATASIG and OP_CHECKDATASIGVERIFY.
+	if magneticAnomalyActive {
+		scriptFlags |= txscript.ScriptVerifySigPushOnly |
+			txscript.ScriptVerifyCleanStack |
+			txscript.ScriptVerifyCheckDataSig
+	}
+
+	// If GreatWall hardfork is active enforce Schnorr and AllowSegwitRecovery script flags.
+	if greatWallActive {
+		scriptFlags |= txscript.ScriptVerifySchnorr | txscript.ScriptVerifyAllowSegwitRecovery
+	}
+
+	// If Graviton hardfork is active enforce MinimalData and SchnorrMultisig script flag.
+	if gravitonActive {
+		scriptFlags |= txscript.ScriptVerifyMinimalData | txscript.ScriptVerifySchnorrMultisig
+	}
+
+	// If Phonon hardfork is active we need to check the sig checks for both blocks and
+	// transactions as well as activate OP_REVERSEBYTES.
+	if phononActive {
+		scriptFlags |= txscript.ScriptReportSigChecks | txscript.ScriptVerifyReverseBytes
+	}
+
+	// If CosmicInflation hardfork is active enforce 64BitIntegers and NativeIntrospection
+	if cosmicInflationActive {
+		scriptFlags |= txscript.ScriptVerify64BitIntegers | txscript.ScriptVerifyNativeIntrospection
+	}
+
+	if upgrade9Active {
+		scriptFlags |= txscript.ScriptAllowCashTokens
+	}
+
+	if upgrade11Active {
+		scriptFlags |= txscript.ScriptAllowMay2025
+	}
+
+	// Enforce CHECKSEQUENCEVERIFY once the soft-fork deployment is fully
+	// active.
+	csvState, err := b.deploymentState(node.parent, chaincfg.DeploymentCSV)
+	if err != nil {
+		return 0, err
+	}
+	if csvState == ThresholdActive {
+		scriptFlags |= txscript.ScriptVerifyCheckSequenceVerify
+	}
+
+	// Apply the script flag overrides configured for testing upgrades.
+	return b.scriptFlagOverrides.Apply(scriptFlags), nil
+}
+
 // CheckConnectBlockTemplate fully validates that connecting the passed block to
 // the main chain does not violate any consensus rules, aside from the proof of
 // work requirement. The block must connect to the current tip of the main chain.
@@ -1310,5 +1368,5 @@ func (b *BlockChain) CheckConnectBlockTemplate(block *bchutil.Block) error {
 	// is not needed and thus extra work can be avoided.
 	view := NewUtxoViewpoint()
 	newNode := newBlockNode(&header, tip)
-	return b.checkConnectBlock(newNode, block, view, nil)
+	return b.checkConnectBlock(newNode, block, view, nil, nil)
 }
@@ -7,6 +7,7 @@ package blockchain
 import (
 	"math"
 	"reflect"
+	"sort"
 	"testing"
 	"time"
 
@@ -226,6 +227,63 @@ func TestCheckBlockSanity(t *testing.T) {
 	}
 }
 
+// TestCheckCanonicalTxOrder ensures CheckCanonicalTxOrder only accepts blocks
+// with the transactions following the coinbase sorted by ascending hash.
+func TestCheckCanonicalTxOrder(t *testing.T) {
+	// Sort the transactions of the block which follow the coinbase.
+	msgBlock := Block100000
+	msgBlock.Transactions = append([]*wire.MsgTx(nil),
+		Block100000.Transactions...)
+	txns := msgBlock.Transactions[1:]
+	sort.Slice(txns, func(i, j int) bool {
+		iHash, jHash := txns[i].TxHash(), txns[j].TxHash()
+		return iHash.Compare(&jHash) < 0
+	})
+	if err := CheckCanonicalTxOrder(bchutil.NewBlock(&msgBlock)); err != nil {
+		t.Errorf("CheckCanonicalTxOrder: unexpected error for a sorted "+
+			"block: %v", err)
+	}
+
+	// The coinbase is not sorted with the other transactions.
+	coinbaseOnly := msgBlock
+	coinbaseOnly.Transactions = msgBlock.Transactions[:1]
+	if err := CheckCanonicalTxOrder(bchutil.NewBlock(&coinbaseOnly)); err != nil {
+		t.Errorf("CheckCanonicalTxOrder: unexpected error for a block "+
+			"with only a coinbase: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(txns []*wire.MsgTx) []*wire.MsgTx
+	}{
+		{
+			name: "swapped transactions",
+			mutate: func(txns []*wire.MsgTx) []*wire.MsgTx {
+				txns[2], txns[3] = txns[3], txns[2]
+				return txns
+			},
+		},
+		{
+			name: "duplicate transaction",
+			mutate: func(txns []*wire.MsgTx) []*wire.MsgTx {
+				return append(txns[:3], txns[2:]...)
+			},
+		},
+	}
+	for _, test := range tests {
+		mutated := msgBlock
+		mutated.Transactions = test.mutate(append([]*wire.MsgTx(nil),
+			msgBlock.Transactions...))
+		err := CheckCanonicalTxOrder(bchutil.NewBlock(&mutated))
+		if rerr, ok := err.(RuleError); !ok ||
+			rerr.ErrorCode != ErrInvalidTxOrder {
+
+			t.Errorf("%s: got error %v, want %v", test.name, err,
+				ErrInvalidTxOrder)
+		}
+	}
+}
+
 // TestCheckSerializedHeight tests the checkSerializedHeight function with
 // various serialized heights and also does negative tests to ensure errors
 // and handled properly.
@@ -0,0 +1,206 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchutil"
+)
+
+// maxValidationStats is the number of the most recently processed blocks the
+// validation timing breakdown is kept for.
+const maxValidationStats = 100
+
+// validationPhase identifies a phase of processing a block.
+type validationPhase int
+
+// The following constants define the phases of processing a block which are
+// timed separately.
+const (
+	// phaseDeserialize is decoding the transactions of the block and
+	// computing their hashes when that was not done before the block was
+	// handed to the chain.
+	phaseDeserialize validationPhase = iota
+
+	// phaseSanity is the checks which don't depend on the chain.
+	phaseSanity
+
+	// phaseContext is the checks which depend on the position of the
+	// block within the chain.
+	phaseContext
+
+	// phaseStore is writing the block to the database.
+	phaseStore
+
+	// phaseFetchInputs is loading the outputs spent by the block.
+	phaseFetchInputs
+
+	// phaseCheckInputs is the checks of the transaction inputs and the
+	// other checks done while connecting the block, except for scripts.
+	phaseCheckInputs
+
+	// phaseScripts is validating the scripts of the block.
+	phaseScripts
+
+	// phaseIndexes is updating the chain state, the spend journal and the
+	// optional indexes in the database and the utxo cache.
+	phaseIndexes
+
+	// phaseNotify is notifying the subscribers of the connected block.
+	phaseNotify
+
+	// phaseFlush is flushing the utxo cache to the database when needed.
+	phaseFlush
+
+	// numValidationPhases is the number of validation phases.
+	//
+	// NOTE: This must be defined last in order to avoid influencing iota.
+	numValidationPhases
+)
+
+// BlockValidationStats houses the time spent in each phase of processing a
+// block which was accepted into the chain.  Phases which were skipped, for
+// example when a block only extends a side chain, take no time.
+type BlockValidationStats struct {
+	Hash      chainhash.Hash
+	Height    int32
+	Time      time.Time
+	MainChain bool
+
+	Deserialize time.Duration
+	Sanity      time.Duration
+	Context     time.Duration
+	Store       time.Duration
+	FetchInputs time.Duration
+	CheckInputs time.Duration
+	Scripts     time.Duration
+	Indexes     time.Duration
+	Notify      time.Duration
+	Flush       time.Duration
+	Total       time.Duration
+}
+
+// blockTimer accumulates the time spent in each phase of processing a block.
+type blockTimer struct {
+	start  time.Time
+	phases [numValidationPhases]time.Duration
+}
+
+// validationStats times the phases of processing blocks and keeps the results
+// for the most recently accepted blocks.  It has its own lock since the
+// results are read without holding the chain lock and the chain lock is
+// released while notifying about connected blocks.
+type validationStats struct {
+	sync.Mutex
+	timers  map[chainhash.Hash]*blockTimer
+	results []BlockValidationStats
+	next    int
+}
+
+// newValidationStats returns a new instance keeping the results for up to the
+// passed number of blocks.
+func newValidationStats(maxResults int) *validationStats {
+	return &validationStats{
+		timers:  make(map[chainhash.Hash]*blockTimer),
+		results: make([]BlockValidationStats, 0, maxResults),
+	}
+}
+
+// start starts timing the processing of the passed block.
+func (s *validationStats) start(hash *chainhash.Hash) {
+	s.Lock()
+	s.timers[*hash] = &blockTimer{start: time.Now()}
+	s.Unlock()
+}
+
+// record adds the time elapsed since start to the passed phase of processing
+// the block with the passed hash.  Nothing is recorded when the block is not
+// being timed, such as when a block template is checked or blocks are
+// reconnected during a reorganization.
+func (s *validationStats) record(hash *chainhash.Hash, phase validationPhase, start time.Time) {
+	elapsed := time.Since(start)
+	s.Lock()
+	if timer, ok := s.timers[*hash]; ok {
+		timer.phases[phase] += elapsed
+	}
+	s.Unlock()
+}
+
+// finish stops timing the processing of the passed block.  The results are
+// kept when the block was accepted into the chain.
+func (s *validationStats) finish(block *bchutil.Block, accepted, mainChain bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	timer, ok := s.timers[*block.Hash()]
+	if !ok {
+		return
+	}
+	delete(s.timers, *block.Hash())
+	if !accepted {
+		return
+	}
+
+	result := BlockValidationStats{
+		Hash:        *block.Hash(),
+		Height:      block.Height(),
+		Time:        timer.start,
+		MainChain:   mainChain,
+		Deserialize: timer.phases[phaseDeserialize],
+		Sanity:      timer.phases[phaseSanity],
+		Context:     timer.phases[phaseContext],
+		Store:       timer.phases[phaseStore],
+		FetchInputs: timer.phases[phaseFetchInputs],
+		CheckInputs: timer.phases[phaseCheckInputs],
+		Scripts:     timer.phases[phaseScripts],
+		Indexes:     timer.phases[phaseIndexes],
+		Notify:      timer.phases[phaseNotify],
+		Flush:       timer.phases[phaseFlush],
+		Total:       time.Since(timer.start),
+	}
+	log.Debugf("Processed block %v (height %d) in %v: deserialize %v, "+
+		"sanity %v, context %v, store %v, fetch inputs %v, check "+
+		"inputs %v, scripts %v, indexes %v, notify %v, flush %v",
+		result.Hash, result.Height, result.Total, result.Deserialize,
+		result.Sanity, result.Context, result.Store, result.FetchInputs,
+		result.CheckInputs, result.Scripts, result.Indexes,
+		result.Notify, result.Flush)
+
+	if len(s.results) < cap(s.results) {
+		s.results = append(s.results, result)
+		return
+	}
+	s.results[s.next] = result
+	s.next = (s.next + 1) % len(s.results)
+}
+
+// latest returns the results for up to the passed number of the most recently
+// accepted blocks, most recent first.
+func (s *validationStats) latest(count int) []BlockValidationStats {
+	s.Lock()
+	defer s.Unlock()
+
+	if count > len(s.results) {
+		count = len(s.results)
+	}
+	latest := make([]BlockValidationStats, 0, count)
+	for i := 1; i <= count; i++ {
+		idx := (s.next - i + len(s.results)) % len(s.results)
+		latest = append(latest, s.results[idx])
+	}
+	return latest
+}
+
+// BlockValidationStats returns the time spent in each phase of processing up
+// to the passed number of the most recently accepted blocks, most recent
+// first.  The results are kept for the last 100 blocks.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) BlockValidationStats(count int) []BlockValidationStats {
+	return b.validationStats.latest(count)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// TestBlockValidationStats ensures the phases of processing blocks are timed
+// and the results are returned for the most recent blocks first.
+func TestBlockValidationStats(t *testing.T) {
+	chain, params, tearDown := utxoCacheTestChain("TestBlockValidationStats")
+	defer tearDown()
+
+	tip := bchutil.NewBlock(params.GenesisBlock)
+	var spends []*spendableOut
+	var blocks []*bchutil.Block
+	for i := 0; i < 3; i++ {
+		tip, spends = addBlock(chain, tip, spends)
+		blocks = append(blocks, tip)
+	}
+
+	// Processing a known block again must not be recorded.
+	if _, _, err := chain.ProcessBlock(blocks[0], BFNone); err == nil {
+		t.Fatal("ProcessBlock: expected an error for a duplicate block")
+	}
+
+	stats := chain.BlockValidationStats(10)
+	if len(stats) != len(blocks) {
+		t.Fatalf("got stats for %d blocks, want %d", len(stats),
+			len(blocks))
+	}
+	for i, stat := range stats {
+		block := blocks[len(blocks)-1-i]
+		if stat.Hash != *block.Hash() || stat.Height != block.Height() {
+			t.Errorf("stats %d are for block %v (height %d), want %v "+
+				"(height %d)", i, stat.Hash, stat.Height,
+				block.Hash(), block.Height())
+		}
+		if !stat.MainChain {
+			t.Errorf("stats %d: block not reported as connected to the "+
+				"main chain", i)
+		}
+		if stat.Total <= 0 || stat.Scripts > stat.Total {
+			t.Errorf("stats %d: unexpected total %v with scripts %v",
+				i, stat.Total, stat.Scripts)
+		}
+	}
+
+	if got := len(chain.BlockValidationStats(2)); got != 2 {
+		t.Errorf("got stats for %d blocks, want 2", got)
+	}
+}
+
+// TestValidationStatsLatest ensures only the results for the most recent
+// blocks are kept once the limit is reached.
+func TestValidationStatsLatest(t *testing.T) {
+	stats := newValidationStats(3)
+	for i := 0; i < 5; i++ {
+		block := bchutil.NewBlock(&wire.MsgBlock{
+			Header: wire.BlockHeader{Nonce: uint32(i)},
+		})
+		block.SetHeight(int32(i))
+		stats.start(block.Hash())
+		stats.record(block.Hash(), phaseScripts, stats.timers[*block.Hash()].start)
+		stats.finish(block, true, true)
+	}
+
+	// Results for blocks which were never started or were rejected must
+	// not be kept.
+	var unknown chainhash.Hash
+	stats.record(&unknown, phaseScripts, stats.results[0].Time)
+	rejected := bchutil.NewBlock(&wire.MsgBlock{
+		Header: wire.BlockHeader{Nonce: 10},
+	})
+	stats.start(rejected.Hash())
+	stats.finish(rejected, false, false)
+	if len(stats.timers) != 0 {
+		t.Fatalf("got %d blocks still being timed, want 0",
+			len(stats.timers))
+	}
+
+	latest := stats.latest(10)
+	want := []int32{4, 3, 2}
+	if len(latest) != len(want) {
+		t.Fatalf("got stats for %d blocks, want %d", len(latest),
+			len(want))
+	}
+	for i, stat := range latest {
+		if stat.Height != want[i] {
+			t.Errorf("stats %d are for height %d, want %d", i,
+				stat.Height, want[i])
+		}
+	}
+}
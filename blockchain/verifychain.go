@@ -0,0 +1,397 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/database"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// VerifyLevel defines how thoroughly VerifyChain verifies the blocks of the
+// main chain.  Each level performs the checks of the levels below it as well.
+type VerifyLevel int32
+
+// These constants define the verification levels.  They match the check
+// levels of the verifychain RPC.
+const (
+	// VerifyReadBlocks ensures the blocks can be loaded from the database.
+	VerifyReadBlocks VerifyLevel = iota
+
+	// VerifySanity performs the context-free sanity checks on the blocks.
+	VerifySanity
+
+	// VerifyReadUndo ensures the undo data of the blocks, which is the
+	// spend journal holding the outputs they spent, can be loaded and
+	// matches the inputs of the blocks.
+	VerifyReadUndo
+
+	// VerifyApplyUndo disconnects the blocks from a view of the utxo set in
+	// memory by applying their undo data, which ensures the outputs they
+	// created are unspent unless spent by a later block.
+	VerifyApplyUndo
+
+	// VerifyScripts connects the disconnected blocks to the view again,
+	// checking their inputs and validating their scripts without relying
+	// on the signature cache.
+	VerifyScripts
+
+	// MaxVerifyLevel is the most thorough verification level.
+	MaxVerifyLevel = VerifyScripts
+)
+
+var (
+	// overwrittenCoinbases holds the hashes of the two coinbases whose
+	// outputs were overwritten by the duplicate coinbases of the blocks
+	// violating the rules set forth in BIP0030.  The duplicates were spent
+	// since, so the outputs are missing from the utxo set.
+	overwrittenCoinbases = map[chainhash.Hash]struct{}{
+		*newHashFromStr("d5d27987d2a3dfc724e359870c6644b40e497bdc0589a033220fe15429d88599"): {},
+		*newHashFromStr("e3bf3d07d4b0375638d5f1db5255fe07ba2c4cb067cd81b84ee974b6585fb468"): {},
+	}
+
+	// ErrVerifyInterrupted is returned by VerifyChain when it was
+	// interrupted before it completed.
+	ErrVerifyInterrupted = errors.New("chain verification interrupted")
+
+	// ErrVerifyTipChanged is returned by VerifyChain when the main chain
+	// changed while applying undo data, which invalidates the view of the
+	// utxo set being verified.
+	ErrVerifyTipChanged = errors.New("the main chain changed during " +
+		"verification")
+)
+
+// VerifyChainProgress describes the progress of VerifyChain.
+type VerifyChainProgress struct {
+	// Height is the height of the block verified last.
+	Height int32
+
+	// Verified is the number of blocks verified so far and Total the
+	// number of blocks to verify.  Blocks are counted twice at
+	// VerifyScripts since they are disconnected and then connected again.
+	Verified int32
+	Total    int32
+}
+
+// VerifyChainOptions defines the verification performed by VerifyChain.
+type VerifyChainOptions struct {
+	// Level is how thoroughly the blocks are verified.
+	Level VerifyLevel
+
+	// Depth is the number of blocks verified back from the tip of the main
+	// chain.  Zero verifies all blocks after the genesis block.
+	Depth int32
+
+	// Throttle pauses the verification after each block for as long as
+	// verifying it took, which leaves most of the resources of the node
+	// to its other tasks.
+	Throttle bool
+
+	// Progress is an optional function called after each block verified.
+	Progress func(VerifyChainProgress)
+}
+
+// verifyBlockOutputs ensures the outputs created by the passed block, which is
+// the block of the passed node, are unspent in the passed view of the utxo set
+// or otherwise in the utxo set of the main chain.  The view holds the outputs
+// restored and removed by disconnecting the blocks after the block.
+//
+// This function MUST be called with the chain state lock held (for reads).
+func (b *BlockChain) verifyBlockOutputs(view *UtxoViewpoint, node *blockNode, block *bchutil.Block) error {
+	for _, tx := range block.Transactions() {
+		prevOut := wire.OutPoint{Hash: *tx.Hash()}
+		for txOutIdx, txOut := range tx.MsgTx().TxOut {
+			if txscript.IsUnspendable(txOut.PkScript) {
+				continue
+			}
+			prevOut.Index = uint32(txOutIdx)
+			entry := view.LookupEntry(prevOut)
+			if entry == nil {
+				var err error
+				entry, err = b.utxoCache.FetchEntry(prevOut)
+				if err != nil {
+					return err
+				}
+			}
+
+			if entry == nil || entry.IsSpent() {
+				if _, ok := overwrittenCoinbases[*tx.Hash()]; ok {
+					continue
+				}
+				return fmt.Errorf("output %v is missing from the "+
+					"utxo set", prevOut)
+			}
+			if entry.Amount() != txOut.Value ||
+				entry.BlockHeight() != node.height {
+
+				return fmt.Errorf("output %v does not match the "+
+					"utxo set", prevOut)
+			}
+		}
+	}
+	return nil
+}
+
+// reconnectBlock checks the inputs and validates the scripts of the passed
+// block, which is the block of the passed node, against the passed view of
+// the utxo set as of its parent and connects it to the view.  Unlike when the
+// block was connected to the main chain, the signature cache is not used.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) reconnectBlock(view *UtxoViewpoint, tip, node *blockNode, block *bchutil.Block) error {
+	magneticAnomalyActive := node.height > b.chainParams.MagneticAnonomalyForkHeight
+
+	// The script flags are derived from the deployment states, which
+	// require the chain state lock for writes.  The inputs spent by the
+	// block were restored to the view when it was disconnected.
+	b.chainLock.Lock()
+	if b.bestChain.Tip() != tip {
+		b.chainLock.Unlock()
+		return ErrVerifyTipChanged
+	}
+	scriptFlags, err := b.blockScriptFlags(node, &block.MsgBlock().Header)
+	if err == nil {
+		err = view.addInputUtxos(b.utxoCache, block, magneticAnomalyActive)
+	}
+	b.chainLock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	// The sigchecks limit of the block derives from the ABLA state of its
+	// parent rather than that of the current tip.  Blocks at or below the
+	// fork height were connected with the initial state, which older
+	// databases might not have stored.
+	var ablaState *ABLAState
+	err = b.db.View(func(dbTx database.Tx) error {
+		var err error
+		ablaState, err = dbFetchAblaStateByHeight(dbTx, node.parent.height)
+		return err
+	})
+	if err != nil {
+		if node.parent.height > b.chainParams.ABLAForkHeight {
+			return AssertError(fmt.Sprintf("reconnectBlock: cannot "+
+				"find ABLA state index for block at height: %d",
+				node.parent.height))
+		}
+		ablaState = &ABLAState{
+			blockHeight:       uint64(b.chainParams.ABLAForkHeight),
+			controlBlockSize:  b.ablaConfig.epsilon0,
+			elasticBufferSize: b.ablaConfig.beta0,
+		}
+	}
+	maxSigChecks := uint32(ablaState.getBlockSizeLimit()) /
+		BlockMaxBytesMaxSigChecksRatio
+
+	for _, tx := range block.Transactions() {
+		_, err := CheckTransactionInputs(tx, node.height, view,
+			b.chainParams)
+		if err != nil {
+			return err
+		}
+		if !magneticAnomalyActive {
+			err := connectTransaction(view, tx, node.height, nil, false)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if magneticAnomalyActive {
+		err := connectTransactions(view, block, nil, false)
+		if err != nil {
+			return err
+		}
+	}
+	return checkBlockScripts(block, view, scriptFlags, nil, nil,
+		maxSigChecks, b.chainParams.Upgrade9Activation())
+}
+
+// VerifyChain verifies the blocks of the main chain back from its tip as
+// defined by the passed options and returns an error describing the first
+// problem found.
+//
+// The blocks are disconnected from a view of the utxo set in memory from
+// VerifyApplyUndo on.  Disconnecting stops early once the view uses as much
+// memory as the utxo cache is allowed to, in which case only the blocks
+// disconnected are connected again at VerifyScripts while the others are
+// verified at VerifyReadUndo.  Since the view depends on the utxo set of the
+// main chain, ErrVerifyTipChanged is returned when the main chain changes
+// before the blocks are connected again.
+//
+// ErrVerifyInterrupted is returned when interrupt is closed before the
+// verification completed.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) VerifyChain(opts *VerifyChainOptions, interrupt <-chan struct{}) error {
+	b.chainLock.RLock()
+	tip := b.bestChain.Tip()
+	b.chainLock.RUnlock()
+
+	depth := opts.Depth
+	if depth <= 0 || depth > tip.height {
+		depth = tip.height
+	}
+	if b.pruneMode && depth > int32(b.pruneDepth) {
+		depth = int32(b.pruneDepth)
+	}
+	progress := VerifyChainProgress{Total: depth}
+	if opts.Level >= VerifyScripts {
+		progress.Total += depth
+	}
+	log.Infof("Verifying %d blocks at level %d", depth, opts.Level)
+
+	b.utxoCache.mtx.Lock()
+	maxViewUsage := b.utxoCache.maxTotalMemoryUsage
+	b.utxoCache.mtx.Unlock()
+
+	// step reports the progress after verifying a block and pauses when
+	// the verification is throttled.
+	step := func(node *blockNode, start time.Time) error {
+		progress.Height = node.height
+		progress.Verified++
+		if opts.Progress != nil {
+			opts.Progress(progress)
+		}
+		if !opts.Throttle {
+			return nil
+		}
+		select {
+		case <-interrupt:
+			return ErrVerifyInterrupted
+		case <-time.After(time.Since(start)):
+			return nil
+		}
+	}
+
+	// Walk back from the tip, disconnecting the blocks from the view
+	// until it grows too large when requested.
+	view := NewUtxoViewpoint()
+	var viewUsage uint64
+	var disconnected []*blockNode
+	undoing := opts.Level >= VerifyApplyUndo
+	node := tip
+	for i := int32(0); i < depth; i++ {
+		if interruptRequested(interrupt) {
+			return ErrVerifyInterrupted
+		}
+		start := time.Now()
+
+		var block *bchutil.Block
+		var stxos []SpentTxOut
+		err := b.db.View(func(dbTx database.Tx) error {
+			var err error
+			block, err = dbFetchBlockByNode(dbTx, node)
+			if err != nil || opts.Level < VerifyReadUndo {
+				return err
+			}
+			stxos, err = dbFetchSpendJournalEntry(dbTx, block)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("unable to load block %v (height %d): %v",
+				node.hash, node.height, err)
+		}
+
+		if opts.Level >= VerifySanity {
+			magneticAnomalyActive := node.height > b.chainParams.MagneticAnonomalyForkHeight
+			upgrade9Active := node.height > b.chainParams.Upgrade9Activation()
+			err := CheckBlockSanity(block, b.chainParams.PowLimit,
+				b.timeSource, magneticAnomalyActive, upgrade9Active)
+			if err != nil {
+				return fmt.Errorf("block %v (height %d) failed the "+
+					"sanity checks: %v", node.hash, node.height, err)
+			}
+		}
+
+		if opts.Level >= VerifyReadUndo && len(stxos) != countSpentOutputs(block) {
+			return fmt.Errorf("undo data of block %v (height %d) has "+
+				"%d spent outputs, want %d", node.hash, node.height,
+				len(stxos), countSpentOutputs(block))
+		}
+
+		if undoing && viewUsage > maxViewUsage {
+			log.Infof("Applying the undo data of the last %d blocks "+
+				"only to stay within the utxo cache size",
+				len(disconnected))
+			undoing = false
+			if opts.Level >= VerifyScripts {
+				progress.Total -= depth - int32(len(disconnected))
+			}
+		}
+		if undoing {
+			b.chainLock.RLock()
+			if b.bestChain.Tip() != tip {
+				b.chainLock.RUnlock()
+				return ErrVerifyTipChanged
+			}
+			err := b.verifyBlockOutputs(view, node, block)
+			if err == nil {
+				err = disconnectTransactions(view, block, stxos)
+			}
+			b.chainLock.RUnlock()
+			if err != nil {
+				return fmt.Errorf("unable to apply the undo data of "+
+					"block %v (height %d): %v", node.hash,
+					node.height, err)
+			}
+			for _, tx := range block.Transactions() {
+				for _, txIn := range tx.MsgTx().TxIn {
+					viewUsage += view.LookupEntry(txIn.PreviousOutPoint).memoryUsage()
+				}
+				viewUsage += uint64(len(tx.MsgTx().TxOut)) *
+					baseUtxoEntrySizeWithoutTokenData
+			}
+			disconnected = append(disconnected, node)
+		}
+
+		if err := step(node, start); err != nil {
+			return err
+		}
+		node = node.parent
+	}
+
+	// Connect the disconnected blocks again in order.
+	if opts.Level >= VerifyScripts {
+		for i := len(disconnected) - 1; i >= 0; i-- {
+			if interruptRequested(interrupt) {
+				return ErrVerifyInterrupted
+			}
+			start := time.Now()
+
+			node := disconnected[i]
+			var block *bchutil.Block
+			err := b.db.View(func(dbTx database.Tx) error {
+				var err error
+				block, err = dbFetchBlockByNode(dbTx, node)
+				return err
+			})
+			if err == nil {
+				err = b.reconnectBlock(view, tip, node, block)
+			}
+			if err == ErrVerifyTipChanged {
+				return err
+			}
+			if err != nil {
+				return fmt.Errorf("unable to connect block %v "+
+					"(height %d) again: %v", node.hash,
+					node.height, err)
+			}
+
+			if err := step(node, start); err != nil {
+				return err
+			}
+		}
+	}
+
+	log.Infof("Chain verification of %d blocks at level %d completed",
+		depth, opts.Level)
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gcash/bchd/database"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// TestVerifyChain ensures the blocks of the main chain verify at every level
+// and that missing undo data and a missing output of the utxo set are
+// detected.
+func TestVerifyChain(t *testing.T) {
+	chain, params, tearDown := utxoCacheTestChain("TestVerifyChain")
+	defer tearDown()
+
+	// Build a chain where each block spends the outputs of the block
+	// before it.
+	tip := bchutil.NewBlock(params.GenesisBlock)
+	var outs []*spendableOut
+	var blocks []*bchutil.Block
+	for i := 0; i < 5; i++ {
+		tip, outs = addBlock(chain, tip, outs)
+		blocks = append(blocks, tip)
+	}
+
+	for level := VerifyReadBlocks; level <= MaxVerifyLevel; level++ {
+		var last VerifyChainProgress
+		opts := &VerifyChainOptions{
+			Level: level,
+			Progress: func(p VerifyChainProgress) {
+				last = p
+			},
+		}
+		if err := chain.VerifyChain(opts, nil); err != nil {
+			t.Fatalf("VerifyChain at level %d: %v", level, err)
+		}
+		want := int32(len(blocks))
+		if level >= VerifyScripts {
+			want *= 2
+		}
+		if last.Verified != want || last.Total != want {
+			t.Errorf("got %d of %d blocks verified at level %d, "+
+				"want %d", last.Verified, last.Total, level, want)
+		}
+	}
+
+	// A closed interrupt channel stops the verification.
+	interrupt := make(chan struct{})
+	close(interrupt)
+	opts := &VerifyChainOptions{Level: MaxVerifyLevel}
+	if err := chain.VerifyChain(opts, interrupt); err != ErrVerifyInterrupted {
+		t.Fatalf("got error %v for an interrupted verification", err)
+	}
+
+	// An output of the tip missing from the utxo set is detected once the
+	// undo data is applied.
+	coinbase := blocks[4].Transactions()[0]
+	outpoint := wire.OutPoint{Hash: *coinbase.Hash()}
+	chain.utxoCache.mtx.Lock()
+	entry, err := chain.utxoCache.getEntry(outpoint)
+	if err != nil || entry == nil {
+		chain.utxoCache.mtx.Unlock()
+		t.Fatalf("unable to fetch the coinbase output of the tip: %v", err)
+	}
+	saved := entry.Clone()
+	entry.Spend()
+	chain.utxoCache.mtx.Unlock()
+	opts = &VerifyChainOptions{Level: VerifyReadUndo}
+	if err := chain.VerifyChain(opts, nil); err != nil {
+		t.Fatalf("VerifyChain at level %d: %v", opts.Level, err)
+	}
+	opts.Level = VerifyApplyUndo
+	err = chain.VerifyChain(opts, nil)
+	if err == nil || !strings.Contains(err.Error(), outpoint.String()) {
+		t.Fatalf("got error %v for a missing output, want one "+
+			"mentioning %v", err, outpoint)
+	}
+	chain.utxoCache.mtx.Lock()
+	chain.utxoCache.cachedEntries[outpoint] = saved
+	chain.utxoCache.mtx.Unlock()
+
+	// Missing undo data is detected unless the verification is limited to
+	// the blocks after it.
+	err = chain.db.Update(func(dbTx database.Tx) error {
+		return dbRemoveSpendJournalEntry(dbTx, blocks[2].Hash())
+	})
+	if err != nil {
+		t.Fatalf("unable to remove the undo data: %v", err)
+	}
+	opts = &VerifyChainOptions{Level: VerifyReadUndo, Depth: 2}
+	if err := chain.VerifyChain(opts, nil); err != nil {
+		t.Fatalf("VerifyChain of the last 2 blocks: %v", err)
+	}
+	opts.Depth = 3
+	if err := chain.VerifyChain(opts, nil); err == nil {
+		t.Fatal("VerifyChain did not detect the missing undo data")
+	}
+	opts.Level = VerifySanity
+	if err := chain.VerifyChain(opts, nil); err != nil {
+		t.Fatalf("VerifyChain at level %d: %v", opts.Level, err)
+	}
+}
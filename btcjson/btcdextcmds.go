@@ -8,6 +8,8 @@
 
 package btcjson
 
+import "github.com/gcash/bchd/wire"
+
 // NodeSubCmd defines the type used in the addnode JSON-RPC command for the
 // sub command field.
 type NodeSubCmd string
@@ -24,6 +26,35 @@ const (
 	NDisconnect NodeSubCmd = "disconnect"
 )
 
+// AuditBlockTemplateCmd defines the auditblocktemplate JSON-RPC command.
+type AuditBlockTemplateCmd struct {
+	HexBlock string
+}
+
+// NewAuditBlockTemplateCmd returns a new instance which can be used to issue an
+// auditblocktemplate JSON-RPC command.
+func NewAuditBlockTemplateCmd(hexBlock string) *AuditBlockTemplateCmd {
+	return &AuditBlockTemplateCmd{
+		HexBlock: hexBlock,
+	}
+}
+
+// CompactSlpIndexCmd defines the compactslpindex JSON-RPC command.
+type CompactSlpIndexCmd struct {
+	MaxUnspentAmount *uint64 `jsonrpcdefault:"0"`
+}
+
+// NewCompactSlpIndexCmd returns a new instance which can be used to issue a
+// compactslpindex JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewCompactSlpIndexCmd(maxUnspentAmount *uint64) *CompactSlpIndexCmd {
+	return &CompactSlpIndexCmd{
+		MaxUnspentAmount: maxUnspentAmount,
+	}
+}
+
 // NodeCmd defines the dropnode JSON-RPC command.
 type NodeCmd struct {
 	SubCmd        NodeSubCmd `jsonrpcusage:"\"connect|remove|disconnect\""`
@@ -59,6 +90,15 @@ func NewDebugLevelCmd(levelSpec string) *DebugLevelCmd {
 	}
 }
 
+// ForceReseedCmd defines the forcereseed JSON-RPC command.
+type ForceReseedCmd struct{}
+
+// NewForceReseedCmd returns a new instance which can be used to issue a
+// forcereseed JSON-RPC command.
+func NewForceReseedCmd() *ForceReseedCmd {
+	return &ForceReseedCmd{}
+}
+
 // GenerateCmd defines the generate JSON-RPC command.
 type GenerateCmd struct {
 	NumBlocks uint32
@@ -90,6 +130,15 @@ func NewGetCurrentNetCmd() *GetCurrentNetCmd {
 	return &GetCurrentNetCmd{}
 }
 
+// GetDBInfoCmd defines the getdbinfo JSON-RPC command.
+type GetDBInfoCmd struct{}
+
+// NewGetDBInfoCmd returns a new instance which can be used to issue a
+// getdbinfo JSON-RPC command.
+func NewGetDBInfoCmd() *GetDBInfoCmd {
+	return &GetDBInfoCmd{}
+}
+
 // GetHeadersCmd defines the getheaders JSON-RPC command.
 //
 // NOTE: This is a btcsuite extension ported from
@@ -111,6 +160,370 @@ func NewGetHeadersCmd(blockLocators []string, hashStop string) *GetHeadersCmd {
 	}
 }
 
+// GetBlockByHeightCmd defines the getblockbyheight JSON-RPC command.
+type GetBlockByHeightCmd struct {
+	Height    int64
+	Verbosity *VerbosityLevel `jsonrpcdefault:"1"`
+}
+
+// NewGetBlockByHeightCmd returns a new instance which can be used to issue a
+// getblockbyheight JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetBlockByHeightCmd(height int64, verbosity *VerbosityLevel) *GetBlockByHeightCmd {
+	return &GetBlockByHeightCmd{
+		Height:    height,
+		Verbosity: verbosity,
+	}
+}
+
+// GetBlockValidationStatsCmd defines the getblockvalidationstats JSON-RPC
+// command.
+type GetBlockValidationStatsCmd struct {
+	Count *int `jsonrpcdefault:"10"`
+}
+
+// NewGetBlockValidationStatsCmd returns a new instance which can be used to
+// issue a getblockvalidationstats JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetBlockValidationStatsCmd(count *int) *GetBlockValidationStatsCmd {
+	return &GetBlockValidationStatsCmd{
+		Count: count,
+	}
+}
+
+// GetCPFPInfoCmd defines the getcpfpinfo JSON-RPC command.
+type GetCPFPInfoCmd struct {
+	TxID      string
+	ChildSize *int `jsonrpcdefault:"192"`
+}
+
+// NewGetCPFPInfoCmd returns a new instance which can be used to issue a
+// getcpfpinfo JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetCPFPInfoCmd(txID string, childSize *int) *GetCPFPInfoCmd {
+	return &GetCPFPInfoCmd{
+		TxID:      txID,
+		ChildSize: childSize,
+	}
+}
+
+// GetDSProofCmd defines the getdsproof JSON-RPC command.
+type GetDSProofCmd struct {
+	ID      string
+	Verbose *bool `jsonrpcdefault:"true"`
+}
+
+// NewGetDSProofCmd returns a new instance which can be used to issue a
+// getdsproof JSON-RPC command.  The ID is the hash of a double-spend proof,
+// the hash of a mempool transaction or an outpoint in the form 'txid:vout'.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetDSProofCmd(id string, verbose *bool) *GetDSProofCmd {
+	return &GetDSProofCmd{
+		ID:      id,
+		Verbose: verbose,
+	}
+}
+
+// GetForkMonitorInfoCmd defines the getforkmonitorinfo JSON-RPC command.
+type GetForkMonitorInfoCmd struct{}
+
+// NewGetForkMonitorInfoCmd returns a new instance which can be used to issue a
+// getforkmonitorinfo JSON-RPC command.
+func NewGetForkMonitorInfoCmd() *GetForkMonitorInfoCmd {
+	return &GetForkMonitorInfoCmd{}
+}
+
+// GetVerifyChainInfoCmd defines the getverifychaininfo JSON-RPC command.
+type GetVerifyChainInfoCmd struct{}
+
+// NewGetVerifyChainInfoCmd returns a new instance which can be used to issue a
+// getverifychaininfo JSON-RPC command.
+func NewGetVerifyChainInfoCmd() *GetVerifyChainInfoCmd {
+	return &GetVerifyChainInfoCmd{}
+}
+
+// StopVerifyChainCmd defines the stopverifychain JSON-RPC command.
+type StopVerifyChainCmd struct{}
+
+// NewStopVerifyChainCmd returns a new instance which can be used to issue a
+// stopverifychain JSON-RPC command.
+func NewStopVerifyChainCmd() *StopVerifyChainCmd {
+	return &StopVerifyChainCmd{}
+}
+
+// GetMempoolSnapshotCmd defines the getmempoolsnapshot JSON-RPC command.
+type GetMempoolSnapshotCmd struct{}
+
+// NewGetMempoolSnapshotCmd returns a new instance which can be used to issue a
+// getmempoolsnapshot JSON-RPC command.
+func NewGetMempoolSnapshotCmd() *GetMempoolSnapshotCmd {
+	return &GetMempoolSnapshotCmd{}
+}
+
+// GetMempoolStatsCmd defines the getmempoolstats JSON-RPC command.
+type GetMempoolStatsCmd struct {
+	History *string `jsonrpcdefault:"\"24h\""`
+}
+
+// NewGetMempoolStatsCmd returns a new instance which can be used to issue a
+// getmempoolstats JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetMempoolStatsCmd(history *string) *GetMempoolStatsCmd {
+	return &GetMempoolStatsCmd{
+		History: history,
+	}
+}
+
+// GetMempoolTxGraphCmd defines the getmempooltxgraph JSON-RPC command.
+type GetMempoolTxGraphCmd struct {
+	TxID string
+}
+
+// NewGetMempoolTxGraphCmd returns a new instance which can be used to issue a
+// getmempooltxgraph JSON-RPC command.
+func NewGetMempoolTxGraphCmd(txID string) *GetMempoolTxGraphCmd {
+	return &GetMempoolTxGraphCmd{
+		TxID: txID,
+	}
+}
+
+// GetOrphanPoolCmd defines the getorphanpool JSON-RPC command.
+type GetOrphanPoolCmd struct{}
+
+// NewGetOrphanPoolCmd returns a new instance which can be used to issue a
+// getorphanpool JSON-RPC command.
+func NewGetOrphanPoolCmd() *GetOrphanPoolCmd {
+	return &GetOrphanPoolCmd{}
+}
+
+// GetOrphanPoolInfoCmd defines the getorphanpoolinfo JSON-RPC command.
+type GetOrphanPoolInfoCmd struct{}
+
+// NewGetOrphanPoolInfoCmd returns a new instance which can be used to issue a
+// getorphanpoolinfo JSON-RPC command.
+func NewGetOrphanPoolInfoCmd() *GetOrphanPoolInfoCmd {
+	return &GetOrphanPoolInfoCmd{}
+}
+
+// GetRejectedTxsCmd defines the getrejectedtxs JSON-RPC command.
+type GetRejectedTxsCmd struct {
+	PeerID *int32
+}
+
+// NewGetRejectedTxsCmd returns a new instance which can be used to issue a
+// getrejectedtxs JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetRejectedTxsCmd(peerID *int32) *GetRejectedTxsCmd {
+	return &GetRejectedTxsCmd{
+		PeerID: peerID,
+	}
+}
+
+// GetReorgInfoCmd defines the getreorginfo JSON-RPC command.
+type GetReorgInfoCmd struct {
+	Count *int `jsonrpcdefault:"10"`
+	TxID  *string
+}
+
+// NewGetReorgInfoCmd returns a new instance which can be used to issue a
+// getreorginfo JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetReorgInfoCmd(count *int, txID *string) *GetReorgInfoCmd {
+	return &GetReorgInfoCmd{
+		Count: count,
+		TxID:  txID,
+	}
+}
+
+// GetScriptFlagsCmd defines the getscriptflags JSON-RPC command.
+type GetScriptFlagsCmd struct {
+	Height int32
+}
+
+// NewGetScriptFlagsCmd returns a new instance which can be used to issue a
+// getscriptflags JSON-RPC command.
+func NewGetScriptFlagsCmd(height int32) *GetScriptFlagsCmd {
+	return &GetScriptFlagsCmd{
+		Height: height,
+	}
+}
+
+// GetTxBroadcastStatusCmd defines the gettxbroadcaststatus JSON-RPC command.
+type GetTxBroadcastStatusCmd struct {
+	TxID string
+}
+
+// NewGetTxBroadcastStatusCmd returns a new instance which can be used to issue
+// a gettxbroadcaststatus JSON-RPC command.
+func NewGetTxBroadcastStatusCmd(txID string) *GetTxBroadcastStatusCmd {
+	return &GetTxBroadcastStatusCmd{
+		TxID: txID,
+	}
+}
+
+// GetUtxoStatsCmd defines the getutxostats JSON-RPC command.
+type GetUtxoStatsCmd struct {
+	Cursor *string
+	Count  *int `jsonrpcdefault:"1000000"`
+}
+
+// NewGetUtxoStatsCmd returns a new instance which can be used to issue a
+// getutxostats JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetUtxoStatsCmd(cursor *string, count *int) *GetUtxoStatsCmd {
+	return &GetUtxoStatsCmd{
+		Cursor: cursor,
+		Count:  count,
+	}
+}
+
+// RegenerateCFiltersCmd defines the regeneratecfilters JSON-RPC command.
+type RegenerateCFiltersCmd struct {
+	StartHeight int32
+	EndHeight   int32
+	FilterType  *wire.FilterType `jsonrpcdefault:"0"`
+}
+
+// NewRegenerateCFiltersCmd returns a new instance which can be used to issue a
+// regeneratecfilters JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewRegenerateCFiltersCmd(startHeight, endHeight int32, filterType *wire.FilterType) *RegenerateCFiltersCmd {
+	return &RegenerateCFiltersCmd{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		FilterType:  filterType,
+	}
+}
+
+// SetDBCacheSizeCmd defines the setdbcachesize JSON-RPC command.
+type SetDBCacheSizeCmd struct {
+	Size uint64
+}
+
+// NewSetDBCacheSizeCmd returns a new instance which can be used to issue a
+// setdbcachesize JSON-RPC command.
+func NewSetDBCacheSizeCmd(size uint64) *SetDBCacheSizeCmd {
+	return &SetDBCacheSizeCmd{
+		Size: size,
+	}
+}
+
+// SetUpgradeActivationCmd defines the setupgradeactivation JSON-RPC command.
+type SetUpgradeActivationCmd struct {
+	Upgrade    string
+	Activation int64
+}
+
+// NewSetUpgradeActivationCmd returns a new instance which can be used to issue
+// a setupgradeactivation JSON-RPC command.
+func NewSetUpgradeActivationCmd(upgrade string, activation int64) *SetUpgradeActivationCmd {
+	return &SetUpgradeActivationCmd{
+		Upgrade:    upgrade,
+		Activation: activation,
+	}
+}
+
+// SignDataSignatureCmd defines the signdatasignature JSON-RPC command.
+type SignDataSignatureCmd struct {
+	PrivKey string
+	Message string
+	SigType *string `jsonrpcdefault:"\"schnorr\""`
+}
+
+// NewSignDataSignatureCmd returns a new instance which can be used to issue a
+// signdatasignature JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSignDataSignatureCmd(privKey, message string, sigType *string) *SignDataSignatureCmd {
+	return &SignDataSignatureCmd{
+		PrivKey: privKey,
+		Message: message,
+		SigType: sigType,
+	}
+}
+
+// ValidateScriptCmd defines the validatescript JSON-RPC command.
+type ValidateScriptCmd struct {
+	LockingScript string
+	RedeemScript  *string
+}
+
+// NewValidateScriptCmd returns a new instance which can be used to issue a
+// validatescript JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewValidateScriptCmd(lockingScript string, redeemScript *string) *ValidateScriptCmd {
+	return &ValidateScriptCmd{
+		LockingScript: lockingScript,
+		RedeemScript:  redeemScript,
+	}
+}
+
+// VerifyDataSignatureCmd defines the verifydatasignature JSON-RPC command.
+type VerifyDataSignatureCmd struct {
+	Signature string
+	Message   string
+	PubKey    string
+}
+
+// NewVerifyDataSignatureCmd returns a new instance which can be used to issue
+// a verifydatasignature JSON-RPC command.
+func NewVerifyDataSignatureCmd(signature, message, pubKey string) *VerifyDataSignatureCmd {
+	return &VerifyDataSignatureCmd{
+		Signature: signature,
+		Message:   message,
+		PubKey:    pubKey,
+	}
+}
+
+// VerifyScriptsPrevOut describes an output spent by the transaction passed to
+// the verifyscripts JSON-RPC command.
+type VerifyScriptsPrevOut struct {
+	ScriptPubKey string  `json:"scriptpubkey"`
+	Amount       float64 `json:"amount"`
+	TokenData    string  `json:"tokendata,omitempty"`
+}
+
+// VerifyScriptsCmd defines the verifyscripts JSON-RPC command.
+type VerifyScriptsCmd struct {
+	HexTx    string
+	PrevOuts []VerifyScriptsPrevOut
+	Flags    *string `jsonrpcdefault:"\"standard\""`
+}
+
+// NewVerifyScriptsCmd returns a new instance which can be used to issue a
+// verifyscripts JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewVerifyScriptsCmd(hexTx string, prevOuts []VerifyScriptsPrevOut, flags *string) *VerifyScriptsCmd {
+	return &VerifyScriptsCmd{
+		HexTx:    hexTx,
+		PrevOuts: prevOuts,
+		Flags:    flags,
+	}
+}
+
 // VersionCmd defines the version JSON-RPC command.
 //
 // NOTE: This is a btcsuite extension ported from
@@ -128,11 +541,39 @@ func init() {
 	// No special flags for commands in this file.
 	flags := UsageFlag(0)
 
+	MustRegisterCmd("auditblocktemplate", (*AuditBlockTemplateCmd)(nil), flags)
+	MustRegisterCmd("compactslpindex", (*CompactSlpIndexCmd)(nil), flags)
 	MustRegisterCmd("debuglevel", (*DebugLevelCmd)(nil), flags)
+	MustRegisterCmd("forcereseed", (*ForceReseedCmd)(nil), flags)
 	MustRegisterCmd("node", (*NodeCmd)(nil), flags)
 	MustRegisterCmd("generate", (*GenerateCmd)(nil), flags)
 	MustRegisterCmd("getbestblock", (*GetBestBlockCmd)(nil), flags)
+	MustRegisterCmd("getblockbyheight", (*GetBlockByHeightCmd)(nil), flags)
+	MustRegisterCmd("getblockvalidationstats", (*GetBlockValidationStatsCmd)(nil), flags)
+	MustRegisterCmd("getcpfpinfo", (*GetCPFPInfoCmd)(nil), flags)
 	MustRegisterCmd("getcurrentnet", (*GetCurrentNetCmd)(nil), flags)
+	MustRegisterCmd("getdbinfo", (*GetDBInfoCmd)(nil), flags)
+	MustRegisterCmd("getdsproof", (*GetDSProofCmd)(nil), flags)
+	MustRegisterCmd("getforkmonitorinfo", (*GetForkMonitorInfoCmd)(nil), flags)
 	MustRegisterCmd("getheaders", (*GetHeadersCmd)(nil), flags)
+	MustRegisterCmd("getmempoolsnapshot", (*GetMempoolSnapshotCmd)(nil), flags)
+	MustRegisterCmd("getmempoolstats", (*GetMempoolStatsCmd)(nil), flags)
+	MustRegisterCmd("getmempooltxgraph", (*GetMempoolTxGraphCmd)(nil), flags)
+	MustRegisterCmd("getorphanpool", (*GetOrphanPoolCmd)(nil), flags)
+	MustRegisterCmd("getorphanpoolinfo", (*GetOrphanPoolInfoCmd)(nil), flags)
+	MustRegisterCmd("getrejectedtxs", (*GetRejectedTxsCmd)(nil), flags)
+	MustRegisterCmd("getreorginfo", (*GetReorgInfoCmd)(nil), flags)
+	MustRegisterCmd("getscriptflags", (*GetScriptFlagsCmd)(nil), flags)
+	MustRegisterCmd("gettxbroadcaststatus", (*GetTxBroadcastStatusCmd)(nil), flags)
+	MustRegisterCmd("getutxostats", (*GetUtxoStatsCmd)(nil), flags)
+	MustRegisterCmd("getverifychaininfo", (*GetVerifyChainInfoCmd)(nil), flags)
+	MustRegisterCmd("regeneratecfilters", (*RegenerateCFiltersCmd)(nil), flags)
+	MustRegisterCmd("setdbcachesize", (*SetDBCacheSizeCmd)(nil), flags)
+	MustRegisterCmd("setupgradeactivation", (*SetUpgradeActivationCmd)(nil), flags)
+	MustRegisterCmd("signdatasignature", (*SignDataSignatureCmd)(nil), flags)
+	MustRegisterCmd("stopverifychain", (*StopVerifyChainCmd)(nil), flags)
+	MustRegisterCmd("validatescript", (*ValidateScriptCmd)(nil), flags)
+	MustRegisterCmd("verifydatasignature", (*VerifyDataSignatureCmd)(nil), flags)
+	MustRegisterCmd("verifyscripts", (*VerifyScriptsCmd)(nil), flags)
 	MustRegisterCmd("version", (*VersionCmd)(nil), flags)
 }
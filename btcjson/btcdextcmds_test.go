@@ -13,6 +13,7 @@ import (
 	"testing"
 
 	"github.com/gcash/bchd/btcjson"
+	"github.com/gcash/bchd/wire"
 )
 
 // TestBtcdExtCmds tests all of the btcd extended commands marshal and unmarshal
@@ -30,6 +31,45 @@ func TestBtcdExtCmds(t *testing.T) {
 		marshalled   string
 		unmarshalled interface{}
 	}{
+		{
+			name: "auditblocktemplate",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("auditblocktemplate", "00")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewAuditBlockTemplateCmd("00")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"auditblocktemplate","params":["00"],"id":1}`,
+			unmarshalled: &btcjson.AuditBlockTemplateCmd{
+				HexBlock: "00",
+			},
+		},
+		{
+			name: "compactslpindex",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("compactslpindex")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewCompactSlpIndexCmd(nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"compactslpindex","params":[],"id":1}`,
+			unmarshalled: &btcjson.CompactSlpIndexCmd{
+				MaxUnspentAmount: btcjson.Uint64(0),
+			},
+		},
+		{
+			name: "compactslpindex optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("compactslpindex", 1000)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewCompactSlpIndexCmd(btcjson.Uint64(1000))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"compactslpindex","params":[1000],"id":1}`,
+			unmarshalled: &btcjson.CompactSlpIndexCmd{
+				MaxUnspentAmount: btcjson.Uint64(1000),
+			},
+		},
 		{
 			name: "debuglevel",
 			newCmd: func() (interface{}, error) {
@@ -43,6 +83,17 @@ func TestBtcdExtCmds(t *testing.T) {
 				LevelSpec: "trace",
 			},
 		},
+		{
+			name: "forcereseed",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("forcereseed")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewForceReseedCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"forcereseed","params":[],"id":1}`,
+			unmarshalled: &btcjson.ForceReseedCmd{},
+		},
 		{
 			name: "node",
 			newCmd: func() (interface{}, error) {
@@ -125,6 +176,74 @@ func TestBtcdExtCmds(t *testing.T) {
 			marshalled:   `{"jsonrpc":"1.0","method":"getbestblock","params":[],"id":1}`,
 			unmarshalled: &btcjson.GetBestBlockCmd{},
 		},
+		{
+			name: "getblockbyheight",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getblockbyheight", 123)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetBlockByHeightCmd(123, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getblockbyheight","params":[123],"id":1}`,
+			unmarshalled: &btcjson.GetBlockByHeightCmd{
+				Height:    123,
+				Verbosity: btcjson.Verbositylevel(1),
+			},
+		},
+		{
+			name: "getblockvalidationstats",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getblockvalidationstats")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetBlockValidationStatsCmd(nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getblockvalidationstats","params":[],"id":1}`,
+			unmarshalled: &btcjson.GetBlockValidationStatsCmd{
+				Count: btcjson.Int(10),
+			},
+		},
+		{
+			name: "getblockvalidationstats optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getblockvalidationstats", 5)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetBlockValidationStatsCmd(btcjson.Int(5))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getblockvalidationstats","params":[5],"id":1}`,
+			unmarshalled: &btcjson.GetBlockValidationStatsCmd{
+				Count: btcjson.Int(5),
+			},
+		},
+		{
+			name: "getcpfpinfo",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getcpfpinfo", "123")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetCPFPInfoCmd("123", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getcpfpinfo","params":["123"],"id":1}`,
+			unmarshalled: &btcjson.GetCPFPInfoCmd{
+				TxID:      "123",
+				ChildSize: btcjson.Int(192),
+			},
+		},
+		{
+			name: "getcpfpinfo optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getcpfpinfo", "123", 300)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetCPFPInfoCmd("123", btcjson.Int(300))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getcpfpinfo","params":["123",300],"id":1}`,
+			unmarshalled: &btcjson.GetCPFPInfoCmd{
+				TxID:      "123",
+				ChildSize: btcjson.Int(300),
+			},
+		},
 		{
 			name: "getcurrentnet",
 			newCmd: func() (interface{}, error) {
@@ -136,6 +255,45 @@ func TestBtcdExtCmds(t *testing.T) {
 			marshalled:   `{"jsonrpc":"1.0","method":"getcurrentnet","params":[],"id":1}`,
 			unmarshalled: &btcjson.GetCurrentNetCmd{},
 		},
+		{
+			name: "getdbinfo",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getdbinfo")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetDBInfoCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getdbinfo","params":[],"id":1}`,
+			unmarshalled: &btcjson.GetDBInfoCmd{},
+		},
+		{
+			name: "getdsproof",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getdsproof", "123")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetDSProofCmd("123", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getdsproof","params":["123"],"id":1}`,
+			unmarshalled: &btcjson.GetDSProofCmd{
+				ID:      "123",
+				Verbose: btcjson.Bool(true),
+			},
+		},
+		{
+			name: "getdsproof optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getdsproof", "123:1", false)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetDSProofCmd("123:1", btcjson.Bool(false))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getdsproof","params":["123:1",false],"id":1}`,
+			unmarshalled: &btcjson.GetDSProofCmd{
+				ID:      "123:1",
+				Verbose: btcjson.Bool(false),
+			},
+		},
 		{
 			name: "getheaders",
 			newCmd: func() (interface{}, error) {
@@ -176,6 +334,395 @@ func TestBtcdExtCmds(t *testing.T) {
 				HashStop: "000000000000000000ba33b33e1fad70b69e234fc24414dd47113bff38f523f7",
 			},
 		},
+		{
+			name: "getforkmonitorinfo",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getforkmonitorinfo")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetForkMonitorInfoCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getforkmonitorinfo","params":[],"id":1}`,
+			unmarshalled: &btcjson.GetForkMonitorInfoCmd{},
+		},
+		{
+			name: "getverifychaininfo",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getverifychaininfo")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetVerifyChainInfoCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getverifychaininfo","params":[],"id":1}`,
+			unmarshalled: &btcjson.GetVerifyChainInfoCmd{},
+		},
+		{
+			name: "stopverifychain",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("stopverifychain")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewStopVerifyChainCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"stopverifychain","params":[],"id":1}`,
+			unmarshalled: &btcjson.StopVerifyChainCmd{},
+		},
+		{
+			name: "getmempoolsnapshot",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getmempoolsnapshot")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetMempoolSnapshotCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getmempoolsnapshot","params":[],"id":1}`,
+			unmarshalled: &btcjson.GetMempoolSnapshotCmd{},
+		},
+		{
+			name: "getmempoolstats",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getmempoolstats")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetMempoolStatsCmd(nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getmempoolstats","params":[],"id":1}`,
+			unmarshalled: &btcjson.GetMempoolStatsCmd{
+				History: btcjson.String("24h"),
+			},
+		},
+		{
+			name: "getmempoolstats optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getmempoolstats", "1h")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetMempoolStatsCmd(btcjson.String("1h"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getmempoolstats","params":["1h"],"id":1}`,
+			unmarshalled: &btcjson.GetMempoolStatsCmd{
+				History: btcjson.String("1h"),
+			},
+		},
+		{
+			name: "getmempooltxgraph",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getmempooltxgraph", "123")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetMempoolTxGraphCmd("123")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getmempooltxgraph","params":["123"],"id":1}`,
+			unmarshalled: &btcjson.GetMempoolTxGraphCmd{
+				TxID: "123",
+			},
+		},
+		{
+			name: "getorphanpool",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getorphanpool")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetOrphanPoolCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getorphanpool","params":[],"id":1}`,
+			unmarshalled: &btcjson.GetOrphanPoolCmd{},
+		},
+		{
+			name: "getorphanpoolinfo",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getorphanpoolinfo")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetOrphanPoolInfoCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getorphanpoolinfo","params":[],"id":1}`,
+			unmarshalled: &btcjson.GetOrphanPoolInfoCmd{},
+		},
+		{
+			name: "getrejectedtxs",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getrejectedtxs")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetRejectedTxsCmd(nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getrejectedtxs","params":[],"id":1}`,
+			unmarshalled: &btcjson.GetRejectedTxsCmd{
+				PeerID: nil,
+			},
+		},
+		{
+			name: "getrejectedtxs optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getrejectedtxs", 3)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetRejectedTxsCmd(btcjson.Int32(3))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getrejectedtxs","params":[3],"id":1}`,
+			unmarshalled: &btcjson.GetRejectedTxsCmd{
+				PeerID: btcjson.Int32(3),
+			},
+		},
+		{
+			name: "getreorginfo",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getreorginfo")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetReorgInfoCmd(nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getreorginfo","params":[],"id":1}`,
+			unmarshalled: &btcjson.GetReorgInfoCmd{
+				Count: btcjson.Int(10),
+			},
+		},
+		{
+			name: "getreorginfo optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getreorginfo", 5, "123")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetReorgInfoCmd(btcjson.Int(5),
+					btcjson.String("123"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getreorginfo","params":[5,"123"],"id":1}`,
+			unmarshalled: &btcjson.GetReorgInfoCmd{
+				Count: btcjson.Int(5),
+				TxID:  btcjson.String("123"),
+			},
+		},
+		{
+			name: "getscriptflags",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getscriptflags", 123)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetScriptFlagsCmd(123)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getscriptflags","params":[123],"id":1}`,
+			unmarshalled: &btcjson.GetScriptFlagsCmd{
+				Height: 123,
+			},
+		},
+		{
+			name: "gettxbroadcaststatus",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("gettxbroadcaststatus", "123")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetTxBroadcastStatusCmd("123")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"gettxbroadcaststatus","params":["123"],"id":1}`,
+			unmarshalled: &btcjson.GetTxBroadcastStatusCmd{
+				TxID: "123",
+			},
+		},
+		{
+			name: "getutxostats",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getutxostats")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetUtxoStatsCmd(nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getutxostats","params":[],"id":1}`,
+			unmarshalled: &btcjson.GetUtxoStatsCmd{
+				Count: btcjson.Int(1000000),
+			},
+		},
+		{
+			name: "getutxostats optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getutxostats", "0a0b", 500)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetUtxoStatsCmd(btcjson.String("0a0b"),
+					btcjson.Int(500))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getutxostats","params":["0a0b",500],"id":1}`,
+			unmarshalled: &btcjson.GetUtxoStatsCmd{
+				Cursor: btcjson.String("0a0b"),
+				Count:  btcjson.Int(500),
+			},
+		},
+		{
+			name: "regeneratecfilters",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("regeneratecfilters", 100, 200)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewRegenerateCFiltersCmd(100, 200, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"regeneratecfilters","params":[100,200],"id":1}`,
+			unmarshalled: &btcjson.RegenerateCFiltersCmd{
+				StartHeight: 100,
+				EndHeight:   200,
+				FilterType:  func() *wire.FilterType { ft := wire.GCSFilterRegular; return &ft }(),
+			},
+		},
+		{
+			name: "regeneratecfilters optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("regeneratecfilters", 100, 200, 1)
+			},
+			staticCmd: func() interface{} {
+				ft := wire.GCSFilterExtended
+				return btcjson.NewRegenerateCFiltersCmd(100, 200, &ft)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"regeneratecfilters","params":[100,200,1],"id":1}`,
+			unmarshalled: &btcjson.RegenerateCFiltersCmd{
+				StartHeight: 100,
+				EndHeight:   200,
+				FilterType:  func() *wire.FilterType { ft := wire.GCSFilterExtended; return &ft }(),
+			},
+		},
+		{
+			name: "setdbcachesize",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("setdbcachesize", 1024)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSetDBCacheSizeCmd(1024)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"setdbcachesize","params":[1024],"id":1}`,
+			unmarshalled: &btcjson.SetDBCacheSizeCmd{
+				Size: 1024,
+			},
+		},
+		{
+			name: "setupgradeactivation",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("setupgradeactivation", "upgrade9", 150)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSetUpgradeActivationCmd("upgrade9", 150)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"setupgradeactivation","params":["upgrade9",150],"id":1}`,
+			unmarshalled: &btcjson.SetUpgradeActivationCmd{
+				Upgrade:    "upgrade9",
+				Activation: 150,
+			},
+		},
+		{
+			name: "signdatasignature",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("signdatasignature", "key", "0a0b")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSignDataSignatureCmd("key", "0a0b", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"signdatasignature","params":["key","0a0b"],"id":1}`,
+			unmarshalled: &btcjson.SignDataSignatureCmd{
+				PrivKey: "key",
+				Message: "0a0b",
+				SigType: btcjson.String("schnorr"),
+			},
+		},
+		{
+			name: "signdatasignature optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("signdatasignature", "key", "0a0b", "ecdsa")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSignDataSignatureCmd("key", "0a0b",
+					btcjson.String("ecdsa"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"signdatasignature","params":["key","0a0b","ecdsa"],"id":1}`,
+			unmarshalled: &btcjson.SignDataSignatureCmd{
+				PrivKey: "key",
+				Message: "0a0b",
+				SigType: btcjson.String("ecdsa"),
+			},
+		},
+		{
+			name: "validatescript",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("validatescript", "51")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewValidateScriptCmd("51", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"validatescript","params":["51"],"id":1}`,
+			unmarshalled: &btcjson.ValidateScriptCmd{
+				LockingScript: "51",
+			},
+		},
+		{
+			name: "validatescript optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("validatescript", "a914", "51")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewValidateScriptCmd("a914",
+					btcjson.String("51"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"validatescript","params":["a914","51"],"id":1}`,
+			unmarshalled: &btcjson.ValidateScriptCmd{
+				LockingScript: "a914",
+				RedeemScript:  btcjson.String("51"),
+			},
+		},
+		{
+			name: "verifydatasignature",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("verifydatasignature", "3044", "0a0b", "02ff")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewVerifyDataSignatureCmd("3044", "0a0b", "02ff")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"verifydatasignature","params":["3044","0a0b","02ff"],"id":1}`,
+			unmarshalled: &btcjson.VerifyDataSignatureCmd{
+				Signature: "3044",
+				Message:   "0a0b",
+				PubKey:    "02ff",
+			},
+		},
+		{
+			name: "verifyscripts",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("verifyscripts", "0100",
+					`[{"scriptpubkey":"51","amount":0.5}]`)
+			},
+			staticCmd: func() interface{} {
+				prevOuts := []btcjson.VerifyScriptsPrevOut{
+					{ScriptPubKey: "51", Amount: 0.5},
+				}
+				return btcjson.NewVerifyScriptsCmd("0100", prevOuts, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"verifyscripts","params":["0100",[{"scriptpubkey":"51","amount":0.5}]],"id":1}`,
+			unmarshalled: &btcjson.VerifyScriptsCmd{
+				HexTx: "0100",
+				PrevOuts: []btcjson.VerifyScriptsPrevOut{
+					{ScriptPubKey: "51", Amount: 0.5},
+				},
+				Flags: btcjson.String("standard"),
+			},
+		},
+		{
+			name: "verifyscripts optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("verifyscripts", "0100",
+					`[{"scriptpubkey":"51","amount":0.5,"tokendata":"ef00"}]`,
+					"P2SH,SCHNORR")
+			},
+			staticCmd: func() interface{} {
+				prevOuts := []btcjson.VerifyScriptsPrevOut{
+					{ScriptPubKey: "51", Amount: 0.5, TokenData: "ef00"},
+				}
+				return btcjson.NewVerifyScriptsCmd("0100", prevOuts,
+					btcjson.String("P2SH,SCHNORR"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"verifyscripts","params":["0100",[{"scriptpubkey":"51","amount":0.5,"tokendata":"ef00"}],"P2SH,SCHNORR"],"id":1}`,
+			unmarshalled: &btcjson.VerifyScriptsCmd{
+				HexTx: "0100",
+				PrevOuts: []btcjson.VerifyScriptsPrevOut{
+					{ScriptPubKey: "51", Amount: 0.5, TokenData: "ef00"},
+				},
+				Flags: btcjson.String("P2SH,SCHNORR"),
+			},
+		},
 		{
 			name: "version",
 			newCmd: func() (interface{}, error) {
@@ -18,3 +18,380 @@ type VersionResult struct {
 	Prerelease    string `json:"prerelease"`
 	BuildMetadata string `json:"buildmetadata"`
 }
+
+// AuditOmittedTxResult models a transaction omitted from the audited block
+// included in the auditblocktemplate response.
+type AuditOmittedTxResult struct {
+	TxID    string `json:"txid"`
+	Size    int    `json:"size"`
+	Fee     int64  `json:"fee"`
+	FeeRate int64  `json:"feerate"`
+}
+
+// AuditBlockTemplateResult models the data returned from the
+// auditblocktemplate command.
+type AuditBlockTemplateResult struct {
+	Hash                  string                 `json:"hash"`
+	Height                int32                  `json:"height"`
+	Valid                 bool                   `json:"valid"`
+	RejectReason          string                 `json:"rejectreason,omitempty"`
+	TxCount               int                    `json:"txcount"`
+	Size                  int                    `json:"size"`
+	Fees                  int64                  `json:"fees"`
+	CoinbaseValue         int64                  `json:"coinbasevalue"`
+	MaxCoinbaseValue      int64                  `json:"maxcoinbasevalue"`
+	MinFeeRate            int64                  `json:"minfeerate"`
+	UnknownTxCount        int                    `json:"unknowntxcount"`
+	UnresolvedTxCount     int                    `json:"unresolvedtxcount"`
+	TemplateTxCount       int                    `json:"templatetxcount"`
+	TemplateSize          int                    `json:"templatesize"`
+	TemplateFees          int64                  `json:"templatefees"`
+	TemplateCoinbaseValue int64                  `json:"templatecoinbasevalue"`
+	Omitted               []AuditOmittedTxResult `json:"omitted"`
+}
+
+// CompactSlpIndexResult models the data returned from the compactslpindex
+// command.
+type CompactSlpIndexResult struct {
+	Tokens         int   `json:"tokens"`
+	Entries        int   `json:"entries"`
+	ReclaimedBytes int64 `json:"reclaimedbytes"`
+}
+
+// ForceReseedResult models the data returned from the forcereseed command.
+type ForceReseedResult struct {
+	DNSSeeds  []string `json:"dnsseeds"`
+	HTTPSeeds []string `json:"httpseeds"`
+}
+
+// BlockValidationStatsResult models the time spent in each phase of processing
+// a block included in the getblockvalidationstats response.  The durations are
+// in milliseconds.
+type BlockValidationStatsResult struct {
+	Hash        string  `json:"hash"`
+	Height      int32   `json:"height"`
+	Time        int64   `json:"time"`
+	MainChain   bool    `json:"mainchain"`
+	Deserialize float64 `json:"deserialize"`
+	Sanity      float64 `json:"sanity"`
+	Context     float64 `json:"context"`
+	Store       float64 `json:"store"`
+	FetchInputs float64 `json:"fetchinputs"`
+	CheckInputs float64 `json:"checkinputs"`
+	Scripts     float64 `json:"scripts"`
+	Indexes     float64 `json:"indexes"`
+	Notify      float64 `json:"notify"`
+	Flush       float64 `json:"flush"`
+	Total       float64 `json:"total"`
+}
+
+// GetCPFPInfoResult models the data returned from the getcpfpinfo command.
+type GetCPFPInfoResult struct {
+	TxID            string   `json:"txid"`
+	InTemplate      bool     `json:"intemplate"`
+	PackageSize     int64    `json:"packagesize"`
+	PackageFees     float64  `json:"packagefees"`
+	PackageFeePerKB float64  `json:"packagefeeperkb"`
+	TargetFeePerKB  float64  `json:"targetfeeperkb"`
+	ChildSize       int64    `json:"childsize"`
+	ChildFee        float64  `json:"childfee"`
+	BlockingTxIDs   []string `json:"blockingtxids"`
+	Truncated       bool     `json:"truncated"`
+}
+
+// DBLevelInfo models a level of the metadata store of the database included in
+// the getdbinfo response.
+type DBLevelInfo struct {
+	Tables          int     `json:"tables"`
+	Size            int64   `json:"size"`
+	CompactionTime  float64 `json:"compactiontime"`
+	CompactionRead  int64   `json:"compactionread"`
+	CompactionWrite int64   `json:"compactionwrite"`
+}
+
+// GetDBInfoResult models the data returned from the getdbinfo command.
+type GetDBInfoResult struct {
+	Type              string        `json:"type"`
+	CacheSize         uint64        `json:"cachesize"`
+	CacheMaxSize      uint64        `json:"cachemaxsize"`
+	CacheEntries      int           `json:"cacheentries"`
+	CacheHits         uint64        `json:"cachehits"`
+	CacheMisses       uint64        `json:"cachemisses"`
+	CacheHitRate      float64       `json:"cachehitrate"`
+	OpenBlockFiles    int           `json:"openblockfiles"`
+	MaxOpenBlockFiles int           `json:"maxopenblockfiles"`
+	BlockFileHits     uint64        `json:"blockfilehits"`
+	BlockFileMisses   uint64        `json:"blockfilemisses"`
+	BlockFileHitRate  float64       `json:"blockfilehitrate"`
+	OpenTables        int           `json:"opentables"`
+	BlockCacheSize    int           `json:"blockcachesize"`
+	IORead            uint64        `json:"ioread"`
+	IOWrite           uint64        `json:"iowrite"`
+	Levels            []DBLevelInfo `json:"levels"`
+}
+
+// MempoolStatsSample models a single sample of the mempool and block statistics
+// included in the getmempoolstats response.
+type MempoolStatsSample struct {
+	Time          int64     `json:"time"`
+	Size          int64     `json:"size"`
+	Bytes         int64     `json:"bytes"`
+	TotalFee      float64   `json:"totalfee"`
+	FeeRates      []float64 `json:"feerates"`
+	BlockHeight   int32     `json:"blockheight"`
+	BlockSize     uint64    `json:"blocksize"`
+	BlockFullness float64   `json:"blockfullness"`
+}
+
+// GetMempoolStatsResult models the data returned from the getmempoolstats
+// command.
+type GetMempoolStatsResult struct {
+	Interval           int64                `json:"interval"`
+	FeeRatePercentiles []int                `json:"feeratepercentiles"`
+	Samples            []MempoolStatsSample `json:"samples"`
+}
+
+// MempoolTxGraphEntry models a transaction of the unconfirmed transaction
+// graph included in the getmempooltxgraph response.
+type MempoolTxGraphEntry struct {
+	TxID     string   `json:"txid"`
+	Size     int32    `json:"size"`
+	Fee      float64  `json:"fee"`
+	FeePerKB float64  `json:"feeperkb"`
+	Time     int64    `json:"time"`
+	Height   int64    `json:"height"`
+	Depends  []string `json:"depends"`
+	SpentBy  []string `json:"spentby"`
+}
+
+// GetMempoolTxGraphResult models the data returned from the getmempooltxgraph
+// command.
+type GetMempoolTxGraphResult struct {
+	Transaction     MempoolTxGraphEntry   `json:"transaction"`
+	AncestorCount   int64                 `json:"ancestorcount"`
+	AncestorSize    int64                 `json:"ancestorsize"`
+	AncestorFees    float64               `json:"ancestorfees"`
+	DescendantCount int64                 `json:"descendantcount"`
+	DescendantSize  int64                 `json:"descendantsize"`
+	DescendantFees  float64               `json:"descendantfees"`
+	Ancestors       []MempoolTxGraphEntry `json:"ancestors"`
+	Descendants     []MempoolTxGraphEntry `json:"descendants"`
+	Truncated       bool                  `json:"truncated"`
+}
+
+// GetOrphanPoolResult models the data of an orphan transaction returned from the
+// getorphanpool command.
+type GetOrphanPoolResult struct {
+	TxID           string   `json:"txid"`
+	Size           int32    `json:"size"`
+	Tag            uint64   `json:"tag"`
+	Expiration     int64    `json:"expiration"`
+	MissingParents []string `json:"missingparents"`
+}
+
+// GetOrphanPoolInfoResult models the data returned from the getorphanpoolinfo
+// command.
+type GetOrphanPoolInfoResult struct {
+	Size           int   `json:"size"`
+	Bytes          int64 `json:"bytes"`
+	MaxOrphans     int   `json:"maxorphans"`
+	MaxOrphanSize  int   `json:"maxorphansize"`
+	Tags           int   `json:"tags"`
+	MissingParents int   `json:"missingparents"`
+	NextExpiration int64 `json:"nextexpiration,omitempty"`
+}
+
+// GetRejectedTxResult models the data of a transaction received from a peer
+// which was rejected returned from the getrejectedtxs command.
+type GetRejectedTxResult struct {
+	TxID   string `json:"txid"`
+	PeerID int32  `json:"peerid"`
+	Addr   string `json:"addr"`
+	Code   string `json:"code"`
+	Reason string `json:"reason"`
+	Time   int64  `json:"time"`
+}
+
+// ReorgInfoResult models a reorganization of the main chain included in the
+// getreorginfo response.
+type ReorgInfoResult struct {
+	Time       int64    `json:"time"`
+	OldTip     string   `json:"oldtip"`
+	OldHeight  int32    `json:"oldheight"`
+	NewTip     string   `json:"newtip"`
+	NewHeight  int32    `json:"newheight"`
+	ForkHeight int32    `json:"forkheight"`
+	Depth      int32    `json:"depth"`
+	TxIDs      []string `json:"txids"`
+}
+
+// BlockScriptFlagsResult models the script flags a block was validated with
+// included in the getblock response when the verbose flag is set to 2.
+type BlockScriptFlagsResult struct {
+	Flags      []string `json:"flags"`
+	ScriptsRun bool     `json:"scriptsrun"`
+}
+
+// GetScriptFlagsResult models the data returned from the getscriptflags
+// command.
+type GetScriptFlagsResult struct {
+	Hash       string   `json:"hash"`
+	Height     int32    `json:"height"`
+	Flags      []string `json:"flags"`
+	ScriptsRun bool     `json:"scriptsrun"`
+}
+
+// GetTxBroadcastStatusResult models the data returned from the
+// gettxbroadcaststatus command.
+type GetTxBroadcastStatusResult struct {
+	TxID        string `json:"txid"`
+	Time        int64  `json:"time"`
+	AnnouncedTo int    `json:"announcedto"`
+	SeenBy      int    `json:"seenby"`
+	FirstSeen   int64  `json:"firstseen,omitempty"`
+	ReplacedBy  string `json:"replacedby,omitempty"`
+}
+
+// UtxoStatsBucket models the statistics of an age or value bucket included in
+// the getutxostats response.
+type UtxoStatsBucket struct {
+	Below     float64 `json:"below,omitempty"`
+	Count     int64   `json:"count"`
+	Amount    float64 `json:"amount"`
+	DustCount int64   `json:"dustcount"`
+}
+
+// GetUtxoStatsResult models the data returned from the getutxostats command.
+type GetUtxoStatsResult struct {
+	Height       int32             `json:"height"`
+	Count        int64             `json:"count"`
+	Amount       float64           `json:"amount"`
+	DustCount    int64             `json:"dustcount"`
+	DustAmount   float64           `json:"dustamount"`
+	AgeBuckets   []UtxoStatsBucket `json:"agebuckets"`
+	ValueBuckets []UtxoStatsBucket `json:"valuebuckets"`
+	Cursor       string            `json:"cursor,omitempty"`
+}
+
+// DSProofSpenderResult models a spender of a double-spend proof included in
+// the getdsproof response.
+type DSProofSpenderResult struct {
+	Version         uint32   `json:"version"`
+	Sequence        uint32   `json:"sequence"`
+	LockTime        uint32   `json:"locktime"`
+	HashPrevOutputs string   `json:"hashprevoutputs"`
+	HashSequence    string   `json:"hashsequence"`
+	HashOutputs     string   `json:"hashoutputs"`
+	PushData        []string `json:"pushdata"`
+}
+
+// GetDSProofResult models the data returned from the getdsproof command when
+// the verbose flag is set.
+type GetDSProofResult struct {
+	DSPID    string                 `json:"dspid"`
+	TxID     string                 `json:"txid"`
+	Outpoint OutPoint               `json:"outpoint"`
+	Hex      string                 `json:"hex"`
+	Spenders []DSProofSpenderResult `json:"spenders"`
+}
+
+// ForkMonitorNodeResult models the state of a single watched node included in
+// the getforkmonitorinfo response.
+type ForkMonitorNodeResult struct {
+	Node       string `json:"node"`
+	Status     string `json:"status"`
+	BestHash   string `json:"bestblockhash,omitempty"`
+	Height     int32  `json:"height,omitempty"`
+	ForkHeight int32  `json:"forkheight,omitempty"`
+	OurDepth   int32  `json:"ourdepth"`
+	TheirDepth int32  `json:"theirdepth"`
+	Alert      bool   `json:"alert"`
+	LastUpdate int64  `json:"lastupdate"`
+	Error      string `json:"error,omitempty"`
+}
+
+// RegenerateCFiltersResult models the data returned from the
+// regeneratecfilters command.
+type RegenerateCFiltersResult struct {
+	StartHeight int32 `json:"startheight"`
+	EndHeight   int32 `json:"endheight"`
+}
+
+// ScriptUpgradeResult models an upgrade enabling features used by a script
+// included in the validatescript response.
+type ScriptUpgradeResult struct {
+	Name             string   `json:"name"`
+	ActivationHeight int32    `json:"activationheight,omitempty"`
+	ActivationTime   int64    `json:"activationtime,omitempty"`
+	Active           bool     `json:"active"`
+	Features         []string `json:"features"`
+}
+
+// ValidateScriptResult models the data returned from the validatescript
+// command.
+type ValidateScriptResult struct {
+	Standard         bool                  `json:"standard"`
+	Errors           []string              `json:"errors,omitempty"`
+	Type             string                `json:"type"`
+	RedeemType       string                `json:"redeemtype,omitempty"`
+	SigChecks        int                   `json:"sigchecks"`
+	OpCost           int                   `json:"opcost"`
+	ActivationHeight int32                 `json:"activationheight,omitempty"`
+	ActivationTime   int64                 `json:"activationtime,omitempty"`
+	Upgrades         []ScriptUpgradeResult `json:"upgrades"`
+}
+
+// SignDataSignatureResult models the data returned from the signdatasignature
+// command.
+type SignDataSignatureResult struct {
+	Signature   string `json:"signature"`
+	PubKey      string `json:"pubkey"`
+	MessageHash string `json:"messagehash"`
+}
+
+// VerifyDataSignatureResult models the data returned from the
+// verifydatasignature command.
+type VerifyDataSignatureResult struct {
+	Valid       bool   `json:"valid"`
+	SigType     string `json:"sigtype"`
+	MessageHash string `json:"messagehash"`
+	Error       string `json:"error,omitempty"`
+}
+
+// VerifyScriptsInputResult models the outcome of the script validation of a
+// single input included in the verifyscripts response.
+type VerifyScriptsInputResult struct {
+	Index        int    `json:"index"`
+	Valid        bool   `json:"valid"`
+	SigChecks    int    `json:"sigchecks"`
+	Error        string `json:"error,omitempty"`
+	ErrorCode    string `json:"errorcode,omitempty"`
+	FailedOpcode string `json:"failedopcode,omitempty"`
+}
+
+// VerifyScriptsResult models the data returned from the verifyscripts command.
+type VerifyScriptsResult struct {
+	TxID       string                     `json:"txid"`
+	Valid      bool                       `json:"valid"`
+	Flags      []string                   `json:"flags"`
+	SigChecks  int                        `json:"sigchecks"`
+	TokenError string                     `json:"tokenerror,omitempty"`
+	Error      string                     `json:"error,omitempty"`
+	Inputs     []VerifyScriptsInputResult `json:"inputs"`
+}
+
+// GetVerifyChainInfoResult models the data returned from the
+// getverifychaininfo command.
+type GetVerifyChainInfoResult struct {
+	Status     string  `json:"status"`
+	CheckLevel int32   `json:"checklevel"`
+	CheckDepth int32   `json:"checkdepth"`
+	Background bool    `json:"background"`
+	Height     int32   `json:"height"`
+	Verified   int32   `json:"verified"`
+	Total      int32   `json:"total"`
+	Progress   float64 `json:"progress"`
+	StartTime  int64   `json:"starttime"`
+	EndTime    int64   `json:"endtime,omitempty"`
+	Error      string  `json:"error,omitempty"`
+}
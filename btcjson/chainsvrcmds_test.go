@@ -684,7 +684,7 @@ func TestChainSvrCmds(t *testing.T) {
 				return btcjson.NewCmd("gettxout", "123", 1)
 			},
 			staticCmd: func() interface{} {
-				return btcjson.NewGetTxOutCmd("123", 1, nil)
+				return btcjson.NewGetTxOutCmd("123", 1, nil, nil)
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"gettxout","params":["123",1],"id":1}`,
 			unmarshalled: &btcjson.GetTxOutCmd{
@@ -699,7 +699,7 @@ func TestChainSvrCmds(t *testing.T) {
 				return btcjson.NewCmd("gettxout", "123", 1, true)
 			},
 			staticCmd: func() interface{} {
-				return btcjson.NewGetTxOutCmd("123", 1, btcjson.Bool(true))
+				return btcjson.NewGetTxOutCmd("123", 1, btcjson.Bool(true), nil)
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"gettxout","params":["123",1,true],"id":1}`,
 			unmarshalled: &btcjson.GetTxOutCmd{
@@ -708,6 +708,23 @@ func TestChainSvrCmds(t *testing.T) {
 				IncludeMempool: btcjson.Bool(true),
 			},
 		},
+		{
+			name: "gettxout height",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("gettxout", "123", 1, false, 100)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetTxOutCmd("123", 1, btcjson.Bool(false),
+					btcjson.Int32(100))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"gettxout","params":["123",1,false,100],"id":1}`,
+			unmarshalled: &btcjson.GetTxOutCmd{
+				Txid:           "123",
+				Vout:           1,
+				IncludeMempool: btcjson.Bool(false),
+				Height:         btcjson.Int32(100),
+			},
+		},
 		{
 			name: "gettxoutproof",
 			newCmd: func() (interface{}, error) {
@@ -838,6 +855,21 @@ func TestChainSvrCmds(t *testing.T) {
 				BlockHash: "0123",
 			},
 		},
+		{
+			name: "prioritisetransaction",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("prioritisetransaction", "123", 0.0, 1000)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewPrioritiseTransactionCmd("123", 0, 1000)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"prioritisetransaction","params":["123",0,1000],"id":1}`,
+			unmarshalled: &btcjson.PrioritiseTransactionCmd{
+				TxID:          "123",
+				PriorityDelta: 0,
+				FeeDelta:      1000,
+			},
+		},
 		{
 			name: "reconsiderblock",
 			newCmd: func() (interface{}, error) {
@@ -1065,6 +1097,19 @@ func TestChainSvrCmds(t *testing.T) {
 				GenProcLimit: btcjson.Int(6),
 			},
 		},
+		{
+			name: "setmocktime",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("setmocktime", 1700000000)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSetMockTimeCmd(1700000000)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"setmocktime","params":[1700000000],"id":1}`,
+			unmarshalled: &btcjson.SetMockTimeCmd{
+				Timestamp: 1700000000,
+			},
+		},
 		{
 			name: "stop",
 			newCmd: func() (interface{}, error) {
@@ -1109,6 +1154,19 @@ func TestChainSvrCmds(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "submitheader",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("submitheader", "112233")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSubmitHeaderCmd("112233")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"submitheader","params":["112233"],"id":1}`,
+			unmarshalled: &btcjson.SubmitHeaderCmd{
+				HexData: "112233",
+			},
+		},
 		{
 			name: "uptime",
 			newCmd: func() (interface{}, error) {
@@ -1145,6 +1203,7 @@ func TestChainSvrCmds(t *testing.T) {
 			unmarshalled: &btcjson.VerifyChainCmd{
 				CheckLevel: btcjson.Int32(3),
 				CheckDepth: btcjson.Int32(288),
+				Background: btcjson.Bool(false),
 			},
 		},
 		{
@@ -1159,6 +1218,7 @@ func TestChainSvrCmds(t *testing.T) {
 			unmarshalled: &btcjson.VerifyChainCmd{
 				CheckLevel: btcjson.Int32(2),
 				CheckDepth: btcjson.Int32(288),
+				Background: btcjson.Bool(false),
 			},
 		},
 		{
@@ -1173,6 +1233,24 @@ func TestChainSvrCmds(t *testing.T) {
 			unmarshalled: &btcjson.VerifyChainCmd{
 				CheckLevel: btcjson.Int32(2),
 				CheckDepth: btcjson.Int32(500),
+				Background: btcjson.Bool(false),
+			},
+		},
+		{
+			name: "verifychain optional3",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("verifychain", 4, 0, true)
+			},
+			staticCmd: func() interface{} {
+				cmd := btcjson.NewVerifyChainCmd(btcjson.Int32(4), btcjson.Int32(0))
+				cmd.Background = btcjson.Bool(true)
+				return cmd
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"verifychain","params":[4,0,true],"id":1}`,
+			unmarshalled: &btcjson.VerifyChainCmd{
+				CheckLevel: btcjson.Int32(4),
+				CheckDepth: btcjson.Int32(0),
+				Background: btcjson.Bool(true),
 			},
 		},
 		{
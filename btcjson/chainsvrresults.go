@@ -47,6 +47,10 @@ type GetBlockVerboseResult struct {
 	Difficulty    float64       `json:"difficulty"`
 	PreviousHash  string        `json:"previousblockhash"`
 	NextHash      string        `json:"nextblockhash,omitempty"`
+
+	// ScriptFlags is only set when the verbose flag is set to 2 and the
+	// script flags the block was validated with were recorded.
+	ScriptFlags *BlockScriptFlagsResult `json:"scriptflags,omitempty"`
 }
 
 // GetBlockVerboseTxResult models the data from the getblock command when the
@@ -71,6 +75,10 @@ type GetBlockVerboseTxResult struct {
 	Difficulty    float64       `json:"difficulty"`
 	PreviousHash  string        `json:"previousblockhash"`
 	NextHash      string        `json:"nextblockhash,omitempty"`
+
+	// ScriptFlags is only set when the script flags the block was
+	// validated with were recorded.
+	ScriptFlags *BlockScriptFlagsResult `json:"scriptflags,omitempty"`
 }
 
 // AddMultisigAddressResult models the data returned from the addmultisigaddress
@@ -139,6 +147,7 @@ type GetBlockChainInfoResult struct {
 	BestBlockHash        string                              `json:"bestblockhash"`
 	Difficulty           float64                             `json:"difficulty"`
 	MedianTime           int64                               `json:"mediantime"`
+	BlockSizeLimit       uint64                              `json:"blocksizelimit"`
 	VerificationProgress float64                             `json:"verificationprogress,omitempty"`
 	SyncHeight           uint64                              `json:"syncheight,omitempty"`
 	Pruned               bool                                `json:"pruned"`
@@ -146,6 +155,9 @@ type GetBlockChainInfoResult struct {
 	ChainWork            string                              `json:"chainwork,omitempty"`
 	SoftForks            []*SoftForkDescription              `json:"softforks"`
 	Bip9SoftForks        map[string]*Bip9SoftForkDescription `json:"bip9_softforks"`
+	ScriptFlags          []string                            `json:"scriptflags"`
+	ScriptFlagOverrides  []string                            `json:"scriptflagoverrides,omitempty"`
+	Warnings             string                              `json:"warnings,omitempty"`
 }
 
 // GetBlockTemplateResultTx models the transactions field of the
@@ -159,6 +171,19 @@ type GetBlockTemplateResultTx struct {
 	Size      int64   `json:"size"`
 }
 
+// GetBlockTemplateResultCandidate models a candidate block template returned
+// in the templates field of the getblocktemplate command.  It shares the header
+// fields of the result it is returned with.
+type GetBlockTemplateResultCandidate struct {
+	Profile       string                     `json:"profile"`
+	SizeLimit     int64                      `json:"sizelimit"`
+	SigCheckTotal int64                      `json:"sigchecktotal"`
+	Fees          int64                      `json:"fees"`
+	Transactions  []GetBlockTemplateResultTx `json:"transactions"`
+	CoinbaseTxn   *GetBlockTemplateResultTx  `json:"coinbasetxn,omitempty"`
+	CoinbaseValue *int64                     `json:"coinbasevalue,omitempty"`
+}
+
 // GetBlockTemplateResultAux models the coinbaseaux field of the
 // getblocktemplate command.
 type GetBlockTemplateResultAux struct {
@@ -170,19 +195,20 @@ type GetBlockTemplateResultAux struct {
 type GetBlockTemplateResult struct {
 	// Base fields from BIP 0022.  CoinbaseAux is optional.  One of
 	// CoinbaseTxn or CoinbaseValue must be specified, but not both.
-	Bits          string                     `json:"bits"`
-	CurTime       int64                      `json:"curtime"`
-	Height        int64                      `json:"height"`
-	PreviousHash  string                     `json:"previousblockhash"`
-	SizeLimit     int64                      `json:"sizelimit,omitempty"`
-	SigCheckLimit int64                      `json:"sigchecklimit,omitempty"`
-	SigCheckTotal int64                      `json:"sigchecktotal,omitempty"`
-	Transactions  []GetBlockTemplateResultTx `json:"transactions"`
-	Version       int32                      `json:"version"`
-	CoinbaseAux   *GetBlockTemplateResultAux `json:"coinbaseaux,omitempty"`
-	CoinbaseTxn   *GetBlockTemplateResultTx  `json:"coinbasetxn,omitempty"`
-	CoinbaseValue *int64                     `json:"coinbasevalue,omitempty"`
-	WorkID        string                     `json:"workid,omitempty"`
+	Bits              string                     `json:"bits"`
+	CurTime           int64                      `json:"curtime"`
+	Height            int64                      `json:"height"`
+	PreviousHash      string                     `json:"previousblockhash"`
+	SizeLimit         int64                      `json:"sizelimit,omitempty"`
+	SigCheckLimit     int64                      `json:"sigchecklimit,omitempty"`
+	SigCheckTotal     int64                      `json:"sigchecktotal,omitempty"`
+	SigCheckRemaining int64                      `json:"sigcheckremaining"`
+	Transactions      []GetBlockTemplateResultTx `json:"transactions"`
+	Version           int32                      `json:"version"`
+	CoinbaseAux       *GetBlockTemplateResultAux `json:"coinbaseaux,omitempty"`
+	CoinbaseTxn       *GetBlockTemplateResultTx  `json:"coinbasetxn,omitempty"`
+	CoinbaseValue     *int64                     `json:"coinbasevalue,omitempty"`
+	WorkID            string                     `json:"workid,omitempty"`
 
 	// Optional long polling from BIP 0022.
 	LongPollID  string `json:"longpollid,omitempty"`
@@ -202,6 +228,18 @@ type GetBlockTemplateResult struct {
 	// Block proposal from BIP 0023.
 	Capabilities  []string `json:"capabilities,omitempty"`
 	RejectReasion string   `json:"reject-reason,omitempty"`
+	// Candidate templates from the templates capability of bchd.
+	Templates []GetBlockTemplateResultCandidate `json:"templates,omitempty"`
+}
+
+// GetChainTipsResult models the data returned from the getchaintips command.
+type GetChainTipsResult struct {
+	Height        int32  `json:"height"`
+	Hash          string `json:"hash"`
+	BranchLen     int32  `json:"branchlen"`
+	Status        string `json:"status"`
+	InvalidBlock  string `json:"invalidblock,omitempty"`
+	InvalidReason string `json:"invalidreason,omitempty"`
 }
 
 // GetMempoolEntryResult models the data returned from the getmempoolentry
@@ -226,8 +264,10 @@ type GetMempoolEntryResult struct {
 // GetMempoolInfoResult models the data returned from the getmempoolinfo
 // command.
 type GetMempoolInfoResult struct {
-	Size  int64 `json:"size"`
-	Bytes int64 `json:"bytes"`
+	Size          int64   `json:"size"`
+	Bytes         int64   `json:"bytes"`
+	MaxMempool    int64   `json:"maxmempool"`
+	MempoolMinFee float64 `json:"mempoolminfee"`
 }
 
 // NetworksResult models the networks data from the getnetworkinfo command.
@@ -250,46 +290,49 @@ type LocalAddressesResult struct {
 // GetNetworkInfoResult models the data returned from the getnetworkinfo
 // command.
 type GetNetworkInfoResult struct {
-	Version         int32                  `json:"version"`
-	SubVersion      string                 `json:"subversion"`
-	ProtocolVersion int32                  `json:"protocolversion"`
-	LocalServices   string                 `json:"localservices"`
-	LocalRelay      bool                   `json:"localrelay"`
-	TimeOffset      int64                  `json:"timeoffset"`
-	Connections     int32                  `json:"connections"`
-	NetworkActive   bool                   `json:"networkactive"`
-	Networks        []NetworksResult       `json:"networks"`
-	RelayFee        float64                `json:"relayfee"`
-	IncrementalFee  float64                `json:"incrementalfee"`
-	LocalAddresses  []LocalAddressesResult `json:"localaddresses"`
-	Warnings        string                 `json:"warnings"`
+	Version            int32                  `json:"version"`
+	SubVersion         string                 `json:"subversion"`
+	ProtocolVersion    int32                  `json:"protocolversion"`
+	LocalServices      string                 `json:"localservices"`
+	LocalServicesNames []string               `json:"localservicesnames"`
+	LocalRelay         bool                   `json:"localrelay"`
+	TimeOffset         int64                  `json:"timeoffset"`
+	Connections        int32                  `json:"connections"`
+	NetworkActive      bool                   `json:"networkactive"`
+	Networks           []NetworksResult       `json:"networks"`
+	RelayFee           float64                `json:"relayfee"`
+	IncrementalFee     float64                `json:"incrementalfee"`
+	LocalAddresses     []LocalAddressesResult `json:"localaddresses"`
+	Warnings           string                 `json:"warnings"`
 }
 
 // GetPeerInfoResult models the data returned from the getpeerinfo command.
 type GetPeerInfoResult struct {
-	ID             int32   `json:"id"`
-	Addr           string  `json:"addr"`
-	AddrLocal      string  `json:"addrlocal,omitempty"`
-	Services       string  `json:"services"`
-	ServicesStr    string  `json:"servicesStr"`
-	RelayTxes      bool    `json:"relaytxes"`
-	LastSend       int64   `json:"lastsend"`
-	LastRecv       int64   `json:"lastrecv"`
-	BytesSent      uint64  `json:"bytessent"`
-	BytesRecv      uint64  `json:"bytesrecv"`
-	ConnTime       int64   `json:"conntime"`
-	TimeOffset     int64   `json:"timeoffset"`
-	PingTime       float64 `json:"pingtime"`
-	PingWait       float64 `json:"pingwait,omitempty"`
-	Version        uint32  `json:"version"`
-	SubVer         string  `json:"subver"`
-	Inbound        bool    `json:"inbound"`
-	StartingHeight int32   `json:"startingheight"`
-	CurrentHeight  int32   `json:"currentheight,omitempty"`
-	BanScore       int32   `json:"banscore"`
-	Whitelisted    bool    `json:"whitelisted"`
-	FeeFilter      int64   `json:"feefilter"`
-	SyncNode       bool    `json:"syncnode"`
+	ID             int32    `json:"id"`
+	Addr           string   `json:"addr"`
+	AddrLocal      string   `json:"addrlocal,omitempty"`
+	Services       string   `json:"services"`
+	ServicesStr    string   `json:"servicesStr"`
+	RelayTxes      bool     `json:"relaytxes"`
+	LastSend       int64    `json:"lastsend"`
+	LastRecv       int64    `json:"lastrecv"`
+	BytesSent      uint64   `json:"bytessent"`
+	BytesRecv      uint64   `json:"bytesrecv"`
+	ConnTime       int64    `json:"conntime"`
+	TimeOffset     int64    `json:"timeoffset"`
+	PingTime       float64  `json:"pingtime"`
+	PingWait       float64  `json:"pingwait,omitempty"`
+	Version        uint32   `json:"version"`
+	SubVer         string   `json:"subver"`
+	Inbound        bool     `json:"inbound"`
+	StartingHeight int32    `json:"startingheight"`
+	CurrentHeight  int32    `json:"currentheight,omitempty"`
+	BanScore       int32    `json:"banscore"`
+	Whitelisted    bool     `json:"whitelisted"`
+	Permissions    []string `json:"permissions"`
+	FeeFilter      int64    `json:"feefilter"`
+	SyncNode       bool     `json:"syncnode"`
+	ConnectionType string   `json:"connectiontype"`
 }
 
 // GetRawMempoolVerboseResult models the data returned from the getrawmempool
@@ -347,6 +390,7 @@ type Vin struct {
 	Txid      string     `json:"txid"`
 	Vout      uint32     `json:"vout"`
 	ScriptSig *ScriptSig `json:"scriptSig"`
+	PrevOut   *PrevOut   `json:"prevOut,omitempty"`
 	Sequence  uint32     `json:"sequence"`
 }
 
@@ -372,11 +416,13 @@ func (v *Vin) MarshalJSON() ([]byte, error) {
 		Txid      string     `json:"txid"`
 		Vout      uint32     `json:"vout"`
 		ScriptSig *ScriptSig `json:"scriptSig"`
+		PrevOut   *PrevOut   `json:"prevOut,omitempty"`
 		Sequence  uint32     `json:"sequence"`
 	}{
 		Txid:      v.Txid,
 		Vout:      v.Vout,
 		ScriptSig: v.ScriptSig,
+		PrevOut:   v.PrevOut,
 		Sequence:  v.Sequence,
 	}
 	return json.Marshal(txStruct)
@@ -479,18 +525,20 @@ type InfoChainResult struct {
 
 // TxRawResult models the data from the getrawtransaction command.
 type TxRawResult struct {
-	Hex           string `json:"hex,omitempty"`
-	Txid          string `json:"txid"`
-	Hash          string `json:"hash,omitempty"`
-	Size          int32  `json:"size,omitempty"`
-	Version       int32  `json:"version"`
-	LockTime      uint32 `json:"locktime"`
-	Vin           []Vin  `json:"vin"`
-	Vout          []Vout `json:"vout"`
-	BlockHash     string `json:"blockhash,omitempty"`
-	Confirmations uint64 `json:"confirmations,omitempty"`
-	Time          int64  `json:"time,omitempty"`
-	Blocktime     int64  `json:"blocktime,omitempty"`
+	Hex           string   `json:"hex,omitempty"`
+	Txid          string   `json:"txid"`
+	Hash          string   `json:"hash,omitempty"`
+	Size          int32    `json:"size,omitempty"`
+	Version       int32    `json:"version"`
+	LockTime      uint32   `json:"locktime"`
+	Vin           []Vin    `json:"vin"`
+	Vout          []Vout   `json:"vout"`
+	BlockHash     string   `json:"blockhash,omitempty"`
+	BlockHeight   int32    `json:"blockheight,omitempty"`
+	Confirmations uint64   `json:"confirmations,omitempty"`
+	Time          int64    `json:"time,omitempty"`
+	Blocktime     int64    `json:"blocktime,omitempty"`
+	Fee           *float64 `json:"fee,omitempty"`
 }
 
 // SearchRawTransactionsResult models the data from the searchrawtransaction
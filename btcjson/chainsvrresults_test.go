@@ -43,6 +43,23 @@ func TestChainSvrCustomResults(t *testing.T) {
 			},
 			expected: `{"txid":"123","vout":1,"scriptSig":{"asm":"0","hex":"00"},"sequence":4294967295}`,
 		},
+		{
+			name: "custom vin marshal with prevout",
+			result: &btcjson.Vin{
+				Txid: "123",
+				Vout: 1,
+				ScriptSig: &btcjson.ScriptSig{
+					Asm: "0",
+					Hex: "00",
+				},
+				PrevOut: &btcjson.PrevOut{
+					Addresses: []string{"addr1"},
+					Value:     1.5,
+				},
+				Sequence: 4294967295,
+			},
+			expected: `{"txid":"123","vout":1,"scriptSig":{"asm":"0","hex":"00"},"prevOut":{"addresses":["addr1"],"value":1.5},"sequence":4294967295}`,
+		},
 		{
 			name: "custom vinprevout marshal with coinbase",
 			result: &btcjson.VinPrevOut{
@@ -86,3 +103,25 @@ func TestChainSvrCustomResults(t *testing.T) {
 		}
 	}
 }
+
+// TestGetPeerInfoResult ensures the kind of connection of a peer is marshalled
+// with the field name of the getpeerinfo result.
+func TestGetPeerInfoResult(t *testing.T) {
+	t.Parallel()
+
+	result := btcjson.GetPeerInfoResult{
+		ConnectionType: "block-relay-only",
+	}
+	marshalled, err := json.Marshal(&result)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(marshalled, &fields); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := fields["connectiontype"]; got != "block-relay-only" {
+		t.Errorf("got connectiontype %v, want block-relay-only - "+
+			"marshalled %s", got, marshalled)
+	}
+}
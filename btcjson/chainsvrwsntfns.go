@@ -75,6 +75,17 @@ const (
 	// from the chain server that inform a client that a transaction that
 	// matches the loaded filter was accepted by the mempool.
 	RelevantTxAcceptedNtfnMethod = "relevanttxaccepted"
+
+	// TxReplacedNtfnMethod is the method used for notifications from the
+	// chain server that a transaction submitted to it was replaced by a
+	// conflicting transaction, such as a malleated variant, which was
+	// connected to the main chain.
+	TxReplacedNtfnMethod = "txreplaced"
+
+	// BlockFeesNtfnMethod is the method used for notifications from the
+	// chain server that a block has been connected, carrying the breakdown
+	// of its coinbase into subsidy and fees for pool accounting.
+	BlockFeesNtfnMethod = "blockfees"
 )
 
 // BlockConnectedNtfn defines the blockconnected JSON-RPC notification.
@@ -285,6 +296,34 @@ func NewRelevantTxAcceptedNtfn(txHex string) *RelevantTxAcceptedNtfn {
 	return &RelevantTxAcceptedNtfn{Transaction: txHex}
 }
 
+// TxReplacedNtfn defines the txreplaced JSON-RPC notification.
+type TxReplacedNtfn struct {
+	TxID            string
+	ReplacementTxID string
+}
+
+// NewTxReplacedNtfn returns a new instance which can be used to issue a
+// txreplaced JSON-RPC notification.
+func NewTxReplacedNtfn(txHash, replacementTxHash string) *TxReplacedNtfn {
+	return &TxReplacedNtfn{
+		TxID:            txHash,
+		ReplacementTxID: replacementTxHash,
+	}
+}
+
+// BlockFeesNtfn defines the blockfees JSON-RPC notification.
+type BlockFeesNtfn struct {
+	BlockFees BlockFeesResult
+}
+
+// NewBlockFeesNtfn returns a new instance which can be used to issue a
+// blockfees JSON-RPC notification.
+func NewBlockFeesNtfn(blockFees BlockFeesResult) *BlockFeesNtfn {
+	return &BlockFeesNtfn{
+		BlockFees: blockFees,
+	}
+}
+
 func init() {
 	// The commands in this file are only usable by websockets and are
 	// notifications.
@@ -301,4 +340,6 @@ func init() {
 	MustRegisterCmd(TxAcceptedNtfnMethod, (*TxAcceptedNtfn)(nil), flags)
 	MustRegisterCmd(TxAcceptedVerboseNtfnMethod, (*TxAcceptedVerboseNtfn)(nil), flags)
 	MustRegisterCmd(RelevantTxAcceptedNtfnMethod, (*RelevantTxAcceptedNtfn)(nil), flags)
+	MustRegisterCmd(TxReplacedNtfnMethod, (*TxReplacedNtfn)(nil), flags)
+	MustRegisterCmd(BlockFeesNtfnMethod, (*BlockFeesNtfn)(nil), flags)
 }
@@ -225,6 +225,57 @@ func TestChainSvrWsNtfns(t *testing.T) {
 				Transaction: "001122",
 			},
 		},
+		{
+			name: "txreplaced",
+			newNtfn: func() (interface{}, error) {
+				return btcjson.NewCmd("txreplaced", "123", "456")
+			},
+			staticNtfn: func() interface{} {
+				return btcjson.NewTxReplacedNtfn("123", "456")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"txreplaced","params":["123","456"],"id":null}`,
+			unmarshalled: &btcjson.TxReplacedNtfn{
+				TxID:            "123",
+				ReplacementTxID: "456",
+			},
+		},
+		{
+			name: "blockfees",
+			newNtfn: func() (interface{}, error) {
+				return btcjson.NewCmd("blockfees", `{"hash":"123","height":100000,"time":1234567890,"size":285,"txcount":1,"subsidy":6.25,"fees":0.0001,"coinbasevalue":6.2501,"fromtemplate":true,"templatefees":0.0002,"feedelta":-0.0001}`)
+			},
+			staticNtfn: func() interface{} {
+				return btcjson.NewBlockFeesNtfn(btcjson.BlockFeesResult{
+					Hash:          "123",
+					Height:        100000,
+					Time:          1234567890,
+					Size:          285,
+					TxCount:       1,
+					Subsidy:       6.25,
+					Fees:          0.0001,
+					CoinbaseValue: 6.2501,
+					FromTemplate:  true,
+					TemplateFees:  0.0002,
+					FeeDelta:      -0.0001,
+				})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"blockfees","params":[{"hash":"123","height":100000,"time":1234567890,"size":285,"txcount":1,"subsidy":6.25,"fees":0.0001,"coinbasevalue":6.2501,"fromtemplate":true,"templatefees":0.0002,"feedelta":-0.0001}],"id":null}`,
+			unmarshalled: &btcjson.BlockFeesNtfn{
+				BlockFees: btcjson.BlockFeesResult{
+					Hash:          "123",
+					Height:        100000,
+					Time:          1234567890,
+					Size:          285,
+					TxCount:       1,
+					Subsidy:       6.25,
+					Fees:          0.0001,
+					CoinbaseValue: 6.2501,
+					FromTemplate:  true,
+					TemplateFees:  0.0002,
+					FeeDelta:      -0.0001,
+				},
+			},
+		},
 	}
 
 	t.Logf("Running %d tests", len(tests))
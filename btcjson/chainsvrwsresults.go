@@ -19,3 +19,20 @@ type RescannedBlock struct {
 	Hash         string   `json:"hash"`
 	Transactions []string `json:"transactions"`
 }
+
+// BlockFeesResult models the breakdown of the coinbase of a connected block
+// sent with the blockfees notification.  The template fields are only set when
+// the block was built from a block template generated by the server.
+type BlockFeesResult struct {
+	Hash          string  `json:"hash"`
+	Height        int32   `json:"height"`
+	Time          int64   `json:"time"`
+	Size          int     `json:"size"`
+	TxCount       int     `json:"txcount"`
+	Subsidy       float64 `json:"subsidy"`
+	Fees          float64 `json:"fees"`
+	CoinbaseValue float64 `json:"coinbasevalue"`
+	FromTemplate  bool    `json:"fromtemplate"`
+	TemplateFees  float64 `json:"templatefees"`
+	FeeDelta      float64 `json:"feedelta"`
+}
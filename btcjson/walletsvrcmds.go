@@ -71,10 +71,12 @@ func NewEncryptWalletCmd(passphrase string) *EncryptWalletCmd {
 // EstimateFeeCmd defines the estimatefee JSON-RPC command.
 type EstimateFeeCmd struct {
 	NumBlocks int64
+	Mode      *string
 }
 
 // NewEstimateFeeCmd returns a new instance which can be used to issue a
-// estimatefee JSON-RPC command.
+// estimatefee JSON-RPC command.  The server estimates the fee in its configured
+// default mode unless the Mode field is set to historical, mempool or blended.
 func NewEstimateFeeCmd(numBlocks int64) *EstimateFeeCmd {
 	return &EstimateFeeCmd{
 		NumBlocks: numBlocks,
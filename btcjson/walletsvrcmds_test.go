@@ -115,6 +115,22 @@ func TestWalletSvrCmds(t *testing.T) {
 				NumBlocks: 6,
 			},
 		},
+		{
+			name: "estimatefee optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("estimatefee", 6, "mempool")
+			},
+			staticCmd: func() interface{} {
+				cmd := btcjson.NewEstimateFeeCmd(6)
+				cmd.Mode = btcjson.String("mempool")
+				return cmd
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"estimatefee","params":[6,"mempool"],"id":1}`,
+			unmarshalled: &btcjson.EstimateFeeCmd{
+				NumBlocks: 6,
+				Mode:      btcjson.String("mempool"),
+			},
+		},
 		{
 			name: "estimatepriority",
 			newCmd: func() (interface{}, error) {
@@ -0,0 +1,50 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import "sync"
+
+// activationMtx protects the upgrade activations which can be changed while
+// the parameters are in use.  It is kept out of Params so the parameters can
+// still be copied.
+var activationMtx sync.RWMutex
+
+// Upgrade9Activation returns the block height after which upgrade9 is active.
+//
+// This function is safe for concurrent access.
+func (p *Params) Upgrade9Activation() int32 {
+	activationMtx.RLock()
+	defer activationMtx.RUnlock()
+	return p.Upgrade9ForkHeight
+}
+
+// SetUpgrade9Activation sets the block height after which upgrade9 is active.
+//
+// This function is safe for concurrent access.
+func (p *Params) SetUpgrade9Activation(height int32) {
+	activationMtx.Lock()
+	p.Upgrade9ForkHeight = height
+	activationMtx.Unlock()
+}
+
+// Upgrade11Activation returns the median time past from which upgrade11 is
+// active.
+//
+// This function is safe for concurrent access.
+func (p *Params) Upgrade11Activation() uint64 {
+	activationMtx.RLock()
+	defer activationMtx.RUnlock()
+	return p.Upgrade11ActivationTime
+}
+
+// SetUpgrade11Activation sets the median time past from which upgrade11 is
+// active.
+//
+// This function is safe for concurrent access.
+func (p *Params) SetUpgrade11Activation(activationTime uint64) {
+	activationMtx.Lock()
+	p.Upgrade11ActivationTime = activationTime
+	activationMtx.Unlock()
+}
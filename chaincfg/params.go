@@ -122,6 +122,10 @@ type Params struct {
 	// Net defines the magic bytes used to identify the network.
 	Net wire.BitcoinNet
 
+	// RegressionTest is set for the regression test network and the
+	// networks derived from it, which are synced from local peers only.
+	RegressionTest bool
+
 	// DefaultPort defines the default peer-to-peer port for the network.
 	DefaultPort string
 
@@ -252,6 +256,13 @@ type Params struct {
 	// Mempool parameters
 	RelayNonStdTxs bool
 
+	// MaxStandardTxSize, MaxStandardSigScriptSize and MaxDataCarrierSize are
+	// the default size limits in bytes of a standard transaction, of each
+	// of its signature scripts and of all of its null data outputs.
+	MaxStandardTxSize        int
+	MaxStandardSigScriptSize int
+	MaxDataCarrierSize       int
+
 	// The prefix used for the cashaddress. This is different for each network.
 	CashAddressPrefix string
 
@@ -438,7 +449,10 @@ var MainNetParams = Params{
 	},
 
 	// Mempool parameters
-	RelayNonStdTxs: false,
+	RelayNonStdTxs:           false,
+	MaxStandardTxSize:        100000,
+	MaxStandardSigScriptSize: 1650,
+	MaxDataCarrierSize:       223,
 
 	// The prefix for the cashaddress
 	CashAddressPrefix: "bitcoincash", // always bitcoincash for mainnet
@@ -466,10 +480,11 @@ var MainNetParams = Params{
 // Bitcoin network.  Not to be confused with the test Bitcoin network (version
 // 3), this network is sometimes simply called "testnet".
 var RegressionNetParams = Params{
-	Name:        "regtest",
-	Net:         wire.TestNet,
-	DefaultPort: "18444",
-	DNSSeeds:    []DNSSeed{},
+	Name:           "regtest",
+	Net:            wire.TestNet,
+	RegressionTest: true,
+	DefaultPort:    "18444",
+	DNSSeeds:       []DNSSeed{},
 
 	// Chain parameters
 	GenesisBlock:     &regTestGenesisBlock,
@@ -540,7 +555,10 @@ var RegressionNetParams = Params{
 	},
 
 	// Mempool parameters
-	RelayNonStdTxs: true,
+	RelayNonStdTxs:           true,
+	MaxStandardTxSize:        100000,
+	MaxStandardSigScriptSize: 1650,
+	MaxDataCarrierSize:       223,
 
 	// The prefix for the cashaddress
 	CashAddressPrefix: "bchreg", // always bchreg for reg testnet
@@ -664,7 +682,10 @@ var TestNet3Params = Params{
 	},
 
 	// Mempool parameters
-	RelayNonStdTxs: true,
+	RelayNonStdTxs:           true,
+	MaxStandardTxSize:        100000,
+	MaxStandardSigScriptSize: 1650,
+	MaxDataCarrierSize:       223,
 
 	// The prefix for the cashaddress
 	CashAddressPrefix: "bchtest", // always bchtest for testnet
@@ -770,7 +791,10 @@ var ChipNetParams = Params{
 	},
 
 	// Mempool parameters
-	RelayNonStdTxs: false,
+	RelayNonStdTxs:           false,
+	MaxStandardTxSize:        100000,
+	MaxStandardSigScriptSize: 1650,
+	MaxDataCarrierSize:       223,
 
 	// The prefix for the cashaddress
 	CashAddressPrefix: "bchtest", // always bchtest for testnet
@@ -882,7 +906,10 @@ var TestNet4Params = Params{
 	},
 
 	// Mempool parameters
-	RelayNonStdTxs: false,
+	RelayNonStdTxs:           false,
+	MaxStandardTxSize:        100000,
+	MaxStandardSigScriptSize: 1650,
+	MaxDataCarrierSize:       223,
 
 	// The prefix for the cashaddress
 	CashAddressPrefix: "bchtest", // always bchtest for testnet
@@ -985,7 +1012,10 @@ var SimNetParams = Params{
 	},
 
 	// Mempool parameters
-	RelayNonStdTxs: true,
+	RelayNonStdTxs:           true,
+	MaxStandardTxSize:        100000,
+	MaxStandardSigScriptSize: 1650,
+	MaxDataCarrierSize:       223,
 
 	// The prefix for the cashaddress
 	CashAddressPrefix: "bchsim", // always bchsim for simnet
@@ -0,0 +1,88 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UpgradeTestPortOffset is added to the ports of a network to get the ports of
+// its upgrade test network, so the nodes of both networks can run side by side.
+const UpgradeTestPortOffset = 1000
+
+// UpgradeTestParams returns a copy of the passed network parameters for a new
+// chain, starting from the genesis block of the passed network, on which all of
+// the upgrades activated by median time past which are not active yet at the
+// passed activation time are force-enabled from that time.  This allows
+// exercising the rules of upcoming upgrades before they activate on the passed
+// network.
+//
+// Since the chain doesn't share the history of the passed network, the upgrades
+// activated by height are active from the genesis block, the asert difficulty
+// adjustment is anchored at the genesis block and the checkpoints of the passed
+// network are removed.  The upgrades activated by time are the uint64 fields of
+// the parameters whose name ends with ActivationTime.
+//
+// The returned parameters are named after the passed network with an
+// "-upgradetest" suffix so their data is kept apart, have no DNS seeds and use
+// the bitwise complement of the network magic along with ports offset by
+// UpgradeTestPortOffset, so the nodes of the passed network don't connect to
+// them.
+func UpgradeTestParams(params *Params, activation time.Time) *Params {
+	upgradeTest := *params
+	upgradeTest.Name = params.Name + "-upgradetest"
+	upgradeTest.Net = ^params.Net
+	upgradeTest.DefaultPort = UpgradeTestPort(params.DefaultPort)
+	upgradeTest.DNSSeeds = nil
+	upgradeTest.Checkpoints = nil
+
+	upgradeTest.BIP0034Height = 0
+	upgradeTest.BIP0065Height = 0
+	upgradeTest.BIP0066Height = 0
+	upgradeTest.CSVHeight = 1
+	upgradeTest.UahfForkHeight = 0
+	upgradeTest.DaaForkHeight = 0
+	upgradeTest.MagneticAnonomalyForkHeight = 0
+	upgradeTest.GreatWallForkHeight = 0
+	upgradeTest.GravitonForkHeight = 0
+	upgradeTest.PhononForkHeight = 0
+	upgradeTest.AxionActivationHeight = 0
+	upgradeTest.Upgrade9ForkHeight = 0
+	upgradeTest.ABLAForkHeight = 0
+
+	genesisHeader := &params.GenesisBlock.Header
+	upgradeTest.AsertDifficultyAnchorHeight = 0
+	upgradeTest.AsertDifficultyAnchorParentTimestamp = genesisHeader.Timestamp.Unix()
+	upgradeTest.AsertDifficultyAnchorBits = genesisHeader.Bits
+
+	activationTime := uint64(activation.Unix())
+	v := reflect.ValueOf(&upgradeTest).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.Type.Kind() != reflect.Uint64 ||
+			!strings.HasSuffix(field.Name, "ActivationTime") {
+
+			continue
+		}
+		if v.Field(i).Uint() > activationTime {
+			v.Field(i).SetUint(activationTime)
+		}
+	}
+	return &upgradeTest
+}
+
+// UpgradeTestPort returns the port of the upgrade test network of a network
+// corresponding to the passed port of the network.  Ports which are not
+// numeric are returned unchanged.
+func UpgradeTestPort(port string) string {
+	n, err := strconv.Atoi(port)
+	if err != nil {
+		return port
+	}
+	return strconv.Itoa(n + UpgradeTestPortOffset)
+}
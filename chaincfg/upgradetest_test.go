@@ -0,0 +1,62 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"testing"
+	"time"
+)
+
+// TestUpgradeTestParams ensures only the upgrades which are not active yet at
+// the activation time are force-enabled and the passed parameters are left
+// untouched.
+func TestUpgradeTestParams(t *testing.T) {
+	params := TestNet4Params
+	params.Upgrade11ActivationTime = 2000000000
+	params.Checkpoints = MainNetParams.Checkpoints
+
+	activation := time.Unix(1800000000, 0)
+	upgradeTest := UpgradeTestParams(&params, activation)
+
+	if upgradeTest.Name != "testnet4-upgradetest" {
+		t.Fatalf("unexpected name %q", upgradeTest.Name)
+	}
+	if upgradeTest.Net != ^params.Net || upgradeTest.DefaultPort != "29333" {
+		t.Fatalf("unexpected network magic %v and port %s",
+			upgradeTest.Net, upgradeTest.DefaultPort)
+	}
+	if len(upgradeTest.DNSSeeds) != 0 {
+		t.Fatalf("unexpected DNS seeds %v", upgradeTest.DNSSeeds)
+	}
+	if upgradeTest.Upgrade11ActivationTime != 1800000000 {
+		t.Fatalf("pending upgrade activates at %d, want %d",
+			upgradeTest.Upgrade11ActivationTime, 1800000000)
+	}
+	if upgradeTest.CosmicInflationActivationTime !=
+		params.CosmicInflationActivationTime {
+
+		t.Fatalf("active upgrade moved to %d",
+			upgradeTest.CosmicInflationActivationTime)
+	}
+	if upgradeTest.Upgrade9ForkHeight != 0 || upgradeTest.AxionActivationHeight != 0 {
+		t.Fatalf("upgrades activated by height at %d and %d, want 0",
+			upgradeTest.Upgrade9ForkHeight, upgradeTest.AxionActivationHeight)
+	}
+	if len(upgradeTest.Checkpoints) != 0 {
+		t.Fatalf("unexpected checkpoints %v", upgradeTest.Checkpoints)
+	}
+	if upgradeTest.AsertDifficultyAnchorHeight != 0 ||
+		upgradeTest.AsertDifficultyAnchorBits != params.GenesisBlock.Header.Bits {
+
+		t.Fatalf("asert anchored at height %d with bits %08x, want the "+
+			"genesis block", upgradeTest.AsertDifficultyAnchorHeight,
+			upgradeTest.AsertDifficultyAnchorBits)
+	}
+	if params.Name != "testnet4" || params.Upgrade11ActivationTime != 2000000000 ||
+		len(params.Checkpoints) == 0 {
+
+		t.Fatal("passed parameters modified")
+	}
+}
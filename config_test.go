@@ -1,86 +0,0 @@
-package main
-
-import (
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"regexp"
-	"testing"
-)
-
-var (
-	rpcuserRegexp = regexp.MustCompile("(?m)^rpcuser=.+$")
-	rpcpassRegexp = regexp.MustCompile("(?m)^rpcpass=.+$")
-)
-
-func TestExcessiveBlockSizeUserAgentComment(t *testing.T) {
-	// Wipe test args.
-	os.Args = []string{"bchd"}
-
-	cfg, _, err := loadConfig()
-	if err != nil {
-		t.Fatal("Failed to load configuration")
-	}
-
-	if len(cfg.UserAgentComments) != 1 {
-		t.Fatal("Expected EB UserAgentComment")
-	}
-
-	uac := cfg.UserAgentComments[0]
-	uacExpected := "EB32.0"
-	if uac != uacExpected {
-		t.Fatalf("Expected UserAgentComments to contain %s but got %s", uacExpected, uac)
-	}
-
-	// Custom excessive block size.
-	os.Args = []string{"bchd", "--excessiveblocksize=64000000"}
-
-	cfg, _, err = loadConfig()
-	if err != nil {
-		t.Fatal("Failed to load configuration")
-	}
-
-	if len(cfg.UserAgentComments) != 1 {
-		t.Fatal("Expected EB UserAgentComment")
-	}
-
-	uac = cfg.UserAgentComments[0]
-	uacExpected = "EB64.0"
-	if uac != uacExpected {
-		t.Fatalf("Expected UserAgentComments to contain %s but got %s", uacExpected, uac)
-	}
-}
-
-func TestCreateDefaultConfigFile(t *testing.T) {
-	// Setup a temporary directory
-	tmpDir, err := ioutil.TempDir("", "bchd")
-	if err != nil {
-		t.Fatalf("Failed creating a temporary directory: %v", err)
-	}
-	testpath := filepath.Join(tmpDir, "test.conf")
-
-	// Clean-up
-	defer func() {
-		os.Remove(testpath)
-		os.Remove(tmpDir)
-	}()
-
-	err = createDefaultConfigFile(testpath)
-
-	if err != nil {
-		t.Fatalf("Failed to create a default config file: %v", err)
-	}
-
-	content, err := ioutil.ReadFile(testpath)
-	if err != nil {
-		t.Fatalf("Failed to read generated default config file: %v", err)
-	}
-
-	if !rpcuserRegexp.Match(content) {
-		t.Error("Could not find rpcuser in generated default config file.")
-	}
-
-	if !rpcpassRegexp.Match(content) {
-		t.Error("Could not find rpcpass in generated default config file.")
-	}
-}
@@ -7,6 +7,7 @@ package connmgr
 import (
 	"errors"
 	"fmt"
+	"math/rand"
 	"net"
 	"sync"
 	"sync/atomic"
@@ -52,6 +53,43 @@ const (
 	ConnDisconnected
 )
 
+// ConnType identifies the purpose of an outbound connection.
+type ConnType uint8
+
+// The following constants define the types of outbound connections.
+const (
+	// ConnFullRelay is a connection used to relay blocks, transactions and
+	// addresses.  It is the type of all manually requested connections.
+	ConnFullRelay ConnType = iota
+
+	// ConnBlockRelay is an automatic connection which only relays blocks.
+	// Since neither transactions nor addresses are relayed over it, the
+	// connection is hard to infer by observing relay traffic, which makes
+	// the node more resistant to network partitioning.
+	ConnBlockRelay
+
+	// ConnFeeler is a short-lived automatic connection used to test
+	// whether an address which has never been connected to is reachable.
+	// It is disconnected as soon as the handshake completes.
+	ConnFeeler
+)
+
+// connTypeStrings is a map of connection types back to their constant names
+// for pretty printing.
+var connTypeStrings = map[ConnType]string{
+	ConnFullRelay:  "full-relay",
+	ConnBlockRelay: "block-relay-only",
+	ConnFeeler:     "feeler",
+}
+
+// String returns the ConnType in human-readable form.
+func (t ConnType) String() string {
+	if s, ok := connTypeStrings[t]; ok {
+		return s
+	}
+	return fmt.Sprintf("Unknown ConnType (%d)", uint8(t))
+}
+
 // ConnReq is the connection request to a network address. If permanent, the
 // connection will be retried on disconnection.
 type ConnReq struct {
@@ -60,6 +98,7 @@ type ConnReq struct {
 
 	Addr      net.Addr
 	Permanent bool
+	Type      ConnType
 
 	conn       net.Conn
 	state      ConnState
@@ -124,6 +163,17 @@ type Config struct {
 	// maintain. Defaults to 8.
 	TargetOutbound uint32
 
+	// TargetBlockRelay is the number of additional outbound block-relay-only
+	// connections to maintain.  They are only made when GetNewAddress is
+	// set.
+	TargetBlockRelay uint32
+
+	// FeelerInterval is the average interval between feeler connections.
+	// Feeler connections are only made once the target number of outbound
+	// connections is reached and are disabled when the interval is zero or
+	// GetFeelerAddress is nil.
+	FeelerInterval time.Duration
+
 	// RetryDuration is the duration to wait before retrying connection
 	// requests. Defaults to 5s.
 	RetryDuration time.Duration
@@ -140,6 +190,10 @@ type Config struct {
 	// to.  If nil, no new connections will be made automatically.
 	GetNewAddress func() (net.Addr, error)
 
+	// GetFeelerAddress is a way to get an address which has never been
+	// connected to in order to test it with a feeler connection.
+	GetFeelerAddress func() (net.Addr, error)
+
 	// Dial connects to the address on the named network. It cannot be nil.
 	Dial func(net.Addr) (net.Conn, error)
 }
@@ -201,6 +255,10 @@ func (cm *ConnManager) handleFailedConn(c *ConnReq) {
 	if atomic.LoadInt32(&cm.stop) != 0 {
 		return
 	}
+	if c.Type == ConnFeeler {
+		// Failing feelers are expected and are not replaced.
+		return
+	}
 	if c.Permanent {
 		c.retryCount++
 		d := time.Duration(c.retryCount) * cm.cfg.RetryDuration
@@ -218,10 +276,10 @@ func (cm *ConnManager) handleFailedConn(c *ConnReq) {
 				"-- retrying connection in: %v", maxFailedAttempts,
 				cm.cfg.RetryDuration)
 			time.AfterFunc(cm.cfg.RetryDuration, func() {
-				cm.NewConnReq()
+				cm.newConnReq(c.Type)
 			})
 		} else {
-			go cm.NewConnReq()
+			go cm.newConnReq(c.Type)
 		}
 	}
 }
@@ -241,11 +299,35 @@ func (cm *ConnManager) connHandler() {
 
 		// conns represents the set of all actively connected peers.
 		conns = make(map[uint64]*ConnReq, cm.cfg.TargetOutbound)
+
+		// feelerTimer paces the feeler connections.  It is nil when
+		// feeler connections are disabled.
+		feelerTimer *time.Timer
+		feelerC     <-chan time.Time
 	)
+	if cm.cfg.FeelerInterval > 0 && cm.cfg.GetFeelerAddress != nil {
+		feelerTimer = time.NewTimer(cm.nextFeelerDelay())
+		feelerC = feelerTimer.C
+		defer feelerTimer.Stop()
+	}
 
 out:
 	for {
 		select {
+		case <-feelerC:
+			feelerTimer.Reset(cm.nextFeelerDelay())
+
+			// Only test addresses once the target number of outbound
+			// connections is reached and no other feeler is in
+			// progress.
+			if countConnType(conns, ConnFullRelay) < cm.cfg.TargetOutbound ||
+				countConnType(conns, ConnFeeler) != 0 ||
+				countConnType(pending, ConnFeeler) != 0 {
+
+				continue
+			}
+			go cm.newConnReq(ConnFeeler)
+
 		case req := <-cm.requests:
 			switch msg := req.(type) {
 
@@ -384,17 +466,53 @@ out:
 	log.Trace("Connection handler done")
 }
 
-// NewConnReq creates a new connection request and connects to the
+// nextFeelerDelay returns the delay until the next feeler connection.  The
+// delay is picked uniformly between half and one and a half times the feeler
+// interval so feeler connections are not made at predictable times.
+func (cm *ConnManager) nextFeelerDelay() time.Duration {
+	interval := int64(cm.cfg.FeelerInterval)
+	return time.Duration(interval/2 + rand.Int63n(interval))
+}
+
+// countConnType returns the number of the passed connection requests which are
+// of the passed type.
+func countConnType(reqs map[uint64]*ConnReq, connType ConnType) uint32 {
+	var n uint32
+	for _, c := range reqs {
+		if c.Type == connType {
+			n++
+		}
+	}
+	return n
+}
+
+// NewConnReq creates a new full-relay connection request and connects to the
 // corresponding address.
 func (cm *ConnManager) NewConnReq() {
+	cm.newConnReq(ConnFullRelay)
+}
+
+// NewBlockRelayConnReq creates a new block-relay-only connection request and
+// connects to the corresponding address.
+func (cm *ConnManager) NewBlockRelayConnReq() {
+	cm.newConnReq(ConnBlockRelay)
+}
+
+// newConnReq creates a new connection request of the passed type and connects
+// to the corresponding address.
+func (cm *ConnManager) newConnReq(connType ConnType) {
 	if atomic.LoadInt32(&cm.stop) != 0 {
 		return
 	}
-	if cm.cfg.GetNewAddress == nil {
+	getAddress := cm.cfg.GetNewAddress
+	if connType == ConnFeeler {
+		getAddress = cm.cfg.GetFeelerAddress
+	}
+	if getAddress == nil {
 		return
 	}
 
-	c := &ConnReq{}
+	c := &ConnReq{Type: connType}
 	atomic.StoreUint64(&c.id, atomic.AddUint64(&cm.connReqCount, 1))
 
 	// Submit a request of a pending connection attempt to the connection
@@ -416,7 +534,7 @@ func (cm *ConnManager) NewConnReq() {
 		return
 	}
 
-	addr, err := cm.cfg.GetNewAddress()
+	addr, err := getAddress()
 	if err != nil {
 		select {
 		case cm.requests <- handleFailed{c, err}:
@@ -572,6 +690,9 @@ func (cm *ConnManager) Start() {
 	for i := atomic.LoadUint64(&cm.connReqCount); i < uint64(cm.cfg.TargetOutbound); i++ {
 		go cm.NewConnReq()
 	}
+	for i := uint32(0); i < cm.cfg.TargetBlockRelay; i++ {
+		go cm.NewBlockRelayConnReq()
+	}
 }
 
 // Wait blocks until the connection manager halts gracefully.
@@ -218,6 +218,80 @@ func TestTargetOutbound(t *testing.T) {
 	cmgr.Stop()
 }
 
+// TestConnTypes tests that the target number of block-relay-only connections
+// is maintained in addition to the full-relay connections and that feeler
+// connections to feeler addresses are made once the target number of
+// outbound connections is reached.
+func TestConnTypes(t *testing.T) {
+	targetOutbound := uint32(2)
+	targetBlockRelay := uint32(2)
+	connected := make(chan *ConnReq)
+	var port int32 = 18555
+	feelerAddr := &net.TCPAddr{IP: net.ParseIP("127.0.0.2"), Port: 18555}
+	cmgr, err := New(&Config{
+		TargetOutbound:   targetOutbound,
+		TargetBlockRelay: targetBlockRelay,
+		FeelerInterval:   10 * time.Millisecond,
+		Dial:             mockDialer,
+		GetNewAddress: func() (net.Addr, error) {
+			return &net.TCPAddr{
+				IP:   net.ParseIP("127.0.0.1"),
+				Port: int(atomic.AddInt32(&port, 1)),
+			}, nil
+		},
+		GetFeelerAddress: func() (net.Addr, error) {
+			return feelerAddr, nil
+		},
+		OnConnection: func(c *ConnReq, _ net.Conn) {
+			connected <- c
+		},
+	})
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+	cmgr.Start()
+	defer cmgr.Stop()
+
+	counts := make(map[ConnType]uint32)
+	timeout := time.After(5 * time.Second)
+	for counts[ConnFeeler] == 0 {
+		select {
+		case c := <-connected:
+			counts[c.Type]++
+			if c.Type == ConnFeeler && c.Addr.String() != feelerAddr.String() {
+				t.Fatalf("feeler connected to unexpected address %v",
+					c.Addr)
+			}
+			if c.Type == ConnFeeler && counts[ConnFullRelay] < targetOutbound {
+				t.Fatal("feeler connection made before reaching " +
+					"the target outbound connections")
+			}
+		case <-timeout:
+			t.Fatalf("no feeler connection made -- counts %v", counts)
+		}
+	}
+	if counts[ConnFullRelay] != targetOutbound {
+		t.Fatalf("full-relay connections: got %d, want %d",
+			counts[ConnFullRelay], targetOutbound)
+	}
+
+	// The block-relay-only connections are made concurrently with the
+	// full-relay ones, so wait for any that are still outstanding.
+	for counts[ConnBlockRelay] < targetBlockRelay {
+		select {
+		case c := <-connected:
+			counts[c.Type]++
+		case <-timeout:
+			t.Fatalf("block-relay-only connections: got %d, want %d",
+				counts[ConnBlockRelay], targetBlockRelay)
+		}
+	}
+	if counts[ConnBlockRelay] != targetBlockRelay {
+		t.Fatalf("block-relay-only connections: got %d, want %d",
+			counts[ConnBlockRelay], targetBlockRelay)
+	}
+}
+
 // TestRetryPermanent tests that permanent connection requests are retried.
 //
 // We make a permanent connection request using Connect, disconnect it using
@@ -5,10 +5,14 @@
 package connmgr
 
 import (
+	"bufio"
 	"fmt"
+	"io"
 	mrand "math/rand"
 	"net"
+	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gcash/bchd/chaincfg"
@@ -20,6 +24,10 @@ const (
 	// seen time.
 	secondsIn3Days int32 = 24 * 60 * 60 * 3
 	secondsIn4Days int32 = 24 * 60 * 60 * 4
+
+	// maxSeedListSize is the maximum number of bytes read from the list
+	// of peer addresses served by an HTTPS seed.
+	maxSeedListSize = 1 << 20
 )
 
 // OnSeed is the signature of the callback function which is invoked when DNS
@@ -33,7 +41,19 @@ type LookupFunc func(string) ([]net.IP, error)
 func SeedFromDNS(chainParams *chaincfg.Params, reqServices wire.ServiceFlag,
 	lookupFn LookupFunc, seedFn OnSeed) {
 
-	for _, dnsseed := range chainParams.DNSSeeds {
+	SeedFromDNSSeeds(chainParams.DNSSeeds, chainParams.DefaultPort,
+		reqServices, lookupFn, seedFn)
+}
+
+// SeedFromDNSSeeds queries the passed DNS seeds to populate the address
+// manager with peers listening on the passed default port.
+func SeedFromDNSSeeds(dnsSeeds []chaincfg.DNSSeed, defaultPort string,
+	reqServices wire.ServiceFlag, lookupFn LookupFunc, seedFn OnSeed) {
+
+	// if this errors then we have *real* problems
+	intPort, _ := strconv.Atoi(defaultPort)
+
+	for _, dnsseed := range dnsSeeds {
 		var host string
 		if !dnsseed.HasFiltering || reqServices == wire.SFNodeNetwork {
 			host = dnsseed.Host
@@ -57,19 +77,106 @@ func SeedFromDNS(chainParams *chaincfg.Params, reqServices wire.ServiceFlag,
 				return
 			}
 			addresses := make([]*wire.NetAddress, len(seedpeers))
-			// if this errors then we have *real* problems
-			intPort, _ := strconv.Atoi(chainParams.DefaultPort)
 			for i, peer := range seedpeers {
-				addresses[i] = wire.NewNetAddressTimestamp(
-					// bitcoind seeds with addresses from
-					// a time randomly selected between 3
-					// and 7 days ago.
-					time.Now().Add(-1*time.Second*time.Duration(secondsIn3Days+
-						randSource.Int31n(secondsIn4Days))),
-					reqServices, peer, uint16(intPort))
+				addresses[i] = newSeedAddress(randSource, peer,
+					uint16(intPort), reqServices)
 			}
 
 			seedFn(addresses)
 		}(host)
 	}
 }
+
+// SeedFromHTTPS fetches the list of peer addresses served at the passed HTTPS
+// URL with the passed client to populate the address manager with peers.
+//
+// The list holds one peer per line as a host or a host and port, with the
+// passed default port used when it is omitted.  Hosts which are not IP
+// addresses are resolved with the passed lookup function.  Empty lines and
+// lines starting with # are ignored.
+func SeedFromHTTPS(url string, client *http.Client, defaultPort string,
+	reqServices wire.ServiceFlag, lookupFn LookupFunc, seedFn OnSeed) {
+
+	go func() {
+		addresses, err := fetchSeedList(url, client, defaultPort,
+			reqServices, lookupFn)
+		if err != nil {
+			log.Infof("HTTPS discovery failed on seed %s: %v", url, err)
+			return
+		}
+
+		log.Infof("%d addresses found from HTTPS seed %s", len(addresses),
+			url)
+
+		if len(addresses) == 0 {
+			return
+		}
+		seedFn(addresses)
+	}()
+}
+
+// fetchSeedList fetches and parses the list of peer addresses served at the
+// passed URL.  See SeedFromHTTPS for the format of the list.
+func fetchSeedList(url string, client *http.Client, defaultPort string,
+	reqServices wire.ServiceFlag, lookupFn LookupFunc) ([]*wire.NetAddress, error) {
+
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	randSource := mrand.New(mrand.NewSource(time.Now().UnixNano()))
+	var addresses []*wire.NetAddress
+	scanner := bufio.NewScanner(io.LimitReader(resp.Body, maxSeedListSize))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		host, portStr, err := net.SplitHostPort(line)
+		if err != nil {
+			host, portStr = line, defaultPort
+		}
+		port, err := strconv.ParseUint(portStr, 10, 16)
+		if err != nil {
+			log.Debugf("Invalid port in entry %q of HTTPS seed %s", line,
+				url)
+			continue
+		}
+
+		ips := []net.IP{net.ParseIP(host)}
+		if ips[0] == nil {
+			ips, err = lookupFn(host)
+			if err != nil {
+				log.Debugf("Unable to resolve entry %q of HTTPS seed "+
+					"%s: %v", line, url, err)
+				continue
+			}
+		}
+		for _, ip := range ips {
+			addresses = append(addresses, newSeedAddress(randSource, ip,
+				uint16(port), reqServices))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+// newSeedAddress returns the address of a peer discovered through a seed.
+func newSeedAddress(randSource *mrand.Rand, ip net.IP, port uint16,
+	services wire.ServiceFlag) *wire.NetAddress {
+
+	// bitcoind seeds with addresses from a time randomly selected between
+	// 3 and 7 days ago.
+	return wire.NewNetAddressTimestamp(
+		time.Now().Add(-1*time.Second*time.Duration(secondsIn3Days+
+			randSource.Int31n(secondsIn4Days))),
+		services, ip, port)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package connmgr
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/wire"
+)
+
+// TestSeedFromHTTPS ensures the list of peer addresses served by an HTTPS seed
+// is parsed with the default port and hostnames resolved.
+func TestSeedFromHTTPS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, "# seed list\n\n"+
+				"10.0.0.1\n"+
+				"10.0.0.2:18444\n"+
+				"[fd00::1]:18445\n"+
+				"seed.example.com\n"+
+				"unknown.example.com\n"+
+				"10.0.0.3:badport\n")
+		}))
+	defer server.Close()
+
+	lookup := func(host string) ([]net.IP, error) {
+		if host == "seed.example.com" {
+			return []net.IP{net.ParseIP("10.0.0.4")}, nil
+		}
+		return nil, fmt.Errorf("unknown host %s", host)
+	}
+	seeded := make(chan []*wire.NetAddress, 1)
+	SeedFromHTTPS(server.URL, server.Client(), "8333", wire.SFNodeNetwork,
+		lookup, func(addrs []*wire.NetAddress) {
+			seeded <- addrs
+		})
+
+	var addrs []*wire.NetAddress
+	select {
+	case addrs = <-seeded:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for seeded addresses")
+	}
+
+	want := []string{"10.0.0.1:8333", "10.0.0.2:18444", "[fd00::1]:18445",
+		"10.0.0.4:8333"}
+	if len(addrs) != len(want) {
+		t.Fatalf("got %d addresses, want %d", len(addrs), len(want))
+	}
+	for i, addr := range addrs {
+		hostPort := net.JoinHostPort(addr.IP.String(),
+			fmt.Sprint(addr.Port))
+		if hostPort != want[i] {
+			t.Fatalf("address %d is %s, want %s", i, hostPort, want[i])
+		}
+		if addr.Services != wire.SFNodeNetwork {
+			t.Fatalf("address %d has services %v", i, addr.Services)
+		}
+	}
+}
+
+// TestSeedFromHTTPSStatus ensures nothing is seeded when the seed list can't
+// be fetched.
+func TestSeedFromHTTPSStatus(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+
+	_, err := fetchSeedList(server.URL, server.Client(), "8333",
+		wire.SFNodeNetwork, net.LookupIP)
+	if err == nil {
+		t.Fatal("seed list fetched despite a not found status")
+	}
+}
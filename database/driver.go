@@ -27,6 +27,11 @@ type Driver struct {
 	// ErrDbDoesNotExist if the database has not already been created.
 	Open func(args ...interface{}) (DB, error)
 
+	// OpenReadOnly is the function that will be invoked with all
+	// user-specified arguments to open the database without modifying it.
+	// It has the same requirements as Open and is optional.
+	OpenReadOnly func(args ...interface{}) (DB, error)
+
 	// UseLogger uses a specified Logger to output package logging info.
 	UseLogger func(logger bchlog.Logger)
 }
@@ -87,3 +92,24 @@ func Open(dbType string, args ...interface{}) (DB, error) {
 
 	return drv.Open(args...)
 }
+
+// OpenReadOnly opens an existing database for the specified type without
+// modifying it.  Transactions on the returned database are read-only.  The
+// arguments are the same as those of Open.
+//
+// ErrDbUnknownType will be returned if the database type is not registered and
+// ErrDriverSpecific if its driver can't open databases read-only.
+func OpenReadOnly(dbType string, args ...interface{}) (DB, error) {
+	drv, exists := drivers[dbType]
+	if !exists {
+		str := fmt.Sprintf("driver %q is not registered", dbType)
+		return nil, makeError(ErrDbUnknownType, str, nil)
+	}
+	if drv.OpenReadOnly == nil {
+		str := fmt.Sprintf("driver %q does not support opening "+
+			"databases read-only", dbType)
+		return nil, makeError(ErrDriverSpecific, str, nil)
+	}
+
+	return drv.OpenReadOnly(args...)
+}
@@ -18,6 +18,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/gcash/bchd/chaincfg/chainhash"
 	"github.com/gcash/bchd/database"
@@ -169,6 +170,12 @@ type blockStore struct {
 	fileNumToLRUElem map[uint32]*list.Element
 	openBlockFiles   map[uint32]*lockableFile
 
+	// fileHits and fileMisses are the number of block file reads which
+	// found the file open and which had to open it.  They are accessed
+	// atomically.
+	fileHits   atomic.Uint64
+	fileMisses atomic.Uint64
+
 	// writeCursor houses the state for the current file and location that
 	// new blocks are written to.
 	writeCursor *writeCursor
@@ -332,6 +339,7 @@ func (s *blockStore) blockFile(fileNum uint32) (*lockableFile, error) {
 		obf := wc.curFile
 		obf.RLock()
 		wc.RUnlock()
+		s.fileHits.Add(1)
 		return obf, nil
 	}
 	wc.RUnlock()
@@ -345,6 +353,7 @@ func (s *blockStore) blockFile(fileNum uint32) (*lockableFile, error) {
 
 		obf.RLock()
 		s.obfMutex.RUnlock()
+		s.fileHits.Add(1)
 		return obf, nil
 	}
 	s.obfMutex.RUnlock()
@@ -356,6 +365,7 @@ func (s *blockStore) blockFile(fileNum uint32) (*lockableFile, error) {
 	if obf, ok := s.openBlockFiles[fileNum]; ok {
 		obf.RLock()
 		s.obfMutex.Unlock()
+		s.fileHits.Add(1)
 		return obf, nil
 	}
 
@@ -368,6 +378,7 @@ func (s *blockStore) blockFile(fileNum uint32) (*lockableFile, error) {
 	}
 	obf.RLock()
 	s.obfMutex.Unlock()
+	s.fileMisses.Add(1)
 	return obf, nil
 }
 
@@ -836,7 +847,7 @@ func (s *blockStore) loadLastBlockHeights(dbPath string) error {
 		if err != nil {
 			break
 		}
-		file, err := os.OpenFile(filePath, os.O_RDWR|os.O_CREATE, 0666)
+		file, err := os.Open(filePath)
 		if err != nil {
 			return err
 		}
@@ -1330,6 +1330,36 @@ func (tx *transaction) FetchBlockHeaders(hashes []chainhash.Hash) ([][]byte, err
 	return tx.FetchBlockRegions(regions)
 }
 
+// FetchBlockSize returns the size of the serialized block identified by the
+// given hash.  It is read from the block index without loading the block.
+//
+// Returns the following errors as required by the interface contract:
+//   - ErrBlockNotFound if the requested block hash does not exist
+//   - ErrTxClosed if the transaction has already been closed
+//
+// This function is part of the database.Tx interface implementation.
+func (tx *transaction) FetchBlockSize(hash *chainhash.Hash) (uint32, error) {
+	// Ensure transaction state is valid.
+	if err := tx.checkClosed(); err != nil {
+		return 0, err
+	}
+
+	// When the block is pending to be written on commit return the size
+	// from there.
+	if idx, exists := tx.pendingBlocks[*hash]; exists {
+		return uint32(len(tx.pendingBlockData[idx].bytes)), nil
+	}
+
+	// The stored block length includes the network, block length and
+	// checksum fields which surround the serialized block in the files.
+	blockRow, err := tx.fetchBlockRow(hash)
+	if err != nil {
+		return 0, err
+	}
+	location := deserializeBlockLoc(blockRow)
+	return location.blockLen - 12, nil
+}
+
 // FetchBlock returns the raw serialized bytes for the block identified by the
 // given hash.  The raw bytes are in the format returned by Serialize on a
 // wire.MsgBlock.
@@ -1787,10 +1817,11 @@ type db struct {
 	closed    bool         // Is the database closed?
 	store     *blockStore  // Handles read/writing blocks to flat files.
 	cache     *dbCache     // Cache layer which wraps underlying leveldb DB.
+	readOnly  bool         // Was the database opened read-only?
 }
 
-// Enforce db implements the database.DB interface.
-var _ database.DB = (*db)(nil)
+// Enforce db implements the database.StatsDB interface.
+var _ database.StatsDB = (*db)(nil)
 
 // Type returns the database driver type the current database instance was
 // created with.
@@ -1807,6 +1838,12 @@ func (db *db) Type() string {
 // which is used by the managed transaction code while the database method
 // returns the interface.
 func (db *db) begin(writable bool) (*transaction, error) {
+	if writable && db.readOnly {
+		str := "cannot begin a writable transaction on a database " +
+			"opened read-only"
+		return nil, makeDbErr(database.ErrTxNotWritable, str, nil)
+	}
+
 	// Make sure there is enough available disk space so we can inform the
 	// user of the problem instead of causing a db failure.
 	if writable {
@@ -2013,6 +2050,83 @@ func (db *db) Close() error {
 	return closeErr
 }
 
+// Stats returns the current statistics of the database cache, the block files
+// and the underlying leveldb database.
+//
+// This function is part of the database.StatsDB interface implementation.
+func (db *db) Stats() (*database.Stats, error) {
+	db.closeLock.RLock()
+	defer db.closeLock.RUnlock()
+
+	if db.closed {
+		return nil, makeDbErr(database.ErrDbNotOpen, errDbNotOpenStr, nil)
+	}
+
+	cache := db.cache
+	cache.cacheLock.RLock()
+	cachedKeys, cachedRemove := cache.cachedKeys, cache.cachedRemove
+	cache.cacheLock.RUnlock()
+
+	store := db.store
+	store.obfMutex.RLock()
+	openBlockFiles := len(store.openBlockFiles)
+	store.obfMutex.RUnlock()
+	wc := store.writeCursor
+	wc.RLock()
+	if wc.curFile.file != nil {
+		openBlockFiles++
+	}
+	wc.RUnlock()
+
+	var ldbStats leveldb.DBStats
+	if err := cache.ldb.Stats(&ldbStats); err != nil {
+		return nil, convertErr("failed to read leveldb statistics", err)
+	}
+
+	stats := &database.Stats{
+		CacheSize:         cachedKeys.Size() + cachedRemove.Size(),
+		CacheMaxSize:      cache.maxSize.Load(),
+		CacheEntries:      cachedKeys.Len() + cachedRemove.Len(),
+		CacheHits:         cache.hits.Load(),
+		CacheMisses:       cache.misses.Load(),
+		OpenBlockFiles:    openBlockFiles,
+		MaxOpenBlockFiles: maxOpenFiles + 1,
+		BlockFileHits:     store.fileHits.Load(),
+		BlockFileMisses:   store.fileMisses.Load(),
+		OpenTables:        ldbStats.OpenedTablesCount,
+		BlockCacheSize:    ldbStats.BlockCacheSize,
+		IORead:            ldbStats.IORead,
+		IOWrite:           ldbStats.IOWrite,
+		Levels:            make([]database.LevelStats, 0, len(ldbStats.LevelSizes)),
+	}
+	for i := range ldbStats.LevelSizes {
+		stats.Levels = append(stats.Levels, database.LevelStats{
+			Tables:          ldbStats.LevelTablesCounts[i],
+			Size:            ldbStats.LevelSizes[i],
+			CompactionTime:  ldbStats.LevelDurations[i],
+			CompactionRead:  ldbStats.LevelRead[i],
+			CompactionWrite: ldbStats.LevelWrite[i],
+		})
+	}
+	return stats, nil
+}
+
+// SetCacheMaxSize sets the size in bytes at which the database cache is flushed
+// to the underlying leveldb database.
+//
+// This function is part of the database.StatsDB interface implementation.
+func (db *db) SetCacheMaxSize(size uint64) error {
+	db.closeLock.RLock()
+	defer db.closeLock.RUnlock()
+
+	if db.closed {
+		return makeDbErr(database.ErrDbNotOpen, errDbNotOpenStr, nil)
+	}
+
+	db.cache.maxSize.Store(size)
+	return nil
+}
+
 // filesExists reports whether the named file or directory exists.
 func fileExists(name string) bool {
 	if _, err := os.Stat(name); err != nil {
@@ -2054,7 +2168,9 @@ func initDB(ldb *leveldb.DB) error {
 
 // openDB opens the database at the provided path.  database.ErrDbDoesNotExist
 // is returned if the database doesn't exist and the create flag is not set.
-func openDB(dbPath string, network wire.BitcoinNet, create bool, cacheSize uint64, flushSecs uint32) (database.DB, error) {
+// Neither the metadata nor the block files are modified when the read-only flag
+// is set.
+func openDB(dbPath string, network wire.BitcoinNet, create, readOnly bool, cacheSize uint64, flushSecs uint32) (database.DB, error) {
 	// Error if the database doesn't exist and the create flag is not set.
 	metadataDbPath := filepath.Join(dbPath, metadataDbName)
 	dbExists := fileExists(metadataDbPath)
@@ -2074,6 +2190,7 @@ func openDB(dbPath string, network wire.BitcoinNet, create bool, cacheSize uint6
 	// Open the metadata database (will create it if needed).
 	opts := opt.Options{
 		ErrorIfExist: create,
+		ReadOnly:     readOnly,
 		Strict:       opt.DefaultStrict,
 		Compression:  opt.NoCompression,
 		Filter:       filter.NewBloomFilter(10),
@@ -2099,7 +2216,7 @@ func openDB(dbPath string, network wire.BitcoinNet, create bool, cacheSize uint6
 		flushSecs = defaultFlushSecs
 	}
 	cache := newDbCache(ldb, store, cacheSize, flushSecs)
-	pdb := &db{store: store, cache: cache}
+	pdb := &db{store: store, cache: cache, readOnly: readOnly}
 
 	// Perform any reconciliation needed between the block and metadata as
 	// well as database initialization, if needed.
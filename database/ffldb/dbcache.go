@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/btcsuite/goleveldb/leveldb"
@@ -285,6 +286,7 @@ func (iter *dbCacheIterator) Error() error {
 // dbCacheSnapshot defines a snapshot of the database cache and underlying
 // database at a particular point in time.
 type dbCacheSnapshot struct {
+	cache         *dbCache
 	dbSnapshot    *leveldb.Snapshot
 	pendingKeys   *treap.Immutable
 	pendingRemove *treap.Immutable
@@ -294,13 +296,16 @@ type dbCacheSnapshot struct {
 func (snap *dbCacheSnapshot) Has(key []byte) bool {
 	// Check the cached entries first.
 	if snap.pendingRemove.Has(key) {
+		snap.cache.hits.Add(1)
 		return false
 	}
 	if snap.pendingKeys.Has(key) {
+		snap.cache.hits.Add(1)
 		return true
 	}
 
 	// Consult the database.
+	snap.cache.misses.Add(1)
 	hasKey, _ := snap.dbSnapshot.Has(key, nil)
 	return hasKey
 }
@@ -310,13 +315,16 @@ func (snap *dbCacheSnapshot) Has(key []byte) bool {
 func (snap *dbCacheSnapshot) Get(key []byte) []byte {
 	// Check the cached entries first.
 	if snap.pendingRemove.Has(key) {
+		snap.cache.hits.Add(1)
 		return nil
 	}
 	if value := snap.pendingKeys.Get(key); value != nil {
+		snap.cache.hits.Add(1)
 		return value
 	}
 
 	// Consult the database.
+	snap.cache.misses.Add(1)
 	value, err := snap.dbSnapshot.Get(key, nil)
 	if err != nil {
 		return nil
@@ -375,8 +383,9 @@ type dbCache struct {
 	// conjunction with the current time and the flush interval.
 	//
 	// NOTE: These flush related fields are protected by the database write
-	// lock.
-	maxSize       uint64
+	// lock, except maxSize which is accessed atomically so it can be read
+	// and changed outside of write transactions.
+	maxSize       atomic.Uint64
 	flushInterval time.Duration
 	lastFlush     time.Time
 
@@ -389,6 +398,12 @@ type dbCache struct {
 	cacheLock    sync.RWMutex
 	cachedKeys   *treap.Immutable
 	cachedRemove *treap.Immutable
+
+	// hits and misses are the number of reads which were served by the
+	// cached entries and by the underlying database.  They are accessed
+	// atomically.
+	hits   atomic.Uint64
+	misses atomic.Uint64
 }
 
 // Snapshot returns a snapshot of the database cache and underlying database at
@@ -407,6 +422,7 @@ func (c *dbCache) Snapshot() (*dbCacheSnapshot, error) {
 	// which is used to atomically swap the root.
 	c.cacheLock.RLock()
 	cacheSnapshot := &dbCacheSnapshot{
+		cache:         c,
 		dbSnapshot:    dbSnapshot,
 		pendingKeys:   c.cachedKeys,
 		pendingRemove: c.cachedRemove,
@@ -545,7 +561,7 @@ func (c *dbCache) needsFlush(tx *transaction) bool {
 	snap := tx.snapshot
 	totalSize := snap.pendingKeys.Size() + snap.pendingRemove.Size()
 	totalSize = uint64(float64(totalSize) * 1.5)
-	return totalSize > c.maxSize
+	return totalSize > c.maxSize.Load()
 }
 
 // commitTx atomically adds all of the pending keys to add and remove into the
@@ -648,13 +664,14 @@ func (c *dbCache) Close() error {
 // exceeds the provided value or it has been longer than the provided interval
 // since the last flush.
 func newDbCache(ldb *leveldb.DB, store *blockStore, maxSize uint64, flushIntervalSecs uint32) *dbCache {
-	return &dbCache{
+	cache := &dbCache{
 		ldb:           ldb,
 		store:         store,
-		maxSize:       maxSize,
 		flushInterval: time.Second * time.Duration(flushIntervalSecs),
 		lastFlush:     time.Now(),
 		cachedKeys:    treap.NewImmutable(),
 		cachedRemove:  treap.NewImmutable(),
 	}
+	cache.maxSize.Store(maxSize)
+	return cache
 }
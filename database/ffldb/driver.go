@@ -67,7 +67,18 @@ func openDBDriver(args ...interface{}) (database.DB, error) {
 		return nil, err
 	}
 
-	return openDB(dbPath, network, false, cacheSize, flushSecs)
+	return openDB(dbPath, network, false, false, cacheSize, flushSecs)
+}
+
+// openReadOnlyDBDriver is the callback provided during driver registration that
+// opens an existing database without modifying it.
+func openReadOnlyDBDriver(args ...interface{}) (database.DB, error) {
+	dbPath, network, cacheSize, flushSecs, err := parseArgs("OpenReadOnly", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return openDB(dbPath, network, false, true, cacheSize, flushSecs)
 }
 
 // createDBDriver is the callback provided during driver registration that
@@ -78,7 +89,7 @@ func createDBDriver(args ...interface{}) (database.DB, error) {
 		return nil, err
 	}
 
-	return openDB(dbPath, network, true, cacheSize, flushSecs)
+	return openDB(dbPath, network, true, false, cacheSize, flushSecs)
 }
 
 // useLogger is the callback provided during driver registration that sets the
@@ -90,10 +101,11 @@ func useLogger(logger bchlog.Logger) {
 func init() {
 	// Register the driver.
 	driver := database.Driver{
-		DbType:    dbType,
-		Create:    createDBDriver,
-		Open:      openDBDriver,
-		UseLogger: useLogger,
+		DbType:       dbType,
+		Create:       createDBDriver,
+		Open:         openDBDriver,
+		OpenReadOnly: openReadOnlyDBDriver,
+		UseLogger:    useLogger,
 	}
 	if err := database.RegisterDriver(driver); err != nil {
 		panic(fmt.Sprintf("Failed to regiser database driver '%s': %v",
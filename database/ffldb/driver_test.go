@@ -5,6 +5,7 @@
 package ffldb_test
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -254,6 +255,63 @@ func TestPersistence(t *testing.T) {
 	}
 }
 
+// TestOpenReadOnly ensures a database opened read-only can be read but not
+// written.
+func TestOpenReadOnly(t *testing.T) {
+	t.Parallel()
+
+	// Opening a database which doesn't exist must fail.
+	dbPath := filepath.Join(os.TempDir(), "ffldb-openreadonlytest")
+	_ = os.RemoveAll(dbPath)
+	_, err := database.OpenReadOnly(dbType, dbPath, blockDataNet)
+	if !checkDbError(t, "OpenReadOnly", err, database.ErrDbDoesNotExist) {
+		return
+	}
+
+	// Create a new database with a stored value.
+	db, err := database.Create(dbType, dbPath, blockDataNet)
+	if err != nil {
+		t.Errorf("Failed to create test database (%s) %v", dbType, err)
+		return
+	}
+	defer os.RemoveAll(dbPath)
+	key, value := []byte("key"), []byte("value")
+	err = db.Update(func(tx database.Tx) error {
+		return tx.Metadata().Put(key, value)
+	})
+	if err != nil {
+		t.Errorf("Update: unexpected error: %v", err)
+		return
+	}
+	db.Close()
+
+	db, err = database.OpenReadOnly(dbType, dbPath, blockDataNet)
+	if err != nil {
+		t.Errorf("Failed to open test database read-only (%s) %v",
+			dbType, err)
+		return
+	}
+	defer db.Close()
+
+	err = db.View(func(tx database.Tx) error {
+		if gotVal := tx.Metadata().Get(key); !bytes.Equal(gotVal, value) {
+			return fmt.Errorf("Get: got %s, want %s", gotVal, value)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("View: unexpected error: %v", err)
+		return
+	}
+
+	err = db.Update(func(tx database.Tx) error {
+		return tx.Metadata().Put(key, []byte("other"))
+	})
+	if !checkDbError(t, "Update", err, database.ErrTxNotWritable) {
+		return
+	}
+}
+
 // TestInterface performs all interfaces tests for this database driver.
 func TestInterface(t *testing.T) {
 	t.Parallel()
@@ -286,3 +344,80 @@ func TestInterface(t *testing.T) {
 		testInterface(t, db)
 	})
 }
+
+// TestStats ensures the statistics of the database account for reads served by
+// the cache and the block files and that the cache size can be changed.
+func TestStats(t *testing.T) {
+	t.Parallel()
+
+	// Create a new database to run tests against.
+	dbPath := filepath.Join(os.TempDir(), "ffldb-statstest")
+	_ = os.RemoveAll(dbPath)
+	db, err := database.Create(dbType, dbPath, blockDataNet)
+	if err != nil {
+		t.Fatalf("Failed to create test database (%s) %v", dbType, err)
+	}
+	defer os.RemoveAll(dbPath)
+	defer db.Close()
+
+	statsDB, ok := db.(database.StatsDB)
+	if !ok {
+		t.Fatal("database does not implement database.StatsDB")
+	}
+
+	// Store a key and a block and read them back along with a key which
+	// isn't cached.
+	genesisBlock := bchutil.NewBlock(chaincfg.MainNetParams.GenesisBlock)
+	err = db.Update(func(tx database.Tx) error {
+		if err := tx.Metadata().Put([]byte("key"), []byte("value")); err != nil {
+			return err
+		}
+		return tx.StoreBlock(genesisBlock)
+	})
+	if err != nil {
+		t.Fatalf("Update: unexpected error: %v", err)
+	}
+	err = db.View(func(tx database.Tx) error {
+		tx.Metadata().Get([]byte("key"))
+		tx.Metadata().Get([]byte("missing"))
+		_, err := tx.FetchBlock(chaincfg.MainNetParams.GenesisHash)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("View: unexpected error: %v", err)
+	}
+
+	stats, err := statsDB.Stats()
+	if err != nil {
+		t.Fatalf("Stats: unexpected error: %v", err)
+	}
+	if stats.CacheEntries == 0 || stats.CacheSize == 0 {
+		t.Fatalf("Stats: cache is empty - %d entries of %d bytes",
+			stats.CacheEntries, stats.CacheSize)
+	}
+	if stats.CacheHits == 0 || stats.CacheMisses == 0 {
+		t.Fatalf("Stats: unexpected cache reads - %d hits and %d "+
+			"misses", stats.CacheHits, stats.CacheMisses)
+	}
+	if stats.BlockFileHits == 0 || stats.OpenBlockFiles != 1 {
+		t.Fatalf("Stats: unexpected block files - %d hits with %d "+
+			"open", stats.BlockFileHits, stats.OpenBlockFiles)
+	}
+
+	if err := statsDB.SetCacheMaxSize(1 << 20); err != nil {
+		t.Fatalf("SetCacheMaxSize: unexpected error: %v", err)
+	}
+	stats, err = statsDB.Stats()
+	if err != nil {
+		t.Fatalf("Stats: unexpected error: %v", err)
+	}
+	if stats.CacheMaxSize != 1<<20 {
+		t.Fatalf("Stats: cache max size is %d, want %d",
+			stats.CacheMaxSize, 1<<20)
+	}
+
+	// Ensure the statistics are unavailable once the database is closed.
+	db.Close()
+	_, err = statsDB.Stats()
+	checkDbError(t, "Stats", err, database.ErrDbNotOpen)
+}
@@ -1280,6 +1280,20 @@ func testFetchBlockIO(tc *testContext, tx database.Tx) bool {
 			return false
 		}
 
+		// Ensure the block size fetched from the database matches the
+		// expected size.
+		gotSize, err := tx.FetchBlockSize(blockHash)
+		if err != nil {
+			tc.t.Errorf("FetchBlockSize(%s): unexpected error: %v",
+				blockHash, err)
+			return false
+		}
+		if gotSize != uint32(len(blockBytes)) {
+			tc.t.Errorf("FetchBlockSize(%s): size mismatch: got %d, "+
+				"want %d", blockHash, gotSize, len(blockBytes))
+			return false
+		}
+
 		// Ensure the first transaction fetched as a block region from
 		// the database matches the expected bytes.
 		region := database.BlockRegion{
@@ -1341,6 +1355,15 @@ func testFetchBlockIO(tc *testContext, tx database.Tx) bool {
 			return false
 		}
 
+		// Ensure fetching the size of a block that doesn't exist
+		// returns the expected error.
+		testName = fmt.Sprintf("FetchBlockSize(%s) invalid block",
+			badBlockHash)
+		_, err = tx.FetchBlockSize(badBlockHash)
+		if !checkDbError(tc.t, testName, err, wantErrCode) {
+			return false
+		}
+
 		// Ensure fetching a block region in a block that doesn't exist
 		// return the expected error.
 		testName = fmt.Sprintf("FetchBlockRegion(%s) invalid hash",
@@ -1873,6 +1896,13 @@ func testClosedTxInterface(tc *testContext, tx database.Tx) bool {
 			return false
 		}
 
+		// Ensure FetchBlockSize returns expected error.
+		testName = fmt.Sprintf("FetchBlockSize #%d on closed tx", i)
+		_, err = tx.FetchBlockSize(blockHash)
+		if !checkDbError(tc.t, testName, err, wantErrCode) {
+			return false
+		}
+
 		// Ensure the first transaction fetched as a block region from
 		// the database returns the expected error.
 		region := database.BlockRegion{
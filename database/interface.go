@@ -310,6 +310,15 @@ type Tx interface {
 	// implementations.
 	FetchBlockHeaders(hashes []chainhash.Hash) ([][]byte, error)
 
+	// FetchBlockSize returns the size of the serialized block identified
+	// by the given hash without loading it.
+	//
+	// The interface contract guarantees at least the following errors will
+	// be returned (other implementation-specific errors are possible):
+	//   - ErrBlockNotFound if the requested block hash does not exist
+	//   - ErrTxClosed if the transaction has already been closed
+	FetchBlockSize(hash *chainhash.Hash) (uint32, error)
+
 	// FetchBlock returns the raw serialized bytes for the block identified
 	// by the given hash.  The raw bytes are in the format returned by
 	// Serialize on a wire.MsgBlock.
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package database
+
+import "time"
+
+// LevelStats describes a level of the tables of the metadata store of a
+// database along with the compactions performed on it.
+type LevelStats struct {
+	Tables          int
+	Size            int64
+	CompactionTime  time.Duration
+	CompactionRead  int64
+	CompactionWrite int64
+}
+
+// Stats describes the caches and the storage of a database.
+type Stats struct {
+	// CacheSize is the size in bytes of the metadata entries held by the
+	// write cache and CacheMaxSize is the size at which it is flushed.
+	CacheSize    uint64
+	CacheMaxSize uint64
+
+	// CacheEntries is the number of metadata entries held by the write
+	// cache.
+	CacheEntries int
+
+	// CacheHits and CacheMisses are the number of metadata reads served
+	// by the write cache and by the metadata store since the database was
+	// opened.
+	CacheHits   uint64
+	CacheMisses uint64
+
+	// OpenBlockFiles is the number of block files with an open handle and
+	// MaxOpenBlockFiles is the maximum number of such files.
+	OpenBlockFiles    int
+	MaxOpenBlockFiles int
+
+	// BlockFileHits and BlockFileMisses are the number of block file reads
+	// which found the file open and which had to open it since the
+	// database was opened.
+	BlockFileHits   uint64
+	BlockFileMisses uint64
+
+	// OpenTables is the number of table files of the metadata store with
+	// an open handle and BlockCacheSize is the size in bytes of its cache
+	// of table blocks.
+	OpenTables     int
+	BlockCacheSize int
+
+	// IORead and IOWrite are the number of bytes read and written by the
+	// metadata store since the database was opened.
+	IORead  uint64
+	IOWrite uint64
+
+	// Levels describes the levels of the metadata store which hold tables
+	// or were compacted, from the lowest to the highest.  Levels which
+	// neither hold tables nor were compacted are omitted.
+	Levels []LevelStats
+}
+
+// StatsDB is a DB which reports statistics about its caches and storage and
+// allows resizing its write cache at runtime.  Drivers may implement it in
+// addition to DB, so callers must check whether a DB implements it.
+type StatsDB interface {
+	DB
+
+	// Stats returns the current statistics of the database.
+	//
+	// The interface contract guarantees at least the following errors will
+	// be returned (other implementation-specific errors are possible):
+	//   - ErrDbNotOpen if the database is not open
+	Stats() (*Stats, error)
+
+	// SetCacheMaxSize sets the size in bytes at which the write cache is
+	// flushed.  The cache is flushed with the next write transaction when
+	// it already exceeds the new size.
+	//
+	// The interface contract guarantees at least the following errors will
+	// be returned (other implementation-specific errors are possible):
+	//   - ErrDbNotOpen if the database is not open
+	SetCacheMaxSize(size uint64) error
+}
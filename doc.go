@@ -36,17 +36,35 @@ Application Options:
 	    --listen=             Add an interface/port to listen for connections
 	                          (default all interfaces port: 8333, testnet: 18333)
 	    --maxpeers=           Max number of inbound and outbound peers (125)
+	    --blockrelaypeers=    Number of additional outbound block-relay-only
+	                          connections to maintain (2)
 	    --nobanning           Disable banning of misbehaving peers
 	    --banduration=        How long to ban misbehaving peers.  Valid time units
 	                          are {s, m, h}.  Minimum 1 second (24h0m0s)
 	    --banthreshold=       Maximum allowed ban score before disconnecting and
 	                          banning misbehaving peers.
-	    --whitelist=          Add an IP network or IP that will not be banned.
-	                          (eg. 192.168.1.0/24 or ::1)
+	    --whitelist=          Add an IP network or IP that will not be banned,
+	                          optionally prefixed by the comma separated
+	                          permissions granted to its peers and '@' (noban,
+	                          relay, forcerelay, mempool, download or all).
+	                          (eg. 192.168.1.0/24, ::1 or noban,mempool@10.0.0.1)
+	    --auditmessages       Log the messages received from peers which approach
+	                          the protocol limits, such as large addr batches,
+	                          inv lists and transactions, to spot
+	                          interoperability issues with other implementations
+	    --auditthreshold=     Percentage of the protocol limit at which a message
+	                          is logged by --auditmessages (90)
+	    --auditreject         Reject the messages logged by --auditmessages and
+	                          disconnect the peers sending them
 	-u, --rpcuser=            Username for RPC connections
 	-P, --rpcpass=            Password for RPC connections
 	    --rpclimituser=       Username for limited RPC connections
 	    --rpclimitpass=       Password for limited RPC connections
+	    --rpcaccount=         Add an RPC account in the form
+	                          <user>:<pass>,methods=<method> <method>...
+	                          [,allowip=<ip or net> ...] -- The method list may
+	                          include * for all methods and @limited for the
+	                          methods of the limited user
 	    --rpclisten=          Add an interface/port to listen for RPC connections
 	                          (default port: 8334, testnet: 18334)
 	    --rpccert=            File containing the certificate file
@@ -57,12 +75,30 @@ Application Options:
 	    --rpcquirks           Mirror some JSON-RPC quirks of Bitcoin Core -- NOTE:
 	                          Discouraged unless interoperability issues need to
 	                          be worked around
+	    --publicrpc           Serve a small set of read-only RPC methods
+	                          (getblock, getrawtransaction, getblockchaininfo,
+	                          ...) to clients without credentials, subject to a
+	                          per client rate limit
+	    --publicrpcrate=      The number of requests per second each client may
+	                          make without credentials (2)
+	    --publicrpcburst=     The number of requests each client may make in a
+	                          burst without credentials (10)
 	    --norpc               Disable built-in RPC server -- NOTE: The RPC server
-	                          is disabled by default if no rpcuser/rpcpass or
-	                          rpclimituser/rpclimitpass is specified
+	                          is disabled by default if no rpcuser/rpcpass,
+	                          rpclimituser/rpclimitpass or rpcaccount is
+	                          specified and publicrpc is not set
 	    --notls               Disable TLS for the RPC server -- NOTE: This is only
 	                          allowed if the RPC server is bound to localhost
 	    --nodnsseed           Disable DNS seeding for peers
+	    --adddnsseed=         Add a DNS seed to query for peers in addition to the
+	                          seeds of the network -- may be specified multiple
+	                          times
+	    --httpseed=           Add an HTTPS URL serving a list of peers, one host or
+	                          host:port per line, to query for peers along with
+	                          the DNS seeds -- may be specified multiple times
+	    --reseedinterval=     Query the seeds for peers again at this interval
+	                          while more addresses are needed -- 0 to only query
+	                          them on startup.  Valid time units are {s, m, h}
 	    --externalip=         Add an ip to the list of local addresses we claim to
 	                          listen on to peers
 	    --proxy=              Connect via SOCKS5 proxy (eg. 127.0.0.1:9050)
@@ -78,12 +114,26 @@ Application Options:
 	    --testnet             Use the test network
 	    --regtest             Use the regression test network
 	    --simnet              Use the simulation test network
+	    --upgradetest=        Run a separate chain from the genesis block of the
+	                          selected network on which all of the upgrades
+	                          which are not active yet activate at this Unix
+	                          time (median time past) -- 0 to disable
 	    --addcheckpoint=      Add a custom checkpoint.  Format: '<height>:<hash>'
 	    --nocheckpoints       Disable built-in checkpoints.  Don't do this unless
 	                          you know what you're doing.
+	    --checkpointurl=      Fetch signed checkpoint updates from this HTTPS URL
+	                          on start up -- requires --checkpointpubkey
+	    --checkpointpubkey=   Hex encoded public key trusted to sign checkpoint
+	                          updates -- may be specified multiple times
+	    --checkpointminsigs=  Number of signatures by distinct
+	                          --checkpointpubkey keys required to accept a
+	                          checkpoint update (1)
 	    --uacomment=          Comment to add to the user agent --
 	                          See BIP 14 for more information.
 	    --dbtype=             Database backend to use for the Block Chain (ffldb)
+	    --dbcheckonly         Report the database migrations which would be
+	                          applied on start up and then exit without
+	                          modifying the database
 	    --profile=            Enable HTTP profiling on given port -- NOTE port
 	                          must be between 1024 and 65536
 	    --cpuprofile=         Write CPU profile to the specified file
@@ -102,6 +152,11 @@ Application Options:
 	                          high priority for relaying
 	    --maxorphantx=        Max number of orphan transactions to keep in memory
 	                          (100)
+	    --maxmempool=         Max total size in megabytes of the transactions to
+	                          keep in the mempool, evicting the ones paying the
+	                          lowest fees beyond it -- 0 to disable (300)
+	    --nopersistmempool    Do not save the mempool to a file in the data
+	                          directory on shutdown and load it on startup
 	    --generate            Generate (mine) bitcoins using the CPU
 	    --miningaddr=         Add the specified payment address to the list of
 	                          addresses to use for generated blocks -- At least
@@ -113,10 +168,16 @@ Application Options:
 	                          a block (750000)
 	    --blockprioritysize=  Size in bytes for high-priority/low-fee transactions
 	                          when creating a block (50000)
+	    --blocklastminutefee= When getblocktemplate reuses the current block
+	                          template, add the transactions which arrived since
+	                          it was generated paying at least this fee in BCH/kB
+	                          to it -- 0 to disable
 	    --nopeerbloomfilters  Disable bloom filtering support.
 	    --nocfilters          Disable committed filtering (CF) support.
 	    --sigcachemaxsize=    The maximum number of entries in the signature
 	                          verification cache.
+	    --nolibsecp256k1      Do not verify signatures with libsecp256k1 when
+	                          bchd is built with the libsecp256k1 build tag.
 	    --blocksonly          Do not accept transactions from remote peers.
 	    --relaynonstd         Relay non-standard transactions regardless of the
 	                          default settings for the active network.
@@ -0,0 +1,91 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"sort"
+
+	"github.com/gcash/bchutil"
+)
+
+// backlogTx is a pool transaction considered by BacklogFeeRate.
+type backlogTx struct {
+	feeRate SatoshiPerByte
+
+	// cumSize is the total size of the transactions paying at least
+	// feeRate, this one included.
+	cumSize uint64
+}
+
+// backlogTxns returns the pool transactions sorted by descending fee rate.  The
+// sorted transactions are cached until the pool changes so estimates requested
+// in a row do not need to sort the whole pool again.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) backlogTxns() []backlogTx {
+	mp.backlogMtx.Lock()
+	defer mp.backlogMtx.Unlock()
+
+	if mp.backlogCache != nil && mp.backlogGeneration == mp.generation {
+		return mp.backlogCache
+	}
+
+	// The size of each transaction is stored in place of the total size
+	// until the sizes are accumulated once sorted.
+	txns := make([]backlogTx, 0, len(mp.pool))
+	for _, txDesc := range mp.pool {
+		size := uint32(txDesc.Tx.MsgTx().SerializeSize())
+		txns = append(txns, backlogTx{
+			feeRate: NewSatoshiPerByte(bchutil.Amount(txDesc.Fee+
+				txDesc.FeeDelta), size),
+			cumSize: uint64(size),
+		})
+	}
+
+	// Fill the blocks with the transactions paying the highest fee rates
+	// and record the total size needed to include each of them.
+	sort.Slice(txns, func(i, j int) bool {
+		return txns[i].feeRate > txns[j].feeRate
+	})
+	var total uint64
+	for i := range txns {
+		total += txns[i].cumSize
+		txns[i].cumSize = total
+	}
+
+	mp.backlogCache = txns
+	mp.backlogGeneration = mp.generation
+	return txns
+}
+
+// BacklogFeeRate returns the fee rate a transaction must pay to be mined within
+// the next numBlocks blocks of at most blockSize bytes ahead of the backlog of
+// transactions waiting in the pool, assuming the blocks include the pool
+// transactions paying the highest fee rates first.  It is never below the fee
+// rate required to be accepted into the pool, which is returned when all of
+// the pool transactions fit in those blocks.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) BacklogFeeRate(numBlocks uint32, blockSize uint64) SatoshiPerByte {
+	minFeeRate := SatoshiPerByte(float64(mp.MinRelayTxFee()) / bytePerKb)
+	capacity := uint64(numBlocks) * blockSize
+
+	mp.mtx.RLock()
+	if uint64(mp.poolSize) <= capacity {
+		mp.mtx.RUnlock()
+		return minFeeRate
+	}
+	txns := mp.backlogTxns()
+	mp.mtx.RUnlock()
+
+	// The fee rate of the first transactions left out is the one to beat.
+	i := sort.Search(len(txns), func(i int) bool {
+		return txns[i].cumSize > capacity
+	})
+	if i < len(txns) && txns[i].feeRate > minFeeRate {
+		return txns[i].feeRate
+	}
+	return minFeeRate
+}
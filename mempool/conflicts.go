@@ -0,0 +1,82 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"sync"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+)
+
+// maxConflictEntries is the maximum number of transactions the conflicts of
+// which are remembered.  The transactions seen first are forgotten first.
+const maxConflictEntries = 10000
+
+// conflictLog remembers which transactions were seen spending the same outputs
+// as each other, either because a transaction was rejected for double spending
+// a pool transaction or because a block confirmed a transaction which double
+// spent a pool transaction.
+type conflictLog struct {
+	mtx       sync.Mutex
+	conflicts map[chainhash.Hash][]chainhash.Hash
+	order     []chainhash.Hash
+}
+
+// addLink records that the first transaction conflicts with the second one.
+//
+// This function MUST be called with the conflict log lock held.
+func (l *conflictLog) addLink(hash, conflict chainhash.Hash) {
+	conflicts, ok := l.conflicts[hash]
+	if !ok {
+		if l.conflicts == nil {
+			l.conflicts = make(map[chainhash.Hash][]chainhash.Hash)
+		}
+		if len(l.order) == maxConflictEntries {
+			delete(l.conflicts, l.order[0])
+			l.order = l.order[1:]
+		}
+		l.order = append(l.order, hash)
+	}
+	for i := range conflicts {
+		if conflicts[i] == conflict {
+			return
+		}
+	}
+	l.conflicts[hash] = append(conflicts, conflict)
+}
+
+// add records that the passed transactions conflict with each other.
+//
+// This function is safe for concurrent access.
+func (l *conflictLog) add(a, b chainhash.Hash) {
+	l.mtx.Lock()
+	l.addLink(a, b)
+	l.addLink(b, a)
+	l.mtx.Unlock()
+}
+
+// get returns the transactions seen conflicting with the passed one.
+//
+// This function is safe for concurrent access.
+func (l *conflictLog) get(hash *chainhash.Hash) []chainhash.Hash {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	conflicts := l.conflicts[*hash]
+	if len(conflicts) == 0 {
+		return nil
+	}
+	return append([]chainhash.Hash(nil), conflicts...)
+}
+
+// Conflicts returns the hashes of the transactions seen spending outputs also
+// spent by the transaction with the passed hash, whether they were rejected by
+// the pool or confirmed by a block in place of a pool transaction.  Only the
+// conflicts of the most recently involved transactions are remembered.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) Conflicts(hash *chainhash.Hash) []chainhash.Hash {
+	return mp.conflicts.get(hash)
+}
@@ -72,7 +72,9 @@ calls or of type mempool.RuleError.  Since there are two classes of rules
 mempool.RuleError type contains a single Err field which will, in turn, either
 be a mempool.TxRuleError or a blockchain.RuleError.  The first indicates a
 violation of mempool acceptance rules while the latter indicates a violation of
-consensus acceptance rules.  This allows the caller to easily differentiate
+consensus acceptance rules.  Spends of the outpoints reserved with
+TxPool.LockOutpoints are reported with a mempool.OutpointLockedError instead so
+they can be told apart from invalid transactions.  This allows the caller to easily differentiate
 between unexpected errors, such as database errors, versus errors due to rule
 violations through type assertions.  In addition, callers can programmatically
 determine the specific rule violation by type asserting the Err field to one of
@@ -0,0 +1,535 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/gcash/bchd/bchec"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// dsProofSigHashMask masks the base signature hash type out of the hash type
+// of a signature.
+const dsProofSigHashMask = 0x1f
+
+// dsProofSigHash returns the signature digest of the input of the passed
+// spender spending the passed output with the passed signature hash type.
+func dsProofSigHash(s *wire.DSProofSpender, prevOut *wire.OutPoint, spent *wire.TxOut, hashType txscript.SigHashType) []byte {
+	var buf bytes.Buffer
+	var scratch [8]byte
+	binary.LittleEndian.PutUint32(scratch[:4], s.Version)
+	buf.Write(scratch[:4])
+	buf.Write(s.HashPrevOutputs[:])
+	buf.Write(s.HashSequence[:])
+	buf.Write(prevOut.Hash[:])
+	binary.LittleEndian.PutUint32(scratch[:4], prevOut.Index)
+	buf.Write(scratch[:4])
+	if !spent.TokenData.IsEmpty() {
+		tokenData := spent.TokenData.TokenDataBuffer()
+		buf.Write(tokenData.Bytes())
+	}
+	wire.WriteVarBytes(&buf, 0, spent.PkScript)
+	binary.LittleEndian.PutUint64(scratch[:], uint64(spent.Value))
+	buf.Write(scratch[:])
+	binary.LittleEndian.PutUint32(scratch[:4], s.Sequence)
+	buf.Write(scratch[:4])
+	buf.Write(s.HashOutputs[:])
+	binary.LittleEndian.PutUint32(scratch[:4], s.LockTime)
+	buf.Write(scratch[:4])
+	binary.LittleEndian.PutUint32(scratch[:4], uint32(hashType))
+	buf.Write(scratch[:4])
+	return chainhash.DoubleHashB(buf.Bytes())
+}
+
+// dsProofSigHashType returns the signature hash type of the passed signature
+// and ensures the signature digest of a spender can be reconstructed from it.
+func dsProofSigHashType(sig []byte) (txscript.SigHashType, error) {
+	if len(sig) == 0 {
+		return 0, errors.New("empty signature")
+	}
+	hashType := txscript.SigHashType(sig[len(sig)-1])
+	if hashType&txscript.SigHashForkID == 0 {
+		return 0, errors.New("signature does not use the fork id")
+	}
+	if hashType&txscript.SigHashUTXO != 0 {
+		return 0, errors.New("signature covers the spent outputs")
+	}
+	return hashType, nil
+}
+
+// newDSProofSpender returns the spender of a double-spend proof describing the
+// input with the passed index of the passed transaction, which must spend a
+// pay-to-pubkey-hash output, along with the public key of the input.
+func newDSProofSpender(tx *wire.MsgTx, idx int) (*wire.DSProofSpender, []byte, error) {
+	txIn := tx.TxIn[idx]
+	if !txscript.IsPushOnlyScript(txIn.SignatureScript) {
+		return nil, nil, errors.New("unlocking script is not push only")
+	}
+	pushes, err := txscript.PushedData(txIn.SignatureScript)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(pushes) != 2 {
+		return nil, nil, errors.New("input does not spend a " +
+			"pay-to-pubkey-hash output")
+	}
+	sig, pubKey := pushes[0], pushes[1]
+	hashType, err := dsProofSigHashType(sig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sigHashes := txscript.NewTxSigHashes(tx)
+	spender := &wire.DSProofSpender{
+		Version:  uint32(tx.Version),
+		Sequence: txIn.Sequence,
+		LockTime: tx.LockTime,
+		PushData: [][]byte{sig},
+	}
+	anyoneCanPay := hashType&txscript.SigHashAnyOneCanPay != 0
+	baseType := hashType & dsProofSigHashMask
+	if !anyoneCanPay {
+		spender.HashPrevOutputs = sigHashes.HashPrevOuts
+	}
+	if !anyoneCanPay && baseType != txscript.SigHashSingle &&
+		baseType != txscript.SigHashNone {
+
+		spender.HashSequence = sigHashes.HashSequence
+	}
+	switch {
+	case baseType != txscript.SigHashSingle && baseType != txscript.SigHashNone:
+		spender.HashOutputs = sigHashes.HashOutputs
+	case baseType == txscript.SigHashSingle && idx < len(tx.TxOut):
+		var buf bytes.Buffer
+		if err := wire.WriteTxOut(&buf, 0, 0, tx.TxOut[idx]); err != nil {
+			return nil, nil, err
+		}
+		spender.HashOutputs = chainhash.DoubleHashH(buf.Bytes())
+	}
+	return spender, pubKey, nil
+}
+
+// newDoubleSpendProof returns a proof that the two passed transactions both
+// spend the passed pay-to-pubkey-hash output.  The proof is validated so only
+// double spends with valid signatures are proven.
+func newDoubleSpendProof(first, double *wire.MsgTx, prevOut wire.OutPoint, spent *wire.TxOut) (*wire.MsgDSProofBeta, error) {
+	spenderOf := func(tx *wire.MsgTx) (*wire.DSProofSpender, []byte, error) {
+		for i, txIn := range tx.TxIn {
+			if txIn.PreviousOutPoint == prevOut {
+				return newDSProofSpender(tx, i)
+			}
+		}
+		return nil, nil, fmt.Errorf("transaction %v does not spend %v",
+			tx.TxHash(), prevOut)
+	}
+	firstSpender, pubKey, err := spenderOf(first)
+	if err != nil {
+		return nil, err
+	}
+	doubleSpender, _, err := spenderOf(double)
+	if err != nil {
+		return nil, err
+	}
+
+	if !dsProofSpendersOrdered(firstSpender, doubleSpender) {
+		firstSpender, doubleSpender = doubleSpender, firstSpender
+	}
+	proof := wire.NewMsgDSProofBeta(prevOut, firstSpender, doubleSpender)
+	if err := ValidateDoubleSpendProof(proof, spent, pubKey); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// dsProofSpendersOrdered returns whether the passed spenders are in the order
+// of a double-spend proof, which is by the hash of their outputs and then the
+// hash of their previous outputs.
+func dsProofSpendersOrdered(a, b *wire.DSProofSpender) bool {
+	cmp := bytes.Compare(a.HashOutputs[:], b.HashOutputs[:])
+	if cmp == 0 {
+		cmp = bytes.Compare(a.HashPrevOutputs[:], b.HashPrevOutputs[:])
+	}
+	return cmp <= 0
+}
+
+// ValidateDoubleSpendProof ensures the passed proof proves the passed output
+// was spent twice.  The output must be a pay-to-pubkey-hash output and the
+// passed public key, which is taken from the pool transaction spending the
+// output, the one it pays to.  The signatures of both spenders must be valid
+// for the public key.
+func ValidateDoubleSpendProof(proof *wire.MsgDSProofBeta, spent *wire.TxOut, pubKey []byte) error {
+	if txscript.GetScriptClass(spent.PkScript) != txscript.PubKeyHashTy {
+		return errors.New("spent output is not pay-to-pubkey-hash")
+	}
+	if !bytes.Equal(spent.PkScript[3:23], bchutil.Hash160(pubKey)) {
+		return errors.New("public key does not match the spent output")
+	}
+	parsedPubKey, err := bchec.ParsePubKey(pubKey, bchec.S256())
+	if err != nil {
+		return err
+	}
+
+	first, double := &proof.FirstSpender, &proof.DoubleSpender
+	if reflect.DeepEqual(first, double) {
+		return errors.New("spenders are identical")
+	}
+	if !dsProofSpendersOrdered(first, double) {
+		return errors.New("spenders are not in canonical order")
+	}
+	for _, spender := range []*wire.DSProofSpender{first, double} {
+		if len(spender.PushData) != 1 {
+			return fmt.Errorf("spender pushes %d items instead of "+
+				"a signature", len(spender.PushData))
+		}
+		sig := spender.PushData[0]
+		hashType, err := dsProofSigHashType(sig)
+		if err != nil {
+			return err
+		}
+		sig = sig[:len(sig)-1]
+
+		var signature *bchec.Signature
+		if len(sig) == 64 {
+			signature, err = bchec.ParseSchnorrSignature(sig)
+		} else {
+			signature, err = bchec.ParseDERSignature(sig, bchec.S256())
+		}
+		if err != nil {
+			return err
+		}
+		hash := dsProofSigHash(spender, &proof.PrevOut, spent, hashType)
+		if !signature.Verify(hash, parsedPubKey) {
+			return errors.New("invalid signature")
+		}
+	}
+	return nil
+}
+
+// maxPendingDSProofs is the maximum number of double-spend proofs created
+// concurrently.  The double spends detected while as many proofs are being
+// created are not proven.
+const maxPendingDSProofs = 8
+
+// dsProofStore holds the double-spend proofs of the outputs spent by the pool
+// transactions.  A single proof is kept per output and it is dropped once the
+// pool transaction spending the output leaves the pool.  It has its own lock
+// so proofs received while holding the pool lock for reads can be stored.
+//
+// It also tracks the outputs whose double spends are being or were proven, so
+// a single proof is attempted per output while the pool transaction spending
+// it stays in the pool, however many double spends of it are seen.
+type dsProofStore struct {
+	mtx        sync.Mutex
+	byOutpoint map[wire.OutPoint]*wire.MsgDSProofBeta
+	byHash     map[chainhash.Hash]*wire.MsgDSProofBeta
+	attempted  map[wire.OutPoint]struct{}
+	pending    int
+
+	// wg tracks the proofs being created so tests can wait for them.
+	wg sync.WaitGroup
+}
+
+// beginAttempt returns whether a proof of a double spend of the passed output
+// should be created, in which case it is tracked until endAttempt is called.
+// It is not when a proof of the output is stored, when a proof of it was
+// already attempted or when too many proofs are being created.
+//
+// This function is safe for concurrent access.
+func (s *dsProofStore) beginAttempt(prevOut wire.OutPoint) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if _, ok := s.byOutpoint[prevOut]; ok {
+		return false
+	}
+	if _, ok := s.attempted[prevOut]; ok {
+		return false
+	}
+	if s.pending >= maxPendingDSProofs {
+		return false
+	}
+	if s.attempted == nil {
+		s.attempted = make(map[wire.OutPoint]struct{})
+	}
+	s.attempted[prevOut] = struct{}{}
+	s.pending++
+	s.wg.Add(1)
+	return true
+}
+
+// endAttempt marks a proof started with beginAttempt as no longer being
+// created.
+//
+// This function is safe for concurrent access.
+func (s *dsProofStore) endAttempt() {
+	s.mtx.Lock()
+	s.pending--
+	s.mtx.Unlock()
+	s.wg.Done()
+}
+
+// has returns whether a proof of the passed output is stored.
+//
+// This function is safe for concurrent access.
+func (s *dsProofStore) has(prevOut wire.OutPoint) bool {
+	s.mtx.Lock()
+	_, ok := s.byOutpoint[prevOut]
+	s.mtx.Unlock()
+	return ok
+}
+
+// add stores the passed proof unless a proof of the same output is stored
+// already and returns whether it was stored.
+//
+// This function is safe for concurrent access.
+func (s *dsProofStore) add(proof *wire.MsgDSProofBeta) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if _, ok := s.byOutpoint[proof.PrevOut]; ok {
+		return false
+	}
+	if s.byOutpoint == nil {
+		s.byOutpoint = make(map[wire.OutPoint]*wire.MsgDSProofBeta)
+		s.byHash = make(map[chainhash.Hash]*wire.MsgDSProofBeta)
+	}
+	s.byOutpoint[proof.PrevOut] = proof
+	s.byHash[proof.Hash()] = proof
+	return true
+}
+
+// removeTx drops the proofs of the outputs spent by the passed transaction.
+//
+// This function is safe for concurrent access.
+func (s *dsProofStore) removeTx(tx *bchutil.Tx) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if len(s.byOutpoint) == 0 && len(s.attempted) == 0 {
+		return
+	}
+	for _, txIn := range tx.MsgTx().TxIn {
+		delete(s.attempted, txIn.PreviousOutPoint)
+		proof, ok := s.byOutpoint[txIn.PreviousOutPoint]
+		if !ok {
+			continue
+		}
+		delete(s.byOutpoint, txIn.PreviousOutPoint)
+		delete(s.byHash, proof.Hash())
+	}
+}
+
+// spentOutput returns the output spent by the passed outpoint, which the
+// passed transaction spends, from the pool or the main chain.  It returns nil
+// when the output is unknown.
+//
+// This function is safe for concurrent access and MUST be called without the
+// mempool lock held.
+func (mp *TxPool) spentOutput(tx *bchutil.Tx, prevOut wire.OutPoint) *wire.TxOut {
+	mp.mtx.RLock()
+	txDesc, exists := mp.pool[prevOut.Hash]
+	mp.mtx.RUnlock()
+	if exists {
+		txOuts := txDesc.Tx.MsgTx().TxOut
+		if prevOut.Index >= uint32(len(txOuts)) {
+			return nil
+		}
+		return txOuts[prevOut.Index]
+	}
+
+	utxoView, err := mp.cfg.FetchUtxoView(tx)
+	if err != nil {
+		return nil
+	}
+	entry := utxoView.LookupEntry(prevOut)
+	if entry == nil || entry.IsSpent() {
+		return nil
+	}
+	return &wire.TxOut{
+		Value:     entry.Amount(),
+		PkScript:  entry.PkScript(),
+		TokenData: entry.TokenData(),
+	}
+}
+
+// maybeAddDoubleSpendProof creates and stores a proof that the passed
+// transactions both spend the passed output in the background when none is
+// stored or was attempted yet.  The first transaction is the pool transaction
+// spending the output.  Proofs can only be created for pay-to-pubkey-hash
+// outputs spent with signatures using the fork id and not covering the spent
+// outputs.
+//
+// This function is safe for concurrent access.  The proof is created without
+// the mempool lock, so it may be held when this function is called.
+func (mp *TxPool) maybeAddDoubleSpendProof(first, double *bchutil.Tx, prevOut wire.OutPoint) {
+	if !mp.dsProofs.beginAttempt(prevOut) {
+		return
+	}
+	go func() {
+		defer mp.dsProofs.endAttempt()
+		mp.addNewDoubleSpendProof(first, double, prevOut)
+	}()
+}
+
+// addNewDoubleSpendProof creates and stores a proof that the passed
+// transactions both spend the passed output.  See maybeAddDoubleSpendProof.
+//
+// This function is safe for concurrent access and MUST be called without the
+// mempool lock held.
+func (mp *TxPool) addNewDoubleSpendProof(first, double *bchutil.Tx, prevOut wire.OutPoint) {
+	spent := mp.spentOutput(double, prevOut)
+	if spent == nil {
+		return
+	}
+	proof, err := newDoubleSpendProof(first.MsgTx(), double.MsgTx(),
+		prevOut, spent)
+	if err != nil {
+		log.Debugf("Unable to prove the double spend of %v by %v: %v",
+			prevOut, double.Hash(), err)
+		return
+	}
+
+	// The proof is only stored when the first transaction still spends
+	// the output.
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+	if mp.outpoints[prevOut] != first {
+		return
+	}
+	if mp.addDoubleSpendProof(proof, first) {
+		log.Infof("Transaction %v double spends %v spent by %v, "+
+			"proven by %v", double.Hash(), prevOut, first.Hash(),
+			proof.Hash())
+	}
+}
+
+// addDoubleSpendProof stores the passed proof of a double spend of the input of
+// the passed pool transaction and notifies the OnDoubleSpendProof callback.  It
+// returns whether the proof was stored, which is not the case when a proof of
+// the same output is stored already.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) addDoubleSpendProof(proof *wire.MsgDSProofBeta, poolTx *bchutil.Tx) bool {
+	if !mp.dsProofs.add(proof) {
+		return false
+	}
+	if mp.cfg.OnDoubleSpendProof != nil {
+		go mp.cfg.OnDoubleSpendProof(proof, poolTx)
+	}
+	return true
+}
+
+// ProcessDoubleSpendProof validates the passed double-spend proof, typically
+// received from a peer, against the pool transaction spending its output and
+// stores it.  It returns whether the proof was stored, which is not the case
+// when a proof of the same output is stored already.  An error is returned
+// when no pool transaction spends the output or the proof is invalid, in which
+// case it is a RuleError.
+//
+// The signatures of the proof are verified without holding the mempool lock.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) ProcessDoubleSpendProof(proof *wire.MsgDSProofBeta) (bool, error) {
+	prevOut := proof.PrevOut
+	mp.mtx.RLock()
+	if mp.dsProofs.has(prevOut) {
+		mp.mtx.RUnlock()
+		return false, nil
+	}
+	poolTx, exists := mp.outpoints[prevOut]
+	mp.mtx.RUnlock()
+	if !exists {
+		return false, fmt.Errorf("no transaction in the memory pool "+
+			"spends %v", prevOut)
+	}
+	spent := mp.spentOutput(poolTx, prevOut)
+	if spent == nil {
+		return false, fmt.Errorf("output %v is unknown", prevOut)
+	}
+
+	// The public key the output pays to is taken from the pool transaction
+	// so the signatures of the proof are checked against it.
+	var pubKey []byte
+	for _, txIn := range poolTx.MsgTx().TxIn {
+		if txIn.PreviousOutPoint != prevOut {
+			continue
+		}
+		pushes, err := txscript.PushedData(txIn.SignatureScript)
+		if err != nil || len(pushes) != 2 {
+			str := fmt.Sprintf("transaction %v does not spend %v "+
+				"with a signature and public key", poolTx.Hash(),
+				prevOut)
+			return false, txRuleError(wire.RejectInvalid, str)
+		}
+		pubKey = pushes[1]
+		break
+	}
+	if err := ValidateDoubleSpendProof(proof, spent, pubKey); err != nil {
+		str := fmt.Sprintf("invalid double-spend proof %v: %v",
+			proof.Hash(), err)
+		return false, txRuleError(wire.RejectInvalid, str)
+	}
+
+	// The proof is only stored when the transaction it was validated
+	// against still spends the output.
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+	if mp.outpoints[prevOut] != poolTx {
+		return false, fmt.Errorf("transaction %v no longer spends %v",
+			poolTx.Hash(), prevOut)
+	}
+	if !mp.addDoubleSpendProof(proof, poolTx) {
+		return false, nil
+	}
+	log.Infof("Received double-spend proof %v of %v spent by %v",
+		proof.Hash(), prevOut, poolTx.Hash())
+	return true, nil
+}
+
+// DoubleSpendProof returns the proof that the passed output, which is spent
+// by a pool transaction, was also spent by another transaction.  It returns
+// nil when no double spend of the output was proven.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) DoubleSpendProof(prevOut wire.OutPoint) *wire.MsgDSProofBeta {
+	mp.dsProofs.mtx.Lock()
+	defer mp.dsProofs.mtx.Unlock()
+
+	return mp.dsProofs.byOutpoint[prevOut]
+}
+
+// DoubleSpendProofByHash returns the double-spend proof with the passed hash,
+// or nil when it is not stored.  It is used to serve proofs to peers.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) DoubleSpendProofByHash(hash *chainhash.Hash) *wire.MsgDSProofBeta {
+	mp.dsProofs.mtx.Lock()
+	defer mp.dsProofs.mtx.Unlock()
+
+	return mp.dsProofs.byHash[*hash]
+}
+
+// DoubleSpendProofs returns all of the stored double-spend proofs.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) DoubleSpendProofs() []*wire.MsgDSProofBeta {
+	mp.dsProofs.mtx.Lock()
+	defer mp.dsProofs.mtx.Unlock()
+
+	proofs := make([]*wire.MsgDSProofBeta, 0, len(mp.dsProofs.byOutpoint))
+	for _, proof := range mp.dsProofs.byOutpoint {
+		proofs = append(proofs, proof)
+	}
+	return proofs
+}
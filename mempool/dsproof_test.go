@@ -0,0 +1,219 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// TestDoubleSpendProof ensures double spends of pay-to-pubkey-hash outputs
+// spent by pool transactions are proven, that the proof does not depend on
+// which transaction was seen first, that double spends with invalid signatures
+// are not proven, that a single proof is attempted per output and that proofs
+// are dropped with the pool transaction.
+func TestDoubleSpendProof(t *testing.T) {
+	t.Parallel()
+
+	harness, _, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	coinbaseHeight := harness.chain.BestHeight() -
+		int32(harness.chainParams.CoinbaseMaturity) + 1
+	coinbase, err := harness.CreateCoinbaseTx(coinbaseHeight, 2)
+	if err != nil {
+		t.Fatalf("unable to create coinbase: %v", err)
+	}
+	harness.chain.utxos.AddTxOuts(coinbase, coinbaseHeight)
+
+	// payWithFee returns a transaction spending the passed output to the
+	// harness and paying the passed fee.
+	payWithFee := func(input spendableOutput, fee int64) *bchutil.Tx {
+		t.Helper()
+		tx := wire.NewMsgTx(wire.TxVersion)
+		tx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: input.outPoint,
+			Sequence:         wire.MaxTxInSequenceNum,
+		})
+		tx.AddTxOut(&wire.TxOut{
+			PkScript: harness.payScript,
+			Value:    int64(input.amount) - fee,
+		})
+		sigScript, err := txscript.SignatureScript(tx, 0,
+			int64(input.amount), harness.payScript,
+			txscript.SigHashAll, harness.signKey, true)
+		if err != nil {
+			t.Fatalf("unable to sign transaction: %v", err)
+		}
+		tx.TxIn[0].SignatureScript = sigScript
+		return bchutil.NewTx(tx)
+	}
+	// process also waits for the proofs created in the background.
+	process := func(tx *bchutil.Tx) error {
+		_, err := harness.txPool.ProcessTransaction(tx, false, false, 0)
+		harness.txPool.dsProofs.wg.Wait()
+		return err
+	}
+
+	out := txOutToSpendableOut(coinbase, 0)
+	first := payWithFee(out, 1000)
+	double := payWithFee(out, 2000)
+	if err := process(first); err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+	}
+	if err := process(double); err == nil {
+		t.Fatalf("ProcessTransaction: accepted double spend")
+	}
+	proof := harness.txPool.DoubleSpendProof(out.outPoint)
+	if proof == nil {
+		t.Fatalf("double spend of %v was not proven", out.outPoint)
+	}
+	hash := proof.Hash()
+	if harness.txPool.DoubleSpendProofByHash(&hash) != proof {
+		t.Errorf("proof %v is not found by its hash", hash)
+	}
+	if proofs := harness.txPool.DoubleSpendProofs(); len(proofs) != 1 {
+		t.Errorf("got %d proofs, want 1", len(proofs))
+	}
+
+	// The proof is the same when the double spend is seen first.
+	spent := coinbase.MsgTx().TxOut[0]
+	reversed, err := newDoubleSpendProof(double.MsgTx(), first.MsgTx(),
+		out.outPoint, spent)
+	if err != nil {
+		t.Fatalf("newDoubleSpendProof: %v", err)
+	}
+	if reversed.Hash() != hash {
+		t.Errorf("got proof %v when the double spend is seen first, "+
+			"want %v", reversed.Hash(), hash)
+	}
+
+	// Tampering with the spenders invalidates the proof.
+	pushes, err := txscript.PushedData(first.MsgTx().TxIn[0].SignatureScript)
+	if err != nil {
+		t.Fatalf("PushedData: %v", err)
+	}
+	if err := ValidateDoubleSpendProof(proof, spent, pushes[1]); err != nil {
+		t.Errorf("ValidateDoubleSpendProof: %v", err)
+	}
+	tampered := *proof
+	tampered.DoubleSpender.LockTime++
+	if err := ValidateDoubleSpendProof(&tampered, spent, pushes[1]); err == nil {
+		t.Errorf("ValidateDoubleSpendProof accepted a tampered proof")
+	}
+	tampered = *proof
+	tampered.FirstSpender, tampered.DoubleSpender =
+		tampered.DoubleSpender, tampered.FirstSpender
+	if err := ValidateDoubleSpendProof(&tampered, spent, pushes[1]); err == nil {
+		t.Errorf("ValidateDoubleSpendProof accepted unordered spenders")
+	}
+
+	// A double spend with an invalid signature is not proven.
+	out = txOutToSpendableOut(coinbase, 1)
+	if err := process(payWithFee(out, 1000)); err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+	}
+	invalid := payWithFee(out, 2000)
+	invalid.MsgTx().TxOut[0].Value++
+	invalid = bchutil.NewTx(invalid.MsgTx())
+	if err := process(invalid); err == nil {
+		t.Fatalf("ProcessTransaction: accepted double spend")
+	}
+	if harness.txPool.DoubleSpendProof(out.outPoint) != nil {
+		t.Errorf("double spend with an invalid signature was proven")
+	}
+
+	// The output was already attempted, so a valid double spend of it is
+	// not proven either.
+	if err := process(payWithFee(out, 3000)); err == nil {
+		t.Fatalf("ProcessTransaction: accepted double spend")
+	}
+	if harness.txPool.DoubleSpendProof(out.outPoint) != nil {
+		t.Errorf("double spend of an attempted output was proven")
+	}
+
+	// The proof is dropped along with the pool transaction.
+	harness.txPool.RemoveTransaction(first, true)
+	if harness.txPool.DoubleSpendProof(txOutToSpendableOut(coinbase,
+		0).outPoint) != nil {
+
+		t.Errorf("proof was kept after the pool transaction was removed")
+	}
+	if harness.txPool.DoubleSpendProofByHash(&hash) != nil {
+		t.Errorf("proof %v is still found by its hash", hash)
+	}
+}
+
+// TestProcessDoubleSpendProof ensures double-spend proofs received from peers
+// are validated against the pool transaction spending their output, stored
+// once and passed to the OnDoubleSpendProof callback.
+func TestProcessDoubleSpendProof(t *testing.T) {
+	t.Parallel()
+
+	harness, spendableOuts, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	notified := make(chan *wire.MsgDSProofBeta, 1)
+	harness.txPool.cfg.OnDoubleSpendProof = func(proof *wire.MsgDSProofBeta, poolTx *bchutil.Tx) {
+		notified <- proof
+	}
+
+	out := spendableOuts[0]
+	first, err := harness.CreateSignedTx([]spendableOutput{out}, 1)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	double, err := harness.CreateSignedTx([]spendableOutput{out}, 2)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	spent := &wire.TxOut{Value: int64(out.amount), PkScript: harness.payScript}
+	proof, err := newDoubleSpendProof(first.MsgTx(), double.MsgTx(),
+		out.outPoint, spent)
+	if err != nil {
+		t.Fatalf("newDoubleSpendProof: %v", err)
+	}
+
+	// Proofs of outputs not spent by pool transactions are rejected.
+	if _, err := harness.txPool.ProcessDoubleSpendProof(proof); err == nil {
+		t.Errorf("ProcessDoubleSpendProof accepted a proof of an output " +
+			"not spent in the pool")
+	}
+
+	_, err = harness.txPool.ProcessTransaction(first, false, false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+	}
+	tampered := *proof
+	tampered.FirstSpender.Sequence--
+	if _, err := harness.txPool.ProcessDoubleSpendProof(&tampered); err == nil {
+		t.Errorf("ProcessDoubleSpendProof accepted a tampered proof")
+	}
+
+	stored, err := harness.txPool.ProcessDoubleSpendProof(proof)
+	if err != nil || !stored {
+		t.Fatalf("ProcessDoubleSpendProof: got (%v, %v), want (true, nil)",
+			stored, err)
+	}
+	if got := <-notified; got != proof {
+		t.Errorf("OnDoubleSpendProof was called with proof %v, want %v",
+			got.Hash(), proof.Hash())
+	}
+	stored, err = harness.txPool.ProcessDoubleSpendProof(proof)
+	if err != nil || stored {
+		t.Errorf("ProcessDoubleSpendProof: got (%v, %v) for a known "+
+			"proof, want (false, nil)", stored, err)
+	}
+	hash := proof.Hash()
+	if harness.txPool.DoubleSpendProofByHash(&hash) != proof {
+		t.Errorf("received proof %v is not found by its hash", hash)
+	}
+}
@@ -5,6 +5,8 @@
 package mempool
 
 import (
+	"fmt"
+
 	"github.com/gcash/bchd/blockchain"
 	"github.com/gcash/bchd/wire"
 )
@@ -13,8 +15,8 @@ import (
 // processing of a transaction failed due to one of the many validation
 // rules.  The caller can use type assertions to determine if a failure was
 // specifically due to a rule violation and use the Err field to access the
-// underlying error, which will be either a TxRuleError or a
-// blockchain.RuleError.
+// underlying error, which will be either a TxRuleError, an OutpointLockedError
+// or a blockchain.RuleError.
 type RuleError struct {
 	Err error
 }
@@ -42,6 +44,33 @@ func (e TxRuleError) Error() string {
 	return e.Description
 }
 
+// OutpointLockedError identifies a transaction rejected because it spends an
+// outpoint reserved with LockOutpoints.  It is distinct from the TxRuleError of
+// the other rule violations so the caller can tell a spend which may be
+// retried once the outpoint is unlocked from an invalid one.
+type OutpointLockedError struct {
+	Outpoint wire.OutPoint
+}
+
+// Error satisfies the error interface and prints human-readable errors.
+func (e OutpointLockedError) Error() string {
+	return fmt.Sprintf("output %v is locked", e.Outpoint)
+}
+
+// ExternalPolicyUnavailableError identifies a transaction which was not
+// accepted because the external policy check of the configuration could not
+// be completed, such as when its server can't be reached.  It is not a
+// RuleError since the transaction may well be accepted once the check is
+// available again.
+type ExternalPolicyUnavailableError struct {
+	Err error
+}
+
+// Error satisfies the error interface and prints human-readable errors.
+func (e ExternalPolicyUnavailableError) Error() string {
+	return fmt.Sprintf("external policy unavailable: %v", e.Err)
+}
+
 // txRuleError creates an underlying TxRuleError with the given a set of
 // arguments and returns a RuleError that encapsulates it.
 func txRuleError(c wire.RejectCode, desc string) RuleError {
@@ -100,6 +129,10 @@ func extractRejectCode(err error) (wire.RejectCode, bool) {
 	case TxRuleError:
 		return err.RejectCode, true
 
+	// Spending a locked outpoint is rejected like a double spend.
+	case OutpointLockedError:
+		return wire.RejectDuplicate, true
+
 	case nil:
 		return wire.RejectInvalid, false
 	}
@@ -54,6 +54,10 @@ var (
 	// EstimateFeeDatabaseKey is the key that we use to
 	// store the fee estimator in the database.
 	EstimateFeeDatabaseKey = []byte("estimatefee")
+
+	// errNotEnoughBlocks is returned by EstimateFee until the minimum
+	// number of blocks has been registered.
+	errNotEnoughBlocks = errors.New("not enough blocks have been observed")
 )
 
 // SatoshiPerByte is number with units of satoshis per byte.
@@ -554,7 +558,7 @@ func (ef *FeeEstimator) EstimateFee(numBlocks uint32) (BchPerKilobyte, error) {
 	// If the number of registered blocks is below the minimum, return
 	// an error.
 	if ef.numBlocksRegistered < ef.minRegisteredBlocks {
-		return -1, errors.New("not enough blocks have been observed")
+		return -1, errNotEnoughBlocks
 	}
 
 	if numBlocks == 0 {
@@ -575,6 +579,33 @@ func (ef *FeeEstimator) EstimateFee(numBlocks uint32) (BchPerKilobyte, error) {
 	return ef.cached[int(numBlocks)-1].ToBchPerKb(), nil
 }
 
+// EstimateFeeWithBacklog estimates the fee per byte to have a tx confirmed a
+// given number of blocks from now from both the history of the fee rates of the
+// confirmed transactions and the passed fee rate needed to be ahead of the
+// current backlog of the mempool, as returned by TxPool.BacklogFeeRate.  The
+// higher of the two is returned, so the estimate follows the backlog during
+// congestion bursts which the history only reflects once they are over.  The
+// backlog fee rate is returned alone when not enough blocks have been observed
+// yet for a historical estimate.
+func (ef *FeeEstimator) EstimateFeeWithBacklog(numBlocks uint32, backlog SatoshiPerByte) (BchPerKilobyte, error) {
+	if numBlocks == 0 {
+		return -1, errors.New("cannot confirm transaction in zero blocks")
+	}
+
+	backlogRate := backlog.ToBchPerKb()
+	historical, err := ef.EstimateFee(numBlocks)
+	if err == errNotEnoughBlocks {
+		return backlogRate, nil
+	}
+	if err != nil {
+		return -1, err
+	}
+	if historical < backlogRate {
+		return backlogRate, nil
+	}
+	return historical, nil
+}
+
 // In case the format for the serialized version of the FeeEstimator changes,
 // we use a version number. If the version number changes, it does not make
 // sense to try to upgrade a previous version to a new version. Instead, just
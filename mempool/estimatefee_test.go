@@ -320,6 +320,62 @@ func (eft *estimateFeeTester) round(txHistory [][]*TxDesc,
 	return append(txHistory, newTxs), append(estimateHistory, estimates)
 }
 
+// TestEstimateFeeWithBacklog ensures the estimates blended with the backlog of
+// the mempool are the higher of the historical and the backlog fee rates and
+// fall back to the backlog fee rate until enough blocks have been observed.
+func TestEstimateFeeWithBacklog(t *testing.T) {
+	ef := newTestFeeEstimator(5, 3, 1)
+	ef.minRegisteredBlocks = 1
+	eft := estimateFeeTester{ef: ef, t: t}
+
+	backlog := SatoshiPerByte(2)
+	estimated, err := ef.EstimateFeeWithBacklog(1, backlog)
+	if err != nil {
+		t.Fatalf("EstimateFeeWithBacklog: %v", err)
+	}
+	if estimated != backlog.ToBchPerKb() {
+		t.Errorf("got estimate %f before any blocks have been "+
+			"registered, want the backlog fee rate %f", estimated,
+			backlog.ToBchPerKb())
+	}
+
+	tx := eft.testTx(1000000)
+	ef.ObserveTransaction(tx)
+	eft.newBlock([]*wire.MsgTx{tx.Tx.MsgTx()})
+	historical := expectedFeePerKilobyte(tx)
+
+	estimated, err = ef.EstimateFeeWithBacklog(1, backlog)
+	if err != nil {
+		t.Fatalf("EstimateFeeWithBacklog: %v", err)
+	}
+	if estimated != historical {
+		t.Errorf("got estimate %f, want the historical estimate %f",
+			estimated, historical)
+	}
+
+	backlog = SatoshiPerByte(1e9)
+	estimated, err = ef.EstimateFeeWithBacklog(1, backlog)
+	if err != nil {
+		t.Fatalf("EstimateFeeWithBacklog: %v", err)
+	}
+	if estimated != backlog.ToBchPerKb() {
+		t.Errorf("got estimate %f during a backlog, want %f",
+			estimated, backlog.ToBchPerKb())
+	}
+
+	if _, err := ef.EstimateFeeWithBacklog(0, backlog); err == nil {
+		t.Errorf("EstimateFeeWithBacklog estimated a fee for zero blocks")
+	}
+
+	// Errors of the historical estimate other than the lack of observed
+	// blocks must not be hidden by the backlog fee rate.
+	_, err = ef.EstimateFeeWithBacklog(estimateFeeDepth+1, backlog)
+	if err == nil {
+		t.Errorf("EstimateFeeWithBacklog estimated a fee beyond the " +
+			"estimation depth")
+	}
+}
+
 // TestEstimateFeeRollback tests the rollback function, which undoes the
 // effect of a adding a new block.
 func TestEstimateFeeRollback(t *testing.T) {
@@ -0,0 +1,189 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// rollingMinFeeHalfLife is the time it takes the minimum fee raised by
+// evicting transactions to decay by half while the pool is at least half full.
+// It decays faster while the pool is emptier.
+const rollingMinFeeHalfLife = 12 * time.Hour
+
+// poolTrimPercent is the percentage of the size limit of the pool it is
+// trimmed down to once it exceeds the limit.  Trimming below the limit lets
+// the pool grow again before the next trim, so the cost of scoring the whole
+// pool is not paid for every transaction accepted while the pool is full.
+const poolTrimPercent = 90
+
+// evictionCandidate is a pool transaction considered for eviction along with
+// its eviction score.
+type evictionCandidate struct {
+	tx *bchutil.Tx
+
+	// feeRate is the fee rate in satoshi per kB of the transaction together
+	// with its descendants, which are evicted along with it, and score is
+	// the larger of that fee rate and the one of the transaction alone, so
+	// a transaction paying well is not evicted because of its descendants.
+	feeRate float64
+	score   float64
+}
+
+// descendantPackage returns the fees, including the fee deltas, and the size
+// of the passed pool transaction together with all of the pool transactions
+// which depend on it, directly or through other pool transactions.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) descendantPackage(txDesc *TxDesc) (int64, int64) {
+	fees := txDesc.Fee + txDesc.FeeDelta
+	size := int64(txDesc.Tx.MsgTx().SerializeSize())
+
+	visited := map[chainhash.Hash]struct{}{*txDesc.Tx.Hash(): {}}
+	queue := []*bchutil.Tx{txDesc.Tx}
+	for len(queue) > 0 {
+		tx := queue[0]
+		queue = queue[1:]
+		prevOut := wire.OutPoint{Hash: *tx.Hash()}
+		for i := range tx.MsgTx().TxOut {
+			prevOut.Index = uint32(i)
+			txRedeemer, exists := mp.outpoints[prevOut]
+			if !exists {
+				continue
+			}
+			hash := *txRedeemer.Hash()
+			if _, ok := visited[hash]; ok {
+				continue
+			}
+			visited[hash] = struct{}{}
+			redeemerDesc, exists := mp.pool[hash]
+			if !exists {
+				continue
+			}
+			fees += redeemerDesc.Fee + redeemerDesc.FeeDelta
+			size += int64(txRedeemer.MsgTx().SerializeSize())
+			queue = append(queue, txRedeemer)
+		}
+	}
+	return fees, size
+}
+
+// rollingMinFeeRate returns the minimum fee rate in satoshi per kB raised by
+// evicting transactions to keep the pool within its size limit, decayed to the
+// passed time.  It is zero when no transactions were evicted recently.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) rollingMinFeeRate(now time.Time) float64 {
+	if mp.rollingMinFee == 0 {
+		return 0
+	}
+
+	halfLife := rollingMinFeeHalfLife
+	limit := mp.cfg.Policy.MaxMempoolBytes
+	if mp.poolSize < limit/4 {
+		halfLife /= 4
+	} else if mp.poolSize < limit/2 {
+		halfLife /= 2
+	}
+	elapsed := now.Sub(mp.rollingMinFeeUpdated)
+	feeRate := mp.rollingMinFee * math.Pow(0.5,
+		elapsed.Seconds()/halfLife.Seconds())
+
+	// Drop the raised fee altogether once it decayed to half of the
+	// minimum relay fee, which is the increment it is raised by.
+	if feeRate < float64(mp.cfg.Policy.MinRelayTxFee)/2 {
+		return 0
+	}
+	return feeRate
+}
+
+// limitPoolSize evicts the pool transactions with the lowest fee rates along
+// with their descendants once the total size of the pool exceeds the limit of
+// the policy, until it is down to poolTrimPercent of the limit.  The minimum fee for new transactions is raised above the fee
+// rate of the evicted transactions so they are not replaced with transactions
+// paying the same, which would only be evicted again.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (mp *TxPool) limitPoolSize() {
+	limit := mp.cfg.Policy.MaxMempoolBytes
+	if limit <= 0 || mp.poolSize <= limit {
+		return
+	}
+
+	candidates := make([]evictionCandidate, 0, len(mp.pool))
+	for _, txDesc := range mp.pool {
+		fees, size := mp.descendantPackage(txDesc)
+		feeRate := float64(fees) * 1000 / float64(size)
+		candidates = append(candidates, evictionCandidate{
+			tx:      txDesc.Tx,
+			feeRate: feeRate,
+			score:   math.Max(float64(txDesc.ModifiedFeePerKB()), feeRate),
+		})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].score < candidates[j].score
+	})
+
+	var maxEvictedFeeRate float64
+	numTxns := len(mp.pool)
+	target := limit / 100 * poolTrimPercent
+	for _, candidate := range candidates {
+		if mp.poolSize <= target {
+			break
+		}
+
+		// The transaction was already evicted as a descendant of a
+		// transaction evicted before.
+		if _, exists := mp.pool[*candidate.tx.Hash()]; !exists {
+			continue
+		}
+		maxEvictedFeeRate = math.Max(maxEvictedFeeRate, candidate.feeRate)
+		mp.removeTransaction(candidate.tx, true)
+	}
+
+	now := mp.cfg.Clock.Now()
+	minFee := maxEvictedFeeRate + float64(mp.cfg.Policy.MinRelayTxFee)
+	mp.rollingMinFee = math.Max(mp.rollingMinFeeRate(now), minFee)
+	mp.rollingMinFeeUpdated = now
+
+	log.Debugf("Evicted %d transactions to limit the pool to %d bytes, "+
+		"raising the minimum fee to %v/kB", numTxns-len(mp.pool), limit,
+		bchutil.Amount(mp.rollingMinFee))
+}
+
+// MinRelayTxFee returns the minimum fee per kB new transactions must currently
+// pay to be accepted into the pool.  It is the minimum relay fee of the policy
+// unless the fee was raised by evicting transactions to keep the pool within
+// its size limit.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) MinRelayTxFee() bchutil.Amount {
+	mp.mtx.RLock()
+	rollingFee := mp.rollingMinFeeRate(mp.cfg.Clock.Now())
+	mp.mtx.RUnlock()
+
+	if rollingFee > float64(mp.cfg.Policy.MinRelayTxFee) {
+		return bchutil.Amount(rollingFee)
+	}
+	return mp.cfg.Policy.MinRelayTxFee
+}
+
+// Size returns the total serialized size in bytes of the transactions in the
+// main pool.  It does not include the orphan pool.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) Size() int64 {
+	mp.mtx.RLock()
+	size := mp.poolSize
+	mp.mtx.RUnlock()
+
+	return size
+}
@@ -12,6 +12,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -69,11 +70,20 @@ type Config struct {
 	// the current best chain.
 	BestHeight func() int32
 
+	// BestHash defines the function to use to access the hash of the tip
+	// of the current best chain.
+	BestHash func() *chainhash.Hash
+
 	// MedianTimePast defines the function to use in order to access the
 	// median time past calculated from the point-of-view of the current
 	// chain tip within the best chain.
 	MedianTimePast func() time.Time
 
+	// Clock provides the current time used to expire orphans, rate limit
+	// free transactions and timestamp the transactions added to the pool.
+	// The system clock is used when it is nil.
+	Clock blockchain.Clock
+
 	// CalcSequenceLock defines the function to use in order to generate
 	// the current sequence lock for the given transaction using the passed
 	// utxo view.
@@ -88,9 +98,17 @@ type Config struct {
 	// SigCache defines a signature cache to use.
 	SigCache *txscript.SigCache
 
-	// HashCache defines the transaction hash mid-state cache to use.
+	// HashCache defines the transaction hash mid-state cache to use.  When
+	// it is shared with the chain, the mid-states computed for accepted
+	// transactions are reused when they are validated as part of a block.
 	HashCache *txscript.HashCache
 
+	// ScriptFlagOverrides forcibly enables or disables script flags on top
+	// of the flags selected by the policy when validating transactions.
+	// It must only be used on test networks to test the behavior of
+	// upgrades.
+	ScriptFlagOverrides txscript.ScriptFlagOverrides
+
 	// AddrIndex defines the optional address index instance to use for
 	// indexing the unconfirmed transactions in the memory pool.
 	// This can be nil if the address index is not enabled.
@@ -99,6 +117,27 @@ type Config struct {
 	// FeeEstimatator provides a feeEstimator. If it is not nil, the mempool
 	// records all new transactions it observes into the feeEstimator.
 	FeeEstimator *FeeEstimator
+
+	// ExternalPolicyCheck is an optional function consulted once a
+	// transaction passed all the other rules, including the validation of
+	// its scripts, to plug in policies which are not part of the mempool.
+	// It is passed the transaction along with the view of the outputs it
+	// spends and rejects the transaction by returning an error, whose
+	// message is used as the reject reason.
+	//
+	// It is called without the mempool lock held and may be called
+	// concurrently.  It is not consulted for the transactions of
+	// disconnected blocks, which are added back to the pool.  It returns an
+	// ExternalPolicyUnavailableError when the transaction can't be checked,
+	// which is not a rejection of the transaction.
+	ExternalPolicyCheck func(*bchutil.Tx, *blockchain.UtxoViewpoint) error
+
+	// OnDoubleSpendProof is an optional function called with each new
+	// double-spend proof stored by the pool, whether it was created from a
+	// double spend seen by the pool or passed to ProcessDoubleSpendProof,
+	// along with the pool transaction whose input it proves double spent.
+	// It is called from its own goroutine, so it may call into the mempool.
+	OnDoubleSpendProof func(*wire.MsgDSProofBeta, *bchutil.Tx)
 }
 
 // Policy houses the policy (configuration parameters) which is used to
@@ -135,9 +174,52 @@ type Policy struct {
 	// of signature checks in each transaction.
 	LimitSigChecks bool
 
+	// MaxStandardTxSize is the maximum size in bytes of a standard
+	// transaction.  Zero selects DefaultMaxStandardTxSize.
+	MaxStandardTxSize int
+
+	// MaxStandardSigScriptSize is the maximum size in bytes of each
+	// signature script of a standard transaction.  Zero selects
+	// DefaultMaxStandardSigScriptSize.
+	MaxStandardSigScriptSize int
+
+	// MaxDataCarrierSize is the maximum size in bytes of all of the null
+	// data outputs of a standard transaction combined.  Zero selects
+	// txscript.MaxDataCarrierSize.
+	MaxDataCarrierSize int
+
+	// MaxDataCarrierOutputs is the maximum number of null data outputs of
+	// a standard transaction.  Zero allows any number of null data outputs
+	// within MaxDataCarrierSize.
+	MaxDataCarrierOutputs int
+
 	// MinRelayTxFee defines the minimum transaction fee in BCH/kB to be
 	// considered a non-zero fee.
 	MinRelayTxFee bchutil.Amount
+
+	// FeeOnly disables the legacy coin-age priority logic.  Transaction
+	// priorities are neither calculated nor required for relay, so free
+	// and low-fee transactions are only accepted within the allowance of
+	// FreeTxRelayLimit.
+	FeeOnly bool
+
+	// MaxTokenCategoryTxs is the maximum number of transactions with
+	// outputs of the same CashToken category allowed in the pool at once,
+	// which bounds the unconfirmed chains of a single category.  Zero
+	// disables the limit.
+	MaxTokenCategoryTxs int
+
+	// MaxTokenGenesisTxs is the maximum number of transactions creating a
+	// new CashToken category accepted into the pool per block interval.
+	// Zero disables the limit.
+	MaxTokenGenesisTxs int
+
+	// MaxMempoolBytes is the maximum total serialized size in bytes of
+	// the transactions in the pool.  The transactions with the lowest fee
+	// rates are evicted along with their descendants when it is exceeded,
+	// and the minimum fee of new transactions is raised above their fee
+	// rate for a while.  Zero disables the limit.
+	MaxMempoolBytes int64
 }
 
 // TxDesc is a descriptor containing a transaction in the mempool along with
@@ -148,6 +230,38 @@ type TxDesc struct {
 	// StartingPriority is the priority of the transaction when it was added
 	// to the pool.
 	StartingPriority float64
+
+	// inputValue is the sum of the values of the confirmed inputs of the
+	// transaction and inputValueHeight is the sum of those values
+	// multiplied by the height of the block which confirmed them.  They
+	// are cached when the transaction is added to the pool, and updated as
+	// the pool transactions it spends are mined, so the current priority
+	// can be calculated without fetching the inputs again.
+	inputValue       float64
+	inputValueHeight float64
+
+	// prioritySize is the adjusted size of the transaction used to
+	// calculate its priority.
+	prioritySize int
+}
+
+// addConfirmedInput adds an input of the transaction with the passed value
+// which was confirmed at the passed height to the cached input values.
+func (txD *TxDesc) addConfirmedInput(value int64, height int32) {
+	txD.inputValue += float64(value)
+	txD.inputValueHeight += float64(value) * float64(height)
+}
+
+// currentPriority returns the priority the transaction has when it is included
+// in a block at the passed height.  It is calculated arithmetically from the
+// cached input values and is equivalent to mining.CalcPriority.
+func (txD *TxDesc) currentPriority(nextBlockHeight int32) float64 {
+	if txD.prioritySize == 0 {
+		return 0
+	}
+	inputValueAge := txD.inputValue*float64(nextBlockHeight) -
+		txD.inputValueHeight
+	return inputValueAge / float64(txD.prioritySize)
 }
 
 // orphanTx is normal transaction that references an ancestor transaction
@@ -175,11 +289,67 @@ type TxPool struct {
 	pennyTotal    float64 // exponentially decaying total for penny spends.
 	lastPennyUnix int64   // unix time of last ``penny spend''
 
+	// generation is incremented whenever a transaction is added to or
+	// removed from the pool or outpoints are locked so transactions checked
+	// without holding the lock for writes can detect that the pool changed
+	// in the meantime.
+	generation uint64
+
+	// backlogCache holds the pool transactions sorted by fee rate for
+	// BacklogFeeRate as of backlogGeneration.  It is protected by
+	// backlogMtx since it is rebuilt with the pool lock held for reads.
+	backlogMtx        sync.Mutex
+	backlogCache      []backlogTx
+	backlogGeneration uint64
+
+	// poolSize is the total serialized size of the transactions in the
+	// pool, which is kept within the MaxMempoolBytes limit of the policy.
+	poolSize int64
+
+	// rollingMinFee is the minimum fee rate in satoshi per kB raised by
+	// evicting transactions to keep the pool within its size limit when it
+	// was last updated at rollingMinFeeUpdated.  It decays over time.
+	rollingMinFee        float64
+	rollingMinFeeUpdated time.Time
+
 	// nextExpireScan is the time after which the orphan pool will be
 	// scanned in order to evict orphans.  This is NOT a hard deadline as
 	// the scan will only run when an orphan is added to the pool as opposed
 	// to on an unconditional timer.
 	nextExpireScan time.Time
+
+	// tokenCategoryTxs counts the pool transactions with outputs of each
+	// CashToken category.  It is only maintained when the category limit
+	// is enabled.
+	tokenCategoryTxs map[chainhash.Hash]int
+
+	// tokenGenesisTxs is the number of transactions creating a new
+	// CashToken category accepted while tokenGenesisHeight was the height
+	// of the next block.
+	tokenGenesisTxs    int
+	tokenGenesisHeight int32
+
+	// conflicts remembers the transactions seen double spending each
+	// other.  It has its own lock so double spends detected while holding
+	// the pool lock for reads can be recorded.
+	conflicts conflictLog
+
+	// dsProofs holds the proofs of the double spends of the outputs spent
+	// by pool transactions.  Like the conflicts, it has its own lock.
+	dsProofs dsProofStore
+
+	// feeDeltas holds the fee deltas set with PrioritiseTransaction by
+	// transaction hash.  They are kept until the transaction is mined so
+	// transactions can be prioritised before they reach the pool.
+	feeDeltas map[chainhash.Hash]int64
+
+	// constrained is set while the memory of the node is constrained, in
+	// which case orphans are not kept and free transactions are rejected.
+	constrained bool
+
+	// lockedOutpoints holds the outpoints reserved with LockOutpoints whose
+	// spends are rejected until they are unlocked.
+	lockedOutpoints map[wire.OutPoint]struct{}
 }
 
 // Ensure the TxPool type implements the mining.TxSource interface.
@@ -261,7 +431,7 @@ func (mp *TxPool) limitNumOrphans() error {
 	// Scan through the orphan pool and remove any expired orphans when it's
 	// time.  This is done for efficiency so the scan only happens
 	// periodically instead of on every orphan added to the pool.
-	if now := time.Now(); now.After(mp.nextExpireScan) {
+	if now := mp.cfg.Clock.Now(); now.After(mp.nextExpireScan) {
 		origNumOrphans := len(mp.orphans)
 		for _, otx := range mp.orphans {
 			if now.After(otx.expiration) {
@@ -311,7 +481,7 @@ func (mp *TxPool) limitNumOrphans() error {
 // This function MUST be called with the mempool lock held (for writes).
 func (mp *TxPool) addOrphan(tx *bchutil.Tx, tag Tag) {
 	// Nothing to do if no orphans are allowed.
-	if mp.cfg.Policy.MaxOrphanTxs <= 0 {
+	if mp.cfg.Policy.MaxOrphanTxs <= 0 || mp.constrained {
 		return
 	}
 
@@ -323,7 +493,7 @@ func (mp *TxPool) addOrphan(tx *bchutil.Tx, tag Tag) {
 	mp.orphans[*tx.Hash()] = &orphanTx{
 		tx:         tx,
 		tag:        tag,
-		expiration: time.Now().Add(orphanTTL),
+		expiration: mp.cfg.Clock.Now().Add(orphanTTL),
 	}
 	for _, txIn := range tx.MsgTx().TxIn {
 		if _, exists := mp.orphansByPrev[txIn.PreviousOutPoint]; !exists {
@@ -470,18 +640,68 @@ func (mp *TxPool) removeTransaction(tx *bchutil.Tx, removeRedeemers bool) {
 
 	// Remove the transaction if needed.
 	if txDesc, exists := mp.pool[*txHash]; exists {
+		// When the transaction is removed because it was mined, the
+		// outputs spent by other transactions in the pool are now
+		// confirmed, so add them to the cached input values of those
+		// transactions.
+		if !removeRedeemers && !mp.cfg.Policy.FeeOnly {
+			mp.confirmPoolOutputs(txDesc.Tx, mp.cfg.BestHeight())
+		}
+
 		// Remove unconfirmed address index entries associated with the
 		// transaction if enabled.
 		if mp.cfg.AddrIndex != nil {
 			mp.cfg.AddrIndex.RemoveUnconfirmedTx(txHash)
 		}
 
-		// Mark the referenced outpoints as unspent by the pool.
+		// Mark the referenced outpoints as unspent by the pool and drop
+		// the proofs of their double spends.
 		for _, txIn := range txDesc.Tx.MsgTx().TxIn {
 			delete(mp.outpoints, txIn.PreviousOutPoint)
 		}
+		mp.dsProofs.removeTx(txDesc.Tx)
+		if mp.cfg.Policy.MaxTokenCategoryTxs > 0 {
+			for category := range tokenCategories(txDesc.Tx) {
+				mp.tokenCategoryTxs[category]--
+				if mp.tokenCategoryTxs[category] <= 0 {
+					delete(mp.tokenCategoryTxs, category)
+				}
+			}
+		}
 		delete(mp.pool, *txHash)
-		atomic.StoreInt64(&mp.lastUpdated, time.Now().Unix())
+		mp.poolSize -= int64(txDesc.Tx.MsgTx().SerializeSize())
+		mp.generation++
+
+		// The partial sighashes computed when the transaction was
+		// accepted are kept for when it is validated as part of a
+		// block.  They are no longer needed once it leaves the pool.
+		if mp.cfg.HashCache != nil {
+			mp.cfg.HashCache.PurgeSigHashes(txHash)
+		}
+		atomic.StoreInt64(&mp.lastUpdated, mp.cfg.Clock.Now().Unix())
+	}
+
+	// The fee delta of a transaction is no longer needed once it is mined.
+	if !removeRedeemers {
+		delete(mp.feeDeltas, *txHash)
+	}
+}
+
+// confirmPoolOutputs adds the outputs of the passed transaction which was
+// confirmed at the passed height to the cached input values of the pool
+// transactions which spend them.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (mp *TxPool) confirmPoolOutputs(tx *bchutil.Tx, height int32) {
+	for i, txOut := range tx.MsgTx().TxOut {
+		prevOut := wire.OutPoint{Hash: *tx.Hash(), Index: uint32(i)}
+		txRedeemer, exists := mp.outpoints[prevOut]
+		if !exists {
+			continue
+		}
+		if redeemerDesc, exists := mp.pool[*txRedeemer.Hash()]; exists {
+			redeemerDesc.addConfirmedInput(txOut.Value, height)
+		}
 	}
 }
 
@@ -498,6 +718,37 @@ func (mp *TxPool) RemoveTransaction(tx *bchutil.Tx, removeRedeemers bool) {
 	mp.mtx.Unlock()
 }
 
+// PrioritiseTransaction adds the passed fee delta to the fee of the transaction
+// with the passed hash when selecting the transactions of new blocks.  The
+// transaction doesn't need to be in the pool yet, the delta is applied once it
+// is accepted and kept until it is mined.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) PrioritiseTransaction(hash *chainhash.Hash, feeDelta int64) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	delta := mp.feeDeltas[*hash] + feeDelta
+	if delta == 0 {
+		delete(mp.feeDeltas, *hash)
+	} else {
+		mp.feeDeltas[*hash] = delta
+	}
+
+	// Replace the descriptor of a pool transaction instead of updating it
+	// since the mining descriptors handed out are read without the lock.
+	if txDesc, exists := mp.pool[*hash]; exists {
+		newDesc := *txDesc
+		newDesc.FeeDelta = delta
+		mp.pool[*hash] = &newDesc
+		mp.backlogMtx.Lock()
+		mp.backlogCache = nil
+		mp.backlogMtx.Unlock()
+		atomic.StoreInt64(&mp.lastUpdated, mp.cfg.Clock.Now().Unix())
+	}
+	log.Debugf("Set fee delta of transaction %v to %d", hash, delta)
+}
+
 // RemoveDoubleSpends removes all transactions which spend outputs spent by the
 // passed transaction from the memory pool.  Removing those transactions then
 // leads to removing all transactions which rely on them, recursively.  This is
@@ -511,6 +762,7 @@ func (mp *TxPool) RemoveDoubleSpends(tx *bchutil.Tx) {
 	for _, txIn := range tx.MsgTx().TxIn {
 		if txRedeemer, ok := mp.outpoints[txIn.PreviousOutPoint]; ok {
 			if !txRedeemer.Hash().IsEqual(tx.Hash()) {
+				mp.conflicts.add(*tx.Hash(), *txRedeemer.Hash())
 				mp.removeTransaction(txRedeemer, true)
 			}
 		}
@@ -529,19 +781,42 @@ func (mp *TxPool) addTransaction(utxoView *blockchain.UtxoViewpoint, tx *bchutil
 	txD := &TxDesc{
 		TxDesc: mining.TxDesc{
 			Tx:       tx,
-			Added:    time.Now(),
+			Added:    mp.cfg.Clock.Now(),
 			Height:   height,
 			Fee:      fee,
 			FeePerKB: fee * 1000 / int64(tx.MsgTx().SerializeSize()),
+			FeeDelta: mp.feeDeltas[*tx.Hash()],
 		},
-		StartingPriority: mining.CalcPriority(tx.MsgTx(), utxoView, height),
+	}
+	// Cache the values of the confirmed inputs so the current priority can
+	// be calculated cheaply.  Inputs which are still in the pool don't age
+	// until they are mined.
+	if !mp.cfg.Policy.FeeOnly {
+		txD.prioritySize = mining.PrioritySize(tx.MsgTx())
+		for _, txIn := range tx.MsgTx().TxIn {
+			entry := utxoView.LookupEntry(txIn.PreviousOutPoint)
+			if entry == nil || entry.IsSpent() ||
+				entry.BlockHeight() == mining.UnminedHeight {
+
+				continue
+			}
+			txD.addConfirmedInput(entry.Amount(), entry.BlockHeight())
+		}
+		txD.StartingPriority = txD.currentPriority(height)
 	}
 
 	mp.pool[*tx.Hash()] = txD
+	mp.poolSize += int64(tx.MsgTx().SerializeSize())
+	mp.generation++
 	for _, txIn := range tx.MsgTx().TxIn {
 		mp.outpoints[txIn.PreviousOutPoint] = tx
 	}
-	atomic.StoreInt64(&mp.lastUpdated, time.Now().Unix())
+	if mp.cfg.Policy.MaxTokenCategoryTxs > 0 {
+		for category := range tokenCategories(tx) {
+			mp.tokenCategoryTxs[category]++
+		}
+	}
+	atomic.StoreInt64(&mp.lastUpdated, mp.cfg.Clock.Now().Unix())
 
 	// Add unconfirmed address index entries associated with the transaction
 	// if enabled.
@@ -557,15 +832,90 @@ func (mp *TxPool) addTransaction(utxoView *blockchain.UtxoViewpoint, tx *bchutil
 	return txD
 }
 
+// tokenCategories returns the set of CashToken categories of the outputs of
+// the passed transaction.
+func tokenCategories(tx *bchutil.Tx) map[chainhash.Hash]struct{} {
+	var categories map[chainhash.Hash]struct{}
+	for _, txOut := range tx.MsgTx().TxOut {
+		if txOut.TokenData.IsEmpty() {
+			continue
+		}
+		if categories == nil {
+			categories = make(map[chainhash.Hash]struct{})
+		}
+		categories[txOut.TokenData.CategoryID] = struct{}{}
+	}
+	return categories
+}
+
+// checkTokenPolicy checks the passed transaction against the CashToken
+// category limits of the mempool policy.  It returns whether the transaction
+// creates a new category so the caller can count it once it is accepted.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) checkTokenPolicy(tx *bchutil.Tx, utxoView *blockchain.UtxoViewpoint, nextBlockHeight int32) (bool, error) {
+	categories := tokenCategories(tx)
+	if len(categories) == 0 {
+		return false, nil
+	}
+
+	if limit := mp.cfg.Policy.MaxTokenCategoryTxs; limit > 0 {
+		for category := range categories {
+			if mp.tokenCategoryTxs[category] >= limit {
+				str := fmt.Sprintf("transaction %v has outputs of "+
+					"token category %v which already has %d "+
+					"transactions in the pool", tx.Hash(),
+					category, limit)
+				return false, txRuleError(wire.RejectNonstandard, str)
+			}
+		}
+	}
+
+	// A category which is not carried by any of the inputs is created by
+	// this transaction.
+	for _, txIn := range tx.MsgTx().TxIn {
+		entry := utxoView.LookupEntry(txIn.PreviousOutPoint)
+		if entry == nil {
+			continue
+		}
+		tokenData := entry.TokenData()
+		if !tokenData.IsEmpty() {
+			delete(categories, tokenData.CategoryID)
+		}
+	}
+	if len(categories) == 0 {
+		return false, nil
+	}
+
+	// The genesis count starts over with each block.
+	genesisTxs := mp.tokenGenesisTxs
+	if mp.tokenGenesisHeight != nextBlockHeight {
+		genesisTxs = 0
+	}
+	if limit := mp.cfg.Policy.MaxTokenGenesisTxs; limit > 0 &&
+		genesisTxs >= limit {
+
+		str := fmt.Sprintf("transaction %v creates a token category "+
+			"but %d token genesis transactions have already been "+
+			"accepted since the last block", tx.Hash(), limit)
+		return false, txRuleError(wire.RejectNonstandard, str)
+	}
+	return true, nil
+}
+
 // checkPoolDoubleSpend checks whether or not the passed transaction is
 // attempting to spend coins already spent by other transactions in the pool.
 // Note it does not check for double spends against transactions already in the
-// main chain.
+// main chain.  Detected double spends are recorded so they are reported by
+// Conflicts.
 //
 // This function MUST be called with the mempool lock held (for reads).
 func (mp *TxPool) checkPoolDoubleSpend(tx *bchutil.Tx) error {
 	for _, txIn := range tx.MsgTx().TxIn {
 		if txR, exists := mp.outpoints[txIn.PreviousOutPoint]; exists {
+			mp.conflicts.add(*tx.Hash(), *txR.Hash())
+			mp.maybeAddDoubleSpendProof(txR, tx,
+				txIn.PreviousOutPoint)
 			str := fmt.Sprintf("output %v already spent by "+
 				"transaction %v in the memory pool",
 				txIn.PreviousOutPoint, txR.Hash())
@@ -576,6 +926,77 @@ func (mp *TxPool) checkPoolDoubleSpend(tx *bchutil.Tx) error {
 	return nil
 }
 
+// checkLockedOutpoints returns an OutpointLockedError wrapped in a RuleError
+// when the passed transaction spends an outpoint locked with LockOutpoints.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) checkLockedOutpoints(tx *bchutil.Tx) error {
+	if len(mp.lockedOutpoints) == 0 {
+		return nil
+	}
+	for _, txIn := range tx.MsgTx().TxIn {
+		if _, ok := mp.lockedOutpoints[txIn.PreviousOutPoint]; ok {
+			return RuleError{Err: OutpointLockedError{
+				Outpoint: txIn.PreviousOutPoint,
+			}}
+		}
+	}
+	return nil
+}
+
+// LockOutpoints reserves the passed outpoints so transactions spending them are
+// rejected with an OutpointLockedError until they are unlocked with
+// UnlockOutpoints.  It lets external services, such as a co-located wallet or a
+// payment channel manager, keep the outpoints they are coordinating a spend of
+// from being spent by others in the meantime.  Locks aren't counted, so the
+// outpoints are unlocked by the first UnlockOutpoints call for them, and they
+// don't apply to the transactions of the blocks.
+//
+// An error is returned and none of the outpoints are locked when any of them is
+// already spent by a transaction in the pool.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) LockOutpoints(outpoints []wire.OutPoint) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	for _, op := range outpoints {
+		if txR, exists := mp.outpoints[op]; exists {
+			return fmt.Errorf("output %v already spent by transaction "+
+				"%v in the memory pool", op, txR.Hash())
+		}
+	}
+	for _, op := range outpoints {
+		mp.lockedOutpoints[op] = struct{}{}
+	}
+	mp.generation++
+	return nil
+}
+
+// UnlockOutpoints releases the passed outpoints locked with LockOutpoints so
+// they can be spent again.  Outpoints which aren't locked are ignored.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) UnlockOutpoints(outpoints []wire.OutPoint) {
+	mp.mtx.Lock()
+	for _, op := range outpoints {
+		delete(mp.lockedOutpoints, op)
+	}
+	mp.mtx.Unlock()
+}
+
+// IsOutpointLocked returns whether the passed outpoint is locked with
+// LockOutpoints.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) IsOutpointLocked(outpoint wire.OutPoint) bool {
+	mp.mtx.RLock()
+	_, locked := mp.lockedOutpoints[outpoint]
+	mp.mtx.RUnlock()
+
+	return locked
+}
+
 // CheckSpend checks whether the passed outpoint is already spent by a
 // transaction in the mempool. If that's the case the spending transaction will
 // be returned, if not nil will be returned.
@@ -600,6 +1021,19 @@ func (mp *TxPool) FetchInputUtxos(tx *bchutil.Tx) (*blockchain.UtxoViewpoint, er
 	return mp.fetchInputUtxos(tx)
 }
 
+// CheckTokenBurns returns the CashTokens destroyed by the passed transaction,
+// with the outputs it spends taken from the main chain and the transactions of
+// the pool.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) CheckTokenBurns(tx *bchutil.Tx) ([]*blockchain.TokenBurn, error) {
+	utxoView, err := mp.FetchInputUtxos(tx)
+	if err != nil {
+		return nil, err
+	}
+	return blockchain.CheckTokenBurns(tx, utxoView), nil
+}
+
 // fetchInputUtxos loads utxo details about the input transactions referenced by
 // the passed transaction.  First, it loads the details form the viewpoint of
 // the main chain, then it adjusts them based upon the contents of the
@@ -709,13 +1143,43 @@ func (mp *TxPool) FetchTxDesc(txHash *chainhash.Hash) (*TxDesc, error) {
 	return nil, fmt.Errorf("transaction is not in the pool")
 }
 
-// maybeAcceptTransaction is the internal function which implements the public
-// MaybeAcceptTransaction.  See the comment for MaybeAcceptTransaction for
-// more details.
+// txCheck houses the results of checking a transaction against the memory pool
+// and the main chain which are needed to validate its scripts and add it to
+// the pool.
+type txCheck struct {
+	// missingParents holds the hashes of the transactions with outputs
+	// spent by the transaction which are neither in the pool nor in the
+	// main chain.  The remaining fields are only set when it is empty.
+	missingParents []*chainhash.Hash
+
+	utxoView       *blockchain.UtxoViewpoint
+	bestHash       chainhash.Hash
+	bestHeight     int32
+	scriptFlags    txscript.ScriptFlags
+	txFee          int64
+	minFee         int64
+	isTokenGenesis bool
+
+	// generation is the generation of the pool the transaction was
+	// checked against.
+	generation uint64
+}
+
+// checkTransaction performs all of the checks for accepting the passed
+// transaction into the memory pool except for validating its scripts and rate
+// limiting free transactions, which are done by validateScripts and
+// addCheckedTransaction respectively.  Non-standard transactions are accepted
+// when either the policy of the pool or the allowNonStd flag allows them.
 //
-// This function MUST be called with the mempool lock held (for writes).
-func (mp *TxPool) maybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejectDupOrphans bool) ([]*chainhash.Hash, *TxDesc, error) {
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) checkTransaction(tx *bchutil.Tx, isNew, rejectDupOrphans, allowNonStd bool) (*txCheck, error) {
 	txHash := tx.Hash()
+	acceptNonStd := mp.cfg.Policy.AcceptNonStd || allowNonStd
+
+	// Note the tip of the main chain before anything is read from it so a
+	// block connected or disconnected while the transaction is checked is
+	// detected once the result is acted on.
+	bestHash := *mp.cfg.BestHash()
 
 	// Don't accept the transaction if it already exists in the pool.  This
 	// applies to orphan transactions as well when the reject duplicate
@@ -725,7 +1189,7 @@ func (mp *TxPool) maybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejec
 		mp.isOrphanInPool(txHash)) {
 
 		str := fmt.Sprintf("already have transaction %v", txHash)
-		return nil, nil, txRuleError(wire.RejectDuplicate, str)
+		return nil, txRuleError(wire.RejectDuplicate, str)
 	}
 
 	medianTimePast := mp.cfg.MedianTimePast()
@@ -741,11 +1205,11 @@ func (mp *TxPool) maybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejec
 		magneticAnomalyActive = true
 	}
 	upgrade9Active := false
-	if nextBlockHeight > mp.cfg.ChainParams.Upgrade9ForkHeight {
+	if nextBlockHeight > mp.cfg.ChainParams.Upgrade9Activation() {
 		upgrade9Active = true
 	}
 
-	upgrade11Active := medianTimePast.Unix() >= int64(mp.cfg.ChainParams.Upgrade11ActivationTime)
+	upgrade11Active := medianTimePast.Unix() >= int64(mp.cfg.ChainParams.Upgrade11Activation())
 
 	scriptFlags := txscript.StandardVerifyFlags
 	if !mp.cfg.Policy.LimitSigChecks {
@@ -758,11 +1222,12 @@ func (mp *TxPool) maybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejec
 
 	if upgrade11Active {
 		scriptFlags |= txscript.ScriptAllowMay2025
-		if !mp.cfg.Policy.AcceptNonStd {
+		if !acceptNonStd {
 			scriptFlags |= txscript.ScriptAllowMay2025StandardOnly
 		}
 
 	}
+	scriptFlags = mp.cfg.ScriptFlagOverrides.Apply(scriptFlags)
 
 	// Perform preliminary sanity checks on the transaction.  This makes
 	// use of blockchain which contains the invariant rules for what
@@ -770,24 +1235,23 @@ func (mp *TxPool) maybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejec
 	err := blockchain.CheckTransactionSanity(tx, magneticAnomalyActive, upgrade9Active, scriptFlags)
 	if err != nil {
 		if cerr, ok := err.(blockchain.RuleError); ok {
-			return nil, nil, chainRuleError(cerr)
+			return nil, chainRuleError(cerr)
 		}
-		return nil, nil, err
+		return nil, err
 	}
 
 	// A standalone transaction must not be a coinbase transaction.
 	if blockchain.IsCoinBase(tx) {
 		str := fmt.Sprintf("transaction %v is an individual coinbase",
 			txHash)
-		return nil, nil, txRuleError(wire.RejectInvalid, str)
+		return nil, txRuleError(wire.RejectInvalid, str)
 	}
 
 	// Don't allow non-standard transactions if the network parameters
 	// forbid their acceptance.
-	if !mp.cfg.Policy.AcceptNonStd {
+	if !acceptNonStd {
 		err = checkTransactionStandard(tx, nextBlockHeight,
-			medianTimePast, mp.cfg.Policy.MinRelayTxFee,
-			mp.cfg.Policy.MaxTxVersion, upgrade9Active)
+			medianTimePast, &mp.cfg.Policy, upgrade9Active)
 		if err != nil {
 			// Attempt to extract a reject code from the error so
 			// it can be retained.  When not possible, fall back to
@@ -798,7 +1262,7 @@ func (mp *TxPool) maybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejec
 			}
 			str := fmt.Sprintf("transaction %v is not standard: %v",
 				txHash, err)
-			return nil, nil, txRuleError(rejectCode, str)
+			return nil, txRuleError(rejectCode, str)
 		}
 	}
 
@@ -812,7 +1276,14 @@ func (mp *TxPool) maybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejec
 	// which examines the actual spend data and prevents double spends.
 	err = mp.checkPoolDoubleSpend(tx)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+
+	// The transaction may not spend outputs reserved by an external
+	// service with LockOutpoints.
+	err = mp.checkLockedOutpoints(tx)
+	if err != nil {
+		return nil, err
 	}
 
 	// Fetch all of the unspent transaction outputs referenced by the inputs
@@ -822,9 +1293,9 @@ func (mp *TxPool) maybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejec
 	utxoView, err := mp.fetchInputUtxos(tx)
 	if err != nil {
 		if cerr, ok := err.(blockchain.RuleError); ok {
-			return nil, nil, chainRuleError(cerr)
+			return nil, chainRuleError(cerr)
 		}
-		return nil, nil, err
+		return nil, err
 	}
 
 	// Don't allow the transaction if it exists in the main chain and is not
@@ -834,7 +1305,7 @@ func (mp *TxPool) maybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejec
 		prevOut.Index = uint32(txOutIdx)
 		entry := utxoView.LookupEntry(prevOut)
 		if entry != nil && !entry.IsSpent() {
-			return nil, nil, txRuleError(wire.RejectDuplicate,
+			return nil, txRuleError(wire.RejectDuplicate,
 				"transaction already exists")
 		}
 		utxoView.RemoveEntry(prevOut)
@@ -856,7 +1327,12 @@ func (mp *TxPool) maybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejec
 		}
 	}
 	if len(missingParents) > 0 {
-		return missingParents, nil, nil
+		return &txCheck{
+			missingParents: missingParents,
+			bestHash:       bestHash,
+			bestHeight:     bestHeight,
+			generation:     mp.generation,
+		}, nil
 	}
 
 	// Don't allow the transaction into the mempool unless its sequence
@@ -865,13 +1341,13 @@ func (mp *TxPool) maybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejec
 	sequenceLock, err := mp.cfg.CalcSequenceLock(tx, utxoView)
 	if err != nil {
 		if cerr, ok := err.(blockchain.RuleError); ok {
-			return nil, nil, chainRuleError(cerr)
+			return nil, chainRuleError(cerr)
 		}
-		return nil, nil, err
+		return nil, err
 	}
 	if !blockchain.SequenceLockActive(sequenceLock, nextBlockHeight,
 		medianTimePast) {
-		return nil, nil, txRuleError(wire.RejectNonstandard,
+		return nil, txRuleError(wire.RejectNonstandard,
 			"transaction's sequence locks on inputs not met")
 	}
 
@@ -883,14 +1359,14 @@ func (mp *TxPool) maybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejec
 		utxoView, mp.cfg.ChainParams)
 	if err != nil {
 		if cerr, ok := err.(blockchain.RuleError); ok {
-			return nil, nil, chainRuleError(cerr)
+			return nil, chainRuleError(cerr)
 		}
-		return nil, nil, err
+		return nil, err
 	}
 
 	// Don't allow transactions with non-standard inputs if the network
 	// parameters forbid their acceptance.
-	if !mp.cfg.Policy.AcceptNonStd {
+	if !acceptNonStd {
 		err := checkInputsStandard(tx, utxoView, scriptFlags)
 		if err != nil {
 			// Attempt to extract a reject code from the error so
@@ -902,7 +1378,18 @@ func (mp *TxPool) maybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejec
 			}
 			str := fmt.Sprintf("transaction %v has a non-standard "+
 				"input: %v", txHash, err)
-			return nil, nil, txRuleError(rejectCode, str)
+			return nil, txRuleError(rejectCode, str)
+		}
+	}
+
+	// Don't allow new transactions exceeding the CashToken category limits.
+	// Transactions added back to the pool from disconnected blocks are
+	// exempted.
+	var isTokenGenesis bool
+	if isNew {
+		isTokenGenesis, err = mp.checkTokenPolicy(tx, utxoView, nextBlockHeight)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -924,28 +1411,118 @@ func (mp *TxPool) maybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejec
 		str := fmt.Sprintf("transaction %v has %d fees which is under "+
 			"the required amount of %d", txHash, txFee,
 			minFee)
-		return nil, nil, txRuleError(wire.RejectInsufficientFee, str)
+		return nil, txRuleError(wire.RejectInsufficientFee, str)
+	}
+
+	// Don't allow new transactions paying less than the minimum fee raised
+	// by evicting transactions to keep the pool within its size limit.
+	// Unlike the minimum relay fee, it applies to small transactions too
+	// since they would only be evicted again.
+	if isNew {
+		rollingFee := mp.rollingMinFeeRate(mp.cfg.Clock.Now())
+		poolMinFee := calcMinRequiredTxRelayFee(serializedSize,
+			bchutil.Amount(rollingFee))
+		if rollingFee > 0 && txFee+mp.feeDeltas[*txHash] < poolMinFee {
+			str := fmt.Sprintf("transaction %v has %d fees which is "+
+				"under the mempool minimum fee of %d", txHash,
+				txFee, poolMinFee)
+			return nil, txRuleError(wire.RejectInsufficientFee, str)
+		}
 	}
 
 	// Require that free transactions have sufficient priority to be mined
 	// in the next block.  Transactions which are being added back to the
 	// memory pool from blocks that have been disconnected during a reorg
-	// are exempted.
-	if isNew && !mp.cfg.Policy.DisableRelayPriority && txFee < minFee {
+	// are exempted.  Priority is never required when the legacy priority
+	// logic is disabled.
+	if isNew && !mp.cfg.Policy.DisableRelayPriority &&
+		!mp.cfg.Policy.FeeOnly && txFee < minFee {
+
 		currentPriority := mining.CalcPriority(tx.MsgTx(), utxoView,
 			nextBlockHeight)
 		if currentPriority <= mining.MinHighPriority {
 			str := fmt.Sprintf("transaction %v has insufficient "+
 				"priority (%g <= %g)", txHash,
 				currentPriority, mining.MinHighPriority)
-			return nil, nil, txRuleError(wire.RejectInsufficientFee, str)
+			return nil, txRuleError(wire.RejectInsufficientFee, str)
+		}
+	}
+
+	return &txCheck{
+		utxoView:       utxoView,
+		bestHash:       bestHash,
+		bestHeight:     bestHeight,
+		scriptFlags:    scriptFlags,
+		txFee:          txFee,
+		minFee:         minFee,
+		isTokenGenesis: isTokenGenesis,
+		generation:     mp.generation,
+	}, nil
+}
+
+// validateScripts verifies the crypto signatures for each input of the passed
+// checked transaction and returns an error if any don't verify.
+//
+// This function is safe for concurrent access and does not require the
+// mempool lock.
+func (mp *TxPool) validateScripts(tx *bchutil.Tx, check *txCheck) error {
+	_, err := blockchain.ValidateTransactionScripts(tx, check.utxoView,
+		check.scriptFlags, mp.cfg.SigCache, mp.cfg.HashCache,
+		mp.cfg.ChainParams.Upgrade9Activation())
+	if err != nil {
+		if mp.cfg.HashCache != nil {
+			mp.cfg.HashCache.PurgeSigHashes(tx.Hash())
+		}
+		if cerr, ok := err.(blockchain.RuleError); ok {
+			return chainRuleError(cerr)
+		}
+		return err
+	}
+	return nil
+}
+
+// checkExternalPolicy consults the external policy check of the configuration,
+// if any, about the passed checked transaction and returns an error if it
+// rejects the transaction.  The transactions which are not new, which are those
+// of disconnected blocks, are not checked.
+//
+// This function is safe for concurrent access and MUST be called without the
+// mempool lock held.
+func (mp *TxPool) checkExternalPolicy(tx *bchutil.Tx, check *txCheck, isNew bool) error {
+	if mp.cfg.ExternalPolicyCheck == nil || !isNew {
+		return nil
+	}
+	if err := mp.cfg.ExternalPolicyCheck(tx, check.utxoView); err != nil {
+		if _, ok := err.(ExternalPolicyUnavailableError); ok {
+			return err
 		}
+		str := fmt.Sprintf("transaction %v rejected by the external "+
+			"policy: %v", tx.Hash(), err)
+		return txRuleError(wire.RejectNonstandard, str)
+	}
+	return nil
+}
+
+// addCheckedTransaction rate limits free transactions and adds the passed
+// transaction, which must have passed checkTransaction and validateScripts
+// against the current state of the pool, to the memory pool.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (mp *TxPool) addCheckedTransaction(tx *bchutil.Tx, check *txCheck, rateLimit bool) (*TxDesc, error) {
+	txHash := tx.Hash()
+
+	// Free-to-relay transactions are rejected altogether while the memory
+	// is constrained.
+	if rateLimit && check.txFee < check.minFee && mp.constrained {
+		str := fmt.Sprintf("transaction %v has been rejected due to low "+
+			"fees while memory is constrained", txHash)
+		return nil, txRuleError(wire.RejectInsufficientFee, str)
 	}
 
 	// Free-to-relay transactions are rate limited here to prevent
 	// penny-flooding with tiny transactions as a form of attack.
-	if rateLimit && txFee < minFee {
-		nowUnix := time.Now().Unix()
+	if rateLimit && check.txFee < check.minFee {
+		nowUnix := mp.cfg.Clock.Now().Unix()
 		// Decay passed data with an exponentially decaying ~10 minute
 		// window - matches bitcoind handling.
 		mp.pennyTotal *= math.Pow(1.0-1.0/600.0,
@@ -956,33 +1533,140 @@ func (mp *TxPool) maybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejec
 		if mp.pennyTotal >= mp.cfg.Policy.FreeTxRelayLimit*10*1000 {
 			str := fmt.Sprintf("transaction %v has been rejected "+
 				"by the rate limiter due to low fees", txHash)
-			return nil, nil, txRuleError(wire.RejectInsufficientFee, str)
+			return nil, txRuleError(wire.RejectInsufficientFee, str)
 		}
 		oldTotal := mp.pennyTotal
 
-		mp.pennyTotal += float64(serializedSize)
+		mp.pennyTotal += float64(tx.MsgTx().SerializeSize())
 		log.Tracef("rate limit: curTotal %v, nextTotal: %v, "+
 			"limit %v", oldTotal, mp.pennyTotal,
 			mp.cfg.Policy.FreeTxRelayLimit*10*1000)
 	}
 
-	// Verify crypto signatures for each input and reject the transaction if
-	// any don't verify.
-	_, err = blockchain.ValidateTransactionScripts(tx, utxoView, scriptFlags,
-		mp.cfg.SigCache, mp.cfg.HashCache, mp.cfg.ChainParams.Upgrade9ForkHeight)
-	if err != nil {
-		if cerr, ok := err.(blockchain.RuleError); ok {
-			return nil, nil, chainRuleError(cerr)
+	// Add to transaction pool.
+	txD := mp.addTransaction(check.utxoView, tx, check.bestHeight,
+		check.txFee)
+	if check.isTokenGenesis {
+		// The genesis count starts over with each block.
+		nextBlockHeight := check.bestHeight + 1
+		if mp.tokenGenesisHeight != nextBlockHeight {
+			mp.tokenGenesisHeight = nextBlockHeight
+			mp.tokenGenesisTxs = 0
 		}
-		return nil, nil, err
+		mp.tokenGenesisTxs++
 	}
 
-	// Add to transaction pool.
-	txD := mp.addTransaction(utxoView, tx, bestHeight, txFee)
+	// Keep the pool within its size limit, which may evict the transaction
+	// itself when it pays the lowest fee rate.
+	mp.limitPoolSize()
+	if _, exists := mp.pool[*txHash]; !exists {
+		if check.isTokenGenesis {
+			mp.tokenGenesisTxs--
+		}
+		str := fmt.Sprintf("transaction %v has been rejected because the "+
+			"mempool is full", txHash)
+		return nil, txRuleError(wire.RejectInsufficientFee, str)
+	}
 
 	log.Debugf("Accepted transaction %v (pool size: %v)", txHash,
 		len(mp.pool))
 
+	return txD, nil
+}
+
+// maybeAcceptTransaction is the internal function which implements the public
+// MaybeAcceptTransaction while the mempool lock is held throughout.  See the
+// comment for MaybeAcceptTransaction for more details.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (mp *TxPool) maybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejectDupOrphans bool) ([]*chainhash.Hash, *TxDesc, error) {
+	check, err := mp.checkTransaction(tx, isNew, rejectDupOrphans, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(check.missingParents) > 0 {
+		return check.missingParents, nil, nil
+	}
+	if err := mp.validateScripts(tx, check); err != nil {
+		return nil, nil, err
+	}
+	txD, err := mp.addCheckedTransaction(tx, check, rateLimit)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nil, txD, nil
+}
+
+// acceptTransaction is the internal function which implements the public
+// MaybeAcceptTransaction.  Unlike maybeAcceptTransaction, the transaction is
+// checked while holding the mempool lock for reads and its scripts, which are
+// the most expensive part of accepting a transaction, are validated without
+// holding the lock so several transactions can be validated concurrently.
+//
+// The lock is then acquired for writes and the checks are repeated when the
+// pool or the main chain changed in the meantime.  The scripts are only
+// validated again when the script flags changed, since the outputs spent by a
+// transaction are immutable once they are found.
+//
+// This function MUST be called without the mempool lock held and returns with
+// it held for writes so the caller can act on the result before the pool
+// changes.
+func (mp *TxPool) acceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejectDupOrphans, allowNonStd bool) ([]*chainhash.Hash, *TxDesc, error) {
+	mp.mtx.RLock()
+	check, err := mp.checkTransaction(tx, isNew, rejectDupOrphans, allowNonStd)
+	mp.mtx.RUnlock()
+
+	var scriptsValid bool
+	if err == nil && len(check.missingParents) == 0 {
+		err = mp.validateScripts(tx, check)
+		if err == nil {
+			err = mp.checkExternalPolicy(tx, check, isNew)
+		}
+		scriptsValid = err == nil
+	}
+
+	mp.mtx.Lock()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if check.generation != mp.generation ||
+		check.bestHash != *mp.cfg.BestHash() {
+
+		recheck, err := mp.checkTransaction(tx, isNew, rejectDupOrphans,
+			allowNonStd)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// The parents of the transaction were found in the meantime,
+		// so it is checked again from the start without the lock held
+		// when it must be checked with the external policy, which
+		// isn't consulted while holding the lock.
+		if len(recheck.missingParents) == 0 && !scriptsValid &&
+			isNew && mp.cfg.ExternalPolicyCheck != nil {
+
+			mp.mtx.Unlock()
+			return mp.acceptTransaction(tx, isNew, rateLimit,
+				rejectDupOrphans, allowNonStd)
+		}
+		if len(recheck.missingParents) == 0 && (!scriptsValid ||
+			recheck.scriptFlags != check.scriptFlags) {
+
+			if err := mp.validateScripts(tx, recheck); err != nil {
+				return nil, nil, err
+			}
+		}
+		check = recheck
+	}
+	if len(check.missingParents) > 0 {
+		return check.missingParents, nil, nil
+	}
+
+	txD, err := mp.addCheckedTransaction(tx, check, rateLimit)
+	if err != nil {
+		return nil, nil, err
+	}
 	return nil, txD, nil
 }
 
@@ -998,9 +1682,7 @@ func (mp *TxPool) maybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit, rejec
 //
 // This function is safe for concurrent access.
 func (mp *TxPool) MaybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit bool) ([]*chainhash.Hash, *TxDesc, error) {
-	// Protect concurrent access.
-	mp.mtx.Lock()
-	hashes, txD, err := mp.maybeAcceptTransaction(tx, isNew, rateLimit, true)
+	hashes, txD, err := mp.acceptTransaction(tx, isNew, rateLimit, true, false)
 	mp.mtx.Unlock()
 
 	return hashes, txD, err
@@ -1009,9 +1691,16 @@ func (mp *TxPool) MaybeAcceptTransaction(tx *bchutil.Tx, isNew, rateLimit bool)
 // processOrphans is the internal function which implements the public
 // ProcessOrphans.  See the comment for ProcessOrphans for more details.
 //
+// When the external policy check of the configuration is set, the orphans
+// whose parents are all available are removed from the orphan pool and
+// returned instead of being accepted, since the check is not consulted while
+// holding the lock.  They must be accepted with acceptOrphans once the lock is
+// released.
+//
 // This function MUST be called with the mempool lock held (for writes).
-func (mp *TxPool) processOrphans(acceptedTx *bchutil.Tx) []*TxDesc {
+func (mp *TxPool) processOrphans(acceptedTx *bchutil.Tx) ([]*TxDesc, []*bchutil.Tx) {
 	var acceptedTxns []*TxDesc
+	var deferredTxns []*bchutil.Tx
 
 	// Start with processing at least the passed transaction.
 	processList := list.New()
@@ -1042,6 +1731,21 @@ func (mp *TxPool) processOrphans(acceptedTx *bchutil.Tx) []*TxDesc {
 
 			// Potentially accept an orphan into the tx pool.
 			for _, tx := range orphans {
+				if mp.cfg.ExternalPolicyCheck != nil {
+					check, err := mp.checkTransaction(tx,
+						true, false, false)
+					if err != nil {
+						mp.removeOrphan(tx, true)
+						break
+					}
+					if len(check.missingParents) > 0 {
+						continue
+					}
+					mp.removeOrphan(tx, false)
+					deferredTxns = append(deferredTxns, tx)
+					break
+				}
+
 				missing, txD, err := mp.maybeAcceptTransaction(
 					tx, true, true, false)
 				if err != nil {
@@ -1086,6 +1790,38 @@ func (mp *TxPool) processOrphans(acceptedTx *bchutil.Tx) []*TxDesc {
 		mp.removeOrphanDoubleSpends(txD.Tx)
 	}
 
+	return acceptedTxns, deferredTxns
+}
+
+// acceptOrphans accepts the passed former orphans returned by processOrphans
+// into the pool, consulting the external policy check of the configuration
+// without the lock held, along with the orphans they make acceptable in turn.
+// The transactions which are not accepted are dropped.
+//
+// It returns a slice of transactions added to the mempool.
+//
+// This function MUST be called without the mempool lock held.
+func (mp *TxPool) acceptOrphans(txns []*bchutil.Tx) []*TxDesc {
+	var acceptedTxns []*TxDesc
+	for len(txns) > 0 {
+		tx := txns[0]
+		txns = txns[1:]
+
+		missing, txD, err := mp.acceptTransaction(tx, true, true, false,
+			false)
+		if err != nil || len(missing) > 0 {
+			mp.mtx.Unlock()
+			log.Debugf("Dropping orphan %v which was not accepted: %v",
+				tx.Hash(), err)
+			continue
+		}
+		acceptedTxns = append(acceptedTxns, txD)
+		newTxs, deferredTxs := mp.processOrphans(tx)
+		mp.mtx.Unlock()
+
+		acceptedTxns = append(acceptedTxns, newTxs...)
+		txns = append(txns, deferredTxs...)
+	}
 	return acceptedTxns
 }
 
@@ -1101,10 +1837,10 @@ func (mp *TxPool) processOrphans(acceptedTx *bchutil.Tx) []*TxDesc {
 // This function is safe for concurrent access.
 func (mp *TxPool) ProcessOrphans(acceptedTx *bchutil.Tx) []*TxDesc {
 	mp.mtx.Lock()
-	acceptedTxns := mp.processOrphans(acceptedTx)
+	acceptedTxns, deferredTxns := mp.processOrphans(acceptedTx)
 	mp.mtx.Unlock()
 
-	return acceptedTxns
+	return append(acceptedTxns, mp.acceptOrphans(deferredTxns)...)
 }
 
 // ProcessTransaction is the main workhorse for handling insertion of new
@@ -1119,16 +1855,35 @@ func (mp *TxPool) ProcessOrphans(acceptedTx *bchutil.Tx) []*TxDesc {
 //
 // This function is safe for concurrent access.
 func (mp *TxPool) ProcessTransaction(tx *bchutil.Tx, allowOrphan, rateLimit bool, tag Tag) ([]*TxDesc, error) {
-	log.Tracef("Processing transaction %v", tx.Hash())
+	return mp.processTransaction(tx, allowOrphan, rateLimit, false, tag)
+}
 
-	// Protect concurrent access.
-	mp.mtx.Lock()
-	defer mp.mtx.Unlock()
+// ProcessNonStandardTransaction is identical to ProcessTransaction except the
+// passed transaction is accepted even when it is not standard and the policy
+// of the pool doesn't accept non-standard transactions.  It is meant for the
+// transactions of trusted sources.  Orphans it makes acceptable are still
+// subject to the policy of the pool.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) ProcessNonStandardTransaction(tx *bchutil.Tx, allowOrphan, rateLimit bool, tag Tag) ([]*TxDesc, error) {
+	return mp.processTransaction(tx, allowOrphan, rateLimit, true, tag)
+}
 
-	// Potentially accept the transaction to the memory pool.
-	missingParents, txD, err := mp.maybeAcceptTransaction(tx, true, rateLimit,
-		true)
+// processTransaction is the internal function which implements the public
+// ProcessTransaction and ProcessNonStandardTransaction.  See the comment for
+// ProcessTransaction for more details.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) processTransaction(tx *bchutil.Tx, allowOrphan, rateLimit, allowNonStd bool, tag Tag) ([]*TxDesc, error) {
+	log.Tracef("Processing transaction %v", tx.Hash())
+
+	// Potentially accept the transaction to the memory pool.  This
+	// returns with the lock held so the orphans are handled against the
+	// same state of the pool.
+	missingParents, txD, err := mp.acceptTransaction(tx, true, rateLimit,
+		true, allowNonStd)
 	if err != nil {
+		mp.mtx.Unlock()
 		return nil, err
 	}
 
@@ -1137,7 +1892,9 @@ func (mp *TxPool) ProcessTransaction(tx *bchutil.Tx, allowOrphan, rateLimit bool
 		// transaction (they may no longer be orphans if all inputs
 		// are now available) and repeat for those accepted
 		// transactions until there are no more.
-		newTxs := mp.processOrphans(tx)
+		newTxs, deferredTxs := mp.processOrphans(tx)
+		mp.mtx.Unlock()
+		newTxs = append(newTxs, mp.acceptOrphans(deferredTxs)...)
 		acceptedTxs := make([]*TxDesc, len(newTxs)+1)
 
 		// Add the parent transaction first so remote nodes
@@ -1147,6 +1904,7 @@ func (mp *TxPool) ProcessTransaction(tx *bchutil.Tx, allowOrphan, rateLimit bool
 
 		return acceptedTxs, nil
 	}
+	defer mp.mtx.Unlock()
 
 	// The transaction is an orphan (has inputs missing).  Reject
 	// it if the flag to allow orphans is not set.
@@ -1171,6 +1929,25 @@ func (mp *TxPool) ProcessTransaction(tx *bchutil.Tx, allowOrphan, rateLimit bool
 	return nil, err
 }
 
+// SetConstrained tightens the policy of the pool while the memory of the node
+// is constrained.  The orphans are evicted and no longer kept and free
+// transactions are rejected, so the pool only grows with transactions paying
+// the minimum relay fee until the policy is relaxed again.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) SetConstrained(constrained bool) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.constrained = constrained
+	if !constrained {
+		return
+	}
+	for _, otx := range mp.orphans {
+		mp.removeOrphan(otx.tx, false)
+	}
+}
+
 // Count returns the number of transactions in the main pool.  It does not
 // include the orphan pool.
 //
@@ -1215,6 +1992,128 @@ func (mp *TxPool) txDescs() []*TxDesc {
 	return descs
 }
 
+// OrphanDesc is a descriptor containing a transaction in the orphan pool along
+// with the metadata needed to diagnose why it isn't accepted.
+type OrphanDesc struct {
+	// Tx is the orphan transaction.
+	Tx *bchutil.Tx
+
+	// Tag is the tag the orphan was added with, which is usually the ID of
+	// the peer which relayed it.
+	Tag Tag
+
+	// Expiration is the time the orphan is evicted at unless its parents
+	// are accepted first.
+	Expiration time.Time
+
+	// MissingParents holds the hashes of the transactions with outputs
+	// spent by the orphan which are neither in the main chain nor in the
+	// main pool.
+	MissingParents []chainhash.Hash
+}
+
+// poolMissingInputs returns the outputs spent by the passed transaction which
+// are not outputs of transactions in the main pool.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) poolMissingInputs(tx *bchutil.Tx) []wire.OutPoint {
+	var prevOuts []wire.OutPoint
+	for _, txIn := range tx.MsgTx().TxIn {
+		prevOut := txIn.PreviousOutPoint
+		poolTxDesc, exists := mp.pool[prevOut.Hash]
+		if exists && prevOut.Index < uint32(len(poolTxDesc.Tx.MsgTx().TxOut)) {
+			continue
+		}
+		prevOuts = append(prevOuts, prevOut)
+	}
+	return prevOuts
+}
+
+// missingParents returns the hashes of the transactions with the passed outputs
+// spent by the passed transaction which are not unspent in the main chain.  The
+// outputs are the ones not found in the main pool by poolMissingInputs.  The
+// main chain is looked up without the mempool lock so the database reads don't
+// hold up the pool.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) missingParents(tx *bchutil.Tx, prevOuts []wire.OutPoint) ([]chainhash.Hash, error) {
+	if len(prevOuts) == 0 {
+		return nil, nil
+	}
+	utxoView, err := mp.cfg.FetchUtxoView(tx)
+	if err != nil {
+		return nil, err
+	}
+	var missingParents []chainhash.Hash
+	seen := make(map[chainhash.Hash]struct{})
+	for _, prevOut := range prevOuts {
+		entry := utxoView.LookupEntry(prevOut)
+		if entry != nil && !entry.IsSpent() {
+			continue
+		}
+		if _, ok := seen[prevOut.Hash]; ok {
+			continue
+		}
+		seen[prevOut.Hash] = struct{}{}
+		missingParents = append(missingParents, prevOut.Hash)
+	}
+	return missingParents, nil
+}
+
+// OrphanMissingParents returns the hashes of the transactions with outputs
+// spent by the orphan with the passed hash which are neither in the main chain
+// nor in the main pool.  Nil is returned when the transaction isn't in the
+// orphan pool.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) OrphanMissingParents(hash *chainhash.Hash) ([]chainhash.Hash, error) {
+	mp.mtx.RLock()
+	otx, exists := mp.orphans[*hash]
+	if !exists {
+		mp.mtx.RUnlock()
+		return nil, nil
+	}
+	prevOuts := mp.poolMissingInputs(otx.tx)
+	mp.mtx.RUnlock()
+
+	return mp.missingParents(otx.tx, prevOuts)
+}
+
+// OrphanDescs returns a slice of descriptors for all the transactions in the
+// orphan pool sorted by expiration.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) OrphanDescs() ([]*OrphanDesc, error) {
+	// The orphans and their inputs missing from the main pool are
+	// collected with the lock held and the main chain is looked up after
+	// releasing it.
+	mp.mtx.RLock()
+	descs := make([]*OrphanDesc, 0, len(mp.orphans))
+	prevOuts := make([][]wire.OutPoint, 0, len(mp.orphans))
+	for _, otx := range mp.orphans {
+		descs = append(descs, &OrphanDesc{
+			Tx:         otx.tx,
+			Tag:        otx.tag,
+			Expiration: otx.expiration,
+		})
+		prevOuts = append(prevOuts, mp.poolMissingInputs(otx.tx))
+	}
+	mp.mtx.RUnlock()
+
+	for i, desc := range descs {
+		missingParents, err := mp.missingParents(desc.Tx, prevOuts[i])
+		if err != nil {
+			return nil, err
+		}
+		desc.MissingParents = missingParents
+	}
+	sort.Slice(descs, func(i, j int) bool {
+		return descs[i].Expiration.Before(descs[j].Expiration)
+	})
+
+	return descs, nil
+}
+
 // TxDescs returns a slice of descriptors for all the transactions in the pool.
 // The descriptors are to be treated as read only.
 //
@@ -1258,16 +2157,11 @@ func (mp *TxPool) RawMempoolVerbose() map[string]*btcjson.GetRawMempoolVerboseRe
 	bestHeight := mp.cfg.BestHeight()
 
 	for _, desc := range mp.pool {
-		// Calculate the current priority based on the inputs to
-		// the transaction.  Use zero if one or more of the
-		// input transactions can't be found for some reason.
+		// Calculate the current priority from the cached input
+		// values.  It is zero when the legacy priority logic is
+		// disabled.
 		tx := desc.Tx
-		var currentPriority float64
-		utxos, err := mp.fetchInputUtxos(tx)
-		if err == nil {
-			currentPriority = mining.CalcPriority(tx.MsgTx(), utxos,
-				bestHeight+1)
-		}
+		currentPriority := desc.currentPriority(bestHeight + 1)
 
 		mpd := &btcjson.GetRawMempoolVerboseResult{
 			Size:             int32(tx.MsgTx().SerializeSize()),
@@ -1394,12 +2288,19 @@ func (mp *TxPool) DecodeCompressedBlock(iBlock interface{}) (*wire.MsgBlock, err
 // New returns a new memory pool for validating and storing standalone
 // transactions until they are mined into a block.
 func New(cfg *Config) *TxPool {
+	txCfg := *cfg
+	if txCfg.Clock == nil {
+		txCfg.Clock = blockchain.SystemClock
+	}
 	return &TxPool{
-		cfg:            *cfg,
-		pool:           make(map[chainhash.Hash]*TxDesc),
-		orphans:        make(map[chainhash.Hash]*orphanTx),
-		orphansByPrev:  make(map[wire.OutPoint]map[chainhash.Hash]*bchutil.Tx),
-		nextExpireScan: time.Now().Add(orphanExpireScanInterval),
-		outpoints:      make(map[wire.OutPoint]*bchutil.Tx),
+		cfg:              txCfg,
+		pool:             make(map[chainhash.Hash]*TxDesc),
+		orphans:          make(map[chainhash.Hash]*orphanTx),
+		orphansByPrev:    make(map[wire.OutPoint]map[chainhash.Hash]*bchutil.Tx),
+		nextExpireScan:   txCfg.Clock.Now().Add(orphanExpireScanInterval),
+		outpoints:        make(map[wire.OutPoint]*bchutil.Tx),
+		tokenCategoryTxs: make(map[chainhash.Hash]int),
+		feeDeltas:        make(map[chainhash.Hash]int64),
+		lockedOutpoints:  make(map[wire.OutPoint]struct{}),
 	}
 }
@@ -5,9 +5,14 @@
 package mempool
 
 import (
+	"bytes"
 	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
 	"reflect"
 	"runtime"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -74,6 +79,16 @@ func (s *fakeChain) BestHeight() int32 {
 	return height
 }
 
+// BestHash returns the hash of the tip of the fake chain, which is derived from
+// its height.
+func (s *fakeChain) BestHash() *chainhash.Hash {
+	s.RLock()
+	height := s.currentHeight
+	s.RUnlock()
+	hash := chainhash.HashH([]byte(fmt.Sprintf("block %d", height)))
+	return &hash
+}
+
 // SetHeight sets the current height associated with the fake chain instance.
 func (s *fakeChain) SetHeight(height int32) {
 	s.Lock()
@@ -141,6 +156,7 @@ type poolHarness struct {
 	chainParams *chaincfg.Params
 
 	chain  *fakeChain
+	clock  *blockchain.MockClock
 	txPool *TxPool
 }
 
@@ -222,9 +238,10 @@ func (p *poolHarness) CreateSignedTx(inputs []spendableOutput, numOutputs uint32
 	}
 
 	// Sign the new transaction.
-	for i := range tx.TxIn {
-		sigScript, err := txscript.LegacySignatureScript(tx, i, p.payScript,
-			txscript.SigHashAll, p.signKey, true)
+	for i, input := range inputs {
+		sigScript, err := txscript.SignatureScript(tx, i,
+			int64(input.amount), p.payScript, txscript.SigHashAll,
+			p.signKey, true)
 		if err != nil {
 			return nil, err
 		}
@@ -303,6 +320,7 @@ func newPoolHarness(chainParams *chaincfg.Params) (*poolHarness, []spendableOutp
 
 	// Create a new fake chain and harness bound to it.
 	chain := &fakeChain{utxos: blockchain.NewUtxoViewpoint()}
+	clock := blockchain.NewMockClock(time.Now())
 	harness := poolHarness{
 		signKey:     signKey,
 		payAddr:     payAddr,
@@ -310,6 +328,7 @@ func newPoolHarness(chainParams *chaincfg.Params) (*poolHarness, []spendableOutp
 		chainParams: chainParams,
 
 		chain: chain,
+		clock: clock,
 		txPool: New(&Config{
 			Policy: Policy{
 				DisableRelayPriority: true,
@@ -323,7 +342,9 @@ func newPoolHarness(chainParams *chaincfg.Params) (*poolHarness, []spendableOutp
 			ChainParams:      chainParams,
 			FetchUtxoView:    chain.FetchUtxoView,
 			BestHeight:       chain.BestHeight,
+			BestHash:         chain.BestHash,
 			MedianTimePast:   chain.MedianTimePast,
+			Clock:            clock,
 			CalcSequenceLock: chain.CalcSequenceLock,
 			SigCache:         nil,
 			AddrIndex:        nil,
@@ -455,6 +476,243 @@ func TestSimpleOrphanChain(t *testing.T) {
 	}
 }
 
+// TestLockOutpoints ensures spends of locked outpoints are rejected with an
+// OutpointLockedError until the outpoints are unlocked, including when they
+// were checked before the outpoints were locked, and that outpoints already
+// spent in the pool can't be locked.
+func TestLockOutpoints(t *testing.T) {
+	t.Parallel()
+
+	harness, spendableOuts, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	tc := &testContext{t, harness}
+
+	tx, err := harness.CreateSignedTx(spendableOuts[:1], 1)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	outpoint := spendableOuts[0].outPoint
+
+	// Locking the outpoint changes the pool for the transactions checked
+	// before it was locked, so they are checked again before being added.
+	check, err := harness.txPool.checkTransaction(tx, true, true, false)
+	if err != nil {
+		t.Fatalf("checkTransaction: unexpected error: %v", err)
+	}
+	err = harness.txPool.LockOutpoints([]wire.OutPoint{outpoint})
+	if err != nil {
+		t.Fatalf("LockOutpoints: unexpected error: %v", err)
+	}
+	if check.generation == harness.txPool.generation {
+		t.Fatal("LockOutpoints: pool generation not changed")
+	}
+	if !harness.txPool.IsOutpointLocked(outpoint) {
+		t.Fatal("IsOutpointLocked: outpoint not reported as locked")
+	}
+
+	// The spend of the locked outpoint is rejected with a distinct error.
+	_, err = harness.txPool.ProcessTransaction(tx, true, false, 0)
+	ruleErr, ok := err.(RuleError)
+	if !ok {
+		t.Fatalf("ProcessTransaction: unexpected error type %T: %v",
+			err, err)
+	}
+	lockedErr, ok := ruleErr.Err.(OutpointLockedError)
+	if !ok {
+		t.Fatalf("ProcessTransaction: unexpected rule error type %T: "+
+			"%v", ruleErr.Err, ruleErr.Err)
+	}
+	if lockedErr.Outpoint != outpoint {
+		t.Fatalf("ProcessTransaction: unexpected locked outpoint - got "+
+			"%v, want %v", lockedErr.Outpoint, outpoint)
+	}
+	testPoolMembership(tc, tx, false, false)
+
+	// The spend is accepted once the outpoint is unlocked, after which the
+	// outpoint can't be locked anymore.
+	harness.txPool.UnlockOutpoints([]wire.OutPoint{outpoint})
+	_, err = harness.txPool.ProcessTransaction(tx, true, false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept valid "+
+			"transaction: %v", err)
+	}
+	testPoolMembership(tc, tx, false, true)
+	err = harness.txPool.LockOutpoints([]wire.OutPoint{outpoint})
+	if err == nil {
+		t.Fatal("LockOutpoints: locked an outpoint spent in the pool")
+	}
+	if harness.txPool.IsOutpointLocked(outpoint) {
+		t.Fatal("IsOutpointLocked: spent outpoint reported as locked")
+	}
+}
+
+// TestConstrainedOrphans ensures the orphans are evicted and no longer kept
+// while the memory is constrained.
+func TestConstrainedOrphans(t *testing.T) {
+	t.Parallel()
+
+	harness, spendableOuts, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	tc := &testContext{t, harness}
+
+	chainedTxns, err := harness.CreateTxChain(spendableOuts[0], 3)
+	if err != nil {
+		t.Fatalf("unable to create transaction chain: %v", err)
+	}
+	_, err = harness.txPool.ProcessTransaction(chainedTxns[1], true,
+		false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept valid orphan %v",
+			err)
+	}
+	testPoolMembership(tc, chainedTxns[1], true, false)
+
+	// The orphan is evicted once the memory is constrained and the next
+	// one isn't kept.
+	harness.txPool.SetConstrained(true)
+	testPoolMembership(tc, chainedTxns[1], false, false)
+	_, err = harness.txPool.ProcessTransaction(chainedTxns[2], true,
+		false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to process orphan %v", err)
+	}
+	testPoolMembership(tc, chainedTxns[2], false, false)
+
+	// Orphans are kept again once the policy is relaxed.
+	harness.txPool.SetConstrained(false)
+	_, err = harness.txPool.ProcessTransaction(chainedTxns[2], true,
+		false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept valid orphan %v",
+			err)
+	}
+	testPoolMembership(tc, chainedTxns[2], true, false)
+}
+
+// TestOrphanExpiration ensures that orphans are expired once they have been in
+// the orphan pool for longer than the orphan TTL as measured by the clock of
+// the pool.
+func TestOrphanExpiration(t *testing.T) {
+	t.Parallel()
+
+	harness, spendableOuts, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	tc := &testContext{t, harness}
+
+	chainedTxns, err := harness.CreateTxChain(spendableOuts[0], 4)
+	if err != nil {
+		t.Fatalf("unable to create transaction chain: %v", err)
+	}
+	_, err = harness.txPool.ProcessTransaction(chainedTxns[1], true,
+		false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept valid orphan %v",
+			err)
+	}
+
+	// The orphan is still around when the next orphan is added before its
+	// TTL passes, even though the expiration scan runs.
+	harness.clock.Add(orphanTTL / 2)
+	_, err = harness.txPool.ProcessTransaction(chainedTxns[2], true,
+		false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept valid orphan %v",
+			err)
+	}
+	testPoolMembership(tc, chainedTxns[1], true, false)
+	testPoolMembership(tc, chainedTxns[2], true, false)
+
+	// Once its TTL passes, the first orphan is expired along with the
+	// orphan redeeming it when the next orphan is added.
+	harness.clock.Add(orphanTTL/2 + time.Second)
+	_, err = harness.txPool.ProcessTransaction(chainedTxns[3], true,
+		false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept valid orphan %v",
+			err)
+	}
+	testPoolMembership(tc, chainedTxns[1], false, false)
+	testPoolMembership(tc, chainedTxns[2], false, false)
+	testPoolMembership(tc, chainedTxns[3], true, false)
+}
+
+// TestOrphanDescs ensures the orphan descriptors report the tag, expiration and
+// missing parents of the orphans.
+func TestOrphanDescs(t *testing.T) {
+	t.Parallel()
+
+	harness, spendableOuts, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+
+	chainedTxns, err := harness.CreateTxChain(spendableOuts[0], 2)
+	if err != nil {
+		t.Fatalf("unable to create transaction chain: %v", err)
+	}
+	_, err = harness.txPool.ProcessTransaction(chainedTxns[1], true,
+		false, 7)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept valid orphan %v",
+			err)
+	}
+
+	descs, err := harness.txPool.OrphanDescs()
+	if err != nil {
+		t.Fatalf("OrphanDescs: unexpected error: %v", err)
+	}
+	if len(descs) != 1 {
+		t.Fatalf("OrphanDescs: unexpected number of orphans - got %d, "+
+			"want 1", len(descs))
+	}
+	desc := descs[0]
+	if !desc.Tx.Hash().IsEqual(chainedTxns[1].Hash()) {
+		t.Fatalf("OrphanDescs: unexpected orphan %v", desc.Tx.Hash())
+	}
+	if desc.Tag != 7 {
+		t.Fatalf("OrphanDescs: unexpected tag - got %d, want 7",
+			desc.Tag)
+	}
+	wantExpiration := harness.clock.Now().Add(orphanTTL)
+	if !desc.Expiration.Equal(wantExpiration) {
+		t.Fatalf("OrphanDescs: unexpected expiration - got %v, want %v",
+			desc.Expiration, wantExpiration)
+	}
+	if len(desc.MissingParents) != 1 ||
+		desc.MissingParents[0] != *chainedTxns[0].Hash() {
+
+		t.Fatalf("OrphanDescs: unexpected missing parents - got %v, "+
+			"want [%v]", desc.MissingParents, chainedTxns[0].Hash())
+	}
+
+	// Ensure the missing parents of the orphan are returned by hash and
+	// that nothing is returned for a transaction which isn't an orphan.
+	parents, err := harness.txPool.OrphanMissingParents(
+		chainedTxns[1].Hash())
+	if err != nil {
+		t.Fatalf("OrphanMissingParents: unexpected error: %v", err)
+	}
+	if len(parents) != 1 || parents[0] != *chainedTxns[0].Hash() {
+		t.Fatalf("OrphanMissingParents: unexpected missing parents - "+
+			"got %v, want [%v]", parents, chainedTxns[0].Hash())
+	}
+	parents, err = harness.txPool.OrphanMissingParents(
+		chainedTxns[0].Hash())
+	if err != nil {
+		t.Fatalf("OrphanMissingParents: unexpected error: %v", err)
+	}
+	if parents != nil {
+		t.Fatalf("OrphanMissingParents: unexpected missing parents "+
+			"for non-orphan - got %v, want none", parents)
+	}
+}
+
 // TestOrphanReject ensures that orphans are properly rejected when the allow
 // orphans flag is not set on ProcessTransaction.
 func TestOrphanReject(t *testing.T) {
@@ -870,6 +1128,383 @@ func TestCheckSpend(t *testing.T) {
 	}
 }
 
+// TestConcurrentAcceptance ensures transactions processed concurrently are
+// rechecked against the changes other transactions made to the pool while
+// their scripts were validated, so exactly one of each pair of conflicting
+// transactions is accepted and children submitted along with their parents
+// end up in the pool.
+func TestConcurrentAcceptance(t *testing.T) {
+	t.Parallel()
+
+	harness, coinbaseOuts, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+
+	// Split the coinbase output into several confirmed outputs.
+	splitTx, err := harness.CreateSignedTx(coinbaseOuts, 5)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	harness.chain.utxos.AddTxOuts(splitTx, harness.chain.BestHeight())
+	var outputs []spendableOutput
+	for i := range splitTx.MsgTx().TxOut {
+		outputs = append(outputs, txOutToSpendableOut(splitTx, uint32(i)))
+	}
+
+	// Create two conflicting transactions spending each of the spendable
+	// outputs but the last, which is spent by a chain of transactions.
+	var conflicts [][2]*bchutil.Tx
+	for _, output := range outputs[:len(outputs)-1] {
+		tx1, err := harness.CreateSignedTx([]spendableOutput{output}, 1)
+		if err != nil {
+			t.Fatalf("unable to create transaction: %v", err)
+		}
+		tx2, err := harness.CreateSignedTx([]spendableOutput{output}, 2)
+		if err != nil {
+			t.Fatalf("unable to create transaction: %v", err)
+		}
+		conflicts = append(conflicts, [2]*bchutil.Tx{tx1, tx2})
+	}
+	chainedTxns, err := harness.CreateTxChain(outputs[len(outputs)-1], 4)
+	if err != nil {
+		t.Fatalf("unable to create transaction chain: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	process := func(tx *bchutil.Tx) {
+		defer wg.Done()
+		harness.txPool.ProcessTransaction(tx, true, false, 0)
+	}
+	for _, pair := range conflicts {
+		wg.Add(2)
+		go process(pair[0])
+		go process(pair[1])
+	}
+	for i := len(chainedTxns) - 1; i >= 0; i-- {
+		wg.Add(1)
+		go process(chainedTxns[i])
+	}
+	wg.Wait()
+
+	for i, pair := range conflicts {
+		inPool1 := harness.txPool.IsTransactionInPool(pair[0].Hash())
+		inPool2 := harness.txPool.IsTransactionInPool(pair[1].Hash())
+		if inPool1 == inPool2 {
+			t.Errorf("conflicting transactions #%d: got %v and %v "+
+				"in pool, want exactly one", i, inPool1, inPool2)
+		}
+	}
+	for i, tx := range chainedTxns {
+		if !harness.txPool.IsTransactionInPool(tx.Hash()) {
+			t.Errorf("chained transaction #%d is not in the pool", i)
+		}
+	}
+	if count := harness.txPool.Count(); count != len(conflicts)+len(chainedTxns) {
+		t.Errorf("got %d transactions in the pool, want %d", count,
+			len(conflicts)+len(chainedTxns))
+	}
+}
+
+// TestFeeOnlyPolicy ensures the legacy priority logic is skipped when the pool
+// is configured with the fee-only policy.
+func TestFeeOnlyPolicy(t *testing.T) {
+	t.Parallel()
+
+	harness, outputs, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	harness.txPool.cfg.Policy.DisableRelayPriority = false
+
+	// Create a chain of zero-fee transactions.  The first one spends a
+	// mature coinbase and has enough priority to be relayed while the
+	// second one spends an unconfirmed output and has no priority.
+	chainedTxns, err := harness.CreateTxChain(outputs[0], 2)
+	if err != nil {
+		t.Fatalf("unable to create transaction chain: %v", err)
+	}
+	_, err = harness.txPool.ProcessTransaction(chainedTxns[0], false,
+		true, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+	}
+	_, err = harness.txPool.ProcessTransaction(chainedTxns[1], false,
+		true, 0)
+	if err == nil {
+		t.Fatal("ProcessTransaction: accepted tx without priority")
+	}
+
+	// The transaction without priority must be accepted within the free
+	// relay allowance once priorities are disabled.
+	harness.txPool.cfg.Policy.FeeOnly = true
+	_, err = harness.txPool.ProcessTransaction(chainedTxns[1], false,
+		true, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+	}
+	verbose := harness.txPool.RawMempoolVerbose()
+	result, ok := verbose[chainedTxns[1].Hash().String()]
+	if !ok {
+		t.Fatalf("RawMempoolVerbose: tx %v not found",
+			chainedTxns[1].Hash())
+	}
+	if result.CurrentPriority != 0 {
+		t.Fatalf("RawMempoolVerbose: unexpected current priority %v",
+			result.CurrentPriority)
+	}
+	desc, err := harness.txPool.FetchTxDesc(chainedTxns[1].Hash())
+	if err != nil {
+		t.Fatalf("FetchTxDesc: unexpected error: %v", err)
+	}
+	if desc.StartingPriority != 0 {
+		t.Fatalf("unexpected starting priority %v",
+			desc.StartingPriority)
+	}
+}
+
+// TestExternalPolicyCheck ensures the external policy check is consulted with
+// the outputs spent by a transaction without the mempool lock held, that
+// transactions it rejects are not accepted, including once they are no longer
+// orphans, and that it is not consulted for the transactions of disconnected
+// blocks.
+func TestExternalPolicyCheck(t *testing.T) {
+	t.Parallel()
+
+	harness, outputs, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	tc := &testContext{t, harness}
+
+	chainedTxns, err := harness.CreateTxChain(outputs[0], 3)
+	if err != nil {
+		t.Fatalf("unable to create transaction chain: %v", err)
+	}
+	rejected := *chainedTxns[2].Hash()
+	var unavailable bool
+	harness.txPool.cfg.ExternalPolicyCheck = func(tx *bchutil.Tx,
+		view *blockchain.UtxoViewpoint) error {
+
+		if !harness.txPool.mtx.TryLock() {
+			t.Errorf("external policy of %v consulted with the "+
+				"mempool lock held", tx.Hash())
+		} else {
+			harness.txPool.mtx.Unlock()
+		}
+		if unavailable {
+			return ExternalPolicyUnavailableError{
+				Err: errors.New("test policy unavailable"),
+			}
+		}
+		for _, txIn := range tx.MsgTx().TxIn {
+			if view.LookupEntry(txIn.PreviousOutPoint) == nil {
+				t.Errorf("spent output %v missing from the view",
+					txIn.PreviousOutPoint)
+			}
+		}
+		if *tx.Hash() == rejected {
+			return errors.New("blocked by test policy")
+		}
+		return nil
+	}
+
+	_, err = harness.txPool.ProcessTransaction(chainedTxns[0], false,
+		false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+	}
+	testPoolMembership(tc, chainedTxns[0], false, true)
+
+	// The rejected transaction is an orphan until its parent is accepted,
+	// at which point the policy rejects it.
+	_, err = harness.txPool.ProcessTransaction(chainedTxns[2], true,
+		false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept orphan: %v", err)
+	}
+	_, err = harness.txPool.ProcessTransaction(chainedTxns[1], false,
+		false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+	}
+	testPoolMembership(tc, chainedTxns[1], false, true)
+	testPoolMembership(tc, chainedTxns[2], false, false)
+
+	_, err = harness.txPool.ProcessTransaction(chainedTxns[2], false,
+		false, 0)
+	rerr, ok := err.(RuleError)
+	if !ok {
+		t.Fatalf("ProcessTransaction: unexpected error %v", err)
+	}
+	txErr, ok := rerr.Err.(TxRuleError)
+	if !ok || txErr.RejectCode != wire.RejectNonstandard ||
+		!strings.Contains(txErr.Description, "blocked by test policy") {
+
+		t.Fatalf("ProcessTransaction: unexpected error %v", err)
+	}
+	testPoolMembership(tc, chainedTxns[2], false, false)
+
+	// Transactions which can't be checked are not accepted, without
+	// being rejected by the policy, unless they are added back from a
+	// disconnected block.
+	unavailable = true
+	tx, err := harness.CreateSignedTx([]spendableOutput{
+		txOutToSpendableOut(chainedTxns[1], 0)}, 2)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	_, err = harness.txPool.ProcessTransaction(tx, false, false, 0)
+	if _, ok := err.(ExternalPolicyUnavailableError); !ok {
+		t.Fatalf("ProcessTransaction: unexpected error %v", err)
+	}
+	testPoolMembership(tc, tx, false, false)
+	_, _, err = harness.txPool.MaybeAcceptTransaction(tx, false, false)
+	if err != nil {
+		t.Fatalf("MaybeAcceptTransaction: failed to accept tx: %v", err)
+	}
+	testPoolMembership(tc, tx, false, true)
+}
+
+// TestTokenPolicy ensures the CashToken category limits of the mempool policy
+// reject transactions once a category has too many pool transactions or too
+// many categories were created since the last block.
+func TestTokenPolicy(t *testing.T) {
+	t.Parallel()
+
+	harness, _, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	mp := harness.txPool
+	mp.cfg.Policy.MaxTokenCategoryTxs = 2
+	mp.cfg.Policy.MaxTokenGenesisTxs = 1
+
+	// newTokenTx returns a transaction spending the passed outpoint with a
+	// single output carrying fungible tokens of the passed category.
+	newTokenTx := func(prevOut wire.OutPoint, category chainhash.Hash) *bchutil.Tx {
+		tx := wire.NewMsgTx(wire.TxVersion)
+		tx.AddTxIn(wire.NewTxIn(&prevOut, nil))
+		tx.AddTxOut(wire.NewTxOut(1000, harness.payScript, wire.TokenData{
+			CategoryID: category,
+			BitField:   wire.HAS_AMOUNT,
+			Amount:     100,
+		}))
+		return bchutil.NewTx(tx)
+	}
+
+	// Confirm a transaction with an output of category A and an output
+	// without tokens which can create a new category.
+	categoryA := chainhash.Hash{0x0a}
+	parent := wire.NewMsgTx(wire.TxVersion)
+	parent.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil))
+	parent.AddTxOut(wire.NewTxOut(1000, harness.payScript, wire.TokenData{
+		CategoryID: categoryA,
+		BitField:   wire.HAS_AMOUNT,
+		Amount:     100,
+	}))
+	parent.AddTxOut(wire.NewTxOut(1000, harness.payScript, wire.TokenData{}))
+	parentTx := bchutil.NewTx(parent)
+	view := blockchain.NewUtxoViewpoint()
+	view.AddTxOuts(parentTx, 1)
+	nextHeight := harness.chain.BestHeight() + 1
+
+	// Spending category A is not a genesis and is accepted until the
+	// category has the maximum number of pool transactions.
+	var categoryTxs []*bchutil.Tx
+	for i := uint32(0); i < 3; i++ {
+		tx := newTokenTx(wire.OutPoint{Hash: *parentTx.Hash(), Index: 0}, categoryA)
+		tx.MsgTx().LockTime = i
+		isGenesis, err := mp.checkTokenPolicy(tx, view, nextHeight)
+		if i < 2 {
+			if err != nil || isGenesis {
+				t.Fatalf("checkTokenPolicy #%d: unexpected result "+
+					"(genesis %v, err %v)", i, isGenesis, err)
+			}
+			mp.addTransaction(view, tx, nextHeight-1, 0)
+			categoryTxs = append(categoryTxs, tx)
+			continue
+		}
+		if err == nil {
+			t.Fatalf("checkTokenPolicy #%d: accepted tx over the "+
+				"category limit", i)
+		}
+		mp.removeTransaction(categoryTxs[0], false)
+		if _, err := mp.checkTokenPolicy(tx, view, nextHeight); err != nil {
+			t.Fatalf("checkTokenPolicy #%d: unexpected error after "+
+				"removal: %v", i, err)
+		}
+	}
+
+	// Creating a category counts against the genesis limit, which starts
+	// over with the next block.
+	genesisOut := wire.OutPoint{Hash: *parentTx.Hash(), Index: 1}
+	isGenesis, err := mp.checkTokenPolicy(newTokenTx(genesisOut, chainhash.Hash{0x0b}), view, nextHeight)
+	if err != nil || !isGenesis {
+		t.Fatalf("checkTokenPolicy: unexpected genesis result "+
+			"(genesis %v, err %v)", isGenesis, err)
+	}
+	mp.tokenGenesisHeight = nextHeight
+	mp.tokenGenesisTxs++
+	genesisTx := newTokenTx(genesisOut, chainhash.Hash{0x0c})
+	if _, err := mp.checkTokenPolicy(genesisTx, view, nextHeight); err == nil {
+		t.Fatal("checkTokenPolicy: accepted genesis over the limit")
+	}
+	if _, err := mp.checkTokenPolicy(genesisTx, view, nextHeight+1); err != nil {
+		t.Fatalf("checkTokenPolicy: unexpected error in the next "+
+			"block: %v", err)
+	}
+}
+
+// TestCurrentPriority ensures the current priority calculated from the cached
+// input values matches the priority calculated from the inputs, including once
+// the pool transactions the inputs refer to are mined.
+func TestCurrentPriority(t *testing.T) {
+	t.Parallel()
+
+	harness, outputs, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	chainedTxns, err := harness.CreateTxChain(outputs[0], 2)
+	if err != nil {
+		t.Fatalf("unable to create transaction chain: %v", err)
+	}
+	for _, tx := range chainedTxns {
+		_, err := harness.txPool.ProcessTransaction(tx, false, false, 0)
+		if err != nil {
+			t.Fatalf("ProcessTransaction: failed to accept tx: %v",
+				err)
+		}
+	}
+
+	checkPriority := func(tx *bchutil.Tx) {
+		t.Helper()
+		utxos, err := harness.txPool.FetchInputUtxos(tx)
+		if err != nil {
+			t.Fatalf("FetchInputUtxos: unexpected error: %v", err)
+		}
+		want := mining.CalcPriority(tx.MsgTx(), utxos,
+			harness.chain.BestHeight()+1)
+		verbose := harness.txPool.RawMempoolVerbose()
+		got := verbose[tx.Hash().String()].CurrentPriority
+		if math.Abs(got-want) > want*1e-9 {
+			t.Fatalf("current priority of %v: got %v, want %v",
+				tx.Hash(), got, want)
+		}
+	}
+	checkPriority(chainedTxns[0])
+	checkPriority(chainedTxns[1])
+
+	// Mine the first transaction and ensure the second one starts aging.
+	nextHeight := harness.chain.BestHeight() + 1
+	harness.chain.utxos.AddTxOuts(chainedTxns[0], nextHeight)
+	harness.chain.SetHeight(nextHeight)
+	harness.txPool.RemoveTransaction(chainedTxns[0], false)
+	harness.chain.SetHeight(nextHeight + 10)
+	checkPriority(chainedTxns[1])
+}
+
 // TestTxPool_DecodeCompressedBlock tests that a compact block is decoded
 // correctly against the mempool.
 func TestTxPool_DecodeCompressedBlock(t *testing.T) {
@@ -938,3 +1573,650 @@ func TestTxPool_DecodeCompressedBlock(t *testing.T) {
 		}
 	}
 }
+
+// TestTxGraph ensures the graph of a pool transaction includes all of its
+// unconfirmed ancestors and descendants, closest first, but not the other
+// transactions spending its ancestors.
+func TestTxGraph(t *testing.T) {
+	t.Parallel()
+
+	harness, outputs, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+
+	// Create the following graph where c spends outputs of both a and b
+	// and e also spends the output of d, which is neither an ancestor nor
+	// a descendant of a, b and c:
+	//
+	//       a
+	//     /   \
+	//   p - b - c - e
+	//     \       /
+	//       --d--
+	p, err := harness.CreateSignedTx(outputs, 3)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	a, err := harness.CreateSignedTx([]spendableOutput{
+		txOutToSpendableOut(p, 0),
+	}, 1)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	b, err := harness.CreateSignedTx([]spendableOutput{
+		txOutToSpendableOut(p, 1),
+	}, 1)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	c, err := harness.CreateSignedTx([]spendableOutput{
+		txOutToSpendableOut(a, 0), txOutToSpendableOut(b, 0),
+	}, 1)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	d, err := harness.CreateSignedTx([]spendableOutput{
+		txOutToSpendableOut(p, 2),
+	}, 1)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	e, err := harness.CreateSignedTx([]spendableOutput{
+		txOutToSpendableOut(c, 0), txOutToSpendableOut(d, 0),
+	}, 1)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	for _, tx := range []*bchutil.Tx{p, a, b, c, d, e} {
+		_, err := harness.txPool.ProcessTransaction(tx, false, false, 0)
+		if err != nil {
+			t.Fatalf("ProcessTransaction: failed to accept tx: %v",
+				err)
+		}
+	}
+
+	hashes := func(entries []*TxGraphEntry) []chainhash.Hash {
+		var hashes []chainhash.Hash
+		for _, entry := range entries {
+			hashes = append(hashes, *entry.Tx.Hash())
+		}
+		return hashes
+	}
+	tests := []struct {
+		name        string
+		tx          *bchutil.Tx
+		ancestors   []*bchutil.Tx
+		descendants []*bchutil.Tx
+	}{
+		{"root", p, nil, []*bchutil.Tx{a, b, d, c, e}},
+		{"one parent", a, []*bchutil.Tx{p}, []*bchutil.Tx{c, e}},
+		{"two parents", c, []*bchutil.Tx{a, b, p}, []*bchutil.Tx{e}},
+		{"two branches", e, []*bchutil.Tx{c, d, a, b, p}, nil},
+	}
+	for _, test := range tests {
+		graph, err := harness.txPool.TxGraph(test.tx.Hash())
+		if err != nil {
+			t.Errorf("%s: TxGraph: %v", test.name, err)
+			continue
+		}
+		if graph.Truncated {
+			t.Errorf("%s: graph unexpectedly truncated", test.name)
+		}
+		if *graph.Tx.Tx.Hash() != *test.tx.Hash() {
+			t.Errorf("%s: graph is for %v, want %v", test.name,
+				graph.Tx.Tx.Hash(), test.tx.Hash())
+		}
+		for _, dir := range []struct {
+			name    string
+			entries []*TxGraphEntry
+			size    int64
+			want    []*bchutil.Tx
+		}{
+			{"ancestors", graph.Ancestors, graph.AncestorSize, test.ancestors},
+			{"descendants", graph.Descendants, graph.DescendantSize, test.descendants},
+		} {
+			var want []chainhash.Hash
+			var wantSize int64
+			for _, tx := range dir.want {
+				want = append(want, *tx.Hash())
+				wantSize += int64(tx.MsgTx().SerializeSize())
+			}
+			if got := hashes(dir.entries); !reflect.DeepEqual(got, want) {
+				t.Errorf("%s: got %s %v, want %v", test.name,
+					dir.name, got, want)
+			}
+			if dir.size != wantSize {
+				t.Errorf("%s: got %s size %d, want %d", test.name,
+					dir.name, dir.size, wantSize)
+			}
+		}
+	}
+
+	// The links of each transaction include the pool transactions outside
+	// of the graph.
+	graph, err := harness.txPool.TxGraph(c.Hash())
+	if err != nil {
+		t.Fatalf("TxGraph: %v", err)
+	}
+	if got := graph.Descendants[0].Depends; !reflect.DeepEqual(got,
+		[]chainhash.Hash{*c.Hash(), *d.Hash()}) {
+
+		t.Errorf("got dependencies %v for the child, want [%v %v]", got,
+			c.Hash(), d.Hash())
+	}
+	if got := graph.Ancestors[2].SpentBy; !reflect.DeepEqual(got,
+		[]chainhash.Hash{*a.Hash(), *b.Hash(), *d.Hash()}) {
+
+		t.Errorf("got spenders %v for the root, want [%v %v %v]", got,
+			a.Hash(), b.Hash(), d.Hash())
+	}
+
+	confirmed := outputs[0].outPoint.Hash
+	if _, err := harness.txPool.TxGraph(&confirmed); err == nil {
+		t.Error("TxGraph: expected an error for a transaction which is " +
+			"not in the pool")
+	}
+}
+
+// TestConflicts ensures transactions rejected for double spending a pool
+// transaction and transactions confirmed in place of a pool transaction are
+// reported as conflicting with it.
+func TestConflicts(t *testing.T) {
+	t.Parallel()
+
+	harness, outputs, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+
+	// Create three transactions spending the same output, which differ
+	// by their number of outputs.
+	var txns []*bchutil.Tx
+	for numOutputs := uint32(1); numOutputs <= 3; numOutputs++ {
+		tx, err := harness.CreateSignedTx(outputs, numOutputs)
+		if err != nil {
+			t.Fatalf("unable to create transaction: %v", err)
+		}
+		txns = append(txns, tx)
+	}
+	accepted, rejected, confirmed := txns[0], txns[1], txns[2]
+
+	if conflicts := harness.txPool.Conflicts(accepted.Hash()); conflicts != nil {
+		t.Fatalf("got conflicts %v before any double spend", conflicts)
+	}
+	_, err = harness.txPool.ProcessTransaction(accepted, false, false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+	}
+	_, err = harness.txPool.ProcessTransaction(rejected, false, false, 0)
+	if err == nil {
+		t.Fatal("ProcessTransaction: accepted a double spend")
+	}
+
+	// A block confirming the last transaction removes the accepted one.
+	harness.txPool.RemoveDoubleSpends(confirmed)
+	if harness.txPool.HaveTransaction(accepted.Hash()) {
+		t.Fatal("double spent transaction is still in the pool")
+	}
+
+	tests := []struct {
+		tx   *bchutil.Tx
+		want []*bchutil.Tx
+	}{
+		{tx: accepted, want: []*bchutil.Tx{rejected, confirmed}},
+		{tx: rejected, want: []*bchutil.Tx{accepted}},
+		{tx: confirmed, want: []*bchutil.Tx{accepted}},
+	}
+	for _, test := range tests {
+		var want []chainhash.Hash
+		for _, tx := range test.want {
+			want = append(want, *tx.Hash())
+		}
+		got := harness.txPool.Conflicts(test.tx.Hash())
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("conflicts of %v: got %v, want %v",
+				test.tx.Hash(), got, want)
+		}
+	}
+}
+
+// TestConflictLogEviction ensures the conflicts of the transactions seen first
+// are forgotten once the conflict log is full.
+func TestConflictLogEviction(t *testing.T) {
+	t.Parallel()
+
+	var log conflictLog
+	hash := func(i int) chainhash.Hash {
+		return chainhash.HashH([]byte{byte(i), byte(i >> 8), byte(i >> 16)})
+	}
+	conflict := chainhash.HashH([]byte("conflict"))
+	for i := 0; i <= maxConflictEntries; i++ {
+		log.addLink(hash(i), conflict)
+	}
+
+	first := hash(0)
+	if conflicts := log.get(&first); conflicts != nil {
+		t.Errorf("got conflicts %v for an evicted transaction", conflicts)
+	}
+	last := hash(maxConflictEntries)
+	if conflicts := log.get(&last); len(conflicts) != 1 || conflicts[0] != conflict {
+		t.Errorf("got conflicts %v, want [%v]", conflicts, conflict)
+	}
+	if len(log.conflicts) != maxConflictEntries {
+		t.Errorf("conflict log has %d entries, want %d",
+			len(log.conflicts), maxConflictEntries)
+	}
+}
+
+// TestProcessNonStandardTransaction ensures non-standard transactions rejected
+// by the policy of the pool are accepted through ProcessNonStandardTransaction.
+func TestProcessNonStandardTransaction(t *testing.T) {
+	t.Parallel()
+
+	harness, outputs, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+
+	// The version of the transaction is above the maximum standard version
+	// of the harness policy.
+	msgTx := wire.NewMsgTx(harness.txPool.cfg.Policy.MaxTxVersion + 1)
+	msgTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: outputs[0].outPoint,
+		Sequence:         wire.MaxTxInSequenceNum,
+	})
+	msgTx.AddTxOut(&wire.TxOut{
+		PkScript: harness.payScript,
+		Value:    int64(outputs[0].amount) - 1000,
+	})
+	sigScript, err := txscript.SignatureScript(msgTx, 0,
+		int64(outputs[0].amount), harness.payScript, txscript.SigHashAll,
+		harness.signKey, true)
+	if err != nil {
+		t.Fatalf("unable to sign transaction: %v", err)
+	}
+	msgTx.TxIn[0].SignatureScript = sigScript
+	tx := bchutil.NewTx(msgTx)
+
+	_, err = harness.txPool.ProcessTransaction(tx, false, true, 0)
+	if code, _ := ErrToRejectErr(err); code != wire.RejectNonstandard {
+		t.Fatalf("ProcessTransaction: got error %v, want a "+
+			"non-standard rejection", err)
+	}
+	_, err = harness.txPool.ProcessNonStandardTransaction(tx, false, true, 0)
+	if err != nil {
+		t.Fatalf("ProcessNonStandardTransaction: failed to accept tx: %v",
+			err)
+	}
+	if !harness.txPool.HaveTransaction(tx.Hash()) {
+		t.Fatal("non-standard transaction is not in the pool")
+	}
+}
+
+// TestPrioritiseTransaction ensures fee deltas are applied to the mining
+// descriptors of transactions whether they are set before or after the
+// transactions are accepted, and forgotten once the transactions are mined.
+func TestPrioritiseTransaction(t *testing.T) {
+	t.Parallel()
+
+	harness, outputs, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	txns, err := harness.CreateTxChain(outputs[0], 2)
+	if err != nil {
+		t.Fatalf("unable to create transaction chain: %v", err)
+	}
+	parent, child := txns[0], txns[1]
+
+	feeDelta := func(tx *bchutil.Tx) int64 {
+		for _, desc := range harness.txPool.MiningDescs() {
+			if *desc.Tx.Hash() == *tx.Hash() {
+				return desc.FeeDelta
+			}
+		}
+		t.Fatalf("transaction %v is not in the pool", tx.Hash())
+		return 0
+	}
+
+	// Deltas set before a transaction is accepted are applied when it is.
+	harness.txPool.PrioritiseTransaction(child.Hash(), 1000)
+	harness.txPool.PrioritiseTransaction(child.Hash(), 500)
+	for _, tx := range txns {
+		_, err := harness.txPool.ProcessTransaction(tx, false, false, 0)
+		if err != nil {
+			t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+		}
+	}
+	if delta := feeDelta(child); delta != 1500 {
+		t.Fatalf("got fee delta %d, want 1500", delta)
+	}
+
+	// Deltas of pool transactions are updated without changing the
+	// descriptors handed out before.
+	before := harness.txPool.MiningDescs()
+	harness.txPool.PrioritiseTransaction(parent.Hash(), -2000)
+	if delta := feeDelta(parent); delta != -2000 {
+		t.Fatalf("got fee delta %d, want -2000", delta)
+	}
+	for _, desc := range before {
+		if desc.FeeDelta != 0 && *desc.Tx.Hash() == *parent.Hash() {
+			t.Fatal("fee delta changed a previous descriptor")
+		}
+	}
+	desc, err := harness.txPool.FetchTxDesc(parent.Hash())
+	if err != nil {
+		t.Fatalf("FetchTxDesc: %v", err)
+	}
+	size := int64(parent.MsgTx().SerializeSize())
+	if got, want := desc.ModifiedFeePerKB(), (desc.Fee-2000)*1000/size; got != want {
+		t.Fatalf("got modified fee per kB %d, want %d", got, want)
+	}
+
+	// Mining a transaction forgets its delta.
+	harness.txPool.RemoveTransaction(parent, false)
+	harness.txPool.mtx.RLock()
+	_, exists := harness.txPool.feeDeltas[*parent.Hash()]
+	harness.txPool.mtx.RUnlock()
+	if exists {
+		t.Fatal("fee delta of a mined transaction was kept")
+	}
+}
+
+// TestSnapshot ensures the transactions of a pool survive a round trip through
+// a snapshot into another pool along with their metadata.
+func TestSnapshot(t *testing.T) {
+	t.Parallel()
+
+	harness, outputs, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	txns, err := harness.CreateTxChain(outputs[0], 3)
+	if err != nil {
+		t.Fatalf("unable to create transaction chain: %v", err)
+	}
+	for _, tx := range txns {
+		_, err := harness.txPool.ProcessTransaction(tx, false, false, 0)
+		if err != nil {
+			t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+		}
+	}
+	harness.txPool.PrioritiseTransaction(txns[1].Hash(), 1000)
+
+	// Transactions must come after the ones they spend.
+	entries := harness.txPool.Snapshot()
+	if len(entries) != len(txns) {
+		t.Fatalf("got %d snapshot entries, want %d", len(entries),
+			len(txns))
+	}
+	for i, entry := range entries {
+		if *entry.Tx.Hash() != *txns[i].Hash() {
+			t.Fatalf("snapshot entry %d is %v, want %v", i,
+				entry.Tx.Hash(), txns[i].Hash())
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := WriteSnapshot(&buf, entries); err != nil {
+		t.Fatalf("WriteSnapshot: %v", err)
+	}
+	read, err := ReadSnapshot(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("ReadSnapshot: %v", err)
+	}
+
+	// The pool the snapshot is imported into shares the outputs spent by
+	// the transactions since the harness is deterministic.  Make the added
+	// times older so it can be checked they are kept.
+	for _, entry := range read {
+		entry.Added = entry.Added.Add(-time.Hour)
+	}
+	other, _, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	accepted := other.txPool.ImportSnapshot(read)
+	if len(accepted) != len(txns) {
+		t.Fatalf("imported %d transactions, want %d", len(accepted),
+			len(txns))
+	}
+	for i, tx := range txns {
+		desc, err := other.txPool.FetchTxDesc(tx.Hash())
+		if err != nil {
+			t.Fatalf("FetchTxDesc: %v", err)
+		}
+		if !desc.Added.Equal(read[i].Added) {
+			t.Errorf("transaction %d was added at %v, want %v", i,
+				desc.Added, read[i].Added)
+		}
+		if desc.Fee != entries[i].Fee || desc.FeeDelta != entries[i].FeeDelta {
+			t.Errorf("transaction %d has fee %d and fee delta %d, "+
+				"want %d and %d", i, desc.Fee, desc.FeeDelta,
+				entries[i].Fee, entries[i].FeeDelta)
+		}
+	}
+
+	// Importing again skips the transactions already in the pool.
+	if accepted := other.txPool.ImportSnapshot(read); len(accepted) != 0 {
+		t.Fatalf("imported %d transactions twice", len(accepted))
+	}
+
+	// Snapshots of another version are rejected.
+	serialized := buf.Bytes()
+	serialized[3]++
+	if _, err := ReadSnapshot(bytes.NewReader(serialized)); err == nil {
+		t.Fatal("ReadSnapshot accepted an unknown version")
+	}
+}
+
+// TestMempoolSizeLimit ensures the transactions with the lowest fee rates are
+// evicted along with their descendants once the pool exceeds its size limit,
+// and that the minimum fee of new transactions is raised above their fee rate
+// until it decays.
+func TestMempoolSizeLimit(t *testing.T) {
+	t.Parallel()
+
+	harness, _, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	coinbaseHeight := harness.chain.BestHeight() -
+		int32(harness.chainParams.CoinbaseMaturity) + 1
+	coinbase, err := harness.CreateCoinbaseTx(coinbaseHeight, 4)
+	if err != nil {
+		t.Fatalf("unable to create coinbase: %v", err)
+	}
+	harness.chain.utxos.AddTxOuts(coinbase, coinbaseHeight)
+
+	// payWithFee returns a transaction spending the passed output to the
+	// harness and paying the passed fee.
+	payWithFee := func(input spendableOutput, fee int64) *bchutil.Tx {
+		t.Helper()
+		tx := wire.NewMsgTx(wire.TxVersion)
+		tx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: input.outPoint,
+			Sequence:         wire.MaxTxInSequenceNum,
+		})
+		tx.AddTxOut(&wire.TxOut{
+			PkScript: harness.payScript,
+			Value:    int64(input.amount) - fee,
+		})
+		sigScript, err := txscript.SignatureScript(tx, 0,
+			int64(input.amount), harness.payScript,
+			txscript.SigHashAll, harness.signKey, true)
+		if err != nil {
+			t.Fatalf("unable to sign transaction: %v", err)
+		}
+		tx.TxIn[0].SignatureScript = sigScript
+		return bchutil.NewTx(tx)
+	}
+	accept := func(tx *bchutil.Tx) error {
+		_, err := harness.txPool.ProcessTransaction(tx, false, false, 0)
+		return err
+	}
+
+	// The transactions are about 190 bytes.  The package of the second
+	// transaction and its child pays the lowest fee rate, around 2.5
+	// satoshi per byte.
+	txA := payWithFee(txOutToSpendableOut(coinbase, 0), 1000)
+	txB := payWithFee(txOutToSpendableOut(coinbase, 1), 400)
+	txC := payWithFee(txOutToSpendableOut(txB, 0), 600)
+	txD := payWithFee(txOutToSpendableOut(coinbase, 2), 2000)
+	for _, tx := range []*bchutil.Tx{txA, txB, txC} {
+		if err := accept(tx); err != nil {
+			t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+		}
+	}
+	harness.txPool.cfg.Policy.MaxMempoolBytes = harness.txPool.Size() + 50
+	if fee := harness.txPool.MinRelayTxFee(); fee != 1000 {
+		t.Fatalf("got minimum relay fee %v before evictions, want %v",
+			fee, bchutil.Amount(1000))
+	}
+
+	if err := accept(txD); err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+	}
+	for _, tx := range []*bchutil.Tx{txA, txD} {
+		if !harness.txPool.IsTransactionInPool(tx.Hash()) {
+			t.Errorf("transaction %v was evicted", tx.Hash())
+		}
+	}
+	for _, tx := range []*bchutil.Tx{txB, txC} {
+		if harness.txPool.IsTransactionInPool(tx.Hash()) {
+			t.Errorf("transaction %v was not evicted", tx.Hash())
+		}
+	}
+	if size := harness.txPool.Size(); size > harness.txPool.cfg.Policy.MaxMempoolBytes {
+		t.Errorf("got pool size %d over the limit of %d", size,
+			harness.txPool.cfg.Policy.MaxMempoolBytes)
+	}
+
+	// The minimum fee is raised by the minimum relay fee above the fee
+	// rate of the evicted package.
+	packageSize := int64(txB.MsgTx().SerializeSize() +
+		txC.MsgTx().SerializeSize())
+	wantFee := bchutil.Amount(1000*1000/packageSize + 1000)
+	if fee := harness.txPool.MinRelayTxFee(); fee < wantFee-1 || fee > wantFee+1 {
+		t.Fatalf("got minimum relay fee %v after evictions, want %v",
+			fee, wantFee)
+	}
+
+	// New transactions paying less are rejected while those paying more
+	// are accepted.
+	txE := payWithFee(txOutToSpendableOut(coinbase, 3), 500)
+	err = accept(txE)
+	if code, _ := extractRejectCode(err); code != wire.RejectInsufficientFee {
+		t.Fatalf("got error %v for a transaction under the mempool "+
+			"minimum fee, want insufficient fee", err)
+	}
+	txE = payWithFee(txOutToSpendableOut(coinbase, 3), 1500)
+	if err := accept(txE); err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+	}
+
+	// The raised fee decays back to the minimum relay fee.
+	harness.clock.Add(rollingMinFeeHalfLife * 4)
+	if fee := harness.txPool.MinRelayTxFee(); fee != 1000 {
+		t.Fatalf("got minimum relay fee %v once decayed, want %v", fee,
+			bchutil.Amount(1000))
+	}
+}
+
+// TestBacklogFeeRate ensures the fee rate needed to be ahead of the backlog of
+// the pool is the fee rate of the first transaction which does not fit in the
+// blocks and never below the minimum relay fee.
+func TestBacklogFeeRate(t *testing.T) {
+	t.Parallel()
+
+	harness, _, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	coinbaseHeight := harness.chain.BestHeight() -
+		int32(harness.chainParams.CoinbaseMaturity) + 1
+	coinbase, err := harness.CreateCoinbaseTx(coinbaseHeight, 3)
+	if err != nil {
+		t.Fatalf("unable to create coinbase: %v", err)
+	}
+	harness.chain.utxos.AddTxOuts(coinbase, coinbaseHeight)
+
+	minFeeRate := SatoshiPerByte(1)
+	if rate := harness.txPool.BacklogFeeRate(1, 1000000); rate != minFeeRate {
+		t.Errorf("got backlog fee rate %v for an empty pool, want %v",
+			rate, minFeeRate)
+	}
+
+	// Add transactions paying 10, 5 and 20 satoshi per byte.
+	var size uint64
+	var hashes []*chainhash.Hash
+	feeRates := []int64{10, 5, 20}
+	for i, feeRate := range feeRates {
+		tx := wire.NewMsgTx(wire.TxVersion)
+		input := txOutToSpendableOut(coinbase, uint32(i))
+		tx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: input.outPoint,
+			Sequence:         wire.MaxTxInSequenceNum,
+		})
+		tx.AddTxOut(&wire.TxOut{PkScript: harness.payScript})
+		sigScript, err := txscript.SignatureScript(tx, 0,
+			int64(input.amount), harness.payScript,
+			txscript.SigHashAll, harness.signKey, true)
+		if err != nil {
+			t.Fatalf("unable to sign transaction: %v", err)
+		}
+		tx.TxIn[0].SignatureScript = sigScript
+
+		// The fee does not change the size of the transaction, so
+		// sign it again with the final output value.
+		txSize := int64(tx.SerializeSize())
+		tx.TxOut[0].Value = int64(input.amount) - feeRate*txSize
+		sigScript, err = txscript.SignatureScript(tx, 0,
+			int64(input.amount), harness.payScript,
+			txscript.SigHashAll, harness.signKey, true)
+		if err != nil {
+			t.Fatalf("unable to sign transaction: %v", err)
+		}
+		tx.TxIn[0].SignatureScript = sigScript
+		size = uint64(tx.SerializeSize())
+		hash := tx.TxHash()
+		hashes = append(hashes, &hash)
+
+		_, err = harness.txPool.ProcessTransaction(bchutil.NewTx(tx),
+			false, false, 0)
+		if err != nil {
+			t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+		}
+	}
+
+	tests := []struct {
+		numBlocks uint32
+		blockSize uint64
+		want      SatoshiPerByte
+	}{
+		{numBlocks: 1, blockSize: 3 * size, want: minFeeRate},
+		{numBlocks: 1, blockSize: 2 * size, want: 5},
+		{numBlocks: 2, blockSize: size, want: 5},
+		{numBlocks: 1, blockSize: size, want: 10},
+		{numBlocks: 1, blockSize: size / 2, want: 20},
+	}
+	for _, test := range tests {
+		rate := harness.txPool.BacklogFeeRate(test.numBlocks,
+			test.blockSize)
+		if math.Abs(float64(rate-test.want)) > 0.1 {
+			t.Errorf("got backlog fee rate %v for %d blocks of %d "+
+				"bytes, want %v", rate, test.numBlocks,
+				test.blockSize, test.want)
+		}
+	}
+
+	// Prioritising the transaction paying 5 satoshi per byte above the
+	// others must be reflected by the cached backlog.
+	harness.txPool.PrioritiseTransaction(hashes[1], 100*int64(size))
+	rate := harness.txPool.BacklogFeeRate(1, 2*size)
+	if math.Abs(float64(rate-10)) > 0.1 {
+		t.Errorf("got backlog fee rate %v after prioritising a "+
+			"transaction, want 10", rate)
+	}
+}
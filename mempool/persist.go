@@ -0,0 +1,78 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"io"
+)
+
+// orphanSnapshot returns the transactions of the orphan pool as snapshot
+// entries.  The time an orphan was added is derived from its expiration.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) orphanSnapshot() []*SnapshotEntry {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	entries := make([]*SnapshotEntry, 0, len(mp.orphans))
+	for _, otx := range mp.orphans {
+		entries = append(entries, &SnapshotEntry{
+			Tx:    otx.tx,
+			Added: otx.expiration.Add(-orphanTTL),
+		})
+	}
+	return entries
+}
+
+// Save writes the transactions of the main pool and of the orphan pool to w so
+// they can be restored with Load, such as across a restart of the node.  The
+// main pool is written as a snapshot, see WriteSnapshot, followed by a
+// snapshot of the orphan pool in which the fees and heights are zero.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) Save(w io.Writer) error {
+	if err := WriteSnapshot(w, mp.Snapshot()); err != nil {
+		return err
+	}
+	return WriteSnapshot(w, mp.orphanSnapshot())
+}
+
+// Load restores the transactions written by Save from r.  The transactions of
+// the main pool are imported with ImportSnapshot, so they are validated again
+// against the pool policy and the current best chain.  The orphans which did
+// not expire since they were saved are then processed again, which adds them
+// to the main pool when their missing parents are available by now.  It
+// returns the descriptors of the transactions added to the main pool and the
+// number of transactions read.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) Load(r io.Reader) ([]*TxDesc, int, error) {
+	entries, err := ReadSnapshot(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	orphans, err := ReadSnapshot(r)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	accepted := mp.ImportSnapshot(entries)
+	now := mp.cfg.Clock.Now()
+	for _, entry := range orphans {
+		if entry.Added.Add(orphanTTL).Before(now) ||
+			mp.HaveTransaction(entry.Tx.Hash()) {
+
+			continue
+		}
+		txDescs, err := mp.ProcessTransaction(entry.Tx, true, false, 0)
+		if err != nil {
+			log.Debugf("Skipping saved orphan %v: %v",
+				entry.Tx.Hash(), err)
+			continue
+		}
+		accepted = append(accepted, txDescs...)
+	}
+	return accepted, len(entries) + len(orphans), nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg"
+)
+
+// TestSaveLoad ensures the transactions of the main pool and of the orphan
+// pool are restored into another pool and that expired orphans are dropped.
+func TestSaveLoad(t *testing.T) {
+	t.Parallel()
+
+	harness, outputs, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	txns, err := harness.CreateTxChain(outputs[0], 3)
+	if err != nil {
+		t.Fatalf("unable to create transaction chain: %v", err)
+	}
+	_, err = harness.txPool.ProcessTransaction(txns[0], false, false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+	}
+	_, err = harness.txPool.ProcessTransaction(txns[2], true, false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept orphan: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := harness.txPool.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	saved := buf.Bytes()
+
+	// The pool the transactions are loaded into shares the outputs spent
+	// by them since the harness is deterministic.
+	other, _, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	tc := &testContext{t, other}
+	accepted, read, err := other.txPool.Load(bytes.NewReader(saved))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(accepted) != 1 || read != 2 {
+		t.Fatalf("loaded %d of %d transactions, want 1 of 2",
+			len(accepted), read)
+	}
+	testPoolMembership(tc, txns[0], false, true)
+	testPoolMembership(tc, txns[2], true, false)
+
+	// The restored orphan is accepted once its parent arrives.
+	acceptedTxns, err := other.txPool.ProcessTransaction(txns[1], false,
+		false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+	}
+	if len(acceptedTxns) != 2 {
+		t.Fatalf("accepted %d transactions, want 2", len(acceptedTxns))
+	}
+
+	// Orphans which expired since they were saved are dropped.
+	expired, _, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	expired.clock.Add(orphanTTL + time.Minute)
+	if _, _, err := expired.txPool.Load(bytes.NewReader(saved)); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	testPoolMembership(&testContext{t, expired}, txns[2], false, false)
+}
@@ -15,9 +15,9 @@ import (
 )
 
 const (
-	// maxStandardSigScriptSize is the maximum size allowed for a
-	// transaction input signature script to be considered standard.  This
-	// value allows for a 15-of-15 CHECKMULTISIG pay-to-script-hash with
+	// DefaultMaxStandardSigScriptSize is the default maximum size allowed
+	// for a transaction input signature script to be considered standard.
+	// This value allows for a 15-of-15 CHECKMULTISIG pay-to-script-hash with
 	// compressed keys.
 	//
 	// The form of the overall script is: OP_0 <15 signatures> OP_PUSHDATA2
@@ -32,7 +32,7 @@ const (
 	// That brings the total to 1+(15*74)+3+513 = 1627.  This value also
 	// adds a few extra bytes to provide a little buffer.
 	// (1 + 15*74 + 3) + (15*34 + 3) + 23 = 1650
-	maxStandardSigScriptSize = 1650
+	DefaultMaxStandardSigScriptSize = 1650
 
 	// DefaultMinRelayTxFee is the minimum fee in satoshi that is required
 	// for a transaction to be treated as free for relay and mining
@@ -46,8 +46,9 @@ const (
 	// considered standard.
 	maxStandardMultiSigKeys = 3
 
-	// maxStandardTxSize is the maximum size of a transaction
-	maxStandardTxSize = 100000
+	// DefaultMaxStandardTxSize is the default maximum size of a standard
+	// transaction.
+	DefaultMaxStandardTxSize = 100000
 )
 
 // calcMinRequiredTxRelayFee returns the minimum transaction fee required for a
@@ -155,12 +156,12 @@ func checkPkScriptStandard(pkScript []byte, scriptClass txscript.ScriptClass) er
 	return nil
 }
 
-// isDust returns whether or not the passed transaction output amount is
+// IsDust returns whether or not the passed transaction output amount is
 // considered dust or not based on the passed minimum transaction relay fee.
 // Dust is defined in terms of the minimum transaction relay fee.  In
 // particular, if the cost to the network to spend coins is more than 1/3 of the
 // minimum transaction relay fee, it is considered dust.
-func isDust(txOut *wire.TxOut, minRelayTxFee bchutil.Amount) bool {
+func IsDust(txOut *wire.TxOut, minRelayTxFee bchutil.Amount) bool {
 	// The total serialized size consists of the output and the associated
 	// input script to redeem it.  Since there is no input script
 	// to redeem it yet, use the minimum size of a typical input script.
@@ -224,6 +225,39 @@ func isDust(txOut *wire.TxOut, minRelayTxFee bchutil.Amount) bool {
 	return txOut.Value*1000/(3*int64(totalSize)) < int64(minRelayTxFee)
 }
 
+// maxStandardTxSize returns the maximum size of a standard transaction.
+func (p *Policy) maxStandardTxSize() int {
+	if p.MaxStandardTxSize > 0 {
+		return p.MaxStandardTxSize
+	}
+	return DefaultMaxStandardTxSize
+}
+
+// maxStandardSigScriptSize returns the maximum size of each signature script of
+// a standard transaction.
+func (p *Policy) maxStandardSigScriptSize() int {
+	if p.MaxStandardSigScriptSize > 0 {
+		return p.MaxStandardSigScriptSize
+	}
+	return DefaultMaxStandardSigScriptSize
+}
+
+// maxDataCarrierSize returns the maximum size of all of the null data outputs
+// of a standard transaction combined.
+func (p *Policy) maxDataCarrierSize() int {
+	if p.MaxDataCarrierSize > 0 {
+		return p.MaxDataCarrierSize
+	}
+	return txscript.MaxDataCarrierSize
+}
+
+// isNullDataScript returns whether the passed public key script is an OP_RETURN
+// followed only by data pushes regardless of its size.
+func isNullDataScript(pkScript []byte) bool {
+	return len(pkScript) > 0 && pkScript[0] == txscript.OP_RETURN &&
+		txscript.IsPushOnlyScript(pkScript[1:])
+}
+
 // checkTransactionStandard performs a series of checks on a transaction to
 // ensure it is a "standard" transaction.  A standard transaction is one that
 // conforms to several additional limiting cases over what is considered a
@@ -232,15 +266,14 @@ func isDust(txOut *wire.TxOut, minRelayTxFee bchutil.Amount) bool {
 // of recognized forms, and not containing "dust" outputs (those that are
 // so small it costs more to process them than they are worth).
 func checkTransactionStandard(tx *bchutil.Tx, height int32,
-	medianTimePast time.Time, minRelayTxFee bchutil.Amount,
-	maxTxVersion int32, upgrade9Active bool) error {
+	medianTimePast time.Time, policy *Policy, upgrade9Active bool) error {
 
 	// The transaction must be a currently supported version.
 	msgTx := tx.MsgTx()
-	if msgTx.Version > maxTxVersion || msgTx.Version < 1 {
+	if msgTx.Version > policy.MaxTxVersion || msgTx.Version < 1 {
 		str := fmt.Sprintf("transaction version %d is not in the "+
 			"valid range of %d-%d", msgTx.Version, 1,
-			maxTxVersion)
+			policy.MaxTxVersion)
 		return txRuleError(wire.RejectNonstandard, str)
 	}
 
@@ -256,22 +289,24 @@ func checkTransactionStandard(tx *bchutil.Tx, height int32,
 	// size of a transaction.  This also helps mitigate CPU exhaustion
 	// attacks.
 	txSize := tx.MsgTx().SerializeSize()
-	if txSize > maxStandardTxSize {
+	maxTxSize := policy.maxStandardTxSize()
+	if txSize > maxTxSize {
 		str := fmt.Sprintf("size of transaction %v is larger than max "+
-			"allowed size of %v", txSize, maxStandardTxSize)
+			"allowed size of %v", txSize, maxTxSize)
 		return txRuleError(wire.RejectNonstandard, str)
 	}
 
+	maxSigScriptSize := policy.maxStandardSigScriptSize()
 	for i, txIn := range msgTx.TxIn {
 		// Each transaction input signature script must not exceed the
-		// maximum size allowed for a standard transaction.  See
-		// the comment on maxStandardSigScriptSize for more details.
+		// maximum size allowed for a standard transaction.  See the
+		// comment on DefaultMaxStandardSigScriptSize for more details.
 		sigScriptLen := len(txIn.SignatureScript)
-		if sigScriptLen > maxStandardSigScriptSize {
+		if sigScriptLen > maxSigScriptSize {
 			str := fmt.Sprintf("transaction input %d: signature "+
 				"script size of %d bytes is large than max "+
 				"allowed size of %d bytes", i, sigScriptLen,
-				maxStandardSigScriptSize)
+				maxSigScriptSize)
 			return txRuleError(wire.RejectNonstandard, str)
 		}
 
@@ -286,7 +321,7 @@ func checkTransactionStandard(tx *bchutil.Tx, height int32,
 
 	// None of the output public key scripts can be a non-standard script or
 	// be "dust" (except when the script is a null data script).
-	dataCarrierSize := 0
+	dataCarrierSize, dataCarrierOutputs := 0, 0
 	for i, txOut := range msgTx.TxOut {
 
 		if !upgrade9Active && !txOut.TokenData.IsEmpty() {
@@ -295,7 +330,15 @@ func checkTransactionStandard(tx *bchutil.Tx, height int32,
 			return txRuleError(rejectCode, str)
 		}
 
+		// Null data scripts larger than txscript.MaxDataCarrierSize
+		// aren't classified as such, so they are recognized here to
+		// let the policy allow larger ones.
 		scriptClass := txscript.GetScriptClass(txOut.PkScript)
+		if scriptClass == txscript.NonStandardTy &&
+			isNullDataScript(txOut.PkScript) {
+
+			scriptClass = txscript.NullDataTy
+		}
 		err := checkPkScriptStandard(txOut.PkScript, scriptClass)
 		if err != nil {
 			// Attempt to extract a reject code from the error so
@@ -314,16 +357,26 @@ func checkTransactionStandard(tx *bchutil.Tx, height int32,
 		// "dust".
 		if scriptClass == txscript.NullDataTy {
 			dataCarrierSize += len(txOut.PkScript)
-		} else if txscript.IsUnspendable(txOut.PkScript) || isDust(txOut, minRelayTxFee) {
+			dataCarrierOutputs++
+		} else if txscript.IsUnspendable(txOut.PkScript) || IsDust(txOut, policy.MinRelayTxFee) {
 			str := fmt.Sprintf("transaction output %d: payment "+
 				"of %d is dust", i, txOut.Value)
 			return txRuleError(wire.RejectDust, str)
 		}
 	}
 
-	// A standard transaction cannot have null data exceeding txscript.MaxDataCarrierSize
-	if dataCarrierSize > txscript.MaxDataCarrierSize {
-		str := fmt.Sprintf("transaction nulldata exceeds %d bytes", txscript.MaxDataCarrierSize)
+	// A standard transaction cannot have null data exceeding the maximum
+	// data carrier size.
+	if maxDataCarrierSize := policy.maxDataCarrierSize(); dataCarrierSize > maxDataCarrierSize {
+		str := fmt.Sprintf("transaction nulldata exceeds %d bytes", maxDataCarrierSize)
+		return txRuleError(wire.RejectNonstandard, str)
+	}
+
+	// A standard transaction cannot have more null data outputs than the
+	// maximum when the policy limits them.
+	if policy.MaxDataCarrierOutputs > 0 && dataCarrierOutputs > policy.MaxDataCarrierOutputs {
+		str := fmt.Sprintf("transaction has %d nulldata outputs, more "+
+			"than %d", dataCarrierOutputs, policy.MaxDataCarrierOutputs)
 		return txRuleError(wire.RejectNonstandard, str)
 	}
 
@@ -332,9 +385,45 @@ func checkTransactionStandard(tx *bchutil.Tx, height int32,
 
 func CheckTransactionStandard(tx *bchutil.Tx, height int32, medianTimePast time.Time, minRelayTxFee bchutil.Amount,
 	maxTxVersion int32, upgrade9Active bool) error {
-	return checkTransactionStandard(tx, height, medianTimePast, minRelayTxFee, maxTxVersion, upgrade9Active)
+	policy := Policy{MinRelayTxFee: minRelayTxFee, MaxTxVersion: maxTxVersion}
+	return checkTransactionStandard(tx, height, medianTimePast, &policy, upgrade9Active)
 }
 
 func CheckInputsStandard(tx *bchutil.Tx, utxoView *blockchain.UtxoViewpoint, scriptFlags txscript.ScriptFlags) error {
 	return checkInputsStandard(tx, utxoView, scriptFlags)
 }
+
+// CheckPkScriptStandard returns an error when an output paying to the passed
+// public key script would not be standard.
+func CheckPkScriptStandard(pkScript []byte) error {
+	scriptClass := txscript.GetScriptClass(pkScript)
+	if err := checkPkScriptStandard(pkScript, scriptClass); err != nil {
+		return err
+	}
+	if scriptClass == txscript.NullDataTy &&
+		len(pkScript) > txscript.MaxDataCarrierSize {
+
+		str := fmt.Sprintf("nulldata exceeds %d bytes",
+			txscript.MaxDataCarrierSize)
+		return txRuleError(wire.RejectNonstandard, str)
+	}
+	return nil
+}
+
+// CheckRedeemScriptStandard returns an error when an input redeeming a
+// pay-to-script-hash output with the passed redeem script would not be
+// standard because pushing the redeem script alone exceeds the maximum size of
+// a standard signature script.
+func CheckRedeemScriptStandard(redeemScript []byte) error {
+	push, err := txscript.NewScriptBuilder().AddData(redeemScript).Script()
+	if err != nil {
+		return txRuleError(wire.RejectNonstandard, err.Error())
+	}
+	if len(push) > DefaultMaxStandardSigScriptSize {
+		str := fmt.Sprintf("signature script pushing the %d byte "+
+			"redeem script is larger than max allowed size of %d "+
+			"bytes", len(redeemScript), DefaultMaxStandardSigScriptSize)
+		return txRuleError(wire.RejectNonstandard, str)
+	}
+	return nil
+}
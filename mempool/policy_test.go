@@ -41,13 +41,13 @@ func TestCalcMinRequiredTxRelayFee(t *testing.T) {
 		},
 		{
 			"max standard tx size with default minimum relay fee",
-			maxStandardTxSize,
+			DefaultMaxStandardTxSize,
 			DefaultMinRelayTxFee,
 			100000,
 		},
 		{
 			"max standard tx size with max satoshi relay fee",
-			maxStandardTxSize,
+			DefaultMaxStandardTxSize,
 			bchutil.MaxSatoshi,
 			bchutil.MaxSatoshi,
 		},
@@ -203,7 +203,7 @@ func TestCheckPkScriptStandard(t *testing.T) {
 	}
 }
 
-// TestDust tests the isDust API.
+// TestDust tests the IsDust API.
 func TestDust(t *testing.T) {
 	pkScript := []byte{0x76, 0xa9, 0x21, 0x03, 0x2f, 0x7e, 0x43,
 		0x0a, 0xa4, 0xc9, 0xd1, 0x59, 0x43, 0x7e, 0x84, 0xb9,
@@ -259,7 +259,7 @@ func TestDust(t *testing.T) {
 		},
 	}
 	for _, test := range tests {
-		res := isDust(&test.txOut, test.relayFee)
+		res := IsDust(&test.txOut, test.relayFee)
 		if res != test.isDust {
 			t.Fatalf("Dust test '%s' failed: want %v got %v",
 				test.name, test.isDust, res)
@@ -350,7 +350,7 @@ func TestCheckTransactionStandard(t *testing.T) {
 				TxOut: []*wire.TxOut{{
 					Value: 0,
 					PkScript: bytes.Repeat([]byte{0x00},
-						maxStandardTxSize+1),
+						DefaultMaxStandardTxSize+1),
 				}},
 				LockTime: 0,
 			},
@@ -365,7 +365,7 @@ func TestCheckTransactionStandard(t *testing.T) {
 				TxIn: []*wire.TxIn{{
 					PreviousOutPoint: dummyPrevOut,
 					SignatureScript: bytes.Repeat([]byte{0x00},
-						maxStandardSigScriptSize+1),
+						DefaultMaxStandardSigScriptSize+1),
 					Sequence: wire.MaxTxInSequenceNum,
 				}},
 				TxOut:    []*wire.TxOut{&dummyTxOut},
@@ -641,11 +641,12 @@ func TestCheckTransactionStandard(t *testing.T) {
 		},
 	}
 
+	policy := Policy{MinRelayTxFee: DefaultMinRelayTxFee, MaxTxVersion: 1}
 	pastMedianTime := time.Now()
 	for _, test := range tests {
 		// Ensure standardness is as expected.
 		err := checkTransactionStandard(bchutil.NewTx(&test.tx),
-			test.height, pastMedianTime, DefaultMinRelayTxFee, 1, false)
+			test.height, pastMedianTime, &policy, false)
 		if err == nil && test.isStandard {
 			// Test passes since function returned standard for a
 			// transaction which is intended to be standard.
@@ -685,3 +686,136 @@ func TestCheckTransactionStandard(t *testing.T) {
 		}
 	}
 }
+
+// TestCheckTransactionStandardLimits ensures the size limits of the policy
+// override the default standardness limits.
+func TestCheckTransactionStandardLimits(t *testing.T) {
+	prevOutHash, err := chainhash.NewHashFromStr("01")
+	if err != nil {
+		t.Fatalf("NewShaHashFromStr: unexpected error: %v", err)
+	}
+	txIn := wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: *prevOutHash, Index: 1},
+		SignatureScript:  bytes.Repeat([]byte{0x00}, 65),
+		Sequence:         wire.MaxTxInSequenceNum,
+	}
+	nullData, err := txscript.NewScriptBuilder().AddOp(txscript.OP_RETURN).
+		AddData(bytes.Repeat([]byte{0x01}, 300)).Script()
+	if err != nil {
+		t.Fatalf("unable to build null data script: %v", err)
+	}
+	tx := bchutil.NewTx(&wire.MsgTx{
+		Version: 1,
+		TxIn:    []*wire.TxIn{&txIn},
+		TxOut:   []*wire.TxOut{{Value: 0, PkScript: nullData}},
+	})
+
+	tests := []struct {
+		name       string
+		policy     Policy
+		isStandard bool
+	}{
+		{
+			name:       "default limits reject large null data",
+			policy:     Policy{},
+			isStandard: false,
+		},
+		{
+			name:       "larger data carrier size",
+			policy:     Policy{MaxDataCarrierSize: 400},
+			isStandard: true,
+		},
+		{
+			name: "smaller standard transaction size",
+			policy: Policy{
+				MaxDataCarrierSize: 400,
+				MaxStandardTxSize:  300,
+			},
+			isStandard: false,
+		},
+		{
+			name: "smaller signature script size",
+			policy: Policy{
+				MaxDataCarrierSize:       400,
+				MaxStandardSigScriptSize: 64,
+			},
+			isStandard: false,
+		},
+	}
+
+	for _, test := range tests {
+		test.policy.MinRelayTxFee = DefaultMinRelayTxFee
+		test.policy.MaxTxVersion = 1
+		err := checkTransactionStandard(tx, 300000, time.Now(),
+			&test.policy, false)
+		if isStandard := err == nil; isStandard != test.isStandard {
+			t.Errorf("%s: unexpected standardness - got %v, want "+
+				"%v (err: %v)", test.name, isStandard,
+				test.isStandard, err)
+		}
+	}
+}
+
+// TestCheckTransactionStandardDataCarrierOutputs ensures transactions may have
+// multiple null data outputs within the aggregate data carrier size unless the
+// policy limits their number.
+func TestCheckTransactionStandardDataCarrierOutputs(t *testing.T) {
+	prevOutHash, err := chainhash.NewHashFromStr("01")
+	if err != nil {
+		t.Fatalf("NewShaHashFromStr: unexpected error: %v", err)
+	}
+	txIn := wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: *prevOutHash, Index: 1},
+		SignatureScript:  bytes.Repeat([]byte{0x00}, 65),
+		Sequence:         wire.MaxTxInSequenceNum,
+	}
+	nullData, err := txscript.NewScriptBuilder().AddOp(txscript.OP_RETURN).
+		AddData(bytes.Repeat([]byte{0x01}, 40)).Script()
+	if err != nil {
+		t.Fatalf("unable to build null data script: %v", err)
+	}
+	msgTx := &wire.MsgTx{Version: 1, TxIn: []*wire.TxIn{&txIn}}
+	for i := 0; i < 3; i++ {
+		msgTx.TxOut = append(msgTx.TxOut, &wire.TxOut{PkScript: nullData})
+	}
+	tx := bchutil.NewTx(msgTx)
+
+	tests := []struct {
+		name       string
+		policy     Policy
+		isStandard bool
+	}{
+		{
+			name:       "any number of outputs within the size",
+			policy:     Policy{},
+			isStandard: true,
+		},
+		{
+			name:       "outputs exceeding the size",
+			policy:     Policy{MaxDataCarrierSize: 100},
+			isStandard: false,
+		},
+		{
+			name:       "as many outputs as the limit",
+			policy:     Policy{MaxDataCarrierOutputs: 3},
+			isStandard: true,
+		},
+		{
+			name:       "more outputs than the limit",
+			policy:     Policy{MaxDataCarrierOutputs: 2},
+			isStandard: false,
+		},
+	}
+
+	for _, test := range tests {
+		test.policy.MinRelayTxFee = DefaultMinRelayTxFee
+		test.policy.MaxTxVersion = 1
+		err := checkTransactionStandard(tx, 300000, time.Now(),
+			&test.policy, false)
+		if isStandard := err == nil; isStandard != test.isStandard {
+			t.Errorf("%s: unexpected standardness - got %v, want "+
+				"%v (err: %v)", test.name, isStandard,
+				test.isStandard, err)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// snapshotVersion is the version of the serialization format of mempool
+// snapshots.
+const snapshotVersion = 1
+
+// maxSnapshotPrealloc is the maximum number of entries allocated up front
+// when reading a snapshot, so a corrupt count can't exhaust the memory.
+const maxSnapshotPrealloc = 10000
+
+// SnapshotEntry is a transaction of a mempool snapshot along with the metadata
+// the pool kept for it.
+type SnapshotEntry struct {
+	Tx *bchutil.Tx
+
+	// Added is when the transaction was added to the pool.
+	Added time.Time
+
+	// Height is the block height when the transaction was added to the
+	// pool.
+	Height int32
+
+	// Fee is the fee the transaction pays.  It is informational, the fee
+	// is calculated again when the transaction is imported.
+	Fee int64
+
+	// FeeDelta is the fee delta set with PrioritiseTransaction.
+	FeeDelta int64
+}
+
+// Snapshot returns the transactions of the main pool along with their
+// metadata.  The transactions are ordered so the ones spending the outputs of
+// other pool transactions come after them, which allows importing them in
+// order.  It does not include the orphan pool.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) Snapshot() []*SnapshotEntry {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	entries := make([]*SnapshotEntry, 0, len(mp.pool))
+	visited := make(map[chainhash.Hash]struct{}, len(mp.pool))
+	var visit func(txD *TxDesc)
+	visit = func(txD *TxDesc) {
+		hash := *txD.Tx.Hash()
+		if _, ok := visited[hash]; ok {
+			return
+		}
+		visited[hash] = struct{}{}
+		for _, txIn := range txD.Tx.MsgTx().TxIn {
+			parent, ok := mp.pool[txIn.PreviousOutPoint.Hash]
+			if ok {
+				visit(parent)
+			}
+		}
+		entries = append(entries, &SnapshotEntry{
+			Tx:       txD.Tx,
+			Added:    txD.Added,
+			Height:   txD.Height,
+			Fee:      txD.Fee,
+			FeeDelta: txD.FeeDelta,
+		})
+	}
+	for _, txD := range mp.pool {
+		visit(txD)
+	}
+	return entries
+}
+
+// ImportSnapshot adds the transactions of the passed snapshot entries to the
+// pool in order, applying their fee deltas and keeping the time they were
+// added to the pool they were exported from.  Each transaction is fully
+// validated against the pool policy and the current best chain, so entries
+// which are already in the pool, no longer valid or rejected are skipped.  It
+// returns the descriptors of the transactions added to the pool.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) ImportSnapshot(entries []*SnapshotEntry) []*TxDesc {
+	var accepted []*TxDesc
+	for _, entry := range entries {
+		hash := entry.Tx.Hash()
+		if mp.HaveTransaction(hash) {
+			continue
+		}
+		_, err := mp.ProcessTransaction(entry.Tx, false, false, 0)
+		if err != nil {
+			log.Debugf("Skipping snapshot transaction %v: %v", hash,
+				err)
+			continue
+		}
+		if entry.FeeDelta != 0 {
+			mp.PrioritiseTransaction(hash, entry.FeeDelta)
+		}
+
+		// Replace the descriptor instead of updating it since the
+		// mining descriptors handed out are read without the lock.
+		mp.mtx.Lock()
+		if txD, exists := mp.pool[*hash]; exists {
+			newDesc := *txD
+			if entry.Added.Before(newDesc.Added) {
+				newDesc.Added = entry.Added
+			}
+			mp.pool[*hash] = &newDesc
+			accepted = append(accepted, &newDesc)
+		}
+		mp.mtx.Unlock()
+	}
+	return accepted
+}
+
+// WriteSnapshot serializes the passed snapshot entries to w.  The format is a
+// version followed by the number of entries and, for each of them, the time
+// it was added in seconds since the epoch, the height, the fee, the fee delta
+// and the transaction in its wire format.
+func WriteSnapshot(w io.Writer, entries []*SnapshotEntry) error {
+	err := binary.Write(w, binary.BigEndian, uint32(snapshotVersion))
+	if err != nil {
+		return err
+	}
+	err = wire.WriteVarInt(w, 0, uint64(len(entries)))
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		fields := []interface{}{
+			entry.Added.Unix(), entry.Height, entry.Fee,
+			entry.FeeDelta,
+		}
+		for _, field := range fields {
+			err := binary.Write(w, binary.BigEndian, field)
+			if err != nil {
+				return err
+			}
+		}
+		if err := entry.Tx.MsgTx().Serialize(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadSnapshot deserializes snapshot entries written by WriteSnapshot from r.
+func ReadSnapshot(r io.Reader) ([]*SnapshotEntry, error) {
+	var version uint32
+	if err := binary.Read(r, binary.BigEndian, &version); err != nil {
+		return nil, err
+	}
+	if version != snapshotVersion {
+		return nil, fmt.Errorf("unsupported mempool snapshot version %d",
+			version)
+	}
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*SnapshotEntry, 0, min(count, maxSnapshotPrealloc))
+	for i := uint64(0); i < count; i++ {
+		var added int64
+		entry := &SnapshotEntry{}
+		fields := []interface{}{
+			&added, &entry.Height, &entry.Fee, &entry.FeeDelta,
+		}
+		for _, field := range fields {
+			err := binary.Read(r, binary.BigEndian, field)
+			if err != nil {
+				return nil, err
+			}
+		}
+		var msgTx wire.MsgTx
+		if err := msgTx.Deserialize(r); err != nil {
+			return nil, err
+		}
+		entry.Added = time.Unix(added, 0)
+		entry.Tx = bchutil.NewTx(&msgTx)
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
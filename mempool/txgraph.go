@@ -0,0 +1,162 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"fmt"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+)
+
+// MaxTxGraphEntries is the maximum number of ancestors, and separately of
+// descendants, returned by TxGraph.
+const MaxTxGraphEntries = 1000
+
+// TxGraphEntry describes a transaction of an unconfirmed transaction graph.
+type TxGraphEntry struct {
+	*TxDesc
+
+	// Size is the serialized size of the transaction in bytes.
+	Size int
+
+	// Depends holds the hashes of the pool transactions with outputs spent
+	// by the transaction in the order of its inputs.
+	Depends []chainhash.Hash
+
+	// SpentBy holds the hashes of the pool transactions which spend
+	// outputs of the transaction in the order of its outputs.
+	SpentBy []chainhash.Hash
+}
+
+// TxGraph is the graph of the unconfirmed transactions a pool transaction
+// depends on and of the unconfirmed transactions which depend on it.
+type TxGraph struct {
+	// Tx is the transaction the graph was requested for.
+	Tx *TxGraphEntry
+
+	// Ancestors are the pool transactions the transaction depends on,
+	// directly or through other pool transactions, ordered by increasing
+	// distance from the transaction.
+	Ancestors []*TxGraphEntry
+
+	// Descendants are the pool transactions which depend on the
+	// transaction, directly or through other pool transactions, ordered
+	// by increasing distance from the transaction.
+	Descendants []*TxGraphEntry
+
+	// AncestorSize and AncestorFees are the total size and fees of the
+	// ancestors, and DescendantSize and DescendantFees those of the
+	// descendants.
+	AncestorSize   int64
+	AncestorFees   int64
+	DescendantSize int64
+	DescendantFees int64
+
+	// Truncated is set when there are more than MaxTxGraphEntries
+	// ancestors or descendants, in which case only the closest ones are
+	// part of the graph.
+	Truncated bool
+}
+
+// txGraphEntry returns the graph entry of the passed pool transaction.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) txGraphEntry(txDesc *TxDesc) *TxGraphEntry {
+	msgTx := txDesc.Tx.MsgTx()
+	entry := &TxGraphEntry{
+		TxDesc: txDesc,
+		Size:   msgTx.SerializeSize(),
+	}
+
+	// Transactions spending several outputs of the same transaction only
+	// list it once.
+	seen := make(map[chainhash.Hash]struct{})
+	for _, txIn := range msgTx.TxIn {
+		hash := txIn.PreviousOutPoint.Hash
+		if _, ok := seen[hash]; ok {
+			continue
+		}
+		if _, ok := mp.pool[hash]; ok {
+			seen[hash] = struct{}{}
+			entry.Depends = append(entry.Depends, hash)
+		}
+	}
+	for i := range msgTx.TxOut {
+		prevOut := wire.OutPoint{Hash: *txDesc.Tx.Hash(), Index: uint32(i)}
+		txRedeemer, ok := mp.outpoints[prevOut]
+		if !ok {
+			continue
+		}
+		hash := *txRedeemer.Hash()
+		if _, ok := seen[hash]; ok {
+			continue
+		}
+		seen[hash] = struct{}{}
+		entry.SpentBy = append(entry.SpentBy, hash)
+	}
+	return entry
+}
+
+// TxGraph returns the graph of the unconfirmed ancestors and descendants of
+// the pool transaction with the passed hash.  Wallets can use it to tell
+// whether a payment depends on unconfirmed transactions and how much fee the
+// whole chain of transactions pays.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) TxGraph(hash *chainhash.Hash) (*TxGraph, error) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	txDesc, exists := mp.pool[*hash]
+	if !exists {
+		return nil, fmt.Errorf("transaction is not in the pool")
+	}
+
+	graph := &TxGraph{Tx: mp.txGraphEntry(txDesc)}
+
+	// Walk the graph breadth first in each direction so the closest
+	// transactions are kept when there are too many of them.
+	walk := func(links func(*TxGraphEntry) []chainhash.Hash) []*TxGraphEntry {
+		var entries []*TxGraphEntry
+		visited := map[chainhash.Hash]struct{}{*hash: {}}
+		queue := []*TxGraphEntry{graph.Tx}
+		for len(queue) > 0 {
+			entry := queue[0]
+			queue = queue[1:]
+			for _, linkHash := range links(entry) {
+				if _, ok := visited[linkHash]; ok {
+					continue
+				}
+				if len(entries) == MaxTxGraphEntries {
+					graph.Truncated = true
+					return entries
+				}
+				visited[linkHash] = struct{}{}
+				linked := mp.txGraphEntry(mp.pool[linkHash])
+				entries = append(entries, linked)
+				queue = append(queue, linked)
+			}
+		}
+		return entries
+	}
+	graph.Ancestors = walk(func(entry *TxGraphEntry) []chainhash.Hash {
+		return entry.Depends
+	})
+	graph.Descendants = walk(func(entry *TxGraphEntry) []chainhash.Hash {
+		return entry.SpentBy
+	})
+
+	for _, entry := range graph.Ancestors {
+		graph.AncestorSize += int64(entry.Size)
+		graph.AncestorFees += entry.Fee
+	}
+	for _, entry := range graph.Descendants {
+		graph.DescendantSize += int64(entry.Size)
+		graph.DescendantFees += entry.Fee
+	}
+
+	return graph, nil
+}
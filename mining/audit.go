@@ -0,0 +1,171 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mining
+
+import (
+	"sort"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// BlockAudit houses the results of comparing the transactions of a proposed
+// block against the transactions in the source pool.
+type BlockAudit struct {
+	// Fees is the total fee paid by the transactions of the block whose
+	// inputs could be resolved.
+	Fees int64
+
+	// UnresolvedTxns is the number of transactions of the block whose fee
+	// could not be calculated because their inputs are missing or invalid.
+	// Such a block violates the consensus rules.
+	UnresolvedTxns int
+
+	// UnknownTxns is the number of transactions of the block which are not
+	// in the source pool.
+	UnknownTxns int
+
+	// MinFeePerKB is the lowest fee per kilobyte paid by a transaction of
+	// the block other than the coinbase.  It is -1 when the block has no
+	// such transactions.
+	MinFeePerKB int64
+
+	// Omitted holds the transactions of the source pool which the block
+	// left out even though they pay a higher fee per kilobyte than
+	// MinFeePerKB and could have been included, ordered by fee per
+	// kilobyte from highest to lowest.
+	Omitted []*TxDesc
+}
+
+// AuditBlock compares the passed block, which is expected to extend the
+// current best chain, against the transactions in the source pool.  It
+// calculates the fees paid by the transactions of the block and reports the
+// transactions of the source pool the block omitted in favor of transactions
+// paying a lower fee per kilobyte.
+//
+// The block is not checked against the consensus rules, which is the job of
+// CheckConnectBlockTemplate on the chain.
+func (g *BlkTmplGenerator) AuditBlock(block *bchutil.Block) (*BlockAudit, error) {
+	best := g.chain.BestSnapshot()
+	nextBlockHeight := best.Height + 1
+
+	// Build a view with the outputs created by the block along with the
+	// outputs from the chain its transactions spend.  The outputs of the
+	// block are added first so they are not replaced by the missing
+	// entries returned for them by the chain.
+	txns := block.Transactions()
+	blockUtxos := blockchain.NewUtxoViewpoint()
+	for _, tx := range txns {
+		blockUtxos.AddTxOuts(tx, nextBlockHeight)
+	}
+	for _, tx := range txns {
+		if blockchain.IsCoinBase(tx) {
+			continue
+		}
+		utxos, err := g.chain.FetchUtxoView(tx)
+		if err != nil {
+			return nil, err
+		}
+		mergeUtxoView(blockUtxos, utxos)
+	}
+
+	sourceTxns := g.txSource.MiningDescs()
+	sourceDescs := make(map[chainhash.Hash]*TxDesc, len(sourceTxns))
+	for _, txDesc := range sourceTxns {
+		sourceDescs[*txDesc.Tx.Hash()] = txDesc
+	}
+
+	audit := &BlockAudit{MinFeePerKB: -1}
+	for _, tx := range txns {
+		if blockchain.IsCoinBase(tx) {
+			continue
+		}
+
+		// Prefer the fee recorded by the source pool and fall back to
+		// calculating it for transactions the pool doesn't know.
+		var fee, feePerKB int64
+		if txDesc, ok := sourceDescs[*tx.Hash()]; ok {
+			fee, feePerKB = txDesc.Fee, txDesc.FeePerKB
+		} else {
+			audit.UnknownTxns++
+			var err error
+			fee, err = blockchain.CheckTransactionInputs(tx,
+				nextBlockHeight, blockUtxos, g.chainParams)
+			if err != nil {
+				log.Debugf("Unable to calculate the fee of tx %s "+
+					"in audited block: %v", tx.Hash(), err)
+				audit.UnresolvedTxns++
+				continue
+			}
+			feePerKB = fee * 1000 / int64(tx.MsgTx().SerializeSize())
+		}
+		audit.Fees += fee
+
+		if audit.MinFeePerKB == -1 || feePerKB < audit.MinFeePerKB {
+			audit.MinFeePerKB = feePerKB
+		}
+	}
+
+	audit.Omitted = omittedTxns(txns, sourceTxns, audit.MinFeePerKB,
+		int64(g.policy.TxMinFreeFee), g.txSource.HaveTransaction,
+		func(tx *bchutil.Tx) bool {
+			return blockchain.IsFinalizedTransaction(tx,
+				nextBlockHeight, g.timeSource.AdjustedTime())
+		})
+	return audit, nil
+}
+
+// omittedTxns returns the transactions of the source pool which are not in the
+// passed block transactions even though they pay a higher fee per kilobyte than
+// minFeePerKB and at least the minimum fee per kilobyte for inclusion in a
+// block.  Transactions which conflict with the block, aren't final or spend
+// outputs of other source pool transactions the block doesn't include are
+// skipped since they could not have been included.
+func omittedTxns(blockTxns []*bchutil.Tx, sourceTxns []*TxDesc, minFeePerKB,
+	minFreeFee int64, haveTransaction func(*chainhash.Hash) bool,
+	isFinalized func(*bchutil.Tx) bool) []*TxDesc {
+
+	inBlock := make(map[chainhash.Hash]struct{}, len(blockTxns))
+	spent := make(map[wire.OutPoint]struct{})
+	for _, tx := range blockTxns {
+		inBlock[*tx.Hash()] = struct{}{}
+		for _, txIn := range tx.MsgTx().TxIn {
+			spent[txIn.PreviousOutPoint] = struct{}{}
+		}
+	}
+
+	var omitted []*TxDesc
+nextTx:
+	for _, txDesc := range sourceTxns {
+		tx := txDesc.Tx
+		if _, ok := inBlock[*tx.Hash()]; ok {
+			continue
+		}
+		if txDesc.FeePerKB <= minFeePerKB || txDesc.FeePerKB < minFreeFee {
+			continue
+		}
+		for _, txIn := range tx.MsgTx().TxIn {
+			prevOut := txIn.PreviousOutPoint
+			if _, ok := spent[prevOut]; ok {
+				continue nextTx
+			}
+			_, parentInBlock := inBlock[prevOut.Hash]
+			if !parentInBlock && haveTransaction(&prevOut.Hash) {
+				continue nextTx
+			}
+		}
+		if !isFinalized(tx) {
+			continue
+		}
+		omitted = append(omitted, txDesc)
+	}
+
+	sort.SliceStable(omitted, func(i, j int) bool {
+		return omitted[i].FeePerKB > omitted[j].FeePerKB
+	})
+	return omitted
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mining
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// TestOmittedTxns ensures the source pool transactions left out of an audited
+// block are only reported when they could have been included in its place.
+func TestOmittedTxns(t *testing.T) {
+	// newTx returns a transaction spending the passed outpoints.  The
+	// lock time makes transactions with the same inputs distinct.
+	newTx := func(lockTime uint32, prevOuts ...wire.OutPoint) *bchutil.Tx {
+		msgTx := wire.NewMsgTx(wire.TxVersion)
+		for i := range prevOuts {
+			msgTx.AddTxIn(wire.NewTxIn(&prevOuts[i], nil))
+		}
+		msgTx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}, wire.TokenData{}))
+		msgTx.LockTime = lockTime
+		return bchutil.NewTx(msgTx)
+	}
+	confirmed := func(index uint32) wire.OutPoint {
+		return wire.OutPoint{Hash: chainhash.Hash{0x01}, Index: index}
+	}
+
+	included := newTx(0, confirmed(0))
+	higherFee := newTx(0, confirmed(1))
+	highestFee := newTx(0, confirmed(2))
+	lowerFee := newTx(0, confirmed(3))
+	conflicting := newTx(1, confirmed(0))
+	missingParent := newTx(0, wire.OutPoint{Hash: *higherFee.Hash()})
+	includedParent := newTx(0, wire.OutPoint{Hash: *included.Hash()})
+	notFinal := newTx(2, confirmed(4))
+	free := newTx(0, confirmed(5))
+
+	sourceTxns := []*TxDesc{
+		{Tx: included, FeePerKB: 2000},
+		{Tx: higherFee, FeePerKB: 3000},
+		{Tx: highestFee, FeePerKB: 5000},
+		{Tx: lowerFee, FeePerKB: 1500},
+		{Tx: conflicting, FeePerKB: 9000},
+		{Tx: missingParent, FeePerKB: 9000},
+		{Tx: includedParent, FeePerKB: 4000},
+		{Tx: notFinal, FeePerKB: 9000},
+		{Tx: free, FeePerKB: 500},
+	}
+	haveTransaction := func(hash *chainhash.Hash) bool {
+		for _, txDesc := range sourceTxns {
+			if txDesc.Tx.Hash().IsEqual(hash) {
+				return true
+			}
+		}
+		return false
+	}
+	isFinalized := func(tx *bchutil.Tx) bool {
+		return tx != notFinal
+	}
+
+	blockTxns := []*bchutil.Tx{included}
+	omitted := omittedTxns(blockTxns, sourceTxns, 2000, 1000,
+		haveTransaction, isFinalized)
+	want := []*bchutil.Tx{highestFee, includedParent, higherFee}
+	if len(omitted) != len(want) {
+		t.Fatalf("got %d omitted transactions, want %d", len(omitted),
+			len(want))
+	}
+	for i, txDesc := range omitted {
+		if txDesc.Tx != want[i] {
+			t.Errorf("omitted transaction %d is %v, want %v", i,
+				txDesc.Tx.Hash(), want[i].Hash())
+		}
+	}
+
+	// Without any transactions besides the coinbase, every transaction
+	// paying at least the minimum fee could have been included.
+	omitted = omittedTxns(nil, sourceTxns, -1, 1000, haveTransaction,
+		isFinalized)
+	if len(omitted) != 5 {
+		t.Fatalf("got %d omitted transactions for an empty block, "+
+			"want 5", len(omitted))
+	}
+}
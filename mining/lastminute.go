@@ -0,0 +1,228 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mining
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// lastMinuteCandidates returns the passed source pool transactions which are
+// not in the passed set of template transactions and pay at least the passed
+// fee per kilobyte, ordered by fee per kilobyte from highest to lowest.
+func lastMinuteCandidates(sourceTxns []*TxDesc, inTemplate map[chainhash.Hash]struct{}, minFeePerKB int64) []*TxDesc {
+	var candidates []*TxDesc
+	for _, txDesc := range sourceTxns {
+		if _, ok := inTemplate[*txDesc.Tx.Hash()]; ok {
+			continue
+		}
+		if txDesc.ModifiedFeePerKB() < minFeePerKB {
+			continue
+		}
+		candidates = append(candidates, txDesc)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].ModifiedFeePerKB() >
+			candidates[j].ModifiedFeePerKB()
+	})
+	return candidates
+}
+
+// AddLastMinuteTxs adds the transactions of the source pool paying at least the
+// passed fee per kilobyte which are not in the passed block template yet, such
+// as the ones which arrived since it was generated, to the template.  This is a
+// quick incremental pass which captures fee spikes without regenerating the
+// whole template, so only the transactions fitting in the remaining size and
+// sigchecks budget of the block are added and none of the transactions of the
+// template are evicted in their favor.
+//
+// Only transactions spending confirmed outputs or outputs of the transactions
+// in the template are added.  The coinbase value and the fees and signature
+// checks of the template are updated accordingly.  The template is left
+// untouched when the resulting block fails the consensus checks or no longer
+// extends the current best chain.
+//
+// It returns the number of transactions added to the template.
+func (g *BlkTmplGenerator) AddLastMinuteTxs(template *BlockTemplate, minFeePerKB int64) (int, error) {
+	best := g.chain.BestSnapshot()
+	msgBlock := template.Block
+	if msgBlock.Header.PrevBlock != best.Hash {
+		return 0, nil
+	}
+	nextBlockHeight := template.Height
+	scriptFlags := templateScriptFlags(g.chainParams, nextBlockHeight,
+		best.MedianTime)
+	canonicalOrder := nextBlockHeight > g.chainParams.MagneticAnonomalyForkHeight
+
+	// Keep track of the outputs created by the transactions of the
+	// template along with the outputs they spend so the added transactions
+	// can spend the former but not double spend the latter.
+	blockTxns := make([]*bchutil.Tx, 0, len(msgBlock.Transactions))
+	inTemplate := make(map[chainhash.Hash]struct{}, len(msgBlock.Transactions))
+	blockUtxos := blockchain.NewUtxoViewpoint()
+	spent := make(map[wire.OutPoint]struct{})
+	blockSigChecks := int64(0)
+	for i, msgTx := range msgBlock.Transactions {
+		tx := bchutil.NewTx(msgTx)
+		inTemplate[*tx.Hash()] = struct{}{}
+		blockSigChecks += template.SigChecks[i]
+		if i == 0 {
+			continue
+		}
+		blockTxns = append(blockTxns, tx)
+		blockUtxos.AddTxOuts(tx, nextBlockHeight)
+		for _, txIn := range msgTx.TxIn {
+			spent[txIn.PreviousOutPoint] = struct{}{}
+		}
+	}
+
+	candidates := lastMinuteCandidates(g.txSource.MiningDescs(), inTemplate,
+		minFeePerKB)
+	if len(candidates) == 0 {
+		return 0, nil
+	}
+
+	blockSize := uint32(msgBlock.SerializeSize())
+	txFees := slices.Clone(template.Fees)
+	txSigChecks := slices.Clone(template.SigChecks)
+	addedFees := int64(0)
+	added := 0
+
+candidateLoop:
+	for _, txDesc := range candidates {
+		tx := txDesc.Tx
+		if blockchain.IsCoinBase(tx) || !blockchain.IsFinalizedTransaction(tx,
+			nextBlockHeight, g.timeSource.AdjustedTime()) {
+
+			continue
+		}
+
+		// Enforce the maximum block size.  Also check for overflow.
+		txSize := uint32(tx.MsgTx().SerializeSize())
+		blockPlusTxSize := blockSize + txSize
+		if blockPlusTxSize < txSize || blockPlusTxSize >= template.BlockMaxSize {
+			log.Tracef("Skipping last-minute tx %s because it would "+
+				"exceed the max block size", tx.Hash())
+			continue
+		}
+
+		// Resolve the inputs of the transaction from the outputs of the
+		// template and from the chain, skipping it when it depends on
+		// other transactions of the source pool or double spends a
+		// transaction of the template.
+		utxos, err := g.chain.FetchUtxoView(tx)
+		if err != nil {
+			log.Warnf("Unable to fetch utxo view for tx %s: %v",
+				tx.Hash(), err)
+			continue
+		}
+		for _, txIn := range tx.MsgTx().TxIn {
+			prevOut := txIn.PreviousOutPoint
+			if _, ok := spent[prevOut]; ok {
+				log.Tracef("Skipping last-minute tx %s because "+
+					"it double spends %s", tx.Hash(), prevOut)
+				continue candidateLoop
+			}
+			if entry := blockUtxos.LookupEntry(prevOut); entry != nil {
+				utxos.Entries()[prevOut] = entry
+				continue
+			}
+			entry := utxos.LookupEntry(prevOut)
+			if entry == nil || entry.IsSpent() {
+				log.Tracef("Skipping last-minute tx %s because "+
+					"it references unspent output %s which "+
+					"is not available", tx.Hash(), prevOut)
+				continue candidateLoop
+			}
+		}
+
+		// Ensure the transaction inputs pass all of the necessary
+		// preconditions before allowing it to be added to the block.
+		_, err = blockchain.CheckTransactionInputs(tx, nextBlockHeight,
+			utxos, g.chainParams)
+		if err != nil {
+			log.Tracef("Skipping last-minute tx %s due to error in "+
+				"CheckTransactionInputs: %v", tx.Hash(), err)
+			continue
+		}
+		sigchecks, err := blockchain.ValidateTransactionScripts(tx, utxos,
+			scriptFlags, g.sigCache, g.hashCache,
+			g.chainParams.Upgrade9Activation())
+		if err != nil {
+			log.Tracef("Skipping last-minute tx %s due to error in "+
+				"ValidateTransactionScripts: %v", tx.Hash(), err)
+			continue
+		}
+		if blockSigChecks+int64(sigchecks) > int64(template.MaxSigChecks) {
+			log.Tracef("Skipping last-minute tx %s with %d "+
+				"sigchecks because it would exceed the remaining "+
+				"sigchecks budget of %d", tx.Hash(), sigchecks,
+				int64(template.MaxSigChecks)-blockSigChecks)
+			continue
+		}
+
+		// Make the outputs of the transaction available to the
+		// remaining candidates and add it to the block.
+		for _, txIn := range tx.MsgTx().TxIn {
+			spent[txIn.PreviousOutPoint] = struct{}{}
+		}
+		blockUtxos.AddTxOuts(tx, nextBlockHeight)
+		blockTxns = append(blockTxns, tx)
+		txFees = append(txFees, txDesc.Fee)
+		txSigChecks = append(txSigChecks, int64(sigchecks))
+		blockSize = blockPlusTxSize
+		blockSigChecks += int64(sigchecks)
+		addedFees += txDesc.Fee
+		added++
+
+		log.Tracef("Adding last-minute tx %s (feePerKB %d)", tx.Hash(),
+			txDesc.ModifiedFeePerKB())
+	}
+	if added == 0 {
+		return 0, nil
+	}
+
+	// Sort the transactions in the canonical order along with their fees
+	// and signature operation counts now that all of them have been
+	// added.
+	if canonicalOrder {
+		sortBlockTxns(blockTxns, txFees, txSigChecks)
+	}
+
+	// Build the updated block with the coinbase collecting the fees of the
+	// added transactions and check it against the consensus rules before
+	// updating the template.
+	coinbaseTx := msgBlock.Transactions[0].Copy()
+	coinbaseTx.TxOut[0].Value += addedFees
+	txFees[0] -= addedFees
+	blockTxns = append([]*bchutil.Tx{bchutil.NewTx(coinbaseTx)}, blockTxns...)
+
+	merkles := blockchain.BuildMerkleTreeStore(blockTxns)
+	newBlock := wire.MsgBlock{Header: msgBlock.Header}
+	newBlock.Header.MerkleRoot = *merkles[len(merkles)-1]
+	for _, tx := range blockTxns {
+		if err := newBlock.AddTransaction(tx.MsgTx()); err != nil {
+			return 0, err
+		}
+	}
+	block := bchutil.NewBlock(&newBlock)
+	block.SetHeight(nextBlockHeight)
+	if err := g.chain.CheckConnectBlockTemplate(block); err != nil {
+		return 0, err
+	}
+
+	log.Debugf("Added %d last-minute transactions paying %d in fees to "+
+		"block template", added, addedFees)
+
+	template.Block = &newBlock
+	template.Fees = txFees
+	template.SigChecks = txSigChecks
+	return added, nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mining
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// TestLastMinuteCandidates ensures only the source pool transactions missing
+// from the template which pay at least the minimum fee rate are considered for
+// last-minute inclusion, highest fee rate first.
+func TestLastMinuteCandidates(t *testing.T) {
+	newTx := func(index uint32) *bchutil.Tx {
+		prevOut := wire.OutPoint{Hash: chainhash.Hash{0x01}, Index: index}
+		msgTx := wire.NewMsgTx(wire.TxVersion)
+		msgTx.AddTxIn(wire.NewTxIn(&prevOut, nil))
+		msgTx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}, wire.TokenData{}))
+		return bchutil.NewTx(msgTx)
+	}
+
+	included := newTx(0)
+	high := newTx(1)
+	higher := newTx(2)
+	prioritized := newTx(3)
+	low := newTx(4)
+	sourceTxns := []*TxDesc{
+		{Tx: included, FeePerKB: 50000},
+		{Tx: high, FeePerKB: 10000},
+		{Tx: low, FeePerKB: 1000},
+		{Tx: higher, FeePerKB: 20000},
+		{Tx: prioritized, FeePerKB: 1000, FeeDelta: 100000},
+	}
+	inTemplate := map[chainhash.Hash]struct{}{*included.Hash(): {}}
+
+	candidates := lastMinuteCandidates(sourceTxns, inTemplate, 10000)
+	want := []*bchutil.Tx{prioritized, higher, high}
+	if len(candidates) != len(want) {
+		t.Fatalf("got %d candidates, want %d", len(candidates), len(want))
+	}
+	for i, txDesc := range candidates {
+		if txDesc.Tx != want[i] {
+			t.Errorf("candidate %d is %v, want %v", i,
+				txDesc.Tx.Hash(), want[i].Hash())
+		}
+	}
+}
@@ -9,8 +9,6 @@ import (
 	"fmt"
 	"time"
 
-	"sort"
-
 	"github.com/gcash/bchd/blockchain"
 	"github.com/gcash/bchd/chaincfg"
 	"github.com/gcash/bchd/chaincfg/chainhash"
@@ -54,6 +52,21 @@ type TxDesc struct {
 
 	// FeePerKB is the fee the transaction pays in Satoshi per 1000 bytes.
 	FeePerKB int64
+
+	// FeeDelta is added to the fee of the transaction when selecting the
+	// transactions of new blocks so it is mined earlier, or later when it
+	// is negative.  It doesn't change the fee the transaction pays.
+	FeeDelta int64
+}
+
+// ModifiedFeePerKB returns the fee per 1000 bytes of the transaction including
+// its fee delta, which is the fee rate used to select the transactions of new
+// blocks.
+func (d *TxDesc) ModifiedFeePerKB() int64 {
+	if d.FeeDelta == 0 {
+		return d.FeePerKB
+	}
+	return (d.Fee + d.FeeDelta) * 1000 / int64(d.Tx.MsgTx().SerializeSize())
 }
 
 // TxSource represents a source of transactions to consider for inclusion in
@@ -84,6 +97,10 @@ type txPrioItem struct {
 	priority float64
 	feePerKB int64
 
+	// tokens is set when the transaction spends or creates CashTokens and
+	// the template profile selects token transactions first.
+	tokens bool
+
 	// dependsOn holds a map of transaction hashes which this one depends
 	// on.  It will only be set when the transaction references other
 	// transactions in the source pool and hence must come after them in
@@ -168,6 +185,15 @@ func txPQByFee(pq *txPriorityQueue, i, j int) bool {
 	return pq.items[i].feePerKB > pq.items[j].feePerKB
 }
 
+// txPQByTokenFee sorts a txPriorityQueue by whether transactions spend or
+// create CashTokens and then by fees per kilobyte and transaction priority.
+func txPQByTokenFee(pq *txPriorityQueue, i, j int) bool {
+	if pq.items[i].tokens != pq.items[j].tokens {
+		return pq.items[i].tokens
+	}
+	return txPQByFee(pq, i, j)
+}
+
 // newTxPriorityQueue returns a new transaction priority queue that reserves the
 // passed amount of space for the elements.  The new priority queue uses either
 // the txPQByPriority or the txPQByFee compare function depending on the
@@ -186,6 +212,23 @@ func newTxPriorityQueue(reserve int, sortByFee bool) *txPriorityQueue {
 	return pq
 }
 
+// TemplateProfile describes a trade-off made when selecting the transactions
+// of a block template, allowing several candidate templates to be generated
+// from the same transactions.
+type TemplateProfile struct {
+	// Name identifies the templates generated with the profile.
+	Name string
+
+	// BlockMaxSize limits the size of the generated templates below the
+	// BlockMaxSize policy setting when it is not zero.  Smaller blocks
+	// collect less fees but propagate faster.
+	BlockMaxSize uint32
+
+	// TokenPriority selects transactions which spend or create CashTokens
+	// before the other ones once the high-priority area has been filled.
+	TokenPriority bool
+}
+
 // BlockTemplate houses a block that has yet to be solved along with additional
 // details about the fees and the number of signature operations for each
 // transaction in the block.
@@ -218,9 +261,40 @@ type BlockTemplate struct {
 	// MaxBlockSize is the block size consensus rule used when creating the block
 	MaxBlockSize uint32
 
+	// BlockMaxSize is the size the transactions of the template were
+	// limited to, which is the lower of the policy setting and the one of
+	// the template profile.
+	BlockMaxSize uint32
+
 	// MaxSigChecks is the total sigchecks allowed in the block given the
 	// consensus rules.
 	MaxSigChecks uint32
+
+	// MinFeePerKB is the lowest fee per kilobyte in satoshi of the
+	// transactions selected by fee when some transactions didn't fit in the
+	// block, which is the fee rate a transaction has to beat to be included
+	// in the template.  It is zero when all the transactions fit.
+	MinFeePerKB int64
+}
+
+// templateScriptFlags returns the script flags used to validate the
+// transactions selected for a block template which connects at the passed
+// height on top of a chain with the passed median time.  The flags enforce the
+// standard verification rules along with the consensus rules active for the
+// block, such as CashTokens and the May 2025 VM limits, so the sigchecks
+// counted for each transaction match the ones counted when the block is
+// connected.  The per-input sigchecks density rule is a relay policy and is not
+// enforced since it was already applied when the transactions were accepted
+// to the mempool.
+func templateScriptFlags(params *chaincfg.Params, nextBlockHeight int32, medianTime time.Time) txscript.ScriptFlags {
+	flags := txscript.StandardVerifyFlags &^ txscript.ScriptVerifyInputSigChecks
+	if nextBlockHeight > params.Upgrade9Activation() {
+		flags |= txscript.ScriptAllowCashTokens
+	}
+	if medianTime.Unix() >= int64(params.Upgrade11Activation()) {
+		flags |= txscript.ScriptAllowMay2025
+	}
+	return flags
 }
 
 // mergeUtxoView adds all of the entries in viewB to viewA.  The result is that
@@ -329,6 +403,26 @@ func logSkippedDeps(tx *bchutil.Tx, deps map[chainhash.Hash]*txPrioItem) {
 	}
 }
 
+// hasTokens returns whether the passed transaction creates CashTokens or spends
+// outputs holding CashTokens which are in the passed utxo view.
+func hasTokens(tx *bchutil.Tx, utxos *blockchain.UtxoViewpoint) bool {
+	for _, txOut := range tx.MsgTx().TxOut {
+		if !txOut.TokenData.IsEmpty() {
+			return true
+		}
+	}
+	for _, txIn := range tx.MsgTx().TxIn {
+		entry := utxos.LookupEntry(txIn.PreviousOutPoint)
+		if entry != nil {
+			tokenData := entry.TokenData()
+			if !tokenData.IsEmpty() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // MinimumMedianTime returns the minimum allowed timestamp for a block building
 // on the end of the provided best chain.  In particular, it is one second after
 // the median timestamp of the last several blocks per the chain consensus
@@ -456,6 +550,35 @@ func NewBlkTmplGenerator(policy *Policy, params *chaincfg.Params,
 //	|  <= policy.BlockMinSize)          |   |
 //	 -----------------------------------  --
 func (g *BlkTmplGenerator) NewBlockTemplate(payToAddress bchutil.Address) (*BlockTemplate, error) {
+	return g.NewBlockTemplateFromDescs(payToAddress,
+		g.txSource.MiningDescs(), &TemplateProfile{})
+}
+
+// NewBlockTemplates returns a block template for each of the passed profiles,
+// which are generated from the passed mining descriptors so they only differ by
+// the trade-offs of the profiles.  See NewBlockTemplateFromDescs for details.
+func (g *BlkTmplGenerator) NewBlockTemplates(payToAddress bchutil.Address, sourceTxns []*TxDesc, profiles []TemplateProfile) ([]*BlockTemplate, error) {
+	templates := make([]*BlockTemplate, 0, len(profiles))
+	for i := range profiles {
+		template, err := g.NewBlockTemplateFromDescs(payToAddress,
+			sourceTxns, &profiles[i])
+		if err != nil {
+			return nil, fmt.Errorf("%s template: %v", profiles[i].Name,
+				err)
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+// NewBlockTemplateFromDescs returns a new block template selecting transactions
+// from the passed mining descriptors of the transaction source pool, as
+// returned by its MiningDescs method, with the trade-offs of the passed
+// profile.  Templates generated from the same descriptors only differ by the
+// trade-offs of their profiles.  See NewBlockTemplate for details, which is the
+// template generated from all the transactions of the pool for an empty
+// profile.
+func (g *BlkTmplGenerator) NewBlockTemplateFromDescs(payToAddress bchutil.Address, sourceTxns []*TxDesc, profile *TemplateProfile) (*BlockTemplate, error) {
 	// Extend the most recently known best block.
 	best := g.chain.BestSnapshot()
 	nextBlockHeight := best.Height + 1
@@ -465,6 +588,8 @@ func (g *BlkTmplGenerator) NewBlockTemplate(payToAddress bchutil.Address) (*Bloc
 	maxBlockSize := g.chain.MaxBlockSize(true, false)
 
 	maxSigChecks := maxBlockSize / blockchain.BlockMaxBytesMaxSigChecksRatio
+	scriptFlags := templateScriptFlags(g.chainParams, nextBlockHeight,
+		best.MedianTime)
 
 	// Create a standard coinbase transaction paying to the provided
 	// address.  NOTE: The coinbase value will be updated to include the
@@ -485,21 +610,40 @@ func (g *BlkTmplGenerator) NewBlockTemplate(payToAddress bchutil.Address) (*Bloc
 		return nil, err
 	}
 
-	// Get the current source transactions and create a priority queue to
-	// hold the transactions which are ready for inclusion into a block
-	// along with some priority related and fee metadata.  Reserve the same
-	// number of items that are available for the priority queue.  Also,
-	// choose the initial sort order for the priority queue based on whether
-	// or not there is an area allocated for high-priority transactions.
-	sourceTxns := g.txSource.MiningDescs()
-	sortedByFee := g.policy.BlockPrioritySize == 0
+	// Limit the size of the block to the one of the profile when it is
+	// smaller than the policy setting.
+	blockMaxSize := g.policy.BlockMaxSize
+	if profile.BlockMaxSize != 0 && profile.BlockMaxSize < blockMaxSize {
+		blockMaxSize = profile.BlockMaxSize
+	}
+
+	// Create a priority queue to hold the source transactions which are
+	// ready for inclusion into a block along with some priority related and
+	// fee metadata.  Reserve the same number of items that are available
+	// for the priority queue.  Also, choose the initial sort order for the
+	// priority queue based on whether or not there is an area allocated
+	// for high-priority transactions.  Profiles which prioritize tokens
+	// order token transactions first once the queue is sorted by fees.
+	sortedByFee := g.policy.FeeOnly || g.policy.BlockPrioritySize == 0
 	priorityQueue := newTxPriorityQueue(len(sourceTxns), sortedByFee)
+	feeLessFunc := txPQByFee
+	if profile.TokenPriority {
+		feeLessFunc = txPQByTokenFee
+		if sortedByFee {
+			priorityQueue.SetLessFunc(feeLessFunc)
+		}
+	}
 
 	// Create a slice to hold the transactions to be included in the
 	// generated block with reserved space.  Also create a utxo view to
 	// house all of the input transactions so multiple lookups can be
-	// avoided.
+	// avoided.  Once MagneticAnomaly is active, the transactions are sorted
+	// in the canonical order required by the CTOR consensus rule once all
+	// of them have been selected.  Otherwise, they are kept in the order
+	// they are selected, which ensures transactions come after the ones
+	// they depend on.
 	blockTxns := make([]*bchutil.Tx, 0, len(sourceTxns))
+	canonicalOrder := nextBlockHeight > g.chainParams.MagneticAnonomalyForkHeight
 	blockUtxos := blockchain.NewUtxoViewpoint()
 
 	// dependers is used to track transactions which depend on another
@@ -591,12 +735,20 @@ mempoolLoop:
 		// Calculate the final transaction priority using the input
 		// value age sum as well as the adjusted transaction size.  The
 		// formula is: sum(inputValue * inputAge) / adjustedTxSize
-		prioItem.priority = CalcPriority(tx.MsgTx(), utxos,
-			nextBlockHeight)
+		//
+		// The priority is not used when ordering purely by fee, so
+		// skip calculating it in that case.
+		if !g.policy.FeeOnly {
+			prioItem.priority = CalcPriority(tx.MsgTx(), utxos,
+				nextBlockHeight)
+		}
 
-		// Calculate the fee in Satoshi/kB.
-		prioItem.feePerKB = txDesc.FeePerKB
+		// Calculate the fee in Satoshi/kB.  The fee delta of the
+		// transaction only changes how it is prioritized, the fee
+		// collected by the coinbase is the fee it pays.
+		prioItem.feePerKB = txDesc.ModifiedFeePerKB()
 		prioItem.fee = txDesc.Fee
+		prioItem.tokens = profile.TokenPriority && hasTokens(tx, utxos)
 
 		// Add the transaction to the priority queue to mark it ready
 		// for inclusion in the block unless it has dependencies.
@@ -620,6 +772,12 @@ mempoolLoop:
 	blockSigChecks := int64(0)
 	totalFees := int64(0)
 
+	// Track the lowest fee rate of the transactions selected by fee and
+	// whether any transaction didn't fit, which together tell the fee
+	// rate needed to get into the block.
+	blockFull := false
+	minFeePerKB := int64(-1)
+
 	// Choose which transactions make it into the block.
 	for priorityQueue.Len() > 0 {
 		// Grab the highest priority (or highest fee per kilobyte
@@ -634,11 +792,12 @@ mempoolLoop:
 		txSize := uint32(tx.MsgTx().SerializeSize())
 		blockPlusTxSize := blockSize + txSize
 		if blockPlusTxSize < txSize ||
-			blockPlusTxSize >= g.policy.BlockMaxSize {
+			blockPlusTxSize >= blockMaxSize {
 
 			log.Tracef("Skipping tx %s because it would exceed "+
 				"the max block size", tx.Hash())
 			logSkippedDeps(tx, deps)
+			blockFull = true
 			continue
 		}
 
@@ -670,7 +829,7 @@ mempoolLoop:
 				prioItem.priority, MinHighPriority)
 
 			sortedByFee = true
-			priorityQueue.SetLessFunc(txPQByFee)
+			priorityQueue.SetLessFunc(feeLessFunc)
 
 			// Put the transaction back into the priority queue and
 			// skip it so it is re-priortized by fees if it won't
@@ -697,8 +856,8 @@ mempoolLoop:
 			continue
 		}
 		sigchecks, err := blockchain.ValidateTransactionScripts(tx, blockUtxos,
-			txscript.StandardVerifyFlags, g.sigCache,
-			g.hashCache, g.chainParams.Upgrade9ForkHeight)
+			scriptFlags, g.sigCache, g.hashCache,
+			g.chainParams.Upgrade9Activation())
 		if err != nil {
 			log.Tracef("Skipping tx %s due to error in "+
 				"ValidateTransactionScripts: %v", tx.Hash(), err)
@@ -706,11 +865,17 @@ mempoolLoop:
 			continue
 		}
 
+		// Enforce the block sigchecks budget.  Transactions which don't
+		// fit are deferred in favor of the remaining ones, which may
+		// still fit into the remaining budget.
 		if blockSigChecks+int64(sigchecks) < blockSigChecks ||
 			blockSigChecks+int64(sigchecks) > int64(maxSigChecks) {
-			log.Tracef("Skipping tx %s because it would "+
-				"exceed the maximum sigchecks per block", tx.Hash())
+			log.Tracef("Skipping tx %s with %d sigchecks because "+
+				"it would exceed the remaining sigchecks budget "+
+				"of %d", tx.Hash(), sigchecks,
+				int64(maxSigChecks)-blockSigChecks)
 			logSkippedDeps(tx, deps)
+			blockFull = true
 			continue
 		}
 
@@ -722,13 +887,17 @@ mempoolLoop:
 
 		// Add the transaction to the block, increment counters, and
 		// save the fees and signature operation counts to the block
-		// template.
+		// template.  They are kept at the index of the transaction in
+		// the final block, which follows the entry for the coinbase.
 		blockTxns = append(blockTxns, tx)
+		txFees = append(txFees, prioItem.fee)
+		txSigChecks = append(txSigChecks, int64(sigchecks))
 		blockSize = blockPlusTxSize
 		blockSigChecks += int64(sigchecks)
 		totalFees += prioItem.fee
-		txFees = append(txFees, prioItem.fee)
-		txSigChecks = append(txSigChecks, int64(sigchecks))
+		if sortedByFee && (minFeePerKB < 0 || prioItem.feePerKB < minFeePerKB) {
+			minFeePerKB = prioItem.feePerKB
+		}
 
 		log.Tracef("Adding tx %s (priority %.2f, feePerKB %.2f)",
 			prioItem.tx.Hash(), prioItem.priority, prioItem.feePerKB)
@@ -768,10 +937,11 @@ mempoolLoop:
 		return nil, err
 	}
 
-	// If MagneticAnomaly is enabled we need to sort transactions by txid to
-	// comply with the CTOR consensus rule.
-	if nextBlockHeight > g.chainParams.MagneticAnonomalyForkHeight {
-		sort.Sort(TxSorter(blockTxns))
+	// Sort the transactions in the canonical order along with their fees
+	// and signature operation counts now that all of them have been
+	// selected.
+	if canonicalOrder {
+		sortBlockTxns(blockTxns, txFees, txSigChecks)
 	}
 	blockTxns = append([]*bchutil.Tx{coinbaseTx}, blockTxns...)
 
@@ -800,6 +970,12 @@ mempoolLoop:
 		return nil, err
 	}
 
+	// Transactions only have to beat the lowest fee rate selected when
+	// some transactions didn't fit in the block.
+	if !blockFull || minFeePerKB < 0 {
+		minFeePerKB = 0
+	}
+
 	log.Debugf("Created new block template (%d transactions, %d in "+
 		"fees, %d signature checks, %d size, target difficulty "+
 		"%064x)", len(msgBlock.Transactions), totalFees, blockSigChecks,
@@ -813,6 +989,8 @@ mempoolLoop:
 		Height:          nextBlockHeight,
 		ValidPayAddress: payToAddress != nil,
 		MaxBlockSize:    uint32(maxBlockSize),
+		BlockMaxSize:    blockMaxSize,
+		MinFeePerKB:     minFeePerKB,
 	}, nil
 }
 
@@ -8,10 +8,12 @@ import (
 	"container/heap"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/gcash/bchd/blockchain"
 	"github.com/gcash/bchd/chaincfg"
 	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
 
 	"github.com/gcash/bchutil"
 )
@@ -113,6 +115,78 @@ func TestTxFeePrioHeap(t *testing.T) {
 	}
 }
 
+// TestTxTokenFeePrioHeap ensures the priority queue of the templates which
+// prioritize tokens orders token transactions first and then by fees.
+func TestTxTokenFeePrioHeap(t *testing.T) {
+	testItems := []*txPrioItem{
+		{feePerKB: 5000, priority: 1},
+		{feePerKB: 1000, priority: 5, tokens: true},
+		{feePerKB: 10000, priority: 2},
+		{feePerKB: 3000, priority: 1, tokens: true},
+		{feePerKB: 3000, priority: 3, tokens: true},
+	}
+	want := []*txPrioItem{
+		testItems[4], testItems[3], testItems[1], testItems[2],
+		testItems[0],
+	}
+
+	priorityQueue := newTxPriorityQueue(len(testItems), true)
+	priorityQueue.SetLessFunc(txPQByTokenFee)
+	for _, prioItem := range testItems {
+		heap.Push(priorityQueue, prioItem)
+	}
+	for i, wantItem := range want {
+		prioItem := heap.Pop(priorityQueue).(*txPrioItem)
+		if prioItem != wantItem {
+			t.Fatalf("item %d: got (fee per KB: %v, priority: %v, "+
+				"tokens: %v), want (fee per KB: %v, priority: "+
+				"%v, tokens: %v)", i, prioItem.feePerKB,
+				prioItem.priority, prioItem.tokens,
+				wantItem.feePerKB, wantItem.priority,
+				wantItem.tokens)
+		}
+	}
+}
+
+// TestSortBlockTxns ensures sortBlockTxns sorts the transactions in the
+// canonical order and keeps their fees and signature check counts at the index
+// of the transaction in the final block.
+func TestSortBlockTxns(t *testing.T) {
+	coinbase := bchutil.NewTx(wire.NewMsgTx(wire.TxVersion))
+	txns := make([]*bchutil.Tx, 0, 100)
+	fees := []int64{-1}
+	sigChecks := []int64{0}
+	for i := 0; i < 100; i++ {
+		msgTx := wire.NewMsgTx(wire.TxVersion)
+		msgTx.LockTime = uint32(i)
+		txns = append(txns, bchutil.NewTx(msgTx))
+		fees = append(fees, int64(i))
+		sigChecks = append(sigChecks, int64(i)*2)
+	}
+	sortBlockTxns(txns, fees, sigChecks)
+
+	var msgBlock wire.MsgBlock
+	for _, tx := range append([]*bchutil.Tx{coinbase}, txns...) {
+		msgBlock.AddTransaction(tx.MsgTx())
+	}
+	err := blockchain.CheckCanonicalTxOrder(bchutil.NewBlock(&msgBlock))
+	if err != nil {
+		t.Fatalf("transactions are not in the canonical order: %v", err)
+	}
+	if fees[0] != -1 || sigChecks[0] != 0 {
+		t.Errorf("got coinbase fee %d and sigchecks %d, want -1 and 0",
+			fees[0], sigChecks[0])
+	}
+	for i, tx := range txns {
+		lockTime := int64(tx.MsgTx().LockTime)
+		if fees[i+1] != lockTime || sigChecks[i+1] != lockTime*2 {
+			t.Errorf("tx %d: got fee %d and sigchecks %d, want %d "+
+				"and %d", i, fees[i+1], sigChecks[i+1],
+				lockTime, lockTime*2)
+		}
+	}
+}
+
 // Test_createCoinbaseTx tests that the coinbase is padded to be over the minimum transaction size.
 func Test_createCoinbaseTx(t *testing.T) {
 	coinbaseScript, err := standardCoinbaseScript(584412, 123456789)
@@ -132,3 +206,55 @@ func Test_createCoinbaseTx(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+// TestTemplateScriptFlags ensures the script flags used to validate template
+// transactions enable the consensus upgrades active for the next block.
+func TestTemplateScriptFlags(t *testing.T) {
+	params := chaincfg.MainNetParams
+	upgrade11Time := time.Unix(int64(params.Upgrade11ActivationTime), 0)
+
+	tests := []struct {
+		name       string
+		height     int32
+		medianTime time.Time
+		tokens     bool
+		may2025    bool
+	}{
+		{
+			name:       "before upgrade9",
+			height:     params.Upgrade9ForkHeight,
+			medianTime: upgrade11Time.Add(-time.Hour * 24 * 365),
+		},
+		{
+			name:       "upgrade9 active",
+			height:     params.Upgrade9ForkHeight + 1,
+			medianTime: upgrade11Time.Add(-time.Second),
+			tokens:     true,
+		},
+		{
+			name:       "upgrade11 active",
+			height:     params.Upgrade9ForkHeight + 1,
+			medianTime: upgrade11Time,
+			tokens:     true,
+			may2025:    true,
+		},
+	}
+
+	for _, test := range tests {
+		flags := templateScriptFlags(&params, test.height, test.medianTime)
+		if !flags.HasFlag(txscript.ScriptReportSigChecks) {
+			t.Errorf("%s: sigchecks are not reported", test.name)
+		}
+		if flags.HasFlag(txscript.ScriptVerifyInputSigChecks) {
+			t.Errorf("%s: input sigchecks policy is enforced", test.name)
+		}
+		if got := flags.HasFlag(txscript.ScriptAllowCashTokens); got != test.tokens {
+			t.Errorf("%s: cashtokens allowed %v, want %v", test.name,
+				got, test.tokens)
+		}
+		if got := flags.HasFlag(txscript.ScriptAllowMay2025); got != test.may2025 {
+			t.Errorf("%s: may2025 allowed %v, want %v", test.name,
+				got, test.may2025)
+		}
+	}
+}
@@ -37,6 +37,12 @@ type Policy struct {
 	// required for a transaction to be treated as free for mining purposes
 	// (block template generation).
 	TxMinFreeFee bchutil.Amount
+
+	// FeeOnly disables the legacy coin-age priority logic when generating
+	// block templates.  Transactions are ordered purely by fee per
+	// kilobyte, BlockPrioritySize is ignored and transaction priorities
+	// are not calculated.
+	FeeOnly bool
 }
 
 // calcInputValueAge is a helper function used to calculate the input age of
@@ -73,11 +79,10 @@ func calcInputValueAge(tx *wire.MsgTx, utxoView *blockchain.UtxoViewpoint, nextB
 	return totalInputAge
 }
 
-// CalcPriority returns a transaction priority given a transaction and the sum
-// of each of its input values multiplied by their age (# of confirmations).
-// Thus, the final formula for the priority is:
-// sum(inputValue * inputAge) / adjustedTxSize
-func CalcPriority(tx *wire.MsgTx, utxoView *blockchain.UtxoViewpoint, nextBlockHeight int32) float64 {
+// PrioritySize returns the adjusted size of the passed transaction which is
+// used to calculate its priority.  It is zero when the transaction is not
+// large enough to have any priority.
+func PrioritySize(tx *wire.MsgTx) int {
 	// In order to encourage spending multiple old unspent transaction
 	// outputs thereby reducing the total set, don't count the constant
 	// overhead for each input as well as enough bytes of the signature
@@ -106,9 +111,21 @@ func CalcPriority(tx *wire.MsgTx, utxoView *blockchain.UtxoViewpoint, nextBlockH
 
 	serializedTxSize := tx.SerializeSize()
 	if overhead >= serializedTxSize {
+		return 0
+	}
+	return serializedTxSize - overhead
+}
+
+// CalcPriority returns a transaction priority given a transaction and the sum
+// of each of its input values multiplied by their age (# of confirmations).
+// Thus, the final formula for the priority is:
+// sum(inputValue * inputAge) / adjustedTxSize
+func CalcPriority(tx *wire.MsgTx, utxoView *blockchain.UtxoViewpoint, nextBlockHeight int32) float64 {
+	prioritySize := PrioritySize(tx)
+	if prioritySize == 0 {
 		return 0.0
 	}
 
 	inputValueAge := calcInputValueAge(tx, utxoView, nextBlockHeight)
-	return inputValueAge / float64(serializedTxSize-overhead)
+	return inputValueAge / float64(prioritySize)
 }
@@ -1,6 +1,10 @@
 package mining
 
-import "github.com/gcash/bchutil"
+import (
+	"sort"
+
+	"github.com/gcash/bchutil"
+)
 
 // TxSorter implements sort.Interface to allow a slice of block headers to
 // be sorted by timestamp.
@@ -23,3 +27,43 @@ func (s TxSorter) Swap(i, j int) {
 func (s TxSorter) Less(i, j int) bool {
 	return s[i].Hash().Compare(s[j].Hash()) < 0
 }
+
+// blockTxSorter implements sort.Interface to allow the transactions selected
+// for a block to be sorted by transaction hash along with their fees and
+// signature check counts.  The fees and signature check counts start with the
+// entry for the coinbase, which is not part of the transactions.
+type blockTxSorter struct {
+	txns      []*bchutil.Tx
+	fees      []int64
+	sigChecks []int64
+}
+
+// Len returns the number of txs in the slice.  It is part of the
+// sort.Interface implementation.
+func (s blockTxSorter) Len() int {
+	return len(s.txns)
+}
+
+// Swap swaps the txs at the passed indices along with their fees and signature
+// check counts.  It is part of the sort.Interface implementation.
+func (s blockTxSorter) Swap(i, j int) {
+	s.txns[i], s.txns[j] = s.txns[j], s.txns[i]
+	s.fees[i+1], s.fees[j+1] = s.fees[j+1], s.fees[i+1]
+	s.sigChecks[i+1], s.sigChecks[j+1] = s.sigChecks[j+1], s.sigChecks[i+1]
+}
+
+// Less returns whether the txs with index i should sort before the
+// tx with index j.  It is part of the sort.Interface implementation.
+func (s blockTxSorter) Less(i, j int) bool {
+	return s.txns[i].Hash().Compare(s.txns[j].Hash()) < 0
+}
+
+// sortBlockTxns sorts the passed transactions selected for a block, which
+// exclude the coinbase, in the canonical order.  The passed fees and signature
+// check counts, which start with the entry for the coinbase, are kept at the
+// index of their transaction in the final block.  This allows the transactions
+// to be appended as they are selected and sorted once they all have been
+// selected.
+func sortBlockTxns(txns []*bchutil.Tx, fees, sigChecks []int64) {
+	sort.Sort(blockTxSorter{txns: txns, fees: fees, sigChecks: sigChecks})
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package netsync
+
+import (
+	"time"
+
+	peerpkg "github.com/gcash/bchd/peer"
+)
+
+const (
+	// chainSplitMinPeers is the minimum number of peers which must have
+	// announced a tip before the tip of the chain is compared against
+	// theirs.
+	chainSplitMinPeers = 4
+
+	// chainSplitWarnDuration is how long the tip of the chain must diverge
+	// from the tips announced by the majority of the peers before a chain
+	// split is reported.
+	chainSplitWarnDuration = 30 * time.Minute
+)
+
+// ChainSplitStatus describes whether the tip of the chain diverges from the
+// tips announced by the majority of the connected peers, which happens when
+// the node ends up on a minority fork.
+type ChainSplitStatus struct {
+	// Detected is set once the tip of the chain diverged from the tips
+	// announced by the majority of the peers for an extended period.
+	Detected bool
+
+	// Since is the time the tip of the chain started to diverge from the
+	// tips announced by the majority of the peers.  It is the zero time
+	// when the tip agrees with the majority.
+	Since time.Time
+
+	// AgreeingPeers is the number of peers which announced a tip on the
+	// main chain.
+	AgreeingPeers int
+
+	// DivergingPeers is the number of peers which announced a tip which
+	// is either unknown or not on the main chain.
+	DivergingPeers int
+
+	// HigherWorkPeers is the number of diverging peers which announced a
+	// tip known to have more work than the tip of the main chain.
+	HigherWorkPeers int
+}
+
+// isHigherWorkPeer returns whether the passed peer announced a tip which is not
+// on the main chain and is known to have more work than the tip of the main
+// chain.
+func (sm *SyncManager) isHigherWorkPeer(state *peerSyncState) bool {
+	tip := state.announcedTip
+	if tip == nil || sm.chain.MainChainHasBlock(tip) {
+		return false
+	}
+	tipWork, err := sm.chain.WorkSumByHash(tip)
+	if err != nil {
+		return false
+	}
+	best := sm.chain.BestSnapshot()
+	bestWork, err := sm.chain.WorkSumByHash(&best.Hash)
+	if err != nil {
+		return false
+	}
+	return tipWork.Cmp(bestWork) > 0
+}
+
+// checkChainSplit compares the tip of the chain against the tips announced by
+// the peers.  When the tip diverges from the tips of the majority of the peers
+// for an extended period a chain split is reported and the sync manager
+// switches to a sync peer on the higher-work chain when one is known.
+func (sm *SyncManager) checkChainSplit() {
+	// The tips announced by the peers are naturally unknown while the
+	// initial block download is in progress, so only start comparing them
+	// once the chain has been current.
+	if !sm.chainSplitArmed {
+		if !sm.chain.IsCurrent() {
+			return
+		}
+		sm.chainSplitArmed = true
+	}
+
+	var agreeing, diverging, higherWork int
+	for _, state := range sm.peerStates {
+		if state.announcedTip == nil {
+			continue
+		}
+		if sm.chain.MainChainHasBlock(state.announcedTip) {
+			agreeing++
+			continue
+		}
+		diverging++
+		if sm.isHigherWorkPeer(state) {
+			higherWork++
+		}
+	}
+
+	status := &sm.chainSplit
+	status.AgreeingPeers = agreeing
+	status.DivergingPeers = diverging
+	status.HigherWorkPeers = higherWork
+
+	// The split is over once the tip agrees with the majority of the peers
+	// again.
+	if agreeing+diverging < chainSplitMinPeers || diverging <= agreeing {
+		if status.Detected {
+			log.Infof("Chain tip agrees with the majority of peers "+
+				"again (%d of %d)", agreeing, agreeing+diverging)
+		}
+		status.Detected = false
+		status.Since = time.Time{}
+		return
+	}
+
+	now := time.Now()
+	if status.Since.IsZero() {
+		status.Since = now
+	}
+	if now.Sub(status.Since) < chainSplitWarnDuration {
+		return
+	}
+
+	if !status.Detected {
+		status.Detected = true
+		best := sm.chain.BestSnapshot()
+		log.Warnf("**********************************************" +
+			"************************")
+		log.Warnf("Possible chain split: the chain tip %v (height %d) "+
+			"has diverged from the tips announced by %d of %d "+
+			"peers since %v", best.Hash, best.Height, diverging,
+			agreeing+diverging, status.Since.Format(time.RFC3339))
+		log.Warnf("This node may be on a minority fork -- check that "+
+			"it runs software which follows the current consensus "+
+			"rules (%d peers announced a chain with more work)",
+			higherWork)
+		log.Warnf("**********************************************" +
+			"************************")
+	}
+
+	// Prefer syncing from a peer on the higher-work chain so the node can
+	// reorganize onto it if it is valid.
+	if higherWork == 0 || sm.syncPeer == nil {
+		return
+	}
+	if state, exists := sm.peerStates[sm.syncPeer]; exists &&
+		sm.isHigherWorkPeer(state) {
+
+		return
+	}
+	log.Infof("Switching sync peer from %v to a peer on the "+
+		"higher-work chain", sm.syncPeer.Addr())
+	if state, exists := sm.peerStates[sm.syncPeer]; exists {
+		sm.clearRequestedState(state)
+	}
+	sm.syncPeer.SetSyncPeer(false)
+	sm.selectNewSyncPeer()
+}
+
+// higherWorkCandidates returns the connected sync candidates which announced a
+// tip on the higher-work chain while a chain split is detected.  It returns
+// nil when there is no split.
+func (sm *SyncManager) higherWorkCandidates() []*peerpkg.Peer {
+	if !sm.chainSplit.Detected || sm.chainSplit.HigherWorkPeers == 0 {
+		return nil
+	}
+	var candidates []*peerpkg.Peer
+	for peer, state := range sm.peerStates {
+		if state.syncCandidate && peer.Connected() &&
+			sm.isHigherWorkPeer(state) {
+
+			candidates = append(candidates, peer)
+		}
+	}
+	return candidates
+}
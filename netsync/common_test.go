@@ -14,6 +14,7 @@ import (
 	"github.com/gcash/bchd/chaincfg"
 	"github.com/gcash/bchd/chaincfg/chainhash"
 	"github.com/gcash/bchd/mempool"
+	"github.com/gcash/bchd/netsync"
 	"github.com/gcash/bchd/peer"
 	"github.com/gcash/bchd/txscript"
 	"github.com/gcash/bchd/wire"
@@ -209,6 +210,9 @@ func (mock *MockPeerNotifier) TransactionConfirmed(tx *bchutil.Tx) {
 	mock.transactionConfirmedChan <- &transactionConfirmedCall{tx: tx}
 }
 
+func (mock *MockPeerNotifier) ReportPeerIncident(p *peer.Peer, incident netsync.PeerIncident) {
+}
+
 // NewMockPeerNotifier creates a new MockPeerNotifier and initializes the
 // channels.
 func NewMockPeerNotifier() *MockPeerNotifier {
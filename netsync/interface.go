@@ -5,6 +5,8 @@
 package netsync
 
 import (
+	"time"
+
 	"github.com/gcash/bchd/blockchain"
 	"github.com/gcash/bchd/chaincfg"
 	"github.com/gcash/bchd/chaincfg/chainhash"
@@ -25,8 +27,26 @@ type PeerNotifier interface {
 	RelayInventory(invVect *wire.InvVect, data interface{})
 
 	TransactionConfirmed(tx *bchutil.Tx)
+
+	// ReportPeerIncident records misbehavior of the passed peer so it can
+	// be taken into account when choosing peers in the future.
+	ReportPeerIncident(p *peer.Peer, incident PeerIncident)
 }
 
+// PeerIncident identifies the kind of misbehavior reported for a peer.
+type PeerIncident int
+
+// The following constants define the kinds of misbehavior reported for a
+// peer.
+const (
+	// PeerStalled indicates the peer stalled the sync as the sync peer.
+	PeerStalled PeerIncident = iota
+
+	// PeerServedBadData indicates the peer sent invalid or unrequested
+	// blocks or headers.
+	PeerServedBadData
+)
+
 // Config is a configuration struct used to initialize a new SyncManager.
 type Config struct {
 	PeerNotifier PeerNotifier
@@ -37,8 +57,18 @@ type Config struct {
 	DisableCheckpoints bool
 	MaxPeers           int
 
+	// TxWorkers is the number of workers processing the transactions
+	// received from peers concurrently.  The transactions are processed
+	// by the block handler when it is less than two.
+	TxWorkers int
+
 	FeeEstimator *mempool.FeeEstimator
 
+	// RejectedTxWindow is how long transactions rejected by the mempool
+	// aren't requested again.  They are forgotten at each new block when
+	// it is zero.
+	RejectedTxWindow time.Duration
+
 	MinSyncPeerNetworkSpeed uint64
 
 	FastSyncMode bool
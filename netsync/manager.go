@@ -50,6 +50,15 @@ const (
 	// hashes to store in memory.
 	maxRequestedTxns = wire.MaxInvPerMsg
 
+	// maxRequestedDSProofs is the maximum number of requested double-spend
+	// proof hashes to store in memory.
+	maxRequestedDSProofs = wire.MaxInvPerMsg
+
+	// maxOrphanParentRequests is the maximum number of missing parents of
+	// orphan transactions whose requests are tracked to fall back to other
+	// peers.
+	maxOrphanParentRequests = wire.MaxInvPerMsg
+
 	// maxLastBlockTime is the longest time in seconds that we will
 	// stay with a sync peer while below the current blockchain height.
 	// Set to 3 minutes.
@@ -58,6 +67,10 @@ const (
 	// syncPeerTickerInterval is how often we check the current
 	// syncPeer. Set to 30 seconds.
 	syncPeerTickerInterval = 30 * time.Second
+
+	// maxAnnouncedHeaders is the maximum number of headers a peer may
+	// announce new blocks with in a single unrequested headers message.
+	maxAnnouncedHeaders = 8
 )
 
 // zeroHash is the zero value hash (all zeros).  It is defined as a convenience.
@@ -107,9 +120,34 @@ type donePeerMsg struct {
 // txMsg packages a bitcoin tx message and the peer it came from together
 // so the block handler has access to that information.
 type txMsg struct {
-	tx    *bchutil.Tx
+	tx         *bchutil.Tx
+	peer       *peerpkg.Peer
+	forceRelay bool
+	reply      chan struct{}
+}
+
+// txResultMsg is sent to the block handler by a transaction worker once it
+// has processed a transaction message.
+type txResultMsg struct {
+	tmsg        *txMsg
+	acceptedTxs []*mempool.TxDesc
+	err         error
+}
+
+// notFoundMsg packages a bitcoin notfound message and the peer it came from
+// together so the block handler has access to that information.
+type notFoundMsg struct {
+	notFound *wire.MsgNotFound
+	peer     *peerpkg.Peer
+}
+
+// dsProofRequestedMsg is a message type to be sent across the message channel
+// for determining whether a double-spend proof received from a peer was
+// requested from it.
+type dsProofRequestedMsg struct {
+	hash  chainhash.Hash
 	peer  *peerpkg.Peer
-	reply chan struct{}
+	reply chan bool
 }
 
 // getSyncPeerMsg is a message type to be sent across the message channel for
@@ -118,6 +156,12 @@ type getSyncPeerMsg struct {
 	reply chan int32
 }
 
+// getChainSplitMsg is a message type to be sent across the message channel for
+// retrieving the chain split status.
+type getChainSplitMsg struct {
+	reply chan ChainSplitStatus
+}
+
 // processBlockResponse is a response sent to the reply channel of a
 // processBlockMsg.
 type processBlockResponse struct {
@@ -136,6 +180,20 @@ type processBlockMsg struct {
 	reply chan processBlockResponse
 }
 
+// processHeaderMsg is a message type to be sent across the message channel
+// for requesting the header of a block which is not available yet is
+// processed and relayed.
+type processHeaderMsg struct {
+	header *wire.BlockHeader
+	reply  chan error
+}
+
+// getRejectedTxnsMsg is a message type to be sent across the message channel
+// for retrieving the recently rejected transactions.
+type getRejectedTxnsMsg struct {
+	reply chan []RejectedTx
+}
+
 // isCurrentMsg is a message type to be sent across the message channel for
 // requesting whether or not the sync manager believes it is synced with the
 // currently connected peers.
@@ -162,10 +220,14 @@ type headerNode struct {
 // peerSyncState stores additional information that the SyncManager tracks
 // about a peer.
 type peerSyncState struct {
-	syncCandidate   bool
-	requestQueue    []*wire.InvVect
-	requestedTxns   map[chainhash.Hash]struct{}
-	requestedBlocks map[chainhash.Hash]struct{}
+	syncCandidate     bool
+	requestQueue      []*wire.InvVect
+	requestedTxns     map[chainhash.Hash]struct{}
+	requestedBlocks   map[chainhash.Hash]struct{}
+	requestedDSProofs map[chainhash.Hash]struct{}
+
+	// announcedTip is the hash of the last block announced by the peer.
+	announcedTip *chainhash.Hash
 }
 
 // syncPeerState stores additional info about the sync peer.
@@ -226,13 +288,27 @@ type SyncManager struct {
 	wg             sync.WaitGroup
 	quit           chan struct{}
 
+	// rejectedTxWindow is how long rejected transactions aren't requested
+	// again.  They are forgotten at each new block when it is zero.
+	rejectedTxWindow time.Duration
+
 	// These fields should only be accessed from the blockHandler thread.
-	rejectedTxns    map[chainhash.Hash]struct{}
-	requestedTxns   map[chainhash.Hash]struct{}
-	requestedBlocks map[chainhash.Hash]struct{}
-	syncPeer        *peerpkg.Peer
-	syncPeerState   *syncPeerState
-	peerStates      map[*peerpkg.Peer]*peerSyncState
+	// The rejected transactions are kept in rejectedTxOrder from the oldest
+	// to the most recent rejection and the elements are indexed by hash.
+	rejectedTxns      map[chainhash.Hash]*list.Element
+	rejectedTxOrder   *list.List
+	requestedTxns     map[chainhash.Hash]struct{}
+	requestedBlocks   map[chainhash.Hash]struct{}
+	requestedDSProofs map[chainhash.Hash]struct{}
+	syncPeer          *peerpkg.Peer
+	syncPeerState     *syncPeerState
+	peerStates        map[*peerpkg.Peer]*peerSyncState
+
+	// orphanParents tracks the missing parents of orphan transactions
+	// which have been requested, along with the peers they have been
+	// requested from, so they can be requested from other peers when a
+	// peer doesn't have them or disconnects.
+	orphanParents map[chainhash.Hash]map[*peerpkg.Peer]struct{}
 
 	// The following fields are used for headers-first mode.
 	headersFirstMode bool
@@ -240,9 +316,25 @@ type SyncManager struct {
 	startHeader      *list.Element
 	nextCheckpoint   *chaincfg.Checkpoint
 
+	// The following fields are used to relay the headers of new blocks
+	// before the blocks are available.  Only the headers building on the
+	// same block are remembered to relay each of them once.
+	relayedHeaders       map[chainhash.Hash]struct{}
+	relayedHeadersParent chainhash.Hash
+
+	// txWorkers are the queues of the workers which process transactions
+	// concurrently.  The transactions are dispatched by peer so the
+	// transactions of each peer are processed in order.
+	txWorkers []chan *txMsg
+
 	// An optional fee estimator.
 	feeEstimator *mempool.FeeEstimator
 
+	// The following fields are used to detect chain splits.  The checks
+	// are armed once the chain has been current.
+	chainSplit      ChainSplitStatus
+	chainSplitArmed bool
+
 	// minSyncPeerNetworkSpeed is the minimum speed allowed for
 	// a sync peer.
 	minSyncPeerNetworkSpeed uint64
@@ -354,6 +446,12 @@ func (sm *SyncManager) startSync() {
 		bestPeers = append(bestPeers, peer)
 	}
 
+	// While a chain split is detected, prefer the peers on the
+	// higher-work chain regardless of the heights they reported.
+	if candidates := sm.higherWorkCandidates(); len(candidates) > 0 {
+		bestPeers = candidates
+	}
+
 	var bestPeer *peerpkg.Peer
 
 	// Try to select a random peer that is at a higher block height,
@@ -401,7 +499,7 @@ func (sm *SyncManager) startSync() {
 		// downloads when in regression test mode.
 		if sm.nextCheckpoint != nil &&
 			best.Height < sm.nextCheckpoint.Height &&
-			sm.chainParams != &chaincfg.RegressionNetParams {
+			!sm.chainParams.RegressionTest {
 
 			bestPeer.PushGetHeadersMsg(locator, sm.nextCheckpoint.Hash)
 			sm.headersFirstMode = true
@@ -453,7 +551,7 @@ func (sm *SyncManager) isSyncCandidate(peer *peerpkg.Peer) bool {
 	// Typically a peer is not a candidate for sync if it's not a full node,
 	// however regression test is special in that the regression tool is
 	// not a full node and still needs to be considered a sync candidate.
-	if sm.chainParams != &chaincfg.RegressionNetParams {
+	if !sm.chainParams.RegressionTest {
 		// The peer is not a candidate for sync if it's not a full
 		// node.
 		nodeServices := peer.Services()
@@ -497,9 +595,10 @@ func (sm *SyncManager) handleNewPeerMsg(peer *peerpkg.Peer) {
 	isSyncCandidate := sm.isSyncCandidate(peer)
 
 	sm.peerStates[peer] = &peerSyncState{
-		syncCandidate:   isSyncCandidate,
-		requestedTxns:   make(map[chainhash.Hash]struct{}),
-		requestedBlocks: make(map[chainhash.Hash]struct{}),
+		syncCandidate:     isSyncCandidate,
+		requestedTxns:     make(map[chainhash.Hash]struct{}),
+		requestedBlocks:   make(map[chainhash.Hash]struct{}),
+		requestedDSProofs: make(map[chainhash.Hash]struct{}),
 	}
 
 	// Start syncing by choosing the best candidate if needed.
@@ -562,6 +661,7 @@ func (sm *SyncManager) handleCheckSyncPeer() {
 		return
 	}
 
+	sm.peerNotifier.ReportPeerIncident(sm.syncPeer, PeerStalled)
 	sm.updateSyncPeer()
 }
 
@@ -636,6 +736,14 @@ func (sm *SyncManager) handleDonePeerMsg(peer *peerpkg.Peer) {
 	// Cleanup state of requested items.
 	sm.clearRequestedState(state)
 
+	// Request the missing parents of orphans which were requested from the
+	// peer from other peers.
+	for txHash := range state.requestedTxns {
+		if _, exists := sm.orphanParents[txHash]; exists {
+			sm.retryOrphanParent(txHash)
+		}
+	}
+
 	// Fetch a new sync peer if this is the sync peer.
 	if peer == sm.syncPeer {
 		sm.updateSyncPeer()
@@ -656,6 +764,12 @@ func (sm *SyncManager) clearRequestedState(state *peerSyncState) {
 	for blockHash := range state.requestedBlocks {
 		delete(sm.requestedBlocks, blockHash)
 	}
+
+	// Remove requested double-spend proofs from the global map so they
+	// will be fetched from elsewhere next time we get an inv.
+	for proofHash := range state.requestedDSProofs {
+		delete(sm.requestedDSProofs, proofHash)
+	}
 }
 
 // updateSyncPeer picks a new peer to sync from.
@@ -699,13 +813,14 @@ func (sm *SyncManager) selectNewSyncPeer() {
 	sm.startSync()
 }
 
-// handleTxMsg handles transaction messages from all peers.
-func (sm *SyncManager) handleTxMsg(tmsg *txMsg) {
+// handleTxMsg handles transaction messages from all peers.  It returns whether
+// the message was handed to a transaction worker, in which case the reply is
+// sent once the worker has processed it.
+func (sm *SyncManager) handleTxMsg(tmsg *txMsg) bool {
 	peer := tmsg.peer
-	state, exists := sm.peerStates[peer]
-	if !exists {
+	if _, exists := sm.peerStates[peer]; !exists {
 		log.Warnf("Received tx message from unknown peer %s", peer)
-		return
+		return false
 	}
 
 	// NOTE:  BitcoinJ, and possibly other wallets, don't follow the spec of
@@ -718,33 +833,77 @@ func (sm *SyncManager) handleTxMsg(tmsg *txMsg) {
 	// interoperability.
 	txHash := tmsg.tx.Hash()
 
-	// Ignore transactions that we have already rejected.  Do not
-	// send a reject message here because if the transaction was already
-	// rejected, the transaction was unsolicited.
-	if _, exists = sm.rejectedTxns[*txHash]; exists {
+	// Ignore transactions that we have already rejected unless the peer
+	// is permitted to force their relay.  Do not send a reject message
+	// here because if the transaction was already rejected, the
+	// transaction was unsolicited.
+	if sm.isRecentlyRejected(txHash) && !tmsg.forceRelay {
 		log.Debugf("Ignoring unsolicited previously rejected "+
 			"transaction %v from %s", txHash, peer)
-		return
+		return false
+	}
+
+	// Hand the transaction to the worker of the peer when there are
+	// workers.  Peers wait for each transaction to be processed before
+	// sending the next one, so the queues never fill up unless something
+	// else queues transactions too.  Processing the transaction here would
+	// overtake the transactions of the peer which are still queued, and
+	// waiting for the worker could deadlock with the worker waiting to
+	// send its results to the block handler, so the transaction is dropped
+	// instead.  It is forgotten as requested so it is requested again the
+	// next time it is announced.
+	if len(sm.txWorkers) > 0 {
+		worker := sm.txWorkers[uint32(peer.ID())%uint32(len(sm.txWorkers))]
+		select {
+		case worker <- tmsg:
+			return true
+		default:
+		}
+
+		log.Debugf("Dropping transaction %v from %s since the "+
+			"transaction worker queue is full", txHash, peer)
+		delete(sm.peerStates[peer].requestedTxns, *txHash)
+		delete(sm.requestedTxns, *txHash)
+		delete(sm.orphanParents, *txHash)
+		return false
 	}
 
 	// Process the transaction to include validation, insertion in the
 	// memory pool, orphan handling, etc.
-	acceptedTxs, err := sm.txMemPool.ProcessTransaction(tmsg.tx,
-		true, true, mempool.Tag(peer.ID()))
+	acceptedTxs, err := sm.processTx(tmsg)
+	sm.handleTxResult(tmsg, acceptedTxs, err)
+	return false
+}
+
+// processTx hands the transaction of the passed message to the memory pool.
+// The transactions of peers permitted to force their relay are accepted even
+// when they are not standard and free transactions are not rate limited.
+func (sm *SyncManager) processTx(tmsg *txMsg) ([]*mempool.TxDesc, error) {
+	tag := mempool.Tag(tmsg.peer.ID())
+	if tmsg.forceRelay {
+		return sm.txMemPool.ProcessNonStandardTransaction(tmsg.tx,
+			true, false, tag)
+	}
+	return sm.txMemPool.ProcessTransaction(tmsg.tx, true, true, tag)
+}
+
+// handleTxResult handles the result of processing a transaction message.
+func (sm *SyncManager) handleTxResult(tmsg *txMsg, acceptedTxs []*mempool.TxDesc, err error) {
+	peer := tmsg.peer
+	txHash := tmsg.tx.Hash()
 
 	// Remove transaction from request maps. Either the mempool/chain
 	// already knows about it and as such we shouldn't have any more
 	// instances of trying to fetch it, or we failed to insert and thus
-	// we'll retry next time we get an inv.
-	delete(state.requestedTxns, *txHash)
+	// we'll retry next time we get an inv.  The peer may have
+	// disconnected while a worker processed the transaction.
+	if state, exists := sm.peerStates[peer]; exists {
+		delete(state.requestedTxns, *txHash)
+	}
 	delete(sm.requestedTxns, *txHash)
+	delete(sm.orphanParents, *txHash)
 
 	if err != nil {
-		// Do not request this transaction again until a new block
-		// has been processed.
-		sm.rejectedTxns[*txHash] = struct{}{}
-		sm.limitMap(sm.rejectedTxns, maxRejectedTxns)
-
 		// When the error is a rule error, it means the transaction was
 		// simply rejected as opposed to something actually going wrong,
 		// so log it as such.  Otherwise, something really did go wrong,
@@ -752,23 +911,205 @@ func (sm *SyncManager) handleTxMsg(tmsg *txMsg) {
 		if _, ok := err.(mempool.RuleError); ok {
 			log.Debugf("Rejected transaction %v from %s: %v",
 				txHash, peer, err)
+		} else if _, ok := err.(mempool.ExternalPolicyUnavailableError); ok {
+			log.Warnf("Unable to check transaction %v from %s: %v",
+				txHash, peer, err)
 		} else {
 			log.Errorf("Failed to process transaction %v: %v",
 				txHash, err)
 		}
 
 		// Convert the error into an appropriate reject message and
-		// send it.
+		// send it.  The rejection is recorded along with the peer and
+		// reason so the transaction isn't requested again while it's
+		// recent and the reason can be looked up over RPC.
+		// Transactions which could not be checked with the external
+		// policy are not recorded since they weren't rejected.
 		code, reason := mempool.ErrToRejectErr(err)
+		if _, ok := err.(mempool.ExternalPolicyUnavailableError); !ok {
+			sm.addRejectedTx(txHash, peer, code, reason)
+		}
 		peer.PushRejectMsg(wire.CmdTx, code, reason, txHash, false)
 		return
 	}
 
 	if len(acceptedTxs) > 0 {
 		sm.peerNotifier.AnnounceNewTransactions(acceptedTxs)
+		return
+	}
+
+	// The transaction was accepted as an orphan when nothing was accepted
+	// to the main pool, so request its missing parents from the peer which
+	// sent it rather than waiting for them to be relayed.
+	if sm.txMemPool.IsOrphanInPool(txHash) {
+		sm.requestOrphanParents(txHash, peer)
 	}
 }
 
+// requestOrphanParents requests the missing parents of the orphan transaction
+// with the passed hash from the passed peer, or from another peer when the
+// peer has disconnected.  Parents which have already been requested or
+// rejected, or which are orphans themselves, are skipped.
+func (sm *SyncManager) requestOrphanParents(txHash *chainhash.Hash, peer *peerpkg.Peer) {
+	parents, err := sm.txMemPool.OrphanMissingParents(txHash)
+	if err != nil {
+		log.Errorf("Failed to fetch the missing parents of orphan %v: %v",
+			txHash, err)
+		return
+	}
+
+	gdmsg := wire.NewMsgGetData()
+	for i := range parents {
+		parent := &parents[i]
+		if sm.isRecentlyRejected(parent) {
+			continue
+		}
+		if _, exists := sm.requestedTxns[*parent]; exists {
+			continue
+		}
+		if sm.txMemPool.HaveTransaction(parent) {
+			continue
+		}
+
+		sm.limitOrphanParents()
+		sm.orphanParents[*parent] = make(map[*peerpkg.Peer]struct{})
+		if _, exists := sm.peerStates[peer]; !exists {
+			sm.retryOrphanParent(*parent)
+			continue
+		}
+		sm.trackOrphanParentRequest(*parent, peer)
+		gdmsg.AddInvVect(wire.NewInvVect(wire.InvTypeTx, parent))
+	}
+	if len(gdmsg.InvList) > 0 {
+		log.Debugf("Requesting %d missing parents of orphan %v from %s",
+			len(gdmsg.InvList), txHash, peer)
+		peer.QueueMessage(gdmsg, nil)
+	}
+}
+
+// trackOrphanParentRequest records the request of the passed missing parent of
+// an orphan from the passed peer.
+func (sm *SyncManager) trackOrphanParentRequest(parent chainhash.Hash, peer *peerpkg.Peer) {
+	sm.orphanParents[parent][peer] = struct{}{}
+	sm.limitMap(sm.requestedTxns, maxRequestedTxns)
+	sm.requestedTxns[parent] = struct{}{}
+	sm.peerStates[peer].requestedTxns[parent] = struct{}{}
+}
+
+// retryOrphanParent requests the passed missing parent of an orphan from a
+// connected peer it hasn't been requested from yet.  The parent is no longer
+// tracked once it has been requested from all the peers.
+func (sm *SyncManager) retryOrphanParent(parent chainhash.Hash) {
+	tried := sm.orphanParents[parent]
+	for peer := range sm.peerStates {
+		if _, exists := tried[peer]; exists || !peer.Connected() {
+			continue
+		}
+		sm.trackOrphanParentRequest(parent, peer)
+		gdmsg := wire.NewMsgGetData()
+		gdmsg.AddInvVect(wire.NewInvVect(wire.InvTypeTx, &parent))
+		log.Debugf("Requesting missing orphan parent %v from %s",
+			parent, peer)
+		peer.QueueMessage(gdmsg, nil)
+		return
+	}
+
+	log.Debugf("Missing orphan parent %v is unavailable from any peer",
+		parent)
+	delete(sm.orphanParents, parent)
+}
+
+// limitOrphanParents evicts a random missing parent of an orphan from the
+// tracked requests if adding a new one would exceed the maximum allowed.
+func (sm *SyncManager) limitOrphanParents() {
+	if len(sm.orphanParents)+1 > maxOrphanParentRequests {
+		for parent := range sm.orphanParents {
+			delete(sm.orphanParents, parent)
+			return
+		}
+	}
+}
+
+// handleNotFoundMsg handles notfound messages from all peers.  The requested
+// transactions the peer doesn't have are forgotten so they can be requested
+// from elsewhere, and the missing parents of orphans are requested from
+// another peer right away.
+func (sm *SyncManager) handleNotFoundMsg(nfmsg *notFoundMsg) {
+	peer := nfmsg.peer
+	state, exists := sm.peerStates[peer]
+	if !exists {
+		log.Warnf("Received notfound message from unknown peer %s", peer)
+		return
+	}
+
+	for _, iv := range nfmsg.notFound.InvList {
+		if iv.Type == wire.InvTypeDoubleSpendProof {
+			if _, exists := state.requestedDSProofs[iv.Hash]; exists {
+				delete(state.requestedDSProofs, iv.Hash)
+				delete(sm.requestedDSProofs, iv.Hash)
+			}
+			continue
+		}
+		if iv.Type != wire.InvTypeTx {
+			continue
+		}
+		if _, exists := state.requestedTxns[iv.Hash]; !exists {
+			continue
+		}
+		delete(state.requestedTxns, iv.Hash)
+		delete(sm.requestedTxns, iv.Hash)
+
+		if _, exists := sm.orphanParents[iv.Hash]; exists {
+			sm.retryOrphanParent(iv.Hash)
+		}
+	}
+}
+
+// handleDSProofRequestedMsg returns whether the double-spend proof of the
+// passed message was requested from the peer it was received from, in which
+// case the request is no longer pending.
+func (sm *SyncManager) handleDSProofRequestedMsg(msg dsProofRequestedMsg) bool {
+	state, exists := sm.peerStates[msg.peer]
+	if !exists {
+		return false
+	}
+	if _, exists := state.requestedDSProofs[msg.hash]; !exists {
+		return false
+	}
+	delete(state.requestedDSProofs, msg.hash)
+	delete(sm.requestedDSProofs, msg.hash)
+	return true
+}
+
+// txWorker processes the transaction messages of the passed queue and sends
+// the results to the block handler.  The mempool validates the transactions of
+// the workers concurrently.  It must be run as a goroutine.
+func (sm *SyncManager) txWorker(txns <-chan *txMsg) {
+out:
+	for {
+		select {
+		case tmsg := <-txns:
+			acceptedTxs, err := sm.processTx(tmsg)
+			result := &txResultMsg{
+				tmsg:        tmsg,
+				acceptedTxs: acceptedTxs,
+				err:         err,
+			}
+			select {
+			case sm.msgChan <- result:
+			case <-sm.quit:
+				break out
+			}
+
+		case <-sm.quit:
+			break out
+		}
+	}
+
+	sm.wg.Done()
+	log.Trace("Transaction worker done")
+}
+
 // current returns true if we believe we are synced with our peers, false if we
 // still have blocks to check
 func (sm *SyncManager) current() bool {
@@ -808,9 +1149,10 @@ func (sm *SyncManager) handleBlockMsg(bmsg *blockMsg) {
 		// the peer or ignore the block when we're in regression test
 		// mode in this case so the chain code is actually fed the
 		// duplicate blocks.
-		if sm.chainParams != &chaincfg.RegressionNetParams {
+		if !sm.chainParams.RegressionTest {
 			log.Warnf("Got unrequested block %v from %s -- "+
 				"disconnecting", blockHash, peer.Addr())
+			sm.peerNotifier.ReportPeerIncident(peer, PeerServedBadData)
 			peer.Disconnect()
 			return
 		}
@@ -854,9 +1196,16 @@ func (sm *SyncManager) handleBlockMsg(bmsg *blockMsg) {
 		// rejected as opposed to something actually going wrong, so log
 		// it as such.  Otherwise, something really did go wrong, so log
 		// it as an actual error.
-		if _, ok := err.(blockchain.RuleError); ok {
+		if ruleErr, ok := err.(blockchain.RuleError); ok {
 			log.Infof("Rejected block %v from %s: %v", blockHash,
 				peer, err)
+
+			// A block already received from another peer is not
+			// bad data served by this one.
+			if ruleErr.ErrorCode != blockchain.ErrDuplicateBlock {
+				sm.peerNotifier.ReportPeerIncident(peer,
+					PeerServedBadData)
+			}
 		} else {
 			log.Errorf("Failed to process block %v: %v",
 				blockHash, err)
@@ -931,8 +1280,8 @@ func (sm *SyncManager) handleBlockMsg(bmsg *blockMsg) {
 		heightUpdate = best.Height
 		blkHashUpdate = &best.Hash
 
-		// Clear the rejected transactions.
-		sm.rejectedTxns = make(map[chainhash.Hash]struct{})
+		// Forget the rejected transactions which may now be valid.
+		sm.handleRejectedTxnsBlock()
 	}
 
 	// Update the block height for this peer. But only send a message to
@@ -1080,13 +1429,11 @@ func (sm *SyncManager) handleHeadersMsg(hmsg *headersMsg) {
 		return
 	}
 
-	// The remote peer is misbehaving if we didn't request headers.
+	// Headers which weren't requested announce new blocks.
 	msg := hmsg.headers
 	numHeaders := len(msg.Headers)
 	if !sm.headersFirstMode {
-		log.Warnf("Got %d unrequested headers from %s -- "+
-			"disconnecting", numHeaders, peer.Addr())
-		peer.Disconnect()
+		sm.handleHeaderAnnouncement(hmsg)
 		return
 	}
 
@@ -1135,6 +1482,7 @@ func (sm *SyncManager) handleHeadersMsg(hmsg *headersMsg) {
 			log.Warnf("Received block header that does not "+
 				"properly connect to the chain from peer %s "+
 				"-- disconnecting", peer.Addr())
+			sm.peerNotifier.ReportPeerIncident(peer, PeerServedBadData)
 			peer.Disconnect()
 			return
 		}
@@ -1153,6 +1501,8 @@ func (sm *SyncManager) handleHeadersMsg(hmsg *headersMsg) {
 					"disconnecting", node.height,
 					node.hash, peer.Addr(),
 					sm.nextCheckpoint.Hash)
+				sm.peerNotifier.ReportPeerIncident(peer,
+					PeerServedBadData)
 				peer.Disconnect()
 				return
 			}
@@ -1231,9 +1581,18 @@ func (sm *SyncManager) haveInventory(invVect *wire.InvVect) (bool, error) {
 	switch invVect.Type {
 	case wire.InvTypeBlock:
 		// Ask chain if the block is known to it in any form (main
-		// chain, side chain, or orphan).
+		// chain, side chain, or orphan).  Blocks already rejected for
+		// violating the consensus rules are not downloaded again.
+		if sm.chain.InvalidBlock(&invVect.Hash) != nil {
+			return true, nil
+		}
 		return sm.chain.HaveBlock(&invVect.Hash)
 
+	case wire.InvTypeDoubleSpendProof:
+		// Ask the transaction memory pool if the double-spend proof is
+		// stored.
+		return sm.txMemPool.DoubleSpendProofByHash(&invVect.Hash) != nil, nil
+
 	case wire.InvTypeTx:
 		// Ask the transaction memory pool if the transaction is known
 		// to it in any form (main pool or orphan).
@@ -1269,6 +1628,92 @@ func (sm *SyncManager) haveInventory(invVect *wire.InvVect) (bool, error) {
 	return true, nil
 }
 
+// handleHeaderAnnouncement handles a headers message a peer sent without a
+// request to announce new blocks.  Each header is validated and relayed to the
+// peers which want headers when it extends the best chain, before the block
+// itself is downloaded.  The announced blocks are then requested like blocks
+// announced with an inv message.
+func (sm *SyncManager) handleHeaderAnnouncement(hmsg *headersMsg) {
+	peer := hmsg.peer
+	headers := hmsg.headers.Headers
+	if len(headers) > maxAnnouncedHeaders {
+		log.Warnf("Got %d unrequested headers from %s -- "+
+			"disconnecting", len(headers), peer.Addr())
+		sm.peerNotifier.ReportPeerIncident(peer, PeerServedBadData)
+		peer.Disconnect()
+		return
+	}
+
+	inv := wire.NewMsgInv()
+	for _, header := range headers {
+		// The peer knows about the block so the header is not relayed
+		// back to it.
+		blockHash := header.BlockHash()
+		iv := wire.NewInvVect(wire.InvTypeBlock, &blockHash)
+		peer.AddKnownInventory(iv)
+
+		err := sm.processHeader(header)
+		if err != nil {
+			ruleErr, ok := err.(blockchain.RuleError)
+			if !ok {
+				log.Errorf("Failed to process header %v: %v",
+					blockHash, err)
+				return
+			}
+
+			switch ruleErr.ErrorCode {
+			case blockchain.ErrDuplicateBlock:
+				continue
+
+			case blockchain.ErrPreviousBlockUnknown:
+				// The block is still requested so its missing
+				// ancestors are fetched by the orphan handling.
+
+			default:
+				log.Infof("Rejected header %v from %s: %v -- "+
+					"disconnecting", blockHash, peer, err)
+				sm.peerNotifier.ReportPeerIncident(peer,
+					PeerServedBadData)
+				peer.Disconnect()
+				return
+			}
+		}
+
+		inv.AddInvVect(iv)
+	}
+	if len(inv.InvList) > 0 {
+		sm.handleInvMsg(&invMsg{inv: inv, peer: peer})
+	}
+}
+
+// processHeader validates the header of a block which is not available yet
+// and relays it to the peers which want headers when it extends the best
+// chain.
+func (sm *SyncManager) processHeader(header *wire.BlockHeader) error {
+	err := sm.chain.ProcessBlockHeader(header, blockchain.BFNone)
+	if err != nil {
+		return err
+	}
+	if header.PrevBlock != sm.chain.BestSnapshot().Hash {
+		return nil
+	}
+
+	if sm.relayedHeaders == nil || header.PrevBlock != sm.relayedHeadersParent {
+		sm.relayedHeaders = make(map[chainhash.Hash]struct{})
+		sm.relayedHeadersParent = header.PrevBlock
+	}
+	blockHash := header.BlockHash()
+	if _, ok := sm.relayedHeaders[blockHash]; ok {
+		return nil
+	}
+	sm.relayedHeaders[blockHash] = struct{}{}
+
+	log.Debugf("Relaying header of block %v", blockHash)
+	iv := wire.NewInvVect(wire.InvTypeBlock, &blockHash)
+	sm.peerNotifier.RelayInventory(iv, header)
+	return nil
+}
+
 // handleInvMsg handles inv messages from all peers.
 // We examine the inventory advertised by the remote peer and act accordingly.
 func (sm *SyncManager) handleInvMsg(imsg *invMsg) {
@@ -1299,6 +1744,12 @@ func (sm *SyncManager) handleInvMsg(imsg *invMsg) {
 		peer.UpdateLastAnnouncedBlock(&invVects[lastBlock].Hash)
 	}
 
+	// Keep track of the tip announced by the peer so it can be compared
+	// against the tip of the chain to detect chain splits.
+	if lastBlock != -1 {
+		state.announcedTip = &invVects[lastBlock].Hash
+	}
+
 	// Ignore invs from peers that aren't the sync if we are not current.
 	// Helps prevent fetching a mass of orphans.
 	if peer != sm.syncPeer && !sm.current() || sm.fastSyncMode {
@@ -1323,6 +1774,12 @@ func (sm *SyncManager) handleInvMsg(imsg *invMsg) {
 		switch iv.Type {
 		case wire.InvTypeBlock:
 		case wire.InvTypeTx:
+		case wire.InvTypeDoubleSpendProof:
+			// Double-spend proofs are only useful to validate
+			// against the mempool of a node which is current.
+			if !sm.current() {
+				continue
+			}
 		default:
 			continue
 		}
@@ -1348,7 +1805,7 @@ func (sm *SyncManager) handleInvMsg(imsg *invMsg) {
 			if iv.Type == wire.InvTypeTx {
 				// Skip the transaction if it has already been
 				// rejected.
-				if _, exists := sm.rejectedTxns[iv.Hash]; exists {
+				if sm.isRecentlyRejected(&iv.Hash) {
 					continue
 				}
 			}
@@ -1434,6 +1891,18 @@ func (sm *SyncManager) handleInvMsg(imsg *invMsg) {
 				sm.limitMap(sm.requestedTxns, maxRequestedTxns)
 				state.requestedTxns[iv.Hash] = struct{}{}
 
+				gdmsg.AddInvVect(iv)
+				numRequested++
+			}
+
+		case wire.InvTypeDoubleSpendProof:
+			// Request the proof if there is not already a pending
+			// request.
+			if _, exists := sm.requestedDSProofs[iv.Hash]; !exists {
+				sm.requestedDSProofs[iv.Hash] = struct{}{}
+				sm.limitMap(sm.requestedDSProofs, maxRequestedDSProofs)
+				state.requestedDSProofs[iv.Hash] = struct{}{}
+
 				gdmsg.AddInvVect(iv)
 				numRequested++
 			}
@@ -1482,6 +1951,7 @@ out:
 		select {
 		case <-ticker.C:
 			sm.handleCheckSyncPeer()
+			sm.checkChainSplit()
 		case m := <-sm.msgChan:
 			switch msg := m.(type) {
 			case *newPeerMsg:
@@ -1491,11 +1961,17 @@ out:
 				}
 
 			case *txMsg:
-				sm.handleTxMsg(msg)
-				if msg.reply != nil {
+				dispatched := sm.handleTxMsg(msg)
+				if !dispatched && msg.reply != nil {
 					msg.reply <- struct{}{}
 				}
 
+			case *txResultMsg:
+				sm.handleTxResult(msg.tmsg, msg.acceptedTxs, msg.err)
+				if msg.tmsg.reply != nil {
+					msg.tmsg.reply <- struct{}{}
+				}
+
 			case *blockMsg:
 				sm.handleBlockMsg(msg)
 				if msg.reply != nil {
@@ -1511,12 +1987,24 @@ out:
 			case *headersMsg:
 				sm.handleHeadersMsg(msg)
 
+			case *notFoundMsg:
+				sm.handleNotFoundMsg(msg)
+
+			case dsProofRequestedMsg:
+				msg.reply <- sm.handleDSProofRequestedMsg(msg)
+
 			case *donePeerMsg:
 				sm.handleDonePeerMsg(msg.peer)
 				if msg.reply != nil {
 					msg.reply <- struct{}{}
 				}
 
+			case getChainSplitMsg:
+				msg.reply <- sm.chainSplit
+
+			case getRejectedTxnsMsg:
+				msg.reply <- sm.rejectedTxList()
+
 			case getSyncPeerMsg:
 				var peerID int32
 
@@ -1549,6 +2037,9 @@ out:
 					err:      nil,
 				}
 
+			case processHeaderMsg:
+				msg.reply <- sm.processHeader(msg.header)
+
 			case isCurrentMsg:
 				msg.reply <- sm.current()
 
@@ -1574,93 +2065,73 @@ out:
 	log.Trace("Block handler done")
 }
 
-// handleBlockchainNotification handles notifications from blockchain.  It does
-// things such as request orphan block parents and relay accepted blocks to
-// connected peers.
-func (sm *SyncManager) handleBlockchainNotification(notification *blockchain.Notification) {
-	switch notification.Type {
-	// A block has been accepted into the block chain.  Relay it to other
-	// peers.
-	case blockchain.NTBlockAccepted:
-		// Don't relay if we are not current. Other peers that are
-		// current should already know about it.
-		if !sm.current() {
-			return
-		}
-
-		block, ok := notification.Data.(*bchutil.Block)
-		if !ok {
-			log.Warnf("Chain accepted notification is not a block.")
-			break
-		}
+// handleBlockAccepted relays a block which has been accepted into the block
+// chain to the connected peers.
+func (sm *SyncManager) handleBlockAccepted(block *bchutil.Block) {
+	// Don't relay if we are not current. Other peers that are
+	// current should already know about it.
+	if !sm.current() {
+		return
+	}
 
-		// Generate the inventory vector and relay it.
-		iv := wire.NewInvVect(wire.InvTypeBlock, block.Hash())
-		sm.peerNotifier.RelayInventory(iv, block.MsgBlock())
+	// Generate the inventory vector and relay it.
+	iv := wire.NewInvVect(wire.InvTypeBlock, block.Hash())
+	sm.peerNotifier.RelayInventory(iv, block.MsgBlock())
+}
 
-	// A block has been connected to the main block chain.
-	case blockchain.NTBlockConnected:
-		block, ok := notification.Data.(*bchutil.Block)
-		if !ok {
-			log.Warnf("Chain connected notification is not a block.")
-			break
-		}
+// handleBlockConnected updates the transaction pool and the fee estimator for
+// a block which has been connected to the main block chain.
+func (sm *SyncManager) handleBlockConnected(block *bchutil.Block) {
+	// Remove all of the transactions (except the coinbase) in the
+	// connected block from the transaction pool.  Secondly, remove any
+	// transactions which are now double spends as a result of these
+	// new transactions.  Finally, remove any transaction that is
+	// no longer an orphan. Transactions which depend on a confirmed
+	// transaction are NOT removed recursively because they are still
+	// valid.
+	for _, tx := range block.Transactions()[1:] {
+		sm.txMemPool.RemoveTransaction(tx, false)
+		sm.txMemPool.RemoveDoubleSpends(tx)
+		sm.txMemPool.RemoveOrphan(tx)
+		sm.peerNotifier.TransactionConfirmed(tx)
+		acceptedTxs := sm.txMemPool.ProcessOrphans(tx)
+		sm.peerNotifier.AnnounceNewTransactions(acceptedTxs)
+	}
 
-		// Remove all of the transactions (except the coinbase) in the
-		// connected block from the transaction pool.  Secondly, remove any
-		// transactions which are now double spends as a result of these
-		// new transactions.  Finally, remove any transaction that is
-		// no longer an orphan. Transactions which depend on a confirmed
-		// transaction are NOT removed recursively because they are still
-		// valid.
-		for _, tx := range block.Transactions()[1:] {
-			sm.txMemPool.RemoveTransaction(tx, false)
-			sm.txMemPool.RemoveDoubleSpends(tx)
-			sm.txMemPool.RemoveOrphan(tx)
-			sm.peerNotifier.TransactionConfirmed(tx)
-			acceptedTxs := sm.txMemPool.ProcessOrphans(tx)
-			sm.peerNotifier.AnnounceNewTransactions(acceptedTxs)
-		}
-
-		// Register block with the fee estimator, if it exists.
-		if sm.feeEstimator != nil {
-			err := sm.feeEstimator.RegisterBlock(block)
-
-			// If an error is somehow generated then the fee estimator
-			// has entered an invalid state. Since it doesn't know how
-			// to recover, create a new one.
-			if err != nil {
-				sm.feeEstimator = mempool.NewFeeEstimator(
-					mempool.DefaultEstimateFeeMaxRollback,
-					mempool.DefaultEstimateFeeMinRegisteredBlocks)
-			}
-		}
+	// Register block with the fee estimator, if it exists.
+	if sm.feeEstimator != nil {
+		err := sm.feeEstimator.RegisterBlock(block)
 
-	// A block has been disconnected from the main block chain.
-	case blockchain.NTBlockDisconnected:
-		block, ok := notification.Data.(*bchutil.Block)
-		if !ok {
-			log.Warnf("Chain disconnected notification is not a block.")
-			break
+		// If an error is somehow generated then the fee estimator
+		// has entered an invalid state. Since it doesn't know how
+		// to recover, create a new one.
+		if err != nil {
+			sm.feeEstimator = mempool.NewFeeEstimator(
+				mempool.DefaultEstimateFeeMaxRollback,
+				mempool.DefaultEstimateFeeMinRegisteredBlocks)
 		}
+	}
+}
 
-		// Reinsert all of the transactions (except the coinbase) into
-		// the transaction pool.
-		for _, tx := range block.Transactions()[1:] {
-			_, _, err := sm.txMemPool.MaybeAcceptTransaction(tx,
-				false, false)
-			if err != nil {
-				// Remove the transaction and all transactions
-				// that depend on it if it wasn't accepted into
-				// the transaction pool.
-				sm.txMemPool.RemoveTransaction(tx, true)
-			}
+// handleBlockDisconnected updates the transaction pool and the fee estimator
+// for a block which has been disconnected from the main block chain.
+func (sm *SyncManager) handleBlockDisconnected(block *bchutil.Block) {
+	// Reinsert all of the transactions (except the coinbase) into
+	// the transaction pool.
+	for _, tx := range block.Transactions()[1:] {
+		_, _, err := sm.txMemPool.MaybeAcceptTransaction(tx,
+			false, false)
+		if err != nil {
+			// Remove the transaction and all transactions
+			// that depend on it if it wasn't accepted into
+			// the transaction pool.
+			sm.txMemPool.RemoveTransaction(tx, true)
 		}
+	}
 
-		// Rollback previous block recorded by the fee estimator.
-		if sm.feeEstimator != nil {
-			sm.feeEstimator.Rollback(block.Hash())
-		}
+	// Rollback previous block recorded by the fee estimator.
+	if sm.feeEstimator != nil {
+		sm.feeEstimator.Rollback(block.Hash())
 	}
 }
 
@@ -1681,15 +2152,17 @@ func (sm *SyncManager) NewPeer(peer *peerpkg.Peer, done chan struct{}) {
 
 // QueueTx adds the passed transaction message and peer to the block handling
 // queue. Responds to the done channel argument after the tx message is
-// processed.
-func (sm *SyncManager) QueueTx(tx *bchutil.Tx, peer *peerpkg.Peer, done chan struct{}) {
+// processed.  The forceRelay flag accepts the transaction even when it is not
+// standard for peers permitted to force the relay of their transactions.
+func (sm *SyncManager) QueueTx(tx *bchutil.Tx, peer *peerpkg.Peer, forceRelay bool, done chan struct{}) {
 	// Don't accept more transactions if we're shutting down.
 	if atomic.LoadInt32(&sm.shutdown) != 0 {
 		done <- struct{}{}
 		return
 	}
 
-	sm.msgChan <- &txMsg{tx: tx, peer: peer, reply: done}
+	sm.msgChan <- &txMsg{tx: tx, peer: peer, forceRelay: forceRelay,
+		reply: done}
 }
 
 // QueueBlock adds the passed block message and peer to the block handling
@@ -1737,6 +2210,31 @@ func (sm *SyncManager) QueueHeaders(headers *wire.MsgHeaders, peer *peerpkg.Peer
 	sm.msgChan <- &headersMsg{headers: headers, peer: peer}
 }
 
+// QueueNotFound adds the passed notfound message and peer to the block handling
+// queue.
+func (sm *SyncManager) QueueNotFound(notFound *wire.MsgNotFound, peer *peerpkg.Peer) {
+	// No channel handling here because peers do not need to block on
+	// notfound messages.
+	if atomic.LoadInt32(&sm.shutdown) != 0 {
+		return
+	}
+
+	sm.msgChan <- &notFoundMsg{notFound: notFound, peer: peer}
+}
+
+// DSProofRequested returns whether the double-spend proof with the passed hash
+// received from the passed peer was requested from it, marking the request as
+// no longer pending.
+func (sm *SyncManager) DSProofRequested(hash *chainhash.Hash, peer *peerpkg.Peer) bool {
+	if atomic.LoadInt32(&sm.shutdown) != 0 {
+		return false
+	}
+
+	reply := make(chan bool)
+	sm.msgChan <- dsProofRequestedMsg{hash: *hash, peer: peer, reply: reply}
+	return <-reply
+}
+
 // DonePeer informs the blockmanager that a peer has disconnected.
 func (sm *SyncManager) DonePeer(peer *peerpkg.Peer, done chan struct{}) {
 	// Ignore if we are shutting down.
@@ -1756,8 +2254,11 @@ func (sm *SyncManager) Start() {
 	}
 
 	log.Trace("Starting sync manager")
-	sm.wg.Add(1)
+	sm.wg.Add(1 + len(sm.txWorkers))
 	go sm.blockHandler()
+	for _, txns := range sm.txWorkers {
+		go sm.txWorker(txns)
+	}
 }
 
 // Stop gracefully shuts down the sync manager by stopping all asynchronous
@@ -1775,6 +2276,23 @@ func (sm *SyncManager) Stop() error {
 	return nil
 }
 
+// ChainSplitStatus returns whether the tip of the chain diverges from the tips
+// announced by the majority of the connected peers.
+func (sm *SyncManager) ChainSplitStatus() ChainSplitStatus {
+	reply := make(chan ChainSplitStatus)
+	sm.msgChan <- getChainSplitMsg{reply: reply}
+	return <-reply
+}
+
+// RejectedTxns returns the transactions received from peers which were
+// recently rejected, along with the peers they were received from and the
+// reasons they were rejected for, sorted by the time they were rejected.
+func (sm *SyncManager) RejectedTxns() []RejectedTx {
+	reply := make(chan []RejectedTx)
+	sm.msgChan <- getRejectedTxnsMsg{reply: reply}
+	return <-reply
+}
+
 // SyncPeerID returns the ID of the current sync peer, or 0 if there is none.
 func (sm *SyncManager) SyncPeerID() int32 {
 	reply := make(chan int32)
@@ -1791,6 +2309,15 @@ func (sm *SyncManager) ProcessBlock(block *bchutil.Block, flags blockchain.Behav
 	return response.isOrphan, response.err
 }
 
+// ProcessHeader validates the header of a block which is not available yet
+// and relays it to the peers which want headers when it extends the best
+// chain.
+func (sm *SyncManager) ProcessHeader(header *wire.BlockHeader) error {
+	reply := make(chan error)
+	sm.msgChan <- processHeaderMsg{header: header, reply: reply}
+	return <-reply
+}
+
 // IsCurrent returns whether or not the sync manager believes it is synced with
 // the connected peers.
 func (sm *SyncManager) IsCurrent() bool {
@@ -1801,8 +2328,9 @@ func (sm *SyncManager) IsCurrent() bool {
 
 // Pause pauses the sync manager until the returned channel is closed.
 //
-// Note that while paused, all peer and block processing is halted.  The
-// message sender should avoid pausing the sync manager for long durations.
+// Note that while paused, all peer and block processing is halted, except for
+// the transactions already handed to the transaction workers.  The message
+// sender should avoid pausing the sync manager for long durations.
 func (sm *SyncManager) Pause() chan<- struct{} {
 	c := make(chan struct{})
 	sm.msgChan <- pauseMsg{c}
@@ -1817,9 +2345,13 @@ func New(config *Config) (*SyncManager, error) {
 		chain:                   config.Chain,
 		txMemPool:               config.TxMemPool,
 		chainParams:             config.ChainParams,
-		rejectedTxns:            make(map[chainhash.Hash]struct{}),
+		rejectedTxns:            make(map[chainhash.Hash]*list.Element),
+		rejectedTxOrder:         list.New(),
+		rejectedTxWindow:        config.RejectedTxWindow,
 		requestedTxns:           make(map[chainhash.Hash]struct{}),
 		requestedBlocks:         make(map[chainhash.Hash]struct{}),
+		requestedDSProofs:       make(map[chainhash.Hash]struct{}),
+		orphanParents:           make(map[chainhash.Hash]map[*peerpkg.Peer]struct{}),
 		peerStates:              make(map[*peerpkg.Peer]*peerSyncState),
 		progressLogger:          newBlockProgressLogger("Processed", log),
 		msgChan:                 make(chan interface{}, config.MaxPeers*3),
@@ -1831,6 +2363,13 @@ func New(config *Config) (*SyncManager, error) {
 		regTestSyncAnyHost:      config.RegTestSyncAnyHost,
 	}
 
+	if config.TxWorkers > 1 {
+		sm.txWorkers = make([]chan *txMsg, config.TxWorkers)
+		for i := range sm.txWorkers {
+			sm.txWorkers[i] = make(chan *txMsg, config.MaxPeers)
+		}
+	}
+
 	best := sm.chain.BestSnapshot()
 	if !config.DisableCheckpoints {
 		// Initialize the next checkpoint based on the current height.
@@ -1842,7 +2381,13 @@ func New(config *Config) (*SyncManager, error) {
 		log.Info("Checkpoints are disabled")
 	}
 
-	sm.chain.Subscribe(sm.handleBlockchainNotification)
+	// The transaction pool must be updated before the chain processes the
+	// next block, so the notifications are delivered synchronously.
+	sm.chain.SubscribeHandlers(&blockchain.NotificationHandlers{
+		OnBlockAccepted:     sm.handleBlockAccepted,
+		OnBlockConnected:    sm.handleBlockConnected,
+		OnBlockDisconnected: sm.handleBlockDisconnected,
+	}, 0)
 
 	return &sm, nil
 }
@@ -42,6 +42,7 @@ var nullTime time.Time
 type testConfig struct {
 	dbName      string
 	chainParams *chaincfg.Params
+	txWorkers   int
 }
 
 type testContext struct {
@@ -92,6 +93,7 @@ func (ctx *testContext) Setup(config *testConfig) error {
 		ChainParams:    ctx.cfg.chainParams,
 		FetchUtxoView:  chain.FetchUtxoView,
 		BestHeight:     func() int32 { return chain.BestSnapshot().Height },
+		BestHash:       func() *chainhash.Hash { return &chain.BestSnapshot().Hash },
 		MedianTimePast: func() time.Time { return chain.BestSnapshot().MedianTime },
 		CalcSequenceLock: func(tx *bchutil.Tx, view *blockchain.UtxoViewpoint) (*blockchain.SequenceLock, error) {
 			return chain.CalcSequenceLock(tx, view, true)
@@ -107,6 +109,10 @@ func (ctx *testContext) Setup(config *testConfig) error {
 		TxMemPool:    txMemPool,
 		ChainParams:  ctx.cfg.chainParams,
 		MaxPeers:     8,
+		TxWorkers:    ctx.cfg.txWorkers,
+
+		// The test peers are not connected from localhost.
+		RegTestSyncAnyHost: true,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create SyncManager: %v", err)
@@ -509,7 +515,95 @@ func TestBlockchainSync(t *testing.T) {
 	}
 }
 
+// TestProcessHeader tests that the headers of blocks which are not available
+// yet are validated and relayed once when they extend the best chain.
+func TestProcessHeader(t *testing.T) {
+	chainParams := chaincfg.RegressionNetParams
+
+	var ctx testContext
+	err := ctx.Setup(&testConfig{
+		dbName:      "TestProcessHeader",
+		chainParams: &chainParams,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Teardown()
+
+	syncMgr := ctx.syncManager
+	syncMgr.Start()
+
+	address, _, err := GenerateAnyoneCanSpendAddress(&chainParams)
+	if err != nil {
+		t.Fatalf("Error constructing P2SH address: %v", err)
+	}
+	genesisBlock := bchutil.NewBlock(chainParams.GenesisBlock)
+	block, err := rpctest.CreateBlock(genesisBlock, nil, 2, nullTime,
+		address, []wire.TxOut{}, &chainParams)
+	if err != nil {
+		t.Fatalf("failed to generate block: %v", err)
+	}
+	header := block.MsgBlock().Header
+
+	// The header extends the best chain so it is relayed.
+	if err := syncMgr.ProcessHeader(&header); err != nil {
+		t.Fatalf("Unexpected error processing header: %v", err)
+	}
+	select {
+	case call := <-ctx.peerNotifier.relayInventoryChan:
+		if call.invVect.Type != wire.InvTypeBlock ||
+			call.invVect.Hash != *block.Hash() {
+			t.Fatalf("Relayed unexpected inventory %v", call.invVect)
+		}
+		if _, ok := call.data.(*wire.BlockHeader); !ok {
+			t.Fatalf("Relayed unexpected data %T", call.data)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for the header to be relayed")
+	}
+
+	// The same header is only relayed once.
+	if err := syncMgr.ProcessHeader(&header); err != nil {
+		t.Fatalf("Unexpected error processing header: %v", err)
+	}
+	select {
+	case call := <-ctx.peerNotifier.relayInventoryChan:
+		t.Fatalf("Header relayed again: %v", call.invVect)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// A header building on an unknown block is rejected.
+	orphan := header
+	orphan.PrevBlock = *block.Hash()
+	target := blockchain.CompactToBig(orphan.Bits)
+	for {
+		hash := orphan.BlockHash()
+		if blockchain.HashToBig(&hash).Cmp(target) <= 0 {
+			break
+		}
+		orphan.Nonce++
+	}
+	err = syncMgr.ProcessHeader(&orphan)
+	if rerr, ok := err.(blockchain.RuleError); !ok ||
+		rerr.ErrorCode != blockchain.ErrPreviousBlockUnknown {
+
+		t.Fatalf("Expected ErrPreviousBlockUnknown, got %v", err)
+	}
+
+	err = syncMgr.Stop()
+	if err != nil {
+		t.Fatalf("failed to stop SyncManager: %v", err)
+	}
+}
+
+// TestMempoolSync tests the processing of transactions received from peers
+// both by the block handler and by transaction workers.
 func TestMempoolSync(t *testing.T) {
+	t.Run("serial", func(t *testing.T) { testMempoolSync(t, 0) })
+	t.Run("workers", func(t *testing.T) { testMempoolSync(t, 4) })
+}
+
+func testMempoolSync(t *testing.T, txWorkers int) {
 	chainParams := chaincfg.RegressionNetParams
 	chainParams.CoinbaseMaturity = 1
 
@@ -517,6 +611,7 @@ func TestMempoolSync(t *testing.T) {
 	err := ctx.Setup(&testConfig{
 		dbName:      "TestMempoolSync",
 		chainParams: &chainParams,
+		txWorkers:   txWorkers,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -529,6 +624,9 @@ func TestMempoolSync(t *testing.T) {
 	remoteMessages := newMessageChans()
 	remotePeerCfg := peer.Config{
 		Listeners: peer.MessageListeners{
+			OnGetData: func(p *peer.Peer, msg *wire.MsgGetData) {
+				remoteMessages.getDataChan <- msg
+			},
 			OnReject: func(p *peer.Peer, msg *wire.MsgReject) {
 				remoteMessages.rejectChan <- msg
 			},
@@ -590,7 +688,7 @@ func TestMempoolSync(t *testing.T) {
 
 	// Attempt to process transaction
 	syncChan := make(chan struct{})
-	syncMgr.QueueTx(tx1, localNode, syncChan)
+	syncMgr.QueueTx(tx1, localNode, false, syncChan)
 	select {
 	case <-syncChan:
 	case <-time.After(time.Second):
@@ -624,7 +722,7 @@ func TestMempoolSync(t *testing.T) {
 	}
 
 	// Attempt to process orphan transaction
-	syncMgr.QueueTx(tx3, localNode, syncChan)
+	syncMgr.QueueTx(tx3, localNode, false, syncChan)
 	select {
 	case <-syncChan:
 	case <-time.After(time.Second):
@@ -639,8 +737,24 @@ func TestMempoolSync(t *testing.T) {
 	default:
 	}
 
+	// Expect node to request the missing parent of the orphan from the
+	// peer which sent it
+	select {
+	case msg := <-remoteMessages.getDataChan:
+		if len(msg.InvList) != 1 ||
+			msg.InvList[0].Type != wire.InvTypeTx ||
+			!msg.InvList[0].Hash.IsEqual(tx2.Hash()) {
+
+			t.Fatalf("GetData message has unexpected inventory %v",
+				msg.InvList)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for remote node to receive getdata " +
+			"message")
+	}
+
 	// Now process parent transaction
-	syncMgr.QueueTx(tx2, localNode, syncChan)
+	syncMgr.QueueTx(tx2, localNode, false, syncChan)
 	select {
 	case <-syncChan:
 	case <-time.After(time.Second):
@@ -670,7 +784,7 @@ func TestMempoolSync(t *testing.T) {
 	}
 
 	// Attempt to process orphan transaction
-	syncMgr.QueueTx(tx4, localNode, syncChan)
+	syncMgr.QueueTx(tx4, localNode, false, syncChan)
 	select {
 	case <-syncChan:
 	case <-time.After(time.Second):
@@ -697,8 +811,17 @@ func TestMempoolSync(t *testing.T) {
 		t.Fatal("Timeout waiting for remote node to receive reject message")
 	}
 
+	// Expect the rejection to be recorded along with the peer and reason
+	rejectedTxns := syncMgr.RejectedTxns()
+	if len(rejectedTxns) != 1 || rejectedTxns[0].Hash != *tx4.Hash() ||
+		rejectedTxns[0].PeerID != localNode.ID() ||
+		rejectedTxns[0].Code != wire.RejectNonstandard {
+
+		t.Fatalf("Unexpected rejected transactions %v", rejectedTxns)
+	}
+
 	// An already rejected transaction should not get a reject response
-	syncMgr.QueueTx(tx4, localNode, syncChan)
+	syncMgr.QueueTx(tx4, localNode, false, syncChan)
 	select {
 	case <-syncChan:
 	case <-time.After(time.Second):
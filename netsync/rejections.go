@@ -0,0 +1,122 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package netsync
+
+import (
+	"container/list"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	peerpkg "github.com/gcash/bchd/peer"
+	"github.com/gcash/bchd/wire"
+)
+
+// RejectedTx describes a transaction received from a peer which was rejected,
+// along with the reason it was rejected for.
+type RejectedTx struct {
+	// Hash is the hash of the rejected transaction.
+	Hash chainhash.Hash
+
+	// PeerID and PeerAddr identify the peer the transaction was received
+	// from.
+	PeerID   int32
+	PeerAddr string
+
+	// Code and Reason are the reject code and reason sent to the peer.
+	Code   wire.RejectCode
+	Reason string
+
+	// Time is the time the transaction was rejected.
+	Time time.Time
+}
+
+// addRejectedTx records that the transaction with the passed hash received
+// from the passed peer was rejected so it isn't requested again while the
+// rejection is recent.  The oldest rejection is evicted when the table is
+// full.
+func (sm *SyncManager) addRejectedTx(txHash *chainhash.Hash, peer *peerpkg.Peer, code wire.RejectCode, reason string) {
+	rejected := &RejectedTx{
+		Hash:     *txHash,
+		PeerID:   peer.ID(),
+		PeerAddr: peer.Addr(),
+		Code:     code,
+		Reason:   reason,
+		Time:     time.Now(),
+	}
+	if elem, exists := sm.rejectedTxns[*txHash]; exists {
+		elem.Value = rejected
+		sm.rejectedTxOrder.MoveToBack(elem)
+		return
+	}
+
+	if len(sm.rejectedTxns)+1 > maxRejectedTxns {
+		sm.removeRejectedTx(sm.rejectedTxOrder.Front())
+	}
+	sm.rejectedTxns[*txHash] = sm.rejectedTxOrder.PushBack(rejected)
+}
+
+// removeRejectedTx forgets the rejection held by the passed element.
+func (sm *SyncManager) removeRejectedTx(elem *list.Element) {
+	rejected := sm.rejectedTxOrder.Remove(elem).(*RejectedTx)
+	delete(sm.rejectedTxns, rejected.Hash)
+}
+
+// isExpiredRejection returns whether the passed rejection is older than the
+// configured window.
+func (sm *SyncManager) isExpiredRejection(rejected *RejectedTx) bool {
+	return sm.rejectedTxWindow > 0 &&
+		time.Since(rejected.Time) >= sm.rejectedTxWindow
+}
+
+// isRecentlyRejected returns whether the transaction with the passed hash was
+// rejected recently enough that it shouldn't be requested again.  Rejections
+// older than the configured window are forgotten.
+func (sm *SyncManager) isRecentlyRejected(txHash *chainhash.Hash) bool {
+	elem, exists := sm.rejectedTxns[*txHash]
+	if !exists {
+		return false
+	}
+	if sm.isExpiredRejection(elem.Value.(*RejectedTx)) {
+		sm.removeRejectedTx(elem)
+		return false
+	}
+	return true
+}
+
+// pruneRejectedTxns forgets the rejections older than the configured window,
+// which are at the front of the list.
+func (sm *SyncManager) pruneRejectedTxns() {
+	for elem := sm.rejectedTxOrder.Front(); elem != nil; {
+		if !sm.isExpiredRejection(elem.Value.(*RejectedTx)) {
+			return
+		}
+		next := elem.Next()
+		sm.removeRejectedTx(elem)
+		elem = next
+	}
+}
+
+// handleRejectedTxnsBlock is invoked when a block is connected.  Without a
+// rejection window the rejected transactions are forgotten since they may
+// have been rejected for spending outputs which the block created, or
+// otherwise only the expired rejections are.
+func (sm *SyncManager) handleRejectedTxnsBlock() {
+	if sm.rejectedTxWindow == 0 {
+		sm.rejectedTxns = make(map[chainhash.Hash]*list.Element)
+		sm.rejectedTxOrder.Init()
+		return
+	}
+	sm.pruneRejectedTxns()
+}
+
+// rejectedTxList returns a copy of the recent rejections sorted by time.
+func (sm *SyncManager) rejectedTxList() []RejectedTx {
+	sm.pruneRejectedTxns()
+	txns := make([]RejectedTx, 0, len(sm.rejectedTxns))
+	for elem := sm.rejectedTxOrder.Front(); elem != nil; elem = elem.Next() {
+		txns = append(txns, *elem.Value.(*RejectedTx))
+	}
+	return txns
+}
@@ -0,0 +1,392 @@
+// Copyright (c) 2013-2016 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"net"
+	"net/http"
+	_ "net/http/pprof"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+
+	"github.com/gcash/bchd/bchec"
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/blockchain/indexers"
+	"github.com/gcash/bchd/database"
+	"github.com/gcash/bchd/version"
+)
+
+const (
+	// blockDbNamePrefix is the prefix for the block database name.  The
+	// database type is appended to this value to form the full block
+	// database name.
+	blockDbNamePrefix = "blocks"
+)
+
+var (
+	cfg *Config
+)
+
+// WinServiceMain is only invoked on Windows.  It detects when bchd is running
+// as a service and reacts accordingly.
+var WinServiceMain func() (bool, error)
+
+// Main is the real main function for bchd.  It is necessary to work around
+// the fact that deferred functions do not run when os.Exit() is called.  The
+// optional nodeChan parameter is mainly used by the service code to be
+// notified with the node once it is setup so it can gracefully stop it when
+// requested from the service control manager.
+func Main(nodeChan chan<- *Node) error {
+	// Load configuration and parse command line.  This function also
+	// initializes logging and configures it accordingly.
+	tcfg, _, err := LoadConfig(os.Args[1:])
+	if err != nil {
+		return err
+	}
+	cfg = tcfg
+	defer func() {
+		if logRotator != nil {
+			bchdLog.Infof("Closing: logRotator")
+			logRotator.Close()
+			bchdLog.Infof("Closed: logRotator")
+		}
+	}()
+
+	// Get a channel that will be closed when a shutdown signal has been
+	// triggered either from an OS signal such as SIGINT (Ctrl+C) or from
+	// another subsystem such as the RPC server.
+	interrupt := interruptListener()
+	defer bchdLog.Info("Shutdown complete")
+
+	// Show version at startup.
+	bchdLog.Infof("Version %s", version.String())
+
+	// Select the signature verification backend.
+	if cfg.NoLibsecp256k1 {
+		bchec.SetAcceleration(false)
+	} else if bchec.AccelerationAvailable() {
+		bchdLog.Info("Verifying signatures with libsecp256k1")
+	}
+
+	// Enable http profiling server if requested.
+	if cfg.Profile != "" {
+		go func() {
+			listenAddr := net.JoinHostPort("", cfg.Profile)
+			bchdLog.Infof("Profile server listening on %s", listenAddr)
+			profileRedirect := http.RedirectHandler("/debug/pprof",
+				http.StatusSeeOther)
+			http.Handle("/", profileRedirect)
+			bchdLog.Errorf("%v", http.ListenAndServe(listenAddr, nil))
+		}()
+	}
+
+	// Write cpu profile if requested.
+	if cfg.CPUProfile != "" {
+		f, err := os.Create(cfg.CPUProfile)
+		if err != nil {
+			bchdLog.Errorf("Unable to create cpu profile: %v", err)
+			return err
+		}
+		pprof.StartCPUProfile(f)
+		defer f.Close()
+		defer pprof.StopCPUProfile()
+	}
+
+	// Perform upgrades to bchd as new versions require it.
+	if err := doUpgrades(); err != nil {
+		bchdLog.Errorf("%v", err)
+		return err
+	}
+
+	// Return now if an interrupt signal was triggered.
+	if interruptRequested(interrupt) {
+		return nil
+	}
+
+	// Drop indexes and exit if requested.
+	if cfg.DropAddrIndex || cfg.DropTxIndex || cfg.DropCfIndex ||
+		cfg.DropSlpIndex {
+
+		return dropIndexes(interrupt)
+	}
+
+	// Report the pending database migrations and exit if requested.
+	if cfg.DbCheckOnly {
+		return checkDbMigrations()
+	}
+
+	// Create the node and start it.
+	n, err := New(cfg, interrupt)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := n.Stop(); err != nil {
+			bchdLog.Errorf("%v", err)
+		}
+	}()
+	n.Start()
+	if nodeChan != nil {
+		nodeChan <- n
+	}
+
+	// Reload the runtime adjustable config options whenever a reload
+	// signal such as SIGHUP is received.
+	go reloadListener(n, interrupt)
+
+	// Wait until the interrupt signal is received from an OS signal or
+	// shutdown is requested through one of the subsystems such as the RPC
+	// server.
+	<-interrupt
+	return nil
+}
+
+// dropIndexes loads the block database and drops the optional indexes which
+// were requested to be dropped via the config.
+func dropIndexes(interrupt <-chan struct{}) error {
+	db, err := loadBlockDB()
+	if err != nil {
+		bchdLog.Errorf("%v", err)
+		return err
+	}
+	defer func() {
+		// Ensure the database is sync'd and closed on shutdown.
+		bchdLog.Infof("Gracefully shutting down the database...")
+		db.Close()
+		bchdLog.Infof("Database has gracefully shutdown")
+	}()
+
+	// Return now if an interrupt signal was triggered.
+	if interruptRequested(interrupt) {
+		return nil
+	}
+
+	// NOTE: The order is important here because dropping the tx index also
+	// drops the address index since it relies on it.
+	if cfg.DropAddrIndex {
+		if err := indexers.DropAddrIndex(db, interrupt); err != nil {
+			bchdLog.Errorf("%v", err)
+			return err
+		}
+
+		return nil
+	}
+	if cfg.DropTxIndex {
+		if err := indexers.DropTxIndex(db, interrupt); err != nil {
+			bchdLog.Errorf("%v", err)
+			return err
+		}
+
+		return nil
+	}
+	if cfg.DropCfIndex {
+		if err := indexers.DropCfIndex(db, interrupt); err != nil {
+			bchdLog.Errorf("%v", err)
+			return err
+		}
+
+		return nil
+	}
+	if cfg.DropSlpIndex {
+		if err := indexers.DropSlpIndex(db, interrupt); err != nil {
+			bchdLog.Errorf("%v", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkDbMigrations opens the block database read-only and logs the migrations
+// of the chain state and of the enabled indexes which will be applied to it on
+// the next start.
+func checkDbMigrations() error {
+	// A database in memory is always created with the latest versions.
+	if cfg.DbType == "memdb" {
+		bchdLog.Infof("The database is up to date")
+		return nil
+	}
+
+	dbPath := blockDbPath(cfg.DbType)
+	bchdLog.Infof("Loading block database read-only from '%s'", dbPath)
+	db, err := database.OpenReadOnly(cfg.DbType, dbPath,
+		activeNetParams.Net, cfg.DBCacheSize*1024*1024, cfg.DBFlushInterval)
+	if dbErr, ok := err.(database.Error); ok &&
+		dbErr.ErrorCode == database.ErrDbDoesNotExist {
+
+		bchdLog.Infof("The database does not exist yet")
+		return nil
+	}
+	if err != nil {
+		bchdLog.Errorf("%v", err)
+		return err
+	}
+	defer db.Close()
+
+	pending, err := blockchain.PendingDbMigrations(db)
+	if err != nil {
+		bchdLog.Errorf("%v", err)
+		return err
+	}
+
+	// Only the enabled indexes are migrated on start.
+	var indexes []indexers.Indexer
+	if cfIndexEnabled() {
+		indexes = append(indexes, indexers.NewCfIndex(db,
+			activeNetParams.Params, cfFilterTypes()...))
+	}
+	indexPending, err := indexers.PendingDbMigrations(db, indexes)
+	if err != nil {
+		bchdLog.Errorf("%v", err)
+		return err
+	}
+	pending = append(pending, indexPending...)
+
+	if len(pending) == 0 {
+		bchdLog.Infof("The database is up to date")
+		return nil
+	}
+	for _, migration := range pending {
+		if migration.FromVersion == migration.ToVersion {
+			bchdLog.Infof("Pending rebuild of the %s at version %d",
+				migration.Name, migration.ToVersion)
+			continue
+		}
+		bchdLog.Infof("Pending migration of the %s from version %d to %d",
+			migration.Name, migration.FromVersion, migration.ToVersion)
+	}
+	return nil
+}
+
+// removeRegressionDB removes the existing regression test database if running
+// in regression test mode and it already exists.
+func removeRegressionDB(dbPath string) error {
+	// Don't do anything if not in regression test mode.
+	if !cfg.RegressionTest {
+		return nil
+	}
+
+	// Don't reset the db if specified by config
+	if cfg.RegressionTestNoReset {
+		return nil
+	}
+
+	// Remove the old regression test database if it already exists.
+	fi, err := os.Stat(dbPath)
+	if err == nil {
+		bchdLog.Infof("Removing regression test database from '%s'", dbPath)
+		if fi.IsDir() {
+			err := os.RemoveAll(dbPath)
+			if err != nil {
+				return err
+			}
+		} else {
+			err := os.Remove(dbPath)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// dbPath returns the path to the block database given a database type.
+func blockDbPath(dbType string) string {
+	// The database name is based on the database type.
+	dbName := blockDbNamePrefix + "_" + dbType
+	if dbType == "sqlite" {
+		dbName = dbName + ".db"
+	}
+	dbPath := filepath.Join(cfg.DataDir, dbName)
+	return dbPath
+}
+
+// warnMultipleDBs shows a warning if multiple block database types are detected.
+// This is not a situation most users want.  It is handy for development however
+// to support multiple side-by-side databases.
+func warnMultipleDBs() {
+	// This is intentionally not using the known db types which depend
+	// on the database types compiled into the binary since we want to
+	// detect legacy db types as well.
+	dbTypes := []string{"ffldb", "leveldb", "sqlite"}
+	duplicateDbPaths := make([]string, 0, len(dbTypes)-1)
+	for _, dbType := range dbTypes {
+		if dbType == cfg.DbType {
+			continue
+		}
+
+		// Store db path as a duplicate db if it exists.
+		dbPath := blockDbPath(dbType)
+		if fileExists(dbPath) {
+			duplicateDbPaths = append(duplicateDbPaths, dbPath)
+		}
+	}
+
+	// Warn if there are extra databases.
+	if len(duplicateDbPaths) > 0 {
+		selectedDbPath := blockDbPath(cfg.DbType)
+		bchdLog.Warnf("WARNING: There are multiple block chain databases "+
+			"using different database types.\nYou probably don't "+
+			"want to waste disk space by having more than one.\n"+
+			"Your current database is located at [%v].\nThe "+
+			"additional database is located at %v", selectedDbPath,
+			duplicateDbPaths)
+	}
+}
+
+// loadBlockDB loads (or creates when needed) the block database taking into
+// account the selected database backend and returns a handle to it.  It also
+// contains additional logic such warning the user if there are multiple
+// databases which consume space on the file system and ensuring the regression
+// test database is clean when in regression test mode.
+func loadBlockDB() (database.DB, error) {
+	// The memdb backend does not have a file path associated with it, so
+	// handle it uniquely.  We also don't want to worry about the multiple
+	// database type warnings when running with the memory database.
+	if cfg.DbType == "memdb" {
+		bchdLog.Infof("Creating block database in memory.")
+		db, err := database.Create(cfg.DbType)
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	}
+
+	warnMultipleDBs()
+
+	// The database name is based on the database type.
+	dbPath := blockDbPath(cfg.DbType)
+
+	// The regression test is special in that it needs a clean database for
+	// each run, so remove it now if it already exists.
+	removeRegressionDB(dbPath)
+
+	bchdLog.Infof("Loading block database from '%s'", dbPath)
+	db, err := database.Open(cfg.DbType, dbPath, activeNetParams.Net, cfg.DBCacheSize*1024*1024, cfg.DBFlushInterval)
+	if err != nil {
+		// Return the error if it's not because the database doesn't
+		// exist.
+		if dbErr, ok := err.(database.Error); !ok || dbErr.ErrorCode !=
+			database.ErrDbDoesNotExist {
+
+			return nil, err
+		}
+
+		// Create the db if it does not exist.
+		err = os.MkdirAll(cfg.DataDir, 0700)
+		if err != nil {
+			return nil, err
+		}
+		db, err = database.Create(cfg.DbType, dbPath, activeNetParams.Net, cfg.DBCacheSize*1024*1024, cfg.DBFlushInterval)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bchdLog.Info("Block database loaded")
+	return db, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/btcjson"
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/mining"
+	"github.com/gcash/bchutil"
+)
+
+// builtFromTemplate returns whether the passed block was built from the passed
+// block template, which is the case when it extends the same block and its
+// transactions other than the coinbase are exactly the ones of the template in
+// the same order.  The coinbase is not compared since the miners using
+// getblocktemplate create their own.
+func builtFromTemplate(block *bchutil.Block, template *mining.BlockTemplate) bool {
+	msgBlock := block.MsgBlock()
+	if msgBlock.Header.PrevBlock != template.Block.Header.PrevBlock ||
+		len(msgBlock.Transactions) != len(template.Block.Transactions) {
+
+		return false
+	}
+
+	for i, tx := range block.Transactions()[1:] {
+		if *tx.Hash() != template.Block.Transactions[i+1].TxHash() {
+			return false
+		}
+	}
+	return true
+}
+
+// coinbaseBreakdown returns the total value of the coinbase of the passed
+// block along with the subsidy and the fees it claims.
+func coinbaseBreakdown(block *bchutil.Block, params *chaincfg.Params) (coinbaseValue, subsidy, fees int64) {
+	for _, txOut := range block.MsgBlock().Transactions[0].TxOut {
+		coinbaseValue += txOut.Value
+	}
+	subsidy = blockchain.CalcBlockSubsidy(block.Height(), params)
+	return coinbaseValue, subsidy, coinbaseValue - subsidy
+}
+
+// blockFeesResult returns the blockfees notification for the passed connected
+// block, breaking its coinbase down into the subsidy and the fees it claims.
+// The fees are compared to the passed template fees when the block was built
+// from a template generated by the server.
+func blockFeesResult(block *bchutil.Block, templateFees int64, fromTemplate bool,
+	params *chaincfg.Params) btcjson.BlockFeesResult {
+
+	msgBlock := block.MsgBlock()
+	coinbaseValue, subsidy, fees := coinbaseBreakdown(block, params)
+	result := btcjson.BlockFeesResult{
+		Hash:          block.Hash().String(),
+		Height:        block.Height(),
+		Time:          msgBlock.Header.Timestamp.Unix(),
+		Size:          msgBlock.SerializeSize(),
+		TxCount:       len(msgBlock.Transactions),
+		Subsidy:       bchutil.Amount(subsidy).ToBCH(),
+		Fees:          bchutil.Amount(fees).ToBCH(),
+		CoinbaseValue: bchutil.Amount(coinbaseValue).ToBCH(),
+	}
+	if fromTemplate {
+		result.FromTemplate = true
+		result.TemplateFees = bchutil.Amount(templateFees).ToBCH()
+		result.FeeDelta = bchutil.Amount(fees - templateFees).ToBCH()
+	}
+	return result
+}
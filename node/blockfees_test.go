@@ -0,0 +1,104 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/mining"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// TestBlockFeesResult ensures the coinbase of a block is broken down into the
+// subsidy and fees and compared to the template it was built from.
+func TestBlockFeesResult(t *testing.T) {
+	params := &chaincfg.RegressionNetParams
+	const height = 200
+	subsidy := blockchain.CalcBlockSubsidy(height, params)
+
+	// newTx returns a transaction spending the passed outpoint with a
+	// single output of the passed value.
+	newTx := func(outpoint wire.OutPoint, value int64) *wire.MsgTx {
+		msgTx := wire.NewMsgTx(1)
+		msgTx.AddTxIn(wire.NewTxIn(&outpoint, []byte{0x51}))
+		msgTx.AddTxOut(wire.NewTxOut(value, []byte{0x51}, wire.TokenData{}))
+		return msgTx
+	}
+	newBlock := func(prevHash chainhash.Hash, txns ...*wire.MsgTx) *wire.MsgBlock {
+		msgBlock := wire.NewMsgBlock(&wire.BlockHeader{PrevBlock: prevHash})
+		for _, tx := range txns {
+			msgBlock.AddTransaction(tx)
+		}
+		return msgBlock
+	}
+
+	prevHash := chainhash.HashH([]byte("prev"))
+	tx1 := newTx(wire.OutPoint{Hash: chainhash.HashH([]byte("tx1"))}, 1000)
+	tx2 := newTx(wire.OutPoint{Hash: chainhash.HashH([]byte("tx2"))}, 1000)
+	template := &mining.BlockTemplate{
+		Block: newBlock(prevHash, newTx(wire.OutPoint{}, subsidy+300),
+			tx1, tx2),
+		Fees: []int64{-300, 100, 200},
+	}
+
+	// A block built from the template falls short of the template fees
+	// when its coinbase claims less than them.
+	block := bchutil.NewBlock(newBlock(prevHash,
+		newTx(wire.OutPoint{}, subsidy+100), tx1, tx2))
+	block.SetHeight(height)
+	if !builtFromTemplate(block, template) {
+		t.Fatal("block not built from the template")
+	}
+	result := blockFeesResult(block, -template.Fees[0], true, params)
+	if result.Subsidy != bchutil.Amount(subsidy).ToBCH() ||
+		result.Fees != bchutil.Amount(100).ToBCH() ||
+		result.CoinbaseValue != bchutil.Amount(subsidy+100).ToBCH() {
+
+		t.Fatalf("unexpected coinbase breakdown %+v", result)
+	}
+	if !result.FromTemplate ||
+		result.TemplateFees != bchutil.Amount(300).ToBCH() ||
+		result.FeeDelta != bchutil.Amount(-200).ToBCH() {
+
+		t.Fatalf("unexpected template comparison %+v", result)
+	}
+	if result.Height != height || result.TxCount != 3 ||
+		result.Size != block.MsgBlock().SerializeSize() {
+
+		t.Fatalf("unexpected block details %+v", result)
+	}
+
+	// A block which isn't built from a template isn't compared to one.
+	result = blockFeesResult(block, 0, false, params)
+	if result.FromTemplate || result.TemplateFees != 0 || result.FeeDelta != 0 {
+		t.Fatalf("unexpected template comparison %+v", result)
+	}
+
+	// Blocks which only include part of the template transactions, include
+	// a transaction which isn't in the template, order them differently or
+	// extend another block weren't built from it.
+	tx3 := newTx(wire.OutPoint{Hash: chainhash.HashH([]byte("tx3"))}, 1000)
+	coinbase := newTx(wire.OutPoint{}, subsidy+100)
+	tests := []struct {
+		name  string
+		block *wire.MsgBlock
+	}{
+		{"subset", newBlock(prevHash, coinbase, tx1)},
+		{"extra transaction", newBlock(prevHash, coinbase, tx1, tx2, tx3)},
+		{"other transaction", newBlock(prevHash, coinbase, tx1, tx3)},
+		{"reordered", newBlock(prevHash, coinbase, tx2, tx1)},
+		{"other parent", newBlock(chainhash.HashH([]byte("other")),
+			coinbase, tx1, tx2)},
+	}
+	for _, test := range tests {
+		if builtFromTemplate(bchutil.NewBlock(test.block), template) {
+			t.Errorf("%s: block built from the template", test.name)
+		}
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+const (
+	// autogenCertOrg is the organization used for the self-signed
+	// certificates generated by bchd.  Only certificates with this
+	// organization are ever regenerated by the cert manager.
+	autogenCertOrg = "bchd autogenerated cert"
+
+	// autogenCertValidity is how long a generated certificate is valid.
+	autogenCertValidity = 10 * 365 * 24 * time.Hour
+
+	// certCheckInterval is the interval at which the cert manager checks
+	// whether the certificate needs to be regenerated or reloaded.
+	certCheckInterval = time.Hour
+)
+
+// certManager provides the TLS certificate used by the RPC, gRPC and
+// Prometheus listeners.  It regenerates the self-signed certificate before it
+// expires or when the configured hosts it must be valid for change, and
+// reloads the certificate whenever the files on disk are replaced, so the
+// listeners pick up the new certificate without a restart.
+//
+// Optionally, certificates for publicly reachable gRPC endpoints may be
+// obtained through ACME, in which case connections for the configured domains
+// are served the ACME certificate and all others fall back to the local one.
+type certManager struct {
+	certFile    string
+	keyFile     string
+	extraHosts  []string
+	renewBefore time.Duration
+
+	// acmeMgr is only set when ACME domains are configured.
+	acmeMgr     *autocert.Manager
+	acmeDomains []string
+
+	mtx     sync.RWMutex
+	cert    *tls.Certificate
+	modTime time.Time
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// newCertManager returns a new cert manager for the passed cert and key files.
+// The pair is generated when neither file exists yet, and regenerated when it
+// was previously generated by bchd and is either close to expiring or no
+// longer valid for all of the passed extra hosts.
+func newCertManager(certFile, keyFile string, extraHosts []string,
+	renewBefore time.Duration) (*certManager, error) {
+
+	m := &certManager{
+		certFile:    certFile,
+		keyFile:     keyFile,
+		extraHosts:  extraHosts,
+		renewBefore: renewBefore,
+		quit:        make(chan struct{}),
+	}
+
+	// Generate the TLS cert and key file if both don't already exist.
+	if !fileExists(keyFile) && !fileExists(certFile) {
+		err := genCertPair(certFile, keyFile, extraHosts)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+	if err := m.maybeRegenerate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// enableACME configures the cert manager to obtain certificates for the passed
+// domains from the Let's Encrypt ACME directory.  Obtained certificates are
+// cached in cacheDir.
+func (m *certManager) enableACME(domains []string, email, cacheDir string) {
+	m.acmeDomains = domains
+	m.acmeMgr = &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		Cache:      autocert.DirCache(cacheDir),
+		HostPolicy: autocert.HostWhitelist(domains...),
+		Email:      email,
+	}
+}
+
+// load reads the cert and key files from disk and makes them the active
+// certificate.
+func (m *certManager) load() error {
+	fi, err := os.Stat(m.certFile)
+	if err != nil {
+		return err
+	}
+	keypair, err := tls.LoadX509KeyPair(m.certFile, m.keyFile)
+	if err != nil {
+		return err
+	}
+	leaf, err := x509.ParseCertificate(keypair.Certificate[0])
+	if err != nil {
+		return err
+	}
+	keypair.Leaf = leaf
+
+	m.mtx.Lock()
+	m.cert = &keypair
+	m.modTime = fi.ModTime()
+	m.mtx.Unlock()
+	return nil
+}
+
+// needsRegeneration returns whether the passed certificate was generated by
+// bchd and either expires within the renewal window or is missing any of the
+// configured extra hosts.  The reason is returned along with the result.
+func (m *certManager) needsRegeneration(leaf *x509.Certificate) (bool, string) {
+	if !slices.Contains(leaf.Subject.Organization, autogenCertOrg) {
+		return false, ""
+	}
+	if time.Until(leaf.NotAfter) < m.renewBefore {
+		return true, "certificate expires " + leaf.NotAfter.String()
+	}
+	for _, host := range m.extraHosts {
+		if ip := net.ParseIP(host); ip != nil {
+			if !slices.ContainsFunc(leaf.IPAddresses, ip.Equal) {
+				return true, "certificate is missing IP " + host
+			}
+			continue
+		}
+		if !slices.Contains(leaf.DNSNames, host) {
+			return true, "certificate is missing host " + host
+		}
+	}
+	return false, ""
+}
+
+// maybeRegenerate regenerates and loads the certificate when it was generated
+// by bchd and needs to be replaced.  Certificates which were not generated by
+// bchd are never replaced, but a warning is logged when they are about to
+// expire.
+func (m *certManager) maybeRegenerate() error {
+	m.mtx.RLock()
+	leaf := m.cert.Leaf
+	m.mtx.RUnlock()
+
+	regenerate, reason := m.needsRegeneration(leaf)
+	if !regenerate {
+		if time.Until(leaf.NotAfter) < m.renewBefore {
+			rpcsLog.Warnf("TLS certificate %s expires %v and must be "+
+				"replaced manually", m.certFile, leaf.NotAfter)
+		}
+		return nil
+	}
+
+	rpcsLog.Infof("Regenerating TLS certificate: %s", reason)
+	err := genCertPair(m.certFile, m.keyFile, m.extraHosts)
+	if err != nil {
+		return err
+	}
+	return m.load()
+}
+
+// maybeReload reloads the certificate when the cert file on disk was replaced
+// since it was last loaded.
+func (m *certManager) maybeReload() error {
+	fi, err := os.Stat(m.certFile)
+	if err != nil {
+		return err
+	}
+
+	m.mtx.RLock()
+	modTime := m.modTime
+	m.mtx.RUnlock()
+	if fi.ModTime().Equal(modTime) {
+		return nil
+	}
+
+	rpcsLog.Infof("TLS certificate %s changed on disk, reloading",
+		m.certFile)
+	return m.load()
+}
+
+// getCertificate returns the active certificate.  It is used as the
+// GetCertificate function of the TLS configs handed out by the cert manager.
+//
+// This function is safe for concurrent access.
+func (m *certManager) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	m.mtx.RLock()
+	cert := m.cert
+	m.mtx.RUnlock()
+	if cert == nil {
+		return nil, errors.New("no TLS certificate loaded")
+	}
+	return cert, nil
+}
+
+// getACMECertificate returns the ACME certificate for connections to any of
+// the configured ACME domains, including ACME TLS-ALPN challenges, and the
+// active local certificate for all other connections.
+//
+// This function is safe for concurrent access.
+func (m *certManager) getACMECertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	isChallenge := slices.Contains(hello.SupportedProtos, acme.ALPNProto)
+	serverName := strings.TrimSuffix(strings.ToLower(hello.ServerName), ".")
+	if isChallenge || slices.Contains(m.acmeDomains, serverName) {
+		return m.acmeMgr.GetCertificate(hello)
+	}
+	return m.getCertificate(hello)
+}
+
+// tlsConfig returns a TLS config which always serves the active local
+// certificate.
+func (m *certManager) tlsConfig() *tls.Config {
+	return &tls.Config{
+		GetCertificate: m.getCertificate,
+		MinVersion:     tls.VersionTLS12,
+	}
+}
+
+// publicTLSConfig returns a TLS config for publicly reachable endpoints.  It
+// serves ACME certificates for the configured ACME domains when enabled and
+// is otherwise identical to tlsConfig.
+func (m *certManager) publicTLSConfig() *tls.Config {
+	if m.acmeMgr == nil {
+		return m.tlsConfig()
+	}
+	return &tls.Config{
+		GetCertificate: m.getACMECertificate,
+		NextProtos:     []string{"h2", "http/1.1", acme.ALPNProto},
+		MinVersion:     tls.VersionTLS12,
+	}
+}
+
+// rotationHandler periodically checks whether the certificate must be
+// regenerated or reloaded.  It must be run as a goroutine.
+func (m *certManager) rotationHandler() {
+	ticker := time.NewTicker(certCheckInterval)
+	defer ticker.Stop()
+
+out:
+	for {
+		select {
+		case <-ticker.C:
+			if err := m.maybeReload(); err != nil {
+				rpcsLog.Errorf("Unable to reload TLS certificate: %v",
+					err)
+				continue
+			}
+			if err := m.maybeRegenerate(); err != nil {
+				rpcsLog.Errorf("Unable to regenerate TLS "+
+					"certificate: %v", err)
+			}
+
+		case <-m.quit:
+			break out
+		}
+	}
+
+	m.wg.Done()
+}
+
+// Start begins the periodic certificate checks.
+func (m *certManager) Start() {
+	m.wg.Add(1)
+	go m.rotationHandler()
+}
+
+// Stop stops the periodic certificate checks and waits for them to finish.
+func (m *certManager) Stop() {
+	close(m.quit)
+	m.wg.Wait()
+}
+
+// acmeCacheDir returns the directory ACME certificates are cached in.
+func acmeCacheDir() string {
+	return filepath.Join(cfg.DataDir, "acme")
+}
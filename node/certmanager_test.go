@@ -0,0 +1,85 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchlog"
+)
+
+// TestCertManagerRegenerate ensures the cert manager only regenerates the
+// autogenerated certificate when it is missing configured hosts or is close
+// to expiring.
+func TestCertManagerRegenerate(t *testing.T) {
+	rpcsLog.SetLevel(bchlog.LevelOff)
+
+	tmpDir, err := ioutil.TempDir("", "bchd")
+	if err != nil {
+		t.Fatalf("Failed creating a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+	certFile := filepath.Join(tmpDir, "rpc.cert")
+	keyFile := filepath.Join(tmpDir, "rpc.key")
+
+	// The pair must be generated when it does not exist yet.
+	renewBefore := 24 * time.Hour
+	m, err := newCertManager(certFile, keyFile, nil, renewBefore)
+	if err != nil {
+		t.Fatalf("newCertManager: unexpected error: %v", err)
+	}
+	cert, err := m.getCertificate(nil)
+	if err != nil {
+		t.Fatalf("getCertificate: unexpected error: %v", err)
+	}
+	serial := cert.Leaf.SerialNumber
+
+	// Loading the same pair again must not regenerate it.
+	m, err = newCertManager(certFile, keyFile, nil, renewBefore)
+	if err != nil {
+		t.Fatalf("newCertManager: unexpected error: %v", err)
+	}
+	cert, _ = m.getCertificate(nil)
+	if cert.Leaf.SerialNumber.Cmp(serial) != 0 {
+		t.Fatal("certificate was unexpectedly regenerated")
+	}
+
+	// Adding extra hosts must regenerate the pair so it is valid for them.
+	extraHosts := []string{"10.1.2.3", "node.example.com"}
+	m, err = newCertManager(certFile, keyFile, extraHosts, renewBefore)
+	if err != nil {
+		t.Fatalf("newCertManager: unexpected error: %v", err)
+	}
+	cert, _ = m.getCertificate(nil)
+	if cert.Leaf.SerialNumber.Cmp(serial) == 0 {
+		t.Fatal("certificate was not regenerated for new hosts")
+	}
+	ip := net.ParseIP("10.1.2.3")
+	if !slices.ContainsFunc(cert.Leaf.IPAddresses, ip.Equal) {
+		t.Fatalf("certificate is missing IP %v", ip)
+	}
+	if !slices.Contains(cert.Leaf.DNSNames, "node.example.com") {
+		t.Fatal("certificate is missing host node.example.com")
+	}
+	serial = cert.Leaf.SerialNumber
+
+	// A renewal window longer than the validity period must regenerate the
+	// pair.
+	m, err = newCertManager(certFile, keyFile, extraHosts,
+		autogenCertValidity+time.Hour)
+	if err != nil {
+		t.Fatalf("newCertManager: unexpected error: %v", err)
+	}
+	cert, _ = m.getCertificate(nil)
+	if cert.Leaf.SerialNumber.Cmp(serial) == 0 {
+		t.Fatal("expiring certificate was not regenerated")
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gcash/bchd/bchec"
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+)
+
+const (
+	// checkpointUpdateFilename is the name of the file in the data
+	// directory the last verified checkpoint update is cached in.
+	checkpointUpdateFilename = "checkpoints.json"
+
+	// checkpointUpdateTimeout is how long fetching a checkpoint update may
+	// take before the cached update is used instead.
+	checkpointUpdateTimeout = 30 * time.Second
+
+	// maxCheckpointUpdateSize is the maximum size in bytes of a checkpoint
+	// update.
+	maxCheckpointUpdateSize = 1 << 20
+)
+
+// signedCheckpointUpdate is the format of a checkpoint update document:
+//
+//	{
+//	  "data": {
+//	    "network": "mainnet",
+//	    "timestamp": 1700000000,
+//	    "checkpoints": [{"height": 800000, "hash": "..."}, ...]
+//	  },
+//	  "signatures": ["<hex schnorr signature>", ...]
+//	}
+//
+// Each signature is a Schnorr signature over the SHA256 hash of the exact bytes
+// of the data field, so the data must not be reformatted after signing.
+type signedCheckpointUpdate struct {
+	Data       json.RawMessage `json:"data"`
+	Signatures []string        `json:"signatures"`
+}
+
+// checkpointUpdate is the signed data of a checkpoint update.
+type checkpointUpdate struct {
+	Network     string `json:"network"`
+	Timestamp   int64  `json:"timestamp"`
+	Checkpoints []struct {
+		Height int32  `json:"height"`
+		Hash   string `json:"hash"`
+	} `json:"checkpoints"`
+}
+
+// verifyCheckpointUpdate checks that the passed checkpoint update document is
+// signed by at least minSigs of the passed keys and is meant for the passed
+// network, and returns its timestamp and checkpoints sorted by height.  Updates
+// which replace a built-in checkpoint of the network are rejected.
+func verifyCheckpointUpdate(raw []byte, pubKeys []*bchec.PublicKey, minSigs int,
+	params *chaincfg.Params) (int64, []chaincfg.Checkpoint, error) {
+
+	var signed signedCheckpointUpdate
+	if err := json.Unmarshal(raw, &signed); err != nil {
+		return 0, nil, fmt.Errorf("malformed checkpoint update: %v", err)
+	}
+
+	// Count the keys with a valid signature so several signatures by the
+	// same key only count once.
+	hash := chainhash.HashB(signed.Data)
+	signedBy := make(map[int]struct{})
+	for _, sigHex := range signed.Signatures {
+		sigBytes, err := hex.DecodeString(sigHex)
+		if err != nil {
+			continue
+		}
+		sig, err := bchec.ParseSchnorrSignature(sigBytes)
+		if err != nil {
+			continue
+		}
+		for i, pubKey := range pubKeys {
+			if sig.Verify(hash, pubKey) {
+				signedBy[i] = struct{}{}
+			}
+		}
+	}
+	if len(signedBy) < minSigs {
+		return 0, nil, fmt.Errorf("checkpoint update has %d valid "+
+			"signatures, %d required", len(signedBy), minSigs)
+	}
+
+	var update checkpointUpdate
+	if err := json.Unmarshal(signed.Data, &update); err != nil {
+		return 0, nil, fmt.Errorf("malformed checkpoint update data: %v",
+			err)
+	}
+	if update.Network != params.Name {
+		return 0, nil, fmt.Errorf("checkpoint update is for network %q",
+			update.Network)
+	}
+
+	builtin := make(map[int32]*chainhash.Hash, len(params.Checkpoints))
+	for _, checkpoint := range params.Checkpoints {
+		builtin[checkpoint.Height] = checkpoint.Hash
+	}
+	checkpoints := make([]chaincfg.Checkpoint, 0, len(update.Checkpoints))
+	for _, cp := range update.Checkpoints {
+		if cp.Height <= 0 || (len(checkpoints) > 0 &&
+			cp.Height <= checkpoints[len(checkpoints)-1].Height) {
+
+			return 0, nil, fmt.Errorf("checkpoint update heights "+
+				"must be positive and increasing, got %d", cp.Height)
+		}
+		hash, err := chainhash.NewHashFromStr(cp.Hash)
+		if err != nil {
+			return 0, nil, fmt.Errorf("malformed hash of checkpoint "+
+				"%d: %v", cp.Height, err)
+		}
+		if builtinHash, ok := builtin[cp.Height]; ok && *builtinHash != *hash {
+			return 0, nil, fmt.Errorf("checkpoint update conflicts "+
+				"with the built-in checkpoint at height %d", cp.Height)
+		}
+		checkpoints = append(checkpoints, chaincfg.Checkpoint{
+			Height: cp.Height,
+			Hash:   hash,
+		})
+	}
+
+	return update.Timestamp, checkpoints, nil
+}
+
+// fetchCheckpointUpdate downloads the checkpoint update document at the passed
+// URL.
+func fetchCheckpointUpdate(client *http.Client, url string) ([]byte, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %q", resp.Status)
+	}
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, maxCheckpointUpdateSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) > maxCheckpointUpdateSize {
+		return nil, errors.New("checkpoint update is too large")
+	}
+	return raw, nil
+}
+
+// loadCheckpointUpdate returns the checkpoints of the most recent verified
+// checkpoint update among the one fetched from the passed URL and the one
+// cached in the passed file, and caches the fetched update when it is the more
+// recent one.  Failures are logged rather than returned so the node still
+// starts with its built-in checkpoints when no update is available.
+func loadCheckpointUpdate(client *http.Client, url, cacheFile string,
+	pubKeys []*bchec.PublicKey, minSigs int, params *chaincfg.Params) []chaincfg.Checkpoint {
+
+	var (
+		timestamp   int64
+		checkpoints []chaincfg.Checkpoint
+	)
+	if raw, err := os.ReadFile(cacheFile); err == nil {
+		timestamp, checkpoints, err = verifyCheckpointUpdate(raw, pubKeys,
+			minSigs, params)
+		if err != nil {
+			srvrLog.Warnf("Ignoring cached checkpoint update %s: %v",
+				cacheFile, err)
+		}
+	} else if !os.IsNotExist(err) {
+		srvrLog.Warnf("Unable to read cached checkpoint update: %v", err)
+	}
+
+	raw, err := fetchCheckpointUpdate(client, url)
+	if err != nil {
+		srvrLog.Warnf("Unable to fetch checkpoint update from %s: %v",
+			url, err)
+		return checkpoints
+	}
+	fetchedTimestamp, fetched, err := verifyCheckpointUpdate(raw, pubKeys,
+		minSigs, params)
+	if err != nil {
+		srvrLog.Warnf("Rejecting checkpoint update from %s: %v", url, err)
+		return checkpoints
+	}
+	if checkpoints != nil && fetchedTimestamp <= timestamp {
+		return checkpoints
+	}
+
+	if err := os.WriteFile(cacheFile, raw, 0600); err != nil {
+		srvrLog.Warnf("Unable to cache checkpoint update: %v", err)
+	}
+	srvrLog.Infof("Loaded %d checkpoints from the checkpoint update of %v",
+		len(fetched), time.Unix(fetchedTimestamp, 0))
+	return fetched
+}
+
+// newCheckpointUpdateClient returns an HTTP client which fetches checkpoint
+// updates through the passed dial function, so they honor the configured
+// proxy.
+func newCheckpointUpdateClient(dial func(string, string, time.Duration) (net.Conn, error)) *http.Client {
+	return &http.Client{
+		Timeout: checkpointUpdateTimeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				return dial(network, addr, checkpointUpdateTimeout)
+			},
+			TLSHandshakeTimeout: checkpointUpdateTimeout,
+		},
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gcash/bchd/bchec"
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchlog"
+)
+
+// signCheckpointUpdate returns a checkpoint update document with the passed
+// data signed by the passed keys.
+func signCheckpointUpdate(t *testing.T, data string, keys ...*bchec.PrivateKey) []byte {
+	t.Helper()
+
+	signed := signedCheckpointUpdate{Data: json.RawMessage(data)}
+	for _, key := range keys {
+		sig, err := key.SignSchnorr(chainhash.HashB([]byte(data)))
+		if err != nil {
+			t.Fatalf("SignSchnorr: %v", err)
+		}
+		signed.Signatures = append(signed.Signatures,
+			hex.EncodeToString(sig.Serialize()))
+	}
+	raw, err := json.Marshal(signed)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	return raw
+}
+
+// TestVerifyCheckpointUpdate ensures checkpoint updates are only accepted when
+// they are signed by enough trusted keys, are meant for the network and don't
+// conflict with the built-in checkpoints.
+func TestVerifyCheckpointUpdate(t *testing.T) {
+	params := &chaincfg.MainNetParams
+	builtin := params.Checkpoints[len(params.Checkpoints)-1]
+	hash := chainhash.DoubleHashH([]byte("checkpoint"))
+
+	var keys []*bchec.PrivateKey
+	var pubKeys []*bchec.PublicKey
+	for i := 0; i < 3; i++ {
+		key, err := bchec.NewPrivateKey(bchec.S256())
+		if err != nil {
+			t.Fatalf("NewPrivateKey: %v", err)
+		}
+		keys = append(keys, key)
+		pubKeys = append(pubKeys, key.PubKey())
+	}
+	untrusted, err := bchec.NewPrivateKey(bchec.S256())
+	if err != nil {
+		t.Fatalf("NewPrivateKey: %v", err)
+	}
+
+	validData := fmt.Sprintf(`{"network":"mainnet","timestamp":100,`+
+		`"checkpoints":[{"height":%d,"hash":"%v"},{"height":%d,"hash":"%v"}]}`,
+		builtin.Height, builtin.Hash, builtin.Height+1000, hash)
+	tests := []struct {
+		name    string
+		raw     []byte
+		minSigs int
+		valid   bool
+	}{{
+		name:    "signed by enough keys",
+		raw:     signCheckpointUpdate(t, validData, keys[0], keys[2]),
+		minSigs: 2,
+		valid:   true,
+	}, {
+		name:    "same key signing twice",
+		raw:     signCheckpointUpdate(t, validData, keys[1], keys[1]),
+		minSigs: 2,
+	}, {
+		name:    "untrusted key",
+		raw:     signCheckpointUpdate(t, validData, keys[0], untrusted),
+		minSigs: 2,
+	}, {
+		name: "reformatted data",
+		raw: bytes.Replace(signCheckpointUpdate(t, validData, keys[0]),
+			[]byte(`"timestamp":100`), []byte(`"timestamp": 100`), 1),
+		minSigs: 1,
+	}, {
+		name: "other network",
+		raw: signCheckpointUpdate(t, `{"network":"testnet3",`+
+			`"timestamp":100,"checkpoints":[]}`, keys[0]),
+		minSigs: 1,
+	}, {
+		name: "conflicting with a built-in checkpoint",
+		raw: signCheckpointUpdate(t, fmt.Sprintf(`{"network":"mainnet",`+
+			`"timestamp":100,"checkpoints":[{"height":%d,"hash":"%v"}]}`,
+			builtin.Height, hash), keys[0]),
+		minSigs: 1,
+	}, {
+		name: "unsorted heights",
+		raw: signCheckpointUpdate(t, fmt.Sprintf(`{"network":"mainnet",`+
+			`"timestamp":100,"checkpoints":[{"height":20,"hash":"%v"},`+
+			`{"height":10,"hash":"%v"}]}`, hash, hash), keys[0]),
+		minSigs: 1,
+	}}
+
+	for _, test := range tests {
+		timestamp, checkpoints, err := verifyCheckpointUpdate(test.raw,
+			pubKeys, test.minSigs, params)
+		if !test.valid {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if timestamp != 100 || len(checkpoints) != 2 ||
+			checkpoints[1].Height != builtin.Height+1000 ||
+			*checkpoints[1].Hash != hash {
+
+			t.Errorf("%s: got timestamp %d and checkpoints %v",
+				test.name, timestamp, checkpoints)
+		}
+	}
+}
+
+// TestLoadCheckpointUpdate ensures fetched checkpoint updates are cached and
+// the cached update is used when the fetched one is unavailable or older.
+func TestLoadCheckpointUpdate(t *testing.T) {
+	oldLog := srvrLog
+	defer func() { srvrLog = oldLog }()
+	srvrLog = bchlog.Disabled
+
+	params := &chaincfg.MainNetParams
+	key, err := bchec.NewPrivateKey(bchec.S256())
+	if err != nil {
+		t.Fatalf("NewPrivateKey: %v", err)
+	}
+	pubKeys := []*bchec.PublicKey{key.PubKey()}
+	update := func(timestamp int64, height int32) []byte {
+		return signCheckpointUpdate(t, fmt.Sprintf(`{"network":"mainnet",`+
+			`"timestamp":%d,"checkpoints":[{"height":%d,"hash":"%v"}]}`,
+			timestamp, height, chainhash.DoubleHashH([]byte("checkpoint"))),
+			key)
+	}
+
+	var served []byte
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if served == nil {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write(served)
+	}))
+	defer srv.Close()
+
+	cacheFile := filepath.Join(t.TempDir(), checkpointUpdateFilename)
+	load := func() []chaincfg.Checkpoint {
+		return loadCheckpointUpdate(srv.Client(), srv.URL, cacheFile,
+			pubKeys, 1, params)
+	}
+
+	// No update is available yet.
+	if checkpoints := load(); len(checkpoints) != 0 {
+		t.Fatalf("got checkpoints %v without an update", checkpoints)
+	}
+
+	// A fetched update is applied and cached.
+	served = update(200, 2000000)
+	if checkpoints := load(); len(checkpoints) != 1 || checkpoints[0].Height != 2000000 {
+		t.Fatalf("got checkpoints %v, want the fetched update", checkpoints)
+	}
+	if _, err := os.Stat(cacheFile); err != nil {
+		t.Fatalf("fetched update was not cached: %v", err)
+	}
+
+	// An older update doesn't replace the cached one.
+	served = update(100, 1000000)
+	if checkpoints := load(); len(checkpoints) != 1 || checkpoints[0].Height != 2000000 {
+		t.Fatalf("got checkpoints %v, want the cached update", checkpoints)
+	}
+
+	// The cached update is used when the URL is unavailable.
+	served = nil
+	if checkpoints := load(); len(checkpoints) != 1 || checkpoints[0].Height != 2000000 {
+		t.Fatalf("got checkpoints %v, want the cached update", checkpoints)
+	}
+}
@@ -0,0 +1,345 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gcash/bchd/cluster/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// clusterPublishTimeout is the maximum time delivering an event to a
+	// member of the cluster may take.
+	clusterPublishTimeout = 10 * time.Second
+
+	// clusterEventExpiry is how long the events seen are remembered to
+	// drop duplicates.  Older events are dropped as well so an event is
+	// never applied twice.
+	clusterEventExpiry = time.Hour
+
+	// clusterQueueSize is the number of events buffered for delivery to
+	// the members of the cluster.  Events are dropped when it is full.
+	clusterQueueSize = 1000
+)
+
+// clusterEventID identifies an event among the events of the cluster.
+type clusterEventID struct {
+	origin   string
+	sequence uint64
+}
+
+// clusterMember houses a member of the cluster events are delivered to.
+type clusterMember struct {
+	addr   string
+	conn   *grpc.ClientConn
+	client pb.ClusterClient
+}
+
+// clusterGossip shares ban decisions, misbehaving peers and fork alerts with
+// the other bchd instances of a cluster run by the same operator, and applies
+// those they share.  Events are delivered to every configured member, which
+// forwards them to its own members, so the members don't all need to be
+// connected to each other.  All connections are authenticated with TLS
+// client and server certificates signed by the cluster CA.
+//
+// It implements the pb.ClusterServer interface.
+type clusterGossip struct {
+	server   *server
+	origin   string
+	sequence uint64 // atomic
+
+	grpcServer *grpc.Server
+	listeners  []net.Listener
+	members    []*clusterMember
+
+	mtx  sync.Mutex
+	seen map[clusterEventID]time.Time
+
+	queue chan *pb.Event
+	quit  chan struct{}
+	wg    sync.WaitGroup
+}
+
+// clusterTLSConfigs returns the TLS configs of the cluster server and clients,
+// which both authenticate with the passed certificate and require the other
+// side to present a certificate signed by the passed CA.
+func clusterTLSConfigs(certFile, keyFile, caFile string) (*tls.Config, *tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	caPEM, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+
+	serverConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}
+	clientConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	}
+	return serverConfig, clientConfig, nil
+}
+
+// newClusterGossip returns a new cluster gossip for the passed server which
+// listens on the passed listeners and delivers events to the passed members.
+func newClusterGossip(s *server, listeners []net.Listener, members []string,
+	certFile, keyFile, caFile string) (*clusterGossip, error) {
+
+	serverConfig, clientConfig, err := clusterTLSConfigs(certFile,
+		keyFile, caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var originBytes [8]byte
+	if _, err := rand.Read(originBytes[:]); err != nil {
+		return nil, err
+	}
+
+	c := &clusterGossip{
+		server:    s,
+		origin:    hex.EncodeToString(originBytes[:]),
+		listeners: listeners,
+		seen:      make(map[clusterEventID]time.Time),
+		queue:     make(chan *pb.Event, clusterQueueSize),
+		quit:      make(chan struct{}),
+	}
+	for _, addr := range members {
+		conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(
+			credentials.NewTLS(clientConfig)))
+		if err != nil {
+			return nil, err
+		}
+		c.members = append(c.members, &clusterMember{
+			addr:   addr,
+			conn:   conn,
+			client: pb.NewClusterClient(conn),
+		})
+	}
+	c.grpcServer = grpc.NewServer(grpc.Creds(credentials.NewTLS(
+		serverConfig)))
+	pb.RegisterClusterServer(c.grpcServer, c)
+	return c, nil
+}
+
+// Start begins serving the cluster listeners and delivering events to the
+// members of the cluster.
+func (c *clusterGossip) Start() {
+	for _, listener := range c.listeners {
+		srvrLog.Infof("Cluster gossip listening on %s", listener.Addr())
+		go func(listener net.Listener) {
+			err := c.grpcServer.Serve(listener)
+			if err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+				srvrLog.Errorf("Cluster gossip listener %s failed: %v",
+					listener.Addr(), err)
+			}
+		}(listener)
+	}
+
+	c.wg.Add(1)
+	go c.deliveryHandler()
+}
+
+// Stop stops serving the cluster listeners and delivering events.
+func (c *clusterGossip) Stop() {
+	c.grpcServer.Stop()
+	close(c.quit)
+	c.wg.Wait()
+	for _, member := range c.members {
+		member.conn.Close()
+	}
+}
+
+// deliveryHandler delivers the queued events to the members of the cluster and
+// forgets the events which expired.  It must be run as a goroutine.
+func (c *clusterGossip) deliveryHandler() {
+	ticker := time.NewTicker(clusterEventExpiry / 4)
+	defer ticker.Stop()
+
+out:
+	for {
+		select {
+		case event := <-c.queue:
+			for _, member := range c.members {
+				c.deliver(member, event)
+			}
+
+		case <-ticker.C:
+			c.mtx.Lock()
+			for id, seen := range c.seen {
+				if time.Since(seen) > clusterEventExpiry {
+					delete(c.seen, id)
+				}
+			}
+			c.mtx.Unlock()
+
+		case <-c.quit:
+			break out
+		}
+	}
+
+	c.wg.Done()
+}
+
+// deliver publishes the passed event to the passed member.
+func (c *clusterGossip) deliver(member *clusterMember, event *pb.Event) {
+	ctx, cancel := context.WithTimeout(context.Background(),
+		clusterPublishTimeout)
+	defer cancel()
+
+	if _, err := member.client.Publish(ctx, event); err != nil {
+		srvrLog.Debugf("Unable to deliver cluster event to %s: %v",
+			member.addr, err)
+	}
+}
+
+// markSeen records the passed event as seen and returns whether it is new.
+// Events which are too old to be deduplicated are never new.
+func (c *clusterGossip) markSeen(event *pb.Event) bool {
+	observed := time.Unix(event.Timestamp, 0)
+	if time.Since(observed) > clusterEventExpiry {
+		return false
+	}
+
+	id := clusterEventID{origin: event.Origin, sequence: event.Sequence}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if _, ok := c.seen[id]; ok {
+		return false
+	}
+	c.seen[id] = time.Now()
+	return true
+}
+
+// enqueue queues the passed event for delivery to the members of the cluster.
+func (c *clusterGossip) enqueue(event *pb.Event) {
+	select {
+	case c.queue <- event:
+	default:
+		srvrLog.Warnf("Cluster gossip queue is full -- dropping event "+
+			"%s/%d", event.Origin, event.Sequence)
+	}
+}
+
+// publish shares a locally observed event with the cluster.
+func (c *clusterGossip) publish(event *pb.Event) {
+	event.Origin = c.origin
+	event.Sequence = atomic.AddUint64(&c.sequence, 1)
+	event.Timestamp = time.Now().Unix()
+	c.markSeen(event)
+	c.enqueue(event)
+}
+
+// PublishBan shares a ban decision with the cluster.
+func (c *clusterGossip) PublishBan(host string, duration time.Duration) {
+	c.publish(&pb.Event{Event: &pb.Event_Ban{Ban: &pb.Ban{
+		Host:     host,
+		Duration: int64(duration / time.Second),
+	}}})
+}
+
+// PublishPeerIncident shares misbehavior of a peer with the cluster.
+func (c *clusterGossip) PublishPeerIncident(host string, incident peerIncident) {
+	kind := pb.PeerIncident_STALL
+	if incident == peerIncidentBadData {
+		kind = pb.PeerIncident_BAD_DATA
+	}
+	c.publish(&pb.Event{Event: &pb.Event_PeerIncident{
+		PeerIncident: &pb.PeerIncident{Host: host, Kind: kind},
+	}})
+}
+
+// PublishForkAlert shares an alert raised by the fork monitor for the passed
+// node with the cluster.
+func (c *clusterGossip) PublishForkAlert(node *forkWatchNode) {
+	c.publish(&pb.Event{Event: &pb.Event_ForkAlert{ForkAlert: &pb.ForkAlert{
+		Node:       node.name,
+		BestHash:   node.bestHash[:],
+		Height:     node.height,
+		ForkHeight: node.forkHeight,
+		OurDepth:   node.ourDepth,
+		TheirDepth: node.theirDepth,
+	}}})
+}
+
+// Publish applies an event delivered by another member of the cluster and
+// forwards it to the members of this node unless it was already seen.
+//
+// This is part of the pb.ClusterServer interface implementation.
+func (c *clusterGossip) Publish(ctx context.Context, event *pb.Event) (*pb.PublishResponse, error) {
+	if !c.markSeen(event) {
+		return &pb.PublishResponse{}, nil
+	}
+
+	s := c.server
+	switch e := event.Event.(type) {
+	case *pb.Event_Ban:
+		ip := net.ParseIP(e.Ban.Host)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid banned host '%s'",
+				e.Ban.Host)
+		}
+		duration := time.Duration(e.Ban.Duration) * time.Second
+		srvrLog.Infof("Cluster member %s banned peer %s for %v",
+			event.Origin, e.Ban.Host, duration)
+
+		// The ban is still forwarded when it isn't applied here since
+		// the settings of the other members may differ.
+		perms := whitelistPermissions(&net.TCPAddr{IP: ip})
+		if !cfg.DisableBanning && !perms.has(permNoBan) {
+			s.BanHost(e.Ban.Host, duration)
+			s.peerReputation.record(e.Ban.Host, peerIncidentBan)
+		}
+
+	case *pb.Event_PeerIncident:
+		incident := peerIncidentStall
+		if e.PeerIncident.Kind == pb.PeerIncident_BAD_DATA {
+			incident = peerIncidentBadData
+		}
+		srvrLog.Debugf("Cluster member %s reported %v for peer %s",
+			event.Origin, e.PeerIncident.Kind, e.PeerIncident.Host)
+		s.peerReputation.record(e.PeerIncident.Host, incident)
+
+	case *pb.Event_ForkAlert:
+		a := e.ForkAlert
+		srvrLog.Warnf("Cluster member %s: the chain of %s with height %d "+
+			"forked from its chain at height %d -- its depth %d, "+
+			"their depth %d", event.Origin, a.Node, a.Height,
+			a.ForkHeight, a.OurDepth, a.TheirDepth)
+
+	default:
+		srvrLog.Debugf("Ignoring unknown cluster event from %s",
+			event.Origin)
+		return &pb.PublishResponse{}, nil
+	}
+
+	c.enqueue(event)
+	return &pb.PublishResponse{}, nil
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/cluster/pb"
+	"github.com/gcash/bchlog"
+)
+
+// writeClusterCerts writes a CA certificate along with a certificate signed by
+// it for 127.0.0.1 and its key to the passed directory and returns the file
+// names of the certificate, the key and the CA certificate.
+func writeClusterCerts(t *testing.T, dir string) (string, string, string) {
+	writePEM := func(name, typ string, der []byte) string {
+		path := filepath.Join(dir, name)
+		data := pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der})
+		if err := os.WriteFile(path, data, 0600); err != nil {
+			t.Fatalf("unable to write %s: %v", name, err)
+		}
+		return path
+	}
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "cluster ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate,
+		caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("unable to create CA certificate: %v", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "cluster node"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth,
+			x509.ExtKeyUsageClientAuth},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caTemplate,
+		&key.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("unable to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unable to marshal key: %v", err)
+	}
+
+	return writePEM("node.cert", "CERTIFICATE", der),
+		writePEM("node.key", "EC PRIVATE KEY", keyDER),
+		writePEM("ca.cert", "CERTIFICATE", caDER)
+}
+
+// TestClusterGossip ensures events are forwarded across the members of a
+// cluster over mutually authenticated TLS and delivered once.
+func TestClusterGossip(t *testing.T) {
+	oldLog := srvrLog
+	defer func() { srvrLog = oldLog }()
+	srvrLog = bchlog.Disabled
+
+	certFile, keyFile, caFile := writeClusterCerts(t, t.TempDir())
+
+	// Chain three members so the events of the first one only reach the
+	// last one when they are forwarded by the second one.
+	var gossips []*clusterGossip
+	var member []string
+	for i := 0; i < 3; i++ {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("unable to listen: %v", err)
+		}
+		c, err := newClusterGossip(nil, []net.Listener{listener},
+			member, certFile, keyFile, caFile)
+		if err != nil {
+			t.Fatalf("newClusterGossip: %v", err)
+		}
+		c.Start()
+		defer c.Stop()
+		gossips = append([]*clusterGossip{c}, gossips...)
+		member = []string{listener.Addr().String()}
+	}
+
+	seen := func(c *clusterGossip, event *pb.Event) bool {
+		c.mtx.Lock()
+		defer c.mtx.Unlock()
+		_, ok := c.seen[clusterEventID{event.Origin, event.Sequence}]
+		return ok
+	}
+
+	gossips[0].PublishForkAlert(&forkWatchNode{name: "http://10.0.0.1"})
+	event := &pb.Event{Origin: gossips[0].origin, Sequence: 1}
+	deadline := time.Now().Add(10 * time.Second)
+	for !seen(gossips[2], event) {
+		if time.Now().After(deadline) {
+			t.Fatal("event was not forwarded to the last member")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !seen(gossips[1], event) {
+		t.Fatal("event was not delivered to the second member")
+	}
+
+	// Events which were already seen or are too old are not new.
+	event.Timestamp = time.Now().Unix()
+	if gossips[2].markSeen(event) {
+		t.Fatal("event was new twice")
+	}
+	event.Sequence++
+	event.Timestamp = time.Now().Add(-2 * clusterEventExpiry).Unix()
+	if gossips[2].markSeen(event) {
+		t.Fatal("expired event was new")
+	}
+}
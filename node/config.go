@@ -2,17 +2,19 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-package main
+package node
 
 import (
 	"bufio"
 	"bytes"
 	"crypto/rand"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"net"
+	"net/url"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -25,6 +27,9 @@ import (
 	"github.com/gcash/bchd/mining"
 
 	"github.com/btcsuite/go-socks/socks"
+	"github.com/gcash/bchd/addrmgr"
+	"github.com/gcash/bchd/bchec"
+	"github.com/gcash/bchd/blockchain"
 	"github.com/gcash/bchd/chaincfg"
 	"github.com/gcash/bchd/chaincfg/chainhash"
 	"github.com/gcash/bchd/connmgr"
@@ -32,6 +37,7 @@ import (
 	_ "github.com/gcash/bchd/database/ffldb"
 	"github.com/gcash/bchd/mempool"
 	"github.com/gcash/bchd/peer"
+	"github.com/gcash/bchd/txscript"
 	"github.com/gcash/bchd/version"
 	"github.com/gcash/bchutil"
 
@@ -48,11 +54,13 @@ const (
 	defaultMaxPeersPerIP           = 5
 	defaultBanDuration             = time.Hour * 24
 	defaultBanThreshold            = 100
+	defaultAuditThreshold          = 90
 	defaultConnectTimeout          = time.Second * 30
 	defaultMaxRPCClients           = 10
 	defaultMaxRPCWebsockets        = 25
 	defaultMaxRPCConcurrentReqs    = 20
 	defaultDbType                  = "ffldb"
+	defaultEstimateFeeMode         = "historical"
 	defaultFreeTxRelayLimit        = 0
 	defaultTrickleInterval         = peer.DefaultTrickleInterval
 	defaultExcessiveBlockSize      = 32000000
@@ -62,20 +70,37 @@ const (
 	defaultGenerate                = false
 	defaultMaxOrphanTransactions   = 100
 	defaultMaxOrphanTxSize         = 100000
+	defaultMaxMempoolMB            = 300
 	defaultSigCacheMaxSize         = 100000
 	defaultTxIndex                 = false
 	defaultAddrIndex               = false
 	defaultSlpIndex                = false
 	defaultSlpCacheMaxSize         = 100000
 	defaultSlpGraphSearch          = false
+	defaultBcmrTimeout             = time.Second * 10
+	defaultBcmrCacheTTL            = time.Hour
+	defaultBcmrIPFSGateway         = "https://ipfs.io/ipfs/"
 	defaultUtxoCacheMaxSizeMiB     = 450
+	defaultUtxoCacheWarmupBlocks   = 10
 	defaultMinSyncPeerNetworkSpeed = 51200
 	defaultPruneDepth              = 4320
 	defaultTargetOutboundPeers     = uint32(8)
+	defaultBlockRelayPeers         = uint32(2)
+	defaultFeelerInterval          = time.Minute * 2
+	defaultStatsInterval           = time.Minute * 5
+	defaultStatsHistory            = time.Hour * 24 * 7
+	defaultForkMonitorInterval     = time.Minute
+	defaultForkMonitorDepth        = 2
+	defaultExternalPolicyTimeout   = time.Second
+	defaultCheckpointMinSigs       = 1
 	minPruneDepth                  = 288
 	defaultDBCacheSize             = 500
 	defaultDBFlushSecs             = 1800
 	defaultRPCAuthTimeout          = 10
+	defaultRPCCertRenewBefore      = time.Hour * 24 * 30
+	defaultGrpcMaxRequestSize      = 1024 * 1024 * 4
+	defaultPublicRPCRate           = 2
+	defaultPublicRPCBurst          = 10
 )
 
 var (
@@ -92,42 +117,56 @@ var (
 // to parse and execute service commands specified via the -s flag.
 var runServiceCommand func(string) error
 
-// config defines the configuration options for bchd.
+// Config defines the configuration options for bchd.
 //
-// See loadConfig for details on the configuration load process.
-type config struct {
+// See LoadConfig for details on the configuration load process.
+type Config struct {
 	ShowVersion             bool          `short:"V" long:"version" description:"Display version information and exit"`
 	ConfigFile              string        `short:"C" long:"configfile" description:"Path to configuration file"`
 	DataDir                 string        `short:"b" long:"datadir" description:"Directory to store data"`
 	LogDir                  string        `long:"logdir" description:"Directory to log output."`
 	AddPeers                []string      `short:"a" long:"addpeer" description:"Add a peer to connect with at startup"`
 	ConnectPeers            []string      `long:"connect" description:"Connect only to the specified peers at startup"`
+	ReplicaOf               []string      `long:"replicaof" description:"Run as a read replica syncing only from the specified primary nodes, accepting the transactions they relay even when they are not standard without rate limiting and requesting their mempool on connect.  Mining, address exchange and the relay of the blocks and transactions learned from the primaries are disabled -- NOTE: Implies --nolisten and --nodnsseed"`
 	DisableListen           bool          `long:"nolisten" description:"Disable listening for incoming connections -- NOTE: Listening is automatically disabled if the --connect or --proxy options are used without also specifying listen interfaces via --listen"`
-	Listeners               []string      `long:"listen" description:"Add an interface/port to listen for connections (default all interfaces port: 8333, testnet: 18333)"`
+	Listeners               []string      `long:"listen" description:"Add an interface/port to listen for connections, optionally followed by comma separated options iface=<name> and whitelist (default all interfaces port: 8333, testnet: 18333)"`
 	MaxPeers                int           `long:"maxpeers" description:"Max number of inbound and outbound peers"`
 	MaxPeersPerIP           int           `long:"maxpeersperip" description:"Max number of inbound and outbound peers per IP"`
 	MinSyncPeerNetworkSpeed uint64        `long:"minsyncpeernetworkspeed" description:"Disconnect sync peers slower than this threshold in bytes/sec"`
 	DisableBanning          bool          `long:"nobanning" description:"Disable banning of misbehaving peers"`
 	BanDuration             time.Duration `long:"banduration" description:"How long to ban misbehaving peers.  Valid time units are {s, m, h}.  Minimum 1 second"`
 	BanThreshold            uint32        `long:"banthreshold" description:"Maximum allowed ban score before disconnecting and banning misbehaving peers."`
-	Whitelists              []string      `long:"whitelist" description:"Add an IP network or IP that will not be banned. (eg. 192.168.1.0/24 or ::1)"`
+	Whitelists              []string      `long:"whitelist" description:"Add an IP network or IP that will not be banned, optionally prefixed by the comma separated permissions granted to its peers and '@' (noban, relay, forcerelay, mempool, download or all). (eg. 192.168.1.0/24, ::1 or noban,mempool@10.0.0.1)"`
+	AuditMessages           bool          `long:"auditmessages" description:"Log the messages received from peers which approach the protocol limits, such as large addr batches, inv lists and transactions, to spot interoperability issues with other implementations"`
+	AuditThreshold          uint32        `long:"auditthreshold" description:"Percentage of the protocol limit at which a message is logged by --auditmessages"`
+	AuditReject             bool          `long:"auditreject" description:"Reject the messages logged by --auditmessages and disconnect the peers sending them"`
 	AgentBlacklist          []string      `long:"agentblacklist" description:"A comma separated list of user-agent substrings which will cause bchd to reject any peers whose user-agent contains any of the blacklisted substrings."`
 	AgentWhitelist          []string      `long:"agentwhitelist" description:"A comma separated list of user-agent substrings which will cause bchd to require all peers' user-agents to contain one of the whitelisted substrings. The blacklist is applied before the whitelist, and an empty whitelist will allow all agents that do not fail the blacklist."`
 	RPCUser                 string        `short:"u" long:"rpcuser" description:"Username for RPC connections"`
 	RPCPass                 string        `short:"P" long:"rpcpass" default-mask:"-" description:"Password for RPC connections"`
 	RPCLimitUser            string        `long:"rpclimituser" description:"Username for limited RPC connections"`
 	RPCLimitPass            string        `long:"rpclimitpass" default-mask:"-" description:"Password for limited RPC connections"`
-	RPCListeners            []string      `long:"rpclisten" description:"Add an interface/port to listen for RPC connections (default port: 8334, testnet: 18334)"`
+	RPCAccounts             []string      `long:"rpcaccount" default-mask:"-" description:"Add an RPC account in the form <user>:<pass>,methods=<method> <method>...[,allowip=<ip or net> ...] -- The method list may include * for all methods and @limited for the methods of the limited user"`
+	RPCListeners            []string      `long:"rpclisten" description:"Add an interface/port to listen for RPC connections, optionally followed by comma separated options iface=<name> and limited (default port: 8334, testnet: 18334)"`
 	RPCCert                 string        `long:"rpccert" description:"File containing the certificate file"`
 	RPCKey                  string        `long:"rpckey" description:"File containing the certificate key"`
+	RPCCertExtraHosts       []string      `long:"rpccertextrahost" description:"Add a host name or IP address the autogenerated RPC certificate is valid for in addition to localhost and any external IPs"`
+	RPCCertRenewBefore      time.Duration `long:"rpccertrenewbefore" description:"Regenerate the autogenerated RPC certificate once it expires within this duration"`
 	RPCMaxClients           int           `long:"rpcmaxclients" description:"Max number of RPC clients for standard connections"`
 	RPCMaxWebsockets        int           `long:"rpcmaxwebsockets" description:"Max number of RPC websocket connections"`
 	RPCMaxConcurrentReqs    int           `long:"rpcmaxconcurrentreqs" description:"Max number of concurrent RPC requests that may be processed concurrently"`
 	RPCQuirks               bool          `long:"rpcquirks" description:"Mirror some JSON-RPC quirks of Bitcoin Core -- NOTE: Discouraged unless interoperability issues need to be worked around"`
+	EstimateFeeMode         string        `long:"estimatefeemode" description:"How estimatefee estimates fees when the request does not pass a mode {historical, mempool, blended}"`
 	RPCAuthTimeout          uint          `long:"rpcauthtimeout" description:"The number of seconds a connection to the RPC server is allowed to stay open without authenticating. To disable the timeout use 0."`
-	DisableRPC              bool          `long:"norpc" description:"Disable built-in RPC server -- NOTE: The RPC server is disabled by default if no rpcuser/rpcpass or rpclimituser/rpclimitpass is specified"`
+	PublicRPC               bool          `long:"publicrpc" description:"Serve a small set of read-only RPC methods (getblock, getrawtransaction, getblockchaininfo, ...) to clients without credentials, subject to a per client rate limit"`
+	PublicRPCRate           float64       `long:"publicrpcrate" description:"The number of requests per second each client may make without credentials when --publicrpc is set"`
+	PublicRPCBurst          int           `long:"publicrpcburst" description:"The number of requests each client may make in a burst without credentials when --publicrpc is set"`
+	DisableRPC              bool          `long:"norpc" description:"Disable built-in RPC server -- NOTE: The RPC server is disabled by default if no rpcuser/rpcpass, rpclimituser/rpclimitpass or rpcaccount is specified and publicrpc is not set"`
 	DisableTLS              bool          `long:"notls" description:"Disable TLS for the RPC server -- NOTE: This is only allowed if the RPC server is bound to localhost"`
 	DisableDNSSeed          bool          `long:"nodnsseed" description:"Disable DNS seeding for peers"`
+	AddDNSSeeds             []string      `long:"adddnsseed" description:"Add a DNS seed to query for peers in addition to the seeds of the network -- may be specified multiple times"`
+	HTTPSeeds               []string      `long:"httpseed" description:"Add an HTTPS URL serving a list of peers, one host or host:port per line, to query for peers along with the DNS seeds -- may be specified multiple times"`
+	ReseedInterval          time.Duration `long:"reseedinterval" description:"Query the seeds for peers again at this interval while more addresses are needed -- 0 to only query them on startup.  Valid time units are {s, m, h}"`
 	ExternalIPs             []string      `long:"externalip" description:"Add an ip to the list of local addresses we claim to listen on to peers"`
 	Proxy                   string        `long:"proxy" description:"Connect via SOCKS5 proxy (eg. 127.0.0.1:9050)"`
 	ProxyUser               string        `long:"proxyuser" description:"Username for proxy server"`
@@ -137,6 +176,8 @@ type config struct {
 	OnionProxyPass          string        `long:"onionpass" default-mask:"-" description:"Password for onion proxy server"`
 	NoOnion                 bool          `long:"noonion" description:"Disable connecting to tor hidden services"`
 	TorIsolation            bool          `long:"torisolation" description:"Enable Tor stream isolation by randomizing user credentials for each connection."`
+	OnlyNets                []string      `long:"onlynet" description:"Only make automatic outbound connections to peers on this network {ipv4, ipv6, onion} -- may be specified multiple times"`
+	PreferNet               string        `long:"prefernet" description:"Prefer automatic outbound connections to peers on this network {ipv4, ipv6, onion}"`
 	TestNet3                bool          `long:"testnet" description:"Use the test network"`
 	TestNet4                bool          `long:"testnet4" description:"Use the test 4 network"`
 	ChipNet                 bool          `long:"chipnet" description:"Use the chip network"`
@@ -144,9 +185,15 @@ type config struct {
 	RegressionTestAnyHost   bool          `long:"regtestanyhost" description:"In regression test mode, allow connections from any host, not just localhost"`
 	RegressionTestNoReset   bool          `long:"regtestnoreset" description:"In regression test mode, don't reset the network db on node restart"`
 	SimNet                  bool          `long:"simnet" description:"Use the simulation test network"`
+	UpgradeTest             int64         `long:"upgradetest" description:"Run a separate chain from the genesis block of the selected network on which all of the upgrades which are not active yet activate at this Unix time (median time past) -- 0 to disable"`
+	UpgradeActivations      []string      `long:"upgradeactivation" description:"Override the activation of a network upgrade to rehearse it, either the block height upgrade9 activates after or the median time past upgrade11 activates at.  Format: '<upgrade>:<activation>' -- Only allowed on regtest and simnet"`
 	AddCheckpoints          []string      `long:"addcheckpoint" description:"Add a custom checkpoint.  Format: '<height>:<hash>'"`
 	DisableCheckpoints      bool          `long:"nocheckpoints" description:"Disable built-in checkpoints.  Don't do this unless you know what you're doing."`
+	CheckpointURL           string        `long:"checkpointurl" description:"Fetch signed checkpoint updates from this HTTPS URL on start up -- requires --checkpointpubkey"`
+	CheckpointPubKeys       []string      `long:"checkpointpubkey" description:"Hex encoded public key trusted to sign checkpoint updates -- may be specified multiple times"`
+	CheckpointMinSigs       int           `long:"checkpointminsigs" description:"Number of signatures by distinct --checkpointpubkey keys required to accept a checkpoint update"`
 	DbType                  string        `long:"dbtype" description:"Database backend to use for the Block Chain"`
+	DbCheckOnly             bool          `long:"dbcheckonly" description:"Report the database migrations which would be applied on start up and then exit without modifying the database"`
 	Profile                 string        `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
 	CPUProfile              string        `long:"cpuprofile" description:"Write CPU profile to the specified file"`
 	DebugLevel              string        `short:"d" long:"debuglevel" description:"Logging level for all subsystems {trace, debug, info, warn, error, critical} -- You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems -- Use show to list available subsystems"`
@@ -157,18 +204,36 @@ type config struct {
 	NoRelayPriority         bool          `long:"norelaypriority" description:"Do not require free or low-fee transactions to have high priority for relaying"`
 	TrickleInterval         time.Duration `long:"trickleinterval" description:"Minimum time between attempts to send new inventory to a connected peer"`
 	MaxOrphanTxs            int           `long:"maxorphantx" description:"Max number of orphan transactions to keep in memory"`
+	RejectedTxWindow        time.Duration `long:"rejectedtxwindow" description:"How long transactions rejected from peers are not requested again -- 0 forgets them at each new block"`
+	MaxMempool              int           `long:"maxmempool" description:"Max total size in megabytes of the transactions to keep in the mempool, evicting the ones paying the lowest fees beyond it -- 0 to disable"`
+	MaxTokenCategoryTxs     int           `long:"maxtokencategorytxs" description:"Max number of unconfirmed transactions with outputs of the same CashToken category to keep in the mempool -- 0 to disable"`
+	MaxTokenGenesisTxs      int           `long:"maxtokengenesistxs" description:"Max number of transactions creating a new CashToken category to accept into the mempool per block -- 0 to disable"`
+	MaxStandardTxSize       int           `long:"maxstandardtxsize" description:"Max size in bytes of a standard transaction -- 0 to use the default of the network"`
+	MaxStandardSigScript    int           `long:"maxstandardsigscriptsize" description:"Max size in bytes of each signature script of a standard transaction -- 0 to use the default of the network"`
+	MaxDataCarrierSize      int           `long:"maxdatacarriersize" description:"Max size in bytes of all of the OP_RETURN outputs of a standard transaction combined -- 0 to use the default of the network"`
+	MaxDataCarrierOutputs   int           `long:"maxdatacarrieroutputs" description:"Max number of OP_RETURN outputs of a standard transaction -- 0 to allow any number within maxdatacarriersize"`
+	NoPersistMempool        bool          `long:"nopersistmempool" description:"Do not save the mempool to a file in the data directory on shutdown and load it on startup"`
+	MempoolSeed             string        `long:"mempoolseed" description:"Pre-fill the mempool with the transactions of a trusted node once the chain is synced by pulling a snapshot through its RPC server, in the form http[s]://[user:pass@]host:port"`
+	MempoolSeedRPCCert      string        `long:"mempoolseedrpccert" description:"File containing the certificate of the RPC server of the https mempool seed node, or of the CA it was issued by, which it is verified against instead of the system certificates"`
 	Generate                bool          `long:"generate" description:"Generate (mine) bitcoins using the CPU"`
 	MiningAddrs             []string      `long:"miningaddr" description:"Add the specified payment address to the list of addresses to use for generated blocks -- At least one address is required if the generate option is set"`
 	BlockMinSize            uint32        `long:"blockminsize" description:"Minimum block size in bytes to be used when creating a block"`
 	BlockMaxSize            uint32        `long:"blockmaxsize" description:"Maximum block size in bytes to be used when creating a block"`
 	BlockPrioritySize       uint32        `long:"blockprioritysize" description:"Size in bytes for high-priority/low-fee transactions when creating a block"`
+	BlockLastMinuteFee      float64       `long:"blocklastminutefee" description:"When getblocktemplate reuses the current block template, add the transactions which arrived since it was generated paying at least this fee in BCH/kB to it -- 0 to disable"`
+	FeeOnlyPolicy           bool          `long:"feeonlypolicy" description:"Disable the legacy coin-age priority logic in the mempool and when creating blocks and order transactions purely by fee rate -- Free transactions are only relayed within the limitfreerelay allowance and blockprioritysize is ignored"`
 	CoinbaseFlags           string        `long:"cbflags" description:"Comment to append to the coinbase input when generating a block template." default:"/bchd/"`
 	UserAgentComments       []string      `long:"uacomment" description:"Comment to add to the user agent -- See BIP 14 for more information."`
 	NoPeerBloomFilters      bool          `long:"nopeerbloomfilters" description:"Disable bloom filtering support"`
 	NoCFilters              bool          `long:"nocfilters" description:"Disable committed filtering (CF) support"`
+	CFExtended              bool          `long:"cfextended" description:"Maintain the extended committed filters, which also include the token categories of the outputs carrying tokens, and serve them to peers -- NOTE: Enabling them rebuilds the committed filter index"`
 	DropCfIndex             bool          `long:"dropcfindex" description:"Deletes the index used for committed filtering (CF) support from the database on start up and then exits."`
+	ScriptFlags             string        `long:"scriptflags" description:"Comma separated script verification flags to forcibly enable when prefixed by + or disable when prefixed by - on top of the consensus and policy flags, such as +MINIMALIF,-SCHNORR, for testing upgrades -- Only allowed on regtest and simnet"`
 	SigCacheMaxSize         uint          `long:"sigcachemaxsize" description:"The maximum number of entries in the signature verification cache"`
-	UtxoCacheMaxSizeMiB     uint          `long:"utxocachemaxsize" description:"The maximum size in MiB of the UTXO cache"`
+	NoLibsecp256k1          bool          `long:"nolibsecp256k1" description:"Do not verify signatures with libsecp256k1 when bchd is built with the libsecp256k1 build tag"`
+	UtxoCacheMaxSize        string        `long:"utxocachemaxsize" description:"The maximum size in MiB of the UTXO cache, or auto to size it based on the memory available to bchd, including container limits, and shrink it under memory pressure"`
+	MemoryCeiling           uint64        `long:"memoryceiling" description:"Memory usage in MiB of the process at which bchd degrades in stages rather than running out of memory: shrinking the signature and hash caches, flushing the UTXO cache, tightening the mempool policy and rejecting inbound peers -- 0 to disable"`
+	UtxoCacheWarmupBlocks   int32         `long:"utxocachewarmupblocks" description:"Preload the UTXO cache on startup with the unspent outputs created in this many of the most recent blocks -- 0 to disable"`
 	BlocksOnly              bool          `long:"blocksonly" description:"Do not accept transactions from remote peers."`
 	TxIndex                 bool          `long:"txindex" description:"Maintain a full hash-based transaction index which makes all transactions available via the getrawtransaction RPC"`
 	DropTxIndex             bool          `long:"droptxindex" description:"Deletes the hash-based transaction index from the database on start up and then exits."`
@@ -178,15 +243,40 @@ type config struct {
 	SlpCacheMaxSize         uint          `long:"slpcachemaxsize" description:"The maximum number of entries in the slp indexer cache"`
 	DropSlpIndex            bool          `long:"dropslpindex" description:"Deletes the slp index from the database on start up and then exits."`
 	SlpGraphSearch          bool          `long:"slpgraphsearch" description:"Enables gRPC calls related to slp graph search."`
+	Bcmr                    bool          `long:"bcmr" description:"Fetch Bitcoin Cash Metadata Registry documents referenced on-chain to serve token metadata via the GetTokenMetadata gRPC method -- Requires txindex"`
+	BcmrTimeout             time.Duration `long:"bcmrtimeout" description:"The maximum time allowed for fetching a single metadata registry document"`
+	BcmrCacheTTL            time.Duration `long:"bcmrcachettl" description:"How long a fetched metadata registry document is cached"`
+	BcmrIPFSGateway         string        `long:"bcmripfsgateway" description:"The gateway URL used to fetch ipfs:// metadata registry documents"`
 	RelayNonStd             bool          `long:"relaynonstd" description:"Relay non-standard transactions regardless of the default settings for the active network."`
 	RejectNonStd            bool          `long:"rejectnonstd" description:"Reject non-standard transactions regardless of the default settings for the active network."`
 	Prune                   bool          `long:"prune" description:"Delete historical blocks from the chain. A buffer of blocks will be retained in case of a reorg."`
 	PruneDepth              uint32        `long:"prunedepth" description:"The number of blocks to retain when running in pruned mode. Cannot be less than 288."`
 	TargetOutboundPeers     uint32        `long:"targetoutboundpeers" description:"Number of outbound connections to maintain"`
+	BlockRelayPeers         uint32        `long:"blockrelaypeers" description:"Number of additional outbound block-relay-only connections to maintain"`
+	FeelerInterval          time.Duration `long:"feelerinterval" description:"Average interval between short-lived feeler connections used to test new addresses -- Use 0 to disable feeler connections"`
+	StatsInterval           time.Duration `long:"statsinterval" description:"Interval between samples of the mempool and block statistics served by the getmempoolstats RPC -- Use 0 to disable recording"`
+	StatsHistory            time.Duration `long:"statshistory" description:"How long recorded mempool and block statistics are kept in the database"`
+	ForkMonitorNodes        []string      `long:"forkmonitornode" description:"Compare the best chain of another node against ours through its RPC server and raise an alert when they fork, in the form http[s]://[user:pass@]host:port"`
+	ForkMonitorInterval     time.Duration `long:"forkmonitorinterval" description:"Interval between comparisons of the best chains of the fork monitor nodes"`
+	ForkMonitorDepth        uint32        `long:"forkmonitordepth" description:"Number of blocks on either side of a fork with a fork monitor node required to raise an alert"`
+	ForkMonitorCA           string        `long:"forkmonitorca" description:"File containing the CA certificates the TLS certificates of the https fork monitor nodes are verified against instead of the system ones"`
+	ClusterListeners        []string      `long:"clusterlisten" description:"Add an interface/port to listen for cluster gossip connections from the other nodes of the cluster"`
+	ClusterMembers          []string      `long:"clustermember" description:"Add a node of the cluster to share ban decisions, misbehaving peers and fork alerts with, in the form host:port"`
+	ClusterCert             string        `long:"clustercert" description:"File containing the TLS certificate this node authenticates with to the other nodes of the cluster"`
+	ClusterKey              string        `long:"clusterkey" description:"File containing the key of the cluster TLS certificate"`
+	ClusterCA               string        `long:"clusterca" description:"File containing the CA certificate the TLS certificates of the nodes of the cluster are signed with"`
+	ExternalPolicy          string        `long:"externalpolicy" description:"Consult the external policy server at this gRPC address, in the form host:port, before accepting transactions into the mempool"`
+	ExternalPolicyCert      string        `long:"externalpolicycert" description:"File containing the TLS certificate of the external policy server -- the connection is not encrypted when it is not set"`
+	ExternalPolicyTimeout   time.Duration `long:"externalpolicytimeout" description:"The maximum time the external policy server may take to check a transaction"`
+	ExternalPolicyFailOpen  bool          `long:"externalpolicyfailopen" description:"Accept transactions when the external policy server can't be reached instead of rejecting them"`
 	ReIndexChainState       bool          `long:"reindexchainstate" description:"Rebuild the UTXO database from currently indexed blocks on disk."`
 	FastSync                bool          `long:"fastsync" description:"Sync full blocks from the last checkpoint to the tip rather than from genesis."`
-	GrpcListeners           []string      `long:"grpclisten" description:"Add an interface/port to listen for experimental gRPC connections (default port: 8335, testnet: 18335)"`
+	GrpcListeners           []string      `long:"grpclisten" description:"Add an interface/port to listen for experimental gRPC connections, optionally followed by comma separated options iface=<name>, noauth and authtoken=<token> (default port: 8335, testnet: 18335)"`
 	GrpcAuthToken           string        `long:"grpcauthtoken" description:"An authentication token for the gRPC API to authenticate clients"`
+	GrpcAuditLog            bool          `long:"grpcauditlog" description:"Log every gRPC request along with how the client authenticated, the status code and the duration"`
+	GrpcMaxRequestSize      int           `long:"grpcmaxrequestsize" description:"The maximum size in bytes of a gRPC request message"`
+	GrpcACMEDomains         []string      `long:"grpcacmedomain" description:"Obtain a certificate for the gRPC server for this publicly reachable domain via ACME (Let's Encrypt) -- NOTE: The gRPC server must be reachable on port 443 of the domain"`
+	GrpcACMEEmail           string        `long:"grpcacmeemail" description:"Contact email address for the ACME account used for --grpcacmedomain"`
 	DBCacheSize             uint64        `long:"dbcachesize" description:"The maximum size in MiB of the database cache"`
 	DBFlushInterval         uint32        `long:"dbflushinterval" description:"The number of seconds between database flushes"`
 	PrometheusListen        string        `long:"prometheus" description:"Specify an (addr):port to serve prometheus metrics (for example :9000 or my-interface:9000, default disabled)"`
@@ -194,9 +284,18 @@ type config struct {
 	oniondial               func(string, string, time.Duration) (net.Conn, error)
 	dial                    func(string, string, time.Duration) (net.Conn, error)
 	addCheckpoints          []chaincfg.Checkpoint
+	checkpointPubKeys       []*bchec.PublicKey
 	miningAddrs             []bchutil.Address
 	minRelayTxFee           bchutil.Amount
-	whitelists              []*net.IPNet
+	blockLastMinuteFee      bchutil.Amount
+	scriptFlagOverrides     txscript.ScriptFlagOverrides
+	utxoCacheMaxSizeMiB     uint64
+	utxoCacheAutoSize       bool
+	whitelists              []netWhitelist
+	rpcAccounts             []*rpcAccount
+	onlyNets                []addrmgr.Network
+	preferNets              []addrmgr.Network
+	args                    []string
 }
 
 // serviceOptions defines the configuration options for the daemon as a service on
@@ -303,6 +402,35 @@ func parseAndSetDebugLevels(debugLevel string) error {
 	return nil
 }
 
+// parseWhitelists parses the passed whitelisted IP addresses and networks.  A
+// bare IP address is treated as a network containing only that address.
+func parseWhitelists(addrs []string) ([]*net.IPNet, error) {
+	whitelists := make([]*net.IPNet, 0, len(addrs))
+	for _, addr := range addrs {
+		_, ipnet, err := net.ParseCIDR(addr)
+		if err != nil {
+			ip := net.ParseIP(addr)
+			if ip == nil {
+				str := "The whitelist value of '%s' is invalid"
+				return nil, fmt.Errorf(str, addr)
+			}
+			var bits int
+			if ip.To4() == nil {
+				// IPv6
+				bits = 128
+			} else {
+				bits = 32
+			}
+			ipnet = &net.IPNet{
+				IP:   ip,
+				Mask: net.CIDRMask(bits, bits),
+			}
+		}
+		whitelists = append(whitelists, ipnet)
+	}
+	return whitelists, nil
+}
+
 // validDbType returns whether or not dbType is a supported database type.
 func validDbType(dbType string) bool {
 	return slices.Contains(knownDbTypes, dbType)
@@ -390,6 +518,44 @@ func parseCheckpoints(checkpointStrings []string) ([]chaincfg.Checkpoint, error)
 	return checkpoints, nil
 }
 
+// applyUpgradeActivations overrides the activations of the upgrades set by the
+// passed strings, using the syntax '<upgrade>:<activation>', in the passed
+// parameters.
+func applyUpgradeActivations(params *chaincfg.Params, activations []string) error {
+	for _, activation := range activations {
+		parts := strings.Split(activation, ":")
+		if len(parts) != 2 {
+			return fmt.Errorf("unable to parse upgrade activation "+
+				"%q -- use the syntax <upgrade>:<activation>",
+				activation)
+		}
+		switch parts[0] {
+		case "upgrade9":
+			height, err := strconv.ParseInt(parts[1], 10, 32)
+			if err != nil || height < 0 {
+				return fmt.Errorf("unable to parse upgrade "+
+					"activation %q due to malformed height",
+					activation)
+			}
+			params.Upgrade9ForkHeight = int32(height)
+
+		case "upgrade11":
+			activationTime, err := strconv.ParseUint(parts[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("unable to parse upgrade "+
+					"activation %q due to malformed time",
+					activation)
+			}
+			params.Upgrade11ActivationTime = activationTime
+
+		default:
+			return fmt.Errorf("unable to parse upgrade activation "+
+				"%q -- expected upgrade9 or upgrade11", activation)
+		}
+	}
+	return nil
+}
+
 // filesExists reports whether the named file or directory exists.
 func fileExists(name string) bool {
 	if _, err := os.Stat(name); err != nil {
@@ -401,7 +567,7 @@ func fileExists(name string) bool {
 }
 
 // newConfigParser returns a new command line flags parser.
-func newConfigParser(cfg *config, so *serviceOptions, options flags.Options) *flags.Parser {
+func newConfigParser(cfg *Config, so *serviceOptions, options flags.Options) *flags.Parser {
 	parser := flags.NewParser(cfg, options)
 	if runtime.GOOS == "windows" {
 		parser.AddGroup("Service Options", "Service Options", so)
@@ -409,21 +575,10 @@ func newConfigParser(cfg *config, so *serviceOptions, options flags.Options) *fl
 	return parser
 }
 
-// loadConfig initializes and parses the config using a config file and command
-// line options.
-//
-// The configuration proceeds as follows:
-//  1. Start with a default config with sane settings
-//  2. Pre-parse the command line to check for an alternative config file
-//  3. Load configuration file overwriting defaults with any specified options
-//  4. Parse CLI options and overwrite/add any specified options
-//
-// The above results in bchd functioning properly without any config settings
-// while still allowing the user to override settings with config files and
-// command line options.  Command line options always take precedence.
-func loadConfig() (*config, []string, error) {
-	// Default config.
-	cfg := config{
+// newDefaultConfig returns a config populated with the default values for all
+// options.
+func newDefaultConfig() Config {
+	return Config{
 		ConfigFile:              defaultConfigFile,
 		DebugLevel:              defaultLogLevel,
 		MaxPeers:                defaultMaxPeers,
@@ -431,14 +586,17 @@ func loadConfig() (*config, []string, error) {
 		MinSyncPeerNetworkSpeed: defaultMinSyncPeerNetworkSpeed,
 		BanDuration:             defaultBanDuration,
 		BanThreshold:            defaultBanThreshold,
+		AuditThreshold:          defaultAuditThreshold,
 		RPCMaxClients:           defaultMaxRPCClients,
 		RPCMaxWebsockets:        defaultMaxRPCWebsockets,
 		RPCMaxConcurrentReqs:    defaultMaxRPCConcurrentReqs,
+		EstimateFeeMode:         defaultEstimateFeeMode,
 		DataDir:                 defaultDataDir,
 		LogDir:                  defaultLogDir,
 		DbType:                  defaultDbType,
 		RPCKey:                  defaultRPCKeyFile,
 		RPCCert:                 defaultRPCCertFile,
+		RPCCertRenewBefore:      defaultRPCCertRenewBefore,
 		ExcessiveBlockSize:      defaultExcessiveBlockSize,
 		MinRelayTxFee:           mempool.DefaultMinRelayTxFee.ToBCH(),
 		FreeTxRelayLimit:        defaultFreeTxRelayLimit,
@@ -448,8 +606,10 @@ func loadConfig() (*config, []string, error) {
 		CoinbaseFlags:           mining.CoinbaseFlags,
 		BlockPrioritySize:       mempool.DefaultBlockPrioritySize,
 		MaxOrphanTxs:            defaultMaxOrphanTransactions,
+		MaxMempool:              defaultMaxMempoolMB,
 		SigCacheMaxSize:         defaultSigCacheMaxSize,
-		UtxoCacheMaxSizeMiB:     defaultUtxoCacheMaxSizeMiB,
+		UtxoCacheMaxSize:        strconv.Itoa(defaultUtxoCacheMaxSizeMiB),
+		UtxoCacheWarmupBlocks:   defaultUtxoCacheWarmupBlocks,
 		Generate:                defaultGenerate,
 		TxIndex:                 defaultTxIndex,
 		RPCAuthTimeout:          defaultRPCAuthTimeout,
@@ -457,12 +617,43 @@ func loadConfig() (*config, []string, error) {
 		SlpIndex:                defaultSlpIndex,
 		SlpCacheMaxSize:         defaultSlpCacheMaxSize,
 		SlpGraphSearch:          defaultSlpGraphSearch,
+		BcmrTimeout:             defaultBcmrTimeout,
+		BcmrCacheTTL:            defaultBcmrCacheTTL,
+		BcmrIPFSGateway:         defaultBcmrIPFSGateway,
 		PruneDepth:              defaultPruneDepth,
 		TargetOutboundPeers:     defaultTargetOutboundPeers,
+		BlockRelayPeers:         defaultBlockRelayPeers,
+		FeelerInterval:          defaultFeelerInterval,
+		StatsInterval:           defaultStatsInterval,
+		StatsHistory:            defaultStatsHistory,
+		ForkMonitorInterval:     defaultForkMonitorInterval,
+		ForkMonitorDepth:        defaultForkMonitorDepth,
+		ExternalPolicyTimeout:   defaultExternalPolicyTimeout,
+		CheckpointMinSigs:       defaultCheckpointMinSigs,
+		GrpcMaxRequestSize:      defaultGrpcMaxRequestSize,
+		PublicRPCRate:           defaultPublicRPCRate,
+		PublicRPCBurst:          defaultPublicRPCBurst,
 		DBCacheSize:             defaultDBCacheSize,
 		DBFlushInterval:         defaultDBFlushSecs,
 		PrometheusListen:        "",
 	}
+}
+
+// LoadConfig initializes and parses the config using a config file and the
+// passed command line arguments, which should not include the program name.
+//
+// The configuration proceeds as follows:
+//  1. Start with a default config with sane settings
+//  2. Pre-parse the command line to check for an alternative config file
+//  3. Load configuration file overwriting defaults with any specified options
+//  4. Parse CLI options and overwrite/add any specified options
+//
+// The above results in bchd functioning properly without any config settings
+// while still allowing the user to override settings with config files and
+// command line options.  Command line options always take precedence.
+func LoadConfig(args []string) (*Config, []string, error) {
+	// Default config.
+	cfg := newDefaultConfig()
 
 	// Service options which are only added on Windows.
 	serviceOpts := serviceOptions{}
@@ -473,7 +664,7 @@ func loadConfig() (*config, []string, error) {
 	// the final parse below.
 	preCfg := cfg
 	preParser := newConfigParser(&preCfg, &serviceOpts, flags.HelpFlag)
-	_, err := preParser.Parse()
+	_, err := preParser.ParseArgs(args)
 	if err != nil {
 		if e, ok := err.(*flags.Error); ok && e.Type == flags.ErrHelp {
 			fmt.Fprintln(os.Stderr, err)
@@ -533,7 +724,7 @@ func loadConfig() (*config, []string, error) {
 	}
 
 	// Parse command line options again to ensure they take precedence.
-	remainingArgs, err := parser.Parse()
+	remainingArgs, err := parser.ParseArgs(args)
 	if err != nil {
 		if e, ok := err.(*flags.Error); !ok || e.Type != flags.ErrHelp {
 			fmt.Fprintln(os.Stderr, usageMessage)
@@ -542,7 +733,7 @@ func loadConfig() (*config, []string, error) {
 	}
 
 	// Create the home directory if it doesn't already exist.
-	funcName := "loadConfig"
+	funcName := "LoadConfig"
 	err = os.MkdirAll(defaultHomeDir, 0700)
 	if err != nil {
 		// Show a nicer error message if it's because a symlink is
@@ -596,6 +787,75 @@ func loadConfig() (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// Clone the parameters of the selected network with the upcoming
+	// upgrades force-enabled when running an upgrade test network.  All of
+	// the nodes of the test network must use the same activation time.
+	if cfg.UpgradeTest < 0 {
+		str := "%s: The upgradetest option may not be less than 0 " +
+			"-- parsed [%d]"
+		err := fmt.Errorf(str, funcName, cfg.UpgradeTest)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+	if cfg.UpgradeTest > 0 {
+		upgradeTestParams := *activeNetParams
+		upgradeTestParams.Params = chaincfg.UpgradeTestParams(
+			activeNetParams.Params, time.Unix(cfg.UpgradeTest, 0))
+		upgradeTestParams.rpcPort = chaincfg.UpgradeTestPort(
+			activeNetParams.rpcPort)
+		upgradeTestParams.gRRPPort = chaincfg.UpgradeTestPort(
+			activeNetParams.gRRPPort)
+		activeNetParams = &upgradeTestParams
+	}
+
+	// Upgrade activation overrides split the node from the rest of the
+	// network, so they are only allowed on the networks used for testing.
+	// The parameters are cloned since they are shared by the whole process.
+	if len(cfg.UpgradeActivations) > 0 {
+		if !cfg.RegressionTest && !cfg.SimNet {
+			str := "%s: The upgradeactivation option is only " +
+				"allowed on regtest and simnet"
+			err := fmt.Errorf(str, funcName)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+		overrideParams := *activeNetParams
+		params := *activeNetParams.Params
+		err := applyUpgradeActivations(&params, cfg.UpgradeActivations)
+		if err != nil {
+			err := fmt.Errorf("%s: %v", funcName, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+		overrideParams.Params = &params
+		activeNetParams = &overrideParams
+	}
+
+	// Script flag overrides split the node from the rest of the network, so
+	// they are only allowed on the networks used for testing.
+	if cfg.ScriptFlags != "" {
+		if !cfg.RegressionTest && !cfg.SimNet {
+			str := "%s: The scriptflags option is only allowed on " +
+				"regtest and simnet"
+			err := fmt.Errorf(str, funcName)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+		cfg.scriptFlagOverrides, err = txscript.ParseScriptFlagOverrides(
+			cfg.ScriptFlags)
+		if err != nil {
+			str := "%s: invalid scriptflags: %v"
+			err := fmt.Errorf(str, funcName, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+	}
+
 	// Re-indexing and pruning don't mix.
 	if cfg.ReIndexChainState && cfg.Prune {
 		str := "%s: reindexchainstate can not be used with a pruned blockchain."
@@ -651,6 +911,23 @@ func loadConfig() (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// Bcmr needs the txindex to look up the transactions publishing
+	// registries.
+	if cfg.Bcmr && !cfg.TxIndex {
+		str := "%s: bcmr can not be used without txindex."
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+	if cfg.Bcmr && cfg.BcmrTimeout <= 0 {
+		str := "%s: bcmrtimeout must be greater than zero -- parsed [%v]"
+		err := fmt.Errorf(str, funcName, cfg.BcmrTimeout)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
 	// Set the default policy for relaying non-standard transactions
 	// according to the default of the active network. The set
 	// configuration value takes precedence over the default value for the
@@ -734,6 +1011,24 @@ func loadConfig() (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// The audit threshold is a percentage of the protocol limits.
+	if cfg.AuditThreshold == 0 || cfg.AuditThreshold > 100 {
+		str := "%s: The auditthreshold option must be between 1 and 100 -- parsed [%d]"
+		err := fmt.Errorf(str, funcName, cfg.AuditThreshold)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
+	// Rejecting the audited messages requires auditing them.
+	if cfg.AuditReject && !cfg.AuditMessages {
+		str := "%s: The auditreject option requires --auditmessages"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
 	if cfg.Prune && cfg.PruneDepth < minPruneDepth {
 		str := "%s: The pruneheight option may not be less than %d -- parsed [%d]"
 		err := fmt.Errorf(str, minPruneDepth, funcName, cfg.PruneDepth)
@@ -742,36 +1037,177 @@ func loadConfig() (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// The stats history must cover at least one sample when the mempool
+	// and block statistics are recorded.
+	if cfg.StatsInterval < 0 || (cfg.StatsInterval > 0 &&
+		cfg.StatsHistory < cfg.StatsInterval) {
+
+		str := "%s: The statsinterval option may not be negative or " +
+			"greater than the statshistory option -- parsed [%v] " +
+			"and [%v]"
+		err := fmt.Errorf(str, funcName, cfg.StatsInterval,
+			cfg.StatsHistory)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
+	// Validate the seeds.
+	for _, httpSeed := range cfg.HTTPSeeds {
+		u, err := url.Parse(httpSeed)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			str := "%s: The httpseed option must be an https URL -- parsed [%s]"
+			err := fmt.Errorf(str, funcName, httpSeed)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+	}
+	if cfg.ReseedInterval < 0 {
+		str := "%s: The reseedinterval option may not be negative -- parsed [%v]"
+		err := fmt.Errorf(str, funcName, cfg.ReseedInterval)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
+	// Validate the mempool seed node.
+	if cfg.MempoolSeed != "" {
+		if _, err := parseMempoolSeed(cfg.MempoolSeed); err != nil {
+			str := "%s: %v"
+			err := fmt.Errorf(str, funcName, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+	}
+	if cfg.MempoolSeedRPCCert != "" {
+		cfg.MempoolSeedRPCCert = cleanAndExpandPath(cfg.MempoolSeedRPCCert)
+	}
+
+	// Validate the external policy server.
+	if cfg.ExternalPolicy != "" {
+		if _, _, err := net.SplitHostPort(cfg.ExternalPolicy); err != nil {
+			str := "%s: Invalid external policy server address '%s': %v"
+			err := fmt.Errorf(str, funcName, cfg.ExternalPolicy, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+		if cfg.ExternalPolicyTimeout <= 0 {
+			str := "%s: The externalpolicytimeout option must be positive -- parsed [%v]"
+			err := fmt.Errorf(str, funcName, cfg.ExternalPolicyTimeout)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+		if cfg.ExternalPolicyCert != "" {
+			cfg.ExternalPolicyCert = cleanAndExpandPath(cfg.ExternalPolicyCert)
+		}
+	}
+
+	// The cluster gossip is only served over mutually authenticated TLS.
+	if len(cfg.ClusterListeners) > 0 || len(cfg.ClusterMembers) > 0 {
+		if cfg.ClusterCert == "" || cfg.ClusterKey == "" ||
+			cfg.ClusterCA == "" {
+
+			str := "%s: The clustercert, clusterkey and clusterca " +
+				"options are required to enable the cluster gossip"
+			err := fmt.Errorf(str, funcName)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+		cfg.ClusterCert = cleanAndExpandPath(cfg.ClusterCert)
+		cfg.ClusterKey = cleanAndExpandPath(cfg.ClusterKey)
+		cfg.ClusterCA = cleanAndExpandPath(cfg.ClusterCA)
+	}
+	for _, addrs := range [][]string{cfg.ClusterListeners, cfg.ClusterMembers} {
+		for _, addr := range addrs {
+			if _, _, err := net.SplitHostPort(addr); err != nil {
+				str := "%s: Invalid cluster address '%s': %v"
+				err := fmt.Errorf(str, funcName, addr, err)
+				fmt.Fprintln(os.Stderr, err)
+				fmt.Fprintln(os.Stderr, usageMessage)
+				return nil, nil, err
+			}
+		}
+	}
+
+	// Validate the fork monitor nodes and settings.
+	for _, spec := range cfg.ForkMonitorNodes {
+		if _, err := parseForkWatchNode(spec); err != nil {
+			str := "%s: %v"
+			err := fmt.Errorf(str, funcName, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+	}
+	if len(cfg.ForkMonitorNodes) > 0 && (cfg.ForkMonitorInterval <= 0 ||
+		cfg.ForkMonitorDepth == 0) {
+
+		str := "%s: The forkmonitorinterval and forkmonitordepth " +
+			"options must be positive -- parsed [%v] and [%d]"
+		err := fmt.Errorf(str, funcName, cfg.ForkMonitorInterval,
+			cfg.ForkMonitorDepth)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+	if cfg.ForkMonitorCA != "" {
+		cfg.ForkMonitorCA = cleanAndExpandPath(cfg.ForkMonitorCA)
+	}
+
+	if cfg.GrpcMaxRequestSize <= 0 {
+		str := "%s: The grpcmaxrequestsize option must be positive " +
+			"-- parsed [%d]"
+		err := fmt.Errorf(str, funcName, cfg.GrpcMaxRequestSize)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
 	// Validate any given whitelisted IP addresses and networks.
 	if len(cfg.Whitelists) > 0 {
-		var ip net.IP
-		cfg.whitelists = make([]*net.IPNet, 0, len(cfg.Whitelists))
+		cfg.whitelists, err = parseNetWhitelists(cfg.Whitelists)
+		if err != nil {
+			err := fmt.Errorf("%s: %v", funcName, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+	}
 
-		for _, addr := range cfg.Whitelists {
-			_, ipnet, err := net.ParseCIDR(addr)
-			if err != nil {
-				ip = net.ParseIP(addr)
-				if ip == nil {
-					str := "%s: The whitelist value of '%s' is invalid"
-					err = fmt.Errorf(str, funcName, addr)
-					fmt.Fprintln(os.Stderr, err)
-					fmt.Fprintln(os.Stderr, usageMessage)
-					return nil, nil, err
-				}
-				var bits int
-				if ip.To4() == nil {
-					// IPv6
-					bits = 128
-				} else {
-					bits = 32
-				}
-				ipnet = &net.IPNet{
-					IP:   ip,
-					Mask: net.CIDRMask(bits, bits),
-				}
-			}
-			cfg.whitelists = append(cfg.whitelists, ipnet)
+	// A read replica only connects to its primaries, which is what
+	// --connect does, so it can't be mixed with the options adding other
+	// peers, accepting inbound connections or mining.
+	if len(cfg.ReplicaOf) > 0 {
+		var conflicts []string
+		if len(cfg.ConnectPeers) > 0 {
+			conflicts = append(conflicts, "--connect")
 		}
+		if len(cfg.AddPeers) > 0 {
+			conflicts = append(conflicts, "--addpeer")
+		}
+		if len(cfg.Listeners) > 0 {
+			conflicts = append(conflicts, "--listen")
+		}
+		if cfg.Generate {
+			conflicts = append(conflicts, "--generate")
+		}
+		if len(conflicts) > 0 {
+			str := "%s: the --replicaof option can not be mixed " +
+				"with %s"
+			err := fmt.Errorf(str, funcName,
+				strings.Join(conflicts, ", "))
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+		cfg.ReplicaOf = normalizeAddresses(cfg.ReplicaOf,
+			activeNetParams.DefaultPort)
+		cfg.ConnectPeers = cfg.ReplicaOf
 	}
 
 	// --addPeer and --connect do not mix.
@@ -824,9 +1260,27 @@ func loadConfig() (*config, []string, error) {
 		return nil, nil, err
 	}
 
-	// The RPC server is disabled if no username or password is provided.
-	if (cfg.RPCUser == "" || cfg.RPCPass == "") &&
-		(cfg.RPCLimitUser == "" || cfg.RPCLimitPass == "") {
+	if cfg.PublicRPC && (cfg.PublicRPCRate <= 0 || cfg.PublicRPCBurst <= 0) {
+		str := "%s: The publicrpcrate and publicrpcburst options " +
+			"must be positive -- parsed [%v] and [%d]"
+		err := fmt.Errorf(str, funcName, cfg.PublicRPCRate,
+			cfg.PublicRPCBurst)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
+	cfg.rpcAccounts, err = buildRPCAccounts(&cfg)
+	if err != nil {
+		err := fmt.Errorf("%s: %v", funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
+	// The RPC server is disabled if there are no accounts unless it serves
+	// public requests.
+	if len(cfg.rpcAccounts) == 0 && !cfg.PublicRPC {
 		cfg.DisableRPC = true
 	}
 
@@ -856,6 +1310,18 @@ func loadConfig() (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// Validate the estimatefeemode.
+	switch cfg.EstimateFeeMode {
+	case "historical", "mempool", "blended":
+	default:
+		str := "%s: invalid estimatefeemode %q -- must be historical, " +
+			"mempool or blended"
+		err := fmt.Errorf(str, funcName, cfg.EstimateFeeMode)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
 	// Validate the minrelaytxfee.
 	cfg.minRelayTxFee, err = bchutil.NewAmount(cfg.MinRelayTxFee)
 	if err != nil {
@@ -866,6 +1332,45 @@ func loadConfig() (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// Validate the blocklastminutefee.
+	cfg.blockLastMinuteFee, err = bchutil.NewAmount(cfg.BlockLastMinuteFee)
+	if err == nil && cfg.blockLastMinuteFee < 0 {
+		err = errors.New("must not be negative")
+	}
+	if err != nil {
+		str := "%s: invalid blocklastminutefee: %v"
+		err := fmt.Errorf(str, funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
+	// Validate the utxocachemaxsize, which is either a size or auto.
+	if cfg.UtxoCacheMaxSize == "auto" {
+		cfg.utxoCacheAutoSize = true
+	} else {
+		cfg.utxoCacheMaxSizeMiB, err = strconv.ParseUint(
+			cfg.UtxoCacheMaxSize, 10, 64)
+		if err != nil {
+			str := "%s: The utxocachemaxsize option must be a size " +
+				"in MiB or auto -- parsed [%s]"
+			err := fmt.Errorf(str, funcName, cfg.UtxoCacheMaxSize)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+	}
+
+	// The rejected transaction window may not be negative.
+	if cfg.RejectedTxWindow < 0 {
+		str := "%s: The rejectedtxwindow option may not be less " +
+			"than 0 -- parsed [%v]"
+		err := fmt.Errorf(str, funcName, cfg.RejectedTxWindow)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
 	// Limit the max orphan count to a sane value.
 	if cfg.MaxOrphanTxs < 0 {
 		str := "%s: The maxorphantx option may not be less than 0 " +
@@ -876,6 +1381,62 @@ func loadConfig() (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// The mempool size limit may not be negative.
+	if cfg.MaxMempool < 0 {
+		str := "%s: The maxmempool option may not be less than 0 " +
+			"-- parsed [%d]"
+		err := fmt.Errorf(str, funcName, cfg.MaxMempool)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
+	// The token category limits may not be negative.
+	if cfg.MaxTokenCategoryTxs < 0 || cfg.MaxTokenGenesisTxs < 0 {
+		str := "%s: The maxtokencategorytxs and maxtokengenesistxs " +
+			"options may not be less than 0 -- parsed [%d] and [%d]"
+		err := fmt.Errorf(str, funcName, cfg.MaxTokenCategoryTxs,
+			cfg.MaxTokenGenesisTxs)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
+	// The standardness limits may not be negative nor allow transactions
+	// which would be invalid by consensus.  Zero selects the default of the
+	// network.
+	if cfg.MaxStandardTxSize < 0 || cfg.MaxStandardSigScript < 0 ||
+		cfg.MaxDataCarrierSize < 0 || cfg.MaxDataCarrierOutputs < 0 {
+
+		str := "%s: The maxstandardtxsize, maxstandardsigscriptsize, " +
+			"maxdatacarriersize and maxdatacarrieroutputs options may " +
+			"not be less than 0 -- parsed [%d], [%d], [%d] and [%d]"
+		err := fmt.Errorf(str, funcName, cfg.MaxStandardTxSize,
+			cfg.MaxStandardSigScript, cfg.MaxDataCarrierSize,
+			cfg.MaxDataCarrierOutputs)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+	if cfg.MaxStandardTxSize > blockchain.MaxTransactionSize {
+		str := "%s: The maxstandardtxsize option may not be more " +
+			"than %d -- parsed [%d]"
+		err := fmt.Errorf(str, funcName, blockchain.MaxTransactionSize,
+			cfg.MaxStandardTxSize)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+	if cfg.MaxStandardTxSize == 0 {
+		cfg.MaxStandardTxSize = activeNetParams.MaxStandardTxSize
+	}
+	if cfg.MaxStandardSigScript == 0 {
+		cfg.MaxStandardSigScript = activeNetParams.MaxStandardSigScriptSize
+	}
+	if cfg.MaxDataCarrierSize == 0 {
+		cfg.MaxDataCarrierSize = activeNetParams.MaxDataCarrierSize
+	}
+
 	// Excessive blocksize cannot be set less than the default but it can be higher.
 	cfg.ExcessiveBlockSize = max(cfg.ExcessiveBlockSize, defaultExcessiveBlockSize)
 
@@ -893,7 +1454,12 @@ func loadConfig() (*config, []string, error) {
 		return nil, nil, err
 	}
 	// Limit the block priority and minimum block sizes to max block size.
+	// There is no area for high-priority transactions when the legacy
+	// priority logic is disabled.
 	cfg.BlockPrioritySize = min(cfg.BlockPrioritySize, cfg.BlockMaxSize)
+	if cfg.FeeOnlyPolicy {
+		cfg.BlockPrioritySize = 0
+	}
 	cfg.BlockMinSize = min(cfg.BlockMinSize, cfg.BlockMaxSize)
 
 	// Prepend ExcessiveBlockSize signaling to the UserAgentComments
@@ -953,6 +1519,16 @@ func loadConfig() (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// --cfextended and --nocfilters do not mix.
+	if cfg.CFExtended && cfg.NoCFilters {
+		err := fmt.Errorf("%s: the --cfextended and --nocfilters "+
+			"options may not be activated at the same time",
+			funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
 	// Check mining addresses are valid and saved parsed versions.
 	cfg.miningAddrs = make([]bchutil.Address, 0, len(cfg.MiningAddrs))
 	for _, strAddr := range cfg.MiningAddrs {
@@ -985,20 +1561,31 @@ func loadConfig() (*config, []string, error) {
 		return nil, nil, err
 	}
 
-	// Add default port to all listener addresses if needed and remove
-	// duplicate addresses.
-	cfg.Listeners = normalizeAddresses(cfg.Listeners,
-		activeNetParams.DefaultPort)
-
-	// Add default port to all rpc listener addresses if needed and remove
-	// duplicate addresses.
-	cfg.RPCListeners = normalizeAddresses(cfg.RPCListeners,
-		activeNetParams.rpcPort)
-
-	// Add default port to all gRPC listener addresses if needed and remove
-	// duplicate addresses.
-	cfg.GrpcListeners = normalizeAddresses(cfg.GrpcListeners,
-		activeNetParams.gRRPPort)
+	// Add default port to all listener specs if needed, ensure only the
+	// options supported by each kind of listener are used and remove
+	// duplicate specs.
+	listenerSpecs := []struct {
+		name        string
+		specs       *[]string
+		defaultPort string
+		allowed     []string
+	}{
+		{"listen", &cfg.Listeners, activeNetParams.DefaultPort, p2pListenOpts},
+		{"rpclisten", &cfg.RPCListeners, activeNetParams.rpcPort, rpcListenOpts},
+		{"grpclisten", &cfg.GrpcListeners, activeNetParams.gRRPPort, grpcListenOpts},
+	}
+	for _, ls := range listenerSpecs {
+		specs, err := normalizeListenerSpecs(*ls.specs, ls.defaultPort,
+			ls.allowed)
+		if err != nil {
+			err := fmt.Errorf("%s: invalid --%s option: %v", funcName,
+				ls.name, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+		*ls.specs = specs
+	}
 
 	// Only allow TLS to be disabled if the RPC or gRPC is bound to localhost
 	// addresses.
@@ -1008,38 +1595,28 @@ func loadConfig() (*config, []string, error) {
 			"127.0.0.1": {},
 			"::1":       {},
 		}
-		for _, addr := range cfg.RPCListeners {
-			host, _, err := net.SplitHostPort(addr)
-			if err != nil {
-				str := "%s: RPC listen interface '%s' is " +
-					"invalid: %v"
-				err := fmt.Errorf(str, funcName, addr, err)
-				fmt.Fprintln(os.Stderr, err)
-				fmt.Fprintln(os.Stderr, usageMessage)
-				return nil, nil, err
-			}
-			if _, ok := allowedTLSListeners[host]; !ok {
-				str := "%s: the --notls option is not recommended " +
-					"when binding RPC to non localhost " +
-					"addresses: %s"
-				bchdLog.Warnf(str, funcName, addr)
-			}
-		}
-		for _, addr := range cfg.GrpcListeners {
-			host, _, err := net.SplitHostPort(addr)
-			if err != nil {
-				str := "%s: gRPC listen interface '%s' is " +
-					"invalid: %v"
-				err := fmt.Errorf(str, funcName, addr, err)
-				fmt.Fprintln(os.Stderr, err)
-				fmt.Fprintln(os.Stderr, usageMessage)
-				return nil, nil, err
-			}
-			if _, ok := allowedTLSListeners[host]; !ok {
-				str := "%s: the --notls option is not recommended " +
-					"when binding gRPC to non localhost " +
-					"addresses: %s"
-				bchdLog.Warnf(str, funcName, addr)
+		for _, ls := range listenerSpecs[1:] {
+			for _, s := range *ls.specs {
+				spec, err := parseListenerSpec(s, ls.defaultPort,
+					ls.allowed)
+				if err != nil {
+					return nil, nil, err
+				}
+				host, _, err := net.SplitHostPort(spec.addr)
+				if err != nil {
+					str := "%s: --%s interface '%s' is " +
+						"invalid: %v"
+					err := fmt.Errorf(str, funcName, ls.name, s, err)
+					fmt.Fprintln(os.Stderr, err)
+					fmt.Fprintln(os.Stderr, usageMessage)
+					return nil, nil, err
+				}
+				if _, ok := allowedTLSListeners[host]; !ok {
+					str := "%s: the --notls option is not " +
+						"recommended when binding --%s to non " +
+						"localhost addresses: %s"
+					bchdLog.Warnf(str, funcName, ls.name, s)
+				}
 			}
 		}
 	}
@@ -1070,6 +1647,91 @@ func loadConfig() (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// Parse the keys trusted to sign checkpoint updates.
+	for _, keyHex := range cfg.CheckpointPubKeys {
+		keyBytes, err := hex.DecodeString(keyHex)
+		if err == nil {
+			var pubKey *bchec.PublicKey
+			pubKey, err = bchec.ParsePubKey(keyBytes, bchec.S256())
+			cfg.checkpointPubKeys = append(cfg.checkpointPubKeys, pubKey)
+		}
+		if err != nil {
+			str := "%s: invalid checkpoint public key %q: %v"
+			err := fmt.Errorf(str, funcName, keyHex, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+	}
+
+	// Checkpoint updates must be fetched over https and need enough
+	// trusted keys to reach the required number of signatures.
+	if cfg.CheckpointURL != "" {
+		if !strings.HasPrefix(cfg.CheckpointURL, "https://") {
+			str := "%s: the checkpoint update URL %q must use https"
+			err := fmt.Errorf(str, funcName, cfg.CheckpointURL)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+		if cfg.CheckpointMinSigs < 1 ||
+			cfg.CheckpointMinSigs > len(cfg.checkpointPubKeys) {
+
+			str := "%s: --checkpointurl requires at least " +
+				"--checkpointminsigs=%d --checkpointpubkey keys " +
+				"(and --checkpointminsigs must be positive)"
+			err := fmt.Errorf(str, funcName, cfg.CheckpointMinSigs)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+	}
+
+	// Parse the networks automatic outbound connections are restricted to
+	// and the preferred network.
+	for _, name := range cfg.OnlyNets {
+		n, err := addrmgr.ParseNetwork(name)
+		if err != nil {
+			str := "%s: invalid --onlynet option: %v"
+			err := fmt.Errorf(str, funcName, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+		if !slices.Contains(cfg.onlyNets, n) {
+			cfg.onlyNets = append(cfg.onlyNets, n)
+		}
+	}
+	if slices.Contains(cfg.onlyNets, addrmgr.OnionNetwork) {
+		if cfg.NoOnion || (cfg.Proxy == "" && cfg.OnionProxy == "") {
+			str := "%s: the --onlynet=onion option requires either " +
+				"proxy or onion to be set and noonion to be unset"
+			err := fmt.Errorf(str, funcName)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+	}
+	if cfg.PreferNet != "" {
+		n, err := addrmgr.ParseNetwork(cfg.PreferNet)
+		if err != nil {
+			str := "%s: invalid --prefernet option: %v"
+			err := fmt.Errorf(str, funcName, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+		if len(cfg.onlyNets) != 0 && !slices.Contains(cfg.onlyNets, n) {
+			str := "%s: the --prefernet network %s is not one of " +
+				"the --onlynet networks"
+			err := fmt.Errorf(str, funcName, n)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+		cfg.preferNets = []addrmgr.Network{n}
+	}
+
 	// Tor stream isolation requires either proxy or onion proxy to be set.
 	if cfg.TorIsolation && cfg.Proxy == "" && cfg.OnionProxy == "" {
 		str := "%s: Tor stream isolation requires either proxy or " +
@@ -1191,6 +1853,7 @@ func loadConfig() (*config, []string, error) {
 		bchdLog.Warnf("%v", configFileError)
 	}
 
+	cfg.args = args
 	return &cfg, remainingArgs, nil
 }
 
@@ -0,0 +1,175 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg"
+)
+
+var (
+	rpcuserRegexp = regexp.MustCompile("(?m)^rpcuser=.+$")
+	rpcpassRegexp = regexp.MustCompile("(?m)^rpcpass=.+$")
+)
+
+func TestExcessiveBlockSizeUserAgentComment(t *testing.T) {
+	// Wipe test args.
+	os.Args = []string{"bchd"}
+
+	cfg, _, err := LoadConfig(os.Args[1:])
+	if err != nil {
+		t.Fatal("Failed to load configuration")
+	}
+
+	if len(cfg.UserAgentComments) != 1 {
+		t.Fatal("Expected EB UserAgentComment")
+	}
+
+	uac := cfg.UserAgentComments[0]
+	uacExpected := "EB32.0"
+	if uac != uacExpected {
+		t.Fatalf("Expected UserAgentComments to contain %s but got %s", uacExpected, uac)
+	}
+
+	// Custom excessive block size.
+	os.Args = []string{"bchd", "--excessiveblocksize=64000000"}
+
+	cfg, _, err = LoadConfig(os.Args[1:])
+	if err != nil {
+		t.Fatal("Failed to load configuration")
+	}
+
+	if len(cfg.UserAgentComments) != 1 {
+		t.Fatal("Expected EB UserAgentComment")
+	}
+
+	uac = cfg.UserAgentComments[0]
+	uacExpected = "EB64.0"
+	if uac != uacExpected {
+		t.Fatalf("Expected UserAgentComments to contain %s but got %s", uacExpected, uac)
+	}
+}
+
+func TestCreateDefaultConfigFile(t *testing.T) {
+	// Setup a temporary directory
+	tmpDir, err := ioutil.TempDir("", "bchd")
+	if err != nil {
+		t.Fatalf("Failed creating a temporary directory: %v", err)
+	}
+	testpath := filepath.Join(tmpDir, "test.conf")
+
+	// Clean-up
+	defer func() {
+		os.Remove(testpath)
+		os.Remove(tmpDir)
+	}()
+
+	err = createDefaultConfigFile(testpath)
+
+	if err != nil {
+		t.Fatalf("Failed to create a default config file: %v", err)
+	}
+
+	content, err := ioutil.ReadFile(testpath)
+	if err != nil {
+		t.Fatalf("Failed to read generated default config file: %v", err)
+	}
+
+	if !rpcuserRegexp.Match(content) {
+		t.Error("Could not find rpcuser in generated default config file.")
+	}
+
+	if !rpcpassRegexp.Match(content) {
+		t.Error("Could not find rpcpass in generated default config file.")
+	}
+}
+
+func TestLoadReloadableConfig(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "bchd")
+	if err != nil {
+		t.Fatalf("Failed creating a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	testpath := filepath.Join(tmpDir, "test.conf")
+	content := "whitelist=10.0.0.0/8\nwhitelist=::1\nrpcmaxclients=3\n" +
+		"agentblacklist=badagent\n"
+	if err := ioutil.WriteFile(testpath, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	// Command line options must continue to take precedence over the
+	// reloaded config file.
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+	cfg = &Config{
+		ConfigFile: testpath,
+		args:       []string{"--rpcmaxclients=7"},
+	}
+
+	rcfg, err := loadReloadableConfig()
+	if err != nil {
+		t.Fatalf("loadReloadableConfig: unexpected error: %v", err)
+	}
+	if len(rcfg.whitelists) != 2 {
+		t.Fatalf("expected 2 parsed whitelists, got %d",
+			len(rcfg.whitelists))
+	}
+	if ones, bits := rcfg.whitelists[1].Mask.Size(); ones != 128 || bits != 128 {
+		t.Fatalf("expected single address IPv6 mask, got /%d of %d",
+			ones, bits)
+	}
+	if rcfg.RPCMaxClients != 7 {
+		t.Fatalf("expected rpcmaxclients 7, got %d", rcfg.RPCMaxClients)
+	}
+	if len(rcfg.AgentBlacklist) != 1 || rcfg.AgentBlacklist[0] != "badagent" {
+		t.Fatalf("unexpected agent blacklist %v", rcfg.AgentBlacklist)
+	}
+
+	// Invalid whitelists must be rejected.
+	content = "whitelist=notanip\n"
+	if err := ioutil.WriteFile(testpath, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	if _, err := loadReloadableConfig(); err == nil {
+		t.Fatal("loadReloadableConfig: expected error for invalid whitelist")
+	}
+}
+
+// TestApplyUpgradeActivations ensures the upgrade activation overrides are
+// applied to the passed parameters and malformed ones are rejected.
+func TestApplyUpgradeActivations(t *testing.T) {
+	params := chaincfg.RegressionNetParams
+	err := applyUpgradeActivations(&params, []string{"upgrade9:150",
+		"upgrade11:1767225600"})
+	if err != nil {
+		t.Fatalf("applyUpgradeActivations: unexpected error: %v", err)
+	}
+	if params.Upgrade9ForkHeight != 150 {
+		t.Fatalf("got upgrade9 height %d, want 150",
+			params.Upgrade9ForkHeight)
+	}
+	if params.Upgrade11ActivationTime != 1767225600 {
+		t.Fatalf("got upgrade11 time %d, want 1767225600",
+			params.Upgrade11ActivationTime)
+	}
+	if chaincfg.RegressionNetParams.Upgrade9ForkHeight == 150 {
+		t.Fatal("the shared regtest parameters were changed")
+	}
+
+	for _, activation := range []string{"upgrade9", "upgrade9:-1",
+		"upgrade11:soon", "upgrade42:1"} {
+
+		err := applyUpgradeActivations(&params, []string{activation})
+		if err == nil {
+			t.Errorf("applyUpgradeActivations: accepted %q", activation)
+		}
+	}
+}
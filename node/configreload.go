@@ -0,0 +1,173 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"sync"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// reloadMtx protects the subset of the config options which may be changed
+// while bchd is running.  Reads of those options from goroutines other than the
+// one performing the reload must hold the read lock.
+//
+// The user agent filters are not protected by this mutex since they are owned
+// by the server's peer handler and are updated through its query channel.
+var reloadMtx sync.RWMutex
+
+// setAgentFiltersMsg is sent to the peer handler to replace the user agent
+// blacklist and whitelist used to filter newly connected peers.
+type setAgentFiltersMsg struct {
+	blacklist []string
+	whitelist []string
+	reply     chan struct{}
+}
+
+// reloadListener listens for the OS signals which request a config reload
+// and reloads the config each time one is received.  It only returns once
+// the passed interrupt channel is closed.
+func reloadListener(n *Node, interrupt <-chan struct{}) {
+	if len(reloadSignals) == 0 {
+		return
+	}
+
+	reloadChannel := make(chan os.Signal, 1)
+	signal.Notify(reloadChannel, reloadSignals...)
+	defer signal.Stop(reloadChannel)
+
+	for {
+		select {
+		case sig := <-reloadChannel:
+			bchdLog.Infof("Received signal (%s).  Reloading config "+
+				"file %s", sig, cfg.ConfigFile)
+			if err := n.ReloadConfig(); err != nil {
+				bchdLog.Errorf("Unable to reload config: %v", err)
+			}
+
+		case <-interrupt:
+			return
+		}
+	}
+}
+
+// loadReloadableConfig parses the config file and the original command line
+// arguments on top of the default config.  Command line options continue to
+// take precedence over the config file.  Only the options which may be
+// changed at runtime are validated.
+func loadReloadableConfig() (*Config, error) {
+	rcfg := newDefaultConfig()
+	parser := newConfigParser(&rcfg, &serviceOptions{}, flags.None)
+	if !(cfg.RegressionTest || cfg.SimNet) || cfg.ConfigFile !=
+		defaultConfigFile {
+
+		err := flags.NewIniParser(parser).ParseFile(cfg.ConfigFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, err := parser.ParseArgs(cfg.args); err != nil {
+		return nil, err
+	}
+
+	var err error
+	rcfg.whitelists, err = parseNetWhitelists(rcfg.Whitelists)
+	if err != nil {
+		return nil, err
+	}
+	if rcfg.DebugLevel == "show" {
+		return nil, errors.New("the debuglevel show command is not " +
+			"supported when reloading")
+	}
+	if rcfg.RPCMaxClients < 0 || rcfg.RPCMaxWebsockets < 0 {
+		return nil, errors.New("the rpcmaxclients and rpcmaxwebsockets " +
+			"options may not be less than 0")
+	}
+	if rcfg.RPCMaxConcurrentReqs < 0 {
+		return nil, fmt.Errorf("the rpcmaxconcurrentreqs option may not "+
+			"be less than 0 -- parsed [%d]", rcfg.RPCMaxConcurrentReqs)
+	}
+
+	return &rcfg, nil
+}
+
+// ReloadConfig re-reads the config file and applies any changes to the options
+// which may be adjusted without a restart.  Those are the IP whitelists, the
+// user agent blacklist and whitelist, the debug level and the RPC client
+// limits.  Changes to any other options are ignored until bchd is restarted.
+//
+// The new RPC concurrent request limit only applies to websocket clients which
+// connect after the reload.
+func (n *Node) ReloadConfig() error {
+	rcfg, err := loadReloadableConfig()
+	if err != nil {
+		return err
+	}
+
+	var changed []string
+	reloadMtx.Lock()
+	if rcfg.DebugLevel != cfg.DebugLevel {
+		if err := parseAndSetDebugLevels(rcfg.DebugLevel); err != nil {
+			reloadMtx.Unlock()
+			return err
+		}
+		cfg.DebugLevel = rcfg.DebugLevel
+		changed = append(changed, fmt.Sprintf("debuglevel=%s",
+			rcfg.DebugLevel))
+	}
+	if !slices.Equal(rcfg.Whitelists, cfg.Whitelists) {
+		cfg.Whitelists = rcfg.Whitelists
+		cfg.whitelists = rcfg.whitelists
+		changed = append(changed, fmt.Sprintf("whitelist=%v",
+			rcfg.Whitelists))
+	}
+	if rcfg.RPCMaxClients != cfg.RPCMaxClients {
+		cfg.RPCMaxClients = rcfg.RPCMaxClients
+		changed = append(changed, fmt.Sprintf("rpcmaxclients=%d",
+			rcfg.RPCMaxClients))
+	}
+	if rcfg.RPCMaxWebsockets != cfg.RPCMaxWebsockets {
+		cfg.RPCMaxWebsockets = rcfg.RPCMaxWebsockets
+		changed = append(changed, fmt.Sprintf("rpcmaxwebsockets=%d",
+			rcfg.RPCMaxWebsockets))
+	}
+	if rcfg.RPCMaxConcurrentReqs != cfg.RPCMaxConcurrentReqs {
+		cfg.RPCMaxConcurrentReqs = rcfg.RPCMaxConcurrentReqs
+		changed = append(changed, fmt.Sprintf("rpcmaxconcurrentreqs=%d",
+			rcfg.RPCMaxConcurrentReqs))
+	}
+	reloadMtx.Unlock()
+
+	blacklistChanged := !slices.Equal(rcfg.AgentBlacklist, cfg.AgentBlacklist)
+	whitelistChanged := !slices.Equal(rcfg.AgentWhitelist, cfg.AgentWhitelist)
+	if blacklistChanged || whitelistChanged {
+		cfg.AgentBlacklist = rcfg.AgentBlacklist
+		cfg.AgentWhitelist = rcfg.AgentWhitelist
+		n.server.setAgentFilters(rcfg.AgentBlacklist, rcfg.AgentWhitelist)
+		if blacklistChanged {
+			changed = append(changed, fmt.Sprintf("agentblacklist=%v",
+				rcfg.AgentBlacklist))
+		}
+		if whitelistChanged {
+			changed = append(changed, fmt.Sprintf("agentwhitelist=%v",
+				rcfg.AgentWhitelist))
+		}
+	}
+
+	if len(changed) == 0 {
+		bchdLog.Infof("Reloaded config: no runtime adjustable options " +
+			"changed")
+		return nil
+	}
+	for _, change := range changed {
+		bchdLog.Infof("Reloaded config: %s", change)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"github.com/gcash/bchd/btcjson"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/mempool"
+	"github.com/gcash/bchd/mining"
+	"github.com/gcash/bchutil"
+)
+
+// cpfpInfo returns the getcpfpinfo result for a child of the passed size which
+// spends the transaction of the passed graph, compared to the passed block
+// template.
+//
+// Block templates select each transaction by its own modified fee rate once
+// the transactions it depends on are selected, so a child can't pull in its
+// ancestors.  The child only gets into the next block along with the package
+// when the transaction and each of its ancestors are in the template or beat
+// the lowest fee rate of the template on their own, and when the child beats
+// it too.  The transactions which don't are reported as blocking the package.
+func cpfpInfo(graph *mempool.TxGraph, template *mining.BlockTemplate,
+	childSize, minRelayFeePerKB int64) *btcjson.GetCPFPInfoResult {
+
+	inTemplate := make(map[chainhash.Hash]struct{},
+		len(template.Block.Transactions))
+	for _, tx := range template.Block.Transactions[1:] {
+		inTemplate[tx.TxHash()] = struct{}{}
+	}
+
+	// The child can't get away with paying less than the minimum relay
+	// fee, even when the whole mempool fits in the block.
+	targetFeePerKB := template.MinFeePerKB
+	if targetFeePerKB < minRelayFeePerKB {
+		targetFeePerKB = minRelayFeePerKB
+	}
+
+	blockingTxIDs := make([]string, 0)
+	for _, entry := range append([]*mempool.TxGraphEntry{graph.Tx},
+		graph.Ancestors...) {
+
+		if _, ok := inTemplate[*entry.Tx.Hash()]; ok {
+			continue
+		}
+		if entry.ModifiedFeePerKB() < targetFeePerKB {
+			blockingTxIDs = append(blockingTxIDs, entry.Tx.Hash().String())
+		}
+	}
+
+	packageSize := int64(graph.Tx.Size) + graph.AncestorSize
+	packageFees := graph.Tx.Fee + graph.AncestorFees
+	_, txInTemplate := inTemplate[*graph.Tx.Tx.Hash()]
+	return &btcjson.GetCPFPInfoResult{
+		TxID:            graph.Tx.Tx.Hash().String(),
+		InTemplate:      txInTemplate,
+		PackageSize:     packageSize,
+		PackageFees:     bchutil.Amount(packageFees).ToBCH(),
+		PackageFeePerKB: bchutil.Amount(packageFees * 1000 / packageSize).ToBCH(),
+		TargetFeePerKB:  bchutil.Amount(targetFeePerKB).ToBCH(),
+		ChildSize:       childSize,
+		ChildFee:        bchutil.Amount((targetFeePerKB*childSize + 999) / 1000).ToBCH(),
+		BlockingTxIDs:   blockingTxIDs,
+		Truncated:       graph.Truncated,
+	}
+}
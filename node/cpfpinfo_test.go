@@ -0,0 +1,127 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/mempool"
+	"github.com/gcash/bchd/mining"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// TestCPFPInfo ensures the fee a child needs to pay is computed against the
+// fee rate of the template and that the ancestors which can't get into the
+// block on their own are reported as blocking the package.
+func TestCPFPInfo(t *testing.T) {
+	// newEntry returns a graph entry for a transaction spending the
+	// passed outpoint which pays the passed fee rate.
+	newEntry := func(outpoint wire.OutPoint, feePerKB int64) *mempool.TxGraphEntry {
+		msgTx := wire.NewMsgTx(1)
+		msgTx.AddTxIn(wire.NewTxIn(&outpoint, []byte{0x51}))
+		msgTx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}, wire.TokenData{}))
+		size := msgTx.SerializeSize()
+		return &mempool.TxGraphEntry{
+			TxDesc: &mempool.TxDesc{TxDesc: mining.TxDesc{
+				Tx:       bchutil.NewTx(msgTx),
+				Fee:      feePerKB * int64(size) / 1000,
+				FeePerKB: feePerKB,
+			}},
+			Size: size,
+		}
+	}
+	newTemplate := func(minFeePerKB int64, txns ...*mempool.TxGraphEntry) *mining.BlockTemplate {
+		msgBlock := wire.NewMsgBlock(&wire.BlockHeader{})
+		msgBlock.AddTransaction(wire.NewMsgTx(1))
+		for _, entry := range txns {
+			msgBlock.AddTransaction(entry.Tx.MsgTx())
+		}
+		return &mining.BlockTemplate{Block: msgBlock, MinFeePerKB: minFeePerKB}
+	}
+
+	const minRelayFeePerKB = 1000
+	const childSize = 200
+	grandparent := newEntry(wire.OutPoint{Hash: chainhash.HashH([]byte("a"))},
+		5000)
+	parent := newEntry(wire.OutPoint{Hash: *grandparent.Tx.Hash()}, 1000)
+	graph := &mempool.TxGraph{
+		Tx:           parent,
+		Ancestors:    []*mempool.TxGraphEntry{grandparent},
+		AncestorSize: int64(grandparent.Size),
+		AncestorFees: grandparent.Fee,
+	}
+
+	tests := []struct {
+		name       string
+		template   *mining.BlockTemplate
+		inTemplate bool
+		target     int64
+		blocking   []string
+	}{
+		{
+			name:     "everything fits",
+			template: newTemplate(0),
+			target:   minRelayFeePerKB,
+			blocking: []string{},
+		},
+		{
+			name:     "parent below the template fee rate",
+			template: newTemplate(2000),
+			target:   2000,
+			blocking: []string{parent.Tx.Hash().String()},
+		},
+		{
+			name:     "whole package below the template fee rate",
+			template: newTemplate(6000),
+			target:   6000,
+			blocking: []string{parent.Tx.Hash().String(),
+				grandparent.Tx.Hash().String()},
+		},
+		{
+			name:     "ancestor already in the template",
+			template: newTemplate(6000, grandparent),
+			target:   6000,
+			blocking: []string{parent.Tx.Hash().String()},
+		},
+		{
+			name:       "package already in the template",
+			template:   newTemplate(6000, grandparent, parent),
+			inTemplate: true,
+			target:     6000,
+			blocking:   []string{},
+		},
+	}
+	for _, test := range tests {
+		result := cpfpInfo(graph, test.template, childSize,
+			minRelayFeePerKB)
+		if result.InTemplate != test.inTemplate {
+			t.Errorf("%s: unexpected in template %v", test.name,
+				result.InTemplate)
+		}
+		if result.TargetFeePerKB != bchutil.Amount(test.target).ToBCH() {
+			t.Errorf("%s: unexpected target fee rate %v", test.name,
+				result.TargetFeePerKB)
+		}
+		wantFee := bchutil.Amount((test.target*childSize + 999) / 1000)
+		if result.ChildFee != wantFee.ToBCH() {
+			t.Errorf("%s: unexpected child fee %v, want %v", test.name,
+				result.ChildFee, wantFee.ToBCH())
+		}
+		if !reflect.DeepEqual(result.BlockingTxIDs, test.blocking) {
+			t.Errorf("%s: unexpected blocking transactions %v, "+
+				"want %v", test.name, result.BlockingTxIDs,
+				test.blocking)
+		}
+		if result.PackageSize != int64(parent.Size+grandparent.Size) ||
+			result.PackageFees != bchutil.Amount(parent.Fee+
+				grandparent.Fee).ToBCH() {
+
+			t.Errorf("%s: unexpected package %+v", test.name, result)
+		}
+	}
+}
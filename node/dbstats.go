@@ -0,0 +1,114 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"github.com/gcash/bchd/database"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// minDBCacheSizeMiB and maxDBCacheSizeMiB are the bounds in MiB of the
+	// size of the database cache set at runtime.
+	minDBCacheSizeMiB = 4
+	maxDBCacheSizeMiB = 64 * 1024
+)
+
+// hitRate returns the share of the lookups of a cache which were hits, or zero
+// if there weren't any lookups.
+func hitRate(hits, misses uint64) float64 {
+	if hits+misses == 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+misses)
+}
+
+// dbStatsCollector exports the statistics of the caches and the storage of the
+// database as Prometheus metrics.
+type dbStatsCollector struct {
+	db database.StatsDB
+
+	cacheSize         *prometheus.Desc
+	cacheMaxSize      *prometheus.Desc
+	cacheEntries      *prometheus.Desc
+	cacheLookups      *prometheus.Desc
+	openBlockFiles    *prometheus.Desc
+	blockFileLookups  *prometheus.Desc
+	openTables        *prometheus.Desc
+	blockCacheSize    *prometheus.Desc
+	ioBytes           *prometheus.Desc
+	compactionSeconds *prometheus.Desc
+}
+
+// Enforce dbStatsCollector implements the prometheus.Collector interface.
+var _ prometheus.Collector = (*dbStatsCollector)(nil)
+
+// newDBStatsCollector returns a collector of the statistics of the passed
+// database.
+func newDBStatsCollector(db database.StatsDB) *dbStatsCollector {
+	desc := func(name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc("bchd_db_"+name, help, labels, nil)
+	}
+	return &dbStatsCollector{
+		db:                db,
+		cacheSize:         desc("cache_size_bytes", "Size of the entries held by the database write cache."),
+		cacheMaxSize:      desc("cache_max_size_bytes", "Size at which the database write cache is flushed."),
+		cacheEntries:      desc("cache_entries", "Number of entries held by the database write cache."),
+		cacheLookups:      desc("cache_lookups_total", "Number of metadata reads by whether they were served by the write cache.", "result"),
+		openBlockFiles:    desc("open_block_files", "Number of block files with an open handle."),
+		blockFileLookups:  desc("block_file_lookups_total", "Number of block file reads by whether the file was already open.", "result"),
+		openTables:        desc("open_tables", "Number of metadata table files with an open handle."),
+		blockCacheSize:    desc("block_cache_size_bytes", "Size of the cache of metadata table blocks."),
+		ioBytes:           desc("io_bytes_total", "Number of bytes read and written by the metadata store.", "direction"),
+		compactionSeconds: desc("compaction_seconds_total", "Time spent compacting the metadata store."),
+	}
+}
+
+// Describe sends the descriptors of the metrics of the database to the passed
+// channel.
+//
+// This is part of the prometheus.Collector interface implementation.
+func (c *dbStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.cacheSize
+	ch <- c.cacheMaxSize
+	ch <- c.cacheEntries
+	ch <- c.cacheLookups
+	ch <- c.openBlockFiles
+	ch <- c.blockFileLookups
+	ch <- c.openTables
+	ch <- c.blockCacheSize
+	ch <- c.ioBytes
+	ch <- c.compactionSeconds
+}
+
+// Collect sends the current metrics of the database to the passed channel.
+//
+// This is part of the prometheus.Collector interface implementation.
+func (c *dbStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	stats, err := c.db.Stats()
+	if err != nil {
+		return
+	}
+
+	var compactionSeconds float64
+	for _, level := range stats.Levels {
+		compactionSeconds += level.CompactionTime.Seconds()
+	}
+
+	gauge, counter := prometheus.GaugeValue, prometheus.CounterValue
+	ch <- prometheus.MustNewConstMetric(c.cacheSize, gauge, float64(stats.CacheSize))
+	ch <- prometheus.MustNewConstMetric(c.cacheMaxSize, gauge, float64(stats.CacheMaxSize))
+	ch <- prometheus.MustNewConstMetric(c.cacheEntries, gauge, float64(stats.CacheEntries))
+	ch <- prometheus.MustNewConstMetric(c.cacheLookups, counter, float64(stats.CacheHits), "hit")
+	ch <- prometheus.MustNewConstMetric(c.cacheLookups, counter, float64(stats.CacheMisses), "miss")
+	ch <- prometheus.MustNewConstMetric(c.openBlockFiles, gauge, float64(stats.OpenBlockFiles))
+	ch <- prometheus.MustNewConstMetric(c.blockFileLookups, counter, float64(stats.BlockFileHits), "hit")
+	ch <- prometheus.MustNewConstMetric(c.blockFileLookups, counter, float64(stats.BlockFileMisses), "miss")
+	ch <- prometheus.MustNewConstMetric(c.openTables, gauge, float64(stats.OpenTables))
+	ch <- prometheus.MustNewConstMetric(c.blockCacheSize, gauge, float64(stats.BlockCacheSize))
+	ch <- prometheus.MustNewConstMetric(c.ioBytes, counter, float64(stats.IORead), "read")
+	ch <- prometheus.MustNewConstMetric(c.ioBytes, counter, float64(stats.IOWrite), "write")
+	ch <- prometheus.MustNewConstMetric(c.compactionSeconds, counter, compactionSeconds)
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/mempool"
+	"github.com/gcash/bchd/mining"
+	"github.com/gcash/bchd/policyhook/pb"
+	"github.com/gcash/bchutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// externalPolicyClient consults an external policy server over gRPC before
+// transactions are accepted into the mempool.  It implements the
+// ExternalPolicyCheck function of the mempool configuration.
+type externalPolicyClient struct {
+	addr       string
+	conn       *grpc.ClientConn
+	client     pb.PolicyhookClient
+	timeout    time.Duration
+	failOpen   bool
+	bestHeight func() int32
+}
+
+// newExternalPolicyClient returns a client of the external policy server at
+// the passed address, which is connected to with TLS when the passed
+// certificate file is set.  Transactions are accepted when the server can't be
+// reached within the passed timeout if failOpen is set, and rejected
+// otherwise.
+func newExternalPolicyClient(addr, certFile string, timeout time.Duration,
+	failOpen bool, bestHeight func() int32) (*externalPolicyClient, error) {
+
+	creds := insecure.NewCredentials()
+	if certFile != "" {
+		var err error
+		creds, err = credentials.NewClientTLSFromFile(certFile, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	return &externalPolicyClient{
+		addr:       addr,
+		conn:       conn,
+		client:     pb.NewPolicyhookClient(conn),
+		timeout:    timeout,
+		failOpen:   failOpen,
+		bestHeight: bestHeight,
+	}, nil
+}
+
+// externalPolicyRequest returns the request to check the passed transaction
+// spending the outputs of the passed view with the external policy server.
+func externalPolicyRequest(tx *bchutil.Tx, view *blockchain.UtxoViewpoint,
+	nextBlockHeight int32) (*pb.CheckTransactionRequest, error) {
+
+	msgTx := tx.MsgTx()
+	var buf bytes.Buffer
+	if err := msgTx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+
+	req := &pb.CheckTransactionRequest{
+		Transaction:     buf.Bytes(),
+		Hash:            tx.Hash().CloneBytes(),
+		SpentOutputs:    make([]*pb.SpentOutput, 0, len(msgTx.TxIn)),
+		NextBlockHeight: nextBlockHeight,
+	}
+	for _, txIn := range msgTx.TxIn {
+		entry := view.LookupEntry(txIn.PreviousOutPoint)
+		if entry == nil {
+			return nil, fmt.Errorf("output %v spent by transaction "+
+				"%v not found", txIn.PreviousOutPoint, tx.Hash())
+		}
+
+		pkScript := entry.PkScript()
+		tokenData := entry.TokenData()
+		if !tokenData.IsEmpty() {
+			prefixed := tokenData.TokenDataBuffer()
+			prefixed.Write(pkScript)
+			pkScript = prefixed.Bytes()
+		}
+		height := entry.BlockHeight()
+		if height == mining.UnminedHeight {
+			height = nextBlockHeight
+		}
+		req.SpentOutputs = append(req.SpentOutputs, &pb.SpentOutput{
+			Value:        entry.Amount(),
+			PubkeyScript: pkScript,
+			Height:       height,
+			Coinbase:     entry.IsCoinBase(),
+		})
+	}
+	return req, nil
+}
+
+// CheckTransaction asks the external policy server whether the passed
+// transaction spending the outputs of the passed view may be accepted into the
+// mempool and returns an error with the reason when it may not.  An
+// ExternalPolicyUnavailableError is returned when the server can't be reached
+// unless the client fails open.
+//
+// This function is safe for concurrent access.
+func (c *externalPolicyClient) CheckTransaction(tx *bchutil.Tx, view *blockchain.UtxoViewpoint) error {
+	req, err := externalPolicyRequest(tx, view, c.bestHeight()+1)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	resp, err := c.client.CheckTransaction(ctx, req)
+	if err != nil {
+		if c.failOpen {
+			srvrLog.Warnf("Accepting transaction %v without checking it "+
+				"with the external policy server %s: %v", tx.Hash(),
+				c.addr, err)
+			return nil
+		}
+		return mempool.ExternalPolicyUnavailableError{
+			Err: fmt.Errorf("server %s: %v", c.addr, err),
+		}
+	}
+	if !resp.Accept {
+		if resp.Reason == "" {
+			return errors.New("rejected by the external policy server")
+		}
+		return errors.New(resp.Reason)
+	}
+	return nil
+}
+
+// Close closes the connection to the external policy server.
+func (c *externalPolicyClient) Close() error {
+	return c.conn.Close()
+}
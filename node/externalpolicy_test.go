@@ -0,0 +1,121 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/mining"
+	"github.com/gcash/bchd/policyhook/pb"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchlog"
+	"github.com/gcash/bchutil"
+	"google.golang.org/grpc"
+)
+
+// testPolicyServer is an external policy server which rejects transactions
+// spending outputs of more than a maximum value.
+type testPolicyServer struct {
+	pb.UnimplementedPolicyhookServer
+	maxValue int64
+	requests chan *pb.CheckTransactionRequest
+}
+
+func (s *testPolicyServer) CheckTransaction(ctx context.Context, req *pb.CheckTransactionRequest) (*pb.CheckTransactionResponse, error) {
+	s.requests <- req
+	for _, out := range req.SpentOutputs {
+		if out.Value > s.maxValue {
+			return &pb.CheckTransactionResponse{Reason: "value too high"}, nil
+		}
+	}
+	return &pb.CheckTransactionResponse{Accept: true}, nil
+}
+
+// TestExternalPolicyClient ensures transactions are checked with the external
+// policy server along with the outputs they spend, and that they are rejected
+// when the server can't be reached unless the client fails open.
+func TestExternalPolicyClient(t *testing.T) {
+	oldLog := srvrLog
+	defer func() { srvrLog = oldLog }()
+	srvrLog = bchlog.Disabled
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to listen: %v", err)
+	}
+	policyServer := &testPolicyServer{
+		maxValue: 5000,
+		requests: make(chan *pb.CheckTransactionRequest, 2),
+	}
+	grpcServer := grpc.NewServer()
+	pb.RegisterPolicyhookServer(grpcServer, policyServer)
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	bestHeight := func() int32 { return 100 }
+	client, err := newExternalPolicyClient(listener.Addr().String(), "",
+		5*time.Second, false, bestHeight)
+	if err != nil {
+		t.Fatalf("unable to create client: %v", err)
+	}
+	defer client.Close()
+
+	// newTx returns a transaction spending an output of the passed value,
+	// which is unconfirmed if height is mining.UnminedHeight, along with
+	// the view of the spent output.
+	newTx := func(value int64, height int32) (*bchutil.Tx, *blockchain.UtxoViewpoint) {
+		prevTx := wire.NewMsgTx(1)
+		prevTx.AddTxOut(wire.NewTxOut(value, []byte{0x52},
+			wire.TokenData{}))
+		view := blockchain.NewUtxoViewpoint()
+		view.AddTxOuts(bchutil.NewTx(prevTx), height)
+
+		prevOut := wire.OutPoint{Hash: prevTx.TxHash()}
+		msgTx := wire.NewMsgTx(1)
+		msgTx.AddTxIn(wire.NewTxIn(&prevOut, []byte{0x51}))
+		msgTx.AddTxOut(wire.NewTxOut(value-100, []byte{0x51},
+			wire.TokenData{}))
+		return bchutil.NewTx(msgTx), view
+	}
+
+	tx, view := newTx(1000, mining.UnminedHeight)
+	if err := client.CheckTransaction(tx, view); err != nil {
+		t.Fatalf("transaction rejected: %v", err)
+	}
+	req := <-policyServer.requests
+	if len(req.SpentOutputs) != 1 || req.SpentOutputs[0].Value != 1000 ||
+		req.SpentOutputs[0].Height != 101 || req.NextBlockHeight != 101 ||
+		len(req.SpentOutputs[0].PubkeyScript) != 1 {
+
+		t.Fatalf("unexpected request %v", req)
+	}
+	if hash, _ := chainhash.NewHash(req.Hash); !hash.IsEqual(tx.Hash()) {
+		t.Fatalf("unexpected transaction hash %v", hash)
+	}
+
+	tx, view = newTx(10000, 50)
+	err = client.CheckTransaction(tx, view)
+	if err == nil || err.Error() != "value too high" {
+		t.Fatalf("unexpected error %v", err)
+	}
+	<-policyServer.requests
+
+	// Transactions are rejected once the server is stopped unless the
+	// client fails open.
+	grpcServer.Stop()
+	tx, view = newTx(1000, 50)
+	if err := client.CheckTransaction(tx, view); err == nil {
+		t.Fatal("transaction accepted without the policy server")
+	}
+	client.failOpen = true
+	if err := client.CheckTransaction(tx, view); err != nil {
+		t.Fatalf("transaction rejected when failing open: %v", err)
+	}
+}
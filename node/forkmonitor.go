@@ -0,0 +1,417 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/btcjson"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+)
+
+const (
+	// forkMonitorRequestTimeout is the maximum time a single RPC request to
+	// a watched node may take.
+	forkMonitorRequestTimeout = 30 * time.Second
+)
+
+// The following constants describe the state of the chain of a watched node
+// relative to ours.
+const (
+	forkStatusUnknown = "unknown"
+	forkStatusSame    = "same"
+	forkStatusBehind  = "behind"
+	forkStatusAhead   = "ahead"
+	forkStatusForked  = "forked"
+)
+
+// forkWatchNode houses a node whose chain is compared against ours by the fork
+// monitor along with the result of the last comparison.
+type forkWatchNode struct {
+	// name identifies the node in logs and RPC results.  It never includes
+	// the credentials.
+	name string
+	url  string
+	user string
+	pass string
+
+	status     string
+	bestHash   chainhash.Hash
+	height     int32
+	forkHeight int32
+	ourDepth   int32
+	theirDepth int32
+	alert      bool
+	lastUpdate time.Time
+	err        error
+}
+
+// parseForkWatchNode parses a watched node in the form
+// 'http[s]://[user:pass@]host:port'.
+func parseForkWatchNode(spec string) (*forkWatchNode, error) {
+	u, err := url.Parse(spec)
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("fork monitor node '%s' must be an "+
+			"http or https URL", spec)
+	}
+
+	node := &forkWatchNode{
+		name:   u.Scheme + "://" + u.Host,
+		status: forkStatusUnknown,
+	}
+	if u.User != nil {
+		node.user = u.User.Username()
+		node.pass, _ = u.User.Password()
+	}
+	u.User = nil
+	node.url = u.String()
+	return node, nil
+}
+
+// rpcClientTLSConfig returns the TLS config used to connect to the RPC servers
+// of other nodes, which verifies their certificates against the CA
+// certificates in the passed file, or against the system ones when the file is
+// empty.
+func rpcClientTLSConfig(caFile string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile == "" {
+		return tlsConfig, nil
+	}
+	caPEM, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+	tlsConfig.RootCAs = pool
+	return tlsConfig, nil
+}
+
+// forkMonitor periodically compares the best chain of a configured list of
+// other nodes against ours through their RPC servers.  An alert is raised
+// when the chain of a node forks from ours and either side of the fork is at
+// least the configured depth, which helps operators notice chain splits, for
+// example around network upgrades.
+type forkMonitor struct {
+	chain      *blockchain.BlockChain
+	interval   time.Duration
+	depth      int32
+	httpClient *http.Client
+
+	mtx   sync.Mutex
+	nodes []*forkWatchNode
+
+	// onAlert is invoked with a copy of the state of a watched node when
+	// an alert is raised for it.  It may be nil.
+	onAlert func(node *forkWatchNode)
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// newForkMonitor returns a new fork monitor which compares the chains of the
+// passed nodes against ours every interval.  The TLS certificates of the nodes
+// are verified against the CA certificates in the passed file, or against the
+// system ones when it is empty.
+func newForkMonitor(chain *blockchain.BlockChain, specs []string,
+	interval time.Duration, depth int32, caFile string) (*forkMonitor, error) {
+
+	nodes := make([]*forkWatchNode, 0, len(specs))
+	for _, spec := range specs {
+		node, err := parseForkWatchNode(spec)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+
+	tlsConfig, err := rpcClientTLSConfig(caFile)
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &forkMonitor{
+		chain:    chain,
+		interval: interval,
+		depth:    depth,
+		httpClient: &http.Client{
+			Timeout:   forkMonitorRequestTimeout,
+			Transport: transport,
+		},
+		nodes:  nodes,
+		ctx:    ctx,
+		cancel: cancel,
+	}, nil
+}
+
+// call issues the passed command to the watched node and unmarshals the result
+// into the passed value.
+func (m *forkMonitor) call(node *forkWatchNode, cmd, result interface{}) error {
+	body, err := btcjson.MarshalCmd("1.0", 1, cmd)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(m.ctx, http.MethodPost,
+		node.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if node.user != "" || node.pass != "" {
+		req.SetBasicAuth(node.user, node.pass)
+	}
+
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var reply btcjson.Response
+	if err := json.NewDecoder(resp.Body).Decode(&reply); err != nil {
+		return fmt.Errorf("status code %d: %v", resp.StatusCode, err)
+	}
+	if reply.Error != nil {
+		return reply.Error
+	}
+	return json.Unmarshal(reply.Result, result)
+}
+
+// header returns the height and previous block hash of the block with the
+// passed hash as known by the watched node.
+func (m *forkMonitor) header(node *forkWatchNode, hash *chainhash.Hash) (int32, *chainhash.Hash, error) {
+	var header btcjson.GetBlockHeaderVerboseResult
+	cmd := btcjson.NewGetBlockHeaderCmd(hash.String(), btcjson.Bool(true))
+	if err := m.call(node, cmd, &header); err != nil {
+		return 0, nil, err
+	}
+	prevHash, err := chainhash.NewHashFromStr(header.PreviousHash)
+	if err != nil {
+		return 0, nil, err
+	}
+	return header.Height, prevHash, nil
+}
+
+// checkNode compares the best chain of the watched node against ours and
+// updates its state.
+func (m *forkMonitor) checkNode(node *forkWatchNode) {
+	var bestHashStr string
+	err := m.call(node, btcjson.NewGetBestBlockHashCmd(), &bestHashStr)
+	if err != nil {
+		m.updateNode(node, func() { node.err = err })
+		return
+	}
+	bestHash, err := chainhash.NewHashFromStr(bestHashStr)
+	if err != nil {
+		m.updateNode(node, func() { node.err = err })
+		return
+	}
+
+	// Walk the chain of the watched node back until a block on our main
+	// chain is found.  Give up once their side of a fork is deeper than
+	// the alert depth since the exact fork point doesn't matter then.
+	var height int32
+	hash := bestHash
+	theirDepth := int32(0)
+	for !m.chain.MainChainHasBlock(hash) {
+		blockHeight, prevHash, err := m.header(node, hash)
+		if err != nil {
+			m.updateNode(node, func() { node.err = err })
+			return
+		}
+		if theirDepth == 0 {
+			height = blockHeight
+		}
+		theirDepth++
+		if theirDepth > m.depth {
+			break
+		}
+		hash = prevHash
+	}
+
+	best := m.chain.BestSnapshot()
+	forkHeight := int32(-1)
+	if m.chain.MainChainHasBlock(hash) {
+		forkHeight, err = m.chain.BlockHeightByHash(hash)
+		if err != nil {
+			m.updateNode(node, func() { node.err = err })
+			return
+		}
+	} else if height > best.Height {
+		// The walk gave up before reaching our chain.  The chain of
+		// the watched node is still in sync with ours when it is more
+		// than the alert depth ahead on top of our tip.
+		var tipHashStr string
+		cmd := btcjson.NewGetBlockHashCmd(int64(best.Height))
+		if err := m.call(node, cmd, &tipHashStr); err != nil {
+			m.updateNode(node, func() { node.err = err })
+			return
+		}
+		if tipHashStr == best.Hash.String() {
+			forkHeight = best.Height
+			theirDepth = height - best.Height
+		}
+	}
+	if theirDepth == 0 {
+		height = forkHeight
+	}
+
+	// The chain of the watched node is ahead of ours when the blocks we
+	// don't have build on our tip.  Otherwise, the chains forked if both
+	// sides have blocks the other side doesn't.
+	var ourDepth int32
+	status := forkStatusSame
+	switch {
+	case forkHeight < 0:
+		ourDepth = -1
+		status = forkStatusForked
+	case theirDepth == 0 && forkHeight < best.Height:
+		ourDepth = best.Height - forkHeight
+		status = forkStatusBehind
+	case theirDepth > 0 && forkHeight == best.Height:
+		status = forkStatusAhead
+	case theirDepth > 0:
+		ourDepth = best.Height - forkHeight
+		status = forkStatusForked
+	}
+	alert := status == forkStatusForked &&
+		(theirDepth >= m.depth || ourDepth < 0 || ourDepth >= m.depth)
+
+	var raised *forkWatchNode
+	m.updateNode(node, func() {
+		if alert && !node.alert {
+			srvrLog.Warnf("Fork monitor: the chain of %s with tip "+
+				"%v (height %d) forked from ours at height %d "+
+				"-- our depth %d, their depth %d", node.name,
+				bestHash, height, forkHeight, ourDepth,
+				theirDepth)
+		} else if !alert && node.alert {
+			srvrLog.Infof("Fork monitor: the chain of %s no "+
+				"longer forks from ours", node.name)
+		}
+
+		node.err = nil
+		node.status = status
+		node.bestHash = *bestHash
+		node.height = height
+		node.forkHeight = forkHeight
+		node.ourDepth = ourDepth
+		node.theirDepth = theirDepth
+		if alert && !node.alert {
+			snapshot := *node
+			snapshot.alert = true
+			raised = &snapshot
+		}
+		node.alert = alert
+	})
+	if raised != nil && m.onAlert != nil {
+		m.onAlert(raised)
+	}
+}
+
+// updateNode applies the passed update to the state of the watched node while
+// holding the monitor lock.
+func (m *forkMonitor) updateNode(node *forkWatchNode, update func()) {
+	m.mtx.Lock()
+	update()
+	node.lastUpdate = time.Now()
+	if node.err != nil {
+		node.status = forkStatusUnknown
+		srvrLog.Debugf("Fork monitor: unable to query %s: %v",
+			node.name, node.err)
+	}
+	m.mtx.Unlock()
+}
+
+// monitorHandler checks the watched nodes every interval.  It must be run as a
+// goroutine.
+func (m *forkMonitor) monitorHandler() {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+out:
+	for {
+		// The chains are only compared once our chain is current since
+		// the watched nodes are naturally ahead while syncing.
+		if m.chain.IsCurrent() {
+			for _, node := range m.nodes {
+				m.checkNode(node)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-m.ctx.Done():
+			break out
+		}
+	}
+
+	m.wg.Done()
+}
+
+// results returns the state of all watched nodes.
+//
+// This function is safe for concurrent access.
+func (m *forkMonitor) results() []btcjson.ForkMonitorNodeResult {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	results := make([]btcjson.ForkMonitorNodeResult, 0, len(m.nodes))
+	for _, node := range m.nodes {
+		result := btcjson.ForkMonitorNodeResult{
+			Node:       node.name,
+			Status:     node.status,
+			ForkHeight: node.forkHeight,
+			OurDepth:   node.ourDepth,
+			TheirDepth: node.theirDepth,
+			Alert:      node.alert,
+			LastUpdate: node.lastUpdate.Unix(),
+		}
+		if node.lastUpdate.IsZero() {
+			result.LastUpdate = 0
+		}
+		if node.status != forkStatusUnknown {
+			result.BestHash = node.bestHash.String()
+			result.Height = node.height
+		}
+		if node.err != nil {
+			result.Error = node.err.Error()
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// Start begins monitoring the watched nodes.
+func (m *forkMonitor) Start() {
+	m.wg.Add(1)
+	go m.monitorHandler()
+}
+
+// Stop stops monitoring the watched nodes and waits for the monitor to finish.
+func (m *forkMonitor) Stop() {
+	m.cancel()
+	m.wg.Wait()
+}
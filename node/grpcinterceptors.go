@@ -0,0 +1,148 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/gcash/bchd/bchrpc"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// grpcInterceptors returns the chains of unary and streaming interceptors
+// every gRPC request passes through, outermost first:
+//
+//   - logging of requests and, when enabled, the audit log
+//   - prometheus metrics, when enabled
+//   - authentication and service readiness
+//   - panic recovery
+//
+// Panics are recovered innermost so they are seen by the other interceptors
+// as an ordinary error.
+func grpcInterceptors(metrics bool) ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
+	unary := []grpc.UnaryServerInterceptor{logUnary}
+	stream := []grpc.StreamServerInterceptor{logStreaming}
+	if metrics {
+		unary = append(unary, grpc_prometheus.UnaryServerInterceptor)
+		stream = append(stream, grpc_prometheus.StreamServerInterceptor)
+	}
+	unary = append(unary, authUnary, recoverUnary)
+	stream = append(stream, authStreaming, recoverStreaming)
+	return unary, stream
+}
+
+// serviceName returns the package.service segment from the full gRPC method
+// name `/package.service/method`.
+func serviceName(method string) string {
+	// Slice off first /
+	method = method[1:]
+	// Keep everything before the next /
+	return method[:strings.IndexRune(method, '/')]
+}
+
+// peerAddr returns the address of the client of a request.
+func peerAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+	return p.Addr.String()
+}
+
+// logRequest logs the outcome of a gRPC request.  With the audit log enabled
+// every request is logged with the way the client authenticated, the status
+// code and the duration.  Otherwise only the invocation and errors are logged.
+func logRequest(ctx context.Context, kind, method string, start time.Time, err error) {
+	addr := peerAddr(ctx)
+	if cfg.GrpcAuditLog {
+		auth, _ := authenticate(ctx)
+		grpcLog.Infof("Audit: %s method %s invoked by %s (auth %s): %s "+
+			"in %v", kind, method, addr, auth, status.Code(err),
+			time.Since(start))
+		return
+	}
+	if err != nil {
+		grpcLog.Errorf("%s method %s invoked by %s errored: %v", kind,
+			method, addr, err)
+	}
+}
+
+func logUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !cfg.GrpcAuditLog {
+		grpcLog.Infof("Unary method %s invoked by %s", info.FullMethod,
+			peerAddr(ctx))
+	}
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logRequest(ctx, "Unary", info.FullMethod, start, err)
+	return resp, err
+}
+
+func logStreaming(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !cfg.GrpcAuditLog {
+		grpcLog.Infof("Streaming method %s invoked by %s", info.FullMethod,
+			peerAddr(ss.Context()))
+	}
+	start := time.Now()
+	err := handler(srv, ss)
+	logRequest(ss.Context(), "Streaming", info.FullMethod, start, err)
+	return err
+}
+
+// checkRequest ensures the client is authenticated and the service of the
+// requested method is ready.
+func checkRequest(ctx context.Context, method string) error {
+	if _, err := authenticate(ctx); err != nil {
+		return err
+	}
+	return bchrpc.ServiceReady(serviceName(method))
+}
+
+func authUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := checkRequest(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func authStreaming(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := checkRequest(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// recoveredError logs a panic recovered while handling a gRPC request and
+// returns the error sent to the client in its place.
+func recoveredError(method string, r interface{}) error {
+	grpcLog.Errorf("Recovered from panic in method %s: %v\n%s", method, r,
+		debug.Stack())
+	return status.Errorf(codes.Internal, "internal error handling %s", method)
+}
+
+func recoverUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			resp, err = nil, recoveredError(info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+func recoverStreaming(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoveredError(info.FullMethod, r)
+		}
+	}()
+	return handler(srv, ss)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gcash/bchlog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// chainUnary calls the passed handler through the interceptors in the same
+// order as grpc.ChainUnaryInterceptor.
+func chainUnary(interceptors []grpc.UnaryServerInterceptor, ctx context.Context,
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+
+	if len(interceptors) == 0 {
+		return handler(ctx, nil)
+	}
+	return interceptors[0](ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return chainUnary(interceptors[1:], ctx, info, handler)
+	})
+}
+
+// TestGrpcInterceptors ensures requests are authenticated before reaching
+// the handler, that the handler runs exactly once and that a panicking handler
+// is turned into an internal error.
+func TestGrpcInterceptors(t *testing.T) {
+	oldCfg, oldLog := cfg, grpcLog
+	defer func() { cfg, grpcLog = oldCfg, oldLog }()
+	grpcLog = bchlog.Disabled
+
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo",
+	}
+	tokenCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(AuthenticationTokenKey, "secret"))
+
+	tests := []struct {
+		name     string
+		auditLog bool
+		ctx      context.Context
+		panics   bool
+		code     codes.Code
+		calls    int
+	}{
+		{
+			name:  "valid token",
+			ctx:   tokenCtx,
+			code:  codes.OK,
+			calls: 1,
+		},
+		{
+			name:     "valid token with audit log",
+			auditLog: true,
+			ctx:      tokenCtx,
+			code:     codes.OK,
+			calls:    1,
+		},
+		{
+			name:     "missing token",
+			auditLog: true,
+			ctx:      context.Background(),
+			code:     codes.Unauthenticated,
+		},
+		{
+			name:   "panic",
+			ctx:    tokenCtx,
+			panics: true,
+			code:   codes.Internal,
+			calls:  1,
+		},
+	}
+
+	for _, test := range tests {
+		cfg = &Config{
+			GrpcAuthToken: "secret",
+			GrpcAuditLog:  test.auditLog,
+		}
+		for _, metrics := range []bool{false, true} {
+			calls := 0
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				calls++
+				if test.panics {
+					panic("handler failure")
+				}
+				return "ok", nil
+			}
+
+			unary, _ := grpcInterceptors(metrics)
+			_, err := chainUnary(unary, test.ctx, info, handler)
+			if code := status.Code(err); code != test.code {
+				t.Errorf("%s (metrics %v): expected code %v, got %v",
+					test.name, metrics, test.code, code)
+			}
+			if calls != test.calls {
+				t.Errorf("%s (metrics %v): expected %d handler calls, "+
+					"got %d", test.name, metrics, test.calls, calls)
+			}
+		}
+	}
+}
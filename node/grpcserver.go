@@ -0,0 +1,145 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/gcash/bchd/bchrpc"
+	"github.com/gcash/bchd/database"
+	"github.com/gorilla/mux"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AuthenticationTokenKey is the key used in the context to authenticate clients.
+// If this is set to anything other than "" in the config, then the server expects
+// the client to set a key value in the context metadata to 'AuthenticationToken: cfg.AuthToken'
+const AuthenticationTokenKey = "AuthenticationToken"
+
+func newGrpcServer(listeners []net.Listener, rpcCfg *bchrpc.GrpcServerConfig, svr *server) (*bchrpc.GrpcServer, error) {
+	if len(listeners) == 0 {
+		return nil, nil
+	}
+
+	rpcCfg.NetMgr = svr
+	metrics := len(cfg.PrometheusListen) != 0
+	if metrics {
+		grpc_prometheus.EnableHandlingTimeHistogram()
+	}
+	unary, streaming := grpcInterceptors(metrics)
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(streaming...),
+		grpc.MaxRecvMsgSize(cfg.GrpcMaxRequestSize),
+	}
+	tlsConfig := svr.certManager.publicTLSConfig()
+	opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	server := grpc.NewServer(opts...)
+
+	allowAllOrigins := grpcweb.WithOriginFunc(func(origin string) bool {
+		return true
+	})
+	wrappedGrpc := grpcweb.WrapServer(server, allowAllOrigins)
+
+	rpcCfg.Server = server
+
+	handler := func(resp http.ResponseWriter, req *http.Request) {
+		if wrappedGrpc.IsGrpcWebRequest(req) || wrappedGrpc.IsAcceptableGrpcCorsRequest(req) {
+			wrappedGrpc.ServeHTTP(resp, req)
+		} else {
+			server.ServeHTTP(resp, req)
+		}
+	}
+
+	// A single http server serves all of the listeners.  The spec of the
+	// listener each request was received on is added to the request
+	// context so the per-listener authentication options can be applied.
+	httpServer := &http.Server{
+		Handler:     http.HandlerFunc(handler),
+		TLSConfig:   tlsConfig,
+		ConnContext: withConnListenerSpec,
+	}
+
+	rpcCfg.HTTPServer = httpServer
+
+	gRPCServer := bchrpc.NewGrpcServer(rpcCfg)
+
+	for _, listener := range listeners {
+		grpcLog.Infof("Experimental gRPC server listening on %s",
+			listener.Addr())
+
+		go func(listener net.Listener) {
+			if err := httpServer.ServeTLS(listener, "", ""); err != nil {
+				grpcLog.Tracef("Finished serving expimental gRPC: %v", err)
+			}
+		}(listener)
+	}
+
+	if metrics {
+		// init Prometheus metrics
+		grpc_prometheus.Register(server)
+		if statsDB, ok := svr.db.(database.StatsDB); ok {
+			prometheus.MustRegister(newDBStatsCollector(statsDB))
+		}
+
+		router := mux.NewRouter()
+		router.Handle("/metrics", promhttp.Handler())
+
+		prometheusHTTPServer := &http.Server{
+			Addr:         cfg.PrometheusListen,
+			Handler:      router,
+			ReadTimeout:  10 * time.Second,
+			WriteTimeout: 10 * time.Second,
+			TLSConfig:    svr.certManager.tlsConfig(),
+		}
+
+		go func() {
+			if err := prometheusHTTPServer.ListenAndServeTLS("", ""); err != nil {
+				grpcLog.Tracef("Finished serving Prometheus metrics %v", err)
+			}
+		}()
+	}
+
+	return gRPCServer, nil
+}
+
+// authenticate ensures the client supplied the authentication token required
+// by the listener the request was received on.  Listeners with the noauth
+// option don't require a token and listeners with the authtoken option require
+// their own token instead of the configured one.  It returns how the client
+// was authenticated for the audit log.
+func authenticate(ctx context.Context) (string, error) {
+	authToken := cfg.GrpcAuthToken
+	method := "token"
+	if spec := ctxListenerSpec(ctx); spec != nil {
+		if spec.hasOption(listenOptNoAuth) {
+			return "noauth listener", nil
+		}
+		if token, ok := spec.options[listenOptAuthToken]; ok {
+			authToken = token
+			method = "listener token"
+		}
+	}
+	if authToken == "" {
+		return "none", nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(AuthenticationTokenKey)) == 0 || md.Get(AuthenticationTokenKey)[0] != authToken {
+		return "rejected", status.Error(codes.Unauthenticated, "invalid authentication token")
+	}
+	return method, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"errors"
+	"net"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gcash/bchd/wire"
+)
+
+// checkVersionSanity returns an error when the passed version message holds
+// values no sane implementation sends.  The lengths of the message and the
+// user agent are already limited when the message is decoded.
+func checkVersionSanity(msg *wire.MsgVersion) error {
+	if msg.LastBlock < 0 {
+		return errors.New("negative start height")
+	}
+	if !utf8.ValidString(msg.UserAgent) {
+		return errors.New("user agent is not valid utf-8")
+	}
+	for _, r := range msg.UserAgent {
+		if !unicode.IsPrint(r) {
+			return errors.New("user agent contains unprintable characters")
+		}
+	}
+	return nil
+}
+
+// nodeAddr returns the address the node of the peer accepts connections on,
+// which identifies the node across connections in both directions, or an
+// empty string when it is unknown.  Inbound peers are only identified when
+// they advertise the port they listen on from a routable host since the
+// connections relayed by a local proxy all share its host.
+func (sp *serverPeer) nodeAddr() string {
+	if !sp.Inbound() {
+		return sp.Addr()
+	}
+	if sp.listenPort == 0 {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(sp.Addr())
+	if err != nil {
+		return ""
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsUnspecified() {
+		return ""
+	}
+	return net.JoinHostPort(host, strconv.Itoa(int(sp.listenPort)))
+}
+
+// isDuplicateOf returns whether the passed peers are connections to the same
+// node in opposite directions.  Inbound peers are never duplicates of each
+// other since the nodes behind the same NAT share the host and likely the port
+// they advertise.  The node must also advertise the same user agent and
+// services on both connections.
+func (sp *serverPeer) isDuplicateOf(other *serverPeer) bool {
+	if sp.Inbound() == other.Inbound() {
+		return false
+	}
+	addr := sp.nodeAddr()
+	return addr != "" && other.nodeAddr() == addr &&
+		sp.UserAgent() == other.UserAgent() &&
+		sp.Services() == other.Services()
+}
+
+// duplicatePeer returns a connected peer whose node is the same as the node of
+// the passed peer, if any.
+func (ps *peerState) duplicatePeer(sp *serverPeer) *serverPeer {
+	var dup *serverPeer
+	ps.forAllPeers(func(other *serverPeer) {
+		if dup == nil && other != sp && other.Connected() &&
+			sp.isDuplicateOf(other) {
+
+			dup = other
+		}
+	})
+	return dup
+}
+
+// preferConnection returns whether the connection to the passed peer is
+// preferred over the existing connection to the same node.  Persistent
+// connections are preferred over the others and outbound connections over
+// inbound ones since the node was chosen locally.  The existing connection is
+// kept otherwise.
+func preferConnection(sp, existing *serverPeer) bool {
+	if sp.persistent != existing.persistent {
+		return sp.persistent
+	}
+	return !sp.Inbound() && existing.Inbound()
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/peer"
+	"github.com/gcash/bchd/wire"
+)
+
+// TestCheckVersionSanity ensures version messages with values no sane
+// implementation sends are rejected.
+func TestCheckVersionSanity(t *testing.T) {
+	tests := []struct {
+		name      string
+		userAgent string
+		lastBlock int32
+		valid     bool
+	}{
+		{"valid", "/bchd:0.20.0/", 700000, true},
+		{"empty user agent", "", 0, true},
+		{"negative start height", "/bchd:0.20.0/", -1, false},
+		{"control character", "/bchd:0.20.0/\n", 0, false},
+		{"non-ascii", "/bchd:0.20.0(\xe2\x82\xac)/", 0, true},
+		{"invalid utf-8", "/bchd:0.20.0(\xe2\x82)/", 0, false},
+		{"unicode control character", "/bchd:0.20.0\u0085/", 0, false},
+	}
+	for _, test := range tests {
+		msg := &wire.MsgVersion{
+			UserAgent: test.userAgent,
+			LastBlock: test.lastBlock,
+		}
+		err := checkVersionSanity(msg)
+		if (err == nil) != test.valid {
+			t.Errorf("%s: unexpected result %v", test.name, err)
+		}
+	}
+}
+
+// TestPreferConnection ensures duplicate connections are collapsed to the
+// persistent or outbound connection.
+func TestPreferConnection(t *testing.T) {
+	peerCfg := &peer.Config{ChainParams: &chaincfg.MainNetParams}
+	newPeer := func(inbound, persistent bool) *serverPeer {
+		sp := newServerPeer(nil, persistent)
+		if inbound {
+			sp.Peer = peer.NewInboundPeer(peerCfg)
+			return sp
+		}
+		p, err := peer.NewOutboundPeer(peerCfg, "10.0.0.1:8333")
+		if err != nil {
+			t.Fatalf("NewOutboundPeer: %v", err)
+		}
+		sp.Peer = p
+		return sp
+	}
+	inbound := newPeer(true, false)
+	outbound := newPeer(false, false)
+	persistent := newPeer(false, true)
+
+	tests := []struct {
+		name         string
+		sp, existing *serverPeer
+		want         bool
+	}{
+		{"outbound over inbound", outbound, inbound, true},
+		{"inbound over outbound", inbound, outbound, false},
+		{"persistent over outbound", persistent, outbound, true},
+		{"outbound over persistent", outbound, persistent, false},
+		{"both inbound", inbound, newPeer(true, false), false},
+		{"both outbound", outbound, newPeer(false, false), false},
+	}
+	for _, test := range tests {
+		if got := preferConnection(test.sp, test.existing); got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
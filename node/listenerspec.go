@@ -0,0 +1,272 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// The following constants are the options which may be specified for a
+// listener in addition to its address.  Listener specs are written as the
+// address followed by any comma separated options, for example
+// ":8333,iface=wg0,whitelist" or "10.0.0.1:8335,noauth".
+const (
+	// listenOptIface binds the listener to the addresses of the named
+	// network interface.  The host part of the address must be empty when
+	// it is used.  It is valid for all listeners.
+	listenOptIface = "iface"
+
+	// listenOptWhitelist treats every peer connecting through the P2P
+	// listener as whitelisted.
+	listenOptWhitelist = "whitelist"
+
+	// listenOptLimited only allows the limited user on the RPC listener.
+	// Admin credentials are downgraded to limited access.
+	listenOptLimited = "limited"
+
+	// listenOptNoAuth disables the authentication token check on the gRPC
+	// listener.
+	listenOptNoAuth = "noauth"
+
+	// listenOptAuthToken overrides the gRPC authentication token on the
+	// gRPC listener.
+	listenOptAuthToken = "authtoken"
+)
+
+var (
+	// p2pListenOpts are the options which are valid for P2P listeners.
+	p2pListenOpts = []string{listenOptIface, listenOptWhitelist}
+
+	// rpcListenOpts are the options which are valid for RPC listeners.
+	rpcListenOpts = []string{listenOptIface, listenOptLimited}
+
+	// grpcListenOpts are the options which are valid for gRPC listeners.
+	grpcListenOpts = []string{listenOptIface, listenOptNoAuth,
+		listenOptAuthToken}
+)
+
+// listenerSpec describes a listener address along with the options which
+// apply to connections accepted by it.
+type listenerSpec struct {
+	addr    string
+	options map[string]string
+}
+
+// parseListenerSpec parses a listener spec in the form
+// '<address>[,<option>[=<value>]...]'.  The default port is added to the
+// address when it does not already specify one and only the passed options
+// are accepted.
+func parseListenerSpec(spec, defaultPort string, allowed []string) (*listenerSpec, error) {
+	fields := strings.Split(spec, ",")
+	ls := &listenerSpec{
+		addr:    normalizeAddress(strings.TrimSpace(fields[0]), defaultPort),
+		options: make(map[string]string),
+	}
+	for _, field := range fields[1:] {
+		name, value, _ := strings.Cut(strings.TrimSpace(field), "=")
+		if !slices.Contains(allowed, name) {
+			return nil, fmt.Errorf("listener option '%s' of '%s' is "+
+				"invalid -- supported options %v", name, spec, allowed)
+		}
+		ls.options[name] = value
+	}
+
+	if iface, ok := ls.options[listenOptIface]; ok {
+		host, _, err := net.SplitHostPort(ls.addr)
+		if err != nil {
+			return nil, err
+		}
+		if host != "" || iface == "" {
+			return nil, fmt.Errorf("listener '%s' must specify an "+
+				"interface name and no host along with the %s "+
+				"option", spec, listenOptIface)
+		}
+	}
+	if token, ok := ls.options[listenOptAuthToken]; ok && token == "" {
+		return nil, fmt.Errorf("listener '%s' must specify a token "+
+			"along with the %s option", spec, listenOptAuthToken)
+	}
+	return ls, nil
+}
+
+// normalizeListenerSpecs returns a new slice with all the passed listener
+// specs normalized with the given default port, and all duplicates removed.
+// An error is returned if any of the specs contain unsupported options.
+func normalizeListenerSpecs(specs []string, defaultPort string, allowed []string) ([]string, error) {
+	normalized := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		ls, err := parseListenerSpec(spec, defaultPort, allowed)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, ls.String())
+	}
+	return removeDuplicateAddresses(normalized), nil
+}
+
+// String returns the listener spec in its normalized string form.
+func (ls *listenerSpec) String() string {
+	names := make([]string, 0, len(ls.options))
+	for name := range ls.options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fields := make([]string, 0, len(names)+1)
+	fields = append(fields, ls.addr)
+	for _, name := range names {
+		if value := ls.options[name]; value != "" {
+			fields = append(fields, name+"="+value)
+			continue
+		}
+		fields = append(fields, name)
+	}
+	return strings.Join(fields, ",")
+}
+
+// hasOption returns whether the named option is set for the listener.
+func (ls *listenerSpec) hasOption(name string) bool {
+	_, ok := ls.options[name]
+	return ok
+}
+
+// netAddrs returns the network addresses the listener must be bound to.  When
+// the listener is bound to a network interface, an address is returned for
+// each of the IP addresses currently assigned to it.
+func (ls *listenerSpec) netAddrs() ([]net.Addr, error) {
+	iface, ok := ls.options[listenOptIface]
+	if !ok {
+		return parseListeners([]string{ls.addr})
+	}
+
+	_, port, err := net.SplitHostPort(ls.addr)
+	if err != nil {
+		return nil, err
+	}
+	netIface, err := net.InterfaceByName(iface)
+	if err != nil {
+		return nil, err
+	}
+	ifaceAddrs, err := netIface.Addrs()
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]string, 0, len(ifaceAddrs))
+	for _, ifaceAddr := range ifaceAddrs {
+		ipNet, ok := ifaceAddr.(*net.IPNet)
+		if !ok {
+			continue
+		}
+		host := ipNet.IP.String()
+		if ipNet.IP.IsLinkLocalUnicast() && ipNet.IP.To4() == nil {
+			host += "%" + iface
+		}
+		addrs = append(addrs, net.JoinHostPort(host, port))
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("interface %s has no IP addresses", iface)
+	}
+	return parseListeners(addrs)
+}
+
+// specListener wraps a net.Listener so the connections it accepts carry the
+// spec of the listener they were accepted by.
+type specListener struct {
+	net.Listener
+	spec *listenerSpec
+}
+
+// Accept waits for and returns the next connection wrapped as a specConn.
+//
+// This is part of the net.Listener interface.
+func (l *specListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &specConn{Conn: conn, spec: l.spec}, nil
+}
+
+// specConn is a connection accepted by a specListener.
+type specConn struct {
+	net.Conn
+	spec *listenerSpec
+}
+
+// connListenerSpec returns the spec of the listener the passed connection was
+// accepted by, unwrapping TLS connections as needed.  It returns nil when the
+// connection was not accepted by a specListener.
+func connListenerSpec(conn net.Conn) *listenerSpec {
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		conn = tlsConn.NetConn()
+	}
+	if sc, ok := conn.(*specConn); ok {
+		return sc.spec
+	}
+	return nil
+}
+
+// listenerSpecKey is the context key used to store the listener spec a
+// request was received on.
+type listenerSpecKey struct{}
+
+// withConnListenerSpec returns a copy of the passed context which carries the
+// spec of the listener the passed connection was accepted by.  It is intended
+// to be used as the ConnContext function of http servers.
+func withConnListenerSpec(ctx context.Context, conn net.Conn) context.Context {
+	spec := connListenerSpec(conn)
+	if spec == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, listenerSpecKey{}, spec)
+}
+
+// ctxListenerSpec returns the spec of the listener the request associated with
+// the passed context was received on, or nil if there is none.
+func ctxListenerSpec(ctx context.Context) *listenerSpec {
+	spec, _ := ctx.Value(listenerSpecKey{}).(*listenerSpec)
+	return spec
+}
+
+// listenSpecs binds listeners for all of the passed listener specs using the
+// passed listen function.  The returned listeners accept connections which
+// carry the spec of the listener.  Addresses which can't be bound are logged
+// and skipped.
+func listenSpecs(specs []string, listenFunc func(string, string) (net.Listener, error)) ([]net.Listener, error) {
+	listeners := make([]net.Listener, 0, len(specs)*2)
+	for _, s := range specs {
+		// The specs have already been normalized and validated, so
+		// allow every option here.
+		spec, err := parseListenerSpec(s, "", []string{listenOptIface,
+			listenOptWhitelist, listenOptLimited, listenOptNoAuth,
+			listenOptAuthToken})
+		if err != nil {
+			return nil, err
+		}
+		netAddrs, err := spec.netAddrs()
+		if err != nil {
+			return nil, err
+		}
+		for _, addr := range netAddrs {
+			listener, err := listenFunc(addr.Network(), addr.String())
+			if err != nil {
+				srvrLog.Warnf("Can't listen on %s: %v", addr, err)
+				continue
+			}
+			listeners = append(listeners, &specListener{
+				Listener: listener,
+				spec:     spec,
+			})
+		}
+	}
+	return listeners, nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"testing"
+)
+
+// TestNormalizeListenerSpecs ensures listener specs are normalized and only
+// the options supported by the kind of listener are accepted.
+func TestNormalizeListenerSpecs(t *testing.T) {
+	tests := []struct {
+		name    string
+		specs   []string
+		allowed []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "plain addresses",
+			specs:   []string{"127.0.0.1", ":8333", "127.0.0.1:8333"},
+			allowed: p2pListenOpts,
+			want:    []string{"127.0.0.1:8333", ":8333"},
+		},
+		{
+			name:    "sorted options",
+			specs:   []string{":8335, noauth ,iface=eth1"},
+			allowed: grpcListenOpts,
+			want:    []string{":8335,iface=eth1,noauth"},
+		},
+		{
+			name:    "auth token",
+			specs:   []string{"10.0.0.1,authtoken=secret"},
+			allowed: grpcListenOpts,
+			want:    []string{"10.0.0.1:8333,authtoken=secret"},
+		},
+		{
+			name:    "unsupported option",
+			specs:   []string{":8334,whitelist"},
+			allowed: rpcListenOpts,
+			wantErr: true,
+		},
+		{
+			name:    "interface with host",
+			specs:   []string{"10.0.0.1:8333,iface=wg0"},
+			allowed: p2pListenOpts,
+			wantErr: true,
+		},
+		{
+			name:    "interface without name",
+			specs:   []string{":8333,iface"},
+			allowed: p2pListenOpts,
+			wantErr: true,
+		},
+		{
+			name:    "auth token without token",
+			specs:   []string{":8335,authtoken"},
+			allowed: grpcListenOpts,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := normalizeListenerSpecs(test.specs, "8333", test.allowed)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected error, got none", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if len(got) != len(test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("%s: got %v, want %v", test.name, got,
+					test.want)
+				break
+			}
+		}
+	}
+}
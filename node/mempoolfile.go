@@ -0,0 +1,82 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+)
+
+// mempoolFileName is the name of the file in the data directory the mempool is
+// saved to on shutdown and loaded from on startup.
+const mempoolFileName = "mempool.dat"
+
+// loadMempool loads the transactions saved to the mempool file on the last
+// shutdown into the mempool.  They are validated again against the current best
+// chain, which is the one they were saved with unless the data directory was
+// changed in between.  The loaded transactions are not announced since the
+// peers were connected to while they were saved have likely seen them already.
+// It must be run as a goroutine.
+func (s *server) loadMempool() {
+	defer s.wg.Done()
+
+	// The mempool is only saved on shutdown once it was loaded so a
+	// shutdown during the load doesn't lose the transactions not loaded
+	// yet.
+	defer atomic.StoreInt32(&s.mempoolLoaded, 1)
+
+	path := filepath.Join(cfg.DataDir, mempoolFileName)
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		srvrLog.Warnf("Unable to open the mempool file: %v", err)
+		return
+	}
+	defer f.Close()
+
+	start := time.Now()
+	accepted, read, err := s.txMemPool.Load(bufio.NewReader(f))
+	if err != nil {
+		srvrLog.Warnf("Unable to load the mempool from %s: %v", path,
+			err)
+		return
+	}
+	srvrLog.Infof("Loaded %d of %d transactions into the mempool from %s "+
+		"in %v", len(accepted), read, path,
+		time.Since(start).Round(time.Millisecond))
+}
+
+// saveMempool saves the transactions of the mempool to the mempool file so
+// they are loaded again on the next startup.  The file is replaced atomically
+// so a failure doesn't leave a truncated file behind.
+func (s *server) saveMempool() error {
+	path := filepath.Join(cfg.DataDir, mempoolFileName)
+	tmpPath := path + ".new"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	err = s.txMemPool.Save(w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gcash/bchd/btcjson"
+	"github.com/gcash/bchd/mempool"
+)
+
+const (
+	// mempoolSeedTimeout is the maximum time pulling the mempool snapshot
+	// from the seed node may take.
+	mempoolSeedTimeout = 5 * time.Minute
+
+	// mempoolSeedPollInterval is the interval between checks whether the
+	// chain is synced before the mempool is seeded.
+	mempoolSeedPollInterval = 5 * time.Second
+)
+
+// mempoolSeedNode houses the node the mempool is seeded from.
+type mempoolSeedNode struct {
+	// name identifies the node in logs.  It never includes the
+	// credentials.
+	name string
+	url  string
+	user string
+	pass string
+}
+
+// parseMempoolSeed parses a mempool seed node in the form
+// 'http[s]://[user:pass@]host:port'.
+func parseMempoolSeed(spec string) (*mempoolSeedNode, error) {
+	u, err := url.Parse(spec)
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("mempool seed node '%s' must be an "+
+			"http or https URL", spec)
+	}
+
+	node := &mempoolSeedNode{name: u.Scheme + "://" + u.Host}
+	if u.User != nil {
+		node.user = u.User.Username()
+		node.pass, _ = u.User.Password()
+	}
+	u.User = nil
+	node.url = u.String()
+	return node, nil
+}
+
+// fetchSnapshot pulls the mempool snapshot of the node through the
+// getmempoolsnapshot RPC.  The certificate of an https node is verified against
+// the certificates in the passed file, or against the system ones when it is
+// empty.  The request is aborted when the passed context is cancelled.
+func (node *mempoolSeedNode) fetchSnapshot(ctx context.Context, certFile string) ([]*mempool.SnapshotEntry, error) {
+	tlsConfig, err := rpcClientTLSConfig(certFile)
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	body, err := btcjson.MarshalCmd("1.0", 1,
+		btcjson.NewGetMempoolSnapshotCmd())
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, node.url,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if node.user != "" || node.pass != "" {
+		req.SetBasicAuth(node.user, node.pass)
+	}
+
+	client := &http.Client{
+		Timeout:   mempoolSeedTimeout,
+		Transport: transport,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var reply btcjson.Response
+	if err := json.NewDecoder(resp.Body).Decode(&reply); err != nil {
+		return nil, fmt.Errorf("status code %d: %v", resp.StatusCode, err)
+	}
+	if reply.Error != nil {
+		return nil, reply.Error
+	}
+	var hexSnapshot string
+	if err := json.Unmarshal(reply.Result, &hexSnapshot); err != nil {
+		return nil, err
+	}
+	serialized, err := hex.DecodeString(hexSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	return mempool.ReadSnapshot(bytes.NewReader(serialized))
+}
+
+// seedMempool pre-fills the mempool with the transactions of the configured
+// seed node once the chain is synced, since the transactions spend outputs of
+// the current best chain.  It must be run as a goroutine.
+func (s *server) seedMempool() {
+	defer s.wg.Done()
+
+	node, err := parseMempoolSeed(cfg.MempoolSeed)
+	if err != nil {
+		srvrLog.Warnf("Unable to seed the mempool: %v", err)
+		return
+	}
+
+	for !s.syncManager.IsCurrent() {
+		select {
+		case <-time.After(mempoolSeedPollInterval):
+		case <-s.quit:
+			return
+		}
+	}
+
+	// Abort pulling the snapshot on shutdown.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	start := time.Now()
+	entries, err := node.fetchSnapshot(ctx, cfg.MempoolSeedRPCCert)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		srvrLog.Warnf("Unable to fetch the mempool snapshot of %s: %v",
+			node.name, err)
+		return
+	}
+	accepted := s.txMemPool.ImportSnapshot(entries)
+	s.AnnounceNewTransactions(accepted)
+	srvrLog.Infof("Seeded the mempool with %d of %d transactions from %s "+
+		"in %v", len(accepted), len(entries), node.name,
+		time.Since(start).Round(time.Millisecond))
+}
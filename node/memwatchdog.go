@@ -0,0 +1,205 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gcash/bchd/blockchain"
+)
+
+const (
+	// memoryWatchdogInterval is the interval at which the memory usage is
+	// compared against the ceiling set with --memoryceiling.
+	memoryWatchdogInterval = time.Second * 10
+
+	// memoryStageHysteresis is the number of percentage points of the
+	// ceiling the memory usage has to drop below the threshold of a stage
+	// before the stage is left, so the node doesn't flap between stages.
+	memoryStageHysteresis = 5
+
+	// procSelfStatmPath is the path of the file describing the memory of
+	// the process on Linux.
+	procSelfStatmPath = "/proc/self/statm"
+)
+
+// memoryStage is a stage of the degradation applied by the memory watchdog as
+// the memory usage approaches the ceiling.  Each stage also applies the
+// degradations of the lower stages.
+type memoryStage int
+
+// These constants define the stages of the memory watchdog.
+const (
+	// memoryStageNormal is the stage in which nothing is degraded.
+	memoryStageNormal memoryStage = iota
+
+	// memoryStageShrinkCaches shrinks the signature cache to a quarter of
+	// its size and clears the hash cache.
+	memoryStageShrinkCaches
+
+	// memoryStageFlushUtxos flushes the UTXO cache to the database at each
+	// check.
+	memoryStageFlushUtxos
+
+	// memoryStageTightenMempool evicts the orphans and rejects free
+	// transactions.
+	memoryStageTightenMempool
+
+	// memoryStageRejectInbound rejects new inbound peers which aren't
+	// whitelisted.
+	memoryStageRejectInbound
+)
+
+// memoryStageThresholds holds the percentage of the ceiling at which each
+// stage is entered.
+var memoryStageThresholds = [...]uint64{
+	memoryStageNormal:         0,
+	memoryStageShrinkCaches:   70,
+	memoryStageFlushUtxos:     80,
+	memoryStageTightenMempool: 90,
+	memoryStageRejectInbound:  95,
+}
+
+// String returns the stage in human-readable form.
+func (stage memoryStage) String() string {
+	switch stage {
+	case memoryStageNormal:
+		return "normal"
+	case memoryStageShrinkCaches:
+		return "shrink caches"
+	case memoryStageFlushUtxos:
+		return "flush UTXO cache"
+	case memoryStageTightenMempool:
+		return "tighten mempool"
+	case memoryStageRejectInbound:
+		return "reject inbound peers"
+	}
+	return "unknown memory stage (" + strconv.Itoa(int(stage)) + ")"
+}
+
+// nextMemoryStage returns the stage for the passed memory usage in bytes given
+// the ceiling and the current stage.  A stage is entered once the usage reaches
+// its threshold and only left once the usage drops below the threshold by the
+// hysteresis.
+func nextMemoryStage(usage, ceiling uint64, current memoryStage) memoryStage {
+	percent := usage * 100 / ceiling
+	stage := memoryStageNormal
+	for s := memoryStageRejectInbound; s > memoryStageNormal; s-- {
+		threshold := memoryStageThresholds[s]
+		if s <= current {
+			threshold -= memoryStageHysteresis
+		}
+		if percent >= threshold {
+			stage = s
+			break
+		}
+	}
+	return stage
+}
+
+// processMemoryUsage returns the memory used by the process in bytes, which is
+// the larger of the Go heap and the resident set.  The memory obtained from
+// the OS by the Go runtime is used instead of the resident set when the latter
+// can't be read, which is the case on other systems than Linux.
+func processMemoryUsage() uint64 {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	rss := stats.Sys
+	if contents, err := os.ReadFile(procSelfStatmPath); err == nil {
+		fields := strings.Fields(string(contents))
+		if len(fields) > 1 {
+			pages, err := strconv.ParseUint(fields[1], 10, 64)
+			if err == nil {
+				rss = pages * uint64(os.Getpagesize())
+			}
+		}
+	}
+	if stats.HeapAlloc > rss {
+		return stats.HeapAlloc
+	}
+	return rss
+}
+
+// setMemoryStage applies the degradations of the passed stage which weren't
+// applied in the current stage and lifts the ones of the current stage which
+// don't apply in the passed one.
+func (s *server) setMemoryStage(current, stage memoryStage) {
+	applies := func(st memoryStage) bool {
+		return current < st && stage >= st
+	}
+	lifted := func(st memoryStage) bool {
+		return current >= st && stage < st
+	}
+
+	if applies(memoryStageShrinkCaches) {
+		s.sigCache.SetMaxEntries(cfg.SigCacheMaxSize / 4)
+		s.hashCache.Clear()
+		debug.FreeOSMemory()
+	}
+	if lifted(memoryStageShrinkCaches) {
+		s.sigCache.SetMaxEntries(cfg.SigCacheMaxSize)
+	}
+	if applies(memoryStageTightenMempool) {
+		s.txMemPool.SetConstrained(true)
+	}
+	if lifted(memoryStageTightenMempool) {
+		s.txMemPool.SetConstrained(false)
+	}
+	if applies(memoryStageRejectInbound) {
+		atomic.StoreInt32(&s.rejectInbound, 1)
+	}
+	if lifted(memoryStageRejectInbound) {
+		atomic.StoreInt32(&s.rejectInbound, 0)
+	}
+}
+
+// memoryWatchdog monitors the memory usage of the process against the ceiling
+// set with --memoryceiling and degrades the node in stages as it approaches
+// the ceiling rather than letting the OS kill the process once it runs out of
+// memory.  It must be run as a goroutine.
+func (s *server) memoryWatchdog() {
+	defer s.wg.Done()
+
+	ceiling := cfg.MemoryCeiling * 1024 * 1024
+	stage := memoryStageNormal
+	ticker := time.NewTicker(memoryWatchdogInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			usage := processMemoryUsage()
+			next := nextMemoryStage(usage, ceiling, stage)
+			if next != stage {
+				logf := srvrLog.Warnf
+				if next < stage {
+					logf = srvrLog.Infof
+				}
+				logf("Memory usage of %d MiB is at %d%% of the "+
+					"ceiling, changing memory stage from "+
+					"%v to %v", usage/(1024*1024),
+					usage*100/ceiling, stage, next)
+				s.setMemoryStage(stage, next)
+				stage = next
+			}
+			if stage >= memoryStageFlushUtxos {
+				err := s.chain.FlushCachedState(
+					blockchain.FlushRequired)
+				if err != nil {
+					srvrLog.Errorf("Unable to flush the UTXO "+
+						"cache: %v", err)
+				}
+			}
+
+		case <-s.quit:
+			return
+		}
+	}
+}
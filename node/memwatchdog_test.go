@@ -0,0 +1,35 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import "testing"
+
+// TestNextMemoryStage ensures the memory stages are entered at their
+// thresholds and only left below the hysteresis.
+func TestNextMemoryStage(t *testing.T) {
+	const ceiling = 1000
+	tests := []struct {
+		usage   uint64
+		current memoryStage
+		want    memoryStage
+	}{
+		{500, memoryStageNormal, memoryStageNormal},
+		{700, memoryStageNormal, memoryStageShrinkCaches},
+		{850, memoryStageNormal, memoryStageFlushUtxos},
+		{990, memoryStageShrinkCaches, memoryStageRejectInbound},
+		{920, memoryStageRejectInbound, memoryStageRejectInbound},
+		{890, memoryStageRejectInbound, memoryStageTightenMempool},
+		{660, memoryStageShrinkCaches, memoryStageShrinkCaches},
+		{640, memoryStageShrinkCaches, memoryStageNormal},
+		{1500, memoryStageNormal, memoryStageRejectInbound},
+	}
+	for _, test := range tests {
+		got := nextMemoryStage(test.usage, ceiling, test.current)
+		if got != test.want {
+			t.Errorf("usage %d in stage %v: got stage %v, want %v",
+				test.usage, test.current, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gcash/bchd/blockchain"
+)
+
+// mockClock is a clock whose time can be fixed with the setmocktime RPC on
+// regtest and simnet.  It is shared by the median time source, the mempool and
+// the peers so tests can control the timestamps of the blocks generated, how
+// far in the future blocks are accepted, and thereby the activation of the
+// upgrades activated by median time past, as well as the expiration and rate
+// limiting behavior of the mempool and the ping and stall timeouts of the
+// peers.
+type mockClock struct {
+	// mockTime is the unix time returned as the current time, or 0 when the
+	// time isn't mocked.  It must only be accessed atomically and only be
+	// written with mtx held.
+	mockTime int64
+
+	mtx     sync.Mutex
+	tickers map[*mockClockTicker]struct{}
+}
+
+// Ensure the mockClock type implements the blockchain.Clock interface.
+var _ blockchain.Clock = (*mockClock)(nil)
+
+// newMockClock returns a new clock which returns the system time until the
+// time is mocked.
+func newMockClock() *mockClock {
+	return &mockClock{
+		tickers: make(map[*mockClockTicker]struct{}),
+	}
+}
+
+// Now returns the mocked time if set, or the system time otherwise.
+//
+// This is part of the blockchain.Clock interface implementation.
+func (m *mockClock) Now() time.Time {
+	if mockTime := atomic.LoadInt64(&m.mockTime); mockTime != 0 {
+		return time.Unix(mockTime, 0)
+	}
+	return time.Now()
+}
+
+// NewTicker returns a ticker which follows the system clock while the time
+// isn't mocked, and fires as the mocked time is moved past each multiple of the
+// passed interval otherwise.
+//
+// This is part of the blockchain.Clock interface implementation.
+func (m *mockClock) NewTicker(d time.Duration) blockchain.Ticker {
+	t := &mockClockTicker{
+		clock:  m,
+		ticker: time.NewTicker(d),
+		c:      make(chan time.Time, 1),
+		period: d,
+		quit:   make(chan struct{}),
+	}
+
+	m.mtx.Lock()
+	if mockTime := atomic.LoadInt64(&m.mockTime); mockTime != 0 {
+		t.next = time.Unix(mockTime, 0).Add(d)
+	}
+	m.tickers[t] = struct{}{}
+	m.mtx.Unlock()
+
+	go t.systemTickHandler()
+	return t
+}
+
+// setMockTime fixes the current time to the passed unix time and fires the
+// tickers whose interval elapsed since the previously mocked time.  Passing 0
+// restores the system time.
+func (m *mockClock) setMockTime(mockTime int64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	mocked := atomic.LoadInt64(&m.mockTime) != 0
+	atomic.StoreInt64(&m.mockTime, mockTime)
+	if mockTime == 0 {
+		return
+	}
+	now := time.Unix(mockTime, 0)
+	for t := range m.tickers {
+		// The intervals start over once the time is mocked.
+		if !mocked {
+			t.next = now.Add(t.period)
+			continue
+		}
+		for !t.next.After(now) {
+			t.tick(t.next)
+			t.next = t.next.Add(t.period)
+		}
+	}
+}
+
+// mockClockTicker implements the blockchain.Ticker interface for a mockClock.
+type mockClockTicker struct {
+	clock  *mockClock
+	ticker *time.Ticker
+	c      chan time.Time
+	period time.Duration
+	quit   chan struct{}
+
+	// next is the mocked time of the next tick.  It is protected by the
+	// mutex of the clock.
+	next time.Time
+}
+
+// tick delivers the passed time on the channel of the ticker.  Like a
+// time.Ticker, the tick is dropped when the channel isn't drained.
+func (t *mockClockTicker) tick(now time.Time) {
+	select {
+	case t.c <- now:
+	default:
+	}
+}
+
+// systemTickHandler forwards the ticks of the system clock while the time isn't
+// mocked.  It must be run as a goroutine.
+func (t *mockClockTicker) systemTickHandler() {
+	for {
+		select {
+		case now := <-t.ticker.C:
+			if atomic.LoadInt64(&t.clock.mockTime) == 0 {
+				t.tick(now)
+			}
+
+		case <-t.quit:
+			return
+		}
+	}
+}
+
+// Chan returns the channel the ticks are delivered on.
+//
+// This is part of the blockchain.Ticker interface implementation.
+func (t *mockClockTicker) Chan() <-chan time.Time {
+	return t.c
+}
+
+// Stop turns off the ticker.
+//
+// This is part of the blockchain.Ticker interface implementation.
+func (t *mockClockTicker) Stop() {
+	t.clock.mtx.Lock()
+	_, ok := t.clock.tickers[t]
+	delete(t.clock.tickers, t)
+	t.clock.mtx.Unlock()
+
+	if ok {
+		t.ticker.Stop()
+		close(t.quit)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"testing"
+	"time"
+)
+
+// TestMockClock ensures the mock clock returns the mocked time while it is set
+// and the system time otherwise.
+func TestMockClock(t *testing.T) {
+	clock := newMockClock()
+
+	mockTime := time.Unix(1234567890, 0)
+	clock.setMockTime(mockTime.Unix())
+	if got := clock.Now(); !got.Equal(mockTime) {
+		t.Fatalf("Now: unexpected mocked time - got %v, want %v", got,
+			mockTime)
+	}
+
+	clock.setMockTime(0)
+	got := clock.Now()
+	if diff := time.Since(got); diff < -time.Minute || diff > time.Minute {
+		t.Fatalf("Now: unexpected time after clearing the mocked time "+
+			"- got %v, want about %v", got, time.Now())
+	}
+}
+
+// TestMockClockTicker ensures the tickers of the mock clock fire as the mocked
+// time is advanced past their interval.
+func TestMockClockTicker(t *testing.T) {
+	clock := newMockClock()
+	clock.setMockTime(1000)
+
+	ticker := clock.NewTicker(time.Minute)
+	defer ticker.Stop()
+	expectTick := func(want bool) {
+		t.Helper()
+		select {
+		case <-ticker.Chan():
+			if !want {
+				t.Fatal("unexpected tick")
+			}
+		default:
+			if want {
+				t.Fatal("expected a tick")
+			}
+		}
+	}
+
+	clock.setMockTime(1030)
+	expectTick(false)
+	clock.setMockTime(1060)
+	expectTick(true)
+
+	// Ticks which aren't received are dropped.
+	clock.setMockTime(1300)
+	expectTick(true)
+	expectTick(false)
+
+	// Moving the time backwards doesn't fire the ticker.
+	clock.setMockTime(1200)
+	expectTick(false)
+	clock.setMockTime(1360)
+	expectTick(true)
+
+	// Stopped tickers don't fire.
+	ticker.Stop()
+	clock.setMockTime(2000)
+	expectTick(false)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/wire"
+)
+
+// messageUsage describes how much of the protocol limit which applies to a
+// message is used by it.
+type messageUsage struct {
+	used  uint64
+	limit uint64
+	unit  string
+}
+
+// exceeds returns whether the usage reaches the passed percentage of the limit.
+func (u *messageUsage) exceeds(threshold uint32) bool {
+	return u.used*100 >= u.limit*uint64(threshold)
+}
+
+// percent returns the percentage of the limit which is used.
+func (u *messageUsage) percent() uint64 {
+	return u.used * 100 / u.limit
+}
+
+// messageLimitUsage returns the usage of the protocol limit which applies to
+// the passed message, or nil when the message isn't subject to a limit worth
+// auditing.  Blocks are limited by the passed excessive block size.
+func messageLimitUsage(msg wire.Message, excessiveBlockSize uint32) *messageUsage {
+	switch m := msg.(type) {
+	case *wire.MsgAddr:
+		return &messageUsage{uint64(len(m.AddrList)), wire.MaxAddrPerMsg,
+			"addresses"}
+	case *wire.MsgInv:
+		return &messageUsage{uint64(len(m.InvList)), wire.MaxInvPerMsg,
+			"inventory vectors"}
+	case *wire.MsgGetData:
+		return &messageUsage{uint64(len(m.InvList)), wire.MaxInvPerMsg,
+			"inventory vectors"}
+	case *wire.MsgNotFound:
+		return &messageUsage{uint64(len(m.InvList)), wire.MaxInvPerMsg,
+			"inventory vectors"}
+	case *wire.MsgHeaders:
+		return &messageUsage{uint64(len(m.Headers)),
+			wire.MaxBlockHeadersPerMsg, "headers"}
+	case *wire.MsgTx:
+		return &messageUsage{uint64(m.SerializeSize()),
+			blockchain.MaxTransactionSize, "bytes"}
+	case *wire.MsgBlock:
+		return &messageUsage{uint64(m.SerializeSize()),
+			uint64(excessiveBlockSize), "bytes"}
+	}
+	return nil
+}
+
+// auditMessage logs the passed message read from the peer when it approaches
+// the protocol limit which applies to it, and returns an error rejecting the
+// message when such messages are rejected.  Other node implementations may
+// enforce the limits more strictly, or differently, so such messages hint at
+// interoperability issues.
+func (sp *serverPeer) auditMessage(msg wire.Message) error {
+	usage := messageLimitUsage(msg, cfg.ExcessiveBlockSize)
+	if usage == nil || !usage.exceeds(cfg.AuditThreshold) {
+		return nil
+	}
+
+	peerLog.Warnf("Peer %s (%s) sent %s message with %d %s, %d%% of the "+
+		"limit of %d", sp, sp.UserAgent(), msg.Command(), usage.used,
+		usage.unit, usage.percent(), usage.limit)
+	if !cfg.AuditReject {
+		return nil
+	}
+	peerLog.Infof("Disconnecting peer %s for sending a message "+
+		"approaching the protocol limits", sp)
+	return fmt.Errorf("message with %d %s exceeds %d%% of the limit of %d",
+		usage.used, usage.unit, cfg.AuditThreshold, usage.limit)
+}
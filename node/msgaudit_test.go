@@ -0,0 +1,55 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/wire"
+)
+
+// TestMessageLimitUsage ensures the usage of the protocol limits by messages
+// is measured and compared against the audit threshold as expected.
+func TestMessageLimitUsage(t *testing.T) {
+	addr := wire.NewMsgAddr()
+	for i := 0; i < 900; i++ {
+		addr.AddAddress(&wire.NetAddress{})
+	}
+	inv := wire.NewMsgInv()
+	inv.AddInvVect(&wire.InvVect{})
+
+	tx := wire.NewMsgTx(1)
+	tx.AddTxOut(wire.NewTxOut(0, make([]byte, 500000), wire.TokenData{}))
+
+	tests := []struct {
+		name    string
+		msg     wire.Message
+		used    uint64
+		limit   uint64
+		exceeds bool
+	}{
+		{"addr", addr, 900, wire.MaxAddrPerMsg, true},
+		{"inv", inv, 1, wire.MaxInvPerMsg, false},
+		{"tx", tx, uint64(tx.SerializeSize()), 1000000, false},
+	}
+	for _, test := range tests {
+		usage := messageLimitUsage(test.msg, defaultExcessiveBlockSize)
+		if usage == nil {
+			t.Fatalf("%s: no usage", test.name)
+		}
+		if usage.used != test.used || usage.limit != test.limit {
+			t.Fatalf("%s: usage %d of %d, want %d of %d", test.name,
+				usage.used, usage.limit, test.used, test.limit)
+		}
+		if usage.exceeds(defaultAuditThreshold) != test.exceeds {
+			t.Fatalf("%s: exceeds %v, want %v", test.name,
+				!test.exceeds, test.exceeds)
+		}
+	}
+
+	if usage := messageLimitUsage(wire.NewMsgPing(0), 0); usage != nil {
+		t.Fatalf("ping: unexpected usage %+v", usage)
+	}
+}
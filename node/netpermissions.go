@@ -0,0 +1,171 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// netPermissions is a set of permissions granted to the peers connecting from
+// or to whitelisted IP addresses and networks.
+type netPermissions uint8
+
+const (
+	// permNoBan prevents the peer from being banned or disconnected for
+	// misbehaving.
+	permNoBan netPermissions = 1 << iota
+
+	// permRelay accepts transactions relayed by the peer even when the
+	// node only relays blocks.
+	permRelay
+
+	// permForceRelay accepts transactions relayed by the peer even when
+	// they are not standard and without rate limiting free transactions.
+	// It implies permRelay.
+	permForceRelay
+
+	// permMempool allows the peer to request the contents of the mempool
+	// without a bloom filter loaded, even when bloom filtering is
+	// disabled.
+	permMempool
+
+	// permDownload allows the peer to request any amount of blocks and
+	// transactions without increasing its ban score.
+	permDownload
+
+	// permAll is the set of all permissions.
+	permAll = permNoBan | permRelay | permForceRelay | permMempool |
+		permDownload
+
+	// permDefault is the set of permissions granted by whitelist entries
+	// and listeners which don't specify any.
+	permDefault = permNoBan
+)
+
+// netPermissionNames maps the names used to configure permissions to the
+// permissions, in the order they are displayed.
+var netPermissionNames = []struct {
+	name string
+	perm netPermissions
+}{
+	{"noban", permNoBan},
+	{"relay", permRelay},
+	{"forcerelay", permForceRelay},
+	{"mempool", permMempool},
+	{"download", permDownload},
+}
+
+// has returns whether all the passed permissions are in the set.
+func (p netPermissions) has(perm netPermissions) bool {
+	return p&perm == perm
+}
+
+// names returns the names of the permissions in the set.
+func (p netPermissions) names() []string {
+	names := make([]string, 0, len(netPermissionNames))
+	for _, n := range netPermissionNames {
+		if p.has(n.perm) {
+			names = append(names, n.name)
+		}
+	}
+	return names
+}
+
+// parseNetPermissions parses a comma separated list of permission names.  The
+// name "all" grants every permission.
+func parseNetPermissions(s string) (netPermissions, error) {
+	var perms netPermissions
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "all" {
+			perms |= permAll
+			continue
+		}
+		found := false
+		for _, n := range netPermissionNames {
+			if n.name == name {
+				perms |= n.perm
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("unknown permission '%s'", name)
+		}
+	}
+	if perms.has(permForceRelay) {
+		perms |= permRelay
+	}
+	return perms, nil
+}
+
+// netWhitelist is a whitelisted IP network along with the permissions granted
+// to the peers in it.
+type netWhitelist struct {
+	*net.IPNet
+	perms netPermissions
+}
+
+// parseNetWhitelists parses the passed whitelist entries.  Each entry is an IP
+// address or network optionally prefixed by a comma separated list of
+// permissions and an '@', for example "noban,mempool@192.168.1.0/24".  Entries
+// without permissions grant permDefault.
+func parseNetWhitelists(entries []string) ([]netWhitelist, error) {
+	whitelists := make([]netWhitelist, 0, len(entries))
+	for _, entry := range entries {
+		perms := permDefault
+		addr := entry
+		if i := strings.LastIndex(entry, "@"); i >= 0 {
+			var err error
+			perms, err = parseNetPermissions(entry[:i])
+			if err != nil {
+				return nil, fmt.Errorf("The whitelist value of "+
+					"'%s' is invalid: %v", entry, err)
+			}
+			addr = entry[i+1:]
+		}
+		ipnets, err := parseWhitelists([]string{addr})
+		if err != nil {
+			return nil, err
+		}
+		whitelists = append(whitelists, netWhitelist{
+			IPNet: ipnets[0],
+			perms: perms,
+		})
+	}
+	return whitelists, nil
+}
+
+// whitelistPermissions returns the permissions granted to the passed address
+// by all the whitelist entries which include it.
+func whitelistPermissions(addr net.Addr) netPermissions {
+	reloadMtx.RLock()
+	whitelists := cfg.whitelists
+	reloadMtx.RUnlock()
+	if len(whitelists) == 0 {
+		return 0
+	}
+
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		srvrLog.Warnf("Unable to SplitHostPort on '%s': %v", addr, err)
+		return 0
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		srvrLog.Warnf("Unable to parse IP '%s'", addr)
+		return 0
+	}
+
+	var perms netPermissions
+	for _, whitelist := range whitelists {
+		if whitelist.Contains(ip) {
+			perms |= whitelist.perms
+		}
+	}
+	return perms
+}
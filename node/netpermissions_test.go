@@ -0,0 +1,77 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"net"
+	"reflect"
+	"testing"
+)
+
+// TestParseNetWhitelists ensures whitelist entries are parsed with the
+// permissions they grant and that the permissions of all the entries matching
+// an address are combined.
+func TestParseNetWhitelists(t *testing.T) {
+	tests := []struct {
+		entry string
+		perms netPermissions
+		valid bool
+	}{
+		{entry: "10.0.0.0/8", perms: permDefault, valid: true},
+		{entry: "mempool@10.0.0.1", perms: permMempool, valid: true},
+		{entry: "noban, download@::1", perms: permNoBan | permDownload, valid: true},
+		{entry: "forcerelay@10.0.0.1", perms: permForceRelay | permRelay, valid: true},
+		{entry: "all@fd00::/16", perms: permAll, valid: true},
+		{entry: "bogus@10.0.0.1"},
+		{entry: "@10.0.0.1"},
+		{entry: "noban@notanip"},
+	}
+	for _, test := range tests {
+		whitelists, err := parseNetWhitelists([]string{test.entry})
+		if !test.valid {
+			if err == nil {
+				t.Errorf("%q: expected an error", test.entry)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.entry, err)
+			continue
+		}
+		if whitelists[0].perms != test.perms {
+			t.Errorf("%q: got permissions %v, want %v", test.entry,
+				whitelists[0].perms.names(), test.perms.names())
+		}
+	}
+
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+	whitelists, err := parseNetWhitelists([]string{"10.0.0.0/8",
+		"mempool,relay@10.0.0.1"})
+	if err != nil {
+		t.Fatalf("parseNetWhitelists: unexpected error: %v", err)
+	}
+	cfg = &Config{whitelists: whitelists}
+
+	addrPerms := []struct {
+		addr  string
+		perms []string
+	}{
+		{addr: "10.0.0.1:8333", perms: []string{"noban", "relay", "mempool"}},
+		{addr: "10.0.0.2:8333", perms: []string{"noban"}},
+		{addr: "192.168.0.1:8333", perms: []string{}},
+	}
+	for _, test := range addrPerms {
+		addr, err := net.ResolveTCPAddr("tcp", test.addr)
+		if err != nil {
+			t.Fatalf("ResolveTCPAddr: %v", err)
+		}
+		perms := whitelistPermissions(addr).names()
+		if !reflect.DeepEqual(perms, test.perms) {
+			t.Errorf("%s: got permissions %v, want %v", test.addr,
+				perms, test.perms)
+		}
+	}
+}
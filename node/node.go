@@ -0,0 +1,133 @@
+// Copyright (c) 2013-2016 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package node implements a full bchd node which may be embedded in other Go
+programs.
+
+The bchd command is a thin wrapper around this package.  Programs which wish to
+run a node in-process should load a configuration with LoadConfig, create the
+node with New, and then Start and Stop it as needed:
+
+	cfg, _, err := node.LoadConfig([]string{"--testnet"})
+	if err != nil {
+		return err
+	}
+	n, err := node.New(cfg, interrupt)
+	if err != nil {
+		return err
+	}
+	n.Start()
+	defer n.Stop()
+
+The chain, mempool and RPC servers of a running node are available through the
+accessor methods on Node.
+
+NOTE: Much of the node state, such as the active configuration, the selected
+network and the subsystem loggers, is kept at the package level.  As a result
+only a single node may be run per process.
+*/
+package node
+
+import (
+	"github.com/gcash/bchd/bchrpc"
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/database"
+	"github.com/gcash/bchd/mempool"
+	"github.com/gcash/bchd/wire"
+)
+
+// Node houses a full bchd node including the block database and the server
+// which manages peers, the chain, the mempool and the RPC servers.
+type Node struct {
+	cfg    *Config
+	db     database.DB
+	server *server
+}
+
+// New returns a new node configured with the passed config.  The block
+// database is opened (or created) and all subsystems are wired up, however the
+// node is not started until Start is called.
+//
+// The interrupt channel is used to abort long running startup operations such
+// as index catch up and should be closed when the node is shutting down.
+func New(config *Config, interrupt <-chan struct{}) (*Node, error) {
+	cfg = config
+
+	// Do required one-time initialization on wire
+	wire.SetLimits(cfg.ExcessiveBlockSize)
+
+	// Load the block database.
+	db, err := loadBlockDB()
+	if err != nil {
+		bchdLog.Errorf("%v", err)
+		return nil, err
+	}
+
+	// Create the server.
+	s, err := newServer(cfg.Listeners, cfg.AgentBlacklist, cfg.AgentWhitelist,
+		db, activeNetParams.Params, interrupt)
+	if err != nil {
+		// TODO: this logging could do with some beautifying.
+		bchdLog.Errorf("Unable to start server on %v: %v",
+			cfg.Listeners, err)
+		db.Close()
+		return nil, err
+	}
+
+	n := &Node{
+		cfg:    cfg,
+		db:     db,
+		server: s,
+	}
+	return n, nil
+}
+
+// Start begins accepting connections from peers and starts the RPC servers.
+func (n *Node) Start() {
+	n.server.Start()
+}
+
+// Stop gracefully shuts down the server, waits for it to finish and then
+// closes the block database.
+func (n *Node) Stop() error {
+	bchdLog.Infof("Gracefully shutting down the server...")
+	n.server.Stop()
+	n.server.WaitForShutdown()
+	srvrLog.Infof("Server shutdown complete")
+
+	// Ensure the database is sync'd and closed on shutdown.
+	bchdLog.Infof("Gracefully shutting down the database...")
+	if err := n.db.Close(); err != nil {
+		return err
+	}
+	bchdLog.Infof("Database has gracefully shutdown")
+	return nil
+}
+
+// Config returns the configuration the node was created with.
+func (n *Node) Config() *Config {
+	return n.cfg
+}
+
+// DB returns the block database used by the node.
+func (n *Node) DB() database.DB {
+	return n.db
+}
+
+// Chain returns the block chain instance managed by the node.
+func (n *Node) Chain() *blockchain.BlockChain {
+	return n.server.chain
+}
+
+// TxMemPool returns the transaction memory pool of the node.
+func (n *Node) TxMemPool() *mempool.TxPool {
+	return n.server.txMemPool
+}
+
+// GrpcServer returns the gRPC server of the node.  It will be nil if the gRPC
+// server is disabled.
+func (n *Node) GrpcServer() *bchrpc.GrpcServer {
+	return n.server.gRPCServer
+}
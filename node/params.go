@@ -2,11 +2,10 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-package main
+package node
 
 import (
 	"github.com/gcash/bchd/chaincfg"
-	"github.com/gcash/bchd/wire"
 )
 
 // activeNetParams is a pointer to the parameters specific to the
@@ -82,14 +81,15 @@ var simNetParams = params{
 // time of writing, bchd currently places blocks for testnet version 3 in the
 // data and log directory "testnet", which does not match the Name field of the
 // chaincfg parameters.  This function can be used to override this directory
-// name as "testnet" when the passed active network matches wire.TestNet3.
+// name as "testnet" when the passed active network is testnet version 3, but
+// not an upgrade test network cloned from it.
 //
 // A proper upgrade to move the data and log directories for this network to
 // "testnet3" is planned for the future, at which point this function can be
 // removed and the network parameter's name used instead.
 func netName(chainParams *params) string {
-	switch chainParams.Net {
-	case wire.TestNet3:
+	switch chainParams.Name {
+	case chaincfg.TestNet3Params.Name:
 		return "testnet"
 	default:
 		return chainParams.Name
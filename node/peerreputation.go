@@ -0,0 +1,278 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"encoding/binary"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gcash/bchd/database"
+)
+
+var (
+	// peerReputationBucketName is the name of the metadata bucket the
+	// historical behavior of the peers is stored in.  Each record is stored
+	// under the host of the peer.
+	peerReputationBucketName = []byte("peerreputation")
+)
+
+const (
+	// peerReputationExpiry is how long the historical behavior of a peer
+	// is remembered after its last incident.
+	peerReputationExpiry = time.Hour * 24 * 30
+
+	// poorPeerScore is the score at which the reputation of a peer is
+	// considered poor, which deprioritizes it when choosing outbound peers.
+	poorPeerScore = 4
+
+	// peerRecordSize is the size of a serialized peer record.
+	peerRecordSize = 4 + 4 + 4 + 8 + 8
+
+	// peerReputationFlushInterval is how often the records changed by new
+	// incidents are written to the database.
+	peerReputationFlushInterval = time.Minute
+)
+
+// peerIncident identifies the kind of misbehavior recorded for a peer.
+type peerIncident int
+
+// The following constants define the kinds of misbehavior recorded for a peer.
+const (
+	peerIncidentBan peerIncident = iota
+	peerIncidentStall
+	peerIncidentBadData
+)
+
+// peerRecord houses the historical behavior of a peer.
+type peerRecord struct {
+	bans         uint32
+	stalls       uint32
+	badData      uint32
+	lastBan      time.Time
+	lastIncident time.Time
+}
+
+// score returns the reputation score of the peer.  Higher scores mean worse
+// behavior.
+func (r *peerRecord) score() uint32 {
+	return r.bans*4 + r.badData*2 + r.stalls
+}
+
+// serialize returns the serialized record.
+func (r *peerRecord) serialize() []byte {
+	b := make([]byte, peerRecordSize)
+	binary.LittleEndian.PutUint32(b[0:], r.bans)
+	binary.LittleEndian.PutUint32(b[4:], r.stalls)
+	binary.LittleEndian.PutUint32(b[8:], r.badData)
+	binary.LittleEndian.PutUint64(b[12:], uint64(r.lastBan.Unix()))
+	binary.LittleEndian.PutUint64(b[20:], uint64(r.lastIncident.Unix()))
+	return b
+}
+
+// deserialize decodes the passed serialized record into r.
+func (r *peerRecord) deserialize(b []byte) error {
+	if len(b) != peerRecordSize {
+		return errors.New("unexpected serialized peer record size")
+	}
+	r.bans = binary.LittleEndian.Uint32(b[0:])
+	r.stalls = binary.LittleEndian.Uint32(b[4:])
+	r.badData = binary.LittleEndian.Uint32(b[8:])
+	r.lastBan = time.Unix(int64(binary.LittleEndian.Uint64(b[12:])), 0)
+	r.lastIncident = time.Unix(int64(binary.LittleEndian.Uint64(b[20:])), 0)
+	return nil
+}
+
+// peerReputation keeps the historical behavior of the peers, such as how
+// often they were banned, stalled the sync or served bad data, in the
+// database.  This allows peers with a poor reputation to be deprioritized
+// after a restart instead of starting with a blank reputation every time.
+//
+// Incidents are recorded in memory and the changed records are written to the
+// database periodically so reporting them never waits for the database.
+type peerReputation struct {
+	db database.DB
+
+	mtx     sync.RWMutex
+	records map[string]*peerRecord
+	dirty   map[string]struct{}
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newPeerReputation returns a new peer reputation store with the records
+// loaded from the database.  Expired records are removed.
+func newPeerReputation(db database.DB) (*peerReputation, error) {
+	pr := &peerReputation{
+		db:      db,
+		records: make(map[string]*peerRecord),
+		dirty:   make(map[string]struct{}),
+		quit:    make(chan struct{}),
+	}
+	err := db.Update(func(dbTx database.Tx) error {
+		bucket, err := dbTx.Metadata().CreateBucketIfNotExists(
+			peerReputationBucketName)
+		if err != nil {
+			return err
+		}
+
+		var expired [][]byte
+		err = bucket.ForEach(func(k, v []byte) error {
+			var r peerRecord
+			if err := r.deserialize(v); err != nil {
+				return err
+			}
+			if time.Since(r.lastIncident) > peerReputationExpiry {
+				expired = append(expired, k)
+				return nil
+			}
+			pr.records[string(k)] = &r
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range expired {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// record adds an incident of the passed kind to the history of the peer with
+// the passed host.  The record is stored in the database by the next flush.
+//
+// This function is safe for concurrent access.
+func (pr *peerReputation) record(host string, incident peerIncident) {
+	now := time.Now()
+
+	pr.mtx.Lock()
+	defer pr.mtx.Unlock()
+
+	r, exists := pr.records[host]
+	if !exists || now.Sub(r.lastIncident) > peerReputationExpiry {
+		r = &peerRecord{}
+		pr.records[host] = r
+	}
+	switch incident {
+	case peerIncidentBan:
+		r.bans++
+		r.lastBan = now
+	case peerIncidentStall:
+		r.stalls++
+	case peerIncidentBadData:
+		r.badData++
+	}
+	r.lastIncident = now
+	pr.dirty[host] = struct{}{}
+}
+
+// flush writes the records changed since the last flush to the database.
+//
+// This function is safe for concurrent access.
+func (pr *peerReputation) flush() error {
+	pr.mtx.Lock()
+	if len(pr.dirty) == 0 {
+		pr.mtx.Unlock()
+		return nil
+	}
+	serialized := make(map[string][]byte, len(pr.dirty))
+	for host := range pr.dirty {
+		serialized[host] = pr.records[host].serialize()
+	}
+	pr.dirty = make(map[string]struct{})
+	pr.mtx.Unlock()
+
+	return pr.db.Update(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(peerReputationBucketName)
+		for host, v := range serialized {
+			if err := bucket.Put([]byte(host), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// flushHandler periodically writes the changed records to the database until
+// the store is stopped, when the remaining changes are written.  It must be
+// run as a goroutine.
+func (pr *peerReputation) flushHandler() {
+	defer pr.wg.Done()
+
+	ticker := time.NewTicker(peerReputationFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-pr.quit:
+			if err := pr.flush(); err != nil {
+				srvrLog.Errorf("Unable to store the reputation of "+
+					"peers: %v", err)
+			}
+			return
+		}
+		if err := pr.flush(); err != nil {
+			srvrLog.Errorf("Unable to store the reputation of peers: %v",
+				err)
+		}
+	}
+}
+
+// Start begins writing the records changed by new incidents to the database.
+func (pr *peerReputation) Start() {
+	pr.wg.Add(1)
+	go pr.flushHandler()
+}
+
+// Stop writes the remaining changed records to the database and waits for the
+// store to finish.
+func (pr *peerReputation) Stop() {
+	close(pr.quit)
+	pr.wg.Wait()
+}
+
+// isPoor returns whether the peer with the passed host has a poor reputation.
+//
+// This function is safe for concurrent access.
+func (pr *peerReputation) isPoor(host string) bool {
+	pr.mtx.RLock()
+	defer pr.mtx.RUnlock()
+
+	r, exists := pr.records[host]
+	if !exists || time.Since(r.lastIncident) > peerReputationExpiry {
+		return false
+	}
+	return r.score() >= poorPeerScore
+}
+
+// activeBans returns the hosts whose last ban has not ended yet given the
+// passed ban duration along with the time their ban ends.
+//
+// This function is safe for concurrent access.
+func (pr *peerReputation) activeBans(banDuration time.Duration) map[string]time.Time {
+	pr.mtx.RLock()
+	defer pr.mtx.RUnlock()
+
+	now := time.Now()
+	bans := make(map[string]time.Time)
+	for host, r := range pr.records {
+		if r.bans == 0 {
+			continue
+		}
+		if banEnd := r.lastBan.Add(banDuration); banEnd.After(now) {
+			bans[host] = banEnd
+		}
+	}
+	return bans
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/database"
+	_ "github.com/gcash/bchd/database/ffldb"
+	"github.com/gcash/bchd/wire"
+)
+
+// TestPeerReputation ensures the incidents of peers are scored, persisted in
+// the database and reloaded along with their active bans.
+func TestPeerReputation(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "ffldb")
+	db, err := database.Create("ffldb", dbPath, wire.MainNet)
+	if err != nil {
+		t.Fatalf("unable to create database: %v", err)
+	}
+	defer db.Close()
+
+	pr, err := newPeerReputation(db)
+	if err != nil {
+		t.Fatalf("newPeerReputation: unexpected error: %v", err)
+	}
+	pr.record("10.0.0.1", peerIncidentStall)
+	pr.record("10.0.0.1", peerIncidentBadData)
+	pr.record("10.0.0.2", peerIncidentBan)
+	if pr.isPoor("10.0.0.1") {
+		t.Fatal("isPoor: peer with a stall and bad data is poor")
+	}
+	pr.record("10.0.0.1", peerIncidentStall)
+
+	// Stopping the store writes the pending records, so reload them from
+	// the database and ensure they are kept.
+	pr.Start()
+	pr.Stop()
+	pr, err = newPeerReputation(db)
+	if err != nil {
+		t.Fatalf("newPeerReputation: unexpected error: %v", err)
+	}
+	for _, host := range []string{"10.0.0.1", "10.0.0.2"} {
+		if !pr.isPoor(host) {
+			t.Fatalf("isPoor: peer %s is not poor after reload", host)
+		}
+	}
+	if pr.isPoor("10.0.0.3") {
+		t.Fatal("isPoor: unknown peer is poor")
+	}
+
+	bans := pr.activeBans(time.Hour)
+	if _, ok := bans["10.0.0.2"]; !ok || len(bans) != 1 {
+		t.Fatalf("activeBans: got %v, want only 10.0.0.2", bans)
+	}
+	if bans := pr.activeBans(0); len(bans) != 0 {
+		t.Fatalf("activeBans: got %v for ended bans, want none", bans)
+	}
+}
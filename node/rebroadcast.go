@@ -0,0 +1,101 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/mempool"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// pendingRebroadcasts houses the inventory submitted to this node which is
+// rebroadcast until it shows up in a block.  The transactions are also tracked
+// by the outpoints they spend, so a transaction is dropped along with the
+// transactions spending its outputs once a conflicting transaction, such as a
+// malleated variant of it, is confirmed instead.  Otherwise a transaction which
+// can no longer be mined would be rebroadcast forever.
+//
+// It must only be accessed by the rebroadcast handler.
+type pendingRebroadcasts struct {
+	invs   map[wire.InvVect]interface{}
+	spends map[wire.OutPoint]chainhash.Hash
+}
+
+// newPendingRebroadcasts returns a new empty set of pending rebroadcasts.
+func newPendingRebroadcasts() *pendingRebroadcasts {
+	return &pendingRebroadcasts{
+		invs:   make(map[wire.InvVect]interface{}),
+		spends: make(map[wire.OutPoint]chainhash.Hash),
+	}
+}
+
+// add starts rebroadcasting the passed inventory.
+func (p *pendingRebroadcasts) add(iv *wire.InvVect, data interface{}) {
+	p.invs[*iv] = data
+	if txD, ok := data.(*mempool.TxDesc); ok {
+		for _, txIn := range txD.Tx.MsgTx().TxIn {
+			p.spends[txIn.PreviousOutPoint] = iv.Hash
+		}
+	}
+}
+
+// remove stops rebroadcasting the passed inventory if present.
+func (p *pendingRebroadcasts) remove(iv *wire.InvVect) {
+	data, ok := p.invs[*iv]
+	if !ok {
+		return
+	}
+	delete(p.invs, *iv)
+	if txD, ok := data.(*mempool.TxDesc); ok {
+		for _, txIn := range txD.Tx.MsgTx().TxIn {
+			if p.spends[txIn.PreviousOutPoint] == iv.Hash {
+				delete(p.spends, txIn.PreviousOutPoint)
+			}
+		}
+	}
+}
+
+// confirmed stops rebroadcasting the passed transaction which was included in
+// a block along with the transactions it replaced, and returns the hashes of
+// the replaced transactions.  A transaction is replaced when it spends any of
+// the outpoints spent by the confirmed transaction or outputs of another
+// replaced transaction, all of which can no longer be mined.
+func (p *pendingRebroadcasts) confirmed(tx *bchutil.Tx) []chainhash.Hash {
+	p.remove(wire.NewInvVect(wire.InvTypeTx, tx.Hash()))
+
+	var replaced []chainhash.Hash
+	conflicts := make([]wire.OutPoint, 0, len(tx.MsgTx().TxIn))
+	for _, txIn := range tx.MsgTx().TxIn {
+		conflicts = append(conflicts, txIn.PreviousOutPoint)
+	}
+	for len(conflicts) > 0 {
+		outpoint := conflicts[len(conflicts)-1]
+		conflicts = conflicts[:len(conflicts)-1]
+
+		hash, ok := p.spends[outpoint]
+		if !ok {
+			continue
+		}
+		iv := wire.NewInvVect(wire.InvTypeTx, &hash)
+		txD, ok := p.invs[*iv].(*mempool.TxDesc)
+		if !ok {
+			delete(p.spends, outpoint)
+			continue
+		}
+		p.remove(iv)
+		replaced = append(replaced, hash)
+
+		// The transactions spending the outputs of the replaced
+		// transaction can no longer be mined either.
+		for i := range txD.Tx.MsgTx().TxOut {
+			conflicts = append(conflicts, wire.OutPoint{
+				Hash:  hash,
+				Index: uint32(i),
+			})
+		}
+	}
+	return replaced
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/mempool"
+	"github.com/gcash/bchd/mining"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// TestPendingRebroadcasts ensures the transactions conflicting with a
+// confirmed transaction are dropped along with their descendants and reported
+// as replaced.
+func TestPendingRebroadcasts(t *testing.T) {
+	// newTx returns a transaction spending the passed outpoints with the
+	// passed signature script.
+	newTx := func(sigScript byte, outpoints ...wire.OutPoint) *bchutil.Tx {
+		msgTx := wire.NewMsgTx(1)
+		for i := range outpoints {
+			msgTx.AddTxIn(wire.NewTxIn(&outpoints[i], []byte{sigScript}))
+		}
+		msgTx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}, wire.TokenData{}))
+		return bchutil.NewTx(msgTx)
+	}
+	add := func(p *pendingRebroadcasts, tx *bchutil.Tx) {
+		p.add(wire.NewInvVect(wire.InvTypeTx, tx.Hash()),
+			&mempool.TxDesc{TxDesc: mining.TxDesc{Tx: tx}})
+	}
+
+	funding := wire.OutPoint{Hash: chainhash.HashH([]byte("funding"))}
+	other := wire.OutPoint{Hash: chainhash.HashH([]byte("other"))}
+	parent := newTx(0x01, funding)
+	child := newTx(0x01, wire.OutPoint{Hash: *parent.Hash()})
+	unrelated := newTx(0x01, other)
+	malleated := newTx(0x02, funding)
+
+	p := newPendingRebroadcasts()
+	add(p, parent)
+	add(p, child)
+	add(p, unrelated)
+
+	// Confirming a malleated variant of the parent replaces the parent and
+	// its child.
+	replaced := p.confirmed(malleated)
+	if len(replaced) != 2 || replaced[0] != *parent.Hash() ||
+		replaced[1] != *child.Hash() {
+
+		t.Fatalf("unexpected replaced transactions %v", replaced)
+	}
+	if len(p.invs) != 1 || len(p.spends) != 1 {
+		t.Fatalf("unexpected pending inventory %d, spends %d",
+			len(p.invs), len(p.spends))
+	}
+
+	// Confirming the pending transaction itself replaces nothing.
+	if replaced := p.confirmed(unrelated); len(replaced) != 0 {
+		t.Fatalf("unexpected replaced transactions %v", replaced)
+	}
+	if len(p.invs) != 0 || len(p.spends) != 0 {
+		t.Fatalf("unexpected pending inventory %d, spends %d",
+			len(p.invs), len(p.spends))
+	}
+}
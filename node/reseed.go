@@ -0,0 +1,96 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/connmgr"
+	"github.com/gcash/bchd/wire"
+)
+
+// httpSeedTimeout is the maximum time fetching the list of peers from an HTTPS
+// seed may take.
+const httpSeedTimeout = time.Minute
+
+// newHTTPSeedClient returns an HTTP client which fetches the lists of peers of
+// the HTTPS seeds through the passed dial function, so they honor the
+// configured proxy.
+func newHTTPSeedClient(dial func(string, string, time.Duration) (net.Conn, error)) *http.Client {
+	return &http.Client{
+		Timeout: httpSeedTimeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				return dial(network, addr, httpSeedTimeout)
+			},
+			TLSHandshakeTimeout: httpSeedTimeout,
+		},
+	}
+}
+
+// reseed queries the DNS seeds of the network along with the seeds added
+// through the configuration for peers, which are added to the address manager
+// as they are discovered.  It returns the hosts of the DNS seeds and the URLs
+// of the HTTPS seeds which are queried.
+func (s *server) reseed() ([]string, []string) {
+	dnsSeeds := make([]chaincfg.DNSSeed, 0, len(activeNetParams.DNSSeeds)+
+		len(cfg.AddDNSSeeds))
+	dnsSeeds = append(dnsSeeds, activeNetParams.DNSSeeds...)
+	for _, host := range cfg.AddDNSSeeds {
+		dnsSeeds = append(dnsSeeds, chaincfg.DNSSeed{Host: host})
+	}
+
+	// Bitcoind uses a lookup of the dns seeder here. This is rather strange
+	// since the values looked up by the DNS seed lookups will vary quite a
+	// lot.  to replicate this behaviour we put all addresses as having
+	// come from the first one.
+	onSeed := func(addrs []*wire.NetAddress) {
+		s.addrManager.AddAddresses(addrs, addrs[0])
+	}
+	connmgr.SeedFromDNSSeeds(dnsSeeds, activeNetParams.DefaultPort,
+		defaultRequiredServices, bchdLookup, onSeed)
+	client := newHTTPSeedClient(cfg.dial)
+	for _, url := range cfg.HTTPSeeds {
+		connmgr.SeedFromHTTPS(url, client,
+			activeNetParams.DefaultPort, defaultRequiredServices,
+			bchdLookup, onSeed)
+	}
+
+	hosts := make([]string, 0, len(dnsSeeds))
+	for _, dnsSeed := range dnsSeeds {
+		hosts = append(hosts, dnsSeed.Host)
+	}
+	return hosts, cfg.HTTPSeeds
+}
+
+// reseedHandler queries the seeds for peers again at the configured reseed
+// interval while the address manager needs more addresses.
+//
+// It must be run as a goroutine.
+func (s *server) reseedHandler() {
+	ticker := time.NewTicker(cfg.ReseedInterval)
+	defer ticker.Stop()
+
+out:
+	for {
+		select {
+		case <-ticker.C:
+			if !s.addrManager.NeedMoreAddresses() {
+				continue
+			}
+			srvrLog.Debugf("Querying the seeds for more peer addresses")
+			s.reseed()
+
+		case <-s.quit:
+			break out
+		}
+	}
+
+	s.wg.Done()
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strings"
+)
+
+const (
+	// rpcAccountAllMethods is the method list entry granting an account
+	// access to every RPC method.
+	rpcAccountAllMethods = "*"
+
+	// rpcAccountLimitedMethods is the method list entry granting an account
+	// access to the methods available to the limited user.
+	rpcAccountLimitedMethods = "@limited"
+)
+
+// rpcAccount is a set of RPC credentials along with the methods they may be
+// used to call and the addresses they may be used from.
+type rpcAccount struct {
+	name    string
+	authsha [sha256.Size]byte
+
+	// methods is the set of methods the account may call.  A nil set means
+	// every method.
+	methods map[string]struct{}
+
+	// allowedNets restricts the addresses the account may be used from.
+	// The account may be used from any address when it is empty.
+	allowedNets []*net.IPNet
+}
+
+// publicRPCAccount is the account used for requests made without credentials
+// when the server runs in public mode.
+var publicRPCAccount = &rpcAccount{name: "public", methods: rpcPublic}
+
+// rpcAuthSha returns the hash of the HTTP basic authorization header for the
+// passed credentials.
+func rpcAuthSha(user, pass string) [sha256.Size]byte {
+	login := user + ":" + pass
+	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
+	return sha256.Sum256([]byte(auth))
+}
+
+// allowsMethod returns whether the account may call the passed method.  Only
+// the methods available to the limited user are allowed when the request was
+// received on a listener with the limited option.
+func (a *rpcAccount) allowsMethod(method string, limitedListener bool) bool {
+	if limitedListener {
+		if _, ok := rpcLimited[method]; !ok {
+			return false
+		}
+	}
+	if a.methods == nil {
+		return true
+	}
+	_, ok := a.methods[method]
+	return ok
+}
+
+// allowsAddr returns whether the account may be used from the passed remote
+// address in the host:port form.
+func (a *rpcAccount) allowsAddr(remoteAddr string) bool {
+	if len(a.allowedNets) == 0 {
+		return true
+	}
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, ipnet := range a.allowedNets {
+		if ipnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupRPCAccount returns the account with the passed authorization hash, or
+// nil if there is none.  Every account is compared so the time taken does not
+// depend on which account matches.
+func lookupRPCAccount(accounts []*rpcAccount, authsha [sha256.Size]byte) *rpcAccount {
+	var match *rpcAccount
+	for _, account := range accounts {
+		if subtle.ConstantTimeCompare(authsha[:], account.authsha[:]) == 1 {
+			match = account
+		}
+	}
+	return match
+}
+
+// parseRPCAccount parses an RPC account in the form
+// '<user>:<pass>,methods=<method> <method>...[,allowip=<ip or net> ...]'.
+// The method list may include * for every method and @limited for the methods
+// available to the limited user.
+func parseRPCAccount(spec string) (*rpcAccount, error) {
+	fields := strings.Split(spec, ",")
+	user, pass, ok := strings.Cut(fields[0], ":")
+	if !ok || user == "" || pass == "" {
+		return nil, fmt.Errorf("rpc account %q must start with "+
+			"<user>:<pass>", user)
+	}
+
+	account := &rpcAccount{
+		name:    user,
+		authsha: rpcAuthSha(user, pass),
+	}
+	var haveMethods bool
+	for _, field := range fields[1:] {
+		name, value, _ := strings.Cut(strings.TrimSpace(field), "=")
+		switch name {
+		case "methods":
+			haveMethods = true
+			methods, err := parseRPCAccountMethods(strings.Fields(value))
+			if err != nil {
+				return nil, fmt.Errorf("rpc account %q: %v", user, err)
+			}
+			account.methods = methods
+
+		case "allowip":
+			nets, err := parseWhitelists(strings.Fields(value))
+			if err != nil {
+				return nil, fmt.Errorf("rpc account %q: %v", user, err)
+			}
+			account.allowedNets = append(account.allowedNets, nets...)
+
+		default:
+			return nil, fmt.Errorf("rpc account %q: unknown option %q",
+				user, name)
+		}
+	}
+	if !haveMethods {
+		return nil, fmt.Errorf("rpc account %q does not specify the "+
+			"methods it may call", user)
+	}
+	return account, nil
+}
+
+// parseRPCAccountMethods returns the set of methods for the passed method
+// list.  A nil set is returned when the list includes every method.
+func parseRPCAccountMethods(list []string) (map[string]struct{}, error) {
+	if len(list) == 0 {
+		return nil, fmt.Errorf("empty method list")
+	}
+	methods := make(map[string]struct{})
+	for _, method := range list {
+		switch method {
+		case rpcAccountAllMethods:
+			return nil, nil
+		case rpcAccountLimitedMethods:
+			for limited := range rpcLimited {
+				methods[limited] = struct{}{}
+			}
+			continue
+		}
+
+		_, isHTTP := rpcHandlers[method]
+		_, isWebsocket := wsHandlers[method]
+		if !isHTTP && !isWebsocket {
+			return nil, fmt.Errorf("unknown method %q", method)
+		}
+		methods[method] = struct{}{}
+	}
+	return methods, nil
+}
+
+// buildRPCAccounts returns the RPC accounts of the passed config.  The rpcuser
+// may call every method and the rpclimituser the methods available to the
+// limited user, followed by the accounts defined with the rpcaccount option.
+func buildRPCAccounts(cfg *Config) ([]*rpcAccount, error) {
+	var accounts []*rpcAccount
+	if cfg.RPCUser != "" && cfg.RPCPass != "" {
+		accounts = append(accounts, &rpcAccount{
+			name:    cfg.RPCUser,
+			authsha: rpcAuthSha(cfg.RPCUser, cfg.RPCPass),
+		})
+	}
+	if cfg.RPCLimitUser != "" && cfg.RPCLimitPass != "" {
+		accounts = append(accounts, &rpcAccount{
+			name:    cfg.RPCLimitUser,
+			authsha: rpcAuthSha(cfg.RPCLimitUser, cfg.RPCLimitPass),
+			methods: rpcLimited,
+		})
+	}
+	for _, spec := range cfg.RPCAccounts {
+		account, err := parseRPCAccount(spec)
+		if err != nil {
+			return nil, err
+		}
+		for _, other := range accounts {
+			if other.name == account.name {
+				return nil, fmt.Errorf("rpc account %q is defined "+
+					"more than once", account.name)
+			}
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"testing"
+)
+
+// TestParseRPCAccount ensures RPC accounts are parsed with the expected
+// method and address restrictions and invalid accounts are rejected.
+func TestParseRPCAccount(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr bool
+		allowed []string
+		denied  []string
+		addrs   []string
+		badAddr []string
+	}{
+		{
+			name:    "method list",
+			spec:    "explorer:secret,methods=getblock getrawtransaction",
+			allowed: []string{"getblock", "getrawtransaction"},
+			denied:  []string{"stop", "getblockcount"},
+			addrs:   []string{"1.2.3.4:8334"},
+		},
+		{
+			name:    "all methods from a network",
+			spec:    "admin:secret,methods=*,allowip=10.0.0.0/8 ::1",
+			allowed: []string{"stop", "getblock"},
+			addrs:   []string{"10.1.2.3:8334", "[::1]:8334"},
+			badAddr: []string{"11.0.0.1:8334", "[::2]:8334"},
+		},
+		{
+			name:    "limited methods",
+			spec:    "wallet:secret,methods=@limited generate",
+			allowed: []string{"getblock", "notifyblocks", "generate"},
+			denied:  []string{"stop", "addnode"},
+		},
+		{
+			name:    "missing password",
+			spec:    "user,methods=*",
+			wantErr: true,
+		},
+		{
+			name:    "missing methods",
+			spec:    "user:secret",
+			wantErr: true,
+		},
+		{
+			name:    "unknown method",
+			spec:    "user:secret,methods=getblok",
+			wantErr: true,
+		},
+		{
+			name:    "invalid address",
+			spec:    "user:secret,methods=*,allowip=10.0.0.0/33",
+			wantErr: true,
+		},
+		{
+			name:    "unknown option",
+			spec:    "user:secret,methods=*,whitelist",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		account, err := parseRPCAccount(test.spec)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if account.authsha != rpcAuthSha(account.name, "secret") {
+			t.Errorf("%s: unexpected credentials", test.name)
+		}
+		for _, method := range test.allowed {
+			if !account.allowsMethod(method, false) {
+				t.Errorf("%s: method %s denied", test.name, method)
+			}
+		}
+		for _, method := range test.denied {
+			if account.allowsMethod(method, false) {
+				t.Errorf("%s: method %s allowed", test.name, method)
+			}
+		}
+		for _, addr := range test.addrs {
+			if !account.allowsAddr(addr) {
+				t.Errorf("%s: address %s denied", test.name, addr)
+			}
+		}
+		for _, addr := range test.badAddr {
+			if account.allowsAddr(addr) {
+				t.Errorf("%s: address %s allowed", test.name, addr)
+			}
+		}
+	}
+}
+
+// TestBuildRPCAccounts ensures the rpcuser and rpclimituser are turned into
+// accounts along with the rpcaccount options, and that the methods of every
+// account are restricted on limited listeners.
+func TestBuildRPCAccounts(t *testing.T) {
+	accounts, err := buildRPCAccounts(&Config{
+		RPCUser:      "admin",
+		RPCPass:      "adminpass",
+		RPCLimitUser: "limited",
+		RPCLimitPass: "limitedpass",
+		RPCAccounts:  []string{"explorer:explorerpass,methods=getblock stop"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	admin := lookupRPCAccount(accounts, rpcAuthSha("admin", "adminpass"))
+	limited := lookupRPCAccount(accounts, rpcAuthSha("limited", "limitedpass"))
+	explorer := lookupRPCAccount(accounts, rpcAuthSha("explorer", "explorerpass"))
+	if admin == nil || limited == nil || explorer == nil {
+		t.Fatal("account not found")
+	}
+	if lookupRPCAccount(accounts, rpcAuthSha("admin", "limitedpass")) != nil {
+		t.Fatal("account found with the wrong password")
+	}
+
+	if !admin.allowsMethod("stop", false) || admin.allowsMethod("stop", true) {
+		t.Fatal("admin must only be limited on limited listeners")
+	}
+	if !admin.allowsMethod("getblock", true) {
+		t.Fatal("admin must be allowed limited methods on limited listeners")
+	}
+	if limited.allowsMethod("stop", false) || !limited.allowsMethod("getblock", false) {
+		t.Fatal("limited user must only be allowed limited methods")
+	}
+	if !explorer.allowsMethod("stop", false) || explorer.allowsMethod("stop", true) ||
+		explorer.allowsMethod("getblockcount", false) {
+
+		t.Fatal("unexpected methods allowed for explorer account")
+	}
+
+	_, err = buildRPCAccounts(&Config{
+		RPCUser:     "admin",
+		RPCPass:     "adminpass",
+		RPCAccounts: []string{"admin:otherpass,methods=*"},
+	})
+	if err == nil {
+		t.Fatal("expected an error for a duplicate account")
+	}
+}
@@ -2,12 +2,13 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-package main
+package node
 
 import (
 	"sync/atomic"
 
 	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/btcjson"
 	"github.com/gcash/bchd/chaincfg/chainhash"
 	"github.com/gcash/bchd/mempool"
 	"github.com/gcash/bchd/netsync"
@@ -57,7 +58,15 @@ func (p *rpcPeer) BanScore() uint32 {
 // This function is safe for concurrent access and is part of the rpcserverPeer
 // interface implementation.
 func (p *rpcPeer) IsWhitelisted() bool {
-	return (*serverPeer)(p).isWhitelisted
+	return (*serverPeer)(p).permissions != 0
+}
+
+// Permissions returns the names of the permissions granted to the peer.
+//
+// This function is safe for concurrent access and is part of the rpcserverPeer
+// interface implementation.
+func (p *rpcPeer) Permissions() []string {
+	return (*serverPeer)(p).permissions.names()
 }
 
 // FeeFilter returns the requested current minimum fee rate for which
@@ -69,6 +78,24 @@ func (p *rpcPeer) FeeFilter() int64 {
 	return atomic.LoadInt64(&(*serverPeer)(p).feeFilter)
 }
 
+// ConnectionType returns the kind of connection to the peer: inbound, manual
+// for persistent peers, or the type of an automatic outbound connection such
+// as full-relay or block-relay-only.
+//
+// This function is safe for concurrent access and is part of the rpcserverPeer
+// interface implementation.
+func (p *rpcPeer) ConnectionType() string {
+	sp := (*serverPeer)(p)
+	switch {
+	case sp.Inbound():
+		return "inbound"
+	case sp.persistent:
+		return "manual"
+	default:
+		return sp.connType.String()
+	}
+}
+
 // rpcConnManager provides a connection manager for use with the RPC server and
 // implements the rpcserverConnManager interface.
 type rpcConnManager struct {
@@ -231,6 +258,34 @@ func (cm *rpcConnManager) RelayTransactions(txns []*mempool.TxDesc) {
 	cm.server.relayTransactions(txns)
 }
 
+// RelayLocalTransaction announces the passed transaction submitted to this
+// node to a random subset of the outbound peers and tracks its propagation.
+//
+// This function is safe for concurrent access and is part of the
+// rpcserverConnManager interface implementation.
+func (cm *rpcConnManager) RelayLocalTransaction(txD *mempool.TxDesc) {
+	cm.server.relayLocalTransaction(txD)
+}
+
+// TxBroadcastStatus returns the propagation state of the passed transaction
+// submitted to this node or nil when it is not tracked.
+//
+// This function is safe for concurrent access and is part of the
+// rpcserverConnManager interface implementation.
+func (cm *rpcConnManager) TxBroadcastStatus(txHash *chainhash.Hash) *btcjson.GetTxBroadcastStatusResult {
+	return cm.server.txBroadcasts.status(txHash)
+}
+
+// Reseed queries the DNS seeds and the HTTPS seeds for peers, which are added
+// to the address manager as they are discovered, and returns the queried
+// seeds.
+//
+// This function is safe for concurrent access and is part of the
+// rpcserverConnManager interface implementation.
+func (cm *rpcConnManager) Reseed() ([]string, []string) {
+	return cm.server.reseed()
+}
+
 // rpcSyncMgr provides a block manager for use with the RPC server and
 // implements the rpcserverSyncManager interface.
 type rpcSyncMgr struct {
@@ -259,6 +314,15 @@ func (b *rpcSyncMgr) SubmitBlock(block *bchutil.Block, flags blockchain.Behavior
 	return b.syncMgr.ProcessBlock(block, flags)
 }
 
+// SubmitHeader validates the provided header of a block which is not
+// available yet and relays it to the network when it extends the best chain.
+//
+// This function is safe for concurrent access and is part of the
+// rpcserverSyncManager interface implementation.
+func (b *rpcSyncMgr) SubmitHeader(header *wire.BlockHeader) error {
+	return b.syncMgr.ProcessHeader(header)
+}
+
 // Pause pauses the sync manager until the returned channel is closed.
 //
 // This function is safe for concurrent access and is part of the
@@ -290,3 +354,21 @@ func (b *rpcSyncMgr) LocateHeaders(locators []*chainhash.Hash, hashStop *chainha
 func (b *rpcSyncMgr) SyncHeight() uint64 {
 	return b.syncMgr.SyncHeight()
 }
+
+// ChainSplitStatus returns whether the tip of the chain diverges from the tips
+// announced by the majority of the connected peers.
+//
+// This function is safe for concurrent access and is part of the
+// rpcserverSyncManager interface implementation.
+func (b *rpcSyncMgr) ChainSplitStatus() netsync.ChainSplitStatus {
+	return b.syncMgr.ChainSplitStatus()
+}
+
+// RejectedTxns returns the transactions received from peers which were
+// recently rejected along with the reasons.
+//
+// This function is safe for concurrent access and is part of the
+// rpcserverSyncManager interface implementation.
+func (b *rpcSyncMgr) RejectedTxns() []netsync.RejectedTx {
+	return b.syncMgr.RejectedTxns()
+}
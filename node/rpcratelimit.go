@@ -0,0 +1,93 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"sync"
+	"time"
+)
+
+// rpcRateLimiterPruneInterval is how often clients whose token bucket has
+// refilled are removed from a rpcRateLimiter.
+const rpcRateLimiterPruneInterval = time.Minute
+
+// rpcTokenBucket tracks the requests of a single client.
+type rpcTokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rpcRateLimiter limits the rate of requests of each client using a token
+// bucket per client.  Each bucket holds up to burst tokens and is refilled at
+// rate tokens per second.  A request takes one token.
+type rpcRateLimiter struct {
+	mtx       sync.Mutex
+	rate      float64
+	burst     float64
+	buckets   map[string]*rpcTokenBucket
+	lastPrune time.Time
+}
+
+// newRPCRateLimiter returns a new rate limiter allowing rate requests per
+// second with bursts of up to burst requests for each client.
+func newRPCRateLimiter(rate float64, burst int) *rpcRateLimiter {
+	return &rpcRateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: make(map[string]*rpcTokenBucket),
+	}
+}
+
+// allow returns whether the client may make a request at the passed time and
+// takes a token from its bucket if so.
+//
+// This function is safe for concurrent access.
+func (l *rpcRateLimiter) allow(client string, now time.Time) bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if now.Sub(l.lastPrune) >= rpcRateLimiterPruneInterval {
+		l.prune(now)
+	}
+
+	bucket, ok := l.buckets[client]
+	if !ok {
+		bucket = &rpcTokenBucket{tokens: l.burst, last: now}
+		l.buckets[client] = bucket
+	}
+	l.refill(bucket, now)
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+// refill adds the tokens accumulated since the bucket was last updated.
+//
+// This function MUST be called with the limiter lock held.
+func (l *rpcRateLimiter) refill(bucket *rpcTokenBucket, now time.Time) {
+	if elapsed := now.Sub(bucket.last); elapsed > 0 {
+		bucket.tokens += elapsed.Seconds() * l.rate
+		if bucket.tokens > l.burst {
+			bucket.tokens = l.burst
+		}
+	}
+	bucket.last = now
+}
+
+// prune removes the clients whose buckets are full again since they are
+// indistinguishable from clients that were never seen.
+//
+// This function MUST be called with the limiter lock held.
+func (l *rpcRateLimiter) prune(now time.Time) {
+	for client, bucket := range l.buckets {
+		l.refill(bucket, now)
+		if bucket.tokens >= l.burst {
+			delete(l.buckets, client)
+		}
+	}
+	l.lastPrune = now
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"testing"
+	"time"
+)
+
+// TestRPCRateLimiter ensures clients are limited to their burst and are
+// refilled at the configured rate independently of each other.
+func TestRPCRateLimiter(t *testing.T) {
+	l := newRPCRateLimiter(2, 3)
+	now := time.Unix(1700000000, 0)
+
+	for i := 0; i < 3; i++ {
+		if !l.allow("10.0.0.1", now) {
+			t.Fatalf("request %d within the burst was denied", i)
+		}
+	}
+	if l.allow("10.0.0.1", now) {
+		t.Fatal("request beyond the burst was allowed")
+	}
+	if !l.allow("10.0.0.2", now) {
+		t.Fatal("request of another client was denied")
+	}
+
+	// Half a second refills a single token at 2 requests per second.
+	now = now.Add(time.Second / 2)
+	if !l.allow("10.0.0.1", now) {
+		t.Fatal("request after refill was denied")
+	}
+	if l.allow("10.0.0.1", now) {
+		t.Fatal("request beyond the refill was allowed")
+	}
+
+	// Clients whose buckets are full again are pruned.
+	now = now.Add(rpcRateLimiterPruneInterval)
+	if !l.allow("10.0.0.3", now) {
+		t.Fatal("request of a new client was denied")
+	}
+	if len(l.buckets) != 1 {
+		t.Fatalf("expected 1 tracked client after pruning, got %d",
+			len(l.buckets))
+	}
+}
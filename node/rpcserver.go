@@ -3,12 +3,11 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-package main
+package node
 
 import (
 	"bytes"
 	"crypto/sha256"
-	"crypto/subtle"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
@@ -21,6 +20,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -40,6 +40,7 @@ import (
 	"github.com/gcash/bchd/mempool"
 	"github.com/gcash/bchd/mining"
 	"github.com/gcash/bchd/mining/cpuminer"
+	"github.com/gcash/bchd/netsync"
 	"github.com/gcash/bchd/peer"
 	"github.com/gcash/bchd/txscript"
 	"github.com/gcash/bchd/version"
@@ -72,6 +73,14 @@ const (
 	// in the memory pool.
 	gbtRegenerateSeconds = 60
 
+	// gbtSmallBlockMaxSize is the maximum size of the candidate block
+	// templates trading fees for a faster propagation.
+	gbtSmallBlockMaxSize = 2000000
+
+	// chainNotificationQueueSize is the number of block chain notifications
+	// buffered for the server before the chain waits for it to catch up.
+	chainNotificationQueueSize = 100
+
 	// maxProtocolVersion is the max protocol version the server supports.
 	maxProtocolVersion = wire.ProtocolVersion
 )
@@ -99,7 +108,16 @@ var (
 	// block template generated by the getblocktemplate RPC.    It is
 	// declared here to avoid the overhead of creating the slice on every
 	// invocation for constant data.
-	gbtCapabilities = []string{"proposal"}
+	gbtCapabilities = []string{"proposal", "templates"}
+
+	// gbtTemplateProfiles are the trade-offs of the candidate block
+	// templates returned by the getblocktemplate RPC along with the main
+	// template, which collects the most fees, when the caller requests the
+	// templates capability.
+	gbtTemplateProfiles = []mining.TemplateProfile{
+		{Name: "smallblock", BlockMaxSize: gbtSmallBlockMaxSize},
+		{Name: "tokens", TokenPriority: true},
+	}
 
 	// JSON 2.0 batched request prefix
 	batchedRequestPrefix = []byte("[")
@@ -120,6 +138,13 @@ var (
 		Code:    btcjson.ErrRPCNoWallet,
 		Message: "This implementation does not implement wallet commands",
 	}
+
+	// ErrRPCReplicaMining is an error returned to RPC clients when a
+	// mining command is invoked on a read replica.
+	ErrRPCReplicaMining = &btcjson.RPCError{
+		Code:    btcjson.ErrRPCMisc,
+		Message: "Mining is disabled on a read replica",
+	}
 )
 
 type commandHandler func(*rpcServer, interface{}, <-chan bool) (interface{}, error)
@@ -129,57 +154,89 @@ type commandHandler func(*rpcServer, interface{}, <-chan bool) (interface{}, err
 // a dependency loop.
 var rpcHandlers map[string]commandHandler
 var rpcHandlersBeforeInit = map[string]commandHandler{
-	"addnode":               handleAddNode,
-	"createrawtransaction":  handleCreateRawTransaction,
-	"debuglevel":            handleDebugLevel,
-	"decoderawtransaction":  handleDecodeRawTransaction,
-	"decodescript":          handleDecodeScript,
-	"estimatefee":           handleEstimateFee,
-	"generate":              handleGenerate,
-	"getaddednodeinfo":      handleGetAddedNodeInfo,
-	"getbestblock":          handleGetBestBlock,
-	"getbestblockhash":      handleGetBestBlockHash,
-	"getblock":              handleGetBlock,
-	"getblockchaininfo":     handleGetBlockChainInfo,
-	"getblockcount":         handleGetBlockCount,
-	"getblockhash":          handleGetBlockHash,
-	"getblockheader":        handleGetBlockHeader,
-	"getblocktemplate":      handleGetBlockTemplate,
-	"getcfilter":            handleGetCFilter,
-	"getcfilterheader":      handleGetCFilterHeader,
-	"getconnectioncount":    handleGetConnectionCount,
-	"getcurrentnet":         handleGetCurrentNet,
-	"getdifficulty":         handleGetDifficulty,
-	"getgenerate":           handleGetGenerate,
-	"gethashespersec":       handleGetHashesPerSec,
-	"getheaders":            handleGetHeaders,
-	"getinfo":               handleGetInfo,
-	"getmempoolinfo":        handleGetMempoolInfo,
-	"getmininginfo":         handleGetMiningInfo,
-	"getnettotals":          handleGetNetTotals,
-	"getnetworkhashps":      handleGetNetworkHashPS,
-	"getnetworkinfo":        handleGetNetworkInfo,
-	"getpeerinfo":           handleGetPeerInfo,
-	"getrawmempool":         handleGetRawMempool,
-	"getrawtransaction":     handleGetRawTransaction,
-	"gettxout":              handleGetTxOut,
-	"gettxoutproof":         handleGetTxOutProof,
-	"help":                  handleHelp,
-	"invalidateblock":       handleInvalidateBlock,
-	"node":                  handleNode,
-	"ping":                  handlePing,
-	"reconsiderblock":       handleReconsiderBlock,
-	"searchrawtransactions": handleSearchRawTransactions,
-	"sendrawtransaction":    handleSendRawTransaction,
-	"setgenerate":           handleSetGenerate,
-	"stop":                  handleStop,
-	"submitblock":           handleSubmitBlock,
-	"uptime":                handleUptime,
-	"validateaddress":       handleValidateAddress,
-	"verifychain":           handleVerifyChain,
-	"verifymessage":         handleVerifyMessage,
-	"verifytxoutproof":      handleVerifyTxOutProof,
-	"version":               handleVersion,
+	"addnode":                 handleAddNode,
+	"auditblocktemplate":      handleAuditBlockTemplate,
+	"compactslpindex":         handleCompactSlpIndex,
+	"createrawtransaction":    handleCreateRawTransaction,
+	"debuglevel":              handleDebugLevel,
+	"decoderawtransaction":    handleDecodeRawTransaction,
+	"decodescript":            handleDecodeScript,
+	"estimatefee":             handleEstimateFee,
+	"forcereseed":             handleForceReseed,
+	"generate":                handleGenerate,
+	"getaddednodeinfo":        handleGetAddedNodeInfo,
+	"getbestblock":            handleGetBestBlock,
+	"getbestblockhash":        handleGetBestBlockHash,
+	"getblock":                handleGetBlock,
+	"getblockbyheight":        handleGetBlockByHeight,
+	"getblockchaininfo":       handleGetBlockChainInfo,
+	"getblockcount":           handleGetBlockCount,
+	"getblockhash":            handleGetBlockHash,
+	"getblockheader":          handleGetBlockHeader,
+	"getblocktemplate":        handleGetBlockTemplate,
+	"getblockvalidationstats": handleGetBlockValidationStats,
+	"getcfilter":              handleGetCFilter,
+	"getcfilterheader":        handleGetCFilterHeader,
+	"getchaintips":            handleGetChainTips,
+	"getconnectioncount":      handleGetConnectionCount,
+	"getcpfpinfo":             handleGetCPFPInfo,
+	"getcurrentnet":           handleGetCurrentNet,
+	"getdbinfo":               handleGetDBInfo,
+	"getdifficulty":           handleGetDifficulty,
+	"getdsproof":              handleGetDSProof,
+	"getforkmonitorinfo":      handleGetForkMonitorInfo,
+	"getgenerate":             handleGetGenerate,
+	"gethashespersec":         handleGetHashesPerSec,
+	"getheaders":              handleGetHeaders,
+	"getinfo":                 handleGetInfo,
+	"getmempoolinfo":          handleGetMempoolInfo,
+	"getmempoolsnapshot":      handleGetMempoolSnapshot,
+	"getmempoolstats":         handleGetMempoolStats,
+	"getmempooltxgraph":       handleGetMempoolTxGraph,
+	"getmininginfo":           handleGetMiningInfo,
+	"getnettotals":            handleGetNetTotals,
+	"getnetworkhashps":        handleGetNetworkHashPS,
+	"getnetworkinfo":          handleGetNetworkInfo,
+	"getorphanpool":           handleGetOrphanPool,
+	"getorphanpoolinfo":       handleGetOrphanPoolInfo,
+	"getpeerinfo":             handleGetPeerInfo,
+	"getrawmempool":           handleGetRawMempool,
+	"getrawtransaction":       handleGetRawTransaction,
+	"getrejectedtxs":          handleGetRejectedTxs,
+	"getreorginfo":            handleGetReorgInfo,
+	"getscriptflags":          handleGetScriptFlags,
+	"gettxbroadcaststatus":    handleGetTxBroadcastStatus,
+	"gettxout":                handleGetTxOut,
+	"gettxoutproof":           handleGetTxOutProof,
+	"getutxostats":            handleGetUtxoStats,
+	"getverifychaininfo":      handleGetVerifyChainInfo,
+	"help":                    handleHelp,
+	"invalidateblock":         handleInvalidateBlock,
+	"node":                    handleNode,
+	"ping":                    handlePing,
+	"prioritisetransaction":   handlePrioritiseTransaction,
+	"reconsiderblock":         handleReconsiderBlock,
+	"regeneratecfilters":      handleRegenerateCFilters,
+	"searchrawtransactions":   handleSearchRawTransactions,
+	"sendrawtransaction":      handleSendRawTransaction,
+	"setdbcachesize":          handleSetDBCacheSize,
+	"setgenerate":             handleSetGenerate,
+	"setmocktime":             handleSetMockTime,
+	"setupgradeactivation":    handleSetUpgradeActivation,
+	"signdatasignature":       handleSignDataSignature,
+	"stop":                    handleStop,
+	"stopverifychain":         handleStopVerifyChain,
+	"submitblock":             handleSubmitBlock,
+	"submitheader":            handleSubmitHeader,
+	"uptime":                  handleUptime,
+	"validateaddress":         handleValidateAddress,
+	"validatescript":          handleValidateScript,
+	"verifychain":             handleVerifyChain,
+	"verifydatasignature":     handleVerifyDataSignature,
+	"verifymessage":           handleVerifyMessage,
+	"verifyscripts":           handleVerifyScripts,
+	"verifytxoutproof":        handleVerifyTxOutProof,
+	"version":                 handleVersion,
 }
 
 // list of commands that we recognize, but for which bchd has no support because
@@ -233,7 +290,6 @@ var rpcAskWallet = map[string]struct{}{
 // Commands that are currently unimplemented, but should ultimately be.
 var rpcUnimplemented = map[string]struct{}{
 	"estimatepriority": {},
-	"getchaintips":     {},
 	"getmempoolentry":  {},
 	"getwork":          {},
 	"preciousblock":    {},
@@ -255,36 +311,62 @@ var rpcLimited = map[string]struct{}{
 	"help": {},
 
 	// HTTP/S-only commands
-	"createrawtransaction":  {},
-	"decoderawtransaction":  {},
-	"decodescript":          {},
-	"estimatefee":           {},
-	"getbestblock":          {},
-	"getbestblockhash":      {},
-	"getblock":              {},
-	"getblockcount":         {},
-	"getblockhash":          {},
-	"getblockheader":        {},
-	"getcfilter":            {},
-	"getcfilterheader":      {},
-	"getcurrentnet":         {},
-	"getdifficulty":         {},
-	"getheaders":            {},
-	"getinfo":               {},
-	"getnettotals":          {},
-	"getnetworkhashps":      {},
-	"getrawmempool":         {},
-	"getrawtransaction":     {},
-	"gettxout":              {},
-	"gettxoutproof":         {},
-	"searchrawtransactions": {},
-	"sendrawtransaction":    {},
-	"submitblock":           {},
-	"uptime":                {},
-	"validateaddress":       {},
-	"verifymessage":         {},
-	"verifytxoutproof":      {},
-	"version":               {},
+	"createrawtransaction":    {},
+	"decoderawtransaction":    {},
+	"decodescript":            {},
+	"estimatefee":             {},
+	"getbestblock":            {},
+	"getbestblockhash":        {},
+	"getblock":                {},
+	"getblockbyheight":        {},
+	"getblockcount":           {},
+	"getblockhash":            {},
+	"getblockheader":          {},
+	"getblockvalidationstats": {},
+	"getcfilter":              {},
+	"getcfilterheader":        {},
+	"getchaintips":            {},
+	"getcurrentnet":           {},
+	"getdbinfo":               {},
+	"getdifficulty":           {},
+	"getdsproof":              {},
+	"getheaders":              {},
+	"getinfo":                 {},
+	"getmempoolstats":         {},
+	"getmempooltxgraph":       {},
+	"getnettotals":            {},
+	"getnetworkhashps":        {},
+	"getrawmempool":           {},
+	"getrawtransaction":       {},
+	"getreorginfo":            {},
+	"getscriptflags":          {},
+	"gettxout":                {},
+	"gettxoutproof":           {},
+	"getverifychaininfo":      {},
+	"searchrawtransactions":   {},
+	"sendrawtransaction":      {},
+	"submitblock":             {},
+	"uptime":                  {},
+	"validateaddress":         {},
+	"validatescript":          {},
+	"verifydatasignature":     {},
+	"verifymessage":           {},
+	"verifyscripts":           {},
+	"verifytxoutproof":        {},
+	"version":                 {},
+}
+
+// Commands that are available without authentication when the server runs in
+// public mode.  Only read-only commands which are cheap to serve are allowed.
+var rpcPublic = map[string]struct{}{
+	"getbestblockhash":  {},
+	"getblock":          {},
+	"getblockbyheight":  {},
+	"getblockchaininfo": {},
+	"getblockcount":     {},
+	"getblockhash":      {},
+	"getblockheader":    {},
+	"getrawtransaction": {},
 }
 
 // builderScript is a convenience function which is used for hard-coded scripts
@@ -343,9 +425,13 @@ type gbtWorkState struct {
 	sync.Mutex
 	lastTxUpdate  time.Time
 	lastGenerated time.Time
+	lastMinute    time.Time
 	prevHash      *chainhash.Hash
 	minTimestamp  time.Time
 	template      *mining.BlockTemplate
+	sourceTxns    []*mining.TxDesc
+	candidates    []*mining.BlockTemplate
+	staleTemplate *mining.BlockTemplate
 	notifyMap     map[chainhash.Hash]map[int64]chan struct{}
 	timeSource    blockchain.MedianTimeSource
 	maxSigChecks  uint32
@@ -523,6 +609,56 @@ func messageToHex(msg wire.Message) (string, error) {
 	return hex.EncodeToString(buf.Bytes()), nil
 }
 
+// handleCompactSlpIndex implements the compactslpindex command.
+func handleCompactSlpIndex(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.CompactSlpIndexCmd)
+
+	if s.cfg.SlpIndex == nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCMisc,
+			Message: "SLP index must be enabled (--slpindex)",
+		}
+	}
+
+	// Abort the compaction between batches when the server shuts down.
+	interrupt := make(chan struct{})
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-s.quit:
+			close(interrupt)
+		case <-done:
+		}
+	}()
+
+	isUnspent := func(outpoint wire.OutPoint) (bool, error) {
+		entry, err := s.cfg.Chain.FetchUtxoEntry(outpoint)
+		if err != nil {
+			return false, err
+		}
+		return entry != nil && !entry.IsSpent(), nil
+	}
+	policy := &indexers.SlpCompactPolicy{MaxUnspentAmount: *c.MaxUnspentAmount}
+	result, err := s.cfg.SlpIndex.Compact(policy, isUnspent, interrupt)
+	if err == indexers.ErrSlpCompactInProgress {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCMisc,
+			Message: err.Error(),
+		}
+	}
+	if err != nil {
+		context := "Failed to compact the slp index"
+		return nil, internalRPCError(err.Error(), context)
+	}
+
+	return &btcjson.CompactSlpIndexResult{
+		Tokens:         result.Tokens,
+		Entries:        result.Entries,
+		ReclaimedBytes: result.ReclaimedBytes,
+	}, nil
+}
+
 // handleCreateRawTransaction handles createrawtransaction commands.
 func handleCreateRawTransaction(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
 	c := cmd.(*btcjson.CreateRawTransactionCmd)
@@ -703,6 +839,22 @@ func handleDebugLevel(s *rpcServer, cmd interface{}, closeNotifier <-chan bool)
 	return "Done.", nil
 }
 
+// handleForceReseed implements the forcereseed command.
+func handleForceReseed(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	if cfg.DisableDNSSeed {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCMisc,
+			Message: "Seeding is disabled",
+		}
+	}
+
+	dnsSeeds, httpSeeds := s.cfg.ConnMgr.Reseed()
+	return &btcjson.ForceReseedResult{
+		DNSSeeds:  dnsSeeds,
+		HTTPSeeds: httpSeeds,
+	}, nil
+}
+
 // createVinList returns a slice of JSON objects for the inputs of the passed
 // transaction.
 func createVinList(mtx *wire.MsgTx) []btcjson.Vin {
@@ -812,12 +964,145 @@ func createTxRawResult(chainParams *chaincfg.Params, mtx *wire.MsgTx,
 		txReply.Time = blkHeader.Timestamp.Unix()
 		txReply.Blocktime = blkHeader.Timestamp.Unix()
 		txReply.BlockHash = blkHash
+		txReply.BlockHeight = blkHeight
 		txReply.Confirmations = uint64(1 + chainHeight - blkHeight)
 	}
 
 	return txReply, nil
 }
 
+// fetchPrevOuts returns the outputs spent by the inputs of the passed
+// transaction in the order of the inputs, or nil when they aren't all
+// available.  The outputs spent by a transaction in the block with the passed
+// hash are loaded from the spend journal of the block, falling back to the
+// transaction index when the block was pruned, while those spent by a
+// transaction in the memory pool are looked up in the memory pool and the utxo
+// set.
+func fetchPrevOuts(s *rpcServer, mtx *wire.MsgTx, blkHash *chainhash.Hash) []*wire.TxOut {
+	if blockchain.IsCoinBaseTx(mtx) {
+		return nil
+	}
+
+	prevOuts := make([]*wire.TxOut, 0, len(mtx.TxIn))
+	if blkHash != nil {
+		block, err := s.cfg.Chain.BlockByHash(blkHash)
+		if err == nil {
+			return fetchPrevOutsFromJournal(s, mtx, block)
+		}
+		if s.cfg.TxIndex == nil {
+			return nil
+		}
+
+		originOutputs, err := fetchInputTxos(s, mtx)
+		if err != nil {
+			rpcsLog.Debugf("Unable to fetch the outputs spent by %v: %v",
+				mtx.TxHash(), err)
+			return nil
+		}
+		for _, txIn := range mtx.TxIn {
+			txOut := originOutputs[txIn.PreviousOutPoint]
+			prevOuts = append(prevOuts, &txOut)
+		}
+		return prevOuts
+	}
+
+	for _, txIn := range mtx.TxIn {
+		origin := txIn.PreviousOutPoint
+		originTx, err := s.cfg.TxMemPool.FetchTransaction(&origin.Hash)
+		if err == nil {
+			txOuts := originTx.MsgTx().TxOut
+			if origin.Index >= uint32(len(txOuts)) {
+				return nil
+			}
+			prevOuts = append(prevOuts, txOuts[origin.Index])
+			continue
+		}
+
+		entry, err := s.cfg.Chain.FetchUtxoEntry(origin)
+		if err != nil || entry == nil || entry.IsSpent() {
+			return nil
+		}
+		prevOuts = append(prevOuts, wire.NewTxOut(entry.Amount(),
+			entry.PkScript(), entry.TokenData()))
+	}
+	return prevOuts
+}
+
+// fetchPrevOutsFromJournal returns the outputs spent by the inputs of the
+// passed transaction included in the passed block from the spend journal of
+// the block, or nil when they aren't available.
+func fetchPrevOutsFromJournal(s *rpcServer, mtx *wire.MsgTx, block *bchutil.Block) []*wire.TxOut {
+	// The journal holds the outputs spent by every input of the block in
+	// order, except for the coinbase, so skip those spent by the preceding
+	// transactions.
+	txHash := mtx.TxHash()
+	offset := 0
+	found := false
+	for _, tx := range block.Transactions()[1:] {
+		if *tx.Hash() == txHash {
+			found = true
+			break
+		}
+		offset += len(tx.MsgTx().TxIn)
+	}
+	if !found {
+		return nil
+	}
+
+	stxos, err := s.cfg.Chain.FetchSpendJournal(block)
+	if err != nil || len(stxos) < offset+len(mtx.TxIn) {
+		rpcsLog.Debugf("Unable to load the spend journal of block %v: %v",
+			block.Hash(), err)
+		return nil
+	}
+
+	prevOuts := make([]*wire.TxOut, 0, len(mtx.TxIn))
+	for _, stxo := range stxos[offset : offset+len(mtx.TxIn)] {
+		// The journal stores the token data of an output as a prefix
+		// of its public key script.
+		var tokenData wire.TokenData
+		pkScript, err := tokenData.SeparateTokenDataFromPKScriptIfExists(
+			stxo.PkScript, 0)
+		if err != nil || pkScript == nil {
+			tokenData = wire.TokenData{}
+			pkScript = stxo.PkScript
+		}
+		prevOuts = append(prevOuts, wire.NewTxOut(stxo.Amount, pkScript,
+			tokenData))
+	}
+	return prevOuts
+}
+
+// addPrevOutInfo adds the value and addresses of the passed outputs spent by
+// the inputs of the transaction of the passed result along with the fee paid by
+// the transaction.
+func addPrevOutInfo(txReply *btcjson.TxRawResult, mtx *wire.MsgTx,
+	prevOuts []*wire.TxOut, chainParams *chaincfg.Params) {
+
+	var totalIn, totalOut int64
+	for i, prevOut := range prevOuts {
+		// Ignore the error here since an error means the script
+		// couldn't parse and there is no additional information about
+		// it anyways.
+		_, addrs, _, _ := txscript.ExtractPkScriptAddrs(prevOut.PkScript,
+			chainParams)
+		encodedAddrs := make([]string, len(addrs))
+		for j, addr := range addrs {
+			encodedAddrs[j] = addr.EncodeAddress()
+		}
+		txReply.Vin[i].PrevOut = &btcjson.PrevOut{
+			Addresses: encodedAddrs,
+			Value:     bchutil.Amount(prevOut.Value).ToBCH(),
+		}
+		totalIn += prevOut.Value
+	}
+	for _, txOut := range mtx.TxOut {
+		totalOut += txOut.Value
+	}
+	fee := bchutil.Amount(totalIn - totalOut).ToBCH()
+	txReply.Fee = &fee
+}
+
 // handleDecodeRawTransaction handles decoderawtransaction commands.
 func handleDecodeRawTransaction(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
 	c := cmd.(*btcjson.DecodeRawTransactionCmd)
@@ -911,8 +1196,38 @@ func handleEstimateFee(s *rpcServer, cmd interface{}, closeNotifier <-chan bool)
 		return -1.0, errors.New("Parameter NumBlocks must be positive")
 	}
 
-	feeRate, err := s.cfg.FeeEstimator.EstimateFee(uint32(c.NumBlocks))
+	// The mempool based estimate is the fee rate needed to be ahead of
+	// the backlog of the mempool in the next blocks of the size created by
+	// the block template generator, which is limited by the policy as well
+	// as by the consensus block size limit.
+	mode := cfg.EstimateFeeMode
+	if c.Mode != nil {
+		mode = *c.Mode
+	}
+	backlogFeeRate := func() mempool.SatoshiPerByte {
+		blockSize := min(uint64(cfg.BlockMaxSize),
+			s.cfg.Chain.BestSnapshot().BlockSizeLimit)
+		return s.cfg.TxMemPool.BacklogFeeRate(uint32(c.NumBlocks),
+			blockSize)
+	}
 
+	var feeRate mempool.BchPerKilobyte
+	var err error
+	switch mode {
+	case "historical":
+		feeRate, err = s.cfg.FeeEstimator.EstimateFee(uint32(c.NumBlocks))
+	case "mempool":
+		feeRate = backlogFeeRate().ToBchPerKb()
+	case "blended":
+		feeRate, err = s.cfg.FeeEstimator.EstimateFeeWithBacklog(
+			uint32(c.NumBlocks), backlogFeeRate())
+	default:
+		return nil, &btcjson.RPCError{
+			Code: btcjson.ErrRPCInvalidParameter,
+			Message: fmt.Sprintf("Invalid estimate mode %q, must be "+
+				"historical, mempool or blended", mode),
+		}
+	}
 	if err != nil {
 		return -1.0, err
 	}
@@ -923,6 +1238,10 @@ func handleEstimateFee(s *rpcServer, cmd interface{}, closeNotifier <-chan bool)
 
 // handleGenerate handles generate commands.
 func handleGenerate(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	if len(cfg.ReplicaOf) > 0 {
+		return nil, ErrRPCReplicaMining
+	}
+
 	// Respond with an error if there are no addresses to pay the
 	// created blocks to.
 	if len(cfg.miningAddrs) == 0 {
@@ -1084,37 +1403,49 @@ func handleGetBestBlockHash(s *rpcServer, cmd interface{}, closeNotifier <-chan
 	return best.Hash.String(), nil
 }
 
-// getDifficultyRatio returns the proof-of-work difficulty as a multiple of the
-// minimum difficulty using the passed bits field from the header of a block.
-func getDifficultyRatio(bits uint32, params *chaincfg.Params) float64 {
-	// The minimum difficulty is the max possible proof-of-work limit bits
-	// converted back to a number.  Note this is not the same as the proof of
-	// work limit directly because the block difficulty is encoded in a block
-	// with the compact form which loses precision.
-	max := blockchain.CompactToBig(params.PowLimitBits)
-	target := blockchain.CompactToBig(bits)
-
-	difficulty := new(big.Rat).SetFrac(max, target)
-	outString := difficulty.FloatString(8)
-	diff, err := strconv.ParseFloat(outString, 64)
-	if err != nil {
-		rpcsLog.Errorf("Cannot get difficulty: %v", err)
-		return 0
-	}
-	return diff
-}
-
 // handleGetBlock implements the getblock command.
 func handleGetBlock(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
 	c := cmd.(*btcjson.GetBlockCmd)
 
-	// Load the raw block bytes from the database.
 	hash, err := chainhash.NewHashFromStr(c.Hash)
 	if err != nil {
 		return nil, rpcDecodeHexError(c.Hash)
 	}
+
+	// Get the block height from a snapshot of the chain so the height,
+	// confirmations and next block are consistent during a reorganize.
+	snapshot := s.cfg.Chain.ChainSnapshot()
+	return getBlockResult(s, snapshot, hash, *c.Verbosity)
+}
+
+// handleGetBlockByHeight implements the getblockbyheight command.
+func handleGetBlockByHeight(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.GetBlockByHeightCmd)
+
+	snapshot := s.cfg.Chain.ChainSnapshot()
+	if c.Height < 0 || c.Height > int64(snapshot.Height()) {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCOutOfRange,
+			Message: "Block number out of range",
+		}
+	}
+	hash, err := snapshot.BlockHashByHeight(int32(c.Height))
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCOutOfRange,
+			Message: "Block number out of range",
+		}
+	}
+	return getBlockResult(s, snapshot, hash, *c.Verbosity)
+}
+
+// getBlockResult returns the getblock result for the block with the passed hash
+// at the passed verbosity.  The height, confirmations and next block are
+// taken from the passed snapshot of the chain.
+func getBlockResult(s *rpcServer, snapshot *blockchain.ChainSnapshot, hash *chainhash.Hash, verbosity btcjson.VerbosityLevel) (interface{}, error) {
+	// Load the raw block bytes from the database.
 	var blkBytes []byte
-	err = s.cfg.DB.View(func(dbTx database.Tx) error {
+	err := s.cfg.DB.View(func(dbTx database.Tx) error {
 		var err error
 		blkBytes, err = dbTx.FetchBlock(hash)
 		return err
@@ -1127,7 +1458,7 @@ func handleGetBlock(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (i
 	}
 
 	// When the verbose flag isn't set, return the serialized block as a hex-encoded string.
-	if c.Verbosity != nil && *c.Verbosity == 0 {
+	if verbosity == 0 {
 		return hex.EncodeToString(blkBytes), nil
 	}
 
@@ -1140,19 +1471,18 @@ func handleGetBlock(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (i
 		return nil, internalRPCError(err.Error(), context)
 	}
 
-	// Get the block height from chain.
-	blockHeight, err := s.cfg.Chain.BlockHeightByHash(hash)
+	blockHeight, err := snapshot.BlockHeightByHash(hash)
 	if err != nil {
 		context := "Failed to obtain block height"
 		return nil, internalRPCError(err.Error(), context)
 	}
 	blk.SetHeight(blockHeight)
-	best := s.cfg.Chain.BestSnapshot()
+	bestHeight := snapshot.Height()
 
 	// Get next block hash unless there are none.
 	var nextHashString string
-	if blockHeight < best.Height {
-		nextHash, err := s.cfg.Chain.BlockHashByHeight(blockHeight + 1)
+	if blockHeight < bestHeight {
+		nextHash, err := snapshot.BlockHashByHeight(blockHeight + 1)
 		if err != nil {
 			context := "No next block"
 			return nil, internalRPCError(err.Error(), context)
@@ -1163,22 +1493,22 @@ func handleGetBlock(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (i
 	params := s.cfg.ChainParams
 	blockHeader := &blk.MsgBlock().Header
 	blockReply := btcjson.GetBlockVerboseResult{
-		Hash:          c.Hash,
+		Hash:          hash.String(),
 		Version:       blockHeader.Version,
 		VersionHex:    fmt.Sprintf("%08x", blockHeader.Version),
 		MerkleRoot:    blockHeader.MerkleRoot.String(),
 		PreviousHash:  blockHeader.PrevBlock.String(),
 		Nonce:         blockHeader.Nonce,
 		Time:          blockHeader.Timestamp.Unix(),
-		Confirmations: int64(1 + best.Height - blockHeight),
+		Confirmations: int64(1 + bestHeight - blockHeight),
 		Height:        int64(blockHeight),
 		Size:          int32(len(blkBytes)),
 		Bits:          strconv.FormatInt(int64(blockHeader.Bits), 16),
-		Difficulty:    getDifficultyRatio(blockHeader.Bits, params),
+		Difficulty:    blockchain.CalcDifficultyRatio(blockHeader.Bits, params),
 		NextHash:      nextHashString,
 	}
 
-	if *c.Verbosity == 1 {
+	if verbosity == 1 {
 		transactions := blk.Transactions()
 		txNames := make([]string, len(transactions))
 		for i, tx := range transactions {
@@ -1192,13 +1522,25 @@ func handleGetBlock(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (i
 		for i, tx := range txns {
 			rawTxn, err := createTxRawResult(params, tx.MsgTx(),
 				tx.Hash().String(), blockHeader, hash.String(),
-				blockHeight, best.Height)
+				blockHeight, bestHeight)
 			if err != nil {
 				return nil, err
 			}
 			rawTxns[i] = *rawTxn
 		}
 		blockReply.RawTx = rawTxns
+
+		flags, err := s.cfg.Chain.BlockScriptFlags(hash)
+		if err != nil {
+			context := "Failed to fetch the block script flags"
+			return nil, internalRPCError(err.Error(), context)
+		}
+		if flags != nil {
+			blockReply.ScriptFlags = &btcjson.BlockScriptFlagsResult{
+				Flags:      flags.Flags.Names(),
+				ScriptsRun: flags.ScriptsRun,
+			}
+		}
 	}
 
 	return blockReply, nil
@@ -1243,12 +1585,16 @@ func handleGetBlockChainInfo(s *rpcServer, cmd interface{}, closeNotifier <-chan
 		Blocks:               chainSnapshot.Height,
 		Headers:              chainSnapshot.Height,
 		BestBlockHash:        chainSnapshot.Hash.String(),
-		Difficulty:           getDifficultyRatio(chainSnapshot.Bits, params),
+		Difficulty:           chainSnapshot.Difficulty,
 		MedianTime:           chainSnapshot.MedianTime.Unix(),
+		BlockSizeLimit:       chainSnapshot.BlockSizeLimit,
 		Pruned:               false,
 		Bip9SoftForks:        make(map[string]*btcjson.Bip9SoftForkDescription),
 		VerificationProgress: verifyProgress,
 		SyncHeight:           syncHeight,
+		ScriptFlags:          chain.NextBlockScriptFlags().Names(),
+		ScriptFlagOverrides:  chain.ScriptFlagOverrides().Names(),
+		Warnings:             chainWarnings(s),
 	}
 
 	// Next, populate the response with information describing the current
@@ -1306,13 +1652,14 @@ func handleGetBlockChainInfo(s *rpcServer, cmd interface{}, closeNotifier <-chan
 			}
 		}
 
-		// Query the chain for the current status of the deployment as
-		// identified by its deployment ID.
-		deploymentStatus, err := chain.ThresholdState(uint32(deployment))
-		if err != nil {
+		// Take the current status of the deployment as identified by
+		// its deployment ID from the snapshot.
+		if deployment >= len(chainSnapshot.DeploymentStates) {
 			context := "Failed to obtain deployment status"
-			return nil, internalRPCError(err.Error(), context)
+			return nil, internalRPCError("deployment state not "+
+				"cached", context)
 		}
+		deploymentStatus := chainSnapshot.DeploymentStates[deployment]
 
 		// Attempt to convert the current deployment status into a
 		// human readable string. If the status is unrecognized, then a
@@ -1345,6 +1692,30 @@ func handleGetBlockCount(s *rpcServer, cmd interface{}, closeNotifier <-chan boo
 	return int64(best.Height), nil
 }
 
+// handleGetChainTips implements the getchaintips command.
+func handleGetChainTips(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	tips := s.cfg.Chain.ChainTips()
+	sort.Slice(tips, func(i, j int) bool {
+		return tips[i].Height > tips[j].Height
+	})
+
+	results := make([]btcjson.GetChainTipsResult, 0, len(tips))
+	for _, tip := range tips {
+		result := btcjson.GetChainTipsResult{
+			Height:    tip.Height,
+			Hash:      tip.Hash.String(),
+			BranchLen: tip.BranchLen,
+			Status:    tip.Status.String(),
+		}
+		if tip.InvalidBlock != nil {
+			result.InvalidBlock = tip.InvalidBlock.Hash.String()
+			result.InvalidReason = tip.InvalidBlock.Reason
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
 // handleGetBlockHash implements the getblockhash command.
 func handleGetBlockHash(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
 	c := cmd.(*btcjson.GetBlockHashCmd)
@@ -1390,18 +1761,20 @@ func handleGetBlockHeader(s *rpcServer, cmd interface{}, closeNotifier <-chan bo
 
 	// The verbose flag is set, so generate the JSON object and return it.
 
-	// Get the block height from chain.
-	blockHeight, err := s.cfg.Chain.BlockHeightByHash(hash)
+	// Get the block height from a snapshot of the chain so the height,
+	// confirmations and next block are consistent during a reorganize.
+	snapshot := s.cfg.Chain.ChainSnapshot()
+	blockHeight, err := snapshot.BlockHeightByHash(hash)
 	if err != nil {
 		context := "Failed to obtain block height"
 		return nil, internalRPCError(err.Error(), context)
 	}
-	best := s.cfg.Chain.BestSnapshot()
+	bestHeight := snapshot.Height()
 
 	// Get next block hash unless there are none.
 	var nextHashString string
-	if blockHeight < best.Height {
-		nextHash, err := s.cfg.Chain.BlockHashByHeight(blockHeight + 1)
+	if blockHeight < bestHeight {
+		nextHash, err := snapshot.BlockHashByHeight(blockHeight + 1)
 		if err != nil {
 			context := "No next block"
 			return nil, internalRPCError(err.Error(), context)
@@ -1412,7 +1785,7 @@ func handleGetBlockHeader(s *rpcServer, cmd interface{}, closeNotifier <-chan bo
 	params := s.cfg.ChainParams
 	blockHeaderReply := btcjson.GetBlockHeaderVerboseResult{
 		Hash:          c.Hash,
-		Confirmations: int64(1 + best.Height - blockHeight),
+		Confirmations: int64(1 + bestHeight - blockHeight),
 		Height:        blockHeight,
 		Version:       blockHeader.Version,
 		VersionHex:    fmt.Sprintf("%08x", blockHeader.Version),
@@ -1422,7 +1795,7 @@ func handleGetBlockHeader(s *rpcServer, cmd interface{}, closeNotifier <-chan bo
 		Nonce:         uint64(blockHeader.Nonce),
 		Time:          blockHeader.Timestamp.Unix(),
 		Bits:          strconv.FormatInt(int64(blockHeader.Bits), 16),
-		Difficulty:    getDifficultyRatio(blockHeader.Bits, params),
+		Difficulty:    blockchain.CalcDifficultyRatio(blockHeader.Bits, params),
 	}
 	return blockHeaderReply, nil
 }
@@ -1516,6 +1889,28 @@ func (state *gbtWorkState) NotifyBlockConnected(blockHash *chainhash.Hash) {
 	}()
 }
 
+// templateFees returns the total fees of the last block template generated by
+// the server the passed block was built from, along with whether there is such
+// a template.  The template is matched while holding the state lock since it
+// may be updated in place with last minute transactions.
+//
+// This function is safe for concurrent access.
+func (state *gbtWorkState) templateFees(block *bchutil.Block) (int64, bool) {
+	state.Lock()
+	defer state.Unlock()
+
+	for _, template := range []*mining.BlockTemplate{state.template,
+		state.staleTemplate} {
+
+		if template != nil && builtFromTemplate(block, template) {
+			// The first entry of the template fees is the negative
+			// of the total fees of the template.
+			return -template.Fees[0], true
+		}
+	}
+	return 0, false
+}
+
 // NotifyMempoolTx uses the new last updated time for the transaction memory
 // pool to notify any long poll clients with a new block template when their
 // existing block template is stale due to enough time passing and the contents
@@ -1572,14 +1967,17 @@ func (state *gbtWorkState) templateUpdateChan(prevHash *chainhash.Hash, lastGene
 // changed or the transactions in the memory pool have been updated and it has
 // been long enough since the last template was generated.  Otherwise, the
 // timestamp for the existing block template is updated (and possibly the
-// difficulty on testnet per the consesus rules).  Finally, if the
+// difficulty on testnet per the consesus rules) and, when the
+// blocklastminutefee option is set, the transactions paying at least that fee
+// which arrived in the memory pool since are added to it.  Finally, if the
 // useCoinbaseValue flag is false and the existing block template does not
 // already contain a valid payment address, the block template will be updated
 // with a randomly selected payment address from the list of configured
-// addresses.
+// addresses.  The candidate block templates are created or updated the same
+// way when withCandidates is set.
 //
 // This function MUST be called with the state locked.
-func (state *gbtWorkState) updateBlockTemplate(s *rpcServer, useCoinbaseValue bool) error {
+func (state *gbtWorkState) updateBlockTemplate(s *rpcServer, useCoinbaseValue, withCandidates bool) error {
 	generator := s.cfg.Generator
 	lastTxUpdate := generator.TxSource().LastUpdated()
 	if lastTxUpdate.IsZero() {
@@ -1602,8 +2000,10 @@ func (state *gbtWorkState) updateBlockTemplate(s *rpcServer, useCoinbaseValue bo
 
 		// Reset the previous best hash the block template was generated
 		// against so any errors below cause the next invocation to try
-		// again.
+		// again.  The candidate templates are stale too.
 		state.prevHash = nil
+		state.sourceTxns = nil
+		state.candidates = nil
 
 		// Choose a payment address at random if the caller requests a
 		// full coinbase as opposed to only the pertinent details needed
@@ -1617,8 +2017,12 @@ func (state *gbtWorkState) updateBlockTemplate(s *rpcServer, useCoinbaseValue bo
 		// can redeem.  This is only acceptable because the returned
 		// block template doesn't include the coinbase, so the caller
 		// will ultimately create their own coinbase which pays to the
-		// appropriate address(es).
-		blkTemplate, err := generator.NewBlockTemplate(payAddr)
+		// appropriate address(es).  The transactions it is generated
+		// from are kept so the candidate templates are generated from
+		// the same ones.
+		sourceTxns := generator.TxSource().MiningDescs()
+		blkTemplate, err := generator.NewBlockTemplateFromDescs(payAddr,
+			sourceTxns, &mining.TemplateProfile{})
 		if err != nil {
 			return internalRPCError("Failed to create new block "+
 				"template: "+err.Error(), "")
@@ -1634,11 +2038,22 @@ func (state *gbtWorkState) updateBlockTemplate(s *rpcServer, useCoinbaseValue bo
 		best := s.cfg.Chain.BestSnapshot()
 		minTimestamp := mining.MinimumMedianTime(best)
 
+		// Keep the last template of the previous best block around so
+		// a block built from it can still be matched against it once
+		// it is connected.
+		if state.template != nil && state.template.Block.Header.PrevBlock !=
+			template.Block.Header.PrevBlock {
+
+			state.staleTemplate = state.template
+		}
+
 		// Update work state to ensure another block template isn't
 		// generated until needed.
 		state.template = template
+		state.sourceTxns = sourceTxns
 		state.lastGenerated = time.Now()
 		state.lastTxUpdate = lastTxUpdate
+		state.lastMinute = lastTxUpdate
 		state.prevHash = latestHash
 		state.minTimestamp = minTimestamp
 		state.maxSigChecks = template.MaxSigChecks
@@ -1666,23 +2081,22 @@ func (state *gbtWorkState) updateBlockTemplate(s *rpcServer, useCoinbaseValue bo
 		// mining addresses to be specified via the config, an error is
 		// returned if none have been specified.
 		if !useCoinbaseValue && !template.ValidPayAddress {
-			// Choose a payment address at random.
-			payToAddr := cfg.miningAddrs[rand.Intn(len(cfg.miningAddrs))]
+			if err := setTemplatePayAddress(template); err != nil {
+				return err
+			}
+		}
 
-			// Update the block coinbase output of the template to
-			// pay to the randomly selected payment address.
-			pkScript, err := txscript.PayToAddrScript(payToAddr)
+		// Add the high fee transactions which arrived since the last
+		// pass over the memory pool to the existing block template
+		// when configured.
+		if cfg.blockLastMinuteFee > 0 && state.lastMinute != lastTxUpdate {
+			_, err := generator.AddLastMinuteTxs(template,
+				int64(cfg.blockLastMinuteFee))
 			if err != nil {
-				context := "Failed to create pay-to-addr script"
-				return internalRPCError(err.Error(), context)
+				rpcsLog.Warnf("Failed to add last-minute "+
+					"transactions to block template: %v", err)
 			}
-			template.Block.Transactions[0].TxOut[0].PkScript = pkScript
-			template.ValidPayAddress = true
-
-			// Update the merkle root.
-			block := bchutil.NewBlock(template.Block)
-			merkles := blockchain.BuildMerkleTreeStore(block.Transactions())
-			template.Block.Header.MerkleRoot = *merkles[len(merkles)-1]
+			state.lastMinute = lastTxUpdate
 		}
 
 		// Set locals for convenience.
@@ -1701,15 +2115,79 @@ func (state *gbtWorkState) updateBlockTemplate(s *rpcServer, useCoinbaseValue bo
 			targetDifficulty)
 	}
 
+	if withCandidates {
+		return state.updateCandidates(s, useCoinbaseValue)
+	}
+	return nil
+}
+
+// updateCandidates creates the candidate block templates of the work state from
+// the transactions of the current block template when they don't exist yet for
+// it.  Otherwise, the
+// timestamps and payment addresses of the existing ones are updated like the
+// ones of the current block template.
+//
+// This function MUST be called with the state locked.
+func (state *gbtWorkState) updateCandidates(s *rpcServer, useCoinbaseValue bool) error {
+	generator := s.cfg.Generator
+	if state.candidates == nil {
+		var payAddr bchutil.Address
+		if !useCoinbaseValue {
+			payAddr = cfg.miningAddrs[rand.Intn(len(cfg.miningAddrs))]
+		}
+		candidates, err := generator.NewBlockTemplates(payAddr,
+			state.sourceTxns, gbtTemplateProfiles)
+		if err != nil {
+			return internalRPCError("Failed to create candidate "+
+				"block templates: "+err.Error(), "")
+		}
+		state.candidates = candidates
+		return nil
+	}
+
+	for _, template := range state.candidates {
+		if !useCoinbaseValue && !template.ValidPayAddress {
+			if err := setTemplatePayAddress(template); err != nil {
+				return err
+			}
+		}
+		generator.UpdateBlockTime(template.Block)
+		template.Block.Header.Nonce = 0
+	}
+	return nil
+}
+
+// setTemplatePayAddress updates the coinbase output of the passed block
+// template to pay to a randomly selected payment address from the list of
+// configured addresses.
+func setTemplatePayAddress(template *mining.BlockTemplate) error {
+	// Choose a payment address at random.
+	payToAddr := cfg.miningAddrs[rand.Intn(len(cfg.miningAddrs))]
+
+	// Update the block coinbase output of the template to pay to the
+	// randomly selected payment address.
+	pkScript, err := txscript.PayToAddrScript(payToAddr)
+	if err != nil {
+		context := "Failed to create pay-to-addr script"
+		return internalRPCError(err.Error(), context)
+	}
+	template.Block.Transactions[0].TxOut[0].PkScript = pkScript
+	template.ValidPayAddress = true
+
+	// Update the merkle root.
+	block := bchutil.NewBlock(template.Block)
+	merkles := blockchain.BuildMerkleTreeStore(block.Transactions())
+	template.Block.Header.MerkleRoot = *merkles[len(merkles)-1]
 	return nil
 }
 
 // blockTemplateResult returns the current block template associated with the
 // state as a btcjson.GetBlockTemplateResult that is ready to be encoded to JSON
-// and returned to the caller.
+// and returned to the caller.  The candidate block templates are included when
+// withCandidates is set.
 //
 // This function MUST be called with the state locked.
-func (state *gbtWorkState) blockTemplateResult(useCoinbaseValue bool, submitOld *bool) (*btcjson.GetBlockTemplateResult, error) {
+func (state *gbtWorkState) blockTemplateResult(useCoinbaseValue, withCandidates bool, submitOld *bool) (*btcjson.GetBlockTemplateResult, error) {
 	// Ensure the timestamps are still in valid range for the template.
 	// This should really only ever happen if the local clock is changed
 	// after the template is generated, but it's important to avoid serving
@@ -1729,9 +2207,85 @@ func (state *gbtWorkState) blockTemplateResult(useCoinbaseValue bool, submitOld
 		}
 	}
 
+	transactions, sigChecks, err := templateResultTxns(template)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate the block template reply.  Note that following mutations are
+	// implied by the included or omission of fields:
+	//  Including MinTime -> time/decrement
+	//  Omitting CoinbaseTxn -> coinbase, generation
+	targetDifficulty := fmt.Sprintf("%064x", blockchain.CompactToBig(header.Bits))
+	templateID := encodeTemplateID(state.prevHash, state.lastGenerated)
+	reply := btcjson.GetBlockTemplateResult{
+		Bits:              strconv.FormatInt(int64(header.Bits), 16),
+		CurTime:           header.Timestamp.Unix(),
+		Height:            int64(template.Height),
+		PreviousHash:      header.PrevBlock.String(),
+		SigCheckLimit:     int64(template.MaxSigChecks),
+		SigCheckTotal:     sigChecks,
+		SigCheckRemaining: int64(template.MaxSigChecks) - sigChecks,
+		SizeLimit:         int64(template.MaxBlockSize),
+		Transactions:      transactions,
+		Version:           header.Version,
+		LongPollID:        templateID,
+		SubmitOld:         submitOld,
+		Target:            targetDifficulty,
+		MinTime:           state.minTimestamp.Unix(),
+		MaxTime:           maxTime.Unix(),
+		Mutable:           gbtMutableFields,
+		NonceRange:        gbtNonceRange,
+		Capabilities:      gbtCapabilities,
+	}
+
+	if useCoinbaseValue {
+		reply.CoinbaseAux = gbtCoinbaseAux
+		reply.CoinbaseValue = &msgBlock.Transactions[0].TxOut[0].Value
+	} else {
+		coinbaseTxn, err := templateCoinbaseTxn(template)
+		if err != nil {
+			return nil, err
+		}
+		reply.CoinbaseTxn = coinbaseTxn
+	}
+
+	if withCandidates {
+		for i, candidate := range state.candidates {
+			transactions, sigChecks, err := templateResultTxns(candidate)
+			if err != nil {
+				return nil, err
+			}
+			result := btcjson.GetBlockTemplateResultCandidate{
+				Profile:       gbtTemplateProfiles[i].Name,
+				SizeLimit:     int64(candidate.MaxBlockSize),
+				SigCheckTotal: sigChecks,
+				Fees:          -candidate.Fees[0],
+				Transactions:  transactions,
+			}
+			if useCoinbaseValue {
+				result.CoinbaseValue = &candidate.Block.Transactions[0].TxOut[0].Value
+			} else {
+				result.CoinbaseTxn, err = templateCoinbaseTxn(candidate)
+				if err != nil {
+					return nil, err
+				}
+			}
+			reply.Templates = append(reply.Templates, result)
+		}
+	}
+
+	return &reply, nil
+}
+
+// templateResultTxns converts the transactions of the passed block template to
+// template result transactions and returns them along with the total number of
+// sigchecks of the template.  The result does not include the coinbase.
+func templateResultTxns(template *mining.BlockTemplate) ([]btcjson.GetBlockTemplateResultTx, int64, error) {
 	// Convert each transaction in the block template to a template result
 	// transaction.  The result does not include the coinbase, so notice
 	// the adjustments to the various lengths and indices.
+	msgBlock := template.Block
 	numTx := len(msgBlock.Transactions)
 	transactions := make([]btcjson.GetBlockTemplateResultTx, 0, numTx-1)
 	txIndex := make(map[chainhash.Hash]int64, numTx)
@@ -1767,7 +2321,7 @@ func (state *gbtWorkState) blockTemplateResult(useCoinbaseValue bool, submitOld
 		txBuf := bytes.NewBuffer(make([]byte, 0, tx.SerializeSize()))
 		if err := tx.Serialize(txBuf); err != nil {
 			context := "Failed to serialize transaction"
-			return nil, internalRPCError(err.Error(), context)
+			return nil, 0, internalRPCError(err.Error(), context)
 		}
 
 		bTx := bchutil.NewTx(tx)
@@ -1781,69 +2335,38 @@ func (state *gbtWorkState) blockTemplateResult(useCoinbaseValue bool, submitOld
 		}
 		transactions = append(transactions, resultTx)
 	}
+	return transactions, sigChecks, nil
+}
 
-	// Generate the block template reply.  Note that following mutations are
-	// implied by the included or omission of fields:
-	//  Including MinTime -> time/decrement
-	//  Omitting CoinbaseTxn -> coinbase, generation
-	targetDifficulty := fmt.Sprintf("%064x", blockchain.CompactToBig(header.Bits))
-	templateID := encodeTemplateID(state.prevHash, state.lastGenerated)
-	reply := btcjson.GetBlockTemplateResult{
-		Bits:          strconv.FormatInt(int64(header.Bits), 16),
-		CurTime:       header.Timestamp.Unix(),
-		Height:        int64(template.Height),
-		PreviousHash:  header.PrevBlock.String(),
-		SigCheckLimit: int64(template.MaxSigChecks),
-		SigCheckTotal: sigChecks,
-		SizeLimit:     int64(template.MaxBlockSize),
-		Transactions:  transactions,
-		Version:       header.Version,
-		LongPollID:    templateID,
-		SubmitOld:     submitOld,
-		Target:        targetDifficulty,
-		MinTime:       state.minTimestamp.Unix(),
-		MaxTime:       maxTime.Unix(),
-		Mutable:       gbtMutableFields,
-		NonceRange:    gbtNonceRange,
-		Capabilities:  gbtCapabilities,
-	}
-
-	if useCoinbaseValue {
-		reply.CoinbaseAux = gbtCoinbaseAux
-		reply.CoinbaseValue = &msgBlock.Transactions[0].TxOut[0].Value
-	} else {
-		// Ensure the template has a valid payment address associated
-		// with it when a full coinbase is requested.
-		if !template.ValidPayAddress {
-			return nil, &btcjson.RPCError{
-				Code: btcjson.ErrRPCInternal.Code,
-				Message: "A coinbase transaction has been " +
-					"requested, but the server has not " +
-					"been configured with any payment " +
-					"addresses via --miningaddr",
-			}
+// templateCoinbaseTxn returns the coinbase of the passed block template as a
+// template result transaction.
+func templateCoinbaseTxn(template *mining.BlockTemplate) (*btcjson.GetBlockTemplateResultTx, error) {
+	// Ensure the template has a valid payment address associated with it
+	// when a full coinbase is requested.
+	if !template.ValidPayAddress {
+		return nil, &btcjson.RPCError{
+			Code: btcjson.ErrRPCInternal.Code,
+			Message: "A coinbase transaction has been requested, " +
+				"but the server has not been configured with " +
+				"any payment addresses via --miningaddr",
 		}
+	}
 
-		// Serialize the transaction for conversion to hex.
-		tx := msgBlock.Transactions[0]
-		txBuf := bytes.NewBuffer(make([]byte, 0, tx.SerializeSize()))
-		if err := tx.Serialize(txBuf); err != nil {
-			context := "Failed to serialize transaction"
-			return nil, internalRPCError(err.Error(), context)
-		}
-
-		resultTx := btcjson.GetBlockTemplateResultTx{
-			Data:      hex.EncodeToString(txBuf.Bytes()),
-			Hash:      tx.TxHash().String(),
-			Depends:   []int64{},
-			Fee:       template.Fees[0],
-			SigChecks: template.SigChecks[0],
-		}
-
-		reply.CoinbaseTxn = &resultTx
+	// Serialize the transaction for conversion to hex.
+	tx := template.Block.Transactions[0]
+	txBuf := bytes.NewBuffer(make([]byte, 0, tx.SerializeSize()))
+	if err := tx.Serialize(txBuf); err != nil {
+		context := "Failed to serialize transaction"
+		return nil, internalRPCError(err.Error(), context)
 	}
 
-	return &reply, nil
+	return &btcjson.GetBlockTemplateResultTx{
+		Data:      hex.EncodeToString(txBuf.Bytes()),
+		Hash:      tx.TxHash().String(),
+		Depends:   []int64{},
+		Fee:       template.Fees[0],
+		SigChecks: template.SigChecks[0],
+	}, nil
 }
 
 // handleGetBlockTemplateLongPoll is a helper for handleGetBlockTemplateRequest
@@ -1856,14 +2379,14 @@ func (state *gbtWorkState) blockTemplateResult(useCoinbaseValue bool, submitOld
 // has passed without finding a solution.
 //
 // See https://en.bitcoin.it/wiki/BIP_0022 for more details.
-func handleGetBlockTemplateLongPoll(s *rpcServer, longPollID string, useCoinbaseValue bool, closeNotifier <-chan bool) (interface{}, error) {
+func handleGetBlockTemplateLongPoll(s *rpcServer, longPollID string, useCoinbaseValue, withCandidates bool, closeNotifier <-chan bool) (interface{}, error) {
 	state := s.gbtWorkState
 	state.Lock()
 	// The state unlock is intentionally not deferred here since it needs to
 	// be manually unlocked before waiting for a notification about block
 	// template changes.
 
-	if err := state.updateBlockTemplate(s, useCoinbaseValue); err != nil {
+	if err := state.updateBlockTemplate(s, useCoinbaseValue, withCandidates); err != nil {
 		state.Unlock()
 		return nil, err
 	}
@@ -1872,7 +2395,7 @@ func handleGetBlockTemplateLongPoll(s *rpcServer, longPollID string, useCoinbase
 	// the caller is invalid.
 	prevHash, lastGenerated, err := decodeTemplateID(longPollID)
 	if err != nil {
-		result, err := state.blockTemplateResult(useCoinbaseValue, nil)
+		result, err := state.blockTemplateResult(useCoinbaseValue, withCandidates, nil)
 		if err != nil {
 			state.Unlock()
 			return nil, err
@@ -1894,7 +2417,7 @@ func handleGetBlockTemplateLongPoll(s *rpcServer, longPollID string, useCoinbase
 		// already been found and added to the block chain.
 		submitOld := prevHash.IsEqual(prevTemplateHash)
 		result, err := state.blockTemplateResult(useCoinbaseValue,
-			&submitOld)
+			withCandidates, &submitOld)
 		if err != nil {
 			state.Unlock()
 			return nil, err
@@ -1926,7 +2449,7 @@ func handleGetBlockTemplateLongPoll(s *rpcServer, longPollID string, useCoinbase
 	state.Lock()
 	defer state.Unlock()
 
-	if err := state.updateBlockTemplate(s, useCoinbaseValue); err != nil {
+	if err := state.updateBlockTemplate(s, useCoinbaseValue, withCandidates); err != nil {
 		return nil, err
 	}
 
@@ -1934,7 +2457,8 @@ func handleGetBlockTemplateLongPoll(s *rpcServer, longPollID string, useCoinbase
 	// block template depending on whether or not a solution has already
 	// been found and added to the block chain.
 	submitOld := prevHash.IsEqual(&state.template.Block.Header.PrevBlock)
-	result, err := state.blockTemplateResult(useCoinbaseValue, &submitOld)
+	result, err := state.blockTemplateResult(useCoinbaseValue,
+		withCandidates, &submitOld)
 	if err != nil {
 		return nil, err
 	}
@@ -1948,12 +2472,14 @@ func handleGetBlockTemplateLongPoll(s *rpcServer, longPollID string, useCoinbase
 // requests.  In addition, it detects the capabilities reported by the caller
 // in regards to whether or not it supports creating its own coinbase (the
 // coinbasetxn and coinbasevalue capabilities) and modifies the returned block
-// template accordingly.
+// template accordingly.  Candidate block templates making other trade-offs are
+// returned along with it when the caller reports the templates capability.
 func handleGetBlockTemplateRequest(s *rpcServer, request *btcjson.TemplateRequest, closeNotifier <-chan bool) (interface{}, error) {
 	// Extract the relevant passed capabilities and restrict the result to
 	// either a coinbase value or a coinbase transaction object depending on
 	// the request.  Default to only providing a coinbase value.
 	useCoinbaseValue := true
+	withCandidates := false
 	if request != nil {
 		var hasCoinbaseValue, hasCoinbaseTxn bool
 		for _, capability := range request.Capabilities {
@@ -1962,6 +2488,8 @@ func handleGetBlockTemplateRequest(s *rpcServer, request *btcjson.TemplateReques
 				hasCoinbaseTxn = true
 			case "coinbasevalue":
 				hasCoinbaseValue = true
+			case "templates":
+				withCandidates = true
 			}
 		}
 
@@ -2008,7 +2536,7 @@ func handleGetBlockTemplateRequest(s *rpcServer, request *btcjson.TemplateReques
 	// be replaced with a new one.
 	if request != nil && request.LongPollID != "" {
 		return handleGetBlockTemplateLongPoll(s, request.LongPollID,
-			useCoinbaseValue, closeNotifier)
+			useCoinbaseValue, withCandidates, closeNotifier)
 	}
 
 	// Protect concurrent access when updating block templates.
@@ -2022,10 +2550,10 @@ func handleGetBlockTemplateRequest(s *rpcServer, request *btcjson.TemplateReques
 	// seconds since the last template was generated.  Otherwise, the
 	// timestamp for the existing block template is updated (and possibly
 	// the difficulty on testnet per the consesus rules).
-	if err := state.updateBlockTemplate(s, useCoinbaseValue); err != nil {
+	if err := state.updateBlockTemplate(s, useCoinbaseValue, withCandidates); err != nil {
 		return nil, err
 	}
-	return state.blockTemplateResult(useCoinbaseValue, nil)
+	return state.blockTemplateResult(useCoinbaseValue, withCandidates, nil)
 }
 
 // chainErrToGBTErrString converts an error returned from btcchain to a string
@@ -2042,6 +2570,8 @@ func chainErrToGBTErrString(err error) string {
 	switch ruleErr.ErrorCode {
 	case blockchain.ErrDuplicateBlock:
 		return "duplicate"
+	case blockchain.ErrKnownInvalidBlock:
+		return "duplicate-invalid"
 	case blockchain.ErrBlockTooBig:
 		return "bad-blk-length"
 	case blockchain.ErrBlockVersionTooOld:
@@ -2131,14 +2661,10 @@ func chainErrToGBTErrString(err error) string {
 	return "rejected: " + err.Error()
 }
 
-// handleGetBlockTemplateProposal is a helper for handleGetBlockTemplate which
-// deals with block proposals.
-//
-// See https://en.bitcoin.it/wiki/BIP_0023 for more details.
-func handleGetBlockTemplateProposal(s *rpcServer, request *btcjson.TemplateRequest) (interface{}, error) {
-	hexData := request.Data
+// decodeBlockProposal deserializes the hex-encoded block proposed by a miner.
+func decodeBlockProposal(hexData string) (*bchutil.Block, error) {
 	if hexData == "" {
-		return false, &btcjson.RPCError{
+		return nil, &btcjson.RPCError{
 			Code: btcjson.ErrRPCType,
 			Message: fmt.Sprintf("Data must contain the " +
 				"hex-encoded serialized block that is being " +
@@ -2152,7 +2678,7 @@ func handleGetBlockTemplateProposal(s *rpcServer, request *btcjson.TemplateReque
 	}
 	dataBytes, err := hex.DecodeString(hexData)
 	if err != nil {
-		return false, &btcjson.RPCError{
+		return nil, &btcjson.RPCError{
 			Code: btcjson.ErrRPCDeserialization,
 			Message: fmt.Sprintf("Data must be "+
 				"hexadecimal string (not %q)", hexData),
@@ -2165,8 +2691,13 @@ func handleGetBlockTemplateProposal(s *rpcServer, request *btcjson.TemplateReque
 			Message: "Block decode failed: " + err.Error(),
 		}
 	}
-	block := bchutil.NewBlock(&msgBlock)
+	return bchutil.NewBlock(&msgBlock), nil
+}
 
+// checkBlockProposal checks the passed block proposal connects to the best
+// chain and follows the consensus rules.  It returns the BIP0022 rejection
+// reason when the block is rejected or an empty string otherwise.
+func checkBlockProposal(s *rpcServer, block *bchutil.Block) (string, error) {
 	// Ensure the block is building from the expected previous block.
 	expectedPrevHash := s.cfg.Chain.BestSnapshot().Hash
 	prevHash := &block.MsgBlock().Header.PrevBlock
@@ -2178,7 +2709,7 @@ func handleGetBlockTemplateProposal(s *rpcServer, request *btcjson.TemplateReque
 		if _, ok := err.(blockchain.RuleError); !ok {
 			errStr := fmt.Sprintf("Failed to process block proposal: %v", err)
 			rpcsLog.Error(errStr)
-			return nil, &btcjson.RPCError{
+			return "", &btcjson.RPCError{
 				Code:    btcjson.ErrRPCVerify,
 				Message: errStr,
 			}
@@ -2187,7 +2718,26 @@ func handleGetBlockTemplateProposal(s *rpcServer, request *btcjson.TemplateReque
 		rpcsLog.Infof("Rejected block proposal: %v", err)
 		return chainErrToGBTErrString(err), nil
 	}
+	return "", nil
+}
+
+// handleGetBlockTemplateProposal is a helper for handleGetBlockTemplate which
+// deals with block proposals.
+//
+// See https://en.bitcoin.it/wiki/BIP_0023 for more details.
+func handleGetBlockTemplateProposal(s *rpcServer, request *btcjson.TemplateRequest) (interface{}, error) {
+	block, err := decodeBlockProposal(request.Data)
+	if err != nil {
+		return false, err
+	}
 
+	reason, err := checkBlockProposal(s, block)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		return reason, nil
+	}
 	return nil, nil
 }
 
@@ -2196,6 +2746,10 @@ func handleGetBlockTemplateProposal(s *rpcServer, request *btcjson.TemplateReque
 // See https://en.bitcoin.it/wiki/BIP_0022 and
 // https://en.bitcoin.it/wiki/BIP_0023 for more details.
 func handleGetBlockTemplate(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	if len(cfg.ReplicaOf) > 0 {
+		return nil, ErrRPCReplicaMining
+	}
+
 	c := cmd.(*btcjson.GetBlockTemplateCmd)
 	request := c.Request
 
@@ -2218,6 +2772,127 @@ func handleGetBlockTemplate(s *rpcServer, cmd interface{}, closeNotifier <-chan
 	}
 }
 
+// handleAuditBlockTemplate implements the auditblocktemplate command.  It
+// reports how the passed block, typically built from the template of a mining
+// pool, compares to the transactions in the mempool and to the template this
+// node would produce, along with any consensus rules it violates.
+func handleAuditBlockTemplate(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.AuditBlockTemplateCmd)
+
+	block, err := decodeBlockProposal(c.HexBlock)
+	if err != nil {
+		return nil, err
+	}
+	msgBlock := block.MsgBlock()
+	best := s.cfg.Chain.BestSnapshot()
+	result := &btcjson.AuditBlockTemplateResult{
+		Hash:    block.Hash().String(),
+		Height:  best.Height + 1,
+		TxCount: len(msgBlock.Transactions),
+		Size:    msgBlock.SerializeSize(),
+		Omitted: []btcjson.AuditOmittedTxResult{},
+	}
+	result.RejectReason, err = checkBlockProposal(s, block)
+	if err != nil {
+		return nil, err
+	}
+	result.Valid = result.RejectReason == ""
+
+	// The transactions of a block which doesn't extend the best chain can't
+	// be compared to the mempool.
+	if result.RejectReason == "bad-prevblk" {
+		return result, nil
+	}
+
+	generator := s.cfg.Generator
+	audit, err := generator.AuditBlock(block)
+	if err != nil {
+		context := "Failed to audit block"
+		return nil, internalRPCError(err.Error(), context)
+	}
+	template, err := generator.NewBlockTemplate(nil)
+	if err != nil {
+		context := "Failed to create new block template"
+		return nil, internalRPCError(err.Error(), context)
+	}
+
+	subsidy := blockchain.CalcBlockSubsidy(result.Height, s.cfg.ChainParams)
+	if len(msgBlock.Transactions) > 0 {
+		for _, txOut := range msgBlock.Transactions[0].TxOut {
+			result.CoinbaseValue += txOut.Value
+		}
+	}
+	result.Fees = audit.Fees
+	result.MaxCoinbaseValue = subsidy + audit.Fees
+	result.MinFeeRate = audit.MinFeePerKB
+	result.UnknownTxCount = audit.UnknownTxns
+	result.UnresolvedTxCount = audit.UnresolvedTxns
+	result.TemplateTxCount = len(template.Block.Transactions)
+	result.TemplateSize = template.Block.SerializeSize()
+	result.TemplateFees = -template.Fees[0]
+	result.TemplateCoinbaseValue = subsidy + result.TemplateFees
+	for _, txDesc := range audit.Omitted {
+		result.Omitted = append(result.Omitted, btcjson.AuditOmittedTxResult{
+			TxID:    txDesc.Tx.Hash().String(),
+			Size:    txDesc.Tx.MsgTx().SerializeSize(),
+			Fee:     txDesc.Fee,
+			FeeRate: txDesc.FeePerKB,
+		})
+	}
+	return result, nil
+}
+
+// handleGetBlockValidationStats implements the getblockvalidationstats command.
+func handleGetBlockValidationStats(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.GetBlockValidationStatsCmd)
+
+	if *c.Count < 0 {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: "Count must not be negative",
+		}
+	}
+
+	// milliseconds returns the passed duration in milliseconds.
+	milliseconds := func(d time.Duration) float64 {
+		return float64(d) / float64(time.Millisecond)
+	}
+
+	stats := s.cfg.Chain.BlockValidationStats(*c.Count)
+	results := make([]btcjson.BlockValidationStatsResult, 0, len(stats))
+	for _, stat := range stats {
+		results = append(results, btcjson.BlockValidationStatsResult{
+			Hash:        stat.Hash.String(),
+			Height:      stat.Height,
+			Time:        stat.Time.Unix(),
+			MainChain:   stat.MainChain,
+			Deserialize: milliseconds(stat.Deserialize),
+			Sanity:      milliseconds(stat.Sanity),
+			Context:     milliseconds(stat.Context),
+			Store:       milliseconds(stat.Store),
+			FetchInputs: milliseconds(stat.FetchInputs),
+			CheckInputs: milliseconds(stat.CheckInputs),
+			Scripts:     milliseconds(stat.Scripts),
+			Indexes:     milliseconds(stat.Indexes),
+			Notify:      milliseconds(stat.Notify),
+			Flush:       milliseconds(stat.Flush),
+			Total:       milliseconds(stat.Total),
+		})
+	}
+	return results, nil
+}
+
+// rpcFilterTypeNotEnabledError is a convenience function for returning a nicely
+// formatted RPC error which indicates the committed filters of the passed type
+// are not maintained.
+func rpcFilterTypeNotEnabledError(filterType wire.FilterType) *btcjson.RPCError {
+	return &btcjson.RPCError{
+		Code: btcjson.ErrRPCInvalidParameter,
+		Message: fmt.Sprintf("Committed filters of type %d are not "+
+			"maintained", filterType),
+	}
+}
+
 // handleGetCFilter implements the getcfilter command.
 func handleGetCFilter(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
 	if s.cfg.CfIndex == nil {
@@ -2228,6 +2903,9 @@ func handleGetCFilter(s *rpcServer, cmd interface{}, closeNotifier <-chan bool)
 	}
 
 	c := cmd.(*btcjson.GetCFilterCmd)
+	if !s.cfg.CfIndex.FilterTypeEnabled(c.FilterType) {
+		return nil, rpcFilterTypeNotEnabledError(c.FilterType)
+	}
 	hash, err := chainhash.NewHashFromStr(c.Hash)
 	if err != nil {
 		return nil, rpcDecodeHexError(c.Hash)
@@ -2257,6 +2935,9 @@ func handleGetCFilterHeader(s *rpcServer, cmd interface{}, closeNotifier <-chan
 	}
 
 	c := cmd.(*btcjson.GetCFilterHeaderCmd)
+	if !s.cfg.CfIndex.FilterTypeEnabled(c.FilterType) {
+		return nil, rpcFilterTypeNotEnabledError(c.FilterType)
+	}
 	hash, err := chainhash.NewHashFromStr(c.Hash)
 	if err != nil {
 		return nil, rpcDecodeHexError(c.Hash)
@@ -2278,6 +2959,61 @@ func handleGetCFilterHeader(s *rpcServer, cmd interface{}, closeNotifier <-chan
 	return hash.String(), nil
 }
 
+// handleGetCPFPInfo implements the getcpfpinfo command.  It computes the fee a
+// child spending the passed mempool transaction needs to pay to get into the
+// next block along with the transaction and its unconfirmed ancestors, given
+// the lowest fee rate of the transactions selected for a block template.
+func handleGetCPFPInfo(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.GetCPFPInfoCmd)
+
+	txHash, err := chainhash.NewHashFromStr(c.TxID)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.TxID)
+	}
+	childSize := int64(*c.ChildSize)
+	if childSize <= 0 {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: "Child size must be positive",
+		}
+	}
+	graph, err := s.cfg.TxMemPool.TxGraph(txHash)
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCNoTxInfo,
+			Message: "Transaction not in mempool",
+		}
+	}
+
+	// Compare the package to the current getblocktemplate template when it
+	// is up to date, and otherwise to a template generated for this call
+	// only so the one served to the miners isn't changed.
+	generator := s.cfg.Generator
+	state := s.gbtWorkState
+	state.Lock()
+	template := state.template
+	if template == nil || state.prevHash == nil ||
+		*state.prevHash != s.cfg.Chain.BestSnapshot().Hash ||
+		state.lastTxUpdate != generator.TxSource().LastUpdated() {
+
+		template = nil
+	}
+	if template != nil {
+		result := cpfpInfo(graph, template, childSize,
+			int64(cfg.minRelayTxFee))
+		state.Unlock()
+		return result, nil
+	}
+	state.Unlock()
+
+	template, err = generator.NewBlockTemplate(nil)
+	if err != nil {
+		return nil, internalRPCError("Failed to create new block "+
+			"template: "+err.Error(), "")
+	}
+	return cpfpInfo(graph, template, childSize, int64(cfg.minRelayTxFee)), nil
+}
+
 // handleGetConnectionCount implements the getconnectioncount command.
 func handleGetConnectionCount(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
 	return s.cfg.ConnMgr.ConnectedCount(), nil
@@ -2288,10 +3024,66 @@ func handleGetCurrentNet(s *rpcServer, cmd interface{}, closeNotifier <-chan boo
 	return s.cfg.ChainParams.Net, nil
 }
 
+// rpcStatsDB returns the database of the server when it reports statistics.
+func rpcStatsDB(s *rpcServer) (database.StatsDB, error) {
+	statsDB, ok := s.cfg.DB.(database.StatsDB)
+	if !ok {
+		return nil, &btcjson.RPCError{
+			Code: btcjson.ErrRPCMisc,
+			Message: fmt.Sprintf("The %s database does not report "+
+				"statistics", s.cfg.DB.Type()),
+		}
+	}
+	return statsDB, nil
+}
+
+// handleGetDBInfo implements the getdbinfo command.
+func handleGetDBInfo(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	statsDB, err := rpcStatsDB(s)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := statsDB.Stats()
+	if err != nil {
+		context := "Failed to read the database statistics"
+		return nil, internalRPCError(err.Error(), context)
+	}
+
+	levels := make([]btcjson.DBLevelInfo, 0, len(stats.Levels))
+	for _, level := range stats.Levels {
+		levels = append(levels, btcjson.DBLevelInfo{
+			Tables:          level.Tables,
+			Size:            level.Size,
+			CompactionTime:  level.CompactionTime.Seconds(),
+			CompactionRead:  level.CompactionRead,
+			CompactionWrite: level.CompactionWrite,
+		})
+	}
+	return &btcjson.GetDBInfoResult{
+		Type:              statsDB.Type(),
+		CacheSize:         stats.CacheSize,
+		CacheMaxSize:      stats.CacheMaxSize,
+		CacheEntries:      stats.CacheEntries,
+		CacheHits:         stats.CacheHits,
+		CacheMisses:       stats.CacheMisses,
+		CacheHitRate:      hitRate(stats.CacheHits, stats.CacheMisses),
+		OpenBlockFiles:    stats.OpenBlockFiles,
+		MaxOpenBlockFiles: stats.MaxOpenBlockFiles,
+		BlockFileHits:     stats.BlockFileHits,
+		BlockFileMisses:   stats.BlockFileMisses,
+		BlockFileHitRate:  hitRate(stats.BlockFileHits, stats.BlockFileMisses),
+		OpenTables:        stats.OpenTables,
+		BlockCacheSize:    stats.BlockCacheSize,
+		IORead:            stats.IORead,
+		IOWrite:           stats.IOWrite,
+		Levels:            levels,
+	}, nil
+}
+
 // handleGetDifficulty implements the getdifficulty command.
 func handleGetDifficulty(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
 	best := s.cfg.Chain.BestSnapshot()
-	return getDifficultyRatio(best.Bits, s.cfg.ChainParams), nil
+	return best.Difficulty, nil
 }
 
 // handleGetGenerate implements the getgenerate command.
@@ -2356,7 +3148,7 @@ func handleGetInfo(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (in
 		TimeOffset:      int64(s.cfg.TimeSource.Offset().Seconds()),
 		Connections:     s.cfg.ConnMgr.ConnectedCount(),
 		Proxy:           cfg.Proxy,
-		Difficulty:      getDifficultyRatio(best.Bits, s.cfg.ChainParams),
+		Difficulty:      best.Difficulty,
 		TestNet:         cfg.TestNet3,
 		RelayFee:        cfg.minRelayTxFee.ToBCH(),
 	}
@@ -2366,19 +3158,405 @@ func handleGetInfo(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (in
 
 // handleGetMempoolInfo implements the getmempoolinfo command.
 func handleGetMempoolInfo(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
-	mempoolTxns := s.cfg.TxMemPool.TxDescs()
+	ret := &btcjson.GetMempoolInfoResult{
+		Size:          int64(s.cfg.TxMemPool.Count()),
+		Bytes:         s.cfg.TxMemPool.Size(),
+		MaxMempool:    int64(cfg.MaxMempool) * 1000000,
+		MempoolMinFee: s.cfg.TxMemPool.MinRelayTxFee().ToBCH(),
+	}
+
+	return ret, nil
+}
 
-	var numBytes int64
-	for _, txD := range mempoolTxns {
-		numBytes += int64(txD.Tx.MsgTx().SerializeSize())
+// fetchOrphanDescs returns the descriptors of the orphans in the mempool,
+// converting a failure to load their inputs to an RPC error.
+func fetchOrphanDescs(s *rpcServer) ([]*mempool.OrphanDesc, error) {
+	descs, err := s.cfg.TxMemPool.OrphanDescs()
+	if err != nil {
+		context := "Failed to load the inputs of the orphans"
+		return nil, internalRPCError(err.Error(), context)
 	}
+	return descs, nil
+}
 
-	ret := &btcjson.GetMempoolInfoResult{
-		Size:  int64(len(mempoolTxns)),
-		Bytes: numBytes,
+// handleGetOrphanPool implements the getorphanpool command.
+func handleGetOrphanPool(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	descs, err := fetchOrphanDescs(s)
+	if err != nil {
+		return nil, err
 	}
 
-	return ret, nil
+	result := make([]btcjson.GetOrphanPoolResult, 0, len(descs))
+	for _, desc := range descs {
+		missingParents := make([]string, 0, len(desc.MissingParents))
+		for _, hash := range desc.MissingParents {
+			missingParents = append(missingParents, hash.String())
+		}
+		result = append(result, btcjson.GetOrphanPoolResult{
+			TxID:           desc.Tx.Hash().String(),
+			Size:           int32(desc.Tx.MsgTx().SerializeSize()),
+			Tag:            uint64(desc.Tag),
+			Expiration:     desc.Expiration.Unix(),
+			MissingParents: missingParents,
+		})
+	}
+	return result, nil
+}
+
+// handleGetOrphanPoolInfo implements the getorphanpoolinfo command.
+func handleGetOrphanPoolInfo(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	descs, err := fetchOrphanDescs(s)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &btcjson.GetOrphanPoolInfoResult{
+		Size:          len(descs),
+		MaxOrphans:    cfg.MaxOrphanTxs,
+		MaxOrphanSize: defaultMaxOrphanTxSize,
+	}
+	tags := make(map[mempool.Tag]struct{})
+	missingParents := make(map[chainhash.Hash]struct{})
+	for _, desc := range descs {
+		result.Bytes += int64(desc.Tx.MsgTx().SerializeSize())
+		tags[desc.Tag] = struct{}{}
+		for _, hash := range desc.MissingParents {
+			missingParents[hash] = struct{}{}
+		}
+	}
+	result.Tags = len(tags)
+	result.MissingParents = len(missingParents)
+
+	// The descriptors are sorted by expiration.
+	if len(descs) > 0 {
+		result.NextExpiration = descs[0].Expiration.Unix()
+	}
+	return result, nil
+}
+
+// handleGetRejectedTxs implements the getrejectedtxs command.
+func handleGetRejectedTxs(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.GetRejectedTxsCmd)
+
+	rejectedTxns := s.cfg.SyncMgr.RejectedTxns()
+	result := make([]btcjson.GetRejectedTxResult, 0, len(rejectedTxns))
+	for _, rejected := range rejectedTxns {
+		if c.PeerID != nil && rejected.PeerID != *c.PeerID {
+			continue
+		}
+		result = append(result, btcjson.GetRejectedTxResult{
+			TxID:   rejected.Hash.String(),
+			PeerID: rejected.PeerID,
+			Addr:   rejected.PeerAddr,
+			Code:   rejected.Code.String(),
+			Reason: rejected.Reason,
+			Time:   rejected.Time.Unix(),
+		})
+	}
+	return result, nil
+}
+
+// handleGetTxBroadcastStatus implements the gettxbroadcaststatus command.
+func handleGetTxBroadcastStatus(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.GetTxBroadcastStatusCmd)
+
+	txHash, err := chainhash.NewHashFromStr(c.TxID)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.TxID)
+	}
+	result := s.cfg.ConnMgr.TxBroadcastStatus(txHash)
+	if result == nil {
+		return nil, &btcjson.RPCError{
+			Code: btcjson.ErrRPCNoTxInfo,
+			Message: "No broadcast information available for " +
+				"transaction -- only transactions submitted to " +
+				"this node are tracked",
+		}
+	}
+	return result, nil
+}
+
+// handleGetUtxoStats implements the getutxostats command.
+func handleGetUtxoStats(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.GetUtxoStatsCmd)
+
+	if *c.Count <= 0 {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: "Count must be positive",
+		}
+	}
+	var cursor []byte
+	if c.Cursor != nil {
+		var err error
+		cursor, err = hex.DecodeString(*c.Cursor)
+		if err != nil {
+			return nil, rpcDecodeHexError(*c.Cursor)
+		}
+	}
+
+	isDust := func(txOut *wire.TxOut) bool {
+		return mempool.IsDust(txOut, cfg.minRelayTxFee)
+	}
+	stats, err := s.cfg.Chain.UtxoStats(cursor, *c.Count, isDust)
+	if err == blockchain.ErrInvalidUtxoStatsCursor {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: "Invalid cursor",
+		}
+	}
+	if err != nil {
+		context := "Failed to scan the utxo set"
+		return nil, internalRPCError(err.Error(), context)
+	}
+
+	buckets := func(stats []blockchain.UtxoBucketStats, below func(i int) float64) []btcjson.UtxoStatsBucket {
+		results := make([]btcjson.UtxoStatsBucket, 0, len(stats))
+		for i, bucket := range stats {
+			result := btcjson.UtxoStatsBucket{
+				Count:     bucket.Count,
+				Amount:    bchutil.Amount(bucket.Amount).ToBCH(),
+				DustCount: bucket.DustCount,
+			}
+			if i < len(stats)-1 {
+				result.Below = below(i)
+			}
+			results = append(results, result)
+		}
+		return results
+	}
+	result := &btcjson.GetUtxoStatsResult{
+		Height:     stats.Height,
+		Count:      stats.Count,
+		Amount:     bchutil.Amount(stats.Amount).ToBCH(),
+		DustCount:  stats.DustCount,
+		DustAmount: bchutil.Amount(stats.DustAmount).ToBCH(),
+		AgeBuckets: buckets(stats.Ages, func(i int) float64 {
+			return float64(blockchain.UtxoAgeBuckets[i])
+		}),
+		ValueBuckets: buckets(stats.Values, func(i int) float64 {
+			return bchutil.Amount(blockchain.UtxoValueBuckets[i]).ToBCH()
+		}),
+	}
+	if stats.Cursor != nil {
+		result.Cursor = hex.EncodeToString(stats.Cursor)
+	}
+	return result, nil
+}
+
+// handleGetDSProof implements the getdsproof command.
+func handleGetDSProof(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.GetDSProofCmd)
+
+	// The ID is either an outpoint, the hash of a proof or the hash of a
+	// pool transaction, in which case the proof of the double spend of
+	// any of its inputs is returned.
+	var proof *wire.MsgDSProofBeta
+	if i := strings.IndexByte(c.ID, ':'); i >= 0 {
+		hash, err := chainhash.NewHashFromStr(c.ID[:i])
+		if err != nil {
+			return nil, rpcDecodeHexError(c.ID[:i])
+		}
+		index, err := strconv.ParseUint(c.ID[i+1:], 10, 32)
+		if err != nil {
+			return nil, &btcjson.RPCError{
+				Code:    btcjson.ErrRPCInvalidParameter,
+				Message: "Invalid outpoint index: " + c.ID[i+1:],
+			}
+		}
+		proof = s.cfg.TxMemPool.DoubleSpendProof(
+			*wire.NewOutPoint(hash, uint32(index)))
+	} else {
+		hash, err := chainhash.NewHashFromStr(c.ID)
+		if err != nil {
+			return nil, rpcDecodeHexError(c.ID)
+		}
+		proof = s.cfg.TxMemPool.DoubleSpendProofByHash(hash)
+		if proof == nil {
+			tx, err := s.cfg.TxMemPool.FetchTransaction(hash)
+			if err == nil {
+				for _, txIn := range tx.MsgTx().TxIn {
+					proof = s.cfg.TxMemPool.DoubleSpendProof(
+						txIn.PreviousOutPoint)
+					if proof != nil {
+						break
+					}
+				}
+			}
+		}
+	}
+	if proof == nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidAddressOrKey,
+			Message: "No double-spend proof found for " + c.ID,
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := proof.BchEncode(&buf, 0, wire.BaseEncoding); err != nil {
+		context := "Failed to serialize double-spend proof"
+		return nil, internalRPCError(err.Error(), context)
+	}
+	if c.Verbose != nil && !*c.Verbose {
+		return hex.EncodeToString(buf.Bytes()), nil
+	}
+
+	result := &btcjson.GetDSProofResult{
+		DSPID: proof.Hash().String(),
+		Outpoint: btcjson.OutPoint{
+			Hash:  proof.PrevOut.Hash.String(),
+			Index: proof.PrevOut.Index,
+		},
+		Hex: hex.EncodeToString(buf.Bytes()),
+	}
+	if tx := s.cfg.TxMemPool.CheckSpend(proof.PrevOut); tx != nil {
+		result.TxID = tx.Hash().String()
+	}
+	for _, spender := range []*wire.DSProofSpender{&proof.FirstSpender,
+		&proof.DoubleSpender} {
+
+		pushData := make([]string, 0, len(spender.PushData))
+		for _, data := range spender.PushData {
+			pushData = append(pushData, hex.EncodeToString(data))
+		}
+		result.Spenders = append(result.Spenders,
+			btcjson.DSProofSpenderResult{
+				Version:         spender.Version,
+				Sequence:        spender.Sequence,
+				LockTime:        spender.LockTime,
+				HashPrevOutputs: spender.HashPrevOutputs.String(),
+				HashSequence:    spender.HashSequence.String(),
+				HashOutputs:     spender.HashOutputs.String(),
+				PushData:        pushData,
+			})
+	}
+	return result, nil
+}
+
+// handleGetForkMonitorInfo implements the getforkmonitorinfo command.
+func handleGetForkMonitorInfo(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	if s.cfg.ForkMonitor == nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCMisc,
+			Message: "The fork monitor is disabled -- use --forkmonitornode to watch other nodes",
+		}
+	}
+	return s.cfg.ForkMonitor.results(), nil
+}
+
+// mempoolTxGraphEntry returns the getmempooltxgraph result for the passed
+// entry of a mempool transaction graph.
+func mempoolTxGraphEntry(entry *mempool.TxGraphEntry) btcjson.MempoolTxGraphEntry {
+	result := btcjson.MempoolTxGraphEntry{
+		TxID:     entry.Tx.Hash().String(),
+		Size:     int32(entry.Size),
+		Fee:      bchutil.Amount(entry.Fee).ToBCH(),
+		FeePerKB: bchutil.Amount(entry.FeePerKB).ToBCH(),
+		Time:     entry.Added.Unix(),
+		Height:   int64(entry.Height),
+		Depends:  make([]string, 0, len(entry.Depends)),
+		SpentBy:  make([]string, 0, len(entry.SpentBy)),
+	}
+	for _, hash := range entry.Depends {
+		result.Depends = append(result.Depends, hash.String())
+	}
+	for _, hash := range entry.SpentBy {
+		result.SpentBy = append(result.SpentBy, hash.String())
+	}
+	return result
+}
+
+// handleGetMempoolTxGraph implements the getmempooltxgraph command.
+func handleGetMempoolTxGraph(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.GetMempoolTxGraphCmd)
+
+	txHash, err := chainhash.NewHashFromStr(c.TxID)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.TxID)
+	}
+	graph, err := s.cfg.TxMemPool.TxGraph(txHash)
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCNoTxInfo,
+			Message: "Transaction not in mempool",
+		}
+	}
+
+	result := &btcjson.GetMempoolTxGraphResult{
+		Transaction:     mempoolTxGraphEntry(graph.Tx),
+		AncestorCount:   int64(len(graph.Ancestors)),
+		AncestorSize:    graph.AncestorSize,
+		AncestorFees:    bchutil.Amount(graph.AncestorFees).ToBCH(),
+		DescendantCount: int64(len(graph.Descendants)),
+		DescendantSize:  graph.DescendantSize,
+		DescendantFees:  bchutil.Amount(graph.DescendantFees).ToBCH(),
+		Ancestors:       make([]btcjson.MempoolTxGraphEntry, 0, len(graph.Ancestors)),
+		Descendants:     make([]btcjson.MempoolTxGraphEntry, 0, len(graph.Descendants)),
+		Truncated:       graph.Truncated,
+	}
+	for _, entry := range graph.Ancestors {
+		result.Ancestors = append(result.Ancestors, mempoolTxGraphEntry(entry))
+	}
+	for _, entry := range graph.Descendants {
+		result.Descendants = append(result.Descendants, mempoolTxGraphEntry(entry))
+	}
+	return result, nil
+}
+
+// handleGetMempoolSnapshot implements the getmempoolsnapshot command.
+func handleGetMempoolSnapshot(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	var buf bytes.Buffer
+	err := mempool.WriteSnapshot(&buf, s.cfg.TxMemPool.Snapshot())
+	if err != nil {
+		context := "Failed to serialize mempool snapshot"
+		return nil, internalRPCError(err.Error(), context)
+	}
+	return hex.EncodeToString(buf.Bytes()), nil
+}
+
+// handleGetMempoolStats implements the getmempoolstats command.
+func handleGetMempoolStats(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.GetMempoolStatsCmd)
+
+	if s.cfg.StatsRecorder == nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCMisc,
+			Message: "Mempool statistics are not recorded -- use --statsinterval to enable",
+		}
+	}
+
+	history, err := time.ParseDuration(*c.History)
+	if err != nil || history <= 0 {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: fmt.Sprintf("Invalid history duration %q", *c.History),
+		}
+	}
+
+	percentiles := statsFeeRatePercentiles[:]
+	samples := s.cfg.StatsRecorder.history(time.Now().Add(-history))
+	result := &btcjson.GetMempoolStatsResult{
+		Interval:           int64(s.cfg.StatsRecorder.interval.Seconds()),
+		FeeRatePercentiles: percentiles,
+		Samples:            make([]btcjson.MempoolStatsSample, 0, len(samples)),
+	}
+	for _, sample := range samples {
+		feeRates := make([]float64, len(sample.feeRates))
+		for i, feeRate := range sample.feeRates {
+			feeRates[i] = bchutil.Amount(feeRate).ToBCH()
+		}
+		result.Samples = append(result.Samples, btcjson.MempoolStatsSample{
+			Time:          sample.timestamp.Unix(),
+			Size:          sample.numTxns,
+			Bytes:         sample.numBytes,
+			TotalFee:      bchutil.Amount(sample.totalFee).ToBCH(),
+			FeeRates:      feeRates,
+			BlockHeight:   sample.blockHeight,
+			BlockSize:     sample.blockSize,
+			BlockFullness: sample.blockFullness(),
+		})
+	}
+	return result, nil
 }
 
 // handleGetMiningInfo implements the getmininginfo command. We only return the
@@ -2405,7 +3583,7 @@ func handleGetMiningInfo(s *rpcServer, cmd interface{}, closeNotifier <-chan boo
 		Blocks:           int64(best.Height),
 		CurrentBlockSize: best.BlockSize,
 		CurrentBlockTx:   best.NumTxns,
-		Difficulty:       getDifficultyRatio(best.Bits, s.cfg.ChainParams),
+		Difficulty:       best.Difficulty,
 		Generate:         s.cfg.CPUMiner.IsMining(),
 		GenProcLimit:     s.cfg.CPUMiner.NumWorkers(),
 		HashesPerSec:     int64(s.cfg.CPUMiner.HashesPerSecond()),
@@ -2439,16 +3617,19 @@ func handleGetNetworkHashPS(s *rpcServer, cmd interface{}, closeNotifier <-chan
 	// since we can't reasonably calculate the number of network hashes
 	// per second from invalid values.  When it's negative, use the current
 	// best block height.
-	best := s.cfg.Chain.BestSnapshot()
+	//
+	// The blocks are looked up in a snapshot of the chain so they all
+	// belong to the same chain even if a reorganize happens meanwhile.
+	snapshot := s.cfg.Chain.ChainSnapshot()
 	endHeight := int32(-1)
 	if c.Height != nil {
 		endHeight = int32(*c.Height)
 	}
-	if endHeight > best.Height || endHeight == 0 {
+	if endHeight > snapshot.Height() || endHeight == 0 {
 		return int64(0), nil
 	}
 	if endHeight < 0 {
-		endHeight = best.Height
+		endHeight = snapshot.Height()
 	}
 
 	// Calculate the number of blocks per retarget interval based on the
@@ -2481,14 +3662,7 @@ func handleGetNetworkHashPS(s *rpcServer, cmd interface{}, closeNotifier <-chan
 	var minTimestamp, maxTimestamp time.Time
 	totalWork := big.NewFloat(0.0)
 	for curHeight := startHeight; curHeight <= endHeight; curHeight++ {
-		hash, err := s.cfg.Chain.BlockHashByHeight(curHeight)
-		if err != nil {
-			context := "Failed to fetch block hash"
-			return nil, internalRPCError(err.Error(), context)
-		}
-
-		// Fetch the header from chain.
-		header, err := s.cfg.Chain.HeaderByHash(hash)
+		header, err := snapshot.HeaderByHeight(curHeight)
 		if err != nil {
 			context := "Failed to fetch block header"
 			return nil, internalRPCError(err.Error(), context)
@@ -2551,14 +3725,10 @@ func handleGetNetworkInfo(s *rpcServer, cmd interface{}, closeNotifier <-chan bo
 		onionProxy = cfg.OnionProxy
 	}
 
-	var warnings string
-	unknownRulesWarned, unknownVersionsWarned := s.cfg.Chain.GetWarnings()
-	if unknownRulesWarned {
-		warnings = "Warning: Unknown new rules activated! "
-	}
-	if unknownVersionsWarned {
-		warnings += "Warning: Unknown block versions being mined! It's possible unknown rules are in effect."
-	}
+	// Networks excluded by --onlynet are reported as limited.
+	ipv4Limited := isNetworkLimited(addrmgr.IPv4Network)
+	ipv6Limited := isNetworkLimited(addrmgr.IPv6Network)
+	onionLimited := isNetworkLimited(addrmgr.OnionNetwork)
 
 	var timeOffset int64
 	if !s.cfg.SyncMgr.IsCurrent() {
@@ -2566,23 +3736,26 @@ func handleGetNetworkInfo(s *rpcServer, cmd interface{}, closeNotifier <-chan bo
 	}
 
 	reply := &btcjson.GetNetworkInfoResult{
-		ProtocolVersion: int32(wire.ProtocolVersion),
-		Version:         version.Numeric(),
-		Connections:     s.cfg.ConnMgr.ConnectedCount(),
-		IncrementalFee:  cfg.MinRelayTxFee,
-		LocalAddresses:  localAddrs,
-		LocalRelay:      !cfg.BlocksOnly,
-		LocalServices:   s.cfg.Services.String(),
-		NetworkActive:   true,
+		ProtocolVersion:    int32(wire.ProtocolVersion),
+		Version:            version.Numeric(),
+		Connections:        s.cfg.ConnMgr.ConnectedCount(),
+		IncrementalFee:     cfg.MinRelayTxFee,
+		LocalAddresses:     localAddrs,
+		LocalRelay:         !cfg.BlocksOnly,
+		LocalServices:      s.cfg.Services.String(),
+		LocalServicesNames: s.cfg.Services.Names(),
+		NetworkActive:      true,
 		Networks: []btcjson.NetworksResult{
 			{
 				Name:      "ipv4",
-				Reachable: ipv4Reachable,
+				Limited:   ipv4Limited,
+				Reachable: ipv4Reachable && !ipv4Limited,
 				Proxy:     cfg.Proxy,
 			},
 			{
 				Name:      "ipv6",
-				Reachable: ipv6Reachable,
+				Limited:   ipv6Limited,
+				Reachable: ipv6Reachable && !ipv6Limited,
 				Proxy:     cfg.Proxy,
 			},
 			{
@@ -2591,17 +3764,39 @@ func handleGetNetworkInfo(s *rpcServer, cmd interface{}, closeNotifier <-chan bo
 				ProxyRandomizeCredentials: cfg.TorIsolation,
 
 				Proxy:     onionProxy,
-				Reachable: cfg.Proxy != "" || cfg.OnionProxy != "",
+				Limited:   onionLimited,
+				Reachable: (cfg.Proxy != "" || cfg.OnionProxy != "") && !onionLimited,
 			},
 		},
 		RelayFee:   cfg.MinRelayTxFee,
 		SubVersion: ver.UserAgent,
 		TimeOffset: timeOffset,
-		Warnings:   warnings,
+		Warnings:   chainWarnings(s),
 	}
 	return reply, nil
 }
 
+// chainWarnings returns the warnings about the state of the chain reported by
+// the getnetworkinfo and getblockchaininfo commands.
+func chainWarnings(s *rpcServer) string {
+	var warnings string
+	unknownRulesWarned, unknownVersionsWarned := s.cfg.Chain.GetWarnings()
+	if unknownRulesWarned {
+		warnings = "Warning: Unknown new rules activated! "
+	}
+	if unknownVersionsWarned {
+		warnings += "Warning: Unknown block versions being mined! It's possible unknown rules are in effect. "
+	}
+	if split := s.cfg.SyncMgr.ChainSplitStatus(); split.Detected {
+		warnings += fmt.Sprintf("Warning: The chain tip has diverged "+
+			"from the tips of %d of %d peers since %s! This node "+
+			"may be on a minority fork.", split.DivergingPeers,
+			split.AgreeingPeers+split.DivergingPeers,
+			split.Since.UTC().Format(time.RFC3339))
+	}
+	return strings.TrimSpace(warnings)
+}
+
 // handleGetPeerInfo implements the getpeerinfo command.
 func handleGetPeerInfo(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
 	peers := s.cfg.ConnMgr.ConnectedPeers()
@@ -2630,8 +3825,10 @@ func handleGetPeerInfo(s *rpcServer, cmd interface{}, closeNotifier <-chan bool)
 			CurrentHeight:  statsSnap.LastBlock,
 			BanScore:       int32(p.BanScore()),
 			Whitelisted:    p.IsWhitelisted(),
+			Permissions:    p.Permissions(),
 			FeeFilter:      p.FeeFilter(),
 			SyncNode:       statsSnap.ID == syncPeerID,
+			ConnectionType: p.ConnectionType(),
 		}
 		if p.ToPeer().LastPingNonce() != 0 {
 			wait := float64(time.Since(statsSnap.LastPingTime).Nanoseconds())
@@ -2779,9 +3976,97 @@ func handleGetRawTransaction(s *rpcServer, cmd interface{}, closeNotifier <-chan
 	if err != nil {
 		return nil, err
 	}
+
+	// Include the outputs spent by the inputs and the fee at the highest
+	// verbosity when they are available.
+	if c.Verbose != nil && *c.Verbose > 1 {
+		prevOuts := fetchPrevOuts(s, mtx, blkHash)
+		if prevOuts != nil {
+			addPrevOutInfo(rawTxn, mtx, prevOuts, s.cfg.ChainParams)
+		}
+	}
 	return *rawTxn, nil
 }
 
+// handleGetReorgInfo implements the getreorginfo command.
+func handleGetReorgInfo(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.GetReorgInfoCmd)
+
+	if *c.Count < 0 {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: "Count must not be negative",
+		}
+	}
+	var txHash *chainhash.Hash
+	if c.TxID != nil {
+		var err error
+		txHash, err = chainhash.NewHashFromStr(*c.TxID)
+		if err != nil {
+			return nil, rpcDecodeHexError(*c.TxID)
+		}
+	}
+
+	// The reorg log returns the most recent reorganizations first, only
+	// keeping those which disconnected the passed transaction if any.
+	entries, err := s.cfg.Chain.ReorgLog(*c.Count, txHash)
+	if err != nil {
+		context := "Failed to load the reorg log"
+		return nil, internalRPCError(err.Error(), context)
+	}
+
+	results := make([]btcjson.ReorgInfoResult, 0, len(entries))
+	for _, entry := range entries {
+		txIDs := make([]string, 0, len(entry.Txids))
+		for j := range entry.Txids {
+			txIDs = append(txIDs, entry.Txids[j].String())
+		}
+		results = append(results, btcjson.ReorgInfoResult{
+			Time:       entry.Time.Unix(),
+			OldTip:     entry.OldTip.String(),
+			OldHeight:  entry.OldHeight,
+			NewTip:     entry.NewTip.String(),
+			NewHeight:  entry.NewHeight,
+			ForkHeight: entry.ForkHeight,
+			Depth:      entry.Depth(),
+			TxIDs:      txIDs,
+		})
+	}
+	return results, nil
+}
+
+// handleGetScriptFlags implements the getscriptflags command.
+func handleGetScriptFlags(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.GetScriptFlagsCmd)
+
+	hash, err := s.cfg.Chain.BlockHashByHeight(c.Height)
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCOutOfRange,
+			Message: "Block number out of range",
+		}
+	}
+	flags, err := s.cfg.Chain.BlockScriptFlags(hash)
+	if err != nil {
+		context := "Failed to fetch the block script flags"
+		return nil, internalRPCError(err.Error(), context)
+	}
+	if flags == nil {
+		return nil, &btcjson.RPCError{
+			Code: btcjson.ErrRPCMisc,
+			Message: fmt.Sprintf("The script flags of block %v were "+
+				"not recorded", hash),
+		}
+	}
+
+	return &btcjson.GetScriptFlagsResult{
+		Hash:       hash.String(),
+		Height:     c.Height,
+		Flags:      flags.Flags.Names(),
+		ScriptsRun: flags.ScriptsRun,
+	}, nil
+}
+
 // handleGetTxOut handles gettxout commands.
 func handleGetTxOut(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
 	c := cmd.(*btcjson.GetTxOutCmd)
@@ -2803,9 +4088,47 @@ func handleGetTxOut(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (i
 	if c.IncludeMempool != nil {
 		includeMempool = *c.IncludeMempool
 	}
-	// TODO: This is racy.  It should attempt to fetch it directly and check
-	// the error.
-	if includeMempool && s.cfg.TxMemPool.HaveTransaction(txHash) {
+	if c.Height != nil {
+		// Reconstruct the output as it was at the requested height of
+		// the main chain, in which case the mempool doesn't apply.
+		best := s.cfg.Chain.BestSnapshot()
+		minHeight := best.Height - blockchain.MaxUtxoHistoryDepth
+		if minHeight < 0 {
+			minHeight = 0
+		}
+		height := *c.Height
+		if height < minHeight || height > best.Height {
+			return nil, &btcjson.RPCError{
+				Code: btcjson.ErrRPCOutOfRange,
+				Message: fmt.Sprintf("Height must be between %d "+
+					"and %d", minHeight, best.Height),
+			}
+		}
+		blockHash, err := s.cfg.Chain.BlockHashByHeight(height)
+		if err != nil {
+			return nil, &btcjson.RPCError{
+				Code:    btcjson.ErrRPCOutOfRange,
+				Message: "Block number out of range",
+			}
+		}
+		out := wire.OutPoint{Hash: *txHash, Index: c.Vout}
+		entry, err := s.cfg.Chain.FetchUtxoEntryAtHeight(out, height)
+		if err != nil {
+			context := "Failed to reconstruct the output"
+			return nil, internalRPCError(err.Error(), context)
+		}
+		if entry == nil {
+			return nil, nil
+		}
+
+		bestBlockHash = blockHash.String()
+		confirmations = 1 + height - entry.BlockHeight()
+		value = entry.Amount()
+		pkScript = entry.PkScript()
+		isCoinbase = entry.IsCoinBase()
+	} else if includeMempool && s.cfg.TxMemPool.HaveTransaction(txHash) {
+		// TODO: This is racy.  It should attempt to fetch it directly
+		// and check the error.
 		tx, err := s.cfg.TxMemPool.FetchTransaction(txHash)
 		if err != nil {
 			return nil, rpcNoTxInfoError(txHash)
@@ -3129,6 +4452,52 @@ func handleInvalidateBlock(s *rpcServer, cmd interface{}, closeNotifier <-chan b
 	return nil, s.cfg.Chain.InvalidateBlock(hash)
 }
 
+// handleRegenerateCFilters implements the regeneratecfilters command.
+func handleRegenerateCFilters(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	if s.cfg.CfIndex == nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCNoCFIndex,
+			Message: "The CF index must be enabled for this command",
+		}
+	}
+
+	c := cmd.(*btcjson.RegenerateCFiltersCmd)
+	if !s.cfg.CfIndex.FilterTypeEnabled(*c.FilterType) {
+		return nil, rpcFilterTypeNotEnabledError(*c.FilterType)
+	}
+	bestHeight := s.cfg.Chain.BestSnapshot().Height
+	if c.StartHeight < 0 || c.StartHeight > c.EndHeight ||
+		c.EndHeight > bestHeight {
+
+		return nil, &btcjson.RPCError{
+			Code: btcjson.ErrRPCInvalidParameter,
+			Message: fmt.Sprintf("Invalid height range %d to %d -- "+
+				"the best height is %d", c.StartHeight,
+				c.EndHeight, bestHeight),
+		}
+	}
+
+	// The filters past the range are rebuilt until their headers match,
+	// so blocks are fetched up to the tip of the main chain.
+	fetchBlock := func(height int32) (*bchutil.Block, error) {
+		if height > s.cfg.Chain.BestSnapshot().Height {
+			return nil, nil
+		}
+		return s.cfg.Chain.BlockByHeight(height)
+	}
+	endHeight, err := s.cfg.CfIndex.RegenerateFilters(*c.FilterType,
+		c.StartHeight, c.EndHeight, fetchBlock)
+	if err != nil {
+		context := "Failed to regenerate committed filters"
+		return nil, internalRPCError(err.Error(), context)
+	}
+
+	return &btcjson.RegenerateCFiltersResult{
+		StartHeight: c.StartHeight,
+		EndHeight:   endHeight,
+	}, nil
+}
+
 // handleReconsiderBlock implements the reconsiderblock command
 func handleReconsiderBlock(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
 	c := cmd.(*btcjson.ReconsiderBlockCmd)
@@ -3188,9 +4557,31 @@ func handlePing(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (inter
 		return nil, internalRPCError("Not sending ping - failed to "+
 			"generate nonce: "+err.Error(), "")
 	}
-	s.cfg.ConnMgr.BroadcastMessage(wire.NewMsgPing(nonce))
+	s.cfg.ConnMgr.BroadcastMessage(wire.NewMsgPing(nonce))
+
+	return nil, nil
+}
+
+// handlePrioritiseTransaction implements the prioritisetransaction command.
+func handlePrioritiseTransaction(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.PrioritiseTransactionCmd)
+
+	txHash, err := chainhash.NewHashFromStr(c.TxID)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.TxID)
+	}
 
-	return nil, nil
+	// Only fee deltas are supported, the priority delta is kept for
+	// compatibility with the parameters of other implementations.
+	if c.PriorityDelta != 0 {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: "Priority deltas are not supported, the priority delta must be 0",
+		}
+	}
+
+	s.cfg.TxMemPool.PrioritiseTransaction(txHash, c.FeeDelta)
+	return true, nil
 }
 
 // retrievedTx represents a transaction that was either loaded from the
@@ -3735,6 +5126,8 @@ func handleSendRawTransaction(s *rpcServer, cmd interface{}, closeNotifier <-cha
 			default:
 				code = btcjson.ErrRPCTxRejected
 			}
+		} else if _, ok := ruleErr.Err.(mempool.OutpointLockedError); ok {
+			code = btcjson.ErrRPCTxRejected
 		}
 
 		return nil, &btcjson.RPCError{
@@ -3758,10 +5151,12 @@ func handleSendRawTransaction(s *rpcServer, cmd interface{}, closeNotifier <-cha
 		return nil, internalRPCError(errStr, "")
 	}
 
-	// Generate and relay inventory vectors for all newly accepted
-	// transactions into the memory pool due to the original being
-	// accepted.
-	s.cfg.ConnMgr.RelayTransactions(acceptedTxs)
+	// Announce the submitted transaction to a random subset of the
+	// outbound peers so its propagation can be tracked, and generate and
+	// relay inventory vectors for all other transactions accepted into the
+	// memory pool due to the original being accepted.
+	s.cfg.ConnMgr.RelayLocalTransaction(acceptedTxs[0])
+	s.cfg.ConnMgr.RelayTransactions(acceptedTxs[1:])
 
 	// Notify both websocket and getblocktemplate long poll clients of all
 	// newly accepted transactions.
@@ -3795,6 +5190,10 @@ func handleSetGenerate(s *rpcServer, cmd interface{}, closeNotifier <-chan bool)
 	if !generate {
 		s.cfg.CPUMiner.Stop()
 	} else {
+		if len(cfg.ReplicaOf) > 0 {
+			return nil, ErrRPCReplicaMining
+		}
+
 		// Respond with an error if there are no addresses to pay the
 		// created blocks to.
 		if len(cfg.miningAddrs) == 0 {
@@ -3812,6 +5211,132 @@ func handleSetGenerate(s *rpcServer, cmd interface{}, closeNotifier <-chan bool)
 	return nil, nil
 }
 
+// handleSetMockTime implements the setmocktime command.
+func handleSetMockTime(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.SetMockTimeCmd)
+
+	clock, ok := s.cfg.Clock.(*mockClock)
+	if !ok {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCMisc,
+			Message: "setmocktime is only supported on regtest and simnet",
+		}
+	}
+	if c.Timestamp < 0 {
+		return nil, rpcInvalidError("Timestamp must be 0 or greater")
+	}
+	clock.setMockTime(c.Timestamp)
+	return nil, nil
+}
+
+// handleSetDBCacheSize implements the setdbcachesize command.
+func handleSetDBCacheSize(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.SetDBCacheSizeCmd)
+
+	if c.Size < minDBCacheSizeMiB || c.Size > maxDBCacheSizeMiB {
+		return nil, rpcInvalidError("Cache size must be between %d and "+
+			"%d MiB", minDBCacheSizeMiB, maxDBCacheSizeMiB)
+	}
+	statsDB, err := rpcStatsDB(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := statsDB.SetCacheMaxSize(c.Size * 1024 * 1024); err != nil {
+		context := "Failed to set the database cache size"
+		return nil, internalRPCError(err.Error(), context)
+	}
+	rpcsLog.Infof("Set the database cache size to %d MiB", c.Size)
+	return nil, nil
+}
+
+// handleSetUpgradeActivation implements the setupgradeactivation command.
+func handleSetUpgradeActivation(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.SetUpgradeActivationCmd)
+
+	// The activation parameters are shared by the whole node, which is
+	// only acceptable on the networks used for testing.
+	if !(cfg.RegressionTest || cfg.SimNet) {
+		return nil, &btcjson.RPCError{
+			Code: btcjson.ErrRPCMisc,
+			Message: "setupgradeactivation is only supported on " +
+				"regtest and simnet",
+		}
+	}
+
+	// Pause the sync manager so no block or transaction relayed by peers
+	// is validated while the parameters change.  The chain validates the
+	// blocks the change affects again under the new rules.
+	pause := s.cfg.SyncMgr.Pause()
+	defer close(pause)
+
+	var err error
+	switch c.Upgrade {
+	case "upgrade9":
+		if c.Activation < 0 || c.Activation > math.MaxInt32 {
+			return nil, rpcInvalidError("Activation height %d is "+
+				"out of range", c.Activation)
+		}
+		err = s.cfg.Chain.SetUpgrade9Activation(int32(c.Activation))
+
+	case "upgrade11":
+		if c.Activation < 0 {
+			return nil, rpcInvalidError("Activation time must be 0 " +
+				"or greater")
+		}
+		err = s.cfg.Chain.SetUpgrade11Activation(uint64(c.Activation))
+
+	default:
+		return nil, rpcInvalidError("Unknown upgrade %q, expected "+
+			"upgrade9 or upgrade11", c.Upgrade)
+	}
+	if err != nil {
+		context := "Failed to validate the chain under the new activation"
+		return nil, internalRPCError(err.Error(), context)
+	}
+	rpcsLog.Infof("Set the activation of %s to %d", c.Upgrade, c.Activation)
+	return nil, nil
+}
+
+// handleSignDataSignature implements the signdatasignature command.
+func handleSignDataSignature(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.SignDataSignatureCmd)
+
+	wif, err := bchutil.DecodeWIF(c.PrivKey)
+	if err != nil || !wif.IsForNet(s.cfg.ChainParams) {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidAddressOrKey,
+			Message: "Invalid private key",
+		}
+	}
+	message, err := hex.DecodeString(c.Message)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.Message)
+	}
+
+	var schnorr bool
+	switch *c.SigType {
+	case "schnorr":
+		schnorr = true
+	case "ecdsa":
+	default:
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: "Signature type must be schnorr or ecdsa",
+		}
+	}
+
+	sig, err := txscript.RawDataSignature(message, wif.PrivKey, schnorr)
+	if err != nil {
+		context := "Failed to sign data"
+		return nil, internalRPCError(err.Error(), context)
+	}
+	return btcjson.SignDataSignatureResult{
+		Signature:   hex.EncodeToString(sig),
+		PubKey:      hex.EncodeToString(wif.SerializePubKey()),
+		MessageHash: hex.EncodeToString(txscript.DataSignatureHash(message)),
+	}, nil
+}
+
 // handleStop implements the stop command.
 func handleStop(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
 	select {
@@ -3854,6 +5379,58 @@ func handleSubmitBlock(s *rpcServer, cmd interface{}, closeNotifier <-chan bool)
 	return nil, nil
 }
 
+// handleSubmitHeader implements the submitheader command.
+func handleSubmitHeader(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.SubmitHeaderCmd)
+
+	// Deserialize the submitted header.
+	hexStr := c.HexData
+	if len(hexStr)%2 != 0 {
+		hexStr = "0" + c.HexData
+	}
+	serializedHeader, err := hex.DecodeString(hexStr)
+	if err != nil {
+		return nil, rpcDecodeHexError(hexStr)
+	}
+	var header wire.BlockHeader
+	err = header.Deserialize(bytes.NewReader(serializedHeader))
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCDeserialization,
+			Message: "Block header decode failed: " + err.Error(),
+		}
+	}
+
+	// Validate the header and relay it to the peers which want headers.
+	err = s.cfg.SyncMgr.SubmitHeader(&header)
+	if err != nil {
+		ruleErr, ok := err.(blockchain.RuleError)
+		if !ok {
+			context := "Failed to process block header"
+			return nil, internalRPCError(err.Error(), context)
+		}
+
+		switch ruleErr.ErrorCode {
+		case blockchain.ErrDuplicateBlock:
+			return nil, nil
+		case blockchain.ErrPreviousBlockUnknown:
+			return nil, &btcjson.RPCError{
+				Code: btcjson.ErrRPCVerify,
+				Message: fmt.Sprintf("Must submit previous "+
+					"header (%v) first", header.PrevBlock),
+			}
+		}
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCVerify,
+			Message: chainErrToGBTErrString(err),
+		}
+	}
+
+	rpcsLog.Infof("Accepted header of block %s via submitheader",
+		header.BlockHash())
+	return nil, nil
+}
+
 // handleUptime implements the uptime command.
 func handleUptime(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
 	return time.Now().Unix() - s.cfg.StartupTime, nil
@@ -3876,52 +5453,32 @@ func handleValidateAddress(s *rpcServer, cmd interface{}, closeNotifier <-chan b
 	return result, nil
 }
 
-func verifyChain(s *rpcServer, level, depth int32) error {
-	best := s.cfg.Chain.BestSnapshot()
-	finishHeight := best.Height - depth
-	if finishHeight < 0 {
-		finishHeight = 0
-	}
-	rpcsLog.Infof("Verifying chain for %d blocks at level %d",
-		best.Height-finishHeight, level)
+// handleValidateScript implements the validatescript command.
+func handleValidateScript(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.ValidateScriptCmd)
 
-	for height := best.Height; height > finishHeight; height-- {
-		// Level 0 just looks up the block.
-		block, err := s.cfg.Chain.BlockByHeight(height)
+	lockingScript, err := hex.DecodeString(c.LockingScript)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.LockingScript)
+	}
+	var redeemScript []byte
+	if c.RedeemScript != nil {
+		redeemScript, err = hex.DecodeString(*c.RedeemScript)
 		if err != nil {
-			rpcsLog.Errorf("Verify is unable to fetch block at "+
-				"height %d: %v", height, err)
-			return err
-		}
-
-		magneticAnomalyActive := false
-		upgrade9Active := false
-
-		prevHeight := height - 1
-		if prevHeight > 0 {
-			if height > s.cfg.ChainParams.MagneticAnonomalyForkHeight {
-				magneticAnomalyActive = true
-			}
-			if height > s.cfg.ChainParams.Upgrade9ForkHeight {
-				upgrade9Active = true
-			}
+			return nil, rpcDecodeHexError(*c.RedeemScript)
 		}
+	}
 
-		// Level 1 does basic chain sanity checks.
-		if level > 0 {
-			err := blockchain.CheckBlockSanity(block,
-				s.cfg.ChainParams.PowLimit, s.cfg.TimeSource, magneticAnomalyActive, upgrade9Active)
-			if err != nil {
-				rpcsLog.Errorf("Verify is unable to validate "+
-					"block at hash %v height %d: %v",
-					block.Hash(), height, err)
-				return err
-			}
+	best := s.cfg.Chain.BestSnapshot()
+	result, err := lintScript(s.cfg.ChainParams, lockingScript,
+		redeemScript, best.Height, best.MedianTime)
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: err.Error(),
 		}
 	}
-	rpcsLog.Infof("Chain verify completed successfully")
-
-	return nil
+	return result, nil
 }
 
 // handleVerifyChain implements the verifychain command.
@@ -3935,9 +5492,159 @@ func handleVerifyChain(s *rpcServer, cmd interface{}, closeNotifier <-chan bool)
 	if c.CheckDepth != nil {
 		checkDepth = *c.CheckDepth
 	}
+	// Clamp the check level to the supported levels.
+	if checkLevel < 0 {
+		checkLevel = 0
+	}
+	if checkLevel > int32(blockchain.MaxVerifyLevel) {
+		checkLevel = int32(blockchain.MaxVerifyLevel)
+	}
+	background := c.Background != nil && *c.Background
+
+	job, err := s.verifier.start(blockchain.VerifyLevel(checkLevel),
+		checkDepth, background)
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCMisc,
+			Message: err.Error(),
+		}
+	}
+	if background {
+		return true, nil
+	}
 
-	err := verifyChain(s, checkLevel, checkDepth)
-	return err == nil, nil
+	// Cancel the verification when the client disconnects.  The server
+	// shutting down cancels it as well.
+	select {
+	case <-job.done:
+	case <-closeNotifier:
+		s.verifier.cancel()
+		<-job.done
+	}
+	return s.verifier.result(job) == nil, nil
+}
+
+// handleGetVerifyChainInfo implements the getverifychaininfo command.
+func handleGetVerifyChainInfo(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	return s.verifier.info(), nil
+}
+
+// handleStopVerifyChain implements the stopverifychain command.
+func handleStopVerifyChain(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	return s.verifier.cancel(), nil
+}
+
+// handleVerifyDataSignature implements the verifydatasignature command.
+func handleVerifyDataSignature(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.VerifyDataSignatureCmd)
+
+	sig, err := hex.DecodeString(c.Signature)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.Signature)
+	}
+	message, err := hex.DecodeString(c.Message)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.Message)
+	}
+	pubKey, err := hex.DecodeString(c.PubKey)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.PubKey)
+	}
+
+	result := btcjson.VerifyDataSignatureResult{
+		SigType:     "ecdsa",
+		MessageHash: hex.EncodeToString(txscript.DataSignatureHash(message)),
+	}
+	switch len(sig) {
+	case 0:
+		result.SigType = "none"
+	case 64:
+		result.SigType = "schnorr"
+	}
+
+	// Apply the rules OP_CHECKDATASIG applies to transactions accepted to
+	// the mempool.
+	result.Valid, err = txscript.VerifyDataSignature(sig, message, pubKey,
+		txscript.StandardVerifyFlags)
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result, nil
+}
+
+// handleVerifyScripts implements the verifyscripts command.
+func handleVerifyScripts(s *rpcServer, cmd interface{}, closeNotifier <-chan bool) (interface{}, error) {
+	c := cmd.(*btcjson.VerifyScriptsCmd)
+
+	// Deserialize the transaction.
+	hexStr := c.HexTx
+	if len(hexStr)%2 != 0 {
+		hexStr = "0" + hexStr
+	}
+	serializedTx, err := hex.DecodeString(hexStr)
+	if err != nil {
+		return nil, rpcDecodeHexError(hexStr)
+	}
+	var mtx wire.MsgTx
+	err = mtx.Deserialize(bytes.NewReader(serializedTx))
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCDeserialization,
+			Message: "TX decode failed: " + err.Error(),
+		}
+	}
+
+	// Decode the outputs spent by the transaction, with the token data
+	// given as its serialized prefix.
+	prevOuts := make([]wire.TxOut, 0, len(c.PrevOuts))
+	for _, prevOut := range c.PrevOuts {
+		pkScript, err := hex.DecodeString(prevOut.ScriptPubKey)
+		if err != nil {
+			return nil, rpcDecodeHexError(prevOut.ScriptPubKey)
+		}
+		amount, err := bchutil.NewAmount(prevOut.Amount)
+		if err != nil {
+			return nil, &btcjson.RPCError{
+				Code:    btcjson.ErrRPCType,
+				Message: "Invalid amount: " + err.Error(),
+			}
+		}
+		var tokenData wire.TokenData
+		if prevOut.TokenData != "" {
+			prefix, err := hex.DecodeString(prevOut.TokenData)
+			if err != nil {
+				return nil, rpcDecodeHexError(prevOut.TokenData)
+			}
+			rest, err := tokenData.SeparateTokenDataFromPKScriptIfExists(
+				prefix, 0)
+			if err != nil || len(rest) != 0 || tokenData.IsEmpty() {
+				return nil, &btcjson.RPCError{
+					Code:    btcjson.ErrRPCInvalidParameter,
+					Message: "Invalid token data " + prevOut.TokenData,
+				}
+			}
+		}
+		prevOuts = append(prevOuts, *wire.NewTxOut(int64(amount),
+			pkScript, tokenData))
+	}
+
+	flags, err := verifyScriptsFlags(*c.Flags,
+		s.cfg.Chain.NextBlockScriptFlags())
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: err.Error(),
+		}
+	}
+	result, err := verifyScripts(&mtx, prevOuts, flags,
+		s.cfg.ChainParams.Upgrade9Activation())
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: err.Error(),
+		}
+	}
+	return result, nil
 }
 
 // handleVerifyMessage implements the verifymessage command.
@@ -4023,8 +5730,7 @@ type rpcServer struct {
 	started                int32
 	shutdown               int32
 	cfg                    rpcserverConfig
-	authsha                [sha256.Size]byte
-	limitauthsha           [sha256.Size]byte
+	accounts               []*rpcAccount
 	ntfnMgr                *wsNotificationManager
 	numClients             int32
 	statusLines            map[int]string
@@ -4034,6 +5740,18 @@ type rpcServer struct {
 	helpCacher             *helpCacher
 	requestProcessShutdown chan struct{}
 	quit                   chan int
+
+	// chainSubscription delivers the block chain notifications the server
+	// relays to its clients.
+	chainSubscription *blockchain.Subscription
+
+	// publicLimiter limits the rate of unauthenticated requests in public
+	// mode.  It is nil when public mode is disabled.
+	publicLimiter *rpcRateLimiter
+
+	// verifier runs the chain verifications requested with the verifychain
+	// command.
+	verifier *chainVerifier
 }
 
 // Stop is used by server.go to stop the rpc listener.
@@ -4050,8 +5768,10 @@ func (s *rpcServer) Stop() error {
 			return err
 		}
 	}
+	s.chainSubscription.Unsubscribe()
 	s.ntfnMgr.Shutdown()
 	s.ntfnMgr.WaitForShutdown()
+	s.verifier.shutdown()
 	close(s.quit)
 	s.wg.Wait()
 	rpcsLog.Infof("RPC server shutdown complete")
@@ -4079,14 +5799,24 @@ func (s *rpcServer) NotifyNewTransactions(txns []*mempool.TxDesc) {
 	}
 }
 
+// NotifyTxReplaced notifies websocket clients that the passed transaction
+// submitted to this node was replaced by the passed conflicting transaction
+// which was included in a block.
+func (s *rpcServer) NotifyTxReplaced(txHash, replacement *chainhash.Hash) {
+	s.ntfnMgr.NotifyTxReplaced(txHash, replacement)
+}
+
 // limitConnections responds with a 503 service unavailable and returns true if
 // adding another client would exceed the maximum allow RPC clients.
 //
 // This function is safe for concurrent access.
 func (s *rpcServer) limitConnections(w http.ResponseWriter, remoteAddr string) bool {
-	if int(atomic.LoadInt32(&s.numClients)+1) > cfg.RPCMaxClients {
+	reloadMtx.RLock()
+	maxClients := cfg.RPCMaxClients
+	reloadMtx.RUnlock()
+	if int(atomic.LoadInt32(&s.numClients)+1) > maxClients {
 		rpcsLog.Infof("Max RPC clients exceeded [%d] - "+
-			"disconnecting client %s", cfg.RPCMaxClients,
+			"disconnecting client %s", maxClients,
 			remoteAddr)
 		http.Error(w, "503 Too busy.  Try again later.",
 			http.StatusServiceUnavailable)
@@ -4115,45 +5845,31 @@ func (s *rpcServer) decrementClients() {
 
 // checkAuth checks the HTTP Basic authentication supplied by a wallet
 // or RPC client in the HTTP request r.  If the supplied authentication
-// does not match the username and password expected, a non-nil error is
-// returned.
+// does not match the credentials of an account which may be used from the
+// address of the client, a non-nil error is returned.
 //
 // This check is time-constant.
 //
-// The first bool return value signifies auth success (true if successful) and
-// the second bool return value specifies whether the user can change the state
-// of the server (true) or whether the user is limited (false). The second is
-// always false if the first is.
-func (s *rpcServer) checkAuth(r *http.Request, require bool) (bool, bool, error) {
+// The returned account determines the methods the client may call.  It is nil
+// when no authentication was supplied and it is not required.
+func (s *rpcServer) checkAuth(r *http.Request, require bool) (*rpcAccount, error) {
 	authhdr := r.Header["Authorization"]
 	if len(authhdr) <= 0 {
 		if require {
 			rpcsLog.Warnf("RPC authentication failure from %s",
 				r.RemoteAddr)
-			return false, false, errors.New("auth failure")
+			return nil, errors.New("auth failure")
 		}
 
-		return false, false, nil
-	}
-
-	authsha := sha256.Sum256([]byte(authhdr[0]))
-
-	// Check for limited auth first as in environments with limited users, those
-	// are probably expected to have a higher volume of calls
-	limitcmp := subtle.ConstantTimeCompare(authsha[:], s.limitauthsha[:])
-	if limitcmp == 1 {
-		return true, false, nil
+		return nil, nil
 	}
 
-	// Check for admin-level auth
-	cmp := subtle.ConstantTimeCompare(authsha[:], s.authsha[:])
-	if cmp == 1 {
-		return true, true, nil
+	account := lookupRPCAccount(s.accounts, sha256.Sum256([]byte(authhdr[0])))
+	if account == nil || !account.allowsAddr(r.RemoteAddr) {
+		rpcsLog.Warnf("RPC authentication failure from %s", r.RemoteAddr)
+		return nil, errors.New("auth failure")
 	}
-
-	// Request's auth doesn't match either user
-	rpcsLog.Warnf("RPC authentication failure from %s", r.RemoteAddr)
-	return false, false, errors.New("auth failure")
+	return account, nil
 }
 
 // parsedRPCCmd represents a JSON-RPC request object that has been parsed into
@@ -4239,15 +5955,21 @@ func createMarshalledReply(rpcVersion string, id interface{}, result interface{}
 }
 
 // processRequest determines the incoming request type (single or batched),
-// parses it and returns a marshalled response.
-func (s *rpcServer) processRequest(request *btcjson.Request, isAdmin bool, closeNotifier <-chan bool) []byte {
+// parses it and returns a marshalled response.  The request is only processed
+// when the account of the client may call the requested method.
+func (s *rpcServer) processRequest(request *btcjson.Request, account *rpcAccount,
+	limitedListener bool, closeNotifier <-chan bool) []byte {
+
 	var result interface{}
 	var jsonErr error
 
-	if !isAdmin {
-		if _, ok := rpcLimited[request.Method]; !ok {
-			jsonErr = rpcInvalidError("limited user not " +
-				"authorized for this method")
+	if !account.allowsMethod(request.Method, limitedListener) {
+		if account == publicRPCAccount {
+			jsonErr = rpcInvalidError("method requires " +
+				"authentication")
+		} else {
+			jsonErr = rpcInvalidError("user not authorized " +
+				"for this method")
 		}
 	}
 
@@ -4291,12 +6013,16 @@ func (s *rpcServer) processRequest(request *btcjson.Request, isAdmin bool, close
 	return msg
 }
 
-// jsonRPCRead handles reading and responding to RPC messages.
-func (s *rpcServer) jsonRPCRead(w http.ResponseWriter, r *http.Request, isAdmin bool) {
+// jsonRPCRead handles reading and responding to RPC messages from a client
+// using the passed account.
+func (s *rpcServer) jsonRPCRead(w http.ResponseWriter, r *http.Request, account *rpcAccount) {
 	if atomic.LoadInt32(&s.shutdown) != 0 {
 		return
 	}
 
+	spec := ctxListenerSpec(r.Context())
+	limitedListener := spec != nil && spec.hasOption(listenOptLimited)
+
 	// Setup a close notifier to stop any long polling routines.
 	closeNotifier := w.(http.CloseNotifier).CloseNotify()
 
@@ -4320,6 +6046,22 @@ func (s *rpcServer) jsonRPCRead(w http.ResponseWriter, r *http.Request, isAdmin
 		batchedRequest = true
 	}
 
+	// Batched requests would allow public clients to get around the rate
+	// limit, so they require authentication.
+	if batchedRequest && account == publicRPCAccount {
+		jsonErr := &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidRequest.Code,
+			Message: "Invalid request: batched requests require authentication",
+		}
+		resp, err := btcjson.MarshalResponse("2.0", nil, nil, jsonErr)
+		if err != nil {
+			rpcsLog.Errorf("Failed to create reply: %v", err)
+			return
+		}
+		writeJSONRPCReply(w, resp)
+		return
+	}
+
 	// Process a single request
 	if !batchedRequest {
 		var req btcjson.Request
@@ -4338,7 +6080,7 @@ func (s *rpcServer) jsonRPCRead(w http.ResponseWriter, r *http.Request, isAdmin
 		}
 
 		if err == nil {
-			resp = s.processRequest(&req, isAdmin, closeNotifier)
+			resp = s.processRequest(&req, account, limitedListener, closeNotifier)
 		}
 
 		if resp != nil {
@@ -4427,7 +6169,7 @@ func (s *rpcServer) jsonRPCRead(w http.ResponseWriter, r *http.Request, isAdmin
 						continue
 					}
 
-					resp = s.processRequest(&req, isAdmin, closeNotifier)
+					resp = s.processRequest(&req, account, limitedListener, closeNotifier)
 					if resp != nil {
 						results = append(results, resp)
 					}
@@ -4462,6 +6204,11 @@ func (s *rpcServer) jsonRPCRead(w http.ResponseWriter, r *http.Request, isAdmin
 		}
 	}
 
+	writeJSONRPCReply(w, msg)
+}
+
+// writeJSONRPCReply writes a marshalled reply to a JSON-RPC request.
+func writeJSONRPCReply(w http.ResponseWriter, msg []byte) {
 	w.Header().Set("Content-Length", strconv.Itoa(len(msg)+1))
 
 	if _, err := w.Write(msg); err != nil {
@@ -4492,6 +6239,10 @@ func (s *rpcServer) Start() {
 	httpServer := &http.Server{
 		Handler:           rpcServeMux,
 		ReadHeaderTimeout: time.Duration(s.cfg.RPCAuthTimeout) * time.Second,
+
+		// Make the spec of the listener each request was received on
+		// available to the handlers.
+		ConnContext: withConnListenerSpec,
 	}
 
 	rpcServeMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
@@ -4506,19 +6257,35 @@ func (s *rpcServer) Start() {
 		s.incrementClients()
 		defer s.decrementClients()
 
-		_, isAdmin, err := s.checkAuth(r, true)
+		// Requests without credentials are served in public mode,
+		// subject to the rate limit of the client.
+		if s.publicLimiter != nil && len(r.Header["Authorization"]) == 0 {
+			host, _, err := net.SplitHostPort(r.RemoteAddr)
+			if err != nil {
+				host = r.RemoteAddr
+			}
+			if !s.publicLimiter.allow(host, time.Now()) {
+				http.Error(w, "429 Too Many Requests.",
+					http.StatusTooManyRequests)
+				return
+			}
+			s.jsonRPCRead(w, r, publicRPCAccount)
+			return
+		}
+
+		account, err := s.checkAuth(r, true)
 		if err != nil {
 			jsonAuthFail(w)
 			return
 		}
 
 		// Read and respond to the request.
-		s.jsonRPCRead(w, r, isAdmin)
+		s.jsonRPCRead(w, r, account)
 	})
 
 	// Websocket endpoint.
 	rpcServeMux.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
-		authenticated, isAdmin, err := s.checkAuth(r, false)
+		account, err := s.checkAuth(r, false)
 		if err != nil {
 			jsonAuthFail(w)
 			return
@@ -4535,7 +6302,7 @@ func (s *rpcServer) Start() {
 			http.Error(w, "400 Bad Request.", http.StatusBadRequest)
 			return
 		}
-		s.WebsocketHandler(ws, r.RemoteAddr, authenticated, isAdmin)
+		s.WebsocketHandler(ws, r.RemoteAddr, account)
 	})
 
 	for _, listener := range s.cfg.Listeners {
@@ -4551,13 +6318,14 @@ func (s *rpcServer) Start() {
 	s.ntfnMgr.Start()
 }
 
-// genCertPair generates a key/cert pair to the paths provided.
-func genCertPair(certFile, keyFile string, externalIPs []string) error {
+// genCertPair generates a key/cert pair to the paths provided.  The cert is
+// valid for localhost along with the passed extra hosts.
+func genCertPair(certFile, keyFile string, extraHosts []string) error {
 	rpcsLog.Infof("Generating TLS certificates...")
 
-	org := "bchd autogenerated cert"
-	validUntil := time.Now().Add(10 * 365 * 24 * time.Hour)
-	cert, key, err := bchutil.NewTLSCertPair(org, validUntil, externalIPs)
+	validUntil := time.Now().Add(autogenCertValidity)
+	cert, key, err := bchutil.NewTLSCertPair(autogenCertOrg, validUntil,
+		extraHosts)
 	if err != nil {
 		return err
 	}
@@ -4594,9 +6362,17 @@ type rpcserverPeer interface {
 	// IsWhitelisted returns whether or not the peer is whitelisted.
 	IsWhitelisted() bool
 
+	// Permissions returns the names of the permissions granted to the
+	// peer.
+	Permissions() []string
+
 	// FeeFilter returns the requested current minimum fee rate for which
 	// transactions should be announced.
 	FeeFilter() int64
+
+	// ConnectionType returns the kind of connection to the peer: inbound,
+	// manual or the type of an automatic outbound connection.
+	ConnectionType() string
 }
 
 // rpcserverConnManager represents a connection manager for use with the RPC
@@ -4658,6 +6434,20 @@ type rpcserverConnManager interface {
 	// RelayTransactions generates and relays inventory vectors for all of
 	// the passed transactions to all connected peers.
 	RelayTransactions(txns []*mempool.TxDesc)
+
+	// RelayLocalTransaction announces the passed transaction submitted to
+	// this node to a random subset of the outbound peers and tracks its
+	// propagation.
+	RelayLocalTransaction(txD *mempool.TxDesc)
+
+	// TxBroadcastStatus returns the propagation state of the passed
+	// transaction submitted to this node or nil when it is not tracked.
+	TxBroadcastStatus(txHash *chainhash.Hash) *btcjson.GetTxBroadcastStatusResult
+
+	// Reseed queries the DNS seeds and the HTTPS seeds for peers, which
+	// are added to the address manager as they are discovered, and
+	// returns the queried seeds.
+	Reseed() ([]string, []string)
 }
 
 // rpcserverSyncManager represents a sync manager for use with the RPC server.
@@ -4673,6 +6463,11 @@ type rpcserverSyncManager interface {
 	// processing it locally.
 	SubmitBlock(block *bchutil.Block, flags blockchain.BehaviorFlags) (bool, error)
 
+	// SubmitHeader validates the provided header of a block which is not
+	// available yet and relays it to the network when it extends the best
+	// chain.
+	SubmitHeader(header *wire.BlockHeader) error
+
 	// Pause pauses the sync manager until the returned channel is closed.
 	Pause() chan<- struct{}
 
@@ -4683,6 +6478,14 @@ type rpcserverSyncManager interface {
 	// SyncHeight returns the block height of the best peer selected to sync from
 	SyncHeight() uint64
 
+	// ChainSplitStatus returns whether the tip of the chain diverges from
+	// the tips announced by the majority of the connected peers.
+	ChainSplitStatus() netsync.ChainSplitStatus
+
+	// RejectedTxns returns the transactions received from peers which
+	// were recently rejected along with the reasons.
+	RejectedTxns() []netsync.RejectedTx
+
 	// LocateHeaders returns the headers of the blocks after the first known
 	// block in the provided locators until the provided stop hash or the
 	// current tip is reached, up to a max of wire.MaxBlockHeadersPerMsg
@@ -4717,6 +6520,7 @@ type rpcserverConfig struct {
 	// These fields allow the RPC server to interface with the local block
 	// chain data and state.
 	TimeSource  blockchain.MedianTimeSource
+	Clock       blockchain.Clock
 	Chain       *blockchain.BlockChain
 	ChainParams *chaincfg.Params
 	DB          database.DB
@@ -4743,6 +6547,14 @@ type rpcserverConfig struct {
 	// the mempool before they are mined into blocks.
 	FeeEstimator *mempool.FeeEstimator
 
+	// StatsRecorder records the history of the mempool and block
+	// statistics.  It is nil when recording is disabled.
+	StatsRecorder *statsRecorder
+
+	// ForkMonitor compares the chains of other nodes against ours.  It is
+	// nil when no nodes are watched.
+	ForkMonitor *forkMonitor
+
 	// Services represents the services supported by this node.
 	Services wire.ServiceFlag
 
@@ -4762,59 +6574,43 @@ func newRPCServer(config *rpcserverConfig) (*rpcServer, error) {
 		helpCacher:             newHelpCacher(),
 		requestProcessShutdown: make(chan struct{}),
 		quit:                   make(chan int),
+		verifier:               newChainVerifier(config.Chain),
 	}
-	if cfg.RPCUser != "" && cfg.RPCPass != "" {
-		login := cfg.RPCUser + ":" + cfg.RPCPass
-		auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
-		rpc.authsha = sha256.Sum256([]byte(auth))
-	}
-	if cfg.RPCLimitUser != "" && cfg.RPCLimitPass != "" {
-		login := cfg.RPCLimitUser + ":" + cfg.RPCLimitPass
-		auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
-		rpc.limitauthsha = sha256.Sum256([]byte(auth))
+	rpc.accounts = cfg.rpcAccounts
+	if cfg.PublicRPC {
+		rpc.publicLimiter = newRPCRateLimiter(cfg.PublicRPCRate,
+			cfg.PublicRPCBurst)
 	}
 	rpc.ntfnMgr = newWsNotificationManager(&rpc)
-	rpc.cfg.Chain.Subscribe(rpc.handleBlockchainNotification)
+	rpc.chainSubscription = rpc.cfg.Chain.SubscribeHandlers(
+		&blockchain.NotificationHandlers{
+			OnBlockAccepted:     rpc.handleBlockAccepted,
+			OnBlockConnected:    rpc.handleBlockConnected,
+			OnBlockDisconnected: rpc.handleBlockDisconnected,
+		}, chainNotificationQueueSize)
 
 	return &rpc, nil
 }
 
-// Callback for notifications from blockchain.  It notifies clients that are
-// long polling for changes or subscribed to websockets notifications.
-func (s *rpcServer) handleBlockchainNotification(notification *blockchain.Notification) {
-	switch notification.Type {
-	case blockchain.NTBlockAccepted:
-		block, ok := notification.Data.(*bchutil.Block)
-		if !ok {
-			rpcsLog.Warnf("Chain accepted notification is not a block.")
-			break
-		}
-
-		// Allow any clients performing long polling via the
-		// getblocktemplate RPC to be notified when the new block causes
-		// their old block template to become stale.
-		s.gbtWorkState.NotifyBlockConnected(block.Hash())
-
-	case blockchain.NTBlockConnected:
-		block, ok := notification.Data.(*bchutil.Block)
-		if !ok {
-			rpcsLog.Warnf("Chain connected notification is not a block.")
-			break
-		}
-
-		// Notify registered websocket clients of incoming block.
-		s.ntfnMgr.NotifyBlockConnected(block)
+// handleBlockAccepted is the callback for blocks accepted into the block
+// chain.  It notifies clients that are long polling for changes.
+func (s *rpcServer) handleBlockAccepted(block *bchutil.Block) {
+	// Allow any clients performing long polling via the getblocktemplate
+	// RPC to be notified when the new block causes their old block
+	// template to become stale.
+	s.gbtWorkState.NotifyBlockConnected(block.Hash())
+}
 
-	case blockchain.NTBlockDisconnected:
-		block, ok := notification.Data.(*bchutil.Block)
-		if !ok {
-			rpcsLog.Warnf("Chain disconnected notification is not a block.")
-			break
-		}
+// handleBlockConnected is the callback for blocks connected to the main chain.
+// It notifies the websocket clients of the block.
+func (s *rpcServer) handleBlockConnected(block *bchutil.Block) {
+	s.ntfnMgr.NotifyBlockConnected(block)
+}
 
-		// Notify registered websocket clients.
-		s.ntfnMgr.NotifyBlockDisconnected(block)
-	}
+// handleBlockDisconnected is the callback for blocks disconnected from the main
+// chain.  It notifies the websocket clients of the block.
+func (s *rpcServer) handleBlockDisconnected(block *bchutil.Block) {
+	s.ntfnMgr.NotifyBlockDisconnected(block)
 }
 
 func init() {
@@ -0,0 +1,1416 @@
+// Copyright (c) 2015-2017 The btcsuite developers
+// Copyright (c) 2015-2017 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gcash/bchd/btcjson"
+)
+
+// helpDescsEnUS defines the English descriptions used for the help strings.
+var helpDescsEnUS = map[string]string{
+	// DebugLevelCmd help.
+	"debuglevel--synopsis": "Dynamically changes the debug logging level.\n" +
+		"The levelspec can either a debug level or of the form:\n" +
+		"<subsystem>=<level>,<subsystem2>=<level2>,...\n" +
+		"The valid debug levels are trace, debug, info, warn, error, and critical.\n" +
+		"The valid subsystems are AMGR, ADXR, BCDB, BMGR, BCHD, CHAN, DISC, PEER, RPCS, SCRP, SRVR, and TXMP.\n" +
+		"Finally the keyword 'show' will return a list of the available subsystems.",
+	"debuglevel-levelspec":   "The debug level(s) to use or the keyword 'show'",
+	"debuglevel--condition0": "levelspec!=show",
+	"debuglevel--condition1": "levelspec=show",
+	"debuglevel--result0":    "The string 'Done.'",
+	"debuglevel--result1":    "The list of subsystems",
+
+	// ForceReseedCmd help.
+	"forcereseed--synopsis": "Queries the DNS seeds of the network along with the seeds added through the adddnsseed and httpseed options for peers.\n" +
+		"The seeds are queried in the background and the discovered peers are added to the address manager.",
+
+	// ForceReseedResult help.
+	"forcereseedresult-dnsseeds":  "The hosts of the queried DNS seeds",
+	"forcereseedresult-httpseeds": "The URLs of the queried HTTPS seeds",
+
+	// AddNodeCmd help.
+	"addnode--synopsis": "Attempts to add or remove a persistent peer.",
+	"addnode-addr":      "IP address and port of the peer to operate on",
+	"addnode-subcmd":    "'add' to add a persistent peer, 'remove' to remove a persistent peer, or 'onetry' to try a single connection to a peer",
+
+	// AuditBlockTemplateCmd help.
+	"auditblocktemplate--synopsis": "Audits a block built from the template of a mining pool against the view of this node.\n" +
+		"Reports the consensus rules the block violates, how its fees and coinbase value compare to the template this node would produce and the mempool transactions it left out despite paying a higher fee rate than a transaction it includes.\n" +
+		"Transactions which conflict with the block, aren't final or depend on mempool transactions the block doesn't include are not reported as left out.",
+	"auditblocktemplate-hexblock": "The hex-encoded serialized block, which does not need to be solved",
+
+	// AuditBlockTemplateResult help.
+	"auditblocktemplateresult-hash":                  "The hash of the block",
+	"auditblocktemplateresult-height":                "The height the block would connect at",
+	"auditblocktemplateresult-valid":                 "Whether the block connects to the best chain and follows the consensus rules, ignoring proof of work",
+	"auditblocktemplateresult-rejectreason":          "The reason the block is rejected as described in BIP0022",
+	"auditblocktemplateresult-txcount":               "The number of transactions in the block",
+	"auditblocktemplateresult-size":                  "The size of the block in bytes",
+	"auditblocktemplateresult-fees":                  "The total fee paid by the transactions of the block (in Satoshi)",
+	"auditblocktemplateresult-coinbasevalue":         "The total value of the coinbase outputs of the block (in Satoshi)",
+	"auditblocktemplateresult-maxcoinbasevalue":      "The block subsidy plus the fees of the block, which the coinbase value may not exceed (in Satoshi)",
+	"auditblocktemplateresult-minfeerate":            "The lowest fee rate paid by a transaction of the block (in Satoshi/kB), -1 when the block only has a coinbase",
+	"auditblocktemplateresult-unknowntxcount":        "The number of transactions of the block which are not in the mempool",
+	"auditblocktemplateresult-unresolvedtxcount":     "The number of transactions of the block whose inputs could not be found, which are not included in the fees",
+	"auditblocktemplateresult-templatetxcount":       "The number of transactions in the template of this node",
+	"auditblocktemplateresult-templatesize":          "The size of the template of this node in bytes",
+	"auditblocktemplateresult-templatefees":          "The total fee paid by the transactions in the template of this node (in Satoshi)",
+	"auditblocktemplateresult-templatecoinbasevalue": "The coinbase value available to the template of this node (in Satoshi)",
+	"auditblocktemplateresult-omitted":               "The mempool transactions left out of the block in favor of transactions paying a lower fee rate, highest fee rate first",
+
+	// AuditOmittedTxResult help.
+	"auditomittedtxresult-txid":    "The hash of the transaction",
+	"auditomittedtxresult-size":    "The size of the transaction in bytes",
+	"auditomittedtxresult-fee":     "The fee paid by the transaction (in Satoshi)",
+	"auditomittedtxresult-feerate": "The fee rate paid by the transaction (in Satoshi/kB)",
+
+	// NodeCmd help.
+	"node--synopsis":     "Attempts to add or remove a peer.",
+	"node-subcmd":        "'disconnect' to remove all matching non-persistent peers, 'remove' to remove a persistent peer, or 'connect' to connect to a peer",
+	"node-target":        "Either the IP address and port of the peer to operate on, or a valid peer ID.",
+	"node-connectsubcmd": "'perm' to make the connected peer a permanent one, 'temp' to try a single connect to a peer",
+
+	// TransactionInput help.
+	"transactioninput-txid": "The hash of the input transaction",
+	"transactioninput-vout": "The specific output of the input transaction to redeem",
+
+	// CompactSlpIndexCmd help.
+	"compactslpindex--synopsis": "Drops the slp index entries of the tokens whose entire supply is provably burned, or whose unspent supply is at most the passed amount, to reclaim disk space.\n" +
+		"Tokens with an unspent mint baton are never dropped and the token metadata of the dropped tokens is kept.\n" +
+		"The transactions of the dropped tokens can no longer be queried, and reorganizing the blocks which spent their outputs makes the restored outputs appear burned.",
+	"compactslpindex-maxunspentamount": "The largest amount of a token, in its base units, which may remain in unspent outputs for the token to be dropped",
+
+	// CompactSlpIndexResult help.
+	"compactslpindexresult-tokens":         "The number of tokens whose entries were dropped",
+	"compactslpindexresult-entries":        "The number of transaction entries dropped",
+	"compactslpindexresult-reclaimedbytes": "The serialized size of the dropped entries, which is released on disk as the database compacts its files",
+
+	// CreateRawTransactionCmd help.
+	"createrawtransaction--synopsis": "Returns a new transaction spending the provided inputs and sending to the provided addresses.\n" +
+		"The transaction inputs are not signed in the created transaction.\n" +
+		"The signrawtransaction RPC command provided by wallet must be used to sign the resulting transaction.",
+	"createrawtransaction-inputs":           "The inputs to the transaction",
+	"createrawtransaction-amounts":          "JSON object with the destination addresses as keys and amounts as values",
+	"createrawtransaction-amounts--key":     "address",
+	"createrawtransaction-amounts--value":   "n.nnn",
+	"createrawtransaction-amounts--desc":    "The destination address as the key and the amount in BTC as the value",
+	"createrawtransaction-cashtokens":       "JSON object containing the cashtokens data of the transaction outputs",
+	"transactionoutputcashtoken-address":    "address",
+	"transactionoutputcashtoken-category":   "Hex-encoded bytes of token category",
+	"transactionoutputcashtoken-amount":     "n",
+	"transactionoutputcashtoken-capability": "none, mutable, minting",
+	"transactionoutputcashtoken-commitment": "Hex-encoded bytes of commitment",
+	"createrawtransaction-locktime":         "Locktime value; a non-zero value will also locktime-activate the inputs",
+	"createrawtransaction--result0":         "Hex-encoded bytes of the serialized transaction",
+
+	// ScriptSig help.
+	"scriptsig-asm": "Disassembly of the script",
+	"scriptsig-hex": "Hex-encoded bytes of the script",
+
+	// PrevOut help.
+	"prevout-addresses": "previous output addresses",
+	"prevout-value":     "previous output value",
+
+	// VinPrevOut help.
+	"vinprevout-coinbase":    "The hex-encoded bytes of the signature script (coinbase txns only)",
+	"vinprevout-txid":        "The hash of the origin transaction (non-coinbase txns only)",
+	"vinprevout-vout":        "The index of the output being redeemed from the origin transaction (non-coinbase txns only)",
+	"vinprevout-scriptSig":   "The signature script used to redeem the origin transaction as a JSON object (non-coinbase txns only)",
+	"vinprevout-txinwitness": "The witness stack of the passed input, encoded as a JSON string array",
+	"vinprevout-prevOut":     "Data from the origin transaction output with index vout.",
+	"vinprevout-sequence":    "The script sequence number",
+
+	// Vin help.
+	"vin-coinbase":    "The hex-encoded bytes of the signature script (coinbase txns only)",
+	"vin-txid":        "The hash of the origin transaction (non-coinbase txns only)",
+	"vin-vout":        "The index of the output being redeemed from the origin transaction (non-coinbase txns only)",
+	"vin-scriptSig":   "The signature script used to redeem the origin transaction as a JSON object (non-coinbase txns only)",
+	"vin-prevOut":     "Data from the origin transaction output with index vout (getrawtransaction with verbose=2 only, when available)",
+	"vin-txinwitness": "The witness used to redeem the input encoded as a string array of its items",
+	"vin-sequence":    "The script sequence number",
+
+	// ScriptPubKeyResult help.
+	"scriptpubkeyresult-asm":       "Disassembly of the script",
+	"scriptpubkeyresult-hex":       "Hex-encoded bytes of the script",
+	"scriptpubkeyresult-reqSigs":   "The number of required signatures",
+	"scriptpubkeyresult-type":      "The type of the script (e.g. 'pubkeyhash')",
+	"scriptpubkeyresult-addresses": "The bitcoin addresses associated with this script",
+
+	// Vout help.
+	"vout-value":        "The amount in BTC",
+	"vout-n":            "The index of this transaction output",
+	"vout-scriptPubKey": "The public key script used to pay coins as a JSON object",
+
+	// TxRawDecodeResult help.
+	"txrawdecoderesult-txid":     "The hash of the transaction",
+	"txrawdecoderesult-version":  "The transaction version",
+	"txrawdecoderesult-locktime": "The transaction lock time",
+	"txrawdecoderesult-vin":      "The transaction inputs as JSON objects",
+	"txrawdecoderesult-vout":     "The transaction outputs as JSON objects",
+
+	// DecodeRawTransactionCmd help.
+	"decoderawtransaction--synopsis": "Returns a JSON object representing the provided serialized, hex-encoded transaction.",
+	"decoderawtransaction-hextx":     "Serialized, hex-encoded transaction",
+
+	// DecodeScriptResult help.
+	"decodescriptresult-asm":       "Disassembly of the script",
+	"decodescriptresult-reqSigs":   "The number of required signatures",
+	"decodescriptresult-type":      "The type of the script (e.g. 'pubkeyhash')",
+	"decodescriptresult-addresses": "The bitcoin addresses associated with this script",
+	"decodescriptresult-p2sh":      "The script hash for use in pay-to-script-hash transactions (only present if the provided redeem script is not already a pay-to-script-hash script)",
+
+	// DecodeScriptCmd help.
+	"decodescript--synopsis": "Returns a JSON object with information about the provided hex-encoded script.",
+	"decodescript-hexscript": "Hex-encoded script",
+
+	// EstimateFeeCmd help.
+	"estimatefee--synopsis": "Estimate the fee per kilobyte in satoshis " +
+		"required for a transaction to be mined before a certain number of " +
+		"blocks have been generated.",
+	"estimatefee-numblocks": "The maximum number of blocks which can be " +
+		"generated before the transaction is mined.",
+	"estimatefee-mode": "How the fee is estimated: historical from the fee rates of recently confirmed transactions, " +
+		"mempool from the fee rate needed to be ahead of the transactions waiting in the memory pool in the next " +
+		"blocks of the size mined by this node, or blended, the higher of both -- defaults to the estimatefeemode option of the server.",
+	"estimatefee--result0": "Estimated fee per kilobyte in satoshis for a block to " +
+		"be mined in the next NumBlocks blocks.",
+
+	// GenerateCmd help
+	"generate--synopsis": "Generates a set number of blocks (simnet or regtest only) and returns a JSON\n" +
+		" array of their hashes.",
+	"generate-numblocks": "Number of blocks to generate",
+	"generate--result0":  "The hashes, in order, of blocks generated by the call",
+
+	// GetAddedNodeInfoResultAddr help.
+	"getaddednodeinforesultaddr-address":   "The ip address for this DNS entry",
+	"getaddednodeinforesultaddr-connected": "The connection 'direction' (inbound/outbound/false)",
+
+	// GetAddedNodeInfoResult help.
+	"getaddednodeinforesult-addednode": "The ip address or domain of the added peer",
+	"getaddednodeinforesult-connected": "Whether or not the peer is currently connected",
+	"getaddednodeinforesult-addresses": "DNS lookup and connection information about the peer",
+
+	// GetAddedNodeInfo help.
+	"getaddednodeinfo--synopsis":   "Returns information about manually added (persistent) peers.",
+	"getaddednodeinfo-dns":         "Specifies whether the returned data is a JSON object including DNS and connection information, or just a list of added peers",
+	"getaddednodeinfo-node":        "Only return information about this specific peer instead of all added peers",
+	"getaddednodeinfo--condition0": "dns=false",
+	"getaddednodeinfo--condition1": "dns=true",
+	"getaddednodeinfo--result0":    "List of added peers",
+
+	// GetBestBlockResult help.
+	"getbestblockresult-hash":   "Hex-encoded bytes of the best block hash",
+	"getbestblockresult-height": "Height of the best block",
+
+	// GetBestBlockCmd help.
+	"getbestblock--synopsis": "Get block height and hash of best block in the main chain.",
+	"getbestblock--result0":  "Get block height and hash of best block in the main chain.",
+
+	// GetBestBlockHashCmd help.
+	"getbestblockhash--synopsis": "Returns the hash of the of the best (most recent) block in the longest block chain.",
+	"getbestblockhash--result0":  "The hex-encoded block hash",
+
+	// GetBlockCmd help.
+	"getblock--synopsis":   "Returns information about a block given its hash.",
+	"getblock-hash":        "The hash of the block",
+	"getblock-verbosity":   "Specifies whether the block data should be returned as a hex-encoded string (0), as parsed data with a slice of TXIDs (1), or as parsed data with parsed transaction data (2) ",
+	"getblock--condition0": "verbosity=0",
+	"getblock--condition1": "verbosity=1",
+	"getblock--condition2": "verbosity=2",
+	"getblock--result0":    "Hex-encoded bytes of the serialized block",
+	"getblock--result1":    "JSON object with information about the block",
+	"getblock--result2":    "JSON object with information about the block and each transaction",
+
+	// GetBlockByHeightCmd help.
+	"getblockbyheight--synopsis":   "Returns information about the block in the best block chain at the given height.",
+	"getblockbyheight-height":      "The block height",
+	"getblockbyheight-verbosity":   "Specifies whether the block data should be returned as a hex-encoded string (0), as parsed data with a slice of TXIDs (1), or as parsed data with parsed transaction data (2) ",
+	"getblockbyheight--condition0": "verbosity=0",
+	"getblockbyheight--condition1": "verbosity=1",
+	"getblockbyheight--condition2": "verbosity=2",
+	"getblockbyheight--result0":    "Hex-encoded bytes of the serialized block",
+	"getblockbyheight--result1":    "JSON object with information about the block",
+	"getblockbyheight--result2":    "JSON object with information about the block and each transaction",
+
+	// GetBlockChainInfoCmd help.
+	"getblockchaininfo--synopsis": "Returns information about the current blockchain state and the status of any active soft-fork deployments.",
+
+	// GetBlockChainInfoResult help.
+	"getblockchaininforesult-chain":                 "The name of the chain the daemon is on (testnet, mainnet, etc)",
+	"getblockchaininforesult-blocks":                "The number of blocks in the best known chain",
+	"getblockchaininforesult-headers":               "The number of headers that we've gathered for in the best known chain",
+	"getblockchaininforesult-bestblockhash":         "The block hash for the latest block in the main chain",
+	"getblockchaininforesult-difficulty":            "The current chain difficulty",
+	"getblockchaininforesult-mediantime":            "The median time from the PoV of the best block in the chain",
+	"getblockchaininforesult-blocksizelimit":        "The maximum size in bytes of the next block, as set by the adaptive block size limit algorithm once it is active",
+	"getblockchaininforesult-verificationprogress":  "An estimate for how much of the best chain we've verified",
+	"getblockchaininforesult-syncheight":            "The block height obtained from the best peer",
+	"getblockchaininforesult-pruned":                "A bool that indicates if the node is pruned or not",
+	"getblockchaininforesult-pruneheight":           "The lowest block retained in the current pruned chain",
+	"getblockchaininforesult-chainwork":             "The total cumulative work in the best chain",
+	"getblockchaininforesult-softforks":             "The status of the super-majority soft-forks",
+	"getblockchaininforesult-bip9_softforks":        "JSON object describing active BIP0009 deployments",
+	"getblockchaininforesult-scriptflags":           "The script verification flags applied to the next block, including the overrides",
+	"getblockchaininforesult-scriptflagoverrides":   "The script verification flags forcibly enabled (+) or disabled (-) with --scriptflags",
+	"getblockchaininforesult-warnings":              "Any blockchain warnings, such as the chain tip diverging from the tips of the majority of peers",
+	"getblockchaininforesult-bip9_softforks--key":   "bip9_softforks",
+	"getblockchaininforesult-bip9_softforks--value": "An object describing a particular BIP009 deployment",
+	"getblockchaininforesult-bip9_softforks--desc":  "The status of any defined BIP0009 soft-fork deployments",
+
+	// SoftForkDescription help.
+	"softforkdescription-reject":  "The current activation status of the softfork",
+	"softforkdescription-version": "The block version that signals enforcement of this softfork",
+	"softforkdescription-id":      "The string identifier for the soft fork",
+	"-status":                     "A bool which indicates if the soft fork is active",
+
+	// TxRawResult help.
+	"txrawresult-hex":           "Hex-encoded transaction",
+	"txrawresult-txid":          "The hash of the transaction",
+	"txrawresult-version":       "The transaction version",
+	"txrawresult-locktime":      "The transaction lock time",
+	"txrawresult-vin":           "The transaction inputs as JSON objects",
+	"txrawresult-vout":          "The transaction outputs as JSON objects",
+	"txrawresult-blockhash":     "Hash of the block the transaction is part of",
+	"txrawresult-blockheight":   "Height of the block the transaction is part of",
+	"txrawresult-fee":           "The fee paid by the transaction in BCH (verbose=2 only, when the outputs spent by the inputs are available)",
+	"txrawresult-confirmations": "Number of confirmations of the block",
+	"txrawresult-time":          "Transaction time in seconds since 1 Jan 1970 GMT",
+	"txrawresult-blocktime":     "Block time in seconds since the 1 Jan 1970 GMT",
+	"txrawresult-size":          "The size of the transaction in bytes",
+	"txrawresult-vsize":         "The virtual size of the transaction in bytes",
+	"txrawresult-hash":          "The wtxid of the transaction",
+
+	// SearchRawTransactionsResult help.
+	"searchrawtransactionsresult-hex":           "Hex-encoded transaction",
+	"searchrawtransactionsresult-txid":          "The hash of the transaction",
+	"searchrawtransactionsresult-hash":          "The wxtid of the transaction",
+	"searchrawtransactionsresult-version":       "The transaction version",
+	"searchrawtransactionsresult-locktime":      "The transaction lock time",
+	"searchrawtransactionsresult-vin":           "The transaction inputs as JSON objects",
+	"searchrawtransactionsresult-vout":          "The transaction outputs as JSON objects",
+	"searchrawtransactionsresult-blockhash":     "Hash of the block the transaction is part of",
+	"searchrawtransactionsresult-confirmations": "Number of confirmations of the block",
+	"searchrawtransactionsresult-time":          "Transaction time in seconds since 1 Jan 1970 GMT",
+	"searchrawtransactionsresult-blocktime":     "Block time in seconds since the 1 Jan 1970 GMT",
+	"searchrawtransactionsresult-size":          "The size of the transaction in bytes",
+	"searchrawtransactionsresult-vsize":         "The virtual size of the transaction in bytes",
+
+	// GetBlockVerboseResult help.
+	"getblockverboseresult-hash":              "The hash of the block (same as provided)",
+	"getblockverboseresult-confirmations":     "The number of confirmations",
+	"getblockverboseresult-size":              "The size of the block",
+	"getblockverboseresult-height":            "The height of the block in the block chain",
+	"getblockverboseresult-version":           "The block version",
+	"getblockverboseresult-versionHex":        "The block version in hexadecimal",
+	"getblockverboseresult-merkleroot":        "Root hash of the merkle tree",
+	"getblockverboseresult-tx":                "The transaction hashes (only when verbosity=1)",
+	"getblockverboseresult-rawtx":             "The transactions as JSON objects (only when verbosity=2)",
+	"getblockverboseresult-time":              "The block time in seconds since 1 Jan 1970 GMT",
+	"getblockverboseresult-nonce":             "The block nonce",
+	"getblockverboseresult-bits":              "The bits which represent the block difficulty",
+	"getblockverboseresult-difficulty":        "The proof-of-work difficulty as a multiple of the minimum difficulty",
+	"getblockverboseresult-previousblockhash": "The hash of the previous block",
+	"getblockverboseresult-nextblockhash":     "The hash of the next block (only if there is one)",
+	"getblockverboseresult-strippedsize":      "The size of the block without witness data",
+	"getblockverboseresult-scriptflags":       "The script flags the block was validated with when it was connected to the main chain (only when verbosity=2 and they were recorded)",
+
+	// BlockScriptFlagsResult help.
+	"blockscriptflagsresult-flags":      "The names of the script flags active for the block, including the configured overrides",
+	"blockscriptflagsresult-scriptsrun": "Whether the scripts of the block were executed, they are skipped for the blocks before the latest checkpoint",
+
+	// GetBlockCountCmd help.
+	"getblockcount--synopsis": "Returns the number of blocks in the longest block chain.",
+	"getblockcount--result0":  "The current block count",
+
+	// GetChainTipsCmd help.
+	"getchaintips--synopsis": "Returns the tips of all known branches of the block tree, including the main chain, highest first.",
+
+	// GetChainTipsResult help.
+	"getchaintipsresult-height":        "The height of the tip",
+	"getchaintipsresult-hash":          "The hash of the tip",
+	"getchaintipsresult-branchlen":     "The number of blocks of the branch which are not part of the main chain, zero for the main chain",
+	"getchaintipsresult-status":        "The state of the branch (active, valid-fork, valid-headers, headers-only or invalid)",
+	"getchaintipsresult-invalidblock":  "The hash of the first block of the branch which failed validation (only for invalid branches when known)",
+	"getchaintipsresult-invalidreason": "Why the first invalid block of the branch was rejected (only for invalid branches when known)",
+
+	// GetBlockHashCmd help.
+	"getblockhash--synopsis": "Returns hash of the block in best block chain at the given height.",
+	"getblockhash-index":     "The block height",
+	"getblockhash--result0":  "The block hash",
+
+	// GetBlockHeaderCmd help.
+	"getblockheader--synopsis":   "Returns information about a block header given its hash.",
+	"getblockheader-hash":        "The hash of the block",
+	"getblockheader-verbose":     "Specifies the block header is returned as a JSON object instead of hex-encoded string",
+	"getblockheader--condition0": "verbose=false",
+	"getblockheader--condition1": "verbose=true",
+	"getblockheader--result0":    "The block header hash",
+
+	// GetBlockHeaderVerboseResult help.
+	"getblockheaderverboseresult-hash":              "The hash of the block (same as provided)",
+	"getblockheaderverboseresult-confirmations":     "The number of confirmations",
+	"getblockheaderverboseresult-height":            "The height of the block in the block chain",
+	"getblockheaderverboseresult-version":           "The block version",
+	"getblockheaderverboseresult-versionHex":        "The block version in hexadecimal",
+	"getblockheaderverboseresult-merkleroot":        "Root hash of the merkle tree",
+	"getblockheaderverboseresult-time":              "The block time in seconds since 1 Jan 1970 GMT",
+	"getblockheaderverboseresult-nonce":             "The block nonce",
+	"getblockheaderverboseresult-bits":              "The bits which represent the block difficulty",
+	"getblockheaderverboseresult-difficulty":        "The proof-of-work difficulty as a multiple of the minimum difficulty",
+	"getblockheaderverboseresult-previousblockhash": "The hash of the previous block",
+	"getblockheaderverboseresult-nextblockhash":     "The hash of the next block (only if there is one)",
+
+	// TemplateRequest help.
+	"templaterequest-mode":         "This is 'template', 'proposal', or omitted",
+	"templaterequest-capabilities": "List of capabilities, including 'templates' to request the candidate block templates",
+	"templaterequest-longpollid":   "The long poll ID of a job to monitor for expiration; required and valid only for long poll requests ",
+	"templaterequest-sigoplimit":   "Number of signature operations allowed in blocks (this parameter is ignored)",
+	"templaterequest-sizelimit":    "Number of bytes allowed in blocks (this parameter is ignored)",
+	"templaterequest-maxversion":   "Highest supported block version number (this parameter is ignored)",
+	"templaterequest-target":       "The desired target for the block template (this parameter is ignored)",
+	"templaterequest-data":         "Hex-encoded block data (only for mode=proposal)",
+	"templaterequest-workid":       "The server provided workid if provided in block template (not applicable)",
+
+	// GetBlockTemplateResultTx help.
+	"getblocktemplateresulttx-data":      "Hex-encoded transaction data (byte-for-byte)",
+	"getblocktemplateresulttx-hash":      "Hex-encoded transaction hash (little endian if treated as a 256-bit number)",
+	"getblocktemplateresulttx-depends":   "Other transactions before this one (by 1-based index in the 'transactions'  list) that must be present in the final block if this one is",
+	"getblocktemplateresulttx-fee":       "Difference in value between transaction inputs and outputs (in Satoshi)",
+	"getblocktemplateresulttx-sigops":    "Total number of signature operations as counted for purposes of block limits",
+	"getblocktemplateresulttx-sigchecks": "Total number of signature checks as counted for purposes of block limits",
+	"getblocktemplateresulttx-size":      "The size of the transaction",
+
+	// GetBlockTemplateResultAux help.
+	"getblocktemplateresultaux-flags": "Hex-encoded byte-for-byte data to include in the coinbase signature script",
+
+	// GetBlockTemplateResultCandidate help.
+	"getblocktemplateresultcandidate-profile":       "The trade-off of the block template ('smallblock' for a block which propagates faster, or 'tokens' for a block which includes CashTokens transactions first)",
+	"getblocktemplateresultcandidate-sizelimit":     "Number of bytes allowed in blocks",
+	"getblocktemplateresultcandidate-sigchecktotal": "The total number of signature checks in the block template",
+	"getblocktemplateresultcandidate-fees":          "The total fees of the transactions in the block template in Satoshi",
+	"getblocktemplateresultcandidate-transactions":  "Array of transactions as JSON objects",
+	"getblocktemplateresultcandidate-coinbasetxn":   "Information about the coinbase transaction",
+	"getblocktemplateresultcandidate-coinbasevalue": "Total amount available for the coinbase in Satoshi",
+
+	// GetBlockTemplateResult help.
+	"getblocktemplateresult-bits":                       "Hex-encoded compressed difficulty",
+	"getblocktemplateresult-curtime":                    "Current time as seen by the server (recommended for block time); must fall within mintime/maxtime rules",
+	"getblocktemplateresult-height":                     "Height of the block to be solved",
+	"getblocktemplateresult-previousblockhash":          "Hex-encoded big-endian hash of the previous block",
+	"getblocktemplateresult-sigoplimit":                 "Number of sigops allowed in blocks ",
+	"getblocktemplateresult-sizelimit":                  "Number of bytes allowed in blocks",
+	"getblocktemplateresult-transactions":               "Array of transactions as JSON objects",
+	"getblocktemplateresult-version":                    "The block version",
+	"getblocktemplateresult-coinbaseaux":                "Data that should be included in the coinbase signature script",
+	"getblocktemplateresult-coinbasetxn":                "Information about the coinbase transaction",
+	"getblocktemplateresult-coinbasevalue":              "Total amount available for the coinbase in Satoshi",
+	"getblocktemplateresult-workid":                     "This value must be returned with result if provided (not provided)",
+	"getblocktemplateresult-longpollid":                 "Identifier for long poll request which allows monitoring for expiration",
+	"getblocktemplateresult-longpolluri":                "An alternate URI to use for long poll requests if provided (not provided)",
+	"getblocktemplateresult-submitold":                  "Not applicable",
+	"getblocktemplateresult-target":                     "Hex-encoded big-endian number which valid results must be less than",
+	"getblocktemplateresult-expires":                    "Maximum number of seconds (starting from when the server sent the response) this work is valid for",
+	"getblocktemplateresult-maxtime":                    "Maximum allowed time",
+	"getblocktemplateresult-mintime":                    "Minimum allowed time",
+	"getblocktemplateresult-mutable":                    "List of mutations the server explicitly allows",
+	"getblocktemplateresult-noncerange":                 "Two concatenated hex-encoded big-endian 32-bit integers which represent the valid ranges of nonces the miner may scan",
+	"getblocktemplateresult-capabilities":               "List of server capabilities including 'proposal' to indicate support for block proposals and 'templates' to indicate support for candidate block templates",
+	"getblocktemplateresult-reject-reason":              "Reason the proposal was invalid as-is (only applies to proposal responses)",
+	"getblocktemplateresult-sigchecktotal":              "The total number of signature checks in the block template",
+	"getblocktemplateresult-sigchecklimit":              "The maximum number of signature checks allowed by the consensus rules",
+	"getblocktemplateresult-sigcheckremaining":          "The number of signature checks which may still be added to the block template without exceeding the consensus limit",
+	"getblocktemplateresult-default_witness_commitment": "The witness commitment itself. Will be populated if the block has witness data",
+	"getblocktemplateresult-templates":                  "Candidate block templates making other trade-offs than this one, which collects the most fees, sharing its header fields (only with the templates capability)",
+
+	// GetBlockTemplateCmd help.
+	"getblocktemplate--synopsis": "Returns a JSON object with information necessary to construct a block to mine or accepts a proposal to validate.\n" +
+		"See BIP0022 and BIP0023 for the full specification.",
+	"getblocktemplate-request":     "Request object which controls the mode and several parameters",
+	"getblocktemplate--condition0": "mode=template",
+	"getblocktemplate--condition1": "mode=proposal, rejected",
+	"getblocktemplate--condition2": "mode=proposal, accepted",
+	"getblocktemplate--result1":    "An error string which represents why the proposal was rejected or nothing if accepted",
+
+	// GetCFilterCmd help.
+	"getcfilter--synopsis":  "Returns a block's committed filter given its hash.",
+	"getcfilter-filtertype": "The type of filter to return (0=regular, 1=extended)",
+	"getcfilter-hash":       "The hash of the block",
+	"getcfilter--result0":   "The block's committed filter",
+
+	// GetCFilterHeaderCmd help.
+	"getcfilterheader--synopsis":  "Returns a block's compact filter header given its hash.",
+	"getcfilterheader-filtertype": "The type of filter header to return (0=regular, 1=extended)",
+	"getcfilterheader-hash":       "The hash of the block",
+	"getcfilterheader--result0":   "The block's gcs filter header",
+
+	// GetConnectionCountCmd help.
+	"getconnectioncount--synopsis": "Returns the number of active connections to other peers.",
+	"getconnectioncount--result0":  "The number of connections",
+
+	// GetCPFPInfoCmd help.
+	"getcpfpinfo--synopsis": "Returns the fee a child spending a transaction in the mempool needs to pay to get into the next block along with the transaction and its unconfirmed ancestors.\n" +
+		"The fee rate to beat is the lowest fee rate of the transactions in the block template, or the minimum relay fee when all the transactions fit in it.\n" +
+		"Block templates select each transaction by its own fee rate once its unconfirmed ancestors are selected, so the child can't pull in the transactions listed as blocking the package.",
+	"getcpfpinfo-txid":      "The hash of the transaction in the mempool",
+	"getcpfpinfo-childsize": "The size in bytes of the child transaction",
+
+	// GetCPFPInfoResult help.
+	"getcpfpinforesult-txid":            "The hash of the transaction",
+	"getcpfpinforesult-intemplate":      "Whether the transaction is already in the block template",
+	"getcpfpinforesult-packagesize":     "Total size in bytes of the transaction and its unconfirmed ancestors",
+	"getcpfpinforesult-packagefees":     "Total fees of the transaction and its unconfirmed ancestors in BCH",
+	"getcpfpinforesult-packagefeeperkb": "Fee rate of the transaction and its unconfirmed ancestors in BCH/kB",
+	"getcpfpinforesult-targetfeeperkb":  "Fee rate in BCH/kB the child and each transaction of the package which isn't in the block template need to get into the next block",
+	"getcpfpinforesult-childsize":       "The size in bytes of the child transaction",
+	"getcpfpinforesult-childfee":        "Minimum fee in BCH the child needs to pay",
+	"getcpfpinforesult-blockingtxids":   "The hashes of the transaction and its unconfirmed ancestors which aren't in the block template and pay less than the target fee rate on their own, which keep the child out of the next block whatever it pays",
+	"getcpfpinforesult-truncated":       "Whether only the closest ancestors are accounted for because there are too many of them",
+
+	// GetCurrentNetCmd help.
+	"getcurrentnet--synopsis": "Get bitcoin network the server is running on.",
+	"getcurrentnet--result0":  "The network identifer",
+
+	// GetDBInfoCmd help.
+	"getdbinfo--synopsis": "Returns statistics about the caches and the storage of the database, to help tuning the database cache size.",
+
+	// DBLevelInfo help.
+	"dblevelinfo-tables":          "Number of tables in the level",
+	"dblevelinfo-size":            "Size of the tables in the level in bytes",
+	"dblevelinfo-compactiontime":  "Time spent compacting the level in seconds",
+	"dblevelinfo-compactionread":  "Number of bytes read compacting the level",
+	"dblevelinfo-compactionwrite": "Number of bytes written compacting the level",
+
+	// GetDBInfoResult help.
+	"getdbinforesult-type":              "The type of the database",
+	"getdbinforesult-cachesize":         "Size of the entries held by the write cache in bytes",
+	"getdbinforesult-cachemaxsize":      "Size of the write cache in bytes at which it is flushed",
+	"getdbinforesult-cacheentries":      "Number of entries held by the write cache",
+	"getdbinforesult-cachehits":         "Number of metadata reads served by the write cache",
+	"getdbinforesult-cachemisses":       "Number of metadata reads served by the metadata store",
+	"getdbinforesult-cachehitrate":      "Share of the metadata reads served by the write cache",
+	"getdbinforesult-openblockfiles":    "Number of block files with an open handle",
+	"getdbinforesult-maxopenblockfiles": "Maximum number of block files with an open handle",
+	"getdbinforesult-blockfilehits":     "Number of block file reads which found the file open",
+	"getdbinforesult-blockfilemisses":   "Number of block file reads which had to open the file",
+	"getdbinforesult-blockfilehitrate":  "Share of the block file reads which found the file open",
+	"getdbinforesult-opentables":        "Number of metadata table files with an open handle",
+	"getdbinforesult-blockcachesize":    "Size of the cache of metadata table blocks in bytes",
+	"getdbinforesult-ioread":            "Number of bytes read by the metadata store",
+	"getdbinforesult-iowrite":           "Number of bytes written by the metadata store",
+	"getdbinforesult-levels":            "The levels of the metadata store which hold tables or were compacted, from the lowest",
+
+	// GetDifficultyCmd help.
+	"getdifficulty--synopsis": "Returns the proof-of-work difficulty as a multiple of the minimum difficulty.",
+	"getdifficulty--result0":  "The difficulty",
+
+	// GetGenerateCmd help.
+	"getgenerate--synopsis": "Returns if the server is set to generate coins (mine) or not.",
+	"getgenerate--result0":  "True if mining, false if not",
+
+	// GetHashesPerSecCmd help.
+	"gethashespersec--synopsis": "Returns a recent hashes per second performance measurement while generating coins (mining).",
+	"gethashespersec--result0":  "The number of hashes per second",
+
+	// InfoChainResult help.
+	"infochainresult-version":         "The version of the server",
+	"infochainresult-protocolversion": "The latest supported protocol version",
+	"infochainresult-blocks":          "The number of blocks processed",
+	"infochainresult-timeoffset":      "The time offset",
+	"infochainresult-connections":     "The number of connected peers",
+	"infochainresult-proxy":           "The proxy used by the server",
+	"infochainresult-difficulty":      "The current target difficulty",
+	"infochainresult-testnet":         "Whether or not server is using testnet",
+	"infochainresult-relayfee":        "The minimum relay fee for non-free transactions in BTC/KB",
+	"infochainresult-errors":          "Any current errors",
+
+	// InfoWalletResult help.
+	"infowalletresult-version":         "The version of the server",
+	"infowalletresult-protocolversion": "The latest supported protocol version",
+	"infowalletresult-walletversion":   "The version of the wallet server",
+	"infowalletresult-balance":         "The total bitcoin balance of the wallet",
+	"infowalletresult-blocks":          "The number of blocks processed",
+	"infowalletresult-timeoffset":      "The time offset",
+	"infowalletresult-connections":     "The number of connected peers",
+	"infowalletresult-proxy":           "The proxy used by the server",
+	"infowalletresult-difficulty":      "The current target difficulty",
+	"infowalletresult-testnet":         "Whether or not server is using testnet",
+	"infowalletresult-keypoololdest":   "Seconds since 1 Jan 1970 GMT of the oldest pre-generated key in the key pool",
+	"infowalletresult-keypoolsize":     "The number of new keys that are pre-generated",
+	"infowalletresult-unlocked_until":  "The timestamp in seconds since 1 Jan 1970 GMT that the wallet is unlocked for transfers, or 0 if the wallet is locked",
+	"infowalletresult-paytxfee":        "The transaction fee set in BTC/KB",
+	"infowalletresult-relayfee":        "The minimum relay fee for non-free transactions in BTC/KB",
+	"infowalletresult-errors":          "Any current errors",
+
+	// GetHeadersCmd help.
+	"getheaders--synopsis":     "Returns block headers starting with the first known block hash from the request",
+	"getheaders-blocklocators": "JSON array of hex-encoded hashes of blocks.  Headers are returned starting from the first known hash in this list",
+	"getheaders-hashstop":      "Block hash to stop including block headers for; if not found, all headers to the latest known block are returned.",
+	"getheaders--result0":      "Serialized block headers of all located blocks, limited to some arbitrary maximum number of hashes (currently 2000, which matches the wire protocol headers message, but this is not guaranteed)",
+
+	// GetInfoCmd help.
+	"getinfo--synopsis": "Returns a JSON object containing various state info.",
+
+	// GetMempoolInfoCmd help.
+	"getmempoolinfo--synopsis": "Returns memory pool information",
+
+	// GetMempoolInfoResult help.
+	"getmempoolinforesult-bytes":         "Size in bytes of the mempool",
+	"getmempoolinforesult-size":          "Number of transactions in the mempool",
+	"getmempoolinforesult-maxmempool":    "Maximum total size in bytes of the mempool, 0 when it is not limited",
+	"getmempoolinforesult-mempoolminfee": "Minimum fee in BCH/kB new transactions must pay, raised above the minimum relay fee while transactions are evicted to keep the mempool within its maximum size",
+
+	// GetTxBroadcastStatusCmd help.
+	"gettxbroadcaststatus--synopsis": "Returns how far a transaction submitted to this node with sendrawtransaction propagated through the network.\n" +
+		"Submitted transactions are announced to a few random outbound peers and every announcement of them by any other peer is counted as evidence of their propagation.",
+	"gettxbroadcaststatus-txid": "The hash of the transaction",
+
+	// GetTxBroadcastStatusResult help.
+	"gettxbroadcaststatusresult-txid":        "The hash of the transaction",
+	"gettxbroadcaststatusresult-time":        "The time the transaction was announced in seconds since 1 Jan 1970 GMT",
+	"gettxbroadcaststatusresult-announcedto": "The number of outbound peers the transaction was announced to",
+	"gettxbroadcaststatusresult-seenby":      "The number of other peers which announced the transaction to this node",
+	"gettxbroadcaststatusresult-firstseen":   "The time the transaction was first announced by another peer in seconds since 1 Jan 1970 GMT",
+	"gettxbroadcaststatusresult-replacedby":  "The hash of the conflicting transaction included in a block which replaced the transaction, such as a malleated variant of it",
+
+	// GetDSProofCmd help.
+	"getdsproof--synopsis": "Returns the proof of a double spend of an output spent by a transaction in the memory pool.\n" +
+		"Proofs are created for pay-to-pubkey-hash outputs when a transaction double spending a memory pool transaction is rejected.",
+	"getdsproof-id":          "The hash of the proof, the hash of a memory pool transaction double spending any of its inputs or an outpoint in the form 'txid:vout'",
+	"getdsproof-verbose":     "Specifies the proof is returned as a JSON object instead of hex-encoded string",
+	"getdsproof--condition0": "verbose=false",
+	"getdsproof--condition1": "verbose=true",
+	"getdsproof--result0":    "Hex-encoded bytes of the serialized proof",
+
+	// GetDSProofResult help.
+	"getdsproofresult-dspid":    "The hash of the proof",
+	"getdsproofresult-txid":     "The hash of the memory pool transaction spending the output",
+	"getdsproofresult-outpoint": "The output spent twice",
+	"getdsproofresult-hex":      "Hex-encoded bytes of the serialized proof",
+	"getdsproofresult-spenders": "The two transactions spending the output, ordered by the hash of their outputs",
+
+	// DSProofSpenderResult help.
+	"dsproofspenderresult-version":         "The version of the transaction",
+	"dsproofspenderresult-sequence":        "The sequence number of the input spending the output",
+	"dsproofspenderresult-locktime":        "The locktime of the transaction",
+	"dsproofspenderresult-hashprevoutputs": "The hash of the outputs spent by the transaction as signed by the input",
+	"dsproofspenderresult-hashsequence":    "The hash of the sequence numbers of the inputs as signed by the input",
+	"dsproofspenderresult-hashoutputs":     "The hash of the outputs of the transaction as signed by the input",
+	"dsproofspenderresult-pushdata":        "The hex-encoded data pushed by the input, which is its signature",
+
+	// GetForkMonitorInfoCmd help.
+	"getforkmonitorinfo--synopsis": "Returns the state of the chains of the nodes watched by the fork monitor relative to ours.\n" +
+		"The fork monitor is only enabled when nodes are watched with the --forkmonitornode option.",
+
+	// ForkMonitorNodeResult help.
+	"forkmonitornoderesult-node":          "The URL of the watched node without credentials",
+	"forkmonitornoderesult-status":        "The state of the chain of the node relative to ours (unknown, same, behind, ahead or forked)",
+	"forkmonitornoderesult-bestblockhash": "The hash of the best block of the node",
+	"forkmonitornoderesult-height":        "The height of the best block of the node",
+	"forkmonitornoderesult-forkheight":    "The height of the last block both chains have in common, or -1 if it is deeper than the alert depth",
+	"forkmonitornoderesult-ourdepth":      "The number of blocks on our chain after the last common block, or -1 if unknown",
+	"forkmonitornoderesult-theirdepth":    "The number of blocks on the chain of the node after the last common block",
+	"forkmonitornoderesult-alert":         "Whether the chains forked by at least the alert depth",
+	"forkmonitornoderesult-lastupdate":    "The time of the last comparison in seconds since 1 Jan 1970 GMT",
+	"forkmonitornoderesult-error":         "The error of the last comparison, if any",
+
+	// GetBlockValidationStatsCmd help.
+	"getblockvalidationstats--synopsis": "Returns the time spent in each phase of processing the most recently accepted blocks, most recent first.\n" +
+		"The timings are kept for the last 100 blocks and are in milliseconds.",
+	"getblockvalidationstats-count": "The number of blocks to return the timings for",
+
+	// BlockValidationStatsResult help.
+	"blockvalidationstatsresult-hash":        "The hash of the block",
+	"blockvalidationstatsresult-height":      "The height of the block",
+	"blockvalidationstatsresult-time":        "The time processing the block started in seconds since 1 Jan 1970 GMT",
+	"blockvalidationstatsresult-mainchain":   "Whether the block was connected to the main chain",
+	"blockvalidationstatsresult-deserialize": "Time spent decoding and hashing the transactions",
+	"blockvalidationstatsresult-sanity":      "Time spent on the checks which don't depend on the chain",
+	"blockvalidationstatsresult-context":     "Time spent on the checks which depend on the position of the block in the chain",
+	"blockvalidationstatsresult-store":       "Time spent writing the block to the database",
+	"blockvalidationstatsresult-fetchinputs": "Time spent loading the outputs spent by the block",
+	"blockvalidationstatsresult-checkinputs": "Time spent checking the transaction inputs, excluding scripts",
+	"blockvalidationstatsresult-scripts":     "Time spent validating scripts",
+	"blockvalidationstatsresult-indexes":     "Time spent updating the chain state, utxo cache and indexes",
+	"blockvalidationstatsresult-notify":      "Time spent notifying subscribers of the connected block",
+	"blockvalidationstatsresult-flush":       "Time spent flushing the utxo cache to the database",
+	"blockvalidationstatsresult-total":       "Total time spent processing the block",
+
+	// GetMempoolSnapshotCmd help.
+	"getmempoolsnapshot--synopsis": "Returns the transactions of the mempool along with their metadata in the snapshot format used to seed the mempool of other nodes.\n" +
+		"The transactions are ordered so they can be imported in order and the fee deltas set with prioritisetransaction are included.",
+	"getmempoolsnapshot--result0": "Hex-encoded bytes of the serialized mempool snapshot",
+
+	// GetMempoolStatsCmd help.
+	"getmempoolstats--synopsis": "Returns the recorded history of the mempool size, mempool fee rate percentiles and block fullness.\n" +
+		"The statistics are only available when they are recorded with the --statsinterval option.",
+	"getmempoolstats-history": "How far back to return samples as a duration such as 30m, 6h or 24h",
+
+	// GetMempoolStatsResult help.
+	"getmempoolstatsresult-interval":           "The interval between samples in seconds",
+	"getmempoolstatsresult-feeratepercentiles": "The percentiles of the fee rates in each sample, weighted by transaction size",
+	"getmempoolstatsresult-samples":            "The samples ordered from oldest to newest",
+
+	// MempoolStatsSample help.
+	"mempoolstatssample-time":          "The time the sample was taken in seconds since 1 Jan 1970 GMT",
+	"mempoolstatssample-size":          "Number of transactions in the mempool",
+	"mempoolstatssample-bytes":         "Size in bytes of the mempool",
+	"mempoolstatssample-totalfee":      "Total fees of the transactions in the mempool in BCH",
+	"mempoolstatssample-feerates":      "Fee rates in BCH/kB at each of the fee rate percentiles",
+	"mempoolstatssample-blockheight":   "Height of the best block",
+	"mempoolstatssample-blocksize":     "Size of the best block in bytes",
+	"mempoolstatssample-blockfullness": "Size of the best block relative to the maximum block size",
+
+	// GetOrphanPoolCmd help.
+	"getorphanpool--synopsis": "Returns the transactions in the orphan pool, which are kept until the transactions they spend are received, sorted by expiration.",
+
+	// GetOrphanPoolResult help.
+	"getorphanpoolresult-txid":           "The hash of the orphan transaction",
+	"getorphanpoolresult-size":           "The size of the orphan transaction in bytes",
+	"getorphanpoolresult-tag":            "The tag the orphan was added with, which is the ID of the peer which relayed it or 0 when it was submitted locally",
+	"getorphanpoolresult-expiration":     "The time the orphan is evicted at unless the transactions it spends are received first in seconds since 1 Jan 1970 GMT",
+	"getorphanpoolresult-missingparents": "The hashes of the transactions spent by the orphan which are neither in the main chain nor in the mempool",
+
+	// GetOrphanPoolInfoCmd help.
+	"getorphanpoolinfo--synopsis": "Returns statistics about the orphan pool.",
+
+	// GetOrphanPoolInfoResult help.
+	"getorphanpoolinforesult-size":           "Number of transactions in the orphan pool",
+	"getorphanpoolinforesult-bytes":          "Size in bytes of the transactions in the orphan pool",
+	"getorphanpoolinforesult-maxorphans":     "Maximum number of transactions kept in the orphan pool",
+	"getorphanpoolinforesult-maxorphansize":  "Maximum size in bytes of an orphan transaction",
+	"getorphanpoolinforesult-tags":           "Number of distinct tags, usually peers, the orphans were added with",
+	"getorphanpoolinforesult-missingparents": "Number of distinct transactions spent by the orphans which are neither in the main chain nor in the mempool",
+	"getorphanpoolinforesult-nextexpiration": "The time the next orphan expires at in seconds since 1 Jan 1970 GMT, omitted when the orphan pool is empty",
+
+	// GetRejectedTxsCmd help.
+	"getrejectedtxs--synopsis": "Returns the transactions received from peers which were recently rejected, along with the reasons they were rejected for, sorted by the time they were rejected.\n" +
+		"Rejected transactions are not requested again for the window set with --rejectedtxwindow, or until the next block by default.",
+	"getrejectedtxs-peerid": "Only return the transactions received from the peer with this ID",
+
+	// GetRejectedTxResult help.
+	"getrejectedtxresult-txid":   "The hash of the rejected transaction",
+	"getrejectedtxresult-peerid": "The ID of the peer the transaction was received from",
+	"getrejectedtxresult-addr":   "The address of the peer the transaction was received from",
+	"getrejectedtxresult-code":   "The reject code sent to the peer",
+	"getrejectedtxresult-reason": "The reason the transaction was rejected for",
+	"getrejectedtxresult-time":   "The time the transaction was rejected in seconds since 1 Jan 1970 GMT",
+
+	// GetMempoolTxGraphCmd help.
+	"getmempooltxgraph--synopsis": "Returns the unconfirmed transactions in the mempool a transaction depends on and those which depend on it.\n" +
+		"Wallets can use it to tell whether a payment depends on unconfirmed transactions.",
+	"getmempooltxgraph-txid": "The hash of the transaction in the mempool",
+
+	// GetMempoolTxGraphResult help.
+	"getmempooltxgraphresult-transaction":     "The transaction",
+	"getmempooltxgraphresult-ancestorcount":   "Number of unconfirmed transactions the transaction depends on, directly or indirectly",
+	"getmempooltxgraphresult-ancestorsize":    "Total size in bytes of the ancestors",
+	"getmempooltxgraphresult-ancestorfees":    "Total fees of the ancestors in BCH",
+	"getmempooltxgraphresult-descendantcount": "Number of unconfirmed transactions which depend on the transaction, directly or indirectly",
+	"getmempooltxgraphresult-descendantsize":  "Total size in bytes of the descendants",
+	"getmempooltxgraphresult-descendantfees":  "Total fees of the descendants in BCH",
+	"getmempooltxgraphresult-ancestors":       "The ancestors ordered by increasing distance from the transaction",
+	"getmempooltxgraphresult-descendants":     "The descendants ordered by increasing distance from the transaction",
+	"getmempooltxgraphresult-truncated":       "Whether only the closest ancestors or descendants are returned because there are too many of them",
+
+	// MempoolTxGraphEntry help.
+	"mempooltxgraphentry-txid":     "The hash of the transaction",
+	"mempooltxgraphentry-size":     "Transaction size in bytes",
+	"mempooltxgraphentry-fee":      "Transaction fee in BCH",
+	"mempooltxgraphentry-feeperkb": "Transaction fee rate in BCH/kB",
+	"mempooltxgraphentry-time":     "Local time transaction entered pool in seconds since 1 Jan 1970 GMT",
+	"mempooltxgraphentry-height":   "Block height when transaction entered the pool",
+	"mempooltxgraphentry-depends":  "Unconfirmed transactions spent by the transaction",
+	"mempooltxgraphentry-spentby":  "Unconfirmed transactions spending outputs of the transaction",
+
+	// GetReorgInfoCmd help.
+	"getreorginfo--synopsis": "Returns the most recent reorganizations of the main chain, most recent first.\n" +
+		"The last 1000 reorganizations are kept across restarts.",
+	"getreorginfo-count": "The maximum number of reorganizations to return",
+	"getreorginfo-txid":  "Only return the reorganizations which disconnected this transaction",
+
+	// ReorgInfoResult help.
+	"reorginforesult-time":       "The time of the reorganization in seconds since 1 Jan 1970 GMT",
+	"reorginforesult-oldtip":     "The hash of the main chain tip before the reorganization",
+	"reorginforesult-oldheight":  "The height of the main chain tip before the reorganization",
+	"reorginforesult-newtip":     "The hash of the main chain tip after the reorganization",
+	"reorginforesult-newheight":  "The height of the main chain tip after the reorganization",
+	"reorginforesult-forkheight": "The height of the last block the old and new main chains have in common",
+	"reorginforesult-depth":      "The number of disconnected blocks",
+	"reorginforesult-txids":      "The transactions of the disconnected blocks not included in the new main chain, which were returned to the mempool when still valid",
+
+	// GetScriptFlagsCmd help.
+	"getscriptflags--synopsis": "Returns the script flags the block of the main chain at the passed height was validated with when it was connected.\n" +
+		"The flags are only recorded for the blocks validated since the node records them, not for those connected without validation.",
+	"getscriptflags-height": "The height of the block",
+
+	// GetScriptFlagsResult help.
+	"getscriptflagsresult-hash":       "The hash of the block",
+	"getscriptflagsresult-height":     "The height of the block",
+	"getscriptflagsresult-flags":      "The names of the script flags active for the block, including the configured overrides",
+	"getscriptflagsresult-scriptsrun": "Whether the scripts of the block were executed, they are skipped for the blocks before the latest checkpoint",
+
+	// GetUtxoStatsCmd help.
+	"getutxostats--synopsis": "Returns the distribution of the ages and values of part of the unspent transaction outputs, including the number of dust outputs.\n" +
+		"The outputs are scanned in chunks: pass the returned cursor to scan the next chunk and add up the results of each chunk.\n" +
+		"The utxo set is read as it was last flushed to the database, so the most recent blocks may not be included.",
+	"getutxostats-cursor": "The cursor returned by the previous call to continue the scan, or omitted to start a new scan",
+	"getutxostats-count":  "The maximum number of outputs to scan",
+
+	// GetUtxoStatsResult help.
+	"getutxostatsresult-height":       "The height the ages of the outputs are relative to",
+	"getutxostatsresult-count":        "Number of scanned outputs",
+	"getutxostatsresult-amount":       "Total value of the scanned outputs in BCH",
+	"getutxostatsresult-dustcount":    "Number of dust outputs, which cost more to spend than the minimum relay fee allows for their value",
+	"getutxostatsresult-dustamount":   "Total value of the dust outputs in BCH",
+	"getutxostatsresult-agebuckets":   "The outputs by age in blocks",
+	"getutxostatsresult-valuebuckets": "The outputs by value in BCH",
+	"getutxostatsresult-cursor":       "The cursor to pass to continue the scan, omitted once all outputs are scanned",
+
+	// UtxoStatsBucket help.
+	"utxostatsbucket-below":     "The exclusive upper bound of the bucket, in blocks for ages and in BCH for values, omitted for the last bucket",
+	"utxostatsbucket-count":     "Number of outputs in the bucket",
+	"utxostatsbucket-amount":    "Total value of the outputs in the bucket in BCH",
+	"utxostatsbucket-dustcount": "Number of dust outputs in the bucket",
+
+	// GetMiningInfoResult help.
+	"getmininginforesult-blocks":           "Height of the latest best block",
+	"getmininginforesult-currentblocksize": "Size of the latest best block",
+	"getmininginforesult-currentblocktx":   "Number of transactions in the latest best block",
+	"getmininginforesult-difficulty":       "Current target difficulty",
+	"getmininginforesult-errors":           "Any current errors",
+	"getmininginforesult-generate":         "Whether or not server is set to generate coins",
+	"getmininginforesult-genproclimit":     "Number of processors to use for coin generation (-1 when disabled)",
+	"getmininginforesult-hashespersec":     "Recent hashes per second performance measurement while generating coins",
+	"getmininginforesult-networkhashps":    "Estimated network hashes per second for the most recent blocks",
+	"getmininginforesult-pooledtx":         "Number of transactions in the memory pool",
+	"getmininginforesult-testnet":          "Whether or not server is using testnet",
+
+	// GetMiningInfoCmd help.
+	"getmininginfo--synopsis": "Returns a JSON object containing mining-related information.",
+
+	// GetNetworkHashPSCmd help.
+	"getnetworkhashps--synopsis": "Returns the estimated network hashes per second for the block heights provided by the parameters.",
+	"getnetworkhashps-blocks":    "The number of blocks, or -1 for blocks since last difficulty change",
+	"getnetworkhashps-height":    "Perform estimate ending with this height or -1 for current best chain block height",
+	"getnetworkhashps--result0":  "Estimated hashes per second",
+
+	// GetNetworkInfo help.
+	"getnetworkinfo--synopsis":       "Returns an object containing various state info regarding P2P networking.",
+	"getnetworkinfo--result0--desc":  "GetNetworkInfo object",
+	"getnetworkinfo--result0--key":   "Field name",
+	"getnetworkinfo--result0--value": "Object containing the network info",
+
+	// GetNetworkInfoResult help.
+	"getnetworkinforesult-version":            "The server version",
+	"getnetworkinforesult-subversion":         "The server subversion string",
+	"getnetworkinforesult-protocolversion":    "The protocol version",
+	"getnetworkinforesult-localservices":      "The services we offer to the network",
+	"getnetworkinforesult-localservicesnames": "The names of the individual services we offer to the network, which only include the services of the enabled subsystems",
+	"getnetworkinforesult-localrelay":         "True if transaction relay is requested from peers",
+	"getnetworkinforesult-timeoffset":         "The time offset",
+	"getnetworkinforesult-connections":        "The number of connections",
+	"getnetworkinforesult-networkactive":      "Whether p2p networking is enabled",
+	"getnetworkinforesult-networks":           "Information per network",
+	"getnetworkinforesult-relayfee":           "Minimum relay fee for transactions in BTC/kB",
+	"getnetworkinforesult-incrementalfee":     "Minimum fee increment for mempool limiting or BIP 125 replacement in BTC/kB",
+	"getnetworkinforesult-localaddresses":     "List of local addresses",
+	"getnetworkinforesult-warnings":           "Any network and blockchain warnings",
+
+	// GetNetTotalsCmd help.
+	"getnettotals--synopsis": "Returns a JSON object containing network traffic statistics.",
+
+	// GetNetTotalsResult help.
+	"getnettotalsresult-totalbytesrecv": "Total bytes received",
+	"getnettotalsresult-totalbytessent": "Total bytes sent",
+	"getnettotalsresult-timemillis":     "Number of milliseconds since 1 Jan 1970 GMT",
+
+	// GetPeerInfoResult help.
+	"getpeerinforesult-id":             "A unique node ID",
+	"getpeerinforesult-addr":           "The ip address and port of the peer",
+	"getpeerinforesult-addrlocal":      "Local address",
+	"getpeerinforesult-services":       "Services bitmask which represents the services supported by the peer",
+	"getpeerinforesult-servicesStr":    "Services string which represents the services supported by the peer",
+	"getpeerinforesult-relaytxes":      "Peer has requested transactions be relayed to it",
+	"getpeerinforesult-lastsend":       "Time the last message was received in seconds since 1 Jan 1970 GMT",
+	"getpeerinforesult-lastrecv":       "Time the last message was sent in seconds since 1 Jan 1970 GMT",
+	"getpeerinforesult-bytessent":      "Total bytes sent",
+	"getpeerinforesult-bytesrecv":      "Total bytes received",
+	"getpeerinforesult-conntime":       "Time the connection was made in seconds since 1 Jan 1970 GMT",
+	"getpeerinforesult-timeoffset":     "The time offset of the peer",
+	"getpeerinforesult-pingtime":       "Number of microseconds the last ping took",
+	"getpeerinforesult-pingwait":       "Number of microseconds a queued ping has been waiting for a response",
+	"getpeerinforesult-version":        "The protocol version of the peer",
+	"getpeerinforesult-subver":         "The user agent of the peer",
+	"getpeerinforesult-inbound":        "Whether or not the peer is an inbound connection",
+	"getpeerinforesult-startingheight": "The latest block height the peer knew about when the connection was established",
+	"getpeerinforesult-currentheight":  "The current height of the peer",
+	"getpeerinforesult-banscore":       "The ban score",
+	"getpeerinforesult-whitelisted":    "Peer IP is whitelisted",
+	"getpeerinforesult-permissions":    "The permissions granted to the peer by the whitelist (noban, relay, forcerelay, mempool or download)",
+	"getpeerinforesult-feefilter":      "The requested minimum fee a transaction must have to be announced to the peer",
+	"getpeerinforesult-syncnode":       "Whether or not the peer is the sync peer",
+	"getpeerinforesult-connectiontype": "The kind of connection (inbound, manual, full-relay, block-relay-only or feeler)",
+
+	// GetPeerInfoCmd help.
+	"getpeerinfo--synopsis": "Returns data about each connected network peer as an array of json objects.",
+
+	// GetRawMempoolVerboseResult help.
+	"getrawmempoolverboseresult-size":             "Transaction size in bytes",
+	"getrawmempoolverboseresult-fee":              "Transaction fee in bitcoins",
+	"getrawmempoolverboseresult-time":             "Local time transaction entered pool in seconds since 1 Jan 1970 GMT",
+	"getrawmempoolverboseresult-height":           "Block height when transaction entered the pool",
+	"getrawmempoolverboseresult-startingpriority": "Priority when transaction entered the pool",
+	"getrawmempoolverboseresult-currentpriority":  "Current priority",
+	"getrawmempoolverboseresult-depends":          "Unconfirmed transactions used as inputs for this transaction",
+	"getrawmempoolverboseresult-vsize":            "The virtual size of a transaction",
+
+	// GetRawMempoolCmd help.
+	"getrawmempool--synopsis":   "Returns information about all of the transactions currently in the memory pool.",
+	"getrawmempool-verbose":     "Returns JSON object when true or an array of transaction hashes when false",
+	"getrawmempool--condition0": "verbose=false",
+	"getrawmempool--condition1": "verbose=true",
+	"getrawmempool--result0":    "Array of transaction hashes",
+
+	// GetRawTransactionCmd help.
+	"getrawtransaction--synopsis":   "Returns information about a transaction given its hash.",
+	"getrawtransaction-txid":        "The hash of the transaction",
+	"getrawtransaction-verbose":     "Specifies the transaction is returned as a JSON object instead of a hex-encoded string when 1, which also includes the outputs spent by the inputs and the fee when they are available when 2",
+	"getrawtransaction--condition0": "verbose=false",
+	"getrawtransaction--condition1": "verbose=true",
+	"getrawtransaction--result0":    "Hex-encoded bytes of the serialized transaction",
+
+	// GetTxOutResult help.
+	"gettxoutresult-bestblock":     "The block hash that contains the transaction output",
+	"gettxoutresult-confirmations": "The number of confirmations",
+	"gettxoutresult-value":         "The transaction amount in BTC",
+	"gettxoutresult-scriptPubKey":  "The public key script used to pay coins as a JSON object",
+	"gettxoutresult-version":       "The transaction version",
+	"gettxoutresult-coinbase":      "Whether or not the transaction is a coinbase",
+
+	// GetTxOutCmd help.
+	"gettxout--synopsis": "Returns information about an unspent transaction output.\n" +
+		"When a height is passed, the output is returned as it was once the block at that height of the main chain was connected if it was unspent then.",
+	"gettxout-txid":           "The hash of the transaction",
+	"gettxout-vout":           "The index of the output",
+	"gettxout-includemempool": "Include the mempool when true, ignored when a height is passed",
+	"gettxout-height":         "The height of the main chain to look up the output at, at most 288 blocks below the tip, instead of the current state",
+
+	// GetTxOutProofCmd help.
+	"gettxoutproof--synopsis": "Returns hex encoded merkle proof for a given transaction set",
+	"gettxoutproof-txids":     "A list of transaction hashes to generate proof for",
+	"gettxoutproof-blockhash": "The block hash the transactions are in",
+	"gettxoutproof--result0":  "Hex encoded merkle proof",
+
+	// VerifyTxOutProofCmd help.
+	"verifytxoutproof--synopsis": "Verifies that a proof points to a transaction in a block, returning the transaction it commits to and throwing an RPC error if the block is not in our best chain",
+	"verifytxoutproof-proof":     "The hex-encoded proof generated by gettxoutproof",
+	"verifytxoutproof--result0":  "Serialized list of txid(s) which the proof commits to, or empty array if the proof is invalid",
+
+	// HelpCmd help.
+	"help--synopsis":   "Returns a list of all commands or help for a specified command.",
+	"help-command":     "The command to retrieve help for",
+	"help--condition0": "no command provided",
+	"help--condition1": "command specified",
+	"help--result0":    "List of commands",
+	"help--result1":    "Help for specified command",
+
+	// PingCmd help.
+	"ping--synopsis": "Queues a ping to be sent to each connected peer.\n" +
+		"Ping times are provided by getpeerinfo via the pingtime and pingwait fields.",
+
+	// PrioritiseTransactionCmd help.
+	"prioritisetransaction--synopsis": "Adds a fee delta to a transaction so it is selected for new block templates as if it paid that much more fee, or less when the delta is negative.\n" +
+		"The fee the transaction pays, and the fees collected by the coinbase, are unchanged.\n" +
+		"Deltas of the same transaction add up, and are kept until it is mined even when it is not in the memory pool yet.",
+	"prioritisetransaction-txid":          "The hash of the transaction",
+	"prioritisetransaction-prioritydelta": "Unsupported, must be 0",
+	"prioritisetransaction-feedelta":      "The fee delta in satoshis",
+	"prioritisetransaction--result0":      "Always true",
+
+	// SearchRawTransactionsCmd help.
+	"searchrawtransactions--synopsis": "Returns raw data for transactions involving the passed address.\n" +
+		"Returned transactions are pulled from both the database, and transactions currently in the mempool.\n" +
+		"Transactions pulled from the mempool will have the 'confirmations' field set to 0.\n" +
+		"Usage of this RPC requires the optional --addrindex flag to be activated, otherwise all responses will simply return with an error stating the address index has not yet been built.\n" +
+		"Similarly, until the address index has caught up with the current best height, all requests will return an error response in order to avoid serving stale data.",
+	"searchrawtransactions-address":     "The Bitcoin address to search for",
+	"searchrawtransactions-verbose":     "Specifies the transaction is returned as a JSON object instead of hex-encoded string",
+	"searchrawtransactions--condition0": "verbose=0",
+	"searchrawtransactions--condition1": "verbose=1",
+	"searchrawtransactions-skip":        "The number of leading transactions to leave out of the final response",
+	"searchrawtransactions-count":       "The maximum number of transactions to return",
+	"searchrawtransactions-vinextra":    "Specify that extra data from previous output will be returned in vin",
+	"searchrawtransactions-reverse":     "Specifies that the transactions should be returned in reverse chronological order",
+	"searchrawtransactions-filteraddrs": "Address list.  Only inputs or outputs with matching address will be returned",
+	"searchrawtransactions--result0":    "Hex-encoded serialized transaction",
+
+	// SendRawTransactionCmd help.
+	"sendrawtransaction--synopsis":     "Submits the serialized, hex-encoded transaction to the local peer and relays it to the network.",
+	"sendrawtransaction-hextx":         "Serialized, hex-encoded signed transaction",
+	"sendrawtransaction-allowhighfees": "Whether or not to allow insanely high fees (bchd does not yet implement this parameter, so it has no effect)",
+	"sendrawtransaction--result0":      "The hash of the transaction",
+
+	// ReconsiderBlockCmd
+	"reconsiderblock--synopsis": "Reconsider a block for validation.",
+	"reconsiderblock-blockhash": "Hash of the block you want to reconsider",
+
+	// RegenerateCFiltersCmd help.
+	"regeneratecfilters--synopsis": "Rebuilds the committed filters of a range of main chain blocks to repair corrupted filters.\n" +
+		"Since each filter header commits to the previous one, the filters past the range are rebuilt as well until the stored header of a block matches its rebuilt header.",
+	"regeneratecfilters-startheight": "The height of the first block whose filter is rebuilt",
+	"regeneratecfilters-endheight":   "The height of the last block whose filter is rebuilt",
+	"regeneratecfilters-filtertype":  "The type of filter to rebuild (0=regular, 1=extended)",
+
+	// RegenerateCFiltersResult help.
+	"regeneratecfiltersresult-startheight": "The height of the first block whose filter was rebuilt",
+	"regeneratecfiltersresult-endheight":   "The height of the last block whose filter was rebuilt",
+
+	// InvalidateBlockCmd
+	"invalidateblock--synopsis": "Invalidate a block.",
+	"invalidateblock-blockhash": "Hash of the block you want to invalidate",
+
+	// SetGenerateCmd help.
+	"setgenerate--synopsis":    "Set the server to generate coins (mine) or not.",
+	"setgenerate-generate":     "Use true to enable generation, false to disable it",
+	"setgenerate-genproclimit": "The number of processors (cores) to limit generation to or -1 for default",
+
+	// SetMockTimeCmd help.
+	"setmocktime--synopsis": "Set the adjusted time of the server to the passed time (regtest and simnet only).",
+	"setmocktime-timestamp": "The unix time to use as the adjusted time or 0 to restore the real time",
+
+	// SetDBCacheSizeCmd help.
+	"setdbcachesize--synopsis": "Set the size of the database cache at which it is flushed, overriding the dbcachesize option until the node restarts.",
+	"setdbcachesize-size":      "The size of the cache in MiB",
+
+	// SetUpgradeActivationCmd help.
+	"setupgradeactivation--synopsis":  "Override the activation of a network upgrade at runtime (regtest and simnet only).",
+	"setupgradeactivation-upgrade":    "The upgrade to override (upgrade9 or upgrade11)",
+	"setupgradeactivation-activation": "The block height upgrade9 activates after or the median time past upgrade11 activates at",
+
+	// StopCmd help.
+	"stop--synopsis": "Shutdown bchd.",
+	"stop--result0":  "The string 'bchd stopping.'",
+
+	// SubmitBlockOptions help.
+	"submitblockoptions-workid": "This parameter is currently ignored",
+
+	// SubmitBlockCmd help.
+	"submitblock--synopsis":   "Attempts to submit a new serialized, hex-encoded block to the network.",
+	"submitblock-hexblock":    "Serialized, hex-encoded block",
+	"submitblock-options":     "This parameter is currently ignored",
+	"submitblock--condition0": "Block successfully submitted",
+	"submitblock--condition1": "Block rejected",
+	"submitblock--result1":    "The reason the block was rejected",
+
+	// SubmitHeaderCmd help.
+	"submitheader--synopsis": "Validates a serialized, hex-encoded block header and relays it to the peers which prefer header announcements before the block itself is available.\n" +
+		"The header is only relayed when it extends the best chain and the previous block must be known.\n" +
+		"The block still needs to be submitted with submitblock once it is available.",
+	"submitheader-hexdata": "Serialized, hex-encoded block header",
+
+	// SignDataSignatureResult help.
+	"signdatasignatureresult-signature":   "The hex-encoded signature to push before the message and the public key for OP_CHECKDATASIG",
+	"signdatasignatureresult-pubkey":      "The hex-encoded public key of the private key, compressed when the private key is for compressed public keys",
+	"signdatasignatureresult-messagehash": "The hex-encoded SHA256 hash of the message which is signed",
+
+	// SignDataSignatureCmd help.
+	"signdatasignature--synopsis": "Signs a message with a private key for verification by OP_CHECKDATASIG and OP_CHECKDATASIGVERIFY.\n" +
+		"The signature is of the single SHA256 hash of the message and has no hash type byte.",
+	"signdatasignature-privkey": "The private key to sign with, in wallet import format",
+	"signdatasignature-message": "The hex-encoded message to sign",
+	"signdatasignature-sigtype": "The type of signature to create (schnorr or ecdsa)",
+
+	// ValidateAddressResult help.
+	"validateaddresschainresult-isvalid": "Whether or not the address is valid",
+	"validateaddresschainresult-address": "The bitcoin address (only when isvalid is true)",
+
+	// ValidateAddressCmd help.
+	"validateaddress--synopsis": "Verify an address is valid.",
+	"validateaddress-address":   "Bitcoin address to validate",
+
+	// ScriptUpgradeResult help.
+	"scriptupgraderesult-name":             "The name of the upgrade",
+	"scriptupgraderesult-activationheight": "The first block height at which the upgrade is active (upgrades activated by height only)",
+	"scriptupgraderesult-activationtime":   "The median time past from which the upgrade is active (upgrades activated by time only)",
+	"scriptupgraderesult-active":           "Whether the upgrade is active for the next block",
+	"scriptupgraderesult-features":         "The opcodes and script templates used by the scripts which the upgrade enabled",
+
+	// ValidateScriptResult help.
+	"validatescriptresult-standard":         "Whether outputs paying to the locking script, and inputs redeeming them with the redeem script, would be standard for the next block",
+	"validatescriptresult-errors":           "The reasons the scripts would not be standard",
+	"validatescriptresult-type":             "The template matched by the locking script (nonstandard when none)",
+	"validatescriptresult-redeemtype":       "The template matched by the redeem script (nonstandard when none)",
+	"validatescriptresult-sigchecks":        "The largest number of signature checks a spend may perform",
+	"validatescriptresult-opcost":           "The estimated least operation cost of a spend, excluding the signature script and the stack dependent costs of opcodes",
+	"validatescriptresult-activationheight": "The first block height at which all the height activated upgrades the scripts need are active",
+	"validatescriptresult-activationtime":   "The median time past from which all the time activated upgrades the scripts need are active",
+	"validatescriptresult-upgrades":         "The upgrades which enabled features used by the scripts",
+
+	// ValidateScriptCmd help.
+	"validatescript--synopsis": "Reports whether a locking script, and optionally the redeem script of a pay-to-script-hash locking script, would be standard under the current policy.\n" +
+		"Also reports the templates the scripts match, the estimated cost of spending them and the upgrades enabling the features they use.",
+	"validatescript-lockingscript": "Hex-encoded locking script",
+	"validatescript-redeemscript":  "Hex-encoded redeem script when the locking script is pay-to-script-hash",
+
+	// VerifyChainCmd help.
+	"verifychain--synopsis": "Verifies the block chain database.\n" +
+		"The actual checks performed by the checklevel parameter are implementation specific.\n" +
+		"For bchd each level includes the checks of the lower levels:\n" +
+		"checklevel=0 - Look up each block and ensure it can be loaded from the database.\n" +
+		"checklevel=1 - Perform basic context-free sanity checks on each block.\n" +
+		"checklevel=2 - Ensure the undo data of each block can be loaded and matches the block.\n" +
+		"checklevel=3 - Apply the undo data of the blocks to a view of the utxo set, which ensures the outputs they create are unspent.\n" +
+		"checklevel=4 - Connect the blocks to the view of the utxo set again, validating all of their scripts.\n" +
+		"Levels 3 and 4 stop undoing blocks before the view outgrows the utxo cache.\n" +
+		"A background verification returns right away and is throttled to leave most of the resources to the node.\n" +
+		"Use getverifychaininfo to follow its progress and stopverifychain to cancel it.",
+	"verifychain-checklevel": "How thorough the block verification is (0-4)",
+	"verifychain-checkdepth": "The number of blocks to check, or 0 for all of them",
+	"verifychain-background": "Whether to verify the chain in the background instead of waiting for the result",
+	"verifychain--result0":   "Whether or not the chain verified, or whether the background verification was started",
+
+	// GetVerifyChainInfoCmd help.
+	"getverifychaininfo--synopsis": "Returns the progress or the outcome of the last chain verification started with verifychain.",
+
+	// GetVerifyChainInfoResult help.
+	"getverifychaininforesult-status":     "The state of the verification (none, running, succeeded, failed or cancelled)",
+	"getverifychaininforesult-checklevel": "How thorough the block verification is",
+	"getverifychaininforesult-checkdepth": "The number of blocks to check, or 0 for all of them",
+	"getverifychaininforesult-background": "Whether the verification runs in the background",
+	"getverifychaininforesult-height":     "The height of the block verified last",
+	"getverifychaininforesult-verified":   "The number of blocks verified so far, counting blocks twice at check level 4",
+	"getverifychaininforesult-total":      "The number of blocks to verify, counting blocks twice at check level 4",
+	"getverifychaininforesult-progress":   "The fraction of the blocks verified so far",
+	"getverifychaininforesult-starttime":  "The time the verification started in seconds since 1 Jan 1970 GMT",
+	"getverifychaininforesult-endtime":    "The time the verification finished in seconds since 1 Jan 1970 GMT",
+	"getverifychaininforesult-error":      "The reason the verification failed, if it did",
+
+	// StopVerifyChainCmd help.
+	"stopverifychain--synopsis": "Cancels the running chain verification.",
+	"stopverifychain--result0":  "Whether a verification was running",
+
+	// VerifyDataSignatureResult help.
+	"verifydatasignatureresult-valid":       "Whether OP_CHECKDATASIG would push true for the signature",
+	"verifydatasignatureresult-sigtype":     "The type of the signature (schnorr, ecdsa or none when it is empty)",
+	"verifydatasignatureresult-messagehash": "The hex-encoded SHA256 hash of the message which the signature is checked against",
+	"verifydatasignatureresult-error":       "Why the script would fail instead of OP_CHECKDATASIG pushing false, such as an invalid encoding of the signature or the public key",
+
+	// VerifyDataSignatureCmd help.
+	"verifydatasignature--synopsis": "Verifies a signature of a message with the rules OP_CHECKDATASIG applies to transactions accepted to the mempool.",
+	"verifydatasignature-signature": "The hex-encoded signature",
+	"verifydatasignature-message":   "The hex-encoded message",
+	"verifydatasignature-pubkey":    "The hex-encoded public key",
+
+	// VerifyMessageCmd help.
+	"verifymessage--synopsis": "Verify a signed message.",
+	"verifymessage-address":   "The bitcoin address to use for the signature",
+	"verifymessage-signature": "The base-64 encoded signature provided by the signer",
+	"verifymessage-message":   "The signed message",
+	"verifymessage--result0":  "Whether or not the signature verified",
+
+	// VerifyScriptsPrevOut help.
+	"verifyscriptsprevout-scriptpubkey": "The hex-encoded locking script of the spent output",
+	"verifyscriptsprevout-amount":       "The value of the spent output in BCH",
+	"verifyscriptsprevout-tokendata":    "The hex-encoded token prefix of the spent output starting with the 0xef prefix byte, if it holds tokens",
+
+	// VerifyScriptsCmd help.
+	"verifyscripts--synopsis": "Validates the scripts of all the inputs of a transaction against the outputs it spends without looking them up, reporting the outcome of each input along with the opcode which failed.\n" +
+		"The transaction doesn't have to be related to the chain of the server, which makes it suitable to check transactions signed offline.",
+	"verifyscripts-hextx":    "The hex-encoded transaction",
+	"verifyscripts-prevouts": "The outputs spent by the transaction in the order of its inputs",
+	"verifyscripts-flags":    "The script flags to validate with: consensus for the flags of the next block, standard for those and the policy flags of the mempool, or a comma separated list of flag names such as P2SH,STRICTENC,SCHNORR",
+
+	// VerifyScriptsInputResult help.
+	"verifyscriptsinputresult-index":        "The index of the input",
+	"verifyscriptsinputresult-valid":        "Whether the scripts of the input are valid",
+	"verifyscriptsinputresult-sigchecks":    "The number of signature checks performed by the input",
+	"verifyscriptsinputresult-error":        "Why the scripts of the input are invalid",
+	"verifyscriptsinputresult-errorcode":    "The script error code, such as ErrEvalFalse",
+	"verifyscriptsinputresult-failedopcode": "The opcode which failed, prefixed by the index of the script and the offset of the opcode in it, unless the scripts failed once they finished executing",
+
+	// VerifyScriptsResult help.
+	"verifyscriptsresult-txid":       "The hash of the transaction",
+	"verifyscriptsresult-valid":      "Whether the scripts of all the inputs and the tokens of the transaction are valid",
+	"verifyscriptsresult-flags":      "The names of the script flags the transaction was validated with",
+	"verifyscriptsresult-sigchecks":  "The total number of signature checks performed by the inputs",
+	"verifyscriptsresult-tokenerror": "Why the tokens of the transaction are invalid, when the token rules are enabled",
+	"verifyscriptsresult-error":      "Why the transaction is rejected when its scripts are validated as a whole the way the chain and the mempool do, such as when its inputs perform more signature checks than allowed for a transaction",
+	"verifyscriptsresult-inputs":     "The outcome of the validation of each input",
+
+	// -------- Websocket-specific help --------
+
+	// Session help.
+	"session--synopsis":       "Return details regarding a websocket client's current connection session.",
+	"sessionresult-sessionid": "The unique session ID for a client's websocket connection.",
+
+	// NotifyBlocksCmd help.
+	"notifyblocks--synopsis": "Request notifications for whenever a block is connected or disconnected from the main (best) chain.",
+
+	// StopNotifyBlocksCmd help.
+	"stopnotifyblocks--synopsis": "Cancel registered notifications for whenever a block is connected or disconnected from the main (best) chain.",
+
+	// NotifyNewTransactionsCmd help.
+	"notifynewtransactions--synopsis": "Send either a txaccepted or a txacceptedverbose notification when a new transaction is accepted into the mempool.",
+	"notifynewtransactions-verbose":   "Specifies which type of notification to receive. If verbose is true, then the caller receives txacceptedverbose, otherwise the caller receives txaccepted",
+
+	// StopNotifyNewTransactionsCmd help.
+	"stopnotifynewtransactions--synopsis": "Stop sending either a txaccepted or a txacceptedverbose notification when a new transaction is accepted into the mempool.",
+
+	// NotifyReceivedCmd help.
+	"notifyreceived--synopsis": "Send a recvtx notification when a transaction added to mempool or appears in a newly-attached block contains a txout pkScript sending to any of the passed addresses.\n" +
+		"Matching outpoints are automatically registered for redeemingtx notifications.",
+	"notifyreceived-addresses": "List of address to receive notifications about",
+
+	// StopNotifyReceivedCmd help.
+	"stopnotifyreceived--synopsis": "Cancel registered receive notifications for each passed address.",
+	"stopnotifyreceived-addresses": "List of address to cancel receive notifications for",
+
+	// OutPoint help.
+	"outpoint-hash":  "The hex-encoded bytes of the outpoint hash",
+	"outpoint-index": "The index of the outpoint",
+
+	// NotifySpentCmd help.
+	"notifyspent--synopsis": "Send a redeemingtx notification when a transaction spending an outpoint appears in mempool (if relayed to this bchd instance) and when such a transaction first appears in a newly-attached block.",
+	"notifyspent-outpoints": "List of transaction outpoints to monitor.",
+
+	// StopNotifySpentCmd help.
+	"stopnotifyspent--synopsis": "Cancel registered spending notifications for each passed outpoint.",
+	"stopnotifyspent-outpoints": "List of transaction outpoints to stop monitoring.",
+
+	// LoadTxFilterCmd help.
+	"loadtxfilter--synopsis": "Load, add to, or reload a websocket client's transaction filter for mempool transactions, new blocks and rescanblocks.",
+	"loadtxfilter-reload":    "Load a new filter instead of adding data to an existing one",
+	"loadtxfilter-addresses": "Array of addresses to add to the transaction filter",
+	"loadtxfilter-outpoints": "Array of outpoints to add to the transaction filter",
+
+	// Rescan help.
+	"rescan--synopsis": "Rescan block chain for transactions to addresses.\n" +
+		"When the endblock parameter is omitted, the rescan continues through the best block in the main chain.\n" +
+		"Rescan results are sent as recvtx and redeemingtx notifications.\n" +
+		"This call returns once the rescan completes.",
+	"rescan-beginblock": "Hash of the first block to begin rescanning",
+	"rescan-addresses":  "List of addresses to include in the rescan",
+	"rescan-outpoints":  "List of transaction outpoints to include in the rescan",
+	"rescan-endblock":   "Hash of final block to rescan",
+
+	// RescanBlocks help.
+	"rescanblocks--synopsis":   "Rescan blocks for transactions matching the loaded transaction filter.",
+	"rescanblocks-blockhashes": "List of hashes to rescan.  Each next block must be a child of the previous.",
+	"rescanblocks--result0":    "List of matching blocks.",
+
+	// RescannedBlock help.
+	"rescannedblock-hash":         "Hash of the matching block.",
+	"rescannedblock-transactions": "List of matching transactions, serialized and hex-encoded.",
+
+	// Uptime help.
+	"uptime--synopsis": "Returns the total uptime of the server.",
+	"uptime--result0":  "The number of seconds that the server has been running",
+
+	// Version help.
+	"version--synopsis":       "Returns the JSON-RPC API version (semver)",
+	"version--result0--desc":  "Version objects keyed by the program or API name",
+	"version--result0--key":   "Program or API name",
+	"version--result0--value": "Object containing the semantic version",
+
+	// VersionResult help.
+	"versionresult-versionstring": "The JSON-RPC API version (semver)",
+	"versionresult-major":         "The major component of the JSON-RPC API version",
+	"versionresult-minor":         "The minor component of the JSON-RPC API version",
+	"versionresult-patch":         "The patch component of the JSON-RPC API version",
+	"versionresult-prerelease":    "Prerelease info about the current build",
+	"versionresult-buildmetadata": "Metadata about the current build",
+}
+
+// rpcResultTypes specifies the result types that each RPC command can return.
+// This information is used to generate the help.  Each result type must be a
+// pointer to the type (or nil to indicate no return value).
+var rpcResultTypes = map[string][]interface{}{
+	"addnode":                 nil,
+	"auditblocktemplate":      {(*btcjson.AuditBlockTemplateResult)(nil)},
+	"compactslpindex":         {(*btcjson.CompactSlpIndexResult)(nil)},
+	"createrawtransaction":    {(*string)(nil)},
+	"debuglevel":              {(*string)(nil), (*string)(nil)},
+	"decoderawtransaction":    {(*btcjson.TxRawDecodeResult)(nil)},
+	"decodescript":            {(*btcjson.DecodeScriptResult)(nil)},
+	"estimatefee":             {(*float64)(nil)},
+	"forcereseed":             {(*btcjson.ForceReseedResult)(nil)},
+	"generate":                {(*[]string)(nil)},
+	"getaddednodeinfo":        {(*[]string)(nil), (*[]btcjson.GetAddedNodeInfoResult)(nil)},
+	"getbestblock":            {(*btcjson.GetBestBlockResult)(nil)},
+	"getbestblockhash":        {(*string)(nil)},
+	"getblock":                {(*string)(nil), (*btcjson.GetBlockVerboseResult)(nil)},
+	"getblockbyheight":        {(*string)(nil), (*btcjson.GetBlockVerboseResult)(nil)},
+	"getblockcount":           {(*int64)(nil)},
+	"getblockhash":            {(*string)(nil)},
+	"getblockheader":          {(*string)(nil), (*btcjson.GetBlockHeaderVerboseResult)(nil)},
+	"getblocktemplate":        {(*btcjson.GetBlockTemplateResult)(nil), (*string)(nil), nil},
+	"getblockchaininfo":       {(*btcjson.GetBlockChainInfoResult)(nil)},
+	"getblockvalidationstats": {(*[]btcjson.BlockValidationStatsResult)(nil)},
+	"getcfilter":              {(*string)(nil)},
+	"getcfilterheader":        {(*string)(nil)},
+	"getchaintips":            {(*[]btcjson.GetChainTipsResult)(nil)},
+	"getconnectioncount":      {(*int32)(nil)},
+	"getcpfpinfo":             {(*btcjson.GetCPFPInfoResult)(nil)},
+	"getcurrentnet":           {(*uint32)(nil)},
+	"getdbinfo":               {(*btcjson.GetDBInfoResult)(nil)},
+	"getdifficulty":           {(*float64)(nil)},
+	"getdsproof":              {(*string)(nil), (*btcjson.GetDSProofResult)(nil)},
+	"getforkmonitorinfo":      {(*[]btcjson.ForkMonitorNodeResult)(nil)},
+	"getgenerate":             {(*bool)(nil)},
+	"gethashespersec":         {(*float64)(nil)},
+	"getheaders":              {(*[]string)(nil)},
+	"getinfo":                 {(*btcjson.InfoChainResult)(nil)},
+	"getmempoolinfo":          {(*btcjson.GetMempoolInfoResult)(nil)},
+	"getmempoolsnapshot":      {(*string)(nil)},
+	"getmempoolstats":         {(*btcjson.GetMempoolStatsResult)(nil)},
+	"getmempooltxgraph":       {(*btcjson.GetMempoolTxGraphResult)(nil)},
+	"getmininginfo":           {(*btcjson.GetMiningInfoResult)(nil)},
+	"getnettotals":            {(*btcjson.GetNetTotalsResult)(nil)},
+	"getnetworkhashps":        {(*float64)(nil)},
+	"getnetworkinfo":          {(*map[string]btcjson.GetNetworkInfoResult)(nil)},
+	"getorphanpool":           {(*[]btcjson.GetOrphanPoolResult)(nil)},
+	"getorphanpoolinfo":       {(*btcjson.GetOrphanPoolInfoResult)(nil)},
+	"getpeerinfo":             {(*[]btcjson.GetPeerInfoResult)(nil)},
+	"getrawmempool":           {(*[]string)(nil), (*btcjson.GetRawMempoolVerboseResult)(nil)},
+	"getrawtransaction":       {(*string)(nil), (*btcjson.TxRawResult)(nil)},
+	"getrejectedtxs":          {(*[]btcjson.GetRejectedTxResult)(nil)},
+	"getreorginfo":            {(*[]btcjson.ReorgInfoResult)(nil)},
+	"getscriptflags":          {(*btcjson.GetScriptFlagsResult)(nil)},
+	"gettxbroadcaststatus":    {(*btcjson.GetTxBroadcastStatusResult)(nil)},
+	"gettxout":                {(*btcjson.GetTxOutResult)(nil)},
+	"gettxoutproof":           {(*string)(nil)},
+	"getutxostats":            {(*btcjson.GetUtxoStatsResult)(nil)},
+	"getverifychaininfo":      {(*btcjson.GetVerifyChainInfoResult)(nil)},
+	"node":                    nil,
+	"help":                    {(*string)(nil), (*string)(nil)},
+	"invalidateblock":         nil,
+	"ping":                    nil,
+	"prioritisetransaction":   {(*bool)(nil)},
+	"reconsiderblock":         nil,
+	"regeneratecfilters":      {(*btcjson.RegenerateCFiltersResult)(nil)},
+	"searchrawtransactions":   {(*string)(nil), (*[]btcjson.SearchRawTransactionsResult)(nil)},
+	"sendrawtransaction":      {(*string)(nil)},
+	"setdbcachesize":          nil,
+	"setgenerate":             nil,
+	"setmocktime":             nil,
+	"setupgradeactivation":    nil,
+	"signdatasignature":       {(*btcjson.SignDataSignatureResult)(nil)},
+	"stop":                    {(*string)(nil)},
+	"stopverifychain":         {(*bool)(nil)},
+	"submitblock":             {nil, (*string)(nil)},
+	"submitheader":            nil,
+	"uptime":                  {(*int64)(nil)},
+	"validateaddress":         {(*btcjson.ValidateAddressChainResult)(nil)},
+	"validatescript":          {(*btcjson.ValidateScriptResult)(nil)},
+	"verifychain":             {(*bool)(nil)},
+	"verifydatasignature":     {(*btcjson.VerifyDataSignatureResult)(nil)},
+	"verifymessage":           {(*bool)(nil)},
+	"verifyscripts":           {(*btcjson.VerifyScriptsResult)(nil)},
+	"verifytxoutproof":        {(*[]string)(nil)},
+	"version":                 {(*map[string]btcjson.VersionResult)(nil)},
+
+	// Websocket commands.
+	"loadtxfilter":              nil,
+	"session":                   {(*btcjson.SessionResult)(nil)},
+	"notifyblocks":              nil,
+	"stopnotifyblocks":          nil,
+	"notifynewtransactions":     nil,
+	"stopnotifynewtransactions": nil,
+	"notifyreceived":            nil,
+	"stopnotifyreceived":        nil,
+	"notifyspent":               nil,
+	"stopnotifyspent":           nil,
+	"rescan":                    nil,
+	"rescanblocks":              {(*[]btcjson.RescannedBlock)(nil)},
+}
+
+// helpCacher provides a concurrent safe type that provides help and usage for
+// the RPC server commands and caches the results for future calls.
+type helpCacher struct {
+	sync.Mutex
+	usage      string
+	methodHelp map[string]string
+}
+
+// rpcMethodHelp returns an RPC help string for the provided method.
+//
+// This function is safe for concurrent access.
+func (c *helpCacher) rpcMethodHelp(method string) (string, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	// Return the cached method help if it exists.
+	if help, exists := c.methodHelp[method]; exists {
+		return help, nil
+	}
+
+	// Look up the result types for the method.
+	resultTypes, ok := rpcResultTypes[method]
+	if !ok {
+		return "", errors.New("no result types specified for method " +
+			method)
+	}
+
+	// Generate, cache, and return the help.
+	help, err := btcjson.GenerateHelp(method, helpDescsEnUS, resultTypes...)
+	if err != nil {
+		return "", err
+	}
+	c.methodHelp[method] = help
+	return help, nil
+}
+
+// rpcUsage returns one-line usage for all support RPC commands.
+//
+// This function is safe for concurrent access.
+func (c *helpCacher) rpcUsage(includeWebsockets bool) (string, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	// Return the cached usage if it is available.
+	if c.usage != "" {
+		return c.usage, nil
+	}
+
+	// Generate a list of one-line usage for every command.
+	usageTexts := make([]string, 0, len(rpcHandlers))
+	for k := range rpcHandlers {
+		usage, err := btcjson.MethodUsageText(k)
+		if err != nil {
+			return "", err
+		}
+		usageTexts = append(usageTexts, usage)
+	}
+
+	// Include websockets commands if requested.
+	if includeWebsockets {
+		for k := range wsHandlers {
+			usage, err := btcjson.MethodUsageText(k)
+			if err != nil {
+				return "", err
+			}
+			usageTexts = append(usageTexts, usage)
+		}
+	}
+
+	sort.Strings(usageTexts)
+	c.usage = strings.Join(usageTexts, "\n")
+	return c.usage, nil
+}
+
+// newHelpCacher returns a new instance of a help cacher which provides help and
+// usage for the RPC server commands and caches the results for future calls.
+func newHelpCacher() *helpCacher {
+	return &helpCacher{
+		methodHelp: make(map[string]string),
+	}
+}
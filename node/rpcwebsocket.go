@@ -3,14 +3,11 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-package main
+package node
 
 import (
 	"bytes"
 	"container/list"
-	"crypto/sha256"
-	"crypto/subtle"
-	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -27,7 +24,6 @@ import (
 	"github.com/gcash/bchd/btcjson"
 	"github.com/gcash/bchd/chaincfg"
 	"github.com/gcash/bchd/chaincfg/chainhash"
-	"github.com/gcash/bchd/database"
 	"github.com/gcash/bchd/txscript"
 	"github.com/gcash/bchd/wire"
 	"github.com/gcash/bchutil"
@@ -85,7 +81,7 @@ var wsHandlersBeforeInit = map[string]wsCommandHandler{
 // server handler which runs each new connection in a new goroutine thereby
 // satisfying the requirement.
 func (s *rpcServer) WebsocketHandler(conn *websocket.Conn, remoteAddr string,
-	authenticated bool, isAdmin bool) {
+	account *rpcAccount) {
 
 	// Clear the read deadline that was set before the websocket hijacked
 	// the connection.
@@ -93,9 +89,12 @@ func (s *rpcServer) WebsocketHandler(conn *websocket.Conn, remoteAddr string,
 
 	// Limit max number of websocket clients.
 	rpcsLog.Infof("New websocket client %s", remoteAddr)
-	if s.ntfnMgr.NumClients()+1 > cfg.RPCMaxWebsockets {
+	reloadMtx.RLock()
+	maxWebsockets := cfg.RPCMaxWebsockets
+	reloadMtx.RUnlock()
+	if s.ntfnMgr.NumClients()+1 > maxWebsockets {
 		rpcsLog.Infof("Max websocket clients exceeded [%d] - "+
-			"disconnecting client %s", cfg.RPCMaxWebsockets,
+			"disconnecting client %s", maxWebsockets,
 			remoteAddr)
 		conn.Close()
 		return
@@ -104,7 +103,7 @@ func (s *rpcServer) WebsocketHandler(conn *websocket.Conn, remoteAddr string,
 	// Create a new websocket client to handle the new websocket connection
 	// and wait for it to shutdown.  Once it has shutdown (and hence
 	// disconnected), remove it and any notifications it registered for.
-	client, err := newWebsocketClient(s, conn, remoteAddr, authenticated, isAdmin)
+	client, err := newWebsocketClient(s, conn, remoteAddr, account)
 	if err != nil {
 		rpcsLog.Errorf("Failed to serve client %s: %v", remoteAddr, err)
 		conn.Close()
@@ -247,6 +246,25 @@ func (m *wsNotificationManager) NotifyMempoolTx(tx *bchutil.Tx, isNew bool) {
 	}
 }
 
+// NotifyTxReplaced passes a transaction submitted to this node which was
+// replaced by a conflicting transaction included in a block to the
+// notification manager for notification processing.
+func (m *wsNotificationManager) NotifyTxReplaced(txHash, replacement *chainhash.Hash) {
+	n := &notificationTxReplaced{
+		txHash:      txHash,
+		replacement: replacement,
+	}
+
+	// As NotifyTxReplaced will be called by the rebroadcast handler and
+	// the RPC server may no longer be running, use a select statement to
+	// unblock enqueuing the notification once the RPC server has begun
+	// shutting down.
+	select {
+	case m.queueNotification <- n:
+	case <-m.quit:
+	}
+}
+
 // wsClientFilter tracks relevant addresses for each websocket client for
 // the `rescanblocks` extension. It is modified by the `loadtxfilter` command.
 //
@@ -452,6 +470,10 @@ type notificationTxAcceptedByMempool struct {
 	isNew bool
 	tx    *bchutil.Tx
 }
+type notificationTxReplaced struct {
+	txHash      *chainhash.Hash
+	replacement *chainhash.Hash
+}
 
 // Notification control requests
 type notificationRegisterClient wsClient
@@ -521,6 +543,7 @@ out:
 						block)
 					m.notifyFilteredBlockConnected(blockNotifications,
 						block)
+					m.notifyBlockFees(blockNotifications, block)
 				}
 
 			case *notificationBlockDisconnected:
@@ -540,6 +563,12 @@ out:
 				m.notifyForTx(watchedOutPoints, watchedAddrs, n.tx, nil)
 				m.notifyRelevantTxAccepted(n.tx, clients)
 
+			case *notificationTxReplaced:
+				if len(blockNotifications) != 0 {
+					m.notifyTxReplaced(blockNotifications,
+						n.txHash, n.replacement)
+				}
+
 			case *notificationRegisterBlocks:
 				wsc := (*wsClient)(n)
 				blockNotifications[wsc.quit] = wsc
@@ -707,6 +736,45 @@ func (*wsNotificationManager) notifyBlockConnected(clients map[chan struct{}]*ws
 	}
 }
 
+// notifyBlockFees notifies websocket clients that have registered for block
+// updates of the breakdown of the coinbase of a block connected to the main
+// chain, compared to the block template generated by the server when the block
+// was built from it.
+func (m *wsNotificationManager) notifyBlockFees(clients map[chan struct{}]*wsClient,
+	block *bchutil.Block) {
+
+	templateFees, fromTemplate := m.server.gbtWorkState.templateFees(block)
+	ntfn := btcjson.NewBlockFeesNtfn(blockFeesResult(block, templateFees,
+		fromTemplate, m.server.cfg.ChainParams))
+	marshalledJSON, err := btcjson.MarshalCmd("1.0", nil, ntfn)
+	if err != nil {
+		rpcsLog.Errorf("Failed to marshal block fees notification: "+
+			"%v", err)
+		return
+	}
+	for _, wsc := range clients {
+		wsc.QueueNotification(marshalledJSON)
+	}
+}
+
+// notifyTxReplaced notifies websocket clients that have registered for block
+// updates when a transaction submitted to this node is replaced by a
+// conflicting transaction included in a block.
+func (*wsNotificationManager) notifyTxReplaced(clients map[chan struct{}]*wsClient,
+	txHash, replacement *chainhash.Hash) {
+
+	ntfn := btcjson.NewTxReplacedNtfn(txHash.String(), replacement.String())
+	marshalledJSON, err := btcjson.MarshalCmd("1.0", nil, ntfn)
+	if err != nil {
+		rpcsLog.Errorf("Failed to marshal tx replaced notification: "+
+			"%v", err)
+		return
+	}
+	for _, wsc := range clients {
+		wsc.QueueNotification(marshalledJSON)
+	}
+}
+
 // notifyBlockDisconnected notifies websocket clients that have registered for
 // block updates when a block is disconnected from the main chain (due to a
 // reorganize).
@@ -1265,9 +1333,14 @@ type wsClient struct {
 	// and therefore is allowed to communicated over the websocket.
 	authenticated bool
 
-	// isAdmin specifies whether a client may change the state of the server;
-	// false means its access is only to the limited set of RPC calls.
-	isAdmin bool
+	// account is the RPC account the client authenticated with, which
+	// determines the methods it may call.
+	account *rpcAccount
+
+	// limitedListener specifies whether the client connected through an
+	// RPC listener with the limited option, in which case it may only call
+	// the methods available to the limited user.
+	limitedListener bool
 
 	// sessionID is a random ID generated for each client when connected.
 	// These IDs may be queried by a client using the session RPC.  A change
@@ -1412,17 +1485,14 @@ out:
 				break out
 			case !c.authenticated:
 				// Check credentials.
-				login := authCmd.Username + ":" + authCmd.Passphrase
-				auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
-				authSha := sha256.Sum256([]byte(auth))
-				cmp := subtle.ConstantTimeCompare(authSha[:], c.server.authsha[:])
-				limitcmp := subtle.ConstantTimeCompare(authSha[:], c.server.limitauthsha[:])
-				if cmp != 1 && limitcmp != 1 {
+				authSha := rpcAuthSha(authCmd.Username, authCmd.Passphrase)
+				account := lookupRPCAccount(c.server.accounts, authSha)
+				if account == nil || !account.allowsAddr(c.addr) {
 					rpcsLog.Warnf("Auth failure.")
 					break out
 				}
 				c.authenticated = true
-				c.isAdmin = cmp == 1
+				c.account = account
 
 				// Marshal and send response.
 				reply, err = createMarshalledReply(cmd.jsonrpc, cmd.id, nil, nil)
@@ -1435,24 +1505,22 @@ out:
 				continue
 			}
 
-			// Check if the client is using limited RPC credentials and
-			// error when not authorized to call the supplied RPC.
-			if !c.isAdmin {
-				if _, ok := rpcLimited[req.Method]; !ok {
-					jsonErr := &btcjson.RPCError{
-						Code:    btcjson.ErrRPCInvalidParams.Code,
-						Message: "limited user not authorized for this method",
-					}
-					// Marshal and send response.
-					reply, err = createMarshalledReply("", req.ID, nil, jsonErr)
-					if err != nil {
-						rpcsLog.Errorf("Failed to marshal parse failure "+
-							"reply: %v", err)
-						continue
-					}
-					c.SendMessage(reply, nil)
+			// Check if the account of the client may call the supplied
+			// RPC and error when not authorized.
+			if !c.account.allowsMethod(req.Method, c.limitedListener) {
+				jsonErr := &btcjson.RPCError{
+					Code:    btcjson.ErrRPCInvalidParams.Code,
+					Message: "user not authorized for this method",
+				}
+				// Marshal and send response.
+				reply, err = createMarshalledReply("", req.ID, nil, jsonErr)
+				if err != nil {
+					rpcsLog.Errorf("Failed to marshal parse failure "+
+						"reply: %v", err)
 					continue
 				}
+				c.SendMessage(reply, nil)
+				continue
 			}
 
 			// Asynchronously handle the request.  A semaphore is used to
@@ -1649,18 +1717,15 @@ out:
 							break out
 						case !c.authenticated:
 							// Check credentials.
-							login := authCmd.Username + ":" + authCmd.Passphrase
-							auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
-							authSha := sha256.Sum256([]byte(auth))
-							cmp := subtle.ConstantTimeCompare(authSha[:], c.server.authsha[:])
-							limitcmp := subtle.ConstantTimeCompare(authSha[:], c.server.limitauthsha[:])
-							if cmp != 1 && limitcmp != 1 {
+							authSha := rpcAuthSha(authCmd.Username, authCmd.Passphrase)
+							account := lookupRPCAccount(c.server.accounts, authSha)
+							if account == nil || !account.allowsAddr(c.addr) {
 								rpcsLog.Warnf("Auth failure.")
 								break out
 							}
 
 							c.authenticated = true
-							c.isAdmin = cmp == 1
+							c.account = account
 
 							// Marshal and send response.
 							reply, err = createMarshalledReply(cmd.jsonrpc, cmd.id, nil, nil)
@@ -1676,27 +1741,25 @@ out:
 							continue
 						}
 
-						// Check if the client is using limited RPC credentials and
-						// error when not authorized to call the supplied RPC.
-						if !c.isAdmin {
-							if _, ok := rpcLimited[req.Method]; !ok {
-								jsonErr := &btcjson.RPCError{
-									Code:    btcjson.ErrRPCInvalidParams.Code,
-									Message: "limited user not authorized for this method",
-								}
-								// Marshal and send response.
-								reply, err = createMarshalledReply(req.Jsonrpc, req.ID, nil, jsonErr)
-								if err != nil {
-									rpcsLog.Errorf("Failed to marshal parse failure "+
-										"reply: %v", err)
-									continue
-								}
-
-								if reply != nil {
-									results = append(results, reply)
-								}
+						// Check if the account of the client may call the supplied
+						// RPC and error when not authorized.
+						if !c.account.allowsMethod(req.Method, c.limitedListener) {
+							jsonErr := &btcjson.RPCError{
+								Code:    btcjson.ErrRPCInvalidParams.Code,
+								Message: "user not authorized for this method",
+							}
+							// Marshal and send response.
+							reply, err = createMarshalledReply(req.Jsonrpc, req.ID, nil, jsonErr)
+							if err != nil {
+								rpcsLog.Errorf("Failed to marshal parse failure "+
+									"reply: %v", err)
 								continue
 							}
+
+							if reply != nil {
+								results = append(results, reply)
+							}
+							continue
 						}
 
 						// Lookup the websocket extension for the command, if it doesn't
@@ -1988,29 +2051,35 @@ func (c *wsClient) WaitForShutdown() {
 }
 
 // newWebsocketClient returns a new websocket client given the notification
-// manager, websocket connection, remote address, and whether or not the client
-// has already been authenticated (via HTTP Basic access authentication).  The
+// manager, websocket connection, remote address, and the account of the client
+// if it has already been authenticated (via HTTP Basic access authentication).  The
 // returned client is ready to start.  Once started, the client will process
 // incoming and outgoing messages in separate goroutines complete with queuing
 // and asynchrous handling for long-running operations.
 func newWebsocketClient(server *rpcServer, conn *websocket.Conn,
-	remoteAddr string, authenticated bool, isAdmin bool) (*wsClient, error) {
+	remoteAddr string, account *rpcAccount) (*wsClient, error) {
 
 	sessionID, err := wire.RandomUint64()
 	if err != nil {
 		return nil, err
 	}
 
+	reloadMtx.RLock()
+	maxConcurrentReqs := cfg.RPCMaxConcurrentReqs
+	reloadMtx.RUnlock()
+
+	spec := connListenerSpec(conn.UnderlyingConn())
 	client := &wsClient{
 		conn:              conn,
 		addr:              remoteAddr,
-		authenticated:     authenticated,
-		isAdmin:           isAdmin,
+		authenticated:     account != nil,
+		account:           account,
 		sessionID:         sessionID,
 		server:            server,
 		addrRequests:      make(map[string]struct{}),
 		spentRequests:     make(map[wire.OutPoint]struct{}),
-		serviceRequestSem: makeSemaphore(cfg.RPCMaxConcurrentReqs),
+		limitedListener:   spec != nil && spec.hasOption(listenOptLimited),
+		serviceRequestSem: makeSemaphore(maxConcurrentReqs),
 		ntfnChan:          make(chan []byte, 1), // nonblocking sync
 		sendChan:          make(chan wsResponse, websocketSendBufferSize),
 		quit:              make(chan struct{}),
@@ -2523,12 +2592,14 @@ func handleRescanBlocks(wsc *wsClient, icmd interface{}) (interface{}, error) {
 	discoveredData := make([]btcjson.RescannedBlock, 0, len(blockHashes))
 
 	// Iterate over each block in the request and rescan.  When a block
-	// contains relevant transactions, add it to the response.
-	bc := wsc.server.cfg.Chain
+	// contains relevant transactions, add it to the response.  The blocks
+	// are looked up in a snapshot of the chain so they all belong to the
+	// same chain even if a reorganize happens meanwhile.
+	snapshot := wsc.server.cfg.Chain.ChainSnapshot()
 	params := wsc.server.cfg.ChainParams
 	var lastBlockHash *chainhash.Hash
 	for i := range blockHashes {
-		block, err := bc.BlockByHash(blockHashes[i])
+		block, err := snapshot.BlockByHash(blockHashes[i])
 		if err != nil {
 			return nil, &btcjson.RPCError{
 				Code:    btcjson.ErrRPCBlockNotFound,
@@ -2556,42 +2627,6 @@ func handleRescanBlocks(wsc *wsClient, icmd interface{}) (interface{}, error) {
 	return &discoveredData, nil
 }
 
-// recoverFromReorg attempts to recover from a detected reorganize during a
-// rescan.  It fetches a new range of block shas from the database and
-// verifies that the new range of blocks is on the same fork as a previous
-// range of blocks.  If this condition does not hold true, the JSON-RPC error
-// for an unrecoverable reorganize is returned.
-func recoverFromReorg(chain *blockchain.BlockChain, minBlock, maxBlock int32,
-	lastBlock *chainhash.Hash) ([]chainhash.Hash, error) {
-
-	hashList, err := chain.HeightRange(minBlock, maxBlock)
-	if err != nil {
-		rpcsLog.Errorf("Error looking up block range: %v", err)
-		return nil, &btcjson.RPCError{
-			Code:    btcjson.ErrRPCDatabase,
-			Message: "Database error: " + err.Error(),
-		}
-	}
-	if lastBlock == nil || len(hashList) == 0 {
-		return hashList, nil
-	}
-
-	blk, err := chain.BlockByHash(&hashList[0])
-	if err != nil {
-		rpcsLog.Errorf("Error looking up possibly reorged block: %v",
-			err)
-		return nil, &btcjson.RPCError{
-			Code:    btcjson.ErrRPCDatabase,
-			Message: "Database error: " + err.Error(),
-		}
-	}
-	jsonErr := descendantBlock(lastBlock, blk)
-	if jsonErr != nil {
-		return nil, jsonErr
-	}
-	return hashList, nil
-}
-
 // descendantBlock returns the appropriate JSON-RPC error if a current block
 // fetched during a reorganize is not a direct child of the parent block hash.
 func descendantBlock(prevHash *chainhash.Hash, curBlock *bchutil.Block) error {
@@ -2608,8 +2643,13 @@ func descendantBlock(prevHash *chainhash.Hash, curBlock *bchutil.Block) error {
 // amount of memory that we'll allocate to a given rescan. Every so often,
 // we'll send back a rescan progress notification to the websockets client. The
 // final block and block hash that we've scanned will be returned.
+//
+// The blocks are read from the passed snapshot of the chain so a reorganize
+// during the rescan doesn't mix blocks from different chains.  When the rescan
+// reaches the tip of the snapshot without a stop block, it continues with a
+// new snapshot which must extend the blocks already scanned.
 func scanBlockChunks(wsc *wsClient, cmd *btcjson.RescanCmd, lookups *rescanKeys, minBlock,
-	maxBlock int32, chain *blockchain.BlockChain) (
+	maxBlock int32, chain *blockchain.BlockChain, snapshot *blockchain.ChainSnapshot) (
 	*bchutil.Block, *chainhash.Hash, error) {
 
 	// lastBlock and lastBlockHash track the previously-rescanned block.
@@ -2626,7 +2666,6 @@ func scanBlockChunks(wsc *wsClient, cmd *btcjson.RescanCmd, lookups *rescanKeys,
 
 	// Instead of fetching all block shas at once, fetch in smaller chunks
 	// to ensure large rescans consume a limited amount of memory.
-fetchRange:
 	for minBlock < maxBlock {
 		// Limit the max number of hashes to fetch at once to the
 		// maximum number of items allowed in a single inventory.
@@ -2637,7 +2676,7 @@ fetchRange:
 		if maxLoopBlock-minBlock > wire.MaxInvPerMsg {
 			maxLoopBlock = minBlock + wire.MaxInvPerMsg
 		}
-		hashList, err := chain.HeightRange(minBlock, maxLoopBlock)
+		hashList, err := snapshot.HeightRange(minBlock, maxLoopBlock)
 		if err != nil {
 			rpcsLog.Errorf("Error looking up block range: %v", err)
 			return nil, nil, &btcjson.RPCError{
@@ -2677,63 +2716,31 @@ fetchRange:
 			}
 			close(pauseGuard)
 			if again {
+				snapshot = chain.ChainSnapshot()
+				_, err := snapshot.BlockHeightByHash(lastBlockHash)
+				if err != nil {
+					rpcsLog.Errorf("Stopping rescan for "+
+						"reorged block %v", lastBlockHash)
+					return nil, nil, &ErrRescanReorg
+				}
 				continue
 			}
 			break
 		}
 
-	loopHashList:
 		for i := range hashList {
-			blk, err := chain.BlockByHash(&hashList[i])
+			blk, err := snapshot.BlockByHash(&hashList[i])
 			if err != nil {
-				// Only handle reorgs if a block could not be
-				// found for the hash.
-				if dbErr, ok := err.(database.Error); !ok ||
-					dbErr.ErrorCode != database.ErrBlockNotFound {
-
-					rpcsLog.Errorf("Error looking up "+
-						"block: %v", err)
-					return nil, nil, &btcjson.RPCError{
-						Code: btcjson.ErrRPCDatabase,
-						Message: "Database error: " +
-							err.Error(),
-					}
-				}
-
-				// If an absolute max block was specified, don't
-				// attempt to handle the reorg.
-				if maxBlock != math.MaxInt32 {
-					rpcsLog.Errorf("Stopping rescan for "+
-						"reorged block %v",
-						cmd.EndBlock)
-					return nil, nil, &ErrRescanReorg
-				}
-
-				// If the lookup for the previously valid block
-				// hash failed, there may have been a reorg.
-				// Fetch a new range of block hashes and verify
-				// that the previously processed block (if there
-				// was any) still exists in the database.  If it
-				// doesn't, we error.
-				//
-				// A goto is used to branch executation back to
-				// before the range was evaluated, as it must be
-				// reevaluated for the new hashList.
-				minBlock += int32(i)
-				hashList, err = recoverFromReorg(
-					chain, minBlock, maxBlock, lastBlockHash,
-				)
-				if err != nil {
-					return nil, nil, err
-				}
-				if len(hashList) == 0 {
-					break fetchRange
+				rpcsLog.Errorf("Error looking up block: %v", err)
+				return nil, nil, &btcjson.RPCError{
+					Code:    btcjson.ErrRPCDatabase,
+					Message: "Database error: " + err.Error(),
 				}
-				goto loopHashList
 			}
 			if i == 0 && lastBlockHash != nil {
 				// Ensure the new hashList is on the same fork
-				// as the last block from the old hashList.
+				// as the last block from the old hashList since
+				// it may be from a newer snapshot.
 				jsonErr := descendantBlock(lastBlockHash, blk)
 				if jsonErr != nil {
 					return nil, nil, jsonErr
@@ -2790,10 +2797,10 @@ fetchRange:
 // handleRescan implements the rescan command extension for websocket
 // connections.
 //
-// NOTE: This does not smartly handle reorgs, and fixing requires database
-// changes (for safe, concurrent access to full block ranges, and support
-// for other chains than the best chain).  It will, however, detect whether
-// a reorg removed a block that was previously processed, and result in the
+// NOTE: The blocks are scanned from a snapshot of the chain taken when the
+// rescan starts, so a reorg during the rescan does not mix blocks from
+// different chains.  When the rescan continues past the tip of the snapshot, a
+// reorg which removed a block that was previously processed results in the
 // handler erroring.  Clients must handle this by finding a block still in
 // the chain (perhaps from a rescanprogress notification) to resume their
 // rescan.
@@ -2834,12 +2841,13 @@ func handleRescan(wsc *wsClient, icmd interface{}) (interface{}, error) {
 	}
 
 	chain := wsc.server.cfg.Chain
+	snapshot := chain.ChainSnapshot()
 
 	minBlockHash, err := chainhash.NewHashFromStr(cmd.BeginBlock)
 	if err != nil {
 		return nil, rpcDecodeHexError(cmd.BeginBlock)
 	}
-	minBlock, err := chain.BlockHeightByHash(minBlockHash)
+	minBlock, err := snapshot.BlockHeightByHash(minBlockHash)
 	if err != nil {
 		return nil, &btcjson.RPCError{
 			Code:    btcjson.ErrRPCBlockNotFound,
@@ -2853,7 +2861,7 @@ func handleRescan(wsc *wsClient, icmd interface{}) (interface{}, error) {
 		if err != nil {
 			return nil, rpcDecodeHexError(*cmd.EndBlock)
 		}
-		maxBlock, err = chain.BlockHeightByHash(maxBlockHash)
+		maxBlock, err = snapshot.BlockHeightByHash(maxBlockHash)
 		if err != nil {
 			return nil, &btcjson.RPCError{
 				Code:    btcjson.ErrRPCBlockNotFound,
@@ -2871,7 +2879,7 @@ func handleRescan(wsc *wsClient, icmd interface{}) (interface{}, error) {
 		// which will notify the clients of any address deposits or output
 		// spends.
 		lastBlock, lastBlockHash, err = scanBlockChunks(
-			wsc, cmd, &lookups, minBlock, maxBlock, chain,
+			wsc, cmd, &lookups, minBlock, maxBlock, chain, snapshot,
 		)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,199 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gcash/bchd/btcjson"
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/mempool"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchutil"
+)
+
+// scriptUpgrade is a network upgrade which enabled script features.
+type scriptUpgrade struct {
+	name string
+
+	// activation returns the first block height at which the upgrade is
+	// active, or zero along with the median time past from which it is
+	// active for upgrades activated by time.
+	activation func(params *chaincfg.Params) (int32, int64)
+
+	// enables returns whether the upgrade enabled the passed opcode.
+	enables func(op byte) bool
+
+	// classes holds the locking script templates enabled by the upgrade.
+	classes []txscript.ScriptClass
+}
+
+// scriptUpgrades are the upgrades which enabled opcodes and locking script
+// templates after the chain split, in activation order.  Features which are not
+// enabled by any of them have been usable since the split.
+var scriptUpgrades = []scriptUpgrade{
+	{
+		name: "magneticanomaly",
+		activation: func(params *chaincfg.Params) (int32, int64) {
+			return params.MagneticAnonomalyForkHeight + 1, 0
+		},
+		enables: func(op byte) bool {
+			return op == txscript.OP_CHECKDATASIG ||
+				op == txscript.OP_CHECKDATASIGVERIFY
+		},
+	},
+	{
+		name: "phonon",
+		activation: func(params *chaincfg.Params) (int32, int64) {
+			return params.PhononForkHeight + 1, 0
+		},
+		enables: func(op byte) bool {
+			return op == txscript.OP_REVERSEBYTES
+		},
+	},
+	{
+		name: "cosmicinflation",
+		activation: func(params *chaincfg.Params) (int32, int64) {
+			return 0, int64(params.CosmicInflationActivationTime)
+		},
+		enables: func(op byte) bool {
+			return op == txscript.OP_MUL ||
+				(op >= txscript.OP_INPUTINDEX &&
+					op <= txscript.OP_OUTPUTBYTECODE)
+		},
+	},
+	{
+		name: "upgrade9",
+		activation: func(params *chaincfg.Params) (int32, int64) {
+			return params.Upgrade9Activation() + 1, 0
+		},
+		enables: func(op byte) bool {
+			return op >= txscript.OP_UTXOTOKENCATEGORY &&
+				op <= txscript.OP_OUTPUTTOKENAMOUNT
+		},
+		classes: []txscript.ScriptClass{txscript.ScriptHash32Ty},
+	},
+}
+
+// lintScript reports whether the outputs paying to the passed locking script,
+// and the inputs redeeming them with the passed redeem script when it is not
+// nil, would be standard in a block following the one with the passed height
+// and median time past.  An error is returned when a script can't be parsed.
+func lintScript(params *chaincfg.Params, lockingScript, redeemScript []byte,
+	bestHeight int32, medianTimePast time.Time) (*btcjson.ValidateScriptResult, error) {
+
+	class := txscript.GetScriptClass(lockingScript)
+	result := &btcjson.ValidateScriptResult{
+		Type:     class.String(),
+		Upgrades: []btcjson.ScriptUpgradeResult{},
+	}
+	addError := func(format string, args ...interface{}) {
+		result.Errors = append(result.Errors, fmt.Sprintf(format, args...))
+	}
+
+	stats, err := txscript.CalcScriptStats(lockingScript)
+	if err != nil {
+		return nil, fmt.Errorf("locking script: %v", err)
+	}
+	if err := mempool.CheckPkScriptStandard(lockingScript); err != nil {
+		addError("locking script: %v", err)
+	}
+
+	// Collect the features of the scripts enabled by each upgrade.
+	features := make([][]string, len(scriptUpgrades))
+	seen := make(map[string]struct{})
+	addFeature := func(name string, enabled func(upgrade *scriptUpgrade) bool) {
+		if _, ok := seen[name]; ok {
+			return
+		}
+		seen[name] = struct{}{}
+		for i := range scriptUpgrades {
+			if enabled(&scriptUpgrades[i]) {
+				features[i] = append(features[i], name)
+			}
+		}
+	}
+	addFeature(class.String(), func(upgrade *scriptUpgrade) bool {
+		for _, c := range upgrade.classes {
+			if c == class {
+				return true
+			}
+		}
+		return false
+	})
+	scripts := []*txscript.ScriptStats{stats}
+
+	if redeemScript != nil {
+		redeemStats, err := txscript.CalcScriptStats(redeemScript)
+		if err != nil {
+			return nil, fmt.Errorf("redeem script: %v", err)
+		}
+		result.RedeemType = txscript.GetScriptClass(redeemScript).String()
+		switch {
+		case txscript.IsPayToScriptHash(lockingScript):
+			if !bytes.Equal(lockingScript[2:22], bchutil.Hash160(redeemScript)) {
+				addError("redeem script does not match the locking " +
+					"script hash")
+			}
+		case txscript.IsPayToScriptHash32(lockingScript):
+			if !bytes.Equal(lockingScript[2:34], chainhash.DoubleHashB(redeemScript)) {
+				addError("redeem script does not match the locking " +
+					"script hash")
+			}
+		default:
+			addError("locking script is not pay-to-script-hash")
+		}
+		if err := mempool.CheckRedeemScriptStandard(redeemScript); err != nil {
+			addError("redeem script: %v", err)
+		}
+		scripts = append(scripts, redeemStats)
+	}
+
+	for _, stats := range scripts {
+		result.SigChecks += stats.MaxSigChecks
+		result.OpCost += stats.EstimatedOpCost()
+		for _, name := range stats.Opcodes {
+			op := txscript.OpcodeByName[name]
+			addFeature(name, func(upgrade *scriptUpgrade) bool {
+				return upgrade.enables(op)
+			})
+		}
+	}
+
+	// A script can't be used before all the upgrades enabling its features
+	// are active.
+	for i, upgrade := range scriptUpgrades {
+		if len(features[i]) == 0 {
+			continue
+		}
+		height, activationTime := upgrade.activation(params)
+		active := bestHeight+1 >= height &&
+			medianTimePast.Unix() >= activationTime
+		result.Upgrades = append(result.Upgrades, btcjson.ScriptUpgradeResult{
+			Name:             upgrade.name,
+			ActivationHeight: height,
+			ActivationTime:   activationTime,
+			Active:           active,
+			Features:         features[i],
+		})
+		if height > result.ActivationHeight {
+			result.ActivationHeight = height
+		}
+		if activationTime > result.ActivationTime {
+			result.ActivationTime = activationTime
+		}
+		if !active {
+			addError("%s not enabled until the %s upgrade",
+				strings.Join(features[i], ", "), upgrade.name)
+		}
+	}
+
+	result.Standard = len(result.Errors) == 0
+	return result, nil
+}
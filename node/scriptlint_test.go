@@ -0,0 +1,189 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchutil"
+)
+
+// TestLintScript ensures the standardness, templates, costs and upgrades of
+// scripts are reported as expected.
+func TestLintScript(t *testing.T) {
+	params := &chaincfg.MainNetParams
+	mustScript := func(builder *txscript.ScriptBuilder) []byte {
+		script, err := builder.Script()
+		if err != nil {
+			t.Fatalf("unable to build script: %v", err)
+		}
+		return script
+	}
+	p2sh := func(redeemScript []byte) []byte {
+		return mustScript(txscript.NewScriptBuilder().
+			AddOp(txscript.OP_HASH160).
+			AddData(bchutil.Hash160(redeemScript)).
+			AddOp(txscript.OP_EQUAL))
+	}
+
+	p2pkh := mustScript(txscript.NewScriptBuilder().
+		AddOp(txscript.OP_DUP).AddOp(txscript.OP_HASH160).
+		AddData(make([]byte, 20)).AddOp(txscript.OP_EQUALVERIFY).
+		AddOp(txscript.OP_CHECKSIG))
+	introspection := mustScript(txscript.NewScriptBuilder().
+		AddOp(txscript.OP_INPUTINDEX).AddOp(txscript.OP_UTXOTOKENAMOUNT).
+		AddOp(txscript.OP_0).AddOp(txscript.OP_GREATERTHAN).
+		AddOp(txscript.OP_VERIFY).AddData(make([]byte, 64)).
+		AddData(make([]byte, 32)).AddData(make([]byte, 33)).
+		AddOp(txscript.OP_CHECKDATASIG))
+	tooLarge := mustScript(txscript.NewScriptBuilder().
+		AddData(make([]byte, 1700)).AddOp(txscript.OP_DROP).
+		AddOp(txscript.OP_1))
+
+	upgrade9Height := params.Upgrade9ForkHeight + 1
+	tests := []struct {
+		name          string
+		lockingScript []byte
+		redeemScript  []byte
+		bestHeight    int32
+		standard      bool
+		numErrors     int
+		scriptType    string
+		redeemType    string
+		sigChecks     int
+		upgrades      []string
+	}{
+		{
+			name:          "p2pkh",
+			lockingScript: p2pkh,
+			bestHeight:    upgrade9Height,
+			standard:      true,
+			scriptType:    "pubkeyhash",
+			sigChecks:     1,
+		},
+		{
+			name:          "bare contract",
+			lockingScript: introspection,
+			bestHeight:    upgrade9Height,
+			numErrors:     1,
+			scriptType:    "nonstandard",
+			sigChecks:     1,
+			upgrades:      []string{"magneticanomaly", "cosmicinflation", "upgrade9"},
+		},
+		{
+			name:          "p2sh contract",
+			lockingScript: p2sh(introspection),
+			redeemScript:  introspection,
+			bestHeight:    upgrade9Height,
+			standard:      true,
+			scriptType:    "scripthash",
+			redeemType:    "nonstandard",
+			sigChecks:     1,
+			upgrades:      []string{"magneticanomaly", "cosmicinflation", "upgrade9"},
+		},
+		{
+			name:          "p2sh contract before activation",
+			lockingScript: p2sh(introspection),
+			redeemScript:  introspection,
+			bestHeight:    upgrade9Height - 2,
+			numErrors:     1,
+			scriptType:    "scripthash",
+			redeemType:    "nonstandard",
+			sigChecks:     1,
+			upgrades:      []string{"magneticanomaly", "cosmicinflation", "upgrade9"},
+		},
+		{
+			name:          "mismatched redeem script",
+			lockingScript: p2sh(p2pkh),
+			redeemScript:  introspection,
+			bestHeight:    upgrade9Height,
+			numErrors:     1,
+			scriptType:    "scripthash",
+			redeemType:    "nonstandard",
+			sigChecks:     1,
+			upgrades:      []string{"magneticanomaly", "cosmicinflation", "upgrade9"},
+		},
+		{
+			name:          "redeem script without p2sh",
+			lockingScript: p2pkh,
+			redeemScript:  p2pkh,
+			bestHeight:    upgrade9Height,
+			numErrors:     1,
+			scriptType:    "pubkeyhash",
+			redeemType:    "pubkeyhash",
+			sigChecks:     2,
+		},
+		{
+			name:          "oversized redeem script",
+			lockingScript: p2sh(tooLarge),
+			redeemScript:  tooLarge,
+			bestHeight:    upgrade9Height,
+			numErrors:     1,
+			scriptType:    "scripthash",
+			redeemType:    "nonstandard",
+		},
+	}
+
+	medianTime := time.Unix(int64(params.Upgrade11ActivationTime), 0)
+	for _, test := range tests {
+		result, err := lintScript(params, test.lockingScript,
+			test.redeemScript, test.bestHeight, medianTime)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if result.Standard != test.standard ||
+			len(result.Errors) != test.numErrors {
+
+			t.Errorf("%s: got standard %v with errors %q, want %v "+
+				"with %d errors", test.name, result.Standard,
+				result.Errors, test.standard, test.numErrors)
+		}
+		if result.Type != test.scriptType ||
+			result.RedeemType != test.redeemType {
+
+			t.Errorf("%s: got types %q and %q, want %q and %q",
+				test.name, result.Type, result.RedeemType,
+				test.scriptType, test.redeemType)
+		}
+		if result.SigChecks != test.sigChecks {
+			t.Errorf("%s: got %d sigchecks, want %d", test.name,
+				result.SigChecks, test.sigChecks)
+		}
+		var upgrades []string
+		for _, upgrade := range result.Upgrades {
+			upgrades = append(upgrades, upgrade.Name)
+		}
+		if !reflect.DeepEqual(upgrades, test.upgrades) {
+			t.Errorf("%s: got upgrades %v, want %v", test.name,
+				upgrades, test.upgrades)
+		}
+	}
+
+	// The activation of a script is that of the last upgrade it needs.
+	result, err := lintScript(params, introspection, nil, upgrade9Height,
+		medianTime)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ActivationHeight != upgrade9Height ||
+		result.ActivationTime != int64(params.CosmicInflationActivationTime) {
+
+		t.Errorf("got activation height %d and time %d, want %d and %d",
+			result.ActivationHeight, result.ActivationTime,
+			upgrade9Height, params.CosmicInflationActivationTime)
+	}
+
+	// Scripts which can't be parsed are rejected.
+	if _, err := lintScript(params, []byte{txscript.OP_DATA_2}, nil,
+		upgrade9Height, medianTime); err == nil {
+
+		t.Error("lintScript accepted a truncated data push")
+	}
+}
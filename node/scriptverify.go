@@ -0,0 +1,151 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/btcjson"
+	"github.com/gcash/bchd/mining"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+)
+
+// verifyScriptsFlags returns the script flags selected by the passed flag set
+// of the verifyscripts command, which is either "consensus" for the flags of
+// the next block, "standard" for those flags along with the policy flags
+// applied by the mempool, or a comma separated list of flag names.
+func verifyScriptsFlags(flagSet string, nextBlockFlags txscript.ScriptFlags) (txscript.ScriptFlags, error) {
+	switch strings.ToLower(strings.TrimSpace(flagSet)) {
+	case "consensus":
+		return nextBlockFlags, nil
+
+	case "standard":
+		flags := nextBlockFlags | txscript.StandardVerifyFlags
+		if flags.HasFlag(txscript.ScriptAllowMay2025) {
+			flags |= txscript.ScriptAllowMay2025StandardOnly
+		}
+		return flags, nil
+	}
+
+	var flags txscript.ScriptFlags
+	for _, name := range strings.Split(flagSet, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		flag, err := txscript.ParseScriptFlag(name)
+		if err != nil {
+			return 0, err
+		}
+		flags |= flag
+	}
+	return flags, nil
+}
+
+// verifyInputScript executes the script pair of the passed input one opcode at
+// a time so the opcode which failed can be reported along with the error.
+func verifyInputScript(tx *wire.MsgTx, idx int, prevOut *wire.TxOut,
+	flags txscript.ScriptFlags, sigHashes *txscript.TxSigHashes,
+	utxoCache *txscript.UtxoCache) btcjson.VerifyScriptsInputResult {
+
+	result := btcjson.VerifyScriptsInputResult{Index: idx}
+	setError := func(err error) {
+		result.Error = err.Error()
+		if serr, ok := err.(txscript.Error); ok {
+			result.ErrorCode = serr.ErrorCode.String()
+		}
+	}
+
+	vm, err := txscript.NewEngine(prevOut.PkScript, tx, idx, flags, nil,
+		sigHashes, utxoCache, prevOut.Value)
+	if err != nil {
+		setError(err)
+		return result
+	}
+	for {
+		opcode, _ := vm.DisasmPC()
+		done, err := vm.Step()
+		if err != nil {
+			setError(err)
+			result.FailedOpcode = opcode
+			break
+		}
+		if done {
+			if err := vm.CheckErrorCondition(true); err != nil {
+				setError(err)
+				break
+			}
+			result.Valid = true
+			break
+		}
+	}
+	result.SigChecks = vm.SigChecks()
+	return result
+}
+
+// verifyScripts validates the scripts of all inputs of the passed transaction
+// spending the passed outputs with the passed script flags.  Unlike the
+// validation performed by the chain and the mempool, it doesn't stop at the
+// first input which fails and reports the outcome of each input.  The
+// transaction is also validated as a whole the same way the chain and the
+// mempool do, which enforces the limits applying to all its inputs such as the
+// maximum number of signature checks of a transaction.
+func verifyScripts(tx *wire.MsgTx, prevOuts []wire.TxOut, flags txscript.ScriptFlags,
+	upgrade9ForkHeight int32) (*btcjson.VerifyScriptsResult, error) {
+
+	if len(prevOuts) != len(tx.TxIn) {
+		return nil, fmt.Errorf("%d previous outputs passed for %d inputs",
+			len(prevOuts), len(tx.TxIn))
+	}
+
+	utxoCache := txscript.NewUtxoCache()
+	for i := range prevOuts {
+		utxoCache.AddEntry(i, prevOuts[i])
+	}
+	sigHashes := txscript.NewTxSigHashes(tx)
+	if flags.HasFlag(txscript.ScriptAllowCashTokens) {
+		sigHashes.AddTxSigHashUtxoFromUtxoCache(tx, utxoCache)
+	}
+
+	result := &btcjson.VerifyScriptsResult{
+		TxID:   tx.TxHash().String(),
+		Valid:  true,
+		Flags:  flags.Names(),
+		Inputs: make([]btcjson.VerifyScriptsInputResult, 0, len(tx.TxIn)),
+	}
+	if flags.HasFlag(txscript.ScriptAllowCashTokens) {
+		_, err := wire.RunCashTokensValidityAlgorithm(utxoCache, tx)
+		if err != nil {
+			result.Valid = false
+			result.TokenError = err.Error()
+		}
+	}
+	for i := range tx.TxIn {
+		input := verifyInputScript(tx, i, &prevOuts[i], flags, sigHashes,
+			utxoCache)
+		result.Valid = result.Valid && input.Valid
+		result.SigChecks += input.SigChecks
+		result.Inputs = append(result.Inputs, input)
+	}
+
+	// The spent outputs are treated as unconfirmed since their height is
+	// not known.
+	utxoView := blockchain.NewUtxoViewpoint()
+	for i, txIn := range tx.TxIn {
+		utxoView.Entries()[txIn.PreviousOutPoint] = blockchain.NewUtxoEntry(
+			&prevOuts[i], mining.UnminedHeight, false)
+	}
+	_, err := blockchain.ValidateTransactionScripts(bchutil.NewTx(tx),
+		utxoView, flags, nil, nil, upgrade9ForkHeight)
+	if err != nil {
+		result.Valid = false
+		result.Error = err.Error()
+	}
+	return result, nil
+}
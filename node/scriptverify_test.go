@@ -0,0 +1,120 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+)
+
+// TestVerifyScriptsFlags ensures the flag sets of the verifyscripts command
+// select the expected script flags.
+func TestVerifyScriptsFlags(t *testing.T) {
+	nextBlockFlags := txscript.ScriptBip16 | txscript.ScriptAllowMay2025
+
+	flags, err := verifyScriptsFlags("consensus", nextBlockFlags)
+	if err != nil || flags != nextBlockFlags {
+		t.Fatalf("unexpected consensus flags %v (err %v)", flags, err)
+	}
+
+	flags, err = verifyScriptsFlags("Standard", nextBlockFlags)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := nextBlockFlags | txscript.StandardVerifyFlags |
+		txscript.ScriptAllowMay2025StandardOnly
+	if flags != want {
+		t.Fatalf("unexpected standard flags %v, want %v", flags, want)
+	}
+
+	flags, err = verifyScriptsFlags("P2SH, schnorr", nextBlockFlags)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := txscript.ScriptBip16 | txscript.ScriptVerifySchnorr; flags != want {
+		t.Fatalf("unexpected listed flags %v, want %v", flags, want)
+	}
+
+	if _, err := verifyScriptsFlags("P2SH,BOGUS", nextBlockFlags); err == nil {
+		t.Fatal("unknown flag accepted")
+	}
+}
+
+// TestVerifyScripts ensures the outcome of the validation of each input is
+// reported rather than stopping at the first input which fails.
+func TestVerifyScripts(t *testing.T) {
+	tx := wire.NewMsgTx(1)
+	for i := 0; i < 3; i++ {
+		prevOut := wire.OutPoint{
+			Hash:  chainhash.HashH([]byte("prev")),
+			Index: uint32(i),
+		}
+		tx.AddTxIn(wire.NewTxIn(&prevOut, nil))
+	}
+	tx.AddTxOut(wire.NewTxOut(1000, []byte{txscript.OP_TRUE},
+		wire.TokenData{}))
+
+	prevOuts := []wire.TxOut{
+		*wire.NewTxOut(2000, []byte{txscript.OP_TRUE}, wire.TokenData{}),
+		*wire.NewTxOut(2000, []byte{txscript.OP_RETURN}, wire.TokenData{}),
+		*wire.NewTxOut(2000, []byte{txscript.OP_FALSE}, wire.TokenData{}),
+	}
+	flags := txscript.ScriptBip16 | txscript.ScriptVerifyCleanStack
+	result, err := verifyScripts(tx, prevOuts, flags, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Valid || len(result.Inputs) != 3 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	if result.TxID != tx.TxHash().String() {
+		t.Fatalf("unexpected txid %s", result.TxID)
+	}
+
+	if input := result.Inputs[0]; !input.Valid || input.Error != "" {
+		t.Fatalf("unexpected result of valid input %+v", input)
+	}
+
+	// OP_RETURN fails while executing the opcode.
+	input := result.Inputs[1]
+	if input.Valid || input.ErrorCode != txscript.ErrEarlyReturn.String() ||
+		input.FailedOpcode != "01:0000: OP_RETURN" {
+
+		t.Fatalf("unexpected result of OP_RETURN input %+v", input)
+	}
+
+	// OP_FALSE fails once the scripts finished executing.
+	input = result.Inputs[2]
+	if input.Valid || input.ErrorCode != txscript.ErrEvalFalse.String() ||
+		input.FailedOpcode != "" {
+
+		t.Fatalf("unexpected result of OP_FALSE input %+v", input)
+	}
+
+	// The transaction is rejected as a whole too.
+	if result.Error == "" {
+		t.Fatal("transaction validation error not reported")
+	}
+
+	// A transaction whose inputs are all valid is valid as a whole.
+	validTx := wire.NewMsgTx(1)
+	validTx.AddTxIn(tx.TxIn[0])
+	validTx.AddTxOut(tx.TxOut[0])
+	result, err = verifyScripts(validTx, prevOuts[:1], flags, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Valid || result.Error != "" {
+		t.Fatalf("unexpected result of valid transaction %+v", result)
+	}
+
+	// The number of spent outputs must match the number of inputs.
+	if _, err := verifyScripts(tx, prevOuts[:2], flags, 0); err == nil {
+		t.Fatal("missing previous output accepted")
+	}
+}
@@ -3,7 +3,7 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-package main
+package node
 
 import (
 	"bytes"
@@ -13,8 +13,11 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	mrand "math/rand"
 	"net"
+	"path/filepath"
 	"runtime"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -46,11 +49,6 @@ import (
 )
 
 const (
-	// defaultServices describes the default services that are supported by
-	// the server.
-	defaultServices = wire.SFNodeNetwork | wire.SFNodeBloom |
-		wire.SFNodeCF | wire.SFNodeBitcoinCash
-
 	// defaultRequiredServices describes the default services that are
 	// required to be supported by outbound peers.
 	defaultRequiredServices = wire.SFNodeNetwork
@@ -67,6 +65,22 @@ const (
 	// than necessary. For this reason we cap the number of peers we
 	// allow to send us blocks directly at three.
 	maxDirectRelayPeers = 3
+
+	// blockReadAheadAge is the minimum age of a block for the next block
+	// from the same peer to be read and prevalidated while it is still
+	// being processed.  Only blocks this old are seen while syncing.
+	blockReadAheadAge = time.Hour * 24
+
+	// maxMempoolInvVects is the maximum number of transactions announced
+	// in response to a mempool message.  The transactions paying the
+	// highest fee rates are announced when the pool holds more.
+	maxMempoolInvVects = 4 * wire.MaxInvPerMsg
+
+	// maxBlocksToAnnounce is the maximum number of headers sent at once to
+	// announce new blocks to the peers which want headers.  Blocks which
+	// would need more headers to connect to a block known by the peer are
+	// announced with an inv instead so the peer locates them itself.
+	maxBlocksToAnnounce = 8
 )
 
 var (
@@ -144,11 +158,21 @@ type broadcastInventoryAdd relayMsg
 // needs to be removed from the rebroadcast map
 type broadcastInventoryDel *wire.InvVect
 
+// broadcastInventoryConfirmed is a type used to declare that the transaction
+// it contains was included in a block, so it needs to be removed from the
+// rebroadcast map along with the transactions it conflicts with
+type broadcastInventoryConfirmed *bchutil.Tx
+
 // relayMsg packages an inventory vector along with the newly discovered
 // inventory so the relay has access to that information.
 type relayMsg struct {
 	invVect *wire.InvVect
 	data    interface{}
+
+	// local is set for transactions submitted to this node, which are
+	// announced directly to a random subset of the outbound peers instead
+	// of being relayed to all peers.
+	local bool
 }
 
 // updatePeerHeightsMsg is a message sent from the blockmanager to the server
@@ -230,8 +254,10 @@ type server struct {
 	bytesSent     uint64 // Total bytes sent by all peers since start.
 	started       int32
 	shutdown      int32
+	mempoolLoaded int32
 	shutdownSched int32
 	startupTime   int64
+	rejectInbound int32 // Set by the memory watchdog under memory pressure.
 
 	chainParams             *chaincfg.Params
 	addrManager             *addrmgr.AddrManager
@@ -240,6 +266,13 @@ type server struct {
 	hashCache               *txscript.HashCache
 	rpcServer               *rpcServer
 	gRPCServer              *bchrpc.GrpcServer
+	certManager             *certManager
+	statsRecorder           *statsRecorder
+	txBroadcasts            *txBroadcastTracker
+	peerReputation          *peerReputation
+	forkMonitor             *forkMonitor
+	cluster                 *clusterGossip
+	externalPolicy          *externalPolicyClient
 	syncManager             *netsync.SyncManager
 	chain                   *blockchain.BlockChain
 	txMemPool               *mempool.TxPool
@@ -259,7 +292,9 @@ type server struct {
 	nat                     NAT
 	db                      database.DB
 	timeSource              blockchain.MedianTimeSource
+	clock                   blockchain.Clock
 	services                wire.ServiceFlag
+	utxoCacheMaxSize        uint64
 
 	// The following fields are used for optional indexes.  They will be nil
 	// if the associated index is not enabled.  These fields are set during
@@ -280,12 +315,18 @@ type server struct {
 	cfCheckptCachesMtx sync.RWMutex
 
 	// agentBlacklist is a list of blacklisted substrings by which to filter
-	// user agents.
+	// user agents.  It, along with agentWhitelist, must only be accessed
+	// from the peer handler goroutine once the server has started.
 	agentBlacklist []string
 
 	// agentWhitelist is a list of whitelisted user agent substrings, no
 	// whitelisting will be applied if the list is empty or nil.
 	agentWhitelist []string
+
+	// primaries are the connection requests to the primary nodes when
+	// running as a read replica.  It is set during initial creation of the
+	// server and never changed afterwards.
+	primaries map[*connmgr.ConnReq]struct{}
 }
 
 // spMsg represents a message over the wire from a specific peer.
@@ -311,6 +352,7 @@ type serverPeer struct {
 	*peer.Peer
 
 	connReq               *connmgr.ConnReq
+	connType              connmgr.ConnType
 	server                *server
 	persistent            bool
 	continueHash          *chainhash.Hash
@@ -320,7 +362,9 @@ type serverPeer struct {
 	supportsCompactBlocks bool
 	cbMtx                 sync.RWMutex
 	sentAddrs             bool
-	isWhitelisted         bool
+	permissions           netPermissions
+	primary               bool
+	listenPort            uint16
 	filter                *bloom.Filter
 	addrMtx               sync.RWMutex
 	knownAddresses        map[string]struct{}
@@ -330,8 +374,18 @@ type serverPeer struct {
 	txProcessed    chan struct{}
 	blockProcessed chan struct{}
 
+	// pendingBlock is signaled once the block queued by the last call to
+	// OnBlock has been processed when that call did not wait for it.
+	pendingBlock chan struct{}
+
 	recvSubscribers map[spMsgSubscription]struct{}
 	mtxSubscribers  sync.RWMutex
+
+	// bestHeaderSent is the hash of the last block whose header was sent
+	// to the peer, either to announce it or in response to getheaders,
+	// so the headers announcing new blocks connect to it.
+	bestHeaderSent    *chainhash.Hash
+	bestHeaderSentMtx sync.Mutex
 }
 
 // newServerPeer returns a new serverPeer instance. The peer needs to be set by
@@ -349,6 +403,72 @@ func newServerPeer(s *server, isPersistent bool) *serverPeer {
 	}
 }
 
+// setBestHeaderSent records the hash of the last block whose header was sent to
+// the peer.
+//
+// This function is safe for concurrent access.
+func (sp *serverPeer) setBestHeaderSent(hash *chainhash.Hash) {
+	sp.bestHeaderSentMtx.Lock()
+	sp.bestHeaderSent = hash
+	sp.bestHeaderSentMtx.Unlock()
+}
+
+// knowsBlockHeader returns whether the peer is known to have the header of the
+// block with the passed hash, because the block or one of its descendants was
+// announced to the peer or by the peer.
+//
+// This function is safe for concurrent access.
+func (sp *serverPeer) knowsBlockHeader(hash *chainhash.Hash) bool {
+	if sp.HasKnownInventory(wire.NewInvVect(wire.InvTypeBlock, hash)) {
+		return true
+	}
+
+	chain := sp.server.chain
+	if last := sp.LastAnnouncedBlock(); last != nil && chain.IsAncestor(hash, last) {
+		return true
+	}
+	sp.bestHeaderSentMtx.Lock()
+	bestHeaderSent := sp.bestHeaderSent
+	sp.bestHeaderSentMtx.Unlock()
+	return bestHeaderSent != nil && chain.IsAncestor(hash, bestHeaderSent)
+}
+
+// announceBlockHeaders announces the block with the passed header to the peer
+// with a headers message which also holds the headers of its ancestors the peer
+// doesn't know about, so it connects to a block known by the peer even after a
+// reorganization.  It returns false without announcing the block when more than
+// maxBlocksToAnnounce headers would be needed, in which case the block should be
+// announced with an inv.
+//
+// This function is safe for concurrent access.
+func (sp *serverPeer) announceBlockHeaders(header *wire.BlockHeader) bool {
+	headers := []wire.BlockHeader{*header}
+	for !sp.knowsBlockHeader(&headers[0].PrevBlock) {
+		if len(headers) == maxBlocksToAnnounce {
+			return false
+		}
+		prevHeader, err := sp.server.chain.HeaderByHash(&headers[0].PrevBlock)
+		if err != nil {
+			return false
+		}
+		headers = append([]wire.BlockHeader{prevHeader}, headers...)
+	}
+
+	msgHeaders := wire.NewMsgHeaders()
+	for i := range headers {
+		if err := msgHeaders.AddBlockHeader(&headers[i]); err != nil {
+			peerLog.Errorf("Failed to add block header: %v", err)
+			return false
+		}
+		blockHash := headers[i].BlockHash()
+		sp.AddKnownInventory(wire.NewInvVect(wire.InvTypeBlock, &blockHash))
+	}
+	blockHash := header.BlockHash()
+	sp.setBestHeaderSent(&blockHash)
+	sp.QueueMessage(msgHeaders, nil)
+	return true
+}
+
 // newestBlock returns the current best block hash and height using the format
 // required by the configuration for the peer package.
 func (sp *serverPeer) newestBlock() (*chainhash.Hash, int32, error) {
@@ -401,12 +521,38 @@ func (sp *serverPeer) compactBlocksSupported() bool {
 	return isSupported
 }
 
+// wantsTx returns whether the passed transaction should be relayed to the
+// peer, taking its relay setting, fee filter and bloom filter into account.
+func (sp *serverPeer) wantsTx(txD *mempool.TxDesc) bool {
+	// Don't relay the transaction to the peer when it has transaction
+	// relaying disabled.
+	if sp.relayTxDisabled() {
+		return false
+	}
+
+	// Don't relay the transaction if the transaction fee-per-kb is less
+	// than the peer's feefilter.
+	feeFilter := atomic.LoadInt64(&sp.feeFilter)
+	if feeFilter > 0 && txD.FeePerKB < feeFilter {
+		return false
+	}
+
+	// Don't relay the transaction if there is a bloom filter loaded and the
+	// transaction doesn't match it.
+	if sp.filter.IsLoaded() {
+		if !sp.filter.MatchTxAndUpdate(txD.Tx) {
+			return false
+		}
+	}
+	return true
+}
+
 // relayTxDisabled returns whether or not relaying of transactions for the given
 // peer is disabled.
 // It is safe for concurrent access.
 func (sp *serverPeer) relayTxDisabled() bool {
 	sp.relayMtx.Lock()
-	isDisabled := sp.disableRelayTx
+	isDisabled := sp.disableRelayTx || sp.connType == connmgr.ConnBlockRelay
 	sp.relayMtx.Unlock()
 
 	return isDisabled
@@ -441,7 +587,7 @@ func (sp *serverPeer) addBanScore(persistent, transient uint32, reason string) {
 	if cfg.DisableBanning {
 		return
 	}
-	if sp.isWhitelisted {
+	if sp.permissions.has(permNoBan) {
 		peerLog.Debugf("Misbehaving whitelisted peer %s: %s", sp, reason)
 		return
 	}
@@ -532,6 +678,22 @@ func (sp *serverPeer) OnVersion(_ *peer.Peer, msg *wire.MsgVersion) *wire.MsgRej
 		return wire.NewMsgReject(msg.Command(), wire.RejectNonstandard, reason)
 	}
 
+	// Reject peers sending version messages no sane implementation sends
+	// and remember their misbehavior so they are deprioritized.
+	if err := checkVersionSanity(msg); err != nil {
+		srvrLog.Debugf("Rejecting peer %s with malformed version: %v",
+			sp.Peer, err)
+		sp.server.ReportPeerIncident(sp.Peer, netsync.PeerServedBadData)
+		return wire.NewMsgReject(msg.Command(), wire.RejectMalformed,
+			err.Error())
+	}
+
+	// Remember the port inbound peers advertise they listen on so duplicate
+	// connections to their node are detected.
+	if isInbound {
+		sp.listenPort = msg.AddrMe.Port
+	}
+
 	// Reject outbound peers that are not full nodes.
 	wantServices := wire.SFNodeNetwork
 	if !isInbound && !hasServices(msg.Services, wantServices) {
@@ -563,8 +725,24 @@ func (sp *serverPeer) OnVersion(_ *peer.Peer, msg *wire.MsgVersion) *wire.MsgRej
 // OnVerAck is invoked when a peer receives a verack bitcoin message and is used
 // to kick start communication with them.
 func (sp *serverPeer) OnVerAck(peer *peer.Peer, msg *wire.MsgVerAck) {
+	// Feeler connections are only made to test whether the address is
+	// reachable, so move the address to the tried table and disconnect now
+	// that the handshake completed.
+	if sp.connType == connmgr.ConnFeeler {
+		srvrLog.Debugf("Feeler connection to %s succeeded", sp)
+		sp.server.addrManager.Good(sp.NA())
+		sp.Disconnect()
+		return
+	}
+
 	sp.server.AddPeer(sp)
 
+	// A read replica requests the mempool of its primaries so its own
+	// mirrors the transactions they relay from the start.
+	if sp.primary {
+		sp.QueueMessage(wire.NewMsgMemPool(), nil)
+	}
+
 	// This peer supports the compact blocks version so we should
 	// send them a sendcmpt message.
 	if sp.compactBlocksSupported() {
@@ -583,51 +761,81 @@ func (sp *serverPeer) OnXVersion(_ *peer.Peer, msg *wire.MsgXVersion) {
 }
 
 // OnMemPool is invoked when a peer receives a mempool bitcoin message.
-// It creates and sends an inventory message with the contents of the memory
-// pool up to the maximum inventory allowed per message.  When the peer has a
-// bloom filter loaded, the contents are filtered accordingly.
+// It creates and sends inventory messages with the contents of the memory
+// pool, which are filtered by the bloom filter and fee filter of the peer.
+// Only peers permitted to request the mempool and peers with a bloom filter
+// loaded are served, so the full contents of the pool aren't handed out to
+// anyone asking.
 func (sp *serverPeer) OnMemPool(_ *peer.Peer, msg *wire.MsgMemPool) {
 	// Only allow mempool requests if the server has bloom filtering
-	// enabled.
-	if sp.server.services&wire.SFNodeBloom != wire.SFNodeBloom {
+	// enabled or the peer is permitted to request the mempool.
+	canRequest := sp.permissions.has(permMempool)
+	if !canRequest && sp.server.services&wire.SFNodeBloom != wire.SFNodeBloom {
 		peerLog.Debugf("peer %v sent mempool request with bloom "+
 			"filtering disabled -- disconnecting", sp)
 		sp.Disconnect()
 		return
 	}
+	if !canRequest && !sp.filter.IsLoaded() {
+		peerLog.Debugf("Ignoring mempool request from peer %v without "+
+			"a bloom filter", sp)
+		return
+	}
 
 	// A decaying ban score increase is applied to prevent flooding.
 	// The ban score accumulates and passes the ban threshold if a burst of
 	// mempool messages comes from a peer. The score decays each minute to
 	// half of its value.
-	sp.addBanScore(0, 33, "mempool")
-
-	// Generate inventory message with the available transactions in the
-	// transaction memory pool.  Limit it to the max allowed inventory
-	// per message.  The NewMsgInvSizeHint function automatically limits
-	// the passed hint to the maximum allowed, so it's safe to pass it
-	// without double checking it here.
-	txMemPool := sp.server.txMemPool
-	txDescs := txMemPool.TxDescs()
-	invMsg := wire.NewMsgInvSizeHint(uint(len(txDescs)))
-
-	for _, txDesc := range txDescs {
-		// Either add all transactions when there is no bloom filter,
-		// or only the transactions that match the filter when there is
-		// one.
-		if !sp.filter.IsLoaded() || sp.filter.MatchTxAndUpdate(txDesc.Tx) {
-			iv := wire.NewInvVect(wire.InvTypeTx, txDesc.Tx.Hash())
+	if !canRequest {
+		sp.addBanScore(0, 33, "mempool")
+	}
+
+	// Generate inventory messages with the available transactions in the
+	// transaction memory pool.  Each message is limited to the max allowed
+	// inventory per message.
+	invVects := mempoolInvVects(sp.server.txMemPool.TxDescs(),
+		atomic.LoadInt64(&sp.feeFilter), sp.filter, maxMempoolInvVects)
+	for len(invVects) > 0 {
+		n := min(len(invVects), wire.MaxInvPerMsg)
+		invMsg := wire.NewMsgInvSizeHint(uint(n))
+		for _, iv := range invVects[:n] {
+			sp.AddKnownInventory(iv)
 			invMsg.AddInvVect(iv)
-			if len(invMsg.InvList)+1 > wire.MaxInvPerMsg {
-				break
-			}
 		}
+		sp.QueueMessage(invMsg, nil)
+		invVects = invVects[n:]
 	}
+}
 
-	// Send the inventory message if there is anything to send.
-	if len(invMsg.InvList) > 0 {
-		sp.QueueMessage(invMsg, nil)
+// mempoolInvVects returns the inventory vectors of the passed pool
+// transactions which pay at least the passed fee filter and match the passed
+// bloom filter when it is loaded.  When more than maxInvVects transactions
+// qualify, only those paying the highest fee rates are returned.
+func mempoolInvVects(txDescs []*mempool.TxDesc, feeFilter int64,
+	filter *bloom.Filter, maxInvVects int) []*wire.InvVect {
+
+	if len(txDescs) > maxInvVects {
+		txDescs = slices.Clone(txDescs)
+		sort.Slice(txDescs, func(i, j int) bool {
+			return txDescs[i].FeePerKB > txDescs[j].FeePerKB
+		})
 	}
+
+	invVects := make([]*wire.InvVect, 0, min(len(txDescs), maxInvVects))
+	for _, txDesc := range txDescs {
+		if len(invVects) == maxInvVects {
+			break
+		}
+		if feeFilter > 0 && txDesc.FeePerKB < feeFilter {
+			continue
+		}
+		if filter.IsLoaded() && !filter.MatchTxAndUpdate(txDesc.Tx) {
+			continue
+		}
+		invVects = append(invVects, wire.NewInvVect(wire.InvTypeTx,
+			txDesc.Tx.Hash()))
+	}
+	return invVects
 }
 
 // OnGetCFMemPool is invoked when a peer receives a getcfmempool bitcoin message.
@@ -682,11 +890,16 @@ func (sp *serverPeer) OnGetCFMemPool(_ *peer.Peer, msg *wire.MsgGetCFMempool) {
 // handler this does not serialize all transactions through a single thread
 // transactions don't rely on the previous one in a linear fashion like blocks.
 func (sp *serverPeer) OnTx(_ *peer.Peer, msg *wire.MsgTx) {
-	if cfg.BlocksOnly {
+	if cfg.BlocksOnly && !sp.permissions.has(permRelay) {
 		peerLog.Tracef("Ignoring tx %v from %v - blocksonly enabled",
 			msg.TxHash(), sp)
 		return
 	}
+	if sp.connType == connmgr.ConnBlockRelay {
+		peerLog.Tracef("Ignoring tx %v from block-relay-only peer %v",
+			msg.TxHash(), sp)
+		return
+	}
 
 	// Add the transaction to the known inventory for the peer.
 	// Convert the raw MsgTx to a bchutil.Tx which provides some convenience
@@ -700,10 +913,48 @@ func (sp *serverPeer) OnTx(_ *peer.Peer, msg *wire.MsgTx) {
 	// processed and known good or bad.  This helps prevent a malicious peer
 	// from queuing up a bunch of bad transactions before disconnecting (or
 	// being disconnected) and wasting memory.
-	sp.server.syncManager.QueueTx(tx, sp.Peer, sp.txProcessed)
+	sp.server.syncManager.QueueTx(tx, sp.Peer,
+		sp.permissions.has(permForceRelay), sp.txProcessed)
 	<-sp.txProcessed
 }
 
+// OnDSProofBeta is invoked when a peer receives a dsproof-beta bitcoin message.
+// The double-spend proof is validated against the mempool transaction spending
+// its output and stored, in which case it is relayed by the mempool callback.
+func (sp *serverPeer) OnDSProofBeta(_ *peer.Peer, msg *wire.MsgDSProofBeta) {
+	hash := msg.Hash()
+	if cfg.BlocksOnly && !sp.permissions.has(permRelay) ||
+		sp.connType == connmgr.ConnBlockRelay {
+
+		peerLog.Tracef("Ignoring double-spend proof %v from %v -- "+
+			"transaction relay disabled", hash, sp)
+		return
+	}
+
+	// Add the proof to the known inventory for the peer so it is not
+	// relayed back to it.
+	iv := wire.NewInvVect(wire.InvTypeDoubleSpendProof, &hash)
+	sp.AddKnownInventory(iv)
+
+	// Proofs are only validated when they were requested, so a peer can't
+	// make the node validate proofs it did not ask for.
+	if !sp.server.syncManager.DSProofRequested(&hash, sp.Peer) {
+		peerLog.Debugf("Ignoring unrequested double-spend proof %v "+
+			"from %v", hash, sp)
+		sp.addBanScore(0, 33, "unrequested dsproof-beta")
+		return
+	}
+
+	_, err := sp.server.txMemPool.ProcessDoubleSpendProof(msg)
+	if err != nil {
+		peerLog.Debugf("Rejected double-spend proof %v from %v: %v",
+			hash, sp, err)
+		if _, ok := err.(mempool.RuleError); ok {
+			sp.addBanScore(10, 0, "invalid dsproof-beta")
+		}
+	}
+}
+
 // OnBlock is invoked when a peer receives a block bitcoin message.  It
 // blocks until the bitcoin block has been fully processed.
 func (sp *serverPeer) OnBlock(_ *peer.Peer, msg *wire.MsgBlock, buf []byte) {
@@ -726,7 +977,30 @@ func (sp *serverPeer) OnBlock(_ *peer.Peer, msg *wire.MsgBlock, buf []byte) {
 	// reference implementation processes blocks in the same
 	// thread and therefore blocks further messages until
 	// the bitcoin block has been fully processed.
+	//
+	// While syncing, one block of read-ahead is allowed instead.  The
+	// block is prevalidated and queued, and only the previous block is
+	// waited for, so the next block can be received and prevalidated
+	// while this one is being connected.  The regression test network
+	// is excluded to keep the behavior the acceptance tests rely on.
+	if !sp.server.chainParams.RegressionTest &&
+		time.Since(msg.Header.Timestamp) > blockReadAheadAge {
+
+		sp.server.chain.PrevalidateBlock(block)
+		done := make(chan struct{}, 1)
+		sp.server.syncManager.QueueBlock(block, sp.Peer, done)
+		if sp.pendingBlock != nil {
+			<-sp.pendingBlock
+		}
+		sp.pendingBlock = done
+		return
+	}
+
 	sp.server.syncManager.QueueBlock(block, sp.Peer, sp.blockProcessed)
+	if sp.pendingBlock != nil {
+		<-sp.pendingBlock
+		sp.pendingBlock = nil
+	}
 	<-sp.blockProcessed
 }
 
@@ -910,7 +1184,14 @@ func (sp *serverPeer) OnGetBlockTxns(_ *peer.Peer, msg *wire.MsgGetBlockTxns) {
 // accordingly.  We pass the message down to blockmanager which will call
 // QueueMessage with any appropriate responses.
 func (sp *serverPeer) OnInv(_ *peer.Peer, msg *wire.MsgInv) {
-	if !cfg.BlocksOnly {
+	// Transactions are neither requested from block-relay-only peers nor
+	// in blocks only mode from peers which aren't permitted to relay them.
+	relayTx := !cfg.BlocksOnly || sp.permissions.has(permRelay)
+	if relayTx && sp.connType != connmgr.ConnBlockRelay {
+		// Record announcements of transactions submitted to this node
+		// as evidence of their propagation.
+		sp.server.txBroadcasts.seen(sp.ID(), msg.InvList)
+
 		if len(msg.InvList) > 0 {
 			sp.server.syncManager.QueueInv(msg, sp.Peer)
 		}
@@ -919,9 +1200,12 @@ func (sp *serverPeer) OnInv(_ *peer.Peer, msg *wire.MsgInv) {
 
 	newInv := wire.NewMsgInvSizeHint(uint(len(msg.InvList)))
 	for _, invVect := range msg.InvList {
+		if invVect.Type == wire.InvTypeDoubleSpendProof {
+			continue
+		}
 		if invVect.Type == wire.InvTypeTx {
 			peerLog.Tracef("Ignoring tx %v in inv from %v -- "+
-				"blocksonly enabled", invVect.Hash, sp)
+				"transaction relay disabled", invVect.Hash, sp)
 			if sp.ProtocolVersion() >= wire.BIP0037Version {
 				peerLog.Infof("Peer %v is announcing "+
 					"transactions -- disconnecting", sp)
@@ -962,7 +1246,10 @@ func (sp *serverPeer) OnGetData(_ *peer.Peer, msg *wire.MsgGetData) {
 	// bursts of small requests are not penalized as that would potentially ban
 	// peers performing IBD.
 	// This incremental score decays each minute to half of its value.
-	sp.addBanScore(0, uint32(length)*99/wire.MaxInvPerMsg, "getdata")
+	// Peers permitted to download are not limited.
+	if !sp.permissions.has(permDownload) {
+		sp.addBanScore(0, uint32(length)*99/wire.MaxInvPerMsg, "getdata")
+	}
 
 	// We wait on this wait channel periodically to prevent queuing
 	// far more data than we can send in a reasonable time, wasting memory.
@@ -990,6 +1277,8 @@ func (sp *serverPeer) OnGetData(_ *peer.Peer, msg *wire.MsgGetData) {
 			err = sp.server.pushCmpctBlockMsg(sp, &iv.Hash, c, waitChan, wire.BaseEncoding)
 		case wire.InvTypeFilteredBlock:
 			err = sp.server.pushMerkleBlockMsg(sp, &iv.Hash, c, waitChan, wire.BaseEncoding)
+		case wire.InvTypeDoubleSpendProof:
+			err = sp.server.pushDSProofMsg(sp, &iv.Hash, c, waitChan)
 		default:
 			peerLog.Warnf("Unknown type in inventory request %d",
 				iv.Type)
@@ -1102,6 +1391,18 @@ func (sp *serverPeer) OnGetHeaders(_ *peer.Peer, msg *wire.MsgGetHeaders) {
 		blockHeaders[i] = &headers[i]
 	}
 	sp.QueueMessage(&wire.MsgHeaders{Headers: blockHeaders}, nil)
+
+	// Track the last header the peer has, so new blocks announced with
+	// headers connect to it.  The peer is at the tip of the locator when
+	// no headers follow it.
+	if len(headers) > 0 {
+		blockHash := headers[len(headers)-1].BlockHash()
+		sp.setBestHeaderSent(&blockHash)
+	} else if len(msg.BlockLocatorHashes) > 0 &&
+		chain.MainChainHasBlock(msg.BlockLocatorHashes[0]) {
+
+		sp.setBestHeaderSent(msg.BlockLocatorHashes[0])
+	}
 }
 
 // OnGetCFilters is invoked when a peer receives a getcfilters bitcoin message.
@@ -1113,11 +1414,7 @@ func (sp *serverPeer) OnGetCFilters(_ *peer.Peer, msg *wire.MsgGetCFilters) {
 
 	// We'll also ensure that the remote party is requesting a set of
 	// filters that we actually currently maintain.
-	switch msg.FilterType {
-	case wire.GCSFilterRegular:
-		break
-
-	default:
+	if !sp.server.cfilterTypeServed(msg.FilterType) {
 		peerLog.Debugf("Filter request for unknown filter: %v",
 			msg.FilterType)
 		return
@@ -1169,11 +1466,7 @@ func (sp *serverPeer) OnGetCFHeaders(_ *peer.Peer, msg *wire.MsgGetCFHeaders) {
 
 	// We'll also ensure that the remote party is requesting a set of
 	// headers for filters that we actually currently maintain.
-	switch msg.FilterType {
-	case wire.GCSFilterRegular:
-		break
-
-	default:
+	if !sp.server.cfilterTypeServed(msg.FilterType) {
 		peerLog.Debugf("Filter request for unknown headers for "+
 			"filter: %v", msg.FilterType)
 		return
@@ -1286,11 +1579,7 @@ func (sp *serverPeer) OnGetCFCheckpt(_ *peer.Peer, msg *wire.MsgGetCFCheckpt) {
 
 	// We'll also ensure that the remote party is requesting a set of
 	// checkpoints for filters that we actually currently maintain.
-	switch msg.FilterType {
-	case wire.GCSFilterRegular:
-		break
-
-	default:
+	if !sp.server.cfilterTypeServed(msg.FilterType) {
 		peerLog.Debugf("Filter request for unknown checkpoints for "+
 			"filter: %v", msg.FilterType)
 		return
@@ -1598,6 +1887,18 @@ func (sp *serverPeer) OnAddr(_ *peer.Peer, msg *wire.MsgAddr) {
 		return
 	}
 
+	// Ignore addresses from block-relay-only peers so they can't be
+	// identified through address relay.
+	if sp.connType == connmgr.ConnBlockRelay {
+		return
+	}
+
+	// Ignore addresses when running as a read replica since it only
+	// connects to its primaries.
+	if len(cfg.ReplicaOf) > 0 {
+		return
+	}
+
 	// Ignore old style addresses which don't include a timestamp.
 	if sp.ProtocolVersion() < wire.NetAddressTimeVersion {
 		return
@@ -1642,9 +1943,22 @@ func (sp *serverPeer) OnReject(p *peer.Peer, msg *wire.MsgReject) {
 	peerLog.Warnf("Received reject message from peer %s, code: %s, reason: %s", p, msg.Code.String(), msg.Reason)
 }
 
-// OnNotFound logs all not found messages received from the remote peer.
+// OnNotFound is invoked when a peer receives a notfound bitcoin message.  It
+// passes the message to the sync manager so the items the peer doesn't have
+// can be requested from other peers.
 func (sp *serverPeer) OnNotFound(p *peer.Peer, msg *wire.MsgNotFound) {
-	peerLog.Warnf("Received not found message from peer %s, %d not found invs", p, len(msg.InvList))
+	peerLog.Debugf("Received not found message from peer %s, %d not found invs", p, len(msg.InvList))
+	sp.server.syncManager.QueueNotFound(msg, p)
+}
+
+// OnCheckMessage is invoked when a peer receives a message, before it is
+// handled, and rejects the messages approaching the protocol limits when
+// configured to.
+func (sp *serverPeer) OnCheckMessage(_ *peer.Peer, msg wire.Message) error {
+	if !cfg.AuditMessages {
+		return nil
+	}
+	return sp.auditMessage(msg)
 }
 
 // OnRead is invoked when a peer receives a message and it is used to update
@@ -1716,6 +2030,21 @@ func (s *server) RemoveRebroadcastInventory(iv *wire.InvVect) {
 	s.modifyRebroadcastInv <- broadcastInventoryDel(iv)
 }
 
+// cfilterTypeServed returns whether the committed filters of the passed type
+// are served to peers.  Each filter type is advertised with a service bit.
+func (s *server) cfilterTypeServed(filterType wire.FilterType) bool {
+	if s.cfIndex == nil || !s.cfIndex.FilterTypeEnabled(filterType) {
+		return false
+	}
+	switch filterType {
+	case wire.GCSFilterRegular:
+		return s.services&wire.SFNodeCF == wire.SFNodeCF
+	case wire.GCSFilterExtended:
+		return s.services&wire.SFNodeCFExtended == wire.SFNodeCFExtended
+	}
+	return false
+}
+
 // relayTransactions generates and relays inventory vectors for all of the
 // passed transactions to all connected peers.
 func (s *server) relayTransactions(txns []*mempool.TxDesc) {
@@ -1725,6 +2054,15 @@ func (s *server) relayTransactions(txns []*mempool.TxDesc) {
 	}
 }
 
+// relayLocalTransaction announces the passed transaction submitted to this
+// node to a random subset of the outbound peers and starts tracking its
+// propagation.
+func (s *server) relayLocalTransaction(txD *mempool.TxDesc) {
+	s.txBroadcasts.track(txD.Tx.Hash())
+	iv := wire.NewInvVect(wire.InvTypeTx, txD.Tx.Hash())
+	s.relayInv <- relayMsg{invVect: iv, data: txD, local: true}
+}
+
 // AnnounceNewTransactions generates and relays inventory vectors and notifies
 // both websocket and getblocktemplate long poll clients of the passed
 // transactions.  This function should be called whenever new transactions
@@ -1733,7 +2071,26 @@ func (s *server) AnnounceNewTransactions(txns []*mempool.TxDesc) {
 	// Generate and relay inventory vectors for all newly accepted
 	// transactions.
 	s.relayTransactions(txns)
+	s.notifyNewTransactions(txns)
+}
+
+// AnnounceLocalTransactions is like AnnounceNewTransactions for the
+// transactions accepted to the mempool as a result of a transaction submitted
+// to this node.  The submitted transaction must be the first one and is
+// announced to a random subset of the outbound peers so its propagation can be
+// tracked.
+func (s *server) AnnounceLocalTransactions(txns []*mempool.TxDesc) {
+	if len(txns) == 0 {
+		return
+	}
+	s.relayLocalTransaction(txns[0])
+	s.relayTransactions(txns[1:])
+	s.notifyNewTransactions(txns)
+}
 
+// notifyNewTransactions notifies both websocket and getblocktemplate long poll
+// clients as well as the gRPC server of the passed transactions.
+func (s *server) notifyNewTransactions(txns []*mempool.TxDesc) {
 	// Notify both websocket and getblocktemplate long poll clients of all
 	// newly accepted transactions.
 	if s.rpcServer != nil {
@@ -1746,6 +2103,25 @@ func (s *server) AnnounceNewTransactions(txns []*mempool.TxDesc) {
 	}
 }
 
+// announceDoubleSpendProof relays the passed double-spend proof newly stored by
+// the mempool to the peers and notifies the gRPC server of it along with the
+// mempool transaction it proves double spent.  It is the OnDoubleSpendProof
+// callback of the mempool.
+func (s *server) announceDoubleSpendProof(proof *wire.MsgDSProofBeta, poolTx *bchutil.Tx) {
+	// Ignore if shutting down.
+	if atomic.LoadInt32(&s.shutdown) != 0 {
+		return
+	}
+
+	hash := proof.Hash()
+	iv := wire.NewInvVect(wire.InvTypeDoubleSpendProof, &hash)
+	s.RelayInventory(iv, proof)
+
+	if s.gRPCServer != nil {
+		s.gRPCServer.NotifyDoubleSpendProof(proof, poolTx)
+	}
+}
+
 // Transaction has one confirmation on the main chain. Now we can mark it as no
 // longer needing rebroadcasting.
 func (s *server) TransactionConfirmed(tx *bchutil.Tx) {
@@ -1754,8 +2130,12 @@ func (s *server) TransactionConfirmed(tx *bchutil.Tx) {
 		return
 	}
 
-	iv := wire.NewInvVect(wire.InvTypeTx, tx.Hash())
-	s.RemoveRebroadcastInventory(iv)
+	// Ignore if shutting down.
+	if atomic.LoadInt32(&s.shutdown) != 0 {
+		return
+	}
+
+	s.modifyRebroadcastInv <- broadcastInventoryConfirmed(tx)
 }
 
 // pushTxMsg sends a tx message for the provided transaction hash to the
@@ -1787,6 +2167,33 @@ func (s *server) pushTxMsg(sp *serverPeer, hash *chainhash.Hash, doneChan chan<-
 	return nil
 }
 
+// pushDSProofMsg sends a dsproof-beta message for the provided double-spend
+// proof hash to the connected peer.  An error is returned if the proof is not
+// stored by the mempool.
+func (s *server) pushDSProofMsg(sp *serverPeer, hash *chainhash.Hash, doneChan chan<- struct{},
+	waitChan <-chan struct{}) error {
+
+	proof := s.txMemPool.DoubleSpendProofByHash(hash)
+	if proof == nil {
+		peerLog.Tracef("Unable to fetch double-spend proof %v from "+
+			"transaction pool", hash)
+
+		if doneChan != nil {
+			doneChan <- struct{}{}
+		}
+		return fmt.Errorf("double-spend proof %v not found", hash)
+	}
+
+	// Once we have fetched data wait for any previous operation to finish.
+	if waitChan != nil {
+		<-waitChan
+	}
+
+	sp.QueueMessage(proof, doneChan)
+
+	return nil
+}
+
 // pushBlockMsg sends a block message for the provided block hash to the
 // connected peer.  An error is returned if the block hash is not known.
 func (s *server) pushBlockMsg(sp *serverPeer, hash *chainhash.Hash, doneChan chan<- struct{},
@@ -2036,8 +2443,28 @@ func (s *server) handleAddPeerMsg(state *peerState, sp *serverPeer) bool {
 		delete(state.banned, host)
 	}
 
+	// Collapse duplicate connections to the same node, keeping the
+	// preferred one.  The replaced connection no longer counts toward the
+	// limits below although it is only removed once it is done.
+	var replacedPeers, replacedIPPeers int
+	if dup := state.duplicatePeer(sp); dup != nil {
+		if !preferConnection(sp, dup) {
+			srvrLog.Debugf("Already connected to the node of peer %s "+
+				"as %s - disconnecting duplicate", sp, dup)
+			sp.Disconnect()
+			return false
+		}
+		srvrLog.Debugf("Replacing duplicate connection %s to the node of "+
+			"peer %s", dup, sp)
+		dup.Disconnect()
+		replacedPeers = 1
+		if !dup.persistent {
+			replacedIPPeers = 1
+		}
+	}
+
 	// Limit max number of total peers per ip.
-	if state.CountIP(host) >= cfg.MaxPeersPerIP {
+	if state.CountIP(host)-replacedIPPeers >= cfg.MaxPeersPerIP {
 		srvrLog.Infof("Max peers per IP reached [%d] - disconnecting peer %s",
 			cfg.MaxPeersPerIP, sp)
 		sp.Disconnect()
@@ -2046,7 +2473,7 @@ func (s *server) handleAddPeerMsg(state *peerState, sp *serverPeer) bool {
 	}
 
 	// Limit max number of total peers.
-	if state.Count() >= cfg.MaxPeers {
+	if state.Count()-replacedPeers >= cfg.MaxPeers {
 		srvrLog.Infof("Max peers reached [%d] - disconnecting peer %s",
 			cfg.MaxPeers, sp)
 		sp.Disconnect()
@@ -2085,12 +2512,18 @@ func (s *server) handleAddPeerMsg(state *peerState, sp *serverPeer) bool {
 	// remote peer for outbound connections. This is skipped when running
 	// on the simulation and regression test networks since they are only
 	// intended to connect to specified peers and actively avoid advertising
-	// and connecting to discovered peers.
-	if !cfg.SimNet && !cfg.RegressionTest && !sp.Inbound() {
+	// and connecting to discovered peers.  A read replica only connects to
+	// its primaries, so it skips this as well.
+	//
+	// Addresses are never exchanged with block-relay-only peers.
+	isBlockRelay := sp.connType == connmgr.ConnBlockRelay
+	if !cfg.SimNet && !cfg.RegressionTest && len(cfg.ReplicaOf) == 0 &&
+		!sp.Inbound() {
+
 		// Advertise the local address when the server accepts incoming
 		// connections and it believes itself to be close to the best
 		// known tip.
-		if !cfg.DisableListen && s.syncManager.IsCurrent() {
+		if !cfg.DisableListen && !isBlockRelay && s.syncManager.IsCurrent() {
 			// Get address that best matches.
 			lna := s.addrManager.GetBestLocalAddress(sp.NA())
 			if addrmgr.IsRoutable(lna) {
@@ -2104,7 +2537,9 @@ func (s *server) handleAddPeerMsg(state *peerState, sp *serverPeer) bool {
 		// more and the peer has a protocol version new enough to
 		// include a timestamp with addresses.
 		hasTimestamp := sp.ProtocolVersion() >= wire.NetAddressTimeVersion
-		if s.addrManager.NeedMoreAddresses() && hasTimestamp {
+		if s.addrManager.NeedMoreAddresses() && hasTimestamp &&
+			!isBlockRelay {
+
 			sp.QueueMessage(wire.NewMsgGetAddr(), nil)
 		}
 
@@ -2131,10 +2566,18 @@ func (s *server) handleDonePeerMsg(state *peerState, sp *serverPeer) {
 	// Regardless of whether the peer was found in our list, we'll inform
 	// our connection manager about the disconnection. This can happen if we
 	// process a peer's `done` message before its `add`.
+	// Feeler connections are not replaced since the connection manager
+	// makes them periodically.
 	if !sp.Inbound() {
-		if sp.persistent {
+		switch {
+		case sp.persistent:
 			s.connManager.Disconnect(sp.connReq.ID())
-		} else {
+		case sp.connType == connmgr.ConnFeeler:
+			s.connManager.Remove(sp.connReq.ID())
+		case sp.connType == connmgr.ConnBlockRelay:
+			s.connManager.Remove(sp.connReq.ID())
+			go s.connManager.NewBlockRelayConnReq()
+		default:
 			s.connManager.Remove(sp.connReq.ID())
 			go s.connManager.NewConnReq()
 		}
@@ -2173,11 +2616,27 @@ func (s *server) handleBanPeerMsg(state *peerState, sp *serverPeer) {
 	srvrLog.Infof("Banned peer %s (%s) for %v", host, direction,
 		cfg.BanDuration)
 	state.banned[host] = time.Now().Add(cfg.BanDuration)
+	s.peerReputation.record(host, peerIncidentBan)
+	if s.cluster != nil {
+		s.cluster.PublishBan(host, cfg.BanDuration)
+	}
 }
 
 // handleRelayInvMsg deals with relaying inventory to peers that are not already
 // known to have it.  It is invoked from the peerHandler goroutine.
 func (s *server) handleRelayInvMsg(state *peerState, msg relayMsg) {
+	if msg.local {
+		s.handleRelayLocalTxMsg(state, msg)
+		return
+	}
+
+	// A read replica is only connected to its primaries, which already
+	// have the blocks and transactions it learns about from them, so it
+	// only relays the transactions submitted to it.
+	if len(cfg.ReplicaOf) > 0 {
+		return
+	}
+
 	state.forAllPeers(func(sp *serverPeer) {
 		if !sp.Connected() {
 			return
@@ -2187,6 +2646,23 @@ func (s *server) handleRelayInvMsg(state *peerState, msg relayMsg) {
 		// remote peer to see if we should do some special relaying or
 		// just drop it into the inv queue with everything else.
 		if msg.invVect.Type == wire.InvTypeBlock {
+			// The header of a block which is not available yet is
+			// only announced to the peers which want headers and
+			// don't know about the block.  An inv would make the
+			// others request a block we can't serve.  The peers
+			// too far behind for the headers to connect to a block
+			// they know are sent an inv instead so the
+			// announcement isn't lost.
+			if header, ok := msg.data.(*wire.BlockHeader); ok {
+				if !sp.WantsHeaders() || sp.HasKnownInventory(msg.invVect) ||
+					sp.announceBlockHeaders(header) {
+
+					return
+				}
+				sp.QueueInventory(msg.invVect)
+				return
+			}
+
 			block, ok := msg.data.(*wire.MsgBlock)
 			if !ok {
 				peerLog.Warnf("Underlying data for block" +
@@ -2223,31 +2699,31 @@ func (s *server) handleRelayInvMsg(state *peerState, msg relayMsg) {
 						return
 					}
 					sp.QueueMessage(cmpctBlock, nil)
+					sp.setBestHeaderSent(&blockHash)
 					return
 				}
 			}
 
 			// If the peer wants us to send block headers instead of inv messages
-			// then we'll send the header here rather than the inv message.
+			// then we'll send the headers here rather than the inv message,
+			// unless they don't connect to a block known by the peer.
 			if sp.WantsHeaders() {
-				msgHeaders := wire.NewMsgHeaders()
-				if err := msgHeaders.AddBlockHeader(&block.Header); err != nil {
-					peerLog.Errorf("Failed to add block"+
-						" header: %v", err)
+				if sp.HasKnownInventory(msg.invVect) ||
+					sp.announceBlockHeaders(&block.Header) {
+
 					return
 				}
-				sp.QueueMessage(msgHeaders, nil)
-				return
 			}
 		}
 
-		if msg.invVect.Type == wire.InvTypeTx {
-			// Don't relay the transaction to the peer when it has
-			// transaction relaying disabled.
-			if sp.relayTxDisabled() {
-				return
-			}
+		// Double-spend proofs are relayed along with transactions.
+		if msg.invVect.Type == wire.InvTypeDoubleSpendProof &&
+			sp.relayTxDisabled() {
 
+			return
+		}
+
+		if msg.invVect.Type == wire.InvTypeTx {
 			txD, ok := msg.data.(*mempool.TxDesc)
 			if !ok {
 				peerLog.Warnf("Underlying data for tx inv "+
@@ -2255,21 +2731,9 @@ func (s *server) handleRelayInvMsg(state *peerState, msg relayMsg) {
 					msg.data)
 				return
 			}
-
-			// Don't relay the transaction if the transaction fee-per-kb
-			// is less than the peer's feefilter.
-			feeFilter := atomic.LoadInt64(&sp.feeFilter)
-			if feeFilter > 0 && txD.FeePerKB < feeFilter {
+			if !sp.wantsTx(txD) {
 				return
 			}
-
-			// Don't relay the transaction if there is a bloom
-			// filter loaded and the transaction doesn't match it.
-			if sp.filter.IsLoaded() {
-				if !sp.filter.MatchTxAndUpdate(txD.Tx) {
-					return
-				}
-			}
 		}
 
 		// Queue the inventory to be relayed with the next batch.
@@ -2279,6 +2743,56 @@ func (s *server) handleRelayInvMsg(state *peerState, msg relayMsg) {
 	})
 }
 
+// handleRelayLocalTxMsg deals with announcing a transaction submitted to this
+// node.  The transaction is announced right away, bypassing the trickle queue,
+// to a random subset of the outbound peers so any announcement of it by the
+// other peers shows that it propagated through the network.  It is relayed to
+// all peers as usual when there are no suitable outbound peers.
+func (s *server) handleRelayLocalTxMsg(state *peerState, msg relayMsg) {
+	txD, ok := msg.data.(*mempool.TxDesc)
+	if !ok {
+		peerLog.Warnf("Underlying data for local tx inv relay is not a "+
+			"*mempool.TxDesc: %T", msg.data)
+		return
+	}
+
+	var candidates []*serverPeer
+	state.forAllOutboundPeers(func(sp *serverPeer) {
+		if sp.Connected() && !sp.HasKnownInventory(msg.invVect) &&
+			sp.wantsTx(txD) {
+
+			candidates = append(candidates, sp)
+		}
+	})
+	if len(candidates) == 0 {
+		srvrLog.Debugf("No outbound peers to announce transaction %v "+
+			"to -- relaying it to all peers", msg.invVect.Hash)
+		s.handleRelayInvMsg(state, relayMsg{invVect: msg.invVect,
+			data: msg.data})
+		return
+	}
+
+	mrand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	if len(candidates) > localTxAnnouncePeers {
+		candidates = candidates[:localTxAnnouncePeers]
+	}
+
+	peerIDs := make([]int32, 0, len(candidates))
+	for _, sp := range candidates {
+		invMsg := wire.NewMsgInvSizeHint(1)
+		invMsg.AddInvVect(msg.invVect)
+		sp.AddKnownInventory(msg.invVect)
+		sp.QueueMessage(invMsg, nil)
+		peerIDs = append(peerIDs, sp.ID())
+	}
+	s.txBroadcasts.announced(&msg.invVect.Hash, peerIDs)
+
+	srvrLog.Debugf("Announced transaction %v to %d outbound peers",
+		msg.invVect.Hash, len(peerIDs))
+}
+
 // handleRelayCmpctBlock deals with direct relaying a compact block to
 // peers which both want a compact block and accept direct relay.
 func (s *server) handleRelayCmpctBlock(state *peerState, msg *wire.MsgCmpctBlock) {
@@ -2345,6 +2859,12 @@ type removeNodeMsg struct {
 	reply chan error
 }
 
+type banHostMsg struct {
+	host     string
+	duration time.Duration
+	reply    chan struct{}
+}
+
 // handleQuery is the central handler for all queries and commands from other
 // goroutines related to peer state.
 func (s *server) handleQuery(state *peerState, querymsg interface{}) {
@@ -2425,6 +2945,13 @@ func (s *server) handleQuery(state *peerState, querymsg interface{}) {
 			peers = append(peers, sp)
 		}
 		msg.reply <- peers
+	case setAgentFiltersMsg:
+		s.agentBlacklist = msg.blacklist
+		s.agentWhitelist = msg.whitelist
+		srvrLog.Infof("User-agent blacklist %s", s.agentBlacklist)
+		srvrLog.Infof("User-agent whitelist %s", s.agentWhitelist)
+		msg.reply <- struct{}{}
+
 	case disconnectNodeMsg:
 		// Check inbound peers. We pass a nil callback since we don't
 		// require any additional actions on disconnect for inbound peers.
@@ -2454,6 +2981,19 @@ func (s *server) handleQuery(state *peerState, querymsg interface{}) {
 		}
 
 		msg.reply <- errors.New("peer not found")
+
+	case banHostMsg:
+		banEnd := time.Now().Add(msg.duration)
+		if banEnd.After(state.banned[msg.host]) {
+			state.banned[msg.host] = banEnd
+		}
+		state.forAllPeers(func(sp *serverPeer) {
+			host, _, err := net.SplitHostPort(sp.Addr())
+			if err == nil && host == msg.host {
+				sp.Disconnect()
+			}
+		})
+		msg.reply <- struct{}{}
 	}
 }
 
@@ -2493,6 +3033,7 @@ func newPeerConfig(sp *serverPeer) *peer.Config {
 			OnBlock:        sp.OnBlock,
 			OnCmpctBlock:   sp.OnCmpctBlock,
 			OnGetBlockTxns: sp.OnGetBlockTxns,
+			OnDSProofBeta:  sp.OnDSProofBeta,
 			OnInv:          sp.OnInv,
 			OnHeaders:      sp.OnHeaders,
 			OnGetData:      sp.OnGetData,
@@ -2508,6 +3049,7 @@ func newPeerConfig(sp *serverPeer) *peer.Config {
 			OnFilterLoad:   sp.OnFilterLoad,
 			OnGetAddr:      sp.OnGetAddr,
 			OnAddr:         sp.OnAddr,
+			OnCheckMessage: sp.OnCheckMessage,
 			OnRead:         sp.OnRead,
 			OnWrite:        sp.OnWrite,
 			OnReject:       sp.OnReject,
@@ -2522,9 +3064,11 @@ func newPeerConfig(sp *serverPeer) *peer.Config {
 		UserAgentComments: cfg.UserAgentComments,
 		ChainParams:       sp.server.chainParams,
 		Services:          sp.server.services,
-		DisableRelayTx:    cfg.BlocksOnly,
+		DisableRelayTx: (cfg.BlocksOnly && !sp.permissions.has(permRelay)) ||
+			sp.connType != connmgr.ConnFullRelay,
 		ProtocolVersion:   peer.MaxProtocolVersion,
 		TrickleInterval:   cfg.TrickleInterval,
+		Clock:             sp.server.clock,
 		MaxKnownInventory: uint((cfg.ExcessiveBlockSize / 1000000) * peer.DefaultMaxKnownInventory),
 	}
 }
@@ -2535,7 +3079,23 @@ func newPeerConfig(sp *serverPeer) *peer.Config {
 // for disconnection.
 func (s *server) inboundPeerConnected(conn net.Conn) {
 	sp := newServerPeer(s, false)
-	sp.isWhitelisted = isWhitelisted(conn.RemoteAddr())
+	sp.permissions = whitelistPermissions(conn.RemoteAddr())
+	if spec := connListenerSpec(conn); spec != nil &&
+		spec.hasOption(listenOptWhitelist) {
+
+		sp.permissions |= permDefault
+	}
+
+	// Only whitelisted peers are accepted while the memory watchdog
+	// rejects inbound peers.
+	if atomic.LoadInt32(&s.rejectInbound) != 0 &&
+		!sp.permissions.has(permNoBan) {
+
+		srvrLog.Debugf("Rejecting inbound peer %s under memory pressure",
+			conn.RemoteAddr())
+		conn.Close()
+		return
+	}
 	sp.Peer = peer.NewInboundPeer(newPeerConfig(sp))
 	sp.AssociateConnection(conn)
 	go s.peerDoneHandler(sp)
@@ -2548,6 +3108,12 @@ func (s *server) inboundPeerConnected(conn net.Conn) {
 // manager of the attempt.
 func (s *server) outboundPeerConnected(c *connmgr.ConnReq, conn net.Conn) {
 	sp := newServerPeer(s, c.Permanent)
+	sp.connType = c.Type
+	sp.permissions = whitelistPermissions(conn.RemoteAddr())
+	if _, ok := s.primaries[c]; ok {
+		sp.primary = true
+		sp.permissions |= permAll
+	}
 	p, err := peer.NewOutboundPeer(newPeerConfig(sp), c.Addr.String())
 	if err != nil {
 		srvrLog.Debugf("Cannot create outbound peer %s: %v", c.Addr, err)
@@ -2561,7 +3127,6 @@ func (s *server) outboundPeerConnected(c *connmgr.ConnReq, conn net.Conn) {
 	}
 	sp.Peer = p
 	sp.connReq = c
-	sp.isWhitelisted = isWhitelisted(conn.RemoteAddr())
 	sp.AssociateConnection(conn)
 	go s.peerDoneHandler(sp)
 }
@@ -2573,7 +3138,8 @@ func (s *server) peerDoneHandler(sp *serverPeer) {
 	s.donePeers <- sp
 
 	// Only tell sync manager we are gone if we ever told it we existed.
-	if sp.VerAckReceived() {
+	// Feelers are disconnected before they are added.
+	if sp.VerAckReceived() && sp.connType != connmgr.ConnFeeler {
 		s.syncManager.DonePeer(sp.Peer, nil)
 
 		// Evict any remaining orphans that were sent by the peer.
@@ -2606,22 +3172,14 @@ func (s *server) peerHandler() {
 		persistentPeers:  make(map[int32]*serverPeer),
 		outboundPeers:    make(map[int32]*serverPeer),
 		directRelayPeers: make(map[int32]*serverPeer),
-		banned:           make(map[string]time.Time),
+		banned:           s.peerReputation.activeBans(cfg.BanDuration),
 		outboundGroups:   make(map[string]int),
 		connectionCount:  make(map[string]int),
 	}
 
 	if !cfg.DisableDNSSeed {
-		// Add peers discovered through DNS to the address manager.
-		connmgr.SeedFromDNS(activeNetParams.Params, defaultRequiredServices,
-			bchdLookup, func(addrs []*wire.NetAddress) {
-				// Bitcoind uses a lookup of the dns seeder here. This
-				// is rather strange since the values looked up by the
-				// DNS seed lookups will vary quite a lot.
-				// to replicate this behaviour we put all addresses as
-				// having come from the first one.
-				s.addrManager.AddAddresses(addrs, addrs[0])
-			})
+		// Add peers discovered through the seeds to the address manager.
+		s.reseed()
 	}
 	go s.connManager.Start()
 
@@ -2718,6 +3276,17 @@ func (s *server) BanPeer(sp *serverPeer) {
 	s.banPeers <- sp
 }
 
+// BanHost bans the peers with the passed host for the passed duration and
+// disconnects those which are connected.
+func (s *server) BanHost(host string, duration time.Duration) {
+	replyChan := make(chan struct{})
+	select {
+	case s.query <- banHostMsg{host: host, duration: duration, reply: replyChan}:
+		<-replyChan
+	case <-s.quit:
+	}
+}
+
 // RelayInventory relays the passed inventory vector to all connected peers
 // that are not already known to have it.
 func (s *server) RelayInventory(invVect *wire.InvVect, data interface{}) {
@@ -2767,6 +3336,41 @@ func (s *server) NetTotals() (uint64, uint64) {
 		atomic.LoadUint64(&s.bytesSent)
 }
 
+// ReportPeerIncident records misbehavior of the passed peer in its reputation
+// so it is deprioritized when choosing outbound peers in the future.
+//
+// This function is safe for concurrent access and is part of the
+// netsync.PeerNotifier interface implementation.
+func (s *server) ReportPeerIncident(p *peer.Peer, incident netsync.PeerIncident) {
+	host, _, err := net.SplitHostPort(p.Addr())
+	if err != nil {
+		return
+	}
+	var kind peerIncident
+	switch incident {
+	case netsync.PeerStalled:
+		kind = peerIncidentStall
+	case netsync.PeerServedBadData:
+		kind = peerIncidentBadData
+	default:
+		return
+	}
+	s.peerReputation.record(host, kind)
+	if s.cluster != nil {
+		s.cluster.PublishPeerIncident(host, kind)
+	}
+}
+
+// addrHost returns the host of the passed network address in the same form
+// as the host of the address of a connected peer.
+func addrHost(na *wire.NetAddress) string {
+	host, _, err := net.SplitHostPort(addrmgr.NetAddressKey(na))
+	if err != nil {
+		return na.IP.String()
+	}
+	return host
+}
+
 // UpdatePeerHeights updates the heights of all peers who have have announced
 // the latest connected main chain block, or a recognized orphan. These height
 // updates allow us to dynamically refresh peer heights, ensuring sync peer
@@ -2785,7 +3389,7 @@ func (s *server) UpdatePeerHeights(latestBlkHash *chainhash.Hash, latestHeight i
 func (s *server) rebroadcastHandler() {
 	// Wait 5 min before first tx rebroadcast.
 	timer := time.NewTimer(5 * time.Minute)
-	pendingInvs := make(map[wire.InvVect]interface{})
+	pending := newPendingRebroadcasts()
 
 out:
 	for {
@@ -2794,18 +3398,33 @@ out:
 			switch msg := riv.(type) {
 			// Incoming InvVects are added to our map of RPC txs.
 			case broadcastInventoryAdd:
-				pendingInvs[*msg.invVect] = msg.data
+				pending.add(msg.invVect, msg.data)
 
 			// When an InvVect has been added to a block, we can
 			// now remove it, if it was present.
 			case broadcastInventoryDel:
-				delete(pendingInvs, *msg)
+				pending.remove(msg)
+
+			// When a transaction has been added to a block, the
+			// transactions it conflicts with can no longer be
+			// mined, so they are removed as well and reported as
+			// replaced.
+			case broadcastInventoryConfirmed:
+				tx := (*bchutil.Tx)(msg)
+				for _, hash := range pending.confirmed(tx) {
+					srvrLog.Infof("Transaction %v was replaced "+
+						"by conflicting transaction %v -- "+
+						"no longer rebroadcasting it", hash,
+						tx.Hash())
+					s.txBroadcasts.replaced(&hash, tx.Hash())
+					s.rpcServer.NotifyTxReplaced(&hash, tx.Hash())
+				}
 			}
 
 		case <-timer.C:
 			// Any inventory we have has not made it into a block
 			// yet. We periodically resubmit them until they have.
-			for iv, data := range pendingInvs {
+			for iv, data := range pending.invs {
 				ivCopy := iv
 				s.RelayInventory(&ivCopy, data)
 			}
@@ -2849,11 +3468,51 @@ func (s *server) Start() {
 	s.wg.Add(1)
 	go s.peerHandler()
 
+	// Preload the UTXO cache with the outputs of the most recent blocks if
+	// enabled.
+	if cfg.UtxoCacheWarmupBlocks > 0 {
+		s.wg.Add(1)
+		go s.warmUtxoCache()
+	}
+
+	// Degrade the node in stages as its memory usage approaches the
+	// ceiling if enabled.
+	if cfg.MemoryCeiling > 0 {
+		s.wg.Add(1)
+		go s.memoryWatchdog()
+	}
+
+	// Shrink the UTXO cache under memory pressure when it's sized based
+	// on the available memory.
+	if cfg.utxoCacheAutoSize {
+		s.wg.Add(1)
+		go s.utxoCacheSizeHandler(s.utxoCacheMaxSize)
+	}
+
 	if s.nat != nil {
 		s.wg.Add(1)
 		go s.upnpUpdateThread()
 	}
 
+	// Query the seeds for peers again periodically if enabled.
+	if !cfg.DisableDNSSeed && cfg.ReseedInterval > 0 {
+		s.wg.Add(1)
+		go s.reseedHandler()
+	}
+
+	// Load the mempool saved on the last shutdown unless disabled.
+	if !cfg.NoPersistMempool {
+		s.wg.Add(1)
+		go s.loadMempool()
+	}
+
+	// Pre-fill the mempool from the seed node once the chain is synced if
+	// enabled.
+	if cfg.MempoolSeed != "" {
+		s.wg.Add(1)
+		go s.seedMempool()
+	}
+
 	if !cfg.DisableRPC {
 		s.wg.Add(1)
 
@@ -2865,6 +3524,27 @@ func (s *server) Start() {
 		if s.gRPCServer != nil {
 			s.gRPCServer.Start()
 		}
+		if s.certManager != nil {
+			s.certManager.Start()
+		}
+	}
+
+	// Start storing the reputation of the peers.
+	s.peerReputation.Start()
+
+	// Start recording the mempool and block statistics if enabled.
+	if s.statsRecorder != nil {
+		s.statsRecorder.Start()
+	}
+
+	// Start comparing the chains of the fork monitor nodes if enabled.
+	if s.forkMonitor != nil {
+		s.forkMonitor.Start()
+	}
+
+	// Start sharing events with the other nodes of the cluster if enabled.
+	if s.cluster != nil {
+		s.cluster.Start()
 	}
 
 	// Start the CPU miner if generation is enabled.
@@ -2899,8 +3579,38 @@ func (s *server) Stop() error {
 			s.gRPCServer.Stop()
 			srvrLog.Info("Stopped: grpcServer")
 		}
+		if s.certManager != nil {
+			s.certManager.Stop()
+		}
+	}
+
+	if s.statsRecorder != nil {
+		s.statsRecorder.Stop()
+	}
+	if s.forkMonitor != nil {
+		s.forkMonitor.Stop()
+	}
+	if s.cluster != nil {
+		s.cluster.Stop()
+	}
+	if s.externalPolicy != nil {
+		s.externalPolicy.Close()
+	}
+
+	// Save the mempool so it is loaded again on the next startup unless
+	// disabled.
+	if !cfg.NoPersistMempool && atomic.LoadInt32(&s.mempoolLoaded) != 0 {
+		srvrLog.Info("Saving mempool")
+		if err := s.saveMempool(); err != nil {
+			srvrLog.Errorf("Unable to save the mempool: %v", err)
+		} else {
+			srvrLog.Info("Mempool save complete")
+		}
 	}
 
+	// Store the reputation of the peers changed since the last flush.
+	s.peerReputation.Stop()
+
 	srvrLog.Info("Saving fee estimate to database")
 	// Save fee estimator state in the database.
 	s.db.Update(func(tx database.Tx) error {
@@ -2916,6 +3626,23 @@ func (s *server) Stop() error {
 	return nil
 }
 
+// warmUtxoCache preloads the UTXO cache with the unspent outputs created in the
+// most recent blocks so validation right after startup doesn't have to fetch
+// every output it spends from the database.  It must be run as a goroutine.
+func (s *server) warmUtxoCache() {
+	defer s.wg.Done()
+
+	start := time.Now()
+	added, err := s.chain.WarmUtxoCache(cfg.UtxoCacheWarmupBlocks, s.quit)
+	if err != nil {
+		srvrLog.Warnf("Unable to preload the UTXO cache: %v", err)
+		return
+	}
+	srvrLog.Infof("Preloaded %d unspent outputs from the last %d blocks "+
+		"into the UTXO cache in %v", added, cfg.UtxoCacheWarmupBlocks,
+		time.Since(start).Round(time.Millisecond))
+}
+
 // WaitForShutdown blocks until the main listener and peer handlers are stopped.
 func (s *server) WaitForShutdown() {
 	srvrLog.Info("Waiting for server waitgroup to complete")
@@ -3061,50 +3788,46 @@ out:
 	s.wg.Done()
 }
 
-// setupRPCListeners returns slices of listeners that are configured for use
-// with the RPC server and gRPC server depending on the configuration settings
-// for listen addresses and TLS.
-func setupRPCListeners() ([]net.Listener, error) {
-	// Setup TLS if not disabled.
-	listenFunc := net.Listen
-	if !cfg.DisableTLS {
-		// Generate the TLS cert and key file if both don't already
-		// exist.
-		if !fileExists(cfg.RPCKey) && !fileExists(cfg.RPCCert) {
-			err := genCertPair(cfg.RPCCert, cfg.RPCKey, cfg.ExternalIPs)
-			if err != nil {
-				return nil, err
-			}
-		}
-		keypair, err := tls.LoadX509KeyPair(cfg.RPCCert, cfg.RPCKey)
-		if err != nil {
-			return nil, err
-		}
-
-		tlsConfig := tls.Config{
-			Certificates: []tls.Certificate{keypair},
-			MinVersion:   tls.VersionTLS12,
-		}
+// setupCertManager returns the cert manager providing the TLS certificate
+// for the RPC, gRPC and Prometheus listeners.  It returns nil when none of the
+// listeners use TLS.
+func setupCertManager() (*certManager, error) {
+	if cfg.DisableTLS && len(cfg.GrpcListeners) == 0 {
+		return nil, nil
+	}
 
-		// Change the standard net.Listen function to the tls one.
-		listenFunc = func(net string, laddr string) (net.Listener, error) {
-			return tls.Listen(net, laddr, &tlsConfig)
-		}
+	extraHosts := make([]string, 0, len(cfg.ExternalIPs)+
+		len(cfg.RPCCertExtraHosts))
+	extraHosts = append(extraHosts, cfg.ExternalIPs...)
+	extraHosts = append(extraHosts, cfg.RPCCertExtraHosts...)
+	certMgr, err := newCertManager(cfg.RPCCert, cfg.RPCKey, extraHosts,
+		cfg.RPCCertRenewBefore)
+	if err != nil {
+		return nil, err
 	}
+	if len(cfg.GrpcACMEDomains) > 0 {
+		certMgr.enableACME(cfg.GrpcACMEDomains, cfg.GrpcACMEEmail,
+			acmeCacheDir())
+	}
+	return certMgr, nil
+}
 
-	rpcNetAddrs, err := parseListeners(cfg.RPCListeners)
+// setupRPCListeners returns slices of listeners that are configured for use
+// with the RPC server and gRPC server depending on the configuration settings
+// for listen addresses and TLS.
+func setupRPCListeners(certMgr *certManager) ([]net.Listener, error) {
+	rpcListeners, err := listenSpecs(cfg.RPCListeners, net.Listen)
 	if err != nil {
 		return nil, err
 	}
 
-	rpcListeners := make([]net.Listener, 0, len(rpcNetAddrs))
-	for _, addr := range rpcNetAddrs {
-		listener, err := listenFunc(addr.Network(), addr.String())
-		if err != nil {
-			rpcsLog.Warnf("Can't listen on %s: %v", addr, err)
-			continue
+	// Setup TLS if not disabled.  The TLS listeners wrap the spec listeners
+	// so the listener options remain available for each connection.
+	if !cfg.DisableTLS {
+		tlsConfig := certMgr.tlsConfig()
+		for i, listener := range rpcListeners {
+			rpcListeners[i] = tls.NewListener(listener, tlsConfig)
 		}
-		rpcListeners = append(rpcListeners, listener)
 	}
 
 	return rpcListeners, nil
@@ -3114,13 +3837,7 @@ func setupRPCListeners() ([]net.Listener, error) {
 // bitcoin network type specified by chainParams.  Use start to begin accepting
 // connections from peers.
 func newServer(listenAddrs, agentBlacklist, agentWhitelist []string, db database.DB, chainParams *chaincfg.Params, interrupt <-chan struct{}) (*server, error) {
-	services := defaultServices
-	if cfg.NoPeerBloomFilters {
-		services &^= wire.SFNodeBloom
-	}
-	if cfg.NoCFilters {
-		services &^= wire.SFNodeCF
-	}
+	services := localServices(cfg.Prune)
 
 	amgr := addrmgr.New(cfg.DataDir, bchdLookup)
 
@@ -3162,15 +3879,23 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string, db database
 		peerHeightsUpdate:    make(chan updatePeerHeightsMsg),
 		nat:                  nat,
 		db:                   db,
-		timeSource:           blockchain.NewMedianTime(),
+		clock:                blockchain.SystemClock,
 		services:             services,
 		sigCache:             txscript.NewSigCache(cfg.SigCacheMaxSize),
 		hashCache:            txscript.NewHashCache(cfg.SigCacheMaxSize),
 		cfCheckptCaches:      make(map[wire.FilterType][]cfHeaderKV),
 		agentBlacklist:       agentBlacklist,
 		agentWhitelist:       agentWhitelist,
+		txBroadcasts:         newTxBroadcastTracker(),
 	}
 
+	// The time can be mocked with the setmocktime RPC on the networks used
+	// for testing.
+	if cfg.RegressionTest || cfg.SimNet {
+		s.clock = newMockClock()
+	}
+	s.timeSource = blockchain.NewMedianTimeWithClock(s.clock)
+
 	// Create the transaction and address indexes if needed.
 	//
 	// CAUTION: the txindex needs to be first in the indexes array because
@@ -3210,9 +3935,9 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string, db database
 		s.slpIndex = indexers.NewSlpIndex(db, slpCfg)
 		indexes = append(indexes, s.slpIndex)
 	}
-	if !cfg.FastSync && !cfg.NoCFilters {
+	if cfIndexEnabled() {
 		indxLog.Info("Committed filter index is enabled")
-		s.cfIndex = indexers.NewCfIndex(db, chainParams)
+		s.cfIndex = indexers.NewCfIndex(db, chainParams, cfFilterTypes()...)
 		indexes = append(indexes, s.cfIndex)
 	}
 
@@ -3223,40 +3948,61 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string, db database
 	}
 
 	// Merge given checkpoints with the default ones unless they are disabled.
+	// Checkpoints added on the command line take precedence over those of
+	// a signed checkpoint update.
 	var checkpoints []chaincfg.Checkpoint
 	if !cfg.DisableCheckpoints {
-		checkpoints = mergeCheckpoints(s.chainParams.Checkpoints, cfg.addCheckpoints)
+		additional := cfg.addCheckpoints
+		if cfg.CheckpointURL != "" {
+			updated := loadCheckpointUpdate(newCheckpointUpdateClient(cfg.dial),
+				cfg.CheckpointURL, filepath.Join(cfg.DataDir, checkpointUpdateFilename),
+				cfg.checkpointPubKeys, cfg.CheckpointMinSigs, s.chainParams)
+			additional = append(updated, cfg.addCheckpoints...)
+		}
+		checkpoints = mergeCheckpoints(s.chainParams.Checkpoints, additional)
 	}
 
 	// Create a new block chain instance with the appropriate configuration.
+	if !cfg.scriptFlagOverrides.IsEmpty() {
+		srvrLog.Warnf("Overriding the script verification flags with %s",
+			strings.Join(cfg.scriptFlagOverrides.Names(), ","))
+	}
 	var err error
+	s.utxoCacheMaxSize = utxoCacheMaxSize()
 	s.chain, err = blockchain.New(&blockchain.Config{
-		DB:                 s.db,
-		UtxoCacheMaxSize:   uint64(cfg.UtxoCacheMaxSizeMiB) * 1024 * 1024,
-		Interrupt:          interrupt,
-		ChainParams:        s.chainParams,
-		Checkpoints:        checkpoints,
-		TimeSource:         s.timeSource,
-		SigCache:           s.sigCache,
-		IndexManager:       indexManager,
-		HashCache:          s.hashCache,
-		ExcessiveBlockSize: cfg.ExcessiveBlockSize,
-		Prune:              cfg.Prune,
-		PruneDepth:         cfg.PruneDepth,
-		ReIndexChainState:  cfg.ReIndexChainState,
-		FastSync:           cfg.FastSync,
-		FastSyncDataDir:    cfg.DataDir,
-		Proxy:              cfg.Proxy,
+		DB:                  s.db,
+		UtxoCacheMaxSize:    s.utxoCacheMaxSize,
+		Interrupt:           interrupt,
+		ChainParams:         s.chainParams,
+		Checkpoints:         checkpoints,
+		TimeSource:          s.timeSource,
+		SigCache:            s.sigCache,
+		IndexManager:        indexManager,
+		HashCache:           s.hashCache,
+		ScriptFlagOverrides: cfg.scriptFlagOverrides,
+		ExcessiveBlockSize:  cfg.ExcessiveBlockSize,
+		Prune:               cfg.Prune,
+		PruneDepth:          cfg.PruneDepth,
+		ReIndexChainState:   cfg.ReIndexChainState,
+		FastSync:            cfg.FastSync,
+		FastSyncDataDir:     cfg.DataDir,
+		Proxy:               cfg.Proxy,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	if s.chain.IsPruned() {
-		s.services &^= wire.SFNodeNetwork
-		s.services |= wire.SFNodeNetworkLimited
+	// Load the historical behavior of the peers so peers with a poor
+	// reputation are deprioritized right after a restart.
+	s.peerReputation, err = newPeerReputation(db)
+	if err != nil {
+		return nil, err
 	}
 
+	// Only the recent blocks are served once the chain was ever pruned or
+	// fast synced, even when pruning is no longer enabled.
+	s.services = localServices(s.chain.IsPruned())
+
 	// Search for a FeeEstimator state in the database. If none can be found
 	// or if it cannot be loaded, create a new one.
 	db.Update(func(tx database.Tx) error {
@@ -3297,22 +4043,85 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string, db database
 			LimitSigChecks:       true,
 			MinRelayTxFee:        cfg.minRelayTxFee,
 			MaxTxVersion:         2,
+			FeeOnly:              cfg.FeeOnlyPolicy,
+			MaxTokenCategoryTxs:  cfg.MaxTokenCategoryTxs,
+			MaxTokenGenesisTxs:   cfg.MaxTokenGenesisTxs,
+			MaxMempoolBytes:      int64(cfg.MaxMempool) * 1000000,
+
+			MaxStandardTxSize:        cfg.MaxStandardTxSize,
+			MaxStandardSigScriptSize: cfg.MaxStandardSigScript,
+			MaxDataCarrierSize:       cfg.MaxDataCarrierSize,
+			MaxDataCarrierOutputs:    cfg.MaxDataCarrierOutputs,
 		},
 		ChainParams:    chainParams,
 		FetchUtxoView:  s.chain.FetchUtxoView,
 		BestHeight:     func() int32 { return s.chain.BestSnapshot().Height },
+		BestHash:       func() *chainhash.Hash { return &s.chain.BestSnapshot().Hash },
 		MedianTimePast: func() time.Time { return s.chain.BestSnapshot().MedianTime },
+		Clock:          s.clock,
 		CalcSequenceLock: func(tx *bchutil.Tx, view *blockchain.UtxoViewpoint) (*blockchain.SequenceLock, error) {
 			return s.chain.CalcSequenceLock(tx, view, true)
 		},
-		IsDeploymentActive: s.chain.IsDeploymentActive,
-		SigCache:           s.sigCache,
-		HashCache:          s.hashCache,
-		AddrIndex:          s.addrIndex,
-		FeeEstimator:       s.feeEstimator,
+		IsDeploymentActive:  s.chain.IsDeploymentActive,
+		SigCache:            s.sigCache,
+		HashCache:           s.hashCache,
+		ScriptFlagOverrides: cfg.scriptFlagOverrides,
+		AddrIndex:           s.addrIndex,
+		FeeEstimator:        s.feeEstimator,
+		OnDoubleSpendProof:  s.announceDoubleSpendProof,
+	}
+	if cfg.ExternalPolicy != "" {
+		s.externalPolicy, err = newExternalPolicyClient(cfg.ExternalPolicy,
+			cfg.ExternalPolicyCert, cfg.ExternalPolicyTimeout,
+			cfg.ExternalPolicyFailOpen, txC.BestHeight)
+		if err != nil {
+			return nil, err
+		}
+		txC.ExternalPolicyCheck = s.externalPolicy.CheckTransaction
 	}
 	s.txMemPool = mempool.New(&txC)
 
+	// Create the fork monitor if any nodes are watched.
+	if len(cfg.ForkMonitorNodes) > 0 {
+		s.forkMonitor, err = newForkMonitor(s.chain,
+			cfg.ForkMonitorNodes, cfg.ForkMonitorInterval,
+			int32(cfg.ForkMonitorDepth), cfg.ForkMonitorCA)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Create the cluster gossip if this node is part of a cluster.
+	if len(cfg.ClusterListeners) > 0 || len(cfg.ClusterMembers) > 0 {
+		var listeners []net.Listener
+		for _, addr := range cfg.ClusterListeners {
+			listener, err := net.Listen("tcp", addr)
+			if err != nil {
+				return nil, err
+			}
+			listeners = append(listeners, listener)
+		}
+		s.cluster, err = newClusterGossip(&s, listeners,
+			cfg.ClusterMembers, cfg.ClusterCert, cfg.ClusterKey,
+			cfg.ClusterCA)
+		if err != nil {
+			return nil, err
+		}
+		if s.forkMonitor != nil {
+			s.forkMonitor.onAlert = s.cluster.PublishForkAlert
+		}
+	}
+
+	// Create the recorder of the mempool and block statistics if enabled.
+	if cfg.StatsInterval > 0 {
+		s.statsRecorder, err = newStatsRecorder(db, s.txMemPool, s.chain,
+			cfg.StatsInterval, cfg.StatsHistory,
+			uint64(cfg.ExcessiveBlockSize))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Ignore the fast sync config option if the blockchain is past
 	// the last checkpoint as we can't fast sync from here.
 	if s.chain.LatestCheckpoint() == nil || s.chain.BestSnapshot().Height > s.chain.LatestCheckpoint().Height {
@@ -3326,7 +4135,9 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string, db database
 		ChainParams:             s.chainParams,
 		DisableCheckpoints:      cfg.DisableCheckpoints,
 		MaxPeers:                cfg.MaxPeers,
+		TxWorkers:               runtime.NumCPU(),
 		FeeEstimator:            s.feeEstimator,
+		RejectedTxWindow:        cfg.RejectedTxWindow,
 		MinSyncPeerNetworkSpeed: cfg.MinSyncPeerNetworkSpeed,
 		FastSyncMode:            cfg.FastSync,
 		RegTestSyncAnyHost:      cfg.RegressionTestAnyHost,
@@ -3345,6 +4156,7 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string, db database
 		BlockMaxSize:      cfg.BlockMaxSize,
 		BlockPrioritySize: cfg.BlockPrioritySize,
 		TxMinFreeFee:      cfg.minRelayTxFee,
+		FeeOnly:           cfg.FeeOnlyPolicy,
 	}
 	blockTemplateGenerator := mining.NewBlkTmplGenerator(&policy,
 		s.chainParams, s.txMemPool, s.chain, s.timeSource,
@@ -3368,8 +4180,23 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string, db database
 	var newAddressFunc func() (net.Addr, error)
 	if !cfg.SimNet && !cfg.RegressionTest && len(cfg.ConnectPeers) == 0 {
 		newAddressFunc = func() (net.Addr, error) {
+			preferred := len(cfg.preferNets) != 0
 			for tries := 0; tries < 100; tries++ {
-				addr := s.addrManager.GetAddress()
+				// Only consider addresses on the preferred
+				// network during the first half of the tries as
+				// long as there are any.  Otherwise, consider
+				// all addresses on the allowed networks.
+				if tries == 50 {
+					preferred = false
+				}
+				var addr *addrmgr.KnownAddress
+				if preferred {
+					addr = s.addrManager.GetAddressFrom(cfg.preferNets...)
+					preferred = addr != nil
+				}
+				if addr == nil {
+					addr = s.addrManager.GetAddressFrom(cfg.onlyNets...)
+				}
 				if addr == nil {
 					break
 				}
@@ -3385,6 +4212,15 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string, db database
 					continue
 				}
 
+				// Skip addresses of peers with a poor reputation
+				// from previous connections during the first 40
+				// tries.
+				if tries < 40 && s.peerReputation.isPoor(
+					addrHost(addr.NetAddress())) {
+
+					continue
+				}
+
 				// only allow recent nodes (10mins) after we failed 30
 				// times
 				if tries < 30 && time.Since(addr.LastAttempt()) < 10*time.Minute {
@@ -3408,19 +4244,54 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string, db database
 		}
 	}
 
+	// Only setup a function to return addresses to test with feeler
+	// connections under the same conditions as above.
+	var feelerAddressFunc func() (net.Addr, error)
+	if newAddressFunc != nil {
+		feelerAddressFunc = func() (net.Addr, error) {
+			for tries := 0; tries < 10; tries++ {
+				addr := s.addrManager.GetUntriedAddress(cfg.onlyNets...)
+				if addr == nil {
+					break
+				}
+
+				// Don't test addresses which were attempted
+				// recently.
+				if time.Since(addr.LastAttempt()) < 10*time.Minute {
+					continue
+				}
+
+				// Mark an attempt for the valid address.
+				s.addrManager.Attempt(addr.NetAddress())
+
+				addrString := addrmgr.NetAddressKey(addr.NetAddress())
+				return addrStringToNetAddr(addrString)
+			}
+
+			return nil, errors.New("no valid feeler address")
+		}
+	}
+
 	// Create a connection manager.
 	targetOutbound := cfg.TargetOutboundPeers
 	if cfg.MaxPeers < int(targetOutbound) {
 		targetOutbound = uint32(cfg.MaxPeers)
 	}
+	targetBlockRelay := cfg.BlockRelayPeers
+	if cfg.MaxPeers < int(targetOutbound+targetBlockRelay) {
+		targetBlockRelay = uint32(cfg.MaxPeers) - targetOutbound
+	}
 	cmgr, err := connmgr.New(&connmgr.Config{
-		Listeners:      listeners,
-		OnAccept:       s.inboundPeerConnected,
-		RetryDuration:  connectionRetryInterval,
-		TargetOutbound: targetOutbound,
-		Dial:           bchdDial,
-		OnConnection:   s.outboundPeerConnected,
-		GetNewAddress:  newAddressFunc,
+		Listeners:        listeners,
+		OnAccept:         s.inboundPeerConnected,
+		RetryDuration:    connectionRetryInterval,
+		TargetOutbound:   targetOutbound,
+		TargetBlockRelay: targetBlockRelay,
+		FeelerInterval:   cfg.FeelerInterval,
+		Dial:             bchdDial,
+		OnConnection:     s.outboundPeerConnected,
+		GetNewAddress:    newAddressFunc,
+		GetFeelerAddress: feelerAddressFunc,
 	})
 	if err != nil {
 		return nil, err
@@ -3432,22 +4303,31 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string, db database
 	if len(permanentPeers) == 0 {
 		permanentPeers = cfg.AddPeers
 	}
+	s.primaries = make(map[*connmgr.ConnReq]struct{}, len(cfg.ReplicaOf))
 	for _, addr := range permanentPeers {
 		netAddr, err := addrStringToNetAddr(addr)
 		if err != nil {
 			return nil, err
 		}
 
-		go s.connManager.Connect(&connmgr.ConnReq{
+		req := &connmgr.ConnReq{
 			Addr:      netAddr,
 			Permanent: true,
-		})
+		}
+		if len(cfg.ReplicaOf) > 0 {
+			s.primaries[req] = struct{}{}
+		}
+		go s.connManager.Connect(req)
 	}
 
 	if !cfg.DisableRPC {
-		// Setup listeners for the configured RPC listen addresses and
-		// TLS settings.
-		rpcListeners, err := setupRPCListeners()
+		// Setup the TLS certificate and listeners for the configured
+		// RPC listen addresses and TLS settings.
+		s.certManager, err = setupCertManager()
+		if err != nil {
+			return nil, err
+		}
+		rpcListeners, err := setupRPCListeners(s.certManager)
 		if err != nil {
 			return nil, err
 		}
@@ -3462,6 +4342,7 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string, db database
 			AddrMgr:        amgr,
 			SyncMgr:        &rpcSyncMgr{&s, s.syncManager},
 			TimeSource:     s.timeSource,
+			Clock:          s.clock,
 			Chain:          s.chain,
 			ChainParams:    chainParams,
 			DB:             db,
@@ -3473,6 +4354,8 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string, db database
 			CfIndex:        s.cfIndex,
 			SlpIndex:       s.slpIndex,
 			FeeEstimator:   s.feeEstimator,
+			StatsRecorder:  s.statsRecorder,
+			ForkMonitor:    s.forkMonitor,
 			Services:       s.services,
 			RPCAuthTimeout: cfg.RPCAuthTimeout,
 		})
@@ -3480,21 +4363,32 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string, db database
 			return nil, err
 		}
 
-		gRPCNetAddrs, err := parseListeners(cfg.GrpcListeners)
+		gRPCListeners, err := listenSpecs(cfg.GrpcListeners, net.Listen)
 		if err != nil {
 			return nil, err
 		}
 
-		s.gRPCServer, err = newGrpcServer(gRPCNetAddrs, &bchrpc.GrpcServerConfig{
-			TimeSource:  s.timeSource,
-			Chain:       s.chain,
-			ChainParams: chainParams,
-			DB:          db,
-			TxMemPool:   s.txMemPool,
-			TxIndex:     s.txIndex,
-			AddrIndex:   s.addrIndex,
-			CfIndex:     s.cfIndex,
-			SlpIndex:    s.slpIndex,
+		var bcmrResolver *bchrpc.BcmrResolver
+		if cfg.Bcmr {
+			bcmrResolver = bchrpc.NewBcmrResolver(&bchrpc.BcmrConfig{
+				Timeout:     cfg.BcmrTimeout,
+				CacheTTL:    cfg.BcmrCacheTTL,
+				IPFSGateway: cfg.BcmrIPFSGateway,
+			})
+		}
+
+		s.gRPCServer, err = newGrpcServer(gRPCListeners, &bchrpc.GrpcServerConfig{
+			TimeSource:   s.timeSource,
+			Chain:        s.chain,
+			ChainParams:  chainParams,
+			DB:           db,
+			TxMemPool:    s.txMemPool,
+			TxIndex:      s.txIndex,
+			AddrIndex:    s.addrIndex,
+			CfIndex:      s.cfIndex,
+			SlpIndex:     s.slpIndex,
+			BcmrResolver: bcmrResolver,
+			TemplateFees: s.rpcServer.gbtWorkState.templateFees,
 		}, &s)
 		if err != nil {
 			return nil, err
@@ -3515,21 +4409,11 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string, db database
 // which is non-nil if UPnP is in use.
 func initListeners(amgr *addrmgr.AddrManager, listenAddrs []string, services wire.ServiceFlag) ([]net.Listener, NAT, error) {
 	// Listen for TCP connections at the configured addresses
-	netAddrs, err := parseListeners(listenAddrs)
+	listeners, err := listenSpecs(listenAddrs, net.Listen)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	listeners := make([]net.Listener, 0, len(netAddrs))
-	for _, addr := range netAddrs {
-		listener, err := net.Listen(addr.Network(), addr.String())
-		if err != nil {
-			srvrLog.Warnf("Can't listen on %s: %v", addr, err)
-			continue
-		}
-		listeners = append(listeners, listener)
-	}
-
 	var nat NAT
 	defaultPort, err := strconv.ParseUint(activeNetParams.DefaultPort, 10, 16)
 	if err != nil {
@@ -3653,11 +4537,33 @@ func addrStringToNetAddr(addr string) (net.Addr, error) {
 	}
 
 	return &net.TCPAddr{
-		IP:   ips[0],
+		IP:   selectIP(ips),
 		Port: port,
 	}, nil
 }
 
+// selectIP returns the IP to connect to out of the passed IPs a host resolved
+// to.  For dual-stack hosts, an IP on the preferred network is returned first,
+// followed by an IP on any of the networks allowed for outbound connections.
+// The first IP is returned when none of the IPs are on those networks.
+func selectIP(ips []net.IP) net.IP {
+	for _, nets := range [][]addrmgr.Network{cfg.preferNets, cfg.onlyNets} {
+		for _, ip := range ips {
+			na := wire.NewNetAddressIPPort(ip, 0, 0)
+			if slices.Contains(nets, addrmgr.AddressNetwork(na)) {
+				return ip
+			}
+		}
+	}
+	return ips[0]
+}
+
+// isNetworkLimited returns whether automatic outbound connections to the
+// passed network are disabled by the --onlynet option.
+func isNetworkLimited(n addrmgr.Network) bool {
+	return len(cfg.onlyNets) != 0 && !slices.Contains(cfg.onlyNets, n)
+}
+
 // addLocalAddress adds an address that this node is listening on to the
 // address manager so that it may be relayed to peers.
 func addLocalAddress(addrMgr *addrmgr.AddrManager, addr string, services wire.ServiceFlag) error {
@@ -3726,30 +4632,19 @@ func dynamicTickDuration(remaining time.Duration) time.Duration {
 	return time.Hour
 }
 
-// isWhitelisted returns whether the IP address is included in the whitelisted
-// networks and IPs.
-func isWhitelisted(addr net.Addr) bool {
-	if len(cfg.whitelists) == 0 {
-		return false
-	}
-
-	host, _, err := net.SplitHostPort(addr.String())
-	if err != nil {
-		srvrLog.Warnf("Unable to SplitHostPort on '%s': %v", addr, err)
-		return false
-	}
-	ip := net.ParseIP(host)
-	if ip == nil {
-		srvrLog.Warnf("Unable to parse IP '%s'", addr)
-		return false
-	}
-
-	for _, ipnet := range cfg.whitelists {
-		if ipnet.Contains(ip) {
-			return true
-		}
-	}
-	return false
+// setAgentFilters replaces the user agent blacklist and whitelist used to
+// filter newly connected peers.  Peers which are already connected are not
+// affected.
+//
+// This function is safe for concurrent access.
+func (s *server) setAgentFilters(blacklist, whitelist []string) {
+	reply := make(chan struct{})
+	s.query <- setAgentFiltersMsg{
+		blacklist: blacklist,
+		whitelist: whitelist,
+		reply:     reply,
+	}
+	<-reply
 }
 
 // checkpointSorter implements sort.Interface to allow a slice of checkpoints to
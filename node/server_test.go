@@ -0,0 +1,76 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gcash/bchd/mempool"
+	"github.com/gcash/bchd/mining"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchutil/bloom"
+)
+
+// TestMempoolInvVects ensures the transactions announced in response to a
+// mempool message honor the fee filter, the bloom filter and the maximum
+// number of announcements, keeping the transactions paying the most.
+func TestMempoolInvVects(t *testing.T) {
+	var txDescs []*mempool.TxDesc
+	for i, feePerKB := range []int64{2000, 4000, 1000, 3000} {
+		msgTx := wire.NewMsgTx(wire.TxVersion)
+		msgTx.LockTime = uint32(i)
+		txDescs = append(txDescs, &mempool.TxDesc{
+			TxDesc: mining.TxDesc{
+				Tx:       bchutil.NewTx(msgTx),
+				FeePerKB: feePerKB,
+			},
+		})
+	}
+	invVect := func(i int) *wire.InvVect {
+		return wire.NewInvVect(wire.InvTypeTx, txDescs[i].Tx.Hash())
+	}
+
+	filter := bloom.NewFilter(10, 0, 0.000001, wire.BloomUpdateNone)
+	filter.AddHash(txDescs[3].Tx.Hash())
+
+	tests := []struct {
+		name        string
+		feeFilter   int64
+		filter      *bloom.Filter
+		maxInvVects int
+		want        []*wire.InvVect
+	}{{
+		name:        "all transactions",
+		filter:      bloom.LoadFilter(nil),
+		maxInvVects: 10,
+		want:        []*wire.InvVect{invVect(0), invVect(1), invVect(2), invVect(3)},
+	}, {
+		name:        "fee filter",
+		feeFilter:   2500,
+		filter:      bloom.LoadFilter(nil),
+		maxInvVects: 10,
+		want:        []*wire.InvVect{invVect(1), invVect(3)},
+	}, {
+		name:        "bloom filter",
+		filter:      filter,
+		maxInvVects: 10,
+		want:        []*wire.InvVect{invVect(3)},
+	}, {
+		name:        "highest fee rates",
+		filter:      bloom.LoadFilter(nil),
+		maxInvVects: 3,
+		want:        []*wire.InvVect{invVect(1), invVect(3), invVect(0)},
+	}}
+
+	for _, test := range tests {
+		got := mempoolInvVects(txDescs, test.feeFilter, test.filter,
+			test.maxInvVects)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
@@ -2,7 +2,7 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-package main
+package node
 
 import (
 	"fmt"
@@ -35,7 +35,7 @@ var elog *eventlog.Log
 
 // logServiceStartOfDay logs information about btcd when the main server has
 // been started to the Windows event log.
-func logServiceStartOfDay(srvr *server) {
+func logServiceStartOfDay(n *Node) {
 	var message string
 	message += fmt.Sprintf("Version %s\n", version.String())
 	message += fmt.Sprintf("Configuration directory: %s\n", defaultHomeDir)
@@ -46,33 +46,33 @@ func logServiceStartOfDay(srvr *server) {
 }
 
 // bchdService houses the main service handler which handles all service
-// updates and launching bchdMain.
+// updates and launching Main.
 type bchdService struct{}
 
 // Execute is the main entry point the winsvc package calls when receiving
 // information from the Windows service control manager.  It launches the
-// long-running bchdMain (which is the real meat of bchd), handles service
+// long-running Main (which is the real meat of bchd), handles service
 // change requests, and notifies the service control manager of changes.
 func (s *bchdService) Execute(args []string, r <-chan svc.ChangeRequest, changes chan<- svc.Status) (bool, uint32) {
 	// Service start is pending.
 	const cmdsAccepted = svc.AcceptStop | svc.AcceptShutdown
 	changes <- svc.Status{State: svc.StartPending}
 
-	// Start bchdMain in a separate goroutine so the service can start
+	// Start Main in a separate goroutine so the service can start
 	// quickly.  Shutdown (along with a potential error) is reported via
-	// doneChan.  serverChan is notified with the main server instance once
+	// doneChan.  nodeChan is notified with the main node instance once
 	// it is started so it can be gracefully stopped.
 	doneChan := make(chan error)
-	serverChan := make(chan *server)
+	nodeChan := make(chan *Node)
 	go func() {
-		err := bchdMain(serverChan)
+		err := Main(nodeChan)
 		doneChan <- err
 	}()
 
 	// Service is now started.
 	changes <- svc.Status{State: svc.Running, Accepts: cmdsAccepted}
 
-	var mainServer *server
+	var mainNode *Node
 loop:
 	for {
 		select {
@@ -94,9 +94,9 @@ loop:
 					"request #%d.", c))
 			}
 
-		case srvr := <-serverChan:
-			mainServer = srvr
-			logServiceStartOfDay(mainServer)
+		case n := <-nodeChan:
+			mainNode = n
+			logServiceStartOfDay(mainNode)
 
 		case err := <-doneChan:
 			if err != nil {
@@ -304,5 +304,5 @@ func serviceMain() (bool, error) {
 // Set windows specific functions to real functions.
 func init() {
 	runServiceCommand = performServiceCommand
-	winServiceMain = serviceMain
+	WinServiceMain = serviceMain
 }
@@ -0,0 +1,62 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"github.com/gcash/bchd/wire"
+)
+
+// serviceProvider associates a service bit the server may advertise to its
+// peers with whether the subsystem providing the service is enabled.
+type serviceProvider struct {
+	flag    wire.ServiceFlag
+	enabled func(pruned bool) bool
+}
+
+// serviceProviders lists the service bits the server may advertise.  A bit is
+// only advertised while the subsystem providing it is enabled so peers never
+// request data the server can't serve.  The services the server doesn't
+// implement, such as xthin and graphene, are never advertised.
+var serviceProviders = []serviceProvider{
+	// Full blocks are served unless they are pruned, in which case only
+	// the recent blocks are.
+	{wire.SFNodeNetwork, func(pruned bool) bool { return !pruned }},
+	{wire.SFNodeNetworkLimited, func(pruned bool) bool { return pruned }},
+	{wire.SFNodeBloom, func(bool) bool { return !cfg.NoPeerBloomFilters }},
+	{wire.SFNodeCF, func(bool) bool { return cfIndexEnabled() }},
+	{wire.SFNodeCFExtended, func(bool) bool {
+		return cfIndexEnabled() && cfg.CFExtended
+	}},
+	{wire.SFNodeBitcoinCash, func(bool) bool { return true }},
+}
+
+// cfIndexEnabled returns whether the committed filter index is maintained.
+// The index isn't built while fast syncing since the blocks before the last
+// checkpoint are never downloaded.
+func cfIndexEnabled() bool {
+	return !cfg.FastSync && !cfg.NoCFilters
+}
+
+// cfFilterTypes returns the filter types maintained by the committed filter
+// index.
+func cfFilterTypes() []wire.FilterType {
+	filterTypes := []wire.FilterType{wire.GCSFilterRegular}
+	if cfg.CFExtended {
+		filterTypes = append(filterTypes, wire.GCSFilterExtended)
+	}
+	return filterTypes
+}
+
+// localServices returns the services advertised to peers given the enabled
+// subsystems and whether the blocks of the chain are pruned.
+func localServices(pruned bool) wire.ServiceFlag {
+	var services wire.ServiceFlag
+	for _, provider := range serviceProviders {
+		if provider.enabled(pruned) {
+			services |= provider.flag
+		}
+	}
+	return services
+}
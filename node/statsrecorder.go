@@ -0,0 +1,312 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"encoding/binary"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/database"
+	"github.com/gcash/bchd/mempool"
+)
+
+var (
+	// statsBucketName is the name of the metadata bucket the recorded
+	// samples are stored in.  Each sample is stored under the big endian
+	// index of its slot in the ring buffer.
+	statsBucketName = []byte("mempoolstats")
+
+	// statsFeeRatePercentiles are the percentiles of the mempool fee rates
+	// which are recorded in each sample.  The percentiles are weighted by
+	// the size of the transactions.
+	statsFeeRatePercentiles = [...]int{10, 25, 50, 75, 90}
+)
+
+// statsSampleSize is the size of a serialized stats sample.
+const statsSampleSize = 8 + 8 + 8 + 8 + 8*len(statsFeeRatePercentiles) + 4 + 8 + 8
+
+// statsSample houses a single sample of the mempool and block statistics.
+type statsSample struct {
+	timestamp    time.Time
+	numTxns      int64
+	numBytes     int64
+	totalFee     int64
+	feeRates     [len(statsFeeRatePercentiles)]int64
+	blockHeight  int32
+	blockSize    uint64
+	maxBlockSize uint64
+}
+
+// serialize returns the serialized sample.
+func (s *statsSample) serialize() []byte {
+	b := make([]byte, statsSampleSize)
+	binary.LittleEndian.PutUint64(b[0:], uint64(s.timestamp.Unix()))
+	binary.LittleEndian.PutUint64(b[8:], uint64(s.numTxns))
+	binary.LittleEndian.PutUint64(b[16:], uint64(s.numBytes))
+	binary.LittleEndian.PutUint64(b[24:], uint64(s.totalFee))
+	offset := 32
+	for _, feeRate := range s.feeRates {
+		binary.LittleEndian.PutUint64(b[offset:], uint64(feeRate))
+		offset += 8
+	}
+	binary.LittleEndian.PutUint32(b[offset:], uint32(s.blockHeight))
+	binary.LittleEndian.PutUint64(b[offset+4:], s.blockSize)
+	binary.LittleEndian.PutUint64(b[offset+12:], s.maxBlockSize)
+	return b
+}
+
+// deserialize decodes the passed serialized sample into s.
+func (s *statsSample) deserialize(b []byte) error {
+	if len(b) != statsSampleSize {
+		return errors.New("unexpected serialized stats sample size")
+	}
+	s.timestamp = time.Unix(int64(binary.LittleEndian.Uint64(b[0:])), 0)
+	s.numTxns = int64(binary.LittleEndian.Uint64(b[8:]))
+	s.numBytes = int64(binary.LittleEndian.Uint64(b[16:]))
+	s.totalFee = int64(binary.LittleEndian.Uint64(b[24:]))
+	offset := 32
+	for i := range s.feeRates {
+		s.feeRates[i] = int64(binary.LittleEndian.Uint64(b[offset:]))
+		offset += 8
+	}
+	s.blockHeight = int32(binary.LittleEndian.Uint32(b[offset:]))
+	s.blockSize = binary.LittleEndian.Uint64(b[offset+4:])
+	s.maxBlockSize = binary.LittleEndian.Uint64(b[offset+12:])
+	return nil
+}
+
+// blockFullness returns the size of the best block at the time of the sample
+// relative to the maximum block size.
+func (s *statsSample) blockFullness() float64 {
+	if s.maxBlockSize == 0 {
+		return 0
+	}
+	return float64(s.blockSize) / float64(s.maxBlockSize)
+}
+
+// statsRecorder periodically samples the mempool size, the mempool fee rate
+// percentiles and the fullness of the best block.  The samples are kept in a
+// fixed size ring buffer which is persisted in the database, so the history
+// survives restarts and can be charted without external collectors.
+type statsRecorder struct {
+	db           database.DB
+	txPool       *mempool.TxPool
+	chain        *blockchain.BlockChain
+	interval     time.Duration
+	maxBlockSize uint64
+
+	mtx     sync.RWMutex
+	samples []*statsSample
+	next    int
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// newStatsRecorder returns a new stats recorder which takes a sample every
+// interval and keeps the samples of the passed history duration.  Any
+// previously recorded samples are loaded from the database.
+func newStatsRecorder(db database.DB, txPool *mempool.TxPool,
+	chain *blockchain.BlockChain, interval, history time.Duration,
+	maxBlockSize uint64) (*statsRecorder, error) {
+
+	capacity := int(history / interval)
+	if capacity < 1 {
+		capacity = 1
+	}
+	r := &statsRecorder{
+		db:           db,
+		txPool:       txPool,
+		chain:        chain,
+		interval:     interval,
+		maxBlockSize: maxBlockSize,
+		samples:      make([]*statsSample, 0, capacity),
+		quit:         make(chan struct{}),
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// load reads the previously recorded samples from the database.  Since the
+// capacity of the ring buffer may have changed since they were recorded, only
+// the newest samples that fit are kept and the ring buffer is rewritten in
+// order from the first slot.
+func (r *statsRecorder) load() error {
+	return r.db.Update(func(dbTx database.Tx) error {
+		var samples []*statsSample
+		meta := dbTx.Metadata()
+		if bucket := meta.Bucket(statsBucketName); bucket != nil {
+			err := bucket.ForEach(func(_, v []byte) error {
+				var s statsSample
+				if err := s.deserialize(v); err != nil {
+					return err
+				}
+				samples = append(samples, &s)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			if err := meta.DeleteBucket(statsBucketName); err != nil {
+				return err
+			}
+		}
+
+		sort.Slice(samples, func(i, j int) bool {
+			return samples[i].timestamp.Before(samples[j].timestamp)
+		})
+		if len(samples) > cap(r.samples) {
+			samples = samples[len(samples)-cap(r.samples):]
+		}
+
+		bucket, err := meta.CreateBucket(statsBucketName)
+		if err != nil {
+			return err
+		}
+		for i, s := range samples {
+			if err := bucket.Put(statsSlotKey(i), s.serialize()); err != nil {
+				return err
+			}
+		}
+		r.samples = append(r.samples, samples...)
+		r.next = len(r.samples) % cap(r.samples)
+		return nil
+	})
+}
+
+// statsSlotKey returns the database key of the passed ring buffer slot.
+func statsSlotKey(slot int) []byte {
+	var key [4]byte
+	binary.BigEndian.PutUint32(key[:], uint32(slot))
+	return key[:]
+}
+
+// sample returns a new sample of the current mempool and best block.
+func (r *statsRecorder) sample() *statsSample {
+	best := r.chain.BestSnapshot()
+	s := &statsSample{
+		timestamp:    time.Now(),
+		blockHeight:  best.Height,
+		blockSize:    best.BlockSize,
+		maxBlockSize: r.maxBlockSize,
+	}
+
+	txDescs := r.txPool.TxDescs()
+	s.numTxns = int64(len(txDescs))
+	if len(txDescs) == 0 {
+		return s
+	}
+
+	// Sort the transactions by fee rate so the size weighted percentiles
+	// can be found by walking them in order.
+	sort.Slice(txDescs, func(i, j int) bool {
+		return txDescs[i].FeePerKB < txDescs[j].FeePerKB
+	})
+	sizes := make([]int64, len(txDescs))
+	for i, txD := range txDescs {
+		sizes[i] = int64(txD.Tx.MsgTx().SerializeSize())
+		s.numBytes += sizes[i]
+		s.totalFee += txD.Fee
+	}
+
+	var cumulative int64
+	i := 0
+	for p, percentile := range statsFeeRatePercentiles {
+		threshold := s.numBytes * int64(percentile) / 100
+		for i < len(txDescs)-1 && cumulative+sizes[i] < threshold {
+			cumulative += sizes[i]
+			i++
+		}
+		s.feeRates[p] = txDescs[i].FeePerKB
+	}
+	return s
+}
+
+// record takes a new sample and stores it in the ring buffer.
+func (r *statsRecorder) record() error {
+	return r.add(r.sample())
+}
+
+// add stores the passed sample in the ring buffer, replacing the oldest sample
+// once the ring buffer is full.
+func (r *statsRecorder) add(s *statsSample) error {
+	r.mtx.Lock()
+	slot := r.next
+	if len(r.samples) < cap(r.samples) {
+		r.samples = append(r.samples, s)
+	} else {
+		r.samples[slot] = s
+	}
+	r.next = (slot + 1) % cap(r.samples)
+	r.mtx.Unlock()
+
+	return r.db.Update(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(statsBucketName)
+		return bucket.Put(statsSlotKey(slot), s.serialize())
+	})
+}
+
+// history returns the recorded samples taken since the passed time, ordered
+// from oldest to newest.
+//
+// This function is safe for concurrent access.
+func (r *statsRecorder) history(since time.Time) []*statsSample {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	ordered := r.samples
+	if len(r.samples) == cap(r.samples) {
+		ordered = make([]*statsSample, 0, len(r.samples))
+		ordered = append(ordered, r.samples[r.next:]...)
+		ordered = append(ordered, r.samples[:r.next]...)
+	}
+
+	first := sort.Search(len(ordered), func(i int) bool {
+		return !ordered[i].timestamp.Before(since)
+	})
+	samples := make([]*statsSample, len(ordered)-first)
+	copy(samples, ordered[first:])
+	return samples
+}
+
+// recordHandler takes a sample every interval.  It must be run as a goroutine.
+func (r *statsRecorder) recordHandler() {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+out:
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.record(); err != nil {
+				srvrLog.Errorf("Unable to record mempool stats: %v",
+					err)
+			}
+
+		case <-r.quit:
+			break out
+		}
+	}
+
+	r.wg.Done()
+}
+
+// Start begins recording samples.
+func (r *statsRecorder) Start() {
+	r.wg.Add(1)
+	go r.recordHandler()
+}
+
+// Stop stops recording samples and waits for the recorder to finish.
+func (r *statsRecorder) Stop() {
+	close(r.quit)
+	r.wg.Wait()
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/database"
+	_ "github.com/gcash/bchd/database/ffldb"
+	"github.com/gcash/bchd/wire"
+)
+
+// TestStatsRecorderHistory ensures the stats recorder keeps the newest samples
+// in its ring buffer, returns them in order and reloads them from the
+// database.
+func TestStatsRecorderHistory(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "ffldb")
+	db, err := database.Create("ffldb", dbPath, wire.MainNet)
+	if err != nil {
+		t.Fatalf("unable to create database: %v", err)
+	}
+	defer db.Close()
+
+	// Create a recorder which keeps three samples and add five of them.
+	interval := time.Minute
+	r, err := newStatsRecorder(db, nil, nil, interval, 3*interval, 1000)
+	if err != nil {
+		t.Fatalf("newStatsRecorder: unexpected error: %v", err)
+	}
+	start := time.Unix(1700000000, 0)
+	var samples []*statsSample
+	for i := 0; i < 5; i++ {
+		s := &statsSample{
+			timestamp:    start.Add(time.Duration(i) * interval),
+			numTxns:      int64(i),
+			numBytes:     int64(i * 250),
+			totalFee:     int64(i * 1000),
+			blockHeight:  int32(i),
+			blockSize:    uint64(i * 100),
+			maxBlockSize: 1000,
+		}
+		for p := range s.feeRates {
+			s.feeRates[p] = int64((p + 1) * 1000)
+		}
+		if err := r.add(s); err != nil {
+			t.Fatalf("add: unexpected error: %v", err)
+		}
+		samples = append(samples, s)
+	}
+
+	checkHistory := func(r *statsRecorder, since time.Time, want []*statsSample) {
+		t.Helper()
+		got := r.history(since)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("history: got %d samples, want %d", len(got),
+				len(want))
+		}
+	}
+	checkHistory(r, time.Time{}, samples[2:])
+	checkHistory(r, samples[3].timestamp, samples[3:])
+	checkHistory(r, samples[4].timestamp.Add(time.Second), []*statsSample{})
+
+	if fullness := samples[4].blockFullness(); fullness != 0.4 {
+		t.Fatalf("blockFullness: got %v, want 0.4", fullness)
+	}
+
+	// Reload the samples with a smaller capacity and ensure only the
+	// newest ones are kept.
+	r, err = newStatsRecorder(db, nil, nil, interval, 2*interval, 1000)
+	if err != nil {
+		t.Fatalf("newStatsRecorder: unexpected error: %v", err)
+	}
+	checkHistory(r, time.Time{}, samples[3:])
+}
@@ -0,0 +1,327 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gcash/bchd/blockchain"
+)
+
+const (
+	// procMemInfoPath is the path of the file describing the memory of the
+	// system on Linux.
+	procMemInfoPath = "/proc/meminfo"
+
+	// procSelfCgroupPath is the path of the file listing the cgroups of the
+	// process on Linux.
+	procSelfCgroupPath = "/proc/self/cgroup"
+
+	// cgroupV2Root and cgroupV1MemoryRoot are the paths the cgroup
+	// hierarchies holding the memory controller are mounted at.  The
+	// memory controller of the cgroup of the process and of its ancestors
+	// limits the memory available to the process when it runs in a
+	// container or a systemd slice.
+	cgroupV2Root       = "/sys/fs/cgroup"
+	cgroupV1MemoryRoot = "/sys/fs/cgroup/memory"
+
+	// autoUtxoCacheShare is the share of the memory available to the
+	// process the utxo cache is sized to with --utxocachemaxsize=auto.  The
+	// rest is left to the other caches, the mempool, the blocks being
+	// processed and the overhead of the garbage collector.
+	autoUtxoCacheShare = 4
+
+	// minAutoUtxoCacheSizeMiB and maxAutoUtxoCacheSizeMiB bound the size of
+	// the utxo cache picked with --utxocachemaxsize=auto.
+	minAutoUtxoCacheSizeMiB = 64
+	maxAutoUtxoCacheSizeMiB = 4096
+
+	// memoryPressurePercent is the percentage of the memory available to
+	// the process below which the free memory is considered under pressure
+	// and the utxo cache is shrunk.
+	memoryPressurePercent = 10
+
+	// memoryCheckInterval is the interval at which the memory pressure is
+	// checked with --utxocachemaxsize=auto.
+	memoryCheckInterval = time.Minute
+)
+
+// systemMemory describes the memory available to the process in bytes.
+type systemMemory struct {
+	total     uint64
+	available uint64
+}
+
+// parseMemInfo parses the total and available memory of the system from the
+// passed contents of /proc/meminfo.  The free memory is used as the available
+// memory on kernels which don't estimate the latter.
+func parseMemInfo(r io.Reader) (*systemMemory, error) {
+	var mem systemMemory
+	var free uint64
+	var hasAvailable bool
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		if len(fields) > 2 && fields[2] == "kB" {
+			value *= 1024
+		}
+		switch fields[0] {
+		case "MemTotal:":
+			mem.total = value
+		case "MemFree:":
+			free = value
+		case "MemAvailable:":
+			mem.available = value
+			hasAvailable = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if mem.total == 0 {
+		return nil, errors.New("total memory not reported")
+	}
+	if !hasAvailable {
+		mem.available = free
+	}
+	return &mem, nil
+}
+
+// readCgroupValue reads the memory amount in bytes held by the passed cgroup
+// file.  It returns false when the file doesn't exist or the amount is
+// unlimited.
+func readCgroupValue(path string) (uint64, bool) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return 0, false
+	}
+	value, err := strconv.ParseUint(strings.TrimSpace(string(contents)), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
+
+// parseCgroupPaths parses the paths of the cgroup of the process in the cgroup
+// v2 hierarchy and in the cgroup v1 hierarchy of the memory controller from the
+// passed contents of /proc/self/cgroup.  The paths are empty when the process
+// isn't in such a hierarchy.
+func parseCgroupPaths(r io.Reader) (string, string, error) {
+	var v2Path, v1Path string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		// Each line is made of the hierarchy id, the comma separated
+		// list of controllers and the path of the cgroup.
+		fields := strings.SplitN(scanner.Text(), ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		if fields[0] == "0" && fields[1] == "" {
+			v2Path = fields[2]
+			continue
+		}
+		for _, controller := range strings.Split(fields[1], ",") {
+			if controller == "memory" {
+				v1Path = fields[2]
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", "", err
+	}
+	return v2Path, v1Path, nil
+}
+
+// cgroupDirs returns the directories of the cgroup at the passed path in the
+// hierarchy mounted at the passed root followed by the ones of its ancestors.
+// Only the root is returned when the cgroup isn't found below it, such as when
+// the cgroup namespace of a container mounts its own cgroup as the root.
+func cgroupDirs(root, path string) []string {
+	root = filepath.Clean(root)
+	dir := filepath.Join(root, path)
+	if !strings.HasPrefix(dir, root+string(filepath.Separator)) {
+		return []string{root}
+	}
+	if _, err := os.Stat(dir); err != nil {
+		return []string{root}
+	}
+	var dirs []string
+	for ; dir != root; dir = filepath.Dir(dir) {
+		dirs = append(dirs, dir)
+	}
+	return append(dirs, root)
+}
+
+// readCgroupMemory returns the memory limit and usage in bytes of the cgroup at
+// the passed path in the hierarchy of either cgroup version mounted at the
+// passed roots.  The limit is the lowest one of the cgroup and its ancestors.
+// It returns false when the process isn't limited by a cgroup.
+func readCgroupMemory(v2Root, v2Path, v1Root, v1Path string) (uint64, uint64, bool) {
+	files := []struct {
+		root, path, limit, usage string
+	}{
+		{v2Root, v2Path, "memory.max", "memory.current"},
+		{v1Root, v1Path, "memory.limit_in_bytes", "memory.usage_in_bytes"},
+	}
+	for _, f := range files {
+		dirs := cgroupDirs(f.root, f.path)
+		var limit uint64
+		var limited bool
+		for _, dir := range dirs {
+			value, ok := readCgroupValue(filepath.Join(dir, f.limit))
+			if ok && (!limited || value < limit) {
+				limit = value
+				limited = true
+			}
+		}
+		if !limited {
+			continue
+		}
+		usage, _ := readCgroupValue(filepath.Join(dirs[0], f.usage))
+		return limit, usage, true
+	}
+	return 0, 0, false
+}
+
+// readSystemMemory returns the memory available to the process, which is
+// limited by the memory controller of its cgroup when it runs in a container.
+// It's only supported on Linux.
+func readSystemMemory() (*systemMemory, error) {
+	f, err := os.Open(procMemInfoPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	mem, err := parseMemInfo(f)
+	if err != nil {
+		return nil, err
+	}
+
+	// The cgroups of the process are looked up at the root of the
+	// hierarchies when they can't be read.
+	var v2Path, v1Path string
+	if f, err := os.Open(procSelfCgroupPath); err == nil {
+		v2Path, v1Path, _ = parseCgroupPaths(f)
+		f.Close()
+	}
+
+	// The limit of a cgroup without one is reported as a huge value by
+	// cgroup v1, so it only applies when it's below the system memory.
+	limit, usage, ok := readCgroupMemory(cgroupV2Root, v2Path,
+		cgroupV1MemoryRoot, v1Path)
+	if ok && limit < mem.total {
+		mem.total = limit
+		var available uint64
+		if usage < limit {
+			available = limit - usage
+		}
+		if available < mem.available {
+			mem.available = available
+		}
+	}
+	return mem, nil
+}
+
+// autoUtxoCacheSize returns the size in bytes of the utxo cache picked for the
+// passed memory with --utxocachemaxsize=auto.
+func autoUtxoCacheSize(mem *systemMemory) uint64 {
+	sizeMiB := mem.total / autoUtxoCacheShare / (1024 * 1024)
+	if sizeMiB < minAutoUtxoCacheSizeMiB {
+		sizeMiB = minAutoUtxoCacheSizeMiB
+	}
+	if sizeMiB > maxAutoUtxoCacheSizeMiB {
+		sizeMiB = maxAutoUtxoCacheSizeMiB
+	}
+	return sizeMiB * 1024 * 1024
+}
+
+// shrinkUtxoCacheSize returns the size in bytes the utxo cache of the passed
+// size is shrunk to given the passed memory.  The size is only reduced while
+// the free memory is under pressure, by a quarter each time, and never below
+// the minimum size picked with --utxocachemaxsize=auto.
+func shrinkUtxoCacheSize(size uint64, mem *systemMemory) uint64 {
+	if mem.available*100 >= mem.total*memoryPressurePercent {
+		return size
+	}
+	minSize := uint64(minAutoUtxoCacheSizeMiB * 1024 * 1024)
+	size -= size / 4
+	if size < minSize {
+		size = minSize
+	}
+	return size
+}
+
+// utxoCacheMaxSize returns the maximum size in bytes of the utxo cache
+// configured with --utxocachemaxsize.  The default size is used when the
+// memory can't be detected for --utxocachemaxsize=auto.
+func utxoCacheMaxSize() uint64 {
+	if !cfg.utxoCacheAutoSize {
+		return uint64(cfg.utxoCacheMaxSizeMiB) * 1024 * 1024
+	}
+	mem, err := readSystemMemory()
+	if err != nil {
+		srvrLog.Warnf("Unable to detect the system memory, using the "+
+			"default UTXO cache size of %d MiB: %v",
+			defaultUtxoCacheMaxSizeMiB, err)
+		return defaultUtxoCacheMaxSizeMiB * 1024 * 1024
+	}
+	size := autoUtxoCacheSize(mem)
+	srvrLog.Infof("Sized the UTXO cache to %d MiB for %d MiB of memory",
+		size/(1024*1024), mem.total/(1024*1024))
+	return size
+}
+
+// utxoCacheSizeHandler shrinks the utxo cache whenever the free memory is under
+// pressure so the node isn't killed for running out of memory.  It must be run
+// as a goroutine.
+func (s *server) utxoCacheSizeHandler(size uint64) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(memoryCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			mem, err := readSystemMemory()
+			if err != nil {
+				srvrLog.Debugf("Unable to read the system memory: %v",
+					err)
+				continue
+			}
+			newSize := shrinkUtxoCacheSize(size, mem)
+			if newSize == size {
+				continue
+			}
+			srvrLog.Warnf("Shrinking the UTXO cache from %d MiB to %d "+
+				"MiB since only %d MiB of memory are available",
+				size/(1024*1024), newSize/(1024*1024),
+				mem.available/(1024*1024))
+			size = newSize
+			s.chain.SetUtxoCacheMaxSize(size)
+			err = s.chain.FlushCachedState(blockchain.FlushIfNeeded)
+			if err != nil {
+				srvrLog.Errorf("Unable to flush the UTXO cache: %v",
+					err)
+			}
+
+		case <-s.quit:
+			return
+		}
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestParseMemInfo ensures the memory of the system is parsed from the
+// contents of /proc/meminfo.
+func TestParseMemInfo(t *testing.T) {
+	tests := []struct {
+		name      string
+		contents  string
+		total     uint64
+		available uint64
+	}{
+		{
+			name: "available",
+			contents: "MemTotal:        2048 kB\nMemFree:          512 kB\n" +
+				"MemAvailable:    1024 kB\n",
+			total:     2048 * 1024,
+			available: 1024 * 1024,
+		},
+		{
+			name:      "free",
+			contents:  "MemTotal:        2048 kB\nMemFree:          512 kB\n",
+			total:     2048 * 1024,
+			available: 512 * 1024,
+		},
+	}
+	for _, test := range tests {
+		mem, err := parseMemInfo(strings.NewReader(test.contents))
+		if err != nil {
+			t.Fatalf("%s: parseMemInfo: %v", test.name, err)
+		}
+		if mem.total != test.total || mem.available != test.available {
+			t.Fatalf("%s: got %+v, want total %d available %d",
+				test.name, mem, test.total, test.available)
+		}
+	}
+
+	if _, err := parseMemInfo(strings.NewReader("MemFree: 1 kB\n")); err == nil {
+		t.Fatal("parsed meminfo without the total memory")
+	}
+}
+
+// TestParseCgroupPaths ensures the paths of the cgroups of the process are
+// parsed from the contents of /proc/self/cgroup.
+func TestParseCgroupPaths(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		v2Path   string
+		v1Path   string
+	}{
+		{
+			name:     "v2",
+			contents: "0::/system.slice/bchd.service\n",
+			v2Path:   "/system.slice/bchd.service",
+		},
+		{
+			name: "v1",
+			contents: "12:cpu,cpuacct:/docker/abc\n" +
+				"11:memory:/docker/abc\n1:name=systemd:/docker/abc\n",
+			v1Path: "/docker/abc",
+		},
+		{
+			name:     "hybrid",
+			contents: "5:blkio,memory:/user.slice\n0::/user.slice/session\n",
+			v2Path:   "/user.slice/session",
+			v1Path:   "/user.slice",
+		},
+	}
+	for _, test := range tests {
+		v2Path, v1Path, err := parseCgroupPaths(strings.NewReader(test.contents))
+		if err != nil {
+			t.Fatalf("%s: parseCgroupPaths: %v", test.name, err)
+		}
+		if v2Path != test.v2Path || v1Path != test.v1Path {
+			t.Fatalf("%s: got paths %q %q, want %q %q", test.name,
+				v2Path, v1Path, test.v2Path, test.v1Path)
+		}
+	}
+}
+
+// TestReadCgroupMemory ensures the memory limits of both cgroup versions are
+// read from the cgroup of the process and its ancestors and unlimited cgroups
+// are ignored.
+func TestReadCgroupMemory(t *testing.T) {
+	write := func(dir, name, contents string) {
+		t.Helper()
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			t.Fatalf("MkdirAll: %v", err)
+		}
+		err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0600)
+		if err != nil {
+			t.Fatalf("WriteFile: %v", err)
+		}
+	}
+	v2, v1 := t.TempDir(), t.TempDir()
+
+	if _, _, ok := readCgroupMemory(v2, "", v1, ""); ok {
+		t.Fatal("read the memory of a missing cgroup")
+	}
+
+	write(v1, "memory.limit_in_bytes", "1073741824\n")
+	write(v1, "memory.usage_in_bytes", "536870912\n")
+	limit, usage, ok := readCgroupMemory(v2, "", v1, "/")
+	if !ok || limit != 1<<30 || usage != 1<<29 {
+		t.Fatalf("v1: got %d %d %v", limit, usage, ok)
+	}
+
+	write(v2, "memory.max", "2147483648\n")
+	write(v2, "memory.current", "1073741824\n")
+	limit, usage, ok = readCgroupMemory(v2, "/", v1, "/")
+	if !ok || limit != 1<<31 || usage != 1<<30 {
+		t.Fatalf("v2: got %d %d %v", limit, usage, ok)
+	}
+
+	write(v2, "memory.max", "max\n")
+	limit, _, _ = readCgroupMemory(v2, "", v1, "")
+	if limit != 1<<30 {
+		t.Fatalf("unlimited v2 cgroup: got limit %d", limit)
+	}
+
+	// The limit of the cgroup of the process applies along with the ones
+	// of its ancestors, and the usage is the one of the cgroup.
+	slice := filepath.Join(v2, "system.slice")
+	service := filepath.Join(slice, "bchd.service")
+	write(service, "memory.max", "max\n")
+	write(service, "memory.current", "268435456\n")
+	write(slice, "memory.max", "536870912\n")
+	limit, usage, ok = readCgroupMemory(v2, "/system.slice/bchd.service",
+		v1, "")
+	if !ok || limit != 1<<29 || usage != 1<<28 {
+		t.Fatalf("nested v2: got %d %d %v", limit, usage, ok)
+	}
+	write(service, "memory.max", "134217728\n")
+	limit, _, _ = readCgroupMemory(v2, "/system.slice/bchd.service", v1, "")
+	if limit != 1<<27 {
+		t.Fatalf("nested v2: got limit %d, want %d", limit, 1<<27)
+	}
+
+	// Cgroups missing from the hierarchy, such as the ones outside of the
+	// cgroup namespace of a container, are looked up at the root.
+	write(v2, "memory.max", "2147483648\n")
+	limit, usage, _ = readCgroupMemory(v2, "/docker/abc", v1, "")
+	if limit != 1<<31 || usage != 1<<30 {
+		t.Fatalf("missing v2 cgroup: got %d %d", limit, usage)
+	}
+}
+
+// TestUtxoCacheSize ensures the automatic utxo cache size is bounded and only
+// shrinks under memory pressure.
+func TestUtxoCacheSize(t *testing.T) {
+	const mib = 1024 * 1024
+	tests := []struct {
+		total uint64
+		size  uint64
+	}{
+		{128 * mib, minAutoUtxoCacheSizeMiB * mib},
+		{2048 * mib, 512 * mib},
+		{64 * 1024 * mib, maxAutoUtxoCacheSizeMiB * mib},
+	}
+	for _, test := range tests {
+		size := autoUtxoCacheSize(&systemMemory{total: test.total})
+		if size != test.size {
+			t.Fatalf("total %d: got size %d, want %d", test.total,
+				size, test.size)
+		}
+	}
+
+	mem := &systemMemory{total: 2048 * mib, available: 1024 * mib}
+	if size := shrinkUtxoCacheSize(512*mib, mem); size != 512*mib {
+		t.Fatalf("shrunk to %d without memory pressure", size)
+	}
+	mem.available = 100 * mib
+	if size := shrinkUtxoCacheSize(512*mib, mem); size != 384*mib {
+		t.Fatalf("shrunk to %d, want %d", size, 384*mib)
+	}
+	if size := shrinkUtxoCacheSize(70*mib, mem); size != minAutoUtxoCacheSizeMiB*mib {
+		t.Fatalf("shrunk to %d below the minimum", size)
+	}
+}
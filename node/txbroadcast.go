@@ -0,0 +1,187 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gcash/bchd/btcjson"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+)
+
+const (
+	// localTxAnnouncePeers is the number of random outbound peers a
+	// transaction submitted to this node is announced to.  The remaining
+	// peers learn about the transaction as it propagates through the
+	// network, so their announcements of it serve as evidence of the
+	// propagation.
+	localTxAnnouncePeers = 4
+
+	// maxTrackedLocalTxns is the maximum number of transactions submitted
+	// to this node whose propagation is tracked.  The oldest transaction
+	// is evicted once the limit is reached.
+	maxTrackedLocalTxns = 1000
+
+	// localTxTrackDuration is how long the propagation of a transaction
+	// submitted to this node is tracked.
+	localTxTrackDuration = time.Hour * 24
+)
+
+// localTxBroadcast houses the propagation state of a transaction submitted to
+// this node.
+type localTxBroadcast struct {
+	added       time.Time
+	announced   time.Time
+	announcedTo map[int32]struct{}
+	seenBy      map[int32]struct{}
+	firstSeen   time.Time
+	replacedBy  *chainhash.Hash
+}
+
+// txBroadcastTracker tracks the propagation of the transactions submitted to
+// this node.  The transactions are announced directly to a few outbound peers
+// and every announcement of the same transaction by any other peer is recorded
+// as evidence that it propagated through the network.
+type txBroadcastTracker struct {
+	mtx  sync.Mutex
+	txns map[chainhash.Hash]*localTxBroadcast
+}
+
+// newTxBroadcastTracker returns a new empty transaction broadcast tracker.
+func newTxBroadcastTracker() *txBroadcastTracker {
+	return &txBroadcastTracker{
+		txns: make(map[chainhash.Hash]*localTxBroadcast),
+	}
+}
+
+// track starts tracking the propagation of the passed transaction.  Expired
+// transactions are removed and the oldest transaction is evicted when the
+// tracker is full.
+//
+// This function is safe for concurrent access.
+func (t *txBroadcastTracker) track(txHash *chainhash.Hash) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if _, exists := t.txns[*txHash]; exists {
+		return
+	}
+
+	now := time.Now()
+	var oldestHash chainhash.Hash
+	var oldest *localTxBroadcast
+	for hash, tx := range t.txns {
+		if now.Sub(tx.added) > localTxTrackDuration {
+			delete(t.txns, hash)
+			continue
+		}
+		if oldest == nil || tx.added.Before(oldest.added) {
+			oldestHash, oldest = hash, tx
+		}
+	}
+	if len(t.txns) >= maxTrackedLocalTxns && oldest != nil {
+		delete(t.txns, oldestHash)
+	}
+
+	t.txns[*txHash] = &localTxBroadcast{
+		added:       now,
+		announcedTo: make(map[int32]struct{}),
+		seenBy:      make(map[int32]struct{}),
+	}
+}
+
+// announced records that the passed transaction was announced to the peers
+// with the passed ids.
+//
+// This function is safe for concurrent access.
+func (t *txBroadcastTracker) announced(txHash *chainhash.Hash, peerIDs []int32) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	tx, exists := t.txns[*txHash]
+	if !exists {
+		return
+	}
+	tx.announced = time.Now()
+	for _, id := range peerIDs {
+		tx.announcedTo[id] = struct{}{}
+	}
+}
+
+// seen records the tracked transactions in the passed inventory announced by
+// the peer with the passed id.  Announcements by the peers the transaction was
+// announced to are ignored since they don't show that it propagated further.
+//
+// This function is safe for concurrent access.
+func (t *txBroadcastTracker) seen(peerID int32, invList []*wire.InvVect) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if len(t.txns) == 0 {
+		return
+	}
+	for _, iv := range invList {
+		if iv.Type != wire.InvTypeTx {
+			continue
+		}
+		tx, exists := t.txns[iv.Hash]
+		if !exists {
+			continue
+		}
+		if _, exists := tx.announcedTo[peerID]; exists {
+			continue
+		}
+		if len(tx.seenBy) == 0 {
+			tx.firstSeen = time.Now()
+		}
+		tx.seenBy[peerID] = struct{}{}
+	}
+}
+
+// replaced records that the passed transaction was replaced by the passed
+// conflicting transaction which was included in a block.
+//
+// This function is safe for concurrent access.
+func (t *txBroadcastTracker) replaced(txHash, replacement *chainhash.Hash) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	tx, exists := t.txns[*txHash]
+	if !exists {
+		return
+	}
+	tx.replacedBy = replacement
+}
+
+// status returns the propagation state of the passed transaction or nil when
+// it is not tracked.
+//
+// This function is safe for concurrent access.
+func (t *txBroadcastTracker) status(txHash *chainhash.Hash) *btcjson.GetTxBroadcastStatusResult {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	tx, exists := t.txns[*txHash]
+	if !exists {
+		return nil
+	}
+	result := &btcjson.GetTxBroadcastStatusResult{
+		TxID:        txHash.String(),
+		AnnouncedTo: len(tx.announcedTo),
+		SeenBy:      len(tx.seenBy),
+	}
+	if !tx.announced.IsZero() {
+		result.Time = tx.announced.Unix()
+	}
+	if !tx.firstSeen.IsZero() {
+		result.FirstSeen = tx.firstSeen.Unix()
+	}
+	if tx.replacedBy != nil {
+		result.ReplacedBy = tx.replacedBy.String()
+	}
+	return result
+}
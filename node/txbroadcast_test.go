@@ -0,0 +1,51 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+)
+
+// TestTxBroadcastTracker ensures announcements of tracked transactions by
+// peers other than the ones they were announced to are counted as propagation
+// evidence.
+func TestTxBroadcastTracker(t *testing.T) {
+	tracker := newTxBroadcastTracker()
+	tracked := chainhash.Hash{0x01}
+	untracked := chainhash.Hash{0x02}
+
+	tracker.track(&tracked)
+	tracker.announced(&tracked, []int32{1, 2})
+
+	invList := []*wire.InvVect{
+		wire.NewInvVect(wire.InvTypeTx, &tracked),
+		wire.NewInvVect(wire.InvTypeTx, &untracked),
+	}
+	tracker.seen(1, invList)
+	tracker.seen(3, invList)
+	tracker.seen(3, invList)
+	tracker.seen(4, []*wire.InvVect{wire.NewInvVect(wire.InvTypeBlock, &tracked)})
+	tracker.seen(5, invList)
+
+	status := tracker.status(&tracked)
+	if status == nil {
+		t.Fatal("status: transaction is not tracked")
+	}
+	if status.AnnouncedTo != 2 || status.SeenBy != 2 {
+		t.Fatalf("status: got announced to %d and seen by %d, want 2 "+
+			"and 2", status.AnnouncedTo, status.SeenBy)
+	}
+	if status.Time == 0 || status.FirstSeen == 0 {
+		t.Fatalf("status: got time %d and first seen %d, want both set",
+			status.Time, status.FirstSeen)
+	}
+	if status := tracker.status(&untracked); status != nil {
+		t.Fatalf("status: got %v for untracked transaction, want nil",
+			status)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/btcjson"
+)
+
+// The following constants describe the state of the last chain verification
+// started with the verifychain RPC.
+const (
+	verifyStatusNone      = "none"
+	verifyStatusRunning   = "running"
+	verifyStatusSucceeded = "succeeded"
+	verifyStatusFailed    = "failed"
+	verifyStatusCancelled = "cancelled"
+)
+
+// errVerifyChainRunning is returned when a chain verification is requested
+// while another one is still running.
+var errVerifyChainRunning = errors.New("a chain verification is already " +
+	"running")
+
+// verifyChainJob houses a chain verification along with its progress and its
+// outcome once it finished.
+type verifyChainJob struct {
+	level      blockchain.VerifyLevel
+	depth      int32
+	background bool
+
+	startTime time.Time
+	endTime   time.Time
+	progress  blockchain.VerifyChainProgress
+	err       error
+
+	// interrupt is closed to cancel the verification and done is closed
+	// once it finished.
+	interrupt chan struct{}
+	cancelled bool
+	done      chan struct{}
+}
+
+// chainVerifier runs the chain verifications requested with the verifychain
+// RPC one at a time and keeps the state of the last one, so its progress can
+// be queried and it can be cancelled while it runs.
+type chainVerifier struct {
+	chain *blockchain.BlockChain
+
+	mtx sync.Mutex
+	job *verifyChainJob
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newChainVerifier returns a new chain verifier for the passed chain.
+func newChainVerifier(chain *blockchain.BlockChain) *chainVerifier {
+	return &chainVerifier{
+		chain: chain,
+		quit:  make(chan struct{}),
+	}
+}
+
+// start starts verifying the passed number of blocks back from the tip of the
+// main chain at the passed level.  Background verifications are throttled so
+// they leave most of the resources of the node to its other tasks.  It returns
+// the started job, whose done channel is closed once it finished.
+func (v *chainVerifier) start(level blockchain.VerifyLevel, depth int32, background bool) (*verifyChainJob, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	select {
+	case <-v.quit:
+		return nil, errors.New("the server is shutting down")
+	default:
+	}
+	if v.job != nil && v.job.endTime.IsZero() {
+		return nil, errVerifyChainRunning
+	}
+
+	job := &verifyChainJob{
+		level:      level,
+		depth:      depth,
+		background: background,
+		startTime:  time.Now(),
+		interrupt:  make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	v.job = job
+
+	v.wg.Add(1)
+	go v.run(job)
+	return job, nil
+}
+
+// run performs the passed verification job.
+//
+// This function MUST be run as a goroutine.
+func (v *chainVerifier) run(job *verifyChainJob) {
+	defer v.wg.Done()
+
+	// Cancel the verification when the server shuts down.
+	interrupt := make(chan struct{})
+	go func() {
+		select {
+		case <-job.interrupt:
+		case <-v.quit:
+		case <-job.done:
+			return
+		}
+		close(interrupt)
+	}()
+
+	err := v.chain.VerifyChain(&blockchain.VerifyChainOptions{
+		Level:    job.level,
+		Depth:    job.depth,
+		Throttle: job.background,
+		Progress: func(progress blockchain.VerifyChainProgress) {
+			v.mtx.Lock()
+			job.progress = progress
+			v.mtx.Unlock()
+		},
+	}, interrupt)
+	if err != nil && err != blockchain.ErrVerifyInterrupted {
+		rpcsLog.Errorf("Chain verification failed: %v", err)
+	}
+
+	v.mtx.Lock()
+	job.err = err
+	job.endTime = time.Now()
+	v.mtx.Unlock()
+	close(job.done)
+}
+
+// cancel cancels the running verification, if any, and returns whether one
+// was running.  It does not wait for the verification to stop.
+func (v *chainVerifier) cancel() bool {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	job := v.job
+	if job == nil || !job.endTime.IsZero() || job.cancelled {
+		return false
+	}
+	job.cancelled = true
+	close(job.interrupt)
+	return true
+}
+
+// result returns the error the passed job finished with.  It must only be
+// called once the job is done.
+func (v *chainVerifier) result(job *verifyChainJob) error {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	return job.err
+}
+
+// info returns the state of the last verification as returned by the
+// getverifychaininfo RPC.
+func (v *chainVerifier) info() *btcjson.GetVerifyChainInfoResult {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	job := v.job
+	if job == nil {
+		return &btcjson.GetVerifyChainInfoResult{Status: verifyStatusNone}
+	}
+
+	result := &btcjson.GetVerifyChainInfoResult{
+		CheckLevel: int32(job.level),
+		CheckDepth: job.depth,
+		Background: job.background,
+		Height:     job.progress.Height,
+		Verified:   job.progress.Verified,
+		Total:      job.progress.Total,
+		StartTime:  job.startTime.Unix(),
+	}
+	if job.progress.Total > 0 {
+		result.Progress = float64(job.progress.Verified) /
+			float64(job.progress.Total)
+	}
+	switch {
+	case job.endTime.IsZero():
+		result.Status = verifyStatusRunning
+	case job.err == blockchain.ErrVerifyInterrupted:
+		result.Status = verifyStatusCancelled
+	case job.err != nil:
+		result.Status = verifyStatusFailed
+		result.Error = job.err.Error()
+	default:
+		result.Status = verifyStatusSucceeded
+		result.Progress = 1
+	}
+	if !job.endTime.IsZero() {
+		result.EndTime = job.endTime.Unix()
+	}
+	return result
+}
+
+// shutdown cancels the running verification, if any, and waits for it to
+// stop.  No verifications can be started afterwards.
+func (v *chainVerifier) shutdown() {
+	v.mtx.Lock()
+	select {
+	case <-v.quit:
+	default:
+		close(v.quit)
+	}
+	v.mtx.Unlock()
+	v.wg.Wait()
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package node
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/database"
+	_ "github.com/gcash/bchd/database/ffldb"
+	"github.com/gcash/bchd/txscript"
+)
+
+// TestChainVerifier ensures the chain verifier reports the state of the last
+// verification and refuses to start verifications once it was shut down.
+func TestChainVerifier(t *testing.T) {
+	params := chaincfg.RegressionNetParams
+	dbPath := filepath.Join(t.TempDir(), "ffldb")
+	db, err := database.Create("ffldb", dbPath, params.Net)
+	if err != nil {
+		t.Fatalf("unable to create database: %v", err)
+	}
+	defer db.Close()
+
+	chain, err := blockchain.New(&blockchain.Config{
+		DB:                 db,
+		ChainParams:        &params,
+		TimeSource:         blockchain.NewMedianTime(),
+		SigCache:           txscript.NewSigCache(1000),
+		UtxoCacheMaxSize:   1024 * 1024,
+		ExcessiveBlockSize: 32000000,
+	})
+	if err != nil {
+		t.Fatalf("unable to create chain: %v", err)
+	}
+
+	v := newChainVerifier(chain)
+	if info := v.info(); info.Status != verifyStatusNone {
+		t.Errorf("got status %q before any verification, want %q",
+			info.Status, verifyStatusNone)
+	}
+
+	job, err := v.start(blockchain.VerifyScripts, 0, true)
+	if err != nil {
+		t.Fatalf("start: unexpected error: %v", err)
+	}
+	<-job.done
+	if err := v.result(job); err != nil {
+		t.Errorf("verification failed: %v", err)
+	}
+	info := v.info()
+	if info.Status != verifyStatusSucceeded || info.Progress != 1 ||
+		info.CheckLevel != int32(blockchain.VerifyScripts) ||
+		!info.Background || info.EndTime == 0 {
+
+		t.Errorf("got unexpected state %+v of a finished verification",
+			info)
+	}
+	if v.cancel() {
+		t.Errorf("cancel reported a finished verification as running")
+	}
+
+	v.shutdown()
+	if _, err := v.start(blockchain.VerifySanity, 0, false); err == nil {
+		t.Errorf("start succeeded after shutdown")
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package peer
+
+import (
+	"sync"
+)
+
+// nonceSet provides a concurrency safe set of nonces without a size limit.
+type nonceSet struct {
+	mtx    sync.Mutex
+	nonces map[uint64]struct{}
+}
+
+// newNonceSet returns a new empty nonce set.
+func newNonceSet() *nonceSet {
+	return &nonceSet{nonces: make(map[uint64]struct{})}
+}
+
+// Exists returns whether or not the passed nonce is in the set.
+//
+// This function is safe for concurrent access.
+func (s *nonceSet) Exists(nonce uint64) bool {
+	s.mtx.Lock()
+	_, exists := s.nonces[nonce]
+	s.mtx.Unlock()
+	return exists
+}
+
+// Add adds the passed nonce to the set.
+//
+// This function is safe for concurrent access.
+func (s *nonceSet) Add(nonce uint64) {
+	s.mtx.Lock()
+	s.nonces[nonce] = struct{}{}
+	s.mtx.Unlock()
+}
+
+// Delete removes the passed nonce from the set if it exists.
+//
+// This function is safe for concurrent access.
+func (s *nonceSet) Delete(nonce uint64) {
+	s.mtx.Lock()
+	delete(s.nonces, nonce)
+	s.mtx.Unlock()
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package peer
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg"
+)
+
+// TestSelfConnectionNonces ensures the nonce sent to a peer whose protocol
+// negotiation is in progress is detected as a self connection even once it is
+// evicted from the recently sent nonces.
+func TestSelfConnectionNonces(t *testing.T) {
+	p, err := NewOutboundPeer(&Config{ChainParams: &chaincfg.MainNetParams},
+		"10.0.0.1:8333")
+	if err != nil {
+		t.Fatalf("NewOutboundPeer: %v", err)
+	}
+	msg, err := p.localVersionMsg()
+	if err != nil {
+		t.Fatalf("localVersionMsg: %v", err)
+	}
+	defer pendingNonces.Delete(msg.Nonce)
+
+	// Evict the nonce from the recently sent nonces.
+	for i := uint(1); i <= sentNonces.limit; i++ {
+		sentNonces.Add(msg.Nonce + uint64(i))
+	}
+	if sentNonces.Exists(msg.Nonce) {
+		t.Fatal("nonce was not evicted from the recently sent nonces")
+	}
+	if !isSelfConnection(msg.Nonce) {
+		t.Fatal("pending nonce not detected as a self connection")
+	}
+
+	pendingNonces.Delete(msg.Nonce)
+	if isSelfConnection(msg.Nonce) {
+		t.Fatal("nonce detected as a self connection once forgotten")
+	}
+}
@@ -84,6 +84,13 @@ var (
 	// sentNonces houses the unique nonces that are generated when pushing
 	// version messages that are used to detect self connections.
 	sentNonces = newMruNonceMap(50)
+
+	// pendingNonces houses the nonces of the version messages sent to the
+	// peers whose protocol negotiation is still in progress.  Unlike the
+	// recently sent nonces they are never evicted, so self connections are
+	// detected regardless of how many connections are being negotiated at
+	// once across all of the listeners.
+	pendingNonces = newNonceSet()
 )
 
 // MessageListeners defines callback function pointers to invoke with message
@@ -217,6 +224,17 @@ type MessageListeners struct {
 	// message.
 	OnBlockTxns func(p *Peer, msg *wire.MsgBlockTxns)
 
+	// OnDSProofBeta is invoked when a peer receives a dsproof-beta bitcoin
+	// message.
+	OnDSProofBeta func(p *Peer, msg *wire.MsgDSProofBeta)
+
+	// OnCheckMessage is invoked when a peer receives a bitcoin message
+	// after the handshake, before the message is handled.  The caller may
+	// return an error in which case the message is not handled, a reject
+	// message with the error is sent to the peer and the peer will be
+	// disconnected.
+	OnCheckMessage func(p *Peer, msg wire.Message) error
+
 	// OnRead is invoked when a peer receives a bitcoin message.  It
 	// consists of the number of bytes read, the message, and whether or not
 	// an error in the read occurred.  Typically, callers will opt to use
@@ -296,6 +314,12 @@ type Config struct {
 	// inventory to a peer.
 	TrickleInterval time.Duration
 
+	// Clock provides the current time and the tickers used to ping the
+	// peer, trickle the inventory, track the activity of the peer and
+	// detect stalled responses.  This field can be omitted in which case
+	// the system clock will be used.
+	Clock blockchain.Clock
+
 	// TstAllowSelfConnection is only used to allow the tests to bypass the self
 	// connection detecting and disconnect logic since they intentionally
 	// do so for testing purposes.
@@ -472,6 +496,10 @@ type Peer struct {
 
 	wireEncoding wire.MessageEncoding
 
+	// versionNonce is the nonce of the version message sent to the peer.  It
+	// is only accessed by the goroutine negotiating the protocol.
+	versionNonce uint64
+
 	knownInventory     *mruInventoryMap
 	prevGetBlocksMtx   sync.Mutex
 	prevGetBlocksBegin *chainhash.Hash
@@ -1116,7 +1144,7 @@ func (p *Peer) handlePongMsg(msg *wire.MsgPong) {
 	if p.ProtocolVersion() > wire.BIP0031Version {
 		p.statsMtx.Lock()
 		if p.lastPingNonce != 0 && msg.Nonce == p.lastPingNonce {
-			p.lastPingMicros = time.Since(p.lastPingTime).Nanoseconds()
+			p.lastPingMicros = p.cfg.Clock.Now().Sub(p.lastPingTime).Nanoseconds()
 			p.lastPingMicros /= 1000 // convert to usec.
 			p.lastPingNonce = 0
 		}
@@ -1258,7 +1286,7 @@ func (p *Peer) maybeAddDeadline(pendingResponses map[string]time.Time, msgCmd st
 	// sent asynchronously and as a result of a long backlock of messages,
 	// such as is typical in the case of initial block download, the
 	// response won't be received in time.
-	deadline := time.Now().Add(stallResponseTimeout)
+	deadline := p.cfg.Clock.Now().Add(stallResponseTimeout)
 	switch msgCmd {
 	case wire.CmdVersion:
 		// Expects a verack message.
@@ -1292,7 +1320,7 @@ func (p *Peer) maybeAddDeadline(pendingResponses map[string]time.Time, msgCmd st
 		// Expects a headers message.  Use a longer deadline since it
 		// can take a while for the remote peer to load all of the
 		// headers.
-		deadline = time.Now().Add(stallResponseTimeout * 3)
+		deadline = p.cfg.Clock.Now().Add(stallResponseTimeout * 3)
 		pendingResponses[wire.CmdHeaders] = deadline
 	}
 }
@@ -1316,7 +1344,7 @@ func (p *Peer) stallHandler() {
 	// stallTicker is used to periodically check pending responses that have
 	// exceeded the expected deadline and disconnect the peer due to
 	// stalling.
-	stallTicker := time.NewTicker(stallTickInterval)
+	stallTicker := p.cfg.Clock.NewTicker(stallTickInterval)
 	defer stallTicker.Stop()
 
 	// ioStopped is used to detect when both the input and output handler
@@ -1367,7 +1395,7 @@ out:
 				}
 
 				handlerActive = true
-				handlersStartTime = time.Now()
+				handlersStartTime = p.cfg.Clock.Now()
 
 			case sccHandlerDone:
 				// Warn on unbalanced callback signalling.
@@ -1380,7 +1408,7 @@ out:
 
 				// Extend active deadlines by the time it took
 				// to execute the callback.
-				duration := time.Since(handlersStartTime)
+				duration := p.cfg.Clock.Now().Sub(handlersStartTime)
 				deadlineOffset += duration
 				handlerActive = false
 
@@ -1389,11 +1417,11 @@ out:
 					msg.command)
 			}
 
-		case <-stallTicker.C:
+		case <-stallTicker.Chan():
 			// Calculate the offset to apply to the deadline based
 			// on how long the handlers have taken to execute since
 			// the last tick.
-			now := time.Now()
+			now := p.cfg.Clock.Now()
 			offset := deadlineOffset
 			if handlerActive {
 				offset += now.Sub(handlersStartTime)
@@ -1508,9 +1536,19 @@ out:
 			}
 			break out
 		}
-		atomic.StoreInt64(&p.lastRecv, time.Now().Unix())
+		atomic.StoreInt64(&p.lastRecv, p.cfg.Clock.Now().Unix())
 		p.stallControl <- stallControlMsg{sccReceiveMessage, rmsg}
 
+		if p.cfg.Listeners.OnCheckMessage != nil {
+			if err := p.cfg.Listeners.OnCheckMessage(p, rmsg); err != nil {
+				log.Debugf("Rejected %s message from %s: %v",
+					rmsg.Command(), p, err)
+				p.PushRejectMsg(rmsg.Command(), wire.RejectInvalid,
+					err.Error(), nil, true)
+				break out
+			}
+		}
+
 		// Handle each supported message type.
 		p.stallControl <- stallControlMsg{sccHandlerStart, rmsg}
 		switch msg := rmsg.(type) {
@@ -1705,6 +1743,11 @@ out:
 				p.cfg.Listeners.OnBlockTxns(p, msg)
 			}
 
+		case *wire.MsgDSProofBeta:
+			if p.cfg.Listeners.OnDSProofBeta != nil {
+				p.cfg.Listeners.OnDSProofBeta(p, msg)
+			}
+
 		default:
 			log.Debugf("Received unhandled message of type %v "+
 				"from %v", rmsg.Command(), p)
@@ -1733,16 +1776,15 @@ func (p *Peer) queueHandler() {
 	pendingMsgs := list.New()
 	invSendQueue := list.New()
 	useTrickleQueue := p.cfg.TrickleInterval > 0
-	var trickleTicker *time.Ticker
+	var trickleChan <-chan time.Time
 
-	// If the trickle interval is 0 we create an unstarted Ticker. This allows
-	// selecting on it without it ever firing. If the trickle interval is
-	// greater than 0 the ticker and trickle queue are used normally.
+	// If the trickle interval is 0 the trickle channel is left nil. This
+	// allows selecting on it without it ever firing. If the trickle interval
+	// is greater than 0 the ticker and trickle queue are used normally.
 	if useTrickleQueue {
-		trickleTicker = time.NewTicker(p.cfg.TrickleInterval)
+		trickleTicker := p.cfg.Clock.NewTicker(p.cfg.TrickleInterval)
 		defer trickleTicker.Stop()
-	} else {
-		trickleTicker = &time.Ticker{C: make(chan (time.Time))}
+		trickleChan = trickleTicker.Chan()
 	}
 
 	// We keep the waiting flag so that we know if we have a message queued
@@ -1818,7 +1860,7 @@ out:
 			invMsg.AddInvVect(iv)
 			waiting = queuePacket(outMsg{msg: invMsg}, pendingMsgs, waiting)
 
-		case <-trickleTicker.C:
+		case <-trickleChan:
 			// Don't send anything if we're disconnecting or there
 			// is no queued inventory.
 			// version is known if send queue has any entries.
@@ -1923,7 +1965,7 @@ out:
 				if p.ProtocolVersion() > wire.BIP0031Version {
 					p.statsMtx.Lock()
 					p.lastPingNonce = m.Nonce
-					p.lastPingTime = time.Now()
+					p.lastPingTime = p.cfg.Clock.Now()
 					p.statsMtx.Unlock()
 				}
 			}
@@ -1948,7 +1990,7 @@ out:
 			// message that it has been sent (if requested), and
 			// signal the send queue to the deliver the next queued
 			// message.
-			atomic.StoreInt64(&p.lastSend, time.Now().Unix())
+			atomic.StoreInt64(&p.lastSend, p.cfg.Clock.Now().Unix())
 			if msg.doneChan != nil {
 				msg.doneChan <- struct{}{}
 			}
@@ -1983,13 +2025,13 @@ cleanup:
 
 // pingHandler periodically pings the peer.  It must be run as a goroutine.
 func (p *Peer) pingHandler() {
-	pingTicker := time.NewTicker(pingInterval)
+	pingTicker := p.cfg.Clock.NewTicker(pingInterval)
 	defer pingTicker.Stop()
 
 out:
 	for {
 		select {
-		case <-pingTicker.C:
+		case <-pingTicker.Chan():
 			nonce, err := wire.RandomUint64()
 			if err != nil {
 				log.Errorf("Not sending ping to %s: %v", p, err)
@@ -2078,6 +2120,13 @@ func (p *Peer) Disconnect() {
 	close(p.quit)
 }
 
+// isSelfConnection returns whether the passed nonce of a received version
+// message is the nonce of a version message sent by any of the peers, which
+// means the connection loops back to this process.
+func isSelfConnection(nonce uint64) bool {
+	return sentNonces.Exists(nonce) || pendingNonces.Exists(nonce)
+}
+
 // readRemoteVersionMsg waits for the next message to arrive from the remote
 // peer.  If the next message is not a version message or the version is not
 // acceptable then return an error.
@@ -2100,7 +2149,7 @@ func (p *Peer) readRemoteVersionMsg() error {
 	}
 
 	// Detect self connections.
-	if !p.cfg.TstAllowSelfConnection && sentNonces.Exists(msg.Nonce) {
+	if !p.cfg.TstAllowSelfConnection && isSelfConnection(msg.Nonce) {
 		return errors.New("disconnecting peer connected to self")
 	}
 
@@ -2120,7 +2169,7 @@ func (p *Peer) readRemoteVersionMsg() error {
 	p.statsMtx.Lock()
 	p.lastBlock = msg.LastBlock
 	p.startingHeight = msg.LastBlock
-	p.timeOffset = msg.Timestamp.Unix() - time.Now().Unix()
+	p.timeOffset = msg.Timestamp.Unix() - p.cfg.Clock.Now().Unix()
 	p.statsMtx.Unlock()
 
 	// Set the peer's ID, user agent, and potentially the flag which
@@ -2235,6 +2284,8 @@ func (p *Peer) localVersionMsg() (*wire.MsgVersion, error) {
 	// recently seen nonces.
 	nonce := uint64(rand.Int63())
 	sentNonces.Add(nonce)
+	pendingNonces.Add(nonce)
+	p.versionNonce = nonce
 
 	// Create a wire.NetAddress to use as "addrme" in the
 	// version message.
@@ -2250,12 +2301,13 @@ func (p *Peer) localVersionMsg() (*wire.MsgVersion, error) {
 	} else {
 		ourNA = &wire.NetAddress{
 			Services:  p.cfg.Services,
-			Timestamp: time.Now(),
+			Timestamp: p.cfg.Clock.Now(),
 		}
 	}
 
 	// Version message.
 	msg := wire.NewMsgVersion(ourNA, theirNA, nonce, blockNum)
+	msg.Timestamp = time.Unix(p.cfg.Clock.Now().Unix(), 0)
 	msg.AddUserAgent(p.cfg.UserAgentName, p.cfg.UserAgentVersion,
 		p.cfg.UserAgentComments...)
 
@@ -2336,11 +2388,19 @@ func (p *Peer) start() error {
 
 	negotiateErr := make(chan error, 1)
 	go func() {
+		var err error
 		if p.inbound {
-			negotiateErr <- p.negotiateInboundProtocol()
+			err = p.negotiateInboundProtocol()
 		} else {
-			negotiateErr <- p.negotiateOutboundProtocol()
+			err = p.negotiateOutboundProtocol()
+		}
+
+		// The nonce sent is only remembered among the recently sent
+		// nonces once the negotiation is over.
+		if p.versionNonce != 0 {
+			pendingNonces.Delete(p.versionNonce)
 		}
+		negotiateErr <- err
 	}()
 
 	// Negotiate the protocol within the specified negotiateTimeout.
@@ -2376,7 +2436,7 @@ func (p *Peer) AssociateConnection(conn net.Conn) {
 	}
 
 	p.conn = conn
-	p.timeConnected = time.Now()
+	p.timeConnected = p.cfg.Clock.Now()
 
 	if p.inbound {
 		p.addr = p.conn.RemoteAddr().String()
@@ -2430,6 +2490,11 @@ func newPeerBase(origCfg *Config, inbound bool) *Peer {
 		cfg.TrickleInterval = DefaultTrickleInterval
 	}
 
+	// Use the system clock if the caller did not specify a clock.
+	if cfg.Clock == nil {
+		cfg.Clock = blockchain.SystemClock
+	}
+
 	// If zero was set then we'll use the default.
 	if cfg.MaxKnownInventory == 0 {
 		cfg.MaxKnownInventory = DefaultMaxKnownInventory
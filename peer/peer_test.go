@@ -446,6 +446,9 @@ func TestPeerListeners(t *testing.T) {
 			OnSendHeaders: func(_ *peer.Peer, msg *wire.MsgSendHeaders) {
 				ok <- msg
 			},
+			OnDSProofBeta: func(_ *peer.Peer, msg *wire.MsgDSProofBeta) {
+				ok <- msg
+			},
 		},
 		UserAgentName:          "peer",
 		UserAgentVersion:       "1.0",
@@ -592,6 +595,11 @@ func TestPeerListeners(t *testing.T) {
 			"OnSendHeaders",
 			wire.NewMsgSendHeaders(),
 		},
+		{
+			"OnDSProofBeta",
+			wire.NewMsgDSProofBeta(wire.OutPoint{},
+				&wire.DSProofSpender{}, &wire.DSProofSpender{}),
+		},
 	}
 	t.Logf("Running %d tests", len(tests))
 	for _, test := range tests {
@@ -918,6 +926,78 @@ func TestDuplicateVersionMsg(t *testing.T) {
 	}
 }
 
+// TestCheckMessage ensures messages rejected by the OnCheckMessage listener
+// are not handled and the peer sending them is disconnected.
+func TestCheckMessage(t *testing.T) {
+	verack := make(chan struct{})
+	handled := make(chan struct{}, 1)
+	peerCfg := &peer.Config{
+		Listeners: peer.MessageListeners{
+			OnVerAck: func(_ *peer.Peer, _ *wire.MsgVerAck) {
+				verack <- struct{}{}
+			},
+			OnInv: func(_ *peer.Peer, _ *wire.MsgInv) {
+				handled <- struct{}{}
+			},
+			OnCheckMessage: func(_ *peer.Peer, msg wire.Message) error {
+				if _, ok := msg.(*wire.MsgInv); ok {
+					return errors.New("inv rejected")
+				}
+				return nil
+			},
+		},
+		UserAgentName:          "peer",
+		UserAgentVersion:       "1.0",
+		ChainParams:            &chaincfg.MainNetParams,
+		Services:               0,
+		TstAllowSelfConnection: true,
+	}
+	inConn, outConn := pipe(
+		&conn{laddr: "10.0.0.1:9108", raddr: "10.0.0.2:9108"},
+		&conn{laddr: "10.0.0.2:9108", raddr: "10.0.0.1:9108"},
+	)
+	outPeer, err := peer.NewOutboundPeer(peerCfg, inConn.laddr)
+	if err != nil {
+		t.Fatalf("NewOutboundPeer: unexpected err: %v\n", err)
+	}
+	outPeer.AssociateConnection(outConn)
+	inPeer := peer.NewInboundPeer(peerCfg)
+	inPeer.AssociateConnection(inConn)
+	for i := 0; i < 2; i++ {
+		select {
+		case <-verack:
+		case <-time.After(time.Second):
+			t.Fatal("verack timeout")
+		}
+	}
+
+	// Send an inv which is rejected by the inbound peer.
+	inv := wire.NewMsgInv()
+	inv.AddInvVect(wire.NewInvVect(wire.InvTypeTx, &chainhash.Hash{}))
+	done := make(chan struct{})
+	outPeer.QueueMessage(inv, done)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("send inv timeout")
+	}
+	disconnected := make(chan struct{}, 1)
+	go func() {
+		inPeer.WaitForDisconnect()
+		disconnected <- struct{}{}
+	}()
+	select {
+	case <-disconnected:
+	case <-time.After(time.Second):
+		t.Fatal("peer did not disconnect")
+	}
+	select {
+	case <-handled:
+		t.Fatal("rejected message was handled")
+	default:
+	}
+}
+
 // TestUpdateLastBlockHeight ensures the last block height is set properly
 // during the initial version negotiation and is only allowed to advance to
 // higher values via the associated update function.
@@ -0,0 +1,4 @@
+#!/bin/sh
+set -e
+
+protoc --go_out=plugins=grpc:./pb --go_opt=paths=source_relative policyhook.proto
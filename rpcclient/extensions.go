@@ -509,3 +509,35 @@ func (c *Client) GetUptimeAsync() FutureGetUptimeResult {
 func (c *Client) GetUptime() (int64, error) {
 	return c.GetUptimeAsync().Receive()
 }
+
+// FutureSetUpgradeActivationResult is a future promise to deliver the result of
+// a SetUpgradeActivationAsync RPC invocation (or an applicable error).
+type FutureSetUpgradeActivationResult chan *response
+
+// Receive waits for the response promised by the future and returns an error if
+// any occurred when overriding the activation of the upgrade.
+func (r FutureSetUpgradeActivationResult) Receive() error {
+	_, err := receiveFuture(r)
+	return err
+}
+
+// SetUpgradeActivationAsync returns an instance of a type that can be used to
+// get the result of the RPC at some future time by invoking the Receive
+// function on the returned instance.
+//
+// See SetUpgradeActivation for the blocking version and more details.
+//
+// NOTE: This is a bchd extension.
+func (c *Client) SetUpgradeActivationAsync(upgrade string, activation int64) FutureSetUpgradeActivationResult {
+	cmd := btcjson.NewSetUpgradeActivationCmd(upgrade, activation)
+	return c.sendCmd(cmd)
+}
+
+// SetUpgradeActivation overrides the activation of the passed upgrade, which is
+// the height upgrade9 activates after or the median time past upgrade11
+// activates at.  It is only supported on regtest and simnet.
+//
+// NOTE: This is a bchd extension.
+func (c *Client) SetUpgradeActivation(upgrade string, activation int64) error {
+	return c.SetUpgradeActivationAsync(upgrade, activation).Receive()
+}
@@ -124,6 +124,34 @@ func (c *Client) SetGenerate(enable bool, numCPUs int) error {
 	return c.SetGenerateAsync(enable, numCPUs).Receive()
 }
 
+// FutureSetMockTimeResult is a future promise to deliver the result of a
+// SetMockTimeAsync RPC invocation (or an applicable error).
+type FutureSetMockTimeResult chan *response
+
+// Receive waits for the response promised by the future and returns an error if
+// any occurred when setting the adjusted time of the server.
+func (r FutureSetMockTimeResult) Receive() error {
+	_, err := receiveFuture(r)
+	return err
+}
+
+// SetMockTimeAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See SetMockTime for the blocking version and more details.
+func (c *Client) SetMockTimeAsync(timestamp int64) FutureSetMockTimeResult {
+	cmd := btcjson.NewSetMockTimeCmd(timestamp)
+	return c.sendCmd(cmd)
+}
+
+// SetMockTime sets the adjusted time of the server to the passed unix time, or
+// restores the real time when it is 0.  It is only supported on regtest and
+// simnet.
+func (c *Client) SetMockTime(timestamp int64) error {
+	return c.SetMockTimeAsync(timestamp).Receive()
+}
+
 // FutureGetHashesPerSecResult is a future promise to deliver the result of a
 // GetHashesPerSecAsync RPC invocation (or an applicable error).
 type FutureGetHashesPerSecResult chan *response
@@ -179,6 +179,20 @@ type NotificationHandlers struct {
 	// made to register for the notification and the function is non-nil.
 	OnTxAcceptedVerbose func(txDetails *btcjson.TxRawResult)
 
+	// OnTxReplaced is invoked when a transaction submitted to the server is
+	// replaced by a conflicting transaction, such as a malleated variant,
+	// which was included in a block.  It will only be invoked if a
+	// preceding call to NotifyBlocks has been made to register for the
+	// notification and the function is non-nil.
+	OnTxReplaced func(hash *chainhash.Hash, replacement *chainhash.Hash)
+
+	// OnBlockFees is invoked when a block is connected to the longest
+	// (best) chain with the breakdown of its coinbase into subsidy and
+	// fees.  It will only be invoked if a preceding call to NotifyBlocks
+	// has been made to register for the notification and the function is
+	// non-nil.
+	OnBlockFees func(blockFees *btcjson.BlockFeesResult)
+
 	// OnBchdConnected is invoked when a wallet connects or disconnects from
 	// bchd.
 	//
@@ -409,6 +423,40 @@ func (c *Client) handleNotification(ntfn *rawNotification) {
 
 		c.ntfnHandlers.OnTxAcceptedVerbose(rawTx)
 
+	// OnTxReplaced
+	case btcjson.TxReplacedNtfnMethod:
+		// Ignore the notification if the client is not interested in
+		// it.
+		if c.ntfnHandlers.OnTxReplaced == nil {
+			return
+		}
+
+		hash, replacement, err := parseTxReplacedNtfnParams(ntfn.Params)
+		if err != nil {
+			log.Warnf("Received invalid tx replaced "+
+				"notification: %v", err)
+			return
+		}
+
+		c.ntfnHandlers.OnTxReplaced(hash, replacement)
+
+	// OnBlockFees
+	case btcjson.BlockFeesNtfnMethod:
+		// Ignore the notification if the client is not interested in
+		// it.
+		if c.ntfnHandlers.OnBlockFees == nil {
+			return
+		}
+
+		blockFees, err := parseBlockFeesNtfnParams(ntfn.Params)
+		if err != nil {
+			log.Warnf("Received invalid block fees notification: %v",
+				err)
+			return
+		}
+
+		c.ntfnHandlers.OnBlockFees(blockFees)
+
 	// OnBchdConnected
 	case btcjson.BtcdConnectedNtfnMethod:
 		// Ignore the notification if the client is not interested in
@@ -753,6 +801,58 @@ func parseTxAcceptedNtfnParams(params []json.RawMessage) (*chainhash.Hash,
 	return txHash, amt, nil
 }
 
+// parseTxReplacedNtfnParams parses out the hashes of the replaced and the
+// replacement transaction from the parameters of a txreplaced notification.
+func parseTxReplacedNtfnParams(params []json.RawMessage) (*chainhash.Hash,
+	*chainhash.Hash, error) {
+
+	if len(params) != 2 {
+		return nil, nil, wrongNumParams(len(params))
+	}
+
+	// Unmarshal both parameters as strings.
+	var txHashStr, replacementStr string
+	err := json.Unmarshal(params[0], &txHashStr)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = json.Unmarshal(params[1], &replacementStr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Decode string encodings of the transaction hashes.
+	txHash, err := chainhash.NewHashFromStr(txHashStr)
+	if err != nil {
+		return nil, nil, err
+	}
+	replacement, err := chainhash.NewHashFromStr(replacementStr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return txHash, replacement, nil
+}
+
+// parseBlockFeesNtfnParams parses out the breakdown of the coinbase of a block
+// from the parameters of a blockfees notification.
+func parseBlockFeesNtfnParams(params []json.RawMessage) (*btcjson.BlockFeesResult,
+	error) {
+
+	if len(params) != 1 {
+		return nil, wrongNumParams(len(params))
+	}
+
+	// Unmarshal first parameter as a block fees result object.
+	var blockFees btcjson.BlockFeesResult
+	err := json.Unmarshal(params[0], &blockFees)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blockFees, nil
+}
+
 // parseTxAcceptedVerboseNtfnParams parses out details about a raw transaction
 // from the parameters of a txacceptedverbose notification.
 func parseTxAcceptedVerboseNtfnParams(params []json.RawMessage) (*btcjson.TxRawResult,
@@ -0,0 +1,148 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package supervisor runs non-critical subsystems in goroutines which recover
+// from their panics and restart them, so a bug in a subsystem does not crash
+// the whole node.
+package supervisor
+
+import (
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gcash/bchlog"
+)
+
+const (
+	// minBackoff is the time a supervised subsystem waits before it is
+	// restarted after its first panic.
+	minBackoff = time.Second
+
+	// maxBackoff is the maximum time a supervised subsystem waits before it
+	// is restarted.  The backoff doubles with each panic up to it.
+	maxBackoff = 5 * time.Minute
+
+	// stableRun is how long a supervised subsystem must run without
+	// panicking for the backoff to be reset.
+	stableRun = 10 * time.Minute
+)
+
+// Supervisor runs a non-critical subsystem in a goroutine which recovers from
+// its panics, logs them along with the stack trace and restarts it with an
+// exponential backoff, so a bug in the subsystem does not crash the whole node.
+//
+// The run function of the subsystem must return once the quit channel it is
+// passed is closed.  The subsystem is not restarted when it returns without
+// panicking.
+type Supervisor struct {
+	name     string
+	log      bchlog.Logger
+	run      func(quit <-chan struct{})
+	restarts uint32 // atomic
+
+	// minBackoff and maxBackoff bound the time waited before restarts.
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	started int32 // atomic
+	quit    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// New returns a supervisor of the subsystem with the passed name and run
+// function which logs the panics of the subsystem to the passed logger.
+func New(name string, log bchlog.Logger, run func(quit <-chan struct{})) *Supervisor {
+	return &Supervisor{
+		name:       name,
+		log:        log,
+		run:        run,
+		minBackoff: minBackoff,
+		maxBackoff: maxBackoff,
+		quit:       make(chan struct{}),
+	}
+}
+
+// Start runs the supervised subsystem in its own goroutine.
+func (s *Supervisor) Start() {
+	if atomic.AddInt32(&s.started, 1) != 1 {
+		return
+	}
+
+	s.wg.Add(1)
+	go s.supervise()
+}
+
+// Stop signals the supervised subsystem to quit and waits for it to return.
+func (s *Supervisor) Stop() {
+	if atomic.LoadInt32(&s.started) == 0 {
+		return
+	}
+	select {
+	case <-s.quit:
+	default:
+		close(s.quit)
+	}
+	s.wg.Wait()
+}
+
+// Wait blocks until the supervised subsystem returns without panicking or the
+// supervisor is stopped.
+func (s *Supervisor) Wait() {
+	s.wg.Wait()
+}
+
+// Restarts returns how many times the supervised subsystem was restarted after
+// a panic.
+func (s *Supervisor) Restarts() uint32 {
+	return atomic.LoadUint32(&s.restarts)
+}
+
+// runOnce runs the supervised subsystem and returns whether it panicked.
+func (s *Supervisor) runOnce() (panicked bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.log.Errorf("Recovered from panic in %s: %v\n%s", s.name,
+				r, debug.Stack())
+			panicked = true
+		}
+	}()
+	s.run(s.quit)
+	return false
+}
+
+// supervise runs the supervised subsystem until it returns without panicking
+// or the supervisor is stopped, restarting it with an exponential backoff
+// after each panic.
+//
+// It must be run as a goroutine.
+func (s *Supervisor) supervise() {
+	defer s.wg.Done()
+
+	backoff := s.minBackoff
+	for {
+		start := time.Now()
+		if !s.runOnce() {
+			return
+		}
+		if time.Since(start) >= stableRun {
+			backoff = s.minBackoff
+		}
+
+		restarts := atomic.AddUint32(&s.restarts, 1)
+		s.log.Warnf("Restarting %s in %v (restart %d)", s.name, backoff,
+			restarts)
+		select {
+		case <-time.After(backoff):
+		case <-s.quit:
+			return
+		}
+
+		backoff *= 2
+		if backoff > s.maxBackoff {
+			backoff = s.maxBackoff
+		}
+	}
+}
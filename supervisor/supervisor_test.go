@@ -0,0 +1,61 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package supervisor
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchlog"
+)
+
+// TestSupervisor ensures supervised subsystems are restarted after panicking
+// until they return, and that stopping the supervisor makes them quit.
+func TestSupervisor(t *testing.T) {
+	// A subsystem panicking twice is restarted twice and not restarted
+	// once it returns.
+	var runs int32
+	sv := New("test", bchlog.Disabled, func(quit <-chan struct{}) {
+		if atomic.AddInt32(&runs, 1) <= 2 {
+			panic("test panic")
+		}
+	})
+	sv.minBackoff = time.Millisecond
+	sv.maxBackoff = time.Millisecond
+	sv.Start()
+	sv.Wait()
+	if got := atomic.LoadInt32(&runs); got != 3 {
+		t.Errorf("got %d runs, want 3", got)
+	}
+	if got := sv.Restarts(); got != 2 {
+		t.Errorf("got %d restarts, want 2", got)
+	}
+	sv.Stop()
+
+	// A running subsystem quits when the supervisor is stopped and is
+	// not restarted while waiting for the backoff.
+	running := make(chan struct{})
+	sv = New("test", bchlog.Disabled, func(quit <-chan struct{}) {
+		close(running)
+		<-quit
+	})
+	sv.Start()
+	<-running
+	sv.Stop()
+
+	sv = New("test", bchlog.Disabled, func(quit <-chan struct{}) {
+		panic("test panic")
+	})
+	sv.minBackoff = time.Hour
+	sv.Start()
+	for sv.Restarts() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	sv.Stop()
+	if got := sv.Restarts(); got != 1 {
+		t.Errorf("got %d restarts after stopping, want 1", got)
+	}
+}
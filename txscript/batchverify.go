@@ -0,0 +1,159 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txscript
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/gcash/bchd/bchec"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+)
+
+// batchVerifySize is the number of signatures verified together in a single
+// batch.  Larger batches share more work while smaller ones make finding an
+// invalid signature cheaper.
+const batchVerifySize = 64
+
+// batchEntry is a signature verification deferred to a BatchVerifier along
+// with the input it was made for.
+type batchEntry struct {
+	sigHash chainhash.Hash
+	sig     *bchec.Signature
+	pubKey  *bchec.PublicKey
+	txHash  chainhash.Hash
+	txIdx   int
+}
+
+// BatchVerifier collects the Schnorr signature verifications deferred by the
+// script engines it is attached to, so the signatures of for example a whole
+// block can be verified together in batches.  ECDSA signatures can not be
+// batched since the signature does not commit to the full nonce point, so
+// they are always verified during script execution.
+//
+// Only signatures whose failure ends script execution under the NULLFAIL
+// rule are deferred.  The engine assumes they are valid, so a script is only
+// valid if Verify returns no error as well.
+type BatchVerifier struct {
+	mtx      sync.Mutex
+	entries  []batchEntry
+	sigCache *SigCache
+}
+
+// NewBatchVerifier returns a new empty batch verifier.  Signatures found to be
+// valid are added to the passed signature cache, which may be nil.
+func NewBatchVerifier(sigCache *SigCache) *BatchVerifier {
+	return &BatchVerifier{sigCache: sigCache}
+}
+
+// add defers the verification of the passed signature.
+//
+// This function is safe for concurrent access.
+func (bv *BatchVerifier) add(sigHash chainhash.Hash, sig *bchec.Signature,
+	pubKey *bchec.PublicKey, txHash chainhash.Hash, txIdx int) {
+
+	bv.mtx.Lock()
+	bv.entries = append(bv.entries, batchEntry{
+		sigHash: sigHash,
+		sig:     sig,
+		pubKey:  pubKey,
+		txHash:  txHash,
+		txIdx:   txIdx,
+	})
+	bv.mtx.Unlock()
+}
+
+// Len returns the number of deferred signature verifications.
+//
+// This function is safe for concurrent access.
+func (bv *BatchVerifier) Len() int {
+	bv.mtx.Lock()
+	defer bv.mtx.Unlock()
+	return len(bv.entries)
+}
+
+// Verify verifies all of the deferred signatures in batches using multiple
+// goroutines.  A batch which fails is verified signature by signature to find
+// the invalid signature, which is reported in the returned error.  The
+// verifier is empty afterwards.
+//
+// This function is safe for concurrent access.
+func (bv *BatchVerifier) Verify() error {
+	bv.mtx.Lock()
+	entries := bv.entries
+	bv.entries = nil
+	bv.mtx.Unlock()
+
+	numBatches := (len(entries) + batchVerifySize - 1) / batchVerifySize
+	if numBatches == 0 {
+		return nil
+	}
+	maxGoRoutines := runtime.NumCPU()
+	if maxGoRoutines > numBatches {
+		maxGoRoutines = numBatches
+	}
+
+	batches := make(chan []batchEntry, numBatches)
+	for i := 0; i < len(entries); i += batchVerifySize {
+		end := i + batchVerifySize
+		if end > len(entries) {
+			end = len(entries)
+		}
+		batches <- entries[i:end]
+	}
+	close(batches)
+
+	results := make(chan error, maxGoRoutines)
+	for i := 0; i < maxGoRoutines; i++ {
+		go func() {
+			for batch := range batches {
+				if err := bv.verifyBatch(batch); err != nil {
+					results <- err
+					return
+				}
+			}
+			results <- nil
+		}()
+	}
+
+	var firstErr error
+	for i := 0; i < maxGoRoutines; i++ {
+		if err := <-results; err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// verifyBatch verifies the passed entries as a single batch and falls back to
+// verifying them one by one when the batch fails.
+func (bv *BatchVerifier) verifyBatch(batch []batchEntry) error {
+	items := make([]bchec.SchnorrBatchItem, 0, len(batch))
+	for i := range batch {
+		items = append(items, bchec.SchnorrBatchItem{
+			PubKey:    batch[i].pubKey,
+			Hash:      batch[i].sigHash[:],
+			Signature: batch[i].sig,
+		})
+	}
+
+	if !bchec.VerifySchnorrBatch(items) {
+		for _, entry := range batch {
+			if !entry.sig.Verify(entry.sigHash[:], entry.pubKey) {
+				str := fmt.Sprintf("invalid signature in input %d "+
+					"of transaction %v", entry.txIdx, entry.txHash)
+				return scriptError(ErrNullFail, str)
+			}
+		}
+	}
+
+	if bv.sigCache != nil {
+		for _, entry := range batch {
+			bv.sigCache.Add(entry.sigHash, entry.sig, entry.pubKey)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txscript
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/bchec"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+)
+
+// TestBatchVerifier ensures Schnorr signatures are deferred to an attached
+// batch verifier and that an invalid one is reported by the verifier rather
+// than by the script execution.
+func TestBatchVerifier(t *testing.T) {
+	t.Parallel()
+
+	const amount = 100000
+	privKey, err := bchec.NewPrivateKey(bchec.S256())
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	pkScript, err := NewScriptBuilder().
+		AddData(privKey.PubKey().SerializeCompressed()).
+		AddOp(OP_CHECKSIG).Script()
+	if err != nil {
+		t.Fatalf("failed to build script: %v", err)
+	}
+
+	tx := wire.NewMsgTx(1)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0), nil))
+	tx.AddTxOut(wire.NewTxOut(amount, pkScript, wire.TokenData{}))
+	sig, err := RawTxInSchnorrSignature(tx, 0, pkScript, SigHashAll, privKey, amount)
+	if err != nil {
+		t.Fatalf("failed to sign: %v", err)
+	}
+
+	execute := func(sig []byte, bv *BatchVerifier) error {
+		sigScript, err := NewScriptBuilder().AddData(sig).Script()
+		if err != nil {
+			t.Fatalf("failed to build script: %v", err)
+		}
+		tx.TxIn[0].SignatureScript = sigScript
+
+		utxoCache := NewUtxoCache()
+		utxoCache.AddEntry(0, *wire.NewTxOut(amount, pkScript, wire.TokenData{}))
+		vm, err := NewEngine(pkScript, tx, 0, StandardVerifyFlags, nil,
+			nil, utxoCache, amount)
+		if err != nil {
+			t.Fatalf("failed to create engine: %v", err)
+		}
+		if bv != nil {
+			vm.SetBatchVerifier(bv)
+		}
+		return vm.Execute()
+	}
+
+	// A valid signature is deferred and passes verification.  It is then
+	// added to the signature cache.
+	sigCache := NewSigCache(10)
+	bv := NewBatchVerifier(sigCache)
+	if err := execute(sig, bv); err != nil {
+		t.Fatalf("unexpected execution error: %v", err)
+	}
+	if bv.Len() != 1 {
+		t.Fatalf("expected 1 deferred signature, got %d", bv.Len())
+	}
+	if err := bv.Verify(); err != nil {
+		t.Fatalf("unexpected verification error: %v", err)
+	}
+	if bv.Len() != 0 || len(sigCache.validSigs) != 1 {
+		t.Fatalf("expected an empty verifier and 1 cached signature, "+
+			"got %d and %d", bv.Len(), len(sigCache.validSigs))
+	}
+
+	// An invalid signature fails execution without a batch verifier and
+	// fails verification with one.
+	badSig := make([]byte, len(sig))
+	copy(badSig, sig)
+	badSig[10] ^= 0x01
+	if err := execute(badSig, nil); !IsErrorCode(err, ErrNullFail) {
+		t.Fatalf("expected ErrNullFail executing without a batch "+
+			"verifier, got %v", err)
+	}
+	bv = NewBatchVerifier(nil)
+	if err := execute(badSig, bv); err != nil {
+		t.Fatalf("unexpected execution error: %v", err)
+	}
+	if err := bv.Verify(); !IsErrorCode(err, ErrNullFail) {
+		t.Fatalf("expected ErrNullFail from the batch verifier, got %v", err)
+	}
+}
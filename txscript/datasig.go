@@ -0,0 +1,59 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txscript
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/gcash/bchd/bchec"
+)
+
+// DataSignatureHash returns the hash OP_CHECKDATASIG verifies signatures of a
+// message against, which is the single SHA256 of the message.
+func DataSignatureHash(message []byte) []byte {
+	hash := sha256.Sum256(message)
+	return hash[:]
+}
+
+// RawDataSignature returns the serialized signature of the passed message which
+// OP_CHECKDATASIG verifies against the public key of the passed private key.
+// Unlike transaction signatures, it is not followed by a hash type.  The
+// signature is a 64 byte Schnorr signature when schnorr is set and a DER
+// encoded ECDSA signature with a low S value otherwise.
+func RawDataSignature(message []byte, key *bchec.PrivateKey, schnorr bool) ([]byte, error) {
+	hash := DataSignatureHash(message)
+	var signature *bchec.Signature
+	var err error
+	if schnorr {
+		signature, err = key.SignSchnorr(hash)
+	} else {
+		signature, err = key.SignECDSA(hash)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot sign data: %s", err)
+	}
+	return signature.Serialize(), nil
+}
+
+// VerifyDataSignature returns whether OP_CHECKDATASIG executed with the passed
+// script flags pushes true for the passed signature, message and public key.
+// An error is returned when the operation fails the script instead, such as
+// when the signature or the public key is not strictly encoded or when a
+// non-empty signature is invalid under the NULLFAIL rule.
+func VerifyDataSignature(sig, message, pubKey []byte, flags ScriptFlags) (bool, error) {
+	vm := Engine{
+		flags:   flags | ScriptVerifyCheckDataSig,
+		metrics: NewScriptExecutionMetrics(0, true),
+	}
+	vm.dstack.PushByteArray(sig)
+	vm.dstack.PushByteArray(message)
+	vm.dstack.PushByteArray(pubKey)
+	pop := parsedOpcode{opcode: &opcodeArray[OP_CHECKDATASIG]}
+	if err := opcodeCheckDataSig(&pop, &vm); err != nil {
+		return false, err
+	}
+	return vm.dstack.PopBool(false)
+}
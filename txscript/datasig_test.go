@@ -0,0 +1,83 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txscript
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/bchec"
+)
+
+// TestDataSignatures ensures the signatures created for OP_CHECKDATASIG are
+// verified with the rules the opcode applies.
+func TestDataSignatures(t *testing.T) {
+	key, err := bchec.NewPrivateKey(bchec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	pubKey := key.PubKey().SerializeCompressed()
+	message := []byte("price:42000")
+	flags := StandardVerifyFlags
+
+	for _, schnorr := range []bool{false, true} {
+		sig, err := RawDataSignature(message, key, schnorr)
+		if err != nil {
+			t.Fatalf("schnorr %v: unable to sign: %v", schnorr, err)
+		}
+		if schnorr != (len(sig) == 64) {
+			t.Errorf("schnorr %v: got a %d byte signature", schnorr,
+				len(sig))
+		}
+
+		valid, err := VerifyDataSignature(sig, message, pubKey, flags)
+		if err != nil || !valid {
+			t.Errorf("schnorr %v: got valid %v with error %v for a "+
+				"good signature", schnorr, valid, err)
+		}
+
+		// An uncompressed key is accepted too.
+		valid, err = VerifyDataSignature(sig, message,
+			key.PubKey().SerializeUncompressed(), flags)
+		if err != nil || !valid {
+			t.Errorf("schnorr %v: got valid %v with error %v for "+
+				"an uncompressed key", schnorr, valid, err)
+		}
+
+		// A signature of another message fails the script because of
+		// the NULLFAIL rule, and returns false without it.
+		other := []byte("price:41000")
+		_, err = VerifyDataSignature(sig, other, pubKey, flags)
+		if !IsErrorCode(err, ErrNullFail) {
+			t.Errorf("schnorr %v: got error %v for a bad signature, "+
+				"want %v", schnorr, err, ErrNullFail)
+		}
+		valid, err = VerifyDataSignature(sig, other, pubKey,
+			flags&^ScriptVerifyNullFail)
+		if err != nil || valid {
+			t.Errorf("schnorr %v: got valid %v with error %v for a "+
+				"bad signature without NULLFAIL", schnorr, valid,
+				err)
+		}
+	}
+
+	// Empty signatures are invalid without failing the script.
+	valid, err := VerifyDataSignature(nil, message, pubKey, flags)
+	if err != nil || valid {
+		t.Errorf("got valid %v with error %v for an empty signature",
+			valid, err)
+	}
+
+	// Hybrid public keys are not strictly encoded.
+	sig, err := RawDataSignature(message, key, true)
+	if err != nil {
+		t.Fatalf("unable to sign: %v", err)
+	}
+	_, err = VerifyDataSignature(sig, message,
+		key.PubKey().SerializeHybrid(), flags)
+	if !IsErrorCode(err, ErrPubKeyType) {
+		t.Errorf("got error %v for a hybrid key, want %v", err,
+			ErrPubKeyType)
+	}
+}
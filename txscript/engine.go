@@ -10,6 +10,7 @@ import (
 	"math/big"
 
 	"github.com/gcash/bchd/bchec"
+	"github.com/gcash/bchd/chaincfg/chainhash"
 	"github.com/gcash/bchd/wire"
 )
 
@@ -184,6 +185,8 @@ type Engine struct {
 	savedFirstStack      [][]byte // stack from first script for bip16 scripts
 	inputAmount          int64
 	sigChecks            int
+	batchVerifier        *BatchVerifier
+	txHash               *chainhash.Hash
 }
 
 // hasFlag returns whether the script engine instance has the passed flag set.
@@ -191,6 +194,58 @@ func (vm *Engine) hasFlag(flag ScriptFlags) bool {
 	return vm.flags.HasFlag(flag)
 }
 
+// SetBatchVerifier attaches a batch verifier to the engine.  Schnorr
+// signatures whose failure would end execution due to the NULLFAIL rule are
+// then assumed valid and left to the batch verifier, which must be verified
+// as well before the script is considered valid.
+func (vm *Engine) SetBatchVerifier(bv *BatchVerifier) {
+	vm.batchVerifier = bv
+}
+
+// sigHashes returns the partial sighashes of the transaction being validated.
+// When none were passed to the engine they are computed on first use and
+// reused by every later signature check of the script.
+func (vm *Engine) sigHashes() *TxSigHashes {
+	if vm.hashCache == nil {
+		vm.hashCache = NewTxSigHashes(&vm.tx)
+	}
+	if vm.hasFlag(ScriptAllowCashTokens) {
+		vm.hashCache.AddTxSigHashUtxoFromUtxoCache(&vm.tx, vm.utxoCache)
+	}
+	return vm.hashCache
+}
+
+// verifySignature returns whether the signature of the hash is valid for the
+// public key, consulting and populating the signature cache when there is
+// one.  When mustBeValid is set, meaning an invalid signature ends execution,
+// Schnorr signatures are deferred to the attached batch verifier, if any.
+func (vm *Engine) verifySignature(hash []byte, signature *bchec.Signature,
+	pubKey *bchec.PublicKey, mustBeValid bool) bool {
+
+	var sigHash chainhash.Hash
+	copy(sigHash[:], hash)
+	if vm.sigCache != nil && vm.sigCache.Exists(sigHash, signature, pubKey) {
+		return true
+	}
+
+	if mustBeValid && vm.batchVerifier != nil &&
+		signature.Type() == bchec.SignatureTypeSchnorr {
+
+		if vm.txHash == nil {
+			txHash := vm.tx.TxHash()
+			vm.txHash = &txHash
+		}
+		vm.batchVerifier.add(sigHash, signature, pubKey, *vm.txHash, vm.txIdx)
+		return true
+	}
+
+	valid := signature.Verify(hash, pubKey)
+	if valid && vm.sigCache != nil {
+		vm.sigCache.Add(sigHash, signature, pubKey)
+	}
+	return valid
+}
+
 // IsBranchExecuting returns whether or not the current conditional branch is
 // actively executing. For example, when the data stack has an OP_FALSE on it
 // and an OP_IF is encountered, the branch is inactive until an OP_ELSE or
@@ -906,6 +961,8 @@ func (vm *Engine) Clone() *Engine {
 		inputAmount: vm.inputAmount,
 		sigCache:    vm.sigCache,
 		hashCache:   vm.hashCache,
+
+		batchVerifier: vm.batchVerifier,
 	}
 	newVM.savedFirstStack = make([][]byte, len(vm.savedFirstStack))
 	for i, stack := range vm.savedFirstStack {
@@ -22,6 +22,11 @@ type TxSigHashes struct {
 	HashOutputs   chainhash.Hash
 	HashUTXOS     chainhash.Hash
 	tokenDataList [][]byte
+
+	// utxosOnce ensures the utxo hashes are computed a single time since
+	// the same sighashes are shared by the inputs being validated
+	// concurrently.
+	utxosOnce sync.Once
 }
 
 // NewTxSigHashes computes, and returns the cached sighashes of the given
@@ -35,10 +40,17 @@ func NewTxSigHashes(tx *wire.MsgTx) *TxSigHashes {
 	}
 }
 
+// AddTxSigHashUtxoFromUtxoCache computes the hash of the outputs spent by the
+// transaction, along with their token data, from the passed utxo cache.  The
+// spent outputs are fixed by the transaction so only the first call computes
+// them and later calls are no-ops.
+//
+// This function is safe for concurrent access.
 func (txSighashes *TxSigHashes) AddTxSigHashUtxoFromUtxoCache(tx *wire.MsgTx, utxoCache *UtxoCache) {
-	hash := calcHashUtxos(tx, utxoCache)
-	txSighashes.HashUTXOS = hash
-	txSighashes.tokenDataList = calUtxoTokenData(tx, utxoCache)
+	txSighashes.utxosOnce.Do(func() {
+		txSighashes.HashUTXOS = calcHashUtxos(tx, utxoCache)
+		txSighashes.tokenDataList = calUtxoTokenData(tx, utxoCache)
+	})
 }
 
 // HashCache houses a set of partial sighashes keyed by txid. The set of partial
@@ -68,6 +80,26 @@ func (h *HashCache) AddSigHashes(tx *wire.MsgTx) {
 	h.Unlock()
 }
 
+// GetOrAddSigHashes returns the cached partial sighashes for the passed
+// transaction, computing and adding them first if they are not present.  The
+// sighashes of a transaction are only ever computed once, even when multiple
+// goroutines request them at the same time.
+func (h *HashCache) GetOrAddSigHashes(tx *wire.MsgTx) *TxSigHashes {
+	txid := tx.TxHash()
+	if item, found := h.GetSigHashes(&txid); found {
+		return item
+	}
+
+	h.Lock()
+	defer h.Unlock()
+	item, found := h.sigHashes[txid]
+	if !found {
+		item = NewTxSigHashes(tx)
+		h.sigHashes[txid] = item
+	}
+	return item
+}
+
 // ContainsHashes returns true if the partial sighashes for the passed
 // transaction currently exist within the HashCache, and false otherwise.
 func (h *HashCache) ContainsHashes(txid *chainhash.Hash) bool {
@@ -98,6 +130,14 @@ func (h *HashCache) PurgeSigHashes(txid *chainhash.Hash) {
 	h.Unlock()
 }
 
+// Clear removes all partial sighashes from the HashCache.  They are computed
+// again by GetOrAddSigHashes when needed.
+func (h *HashCache) Clear() {
+	h.Lock()
+	clear(h.sigHashes)
+	h.Unlock()
+}
+
 // UtxoCache houses the utxos (scriptPubkey and value) for each input index
 // in a single transaction. We use this class for the native introspection
 // opcodes instead of the UtxoViewpoint class from the blockchain package to
@@ -126,9 +166,9 @@ func (u *UtxoCache) AddEntry(i int, output wire.TxOut) {
 func (u *UtxoCache) GetEntry(i int) (wire.TxOut, error) {
 	u.RLock()
 	utxo, ok := u.utxos[i]
+	u.RUnlock()
 	if !ok {
 		return wire.TxOut{}, errors.New("not found")
 	}
-	u.RUnlock()
 	return utxo, nil
 }
@@ -6,6 +6,7 @@ package txscript
 
 import (
 	"math/rand"
+	"reflect"
 	"testing"
 	"time"
 
@@ -183,3 +184,42 @@ func TestHashCachePurge(t *testing.T) {
 		}
 	}
 }
+
+// TestHashCacheGetOrAddSigHashes tests that GetOrAddSigHashes computes the
+// partial sighashes of a transaction once and returns the cached instance on
+// later calls.
+func TestHashCacheGetOrAddSigHashes(t *testing.T) {
+	t.Parallel()
+
+	tx, err := genTestTx()
+	if err != nil {
+		t.Fatalf("unable to generate test tx: %v", err)
+	}
+
+	cache := NewHashCache(10)
+	sigHashes := cache.GetOrAddSigHashes(tx)
+	if !reflect.DeepEqual(sigHashes, NewTxSigHashes(tx)) {
+		t.Fatalf("sighashes mismatch: got %v, want %v",
+			spew.Sdump(sigHashes), spew.Sdump(NewTxSigHashes(tx)))
+	}
+
+	txid := tx.TxHash()
+	if !cache.ContainsHashes(&txid) {
+		t.Fatalf("tx %v not added to the cache", txid)
+	}
+	if cache.GetOrAddSigHashes(tx) != sigHashes {
+		t.Fatalf("sighashes of tx %v computed twice", txid)
+	}
+
+	// The utxo hashes are only computed by the first call.
+	utxoCache := NewUtxoCache()
+	for i := range tx.TxIn {
+		utxoCache.AddEntry(i, wire.TxOut{Value: int64(i)})
+	}
+	sigHashes.AddTxSigHashUtxoFromUtxoCache(tx, utxoCache)
+	hashUtxos := sigHashes.HashUTXOS
+	sigHashes.AddTxSigHashUtxoFromUtxoCache(tx, NewUtxoCache())
+	if sigHashes.HashUTXOS != hashUtxos {
+		t.Fatal("utxo hashes were recomputed")
+	}
+}
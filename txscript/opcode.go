@@ -2598,16 +2598,9 @@ func opcodeCheckSig(op *parsedOpcode, vm *Engine) error {
 	subScript = removeOpcodeByData(subScript, fullSigBytes)
 
 	var sigHashes *TxSigHashes
-	if vm.hashCache != nil {
-		sigHashes = vm.hashCache
-	} else {
-		sigHashes = NewTxSigHashes(&vm.tx)
-	}
-
-	if vm.hasFlag(ScriptAllowCashTokens) {
-		sigHashes.AddTxSigHashUtxoFromUtxoCache(&vm.tx, vm.utxoCache)
+	if vm.hasFlag(ScriptVerifyBip143SigHash) {
+		sigHashes = vm.sigHashes()
 	}
-
 	hash, totalBytesHashedlength, err := calcSignatureHash(subScript, sigHashes, hashType, &vm.tx, vm.txIdx,
 		vm.inputAmount, vm.hasFlag(ScriptVerifyBip143SigHash))
 	if err != nil {
@@ -2635,19 +2628,8 @@ func opcodeCheckSig(op *parsedOpcode, vm *Engine) error {
 		return nil
 	}
 
-	var valid bool
-	if vm.sigCache != nil {
-		var sigHash chainhash.Hash
-		copy(sigHash[:], hash)
-
-		valid = vm.sigCache.Exists(sigHash, signature, pubKey)
-		if !valid && signature.Verify(hash, pubKey) {
-			vm.sigCache.Add(sigHash, signature, pubKey)
-			valid = true
-		}
-	} else {
-		valid = signature.Verify(hash, pubKey)
-	}
+	valid := vm.verifySignature(hash, signature, pubKey,
+		vm.hasFlag(ScriptVerifyNullFail))
 	if len(sigBytes) > 0 {
 		vm.sigChecks++
 		if !valid && vm.hasFlag(ScriptVerifyNullFail) {
@@ -2797,14 +2779,7 @@ func opcodeCheckMultiSig(op *parsedOpcode, vm *Engine) error {
 
 	var sigHashes *TxSigHashes
 	if vm.hasFlag(ScriptVerifyBip143SigHash) {
-		if vm.hashCache != nil {
-			sigHashes = vm.hashCache
-		} else {
-			sigHashes = NewTxSigHashes(&vm.tx)
-		}
-	}
-	if vm.hasFlag(ScriptAllowCashTokens) {
-		sigHashes.AddTxSigHashUtxoFromUtxoCache(&vm.tx, vm.utxoCache)
+		sigHashes = vm.sigHashes()
 	}
 	success := true
 
@@ -2914,19 +2889,9 @@ func opcodeCheckMultiSig(op *parsedOpcode, vm *Engine) error {
 				return nil
 			}
 
-			var valid bool
-			if vm.sigCache != nil {
-				var sigHash chainhash.Hash
-				copy(sigHash[:], signatureHash)
-
-				valid = vm.sigCache.Exists(sigHash, parsedSig, parsedPubKey)
-				if !valid && parsedSig.Verify(signatureHash, parsedPubKey) {
-					vm.sigCache.Add(sigHash, parsedSig, parsedPubKey)
-					valid = true
-				}
-			} else {
-				valid = parsedSig.Verify(signatureHash, parsedPubKey)
-			}
+			// A failure always ends execution in Schnorr mode.
+			valid := vm.verifySignature(signatureHash, parsedSig,
+				parsedPubKey, true)
 
 			if !valid {
 				str := "not all signatures empty on failed checkmultisig"
@@ -3039,19 +3004,8 @@ func opcodeCheckMultiSig(op *parsedOpcode, vm *Engine) error {
 				return nil
 			}
 
-			var valid bool
-			if vm.sigCache != nil {
-				var sigHash chainhash.Hash
-				copy(sigHash[:], signatureHash)
-
-				valid = vm.sigCache.Exists(sigHash, parsedSig, parsedPubKey)
-				if !valid && parsedSig.Verify(signatureHash, parsedPubKey) {
-					vm.sigCache.Add(sigHash, parsedSig, parsedPubKey)
-					valid = true
-				}
-			} else {
-				valid = parsedSig.Verify(signatureHash, parsedPubKey)
-			}
+			valid := vm.verifySignature(signatureHash, parsedSig,
+				parsedPubKey, false)
 
 			if valid {
 				// PubKey verified, move on to the next signature.
@@ -3161,19 +3115,8 @@ func opcodeCheckDataSig(op *parsedOpcode, vm *Engine) error {
 		return nil
 	}
 
-	var valid bool
-	if vm.sigCache != nil {
-		var sigHash chainhash.Hash
-		copy(sigHash[:], messageHash[:])
-
-		valid = vm.sigCache.Exists(sigHash, signature, pubKey)
-		if !valid && signature.Verify(messageHash[:], pubKey) {
-			vm.sigCache.Add(sigHash, signature, pubKey)
-			valid = true
-		}
-	} else {
-		valid = signature.Verify(messageHash[:], pubKey)
-	}
+	valid := vm.verifySignature(messageHash[:], signature, pubKey,
+		vm.hasFlag(ScriptVerifyNullFail))
 	if len(sigBytes) > 0 {
 		vm.sigChecks++
 
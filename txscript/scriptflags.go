@@ -0,0 +1,134 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txscript
+
+import (
+	"fmt"
+	"strings"
+)
+
+// scriptFlagNames maps the script flags to the names they are referred to by,
+// which follow the names used by the script tests shared with other node
+// implementations.
+var scriptFlagNames = []struct {
+	flag ScriptFlags
+	name string
+}{
+	{ScriptBip16, "P2SH"},
+	{ScriptStrictMultiSig, "NULLDUMMY"},
+	{ScriptDiscourageUpgradableNops, "DISCOURAGE_UPGRADABLE_NOPS"},
+	{ScriptVerifyCheckLockTimeVerify, "CHECKLOCKTIMEVERIFY"},
+	{ScriptVerifyCheckSequenceVerify, "CHECKSEQUENCEVERIFY"},
+	{ScriptVerifyCleanStack, "CLEANSTACK"},
+	{ScriptVerifyDERSignatures, "DERSIG"},
+	{ScriptVerifyLowS, "LOW_S"},
+	{ScriptVerifyMinimalData, "MINIMALDATA"},
+	{ScriptVerifyMinimalIf, "MINIMALIF"},
+	{ScriptVerifyNullFail, "NULLFAIL"},
+	{ScriptVerifySigPushOnly, "SIGPUSHONLY"},
+	{ScriptVerifyStrictEncoding, "STRICTENC"},
+	{ScriptVerifyCompressedPubkey, "COMPRESSED_PUBKEYTYPE"},
+	{ScriptVerifyBip143SigHash, "SIGHASH_FORKID"},
+	{ScriptVerifyCheckDataSig, "CHECKDATASIG"},
+	{ScriptVerifySchnorr, "SCHNORR"},
+	{ScriptVerifyAllowSegwitRecovery, "ALLOWSEGWITRECOVERY"},
+	{ScriptVerifySchnorrMultisig, "SCHNORR_MULTISIG"},
+	{ScriptReportSigChecks, "REPORT_SIGCHECKS"},
+	{ScriptVerifyInputSigChecks, "INPUT_SIGCHECKS"},
+	{ScriptVerifyReverseBytes, "REVERSEBYTES"},
+	{ScriptVerify64BitIntegers, "64_BIT_INTEGERS"},
+	{ScriptVerifyNativeIntrospection, "NATIVE_INTROSPECTION"},
+	{ScriptAllowCashTokens, "TOKENS"},
+	{ScriptAllowMay2025, "MAY2025"},
+	{ScriptAllowMay2025StandardOnly, "MAY2025_STANDARD_ONLY"},
+}
+
+// Names returns the names of the flags set in the ScriptFlags.
+func (scriptFlags ScriptFlags) Names() []string {
+	var names []string
+	for _, f := range scriptFlagNames {
+		if scriptFlags.HasFlag(f.flag) {
+			names = append(names, f.name)
+		}
+	}
+	return names
+}
+
+// String returns the ScriptFlags in human-readable form.
+func (scriptFlags ScriptFlags) String() string {
+	return strings.Join(scriptFlags.Names(), ",")
+}
+
+// ParseScriptFlag returns the script flag with the passed name.  The name is
+// case insensitive.
+func ParseScriptFlag(name string) (ScriptFlags, error) {
+	for _, f := range scriptFlagNames {
+		if strings.EqualFold(f.name, name) {
+			return f.flag, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown script flag %q", name)
+}
+
+// ScriptFlagOverrides describes script flags which are forcibly enabled or
+// disabled on top of the flags selected by the consensus and policy rules.
+// They are only meant for testing the behavior of upgrades on test networks
+// since overriding the flags splits the node from the rest of the network.
+type ScriptFlagOverrides struct {
+	Enable  ScriptFlags
+	Disable ScriptFlags
+}
+
+// ParseScriptFlagOverrides parses script flag overrides from a comma separated
+// list of flag names, each prefixed by + to enable the flag or - to disable it,
+// such as "+MINIMALIF,-SCHNORR".
+func ParseScriptFlagOverrides(s string) (ScriptFlagOverrides, error) {
+	var overrides ScriptFlagOverrides
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if field[0] != '+' && field[0] != '-' {
+			return ScriptFlagOverrides{}, fmt.Errorf("script flag "+
+				"override %q must be prefixed by + or -", field)
+		}
+		flag, err := ParseScriptFlag(field[1:])
+		if err != nil {
+			return ScriptFlagOverrides{}, err
+		}
+		if field[0] == '+' {
+			overrides.Enable |= flag
+			overrides.Disable &^= flag
+		} else {
+			overrides.Disable |= flag
+			overrides.Enable &^= flag
+		}
+	}
+	return overrides, nil
+}
+
+// IsEmpty returns whether no flags are overridden.
+func (o ScriptFlagOverrides) IsEmpty() bool {
+	return o.Enable == 0 && o.Disable == 0
+}
+
+// Apply returns the passed flags with the overrides applied.
+func (o ScriptFlagOverrides) Apply(flags ScriptFlags) ScriptFlags {
+	return (flags | o.Enable) &^ o.Disable
+}
+
+// Names returns the overridden flags, each prefixed by + when the flag is
+// enabled or - when it's disabled.
+func (o ScriptFlagOverrides) Names() []string {
+	var names []string
+	for _, name := range o.Enable.Names() {
+		names = append(names, "+"+name)
+	}
+	for _, name := range o.Disable.Names() {
+		names = append(names, "-"+name)
+	}
+	return names
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txscript
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestScriptFlagNames ensures every script flag has a name which parses back
+// into the flag.
+func TestScriptFlagNames(t *testing.T) {
+	for flag := ScriptBip16; flag <= ScriptAllowMay2025StandardOnly; flag <<= 1 {
+		names := flag.Names()
+		if len(names) != 1 {
+			t.Fatalf("flag %#x has names %v", uint32(flag), names)
+		}
+		parsed, err := ParseScriptFlag(names[0])
+		if err != nil {
+			t.Fatalf("ParseScriptFlag(%q): %v", names[0], err)
+		}
+		if parsed != flag {
+			t.Fatalf("ParseScriptFlag(%q) = %#x, want %#x", names[0],
+				uint32(parsed), uint32(flag))
+		}
+	}
+}
+
+// TestParseScriptFlagOverrides ensures script flag overrides are parsed and
+// applied as expected.
+func TestParseScriptFlagOverrides(t *testing.T) {
+	overrides, err := ParseScriptFlagOverrides("+minimalif, -SCHNORR,+SCHNORR,-TOKENS")
+	if err != nil {
+		t.Fatalf("ParseScriptFlagOverrides: %v", err)
+	}
+	want := ScriptFlagOverrides{
+		Enable:  ScriptVerifyMinimalIf | ScriptVerifySchnorr,
+		Disable: ScriptAllowCashTokens,
+	}
+	if overrides != want {
+		t.Fatalf("got overrides %+v, want %+v", overrides, want)
+	}
+	names := []string{"+MINIMALIF", "+SCHNORR", "-TOKENS"}
+	if !reflect.DeepEqual(overrides.Names(), names) {
+		t.Fatalf("got names %v, want %v", overrides.Names(), names)
+	}
+
+	flags := overrides.Apply(ScriptBip16 | ScriptAllowCashTokens)
+	if flags != ScriptBip16|ScriptVerifyMinimalIf|ScriptVerifySchnorr {
+		t.Fatalf("got flags %v", flags)
+	}
+
+	for _, s := range []string{"MINIMALIF", "+UNKNOWN", "+"} {
+		if _, err := ParseScriptFlagOverrides(s); err == nil {
+			t.Fatalf("parsed invalid overrides %q", s)
+		}
+	}
+}
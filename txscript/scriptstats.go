@@ -0,0 +1,77 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txscript
+
+// ScriptStats holds the properties of a script which can be determined without
+// executing it.
+type ScriptStats struct {
+	// NumOpcodes is the number of opcodes of the script, data pushes
+	// included.
+	NumOpcodes int
+
+	// PushedBytes is the number of bytes pushed by the data push opcodes
+	// of the script.
+	PushedBytes int
+
+	// MaxSigChecks is the largest number of signature checks the script
+	// may perform when every opcode is executed.  Multisig checks count
+	// the number of public keys when it immediately precedes the opcode
+	// and MaxPubKeysPerMultiSig otherwise.
+	MaxSigChecks int
+
+	// Opcodes holds the names of the distinct opcodes of the script which
+	// are not data pushes, in the order they first appear.
+	Opcodes []string
+}
+
+// EstimatedOpCost returns the operation cost of executing every opcode of the
+// script once along with its data pushes and signature checks.  Opcodes which
+// manipulate the stack add a cost depending on their operands which can't be
+// known without executing the script, so this is an estimate of the least cost
+// of a spend.
+func (s *ScriptStats) EstimatedOpCost() int {
+	return s.NumOpcodes*OpcodeBaseCost + s.PushedBytes +
+		s.MaxSigChecks*SigCheckCostFactor
+}
+
+// CalcScriptStats returns the properties of the passed script which can be
+// determined without executing it.  An error is returned when the script can't
+// be parsed.
+func CalcScriptStats(script []byte) (*ScriptStats, error) {
+	pops, err := parseScript(script)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &ScriptStats{NumOpcodes: len(pops)}
+	seen := make(map[byte]struct{})
+	for i, pop := range pops {
+		op := pop.opcode.value
+		if op <= OP_16 && op != OP_RESERVED {
+			stats.PushedBytes += len(pop.data)
+			continue
+		}
+
+		switch op {
+		case OP_CHECKSIG, OP_CHECKSIGVERIFY, OP_CHECKDATASIG,
+			OP_CHECKDATASIGVERIFY:
+
+			stats.MaxSigChecks++
+
+		case OP_CHECKMULTISIG, OP_CHECKMULTISIGVERIFY:
+			if i > 0 && isSmallInt(pops[i-1].opcode) {
+				stats.MaxSigChecks += asSmallInt(pops[i-1].opcode)
+			} else {
+				stats.MaxSigChecks += MaxPubKeysPerMultiSig
+			}
+		}
+
+		if _, ok := seen[op]; !ok {
+			seen[op] = struct{}{}
+			stats.Opcodes = append(stats.Opcodes, pop.opcode.name)
+		}
+	}
+	return stats, nil
+}
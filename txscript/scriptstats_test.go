@@ -0,0 +1,83 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txscript
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestCalcScriptStats ensures the opcodes, data pushes and signature checks of
+// scripts are counted as expected.
+func TestCalcScriptStats(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   ScriptStats
+	}{
+		{
+			name: "p2pkh",
+			script: "DUP HASH160 DATA_20 0x01020304050607080910" +
+				"11121314151617181920 EQUALVERIFY CHECKSIG",
+			want: ScriptStats{
+				NumOpcodes:   5,
+				PushedBytes:  20,
+				MaxSigChecks: 1,
+				Opcodes: []string{"OP_DUP", "OP_HASH160",
+					"OP_EQUALVERIFY", "OP_CHECKSIG"},
+			},
+		},
+		{
+			name: "2 of 3 multisig",
+			script: "2 DATA_33 0x02" + strings.Repeat("01", 32) +
+				" DATA_33 0x02" + strings.Repeat("02", 32) +
+				" DATA_33 0x02" + strings.Repeat("03", 32) +
+				" 3 CHECKMULTISIG",
+			want: ScriptStats{
+				NumOpcodes:   6,
+				PushedBytes:  99,
+				MaxSigChecks: 3,
+				Opcodes:      []string{"OP_CHECKMULTISIG"},
+			},
+		},
+		{
+			name:   "multisig with a computed key count",
+			script: "DEPTH CHECKMULTISIGVERIFY CHECKDATASIG",
+			want: ScriptStats{
+				NumOpcodes:   3,
+				MaxSigChecks: MaxPubKeysPerMultiSig + 1,
+				Opcodes: []string{"OP_DEPTH",
+					"OP_CHECKMULTISIGVERIFY", "OP_CHECKDATASIG"},
+			},
+		},
+		{
+			name:   "repeated opcodes",
+			script: "INPUTINDEX UTXOVALUE INPUTINDEX UTXOVALUE ADD",
+			want: ScriptStats{
+				NumOpcodes: 5,
+				Opcodes: []string{"OP_INPUTINDEX",
+					"OP_UTXOVALUE", "OP_ADD"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		stats, err := CalcScriptStats(mustParseShortForm(test.script))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(*stats, test.want) {
+			t.Errorf("%s: got stats %+v, want %+v", test.name,
+				*stats, test.want)
+		}
+	}
+
+	// Scripts which can't be parsed must be rejected.
+	if _, err := CalcScriptStats([]byte{OP_DATA_2, 0x01}); err == nil {
+		t.Error("CalcScriptStats accepted a truncated data push")
+	}
+}
@@ -97,3 +97,22 @@ func (s *SigCache) Add(sigHash chainhash.Hash, sig *bchec.Signature, pubKey *bch
 	}
 	s.validSigs[sigHash] = sigCacheEntry{sig, pubKey}
 }
+
+// SetMaxEntries changes the maximum number of entries allowed to exist in the
+// SigCache.  Random entries are evicted when the cache holds more entries than
+// the new max.
+//
+// NOTE: This function is safe for concurrent access. Writers will block
+// simultaneous readers until function execution has concluded.
+func (s *SigCache) SetMaxEntries(maxEntries uint) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.maxEntries = maxEntries
+	for sigEntry := range s.validSigs {
+		if uint(len(s.validSigs)) <= maxEntries {
+			break
+		}
+		delete(s.validSigs, sigEntry)
+	}
+}
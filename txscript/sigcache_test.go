@@ -138,3 +138,33 @@ func TestSigCacheAddMaxEntriesZeroOrNegative(t *testing.T) {
 			"been added", len(sigCache.validSigs))
 	}
 }
+
+// TestSigCacheSetMaxEntries tests that lowering the max number of entries of
+// the signature cache evicts entries down to the new max and that the new max
+// applies to entries added afterwards.
+func TestSigCacheSetMaxEntries(t *testing.T) {
+	sigCache := NewSigCache(20)
+	for i := 0; i < 20; i++ {
+		msg, sig, key, err := genRandomSig()
+		if err != nil {
+			t.Fatalf("unable to generate random signature test data")
+		}
+		sigCache.Add(*msg, sig, key)
+	}
+
+	sigCache.SetMaxEntries(5)
+	if len(sigCache.validSigs) != 5 {
+		t.Fatalf("sigcache should now have 5 entries, instead it has %v",
+			len(sigCache.validSigs))
+	}
+
+	msg, sig, key, err := genRandomSig()
+	if err != nil {
+		t.Fatalf("unable to generate random signature test data")
+	}
+	sigCache.Add(*msg, sig, key)
+	if len(sigCache.validSigs) != 5 {
+		t.Fatalf("sigcache should still have 5 entries, instead it has %v",
+			len(sigCache.validSigs))
+	}
+}
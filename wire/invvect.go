@@ -30,6 +30,10 @@ const (
 	InvTypeBlock         InvType = 2
 	InvTypeFilteredBlock InvType = 3
 	InvTypeCmpctBlock    InvType = 4
+
+	// InvTypeDoubleSpendProof announces a double-spend proof, which is
+	// identified by its hash.
+	InvTypeDoubleSpendProof InvType = 0x94a0
 )
 
 // Map of service flags back to their constant names for pretty printing.
@@ -39,6 +43,8 @@ var ivStrings = map[InvType]string{
 	InvTypeBlock:         "MSG_BLOCK",
 	InvTypeFilteredBlock: "MSG_FILTERED_BLOCK",
 	InvTypeCmpctBlock:    "MSG_CMPCT_BLOCK",
+
+	InvTypeDoubleSpendProof: "MSG_DOUBLESPENDPROOF",
 }
 
 // String returns the InvType in human-readable form.
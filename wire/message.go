@@ -74,6 +74,7 @@ const (
 	CmdCmpctBlock   = "cmpctblock"
 	CmdGetBlockTxns = "getblocktxn"
 	CmdBlockTxns    = "blocktxn"
+	CmdDSProofBeta  = "dsproof-beta"
 	CmdSendAddrV2   = "sendaddrv2"
 )
 
@@ -214,6 +215,9 @@ func makeEmptyMessage(command string) (Message, error) {
 	case CmdBlockTxns:
 		msg = &MsgBlockTxns{}
 
+	case CmdDSProofBeta:
+		msg = &MsgDSProofBeta{}
+
 	default:
 		return nil, fmt.Errorf("unhandled command [%s]", command)
 	}
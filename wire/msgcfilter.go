@@ -17,6 +17,10 @@ type FilterType uint8
 const (
 	// GCSFilterRegular is the regular filter type.
 	GCSFilterRegular FilterType = iota
+
+	// GCSFilterExtended is the extended filter type, which also includes
+	// the token categories of the outputs carrying tokens.
+	GCSFilterExtended
 )
 
 const (
@@ -0,0 +1,163 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+)
+
+const (
+	// MaxDSProofPushes is the maximum number of data pushes of a spender of
+	// a double-spend proof.  Proofs are only created for pay-to-pubkey-hash
+	// outputs, whose spenders only push their signature.
+	MaxDSProofPushes = 1
+
+	// MaxDSProofPushSize is the maximum size of a data push of a spender of
+	// a double-spend proof, which is the maximum size of a script element.
+	MaxDSProofPushSize = 520
+
+	// maxDSProofSpenderPayload is the maximum payload size of a spender of
+	// a double-spend proof.  Version 4 bytes + sequence 4 bytes + locktime
+	// 4 bytes + 3 hashes + push count and the pushes with their lengths.
+	maxDSProofSpenderPayload = 12 + 3*chainhash.HashSize +
+		MaxVarIntPayload + MaxDSProofPushes*(MaxVarIntPayload+MaxDSProofPushSize)
+
+	// maxDSProofPayload is the maximum payload size of a double-spend proof,
+	// which is the outpoint and the two spenders.
+	maxDSProofPayload = chainhash.HashSize + 4 + 2*maxDSProofSpenderPayload
+)
+
+// DSProofSpender describes one of the two transactions spending the output of
+// a double-spend proof by the parts of the BIP0143 signature digest of its
+// input spending the output, along with the data pushed by that input.  The
+// hashes are zero when the signature does not cover them.
+type DSProofSpender struct {
+	Version         uint32
+	Sequence        uint32
+	LockTime        uint32
+	HashPrevOutputs chainhash.Hash
+	HashSequence    chainhash.Hash
+	HashOutputs     chainhash.Hash
+	PushData        [][]byte
+}
+
+// readDSProofSpender reads an encoded DSProofSpender from r.
+func readDSProofSpender(r io.Reader, pver uint32, s *DSProofSpender) error {
+	err := readElements(r, &s.Version, &s.Sequence, &s.LockTime,
+		&s.HashPrevOutputs, &s.HashSequence, &s.HashOutputs)
+	if err != nil {
+		return err
+	}
+
+	count, err := ReadVarInt(r, pver)
+	if err != nil {
+		return err
+	}
+	if count > MaxDSProofPushes {
+		str := fmt.Sprintf("too many pushes for double-spend proof "+
+			"spender [count %d, max %d]", count, MaxDSProofPushes)
+		return messageError("readDSProofSpender", str)
+	}
+	s.PushData = make([][]byte, 0, count)
+	for i := uint64(0); i < count; i++ {
+		data, err := ReadVarBytes(r, pver, MaxDSProofPushSize,
+			"double-spend proof push data")
+		if err != nil {
+			return err
+		}
+		s.PushData = append(s.PushData, data)
+	}
+	return nil
+}
+
+// writeDSProofSpender encodes a DSProofSpender to w.
+func writeDSProofSpender(w io.Writer, pver uint32, s *DSProofSpender) error {
+	err := writeElements(w, s.Version, s.Sequence, s.LockTime,
+		&s.HashPrevOutputs, &s.HashSequence, &s.HashOutputs)
+	if err != nil {
+		return err
+	}
+
+	if err := WriteVarInt(w, pver, uint64(len(s.PushData))); err != nil {
+		return err
+	}
+	for _, data := range s.PushData {
+		if err := WriteVarBytes(w, pver, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MsgDSProofBeta implements the Message interface and represents a bitcoin
+// dsproof-beta message.  It proves that an output was spent by two different
+// transactions without including them entirely, so nodes can alert their
+// users of a double spend of an unconfirmed payment.  The spenders are ordered
+// by the hash of their outputs and then the hash of their previous outputs.
+type MsgDSProofBeta struct {
+	PrevOut       OutPoint
+	FirstSpender  DSProofSpender
+	DoubleSpender DSProofSpender
+}
+
+// BchDecode decodes r using the bitcoin protocol encoding into the receiver.
+// This is part of the Message interface implementation.
+func (msg *MsgDSProofBeta) BchDecode(r io.Reader, pver uint32, enc MessageEncoding) error {
+	err := readElements(r, &msg.PrevOut.Hash, &msg.PrevOut.Index)
+	if err != nil {
+		return err
+	}
+	if err := readDSProofSpender(r, pver, &msg.FirstSpender); err != nil {
+		return err
+	}
+	return readDSProofSpender(r, pver, &msg.DoubleSpender)
+}
+
+// BchEncode encodes the receiver to w using the bitcoin protocol encoding.
+// This is part of the Message interface implementation.
+func (msg *MsgDSProofBeta) BchEncode(w io.Writer, pver uint32, enc MessageEncoding) error {
+	err := writeElements(w, &msg.PrevOut.Hash, msg.PrevOut.Index)
+	if err != nil {
+		return err
+	}
+	if err := writeDSProofSpender(w, pver, &msg.FirstSpender); err != nil {
+		return err
+	}
+	return writeDSProofSpender(w, pver, &msg.DoubleSpender)
+}
+
+// Command returns the protocol command string for the message.  This is part
+// of the Message interface implementation.
+func (msg *MsgDSProofBeta) Command() string {
+	return CmdDSProofBeta
+}
+
+// MaxPayloadLength returns the maximum length the payload can be for the
+// receiver.  This is part of the Message interface implementation.
+func (msg *MsgDSProofBeta) MaxPayloadLength(pver uint32) uint32 {
+	return maxDSProofPayload
+}
+
+// Hash returns the hash identifying the proof, which is the double sha256 of
+// its serialization.
+func (msg *MsgDSProofBeta) Hash() chainhash.Hash {
+	var buf bytes.Buffer
+	_ = msg.BchEncode(&buf, 0, BaseEncoding)
+	return chainhash.DoubleHashH(buf.Bytes())
+}
+
+// NewMsgDSProofBeta returns a new bitcoin dsproof-beta message that conforms
+// to the Message interface using the passed parameters.
+func NewMsgDSProofBeta(prevOut OutPoint, firstSpender, doubleSpender *DSProofSpender) *MsgDSProofBeta {
+	return &MsgDSProofBeta{
+		PrevOut:       prevOut,
+		FirstSpender:  *firstSpender,
+		DoubleSpender: *doubleSpender,
+	}
+}
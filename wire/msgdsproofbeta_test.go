@@ -0,0 +1,115 @@
+// Copyright (c) 2026 The bchd developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+)
+
+// newTestDSProof returns a double-spend proof used by the tests.
+func newTestDSProof() *MsgDSProofBeta {
+	spender := func(seed byte) *DSProofSpender {
+		return &DSProofSpender{
+			Version:         2,
+			Sequence:        MaxTxInSequenceNum - 1,
+			LockTime:        uint32(seed),
+			HashPrevOutputs: chainhash.Hash{seed, 1},
+			HashSequence:    chainhash.Hash{seed, 2},
+			HashOutputs:     chainhash.Hash{seed, 3},
+			PushData:        [][]byte{bytes.Repeat([]byte{seed}, 65)},
+		}
+	}
+	prevOut := NewOutPoint(&chainhash.Hash{0xaa}, 3)
+	return NewMsgDSProofBeta(*prevOut, spender(1), spender(2))
+}
+
+// TestDSProofBeta tests the MsgDSProofBeta API and that it survives a round
+// trip through the wire encoding.
+func TestDSProofBeta(t *testing.T) {
+	pver := ProtocolVersion
+	enc := BaseEncoding
+
+	msg := newTestDSProof()
+	if cmd := msg.Command(); cmd != "dsproof-beta" {
+		t.Errorf("NewMsgDSProofBeta: wrong command - got %v want %v",
+			cmd, "dsproof-beta")
+	}
+
+	var buf bytes.Buffer
+	if err := msg.BchEncode(&buf, pver, enc); err != nil {
+		t.Fatalf("encode of MsgDSProofBeta failed: %v", err)
+	}
+	if uint32(buf.Len()) > msg.MaxPayloadLength(pver) {
+		t.Errorf("encoded size %d exceeds max payload length %d",
+			buf.Len(), msg.MaxPayloadLength(pver))
+	}
+	serialized := buf.Bytes()
+
+	var readmsg MsgDSProofBeta
+	if err := readmsg.BchDecode(bytes.NewReader(serialized), pver, enc); err != nil {
+		t.Fatalf("decode of MsgDSProofBeta failed: %v", err)
+	}
+	if !reflect.DeepEqual(&readmsg, msg) {
+		t.Errorf("BchDecode: wrong message - got %v, want %v",
+			spew.Sdump(&readmsg), spew.Sdump(msg))
+	}
+	if readmsg.Hash() != chainhash.DoubleHashH(serialized) {
+		t.Errorf("Hash: got %v, want %v", readmsg.Hash(),
+			chainhash.DoubleHashH(serialized))
+	}
+
+	// The hash commits to every field.
+	hash := msg.Hash()
+	msg.DoubleSpender.PushData[0][0] ^= 0xff
+	if msg.Hash() == hash {
+		t.Errorf("Hash: unchanged after modifying the push data")
+	}
+}
+
+// TestDSProofBetaWireErrors performs negative tests against wire encode and
+// decode of MsgDSProofBeta to confirm error paths work correctly.
+func TestDSProofBetaWireErrors(t *testing.T) {
+	pver := ProtocolVersion
+	enc := BaseEncoding
+
+	msg := newTestDSProof()
+	var buf bytes.Buffer
+	if err := msg.BchEncode(&buf, pver, enc); err != nil {
+		t.Fatalf("encode of MsgDSProofBeta failed: %v", err)
+	}
+	serialized := buf.Bytes()
+
+	// Truncated encodings must fail to decode and writers failing at any
+	// point must fail the encode.
+	for _, max := range []int{0, 36, 40, 148, 150, 200, len(serialized) - 1} {
+		var readmsg MsgDSProofBeta
+		r := newFixedReader(max, serialized)
+		if err := readmsg.BchDecode(r, pver, enc); err == nil {
+			t.Errorf("BchDecode #%d: decoded truncated message", max)
+		}
+		w := newFixedWriter(max)
+		if err := msg.BchEncode(w, pver, enc); err == nil {
+			t.Errorf("BchEncode #%d: encoded into short writer", max)
+		}
+	}
+
+	// Too many pushes must be rejected.
+	msg.FirstSpender.PushData = append(msg.FirstSpender.PushData, []byte{1})
+	buf.Reset()
+	if err := msg.BchEncode(&buf, pver, enc); err != nil {
+		t.Fatalf("encode of MsgDSProofBeta failed: %v", err)
+	}
+	var readmsg MsgDSProofBeta
+	err := readmsg.BchDecode(&buf, pver, enc)
+	if _, ok := err.(*MessageError); !ok {
+		t.Errorf("BchDecode: got error %v with too many pushes, want "+
+			"MessageError", err)
+	}
+}
@@ -112,6 +112,14 @@ const (
 	SFNodeNetworkLimited
 )
 
+const (
+	// SFNodeCFExtended is a flag used to indicate a peer supports the
+	// extended committed filters, which also include the token categories
+	// of the outputs carrying tokens.  It uses one of the bits 24 to 31
+	// reserved for experimental services until a bit is assigned to it.
+	SFNodeCFExtended ServiceFlag = 1 << 24
+)
+
 // Map of service flags back to their constant names for pretty printing.
 var sfStrings = map[ServiceFlag]string{
 	SFNodeNetwork:        "SFNodeNetwork",
@@ -125,6 +133,7 @@ var sfStrings = map[ServiceFlag]string{
 	SFNodeCF:             "SFNodeCF",
 	SFNodeXThinner:       "SFNodeXThinner",
 	SFNodeNetworkLimited: "SFNodeNetworkLimited",
+	SFNodeCFExtended:     "SFNodeCFExtended",
 }
 
 // orderedSFStrings is an ordered list of service flags from highest to
@@ -141,31 +150,34 @@ var orderedSFStrings = []ServiceFlag{
 	SFNodeCF,
 	SFNodeXThinner,
 	SFNodeNetworkLimited,
+	SFNodeCFExtended,
 }
 
-// String returns the ServiceFlag in human-readable form.
-func (f ServiceFlag) String() string {
-	// No flags are set.
-	if f == 0 {
-		return "0x0"
-	}
-
-	// Add individual bit flags.
-	s := ""
+// Names returns the names of the individual service flags set in the
+// ServiceFlag along with any remaining flags which aren't accounted for as a
+// single hex value.
+func (f ServiceFlag) Names() []string {
+	var names []string
 	for _, flag := range orderedSFStrings {
 		if f&flag == flag {
-			s += sfStrings[flag] + "|"
+			names = append(names, sfStrings[flag])
 			f -= flag
 		}
 	}
-
-	// Add any remaining flags which aren't accounted for as hex.
-	s = strings.TrimRight(s, "|")
 	if f != 0 {
-		s += "|0x" + strconv.FormatUint(uint64(f), 16)
+		names = append(names, "0x"+strconv.FormatUint(uint64(f), 16))
+	}
+	return names
+}
+
+// String returns the ServiceFlag in human-readable form.
+func (f ServiceFlag) String() string {
+	// No flags are set.
+	if f == 0 {
+		return "0x0"
 	}
-	s = strings.TrimLeft(s, "|")
-	return s
+
+	return strings.Join(f.Names(), "|")
 }
 
 // BitcoinNet represents which bitcoin network a message belongs to.
@@ -4,7 +4,10 @@
 
 package wire
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 // TestServiceFlagStringer tests the stringized output for service flag types.
 func TestServiceFlagStringer(t *testing.T) {
@@ -24,7 +27,8 @@ func TestServiceFlagStringer(t *testing.T) {
 		{SFNodeCF, "SFNodeCF"},
 		{SFNodeXThinner, "SFNodeXThinner"},
 		{SFNodeNetworkLimited, "SFNodeNetworkLimited"},
-		{0xffffffff, "SFNodeNetwork|SFNodeGetUTXO|SFNodeBloom|SFNodeWitness|SFNodeXthin|SFNodeBitcoinCash|SFNodeGraphene|SFNodeWeakBlocks|SFNodeCF|SFNodeXThinner|SFNodeNetworkLimited|0xfffff800"},
+		{SFNodeCFExtended, "SFNodeCFExtended"},
+		{0xffffffff, "SFNodeNetwork|SFNodeGetUTXO|SFNodeBloom|SFNodeWitness|SFNodeXthin|SFNodeBitcoinCash|SFNodeGraphene|SFNodeWeakBlocks|SFNodeCF|SFNodeXThinner|SFNodeNetworkLimited|SFNodeCFExtended|0xfefff800"},
 	}
 
 	t.Logf("Running %d tests", len(tests))
@@ -38,6 +42,32 @@ func TestServiceFlagStringer(t *testing.T) {
 	}
 }
 
+// TestServiceFlagNames tests the decomposition of service flags into the names
+// of the individual flags.
+func TestServiceFlagNames(t *testing.T) {
+	tests := []struct {
+		in   ServiceFlag
+		want []string
+	}{
+		{0, nil},
+		{SFNodeNetworkLimited, []string{"SFNodeNetworkLimited"}},
+		{SFNodeNetwork | SFNodeBloom | SFNodeBitcoinCash | SFNodeCF,
+			[]string{"SFNodeNetwork", "SFNodeBloom", "SFNodeBitcoinCash",
+				"SFNodeCF"}},
+		{SFNodeCFExtended | 0x10000, []string{"SFNodeCFExtended", "0x10000"}},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		result := test.in.Names()
+		if !reflect.DeepEqual(result, test.want) {
+			t.Errorf("Names #%d\n got: %v want: %v", i, result,
+				test.want)
+			continue
+		}
+	}
+}
+
 // TestBitcoinNetStringer tests the stringized output for bitcoin net types.
 func TestBitcoinNetStringer(t *testing.T) {
 	tests := []struct {